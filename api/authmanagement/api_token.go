@@ -42,7 +42,7 @@ func (a AuthManagementService) APIToken(ctx context.Context, req *connect.Reques
 
 	telemetry.WithAttributes(span, telemetry.AttributeKV{Key: "project-id", Value: req.Msg.ProjectId})
 
-	existingTokens, err := a.Config.APITokenManager.ListAPITokensByProjectID(uint(req.Msg.ProjectId))
+	existingTokens, err := a.Config.APITokenManager.ListAPITokensByProjectID(ctx, uint(req.Msg.ProjectId))
 	if err != nil {
 		return resp, telemetry.Error(ctx, span, err, "error listing api tokens")
 	}
@@ -75,7 +75,7 @@ func (a AuthManagementService) APIToken(ctx context.Context, req *connect.Reques
 			Name:       "porter-agent-token",
 		}
 
-		apiToken, err = a.Config.APITokenManager.CreateAPIToken(apiToken)
+		apiToken, err = a.Config.APITokenManager.CreateAPIToken(ctx, apiToken)
 		if err != nil {
 			return resp, telemetry.Error(ctx, span, err, "error creating api token")
 		}
@@ -0,0 +1,103 @@
+package client
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/porter-dev/porter/api/types"
+)
+
+// ListAddonTemplates lists the curated charts which can be installed as addons
+func (c *Client) ListAddonTemplates(
+	ctx context.Context,
+	projectID, clusterID uint,
+) (*types.ListAddonTemplatesResponse, error) {
+	resp := &types.ListAddonTemplatesResponse{}
+
+	err := c.getRequest(
+		fmt.Sprintf(
+			"/projects/%d/clusters/%d/addons/templates",
+			projectID, clusterID,
+		),
+		nil,
+		resp,
+	)
+
+	return resp, err
+}
+
+// ListAddons lists the addons installed into a cluster
+func (c *Client) ListAddons(
+	ctx context.Context,
+	projectID, clusterID uint,
+) (*types.ListAddonsResponse, error) {
+	resp := &types.ListAddonsResponse{}
+
+	err := c.getRequest(
+		fmt.Sprintf(
+			"/projects/%d/clusters/%d/addons",
+			projectID, clusterID,
+		),
+		nil,
+		resp,
+	)
+
+	return resp, err
+}
+
+// InstallAddon installs a curated chart into a cluster
+func (c *Client) InstallAddon(
+	ctx context.Context,
+	projectID, clusterID uint,
+	req *types.InstallAddonRequest,
+) (*types.Addon, error) {
+	resp := &types.Addon{}
+
+	err := c.postRequest(
+		fmt.Sprintf(
+			"/projects/%d/clusters/%d/addons",
+			projectID, clusterID,
+		),
+		req,
+		resp,
+	)
+
+	return resp, err
+}
+
+// UpgradeAddon changes the chart version or values of an addon
+func (c *Client) UpgradeAddon(
+	ctx context.Context,
+	projectID, clusterID uint,
+	name string,
+	req *types.UpgradeAddonRequest,
+) (*types.Addon, error) {
+	resp := &types.Addon{}
+
+	err := c.postRequest(
+		fmt.Sprintf(
+			"/projects/%d/clusters/%d/addons/%s",
+			projectID, clusterID, name,
+		),
+		req,
+		resp,
+	)
+
+	return resp, err
+}
+
+// UninstallAddon uninstalls an addon from a cluster
+func (c *Client) UninstallAddon(
+	ctx context.Context,
+	projectID, clusterID uint,
+	name string,
+) error {
+	return c.deleteRequest(
+		fmt.Sprintf(
+			"/projects/%d/clusters/%d/addons/%s",
+			projectID, clusterID, name,
+		),
+		nil,
+		nil,
+	)
+}
@@ -1,20 +1,24 @@
 package client
 
 import (
+	"bufio"
 	"context"
 	"encoding/base64"
 	"encoding/json"
 	"errors"
 	"fmt"
+	"io"
 	"io/ioutil"
 	"net/http"
 	"net/url"
 	"os"
 	"path/filepath"
+	"reflect"
 	"strings"
 	"time"
 
 	"github.com/gorilla/schema"
+	"github.com/gorilla/websocket"
 	"github.com/porter-dev/porter/api/types"
 	"k8s.io/client-go/util/homedir"
 )
@@ -29,6 +33,9 @@ type Client struct {
 
 	// cfToken is a cloudflare token for accessing the API
 	cfToken string
+
+	// mfaCode is a multi-factor authentication code, which is sent with all API calls for operations which require one
+	mfaCode string
 }
 
 // NewClientInput contains all information required to create a new API Client
@@ -59,6 +66,9 @@ func NewClientWithConfig(ctx context.Context, input NewClientInput) (Client, err
 	if cfToken := os.Getenv("PORTER_CF_ACCESS_TOKEN"); cfToken != "" {
 		client.cfToken = cfToken
 	}
+	if mfaCode := os.Getenv("PORTER_MFA_CODE"); mfaCode != "" {
+		client.mfaCode = mfaCode
+	}
 
 	if input.BearerToken != "" {
 		client.Token = input.BearerToken
@@ -106,12 +116,27 @@ func NewClient(baseURL string, cookieFileName string) *Client {
 		client.cfToken = cfToken
 	}
 
+	// sensitive operations require a multi-factor authentication code for users who enabled it
+	if mfaCode := os.Getenv("PORTER_MFA_CODE"); mfaCode != "" {
+		client.mfaCode = mfaCode
+	}
+
 	return client
 }
 
+// newQueryEncoder returns an encoder for query parameters which encodes timestamps as RFC3339, which is how the server decodes them
+func newQueryEncoder() *schema.Encoder {
+	encoder := schema.NewEncoder()
+	encoder.RegisterEncoder(time.Time{}, func(v reflect.Value) string {
+		return v.Interface().(time.Time).Format(time.RFC3339)
+	})
+
+	return encoder
+}
+
 func (c *Client) getRequest(relPath string, data interface{}, response interface{}) error {
 	vals := make(map[string][]string)
-	err := schema.NewEncoder().Encode(data, vals)
+	err := newQueryEncoder().Encode(data, vals)
 
 	urlVals := url.Values(vals)
 	encodedURLVals := urlVals.Encode()
@@ -148,14 +173,23 @@ func (c *Client) getRequest(relPath string, data interface{}, response interface
 
 type postRequestOpts struct {
 	retryCount uint
+	// headers are set on the request along with the content type and auth headers
+	headers map[string]string
 }
 
 func (c *Client) postRequest(relPath string, data interface{}, response interface{}, opts ...postRequestOpts) error {
 	var retryCount uint = 1
+	headers := make(map[string]string)
 
 	if len(opts) > 0 {
 		for _, opt := range opts {
-			retryCount = opt.retryCount
+			if opt.retryCount != 0 {
+				retryCount = opt.retryCount
+			}
+
+			for key, val := range opt.headers {
+				headers[key] = val
+			}
 		}
 	}
 
@@ -177,6 +211,10 @@ func (c *Client) postRequest(relPath string, data interface{}, response interfac
 			return err
 		}
 
+		for key, val := range headers {
+			req.Header.Set(key, val)
+		}
+
 		httpErr, err = c.sendRequest(req, response, true)
 
 		if httpErr == nil && err == nil {
@@ -282,16 +320,7 @@ func (c *Client) sendRequest(req *http.Request, v interface{}, useCookie bool) (
 	req.Header.Set("Content-Type", "application/json; charset=utf-8")
 	req.Header.Set("Accept", "application/json; charset=utf-8")
 
-	if c.Token != "" {
-		req.Header.Set("Authorization", fmt.Sprintf("Bearer %s", c.Token))
-	} else if cookie, _ := c.getCookie(); useCookie && cookie != nil {
-		c.Cookie = cookie
-		req.AddCookie(c.Cookie)
-	}
-
-	if c.cfToken != "" {
-		req.Header.Set("cf-access-token", c.cfToken)
-	}
+	c.setAuthHeaders(req, useCookie)
 
 	res, err := c.HTTPClient.Do(req)
 	if err != nil {
@@ -322,6 +351,165 @@ func (c *Client) sendRequest(req *http.Request, v interface{}, useCookie bool) (
 	return nil, nil
 }
 
+func (c *Client) setAuthHeaders(req *http.Request, useCookie bool) {
+	if c.Token != "" {
+		req.Header.Set("Authorization", fmt.Sprintf("Bearer %s", c.Token))
+	} else if cookie, _ := c.getCookie(); useCookie && cookie != nil {
+		c.Cookie = cookie
+		req.AddCookie(c.Cookie)
+	}
+
+	if c.cfToken != "" {
+		req.Header.Set("cf-access-token", c.cfToken)
+	}
+
+	if c.mfaCode != "" {
+		req.Header.Set(types.MFACodeHeader, c.mfaCode)
+	}
+}
+
+// streamRequest sends a GET request to an endpoint which responds with server-sent events, calling onEvent with the
+// name and data of each event until the server closes the stream, the context is cancelled, or onEvent returns an error.
+// Unlike other requests, the stream is not subject to the timeout of the HTTP client.
+func (c *Client) streamRequest(ctx context.Context, relPath string, data interface{}, onEvent func(event string, data []byte) error) error {
+	vals := make(map[string][]string)
+	if err := newQueryEncoder().Encode(data, vals); err != nil {
+		return err
+	}
+
+	reqURL := fmt.Sprintf("%s%s", c.BaseURL, relPath)
+	if encodedURLVals := url.Values(vals).Encode(); encodedURLVals != "" {
+		reqURL = fmt.Sprintf("%s?%s", reqURL, encodedURLVals)
+	}
+
+	req, err := http.NewRequestWithContext(ctx, "GET", reqURL, nil)
+	if err != nil {
+		return err
+	}
+
+	return c.doStreamRequest(req, onEvent)
+}
+
+// streamPostRequest sends a POST request with the given body to an endpoint which responds with server-sent events, in the
+// same way as streamRequest. The body is streamed, so large uploads are not held in memory.
+func (c *Client) streamPostRequest(ctx context.Context, relPath string, contentType string, body io.Reader, onEvent func(event string, data []byte) error) error {
+	req, err := http.NewRequestWithContext(ctx, "POST", fmt.Sprintf("%s%s", c.BaseURL, relPath), body)
+	if err != nil {
+		return err
+	}
+
+	req.Header.Set("Content-Type", contentType)
+
+	return c.doStreamRequest(req, onEvent)
+}
+
+// doStreamRequest sends a request to an endpoint which responds with server-sent events, and parses the events of the response
+func (c *Client) doStreamRequest(req *http.Request, onEvent func(event string, data []byte) error) error {
+	req.Header.Set("Accept", "text/event-stream")
+	c.setAuthHeaders(req, true)
+
+	httpClient := *c.HTTPClient
+	httpClient.Timeout = 0
+
+	res, err := httpClient.Do(req)
+	if err != nil {
+		return err
+	}
+	defer res.Body.Close()
+
+	if res.StatusCode < http.StatusOK || res.StatusCode >= http.StatusBadRequest {
+		var errRes types.ExternalError
+		if err = json.NewDecoder(res.Body).Decode(&errRes); err == nil {
+			return fmt.Errorf("%v", errRes.Error)
+		}
+
+		return fmt.Errorf("unknown error, status code: %d", res.StatusCode)
+	}
+
+	scanner := bufio.NewScanner(res.Body)
+	scanner.Buffer(make([]byte, 0, 64*1024), 1024*1024)
+
+	var event string
+	var eventData []string
+
+	for scanner.Scan() {
+		line := scanner.Text()
+
+		switch {
+		case line == "":
+			if event != "" || len(eventData) > 0 {
+				if event == "" {
+					event = "message"
+				}
+
+				if err := onEvent(event, []byte(strings.Join(eventData, "\n"))); err != nil {
+					return err
+				}
+			}
+
+			event = ""
+			eventData = nil
+		case strings.HasPrefix(line, ":"):
+			// comments keep the connection alive
+		case strings.HasPrefix(line, "event:"):
+			event = strings.TrimSpace(strings.TrimPrefix(line, "event:"))
+		case strings.HasPrefix(line, "data:"):
+			eventData = append(eventData, strings.TrimPrefix(strings.TrimPrefix(line, "data:"), " "))
+		}
+	}
+
+	return scanner.Err()
+}
+
+// dialWebsocket opens a websocket connection to an endpoint which upgrades to a websocket, authenticating the same way
+// as other requests. The scheme of the base URL is replaced with the matching websocket scheme.
+func (c *Client) dialWebsocket(ctx context.Context, relPath string, data interface{}) (*websocket.Conn, error) {
+	vals := make(map[string][]string)
+	if err := newQueryEncoder().Encode(data, vals); err != nil {
+		return nil, err
+	}
+
+	reqURL, err := url.Parse(fmt.Sprintf("%s%s", c.BaseURL, relPath))
+	if err != nil {
+		return nil, err
+	}
+
+	switch reqURL.Scheme {
+	case "https":
+		reqURL.Scheme = "wss"
+	default:
+		reqURL.Scheme = "ws"
+	}
+
+	reqURL.RawQuery = url.Values(vals).Encode()
+
+	// the auth headers are set on a throwaway request so that the websocket handshake carries the same credentials
+	req, err := http.NewRequestWithContext(ctx, "GET", reqURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	c.setAuthHeaders(req, true)
+
+	conn, res, err := websocket.DefaultDialer.DialContext(ctx, reqURL.String(), req.Header)
+	if err != nil {
+		if res != nil {
+			defer res.Body.Close()
+
+			var errRes types.ExternalError
+			if decodeErr := json.NewDecoder(res.Body).Decode(&errRes); decodeErr == nil && errRes.Error != "" {
+				return nil, fmt.Errorf("%v", errRes.Error)
+			}
+
+			return nil, fmt.Errorf("unknown error, status code: %d", res.StatusCode)
+		}
+
+		return nil, err
+	}
+
+	return conn, nil
+}
+
 // CookieStorage for temporary fs-based cookie storage before jwt tokens
 type CookieStorage struct {
 	Cookie *http.Cookie `json:"cookie"`
@@ -0,0 +1,104 @@
+package client
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/porter-dev/porter/api/types"
+)
+
+// ListAppTemplates lists the app templates of a project
+func (c *Client) ListAppTemplates(
+	ctx context.Context,
+	projectID uint,
+) (*types.ListAppTemplatesResponse, error) {
+	resp := &types.ListAppTemplatesResponse{}
+
+	err := c.getRequest(
+		fmt.Sprintf(
+			"/projects/%d/app-templates",
+			projectID,
+		),
+		nil,
+		resp,
+	)
+
+	return resp, err
+}
+
+// CreateAppTemplate creates an app template, or replaces the app template with the same name
+func (c *Client) CreateAppTemplate(
+	ctx context.Context,
+	projectID uint,
+	req *types.CreateAppTemplateRequest,
+) (*types.AppTemplate, error) {
+	resp := &types.AppTemplate{}
+
+	err := c.postRequest(
+		fmt.Sprintf(
+			"/projects/%d/app-templates",
+			projectID,
+		),
+		req,
+		resp,
+	)
+
+	return resp, err
+}
+
+// GetAppTemplate retrieves an app template by name
+func (c *Client) GetAppTemplate(
+	ctx context.Context,
+	projectID uint,
+	name string,
+) (*types.AppTemplate, error) {
+	resp := &types.AppTemplate{}
+
+	err := c.getRequest(
+		fmt.Sprintf(
+			"/projects/%d/app-templates/%s",
+			projectID, name,
+		),
+		nil,
+		resp,
+	)
+
+	return resp, err
+}
+
+// DeleteAppTemplate deletes an app template by name
+func (c *Client) DeleteAppTemplate(
+	ctx context.Context,
+	projectID uint,
+	name string,
+) error {
+	return c.deleteRequest(
+		fmt.Sprintf(
+			"/projects/%d/app-templates/%s",
+			projectID, name,
+		),
+		nil,
+		nil,
+	)
+}
+
+// InstantiateAppTemplate returns the porter.yaml of a new app created from an app template
+func (c *Client) InstantiateAppTemplate(
+	ctx context.Context,
+	projectID uint,
+	name string,
+	req *types.InstantiateAppTemplateRequest,
+) (*types.InstantiateAppTemplateResponse, error) {
+	resp := &types.InstantiateAppTemplateResponse{}
+
+	err := c.postRequest(
+		fmt.Sprintf(
+			"/projects/%d/app-templates/%s/instantiate",
+			projectID, name,
+		),
+		req,
+		resp,
+	)
+
+	return resp, err
+}
@@ -0,0 +1,135 @@
+package client
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/porter-dev/porter/api/types"
+)
+
+// ListDatastores lists the datastores of a project
+func (c *Client) ListDatastores(
+	ctx context.Context,
+	projectID uint,
+) (*types.ListDatastoresResponse, error) {
+	resp := &types.ListDatastoresResponse{}
+
+	err := c.getRequest(
+		fmt.Sprintf(
+			"/projects/%d/datastores",
+			projectID,
+		),
+		nil,
+		resp,
+	)
+
+	return resp, err
+}
+
+// GetDatastore returns a datastore of a project
+func (c *Client) GetDatastore(
+	ctx context.Context,
+	projectID, datastoreID uint,
+) (*types.Datastore, error) {
+	resp := &types.Datastore{}
+
+	err := c.getRequest(
+		fmt.Sprintf(
+			"/projects/%d/datastores/%d",
+			projectID, datastoreID,
+		),
+		nil,
+		resp,
+	)
+
+	return resp, err
+}
+
+// CreateDatastore provisions a datastore in a project
+func (c *Client) CreateDatastore(
+	ctx context.Context,
+	projectID uint,
+	req *types.CreateDatastoreRequest,
+) (*types.Datastore, error) {
+	resp := &types.Datastore{}
+
+	err := c.postRequest(
+		fmt.Sprintf(
+			"/projects/%d/datastores",
+			projectID,
+		),
+		req,
+		resp,
+	)
+
+	return resp, err
+}
+
+// DeleteDatastore deletes a datastore of a project
+func (c *Client) DeleteDatastore(
+	ctx context.Context,
+	projectID, datastoreID uint,
+) error {
+	return c.deleteRequest(
+		fmt.Sprintf(
+			"/projects/%d/datastores/%d",
+			projectID, datastoreID,
+		),
+		nil,
+		nil,
+	)
+}
+
+// LinkDatastore links a datastore to an app
+func (c *Client) LinkDatastore(
+	ctx context.Context,
+	projectID, datastoreID uint,
+	req *types.LinkDatastoreRequest,
+) (*types.DatastoreLink, error) {
+	resp := &types.DatastoreLink{}
+
+	err := c.postRequest(
+		fmt.Sprintf(
+			"/projects/%d/datastores/%d/links",
+			projectID, datastoreID,
+		),
+		req,
+		resp,
+	)
+
+	return resp, err
+}
+
+// UnlinkDatastore unlinks a datastore from an app
+func (c *Client) UnlinkDatastore(
+	ctx context.Context,
+	projectID, datastoreID, linkID uint,
+) error {
+	return c.deleteRequest(
+		fmt.Sprintf(
+			"/projects/%d/datastores/%d/links/%d",
+			projectID, datastoreID, linkID,
+		),
+		nil,
+		nil,
+	)
+}
+
+// RotateDatastoreCredentials issues new credentials for the database user of a linked app and redeploys the app
+func (c *Client) RotateDatastoreCredentials(
+	ctx context.Context,
+	projectID, datastoreID, linkID uint,
+) (*types.RotateDatastoreCredentialsResponse, error) {
+	resp := &types.RotateDatastoreCredentialsResponse{}
+
+	err := c.postRequest(
+		fmt.Sprintf(
+			"/projects/%d/datastores/%d/links/%d/rotate",
+			projectID, datastoreID, linkID,
+		),
+		nil,
+		resp,
+	)
+
+	return resp, err
+}
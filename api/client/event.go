@@ -24,3 +24,23 @@ func (c *Client) CreateEvent(
 		nil,
 	)
 }
+
+// SearchKubeEvents returns the kube events of a cluster which match a query
+func (c *Client) SearchKubeEvents(
+	ctx context.Context,
+	projID, clusterID uint,
+	req *types.SearchKubeEventsRequest,
+) (*types.SearchKubeEventsResponse, error) {
+	resp := &types.SearchKubeEventsResponse{}
+
+	err := c.getRequest(
+		fmt.Sprintf(
+			"/projects/%d/clusters/%d/kube_events/search",
+			projID, clusterID,
+		),
+		req,
+		resp,
+	)
+
+	return resp, err
+}
@@ -0,0 +1,67 @@
+package client
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/porter-dev/porter/api/types"
+)
+
+// CreateImageAttestation uploads the build provenance attestation of an image after it is pushed
+func (c *Client) CreateImageAttestation(
+	ctx context.Context,
+	projectID uint,
+	req *types.CreateImageAttestationRequest,
+) (*types.ImageAttestation, error) {
+	resp := &types.ImageAttestation{}
+
+	err := c.postRequest(
+		fmt.Sprintf(
+			"/projects/%d/image-attestations",
+			projectID,
+		),
+		req,
+		resp,
+	)
+
+	return resp, err
+}
+
+// GetImageSigningPolicy retrieves the image signing policy of a project
+func (c *Client) GetImageSigningPolicy(
+	ctx context.Context,
+	projectID uint,
+) (*types.ImageSigningPolicy, error) {
+	resp := &types.ImageSigningPolicy{}
+
+	err := c.getRequest(
+		fmt.Sprintf(
+			"/projects/%d/image-signing-policy",
+			projectID,
+		),
+		nil,
+		resp,
+	)
+
+	return resp, err
+}
+
+// UpdateImageSigningPolicy creates or replaces the image signing policy of a project
+func (c *Client) UpdateImageSigningPolicy(
+	ctx context.Context,
+	projectID uint,
+	req *types.UpdateImageSigningPolicyRequest,
+) (*types.ImageSigningPolicy, error) {
+	resp := &types.ImageSigningPolicy{}
+
+	err := c.postRequest(
+		fmt.Sprintf(
+			"/projects/%d/image-signing-policy",
+			projectID,
+		),
+		req,
+		resp,
+	)
+
+	return resp, err
+}
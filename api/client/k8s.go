@@ -8,6 +8,7 @@ import (
 	"strings"
 
 	"github.com/fatih/color"
+	"github.com/gorilla/websocket"
 	"github.com/porter-dev/porter/api/types"
 
 	v1 "k8s.io/api/batch/v1"
@@ -58,6 +59,23 @@ func (c *Client) CreateNewK8sNamespace(
 	return resp, err
 }
 
+// DeleteK8sNamespace deletes a namespace in a k8s cluster
+func (c *Client) DeleteK8sNamespace(
+	ctx context.Context,
+	projectID uint,
+	clusterID uint,
+	namespace string,
+) error {
+	return c.deleteRequest(
+		fmt.Sprintf(
+			"/projects/%d/clusters/%d/namespaces/%s",
+			projectID, clusterID, namespace,
+		),
+		nil,
+		nil,
+	)
+}
+
 func (c *Client) GetKubeconfig(
 	ctx context.Context,
 	projectID uint,
@@ -229,3 +247,25 @@ func (c *Client) GetK8sAllPods(
 
 	return resp, err
 }
+
+// TunnelService opens a websocket tunnel to a port of a service in the cluster. Binary messages sent on the connection are
+// written to the port and data read from the port is received as binary messages. A text message received on the
+// connection is an error which closed the tunnel.
+func (c *Client) TunnelService(
+	ctx context.Context,
+	projectID, clusterID uint,
+	namespace, name string,
+	port int32,
+) (*websocket.Conn, error) {
+	return c.dialWebsocket(
+		ctx,
+		fmt.Sprintf(
+			"/projects/%d/clusters/%d/namespaces/%s/services/%s/tunnel",
+			projectID, clusterID,
+			namespace, name,
+		),
+		&types.TunnelServiceRequest{
+			Port: port,
+		},
+	)
+}
@@ -2,11 +2,16 @@ package client
 
 import (
 	"context"
+	"encoding/json"
 	"fmt"
+	"io"
+	"mime/multipart"
+	"time"
 
 	"github.com/porter-dev/porter/api/server/handlers/porter_app"
 
 	"github.com/porter-dev/porter/api/types"
+	"github.com/porter-dev/porter/internal/cost"
 )
 
 func (c *Client) NewGetPorterApp(
@@ -69,6 +74,25 @@ func (c *Client) NewCreateOrUpdatePorterAppEvent(
 	return *resp, err
 }
 
+// ListPorterApps lists the apps in a given project and cluster
+func (c *Client) ListPorterApps(
+	ctx context.Context,
+	projectID, clusterID uint,
+) (types.ListPorterAppResponse, error) {
+	resp := types.ListPorterAppResponse{}
+
+	err := c.getRequest(
+		fmt.Sprintf(
+			"/projects/%d/clusters/%d/applications",
+			projectID, clusterID,
+		),
+		nil,
+		&resp,
+	)
+
+	return resp, err
+}
+
 // TODO: remove these functions once they are no longer called (check telemetry)
 func (c *Client) GetPorterApp(
 	ctx context.Context,
@@ -202,13 +226,19 @@ func (c *Client) ValidatePorterApp(
 	return resp, err
 }
 
-// ApplyPorterApp takes in a base64 encoded app definition and applies it to the cluster
+// ApplyPorterApp takes in a base64 encoded app definition and applies it to the cluster. ifMatch is the ETag of the configuration
+// of the app the definition was made from, which is required to apply an existing app.
 func (c *Client) ApplyPorterApp(
 	ctx context.Context,
 	projectID, clusterID uint,
 	base64AppProto string,
 	deploymentTarget string,
 	appRevisionID string,
+	appName string,
+	commitSHA string,
+	gitBranch string,
+	gitRepoURL string,
+	ifMatch string,
 ) (*porter_app.ApplyPorterAppResponse, error) {
 	resp := &porter_app.ApplyPorterAppResponse{}
 
@@ -216,6 +246,15 @@ func (c *Client) ApplyPorterApp(
 		Base64AppProto:     base64AppProto,
 		DeploymentTargetId: deploymentTarget,
 		AppRevisionID:      appRevisionID,
+		AppName:            appName,
+		CommitSHA:          commitSHA,
+		GitBranch:          gitBranch,
+		GitRepoURL:         gitRepoURL,
+	}
+
+	var opts []postRequestOpts
+	if ifMatch != "" {
+		opts = append(opts, postRequestOpts{headers: map[string]string{types.IfMatchHeader: ifMatch}})
 	}
 
 	err := c.postRequest(
@@ -225,6 +264,7 @@ func (c *Client) ApplyPorterApp(
 		),
 		req,
 		resp,
+		opts...,
 	)
 
 	return resp, err
@@ -251,6 +291,30 @@ func (c *Client) DefaultDeploymentTarget(
 	return resp, err
 }
 
+// PreviewDeploymentTarget gets or creates the ephemeral deployment target for a preview environment with the given name
+func (c *Client) PreviewDeploymentTarget(
+	ctx context.Context,
+	projectID, clusterID uint,
+	name string,
+) (*porter_app.PreviewDeploymentTargetResponse, error) {
+	resp := &porter_app.PreviewDeploymentTargetResponse{}
+
+	req := &porter_app.PreviewDeploymentTargetRequest{
+		Name: name,
+	}
+
+	err := c.postRequest(
+		fmt.Sprintf(
+			"/projects/%d/clusters/%d/preview-deployment-target",
+			projectID, clusterID,
+		),
+		req,
+		resp,
+	)
+
+	return resp, err
+}
+
 // CurrentAppRevision returns the currently deployed app revision for a given project, app name and deployment target
 func (c *Client) CurrentAppRevision(
 	ctx context.Context,
@@ -275,6 +339,31 @@ func (c *Client) CurrentAppRevision(
 	return resp, err
 }
 
+// AppRevisionDiff returns the field level changes to an app between two of its revisions
+func (c *Client) AppRevisionDiff(
+	ctx context.Context,
+	projectID uint, clusterID uint,
+	appName string, deploymentTarget string,
+	fromRevisionNumber uint64, toRevisionNumber uint64,
+) (*porter_app.AppRevisionDiffResponse, error) {
+	resp := &porter_app.AppRevisionDiffResponse{}
+
+	req := &porter_app.AppRevisionDiffRequest{
+		DeploymentTargetID: deploymentTarget,
+	}
+
+	err := c.getRequest(
+		fmt.Sprintf(
+			"/projects/%d/clusters/%d/apps/%s/revisions/%d/diff/%d",
+			projectID, clusterID, appName, fromRevisionNumber, toRevisionNumber,
+		),
+		req,
+		resp,
+	)
+
+	return resp, err
+}
+
 // CreatePorterAppDBEntryInput is the input struct to CreatePorterAppDBEntry
 type CreatePorterAppDBEntryInput struct {
 	AppName         string
@@ -334,16 +423,19 @@ func (c *Client) CreatePorterAppDBEntry(
 	return err
 }
 
-// CreateSubdomain returns a subdomain for a given service that point to the ingress-nginx service in the cluster
+// CreateSubdomain returns a subdomain for a given service that point to the ingress-nginx service in the cluster, or a subdomain
+// allocated from the wildcard domain of the project if it has one
 func (c *Client) CreateSubdomain(
 	ctx context.Context,
 	projectID uint, clusterID uint,
 	appName string, serviceName string,
+	deploymentTargetID string,
 ) (*porter_app.CreateSubdomainResponse, error) {
 	resp := &porter_app.CreateSubdomainResponse{}
 
 	req := &porter_app.CreateSubdomainRequest{
-		ServiceName: serviceName,
+		ServiceName:        serviceName,
+		DeploymentTargetID: deploymentTargetID,
 	}
 
 	err := c.postRequest(
@@ -357,3 +449,454 @@ func (c *Client) CreateSubdomain(
 
 	return resp, err
 }
+
+// AppStatus returns the status of each service of an app on a deployment target
+func (c *Client) AppStatus(
+	ctx context.Context,
+	projectID, clusterID uint,
+	appName string, deploymentTarget string,
+) (*porter_app.AppStatusResponse, error) {
+	resp := &porter_app.AppStatusResponse{}
+
+	req := &porter_app.AppStatusRequest{
+		DeploymentTargetID: deploymentTarget,
+	}
+
+	err := c.getRequest(
+		fmt.Sprintf(
+			"/projects/%d/clusters/%d/apps/%s/status",
+			projectID, clusterID, appName,
+		),
+		req,
+		resp,
+	)
+
+	return resp, err
+}
+
+// ListJobRuns lists the runs of a job service of an app that started after since, most recent first
+func (c *Client) ListJobRuns(
+	ctx context.Context,
+	projectID, clusterID uint,
+	appName, jobName string,
+	since time.Time,
+) (*types.ListJobRunsResponse, error) {
+	resp := &types.ListJobRunsResponse{}
+
+	req := &types.ListJobRunsRequest{
+		Since: since,
+	}
+
+	err := c.getRequest(
+		fmt.Sprintf(
+			"/projects/%d/clusters/%d/apps/%s/jobs/%s/runs",
+			projectID, clusterID, appName, jobName,
+		),
+		req,
+		resp,
+	)
+
+	return resp, err
+}
+
+// RunJob launches a one-off run of a job service of an app, with optional env and command overrides
+func (c *Client) RunJob(
+	ctx context.Context,
+	projectID, clusterID uint,
+	appName, jobName string,
+	deploymentTargetID string,
+	envOverrides map[string]string,
+	command string,
+) (*porter_app.RunJobResponse, error) {
+	resp := &porter_app.RunJobResponse{}
+
+	req := &porter_app.RunJobRequest{
+		DeploymentTargetID: deploymentTargetID,
+		EnvOverrides:       envOverrides,
+		Command:            command,
+	}
+
+	err := c.postRequest(
+		fmt.Sprintf(
+			"/projects/%d/clusters/%d/apps/%s/jobs/%s/run",
+			projectID, clusterID, appName, jobName,
+		),
+		req,
+		resp,
+	)
+
+	return resp, err
+}
+
+// ResourceRecommendations returns the observed usage and recommended resources of each service of an app
+func (c *Client) ResourceRecommendations(
+	ctx context.Context,
+	projectID, clusterID uint,
+	appName string,
+	deploymentTargetID string,
+	windowHours int,
+) (*porter_app.ResourceRecommendationsResponse, error) {
+	resp := &porter_app.ResourceRecommendationsResponse{}
+
+	req := &porter_app.ResourceRecommendationsRequest{
+		DeploymentTargetID: deploymentTargetID,
+		WindowHours:        windowHours,
+	}
+
+	err := c.getRequest(
+		fmt.Sprintf(
+			"/projects/%d/clusters/%d/apps/%s/resource-recommendations",
+			projectID, clusterID, appName,
+		),
+		req,
+		resp,
+	)
+
+	return resp, err
+}
+
+// CostEstimate returns the estimated monthly cost of the services of a validated app on the cluster
+func (c *Client) CostEstimate(
+	ctx context.Context,
+	projectID, clusterID uint,
+	base64AppProto string,
+) (*cost.Estimate, error) {
+	resp := &cost.Estimate{}
+
+	req := &porter_app.CostEstimateRequest{
+		Base64AppProto: base64AppProto,
+	}
+
+	err := c.postRequest(
+		fmt.Sprintf(
+			"/projects/%d/clusters/%d/apps/cost-estimate",
+			projectID, clusterID,
+		),
+		req,
+		resp,
+	)
+
+	return resp, err
+}
+
+// AppMetrics returns the CPU, memory, network and HTTP latency metrics of the services of an app over a range of unix timestamps
+func (c *Client) AppMetrics(
+	ctx context.Context,
+	projectID, clusterID uint,
+	appName string,
+	req *porter_app.AppMetricsRequest,
+) (*porter_app.AppMetricsResponse, error) {
+	resp := &porter_app.AppMetricsResponse{}
+
+	err := c.getRequest(
+		fmt.Sprintf(
+			"/projects/%d/clusters/%d/apps/%s/metrics",
+			projectID, clusterID, appName,
+		),
+		req,
+		resp,
+	)
+
+	return resp, err
+}
+
+// EnvDiff compares the effective env of an app between two deployment targets, identified by their namespace selectors
+func (c *Client) EnvDiff(
+	ctx context.Context,
+	projectID, clusterID uint,
+	appName string,
+	base, compare string,
+) (*porter_app.EnvDiffResponse, error) {
+	resp := &porter_app.EnvDiffResponse{}
+
+	req := &porter_app.EnvDiffRequest{
+		Base:    base,
+		Compare: compare,
+	}
+
+	err := c.getRequest(
+		fmt.Sprintf(
+			"/projects/%d/clusters/%d/apps/%s/env-diff",
+			projectID, clusterID, appName,
+		),
+		req,
+		resp,
+	)
+
+	return resp, err
+}
+
+// CreateBuildLog starts a build log for a build of an app run by the CLI, whose output is streamed with AppendBuildLog
+func (c *Client) CreateBuildLog(
+	ctx context.Context,
+	projectID, clusterID uint,
+	appName string,
+	name, imageTag string,
+) (*porter_app.BuildLog, error) {
+	resp := &porter_app.BuildLog{}
+
+	req := &porter_app.CreateBuildLogRequest{
+		Name:     name,
+		ImageTag: imageTag,
+	}
+
+	err := c.postRequest(
+		fmt.Sprintf(
+			"/projects/%d/clusters/%d/apps/%s/build-logs",
+			projectID, clusterID, appName,
+		),
+		req,
+		resp,
+	)
+
+	return resp, err
+}
+
+// AppendBuildLog appends chunks of build output to a build log. The status is set with the final chunks of the build.
+func (c *Client) AppendBuildLog(
+	ctx context.Context,
+	projectID, clusterID uint,
+	appName string,
+	buildLogID uint,
+	chunks []porter_app.BuildLogChunk,
+	status *types.EventStatus,
+) error {
+	req := &porter_app.AppendBuildLogRequest{
+		Chunks: chunks,
+		Status: status,
+	}
+
+	return c.postRequest(
+		fmt.Sprintf(
+			"/projects/%d/clusters/%d/apps/%s/build-logs/%d",
+			projectID, clusterID, appName, buildLogID,
+		),
+		req,
+		&porter_app.BuildLog{},
+	)
+}
+
+// UpdateAutoRollback sets whether failed rollouts of an app are automatically rolled back to the previous revision
+func (c *Client) UpdateAutoRollback(
+	ctx context.Context,
+	projectID, clusterID uint,
+	appName string,
+	enabled bool,
+	timeoutSeconds int,
+) (*types.PorterApp, error) {
+	resp := &types.PorterApp{}
+
+	req := &porter_app.UpdateAutoRollbackRequest{
+		Enabled:        enabled,
+		TimeoutSeconds: timeoutSeconds,
+	}
+
+	err := c.postRequest(
+		fmt.Sprintf(
+			"/projects/%d/clusters/%d/apps/%s/auto-rollback",
+			projectID, clusterID, appName,
+		),
+		req,
+		resp,
+	)
+
+	return resp, err
+}
+
+// StreamDeployEvents streams the rollout progress of an app revision, calling onEvent with each server-sent event until the
+// rollout completes, fails or times out
+func (c *Client) StreamDeployEvents(
+	ctx context.Context,
+	projectID, clusterID uint,
+	appName string,
+	appRevisionID string,
+	deploymentTargetID string,
+	onEvent func(event porter_app.DeployEventType, data []byte) error,
+) error {
+	req := &porter_app.DeployEventsRequest{
+		DeploymentTargetID: deploymentTargetID,
+	}
+
+	return c.streamRequest(
+		ctx,
+		fmt.Sprintf(
+			"/projects/%d/clusters/%d/apps/%s/deploys/%s/events",
+			projectID, clusterID, appName, appRevisionID,
+		),
+		req,
+		func(event string, data []byte) error {
+			return onEvent(porter_app.DeployEventType(event), data)
+		},
+	)
+}
+
+// CreateRemoteBuild uploads a build context, as a gzipped tar, to be built and pushed by a build runner in the cluster of an app,
+// calling onEvent with each server-sent event until the build completes or fails
+func (c *Client) CreateRemoteBuild(
+	ctx context.Context,
+	projectID, clusterID uint,
+	appName string,
+	req *porter_app.RemoteBuildRequest,
+	buildContext io.Reader,
+	onEvent func(event porter_app.RemoteBuildEventType, data []byte) error,
+) error {
+	body, writer := io.Pipe()
+	multipartWriter := multipart.NewWriter(writer)
+
+	// the multipart body is written as it is sent, so that the build context is not held in memory
+	go func() {
+		writer.CloseWithError(writeRemoteBuildBody(multipartWriter, req, buildContext)) // nolint:errcheck
+	}()
+	defer body.Close() // nolint:errcheck
+
+	return c.streamPostRequest(
+		ctx,
+		fmt.Sprintf(
+			"/projects/%d/clusters/%d/apps/%s/remote-builds",
+			projectID, clusterID, appName,
+		),
+		multipartWriter.FormDataContentType(),
+		body,
+		func(event string, data []byte) error {
+			return onEvent(porter_app.RemoteBuildEventType(event), data)
+		},
+	)
+}
+
+func writeRemoteBuildBody(multipartWriter *multipart.Writer, req *porter_app.RemoteBuildRequest, buildContext io.Reader) error {
+	buildPart, err := multipartWriter.CreateFormField("build")
+	if err != nil {
+		return err
+	}
+	if err := json.NewEncoder(buildPart).Encode(req); err != nil {
+		return err
+	}
+
+	contextPart, err := multipartWriter.CreateFormFile("context", "context.tar.gz")
+	if err != nil {
+		return err
+	}
+	if _, err := io.Copy(contextPart, buildContext); err != nil {
+		return err
+	}
+
+	return multipartWriter.Close()
+}
+
+// ListAppCertificates lists the TLS certificates of the domains of an app
+func (c *Client) ListAppCertificates(
+	ctx context.Context,
+	projectID, clusterID uint,
+	appName string,
+) (*types.ListAppCertificatesResponse, error) {
+	resp := &types.ListAppCertificatesResponse{}
+
+	err := c.getRequest(
+		fmt.Sprintf(
+			"/projects/%d/clusters/%d/apps/%s/certificates",
+			projectID, clusterID, appName,
+		),
+		nil,
+		resp,
+	)
+
+	return resp, err
+}
+
+// UploadCustomCertificate sets a custom TLS certificate for a domain of an app
+func (c *Client) UploadCustomCertificate(
+	ctx context.Context,
+	projectID, clusterID uint,
+	appName, domain string,
+	req *types.UploadCustomCertificateRequest,
+) (*types.UploadCustomCertificateResponse, error) {
+	resp := &types.UploadCustomCertificateResponse{}
+
+	err := c.postRequest(
+		fmt.Sprintf(
+			"/projects/%d/clusters/%d/apps/%s/certificates/%s",
+			projectID, clusterID, appName, domain,
+		),
+		req,
+		resp,
+	)
+
+	return resp, err
+}
+
+// DeleteCustomCertificate deletes the custom TLS certificate of a domain of an app
+func (c *Client) DeleteCustomCertificate(
+	ctx context.Context,
+	projectID, clusterID uint,
+	appName, domain string,
+) error {
+	return c.deleteRequest(
+		fmt.Sprintf(
+			"/projects/%d/clusters/%d/apps/%s/certificates/%s",
+			projectID, clusterID, appName, domain,
+		),
+		nil,
+		nil,
+	)
+}
+
+// ListCustomDomains lists the custom domains of an app and the status of their DNS records
+func (c *Client) ListCustomDomains(
+	ctx context.Context,
+	projectID, clusterID uint,
+	appName string,
+) (*types.ListCustomDomainsResponse, error) {
+	resp := &types.ListCustomDomainsResponse{}
+
+	err := c.getRequest(
+		fmt.Sprintf(
+			"/projects/%d/clusters/%d/apps/%s/domains",
+			projectID, clusterID, appName,
+		),
+		nil,
+		resp,
+	)
+
+	return resp, err
+}
+
+// VerifyCustomDomain checks the DNS record of a custom domain of an app
+func (c *Client) VerifyCustomDomain(
+	ctx context.Context,
+	projectID, clusterID uint,
+	appName, domain string,
+) (*types.CustomDomain, error) {
+	resp := &types.CustomDomain{}
+
+	err := c.postRequest(
+		fmt.Sprintf(
+			"/projects/%d/clusters/%d/apps/%s/domains/%s/verify",
+			projectID, clusterID, appName, domain,
+		),
+		nil,
+		resp,
+	)
+
+	return resp, err
+}
+
+// ConfigureDomainDNS lets Porter manage the DNS record of a custom domain of an app
+func (c *Client) ConfigureDomainDNS(
+	ctx context.Context,
+	projectID, clusterID uint,
+	appName, domain string,
+	req *types.ConfigureDomainDNSRequest,
+) (*types.CustomDomain, error) {
+	resp := &types.CustomDomain{}
+
+	err := c.postRequest(
+		fmt.Sprintf(
+			"/projects/%d/clusters/%d/apps/%s/domains/%s/dns",
+			projectID, clusterID, appName, domain,
+		),
+		req,
+		resp,
+	)
+
+	return resp, err
+}
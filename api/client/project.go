@@ -46,6 +46,65 @@ func (c *Client) GetProjectCluster(
 	return resp, err
 }
 
+// GetClusterEgressIPs retrieves the public IPs that traffic from an AWS cluster to the internet leaves from
+func (c *Client) GetClusterEgressIPs(
+	ctx context.Context,
+	projectID uint,
+	clusterID uint,
+) (*types.GetEgressIPsResponse, error) {
+	resp := &types.GetEgressIPsResponse{}
+
+	err := c.getRequest(
+		fmt.Sprintf(
+			"/projects/%d/clusters/%d/integrations/aws/egress_ips",
+			projectID, clusterID,
+		),
+		nil,
+		resp,
+	)
+
+	return resp, err
+}
+
+// GetProjectResourcePresets retrieves the resources given to services which do not set them in porter.yaml
+func (c *Client) GetProjectResourcePresets(
+	ctx context.Context,
+	projectID uint,
+) (*types.ProjectResourcePresets, error) {
+	resp := &types.ProjectResourcePresets{}
+
+	err := c.getRequest(
+		fmt.Sprintf(
+			"/projects/%d/resource-presets",
+			projectID,
+		),
+		nil,
+		resp,
+	)
+
+	return resp, err
+}
+
+// UpdateProjectResourcePresets replaces the resources given to services which do not set them in porter.yaml
+func (c *Client) UpdateProjectResourcePresets(
+	ctx context.Context,
+	projectID uint,
+	req *types.UpdateProjectResourcePresetsRequest,
+) (*types.ProjectResourcePresets, error) {
+	resp := &types.ProjectResourcePresets{}
+
+	err := c.postRequest(
+		fmt.Sprintf(
+			"/projects/%d/resource-presets",
+			projectID,
+		),
+		req,
+		resp,
+	)
+
+	return resp, err
+}
+
 // ListProjectClusters creates a list of clusters for a given project
 func (c *Client) ListProjectClusters(
 	ctx context.Context,
@@ -217,6 +217,27 @@ func (c *Client) GetACRAuthorizationToken(
 	return resp, err
 }
 
+// GetBasicRegistryAuthorizationToken gets the credentials of a registry which authenticates with a username and token,
+// such as ghcr.io or quay.io
+func (c *Client) GetBasicRegistryAuthorizationToken(
+	ctx context.Context,
+	projectID uint,
+	req *types.GetRegistryBasicTokenRequest,
+) (*types.GetRegistryTokenResponse, error) {
+	resp := &types.GetRegistryTokenResponse{}
+
+	err := c.getRequest(
+		fmt.Sprintf(
+			"/projects/%d/registries/basic/token",
+			projectID,
+		),
+		req,
+		resp,
+	)
+
+	return resp, err
+}
+
 // GetDockerhubAuthorizationToken gets a Docker Hub authorization token
 func (c *Client) GetDockerhubAuthorizationToken(
 	ctx context.Context,
@@ -315,3 +336,62 @@ func (c *Client) CreateRepository(
 		nil,
 	)
 }
+
+// GetRegistryGCPolicy returns the registry garbage collection policy of a project
+func (c *Client) GetRegistryGCPolicy(
+	ctx context.Context,
+	projectID uint,
+) (*types.RegistryGCPolicy, error) {
+	resp := &types.RegistryGCPolicy{}
+
+	err := c.getRequest(
+		fmt.Sprintf(
+			"/projects/%d/registries/gc-policy",
+			projectID,
+		),
+		nil,
+		resp,
+	)
+
+	return resp, err
+}
+
+// UpdateRegistryGCPolicy creates or updates the registry garbage collection policy of a project
+func (c *Client) UpdateRegistryGCPolicy(
+	ctx context.Context,
+	projectID uint,
+	req *types.UpdateRegistryGCPolicyRequest,
+) (*types.RegistryGCPolicy, error) {
+	resp := &types.RegistryGCPolicy{}
+
+	err := c.postRequest(
+		fmt.Sprintf(
+			"/projects/%d/registries/gc-policy",
+			projectID,
+		),
+		req,
+		resp,
+	)
+
+	return resp, err
+}
+
+// RunRegistryGC deletes the stale image tags from the registries of a project, according to the project's registry garbage collection policy
+func (c *Client) RunRegistryGC(
+	ctx context.Context,
+	projectID uint,
+	req *types.RunRegistryGCRequest,
+) (*types.RunRegistryGCResponse, error) {
+	resp := &types.RunRegistryGCResponse{}
+
+	err := c.postRequest(
+		fmt.Sprintf(
+			"/projects/%d/registries/gc",
+			projectID,
+		),
+		req,
+		resp,
+	)
+
+	return resp, err
+}
@@ -28,3 +28,65 @@ func (c *Client) ListReleases(
 
 	return resp, err
 }
+
+// ListHelmReleases lists the latest revision of each Helm release in a cluster, including
+// releases which are not managed by Porter
+func (c *Client) ListHelmReleases(
+	ctx context.Context,
+	projectID, clusterID uint,
+	req *types.ListHelmReleasesRequest,
+) (*types.ListHelmReleasesResponse, error) {
+	resp := &types.ListHelmReleasesResponse{}
+
+	err := c.getRequest(
+		fmt.Sprintf(
+			"/projects/%d/clusters/%d/helm_releases",
+			projectID, clusterID,
+		),
+		req,
+		resp,
+	)
+
+	return resp, err
+}
+
+// GetHelmRelease gets a revision of a Helm release in a cluster with its values
+func (c *Client) GetHelmRelease(
+	ctx context.Context,
+	projectID, clusterID uint,
+	namespace, name string,
+	req *types.GetHelmReleaseRequest,
+) (*types.GetHelmReleaseResponse, error) {
+	resp := &types.GetHelmReleaseResponse{}
+
+	err := c.getRequest(
+		fmt.Sprintf(
+			"/projects/%d/clusters/%d/helm_releases/%s/%s",
+			projectID, clusterID, namespace, name,
+		),
+		req,
+		resp,
+	)
+
+	return resp, err
+}
+
+// GetHelmReleaseHistory lists the revisions of a Helm release in a cluster, newest first
+func (c *Client) GetHelmReleaseHistory(
+	ctx context.Context,
+	projectID, clusterID uint,
+	namespace, name string,
+) (*types.GetHelmReleaseHistoryResponse, error) {
+	resp := &types.GetHelmReleaseHistoryResponse{}
+
+	err := c.getRequest(
+		fmt.Sprintf(
+			"/projects/%d/clusters/%d/helm_releases/%s/%s/history",
+			projectID, clusterID, namespace, name,
+		),
+		nil,
+		resp,
+	)
+
+	return resp, err
+}
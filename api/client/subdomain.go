@@ -0,0 +1,98 @@
+package client
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/porter-dev/porter/api/types"
+)
+
+// SetWildcardDomain sets the wildcard domain of a project, from which apps are allocated subdomains
+func (c *Client) SetWildcardDomain(
+	ctx context.Context,
+	projectID uint,
+	req *types.SetWildcardDomainRequest,
+) (*types.WildcardDomain, error) {
+	resp := &types.WildcardDomain{}
+
+	err := c.postRequest(
+		fmt.Sprintf(
+			"/projects/%d/wildcard-domain",
+			projectID,
+		),
+		req,
+		resp,
+	)
+
+	return resp, err
+}
+
+// DeleteWildcardDomain deletes the wildcard domain of a project and releases its subdomains
+func (c *Client) DeleteWildcardDomain(
+	ctx context.Context,
+	projectID uint,
+) error {
+	return c.deleteRequest(
+		fmt.Sprintf(
+			"/projects/%d/wildcard-domain",
+			projectID,
+		),
+		nil,
+		nil,
+	)
+}
+
+// ListSubdomainAllocations lists the subdomains allocated from the wildcard domain of a project
+func (c *Client) ListSubdomainAllocations(
+	ctx context.Context,
+	projectID uint,
+) (*types.ListSubdomainAllocationsResponse, error) {
+	resp := &types.ListSubdomainAllocationsResponse{}
+
+	err := c.getRequest(
+		fmt.Sprintf(
+			"/projects/%d/subdomains",
+			projectID,
+		),
+		nil,
+		resp,
+	)
+
+	return resp, err
+}
+
+// ReserveSubdomain reserves a subdomain of the wildcard domain of a project
+func (c *Client) ReserveSubdomain(
+	ctx context.Context,
+	projectID uint,
+	req *types.ReserveSubdomainRequest,
+) (*types.SubdomainAllocation, error) {
+	resp := &types.SubdomainAllocation{}
+
+	err := c.postRequest(
+		fmt.Sprintf(
+			"/projects/%d/subdomains",
+			projectID,
+		),
+		req,
+		resp,
+	)
+
+	return resp, err
+}
+
+// ReleaseSubdomain releases a subdomain of the wildcard domain of a project
+func (c *Client) ReleaseSubdomain(
+	ctx context.Context,
+	projectID uint,
+	name string,
+) error {
+	return c.deleteRequest(
+		fmt.Sprintf(
+			"/projects/%d/subdomains/%s",
+			projectID, name,
+		),
+		nil,
+		nil,
+	)
+}
@@ -0,0 +1,89 @@
+package rpc
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"connectrpc.com/connect"
+)
+
+// Client is a client of the Connect API
+type Client struct {
+	listProjects *connect.Client[ListProjectsRequest, ListProjectsResponse]
+	getProject   *connect.Client[GetProjectRequest, GetProjectResponse]
+	listClusters *connect.Client[ListClustersRequest, ListClustersResponse]
+	listApps     *connect.Client[ListAppsRequest, ListAppsResponse]
+	validateApp  *connect.Client[ValidateAppRequest, ValidateAppResponse]
+	applyApp     *connect.Client[ApplyAppRequest, ApplyAppResponse]
+}
+
+// NewClient returns a client of the Connect API served at baseURL, e.g. https://dashboard.porter.run/api/rpc, which
+// authenticates with a bearer token
+func NewClient(httpClient connect.HTTPClient, baseURL string, token string, opts ...connect.ClientOption) *Client {
+	baseURL = strings.TrimSuffix(baseURL, "/")
+
+	opts = append([]connect.ClientOption{
+		connect.WithCodec(Codec{}),
+		connect.WithInterceptors(bearerTokenInterceptor(token)),
+	}, opts...)
+
+	return &Client{
+		listProjects: connect.NewClient[ListProjectsRequest, ListProjectsResponse](httpClient, baseURL+ProjectServiceListProjectsProcedure, opts...),
+		getProject:   connect.NewClient[GetProjectRequest, GetProjectResponse](httpClient, baseURL+ProjectServiceGetProjectProcedure, opts...),
+		listClusters: connect.NewClient[ListClustersRequest, ListClustersResponse](httpClient, baseURL+ClusterServiceListClustersProcedure, opts...),
+		listApps:     connect.NewClient[ListAppsRequest, ListAppsResponse](httpClient, baseURL+AppServiceListAppsProcedure, opts...),
+		validateApp:  connect.NewClient[ValidateAppRequest, ValidateAppResponse](httpClient, baseURL+AppServiceValidateAppProcedure, opts...),
+		applyApp:     connect.NewClient[ApplyAppRequest, ApplyAppResponse](httpClient, baseURL+AppServiceApplyAppProcedure, opts...),
+	}
+}
+
+// ListProjects lists the projects of the authenticated user
+func (c *Client) ListProjects(ctx context.Context, req *ListProjectsRequest) (*ListProjectsResponse, error) {
+	return message(c.listProjects.CallUnary(ctx, connect.NewRequest(req)))
+}
+
+// GetProject reads a project
+func (c *Client) GetProject(ctx context.Context, req *GetProjectRequest) (*GetProjectResponse, error) {
+	return message(c.getProject.CallUnary(ctx, connect.NewRequest(req)))
+}
+
+// ListClusters lists the clusters of a project
+func (c *Client) ListClusters(ctx context.Context, req *ListClustersRequest) (*ListClustersResponse, error) {
+	return message(c.listClusters.CallUnary(ctx, connect.NewRequest(req)))
+}
+
+// ListApps lists the apps of a cluster
+func (c *Client) ListApps(ctx context.Context, req *ListAppsRequest) (*ListAppsResponse, error) {
+	return message(c.listApps.CallUnary(ctx, connect.NewRequest(req)))
+}
+
+// ValidateApp validates an app definition
+func (c *Client) ValidateApp(ctx context.Context, req *ValidateAppRequest) (*ValidateAppResponse, error) {
+	return message(c.validateApp.CallUnary(ctx, connect.NewRequest(req)))
+}
+
+// ApplyApp applies an app definition or revision
+func (c *Client) ApplyApp(ctx context.Context, req *ApplyAppRequest) (*ApplyAppResponse, error) {
+	return message(c.applyApp.CallUnary(ctx, connect.NewRequest(req)))
+}
+
+func message[T any](res *connect.Response[T], err error) (*T, error) {
+	if err != nil {
+		return nil, err
+	}
+
+	return res.Msg, nil
+}
+
+func bearerTokenInterceptor(token string) connect.UnaryInterceptorFunc {
+	return func(next connect.UnaryFunc) connect.UnaryFunc {
+		return func(ctx context.Context, req connect.AnyRequest) (connect.AnyResponse, error) {
+			if token != "" {
+				req.Header().Set("Authorization", fmt.Sprintf("Bearer %s", token))
+			}
+
+			return next(ctx, req)
+		}
+	}
+}
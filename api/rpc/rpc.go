@@ -0,0 +1,134 @@
+// Package rpc defines the Connect API of the Porter server, which exposes the core project, cluster and app operations of the
+// REST API to RPC clients. Messages are the API types of the REST API, and are encoded as JSON.
+package rpc
+
+import (
+	"encoding/json"
+
+	porterv1 "github.com/porter-dev/api-contracts/generated/go/porter/v1"
+
+	"github.com/porter-dev/porter/api/types"
+)
+
+const (
+	// ProjectServiceName is the name of the service for project operations
+	ProjectServiceName = "porter.server.v1.ProjectService"
+	// ClusterServiceName is the name of the service for cluster operations
+	ClusterServiceName = "porter.server.v1.ClusterService"
+	// AppServiceName is the name of the service for app operations
+	AppServiceName = "porter.server.v1.AppService"
+)
+
+const (
+	// ProjectServiceListProjectsProcedure lists the projects of the authenticated user
+	ProjectServiceListProjectsProcedure = "/" + ProjectServiceName + "/ListProjects"
+	// ProjectServiceGetProjectProcedure reads a project
+	ProjectServiceGetProjectProcedure = "/" + ProjectServiceName + "/GetProject"
+	// ClusterServiceListClustersProcedure lists the clusters of a project
+	ClusterServiceListClustersProcedure = "/" + ClusterServiceName + "/ListClusters"
+	// AppServiceListAppsProcedure lists the apps of a cluster
+	AppServiceListAppsProcedure = "/" + AppServiceName + "/ListApps"
+	// AppServiceValidateAppProcedure validates an app definition
+	AppServiceValidateAppProcedure = "/" + AppServiceName + "/ValidateApp"
+	// AppServiceApplyAppProcedure applies an app definition or revision
+	AppServiceApplyAppProcedure = "/" + AppServiceName + "/ApplyApp"
+)
+
+// Codec encodes the messages of the Connect API as JSON. It replaces the default JSON codec of connect, which only supports
+// protobuf messages.
+type Codec struct{}
+
+// Name returns the name of the codec, which sets the content type of requests to application/json
+func (Codec) Name() string {
+	return "json"
+}
+
+// Marshal encodes a message as JSON
+func (Codec) Marshal(msg any) ([]byte, error) {
+	return json.Marshal(msg)
+}
+
+// Unmarshal decodes a JSON message. Empty requests are decoded as empty messages.
+func (Codec) Unmarshal(data []byte, msg any) error {
+	if len(data) == 0 {
+		return nil
+	}
+
+	return json.Unmarshal(data, msg)
+}
+
+// ListProjectsRequest is the request of ProjectService.ListProjects
+type ListProjectsRequest struct{}
+
+// ListProjectsResponse is the response of ProjectService.ListProjects
+type ListProjectsResponse struct {
+	Projects types.ListUserProjectsResponse `json:"projects"`
+}
+
+// GetProjectRequest is the request of ProjectService.GetProject
+type GetProjectRequest struct {
+	ProjectID uint `json:"project_id"`
+}
+
+// GetProjectResponse is the response of ProjectService.GetProject
+type GetProjectResponse struct {
+	Project *types.Project `json:"project"`
+}
+
+// ListClustersRequest is the request of ClusterService.ListClusters
+type ListClustersRequest struct {
+	ProjectID uint `json:"project_id"`
+}
+
+// ListClustersResponse is the response of ClusterService.ListClusters
+type ListClustersResponse struct {
+	Clusters types.ListClusterResponse `json:"clusters"`
+}
+
+// ListAppsRequest is the request of AppService.ListApps
+type ListAppsRequest struct {
+	ProjectID uint `json:"project_id"`
+	ClusterID uint `json:"cluster_id"`
+}
+
+// ListAppsResponse is the response of AppService.ListApps
+type ListAppsResponse struct {
+	Apps types.ListPorterAppResponse `json:"apps"`
+}
+
+// ValidateAppRequest is the request of AppService.ValidateApp
+type ValidateAppRequest struct {
+	ProjectID          uint   `json:"project_id"`
+	ClusterID          uint   `json:"cluster_id"`
+	Base64AppProto     string `json:"b64_app_proto"`
+	DeploymentTargetID string `json:"deployment_target_id"`
+	CommitSHA          string `json:"commit_sha"`
+}
+
+// ValidateAppResponse is the response of AppService.ValidateApp
+type ValidateAppResponse struct {
+	ValidatedBase64AppProto string `json:"validate_b64_app_proto"`
+}
+
+// ApplyAppRequest is the request of AppService.ApplyApp. Either Base64AppProto or AppRevisionID must be set.
+type ApplyAppRequest struct {
+	ProjectID          uint   `json:"project_id"`
+	ClusterID          uint   `json:"cluster_id"`
+	Base64AppProto     string `json:"b64_app_proto"`
+	DeploymentTargetID string `json:"deployment_target_id"`
+	AppRevisionID      string `json:"app_revision_id"`
+	AppName            string `json:"app_name"`
+	CommitSHA          string `json:"commit_sha"`
+	GitBranch          string `json:"git_branch"`
+	GitRepoURL         string `json:"git_repo_url"`
+	// IfMatch is the ETag of the configuration of the app the request was made from, which is required to apply an existing app
+	IfMatch string `json:"if_match"`
+}
+
+// ApplyAppResponse is the response of AppService.ApplyApp
+type ApplyAppResponse struct {
+	AppRevisionID string                 `json:"app_revision_id"`
+	CLIAction     porterv1.EnumCLIAction `json:"cli_action"`
+	// ConfigRevision is the config revision of the app after the apply
+	ConfigRevision uint `json:"config_revision"`
+}
@@ -2,6 +2,7 @@ package authn
 
 import (
 	"context"
+	"crypto/subtle"
 	"fmt"
 	"net/http"
 	"strings"
@@ -118,7 +119,7 @@ func (authn *AuthN) handleForbiddenForSession(
 			pathSegments := strings.Split(r.URL.Path, "/")
 			inviteToken := pathSegments[len(pathSegments)-1]
 
-			invite, err := authn.config.Repo.Invite().ReadInviteByToken(inviteToken)
+			invite, err := authn.config.Repo.Invite().ReadInviteByToken(r.Context(), inviteToken)
 			if err != nil || invite.ProjectID == 0 || invite.Email == "" {
 				apierrors.HandleAPIError(authn.config.Logger, authn.config.Alerter, w, r,
 					apierrors.NewErrPassThroughToClient(fmt.Errorf("invalid invite token"), http.StatusBadRequest), true)
@@ -142,9 +143,14 @@ func (authn *AuthN) handleForbiddenForSession(
 }
 
 func (authn *AuthN) verifyTokenWithNext(w http.ResponseWriter, r *http.Request, tok *token.Token) {
+	if tok.SubKind == token.ServiceAccount {
+		authn.nextWithServiceAccount(w, r, tok)
+		return
+	}
+
 	// if the token has a stored token id we check that the token is valid in the database
 	if tok.TokenID != "" {
-		apiToken, err := authn.config.Repo.APIToken().ReadAPIToken(tok.ProjectID, tok.TokenID)
+		apiToken, err := authn.config.Repo.APIToken().ReadAPIToken(r.Context(), tok.ProjectID, tok.TokenID)
 		if err != nil {
 			authn.sendForbiddenError(fmt.Errorf("token with id %s not valid", tok.TokenID), w, r)
 			return
@@ -179,16 +185,55 @@ func (authn *AuthN) nextWithAPIToken(w http.ResponseWriter, r *http.Request, tok
 	authn.next.ServeHTTP(w, r)
 }
 
+// nextWithServiceAccount checks that a service account token is the current token of a service account which has not
+// expired, and calls the next handler with the user of the service account
+func (authn *AuthN) nextWithServiceAccount(w http.ResponseWriter, r *http.Request, tok *token.Token) {
+	sa, err := authn.config.Repo.ServiceAccount().ReadServiceAccountByUserID(r.Context(), tok.IBy)
+	if err != nil || sa.ProjectID != tok.ProjectID {
+		authn.sendForbiddenError(fmt.Errorf("service account token for user %d not valid", tok.IBy), w, r)
+		return
+	}
+
+	if sa.IsExpired() {
+		authn.sendForbiddenError(fmt.Errorf("service account %d has expired", sa.ID), w, r)
+		return
+	}
+
+	if sa.TokenHash == "" || subtle.ConstantTimeCompare([]byte(token.HashSecret(tok.Secret)), []byte(sa.TokenHash)) != 1 {
+		authn.sendForbiddenError(fmt.Errorf("service account token for service account %d has been rotated", sa.ID), w, r)
+		return
+	}
+
+	user, err := authn.config.Repo.User().ReadUser(r.Context(), sa.UserID)
+	if err != nil {
+		authn.sendForbiddenError(fmt.Errorf("user with id %d not found in database", sa.UserID), w, r)
+		return
+	}
+
+	authn.nextWithUser(w, r, user)
+}
+
 // nextWithUserID calls the next handler with the user set in the context with key
 // `types.UserScope`.
 func (authn *AuthN) nextWithUserID(w http.ResponseWriter, r *http.Request, userID uint) {
 	// search for the user
-	user, err := authn.config.Repo.User().ReadUser(userID)
+	user, err := authn.config.Repo.User().ReadUser(r.Context(), userID)
 	if err != nil {
 		authn.sendForbiddenError(fmt.Errorf("user with id %d not found in database", userID), w, r)
 		return
 	}
 
+	// service accounts can only authenticate with their own tokens, which stop working once they expire or are rotated
+	if user.ServiceAccount {
+		authn.sendForbiddenError(fmt.Errorf("user with id %d is a service account", userID), w, r)
+		return
+	}
+
+	authn.nextWithUser(w, r, user)
+}
+
+// nextWithUser calls the next handler with the user set in the context with key `types.UserScope`
+func (authn *AuthN) nextWithUser(w http.ResponseWriter, r *http.Request, user *models.User) {
 	// add the user to the context
 	ctx := r.Context()
 	ctx = context.WithValue(ctx, types.UserScope, user)
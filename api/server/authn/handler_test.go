@@ -1,15 +1,18 @@
 package authn_test
 
 import (
+	"context"
 	"fmt"
 	"net/http"
 	"net/http/httptest"
 	"testing"
+	"time"
 
 	"github.com/porter-dev/porter/api/server/authn"
 	"github.com/porter-dev/porter/api/server/shared/apitest"
 	"github.com/porter-dev/porter/api/server/shared/config"
 	"github.com/porter-dev/porter/api/types"
+	"github.com/porter-dev/porter/internal/auth/token"
 	"github.com/porter-dev/porter/internal/models"
 	"github.com/stretchr/testify/assert"
 	"gorm.io/gorm"
@@ -113,6 +116,86 @@ func TestUnauthenticatedUserWithToken(t *testing.T) {
 	assertForbiddenError(t, next, rr)
 }
 
+func TestServiceAccountWithToken(t *testing.T) {
+	config, handler, next := loadHandlers(t)
+
+	user, err := config.Repo.User().CreateUser(context.Background(), &models.User{
+		Email:          "sa-1@project-1.service-account.invalid",
+		EmailVerified:  true,
+		ServiceAccount: true,
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	sa, err := config.Repo.ServiceAccount().CreateServiceAccount(context.Background(), &models.ServiceAccount{
+		ProjectID: 1,
+		UserID:    user.ID,
+		Name:      "ci",
+		TokenHash: token.HashSecret("current-secret"),
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	serveWithToken := func(tok *token.Token) *httptest.ResponseRecorder {
+		next.WasCalled = false
+		next.User = nil
+
+		tokenStr, err := tok.EncodeToken(config.TokenConf)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		req, err := http.NewRequest("GET", "/auth-endpoint", nil)
+		if err != nil {
+			t.Fatal(err)
+		}
+		req.Header.Add("Authorization", fmt.Sprintf("Bearer %s", tokenStr))
+
+		rr := httptest.NewRecorder()
+		handler.ServeHTTP(rr, req)
+
+		return rr
+	}
+
+	current, err := token.GetTokenForServiceAccount(user.ID, 1, "current-secret")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	rr := serveWithToken(current)
+	assertNextHandlerCalled(t, next, rr, user)
+
+	// a token whose secret was rotated is rejected
+	rotated, err := token.GetTokenForServiceAccount(user.ID, 1, "previous-secret")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	rr = serveWithToken(rotated)
+	assertForbiddenError(t, next, rr)
+
+	// service accounts cannot authenticate with user tokens, which never expire
+	userToken, err := token.GetTokenForUser(user.ID)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	rr = serveWithToken(userToken)
+	assertForbiddenError(t, next, rr)
+
+	// expired service accounts are rejected
+	expiresAt := time.Now().Add(-time.Minute)
+	sa.ExpiresAt = &expiresAt
+	if _, err := config.Repo.ServiceAccount().UpdateServiceAccount(context.Background(), sa); err != nil {
+		t.Fatal(err)
+	}
+
+	rr = serveWithToken(current)
+	assertForbiddenError(t, next, rr)
+}
+
 func TestAuthBadDatabaseRead(t *testing.T) {
 	config, handler, next := loadHandlers(t)
 
@@ -0,0 +1,87 @@
+package authn
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"time"
+
+	"gorm.io/gorm"
+
+	"github.com/porter-dev/porter/api/server/shared/config"
+	"github.com/porter-dev/porter/internal/auth/totp"
+	"github.com/porter-dev/porter/internal/models"
+	"github.com/porter-dev/porter/internal/repository"
+)
+
+const (
+	// MFAElevationDuration is how long a session stays elevated after the user verifies a multi-factor authentication code
+	MFAElevationDuration = 15 * time.Minute
+
+	sessionKeyMFAElevatedAt = "mfa_elevated_at"
+)
+
+// VerifyMFACode checks a TOTP code or recovery code of the user. Codes can only be used once: accepted TOTP codes and
+// any codes generated before them are rejected afterwards, and accepted recovery codes are removed.
+func VerifyMFACode(ctx context.Context, repo repository.Repository, user *models.User, code string) (bool, error) {
+	mfa, err := repo.UserMFA().ReadUserMFA(ctx, user.ID)
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return false, nil
+		}
+		return false, fmt.Errorf("error reading mfa enrollment: %w", err)
+	}
+
+	step, ok, err := totp.Validate(string(mfa.TOTPSecret), code, time.Now())
+	if err != nil {
+		return false, fmt.Errorf("error validating totp code: %w", err)
+	}
+
+	if ok {
+		if step <= mfa.LastUsedStep {
+			return false, nil
+		}
+		mfa.LastUsedStep = step
+	} else if !mfa.UseRecoveryCode(totp.HashRecoveryCode(code)) {
+		return false, nil
+	}
+
+	if _, err := repo.UserMFA().UpdateUserMFA(ctx, mfa); err != nil {
+		return false, fmt.Errorf("error updating mfa enrollment: %w", err)
+	}
+
+	return true, nil
+}
+
+// SaveUserMFAElevated marks the session of the request as elevated, and returns when the elevation expires
+func SaveUserMFAElevated(
+	w http.ResponseWriter,
+	r *http.Request,
+	config *config.Config,
+) (time.Time, error) {
+	session, err := config.Store.Get(r, config.ServerConf.CookieName)
+	if err != nil {
+		return time.Time{}, err
+	}
+
+	now := time.Now()
+	session.Values[sessionKeyMFAElevatedAt] = now.Unix()
+
+	return now.Add(MFAElevationDuration), session.Save(r, w)
+}
+
+// IsSessionMFAElevated returns true if the user of the session verified a multi-factor authentication code recently
+func IsSessionMFAElevated(r *http.Request, config *config.Config) bool {
+	session, err := config.Store.Get(r, config.ServerConf.CookieName)
+	if err != nil {
+		return false
+	}
+
+	elevatedAt, ok := session.Values[sessionKeyMFAElevatedAt].(int64)
+	if !ok {
+		return false
+	}
+
+	return time.Since(time.Unix(elevatedAt, 0)) < MFAElevationDuration
+}
@@ -2,6 +2,7 @@ package authn
 
 import (
 	"net/http"
+	"time"
 
 	"github.com/porter-dev/porter/api/server/shared/config"
 	"github.com/porter-dev/porter/internal/models"
@@ -12,6 +13,27 @@ func SaveUserAuthenticated(
 	r *http.Request,
 	config *config.Config,
 	user *models.User,
+) (string, error) {
+	return saveUserAuthenticated(w, r, config, user, false)
+}
+
+// SaveUserAuthenticatedWithMFA saves the user as authenticated in the session, and marks the session as elevated
+// since the user verified a multi-factor authentication code to log in
+func SaveUserAuthenticatedWithMFA(
+	w http.ResponseWriter,
+	r *http.Request,
+	config *config.Config,
+	user *models.User,
+) (string, error) {
+	return saveUserAuthenticated(w, r, config, user, true)
+}
+
+func saveUserAuthenticated(
+	w http.ResponseWriter,
+	r *http.Request,
+	config *config.Config,
+	user *models.User,
+	mfaVerified bool,
 ) (string, error) {
 	session, err := config.Store.Get(r, config.ServerConf.CookieName)
 	if err != nil {
@@ -28,6 +50,12 @@ func SaveUserAuthenticated(
 	session.Values["user_id"] = user.ID
 	session.Values["email"] = user.Email
 
+	// a new login is only elevated if the user verified a multi-factor authentication code
+	session.Values[sessionKeyMFAElevatedAt] = nil
+	if mfaVerified {
+		session.Values[sessionKeyMFAElevatedAt] = time.Now().Unix()
+	}
+
 	// we unset the redirect uri after login
 	session.Values["redirect_uri"] = ""
 
@@ -47,5 +75,6 @@ func SaveUserUnauthenticated(
 	session.Values["authenticated"] = false
 	session.Values["user_id"] = nil
 	session.Values["email"] = nil
+	session.Values[sessionKeyMFAElevatedAt] = nil
 	return session.Save(r, w)
 }
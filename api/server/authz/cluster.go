@@ -52,7 +52,7 @@ func (p *ClusterScopedMiddleware) ServeHTTP(w http.ResponseWriter, r *http.Reque
 	// get the cluster id from the URL param context
 	reqScopes, _ := r.Context().Value(types.RequestScopeCtxKey).(map[types.PermissionScope]*types.RequestAction)
 	clusterID := reqScopes[types.ClusterScope].Resource.UInt
-	cluster, err := p.config.Repo.Cluster().ReadCluster(proj.ID, clusterID)
+	cluster, err := p.config.Repo.Cluster().ReadCluster(r.Context(), proj.ID, clusterID)
 	if err != nil {
 		if err == gorm.ErrRecordNotFound {
 			apierrors.HandleAPIError(p.config.Logger, p.config.Alerter, w, r, apierrors.NewErrForbidden(
@@ -51,7 +51,7 @@ func (p *GitInstallationScopedMiddleware) ServeHTTP(w http.ResponseWriter, r *ht
 
 	telemetry.WithAttributes(span, telemetry.AttributeKV{Key: "git-installation-id", Value: gitInstallationID})
 
-	gitInstallation, err := p.config.Repo.GithubAppInstallation().ReadGithubAppInstallationByInstallationID(gitInstallationID)
+	gitInstallation, err := p.config.Repo.GithubAppInstallation().ReadGithubAppInstallationByInstallationID(ctx, gitInstallationID)
 	if err != nil && errors.Is(err, gorm.ErrRecordNotFound) {
 		err = telemetry.Error(ctx, span, err, "git installation not found")
 		apierrors.HandleAPIError(p.config.Logger, p.config.Alerter, w, r, apierrors.NewErrPassThroughToClient(err, http.StatusNotFound), true)
@@ -85,7 +85,7 @@ func (p *GitInstallationScopedMiddleware) doesUserHaveGitInstallationAccess(ctx
 	ctx, span := telemetry.NewSpan(ctx, "check-user-has-git-installation-access")
 	defer span.End()
 
-	oauthInt, err := p.config.Repo.GithubAppOAuthIntegration().ReadGithubAppOauthIntegration(githubIntegrationID)
+	oauthInt, err := p.config.Repo.GithubAppOAuthIntegration().ReadGithubAppOauthIntegration(ctx, githubIntegrationID)
 	if err != nil {
 		return telemetry.Error(ctx, span, err, "unable to read github app oauth integration")
 	}
@@ -96,7 +96,7 @@ func (p *GitInstallationScopedMiddleware) doesUserHaveGitInstallationAccess(ctx
 
 	if _, _, err = oauth.GetAccessToken(oauthInt.SharedOAuthModel,
 		&p.config.GithubAppConf.Config,
-		oauth.MakeUpdateGithubAppOauthIntegrationFunction(oauthInt, p.config.Repo)); err != nil {
+		oauth.MakeUpdateGithubAppOauthIntegrationFunction(ctx, oauthInt, p.config.Repo)); err != nil {
 		return telemetry.Error(ctx, span, err, "unable to get access token")
 	}
 
@@ -137,7 +137,7 @@ func (p *GitInstallationScopedMiddleware) doesUserHaveGitInstallationAccess(ctx
 
 	telemetry.WithAttributes(span, telemetry.AttributeKV{Key: "account-ids", Value: fmt.Sprintf("%v", accountIDs)})
 
-	installations, err := p.config.Repo.GithubAppInstallation().ReadGithubAppInstallationByAccountIDs(accountIDs)
+	installations, err := p.config.Repo.GithubAppInstallation().ReadGithubAppInstallationByAccountIDs(ctx, accountIDs)
 	if err != nil {
 		return telemetry.Error(ctx, span, err, "unable to read github app installations")
 	}
@@ -39,7 +39,7 @@ func (p *HelmRepoScopedMiddleware) ServeHTTP(w http.ResponseWriter, r *http.Requ
 	reqScopes, _ := r.Context().Value(types.RequestScopeCtxKey).(map[types.PermissionScope]*types.RequestAction)
 	helmRepoID := reqScopes[types.HelmRepoScope].Resource.UInt
 
-	helmRepo, err := p.config.Repo.HelmRepo().ReadHelmRepo(proj.ID, helmRepoID)
+	helmRepo, err := p.config.Repo.HelmRepo().ReadHelmRepo(r.Context(), proj.ID, helmRepoID)
 	if err != nil {
 		if err == gorm.ErrRecordNotFound {
 			apierrors.HandleAPIError(p.config.Logger, p.config.Alerter, w, r, apierrors.NewErrForbidden(
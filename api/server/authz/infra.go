@@ -39,7 +39,7 @@ func (p *InfraScopedMiddleware) ServeHTTP(w http.ResponseWriter, r *http.Request
 	reqScopes, _ := r.Context().Value(types.RequestScopeCtxKey).(map[types.PermissionScope]*types.RequestAction)
 	infraID := reqScopes[types.InfraScope].Resource.UInt
 
-	infra, err := p.config.Repo.Infra().ReadInfra(proj.ID, infraID)
+	infra, err := p.config.Repo.Infra().ReadInfra(r.Context(), proj.ID, infraID)
 	if err != nil {
 		if err == gorm.ErrRecordNotFound {
 			apierrors.HandleAPIError(p.config.Logger, p.config.Alerter, w, r, apierrors.NewErrForbidden(
@@ -39,7 +39,7 @@ func (p *InviteScopedMiddleware) ServeHTTP(w http.ResponseWriter, r *http.Reques
 	reqScopes, _ := r.Context().Value(types.RequestScopeCtxKey).(map[types.PermissionScope]*types.RequestAction)
 	inviteID := reqScopes[types.InviteScope].Resource.UInt
 
-	invite, err := p.config.Repo.Invite().ReadInvite(proj.ID, inviteID)
+	invite, err := p.config.Repo.Invite().ReadInvite(r.Context(), proj.ID, inviteID)
 	if err != nil {
 		if err == gorm.ErrRecordNotFound {
 			apierrors.HandleAPIError(p.config.Logger, p.config.Alerter, w, r, apierrors.NewErrForbidden(
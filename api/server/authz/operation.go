@@ -38,7 +38,7 @@ func (p *OperationScopedMiddleware) ServeHTTP(w http.ResponseWriter, r *http.Req
 	operationID := reqScopes[types.OperationScope].Resource.Name
 
 	// look for matching operation for the infra
-	operation, err := p.config.Repo.Infra().ReadOperation(infra.ID, operationID)
+	operation, err := p.config.Repo.Infra().ReadOperation(r.Context(), infra.ID, operationID)
 	if err != nil {
 		if errors.Is(err, gorm.ErrRecordNotFound) {
 			apierrors.HandleAPIError(p.config.Logger, p.config.Alerter, w, r, apierrors.NewErrForbidden(err), true)
@@ -5,6 +5,7 @@ import (
 	"fmt"
 	"net/http"
 
+	"github.com/go-chi/chi/v5"
 	"github.com/porter-dev/porter/api/server/authz/policy"
 	"github.com/porter-dev/porter/api/server/shared/apierrors"
 	"github.com/porter-dev/porter/api/server/shared/config"
@@ -47,53 +48,114 @@ func (h *PolicyHandler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
-	policyLoaderOpts := &policy.PolicyLoaderOpts{}
+	ctx, reqErr := AuthorizeRequestScopes(r.Context(), h.loader, reqScopes)
+	if reqErr != nil {
+		apierrors.HandleAPIError(h.config.Logger, h.config.Alerter, w, r, reqErr, true)
+		return
+	}
 
-	// first check if an api token exists in context
-	if r.Context().Value("api_token") != nil {
-		projID := reqScopes[types.ProjectScope].Resource.UInt
+	r = r.Clone(ctx)
+	h.next.ServeHTTP(w, r)
+}
+
+// AuthorizeRequestScopes checks that the policy of the api token or user in the context permits the actions of the
+// request scopes, which must include the project scope. It returns a context with the request scopes and the policy,
+// for handlers which check access to resources named in the request body.
+func AuthorizeRequestScopes(
+	ctx context.Context,
+	loader policy.PolicyDocumentLoader,
+	reqScopes map[types.PermissionScope]*types.RequestAction,
+) (context.Context, apierrors.RequestError) {
+	projectAction, ok := reqScopes[types.ProjectScope]
+	if !ok {
+		return nil, apierrors.NewErrForbidden(fmt.Errorf("request is not scoped to a project"))
+	}
 
-		// FIXME: find a clean way to get the project
+	policyLoaderOpts := &policy.PolicyLoaderOpts{
+		ProjectID: projectAction.Resource.UInt,
+	}
 
-		apiToken, _ := r.Context().Value("api_token").(*models.APIToken)
+	// first check if an api token exists in context
+	if apiToken, ok := ctx.Value("api_token").(*models.APIToken); ok && apiToken != nil {
 		policyLoaderOpts.ProjectToken = apiToken
-		policyLoaderOpts.ProjectID = projID
 	} else {
-		projID := reqScopes[types.ProjectScope].Resource.UInt
-		user, _ := r.Context().Value(types.UserScope).(*models.User)
+		user, ok := ctx.Value(types.UserScope).(*models.User)
+		if !ok || user == nil {
+			return nil, apierrors.NewErrForbidden(fmt.Errorf("request is not authenticated"))
+		}
 
-		policyLoaderOpts.ProjectID = projID
 		policyLoaderOpts.UserID = user.ID
 	}
 
 	// load policy documents for the user + project
-	policyDocs, reqErr := h.loader.LoadPolicyDocuments(policyLoaderOpts)
-
+	policyDocs, reqErr := loader.LoadPolicyDocuments(ctx, policyLoaderOpts)
 	if reqErr != nil {
-		apierrors.HandleAPIError(h.config.Logger, h.config.Alerter, w, r, reqErr, true)
-		return
+		return nil, reqErr
 	}
 
 	// validate that the policy permits the action
-	hasAccess := policy.HasScopeAccess(policyDocs, reqScopes)
-
-	if !hasAccess {
-		apierrors.HandleAPIError(
-			h.config.Logger,
-			h.config.Alerter,
-			w,
-			r,
-			apierrors.NewErrForbidden(fmt.Errorf("policy forbids action in project %d", policyLoaderOpts.ProjectID)),
-			true,
-		)
+	if !policy.HasScopeAccess(policyDocs, reqScopes) {
+		return nil, apierrors.NewErrForbidden(fmt.Errorf("policy forbids action in project %d", policyLoaderOpts.ProjectID))
+	}
 
-		return
+	ctx = NewRequestScopeCtx(ctx, reqScopes)
+	ctx = context.WithValue(ctx, types.PolicyDocumentsCtxKey, policyDocs)
+
+	return ctx, nil
+}
+
+// HasPorterAppAccess checks that the policy which authorized a request permits an action on an app in the
+// cluster of the request. It is used by endpoints which name the app in the request body rather than the path,
+// and returns false if the request was not authorized by a policy.
+func HasPorterAppAccess(ctx context.Context, appName string, verb types.APIVerb) bool {
+	return hasResourceAccess(ctx, types.PorterAppScope, types.NameOrUInt{Name: appName}, verb, types.ProjectScope, types.ClusterScope)
+}
+
+// HasClusterAccess checks that the policy which authorized a request permits reading a cluster of the project, so
+// that project endpoints can filter out clusters which a member is restricted from
+func HasClusterAccess(ctx context.Context, clusterID uint) bool {
+	return hasResourceAccess(ctx, types.ClusterScope, types.NameOrUInt{UInt: clusterID}, types.APIVerbGet, types.ProjectScope)
+}
+
+// HasNamespaceAccess checks that the policy which authorized a request permits an action on a namespace in the
+// cluster of the request, for cluster endpoints which list namespaces or name them in the request body
+func HasNamespaceAccess(ctx context.Context, namespace string, verb types.APIVerb) bool {
+	return hasResourceAccess(ctx, types.NamespaceScope, types.NameOrUInt{Name: namespace}, verb, types.ProjectScope, types.ClusterScope)
+}
+
+// hasResourceAccess checks an action on a resource against the policy which authorized a request, along with
+// the resources of the parent scopes of the request
+func hasResourceAccess(
+	ctx context.Context,
+	scope types.PermissionScope,
+	resource types.NameOrUInt,
+	verb types.APIVerb,
+	parentScopes ...types.PermissionScope,
+) bool {
+	policyDocs, ok := ctx.Value(types.PolicyDocumentsCtxKey).([]*types.PolicyDocument)
+	if !ok {
+		return false
 	}
 
-	// add the set of resource ids to the request context
-	ctx := NewRequestScopeCtx(r.Context(), reqScopes)
-	r = r.Clone(ctx)
-	h.next.ServeHTTP(w, r)
+	reqScopes, _ := ctx.Value(types.RequestScopeCtxKey).(map[types.PermissionScope]*types.RequestAction)
+
+	resourceScopes := map[types.PermissionScope]*types.RequestAction{
+		scope: {
+			Verb:     verb,
+			Resource: resource,
+		},
+	}
+
+	for _, parentScope := range parentScopes {
+		if action, ok := reqScopes[parentScope]; ok {
+			resourceScopes[parentScope] = &types.RequestAction{
+				Verb:     verb,
+				Resource: action.Resource,
+			}
+		}
+	}
+
+	return policy.HasScopeAccess(policyDocs, resourceScopes)
 }
 
 func NewRequestScopeCtx(ctx context.Context, reqScopes map[types.PermissionScope]*types.RequestAction) context.Context {
@@ -138,6 +200,12 @@ func getRequestActionForEndpoint(
 			resource.UInt, reqErr = requestutils.GetURLParamUint(r, types.URLParamIntegrationID)
 		case types.APIContractRevisionScope:
 			resource.Name, reqErr = requestutils.GetURLParamString(r, types.URLParamAPIContractRevisionID)
+		case types.PorterAppScope:
+			resource.Name, reqErr = requestutils.GetURLParamString(r, types.URLParamPorterAppName)
+		case types.EnvGroupScope:
+			// env groups are named in the body of some endpoints, which are only allowed by policies
+			// that do not restrict the env group resources
+			resource.Name = chi.URLParam(r, string(types.URLParamEnvGroupName))
 		}
 
 		if reqErr != nil {
@@ -150,5 +218,18 @@ func getRequestActionForEndpoint(
 		}
 	}
 
+	// cluster endpoints which read a namespace from the query, such as the log and status streams,
+	// are checked against the namespace as well
+	if _, ok := res[types.ClusterScope]; ok {
+		if _, ok := res[types.NamespaceScope]; !ok {
+			if namespace := r.URL.Query().Get(string(types.URLParamNamespace)); namespace != "" {
+				res[types.NamespaceScope] = &types.RequestAction{
+					Verb:     endpointMeta.Verb,
+					Resource: types.NameOrUInt{Name: namespace},
+				}
+			}
+		}
+	}
+
 	return res, nil
 }
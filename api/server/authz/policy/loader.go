@@ -1,6 +1,7 @@
 package policy
 
 import (
+	"context"
 	"errors"
 	"fmt"
 	"net/http"
@@ -18,7 +19,7 @@ type PolicyLoaderOpts struct {
 }
 
 type PolicyDocumentLoader interface {
-	LoadPolicyDocuments(opts *PolicyLoaderOpts) ([]*types.PolicyDocument, apierrors.RequestError)
+	LoadPolicyDocuments(ctx context.Context, opts *PolicyLoaderOpts) ([]*types.PolicyDocument, apierrors.RequestError)
 }
 
 // RepoPolicyDocumentLoader loads policy documents by reading from the repository database
@@ -32,6 +33,7 @@ func NewBasicPolicyDocumentLoader(projRepo repository.ProjectRepository, policyR
 }
 
 func (b *RepoPolicyDocumentLoader) LoadPolicyDocuments(
+	ctx context.Context,
 	opts *PolicyLoaderOpts,
 ) ([]*types.PolicyDocument, apierrors.RequestError) {
 	if opts.ProjectToken != nil {
@@ -41,7 +43,7 @@ func (b *RepoPolicyDocumentLoader) LoadPolicyDocuments(
 		}
 
 		// load the policy
-		apiPolicy, reqErr := GetAPIPolicyFromUID(b.policyRepo, opts.ProjectToken.ProjectID, opts.ProjectToken.PolicyUID)
+		apiPolicy, reqErr := GetAPIPolicyFromUID(ctx, b.policyRepo, opts.ProjectToken.ProjectID, opts.ProjectToken.PolicyUID)
 
 		if reqErr != nil {
 			return nil, reqErr
@@ -52,7 +54,7 @@ func (b *RepoPolicyDocumentLoader) LoadPolicyDocuments(
 		userID := opts.UserID
 		projectID := opts.ProjectID
 		// read role and case on role "kind"
-		role, err := b.projRepo.ReadProjectRole(projectID, userID)
+		role, err := b.projRepo.ReadProjectRole(ctx, projectID, userID)
 
 		if err != nil && err == gorm.ErrRecordNotFound {
 			return nil, apierrors.NewErrForbidden(
@@ -63,18 +65,41 @@ func (b *RepoPolicyDocumentLoader) LoadPolicyDocuments(
 		}
 
 		// load role based on role kind
+		var policyDocs []*types.PolicyDocument
+
 		switch role.Kind {
 		case types.RoleAdmin:
-			return types.AdminPolicy, nil
+			policyDocs = types.AdminPolicy
 		case types.RoleDeveloper:
-			return types.DeveloperPolicy, nil
+			policyDocs = types.DeveloperPolicy
 		case types.RoleViewer:
-			return types.ViewerPolicy, nil
+			policyDocs = types.ViewerPolicy
+		case types.RoleCustom:
+			if role.PolicyUID == "" {
+				return nil, apierrors.NewErrForbidden(
+					fmt.Errorf("custom role for user %d, project %d does not have a policy", userID, projectID),
+				)
+			}
+
+			apiPolicy, reqErr := GetAPIPolicyFromUID(ctx, b.policyRepo, projectID, role.PolicyUID)
+			if reqErr != nil {
+				return nil, reqErr
+			}
+
+			policyDocs = apiPolicy.Policy
 		default:
 			return nil, apierrors.NewErrForbidden(
 				fmt.Errorf("%s role not supported for user %d, project %d", string(role.Kind), userID, projectID),
 			)
 		}
+
+		// roles may be restricted to some clusters and namespaces of the project
+		policyDocs, err = RestrictPolicy(policyDocs, role.RestrictedClusterIDs(), role.RestrictedNamespaces())
+		if err != nil {
+			return nil, apierrors.NewErrInternal(err)
+		}
+
+		return policyDocs, nil
 	}
 
 	return nil, apierrors.NewErrForbidden(
@@ -82,7 +107,7 @@ func (b *RepoPolicyDocumentLoader) LoadPolicyDocuments(
 	)
 }
 
-func GetAPIPolicyFromUID(policyRepo repository.PolicyRepository, projectID uint, uid string) (*types.APIPolicy, apierrors.RequestError) {
+func GetAPIPolicyFromUID(ctx context.Context, policyRepo repository.PolicyRepository, projectID uint, uid string) (*types.APIPolicy, apierrors.RequestError) {
 	switch uid {
 	case "admin":
 		return &types.APIPolicy{
@@ -110,7 +135,7 @@ func GetAPIPolicyFromUID(policyRepo repository.PolicyRepository, projectID uint,
 		}, nil
 	default:
 		// look up the policy and make sure it exists
-		policyModel, err := policyRepo.ReadPolicy(projectID, uid)
+		policyModel, err := policyRepo.ReadPolicy(ctx, projectID, uid)
 		if err != nil {
 			if errors.Is(err, gorm.ErrRecordNotFound) {
 				return nil, apierrors.NewErrPassThroughToClient(
@@ -1,6 +1,8 @@
 package policy_test
 
 import (
+	"context"
+	"encoding/json"
 	"fmt"
 	"net/http"
 	"testing"
@@ -16,6 +18,7 @@ import (
 type basicLoaderTest struct {
 	description      string
 	roleKind         types.RoleKind
+	policyUID        string
 	expErr           bool
 	expErrString     string
 	expErrStatusCode int
@@ -39,11 +42,25 @@ var basicLoaderTests = []basicLoaderTest{
 		expPolicy:   types.ViewerPolicy,
 	},
 	{
-		description:      "should not load custom policy for basic loader",
+		description:      "should not load custom role without a policy",
 		roleKind:         types.RoleCustom,
 		expErr:           true,
 		expErrStatusCode: http.StatusForbidden,
-		expErrString:     "custom role not supported for user 1, project 1",
+		expErrString:     "custom role for user 1, project 1 does not have a policy",
+	},
+	{
+		description: "should load custom role policy",
+		roleKind:    types.RoleCustom,
+		policyUID:   "deploy-app",
+		expPolicy:   testPolicyDeploySingleApp,
+	},
+	{
+		description:      "should not load custom role with a policy from another project",
+		roleKind:         types.RoleCustom,
+		policyUID:        "other-project",
+		expErr:           true,
+		expErrStatusCode: http.StatusBadRequest,
+		expErrString:     "policy not found in project",
 	},
 }
 
@@ -53,7 +70,8 @@ func TestBasicPolicyDocumentLoader(t *testing.T) {
 	for _, basicTest := range basicLoaderTests {
 		// use the in-memory project repo
 		projRepo := test.NewProjectRepository(true)
-		loader := policy.NewBasicPolicyDocumentLoader(projRepo, nil)
+		policyRepo := test.NewPolicyRepository(true)
+		loader := policy.NewBasicPolicyDocumentLoader(projRepo, policyRepo)
 
 		project := &models.Project{
 			Name: "test-project",
@@ -61,17 +79,36 @@ func TestBasicPolicyDocumentLoader(t *testing.T) {
 
 		var err error
 
-		project, err = projRepo.CreateProject(project)
+		project, err = projRepo.CreateProject(context.Background(), project)
+
+		if err != nil {
+			t.Fatalf("%v", err)
+		}
 
+		policyBytes, err := json.Marshal(testPolicyDeploySingleApp)
 		if err != nil {
 			t.Fatalf("%v", err)
 		}
 
-		_, err = projRepo.CreateProjectRole(project, &models.Role{
+		for projectID, uid := range map[uint]string{1: "deploy-app", 2: "other-project"} {
+			_, err = policyRepo.CreatePolicy(context.Background(), &models.Policy{
+				ProjectID:   projectID,
+				UniqueID:    uid,
+				Name:        uid,
+				PolicyBytes: policyBytes,
+			})
+
+			if err != nil {
+				t.Fatalf("%v", err)
+			}
+		}
+
+		_, err = projRepo.CreateProjectRole(context.Background(), project, &models.Role{
 			Role: types.Role{
 				UserID:    1,
 				ProjectID: 1,
 				Kind:      basicTest.roleKind,
+				PolicyUID: basicTest.policyUID,
 			},
 		})
 
@@ -79,7 +116,7 @@ func TestBasicPolicyDocumentLoader(t *testing.T) {
 			t.Fatalf("%v", err)
 		}
 
-		docs, reqErr := loader.LoadPolicyDocuments(&policy.PolicyLoaderOpts{
+		docs, reqErr := loader.LoadPolicyDocuments(context.Background(), &policy.PolicyLoaderOpts{
 			ProjectID: 1,
 			UserID:    1,
 		})
@@ -134,13 +171,13 @@ func TestErrorForbiddenInvalidRole(t *testing.T) {
 
 	var err error
 
-	project, err = projRepo.CreateProject(project)
+	project, err = projRepo.CreateProject(context.Background(), project)
 
 	if err != nil {
 		t.Fatalf("%v", err)
 	}
 
-	_, err = projRepo.CreateProjectRole(project, &models.Role{
+	_, err = projRepo.CreateProjectRole(context.Background(), project, &models.Role{
 		Role: types.Role{
 			UserID:    1,
 			ProjectID: 1,
@@ -152,7 +189,7 @@ func TestErrorForbiddenInvalidRole(t *testing.T) {
 		t.Fatalf("%v", err)
 	}
 
-	_, reqErr := loader.LoadPolicyDocuments(&policy.PolicyLoaderOpts{
+	_, reqErr := loader.LoadPolicyDocuments(context.Background(), &policy.PolicyLoaderOpts{
 		ProjectID: 1,
 		UserID:    2,
 	})
@@ -182,7 +219,7 @@ func TestErrorCannotQuery(t *testing.T) {
 	projRepo := test.NewProjectRepository(false)
 	loader := policy.NewBasicPolicyDocumentLoader(projRepo, nil)
 
-	_, reqErr := loader.LoadPolicyDocuments(&policy.PolicyLoaderOpts{
+	_, reqErr := loader.LoadPolicyDocuments(context.Background(), &policy.PolicyLoaderOpts{
 		ProjectID: 2,
 		UserID:    1,
 	})
@@ -1,11 +1,18 @@
 package policy
 
 import (
+	"fmt"
+
 	"github.com/porter-dev/porter/api/types"
 )
 
 // HasScopeAccess checks that a user can perform an action (`verb`) against a specific
 // resource (`resource+scope`) according to a `policy`.
+//
+// The verb is only checked against the most specific scopes of the request, i.e. the requested
+// scopes without a requested child scope. Their parent scopes only restrict which resources can be
+// reached, so that a policy can grant write access to a single app in a cluster which is otherwise
+// read-only.
 func HasScopeAccess(
 	policy []*types.PolicyDocument,
 	reqScopes map[types.PermissionScope]*types.RequestAction,
@@ -27,16 +34,21 @@ func HasScopeAccess(
 		}
 
 		for matchScope, matchDoc := range matchDocs {
+			isLeaf := !hasRequestedDescendant(findScopeSubTree(types.ScopeHeirarchy, matchScope), reqScopes)
+
 			// for the matching scope, make sure it matches the allowed resources if the
-			// resource list is explicitly set
-			if len(matchDoc.Resources) > 0 && reqScopes[matchScope].Verb != types.APIVerbList {
+			// resource list is explicitly set. List requests which do not name a resource
+			// of the scope are only filtered by the resources of the parent scopes.
+			isUnnamedList := isLeaf && reqScopes[matchScope].Verb == types.APIVerbList && reqScopes[matchScope].Resource == types.NameOrUInt{}
+
+			if len(matchDoc.Resources) > 0 && !isUnnamedList {
 				if !isResourceAllowed(matchDoc, reqScopes[matchScope].Resource) {
 					isValid = false
 				}
 			}
 
-			// for the matching scope, make sure it matches the allowed verbs
-			if !isVerbAllowed(matchDoc, reqScopes[matchScope].Verb) {
+			// for the most specific scopes, make sure it matches the allowed verbs
+			if isLeaf && !isVerbAllowed(matchDoc, reqScopes[matchScope].Verb) {
 				isValid = false
 			}
 		}
@@ -49,6 +61,98 @@ func HasScopeAccess(
 	return false
 }
 
+// ValidatePolicy checks that each document of a policy matches the scope hierarchy of the API
+// server and only grants known verbs, so that invalid policies are rejected when they are saved
+// rather than silently denying every request.
+func ValidatePolicy(policy []*types.PolicyDocument) error {
+	if len(policy) == 0 {
+		return fmt.Errorf("policy must have at least one document")
+	}
+
+	for i, policyDoc := range policy {
+		if policyDoc == nil {
+			return fmt.Errorf("policy document %d is empty", i)
+		}
+
+		isValid, _ := populateAndVerifyPolicyDocument(
+			policyDoc,
+			types.ScopeHeirarchy,
+			types.ProjectScope,
+			types.ReadWriteVerbGroup(),
+			map[types.PermissionScope]*types.RequestAction{},
+			nil,
+		)
+
+		if !isValid {
+			return fmt.Errorf("policy document %d does not match the scope hierarchy, which starts at the %s scope", i, types.ProjectScope)
+		}
+
+		if err := validatePolicyDocumentVerbs(policyDoc); err != nil {
+			return fmt.Errorf("policy document %d is invalid: %w", i, err)
+		}
+	}
+
+	return nil
+}
+
+func validatePolicyDocumentVerbs(policyDoc *types.PolicyDocument) error {
+	for _, verb := range policyDoc.Verbs {
+		known := false
+
+		for _, knownVerb := range types.ReadWriteVerbGroup() {
+			if verb == knownVerb {
+				known = true
+				break
+			}
+		}
+
+		if !known {
+			return fmt.Errorf("unknown verb %s for the %s scope", verb, policyDoc.Scope)
+		}
+	}
+
+	for _, child := range policyDoc.Children {
+		if child == nil {
+			continue
+		}
+
+		if err := validatePolicyDocumentVerbs(child); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// findScopeSubTree returns the child scopes of a scope in the tree, or nil if the scope is not in the tree
+func findScopeSubTree(tree types.ScopeTree, scope types.PermissionScope) types.ScopeTree {
+	for currScope, subTree := range tree {
+		if currScope == scope {
+			return subTree
+		}
+
+		if res := findScopeSubTree(subTree, scope); res != nil {
+			return res
+		}
+	}
+
+	return nil
+}
+
+func hasRequestedDescendant(tree types.ScopeTree, reqScopes map[types.PermissionScope]*types.RequestAction) bool {
+	for currScope, subTree := range tree {
+		if _, ok := reqScopes[currScope]; ok {
+			return true
+		}
+
+		if hasRequestedDescendant(subTree, reqScopes) {
+			return true
+		}
+	}
+
+	return false
+}
+
 func isResourceAllowed(
 	matchDoc *types.PolicyDocument,
 	resource types.NameOrUInt,
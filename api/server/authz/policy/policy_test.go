@@ -138,6 +138,144 @@ var hasScopeAccessTests = []testHasScopeAccess{
 		},
 		expRes: false,
 	},
+	{
+		description: "custom policy for a single app can update the app in a read-only cluster",
+		policy:      testPolicyDeploySingleApp,
+		reqScopes: map[types.PermissionScope]*types.RequestAction{
+			types.ProjectScope: {
+				Verb: types.APIVerbUpdate,
+				Resource: types.NameOrUInt{
+					UInt: 1,
+				},
+			},
+			types.ClusterScope: {
+				Verb: types.APIVerbUpdate,
+				Resource: types.NameOrUInt{
+					UInt: 1,
+				},
+			},
+			types.PorterAppScope: {
+				Verb: types.APIVerbUpdate,
+				Resource: types.NameOrUInt{
+					Name: "web",
+				},
+			},
+		},
+		expRes: true,
+	},
+	{
+		description: "custom policy for a single app cannot update another app",
+		policy:      testPolicyDeploySingleApp,
+		reqScopes: map[types.PermissionScope]*types.RequestAction{
+			types.ProjectScope: {
+				Verb: types.APIVerbUpdate,
+				Resource: types.NameOrUInt{
+					UInt: 1,
+				},
+			},
+			types.ClusterScope: {
+				Verb: types.APIVerbUpdate,
+				Resource: types.NameOrUInt{
+					UInt: 1,
+				},
+			},
+			types.PorterAppScope: {
+				Verb: types.APIVerbUpdate,
+				Resource: types.NameOrUInt{
+					Name: "worker",
+				},
+			},
+		},
+		expRes: false,
+	},
+	{
+		description: "custom policy for a single app can read other apps",
+		policy:      testPolicyDeploySingleApp,
+		reqScopes: map[types.PermissionScope]*types.RequestAction{
+			types.ProjectScope: {
+				Verb: types.APIVerbGet,
+				Resource: types.NameOrUInt{
+					UInt: 1,
+				},
+			},
+			types.ClusterScope: {
+				Verb: types.APIVerbGet,
+				Resource: types.NameOrUInt{
+					UInt: 1,
+				},
+			},
+			types.PorterAppScope: {
+				Verb: types.APIVerbGet,
+				Resource: types.NameOrUInt{
+					Name: "worker",
+				},
+			},
+		},
+		expRes: true,
+	},
+	{
+		description: "custom policy for a single app cannot update the cluster",
+		policy:      testPolicyDeploySingleApp,
+		reqScopes: map[types.PermissionScope]*types.RequestAction{
+			types.ProjectScope: {
+				Verb: types.APIVerbUpdate,
+				Resource: types.NameOrUInt{
+					UInt: 1,
+				},
+			},
+			types.ClusterScope: {
+				Verb: types.APIVerbUpdate,
+				Resource: types.NameOrUInt{
+					UInt: 1,
+				},
+			},
+		},
+		expRes: false,
+	},
+	{
+		description: "custom policy for a single app cannot update env groups",
+		policy:      testPolicyDeploySingleApp,
+		reqScopes: map[types.PermissionScope]*types.RequestAction{
+			types.ProjectScope: {
+				Verb: types.APIVerbUpdate,
+				Resource: types.NameOrUInt{
+					UInt: 1,
+				},
+			},
+			types.ClusterScope: {
+				Verb: types.APIVerbUpdate,
+				Resource: types.NameOrUInt{
+					UInt: 1,
+				},
+			},
+			types.EnvGroupScope: {
+				Verb: types.APIVerbUpdate,
+				Resource: types.NameOrUInt{
+					Name: "secrets",
+				},
+			},
+		},
+		expRes: false,
+	},
+	{
+		description: "list requests are filtered by the resources of parent scopes",
+		policy:      testPolicyNamespaceSpecific,
+		reqScopes: map[types.PermissionScope]*types.RequestAction{
+			types.ClusterScope: {
+				Verb: types.APIVerbList,
+				Resource: types.NameOrUInt{
+					UInt: 502,
+				},
+			},
+			types.NamespaceScope: {
+				Verb: types.APIVerbList,
+				Resource: types.NameOrUInt{
+					Name: "abelanger",
+				},
+			},
+		},
+		expRes: false,
+	},
 	{
 		description: "test invalid policy document",
 		policy:      testInvalidPolicyDocument,
@@ -179,6 +317,22 @@ func TestHasScopeAccess(t *testing.T) {
 	}
 }
 
+func TestValidatePolicy(t *testing.T) {
+	assert := assert.New(t)
+
+	assert.NoError(policy.ValidatePolicy(types.AdminPolicy), "admin policy")
+	assert.NoError(policy.ValidatePolicy(testPolicyDeploySingleApp), "single app policy")
+	assert.Error(policy.ValidatePolicy(nil), "empty policy")
+	assert.Error(policy.ValidatePolicy(testInvalidPolicyDocument), "invalid policy document")
+	assert.Error(policy.ValidatePolicy(testInvalidPolicyDocumentNested), "invalid nested policy document")
+	assert.Error(policy.ValidatePolicy([]*types.PolicyDocument{
+		{
+			Scope: types.ProjectScope,
+			Verbs: []types.APIVerb{"deploy"},
+		},
+	}), "unknown verb")
+}
+
 func BenchmarkSimpleHasScopeAccess(b *testing.B) {
 	for i := 0; i < b.N; i++ {
 		res := policy.HasScopeAccess(
@@ -218,6 +372,52 @@ var testPolicySpecificClusters = []*types.PolicyDocument{
 	},
 }
 
+// testPolicyDeploySingleApp allows a user to read the clusters of the project, and to deploy
+// the app "web", without access to env groups or project settings.
+var testPolicyDeploySingleApp = []*types.PolicyDocument{
+	{
+		Scope: types.ProjectScope,
+		Verbs: types.ReadVerbGroup(),
+		Children: map[types.PermissionScope]*types.PolicyDocument{
+			types.ClusterScope: {
+				Scope: types.ClusterScope,
+				Verbs: types.ReadVerbGroup(),
+				Children: map[types.PermissionScope]*types.PolicyDocument{
+					types.EnvGroupScope: {
+						Scope: types.EnvGroupScope,
+						Verbs: []types.APIVerb{},
+					},
+				},
+			},
+		},
+	},
+	{
+		Scope: types.ProjectScope,
+		Verbs: types.ReadVerbGroup(),
+		Children: map[types.PermissionScope]*types.PolicyDocument{
+			types.ClusterScope: {
+				Scope: types.ClusterScope,
+				Verbs: types.ReadVerbGroup(),
+				Children: map[types.PermissionScope]*types.PolicyDocument{
+					types.PorterAppScope: {
+						Scope: types.PorterAppScope,
+						Verbs: types.ReadWriteVerbGroup(),
+						Resources: []types.NameOrUInt{
+							{
+								Name: "web",
+							},
+						},
+					},
+					types.EnvGroupScope: {
+						Scope: types.EnvGroupScope,
+						Verbs: []types.APIVerb{},
+					},
+				},
+			},
+		},
+	},
+}
+
 var testPolicyNamespaceSpecific = []*types.PolicyDocument{
 	// This document allows a user to view the namespace "abelanger" in the cluster
 	// with id 500.
@@ -0,0 +1,99 @@
+package policy
+
+import (
+	"encoding/json"
+
+	"github.com/porter-dev/porter/api/types"
+)
+
+// RestrictPolicy returns a copy of a policy which only applies to the given clusters and namespaces. A nil or
+// empty list leaves the corresponding scope unrestricted. Resources which are already restricted by the policy
+// are intersected with the allowed resources.
+func RestrictPolicy(policy []*types.PolicyDocument, clusterIDs []uint, namespaces []string) ([]*types.PolicyDocument, error) {
+	if len(clusterIDs) == 0 && len(namespaces) == 0 {
+		return policy, nil
+	}
+
+	// copy the policy, since the preset policies are shared across requests
+	policyBytes, err := json.Marshal(policy)
+	if err != nil {
+		return nil, err
+	}
+
+	res := make([]*types.PolicyDocument, 0)
+
+	if err := json.Unmarshal(policyBytes, &res); err != nil {
+		return nil, err
+	}
+
+	allowedClusters := make([]types.NameOrUInt, 0, len(clusterIDs))
+	for _, id := range clusterIDs {
+		allowedClusters = append(allowedClusters, types.NameOrUInt{UInt: id})
+	}
+
+	allowedNamespaces := make([]types.NameOrUInt, 0, len(namespaces))
+	for _, namespace := range namespaces {
+		allowedNamespaces = append(allowedNamespaces, types.NameOrUInt{Name: namespace})
+	}
+
+	for _, projectDoc := range res {
+		// the project scope does not pass its verbs to a missing cluster document, so there is
+		// nothing to restrict
+		clusterDoc := projectDoc.Children[types.ClusterScope]
+		if clusterDoc == nil {
+			continue
+		}
+
+		if len(allowedClusters) > 0 {
+			clusterDoc.Resources = restrictResources(clusterDoc.Resources, allowedClusters)
+		}
+
+		if len(allowedNamespaces) > 0 {
+			namespaceDoc := clusterDoc.Children[types.NamespaceScope]
+
+			// a missing namespace document inherits the verbs of the cluster document
+			if namespaceDoc == nil {
+				namespaceDoc = &types.PolicyDocument{
+					Scope: types.NamespaceScope,
+					Verbs: clusterDoc.Verbs,
+				}
+
+				if clusterDoc.Children == nil {
+					clusterDoc.Children = make(map[types.PermissionScope]*types.PolicyDocument)
+				}
+
+				clusterDoc.Children[types.NamespaceScope] = namespaceDoc
+			}
+
+			namespaceDoc.Resources = restrictResources(namespaceDoc.Resources, allowedNamespaces)
+		}
+	}
+
+	return res, nil
+}
+
+// restrictResources returns the resources of a policy document which are allowed. Since an empty resource
+// list allows all resources, an empty intersection is represented by the zero resource, which does not match
+// any cluster or namespace.
+func restrictResources(resources []types.NameOrUInt, allowed []types.NameOrUInt) []types.NameOrUInt {
+	if len(resources) == 0 {
+		return allowed
+	}
+
+	res := make([]types.NameOrUInt, 0)
+
+	for _, resource := range resources {
+		for _, allowedResource := range allowed {
+			if resource == allowedResource {
+				res = append(res, resource)
+				break
+			}
+		}
+	}
+
+	if len(res) == 0 {
+		return []types.NameOrUInt{{}}
+	}
+
+	return res
+}
@@ -0,0 +1,75 @@
+package policy_test
+
+import (
+	"testing"
+
+	"github.com/porter-dev/porter/api/server/authz/policy"
+	"github.com/porter-dev/porter/api/types"
+	"github.com/stretchr/testify/assert"
+)
+
+func clusterNamespaceScopes(verb types.APIVerb, clusterID uint, namespace string) map[types.PermissionScope]*types.RequestAction {
+	reqScopes := map[types.PermissionScope]*types.RequestAction{
+		types.ProjectScope: {
+			Verb:     verb,
+			Resource: types.NameOrUInt{UInt: 1},
+		},
+		types.ClusterScope: {
+			Verb:     verb,
+			Resource: types.NameOrUInt{UInt: clusterID},
+		},
+	}
+
+	if namespace != "" {
+		reqScopes[types.NamespaceScope] = &types.RequestAction{
+			Verb:     verb,
+			Resource: types.NameOrUInt{Name: namespace},
+		}
+	}
+
+	return reqScopes
+}
+
+func TestRestrictPolicyClusters(t *testing.T) {
+	assert := assert.New(t)
+
+	restricted, err := policy.RestrictPolicy(types.DeveloperPolicy, []uint{2}, nil)
+	if err != nil {
+		t.Fatalf("%v", err)
+	}
+
+	assert.True(policy.HasScopeAccess(restricted, clusterNamespaceScopes(types.APIVerbUpdate, 2, "")), "allowed cluster")
+	assert.True(policy.HasScopeAccess(restricted, clusterNamespaceScopes(types.APIVerbList, 2, "default")), "namespace in allowed cluster")
+	assert.False(policy.HasScopeAccess(restricted, clusterNamespaceScopes(types.APIVerbGet, 3, "")), "other cluster")
+	assert.False(policy.HasScopeAccess(restricted, clusterNamespaceScopes(types.APIVerbList, 3, "")), "list in other cluster")
+	assert.False(policy.HasScopeAccess(restricted, clusterNamespaceScopes(types.APIVerbGet, 3, "default")), "namespace in other cluster")
+
+	// the preset policy must not be modified
+	assert.Empty(types.DeveloperPolicy[0].Children[types.ClusterScope].Resources)
+}
+
+func TestRestrictPolicyNamespaces(t *testing.T) {
+	assert := assert.New(t)
+
+	restricted, err := policy.RestrictPolicy(types.AdminPolicy, nil, []string{"staging"})
+	if err != nil {
+		t.Fatalf("%v", err)
+	}
+
+	assert.True(policy.HasScopeAccess(restricted, clusterNamespaceScopes(types.APIVerbDelete, 1, "staging")), "allowed namespace")
+	assert.False(policy.HasScopeAccess(restricted, clusterNamespaceScopes(types.APIVerbGet, 1, "production")), "other namespace")
+	assert.True(policy.HasScopeAccess(restricted, clusterNamespaceScopes(types.APIVerbGet, 1, "")), "cluster without namespace")
+}
+
+func TestRestrictPolicyIntersectsResources(t *testing.T) {
+	assert := assert.New(t)
+
+	restricted, err := policy.RestrictPolicy(testPolicySpecificClusters, []uint{2}, nil)
+	if err != nil {
+		t.Fatalf("%v", err)
+	}
+
+	// the policy only allows cluster 1, so restricting it to cluster 2 allows no cluster
+	assert.False(policy.HasScopeAccess(restricted, clusterNamespaceScopes(types.APIVerbGet, 1, "")), "cluster allowed by policy")
+	assert.False(policy.HasScopeAccess(restricted, clusterNamespaceScopes(types.APIVerbGet, 2, "")), "cluster allowed by restriction")
+}
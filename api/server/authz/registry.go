@@ -39,7 +39,7 @@ func (p *RegistryScopedMiddleware) ServeHTTP(w http.ResponseWriter, r *http.Requ
 	reqScopes, _ := r.Context().Value(types.RequestScopeCtxKey).(map[types.PermissionScope]*types.RequestAction)
 	registryID := reqScopes[types.RegistryScope].Resource.UInt
 
-	registry, err := p.config.Repo.Registry().ReadRegistry(proj.ID, registryID)
+	registry, err := p.config.Repo.Registry().ReadRegistry(r.Context(), proj.ID, registryID)
 	if err != nil {
 		if err == gorm.ErrRecordNotFound {
 			apierrors.HandleAPIError(p.config.Logger, p.config.Alerter, w, r, apierrors.NewErrForbidden(
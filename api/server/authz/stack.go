@@ -39,7 +39,7 @@ func (p *StackScopedMiddleware) ServeHTTP(w http.ResponseWriter, r *http.Request
 	reqScopes, _ := r.Context().Value(types.RequestScopeCtxKey).(map[types.PermissionScope]*types.RequestAction)
 	stackID := reqScopes[types.StackScope].Resource.Name
 
-	stack, err := p.config.Repo.Stack().ReadStackByStringID(proj.ID, stackID)
+	stack, err := p.config.Repo.Stack().ReadStackByStringID(r.Context(), proj.ID, stackID)
 	if err != nil {
 		if err == gorm.ErrRecordNotFound {
 			apierrors.HandleAPIError(p.config.Logger, p.config.Alerter, w, r, apierrors.NewErrForbidden(
@@ -0,0 +1,46 @@
+package addon
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+
+	"github.com/porter-dev/porter/api/server/shared/config"
+	"github.com/porter-dev/porter/api/types"
+	"github.com/porter-dev/porter/internal/addons"
+	"github.com/porter-dev/porter/internal/helm/loader"
+	"github.com/porter-dev/porter/internal/models"
+	"github.com/stefanmcshane/helm/pkg/chart"
+)
+
+// loadAddonChart loads the curated chart of an addon type from the addon chart repository of the server
+func loadAddonChart(ctx context.Context, config *config.Config, addonType types.AddonType, version string) (*chart.Chart, error) {
+	template, ok := addons.Template(addonType)
+	if !ok {
+		return nil, fmt.Errorf("unknown addon type %s", addonType)
+	}
+
+	return loader.LoadChartPublic(ctx, config.ServerConf.DefaultAddonHelmRepoURL, template.ChartName, version)
+}
+
+// setAddonData stores the inputs and raw values of an addon on the model
+func setAddonData(addon *models.Addon, inputs map[string]string, values map[string]interface{}) error {
+	inputBytes, err := json.Marshal(inputs)
+	if err != nil {
+		return fmt.Errorf("error marshaling addon inputs: %w", err)
+	}
+
+	addon.Inputs = inputBytes
+	addon.Values = nil
+
+	if len(values) > 0 {
+		valueBytes, err := json.Marshal(values)
+		if err != nil {
+			return fmt.Errorf("error marshaling addon values: %w", err)
+		}
+
+		addon.Values = valueBytes
+	}
+
+	return nil
+}
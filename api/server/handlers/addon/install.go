@@ -0,0 +1,158 @@
+package addon
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+
+	"github.com/porter-dev/porter/api/server/authz"
+	"github.com/porter-dev/porter/api/server/handlers"
+	"github.com/porter-dev/porter/api/server/shared"
+	"github.com/porter-dev/porter/api/server/shared/apierrors"
+	"github.com/porter-dev/porter/api/server/shared/config"
+	"github.com/porter-dev/porter/api/types"
+	"github.com/porter-dev/porter/internal/addons"
+	"github.com/porter-dev/porter/internal/helm"
+	"github.com/porter-dev/porter/internal/models"
+	"github.com/porter-dev/porter/internal/telemetry"
+	"gorm.io/gorm"
+)
+
+// InstallAddonHandler installs a curated chart into a cluster
+type InstallAddonHandler struct {
+	handlers.PorterHandlerReadWriter
+	authz.KubernetesAgentGetter
+}
+
+// NewInstallAddonHandler returns a new InstallAddonHandler
+func NewInstallAddonHandler(
+	config *config.Config,
+	decoderValidator shared.RequestDecoderValidator,
+	writer shared.ResultWriter,
+) *InstallAddonHandler {
+	return &InstallAddonHandler{
+		PorterHandlerReadWriter: handlers.NewDefaultPorterHandler(config, decoderValidator, writer),
+		KubernetesAgentGetter:   authz.NewOutOfClusterAgentGetter(config),
+	}
+}
+
+// ServeHTTP renders the values of the addon from the inputs of its install form and installs its chart
+func (c *InstallAddonHandler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	ctx, span := telemetry.NewSpan(r.Context(), "serve-install-addon")
+	defer span.End()
+
+	cluster, _ := ctx.Value(types.ClusterScope).(*models.Cluster)
+
+	request := &types.InstallAddonRequest{}
+	if ok := c.DecodeAndValidate(w, r, request); !ok {
+		return
+	}
+
+	if request.Namespace == "" {
+		request.Namespace = "default"
+	}
+
+	telemetry.WithAttributes(span,
+		telemetry.AttributeKV{Key: "project-id", Value: cluster.ProjectID},
+		telemetry.AttributeKV{Key: "cluster-id", Value: cluster.ID},
+		telemetry.AttributeKV{Key: "addon-type", Value: string(request.Type)},
+		telemetry.AttributeKV{Key: "addon-name", Value: request.Name},
+		telemetry.AttributeKV{Key: "namespace", Value: request.Namespace},
+	)
+
+	// roles may be restricted to some namespaces of the cluster
+	if !authz.HasNamespaceAccess(ctx, request.Namespace, types.APIVerbCreate) {
+		c.HandleAPIError(w, r, apierrors.NewErrForbidden(
+			fmt.Errorf("policy forbids installing addons in namespace %s", request.Namespace),
+		))
+		return
+	}
+
+	_, err := c.Repo().Addon().ReadAddon(ctx, cluster.ProjectID, cluster.ID, request.Name)
+	if err == nil {
+		err := telemetry.Error(ctx, span, nil, fmt.Sprintf("addon %s already exists in the cluster", request.Name))
+		c.HandleAPIError(w, r, apierrors.NewErrPassThroughToClient(err, http.StatusConflict))
+		return
+	}
+
+	if !errors.Is(err, gorm.ErrRecordNotFound) {
+		err := telemetry.Error(ctx, span, err, "error reading addon")
+		c.HandleAPIError(w, r, apierrors.NewErrPassThroughToClient(err, http.StatusInternalServerError))
+		return
+	}
+
+	values, err := addons.Values(request.Type, request.Inputs, request.Values)
+	if err != nil {
+		err := telemetry.Error(ctx, span, err, "invalid addon inputs")
+		c.HandleAPIError(w, r, apierrors.NewErrPassThroughToClient(err, http.StatusBadRequest))
+		return
+	}
+
+	chart, err := loadAddonChart(ctx, c.Config(), request.Type, request.ChartVersion)
+	if err != nil {
+		err := telemetry.Error(ctx, span, err, "error loading addon chart")
+		c.HandleAPIError(w, r, apierrors.NewErrPassThroughToClient(err, http.StatusBadRequest))
+		return
+	}
+
+	registries, err := c.Repo().Registry().ListRegistriesByProjectID(ctx, cluster.ProjectID)
+	if err != nil {
+		err := telemetry.Error(ctx, span, err, "error listing registries")
+		c.HandleAPIError(w, r, apierrors.NewErrPassThroughToClient(err, http.StatusInternalServerError))
+		return
+	}
+
+	helmAgent, err := c.GetHelmAgent(ctx, r, cluster, request.Namespace)
+	if err != nil {
+		err := telemetry.Error(ctx, span, err, "error getting helm agent")
+		c.HandleAPIError(w, r, apierrors.NewErrPassThroughToClient(err, http.StatusInternalServerError))
+		return
+	}
+
+	_, err = helmAgent.InstallChart(ctx, &helm.InstallChartConfig{
+		Chart:      chart,
+		Name:       request.Name,
+		Namespace:  request.Namespace,
+		Values:     values,
+		Cluster:    cluster,
+		Repo:       c.Repo(),
+		Registries: registries,
+	}, c.Config().DOConf, c.Config().ServerConf.DisablePullSecretsInjection)
+	if err != nil {
+		err := telemetry.Error(ctx, span, err, "error installing addon chart")
+		c.HandleAPIError(w, r, apierrors.NewErrPassThroughToClient(err, http.StatusBadRequest))
+		return
+	}
+
+	addon := &models.Addon{
+		ProjectID:    cluster.ProjectID,
+		ClusterID:    cluster.ID,
+		Name:         request.Name,
+		Namespace:    request.Namespace,
+		Type:         request.Type,
+		ChartVersion: request.ChartVersion,
+	}
+
+	if err := setAddonData(addon, request.Inputs, request.Values); err != nil {
+		err := telemetry.Error(ctx, span, err, "error setting addon data")
+		c.HandleAPIError(w, r, apierrors.NewErrPassThroughToClient(err, http.StatusInternalServerError))
+		return
+	}
+
+	addon, err = c.Repo().Addon().CreateAddon(ctx, addon)
+	if err != nil {
+		err := telemetry.Error(ctx, span, err, "error creating addon")
+		c.HandleAPIError(w, r, apierrors.NewErrPassThroughToClient(err, http.StatusInternalServerError))
+		return
+	}
+
+	res, err := addon.ToAddonType(addons.SecretKeys(addon.Type))
+	if err != nil {
+		err := telemetry.Error(ctx, span, err, "error converting addon")
+		c.HandleAPIError(w, r, apierrors.NewErrPassThroughToClient(err, http.StatusInternalServerError))
+		return
+	}
+
+	w.WriteHeader(http.StatusCreated)
+	c.WriteResult(w, r, res)
+}
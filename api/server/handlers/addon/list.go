@@ -0,0 +1,111 @@
+package addon
+
+import (
+	"fmt"
+	"net/http"
+
+	"github.com/porter-dev/porter/api/server/authz"
+	"github.com/porter-dev/porter/api/server/handlers"
+	"github.com/porter-dev/porter/api/server/shared"
+	"github.com/porter-dev/porter/api/server/shared/apierrors"
+	"github.com/porter-dev/porter/api/server/shared/config"
+	"github.com/porter-dev/porter/api/types"
+	"github.com/porter-dev/porter/internal/addons"
+	"github.com/porter-dev/porter/internal/models"
+	"github.com/porter-dev/porter/internal/telemetry"
+	"github.com/stefanmcshane/helm/pkg/release"
+)
+
+// ListAddonsHandler lists the addons installed into a cluster
+type ListAddonsHandler struct {
+	handlers.PorterHandlerWriter
+	authz.KubernetesAgentGetter
+}
+
+// NewListAddonsHandler returns a new ListAddonsHandler
+func NewListAddonsHandler(
+	config *config.Config,
+	writer shared.ResultWriter,
+) *ListAddonsHandler {
+	return &ListAddonsHandler{
+		PorterHandlerWriter:   handlers.NewDefaultPorterHandler(config, nil, writer),
+		KubernetesAgentGetter: authz.NewOutOfClusterAgentGetter(config),
+	}
+}
+
+// ServeHTTP lists the addons of a cluster with the status of their Helm releases
+func (c *ListAddonsHandler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	ctx, span := telemetry.NewSpan(r.Context(), "serve-list-addons")
+	defer span.End()
+
+	cluster, _ := ctx.Value(types.ClusterScope).(*models.Cluster)
+
+	telemetry.WithAttributes(span,
+		telemetry.AttributeKV{Key: "project-id", Value: cluster.ProjectID},
+		telemetry.AttributeKV{Key: "cluster-id", Value: cluster.ID},
+	)
+
+	addonModels, err := c.Repo().Addon().ListAddonsByClusterID(ctx, cluster.ProjectID, cluster.ID)
+	if err != nil {
+		err := telemetry.Error(ctx, span, err, "error listing addons")
+		c.HandleAPIError(w, r, apierrors.NewErrPassThroughToClient(err, http.StatusInternalServerError))
+		return
+	}
+
+	helmAgent, err := c.GetHelmAgent(ctx, r, cluster, "")
+	if err != nil {
+		err := telemetry.Error(ctx, span, err, "error getting helm agent")
+		c.HandleAPIError(w, r, apierrors.NewErrPassThroughToClient(err, http.StatusInternalServerError))
+		return
+	}
+
+	// superseded revisions are listed so that the latest revision of each release is always found
+	releases, err := helmAgent.ListReleases(ctx, "", &types.ReleaseListFilter{
+		StatusFilter: []string{
+			string(release.StatusDeployed),
+			string(release.StatusFailed),
+			string(release.StatusSuperseded),
+			string(release.StatusUninstalling),
+			string(release.StatusPendingInstall),
+			string(release.StatusPendingUpgrade),
+			string(release.StatusPendingRollback),
+		},
+	})
+	if err != nil {
+		err := telemetry.Error(ctx, span, err, "error listing helm releases")
+		c.HandleAPIError(w, r, apierrors.NewErrPassThroughToClient(err, http.StatusInternalServerError))
+		return
+	}
+
+	statuses := make(map[string]string)
+
+	for _, rel := range releases {
+		if rel.Info != nil {
+			statuses[fmt.Sprintf("%s/%s", rel.Namespace, rel.Name)] = rel.Info.Status.String()
+		}
+	}
+
+	res := &types.ListAddonsResponse{
+		Addons: make([]types.Addon, 0, len(addonModels)),
+	}
+
+	for _, addonModel := range addonModels {
+		// roles may be restricted to some namespaces of the cluster
+		if !authz.HasNamespaceAccess(ctx, addonModel.Namespace, types.APIVerbGet) {
+			continue
+		}
+
+		addon, err := addonModel.ToAddonType(addons.SecretKeys(addonModel.Type))
+		if err != nil {
+			err := telemetry.Error(ctx, span, err, "error converting addon")
+			c.HandleAPIError(w, r, apierrors.NewErrPassThroughToClient(err, http.StatusInternalServerError))
+			return
+		}
+
+		addon.Status = statuses[fmt.Sprintf("%s/%s", addonModel.Namespace, addonModel.Name)]
+
+		res.Addons = append(res.Addons, *addon)
+	}
+
+	c.WriteResult(w, r, res)
+}
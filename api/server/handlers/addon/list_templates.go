@@ -0,0 +1,32 @@
+package addon
+
+import (
+	"net/http"
+
+	"github.com/porter-dev/porter/api/server/handlers"
+	"github.com/porter-dev/porter/api/server/shared"
+	"github.com/porter-dev/porter/api/server/shared/config"
+	"github.com/porter-dev/porter/api/types"
+	"github.com/porter-dev/porter/internal/addons"
+)
+
+// ListAddonTemplatesHandler lists the curated charts which can be installed as addons
+type ListAddonTemplatesHandler struct {
+	handlers.PorterHandlerWriter
+}
+
+// NewListAddonTemplatesHandler returns a new ListAddonTemplatesHandler
+func NewListAddonTemplatesHandler(
+	config *config.Config,
+	writer shared.ResultWriter,
+) *ListAddonTemplatesHandler {
+	return &ListAddonTemplatesHandler{
+		PorterHandlerWriter: handlers.NewDefaultPorterHandler(config, nil, writer),
+	}
+}
+
+func (c *ListAddonTemplatesHandler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	c.WriteResult(w, r, &types.ListAddonTemplatesResponse{
+		Templates: addons.Templates(),
+	})
+}
@@ -0,0 +1,99 @@
+package addon
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+
+	"github.com/porter-dev/porter/api/server/authz"
+	"github.com/porter-dev/porter/api/server/handlers"
+	"github.com/porter-dev/porter/api/server/shared"
+	"github.com/porter-dev/porter/api/server/shared/apierrors"
+	"github.com/porter-dev/porter/api/server/shared/config"
+	"github.com/porter-dev/porter/api/server/shared/requestutils"
+	"github.com/porter-dev/porter/api/types"
+	"github.com/porter-dev/porter/internal/models"
+	"github.com/porter-dev/porter/internal/telemetry"
+	"github.com/stefanmcshane/helm/pkg/storage/driver"
+	"gorm.io/gorm"
+)
+
+// UninstallAddonHandler uninstalls an addon from a cluster
+type UninstallAddonHandler struct {
+	handlers.PorterHandlerWriter
+	authz.KubernetesAgentGetter
+}
+
+// NewUninstallAddonHandler returns a new UninstallAddonHandler
+func NewUninstallAddonHandler(
+	config *config.Config,
+	writer shared.ResultWriter,
+) *UninstallAddonHandler {
+	return &UninstallAddonHandler{
+		PorterHandlerWriter:   handlers.NewDefaultPorterHandler(config, nil, writer),
+		KubernetesAgentGetter: authz.NewOutOfClusterAgentGetter(config),
+	}
+}
+
+// ServeHTTP uninstalls the Helm release of an addon and deletes the addon. Addons whose release was already
+// uninstalled outside of Porter are deleted as well.
+func (c *UninstallAddonHandler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	ctx, span := telemetry.NewSpan(r.Context(), "serve-uninstall-addon")
+	defer span.End()
+
+	cluster, _ := ctx.Value(types.ClusterScope).(*models.Cluster)
+
+	name, reqErr := requestutils.GetURLParamString(r, types.URLParamAddonName)
+	if reqErr != nil {
+		c.HandleAPIError(w, r, reqErr)
+		return
+	}
+
+	telemetry.WithAttributes(span,
+		telemetry.AttributeKV{Key: "project-id", Value: cluster.ProjectID},
+		telemetry.AttributeKV{Key: "cluster-id", Value: cluster.ID},
+		telemetry.AttributeKV{Key: "addon-name", Value: name},
+	)
+
+	addon, err := c.Repo().Addon().ReadAddon(ctx, cluster.ProjectID, cluster.ID, name)
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			c.HandleAPIError(w, r, apierrors.NewErrNotFound(err))
+			return
+		}
+
+		err := telemetry.Error(ctx, span, err, "error reading addon")
+		c.HandleAPIError(w, r, apierrors.NewErrPassThroughToClient(err, http.StatusInternalServerError))
+		return
+	}
+
+	// roles may be restricted to some namespaces of the cluster
+	if !authz.HasNamespaceAccess(ctx, addon.Namespace, types.APIVerbDelete) {
+		c.HandleAPIError(w, r, apierrors.NewErrForbidden(
+			fmt.Errorf("policy forbids uninstalling addons in namespace %s", addon.Namespace),
+		))
+		return
+	}
+
+	helmAgent, err := c.GetHelmAgent(ctx, r, cluster, addon.Namespace)
+	if err != nil {
+		err := telemetry.Error(ctx, span, err, "error getting helm agent")
+		c.HandleAPIError(w, r, apierrors.NewErrPassThroughToClient(err, http.StatusInternalServerError))
+		return
+	}
+
+	_, err = helmAgent.UninstallChart(ctx, addon.Name)
+	if err != nil && !errors.Is(err, driver.ErrReleaseNotFound) {
+		err := telemetry.Error(ctx, span, err, "error uninstalling addon release")
+		c.HandleAPIError(w, r, apierrors.NewErrPassThroughToClient(err, http.StatusInternalServerError))
+		return
+	}
+
+	if err := c.Repo().Addon().DeleteAddon(ctx, addon); err != nil {
+		err := telemetry.Error(ctx, span, err, "error deleting addon")
+		c.HandleAPIError(w, r, apierrors.NewErrPassThroughToClient(err, http.StatusInternalServerError))
+		return
+	}
+
+	w.WriteHeader(http.StatusOK)
+}
@@ -0,0 +1,173 @@
+package addon
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+
+	"github.com/porter-dev/porter/api/server/authz"
+	"github.com/porter-dev/porter/api/server/handlers"
+	"github.com/porter-dev/porter/api/server/shared"
+	"github.com/porter-dev/porter/api/server/shared/apierrors"
+	"github.com/porter-dev/porter/api/server/shared/config"
+	"github.com/porter-dev/porter/api/server/shared/requestutils"
+	"github.com/porter-dev/porter/api/types"
+	"github.com/porter-dev/porter/internal/addons"
+	"github.com/porter-dev/porter/internal/helm"
+	"github.com/porter-dev/porter/internal/models"
+	"github.com/porter-dev/porter/internal/telemetry"
+	"gorm.io/gorm"
+)
+
+// UpgradeAddonHandler changes the chart version or values of an addon
+type UpgradeAddonHandler struct {
+	handlers.PorterHandlerReadWriter
+	authz.KubernetesAgentGetter
+}
+
+// NewUpgradeAddonHandler returns a new UpgradeAddonHandler
+func NewUpgradeAddonHandler(
+	config *config.Config,
+	decoderValidator shared.RequestDecoderValidator,
+	writer shared.ResultWriter,
+) *UpgradeAddonHandler {
+	return &UpgradeAddonHandler{
+		PorterHandlerReadWriter: handlers.NewDefaultPorterHandler(config, decoderValidator, writer),
+		KubernetesAgentGetter:   authz.NewOutOfClusterAgentGetter(config),
+	}
+}
+
+// ServeHTTP merges the new inputs into the inputs of the addon, and upgrades its release with the rendered values
+func (c *UpgradeAddonHandler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	ctx, span := telemetry.NewSpan(r.Context(), "serve-upgrade-addon")
+	defer span.End()
+
+	cluster, _ := ctx.Value(types.ClusterScope).(*models.Cluster)
+
+	name, reqErr := requestutils.GetURLParamString(r, types.URLParamAddonName)
+	if reqErr != nil {
+		c.HandleAPIError(w, r, reqErr)
+		return
+	}
+
+	request := &types.UpgradeAddonRequest{}
+	if ok := c.DecodeAndValidate(w, r, request); !ok {
+		return
+	}
+
+	telemetry.WithAttributes(span,
+		telemetry.AttributeKV{Key: "project-id", Value: cluster.ProjectID},
+		telemetry.AttributeKV{Key: "cluster-id", Value: cluster.ID},
+		telemetry.AttributeKV{Key: "addon-name", Value: name},
+	)
+
+	addon, err := c.Repo().Addon().ReadAddon(ctx, cluster.ProjectID, cluster.ID, name)
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			c.HandleAPIError(w, r, apierrors.NewErrNotFound(err))
+			return
+		}
+
+		err := telemetry.Error(ctx, span, err, "error reading addon")
+		c.HandleAPIError(w, r, apierrors.NewErrPassThroughToClient(err, http.StatusInternalServerError))
+		return
+	}
+
+	// roles may be restricted to some namespaces of the cluster
+	if !authz.HasNamespaceAccess(ctx, addon.Namespace, types.APIVerbUpdate) {
+		c.HandleAPIError(w, r, apierrors.NewErrForbidden(
+			fmt.Errorf("policy forbids upgrading addons in namespace %s", addon.Namespace),
+		))
+		return
+	}
+
+	inputs, err := addon.AddonInputs()
+	if err != nil {
+		err := telemetry.Error(ctx, span, err, "error reading addon inputs")
+		c.HandleAPIError(w, r, apierrors.NewErrPassThroughToClient(err, http.StatusInternalServerError))
+		return
+	}
+
+	for key, value := range request.Inputs {
+		inputs[key] = value
+	}
+
+	overrides := request.Values
+	if overrides == nil {
+		overrides, err = addon.AddonValues()
+		if err != nil {
+			err := telemetry.Error(ctx, span, err, "error reading addon values")
+			c.HandleAPIError(w, r, apierrors.NewErrPassThroughToClient(err, http.StatusInternalServerError))
+			return
+		}
+	}
+
+	values, err := addons.Values(addon.Type, inputs, overrides)
+	if err != nil {
+		err := telemetry.Error(ctx, span, err, "invalid addon inputs")
+		c.HandleAPIError(w, r, apierrors.NewErrPassThroughToClient(err, http.StatusBadRequest))
+		return
+	}
+
+	conf := &helm.UpgradeReleaseConfig{
+		Name:    addon.Name,
+		Values:  values,
+		Cluster: cluster,
+		Repo:    c.Repo(),
+	}
+
+	// the chart of the installed release is kept unless the version changes
+	if request.ChartVersion != "" && request.ChartVersion != addon.ChartVersion {
+		conf.Chart, err = loadAddonChart(ctx, c.Config(), addon.Type, request.ChartVersion)
+		if err != nil {
+			err := telemetry.Error(ctx, span, err, "error loading addon chart")
+			c.HandleAPIError(w, r, apierrors.NewErrPassThroughToClient(err, http.StatusBadRequest))
+			return
+		}
+
+		addon.ChartVersion = request.ChartVersion
+	}
+
+	conf.Registries, err = c.Repo().Registry().ListRegistriesByProjectID(ctx, cluster.ProjectID)
+	if err != nil {
+		err := telemetry.Error(ctx, span, err, "error listing registries")
+		c.HandleAPIError(w, r, apierrors.NewErrPassThroughToClient(err, http.StatusInternalServerError))
+		return
+	}
+
+	helmAgent, err := c.GetHelmAgent(ctx, r, cluster, addon.Namespace)
+	if err != nil {
+		err := telemetry.Error(ctx, span, err, "error getting helm agent")
+		c.HandleAPIError(w, r, apierrors.NewErrPassThroughToClient(err, http.StatusInternalServerError))
+		return
+	}
+
+	_, err = helmAgent.UpgradeReleaseByValues(ctx, conf, c.Config().DOConf, c.Config().ServerConf.DisablePullSecretsInjection, false)
+	if err != nil {
+		err := telemetry.Error(ctx, span, err, "error upgrading addon release")
+		c.HandleAPIError(w, r, apierrors.NewErrPassThroughToClient(err, http.StatusBadRequest))
+		return
+	}
+
+	if err := setAddonData(addon, inputs, overrides); err != nil {
+		err := telemetry.Error(ctx, span, err, "error setting addon data")
+		c.HandleAPIError(w, r, apierrors.NewErrPassThroughToClient(err, http.StatusInternalServerError))
+		return
+	}
+
+	addon, err = c.Repo().Addon().UpdateAddon(ctx, addon)
+	if err != nil {
+		err := telemetry.Error(ctx, span, err, "error updating addon")
+		c.HandleAPIError(w, r, apierrors.NewErrPassThroughToClient(err, http.StatusInternalServerError))
+		return
+	}
+
+	res, err := addon.ToAddonType(addons.SecretKeys(addon.Type))
+	if err != nil {
+		err := telemetry.Error(ctx, span, err, "error converting addon")
+		c.HandleAPIError(w, r, apierrors.NewErrPassThroughToClient(err, http.StatusInternalServerError))
+		return
+	}
+
+	c.WriteResult(w, r, res)
+}
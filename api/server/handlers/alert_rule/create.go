@@ -0,0 +1,65 @@
+package alert_rule
+
+import (
+	"net/http"
+
+	"github.com/porter-dev/porter/api/server/handlers"
+	"github.com/porter-dev/porter/api/server/shared"
+	"github.com/porter-dev/porter/api/server/shared/apierrors"
+	"github.com/porter-dev/porter/api/server/shared/config"
+	"github.com/porter-dev/porter/api/types"
+	"github.com/porter-dev/porter/internal/models"
+	"github.com/porter-dev/porter/internal/telemetry"
+)
+
+// CreateAlertRuleHandler creates an alert rule on a project
+type CreateAlertRuleHandler struct {
+	handlers.PorterHandlerReadWriter
+}
+
+// NewCreateAlertRuleHandler returns a new CreateAlertRuleHandler
+func NewCreateAlertRuleHandler(
+	config *config.Config,
+	decoderValidator shared.RequestDecoderValidator,
+	writer shared.ResultWriter,
+) *CreateAlertRuleHandler {
+	return &CreateAlertRuleHandler{
+		PorterHandlerReadWriter: handlers.NewDefaultPorterHandler(config, decoderValidator, writer),
+	}
+}
+
+func (c *CreateAlertRuleHandler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	ctx, span := telemetry.NewSpan(r.Context(), "serve-create-alert-rule")
+	defer span.End()
+
+	project, _ := ctx.Value(types.ProjectScope).(*models.Project)
+
+	request := &types.CreateAlertRuleRequest{}
+	if ok := c.DecodeAndValidate(w, r, request); !ok {
+		return
+	}
+
+	if !request.NotifyWebhooks && !request.NotifySlack {
+		err := telemetry.Error(ctx, span, nil, "alert rule must notify webhooks or slack")
+		c.HandleAPIError(w, r, apierrors.NewErrPassThroughToClient(err, http.StatusBadRequest))
+		return
+	}
+
+	rule, err := c.Repo().AlertRule().CreateAlertRule(ctx, &models.AlertRule{
+		ProjectID:      project.ID,
+		Name:           request.Name,
+		Severity:       request.Severity,
+		ResourceType:   request.ResourceType,
+		Namespace:      request.Namespace,
+		NotifyWebhooks: request.NotifyWebhooks,
+		NotifySlack:    request.NotifySlack,
+	})
+	if err != nil {
+		err = telemetry.Error(ctx, span, err, "error creating alert rule")
+		c.HandleAPIError(w, r, apierrors.NewErrInternal(err))
+		return
+	}
+
+	w.WriteHeader(http.StatusCreated)
+	c.WriteResult(w, r, rule.ToAlertRuleType())
+}
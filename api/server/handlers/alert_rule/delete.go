@@ -0,0 +1,64 @@
+package alert_rule
+
+import (
+	"errors"
+	"net/http"
+
+	"github.com/porter-dev/porter/api/server/handlers"
+	"github.com/porter-dev/porter/api/server/shared"
+	"github.com/porter-dev/porter/api/server/shared/apierrors"
+	"github.com/porter-dev/porter/api/server/shared/config"
+	"github.com/porter-dev/porter/api/server/shared/requestutils"
+	"github.com/porter-dev/porter/api/types"
+	"github.com/porter-dev/porter/internal/models"
+	"github.com/porter-dev/porter/internal/telemetry"
+	"gorm.io/gorm"
+)
+
+// DeleteAlertRuleHandler deletes an alert rule from a project
+type DeleteAlertRuleHandler struct {
+	handlers.PorterHandlerWriter
+}
+
+// NewDeleteAlertRuleHandler returns a new DeleteAlertRuleHandler
+func NewDeleteAlertRuleHandler(
+	config *config.Config,
+	writer shared.ResultWriter,
+) *DeleteAlertRuleHandler {
+	return &DeleteAlertRuleHandler{
+		PorterHandlerWriter: handlers.NewDefaultPorterHandler(config, nil, writer),
+	}
+}
+
+func (c *DeleteAlertRuleHandler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	ctx, span := telemetry.NewSpan(r.Context(), "serve-delete-alert-rule")
+	defer span.End()
+
+	project, _ := ctx.Value(types.ProjectScope).(*models.Project)
+
+	ruleID, reqErr := requestutils.GetURLParamUint(r, types.URLParamAlertRuleID)
+	if reqErr != nil {
+		c.HandleAPIError(w, r, reqErr)
+		return
+	}
+
+	rule, err := c.Repo().AlertRule().ReadAlertRule(ctx, project.ID, ruleID)
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			c.HandleAPIError(w, r, apierrors.NewErrNotFound(err))
+			return
+		}
+
+		err = telemetry.Error(ctx, span, err, "error reading alert rule")
+		c.HandleAPIError(w, r, apierrors.NewErrInternal(err))
+		return
+	}
+
+	if err := c.Repo().AlertRule().DeleteAlertRule(ctx, rule); err != nil {
+		err = telemetry.Error(ctx, span, err, "error deleting alert rule")
+		c.HandleAPIError(w, r, apierrors.NewErrInternal(err))
+		return
+	}
+
+	w.WriteHeader(http.StatusOK)
+}
@@ -0,0 +1,49 @@
+package alert_rule
+
+import (
+	"net/http"
+
+	"github.com/porter-dev/porter/api/server/handlers"
+	"github.com/porter-dev/porter/api/server/shared"
+	"github.com/porter-dev/porter/api/server/shared/apierrors"
+	"github.com/porter-dev/porter/api/server/shared/config"
+	"github.com/porter-dev/porter/api/types"
+	"github.com/porter-dev/porter/internal/models"
+	"github.com/porter-dev/porter/internal/telemetry"
+)
+
+// ListAlertRulesHandler lists the alert rules of a project
+type ListAlertRulesHandler struct {
+	handlers.PorterHandlerWriter
+}
+
+// NewListAlertRulesHandler returns a new ListAlertRulesHandler
+func NewListAlertRulesHandler(
+	config *config.Config,
+	writer shared.ResultWriter,
+) *ListAlertRulesHandler {
+	return &ListAlertRulesHandler{
+		PorterHandlerWriter: handlers.NewDefaultPorterHandler(config, nil, writer),
+	}
+}
+
+func (c *ListAlertRulesHandler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	ctx, span := telemetry.NewSpan(r.Context(), "serve-list-alert-rules")
+	defer span.End()
+
+	project, _ := ctx.Value(types.ProjectScope).(*models.Project)
+
+	rules, err := c.Repo().AlertRule().ListAlertRulesByProjectID(ctx, project.ID)
+	if err != nil {
+		err = telemetry.Error(ctx, span, err, "error listing alert rules")
+		c.HandleAPIError(w, r, apierrors.NewErrInternal(err))
+		return
+	}
+
+	res := make(types.ListAlertRulesResponse, 0, len(rules))
+	for _, rule := range rules {
+		res = append(res, rule.ToAlertRuleType())
+	}
+
+	c.WriteResult(w, r, res)
+}
@@ -1,6 +1,7 @@
 package api_contract
 
 import (
+	"errors"
 	"net/http"
 
 	"connectrpc.com/connect"
@@ -13,6 +14,7 @@ import (
 	"github.com/porter-dev/porter/api/server/shared/config"
 	"github.com/porter-dev/porter/api/types"
 	"github.com/porter-dev/porter/internal/models"
+	"github.com/porter-dev/porter/internal/quota"
 	"github.com/porter-dev/porter/internal/telemetry"
 )
 
@@ -37,6 +39,7 @@ func (c *APIContractUpdateHandler) ServeHTTP(w http.ResponseWriter, r *http.Requ
 	defer span.End()
 
 	user, _ := ctx.Value(types.UserScope).(*models.User)
+	project, _ := ctx.Value(types.ProjectScope).(*models.Project)
 
 	var apiContract porterv1.Contract
 
@@ -47,6 +50,23 @@ func (c *APIContractUpdateHandler) ServeHTTP(w http.ResponseWriter, r *http.Requ
 		return
 	}
 
+	// contracts without a cluster id create a new cluster
+	if apiContract.Cluster != nil && apiContract.Cluster.ClusterId == 0 {
+		err := quota.CheckClusterQuota(ctx, c.Repo(), project)
+		if err != nil {
+			var quotaErr *quota.ExceededError
+			if errors.As(err, &quotaErr) {
+				_ = telemetry.Error(ctx, span, err, "cluster exceeds project quota")
+				handlers.HandleQuotaExceeded(c.Config(), w, r, quotaErr)
+				return
+			}
+
+			e := telemetry.Error(ctx, span, err, "error checking project quotas")
+			c.HandleAPIError(w, r, apierrors.NewErrPassThroughToClient(e, http.StatusInternalServerError))
+			return
+		}
+	}
+
 	apiContract.User = &porterv1.User{
 		Id: int32(user.ID),
 	}
@@ -40,6 +40,12 @@ func (p *APITokenCreateHandler) ServeHTTP(w http.ResponseWriter, r *http.Request
 		return
 	}
 
+	// tokens created by a service account would outlive its expiry
+	if user.ServiceAccount {
+		p.HandleAPIError(w, r, apierrors.NewErrForbidden(fmt.Errorf("service accounts cannot create api tokens")))
+		return
+	}
+
 	req := &types.CreateAPIToken{}
 
 	if ok := p.DecodeAndValidate(w, r, req); !ok {
@@ -51,7 +57,7 @@ func (p *APITokenCreateHandler) ServeHTTP(w http.ResponseWriter, r *http.Request
 		req.ExpiresAt = time.Now().Add(time.Hour * 24 * 365)
 	}
 
-	apiPolicy, reqErr := policy.GetAPIPolicyFromUID(p.Repo().Policy(), proj.ID, req.PolicyUID)
+	apiPolicy, reqErr := policy.GetAPIPolicyFromUID(r.Context(), p.Repo().Policy(), proj.ID, req.PolicyUID)
 
 	if reqErr != nil {
 		p.HandleAPIError(w, r, reqErr)
@@ -89,7 +95,7 @@ func (p *APITokenCreateHandler) ServeHTTP(w http.ResponseWriter, r *http.Request
 		SecretKey:       hashedToken,
 	}
 
-	apiToken, err = p.Repo().APIToken().CreateAPIToken(apiToken)
+	apiToken, err = p.Repo().APIToken().CreateAPIToken(r.Context(), apiToken)
 
 	if err != nil {
 		p.HandleAPIError(w, r, apierrors.NewErrInternal(err))
@@ -46,7 +46,7 @@ func (p *APITokenGetHandler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
-	token, err := p.Repo().APIToken().ReadAPIToken(proj.ID, tokenID)
+	token, err := p.Repo().APIToken().ReadAPIToken(r.Context(), proj.ID, tokenID)
 	if err != nil {
 		if errors.Is(err, gorm.ErrRecordNotFound) {
 			p.HandleAPIError(w, r, apierrors.NewErrPassThroughToClient(
@@ -60,7 +60,7 @@ func (p *APITokenGetHandler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
-	apiPolicy, reqErr := policy.GetAPIPolicyFromUID(p.Repo().Policy(), proj.ID, token.PolicyUID)
+	apiPolicy, reqErr := policy.GetAPIPolicyFromUID(r.Context(), p.Repo().Policy(), proj.ID, token.PolicyUID)
 
 	if reqErr != nil {
 		p.HandleAPIError(w, r, reqErr)
@@ -34,7 +34,7 @@ func (p *APITokenListHandler) ServeHTTP(w http.ResponseWriter, r *http.Request)
 		return
 	}
 
-	tokens, err := p.Repo().APIToken().ListAPITokensByProjectID(proj.ID)
+	tokens, err := p.Repo().APIToken().ListAPITokensByProjectID(r.Context(), proj.ID)
 	if err != nil {
 		p.HandleAPIError(w, r, apierrors.NewErrInternal(err))
 		return
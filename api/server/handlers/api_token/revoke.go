@@ -45,7 +45,7 @@ func (p *APITokenRevokeHandler) ServeHTTP(w http.ResponseWriter, r *http.Request
 		return
 	}
 
-	token, err := p.Repo().APIToken().ReadAPIToken(proj.ID, tokenID)
+	token, err := p.Repo().APIToken().ReadAPIToken(r.Context(), proj.ID, tokenID)
 	if err != nil {
 		if errors.Is(err, gorm.ErrRecordNotFound) {
 			p.HandleAPIError(w, r, apierrors.NewErrPassThroughToClient(
@@ -61,7 +61,7 @@ func (p *APITokenRevokeHandler) ServeHTTP(w http.ResponseWriter, r *http.Request
 
 	token.Revoked = true
 
-	token, err = p.Repo().APIToken().UpdateAPIToken(token)
+	token, err = p.Repo().APIToken().UpdateAPIToken(r.Context(), token)
 
 	if err != nil {
 		p.HandleAPIError(w, r, apierrors.NewErrInternal(err))
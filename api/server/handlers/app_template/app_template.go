@@ -0,0 +1,244 @@
+package app_template
+
+import (
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"strings"
+
+	"github.com/porter-dev/porter/api/server/handlers"
+	"github.com/porter-dev/porter/api/server/shared"
+	"github.com/porter-dev/porter/api/server/shared/apierrors"
+	"github.com/porter-dev/porter/api/server/shared/config"
+	"github.com/porter-dev/porter/api/server/shared/requestutils"
+	"github.com/porter-dev/porter/api/types"
+	"github.com/porter-dev/porter/internal/models"
+	"github.com/porter-dev/porter/internal/porter_app"
+	"github.com/porter-dev/porter/internal/telemetry"
+	"gorm.io/gorm"
+	"k8s.io/apimachinery/pkg/util/validation"
+)
+
+// CreateAppTemplateHandler creates an app template, or replaces the app template of the project with the same name
+type CreateAppTemplateHandler struct {
+	handlers.PorterHandlerReadWriter
+}
+
+// NewCreateAppTemplateHandler returns a new CreateAppTemplateHandler
+func NewCreateAppTemplateHandler(
+	config *config.Config,
+	decoderValidator shared.RequestDecoderValidator,
+	writer shared.ResultWriter,
+) *CreateAppTemplateHandler {
+	return &CreateAppTemplateHandler{
+		PorterHandlerReadWriter: handlers.NewDefaultPorterHandler(config, decoderValidator, writer),
+	}
+}
+
+func (c *CreateAppTemplateHandler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	ctx, span := telemetry.NewSpan(r.Context(), "serve-create-app-template")
+	defer span.End()
+
+	project, _ := ctx.Value(types.ProjectScope).(*models.Project)
+
+	request := &types.CreateAppTemplateRequest{}
+	if ok := c.DecodeAndValidate(w, r, request); !ok {
+		_ = telemetry.Error(ctx, span, nil, "error decoding request")
+		return
+	}
+
+	telemetry.WithAttributes(span, telemetry.AttributeKV{Key: "template-name", Value: request.Name})
+
+	if errs := validation.IsDNS1123Label(request.Name); len(errs) > 0 {
+		err := fmt.Errorf("invalid template name '%s': %s", request.Name, strings.Join(errs, ", "))
+		c.HandleAPIError(w, r, apierrors.NewErrPassThroughToClient(telemetry.Error(ctx, span, err, "invalid template name"), http.StatusBadRequest))
+		return
+	}
+
+	porterYaml, err := base64.StdEncoding.DecodeString(request.B64PorterYAML)
+	if err != nil {
+		c.HandleAPIError(w, r, apierrors.NewErrPassThroughToClient(telemetry.Error(ctx, span, err, "error decoding porter yaml"), http.StatusBadRequest))
+		return
+	}
+
+	if err := porter_app.ValidateAppTemplate(ctx, porterYaml, request.Variables); err != nil {
+		c.HandleAPIError(w, r, apierrors.NewErrPassThroughToClient(err, http.StatusBadRequest))
+		return
+	}
+
+	variables, err := json.Marshal(request.Variables)
+	if err != nil {
+		c.HandleAPIError(w, r, apierrors.NewErrInternal(telemetry.Error(ctx, span, err, "error encoding template variables")))
+		return
+	}
+
+	template, err := c.Repo().AppTemplate().ReadAppTemplateByName(ctx, project.ID, request.Name)
+	if err != nil && !errors.Is(err, gorm.ErrRecordNotFound) {
+		c.HandleAPIError(w, r, apierrors.NewErrInternal(telemetry.Error(ctx, span, err, "error reading app template")))
+		return
+	}
+	if template == nil {
+		template = &models.AppTemplate{ProjectID: project.ID, Name: request.Name}
+	}
+
+	template.Description = request.Description
+	template.PorterYAML = string(porterYaml)
+	template.Variables = variables
+
+	if template.ID == 0 {
+		template, err = c.Repo().AppTemplate().CreateAppTemplate(ctx, template)
+	} else {
+		template, err = c.Repo().AppTemplate().UpdateAppTemplate(ctx, template)
+	}
+	if err != nil {
+		c.HandleAPIError(w, r, apierrors.NewErrInternal(telemetry.Error(ctx, span, err, "error saving app template")))
+		return
+	}
+
+	res, err := template.ToAppTemplateType()
+	if err != nil {
+		c.HandleAPIError(w, r, apierrors.NewErrInternal(telemetry.Error(ctx, span, err, "error converting app template")))
+		return
+	}
+
+	c.WriteResult(w, r, res)
+}
+
+// ListAppTemplatesHandler lists the app templates of a project
+type ListAppTemplatesHandler struct {
+	handlers.PorterHandlerWriter
+}
+
+// NewListAppTemplatesHandler returns a new ListAppTemplatesHandler
+func NewListAppTemplatesHandler(
+	config *config.Config,
+	writer shared.ResultWriter,
+) *ListAppTemplatesHandler {
+	return &ListAppTemplatesHandler{
+		PorterHandlerWriter: handlers.NewDefaultPorterHandler(config, nil, writer),
+	}
+}
+
+func (c *ListAppTemplatesHandler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	ctx, span := telemetry.NewSpan(r.Context(), "serve-list-app-templates")
+	defer span.End()
+
+	project, _ := ctx.Value(types.ProjectScope).(*models.Project)
+
+	templates, err := c.Repo().AppTemplate().ListAppTemplatesByProjectID(ctx, project.ID)
+	if err != nil {
+		c.HandleAPIError(w, r, apierrors.NewErrInternal(telemetry.Error(ctx, span, err, "error listing app templates")))
+		return
+	}
+
+	res := types.ListAppTemplatesResponse{
+		AppTemplates: make([]types.AppTemplate, 0, len(templates)),
+	}
+	for _, template := range templates {
+		appTemplate, err := template.ToAppTemplateType()
+		if err != nil {
+			c.HandleAPIError(w, r, apierrors.NewErrInternal(telemetry.Error(ctx, span, err, "error converting app template")))
+			return
+		}
+		res.AppTemplates = append(res.AppTemplates, *appTemplate)
+	}
+
+	c.WriteResult(w, r, res)
+}
+
+// GetAppTemplateHandler returns an app template of a project
+type GetAppTemplateHandler struct {
+	handlers.PorterHandlerWriter
+}
+
+// NewGetAppTemplateHandler returns a new GetAppTemplateHandler
+func NewGetAppTemplateHandler(
+	config *config.Config,
+	writer shared.ResultWriter,
+) *GetAppTemplateHandler {
+	return &GetAppTemplateHandler{
+		PorterHandlerWriter: handlers.NewDefaultPorterHandler(config, nil, writer),
+	}
+}
+
+func (c *GetAppTemplateHandler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	ctx, span := telemetry.NewSpan(r.Context(), "serve-get-app-template")
+	defer span.End()
+
+	project, _ := ctx.Value(types.ProjectScope).(*models.Project)
+
+	template, ok := readAppTemplate(w, r, c.PorterHandlerWriter, project.ID)
+	if !ok {
+		return
+	}
+
+	res, err := template.ToAppTemplateType()
+	if err != nil {
+		c.HandleAPIError(w, r, apierrors.NewErrInternal(telemetry.Error(ctx, span, err, "error converting app template")))
+		return
+	}
+
+	c.WriteResult(w, r, res)
+}
+
+// DeleteAppTemplateHandler deletes an app template of a project. Apps created from the template are not changed.
+type DeleteAppTemplateHandler struct {
+	handlers.PorterHandlerWriter
+}
+
+// NewDeleteAppTemplateHandler returns a new DeleteAppTemplateHandler
+func NewDeleteAppTemplateHandler(
+	config *config.Config,
+	writer shared.ResultWriter,
+) *DeleteAppTemplateHandler {
+	return &DeleteAppTemplateHandler{
+		PorterHandlerWriter: handlers.NewDefaultPorterHandler(config, nil, writer),
+	}
+}
+
+func (c *DeleteAppTemplateHandler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	ctx, span := telemetry.NewSpan(r.Context(), "serve-delete-app-template")
+	defer span.End()
+
+	project, _ := ctx.Value(types.ProjectScope).(*models.Project)
+
+	template, ok := readAppTemplate(w, r, c.PorterHandlerWriter, project.ID)
+	if !ok {
+		return
+	}
+
+	if err := c.Repo().AppTemplate().DeleteAppTemplate(ctx, template); err != nil {
+		c.HandleAPIError(w, r, apierrors.NewErrInternal(telemetry.Error(ctx, span, err, "error deleting app template")))
+		return
+	}
+
+	w.WriteHeader(http.StatusOK)
+}
+
+// readAppTemplate reads the app template named in the URL of a request, writing an error to the response if it cannot be read
+func readAppTemplate(w http.ResponseWriter, r *http.Request, c handlers.PorterHandlerWriter, projectID uint) (*models.AppTemplate, bool) {
+	ctx, span := telemetry.NewSpan(r.Context(), "read-app-template")
+	defer span.End()
+
+	name, reqErr := requestutils.GetURLParamString(r, types.URLParamAppTemplateName)
+	if reqErr != nil {
+		c.HandleAPIError(w, r, apierrors.NewErrPassThroughToClient(telemetry.Error(ctx, span, reqErr, "error parsing template name"), http.StatusBadRequest))
+		return nil, false
+	}
+
+	telemetry.WithAttributes(span, telemetry.AttributeKV{Key: "template-name", Value: name})
+
+	template, err := c.Repo().AppTemplate().ReadAppTemplateByName(ctx, projectID, name)
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			c.HandleAPIError(w, r, apierrors.NewErrNotFound(telemetry.Error(ctx, span, err, fmt.Sprintf("app template '%s' not found", name))))
+			return nil, false
+		}
+		c.HandleAPIError(w, r, apierrors.NewErrInternal(telemetry.Error(ctx, span, err, "error reading app template")))
+		return nil, false
+	}
+
+	return template, true
+}
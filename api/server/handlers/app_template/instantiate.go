@@ -0,0 +1,75 @@
+package app_template
+
+import (
+	"encoding/base64"
+	"fmt"
+	"net/http"
+	"strings"
+
+	"github.com/porter-dev/porter/api/server/handlers"
+	"github.com/porter-dev/porter/api/server/shared"
+	"github.com/porter-dev/porter/api/server/shared/apierrors"
+	"github.com/porter-dev/porter/api/server/shared/config"
+	"github.com/porter-dev/porter/api/types"
+	"github.com/porter-dev/porter/internal/models"
+	"github.com/porter-dev/porter/internal/porter_app"
+	"github.com/porter-dev/porter/internal/telemetry"
+	"k8s.io/apimachinery/pkg/util/validation"
+)
+
+// InstantiateAppTemplateHandler returns the porter.yaml of a new app created from an app template. The app is not deployed;
+// the porter.yaml is applied like any other app.
+type InstantiateAppTemplateHandler struct {
+	handlers.PorterHandlerReadWriter
+}
+
+// NewInstantiateAppTemplateHandler returns a new InstantiateAppTemplateHandler
+func NewInstantiateAppTemplateHandler(
+	config *config.Config,
+	decoderValidator shared.RequestDecoderValidator,
+	writer shared.ResultWriter,
+) *InstantiateAppTemplateHandler {
+	return &InstantiateAppTemplateHandler{
+		PorterHandlerReadWriter: handlers.NewDefaultPorterHandler(config, decoderValidator, writer),
+	}
+}
+
+func (c *InstantiateAppTemplateHandler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	ctx, span := telemetry.NewSpan(r.Context(), "serve-instantiate-app-template")
+	defer span.End()
+
+	project, _ := ctx.Value(types.ProjectScope).(*models.Project)
+
+	template, ok := readAppTemplate(w, r, c.PorterHandlerReadWriter, project.ID)
+	if !ok {
+		return
+	}
+
+	request := &types.InstantiateAppTemplateRequest{}
+	if ok := c.DecodeAndValidate(w, r, request); !ok {
+		_ = telemetry.Error(ctx, span, nil, "error decoding request")
+		return
+	}
+
+	telemetry.WithAttributes(span,
+		telemetry.AttributeKV{Key: "template-name", Value: template.Name},
+		telemetry.AttributeKV{Key: "app-name", Value: request.AppName},
+	)
+
+	if errs := validation.IsDNS1123Label(request.AppName); len(errs) > 0 {
+		err := fmt.Errorf("invalid app name '%s': %s", request.AppName, strings.Join(errs, ", "))
+		c.HandleAPIError(w, r, apierrors.NewErrPassThroughToClient(telemetry.Error(ctx, span, err, "invalid app name"), http.StatusBadRequest))
+		return
+	}
+
+	porterYaml, err := porter_app.RenderAppTemplate(ctx, project, template, request.AppName, request.Variables)
+	if err != nil {
+		c.HandleAPIError(w, r, apierrors.NewErrPassThroughToClient(err, http.StatusBadRequest))
+		return
+	}
+
+	c.WriteResult(w, r, &types.InstantiateAppTemplateResponse{
+		AppName:       request.AppName,
+		B64PorterYAML: base64.StdEncoding.EncodeToString(porterYaml),
+	})
+}
@@ -1,7 +1,9 @@
 package cluster
 
 import (
+	"context"
 	"encoding/base64"
+	"errors"
 	"fmt"
 	"net/http"
 	"regexp"
@@ -13,6 +15,7 @@ import (
 	"github.com/porter-dev/porter/api/types"
 	"github.com/porter-dev/porter/internal/kubernetes/resolver"
 	"github.com/porter-dev/porter/internal/models"
+	"github.com/porter-dev/porter/internal/quota"
 	"github.com/porter-dev/porter/internal/repository"
 )
 
@@ -40,13 +43,18 @@ func (c *CreateClusterManualHandler) ServeHTTP(w http.ResponseWriter, r *http.Re
 		return
 	}
 
-	cluster, err := getClusterModelFromManualRequest(c.Repo(), proj, request)
+	if err := quota.CheckClusterQuota(r.Context(), c.Repo(), proj); err != nil {
+		handleClusterCreationError(c, w, r, err)
+		return
+	}
+
+	cluster, err := getClusterModelFromManualRequest(r.Context(), c.Repo(), proj, request)
 	if err != nil {
 		c.HandleAPIError(w, r, apierrors.NewErrInternal(err))
 		return
 	}
 
-	cluster, err = c.Repo().Cluster().CreateCluster(cluster)
+	cluster, err = c.Repo().Cluster().CreateCluster(r.Context(), cluster)
 
 	if err != nil {
 		c.HandleAPIError(w, r, apierrors.NewErrInternal(err))
@@ -56,7 +64,20 @@ func (c *CreateClusterManualHandler) ServeHTTP(w http.ResponseWriter, r *http.Re
 	c.WriteResult(w, r, cluster.ToClusterType())
 }
 
+// handleClusterCreationError writes a quota exceeded error if creating a cluster failed because the project cannot have another
+// cluster, and an internal error otherwise
+func handleClusterCreationError(c handlers.PorterHandler, w http.ResponseWriter, r *http.Request, err error) {
+	var quotaErr *quota.ExceededError
+	if errors.As(err, &quotaErr) {
+		handlers.HandleQuotaExceeded(c.Config(), w, r, quotaErr)
+		return
+	}
+
+	c.HandleAPIError(w, r, apierrors.NewErrInternal(err))
+}
+
 func getClusterModelFromManualRequest(
+	ctx context.Context,
 	repo repository.Repository,
 	project *models.Project,
 	request *types.CreateClusterManualRequest,
@@ -67,7 +88,7 @@ func getClusterModelFromManualRequest(
 		authMechanism = models.GCP
 
 		// check that the integration exists
-		_, err := repo.GCPIntegration().ReadGCPIntegration(project.ID, request.GCPIntegrationID)
+		_, err := repo.GCPIntegration().ReadGCPIntegration(ctx, project.ID, request.GCPIntegrationID)
 		if err != nil {
 			return nil, fmt.Errorf("gcp integration not found")
 		}
@@ -75,7 +96,7 @@ func getClusterModelFromManualRequest(
 		authMechanism = models.AWS
 
 		// check that the integration exists
-		_, err := repo.AWSIntegration().ReadAWSIntegration(project.ID, request.AWSIntegrationID)
+		_, err := repo.AWSIntegration().ReadAWSIntegration(ctx, project.ID, request.AWSIntegrationID)
 		if err != nil {
 			return nil, fmt.Errorf("aws integration not found")
 		}
@@ -112,14 +133,19 @@ func getClusterModelFromManualRequest(
 }
 
 func createClusterFromCandidate(
+	ctx context.Context,
 	repo repository.Repository,
 	project *models.Project,
 	user *models.User,
 	candidate *models.ClusterCandidate,
 	clResolver *types.ClusterResolverAll,
 ) (*models.Cluster, *models.ClusterCandidate, error) {
+	if err := quota.CheckClusterQuota(ctx, repo, project); err != nil {
+		return nil, nil, err
+	}
+
 	// we query the repo again to get the decrypted version of the cluster candidate
-	cc, err := repo.Cluster().ReadClusterCandidate(project.ID, candidate.ID)
+	cc, err := repo.Cluster().ReadClusterCandidate(ctx, project.ID, candidate.ID)
 	if err != nil {
 		return nil, nil, err
 	}
@@ -131,18 +157,18 @@ func createClusterFromCandidate(
 		UserID:             user.ID,
 	}
 
-	err = cResolver.ResolveIntegration(repo)
+	err = cResolver.ResolveIntegration(ctx, repo)
 
 	if err != nil {
 		return nil, nil, err
 	}
 
-	cluster, err := cResolver.ResolveCluster(repo)
+	cluster, err := cResolver.ResolveCluster(ctx, repo)
 	if err != nil {
 		return nil, nil, err
 	}
 
-	cc, err = repo.Cluster().UpdateClusterCandidateCreatedClusterID(cc.ID, cluster.ID)
+	cc, err = repo.Cluster().UpdateClusterCandidateCreatedClusterID(ctx, cc.ID, cluster.ID)
 
 	if err != nil {
 		return nil, nil, err
@@ -1,11 +1,12 @@
 package cluster
 
 import (
+	"context"
 	"net/http"
+	"time"
 
 	"github.com/porter-dev/porter/api/server/handlers"
 	"github.com/porter-dev/porter/api/server/shared"
-	"github.com/porter-dev/porter/api/server/shared/apierrors"
 	"github.com/porter-dev/porter/api/server/shared/config"
 	"github.com/porter-dev/porter/api/types"
 	"github.com/porter-dev/porter/internal/analytics"
@@ -39,24 +40,47 @@ func (c *CreateClusterCandidateHandler) ServeHTTP(w http.ResponseWriter, r *http
 		return
 	}
 
-	ccs, err := getClusterCandidateModelsFromRequest(c.Repo(), proj, request, c.Config().ServerConf.IsLocal)
+	res, err := createClusterCandidates(r.Context(), c.Config(), proj, user, request)
 	if err != nil {
-		c.HandleAPIError(w, r, apierrors.NewErrInternal(err))
+		handleClusterCreationError(c, w, r, err)
 		return
 	}
 
+	c.WriteResult(w, r, res)
+}
+
+// createClusterCandidates creates a cluster candidate for each context in the kubeconfig of the request. Candidates which do not
+// need to be resolved are converted to clusters immediately.
+func createClusterCandidates(
+	ctx context.Context,
+	conf *config.Config,
+	proj *models.Project,
+	user *models.User,
+	request *types.CreateClusterCandidateRequest,
+) (types.CreateClusterCandidateResponse, error) {
+	ccs, err := getClusterCandidateModelsFromRequest(conf.Repo, proj, request, conf.ServerConf.IsLocal)
+	if err != nil {
+		return nil, err
+	}
+
 	res := make(types.CreateClusterCandidateResponse, 0)
 
+	var expiresAt *time.Time
+	if conf.ServerConf.ClusterCandidateTTL > 0 {
+		expiry := time.Now().Add(conf.ServerConf.ClusterCandidateTTL)
+		expiresAt = &expiry
+	}
+
 	for _, cc := range ccs {
-		// handle write to the database
-		cc, err = c.Repo().Cluster().CreateClusterCandidate(cc)
+		cc.ExpiresAt = expiresAt
 
+		// handle write to the database
+		cc, err = conf.Repo.Cluster().CreateClusterCandidate(ctx, cc)
 		if err != nil {
-			c.HandleAPIError(w, r, apierrors.NewErrInternal(err))
-			return
+			return nil, err
 		}
 
-		c.Config().AnalyticsClient.Track(analytics.ClusterConnectionStartTrack(
+		conf.AnalyticsClient.Track(analytics.ClusterConnectionStartTrack(
 			&analytics.ClusterConnectionStartTrackOpts{
 				ProjectScopedTrackOpts: analytics.GetProjectScopedTrackOpts(user.ID, proj.ID),
 				ClusterCandidateID:     cc.ID,
@@ -67,14 +91,12 @@ func (c *CreateClusterCandidateHandler) ServeHTTP(w http.ResponseWriter, r *http
 		// automatically
 		if len(cc.Resolvers) == 0 {
 			var cluster *models.Cluster
-			cluster, cc, err = createClusterFromCandidate(c.Repo(), proj, user, cc, &types.ClusterResolverAll{})
-
+			cluster, cc, err = createClusterFromCandidate(ctx, conf.Repo, proj, user, cc, &types.ClusterResolverAll{})
 			if err != nil {
-				c.HandleAPIError(w, r, apierrors.NewErrInternal(err))
-				return
+				return nil, err
 			}
 
-			c.Config().AnalyticsClient.Track(analytics.ClusterConnectionSuccessTrack(
+			conf.AnalyticsClient.Track(analytics.ClusterConnectionSuccessTrack(
 				&analytics.ClusterConnectionSuccessTrackOpts{
 					ClusterScopedTrackOpts: analytics.GetClusterScopedTrackOpts(user.ID, proj.ID, cluster.ID),
 					ClusterCandidateID:     cc.ID,
@@ -85,7 +107,7 @@ func (c *CreateClusterCandidateHandler) ServeHTTP(w http.ResponseWriter, r *http
 		res = append(res, cc.ToClusterCandidateType())
 	}
 
-	c.WriteResult(w, r, res)
+	return res, nil
 }
 
 func getClusterCandidateModelsFromRequest(
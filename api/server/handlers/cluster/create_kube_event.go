@@ -0,0 +1,120 @@
+package cluster
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"time"
+
+	"go.opentelemetry.io/otel/trace"
+	"gorm.io/gorm"
+
+	"github.com/porter-dev/porter/api/server/handlers"
+	"github.com/porter-dev/porter/api/server/shared"
+	"github.com/porter-dev/porter/api/server/shared/apierrors"
+	"github.com/porter-dev/porter/api/server/shared/config"
+	"github.com/porter-dev/porter/api/types"
+	"github.com/porter-dev/porter/internal/eventsink"
+	"github.com/porter-dev/porter/internal/models"
+	"github.com/porter-dev/porter/internal/notifier/alert"
+	"github.com/porter-dev/porter/internal/telemetry"
+)
+
+// kubeEventGroupWindow is how long after its last update an event groups new occurrences for the same object
+const kubeEventGroupWindow = time.Hour
+
+// CreateKubeEventHandler records a kube event of a cluster, sends it to the alert rules of the project and
+// forwards it to the event sinks of the project
+type CreateKubeEventHandler struct {
+	handlers.PorterHandlerReadWriter
+}
+
+// NewCreateKubeEventHandler returns a new CreateKubeEventHandler
+func NewCreateKubeEventHandler(
+	config *config.Config,
+	decoderValidator shared.RequestDecoderValidator,
+	writer shared.ResultWriter,
+) *CreateKubeEventHandler {
+	return &CreateKubeEventHandler{
+		PorterHandlerReadWriter: handlers.NewDefaultPorterHandler(config, decoderValidator, writer),
+	}
+}
+
+// ServeHTTP appends the event to the recent event of the same object, or creates a new event if there is none
+func (c *CreateKubeEventHandler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	ctx, span := telemetry.NewSpan(r.Context(), "serve-create-kube-event")
+	defer span.End()
+
+	project, _ := ctx.Value(types.ProjectScope).(*models.Project)
+	cluster, _ := ctx.Value(types.ClusterScope).(*models.Cluster)
+
+	request := &types.CreateKubeEventRequest{}
+	if ok := c.DecodeAndValidate(w, r, request); !ok {
+		err := telemetry.Error(ctx, span, nil, "error decoding request")
+		c.HandleAPIError(w, r, apierrors.NewErrPassThroughToClient(err, http.StatusBadRequest))
+		return
+	}
+
+	telemetry.WithAttributes(span,
+		telemetry.AttributeKV{Key: "resource-type", Value: request.ResourceType},
+		telemetry.AttributeKV{Key: "name", Value: request.Name},
+		telemetry.AttributeKV{Key: "reason", Value: request.Reason},
+	)
+
+	event, err := c.Repo().KubeEvent().ReadEventByGroup(ctx, project.ID, cluster.ID, &types.GroupOptions{
+		ResourceType:  request.ResourceType,
+		Name:          request.Name,
+		Namespace:     request.Namespace,
+		ThresholdTime: time.Now().Add(-kubeEventGroupWindow),
+	})
+	if err != nil {
+		if !errors.Is(err, gorm.ErrRecordNotFound) {
+			err := telemetry.Error(ctx, span, err, "error reading kube event")
+			c.HandleAPIError(w, r, apierrors.NewErrPassThroughToClient(err, http.StatusInternalServerError))
+			return
+		}
+
+		event, err = c.Repo().KubeEvent().CreateEvent(ctx, &models.KubeEvent{
+			ProjectID:    project.ID,
+			ClusterID:    cluster.ID,
+			Name:         request.Name,
+			ResourceType: request.ResourceType,
+			OwnerType:    request.OwnerType,
+			OwnerName:    request.OwnerName,
+			Namespace:    request.Namespace,
+		})
+		if err != nil {
+			err := telemetry.Error(ctx, span, err, "error creating kube event")
+			c.HandleAPIError(w, r, apierrors.NewErrPassThroughToClient(err, http.StatusInternalServerError))
+			return
+		}
+	}
+
+	subEvent := &models.KubeSubEvent{
+		EventType: request.EventType,
+		Message:   request.Message,
+		Reason:    request.Reason,
+		Timestamp: request.Timestamp,
+	}
+
+	if err := c.Repo().KubeEvent().AppendSubEvent(ctx, event, subEvent); err != nil {
+		err := telemetry.Error(ctx, span, err, "error appending kube sub event")
+		c.HandleAPIError(w, r, apierrors.NewErrPassThroughToClient(err, http.StatusInternalServerError))
+		return
+	}
+
+	// the request context is canceled once the response is written, so alerts are sent and events are
+	// forwarded to sinks on a detached context
+	alertCtx := trace.ContextWithSpan(context.Background(), trace.SpanFromContext(ctx))
+
+	go func() {
+		_ = alert.NewEvaluator(c.Repo()).Evaluate(alertCtx, event, subEvent)
+	}()
+
+	go func() {
+		_ = eventsink.NewForwarder(c.Repo()).Forward(alertCtx, event, subEvent)
+	}()
+
+	w.WriteHeader(http.StatusCreated)
+	c.WriteResult(w, r, event.ToKubeEventType())
+}
@@ -3,7 +3,6 @@ package cluster
 import (
 	"fmt"
 	"net/http"
-	"time"
 
 	"github.com/porter-dev/porter/api/server/authz"
 	"github.com/porter-dev/porter/api/server/handlers"
@@ -12,6 +11,7 @@ import (
 	"github.com/porter-dev/porter/api/server/shared/config"
 	"github.com/porter-dev/porter/api/types"
 	"github.com/porter-dev/porter/internal/models"
+	v1 "k8s.io/api/core/v1"
 )
 
 type CreateNamespaceHandler struct {
@@ -37,6 +37,38 @@ func (c *CreateNamespaceHandler) ServeHTTP(w http.ResponseWriter, r *http.Reques
 		return
 	}
 
+	// roles may be restricted to some namespaces of the cluster
+	if !authz.HasNamespaceAccess(r.Context(), request.Name, types.APIVerbCreate) {
+		c.HandleAPIError(w, r, apierrors.NewErrForbidden(
+			fmt.Errorf("policy forbids creating namespace %s", request.Name),
+		))
+		return
+	}
+
+	var quotaHard v1.ResourceList
+
+	if request.ResourceQuota != nil {
+		hard, err := resourceQuotaHard(request.ResourceQuota)
+		if err != nil {
+			c.HandleAPIError(w, r, apierrors.NewErrPassThroughToClient(err, http.StatusBadRequest))
+			return
+		}
+
+		quotaHard = hard
+	}
+
+	var limits *v1.LimitRangeItem
+
+	if request.LimitRange != nil {
+		item, err := limitRangeItem(request.LimitRange)
+		if err != nil {
+			c.HandleAPIError(w, r, apierrors.NewErrPassThroughToClient(err, http.StatusBadRequest))
+			return
+		}
+
+		limits = &item
+	}
+
 	cluster, _ := r.Context().Value(types.ClusterScope).(*models.Cluster)
 
 	agent, err := c.GetAgent(r, cluster, "")
@@ -60,16 +92,33 @@ func (c *CreateNamespaceHandler) ServeHTTP(w http.ResponseWriter, r *http.Reques
 		return
 	}
 
-	res := &types.NamespaceResponse{
-		Name:              namespace.Name,
-		CreationTimestamp: namespace.CreationTimestamp.Time.UTC().Format(time.RFC1123),
-		Status:            string(namespace.Status.Phase),
+	var quota *v1.ResourceQuota
+
+	if quotaHard != nil {
+		quota, err = agent.ApplyNamespaceResourceQuota(namespace.Name, quotaHard)
+		if err != nil {
+			// do not leave behind a namespace without the requested quota
+			_ = agent.DeleteNamespace(namespace.Name)
+
+			c.HandleAPIError(w, r, apierrors.NewErrInternal(err))
+			return
+		}
 	}
 
-	if namespace.DeletionTimestamp != nil {
-		res.DeletionTimestamp = namespace.DeletionTimestamp.Time.UTC().Format(time.RFC1123)
+	var limitRange *v1.LimitRange
+
+	if limits != nil {
+		limitRange, err = agent.ApplyNamespaceLimitRange(namespace.Name, *limits)
+		if err != nil {
+			_ = agent.DeleteNamespace(namespace.Name)
+
+			c.HandleAPIError(w, r, apierrors.NewErrInternal(err))
+			return
+		}
 	}
 
+	res := toNamespaceResponse(namespace, quota, limitRange)
+
 	w.WriteHeader(http.StatusCreated)
 	c.WriteResult(w, r, res)
 }
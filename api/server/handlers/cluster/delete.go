@@ -70,7 +70,7 @@ func (c *ClusterDeleteHandler) ServeHTTP(w http.ResponseWriter, r *http.Request)
 		}
 	}
 
-	err := c.Repo().Cluster().DeleteCluster(cluster)
+	err := c.Repo().Cluster().DeleteCluster(ctx, cluster)
 	if err != nil {
 		c.HandleAPIError(w, r, apierrors.NewErrInternal(err))
 		return
@@ -0,0 +1,70 @@
+package cluster
+
+import (
+	"errors"
+	"net/http"
+
+	"github.com/porter-dev/porter/api/server/handlers"
+	"github.com/porter-dev/porter/api/server/shared"
+	"github.com/porter-dev/porter/api/server/shared/apierrors"
+	"github.com/porter-dev/porter/api/server/shared/config"
+	"github.com/porter-dev/porter/api/server/shared/requestutils"
+	"github.com/porter-dev/porter/api/types"
+	"github.com/porter-dev/porter/internal/models"
+	"github.com/porter-dev/porter/internal/telemetry"
+	"gorm.io/gorm"
+)
+
+// DeleteClusterCandidateHandler deletes a cluster candidate which is no longer needed
+type DeleteClusterCandidateHandler struct {
+	handlers.PorterHandlerWriter
+}
+
+// NewDeleteClusterCandidateHandler returns a new DeleteClusterCandidateHandler
+func NewDeleteClusterCandidateHandler(
+	config *config.Config,
+	writer shared.ResultWriter,
+) *DeleteClusterCandidateHandler {
+	return &DeleteClusterCandidateHandler{
+		PorterHandlerWriter: handlers.NewDefaultPorterHandler(config, nil, writer),
+	}
+}
+
+// ServeHTTP deletes the cluster candidate in the url. Clusters created from the candidate are not affected.
+func (c *DeleteClusterCandidateHandler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	ctx, span := telemetry.NewSpan(r.Context(), "serve-delete-cluster-candidate")
+	defer span.End()
+
+	proj, _ := ctx.Value(types.ProjectScope).(*models.Project)
+
+	ccID, reqErr := requestutils.GetURLParamUint(r, types.URLParamCandidateID)
+	if reqErr != nil {
+		err := telemetry.Error(ctx, span, reqErr, "error parsing candidate id")
+		c.HandleAPIError(w, r, apierrors.NewErrPassThroughToClient(err, http.StatusBadRequest))
+		return
+	}
+
+	telemetry.WithAttributes(span, telemetry.AttributeKV{Key: "candidate-id", Value: ccID})
+
+	cc, err := c.Repo().Cluster().ReadClusterCandidate(ctx, proj.ID, ccID)
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			err = telemetry.Error(ctx, span, err, "cluster candidate not found")
+			c.HandleAPIError(w, r, apierrors.NewErrNotFound(err))
+			return
+		}
+
+		err = telemetry.Error(ctx, span, err, "error reading cluster candidate")
+		c.HandleAPIError(w, r, apierrors.NewErrInternal(err))
+		return
+	}
+
+	err = c.Repo().Cluster().DeleteClusterCandidate(ctx, cc)
+	if err != nil {
+		err = telemetry.Error(ctx, span, err, "error deleting cluster candidate")
+		c.HandleAPIError(w, r, apierrors.NewErrInternal(err))
+		return
+	}
+
+	w.WriteHeader(http.StatusOK)
+}
@@ -0,0 +1,139 @@
+package cluster
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+
+	"github.com/porter-dev/porter/api/server/authz"
+	"github.com/porter-dev/porter/api/server/handlers"
+	"github.com/porter-dev/porter/api/server/shared"
+	"github.com/porter-dev/porter/api/server/shared/apierrors"
+	"github.com/porter-dev/porter/api/server/shared/config"
+	"github.com/porter-dev/porter/api/server/shared/requestutils"
+	"github.com/porter-dev/porter/api/types"
+	"github.com/porter-dev/porter/internal/models"
+	"github.com/porter-dev/porter/internal/telemetry"
+	"github.com/stefanmcshane/helm/pkg/chartutil"
+	"github.com/stefanmcshane/helm/pkg/storage/driver"
+)
+
+// GetHelmReleaseHandler gets a revision of any Helm release of a cluster with its values
+type GetHelmReleaseHandler struct {
+	handlers.PorterHandlerReadWriter
+	authz.KubernetesAgentGetter
+}
+
+// NewGetHelmReleaseHandler returns a new GetHelmReleaseHandler
+func NewGetHelmReleaseHandler(
+	config *config.Config,
+	decoderValidator shared.RequestDecoderValidator,
+	writer shared.ResultWriter,
+) *GetHelmReleaseHandler {
+	return &GetHelmReleaseHandler{
+		PorterHandlerReadWriter: handlers.NewDefaultPorterHandler(config, decoderValidator, writer),
+		KubernetesAgentGetter:   authz.NewOutOfClusterAgentGetter(config),
+	}
+}
+
+// ServeHTTP returns the requested revision of a release, or its latest revision, with the supplied or computed values
+func (c *GetHelmReleaseHandler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	ctx, span := telemetry.NewSpan(r.Context(), "serve-get-helm-release")
+	defer span.End()
+
+	cluster, _ := ctx.Value(types.ClusterScope).(*models.Cluster)
+
+	namespace, name, ok := readHelmReleaseParams(c, w, r)
+	if !ok {
+		return
+	}
+
+	request := &types.GetHelmReleaseRequest{}
+	if ok := c.DecodeAndValidate(w, r, request); !ok {
+		err := telemetry.Error(ctx, span, nil, "error decoding request")
+		c.HandleAPIError(w, r, apierrors.NewErrPassThroughToClient(err, http.StatusBadRequest))
+		return
+	}
+
+	telemetry.WithAttributes(span,
+		telemetry.AttributeKV{Key: "cluster-id", Value: cluster.ID},
+		telemetry.AttributeKV{Key: "namespace", Value: namespace},
+		telemetry.AttributeKV{Key: "release-name", Value: name},
+		telemetry.AttributeKV{Key: "revision", Value: request.Revision},
+	)
+
+	helmAgent, err := c.GetHelmAgent(ctx, r, cluster, namespace)
+	if err != nil {
+		err := telemetry.Error(ctx, span, err, "error getting helm agent")
+		c.HandleAPIError(w, r, apierrors.NewErrPassThroughToClient(err, http.StatusInternalServerError))
+		return
+	}
+
+	rel, err := helmAgent.GetRelease(ctx, name, request.Revision, false)
+	if err != nil {
+		if isHelmReleaseNotFound(err) {
+			err := telemetry.Error(ctx, span, err, "helm release not found")
+			c.HandleAPIError(w, r, apierrors.NewErrPassThroughToClient(err, http.StatusNotFound))
+			return
+		}
+
+		err := telemetry.Error(ctx, span, err, "error getting helm release")
+		c.HandleAPIError(w, r, apierrors.NewErrPassThroughToClient(err, http.StatusInternalServerError))
+		return
+	}
+
+	values := rel.Config
+
+	if request.AllValues && rel.Chart != nil {
+		values, err = chartutil.CoalesceValues(rel.Chart, rel.Config)
+		if err != nil {
+			err := telemetry.Error(ctx, span, err, "error computing helm release values")
+			c.HandleAPIError(w, r, apierrors.NewErrPassThroughToClient(err, http.StatusInternalServerError))
+			return
+		}
+	}
+
+	if values == nil {
+		values = map[string]interface{}{}
+	}
+
+	res := &types.GetHelmReleaseResponse{
+		Release: toHelmReleaseSummary(rel),
+		Values:  values,
+	}
+
+	if rel.Info != nil {
+		res.Notes = rel.Info.Notes
+	}
+
+	c.WriteResult(w, r, res)
+}
+
+// readHelmReleaseParams reads the namespace and name of a release from the url, and checks that the user may read the namespace
+func readHelmReleaseParams(c handlers.PorterHandler, w http.ResponseWriter, r *http.Request) (string, string, bool) {
+	namespace, reqErr := requestutils.GetURLParamString(r, types.URLParamNamespace)
+	if reqErr != nil {
+		c.HandleAPIError(w, r, reqErr)
+		return "", "", false
+	}
+
+	name, reqErr := requestutils.GetURLParamString(r, types.URLParamReleaseName)
+	if reqErr != nil {
+		c.HandleAPIError(w, r, reqErr)
+		return "", "", false
+	}
+
+	// roles may be restricted to some namespaces of the cluster
+	if !authz.HasNamespaceAccess(r.Context(), namespace, types.APIVerbGet) {
+		c.HandleAPIError(w, r, apierrors.NewErrForbidden(
+			fmt.Errorf("policy forbids reading releases in namespace %s", namespace),
+		))
+		return "", "", false
+	}
+
+	return namespace, name, true
+}
+
+func isHelmReleaseNotFound(err error) bool {
+	return errors.Is(err, driver.ErrReleaseNotFound)
+}
@@ -0,0 +1,85 @@
+package cluster
+
+import (
+	"net/http"
+	"sort"
+
+	"github.com/porter-dev/porter/api/server/authz"
+	"github.com/porter-dev/porter/api/server/handlers"
+	"github.com/porter-dev/porter/api/server/shared"
+	"github.com/porter-dev/porter/api/server/shared/apierrors"
+	"github.com/porter-dev/porter/api/server/shared/config"
+	"github.com/porter-dev/porter/api/types"
+	"github.com/porter-dev/porter/internal/models"
+	"github.com/porter-dev/porter/internal/telemetry"
+)
+
+// GetHelmReleaseHistoryHandler lists the stored revisions of any Helm release of a cluster
+type GetHelmReleaseHistoryHandler struct {
+	handlers.PorterHandlerWriter
+	authz.KubernetesAgentGetter
+}
+
+// NewGetHelmReleaseHistoryHandler returns a new GetHelmReleaseHistoryHandler
+func NewGetHelmReleaseHistoryHandler(
+	config *config.Config,
+	writer shared.ResultWriter,
+) *GetHelmReleaseHistoryHandler {
+	return &GetHelmReleaseHistoryHandler{
+		PorterHandlerWriter:   handlers.NewDefaultPorterHandler(config, nil, writer),
+		KubernetesAgentGetter: authz.NewOutOfClusterAgentGetter(config),
+	}
+}
+
+// ServeHTTP returns the revisions of a release, newest first
+func (c *GetHelmReleaseHistoryHandler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	ctx, span := telemetry.NewSpan(r.Context(), "serve-get-helm-release-history")
+	defer span.End()
+
+	cluster, _ := ctx.Value(types.ClusterScope).(*models.Cluster)
+
+	namespace, name, ok := readHelmReleaseParams(c, w, r)
+	if !ok {
+		return
+	}
+
+	telemetry.WithAttributes(span,
+		telemetry.AttributeKV{Key: "cluster-id", Value: cluster.ID},
+		telemetry.AttributeKV{Key: "namespace", Value: namespace},
+		telemetry.AttributeKV{Key: "release-name", Value: name},
+	)
+
+	helmAgent, err := c.GetHelmAgent(ctx, r, cluster, namespace)
+	if err != nil {
+		err := telemetry.Error(ctx, span, err, "error getting helm agent")
+		c.HandleAPIError(w, r, apierrors.NewErrPassThroughToClient(err, http.StatusInternalServerError))
+		return
+	}
+
+	history, err := helmAgent.GetReleaseHistory(ctx, name)
+	if err != nil {
+		if isHelmReleaseNotFound(err) {
+			err := telemetry.Error(ctx, span, err, "helm release not found")
+			c.HandleAPIError(w, r, apierrors.NewErrPassThroughToClient(err, http.StatusNotFound))
+			return
+		}
+
+		err := telemetry.Error(ctx, span, err, "error getting helm release history")
+		c.HandleAPIError(w, r, apierrors.NewErrPassThroughToClient(err, http.StatusInternalServerError))
+		return
+	}
+
+	res := &types.GetHelmReleaseHistoryResponse{
+		Revisions: make([]types.HelmReleaseSummary, 0, len(history)),
+	}
+
+	for _, rel := range history {
+		res.Revisions = append(res.Revisions, toHelmReleaseSummary(rel))
+	}
+
+	sort.Slice(res.Revisions, func(i, j int) bool {
+		return res.Revisions[i].Revision > res.Revisions[j].Revision
+	})
+
+	c.WriteResult(w, r, res)
+}
@@ -2,7 +2,6 @@ package cluster
 
 import (
 	"net/http"
-	"time"
 
 	"github.com/porter-dev/porter/api/server/authz"
 	"github.com/porter-dev/porter/api/server/handlers"
@@ -56,15 +55,19 @@ func (c *GetNamespaceHandler) ServeHTTP(w http.ResponseWriter, r *http.Request)
 		return
 	}
 
-	res := &types.NamespaceResponse{
-		Name:              namespace.Name,
-		CreationTimestamp: namespace.CreationTimestamp.Time.UTC().Format(time.RFC1123),
-		Status:            string(namespace.Status.Phase),
+	quotas, err := agent.ListNamespaceResourceQuotas()
+	if err != nil {
+		c.HandleAPIError(w, r, apierrors.NewErrInternal(err))
+		return
 	}
 
-	if namespace.DeletionTimestamp != nil {
-		res.DeletionTimestamp = namespace.DeletionTimestamp.Time.UTC().Format(time.RFC1123)
+	limitRanges, err := agent.ListNamespaceLimitRanges()
+	if err != nil {
+		c.HandleAPIError(w, r, apierrors.NewErrInternal(err))
+		return
 	}
 
+	res := toNamespaceResponse(namespace, quotas[namespace.Name], limitRanges[namespace.Name])
+
 	c.WriteResult(w, r, res)
 }
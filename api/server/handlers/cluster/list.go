@@ -1,14 +1,17 @@
 package cluster
 
 import (
+	"context"
 	"net/http"
 
+	"github.com/porter-dev/porter/api/server/authz"
 	"github.com/porter-dev/porter/api/server/handlers"
 	"github.com/porter-dev/porter/api/server/shared"
 	"github.com/porter-dev/porter/api/server/shared/apierrors"
 	"github.com/porter-dev/porter/api/server/shared/config"
 	"github.com/porter-dev/porter/api/types"
 	"github.com/porter-dev/porter/internal/models"
+	"github.com/porter-dev/porter/internal/repository"
 )
 
 type ClusterListHandler struct {
@@ -28,18 +31,32 @@ func (p *ClusterListHandler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
 	// read the project from context
 	proj, _ := r.Context().Value(types.ProjectScope).(*models.Project)
 
-	// read all clusters for this project
-	clusters, err := p.Repo().Cluster().ListClustersByProjectID(proj.ID)
+	res, err := ListClusters(r.Context(), p.Repo(), proj)
 	if err != nil {
 		p.HandleAPIError(w, r, apierrors.NewErrInternal(err))
 		return
 	}
 
-	res := make(types.ListClusterResponse, len(clusters))
+	p.WriteResult(w, r, res)
+}
 
-	for i, cluster := range clusters {
-		res[i] = cluster.ToClusterType()
+// ListClusters returns the clusters of a project which the policy in the context permits access to
+func ListClusters(ctx context.Context, repo repository.Repository, proj *models.Project) (types.ListClusterResponse, error) {
+	clusters, err := repo.Cluster().ListClustersByProjectID(ctx, proj.ID)
+	if err != nil {
+		return nil, err
 	}
 
-	p.WriteResult(w, r, res)
+	res := make(types.ListClusterResponse, 0, len(clusters))
+
+	for _, cluster := range clusters {
+		// roles may be restricted to some clusters of the project
+		if !authz.HasClusterAccess(ctx, cluster.ID) {
+			continue
+		}
+
+		res = append(res, cluster.ToClusterType())
+	}
+
+	return res, nil
 }
@@ -27,7 +27,7 @@ func NewListClusterCandidatesHandler(
 func (c *ListClusterCandidatesHandler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
 	proj, _ := r.Context().Value(types.ProjectScope).(*models.Project)
 
-	ccs, err := c.Repo().Cluster().ListClusterCandidatesByProjectID(proj.ID)
+	ccs, err := c.Repo().Cluster().ListClusterCandidatesByProjectID(r.Context(), proj.ID)
 	if err != nil {
 		c.HandleAPIError(w, r, apierrors.NewErrInternal(err))
 		return
@@ -0,0 +1,130 @@
+package cluster
+
+import (
+	"net/http"
+	"sort"
+
+	"github.com/porter-dev/porter/api/server/authz"
+	"github.com/porter-dev/porter/api/server/handlers"
+	"github.com/porter-dev/porter/api/server/shared"
+	"github.com/porter-dev/porter/api/server/shared/apierrors"
+	"github.com/porter-dev/porter/api/server/shared/config"
+	"github.com/porter-dev/porter/api/types"
+	"github.com/porter-dev/porter/internal/models"
+	"github.com/porter-dev/porter/internal/telemetry"
+	"github.com/stefanmcshane/helm/pkg/release"
+)
+
+// defaultHelmReleaseStatuses are the statuses listed when a request does not filter by status. Superseded
+// revisions are never the latest revision of a release, so they are left out.
+var defaultHelmReleaseStatuses = []string{
+	string(release.StatusDeployed),
+	string(release.StatusFailed),
+	string(release.StatusUninstalling),
+	string(release.StatusPendingInstall),
+	string(release.StatusPendingUpgrade),
+	string(release.StatusPendingRollback),
+}
+
+// ListHelmReleasesHandler lists the Helm releases of a cluster, including releases which are not managed by Porter
+type ListHelmReleasesHandler struct {
+	handlers.PorterHandlerReadWriter
+	authz.KubernetesAgentGetter
+}
+
+// NewListHelmReleasesHandler returns a new ListHelmReleasesHandler
+func NewListHelmReleasesHandler(
+	config *config.Config,
+	decoderValidator shared.RequestDecoderValidator,
+	writer shared.ResultWriter,
+) *ListHelmReleasesHandler {
+	return &ListHelmReleasesHandler{
+		PorterHandlerReadWriter: handlers.NewDefaultPorterHandler(config, decoderValidator, writer),
+		KubernetesAgentGetter:   authz.NewOutOfClusterAgentGetter(config),
+	}
+}
+
+// ServeHTTP lists the latest revision of each Helm release in the namespaces the user has access to
+func (c *ListHelmReleasesHandler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	ctx, span := telemetry.NewSpan(r.Context(), "serve-list-helm-releases")
+	defer span.End()
+
+	cluster, _ := ctx.Value(types.ClusterScope).(*models.Cluster)
+
+	request := &types.ListHelmReleasesRequest{}
+	if ok := c.DecodeAndValidate(w, r, request); !ok {
+		err := telemetry.Error(ctx, span, nil, "error decoding request")
+		c.HandleAPIError(w, r, apierrors.NewErrPassThroughToClient(err, http.StatusBadRequest))
+		return
+	}
+
+	telemetry.WithAttributes(span,
+		telemetry.AttributeKV{Key: "project-id", Value: cluster.ProjectID},
+		telemetry.AttributeKV{Key: "cluster-id", Value: cluster.ID},
+		telemetry.AttributeKV{Key: "namespace", Value: request.Namespace},
+	)
+
+	statuses := request.Statuses
+	if len(statuses) == 0 {
+		statuses = defaultHelmReleaseStatuses
+	}
+
+	helmAgent, err := c.GetHelmAgent(ctx, r, cluster, request.Namespace)
+	if err != nil {
+		err := telemetry.Error(ctx, span, err, "error getting helm agent")
+		c.HandleAPIError(w, r, apierrors.NewErrPassThroughToClient(err, http.StatusInternalServerError))
+		return
+	}
+
+	releases, err := helmAgent.ListReleases(ctx, request.Namespace, &types.ReleaseListFilter{StatusFilter: statuses})
+	if err != nil {
+		err := telemetry.Error(ctx, span, err, "error listing helm releases")
+		c.HandleAPIError(w, r, apierrors.NewErrPassThroughToClient(err, http.StatusInternalServerError))
+		return
+	}
+
+	res := &types.ListHelmReleasesResponse{
+		Releases: make([]types.HelmReleaseSummary, 0, len(releases)),
+	}
+
+	for _, rel := range releases {
+		// roles may be restricted to some namespaces of the cluster
+		if !authz.HasNamespaceAccess(ctx, rel.Namespace, types.APIVerbGet) {
+			continue
+		}
+
+		res.Releases = append(res.Releases, toHelmReleaseSummary(rel))
+	}
+
+	sort.Slice(res.Releases, func(i, j int) bool {
+		if res.Releases[i].Namespace != res.Releases[j].Namespace {
+			return res.Releases[i].Namespace < res.Releases[j].Namespace
+		}
+
+		return res.Releases[i].Name < res.Releases[j].Name
+	})
+
+	c.WriteResult(w, r, res)
+}
+
+func toHelmReleaseSummary(rel *release.Release) types.HelmReleaseSummary {
+	summary := types.HelmReleaseSummary{
+		Name:      rel.Name,
+		Namespace: rel.Namespace,
+		Revision:  rel.Version,
+	}
+
+	if rel.Info != nil {
+		summary.Status = rel.Info.Status.String()
+		summary.Description = rel.Info.Description
+		summary.UpdatedAt = rel.Info.LastDeployed.Time
+	}
+
+	if rel.Chart != nil && rel.Chart.Metadata != nil {
+		summary.Chart = rel.Chart.Metadata.Name
+		summary.ChartVersion = rel.Chart.Metadata.Version
+		summary.AppVersion = rel.Chart.Metadata.AppVersion
+	}
+
+	return summary
+}
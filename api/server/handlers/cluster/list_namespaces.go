@@ -2,7 +2,6 @@ package cluster
 
 import (
 	"net/http"
-	"time"
 
 	"github.com/porter-dev/porter/api/server/authz"
 	"github.com/porter-dev/porter/api/server/handlers"
@@ -43,18 +42,27 @@ func (c *ListNamespacesHandler) ServeHTTP(w http.ResponseWriter, r *http.Request
 		return
 	}
 
+	quotas, err := agent.ListNamespaceResourceQuotas()
+	if err != nil {
+		c.HandleAPIError(w, r, apierrors.NewErrInternal(err))
+		return
+	}
+
+	limitRanges, err := agent.ListNamespaceLimitRanges()
+	if err != nil {
+		c.HandleAPIError(w, r, apierrors.NewErrInternal(err))
+		return
+	}
+
 	res := types.ListNamespacesResponse{}
 
-	for _, ns := range namespaceList.Items {
-		namespace := &types.NamespaceResponse{
-			Name:              ns.Name,
-			CreationTimestamp: ns.CreationTimestamp.Time.UTC().Format(time.RFC1123),
-			Status:            string(ns.Status.Phase),
+	for i, ns := range namespaceList.Items {
+		// roles may be restricted to some namespaces of the cluster
+		if !authz.HasNamespaceAccess(r.Context(), ns.Name, types.APIVerbGet) {
+			continue
 		}
 
-		if ns.DeletionTimestamp != nil {
-			namespace.DeletionTimestamp = ns.DeletionTimestamp.Time.UTC().Format(time.RFC1123)
-		}
+		namespace := toNamespaceResponse(&namespaceList.Items[i], quotas[ns.Name], limitRanges[ns.Name])
 
 		res = append(res, namespace)
 	}
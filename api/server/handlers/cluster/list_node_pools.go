@@ -0,0 +1,76 @@
+package cluster
+
+import (
+	"net/http"
+
+	"github.com/porter-dev/porter/api/server/authz"
+	"github.com/porter-dev/porter/api/server/handlers"
+	"github.com/porter-dev/porter/api/server/shared"
+	"github.com/porter-dev/porter/api/server/shared/apierrors"
+	"github.com/porter-dev/porter/api/server/shared/config"
+	"github.com/porter-dev/porter/api/types"
+	"github.com/porter-dev/porter/internal/kubernetes/nodes"
+	"github.com/porter-dev/porter/internal/models"
+	"github.com/porter-dev/porter/internal/telemetry"
+)
+
+// ListNodePoolsHandler lists the node pools of a cluster, with the allocatable and requested resources of their nodes
+type ListNodePoolsHandler struct {
+	handlers.PorterHandlerWriter
+	authz.KubernetesAgentGetter
+}
+
+// NewListNodePoolsHandler returns a new ListNodePoolsHandler
+func NewListNodePoolsHandler(
+	config *config.Config,
+	writer shared.ResultWriter,
+) *ListNodePoolsHandler {
+	return &ListNodePoolsHandler{
+		PorterHandlerWriter:   handlers.NewDefaultPorterHandler(config, nil, writer),
+		KubernetesAgentGetter: authz.NewOutOfClusterAgentGetter(config),
+	}
+}
+
+// ServeHTTP groups the nodes of a cluster into node pools. For clusters provisioned by Porter, the pools declared in the latest
+// contract of the cluster are listed with their autoscaling bounds, including pools which have scaled to zero nodes.
+func (c *ListNodePoolsHandler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	ctx, span := telemetry.NewSpan(r.Context(), "serve-list-node-pools")
+	defer span.End()
+
+	cluster, _ := ctx.Value(types.ClusterScope).(*models.Cluster)
+
+	telemetry.WithAttributes(span,
+		telemetry.AttributeKV{Key: "project-id", Value: cluster.ProjectID},
+		telemetry.AttributeKV{Key: "cluster-id", Value: cluster.ID},
+	)
+
+	var declared []*nodes.NodePool
+
+	revisions, err := c.Repo().APIContractRevisioner().List(ctx, cluster.ProjectID, cluster.ID)
+	if err != nil {
+		err := telemetry.Error(ctx, span, err, "error listing cluster contract revisions")
+		c.HandleAPIError(w, r, apierrors.NewErrPassThroughToClient(err, http.StatusInternalServerError))
+		return
+	}
+
+	// revisions are sorted by most recent first
+	if len(revisions) > 0 {
+		contract, err := revisions[0].Contract()
+		if err != nil {
+			err := telemetry.Error(ctx, span, err, "error reading cluster contract")
+			c.HandleAPIError(w, r, apierrors.NewErrPassThroughToClient(err, http.StatusInternalServerError))
+			return
+		}
+
+		declared = nodes.NodePoolsFromContract(contract)
+	}
+
+	agent, err := c.GetAgent(r, cluster, "")
+	if err != nil {
+		err := telemetry.Error(ctx, span, err, "error getting kubernetes agent")
+		c.HandleAPIError(w, r, apierrors.NewErrPassThroughToClient(err, http.StatusInternalServerError))
+		return
+	}
+
+	c.WriteResult(w, r, nodes.GroupNodePools(nodes.GetNodesUsage(agent.Clientset), declared))
+}
@@ -0,0 +1,147 @@
+package cluster
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/porter-dev/porter/api/types"
+	v1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
+)
+
+// toNamespaceResponse converts a namespace and the quota objects Porter manages in it, which
+// may be nil, to the api type
+func toNamespaceResponse(ns *v1.Namespace, quota *v1.ResourceQuota, limitRange *v1.LimitRange) *types.NamespaceResponse {
+	res := &types.NamespaceResponse{
+		Name:              ns.Name,
+		CreationTimestamp: ns.CreationTimestamp.Time.UTC().Format(time.RFC1123),
+		Status:            string(ns.Status.Phase),
+		Labels:            ns.Labels,
+	}
+
+	if ns.DeletionTimestamp != nil {
+		res.DeletionTimestamp = ns.DeletionTimestamp.Time.UTC().Format(time.RFC1123)
+	}
+
+	if quota != nil {
+		hard := quota.Spec.Hard
+
+		res.ResourceQuota = &types.NamespaceResourceQuota{
+			RequestsCPU:    quantityString(hard, v1.ResourceRequestsCPU),
+			RequestsMemory: quantityString(hard, v1.ResourceRequestsMemory),
+			LimitsCPU:      quantityString(hard, v1.ResourceLimitsCPU),
+			LimitsMemory:   quantityString(hard, v1.ResourceLimitsMemory),
+			Pods:           quantityString(hard, v1.ResourcePods),
+		}
+	}
+
+	if limitRange != nil {
+		for _, item := range limitRange.Spec.Limits {
+			if item.Type != v1.LimitTypeContainer {
+				continue
+			}
+
+			res.LimitRange = &types.NamespaceLimitRange{
+				DefaultRequestCPU:    quantityString(item.DefaultRequest, v1.ResourceCPU),
+				DefaultRequestMemory: quantityString(item.DefaultRequest, v1.ResourceMemory),
+				DefaultLimitCPU:      quantityString(item.Default, v1.ResourceCPU),
+				DefaultLimitMemory:   quantityString(item.Default, v1.ResourceMemory),
+				MaxCPU:               quantityString(item.Max, v1.ResourceCPU),
+				MaxMemory:            quantityString(item.Max, v1.ResourceMemory),
+			}
+
+			break
+		}
+	}
+
+	return res
+}
+
+// resourceQuotaHard converts a resource quota template to the hard limits of a ResourceQuota
+func resourceQuotaHard(quota *types.NamespaceResourceQuota) (v1.ResourceList, error) {
+	hard := v1.ResourceList{}
+
+	quantities := map[v1.ResourceName]string{
+		v1.ResourceRequestsCPU:    quota.RequestsCPU,
+		v1.ResourceRequestsMemory: quota.RequestsMemory,
+		v1.ResourceLimitsCPU:      quota.LimitsCPU,
+		v1.ResourceLimitsMemory:   quota.LimitsMemory,
+		v1.ResourcePods:           quota.Pods,
+	}
+
+	if err := addQuantities(hard, quantities); err != nil {
+		return nil, err
+	}
+
+	if len(hard) == 0 {
+		return nil, fmt.Errorf("resource quota must set at least one limit")
+	}
+
+	return hard, nil
+}
+
+// limitRangeItem converts a limit range template to the container limits of a LimitRange
+func limitRangeItem(limitRange *types.NamespaceLimitRange) (v1.LimitRangeItem, error) {
+	item := v1.LimitRangeItem{
+		Type:           v1.LimitTypeContainer,
+		DefaultRequest: v1.ResourceList{},
+		Default:        v1.ResourceList{},
+		Max:            v1.ResourceList{},
+	}
+
+	err := addQuantities(item.DefaultRequest, map[v1.ResourceName]string{
+		v1.ResourceCPU:    limitRange.DefaultRequestCPU,
+		v1.ResourceMemory: limitRange.DefaultRequestMemory,
+	})
+	if err != nil {
+		return item, err
+	}
+
+	err = addQuantities(item.Default, map[v1.ResourceName]string{
+		v1.ResourceCPU:    limitRange.DefaultLimitCPU,
+		v1.ResourceMemory: limitRange.DefaultLimitMemory,
+	})
+	if err != nil {
+		return item, err
+	}
+
+	err = addQuantities(item.Max, map[v1.ResourceName]string{
+		v1.ResourceCPU:    limitRange.MaxCPU,
+		v1.ResourceMemory: limitRange.MaxMemory,
+	})
+	if err != nil {
+		return item, err
+	}
+
+	if len(item.DefaultRequest) == 0 && len(item.Default) == 0 && len(item.Max) == 0 {
+		return item, fmt.Errorf("limit range must set at least one limit")
+	}
+
+	return item, nil
+}
+
+// addQuantities parses the non-empty quantities and adds them to the resource list
+func addQuantities(list v1.ResourceList, quantities map[v1.ResourceName]string) error {
+	for name, value := range quantities {
+		if value == "" {
+			continue
+		}
+
+		quantity, err := resource.ParseQuantity(value)
+		if err != nil {
+			return fmt.Errorf("invalid quantity %q for %s: %w", value, name, err)
+		}
+
+		list[name] = quantity
+	}
+
+	return nil
+}
+
+func quantityString(list v1.ResourceList, name v1.ResourceName) string {
+	if quantity, ok := list[name]; ok {
+		return quantity.String()
+	}
+
+	return ""
+}
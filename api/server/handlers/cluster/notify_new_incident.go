@@ -1,6 +1,7 @@
 package cluster
 
 import (
+	"context"
 	"errors"
 	"fmt"
 	"net/http"
@@ -45,9 +46,9 @@ func (c *NotifyNewIncidentHandler) ServeHTTP(w http.ResponseWriter, r *http.Requ
 		return
 	}
 
-	slackInts, _ := c.Repo().SlackIntegration().ListSlackIntegrationsByProjectID(cluster.ProjectID)
+	slackInts, _ := c.Repo().SlackIntegration().ListSlackIntegrationsByProjectID(r.Context(), cluster.ProjectID)
 
-	rel, err := c.Repo().Release().ReadRelease(cluster.ID, request.ReleaseName, request.ReleaseNamespace)
+	rel, err := c.Repo().Release().ReadRelease(r.Context(), cluster.ID, request.ReleaseName, request.ReleaseNamespace)
 
 	if err != nil && !errors.Is(err, gorm.ErrRecordNotFound) {
 		c.HandleAPIError(w, r, apierrors.NewErrInternal(err))
@@ -57,7 +58,7 @@ func (c *NotifyNewIncidentHandler) ServeHTTP(w http.ResponseWriter, r *http.Requ
 	var notifConf *types.NotificationConfig
 
 	if rel != nil && rel.NotificationConfig != 0 {
-		conf, err := c.Repo().NotificationConfig().ReadNotificationConfig(rel.NotificationConfig)
+		conf, err := c.Repo().NotificationConfig().ReadNotificationConfig(r.Context(), rel.NotificationConfig)
 		if err != nil {
 			c.HandleAPIError(w, r, apierrors.NewErrInternal(err))
 			return
@@ -66,7 +67,7 @@ func (c *NotifyNewIncidentHandler) ServeHTTP(w http.ResponseWriter, r *http.Requ
 		notifConf = conf.ToNotificationConfigType()
 	}
 
-	users, err := getUsersByProjectID(c.Repo(), cluster.ProjectID)
+	users, err := getUsersByProjectID(r.Context(), c.Repo(), cluster.ProjectID)
 	if err != nil {
 		c.HandleAPIError(w, r, apierrors.NewErrInternal(err))
 		return
@@ -124,8 +125,8 @@ func (c *NotifyNewIncidentHandler) ServeHTTP(w http.ResponseWriter, r *http.Requ
 	}
 }
 
-func getUsersByProjectID(repo repository.Repository, projectID uint) ([]*models.User, error) {
-	roles, err := repo.Project().ListProjectRoles(projectID)
+func getUsersByProjectID(ctx context.Context, repo repository.Repository, projectID uint) ([]*models.User, error) {
+	roles, err := repo.Project().ListProjectRoles(ctx, projectID)
 	if err != nil {
 		return nil, err
 	}
@@ -139,5 +140,5 @@ func getUsersByProjectID(repo repository.Repository, projectID uint) ([]*models.
 		idArr = append(idArr, role.UserID)
 	}
 
-	return repo.User().ListUsersByIDs(idArr)
+	return repo.User().ListUsersByIDs(ctx, idArr)
 }
@@ -44,9 +44,9 @@ func (c *NotifyResolvedIncidentHandler) ServeHTTP(w http.ResponseWriter, r *http
 		return
 	}
 
-	slackInts, _ := c.Repo().SlackIntegration().ListSlackIntegrationsByProjectID(cluster.ProjectID)
+	slackInts, _ := c.Repo().SlackIntegration().ListSlackIntegrationsByProjectID(r.Context(), cluster.ProjectID)
 
-	rel, err := c.Repo().Release().ReadRelease(cluster.ID, request.ReleaseName, request.ReleaseNamespace)
+	rel, err := c.Repo().Release().ReadRelease(r.Context(), cluster.ID, request.ReleaseName, request.ReleaseNamespace)
 
 	if err != nil && !errors.Is(err, gorm.ErrRecordNotFound) {
 		c.HandleAPIError(w, r, apierrors.NewErrInternal(err))
@@ -56,7 +56,7 @@ func (c *NotifyResolvedIncidentHandler) ServeHTTP(w http.ResponseWriter, r *http
 	var notifConf *types.NotificationConfig
 
 	if rel != nil && rel.NotificationConfig != 0 {
-		conf, err := c.Repo().NotificationConfig().ReadNotificationConfig(rel.NotificationConfig)
+		conf, err := c.Repo().NotificationConfig().ReadNotificationConfig(r.Context(), rel.NotificationConfig)
 		if err != nil {
 			c.HandleAPIError(w, r, apierrors.NewErrInternal(err))
 			return
@@ -72,7 +72,7 @@ func (c *NotifyResolvedIncidentHandler) ServeHTTP(w http.ResponseWriter, r *http
 	}
 
 	if sc := c.Config().ServerConf; sc.SendgridAPIKey != "" && sc.SendgridSenderEmail != "" && sc.SendgridIncidentAlertTemplateID != "" {
-		users, err := getUsersByProjectID(c.Repo(), cluster.ProjectID)
+		users, err := getUsersByProjectID(r.Context(), c.Repo(), cluster.ProjectID)
 		if err != nil {
 			c.HandleAPIError(w, r, apierrors.NewErrInternal(err))
 			return
@@ -39,15 +39,15 @@ func (c *ResolveClusterCandidateHandler) ServeHTTP(w http.ResponseWriter, r *htt
 		return
 	}
 
-	cc, err := c.Repo().Cluster().ReadClusterCandidate(proj.ID, ccID)
+	cc, err := c.Repo().Cluster().ReadClusterCandidate(r.Context(), proj.ID, ccID)
 	if err != nil {
 		c.HandleAPIError(w, r, apierrors.NewErrInternal(err))
 		return
 	}
 
-	cluster, cc, err := createClusterFromCandidate(c.Repo(), proj, user, cc, request)
+	cluster, cc, err := createClusterFromCandidate(r.Context(), c.Repo(), proj, user, cc, request)
 	if err != nil {
-		c.HandleAPIError(w, r, apierrors.NewErrInternal(err))
+		handleClusterCreationError(c, w, r, err)
 		return
 	}
 
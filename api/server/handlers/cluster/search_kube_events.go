@@ -0,0 +1,67 @@
+package cluster
+
+import (
+	"net/http"
+
+	"github.com/porter-dev/porter/api/server/handlers"
+	"github.com/porter-dev/porter/api/server/shared"
+	"github.com/porter-dev/porter/api/server/shared/apierrors"
+	"github.com/porter-dev/porter/api/server/shared/config"
+	"github.com/porter-dev/porter/api/types"
+	"github.com/porter-dev/porter/internal/models"
+	"github.com/porter-dev/porter/internal/telemetry"
+)
+
+// SearchKubeEventsHandler searches the kube events of a cluster
+type SearchKubeEventsHandler struct {
+	handlers.PorterHandlerReadWriter
+}
+
+// NewSearchKubeEventsHandler returns a new SearchKubeEventsHandler
+func NewSearchKubeEventsHandler(
+	config *config.Config,
+	decoderValidator shared.RequestDecoderValidator,
+	writer shared.ResultWriter,
+) *SearchKubeEventsHandler {
+	return &SearchKubeEventsHandler{
+		PorterHandlerReadWriter: handlers.NewDefaultPorterHandler(config, decoderValidator, writer),
+	}
+}
+
+// ServeHTTP returns the kube events whose name, or the message or reason of one of their sub events, matches a query
+func (c *SearchKubeEventsHandler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	ctx, span := telemetry.NewSpan(r.Context(), "serve-search-kube-events")
+	defer span.End()
+
+	project, _ := ctx.Value(types.ProjectScope).(*models.Project)
+	cluster, _ := ctx.Value(types.ClusterScope).(*models.Cluster)
+
+	request := &types.SearchKubeEventsRequest{}
+	if ok := c.DecodeAndValidate(w, r, request); !ok {
+		err := telemetry.Error(ctx, span, nil, "error decoding request")
+		c.HandleAPIError(w, r, apierrors.NewErrPassThroughToClient(err, http.StatusBadRequest))
+		return
+	}
+
+	telemetry.WithAttributes(span,
+		telemetry.AttributeKV{Key: "query", Value: request.Query},
+		telemetry.AttributeKV{Key: "limit", Value: request.Limit},
+	)
+
+	events, err := c.Repo().KubeEvent().SearchEvents(ctx, project.ID, cluster.ID, request)
+	if err != nil {
+		err := telemetry.Error(ctx, span, err, "error searching kube events")
+		c.HandleAPIError(w, r, apierrors.NewErrPassThroughToClient(err, http.StatusInternalServerError))
+		return
+	}
+
+	res := &types.SearchKubeEventsResponse{
+		KubeEvents: make([]*types.KubeEvent, 0, len(events)),
+	}
+
+	for _, event := range events {
+		res.KubeEvents = append(res.KubeEvents, event.ToKubeEventType())
+	}
+
+	c.WriteResult(w, r, res)
+}
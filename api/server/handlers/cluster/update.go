@@ -40,7 +40,7 @@ func (c *ClusterUpdateHandler) ServeHTTP(w http.ResponseWriter, r *http.Request)
 	// if the cluster has an AWS integration, and the request does not have a cluster name attached, make
 	// sure that the old cluster name is set
 	if cluster.AWSIntegrationID != 0 && request.AWSClusterID == "" {
-		awsInt, err := c.Repo().AWSIntegration().ReadAWSIntegration(cluster.ProjectID, cluster.AWSIntegrationID)
+		awsInt, err := c.Repo().AWSIntegration().ReadAWSIntegration(r.Context(), cluster.ProjectID, cluster.AWSIntegrationID)
 		if err != nil {
 			c.HandleAPIError(w, r, apierrors.NewErrInternal(err))
 			return
@@ -49,7 +49,7 @@ func (c *ClusterUpdateHandler) ServeHTTP(w http.ResponseWriter, r *http.Request)
 		if string(awsInt.AWSClusterID) == "" {
 			awsInt.AWSClusterID = []byte(cluster.Name)
 
-			awsInt, err = c.Repo().AWSIntegration().OverwriteAWSIntegration(awsInt)
+			awsInt, err = c.Repo().AWSIntegration().OverwriteAWSIntegration(r.Context(), awsInt)
 
 			if err != nil {
 				c.HandleAPIError(w, r, apierrors.NewErrInternal(err))
@@ -72,7 +72,7 @@ func (c *ClusterUpdateHandler) ServeHTTP(w http.ResponseWriter, r *http.Request)
 		cluster.Name = request.Name
 	}
 
-	cluster, err := c.Repo().Cluster().UpdateCluster(cluster)
+	cluster, err := c.Repo().Cluster().UpdateCluster(r.Context(), cluster)
 	if err != nil {
 		c.HandleAPIError(w, r, apierrors.NewErrInternal(err))
 		return
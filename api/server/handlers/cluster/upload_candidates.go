@@ -0,0 +1,100 @@
+package cluster
+
+import (
+	"fmt"
+	"io"
+	"net/http"
+	"strconv"
+
+	"github.com/porter-dev/porter/api/server/handlers"
+	"github.com/porter-dev/porter/api/server/shared"
+	"github.com/porter-dev/porter/api/server/shared/apierrors"
+	"github.com/porter-dev/porter/api/server/shared/config"
+	"github.com/porter-dev/porter/api/types"
+	"github.com/porter-dev/porter/internal/models"
+	"github.com/porter-dev/porter/internal/telemetry"
+)
+
+const (
+	// maxKubeconfigUploadBytes is the largest kubeconfig accepted by the upload endpoint
+	maxKubeconfigUploadBytes = 32 << 20
+	// kubeconfigUploadMemoryBytes is the amount of the upload held in memory; the rest is buffered to disk
+	kubeconfigUploadMemoryBytes = 4 << 20
+)
+
+// UploadClusterCandidatesHandler creates cluster candidates from a kubeconfig sent as a multipart file upload. Large
+// kubeconfigs with many contexts are streamed rather than embedded in a JSON body, and each context becomes its own candidate.
+type UploadClusterCandidatesHandler struct {
+	handlers.PorterHandlerWriter
+}
+
+// NewUploadClusterCandidatesHandler returns a new UploadClusterCandidatesHandler
+func NewUploadClusterCandidatesHandler(
+	config *config.Config,
+	writer shared.ResultWriter,
+) *UploadClusterCandidatesHandler {
+	return &UploadClusterCandidatesHandler{
+		PorterHandlerWriter: handlers.NewDefaultPorterHandler(config, nil, writer),
+	}
+}
+
+// ServeHTTP reads the kubeconfig file from the "kubeconfig" form field, and the optional "is_local" form field
+func (c *UploadClusterCandidatesHandler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	ctx, span := telemetry.NewSpan(r.Context(), "serve-upload-cluster-candidates")
+	defer span.End()
+
+	proj, _ := ctx.Value(types.ProjectScope).(*models.Project)
+	user, _ := ctx.Value(types.UserScope).(*models.User)
+
+	r.Body = http.MaxBytesReader(w, r.Body, maxKubeconfigUploadBytes)
+
+	err := r.ParseMultipartForm(kubeconfigUploadMemoryBytes)
+	if err != nil {
+		err = telemetry.Error(ctx, span, err, "error parsing multipart form")
+		c.HandleAPIError(w, r, apierrors.NewErrPassThroughToClient(err, http.StatusBadRequest))
+		return
+	}
+	defer r.MultipartForm.RemoveAll() // nolint:errcheck
+
+	file, header, err := r.FormFile("kubeconfig")
+	if err != nil {
+		err = telemetry.Error(ctx, span, err, "kubeconfig file is required")
+		c.HandleAPIError(w, r, apierrors.NewErrPassThroughToClient(err, http.StatusBadRequest))
+		return
+	}
+	defer file.Close()
+
+	telemetry.WithAttributes(span, telemetry.AttributeKV{Key: "kubeconfig-size", Value: header.Size})
+
+	kubeconfig, err := io.ReadAll(file)
+	if err != nil {
+		err = telemetry.Error(ctx, span, err, "error reading kubeconfig file")
+		c.HandleAPIError(w, r, apierrors.NewErrPassThroughToClient(err, http.StatusBadRequest))
+		return
+	}
+
+	var isLocal bool
+	if val := r.FormValue("is_local"); val != "" {
+		isLocal, err = strconv.ParseBool(val)
+		if err != nil {
+			err = telemetry.Error(ctx, span, err, fmt.Sprintf("invalid is_local value %s", val))
+			c.HandleAPIError(w, r, apierrors.NewErrPassThroughToClient(err, http.StatusBadRequest))
+			return
+		}
+	}
+
+	res, err := createClusterCandidates(ctx, c.Config(), proj, user, &types.CreateClusterCandidateRequest{
+		ProjectID:  proj.ID,
+		Kubeconfig: string(kubeconfig),
+		IsLocal:    isLocal,
+	})
+	if err != nil {
+		err = telemetry.Error(ctx, span, err, "error creating cluster candidates")
+		c.HandleAPIError(w, r, apierrors.NewErrInternal(err))
+		return
+	}
+
+	telemetry.WithAttributes(span, telemetry.AttributeKV{Key: "candidate-count", Value: len(res)})
+
+	c.WriteResult(w, r, res)
+}
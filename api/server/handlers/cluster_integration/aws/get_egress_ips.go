@@ -0,0 +1,127 @@
+package aws
+
+import (
+	"net/http"
+	"sort"
+	"strings"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/ec2"
+	"github.com/aws/aws-sdk-go/service/eks"
+
+	"github.com/porter-dev/porter/api/server/handlers"
+	"github.com/porter-dev/porter/api/server/shared"
+	"github.com/porter-dev/porter/api/server/shared/apierrors"
+	"github.com/porter-dev/porter/api/server/shared/config"
+	"github.com/porter-dev/porter/api/types"
+	"github.com/porter-dev/porter/internal/models"
+	"github.com/porter-dev/porter/internal/telemetry"
+)
+
+// GetEgressIPsHandler handles requests to the /integrations/aws/egress_ips endpoint
+type GetEgressIPsHandler struct {
+	handlers.PorterHandlerReadWriter
+}
+
+// NewGetEgressIPsHandler returns a new GetEgressIPsHandler
+func NewGetEgressIPsHandler(
+	config *config.Config,
+	decoderValidator shared.RequestDecoderValidator,
+	writer shared.ResultWriter,
+) *GetEgressIPsHandler {
+	return &GetEgressIPsHandler{
+		PorterHandlerReadWriter: handlers.NewDefaultPorterHandler(config, decoderValidator, writer),
+	}
+}
+
+// ServeHTTP returns the public IPs of the NAT gateways in the VPC of an EKS cluster. Traffic from the private subnets of the
+// cluster leaves through these gateways, so they are the IPs to allowlist with third parties.
+func (c *GetEgressIPsHandler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	ctx, span := telemetry.NewSpan(r.Context(), "serve-get-egress-ips")
+	defer span.End()
+
+	project, _ := ctx.Value(types.ProjectScope).(*models.Project)
+	cluster, _ := ctx.Value(types.ClusterScope).(*models.Cluster)
+
+	telemetry.WithAttributes(span,
+		telemetry.AttributeKV{Key: "project-id", Value: project.ID},
+		telemetry.AttributeKV{Key: "cluster-id", Value: cluster.ID},
+	)
+
+	if cluster.AWSIntegrationID == 0 {
+		err := telemetry.Error(ctx, span, nil, "egress ips are only available for aws clusters")
+		c.HandleAPIError(w, r, apierrors.NewErrPassThroughToClient(err, http.StatusBadRequest))
+		return
+	}
+
+	awsInt, err := c.Repo().AWSIntegration().ReadAWSIntegration(ctx, project.ID, cluster.AWSIntegrationID)
+	if err != nil {
+		err := telemetry.Error(ctx, span, err, "error reading aws integration")
+		c.HandleAPIError(w, r, apierrors.NewErrInternal(err))
+		return
+	}
+
+	awsSession, err := awsInt.GetSession()
+	if err != nil {
+		err := telemetry.Error(ctx, span, err, "error getting aws session")
+		c.HandleAPIError(w, r, apierrors.NewErrPassThroughToClient(err, http.StatusConflict))
+		return
+	}
+
+	awsConf := aws.NewConfig()
+	if awsInt.AWSRegion != "" {
+		awsConf = awsConf.WithRegion(awsInt.AWSRegion)
+	}
+
+	clusterName := cluster.Name
+	if strings.HasPrefix(clusterName, "arn:aws:eks:") {
+		parts := strings.Split(clusterName, "/")
+		clusterName = parts[len(parts)-1]
+	}
+
+	clusterInfo, err := eks.New(awsSession, awsConf).DescribeClusterWithContext(ctx, &eks.DescribeClusterInput{
+		Name: aws.String(clusterName),
+	})
+	if err != nil || clusterInfo.Cluster == nil || clusterInfo.Cluster.ResourcesVpcConfig == nil {
+		err := telemetry.Error(ctx, span, err, "error describing eks cluster")
+		c.HandleAPIError(w, r, apierrors.NewErrInternal(err))
+		return
+	}
+
+	res := &types.GetEgressIPsResponse{
+		EgressIPs: []string{},
+	}
+
+	err = ec2.New(awsSession, awsConf).DescribeNatGatewaysPagesWithContext(ctx, &ec2.DescribeNatGatewaysInput{
+		Filter: []*ec2.Filter{
+			{
+				Name:   aws.String("vpc-id"),
+				Values: []*string{clusterInfo.Cluster.ResourcesVpcConfig.VpcId},
+			},
+			{
+				Name:   aws.String("state"),
+				Values: []*string{aws.String(ec2.NatGatewayStateAvailable)},
+			},
+		},
+	}, func(page *ec2.DescribeNatGatewaysOutput, lastPage bool) bool {
+		for _, gateway := range page.NatGateways {
+			for _, address := range gateway.NatGatewayAddresses {
+				if address.PublicIp != nil {
+					res.EgressIPs = append(res.EgressIPs, *address.PublicIp)
+				}
+			}
+		}
+
+		return !lastPage
+	})
+	if err != nil {
+		err := telemetry.Error(ctx, span, err, "error describing nat gateways")
+		c.HandleAPIError(w, r, apierrors.NewErrInternal(err))
+		return
+	}
+
+	sort.Strings(res.EgressIPs)
+	telemetry.WithAttributes(span, telemetry.AttributeKV{Key: "egress-ip-count", Value: len(res.EgressIPs)})
+
+	c.WriteResult(w, r, res)
+}
@@ -30,7 +30,7 @@ func (p *DatabaseListHandler) ServeHTTP(w http.ResponseWriter, r *http.Request)
 	cluster, _ := r.Context().Value(types.ClusterScope).(*models.Cluster)
 
 	// read all clusters for this project
-	dbs, err := p.Repo().Database().ListDatabases(proj.ID, cluster.ID)
+	dbs, err := p.Repo().Database().ListDatabases(r.Context(), proj.ID, cluster.ID)
 	if err != nil {
 		p.HandleAPIError(w, r, apierrors.NewErrInternal(err))
 		return
@@ -36,7 +36,7 @@ func (p *DatabaseUpdateStatusHandler) ServeHTTP(w http.ResponseWriter, r *http.R
 	}
 
 	// read all clusters for this project
-	db, err := p.Repo().Database().ReadDatabaseByInfraID(proj.ID, infra.ID)
+	db, err := p.Repo().Database().ReadDatabaseByInfraID(r.Context(), proj.ID, infra.ID)
 	if err != nil {
 		p.HandleAPIError(w, r, apierrors.NewErrInternal(err))
 		return
@@ -44,7 +44,7 @@ func (p *DatabaseUpdateStatusHandler) ServeHTTP(w http.ResponseWriter, r *http.R
 
 	db.Status = req.Status
 
-	db, err = p.Repo().Database().UpdateDatabase(db)
+	db, err = p.Repo().Database().UpdateDatabase(r.Context(), db)
 
 	if err != nil {
 		p.HandleAPIError(w, r, apierrors.NewErrInternal(err))
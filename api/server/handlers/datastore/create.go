@@ -0,0 +1,112 @@
+package datastore
+
+import (
+	"errors"
+	"net/http"
+
+	"github.com/porter-dev/porter/api/server/handlers"
+	"github.com/porter-dev/porter/api/server/shared"
+	"github.com/porter-dev/porter/api/server/shared/apierrors"
+	"github.com/porter-dev/porter/api/server/shared/config"
+	"github.com/porter-dev/porter/api/types"
+	"github.com/porter-dev/porter/internal/datastore"
+	"github.com/porter-dev/porter/internal/models"
+	"github.com/porter-dev/porter/internal/telemetry"
+	"gorm.io/gorm"
+)
+
+// CreateDatastoreHandler provisions a datastore with an AWS integration of a project
+type CreateDatastoreHandler struct {
+	handlers.PorterHandlerReadWriter
+}
+
+// NewCreateDatastoreHandler returns a new CreateDatastoreHandler
+func NewCreateDatastoreHandler(
+	config *config.Config,
+	decoderValidator shared.RequestDecoderValidator,
+	writer shared.ResultWriter,
+) *CreateDatastoreHandler {
+	return &CreateDatastoreHandler{
+		PorterHandlerReadWriter: handlers.NewDefaultPorterHandler(config, decoderValidator, writer),
+	}
+}
+
+func (c *CreateDatastoreHandler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	ctx, span := telemetry.NewSpan(r.Context(), "serve-create-datastore")
+	defer span.End()
+
+	project, _ := ctx.Value(types.ProjectScope).(*models.Project)
+
+	request := &types.CreateDatastoreRequest{}
+	if ok := c.DecodeAndValidate(w, r, request); !ok {
+		return
+	}
+
+	telemetry.WithAttributes(span,
+		telemetry.AttributeKV{Key: "datastore-name", Value: request.Name},
+		telemetry.AttributeKV{Key: "engine", Value: string(request.Engine)},
+	)
+
+	datastores, err := c.Repo().Datastore().ListDatastoresByProjectID(ctx, project.ID)
+	if err != nil {
+		err = telemetry.Error(ctx, span, err, "error listing datastores")
+		c.HandleAPIError(w, r, apierrors.NewErrInternal(err))
+		return
+	}
+
+	for _, ds := range datastores {
+		if ds.Name == request.Name {
+			err := telemetry.Error(ctx, span, nil, "a datastore with this name already exists")
+			c.HandleAPIError(w, r, apierrors.NewErrPassThroughToClient(err, http.StatusConflict))
+			return
+		}
+	}
+
+	awsInt, err := c.Repo().AWSIntegration().ReadAWSIntegration(ctx, project.ID, request.AWSIntegrationID)
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			err = telemetry.Error(ctx, span, err, "aws integration of datastore not found")
+			c.HandleAPIError(w, r, apierrors.NewErrPassThroughToClient(err, http.StatusBadRequest))
+			return
+		}
+
+		err = telemetry.Error(ctx, span, err, "error reading aws integration")
+		c.HandleAPIError(w, r, apierrors.NewErrInternal(err))
+		return
+	}
+
+	provisioner, err := datastore.NewProvisioner(awsInt, request.Region)
+	if err != nil {
+		err = telemetry.Error(ctx, span, err, "error getting aws session")
+		c.HandleAPIError(w, r, apierrors.NewErrInternal(err))
+		return
+	}
+
+	ds := &models.Datastore{
+		ProjectID:        project.ID,
+		AWSIntegrationID: awsInt.ID,
+		Name:             request.Name,
+		Region:           request.Region,
+		Engine:           request.Engine,
+		EngineVersion:    request.EngineVersion,
+		InstanceClass:    request.InstanceClass,
+		StorageGB:        request.StorageGB,
+		DatabaseName:     request.DatabaseName,
+	}
+
+	if err := provisioner.Create(ctx, ds); err != nil {
+		err = telemetry.Error(ctx, span, err, "error provisioning datastore")
+		c.HandleAPIError(w, r, apierrors.NewErrPassThroughToClient(err, http.StatusBadRequest))
+		return
+	}
+
+	ds, err = c.Repo().Datastore().CreateDatastore(ctx, ds)
+	if err != nil {
+		err = telemetry.Error(ctx, span, err, "error creating datastore")
+		c.HandleAPIError(w, r, apierrors.NewErrInternal(err))
+		return
+	}
+
+	w.WriteHeader(http.StatusCreated)
+	c.WriteResult(w, r, ds.ToDatastoreType())
+}
@@ -0,0 +1,95 @@
+package datastore
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+
+	"github.com/porter-dev/porter/api/server/shared/apierrors"
+	"github.com/porter-dev/porter/api/server/shared/config"
+	"github.com/porter-dev/porter/api/server/shared/requestutils"
+	"github.com/porter-dev/porter/api/types"
+	"github.com/porter-dev/porter/internal/datastore"
+	"github.com/porter-dev/porter/internal/models"
+	"github.com/porter-dev/porter/internal/telemetry"
+	"gorm.io/gorm"
+)
+
+// newProvisioner returns a provisioner for the region of a datastore, with the credentials of its AWS integration
+func newProvisioner(ctx context.Context, config *config.Config, ds *models.Datastore) (*datastore.Provisioner, error) {
+	awsInt, err := config.Repo.AWSIntegration().ReadAWSIntegration(ctx, ds.ProjectID, ds.AWSIntegrationID)
+	if err != nil {
+		return nil, fmt.Errorf("error reading aws integration: %w", err)
+	}
+
+	return datastore.NewProvisioner(awsInt, ds.Region)
+}
+
+// refreshDatastore updates a datastore which is not in a terminal state from its RDS instance. A
+// datastore being deleted is removed once its instance no longer exists, in which case nil is returned.
+func refreshDatastore(ctx context.Context, config *config.Config, ds *models.Datastore) (*models.Datastore, error) {
+	if datastore.IsTerminal(ds.Status) {
+		return ds, nil
+	}
+
+	provisioner, err := newProvisioner(ctx, config, ds)
+	if err != nil {
+		return nil, err
+	}
+
+	err = provisioner.Refresh(ctx, ds)
+	if err != nil {
+		if !errors.Is(err, datastore.ErrInstanceNotFound) {
+			return nil, err
+		}
+
+		if ds.Status == types.DatastoreStatus_Deleting {
+			if err := config.Repo.Datastore().DeleteDatastore(ctx, ds); err != nil {
+				return nil, fmt.Errorf("error deleting datastore: %w", err)
+			}
+
+			return nil, nil
+		}
+
+		ds.Status = types.DatastoreStatus_Failed
+		ds.StatusMessage = "rds instance no longer exists"
+	}
+
+	links := ds.Links
+
+	ds, err = config.Repo.Datastore().UpdateDatastore(ctx, ds)
+	if err != nil {
+		return nil, fmt.Errorf("error updating datastore: %w", err)
+	}
+
+	ds.Links = links
+
+	return ds, nil
+}
+
+// readDatastore reads the datastore of the url of a request from the project scope of the request
+func readDatastore(ctx context.Context, config *config.Config, r *http.Request) (*models.Datastore, apierrors.RequestError) {
+	ctx, span := telemetry.NewSpan(ctx, "read-datastore")
+	defer span.End()
+
+	project, _ := ctx.Value(types.ProjectScope).(*models.Project)
+
+	datastoreID, reqErr := requestutils.GetURLParamUint(r, types.URLParamDatastoreID)
+	if reqErr != nil {
+		return nil, reqErr
+	}
+
+	telemetry.WithAttributes(span, telemetry.AttributeKV{Key: "datastore-id", Value: datastoreID})
+
+	ds, err := config.Repo.Datastore().ReadDatastore(ctx, project.ID, datastoreID)
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, apierrors.NewErrNotFound(err)
+		}
+
+		return nil, apierrors.NewErrInternal(telemetry.Error(ctx, span, err, "error reading datastore"))
+	}
+
+	return ds, nil
+}
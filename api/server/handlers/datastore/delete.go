@@ -0,0 +1,65 @@
+package datastore
+
+import (
+	"net/http"
+
+	"github.com/porter-dev/porter/api/server/handlers"
+	"github.com/porter-dev/porter/api/server/shared"
+	"github.com/porter-dev/porter/api/server/shared/apierrors"
+	"github.com/porter-dev/porter/api/server/shared/config"
+	"github.com/porter-dev/porter/internal/telemetry"
+)
+
+// DeleteDatastoreHandler deletes the instance of a datastore. The datastore is removed from the
+// project once its instance no longer exists.
+type DeleteDatastoreHandler struct {
+	handlers.PorterHandlerWriter
+}
+
+// NewDeleteDatastoreHandler returns a new DeleteDatastoreHandler
+func NewDeleteDatastoreHandler(
+	config *config.Config,
+	writer shared.ResultWriter,
+) *DeleteDatastoreHandler {
+	return &DeleteDatastoreHandler{
+		PorterHandlerWriter: handlers.NewDefaultPorterHandler(config, nil, writer),
+	}
+}
+
+func (c *DeleteDatastoreHandler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	ctx, span := telemetry.NewSpan(r.Context(), "serve-delete-datastore")
+	defer span.End()
+
+	ds, reqErr := readDatastore(ctx, c.Config(), r)
+	if reqErr != nil {
+		c.HandleAPIError(w, r, reqErr)
+		return
+	}
+
+	provisioner, err := newProvisioner(ctx, c.Config(), ds)
+	if err != nil {
+		err = telemetry.Error(ctx, span, err, "error getting aws session")
+		c.HandleAPIError(w, r, apierrors.NewErrInternal(err))
+		return
+	}
+
+	if err := provisioner.Delete(ctx, ds); err != nil {
+		err = telemetry.Error(ctx, span, err, "error deleting datastore instance")
+		c.HandleAPIError(w, r, apierrors.NewErrPassThroughToClient(err, http.StatusBadRequest))
+		return
+	}
+
+	links := ds.Links
+
+	ds, err = c.Repo().Datastore().UpdateDatastore(ctx, ds)
+	if err != nil {
+		err = telemetry.Error(ctx, span, err, "error updating datastore")
+		c.HandleAPIError(w, r, apierrors.NewErrInternal(err))
+		return
+	}
+
+	ds.Links = links
+
+	w.WriteHeader(http.StatusAccepted)
+	c.WriteResult(w, r, ds.ToDatastoreType())
+}
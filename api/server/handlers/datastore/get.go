@@ -0,0 +1,52 @@
+package datastore
+
+import (
+	"net/http"
+
+	"github.com/porter-dev/porter/api/server/handlers"
+	"github.com/porter-dev/porter/api/server/shared"
+	"github.com/porter-dev/porter/api/server/shared/apierrors"
+	"github.com/porter-dev/porter/api/server/shared/config"
+	"github.com/porter-dev/porter/internal/telemetry"
+	"gorm.io/gorm"
+)
+
+// GetDatastoreHandler returns a datastore of a project, with the current state of its instance
+type GetDatastoreHandler struct {
+	handlers.PorterHandlerWriter
+}
+
+// NewGetDatastoreHandler returns a new GetDatastoreHandler
+func NewGetDatastoreHandler(
+	config *config.Config,
+	writer shared.ResultWriter,
+) *GetDatastoreHandler {
+	return &GetDatastoreHandler{
+		PorterHandlerWriter: handlers.NewDefaultPorterHandler(config, nil, writer),
+	}
+}
+
+func (c *GetDatastoreHandler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	ctx, span := telemetry.NewSpan(r.Context(), "serve-get-datastore")
+	defer span.End()
+
+	ds, reqErr := readDatastore(ctx, c.Config(), r)
+	if reqErr != nil {
+		c.HandleAPIError(w, r, reqErr)
+		return
+	}
+
+	ds, err := refreshDatastore(ctx, c.Config(), ds)
+	if err != nil {
+		err = telemetry.Error(ctx, span, err, "error refreshing datastore")
+		c.HandleAPIError(w, r, apierrors.NewErrInternal(err))
+		return
+	}
+
+	if ds == nil {
+		c.HandleAPIError(w, r, apierrors.NewErrNotFound(gorm.ErrRecordNotFound))
+		return
+	}
+
+	c.WriteResult(w, r, ds.ToDatastoreType())
+}
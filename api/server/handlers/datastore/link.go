@@ -0,0 +1,152 @@
+package datastore
+
+import (
+	"errors"
+	"net/http"
+
+	"github.com/porter-dev/porter/api/server/handlers"
+	"github.com/porter-dev/porter/api/server/shared"
+	"github.com/porter-dev/porter/api/server/shared/apierrors"
+	"github.com/porter-dev/porter/api/server/shared/config"
+	"github.com/porter-dev/porter/api/types"
+	"github.com/porter-dev/porter/internal/datastore"
+	"github.com/porter-dev/porter/internal/models"
+	"github.com/porter-dev/porter/internal/telemetry"
+	"gorm.io/gorm"
+)
+
+const defaultLinkEnvVar = "DATABASE_URL"
+
+// LinkDatastoreHandler links a datastore to an app, so that its connection string is set in the env
+// of the app when the app is deployed. A dedicated database user is created for the app.
+type LinkDatastoreHandler struct {
+	handlers.PorterHandlerReadWriter
+}
+
+// NewLinkDatastoreHandler returns a new LinkDatastoreHandler
+func NewLinkDatastoreHandler(
+	config *config.Config,
+	decoderValidator shared.RequestDecoderValidator,
+	writer shared.ResultWriter,
+) *LinkDatastoreHandler {
+	return &LinkDatastoreHandler{
+		PorterHandlerReadWriter: handlers.NewDefaultPorterHandler(config, decoderValidator, writer),
+	}
+}
+
+func (c *LinkDatastoreHandler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	ctx, span := telemetry.NewSpan(r.Context(), "serve-link-datastore")
+	defer span.End()
+
+	ds, reqErr := readDatastore(ctx, c.Config(), r)
+	if reqErr != nil {
+		c.HandleAPIError(w, r, reqErr)
+		return
+	}
+
+	request := &types.LinkDatastoreRequest{}
+	if ok := c.DecodeAndValidate(w, r, request); !ok {
+		return
+	}
+
+	if request.EnvVar == "" {
+		request.EnvVar = defaultLinkEnvVar
+	}
+
+	telemetry.WithAttributes(span,
+		telemetry.AttributeKV{Key: "cluster-id", Value: request.ClusterID},
+		telemetry.AttributeKV{Key: "app-name", Value: request.AppName},
+	)
+
+	if _, err := c.Repo().Cluster().ReadCluster(ctx, ds.ProjectID, request.ClusterID); err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			err = telemetry.Error(ctx, span, err, "cluster not found")
+			c.HandleAPIError(w, r, apierrors.NewErrPassThroughToClient(err, http.StatusBadRequest))
+			return
+		}
+
+		err = telemetry.Error(ctx, span, err, "error reading cluster")
+		c.HandleAPIError(w, r, apierrors.NewErrInternal(err))
+		return
+	}
+
+	for _, link := range ds.Links {
+		if link.ClusterID == request.ClusterID && link.AppName == request.AppName {
+			err := telemetry.Error(ctx, span, nil, "datastore is already linked to app")
+			c.HandleAPIError(w, r, apierrors.NewErrPassThroughToClient(err, http.StatusConflict))
+			return
+		}
+	}
+
+	links, err := c.Repo().Datastore().ListDatastoreLinksByApp(ctx, ds.ProjectID, request.ClusterID, request.AppName)
+	if err != nil {
+		err = telemetry.Error(ctx, span, err, "error listing links of app")
+		c.HandleAPIError(w, r, apierrors.NewErrInternal(err))
+		return
+	}
+
+	for _, link := range links {
+		if link.EnvVar == request.EnvVar {
+			err := telemetry.Error(ctx, span, nil, "env var of app is already set by a linked datastore")
+			c.HandleAPIError(w, r, apierrors.NewErrPassThroughToClient(err, http.StatusConflict))
+			return
+		}
+	}
+
+	if ds.Status != types.DatastoreStatus_Available {
+		err := telemetry.Error(ctx, span, nil, "datastore must be available to be linked")
+		c.HandleAPIError(w, r, apierrors.NewErrPassThroughToClient(err, http.StatusConflict))
+		return
+	}
+
+	password, err := datastore.GeneratePassword()
+	if err != nil {
+		err = telemetry.Error(ctx, span, err, "error generating password")
+		c.HandleAPIError(w, r, apierrors.NewErrInternal(err))
+		return
+	}
+
+	users, err := datastore.ConnectUsers(ctx, ds)
+	if err != nil {
+		if errors.Is(err, datastore.ErrUnsupportedEngine) {
+			err = telemetry.Error(ctx, span, err, "datastore engine does not support app users")
+			c.HandleAPIError(w, r, apierrors.NewErrPassThroughToClient(err, http.StatusBadRequest))
+			return
+		}
+
+		err = telemetry.Error(ctx, span, err, "error connecting to datastore")
+		c.HandleAPIError(w, r, apierrors.NewErrInternal(err))
+		return
+	}
+	defer users.Close()
+
+	username := datastore.AppUsername(request.ClusterID, request.AppName)
+
+	if err := users.Create(ctx, username, password); err != nil {
+		err = telemetry.Error(ctx, span, err, "error creating database user")
+		c.HandleAPIError(w, r, apierrors.NewErrInternal(err))
+		return
+	}
+
+	link, err := c.Repo().Datastore().CreateDatastoreLink(ctx, &models.DatastoreLink{
+		DatastoreID: ds.ID,
+		ProjectID:   ds.ProjectID,
+		ClusterID:   request.ClusterID,
+		AppName:     request.AppName,
+		EnvVar:      request.EnvVar,
+		Username:    username,
+		Password:    []byte(password),
+	})
+	if err != nil {
+		if dropErr := users.Drop(ctx, username); dropErr != nil {
+			_ = telemetry.Error(ctx, span, dropErr, "error dropping database user")
+		}
+
+		err = telemetry.Error(ctx, span, err, "error creating datastore link")
+		c.HandleAPIError(w, r, apierrors.NewErrInternal(err))
+		return
+	}
+
+	w.WriteHeader(http.StatusCreated)
+	c.WriteResult(w, r, link.ToDatastoreLinkType())
+}
@@ -0,0 +1,63 @@
+package datastore
+
+import (
+	"net/http"
+
+	"github.com/porter-dev/porter/api/server/handlers"
+	"github.com/porter-dev/porter/api/server/shared"
+	"github.com/porter-dev/porter/api/server/shared/apierrors"
+	"github.com/porter-dev/porter/api/server/shared/config"
+	"github.com/porter-dev/porter/api/types"
+	"github.com/porter-dev/porter/internal/models"
+	"github.com/porter-dev/porter/internal/telemetry"
+)
+
+// ListDatastoresHandler lists the datastores of a project
+type ListDatastoresHandler struct {
+	handlers.PorterHandlerWriter
+}
+
+// NewListDatastoresHandler returns a new ListDatastoresHandler
+func NewListDatastoresHandler(
+	config *config.Config,
+	writer shared.ResultWriter,
+) *ListDatastoresHandler {
+	return &ListDatastoresHandler{
+		PorterHandlerWriter: handlers.NewDefaultPorterHandler(config, nil, writer),
+	}
+}
+
+func (c *ListDatastoresHandler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	ctx, span := telemetry.NewSpan(r.Context(), "serve-list-datastores")
+	defer span.End()
+
+	project, _ := ctx.Value(types.ProjectScope).(*models.Project)
+
+	datastores, err := c.Repo().Datastore().ListDatastoresByProjectID(ctx, project.ID)
+	if err != nil {
+		err = telemetry.Error(ctx, span, err, "error listing datastores")
+		c.HandleAPIError(w, r, apierrors.NewErrInternal(err))
+		return
+	}
+
+	res := types.ListDatastoresResponse{
+		Datastores: make([]types.Datastore, 0, len(datastores)),
+	}
+
+	for _, ds := range datastores {
+		refreshed, err := refreshDatastore(ctx, c.Config(), ds)
+		if err != nil {
+			// the last known state of the datastore is listed if its instance cannot be described
+			_ = telemetry.Error(ctx, span, err, "error refreshing datastore")
+			refreshed = ds
+		}
+
+		if refreshed == nil {
+			continue
+		}
+
+		res.Datastores = append(res.Datastores, *refreshed.ToDatastoreType())
+	}
+
+	c.WriteResult(w, r, res)
+}
@@ -0,0 +1,164 @@
+package datastore
+
+import (
+	"errors"
+	"net/http"
+	"time"
+
+	"github.com/porter-dev/porter/api/server/authz"
+	"github.com/porter-dev/porter/api/server/handlers"
+	"github.com/porter-dev/porter/api/server/shared"
+	"github.com/porter-dev/porter/api/server/shared/apierrors"
+	"github.com/porter-dev/porter/api/server/shared/config"
+	"github.com/porter-dev/porter/api/server/shared/requestutils"
+	"github.com/porter-dev/porter/api/types"
+	"github.com/porter-dev/porter/internal/datastore"
+	"github.com/porter-dev/porter/internal/kubernetes/porter_app"
+	"github.com/porter-dev/porter/internal/models"
+	"github.com/porter-dev/porter/internal/telemetry"
+	"gorm.io/gorm"
+)
+
+// RotateDatastoreCredentialsHandler issues a new password for the database user of a linked app, then redeploys the
+// workloads of the app with the new connection string. Links without a dedicated user are given one.
+type RotateDatastoreCredentialsHandler struct {
+	handlers.PorterHandlerWriter
+	authz.KubernetesAgentGetter
+}
+
+// NewRotateDatastoreCredentialsHandler returns a new RotateDatastoreCredentialsHandler
+func NewRotateDatastoreCredentialsHandler(
+	config *config.Config,
+	writer shared.ResultWriter,
+) *RotateDatastoreCredentialsHandler {
+	return &RotateDatastoreCredentialsHandler{
+		PorterHandlerWriter:   handlers.NewDefaultPorterHandler(config, nil, writer),
+		KubernetesAgentGetter: authz.NewOutOfClusterAgentGetter(config),
+	}
+}
+
+func (c *RotateDatastoreCredentialsHandler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	ctx, span := telemetry.NewSpan(r.Context(), "serve-rotate-datastore-credentials")
+	defer span.End()
+
+	ds, reqErr := readDatastore(ctx, c.Config(), r)
+	if reqErr != nil {
+		c.HandleAPIError(w, r, reqErr)
+		return
+	}
+
+	linkID, reqErr := requestutils.GetURLParamUint(r, types.URLParamDatastoreLinkID)
+	if reqErr != nil {
+		c.HandleAPIError(w, r, reqErr)
+		return
+	}
+
+	var link *models.DatastoreLink
+	for i := range ds.Links {
+		if ds.Links[i].ID == linkID {
+			link = &ds.Links[i]
+			break
+		}
+	}
+
+	if link == nil {
+		c.HandleAPIError(w, r, apierrors.NewErrNotFound(gorm.ErrRecordNotFound))
+		return
+	}
+
+	telemetry.WithAttributes(span,
+		telemetry.AttributeKV{Key: "cluster-id", Value: link.ClusterID},
+		telemetry.AttributeKV{Key: "app-name", Value: link.AppName},
+	)
+
+	if ds.Status != types.DatastoreStatus_Available {
+		err := telemetry.Error(ctx, span, nil, "datastore must be available to rotate credentials")
+		c.HandleAPIError(w, r, apierrors.NewErrPassThroughToClient(err, http.StatusConflict))
+		return
+	}
+
+	cluster, err := c.Repo().Cluster().ReadCluster(ctx, ds.ProjectID, link.ClusterID)
+	if err != nil {
+		err = telemetry.Error(ctx, span, err, "error reading cluster of linked app")
+		c.HandleAPIError(w, r, apierrors.NewErrInternal(err))
+		return
+	}
+
+	password, err := datastore.GeneratePassword()
+	if err != nil {
+		err = telemetry.Error(ctx, span, err, "error generating password")
+		c.HandleAPIError(w, r, apierrors.NewErrInternal(err))
+		return
+	}
+
+	users, err := datastore.ConnectUsers(ctx, ds)
+	if err != nil {
+		if errors.Is(err, datastore.ErrUnsupportedEngine) {
+			err = telemetry.Error(ctx, span, err, "datastore engine does not support app users")
+			c.HandleAPIError(w, r, apierrors.NewErrPassThroughToClient(err, http.StatusBadRequest))
+			return
+		}
+
+		err = telemetry.Error(ctx, span, err, "error connecting to datastore")
+		c.HandleAPIError(w, r, apierrors.NewErrInternal(err))
+		return
+	}
+	defer users.Close()
+
+	if link.Username == "" {
+		link.Username = datastore.AppUsername(link.ClusterID, link.AppName)
+		err = users.Create(ctx, link.Username, password)
+	} else {
+		err = users.SetPassword(ctx, link.Username, password)
+	}
+	if err != nil {
+		err = telemetry.Error(ctx, span, err, "error setting password of database user")
+		c.HandleAPIError(w, r, apierrors.NewErrInternal(err))
+		return
+	}
+
+	now := time.Now().UTC()
+	link.Password = []byte(password)
+	link.CredentialsRotatedAt = &now
+
+	link, err = c.Repo().Datastore().UpdateDatastoreLink(ctx, link)
+	if err != nil {
+		err = telemetry.Error(ctx, span, err, "error updating datastore link")
+		c.HandleAPIError(w, r, apierrors.NewErrInternal(err))
+		return
+	}
+
+	connStr, err := datastore.ConnectionString(ds, link.Username, string(link.Password))
+	if err != nil {
+		err = telemetry.Error(ctx, span, err, "error building connection string")
+		c.HandleAPIError(w, r, apierrors.NewErrInternal(err))
+		return
+	}
+
+	agent, err := c.GetAgent(r, cluster, "")
+	if err != nil {
+		err = telemetry.Error(ctx, span, err, "error getting k8s agent")
+		c.HandleAPIError(w, r, apierrors.NewErrInternal(err))
+		return
+	}
+
+	// the password is already rotated, so the workloads which were not redeployed fail to open new connections
+	// until the app is deployed again
+	redeployed, err := porter_app.SetAppEnvVar(ctx, agent.Clientset, link.AppName, link.EnvVar, connStr)
+	if err != nil {
+		err = telemetry.Error(ctx, span, err, "credentials were rotated, but the app could not be redeployed")
+		c.HandleAPIError(w, r, apierrors.NewErrPassThroughToClient(err, http.StatusInternalServerError))
+		return
+	}
+
+	telemetry.WithAttributes(span, telemetry.AttributeKV{Key: "redeployed-workloads", Value: len(redeployed)})
+
+	if redeployed == nil {
+		redeployed = []string{}
+	}
+
+	c.WriteResult(w, r, types.RotateDatastoreCredentialsResponse{
+		Link:                link.ToDatastoreLinkType(),
+		RedeployedWorkloads: redeployed,
+	})
+}
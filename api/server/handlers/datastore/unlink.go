@@ -0,0 +1,88 @@
+package datastore
+
+import (
+	"context"
+	"net/http"
+
+	"github.com/porter-dev/porter/api/server/handlers"
+	"github.com/porter-dev/porter/api/server/shared"
+	"github.com/porter-dev/porter/api/server/shared/apierrors"
+	"github.com/porter-dev/porter/api/server/shared/config"
+	"github.com/porter-dev/porter/api/server/shared/requestutils"
+	"github.com/porter-dev/porter/api/types"
+	"github.com/porter-dev/porter/internal/datastore"
+	"github.com/porter-dev/porter/internal/models"
+	"github.com/porter-dev/porter/internal/telemetry"
+	"gorm.io/gorm"
+)
+
+// UnlinkDatastoreHandler unlinks a datastore from an app and drops the database user of the app, whose objects are
+// reassigned to the master user. The env of the app is unchanged until the app is next deployed.
+type UnlinkDatastoreHandler struct {
+	handlers.PorterHandlerWriter
+}
+
+// NewUnlinkDatastoreHandler returns a new UnlinkDatastoreHandler
+func NewUnlinkDatastoreHandler(
+	config *config.Config,
+	writer shared.ResultWriter,
+) *UnlinkDatastoreHandler {
+	return &UnlinkDatastoreHandler{
+		PorterHandlerWriter: handlers.NewDefaultPorterHandler(config, nil, writer),
+	}
+}
+
+func (c *UnlinkDatastoreHandler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	ctx, span := telemetry.NewSpan(r.Context(), "serve-unlink-datastore")
+	defer span.End()
+
+	ds, reqErr := readDatastore(ctx, c.Config(), r)
+	if reqErr != nil {
+		c.HandleAPIError(w, r, reqErr)
+		return
+	}
+
+	linkID, reqErr := requestutils.GetURLParamUint(r, types.URLParamDatastoreLinkID)
+	if reqErr != nil {
+		c.HandleAPIError(w, r, reqErr)
+		return
+	}
+
+	for i := range ds.Links {
+		if ds.Links[i].ID != linkID {
+			continue
+		}
+
+		link := &ds.Links[i]
+
+		// the user cannot be dropped while the instance is unavailable, and goes with the instance once it is deleted
+		if link.Username != "" && ds.Status == types.DatastoreStatus_Available {
+			if err := dropUser(ctx, ds, link.Username); err != nil {
+				err = telemetry.Error(ctx, span, err, "error dropping database user")
+				c.HandleAPIError(w, r, apierrors.NewErrInternal(err))
+				return
+			}
+		}
+
+		if err := c.Repo().Datastore().DeleteDatastoreLink(ctx, link); err != nil {
+			err = telemetry.Error(ctx, span, err, "error deleting datastore link")
+			c.HandleAPIError(w, r, apierrors.NewErrInternal(err))
+			return
+		}
+
+		w.WriteHeader(http.StatusOK)
+		return
+	}
+
+	c.HandleAPIError(w, r, apierrors.NewErrNotFound(gorm.ErrRecordNotFound))
+}
+
+func dropUser(ctx context.Context, ds *models.Datastore, username string) error {
+	users, err := datastore.ConnectUsers(ctx, ds)
+	if err != nil {
+		return err
+	}
+	defer users.Close()
+
+	return users.Drop(ctx, username)
+}
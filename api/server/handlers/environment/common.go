@@ -68,6 +68,7 @@ func isGithubPRClosed(
 }
 
 func validateGetDeploymentRequest(
+	ctx context.Context,
 	projectID, clusterID, envID uint,
 	owner, name string,
 	request *types.GetDeploymentRequest,
@@ -84,7 +85,7 @@ func validateGetDeploymentRequest(
 
 	// read the deployment
 	if request.DeploymentID != 0 {
-		depl, err = repo.Environment().ReadDeploymentByID(projectID, clusterID, request.DeploymentID)
+		depl, err = repo.Environment().ReadDeploymentByID(ctx, projectID, clusterID, request.DeploymentID)
 
 		if err != nil {
 			if errors.Is(err, gorm.ErrRecordNotFound) {
@@ -94,7 +95,7 @@ func validateGetDeploymentRequest(
 			return nil, apierrors.NewErrInternal(err)
 		}
 	} else if request.PRNumber != 0 {
-		depl, err = repo.Environment().ReadDeploymentByGitDetails(envID, owner, name, request.PRNumber)
+		depl, err = repo.Environment().ReadDeploymentByGitDetails(ctx, envID, owner, name, request.PRNumber)
 
 		if err != nil {
 			if errors.Is(err, gorm.ErrRecordNotFound) {
@@ -104,7 +105,7 @@ func validateGetDeploymentRequest(
 			return nil, apierrors.NewErrInternal(err)
 		}
 	} else if request.Namespace != "" {
-		depl, err = repo.Environment().ReadDeployment(envID, request.Namespace)
+		depl, err = repo.Environment().ReadDeployment(ctx, envID, request.Namespace)
 
 		if err != nil {
 			if errors.Is(err, gorm.ErrRecordNotFound) {
@@ -114,7 +115,7 @@ func validateGetDeploymentRequest(
 			return nil, apierrors.NewErrInternal(err)
 		}
 	} else if request.Branch != "" {
-		depl, err = repo.Environment().ReadDeploymentForBranch(envID, owner, name, request.Branch)
+		depl, err = repo.Environment().ReadDeploymentForBranch(ctx, envID, owner, name, request.Branch)
 
 		if err != nil {
 			if errors.Is(err, gorm.ErrRecordNotFound) {
@@ -118,7 +118,7 @@ func (c *CreateEnvironmentHandler) ServeHTTP(w http.ResponseWriter, r *http.Requ
 
 	env.GithubWebhookID = hook.GetID()
 
-	env, err = c.Repo().Environment().CreateEnvironment(env)
+	env, err = c.Repo().Environment().CreateEnvironment(r.Context(), env)
 
 	if err != nil {
 		_, deleteErr := client.Repositories.DeleteHook(context.Background(), owner, name, hook.GetID())
@@ -144,7 +144,7 @@ func (c *CreateEnvironmentHandler) ServeHTTP(w http.ResponseWriter, r *http.Requ
 			return
 		}
 
-		_, deleteErr = c.Repo().Environment().DeleteEnvironment(env)
+		_, deleteErr = c.Repo().Environment().DeleteEnvironment(r.Context(), env)
 
 		if deleteErr != nil {
 			c.HandleAPIError(w, r, apierrors.NewErrInternal(fmt.Errorf("error deleting created preview environment: %w",
@@ -166,7 +166,7 @@ func (c *CreateEnvironmentHandler) ServeHTTP(w http.ResponseWriter, r *http.Requ
 			return
 		}
 
-		_, deleteErr = c.Repo().Environment().DeleteEnvironment(env)
+		_, deleteErr = c.Repo().Environment().DeleteEnvironment(r.Context(), env)
 
 		if deleteErr != nil {
 			c.HandleAPIError(w, r, apierrors.NewErrInternal(fmt.Errorf("error deleting created preview environment: %w",
@@ -210,7 +210,7 @@ func (c *CreateEnvironmentHandler) ServeHTTP(w http.ResponseWriter, r *http.Requ
 	envType := env.ToEnvironmentType()
 
 	if len(envType.GitDeployBranches) > 0 && c.Config().ServerConf.EnableAutoPreviewBranchDeploy {
-		errs := autoDeployBranch(env, c.Config(), envType.GitDeployBranches, false)
+		errs := autoDeployBranch(r.Context(), env, c.Config(), envType.GitDeployBranches, false)
 
 		if len(errs) > 0 {
 			errString := errs[0].Error()
@@ -234,6 +234,7 @@ func getGithubWebhookURLFromUID(serverURL, webhookUID string) string {
 }
 
 func autoDeployBranch(
+	ctx context.Context,
 	env *models.Environment,
 	config *config.Config,
 	branches []string,
@@ -249,7 +250,7 @@ func autoDeployBranch(
 
 		go func(errs []error, branch string) {
 			defer wg.Done()
-			errs = append(errs, createWorkflowDispatchForBranch(env, config, onlyNewDeployments, branch)...)
+			errs = append(errs, createWorkflowDispatchForBranch(ctx, env, config, onlyNewDeployments, branch)...)
 		}(errs, branch)
 	}
 
@@ -259,6 +260,7 @@ func autoDeployBranch(
 }
 
 func createWorkflowDispatchForBranch(
+	ctx context.Context,
 	env *models.Environment,
 	config *config.Config,
 	onlyNewDeployments bool,
@@ -274,7 +276,7 @@ func createWorkflowDispatchForBranch(
 
 	var deplID uint
 
-	depl, err := config.Repo.Environment().ReadDeploymentForBranch(env.ID, env.GitRepoOwner, env.GitRepoName, branch)
+	depl, err := config.Repo.Environment().ReadDeploymentForBranch(ctx, env.ID, env.GitRepoOwner, env.GitRepoName, branch)
 
 	if err == nil {
 		if onlyNewDeployments {
@@ -284,7 +286,7 @@ func createWorkflowDispatchForBranch(
 		deplID = depl.ID
 	} else {
 		if errors.Is(err, gorm.ErrRecordNotFound) {
-			depl, err := config.Repo.Environment().CreateDeployment(&models.Deployment{
+			depl, err := config.Repo.Environment().CreateDeployment(ctx, &models.Deployment{
 				EnvironmentID: env.ID,
 				Status:        types.DeploymentStatusCreating,
 				PRName:        fmt.Sprintf("Deployment for branch %s", branch),
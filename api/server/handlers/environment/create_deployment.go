@@ -53,7 +53,7 @@ func (c *CreateDeploymentHandler) ServeHTTP(w http.ResponseWriter, r *http.Reque
 	}
 
 	// read the environment to get the environment id
-	env, err := c.Repo().Environment().ReadEnvironment(project.ID, cluster.ID, uint(ga.InstallationID), owner, name)
+	env, err := c.Repo().Environment().ReadEnvironment(r.Context(), project.ID, cluster.ID, uint(ga.InstallationID), owner, name)
 	if err != nil {
 		if errors.Is(err, gorm.ErrRecordNotFound) {
 			c.HandleAPIError(w, r, apierrors.NewErrNotFound(
@@ -94,7 +94,7 @@ func (c *CreateDeploymentHandler) ServeHTTP(w http.ResponseWriter, r *http.Reque
 	}
 
 	// create the deployment
-	depl, err := c.Repo().Environment().CreateDeployment(&models.Deployment{
+	depl, err := c.Repo().Environment().CreateDeployment(r.Context(), &models.Deployment{
 		EnvironmentID:  env.ID,
 		Namespace:      request.Namespace,
 		Status:         types.DeploymentStatusCreating,
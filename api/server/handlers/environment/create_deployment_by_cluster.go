@@ -66,6 +66,7 @@ func (c *CreateDeploymentByClusterHandler) ServeHTTP(w http.ResponseWriter, r *h
 
 	// read the environment to get the environment id
 	env, err := c.Repo().Environment().ReadEnvironmentByOwnerRepoName(
+		ctx,
 		project.ID, cluster.ID, request.RepoOwner, request.RepoName,
 	)
 	if err != nil {
@@ -116,7 +117,7 @@ func (c *CreateDeploymentByClusterHandler) ServeHTTP(w http.ResponseWriter, r *h
 	}
 
 	// create the deployment
-	depl, err := c.Repo().Environment().CreateDeployment(&models.Deployment{
+	depl, err := c.Repo().Environment().CreateDeployment(ctx, &models.Deployment{
 		EnvironmentID:  env.ID,
 		Namespace:      request.Namespace,
 		Status:         types.DeploymentStatusCreating,
@@ -48,7 +48,7 @@ func (c *DeleteEnvironmentHandler) ServeHTTP(w http.ResponseWriter, r *http.Requ
 	}
 
 	// read the environment to get the environment id
-	env, err := c.Repo().Environment().ReadEnvironment(project.ID, cluster.ID, uint(ga.InstallationID), owner, name)
+	env, err := c.Repo().Environment().ReadEnvironment(r.Context(), project.ID, cluster.ID, uint(ga.InstallationID), owner, name)
 	if err != nil {
 		if errors.Is(err, gorm.ErrRecordNotFound) {
 			c.HandleAPIError(w, r, apierrors.NewErrNotFound(errEnvironmentNotFound))
@@ -66,7 +66,7 @@ func (c *DeleteEnvironmentHandler) ServeHTTP(w http.ResponseWriter, r *http.Requ
 		return
 	}
 
-	depls, err := c.Repo().Environment().ListDeployments(env.ID)
+	depls, err := c.Repo().Environment().ListDeployments(r.Context(), env.ID)
 	if err != nil {
 		c.HandleAPIError(w, r, apierrors.NewErrInternal(err))
 		return
@@ -77,7 +77,7 @@ func (c *DeleteEnvironmentHandler) ServeHTTP(w http.ResponseWriter, r *http.Requ
 			agent.DeleteNamespace(depl.Namespace)
 		}
 
-		if _, err := c.Repo().Environment().DeleteDeployment(depl); err != nil {
+		if _, err := c.Repo().Environment().DeleteDeployment(r.Context(), depl); err != nil {
 			c.HandleAPIError(w, r, apierrors.NewErrInternal(err))
 			return
 		}
@@ -87,7 +87,7 @@ func (c *DeleteEnvironmentHandler) ServeHTTP(w http.ResponseWriter, r *http.Requ
 	webhookUID := env.WebhookID
 
 	// delete the environment
-	env, err = c.Repo().Environment().DeleteEnvironment(env)
+	env, err = c.Repo().Environment().DeleteEnvironment(r.Context(), env)
 
 	if err != nil {
 		c.HandleAPIError(w, r, apierrors.NewErrInternal(err))
@@ -48,7 +48,7 @@ func (c *DeleteDeploymentHandler) ServeHTTP(w http.ResponseWriter, r *http.Reque
 	}
 
 	// read the deployment
-	depl, err := c.Repo().Environment().ReadDeploymentByID(project.ID, cluster.ID, deplID)
+	depl, err := c.Repo().Environment().ReadDeploymentByID(r.Context(), project.ID, cluster.ID, deplID)
 	if err != nil {
 		if errors.Is(err, gorm.ErrRecordNotFound) {
 			c.HandleAPIError(w, r, apierrors.NewErrNotFound(errDeploymentNotFound))
@@ -60,7 +60,7 @@ func (c *DeleteDeploymentHandler) ServeHTTP(w http.ResponseWriter, r *http.Reque
 	}
 
 	// check that the environment belongs to the project and cluster IDs
-	env, err := c.Repo().Environment().ReadEnvironmentByID(project.ID, cluster.ID, depl.EnvironmentID)
+	env, err := c.Repo().Environment().ReadEnvironmentByID(r.Context(), project.ID, cluster.ID, depl.EnvironmentID)
 	if err != nil {
 		if errors.Is(err, gorm.ErrRecordNotFound) {
 			c.HandleAPIError(w, r, apierrors.NewErrNotFound(errEnvironmentNotFound))
@@ -99,7 +99,7 @@ func (c *DeleteDeploymentHandler) ServeHTTP(w http.ResponseWriter, r *http.Reque
 		agent.DeleteNamespace(depl.Namespace)
 	}
 
-	_, err = c.Repo().Environment().DeleteDeployment(depl)
+	_, err = c.Repo().Environment().DeleteDeployment(r.Context(), depl)
 
 	if err != nil {
 		if errors.Is(err, gorm.ErrRecordNotFound) {
@@ -122,7 +122,7 @@ func (c *DeleteDeploymentHandler) ServeHTTP(w http.ResponseWriter, r *http.Reque
 
 	env.GitDeployBranches = strings.Join(newBranches, ",")
 
-	_, err = c.Repo().Environment().UpdateEnvironment(env)
+	_, err = c.Repo().Environment().UpdateEnvironment(r.Context(), env)
 
 	if err != nil {
 		c.HandleAPIError(w, r, apierrors.NewErrInternal(err))
@@ -64,7 +64,7 @@ func (c *EnablePullRequestHandler) ServeHTTP(w http.ResponseWriter, r *http.Requ
 		telemetry.AttributeKV{Key: "updated-at", Value: request.UpdatedAt},
 	)
 
-	env, err := c.Repo().Environment().ReadEnvironmentByOwnerRepoName(project.ID, cluster.ID, request.RepoOwner, request.RepoName)
+	env, err := c.Repo().Environment().ReadEnvironmentByOwnerRepoName(ctx, project.ID, cluster.ID, request.RepoOwner, request.RepoName)
 	if err != nil {
 		err = telemetry.Error(ctx, span, err, "error reading environment by owner repo name")
 
@@ -187,7 +187,7 @@ func (c *EnablePullRequestHandler) ServeHTTP(w http.ResponseWriter, r *http.Requ
 	}
 
 	// create the deployment
-	depl, err := c.Repo().Environment().CreateDeployment(&models.Deployment{
+	depl, err := c.Repo().Environment().CreateDeployment(ctx, &models.Deployment{
 		EnvironmentID: env.ID,
 		Namespace:     "",
 		Status:        types.DeploymentStatusCreating,
@@ -61,7 +61,7 @@ func (c *FinalizeDeploymentHandler) ServeHTTP(w http.ResponseWriter, r *http.Req
 	var err error
 
 	// read the environment to get the environment id
-	env, err := c.Repo().Environment().ReadEnvironment(project.ID, cluster.ID, uint(ga.InstallationID), owner, name)
+	env, err := c.Repo().Environment().ReadEnvironment(r.Context(), project.ID, cluster.ID, uint(ga.InstallationID), owner, name)
 	if err != nil {
 		if errors.Is(err, gorm.ErrRecordNotFound) {
 			c.HandleAPIError(w, r, apierrors.NewErrNotFound(errEnvironmentNotFound))
@@ -76,7 +76,7 @@ func (c *FinalizeDeploymentHandler) ServeHTTP(w http.ResponseWriter, r *http.Req
 
 	// read the deployment
 	if request.PRNumber != 0 {
-		depl, err = c.Repo().Environment().ReadDeploymentByGitDetails(env.ID, owner, name, request.PRNumber)
+		depl, err = c.Repo().Environment().ReadDeploymentByGitDetails(r.Context(), env.ID, owner, name, request.PRNumber)
 
 		if err != nil {
 			if errors.Is(err, gorm.ErrRecordNotFound) {
@@ -88,7 +88,7 @@ func (c *FinalizeDeploymentHandler) ServeHTTP(w http.ResponseWriter, r *http.Req
 			return
 		}
 	} else if request.Namespace != "" {
-		depl, err = c.Repo().Environment().ReadDeployment(env.ID, request.Namespace)
+		depl, err = c.Repo().Environment().ReadDeployment(r.Context(), env.ID, request.Namespace)
 
 		if err != nil {
 			if errors.Is(err, gorm.ErrRecordNotFound) {
@@ -111,7 +111,7 @@ func (c *FinalizeDeploymentHandler) ServeHTTP(w http.ResponseWriter, r *http.Req
 	depl.LastErrors = ""
 
 	// update the deployment
-	depl, err = c.Repo().Environment().UpdateDeployment(depl)
+	depl, err = c.Repo().Environment().UpdateDeployment(r.Context(), depl)
 
 	if err != nil {
 		c.HandleAPIError(w, r, apierrors.NewErrInternal(err))
@@ -178,7 +178,7 @@ func (c *FinalizeDeploymentHandler) ServeHTTP(w http.ResponseWriter, r *http.Req
 			)
 		}
 
-		err = createOrUpdateComment(client, c.Repo(), env.NewCommentsDisabled, depl, github.String(commentBody))
+		err = createOrUpdateComment(r.Context(), client, c.Repo(), env.NewCommentsDisabled, depl, github.String(commentBody))
 
 		if err != nil {
 			c.HandleAPIError(w, r, apierrors.NewErrInternal(err))
@@ -190,6 +190,7 @@ func (c *FinalizeDeploymentHandler) ServeHTTP(w http.ResponseWriter, r *http.Req
 }
 
 func createOrUpdateComment(
+	ctx context.Context,
 	client *github.Client,
 	repo repository.Repository,
 	newCommentsDisabled bool,
@@ -209,7 +210,7 @@ func createOrUpdateComment(
 	if newCommentsDisabled {
 		if depl.GHPRCommentID == 0 {
 			// create a new comment
-			err := createGithubComment(client, repo, depl, commentBody)
+			err := createGithubComment(ctx, client, repo, depl, commentBody)
 			if err != nil {
 				return err
 			}
@@ -221,7 +222,7 @@ func createOrUpdateComment(
 				if strings.Contains(err.Error(), "404") {
 					// perhaps a deleted comment?
 					// create a new comment
-					err := createGithubComment(client, repo, depl, commentBody)
+					err := createGithubComment(ctx, client, repo, depl, commentBody)
 					if err != nil {
 						return fmt.Errorf("invalid github comment ID for deployment with ID: %d. Error creating "+
 							"new comment: %w", depl.ID, err)
@@ -232,7 +233,7 @@ func createOrUpdateComment(
 			}
 		}
 	} else {
-		err := createGithubComment(client, repo, depl, commentBody)
+		err := createGithubComment(ctx, client, repo, depl, commentBody)
 		if err != nil {
 			return err
 		}
@@ -242,6 +243,7 @@ func createOrUpdateComment(
 }
 
 func createGithubComment(
+	ctx context.Context,
 	client *github.Client,
 	repo repository.Repository,
 	depl *models.Deployment,
@@ -263,7 +265,7 @@ func createGithubComment(
 
 	depl.GHPRCommentID = ghResp.GetID()
 
-	_, err = repo.Environment().UpdateDeployment(depl)
+	_, err = repo.Environment().UpdateDeployment(ctx, depl)
 
 	if err != nil {
 		return fmt.Errorf("error updating deployment with ID: %d. Error: %w", depl.ID, err)
@@ -51,6 +51,7 @@ func (c *FinalizeDeploymentByClusterHandler) ServeHTTP(w http.ResponseWriter, r
 
 	// read the environment to get the environment id
 	env, err := c.Repo().Environment().ReadEnvironmentByOwnerRepoName(
+		r.Context(),
 		project.ID, cluster.ID, request.RepoOwner, request.RepoName,
 	)
 
@@ -69,6 +70,7 @@ func (c *FinalizeDeploymentByClusterHandler) ServeHTTP(w http.ResponseWriter, r
 	// read the deployment
 	if request.PRNumber != 0 {
 		depl, err = c.Repo().Environment().ReadDeploymentByGitDetails(
+			r.Context(),
 			env.ID, request.RepoOwner, request.RepoName, request.PRNumber,
 		)
 
@@ -82,7 +84,7 @@ func (c *FinalizeDeploymentByClusterHandler) ServeHTTP(w http.ResponseWriter, r
 			return
 		}
 	} else if request.Namespace != "" {
-		depl, err = c.Repo().Environment().ReadDeployment(env.ID, request.Namespace)
+		depl, err = c.Repo().Environment().ReadDeployment(r.Context(), env.ID, request.Namespace)
 
 		if err != nil {
 			if errors.Is(err, gorm.ErrRecordNotFound) {
@@ -105,7 +107,7 @@ func (c *FinalizeDeploymentByClusterHandler) ServeHTTP(w http.ResponseWriter, r
 	depl.LastErrors = ""
 
 	// update the deployment
-	depl, err = c.Repo().Environment().UpdateDeployment(depl)
+	depl, err = c.Repo().Environment().UpdateDeployment(r.Context(), depl)
 
 	if err != nil {
 		c.HandleAPIError(w, r, apierrors.NewErrInternal(err))
@@ -174,7 +176,7 @@ func (c *FinalizeDeploymentByClusterHandler) ServeHTTP(w http.ResponseWriter, r
 			)
 		}
 
-		err = createOrUpdateComment(client, c.Repo(), env.NewCommentsDisabled, depl, github.String(commentBody))
+		err = createOrUpdateComment(r.Context(), client, c.Repo(), env.NewCommentsDisabled, depl, github.String(commentBody))
 
 		if err != nil {
 			c.HandleAPIError(w, r, apierrors.NewErrInternal(err))
@@ -67,7 +67,7 @@ func (c *FinalizeDeploymentWithErrorsHandler) ServeHTTP(w http.ResponseWriter, r
 	var err error
 
 	// read the environment to get the environment id
-	env, err := c.Repo().Environment().ReadEnvironment(project.ID, cluster.ID, uint(ga.InstallationID), owner, name)
+	env, err := c.Repo().Environment().ReadEnvironment(r.Context(), project.ID, cluster.ID, uint(ga.InstallationID), owner, name)
 	if err != nil {
 		if errors.Is(err, gorm.ErrRecordNotFound) {
 			c.HandleAPIError(w, r, apierrors.NewErrNotFound(errEnvironmentNotFound))
@@ -82,7 +82,7 @@ func (c *FinalizeDeploymentWithErrorsHandler) ServeHTTP(w http.ResponseWriter, r
 
 	// read the deployment
 	if request.PRNumber != 0 {
-		depl, err = c.Repo().Environment().ReadDeploymentByGitDetails(env.ID, owner, name, request.PRNumber)
+		depl, err = c.Repo().Environment().ReadDeploymentByGitDetails(r.Context(), env.ID, owner, name, request.PRNumber)
 
 		if err != nil {
 			if errors.Is(err, gorm.ErrRecordNotFound) {
@@ -94,7 +94,7 @@ func (c *FinalizeDeploymentWithErrorsHandler) ServeHTTP(w http.ResponseWriter, r
 			return
 		}
 	} else if request.Namespace != "" {
-		depl, err = c.Repo().Environment().ReadDeployment(env.ID, request.Namespace)
+		depl, err = c.Repo().Environment().ReadDeployment(r.Context(), env.ID, request.Namespace)
 
 		if err != nil {
 			if errors.Is(err, gorm.ErrRecordNotFound) {
@@ -130,7 +130,7 @@ func (c *FinalizeDeploymentWithErrorsHandler) ServeHTTP(w http.ResponseWriter, r
 
 	// we do not care of the error in this case because the list deployments endpoint
 	// talks to the github API to fetch the deployment status correctly
-	c.Repo().Environment().UpdateDeployment(depl)
+	c.Repo().Environment().UpdateDeployment(r.Context(), depl)
 
 	// FIXME: ignore the status of this API call for now
 	client.Repositories.CreateDeploymentStatus(
@@ -193,7 +193,7 @@ func (c *FinalizeDeploymentWithErrorsHandler) ServeHTTP(w http.ResponseWriter, r
 			commentBody += fmt.Sprintf("<details>\n  <summary><code>%s</code></summary>\n\n  **Error:** %s\n</details>\n", res, err)
 		}
 
-		err = createOrUpdateComment(client, c.Repo(), env.NewCommentsDisabled, depl, github.String(commentBody))
+		err = createOrUpdateComment(r.Context(), client, c.Repo(), env.NewCommentsDisabled, depl, github.String(commentBody))
 
 		if err != nil {
 			c.HandleAPIError(w, r, apierrors.NewErrInternal(err))
@@ -60,6 +60,7 @@ func (c *FinalizeDeploymentWithErrorsByClusterHandler) ServeHTTP(w http.Response
 
 	// read the environment to get the environment id
 	env, err := c.Repo().Environment().ReadEnvironmentByOwnerRepoName(
+		r.Context(),
 		project.ID, cluster.ID, request.RepoOwner, request.RepoName,
 	)
 
@@ -78,6 +79,7 @@ func (c *FinalizeDeploymentWithErrorsByClusterHandler) ServeHTTP(w http.Response
 	// read the deployment
 	if request.PRNumber != 0 {
 		depl, err = c.Repo().Environment().ReadDeploymentByGitDetails(
+			r.Context(),
 			env.ID, request.RepoOwner, request.RepoName, request.PRNumber,
 		)
 
@@ -91,7 +93,7 @@ func (c *FinalizeDeploymentWithErrorsByClusterHandler) ServeHTTP(w http.Response
 			return
 		}
 	} else if request.Namespace != "" {
-		depl, err = c.Repo().Environment().ReadDeployment(env.ID, request.Namespace)
+		depl, err = c.Repo().Environment().ReadDeployment(r.Context(), env.ID, request.Namespace)
 
 		if err != nil {
 			if errors.Is(err, gorm.ErrRecordNotFound) {
@@ -128,7 +130,7 @@ func (c *FinalizeDeploymentWithErrorsByClusterHandler) ServeHTTP(w http.Response
 
 	// we do not care of the error in this case because the list deployments endpoint
 	// talks to the github API to fetch the deployment status correctly
-	_, _ = c.Repo().Environment().UpdateDeployment(depl)
+	_, _ = c.Repo().Environment().UpdateDeployment(r.Context(), depl)
 
 	// FIXME: ignore the status of this API call for now
 	client.Repositories.CreateDeploymentStatus(
@@ -193,7 +195,7 @@ func (c *FinalizeDeploymentWithErrorsByClusterHandler) ServeHTTP(w http.Response
 			commentBody += fmt.Sprintf("<details>\n  <summary><code>%s</code></summary>\n\n  **Error:** %s\n</details>\n", res, err)
 		}
 
-		err = createOrUpdateComment(client, c.Repo(), env.NewCommentsDisabled, depl, github.String(commentBody))
+		err = createOrUpdateComment(r.Context(), client, c.Repo(), env.NewCommentsDisabled, depl, github.String(commentBody))
 
 		if err != nil {
 			c.HandleAPIError(w, r, apierrors.NewErrInternal(err))
@@ -29,7 +29,7 @@ func (c *ListEnvironmentHandler) ServeHTTP(w http.ResponseWriter, r *http.Reques
 	project, _ := r.Context().Value(types.ProjectScope).(*models.Project)
 	cluster, _ := r.Context().Value(types.ClusterScope).(*models.Cluster)
 
-	envs, err := c.Repo().Environment().ListEnvironments(project.ID, cluster.ID)
+	envs, err := c.Repo().Environment().ListEnvironments(r.Context(), project.ID, cluster.ID)
 	if err != nil {
 		c.HandleAPIError(w, r, apierrors.NewErrPassThroughToClient(
 			fmt.Errorf("error listing environments"), http.StatusInternalServerError, err.Error(),
@@ -42,7 +42,7 @@ func (c *ListEnvironmentHandler) ServeHTTP(w http.ResponseWriter, r *http.Reques
 	for _, env := range envs {
 		environment := env.ToEnvironmentType()
 
-		depls, err := c.Repo().Environment().ListDeployments(env.ID)
+		depls, err := c.Repo().Environment().ListDeployments(r.Context(), env.ID)
 		if err != nil {
 			c.HandleAPIError(w, r, apierrors.NewErrPassThroughToClient(
 				fmt.Errorf("error listing environments: error listing deployments for environment ID %d", env.ID),
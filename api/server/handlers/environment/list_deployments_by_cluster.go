@@ -53,7 +53,7 @@ func (c *ListDeploymentsByClusterHandler) ServeHTTP(w http.ResponseWriter, r *ht
 	var pullRequests []*types.PullRequest
 
 	if req.EnvironmentID == 0 {
-		depls, err := c.Repo().Environment().ListDeploymentsByCluster(project.ID, cluster.ID)
+		depls, err := c.Repo().Environment().ListDeploymentsByCluster(ctx, project.ID, cluster.ID)
 		if err != nil {
 			err = telemetry.Error(ctx, span, err, "failed to list deployments from cluster")
 			c.HandleAPIError(w, r, apierrors.NewErrPassThroughToClient(err, http.StatusInternalServerError))
@@ -68,7 +68,7 @@ func (c *ListDeploymentsByClusterHandler) ServeHTTP(w http.ResponseWriter, r *ht
 				"%s-%s-%d", deployment.RepoOwner, deployment.RepoName, deployment.PullRequestID,
 			)] = true
 
-			env, err := c.Repo().Environment().ReadEnvironmentByID(project.ID, cluster.ID, deployment.EnvironmentID)
+			env, err := c.Repo().Environment().ReadEnvironmentByID(ctx, project.ID, cluster.ID, deployment.EnvironmentID)
 			if err != nil {
 				err = telemetry.Error(ctx, span, err, "failed to get environment from deployment")
 				c.HandleAPIError(w, r, apierrors.NewErrPassThroughToClient(err, http.StatusInternalServerError))
@@ -86,7 +86,7 @@ func (c *ListDeploymentsByClusterHandler) ServeHTTP(w http.ResponseWriter, r *ht
 		wg.Add(len(deployments))
 
 		for _, deployment := range deployments {
-			env, err := c.Repo().Environment().ReadEnvironmentByID(project.ID, cluster.ID, deployment.EnvironmentID)
+			env, err := c.Repo().Environment().ReadEnvironmentByID(ctx, project.ID, cluster.ID, deployment.EnvironmentID)
 			if err != nil {
 				err = telemetry.Error(ctx, span, err, "failed to get environment from deployment")
 				c.HandleAPIError(w, r, apierrors.NewErrPassThroughToClient(err, http.StatusInternalServerError))
@@ -113,7 +113,7 @@ func (c *ListDeploymentsByClusterHandler) ServeHTTP(w http.ResponseWriter, r *ht
 
 		wg.Wait()
 
-		envList, err := c.Repo().Environment().ListEnvironments(project.ID, cluster.ID)
+		envList, err := c.Repo().Environment().ListEnvironments(ctx, project.ID, cluster.ID)
 		if err != nil {
 			c.HandleAPIError(w, r, apierrors.NewErrInternal(err))
 			return
@@ -141,14 +141,14 @@ func (c *ListDeploymentsByClusterHandler) ServeHTTP(w http.ResponseWriter, r *ht
 			pullRequests = append(pullRequests, prs...)
 		}
 	} else {
-		env, err := c.Repo().Environment().ReadEnvironmentByID(project.ID, cluster.ID, req.EnvironmentID)
+		env, err := c.Repo().Environment().ReadEnvironmentByID(ctx, project.ID, cluster.ID, req.EnvironmentID)
 		if err != nil {
 			err = telemetry.Error(ctx, span, err, "error fetching environment")
 			c.HandleAPIError(w, r, apierrors.NewErrPassThroughToClient(err, http.StatusInternalServerError))
 			return
 		}
 
-		depls, err := c.Repo().Environment().ListDeployments(env.ID)
+		depls, err := c.Repo().Environment().ListDeployments(ctx, env.ID)
 		if err != nil {
 			err = telemetry.Error(ctx, span, err, "error listing deployments")
 			c.HandleAPIError(w, r, apierrors.NewErrPassThroughToClient(err, http.StatusInternalServerError))
@@ -43,7 +43,7 @@ func (c *ReenableDeploymentHandler) ServeHTTP(w http.ResponseWriter, r *http.Req
 		return
 	}
 
-	depl, err := c.Repo().Environment().ReadDeploymentByID(project.ID, cluster.ID, deplID)
+	depl, err := c.Repo().Environment().ReadDeploymentByID(r.Context(), project.ID, cluster.ID, deplID)
 	if err != nil {
 		c.HandleAPIError(w, r, apierrors.NewErrInternal(err))
 		return
@@ -56,7 +56,7 @@ func (c *ReenableDeploymentHandler) ServeHTTP(w http.ResponseWriter, r *http.Req
 		return
 	}
 
-	env, err := c.Repo().Environment().ReadEnvironmentByID(project.ID, cluster.ID, depl.EnvironmentID)
+	env, err := c.Repo().Environment().ReadEnvironmentByID(r.Context(), project.ID, cluster.ID, depl.EnvironmentID)
 	if err != nil {
 		c.HandleAPIError(w, r, apierrors.NewErrInternal(err))
 		return
@@ -88,7 +88,7 @@ func (c *ReenableDeploymentHandler) ServeHTTP(w http.ResponseWriter, r *http.Req
 
 	depl.Status = types.DeploymentStatusCreating
 
-	depl, err = c.Repo().Environment().UpdateDeployment(depl)
+	depl, err = c.Repo().Environment().UpdateDeployment(r.Context(), depl)
 
 	if err != nil {
 		c.HandleAPIError(w, r, apierrors.NewErrInternal(err))
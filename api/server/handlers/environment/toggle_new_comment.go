@@ -49,7 +49,7 @@ func (c *ToggleNewCommentHandler) ServeHTTP(w http.ResponseWriter, r *http.Reque
 		return
 	}
 
-	env, err := c.Repo().Environment().ReadEnvironmentByID(project.ID, cluster.ID, environmentID)
+	env, err := c.Repo().Environment().ReadEnvironmentByID(r.Context(), project.ID, cluster.ID, environmentID)
 	if err != nil {
 		if errors.Is(err, gorm.ErrRecordNotFound) {
 			c.HandleAPIError(w, r, apierrors.NewErrNotFound(fmt.Errorf("no such environment with ID: %d", environmentID)))
@@ -63,7 +63,7 @@ func (c *ToggleNewCommentHandler) ServeHTTP(w http.ResponseWriter, r *http.Reque
 	if env.NewCommentsDisabled != request.Disable {
 		env.NewCommentsDisabled = request.Disable
 
-		_, err = c.Repo().Environment().UpdateEnvironment(env)
+		_, err = c.Repo().Environment().UpdateEnvironment(r.Context(), env)
 
 		if err != nil {
 			c.HandleAPIError(w, r, apierrors.NewErrInternal(err))
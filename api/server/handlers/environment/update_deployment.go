@@ -60,13 +60,13 @@ func (c *UpdateDeploymentHandler) ServeHTTP(w http.ResponseWriter, r *http.Reque
 	var err error
 
 	// read the environment to get the environment id
-	env, err := c.Repo().Environment().ReadEnvironment(project.ID, cluster.ID, uint(ga.InstallationID), owner, name)
+	env, err := c.Repo().Environment().ReadEnvironment(r.Context(), project.ID, cluster.ID, uint(ga.InstallationID), owner, name)
 	if err != nil {
 		c.HandleAPIError(w, r, apierrors.NewErrInternal(err))
 		return
 	}
 
-	depl, err := c.Repo().Environment().ReadDeploymentForBranch(env.ID, owner, name, request.PRBranchFrom)
+	depl, err := c.Repo().Environment().ReadDeploymentForBranch(r.Context(), env.ID, owner, name, request.PRBranchFrom)
 
 	if err != nil && !errors.Is(err, gorm.ErrRecordNotFound) {
 		c.HandleAPIError(w, r, apierrors.NewErrInternal(err))
@@ -75,7 +75,7 @@ func (c *UpdateDeploymentHandler) ServeHTTP(w http.ResponseWriter, r *http.Reque
 
 	if depl == nil {
 		if request.PRNumber != 0 {
-			depl, err = c.Repo().Environment().ReadDeploymentByGitDetails(env.ID, owner, name, request.PRNumber)
+			depl, err = c.Repo().Environment().ReadDeploymentByGitDetails(r.Context(), env.ID, owner, name, request.PRNumber)
 
 			if err != nil {
 				if errors.Is(err, gorm.ErrRecordNotFound) {
@@ -87,7 +87,7 @@ func (c *UpdateDeploymentHandler) ServeHTTP(w http.ResponseWriter, r *http.Reque
 				return
 			}
 		} else if request.Namespace != "" {
-			depl, err = c.Repo().Environment().ReadDeployment(env.ID, request.Namespace)
+			depl, err = c.Repo().Environment().ReadDeployment(r.Context(), env.ID, request.Namespace)
 
 			if err != nil {
 				if errors.Is(err, gorm.ErrRecordNotFound) {
@@ -142,7 +142,7 @@ func (c *UpdateDeploymentHandler) ServeHTTP(w http.ResponseWriter, r *http.Reque
 	depl.CommitSHA = request.CommitSHA
 
 	// update the deployment
-	depl, err = c.Repo().Environment().UpdateDeployment(depl)
+	depl, err = c.Repo().Environment().UpdateDeployment(r.Context(), depl)
 
 	if err != nil {
 		c.HandleAPIError(w, r, apierrors.NewErrInternal(err))
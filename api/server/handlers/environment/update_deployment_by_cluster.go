@@ -52,6 +52,7 @@ func (c *UpdateDeploymentByClusterHandler) ServeHTTP(w http.ResponseWriter, r *h
 
 	// read the environment to get the environment id
 	env, err := c.Repo().Environment().ReadEnvironmentByOwnerRepoName(
+		r.Context(),
 		project.ID, cluster.ID, request.RepoOwner, request.RepoName,
 	)
 
@@ -61,6 +62,7 @@ func (c *UpdateDeploymentByClusterHandler) ServeHTTP(w http.ResponseWriter, r *h
 	}
 
 	depl, err := c.Repo().Environment().ReadDeploymentForBranch(
+		r.Context(),
 		env.ID, request.RepoOwner, request.RepoName, request.PRBranchFrom,
 	)
 
@@ -72,6 +74,7 @@ func (c *UpdateDeploymentByClusterHandler) ServeHTTP(w http.ResponseWriter, r *h
 	if depl == nil {
 		if request.PRNumber != 0 {
 			depl, err = c.Repo().Environment().ReadDeploymentByGitDetails(
+				r.Context(),
 				env.ID, request.RepoOwner, request.RepoName, request.PRNumber,
 			)
 
@@ -85,7 +88,7 @@ func (c *UpdateDeploymentByClusterHandler) ServeHTTP(w http.ResponseWriter, r *h
 				return
 			}
 		} else if request.Namespace != "" {
-			depl, err = c.Repo().Environment().ReadDeployment(env.ID, request.Namespace)
+			depl, err = c.Repo().Environment().ReadDeployment(r.Context(), env.ID, request.Namespace)
 
 			if err != nil {
 				if errors.Is(err, gorm.ErrRecordNotFound) {
@@ -143,7 +146,7 @@ func (c *UpdateDeploymentByClusterHandler) ServeHTTP(w http.ResponseWriter, r *h
 	depl.CommitSHA = request.CommitSHA
 
 	// update the deployment
-	depl, err = c.Repo().Environment().UpdateDeployment(depl)
+	depl, err = c.Repo().Environment().UpdateDeployment(r.Context(), depl)
 
 	if err != nil {
 		c.HandleAPIError(w, r, apierrors.NewErrInternal(err))
@@ -60,7 +60,7 @@ func (c *UpdateDeploymentStatusHandler) ServeHTTP(w http.ResponseWriter, r *http
 	var err error
 
 	// read the environment to get the environment id
-	env, err := c.Repo().Environment().ReadEnvironment(project.ID, cluster.ID, uint(ga.InstallationID), owner, name)
+	env, err := c.Repo().Environment().ReadEnvironment(r.Context(), project.ID, cluster.ID, uint(ga.InstallationID), owner, name)
 	if err != nil {
 		if errors.Is(err, gorm.ErrRecordNotFound) {
 			c.HandleAPIError(w, r, apierrors.NewErrNotFound(errEnvironmentNotFound))
@@ -71,7 +71,7 @@ func (c *UpdateDeploymentStatusHandler) ServeHTTP(w http.ResponseWriter, r *http
 		return
 	}
 
-	depl, err := c.Repo().Environment().ReadDeploymentForBranch(env.ID, owner, name, request.PRBranchFrom)
+	depl, err := c.Repo().Environment().ReadDeploymentForBranch(r.Context(), env.ID, owner, name, request.PRBranchFrom)
 
 	if err != nil && !errors.Is(err, gorm.ErrRecordNotFound) {
 		c.HandleAPIError(w, r, apierrors.NewErrInternal(err))
@@ -80,7 +80,7 @@ func (c *UpdateDeploymentStatusHandler) ServeHTTP(w http.ResponseWriter, r *http
 
 	if depl == nil {
 		if request.PRNumber != 0 {
-			depl, err = c.Repo().Environment().ReadDeploymentByGitDetails(env.ID, owner, name, request.PRNumber)
+			depl, err = c.Repo().Environment().ReadDeploymentByGitDetails(r.Context(), env.ID, owner, name, request.PRNumber)
 
 			if err != nil {
 				if errors.Is(err, gorm.ErrRecordNotFound) {
@@ -92,7 +92,7 @@ func (c *UpdateDeploymentStatusHandler) ServeHTTP(w http.ResponseWriter, r *http
 				return
 			}
 		} else if request.Namespace != "" {
-			depl, err = c.Repo().Environment().ReadDeployment(env.ID, request.Namespace)
+			depl, err = c.Repo().Environment().ReadDeployment(r.Context(), env.ID, request.Namespace)
 
 			if err != nil {
 				if errors.Is(err, gorm.ErrRecordNotFound) {
@@ -146,7 +146,7 @@ func (c *UpdateDeploymentStatusHandler) ServeHTTP(w http.ResponseWriter, r *http
 	depl.Status = types.DeploymentStatus(request.Status)
 
 	// create the deployment
-	depl, err = c.Repo().Environment().UpdateDeployment(depl)
+	depl, err = c.Repo().Environment().UpdateDeployment(r.Context(), depl)
 
 	if err != nil {
 		c.HandleAPIError(w, r, apierrors.NewErrInternal(err))
@@ -52,6 +52,7 @@ func (c *UpdateDeploymentStatusByClusterHandler) ServeHTTP(w http.ResponseWriter
 
 	// read the environment to get the environment id
 	env, err := c.Repo().Environment().ReadEnvironmentByOwnerRepoName(
+		r.Context(),
 		project.ID, cluster.ID, request.RepoOwner, request.RepoName,
 	)
 
@@ -66,6 +67,7 @@ func (c *UpdateDeploymentStatusByClusterHandler) ServeHTTP(w http.ResponseWriter
 	}
 
 	depl, err := c.Repo().Environment().ReadDeploymentForBranch(
+		r.Context(),
 		env.ID, request.RepoOwner, request.RepoName, request.PRBranchFrom,
 	)
 
@@ -77,6 +79,7 @@ func (c *UpdateDeploymentStatusByClusterHandler) ServeHTTP(w http.ResponseWriter
 	if depl == nil {
 		if request.PRNumber != 0 {
 			depl, err = c.Repo().Environment().ReadDeploymentByGitDetails(
+				r.Context(),
 				env.ID, request.RepoOwner, request.RepoName, request.PRNumber,
 			)
 
@@ -90,7 +93,7 @@ func (c *UpdateDeploymentStatusByClusterHandler) ServeHTTP(w http.ResponseWriter
 				return
 			}
 		} else if request.Namespace != "" {
-			depl, err = c.Repo().Environment().ReadDeployment(env.ID, request.Namespace)
+			depl, err = c.Repo().Environment().ReadDeployment(r.Context(), env.ID, request.Namespace)
 
 			if err != nil {
 				if errors.Is(err, gorm.ErrRecordNotFound) {
@@ -146,7 +149,7 @@ func (c *UpdateDeploymentStatusByClusterHandler) ServeHTTP(w http.ResponseWriter
 	depl.Status = types.DeploymentStatus(request.Status)
 
 	// create the deployment
-	depl, err = c.Repo().Environment().UpdateDeployment(depl)
+	depl, err = c.Repo().Environment().UpdateDeployment(r.Context(), depl)
 
 	if err != nil {
 		c.HandleAPIError(w, r, apierrors.NewErrInternal(err))
@@ -72,7 +72,7 @@ func (c *UpdateEnvironmentSettingsHandler) ServeHTTP(w http.ResponseWriter, r *h
 		telemetry.AttributeKV{Key: "git-deploy-branches", Value: request.GitDeployBranches},
 	)
 
-	env, err := c.Repo().Environment().ReadEnvironmentByID(project.ID, cluster.ID, envID)
+	env, err := c.Repo().Environment().ReadEnvironmentByID(ctx, project.ID, cluster.ID, envID)
 	if err != nil {
 		err = telemetry.Error(ctx, span, err, "could not read environment by id")
 
@@ -167,7 +167,7 @@ func (c *UpdateEnvironmentSettingsHandler) ServeHTTP(w http.ResponseWriter, r *h
 		env.GitDeployBranches = strings.Join(request.GitDeployBranches, ",")
 
 		if len(request.GitDeployBranches) > 0 && c.Config().ServerConf.EnableAutoPreviewBranchDeploy {
-			errs := autoDeployBranch(env, c.Config(), request.GitDeployBranches, true)
+			errs := autoDeployBranch(ctx, env, c.Config(), request.GitDeployBranches, true)
 
 			if len(errs) > 0 {
 				errString := errs[0].Error()
@@ -212,7 +212,7 @@ func (c *UpdateEnvironmentSettingsHandler) ServeHTTP(w http.ResponseWriter, r *h
 	}
 
 	if changed {
-		env, err = c.Repo().Environment().UpdateEnvironment(env)
+		env, err = c.Repo().Environment().UpdateEnvironment(ctx, env)
 
 		if err != nil {
 			err = telemetry.Error(ctx, span, err, "could not update environment")
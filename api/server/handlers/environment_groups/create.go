@@ -1,6 +1,7 @@
 package environment_groups
 
 import (
+	"fmt"
 	"net/http"
 	"time"
 
@@ -40,11 +41,18 @@ type UpdateEnvironmentGroupRequest struct {
 
 	// SecretVariables are sensitive values. All values must be a string due to a kubernetes limitation.
 	SecretVariables map[string]string `json:"secret_variables"`
+
+	// ExpectedVersion is the latest version of the env group that the update is based on. If set, the update is rejected if the env group
+	// has been updated since, so that concurrent updates do not silently overwrite each other. Set to 0 to skip the check.
+	ExpectedVersion int `json:"expected_version,omitempty"`
 }
 type UpdateEnvironmentGroupResponse struct {
 	// Name of the env group to create or update
 	Name string `json:"name"`
 
+	// Version is the version of the env group created by the update
+	Version int `json:"version"`
+
 	// Variables are variables which should are not sensitive. All values must be a string due to a kubernetes limitation.
 	Variables map[string]string `json:"variables,omitempty"`
 
@@ -62,10 +70,13 @@ func (c *UpdateEnvironmentGroupHandler) ServeHTTP(w http.ResponseWriter, r *http
 	if ok := c.DecodeAndValidate(w, r, request); !ok {
 		return
 	}
+	user, _ := ctx.Value(types.UserScope).(*models.User)
+	project, _ := ctx.Value(types.ProjectScope).(*models.Project)
 	cluster, _ := ctx.Value(types.ClusterScope).(*models.Cluster)
 
 	telemetry.WithAttributes(span,
 		telemetry.AttributeKV{Key: "environment-group-name", Value: request.Name},
+		telemetry.AttributeKV{Key: "expected-version", Value: request.ExpectedVersion},
 	)
 
 	agent, err := c.GetAgent(r, cluster, "")
@@ -75,6 +86,22 @@ func (c *UpdateEnvironmentGroupHandler) ServeHTTP(w http.ResponseWriter, r *http
 		return
 	}
 
+	if request.ExpectedVersion != 0 {
+		latest, err := environment_groups.LatestBaseEnvironmentGroup(ctx, agent, request.Name)
+		if err != nil {
+			err := telemetry.Error(ctx, span, err, "unable to get latest environment group")
+			c.HandleAPIError(w, r, apierrors.NewErrPassThroughToClient(err, http.StatusInternalServerError))
+			return
+		}
+
+		if latest.Version != request.ExpectedVersion {
+			telemetry.WithAttributes(span, telemetry.AttributeKV{Key: "latest-version", Value: latest.Version})
+			err := telemetry.Error(ctx, span, nil, fmt.Sprintf("environment group has been updated since version %d, latest version is %d", request.ExpectedVersion, latest.Version))
+			c.HandleAPIError(w, r, apierrors.NewErrPassThroughToClient(err, http.StatusConflict))
+			return
+		}
+	}
+
 	secrets := make(map[string][]byte)
 	for k, v := range request.SecretVariables {
 		secrets[k] = []byte(v)
@@ -87,27 +114,31 @@ func (c *UpdateEnvironmentGroupHandler) ServeHTTP(w http.ResponseWriter, r *http
 		CreatedAtUTC:    time.Now().UTC(),
 	}
 
-	err = environment_groups.CreateOrUpdateBaseEnvironmentGroup(ctx, agent, envGroup)
+	version, err := environment_groups.CreateOrUpdateBaseEnvironmentGroup(ctx, agent, envGroup)
 	if err != nil {
 		err := telemetry.Error(ctx, span, err, "unable to create or update environment group")
 		c.HandleAPIError(w, r, apierrors.NewErrPassThroughToClient(err, http.StatusInternalServerError))
 		return
 	}
+	telemetry.WithAttributes(span, telemetry.AttributeKV{Key: "version", Value: version})
+
+	_, err = c.Repo().EnvironmentGroupVersion().CreateEnvironmentGroupVersion(ctx, &models.EnvironmentGroupVersion{
+		ProjectID: project.ID,
+		ClusterID: cluster.ID,
+		Name:      envGroup.Name,
+		Version:   version,
+		CreatedBy: user.Email,
+	})
+	if err != nil {
+		err := telemetry.Error(ctx, span, err, "unable to record environment group version")
+		c.HandleAPIError(w, r, apierrors.NewErrPassThroughToClient(err, http.StatusInternalServerError))
+		return
+	}
 
 	envGroupResponse := &UpdateEnvironmentGroupResponse{
 		Name:      envGroup.Name,
+		Version:   version,
 		CreatedAt: envGroup.CreatedAtUTC,
 	}
 	c.WriteResult(w, r, envGroupResponse)
-
-	// TODO: Syncing applications that are linked is currently done by the frontend. This should be done entirely
-	// applicationsToSync, err := environment_groups.LinkedApplications(ctx, agent, envGroup.Name)
-	// if err != nil {
-	// 	err := telemetry.Error(ctx, span, err, "unable to find linked applications for environment group")
-	// 	c.HandleAPIError(w, r, apierrors.NewErrPassThroughToClient(err, http.StatusInternalServerError))
-	// 	return
-	// }
-	// for _, app := range applicationsToSync {
-	// 	TODO: Call porter app update
-	// }
 }
@@ -68,4 +68,11 @@ func (c *DeleteEnvironmentGroupHandler) ServeHTTP(w http.ResponseWriter, r *http
 		c.HandleAPIError(w, r, apierrors.NewErrPassThroughToClient(err, http.StatusInternalServerError))
 		return
 	}
+
+	err = c.Repo().EnvironmentGroupVersion().DeleteEnvironmentGroupVersions(ctx, cluster.ID, request.Name)
+	if err != nil {
+		err := telemetry.Error(ctx, span, err, "unable to delete environment group versions")
+		c.HandleAPIError(w, r, apierrors.NewErrPassThroughToClient(err, http.StatusInternalServerError))
+		return
+	}
 }
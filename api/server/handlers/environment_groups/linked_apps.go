@@ -0,0 +1,119 @@
+package environment_groups
+
+import (
+	"net/http"
+	"sort"
+	"strings"
+
+	"github.com/porter-dev/porter/api/server/authz"
+	"github.com/porter-dev/porter/api/server/handlers"
+	"github.com/porter-dev/porter/api/server/shared"
+	"github.com/porter-dev/porter/api/server/shared/apierrors"
+	"github.com/porter-dev/porter/api/server/shared/config"
+	"github.com/porter-dev/porter/api/server/shared/requestutils"
+	"github.com/porter-dev/porter/api/types"
+	environmentgroups "github.com/porter-dev/porter/internal/kubernetes/environment_groups"
+	"github.com/porter-dev/porter/internal/models"
+	"github.com/porter-dev/porter/internal/telemetry"
+)
+
+// ListLinkedApplicationsHandler handles requests to the /environment-groups/{name}/linked-apps endpoint
+type ListLinkedApplicationsHandler struct {
+	handlers.PorterHandlerReadWriter
+	authz.KubernetesAgentGetter
+}
+
+// NewListLinkedApplicationsHandler returns a new ListLinkedApplicationsHandler
+func NewListLinkedApplicationsHandler(
+	config *config.Config,
+	decoderValidator shared.RequestDecoderValidator,
+	writer shared.ResultWriter,
+) *ListLinkedApplicationsHandler {
+	return &ListLinkedApplicationsHandler{
+		PorterHandlerReadWriter: handlers.NewDefaultPorterHandler(config, decoderValidator, writer),
+		KubernetesAgentGetter:   authz.NewOutOfClusterAgentGetter(config),
+	}
+}
+
+// ListLinkedApplicationsResponse is the response object for the /environment-groups/{name}/linked-apps endpoint
+type ListLinkedApplicationsResponse struct {
+	Name         string              `json:"name"`
+	Applications []LinkedApplication `json:"applications"`
+}
+
+// LinkedApplication is an application which loads the variables of an environment group
+type LinkedApplication struct {
+	// Name is the porter app name, derived from the namespace of the application
+	Name      string `json:"name"`
+	Namespace string `json:"namespace"`
+	// Workloads are the deployments and cronjobs of the application which reference the environment group
+	Workloads []string `json:"workloads"`
+}
+
+// ServeHTTP lists the applications which are linked to an environment group
+func (c *ListLinkedApplicationsHandler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	ctx, span := telemetry.NewSpan(r.Context(), "serve-list-env-group-linked-apps")
+	defer span.End()
+
+	cluster, _ := ctx.Value(types.ClusterScope).(*models.Cluster)
+
+	name, reqErr := requestutils.GetURLParamString(r, types.URLParamEnvGroupName)
+	if reqErr != nil {
+		err := telemetry.Error(ctx, span, reqErr, "error parsing environment group name from url")
+		c.HandleAPIError(w, r, apierrors.NewErrPassThroughToClient(err, http.StatusBadRequest))
+		return
+	}
+	telemetry.WithAttributes(span, telemetry.AttributeKV{Key: "environment-group-name", Value: name})
+
+	agent, err := c.GetAgent(r, cluster, "")
+	if err != nil {
+		err = telemetry.Error(ctx, span, err, "unable to connect to cluster")
+		c.HandleAPIError(w, r, apierrors.NewErrPassThroughToClient(err, http.StatusServiceUnavailable))
+		return
+	}
+
+	applications, err := environmentgroups.LinkedApplications(ctx, agent, name)
+	if err != nil {
+		err = telemetry.Error(ctx, span, err, "unable to get linked applications")
+		c.HandleAPIError(w, r, apierrors.NewErrPassThroughToClient(err, http.StatusInternalServerError))
+		return
+	}
+
+	c.WriteResult(w, r, ListLinkedApplicationsResponse{
+		Name:         name,
+		Applications: groupLinkedApplications(applications),
+	})
+}
+
+// groupLinkedApplications groups the linked workloads by the namespace of the porter app which they belong to
+func groupLinkedApplications(workloads []environmentgroups.LinkedPorterApplication) []LinkedApplication {
+	byNamespace := make(map[string]*LinkedApplication)
+	for _, workload := range workloads {
+		if workload.Namespace == "" {
+			continue
+		}
+
+		app, ok := byNamespace[workload.Namespace]
+		if !ok {
+			app = &LinkedApplication{
+				Name:      strings.TrimPrefix(workload.Namespace, "porter-stack-"),
+				Namespace: workload.Namespace,
+				Workloads: []string{},
+			}
+			byNamespace[workload.Namespace] = app
+		}
+		app.Workloads = append(app.Workloads, workload.Name)
+	}
+
+	applications := []LinkedApplication{}
+	for _, app := range byNamespace {
+		sort.Strings(app.Workloads)
+		applications = append(applications, *app)
+	}
+
+	sort.Slice(applications, func(i, j int) bool {
+		return applications[i].Namespace < applications[j].Namespace
+	})
+
+	return applications
+}
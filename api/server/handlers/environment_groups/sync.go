@@ -0,0 +1,121 @@
+package environment_groups
+
+import (
+	"errors"
+	"net/http"
+	"time"
+
+	"github.com/porter-dev/porter/api/server/authz"
+	"github.com/porter-dev/porter/api/server/handlers"
+	"github.com/porter-dev/porter/api/server/shared"
+	"github.com/porter-dev/porter/api/server/shared/apierrors"
+	"github.com/porter-dev/porter/api/server/shared/config"
+	"github.com/porter-dev/porter/api/server/shared/requestutils"
+	"github.com/porter-dev/porter/api/types"
+	environmentgroups "github.com/porter-dev/porter/internal/kubernetes/environment_groups"
+	"github.com/porter-dev/porter/internal/models"
+	"github.com/porter-dev/porter/internal/telemetry"
+	"gorm.io/gorm"
+)
+
+// SyncLinkedApplicationsHandler handles requests to the /environment-groups/{name}/sync endpoint
+type SyncLinkedApplicationsHandler struct {
+	handlers.PorterHandlerReadWriter
+	authz.KubernetesAgentGetter
+}
+
+// NewSyncLinkedApplicationsHandler returns a new SyncLinkedApplicationsHandler
+func NewSyncLinkedApplicationsHandler(
+	config *config.Config,
+	decoderValidator shared.RequestDecoderValidator,
+	writer shared.ResultWriter,
+) *SyncLinkedApplicationsHandler {
+	return &SyncLinkedApplicationsHandler{
+		PorterHandlerReadWriter: handlers.NewDefaultPorterHandler(config, decoderValidator, writer),
+		KubernetesAgentGetter:   authz.NewOutOfClusterAgentGetter(config),
+	}
+}
+
+// SyncLinkedApplicationsResponse is the response object for the /environment-groups/{name}/sync endpoint
+type SyncLinkedApplicationsResponse struct {
+	Name string `json:"name"`
+	// Version is the version of the environment group that the linked applications were synced to
+	Version      int                 `json:"version"`
+	Applications []LinkedApplication `json:"applications"`
+}
+
+// ServeHTTP syncs the latest version of an environment group to all linked applications, which redeploys them. Either all linked applications
+// are updated, or none are.
+func (c *SyncLinkedApplicationsHandler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	ctx, span := telemetry.NewSpan(r.Context(), "serve-sync-env-group-linked-apps")
+	defer span.End()
+
+	cluster, _ := ctx.Value(types.ClusterScope).(*models.Cluster)
+
+	name, reqErr := requestutils.GetURLParamString(r, types.URLParamEnvGroupName)
+	if reqErr != nil {
+		err := telemetry.Error(ctx, span, reqErr, "error parsing environment group name from url")
+		c.HandleAPIError(w, r, apierrors.NewErrPassThroughToClient(err, http.StatusBadRequest))
+		return
+	}
+	telemetry.WithAttributes(span, telemetry.AttributeKV{Key: "environment-group-name", Value: name})
+
+	agent, err := c.GetAgent(r, cluster, "")
+	if err != nil {
+		err = telemetry.Error(ctx, span, err, "unable to connect to cluster")
+		c.HandleAPIError(w, r, apierrors.NewErrPassThroughToClient(err, http.StatusServiceUnavailable))
+		return
+	}
+
+	latest, err := environmentgroups.LatestBaseEnvironmentGroup(ctx, agent, name)
+	if err != nil {
+		err = telemetry.Error(ctx, span, err, "unable to get latest environment group")
+		c.HandleAPIError(w, r, apierrors.NewErrPassThroughToClient(err, http.StatusInternalServerError))
+		return
+	}
+
+	if latest.Version == 0 {
+		err := telemetry.Error(ctx, span, nil, "environment group not found")
+		c.HandleAPIError(w, r, apierrors.NewErrPassThroughToClient(err, http.StatusNotFound))
+		return
+	}
+	telemetry.WithAttributes(span, telemetry.AttributeKV{Key: "version", Value: latest.Version})
+
+	synced, err := environmentgroups.SyncLatestVersionToLinkedApplications(ctx, agent, name)
+	if err != nil {
+		err = telemetry.Error(ctx, span, err, "unable to sync environment group to linked applications")
+		c.HandleAPIError(w, r, apierrors.NewErrPassThroughToClient(err, http.StatusInternalServerError))
+		return
+	}
+
+	syncedAt := time.Now().UTC()
+
+	record, err := c.Repo().EnvironmentGroupVersion().ReadEnvironmentGroupVersion(ctx, cluster.ID, name, latest.Version)
+	if err != nil && !errors.Is(err, gorm.ErrRecordNotFound) {
+		err = telemetry.Error(ctx, span, err, "unable to read environment group version")
+		c.HandleAPIError(w, r, apierrors.NewErrPassThroughToClient(err, http.StatusInternalServerError))
+		return
+	}
+
+	// versions created before version history was recorded have no record
+	if record != nil {
+		record.LinkedAppsSyncedAt = &syncedAt
+		_, err = c.Repo().EnvironmentGroupVersion().UpdateEnvironmentGroupVersion(ctx, record)
+		if err != nil {
+			err = telemetry.Error(ctx, span, err, "unable to update environment group version")
+			c.HandleAPIError(w, r, apierrors.NewErrPassThroughToClient(err, http.StatusInternalServerError))
+			return
+		}
+	}
+
+	workloads := make([]environmentgroups.LinkedPorterApplication, 0, len(synced))
+	for _, app := range synced {
+		workloads = append(workloads, app.LinkedPorterApplication)
+	}
+
+	c.WriteResult(w, r, SyncLinkedApplicationsResponse{
+		Name:         name,
+		Version:      latest.Version,
+		Applications: groupLinkedApplications(workloads),
+	})
+}
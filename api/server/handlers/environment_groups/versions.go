@@ -0,0 +1,139 @@
+package environment_groups
+
+import (
+	"net/http"
+	"sort"
+	"time"
+
+	"github.com/porter-dev/porter/api/server/authz"
+	"github.com/porter-dev/porter/api/server/handlers"
+	"github.com/porter-dev/porter/api/server/shared"
+	"github.com/porter-dev/porter/api/server/shared/apierrors"
+	"github.com/porter-dev/porter/api/server/shared/config"
+	"github.com/porter-dev/porter/api/server/shared/requestutils"
+	"github.com/porter-dev/porter/api/types"
+	environmentgroups "github.com/porter-dev/porter/internal/kubernetes/environment_groups"
+	"github.com/porter-dev/porter/internal/models"
+	"github.com/porter-dev/porter/internal/telemetry"
+)
+
+// ListEnvironmentGroupVersionsHandler handles requests to the /environment-groups/{name}/versions endpoint
+type ListEnvironmentGroupVersionsHandler struct {
+	handlers.PorterHandlerReadWriter
+	authz.KubernetesAgentGetter
+}
+
+// NewListEnvironmentGroupVersionsHandler returns a new ListEnvironmentGroupVersionsHandler
+func NewListEnvironmentGroupVersionsHandler(
+	config *config.Config,
+	decoderValidator shared.RequestDecoderValidator,
+	writer shared.ResultWriter,
+) *ListEnvironmentGroupVersionsHandler {
+	return &ListEnvironmentGroupVersionsHandler{
+		PorterHandlerReadWriter: handlers.NewDefaultPorterHandler(config, decoderValidator, writer),
+		KubernetesAgentGetter:   authz.NewOutOfClusterAgentGetter(config),
+	}
+}
+
+// ListEnvironmentGroupVersionsResponse is the response object for the /environment-groups/{name}/versions endpoint
+type ListEnvironmentGroupVersionsResponse struct {
+	Name string `json:"name"`
+	// Versions are sorted with the most recent version first
+	Versions []EnvironmentGroupVersion `json:"versions"`
+}
+
+// EnvironmentGroupVersion is a single version of an environment group
+type EnvironmentGroupVersion struct {
+	Version   int               `json:"version"`
+	Variables map[string]string `json:"variables"`
+	// SecretVariableKeys are the names of the secret variables in the version. Secret values are not returned.
+	SecretVariableKeys []string  `json:"secret_variable_keys"`
+	CreatedAtUTC       time.Time `json:"created_at"`
+	// CreatedBy is the email of the user who created the version, if it was recorded when the version was created
+	CreatedBy string `json:"created_by,omitempty"`
+	// LinkedAppsSyncedAt is the last time the version was synced to the linked applications
+	LinkedAppsSyncedAt *time.Time `json:"linked_apps_synced_at,omitempty"`
+}
+
+// ServeHTTP lists all versions of an environment group, combining the variables stored in the cluster with the version history stored in the database
+func (c *ListEnvironmentGroupVersionsHandler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	ctx, span := telemetry.NewSpan(r.Context(), "serve-list-env-group-versions")
+	defer span.End()
+
+	cluster, _ := ctx.Value(types.ClusterScope).(*models.Cluster)
+
+	name, reqErr := requestutils.GetURLParamString(r, types.URLParamEnvGroupName)
+	if reqErr != nil {
+		err := telemetry.Error(ctx, span, reqErr, "error parsing environment group name from url")
+		c.HandleAPIError(w, r, apierrors.NewErrPassThroughToClient(err, http.StatusBadRequest))
+		return
+	}
+	telemetry.WithAttributes(span, telemetry.AttributeKV{Key: "environment-group-name", Value: name})
+
+	agent, err := c.GetAgent(r, cluster, "")
+	if err != nil {
+		err = telemetry.Error(ctx, span, err, "unable to connect to cluster")
+		c.HandleAPIError(w, r, apierrors.NewErrPassThroughToClient(err, http.StatusServiceUnavailable))
+		return
+	}
+
+	envGroupVersions, err := environmentgroups.ListEnvironmentGroups(ctx, agent,
+		environmentgroups.WithNamespace(environmentgroups.Namespace_EnvironmentGroups),
+		environmentgroups.WithEnvironmentGroupName(name),
+	)
+	if err != nil {
+		err = telemetry.Error(ctx, span, err, "unable to list environment group versions")
+		c.HandleAPIError(w, r, apierrors.NewErrPassThroughToClient(err, http.StatusInternalServerError))
+		return
+	}
+
+	if len(envGroupVersions) == 0 {
+		err := telemetry.Error(ctx, span, nil, "environment group not found")
+		c.HandleAPIError(w, r, apierrors.NewErrPassThroughToClient(err, http.StatusNotFound))
+		return
+	}
+
+	versionRecords, err := c.Repo().EnvironmentGroupVersion().ListEnvironmentGroupVersions(ctx, cluster.ID, name)
+	if err != nil {
+		err = telemetry.Error(ctx, span, err, "unable to list environment group version history")
+		c.HandleAPIError(w, r, apierrors.NewErrPassThroughToClient(err, http.StatusInternalServerError))
+		return
+	}
+
+	recordsByVersion := make(map[int]*models.EnvironmentGroupVersion)
+	for _, record := range versionRecords {
+		recordsByVersion[record.Version] = record
+	}
+
+	response := ListEnvironmentGroupVersionsResponse{
+		Name:     name,
+		Versions: []EnvironmentGroupVersion{},
+	}
+
+	for _, envGroup := range envGroupVersions {
+		version := EnvironmentGroupVersion{
+			Version:            envGroup.Version,
+			Variables:          envGroup.Variables,
+			SecretVariableKeys: []string{},
+			CreatedAtUTC:       envGroup.CreatedAtUTC,
+		}
+
+		for k := range envGroup.SecretVariables {
+			version.SecretVariableKeys = append(version.SecretVariableKeys, k)
+		}
+		sort.Strings(version.SecretVariableKeys)
+
+		if record, ok := recordsByVersion[envGroup.Version]; ok {
+			version.CreatedBy = record.CreatedBy
+			version.LinkedAppsSyncedAt = record.LinkedAppsSyncedAt
+		}
+
+		response.Versions = append(response.Versions, version)
+	}
+
+	sort.Slice(response.Versions, func(i, j int) bool {
+		return response.Versions[i].Version > response.Versions[j].Version
+	})
+
+	c.WriteResult(w, r, response)
+}
@@ -0,0 +1,118 @@
+package event_sink
+
+import (
+	"encoding/json"
+	"errors"
+	"net/http"
+
+	"github.com/porter-dev/porter/api/server/handlers"
+	"github.com/porter-dev/porter/api/server/shared"
+	"github.com/porter-dev/porter/api/server/shared/apierrors"
+	"github.com/porter-dev/porter/api/server/shared/config"
+	"github.com/porter-dev/porter/api/types"
+	"github.com/porter-dev/porter/internal/models"
+	"github.com/porter-dev/porter/internal/telemetry"
+	"gorm.io/gorm"
+)
+
+// CreateEventSinkHandler creates an event sink on a project
+type CreateEventSinkHandler struct {
+	handlers.PorterHandlerReadWriter
+}
+
+// NewCreateEventSinkHandler returns a new CreateEventSinkHandler
+func NewCreateEventSinkHandler(
+	config *config.Config,
+	decoderValidator shared.RequestDecoderValidator,
+	writer shared.ResultWriter,
+) *CreateEventSinkHandler {
+	return &CreateEventSinkHandler{
+		PorterHandlerReadWriter: handlers.NewDefaultPorterHandler(config, decoderValidator, writer),
+	}
+}
+
+func (c *CreateEventSinkHandler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	ctx, span := telemetry.NewSpan(r.Context(), "serve-create-event-sink")
+	defer span.End()
+
+	project, _ := ctx.Value(types.ProjectScope).(*models.Project)
+
+	request := &types.CreateEventSinkRequest{}
+	if ok := c.DecodeAndValidate(w, r, request); !ok {
+		return
+	}
+
+	telemetry.WithAttributes(span, telemetry.AttributeKV{Key: "sink-type", Value: string(request.Type)})
+
+	// only the config of the type of the sink is stored
+	config := types.EventSinkConfig{}
+
+	switch request.Type {
+	case types.EventSinkType_Datadog:
+		if request.Datadog == nil || request.Datadog.APIKey == "" {
+			err := telemetry.Error(ctx, span, nil, "datadog event sink must set an api key")
+			c.HandleAPIError(w, r, apierrors.NewErrPassThroughToClient(err, http.StatusBadRequest))
+			return
+		}
+
+		config.Datadog = request.Datadog
+	case types.EventSinkType_Loki:
+		if request.Loki == nil || request.Loki.URL == "" {
+			err := telemetry.Error(ctx, span, nil, "loki event sink must set a url")
+			c.HandleAPIError(w, r, apierrors.NewErrPassThroughToClient(err, http.StatusBadRequest))
+			return
+		}
+
+		config.Loki = request.Loki
+	case types.EventSinkType_CloudWatch:
+		if request.CloudWatch == nil || request.CloudWatch.LogGroup == "" {
+			err := telemetry.Error(ctx, span, nil, "cloudwatch event sink must set a log group")
+			c.HandleAPIError(w, r, apierrors.NewErrPassThroughToClient(err, http.StatusBadRequest))
+			return
+		}
+
+		_, err := c.Repo().AWSIntegration().ReadAWSIntegration(ctx, project.ID, request.CloudWatch.AWSIntegrationID)
+		if err != nil {
+			if errors.Is(err, gorm.ErrRecordNotFound) {
+				err = telemetry.Error(ctx, span, err, "aws integration of cloudwatch event sink not found")
+				c.HandleAPIError(w, r, apierrors.NewErrPassThroughToClient(err, http.StatusBadRequest))
+				return
+			}
+
+			err = telemetry.Error(ctx, span, err, "error reading aws integration")
+			c.HandleAPIError(w, r, apierrors.NewErrInternal(err))
+			return
+		}
+
+		config.CloudWatch = request.CloudWatch
+	}
+
+	configBytes, err := json.Marshal(config)
+	if err != nil {
+		err = telemetry.Error(ctx, span, err, "error encoding event sink config")
+		c.HandleAPIError(w, r, apierrors.NewErrInternal(err))
+		return
+	}
+
+	sink, err := c.Repo().EventSink().CreateEventSink(ctx, &models.EventSink{
+		ProjectID: project.ID,
+		Name:      request.Name,
+		Type:      request.Type,
+		Config:    configBytes,
+	})
+	if err != nil {
+		err = telemetry.Error(ctx, span, err, "error creating event sink")
+		c.HandleAPIError(w, r, apierrors.NewErrInternal(err))
+		return
+	}
+
+	res, err := sink.ToEventSinkType()
+	if err != nil {
+		err = telemetry.Error(ctx, span, err, "error decoding event sink config")
+		c.HandleAPIError(w, r, apierrors.NewErrInternal(err))
+		return
+	}
+
+	w.WriteHeader(http.StatusCreated)
+	c.WriteResult(w, r, res)
+}
@@ -0,0 +1,64 @@
+package event_sink
+
+import (
+	"errors"
+	"net/http"
+
+	"github.com/porter-dev/porter/api/server/handlers"
+	"github.com/porter-dev/porter/api/server/shared"
+	"github.com/porter-dev/porter/api/server/shared/apierrors"
+	"github.com/porter-dev/porter/api/server/shared/config"
+	"github.com/porter-dev/porter/api/server/shared/requestutils"
+	"github.com/porter-dev/porter/api/types"
+	"github.com/porter-dev/porter/internal/models"
+	"github.com/porter-dev/porter/internal/telemetry"
+	"gorm.io/gorm"
+)
+
+// DeleteEventSinkHandler deletes an event sink from a project
+type DeleteEventSinkHandler struct {
+	handlers.PorterHandlerWriter
+}
+
+// NewDeleteEventSinkHandler returns a new DeleteEventSinkHandler
+func NewDeleteEventSinkHandler(
+	config *config.Config,
+	writer shared.ResultWriter,
+) *DeleteEventSinkHandler {
+	return &DeleteEventSinkHandler{
+		PorterHandlerWriter: handlers.NewDefaultPorterHandler(config, nil, writer),
+	}
+}
+
+func (c *DeleteEventSinkHandler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	ctx, span := telemetry.NewSpan(r.Context(), "serve-delete-event-sink")
+	defer span.End()
+
+	project, _ := ctx.Value(types.ProjectScope).(*models.Project)
+
+	sinkID, reqErr := requestutils.GetURLParamUint(r, types.URLParamEventSinkID)
+	if reqErr != nil {
+		c.HandleAPIError(w, r, reqErr)
+		return
+	}
+
+	sink, err := c.Repo().EventSink().ReadEventSink(ctx, project.ID, sinkID)
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			c.HandleAPIError(w, r, apierrors.NewErrNotFound(err))
+			return
+		}
+
+		err = telemetry.Error(ctx, span, err, "error reading event sink")
+		c.HandleAPIError(w, r, apierrors.NewErrInternal(err))
+		return
+	}
+
+	if err := c.Repo().EventSink().DeleteEventSink(ctx, sink); err != nil {
+		err = telemetry.Error(ctx, span, err, "error deleting event sink")
+		c.HandleAPIError(w, r, apierrors.NewErrInternal(err))
+		return
+	}
+
+	w.WriteHeader(http.StatusOK)
+}
@@ -0,0 +1,56 @@
+package event_sink
+
+import (
+	"net/http"
+
+	"github.com/porter-dev/porter/api/server/handlers"
+	"github.com/porter-dev/porter/api/server/shared"
+	"github.com/porter-dev/porter/api/server/shared/apierrors"
+	"github.com/porter-dev/porter/api/server/shared/config"
+	"github.com/porter-dev/porter/api/types"
+	"github.com/porter-dev/porter/internal/models"
+	"github.com/porter-dev/porter/internal/telemetry"
+)
+
+// ListEventSinksHandler lists the event sinks of a project
+type ListEventSinksHandler struct {
+	handlers.PorterHandlerWriter
+}
+
+// NewListEventSinksHandler returns a new ListEventSinksHandler
+func NewListEventSinksHandler(
+	config *config.Config,
+	writer shared.ResultWriter,
+) *ListEventSinksHandler {
+	return &ListEventSinksHandler{
+		PorterHandlerWriter: handlers.NewDefaultPorterHandler(config, nil, writer),
+	}
+}
+
+func (c *ListEventSinksHandler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	ctx, span := telemetry.NewSpan(r.Context(), "serve-list-event-sinks")
+	defer span.End()
+
+	project, _ := ctx.Value(types.ProjectScope).(*models.Project)
+
+	sinks, err := c.Repo().EventSink().ListEventSinksByProjectID(ctx, project.ID)
+	if err != nil {
+		err = telemetry.Error(ctx, span, err, "error listing event sinks")
+		c.HandleAPIError(w, r, apierrors.NewErrInternal(err))
+		return
+	}
+
+	res := make(types.ListEventSinksResponse, 0, len(sinks))
+	for _, sink := range sinks {
+		sinkType, err := sink.ToEventSinkType()
+		if err != nil {
+			err = telemetry.Error(ctx, span, err, "error decoding event sink config")
+			c.HandleAPIError(w, r, apierrors.NewErrInternal(err))
+			return
+		}
+
+		res = append(res, sinkType)
+	}
+
+	c.WriteResult(w, r, res)
+}
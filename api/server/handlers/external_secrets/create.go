@@ -0,0 +1,122 @@
+package external_secrets
+
+import (
+	"net/http"
+	"net/url"
+
+	"github.com/google/uuid"
+	"github.com/porter-dev/porter/api/server/authz"
+	"github.com/porter-dev/porter/api/server/handlers"
+	"github.com/porter-dev/porter/api/server/shared"
+	"github.com/porter-dev/porter/api/server/shared/apierrors"
+	"github.com/porter-dev/porter/api/server/shared/config"
+	"github.com/porter-dev/porter/api/types"
+	externalsecrets "github.com/porter-dev/porter/internal/integrations/external_secrets"
+	"github.com/porter-dev/porter/internal/models"
+	ints "github.com/porter-dev/porter/internal/models/integrations"
+	"github.com/porter-dev/porter/internal/telemetry"
+)
+
+// CreateExternalSecretsIntegrationHandler handles requests to create an external secrets integration
+type CreateExternalSecretsIntegrationHandler struct {
+	handlers.PorterHandlerReadWriter
+	authz.KubernetesAgentGetter
+}
+
+// NewCreateExternalSecretsIntegrationHandler returns a new CreateExternalSecretsIntegrationHandler
+func NewCreateExternalSecretsIntegrationHandler(
+	config *config.Config,
+	decoderValidator shared.RequestDecoderValidator,
+	writer shared.ResultWriter,
+) *CreateExternalSecretsIntegrationHandler {
+	return &CreateExternalSecretsIntegrationHandler{
+		PorterHandlerReadWriter: handlers.NewDefaultPorterHandler(config, decoderValidator, writer),
+		KubernetesAgentGetter:   authz.NewOutOfClusterAgentGetter(config),
+	}
+}
+
+// ServeHTTP checks that the provider credentials can read secrets, stores the integration with its credentials encrypted, and runs the first sync
+func (c *CreateExternalSecretsIntegrationHandler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	ctx, span := telemetry.NewSpan(r.Context(), "serve-create-external-secrets-integration")
+	defer span.End()
+
+	user, _ := ctx.Value(types.UserScope).(*models.User)
+	project, _ := ctx.Value(types.ProjectScope).(*models.Project)
+	cluster, _ := ctx.Value(types.ClusterScope).(*models.Cluster)
+
+	request := &types.CreateExternalSecretsIntegrationRequest{}
+	if ok := c.DecodeAndValidate(w, r, request); !ok {
+		return
+	}
+
+	telemetry.WithAttributes(span,
+		telemetry.AttributeKV{Key: "provider", Value: string(request.Provider)},
+		telemetry.AttributeKV{Key: "environment-group-name", Value: request.EnvironmentGroupName},
+		telemetry.AttributeKV{Key: "sync-interval-minutes", Value: request.SyncIntervalMinutes},
+	)
+
+	if request.Provider == types.ExternalSecretsProvider_Vault {
+		vaultURL, err := url.Parse(request.VaultAddress)
+		if err != nil || (vaultURL.Scheme != "https" && vaultURL.Scheme != "http") || vaultURL.Host == "" {
+			err := telemetry.Error(ctx, span, err, "vault address must be an http or https url")
+			c.HandleAPIError(w, r, apierrors.NewErrPassThroughToClient(err, http.StatusBadRequest))
+			return
+		}
+	}
+
+	integration := &ints.ExternalSecretsIntegration{
+		UserID:               user.ID,
+		ProjectID:            project.ID,
+		ClusterID:            cluster.ID,
+		Provider:             string(request.Provider),
+		EnvironmentGroupName: request.EnvironmentGroupName,
+		VaultAddress:         request.VaultAddress,
+		VaultNamespace:       request.VaultNamespace,
+		VaultSecretPath:      request.VaultSecretPath,
+		DopplerProject:       request.DopplerProject,
+		DopplerConfig:        request.DopplerConfig,
+		SyncIntervalMinutes:  request.SyncIntervalMinutes,
+		RedeployLinkedApps:   request.RedeployLinkedApps,
+		Token:                []byte(request.Token),
+	}
+
+	if request.WebhookSecret != "" {
+		integration.WebhookID = uuid.New().String()
+		integration.WebhookSecret = []byte(request.WebhookSecret)
+	}
+
+	provider, err := externalsecrets.NewProvider(integration)
+	if err != nil {
+		err := telemetry.Error(ctx, span, err, "error creating external secrets provider")
+		c.HandleAPIError(w, r, apierrors.NewErrPassThroughToClient(err, http.StatusBadRequest))
+		return
+	}
+
+	if _, err := provider.Secrets(ctx); err != nil {
+		err := telemetry.Error(ctx, span, err, "unable to read secrets with the provided credentials")
+		c.HandleAPIError(w, r, apierrors.NewErrPassThroughToClient(err, http.StatusBadRequest))
+		return
+	}
+
+	agent, err := c.GetAgent(r, cluster, "")
+	if err != nil {
+		err := telemetry.Error(ctx, span, err, "unable to connect to kubernetes cluster")
+		c.HandleAPIError(w, r, apierrors.NewErrPassThroughToClient(err, http.StatusInternalServerError))
+		return
+	}
+
+	integration, err = c.Repo().ExternalSecretsIntegration().CreateExternalSecretsIntegration(ctx, integration)
+	if err != nil {
+		err := telemetry.Error(ctx, span, err, "error creating external secrets integration")
+		c.HandleAPIError(w, r, apierrors.NewErrPassThroughToClient(err, http.StatusInternalServerError))
+		return
+	}
+	telemetry.WithAttributes(span, telemetry.AttributeKV{Key: "external-secrets-integration-id", Value: integration.ID})
+
+	// a failed first sync is recorded on the integration, so the integration is still returned
+	_, _ = externalsecrets.Sync(ctx, agent, c.Repo(), integration)
+
+	c.WriteResult(w, r, types.CreateExternalSecretsIntegrationResponse{
+		ExternalSecretsIntegration: integration.ToExternalSecretsIntegrationType(),
+	})
+}
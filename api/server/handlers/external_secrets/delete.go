@@ -0,0 +1,60 @@
+package external_secrets
+
+import (
+	"errors"
+	"net/http"
+
+	"github.com/porter-dev/porter/api/server/handlers"
+	"github.com/porter-dev/porter/api/server/shared"
+	"github.com/porter-dev/porter/api/server/shared/apierrors"
+	"github.com/porter-dev/porter/api/server/shared/config"
+	"github.com/porter-dev/porter/api/server/shared/requestutils"
+	"github.com/porter-dev/porter/api/types"
+	"github.com/porter-dev/porter/internal/models"
+	"github.com/porter-dev/porter/internal/telemetry"
+	"gorm.io/gorm"
+)
+
+// DeleteExternalSecretsIntegrationHandler handles requests to delete an external secrets integration
+type DeleteExternalSecretsIntegrationHandler struct {
+	handlers.PorterHandlerWriter
+}
+
+// NewDeleteExternalSecretsIntegrationHandler returns a new DeleteExternalSecretsIntegrationHandler
+func NewDeleteExternalSecretsIntegrationHandler(
+	config *config.Config,
+	writer shared.ResultWriter,
+) *DeleteExternalSecretsIntegrationHandler {
+	return &DeleteExternalSecretsIntegrationHandler{
+		PorterHandlerWriter: handlers.NewDefaultPorterHandler(config, nil, writer),
+	}
+}
+
+// ServeHTTP deletes an external secrets integration. The env group and the secrets already synced into it are kept.
+func (c *DeleteExternalSecretsIntegrationHandler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	ctx, span := telemetry.NewSpan(r.Context(), "serve-delete-external-secrets-integration")
+	defer span.End()
+
+	project, _ := ctx.Value(types.ProjectScope).(*models.Project)
+	cluster, _ := ctx.Value(types.ClusterScope).(*models.Cluster)
+
+	integrationID, reqErr := requestutils.GetURLParamUint(r, types.URLParamExternalSecretsIntegrationID)
+	if reqErr != nil {
+		err := telemetry.Error(ctx, span, reqErr, "error parsing external secrets integration id")
+		c.HandleAPIError(w, r, apierrors.NewErrPassThroughToClient(err, http.StatusBadRequest))
+		return
+	}
+	telemetry.WithAttributes(span, telemetry.AttributeKV{Key: "external-secrets-integration-id", Value: integrationID})
+
+	err := c.Repo().ExternalSecretsIntegration().DeleteExternalSecretsIntegration(ctx, project.ID, cluster.ID, integrationID)
+	if err != nil {
+		status := http.StatusInternalServerError
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			status = http.StatusNotFound
+		}
+
+		err := telemetry.Error(ctx, span, err, "error deleting external secrets integration")
+		c.HandleAPIError(w, r, apierrors.NewErrPassThroughToClient(err, status))
+		return
+	}
+}
@@ -0,0 +1,51 @@
+package external_secrets
+
+import (
+	"net/http"
+
+	"github.com/porter-dev/porter/api/server/handlers"
+	"github.com/porter-dev/porter/api/server/shared"
+	"github.com/porter-dev/porter/api/server/shared/apierrors"
+	"github.com/porter-dev/porter/api/server/shared/config"
+	"github.com/porter-dev/porter/api/types"
+	"github.com/porter-dev/porter/internal/models"
+	"github.com/porter-dev/porter/internal/telemetry"
+)
+
+// ListExternalSecretsIntegrationsHandler handles requests to list the external secrets integrations of a cluster
+type ListExternalSecretsIntegrationsHandler struct {
+	handlers.PorterHandlerWriter
+}
+
+// NewListExternalSecretsIntegrationsHandler returns a new ListExternalSecretsIntegrationsHandler
+func NewListExternalSecretsIntegrationsHandler(
+	config *config.Config,
+	writer shared.ResultWriter,
+) *ListExternalSecretsIntegrationsHandler {
+	return &ListExternalSecretsIntegrationsHandler{
+		PorterHandlerWriter: handlers.NewDefaultPorterHandler(config, nil, writer),
+	}
+}
+
+// ServeHTTP lists the external secrets integrations of a cluster
+func (c *ListExternalSecretsIntegrationsHandler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	ctx, span := telemetry.NewSpan(r.Context(), "serve-list-external-secrets-integrations")
+	defer span.End()
+
+	project, _ := ctx.Value(types.ProjectScope).(*models.Project)
+	cluster, _ := ctx.Value(types.ClusterScope).(*models.Cluster)
+
+	integrations, err := c.Repo().ExternalSecretsIntegration().ListExternalSecretsIntegrationsByClusterID(ctx, project.ID, cluster.ID)
+	if err != nil {
+		err := telemetry.Error(ctx, span, err, "error listing external secrets integrations")
+		c.HandleAPIError(w, r, apierrors.NewErrPassThroughToClient(err, http.StatusInternalServerError))
+		return
+	}
+
+	res := make(types.ListExternalSecretsIntegrationsResponse, 0, len(integrations))
+	for _, integration := range integrations {
+		res = append(res, integration.ToExternalSecretsIntegrationType())
+	}
+
+	c.WriteResult(w, r, res)
+}
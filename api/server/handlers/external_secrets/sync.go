@@ -0,0 +1,85 @@
+package external_secrets
+
+import (
+	"errors"
+	"net/http"
+
+	"github.com/porter-dev/porter/api/server/authz"
+	"github.com/porter-dev/porter/api/server/handlers"
+	"github.com/porter-dev/porter/api/server/shared"
+	"github.com/porter-dev/porter/api/server/shared/apierrors"
+	"github.com/porter-dev/porter/api/server/shared/config"
+	"github.com/porter-dev/porter/api/server/shared/requestutils"
+	"github.com/porter-dev/porter/api/types"
+	externalsecrets "github.com/porter-dev/porter/internal/integrations/external_secrets"
+	"github.com/porter-dev/porter/internal/models"
+	"github.com/porter-dev/porter/internal/telemetry"
+	"gorm.io/gorm"
+)
+
+// SyncExternalSecretsHandler handles requests to sync an external secrets integration on demand
+type SyncExternalSecretsHandler struct {
+	handlers.PorterHandlerReadWriter
+	authz.KubernetesAgentGetter
+}
+
+// NewSyncExternalSecretsHandler returns a new SyncExternalSecretsHandler
+func NewSyncExternalSecretsHandler(
+	config *config.Config,
+	decoderValidator shared.RequestDecoderValidator,
+	writer shared.ResultWriter,
+) *SyncExternalSecretsHandler {
+	return &SyncExternalSecretsHandler{
+		PorterHandlerReadWriter: handlers.NewDefaultPorterHandler(config, decoderValidator, writer),
+		KubernetesAgentGetter:   authz.NewOutOfClusterAgentGetter(config),
+	}
+}
+
+// ServeHTTP syncs the secrets of the external provider into the env group of the integration
+func (c *SyncExternalSecretsHandler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	ctx, span := telemetry.NewSpan(r.Context(), "serve-sync-external-secrets")
+	defer span.End()
+
+	project, _ := ctx.Value(types.ProjectScope).(*models.Project)
+	cluster, _ := ctx.Value(types.ClusterScope).(*models.Cluster)
+
+	integrationID, reqErr := requestutils.GetURLParamUint(r, types.URLParamExternalSecretsIntegrationID)
+	if reqErr != nil {
+		err := telemetry.Error(ctx, span, reqErr, "error parsing external secrets integration id")
+		c.HandleAPIError(w, r, apierrors.NewErrPassThroughToClient(err, http.StatusBadRequest))
+		return
+	}
+	telemetry.WithAttributes(span, telemetry.AttributeKV{Key: "external-secrets-integration-id", Value: integrationID})
+
+	integration, err := c.Repo().ExternalSecretsIntegration().ReadExternalSecretsIntegration(ctx, project.ID, cluster.ID, integrationID)
+	if err != nil {
+		status := http.StatusInternalServerError
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			status = http.StatusNotFound
+		}
+
+		err := telemetry.Error(ctx, span, err, "error reading external secrets integration")
+		c.HandleAPIError(w, r, apierrors.NewErrPassThroughToClient(err, status))
+		return
+	}
+
+	agent, err := c.GetAgent(r, cluster, "")
+	if err != nil {
+		err := telemetry.Error(ctx, span, err, "unable to connect to kubernetes cluster")
+		c.HandleAPIError(w, r, apierrors.NewErrPassThroughToClient(err, http.StatusInternalServerError))
+		return
+	}
+
+	result, err := externalsecrets.Sync(ctx, agent, c.Repo(), integration)
+	if err != nil {
+		err := telemetry.Error(ctx, span, err, "error syncing external secrets")
+		c.HandleAPIError(w, r, apierrors.NewErrPassThroughToClient(err, http.StatusBadGateway))
+		return
+	}
+
+	c.WriteResult(w, r, types.SyncExternalSecretsResponse{
+		Changed:        result.Changed,
+		Version:        result.Version,
+		RedeployedApps: result.RedeployedApps,
+	})
+}
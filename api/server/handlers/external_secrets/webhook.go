@@ -0,0 +1,115 @@
+package external_secrets
+
+import (
+	"errors"
+	"io"
+	"net/http"
+
+	"github.com/porter-dev/porter/api/server/authz"
+	"github.com/porter-dev/porter/api/server/handlers"
+	"github.com/porter-dev/porter/api/server/shared"
+	"github.com/porter-dev/porter/api/server/shared/apierrors"
+	"github.com/porter-dev/porter/api/server/shared/config"
+	"github.com/porter-dev/porter/api/server/shared/requestutils"
+	"github.com/porter-dev/porter/api/types"
+	externalsecrets "github.com/porter-dev/porter/internal/integrations/external_secrets"
+	"github.com/porter-dev/porter/internal/telemetry"
+	"gorm.io/gorm"
+)
+
+// maxWebhookBodyBytes bounds the size of webhook payloads, which are only read to verify their signature
+const maxWebhookBodyBytes = 1 << 20
+
+// ExternalSecretsWebhookHandler handles webhooks from an external secrets provider, such as Doppler, which notify that secrets changed
+type ExternalSecretsWebhookHandler struct {
+	handlers.PorterHandlerReadWriter
+	authz.KubernetesAgentGetter
+}
+
+// NewExternalSecretsWebhookHandler returns a new ExternalSecretsWebhookHandler
+func NewExternalSecretsWebhookHandler(
+	config *config.Config,
+	decoderValidator shared.RequestDecoderValidator,
+	writer shared.ResultWriter,
+) *ExternalSecretsWebhookHandler {
+	return &ExternalSecretsWebhookHandler{
+		PorterHandlerReadWriter: handlers.NewDefaultPorterHandler(config, decoderValidator, writer),
+		KubernetesAgentGetter:   authz.NewOutOfClusterAgentGetter(config),
+	}
+}
+
+// ServeHTTP verifies the signature of the webhook and syncs the integration it belongs to. The signature is read from the
+// X-Doppler-Signature header, or from X-Porter-Signature for other callers.
+func (c *ExternalSecretsWebhookHandler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	ctx, span := telemetry.NewSpan(r.Context(), "serve-external-secrets-webhook")
+	defer span.End()
+
+	webhookID, reqErr := requestutils.GetURLParamString(r, types.URLParamExternalSecretsWebhookID)
+	if reqErr != nil {
+		err := telemetry.Error(ctx, span, reqErr, "error parsing webhook id")
+		c.HandleAPIError(w, r, apierrors.NewErrPassThroughToClient(err, http.StatusBadRequest))
+		return
+	}
+
+	body, err := io.ReadAll(io.LimitReader(r.Body, maxWebhookBodyBytes))
+	if err != nil {
+		err := telemetry.Error(ctx, span, err, "error reading webhook body")
+		c.HandleAPIError(w, r, apierrors.NewErrPassThroughToClient(err, http.StatusBadRequest))
+		return
+	}
+
+	integration, err := c.Repo().ExternalSecretsIntegration().ReadExternalSecretsIntegrationByWebhookID(ctx, webhookID)
+	if err != nil {
+		status := http.StatusInternalServerError
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			status = http.StatusNotFound
+		}
+
+		err := telemetry.Error(ctx, span, err, "error reading external secrets integration")
+		c.HandleAPIError(w, r, apierrors.NewErrPassThroughToClient(err, status))
+		return
+	}
+	telemetry.WithAttributes(span,
+		telemetry.AttributeKV{Key: "external-secrets-integration-id", Value: integration.ID},
+		telemetry.AttributeKV{Key: "project-id", Value: integration.ProjectID},
+		telemetry.AttributeKV{Key: "cluster-id", Value: integration.ClusterID},
+	)
+
+	signature := r.Header.Get("X-Doppler-Signature")
+	if signature == "" {
+		signature = r.Header.Get("X-Porter-Signature")
+	}
+
+	if !externalsecrets.VerifyWebhookSignature(integration.WebhookSecret, body, signature) {
+		err := telemetry.Error(ctx, span, nil, "invalid webhook signature")
+		c.HandleAPIError(w, r, apierrors.NewErrPassThroughToClient(err, http.StatusUnauthorized))
+		return
+	}
+
+	cluster, err := c.Repo().Cluster().ReadCluster(ctx, integration.ProjectID, integration.ClusterID)
+	if err != nil {
+		err := telemetry.Error(ctx, span, err, "error reading cluster")
+		c.HandleAPIError(w, r, apierrors.NewErrPassThroughToClient(err, http.StatusInternalServerError))
+		return
+	}
+
+	agent, err := c.GetAgent(r, cluster, "")
+	if err != nil {
+		err := telemetry.Error(ctx, span, err, "unable to connect to kubernetes cluster")
+		c.HandleAPIError(w, r, apierrors.NewErrPassThroughToClient(err, http.StatusInternalServerError))
+		return
+	}
+
+	result, err := externalsecrets.Sync(ctx, agent, c.Repo(), integration)
+	if err != nil {
+		err := telemetry.Error(ctx, span, err, "error syncing external secrets")
+		c.HandleAPIError(w, r, apierrors.NewErrPassThroughToClient(err, http.StatusBadGateway))
+		return
+	}
+
+	c.WriteResult(w, r, types.SyncExternalSecretsResponse{
+		Changed:        result.Changed,
+		Version:        result.Version,
+		RedeployedApps: result.RedeployedApps,
+	})
+}
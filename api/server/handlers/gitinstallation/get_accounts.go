@@ -117,7 +117,7 @@ resultOrErrorReader:
 	res.Username = *authUser.Login
 
 	// check if user has app installed in their account
-	installation, err := c.Repo().GithubAppInstallation().ReadGithubAppInstallationByAccountID(*authUser.ID)
+	installation, err := c.Repo().GithubAppInstallation().ReadGithubAppInstallationByAccountID(ctx, *authUser.ID)
 
 	if err != nil && err != gorm.ErrRecordNotFound {
 		c.HandleAPIError(w, r, apierrors.NewErrInternal(err))
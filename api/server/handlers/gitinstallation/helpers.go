@@ -21,19 +21,19 @@ func GetGithubAppOauthTokenFromRequest(config *config.Config, r *http.Request) (
 	user, _ := r.Context().Value(types.UserScope).(*models.User)
 
 	getOAuthInt := config.Repo.GithubAppOAuthIntegration().ReadGithubAppOauthIntegration
-	oauthInt, err := getOAuthInt(user.GithubAppIntegrationID)
+	oauthInt, err := getOAuthInt(r.Context(), user.GithubAppIntegrationID)
 	if err != nil {
 		return nil, err
 	}
 
 	_, _, err = oauth.GetAccessToken(oauthInt.SharedOAuthModel,
 		&config.GithubAppConf.Config,
-		oauth.MakeUpdateGithubAppOauthIntegrationFunction(oauthInt, config.Repo),
+		oauth.MakeUpdateGithubAppOauthIntegrationFunction(r.Context(), oauthInt, config.Repo),
 	)
 
 	if err != nil {
 		// try again, in case the token got updated
-		oauthInt2, err := getOAuthInt(user.GithubAppIntegrationID)
+		oauthInt2, err := getOAuthInt(r.Context(), user.GithubAppIntegrationID)
 
 		if err != nil || oauthInt2.Expiry == oauthInt.Expiry {
 			return nil, err
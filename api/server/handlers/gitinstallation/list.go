@@ -76,7 +76,7 @@ func (c *GitRepoListHandler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
 		}
 	}
 
-	installationData, err := c.Repo().GithubAppInstallation().ReadGithubAppInstallationByAccountIDs(accountIds)
+	installationData, err := c.Repo().GithubAppInstallation().ReadGithubAppInstallationByAccountIDs(r.Context(), accountIds)
 	if err != nil {
 		c.HandleAPIError(w, r, apierrors.NewErrInternal(err))
 		return
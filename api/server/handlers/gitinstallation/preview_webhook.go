@@ -0,0 +1,260 @@
+package gitinstallation
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+
+	"connectrpc.com/connect"
+	ghinstallation "github.com/bradleyfalzon/ghinstallation/v2"
+	"github.com/google/go-github/v41/github"
+	porterv1 "github.com/porter-dev/api-contracts/generated/go/porter/v1"
+
+	"github.com/porter-dev/porter/internal/models"
+	"github.com/porter-dev/porter/internal/porter_app"
+	v2 "github.com/porter-dev/porter/internal/porter_app/v2"
+	"github.com/porter-dev/porter/internal/telemetry"
+)
+
+const (
+	// defaultPorterYamlPath is the path of the porter.yaml used when an app does not specify one
+	defaultPorterYamlPath = "porter.yaml"
+	// previewStatusContextPrefix prefixes the context of the commit statuses reported for preview deployments
+	previewStatusContextPrefix = "porter/preview"
+)
+
+// processPullRequestEvent creates, updates or deletes the preview deployments of every app deployed from the repo of the pull request
+func (c *GithubAppWebhookHandler) processPullRequestEvent(ctx context.Context, r *http.Request, event *github.PullRequestEvent) error {
+	ctx, span := telemetry.NewSpan(ctx, "process-pull-request-event")
+	defer span.End()
+
+	if event.GetInstallation() == nil || event.GetPullRequest() == nil || event.GetRepo() == nil {
+		return telemetry.Error(ctx, span, nil, "pull request event is missing installation, pull request or repo")
+	}
+
+	action := event.GetAction()
+	telemetry.WithAttributes(span,
+		telemetry.AttributeKV{Key: "action", Value: action},
+		telemetry.AttributeKV{Key: "repo", Value: event.GetRepo().GetFullName()},
+		telemetry.AttributeKV{Key: "pr-number", Value: event.GetPullRequest().GetNumber()},
+	)
+
+	switch action {
+	case "opened", "reopened", "synchronize", "closed":
+	default:
+		return nil
+	}
+
+	apps, err := c.Repo().PorterApp().ReadPorterAppsByGitRepo(ctx, uint(event.GetInstallation().GetID()), event.GetRepo().GetFullName())
+	if err != nil {
+		return telemetry.Error(ctx, span, err, "error reading porter apps for repo")
+	}
+
+	if len(apps) == 0 {
+		return nil
+	}
+
+	client, err := c.githubInstallationClient(event.GetInstallation().GetID())
+	if err != nil {
+		return telemetry.Error(ctx, span, err, "error getting github installation client")
+	}
+
+	var errs []error
+	for _, app := range apps {
+		if action == "closed" {
+			err = c.deletePreview(ctx, r, app, event)
+		} else {
+			err = c.deployPreview(ctx, r, client, app, event)
+		}
+
+		if err != nil {
+			errs = append(errs, fmt.Errorf("app %s: %w", app.Name, err))
+		}
+	}
+
+	if len(errs) > 0 {
+		return telemetry.Error(ctx, span, errors.Join(errs...), "error processing preview deployments")
+	}
+
+	return nil
+}
+
+// deployPreview applies the preview overrides of the app's porter.yaml at the head of the pull request to the preview deployment
+// target of the pull request, reporting the result as a commit status
+func (c *GithubAppWebhookHandler) deployPreview(ctx context.Context, r *http.Request, client *github.Client, app *models.PorterApp, event *github.PullRequestEvent) error {
+	ctx, span := telemetry.NewSpan(ctx, "deploy-preview")
+	defer span.End()
+
+	telemetry.WithAttributes(span, telemetry.AttributeKV{Key: "app-name", Value: app.Name})
+
+	project, cluster, err := c.previewProjectAndCluster(ctx, app)
+	if err != nil || project == nil {
+		return err
+	}
+
+	owner := event.GetRepo().GetOwner().GetLogin()
+	repo := event.GetRepo().GetName()
+	sha := event.GetPullRequest().GetHead().GetSHA()
+
+	porterYamlPath := app.PorterYamlPath
+	if porterYamlPath == "" {
+		porterYamlPath = defaultPorterYamlPath
+	}
+
+	file, _, _, err := client.Repositories.GetContents(ctx, owner, repo, porterYamlPath, &github.RepositoryContentGetOptions{Ref: sha})
+	if err != nil || file == nil {
+		// apps without a porter.yaml in the repo cannot have previews
+		return nil
+	}
+
+	contents, err := file.GetContent()
+	if err != nil {
+		return telemetry.Error(ctx, span, err, "error decoding porter yaml contents")
+	}
+
+	appProto, err := porter_app.ParsePreviewYAML(ctx, []byte(contents), v2.WithStrictParsing(c.Config().ServerConf.PorterYAMLStrictParsing), porter_app.ProjectServiceDefaults(project))
+	if err != nil {
+		c.reportPreviewStatus(ctx, client, event, app, "failure", "Invalid porter.yaml previews section")
+		return telemetry.Error(ctx, span, err, "error parsing preview yaml")
+	}
+
+	if appProto == nil {
+		// previews are opt-in through the previews section of the porter.yaml
+		return nil
+	}
+
+	c.reportPreviewStatus(ctx, client, event, app, "pending", "Deploying preview environment")
+
+	agent, err := c.GetAgent(r, cluster, "")
+	if err != nil {
+		c.reportPreviewStatus(ctx, client, event, app, "error", "Could not connect to cluster")
+		return telemetry.Error(ctx, span, err, "error getting kubernetes agent")
+	}
+
+	name := porter_app.PreviewNameForPullRequest(event.GetPullRequest().GetNumber())
+	deploymentTarget, err := porter_app.GetOrCreatePreviewDeploymentTarget(ctx, c.Repo(), agent, project.ID, cluster.ID, name)
+	if err != nil {
+		c.reportPreviewStatus(ctx, client, event, app, "error", "Could not create preview environment")
+		return telemetry.Error(ctx, span, err, "error getting preview deployment target")
+	}
+
+	validateResp, err := c.Config().ClusterControlPlaneClient.ValidatePorterApp(ctx, connect.NewRequest(&porterv1.ValidatePorterAppRequest{
+		ProjectId:          int64(project.ID),
+		DeploymentTargetId: deploymentTarget.ID.String(),
+		CommitSha:          sha,
+		App:                appProto,
+	}))
+	if err != nil || validateResp == nil || validateResp.Msg == nil || validateResp.Msg.App == nil {
+		c.reportPreviewStatus(ctx, client, event, app, "failure", "Preview app failed validation")
+		return telemetry.Error(ctx, span, err, "error calling ccp validate porter app")
+	}
+
+	applyResp, err := c.Config().ClusterControlPlaneClient.ApplyPorterApp(ctx, connect.NewRequest(&porterv1.ApplyPorterAppRequest{
+		ProjectId:          int64(project.ID),
+		DeploymentTargetId: deploymentTarget.ID.String(),
+		App:                validateResp.Msg.App,
+	}))
+	if err != nil || applyResp == nil || applyResp.Msg == nil {
+		c.reportPreviewStatus(ctx, client, event, app, "failure", "Preview deployment failed")
+		return telemetry.Error(ctx, span, err, "error calling ccp apply porter app")
+	}
+
+	telemetry.WithAttributes(span,
+		telemetry.AttributeKV{Key: "app-revision-id", Value: applyResp.Msg.PorterAppRevisionId},
+		telemetry.AttributeKV{Key: "cli-action", Value: applyResp.Msg.CliAction.String()},
+	)
+
+	if applyResp.Msg.CliAction == porterv1.EnumCLIAction_ENUM_CLI_ACTION_BUILD {
+		// images are built in CI, so the deploy completes once `porter apply --preview` runs for this commit
+		c.reportPreviewStatus(ctx, client, event, app, "pending", "Waiting for `porter apply --preview` to build this commit")
+		return nil
+	}
+
+	c.reportPreviewStatus(ctx, client, event, app, "success", fmt.Sprintf("Preview deployed to %s", name))
+
+	return nil
+}
+
+// deletePreview deletes the preview deployment target of the pull request when it is closed
+func (c *GithubAppWebhookHandler) deletePreview(ctx context.Context, r *http.Request, app *models.PorterApp, event *github.PullRequestEvent) error {
+	ctx, span := telemetry.NewSpan(ctx, "delete-preview")
+	defer span.End()
+
+	telemetry.WithAttributes(span, telemetry.AttributeKV{Key: "app-name", Value: app.Name})
+
+	project, cluster, err := c.previewProjectAndCluster(ctx, app)
+	if err != nil || project == nil {
+		return err
+	}
+
+	agent, err := c.GetAgent(r, cluster, "")
+	if err != nil {
+		return telemetry.Error(ctx, span, err, "error getting kubernetes agent")
+	}
+
+	name := porter_app.PreviewNameForPullRequest(event.GetPullRequest().GetNumber())
+	err = porter_app.DeletePreviewDeploymentTarget(ctx, c.Repo(), agent, project.ID, cluster.ID, name)
+	if err != nil {
+		return telemetry.Error(ctx, span, err, "error deleting preview deployment target")
+	}
+
+	return nil
+}
+
+// previewProjectAndCluster returns the project and cluster of an app, or a nil project if the project does not support previews
+func (c *GithubAppWebhookHandler) previewProjectAndCluster(ctx context.Context, app *models.PorterApp) (*models.Project, *models.Cluster, error) {
+	project, err := c.Repo().Project().ReadProject(ctx, app.ProjectID)
+	if err != nil {
+		return nil, nil, fmt.Errorf("error reading project: %w", err)
+	}
+
+	if !project.ValidateApplyV2 {
+		return nil, nil, nil
+	}
+
+	cluster, err := c.Repo().Cluster().ReadCluster(ctx, app.ProjectID, app.ClusterID)
+	if err != nil {
+		return nil, nil, fmt.Errorf("error reading cluster: %w", err)
+	}
+
+	return project, cluster, nil
+}
+
+// reportPreviewStatus reports the state of a preview deployment as a commit status on the head of the pull request
+func (c *GithubAppWebhookHandler) reportPreviewStatus(ctx context.Context, client *github.Client, event *github.PullRequestEvent, app *models.PorterApp, state, description string) {
+	ctx, span := telemetry.NewSpan(ctx, "report-preview-status")
+	defer span.End()
+
+	_, _, err := client.Repositories.CreateStatus(
+		ctx,
+		event.GetRepo().GetOwner().GetLogin(),
+		event.GetRepo().GetName(),
+		event.GetPullRequest().GetHead().GetSHA(),
+		&github.RepoStatus{
+			State:       github.String(state),
+			Description: github.String(description),
+			Context:     github.String(fmt.Sprintf("%s/%s", previewStatusContextPrefix, app.Name)),
+			TargetURL:   github.String(fmt.Sprintf("%s/apps/%s", c.Config().ServerConf.ServerURL, app.Name)),
+		},
+	)
+	if err != nil {
+		// a failed status report should not fail the deploy
+		_ = telemetry.Error(ctx, span, err, "error creating commit status")
+	}
+}
+
+// githubInstallationClient returns a github client authenticated as the given installation of the Porter GitHub app
+func (c *GithubAppWebhookHandler) githubInstallationClient(installationID int64) (*github.Client, error) {
+	itr, err := ghinstallation.NewKeyFromFile(
+		http.DefaultTransport,
+		c.Config().GithubAppConf.AppID,
+		installationID,
+		c.Config().GithubAppConf.SecretPath,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	return github.NewClient(&http.Client{Transport: itr}), nil
+}
@@ -26,6 +26,7 @@ func NewGithubAppWebhookHandler(
 ) *GithubAppWebhookHandler {
 	return &GithubAppWebhookHandler{
 		PorterHandlerReadWriter: handlers.NewDefaultPorterHandler(config, decoderValidator, writer),
+		KubernetesAgentGetter:   authz.NewOutOfClusterAgentGetter(config),
 	}
 }
 
@@ -43,13 +44,19 @@ func (c *GithubAppWebhookHandler) ServeHTTP(w http.ResponseWriter, r *http.Reque
 	}
 
 	switch e := event.(type) {
+	case *github.PullRequestEvent:
+		err := c.processPullRequestEvent(r.Context(), r, e)
+		if err != nil {
+			c.HandleAPIError(w, r, apierrors.NewErrInternal(err))
+			return
+		}
 	case *github.InstallationEvent:
 		if *e.Action == "created" {
-			_, err := c.Repo().GithubAppInstallation().ReadGithubAppInstallationByAccountID(*e.Installation.Account.ID)
+			_, err := c.Repo().GithubAppInstallation().ReadGithubAppInstallationByAccountID(r.Context(), *e.Installation.Account.ID)
 
 			if err != nil && err == gorm.ErrRecordNotFound {
 				// insert account/installation pair into database
-				_, err := c.Repo().GithubAppInstallation().CreateGithubAppInstallation(&ints.GithubAppInstallation{
+				_, err := c.Repo().GithubAppInstallation().CreateGithubAppInstallation(r.Context(), &ints.GithubAppInstallation{
 					AccountID:      *e.Installation.Account.ID,
 					InstallationID: *e.Installation.ID,
 				})
@@ -64,7 +71,7 @@ func (c *GithubAppWebhookHandler) ServeHTTP(w http.ResponseWriter, r *http.Reque
 			}
 		}
 		if *e.Action == "deleted" {
-			err := c.Repo().GithubAppInstallation().DeleteGithubAppInstallationByAccountID(*e.Installation.Account.ID)
+			err := c.Repo().GithubAppInstallation().DeleteGithubAppInstallationByAccountID(r.Context(), *e.Installation.Account.ID)
 			if err != nil {
 				c.HandleAPIError(w, r, apierrors.NewErrInternal(err))
 				return
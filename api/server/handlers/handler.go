@@ -1,6 +1,7 @@
 package handlers
 
 import (
+	"errors"
 	"fmt"
 	"net/http"
 
@@ -9,6 +10,7 @@ import (
 	"github.com/porter-dev/porter/api/server/shared/config"
 	"github.com/porter-dev/porter/api/types"
 	"github.com/porter-dev/porter/internal/models"
+	"github.com/porter-dev/porter/internal/quota"
 	"github.com/porter-dev/porter/internal/repository"
 )
 
@@ -158,3 +160,32 @@ func (u *Unavailable) ServeHTTP(w http.ResponseWriter, r *http.Request) {
 		Code: types.ErrCodeUnavailable,
 	})
 }
+
+// HandleQuotaExceeded writes an error for a request which would exceed one of the quotas of a project, with the exceeded quota
+// as the error details
+func HandleQuotaExceeded(config *config.Config, w http.ResponseWriter, r *http.Request, err *quota.ExceededError) {
+	apierrors.HandleAPIError(config.Logger, config.Alerter, w, r, apierrors.NewErrPassThroughToClient(
+		err,
+		http.StatusForbidden,
+	), true, apierrors.ErrorOpts{
+		Code:    types.ErrCodeQuotaExceeded,
+		Details: err.Details,
+	})
+}
+
+// HandleServiceError writes an error returned by a service function shared between the REST and RPC APIs. Quota errors and
+// request errors are written as they are, and any other error is written as an internal error.
+func HandleServiceError(config *config.Config, w http.ResponseWriter, r *http.Request, err error) {
+	var quotaErr *quota.ExceededError
+	if errors.As(err, &quotaErr) {
+		HandleQuotaExceeded(config, w, r, quotaErr)
+		return
+	}
+
+	var reqErr apierrors.RequestError
+	if !errors.As(err, &reqErr) {
+		reqErr = apierrors.NewErrInternal(err)
+	}
+
+	apierrors.HandleAPIError(config.Logger, config.Alerter, w, r, reqErr, true)
+}
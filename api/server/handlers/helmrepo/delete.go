@@ -39,7 +39,7 @@ func (p *HelmRepoDeleteHandler) ServeHTTP(w http.ResponseWriter, r *http.Request
 		return
 	}
 
-	helmRepo, err := p.Repo().HelmRepo().ReadHelmRepo(proj.ID, helmRepoID)
+	helmRepo, err := p.Repo().HelmRepo().ReadHelmRepo(r.Context(), proj.ID, helmRepoID)
 	if err != nil {
 		if errors.Is(err, gorm.ErrRecordNotFound) {
 			p.HandleAPIError(w, r, apierrors.NewErrNotFound(fmt.Errorf("no such helm repo")))
@@ -51,13 +51,13 @@ func (p *HelmRepoDeleteHandler) ServeHTTP(w http.ResponseWriter, r *http.Request
 	}
 
 	if helmRepo.BasicAuthIntegrationID != 0 {
-		basicAuthInt, err := p.Repo().BasicIntegration().ReadBasicIntegration(proj.ID, helmRepo.BasicAuthIntegrationID)
+		basicAuthInt, err := p.Repo().BasicIntegration().ReadBasicIntegration(r.Context(), proj.ID, helmRepo.BasicAuthIntegrationID)
 
 		if err != nil && !errors.Is(err, gorm.ErrRecordNotFound) {
 			p.HandleAPIError(w, r, apierrors.NewErrInternal(err))
 			return
 		} else if err == nil {
-			_, err = p.Repo().BasicIntegration().DeleteBasicIntegration(basicAuthInt)
+			_, err = p.Repo().BasicIntegration().DeleteBasicIntegration(r.Context(), basicAuthInt)
 
 			if err != nil {
 				p.HandleAPIError(w, r, apierrors.NewErrInternal(err))
@@ -66,7 +66,7 @@ func (p *HelmRepoDeleteHandler) ServeHTTP(w http.ResponseWriter, r *http.Request
 		}
 	}
 
-	err = p.Repo().HelmRepo().DeleteHelmRepo(helmRepo)
+	err = p.Repo().HelmRepo().DeleteHelmRepo(r.Context(), helmRepo)
 
 	if err != nil {
 		p.HandleAPIError(w, r, apierrors.NewErrInternal(err))
@@ -27,7 +27,7 @@ func NewHelmRepoListHandler(
 func (c *HelmRepoListHandler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
 	proj, _ := r.Context().Value(types.ProjectScope).(*models.Project)
 
-	hrs, err := c.Repo().HelmRepo().ListHelmReposByProjectID(proj.ID)
+	hrs, err := c.Repo().HelmRepo().ListHelmReposByProjectID(r.Context(), proj.ID)
 	if err != nil {
 		c.HandleAPIError(w, r, apierrors.NewErrInternal(err))
 		return
@@ -37,7 +37,7 @@ func (t *ChartListHandler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
 
 	if helmRepo.BasicAuthIntegrationID != 0 {
 		// read the basic integration id
-		basic, err := t.Repo().BasicIntegration().ReadBasicIntegration(proj.ID, helmRepo.BasicAuthIntegrationID)
+		basic, err := t.Repo().BasicIntegration().ReadBasicIntegration(r.Context(), proj.ID, helmRepo.BasicAuthIntegrationID)
 		if err != nil {
 			t.HandleAPIError(w, r, apierrors.NewErrInternal(err))
 			return
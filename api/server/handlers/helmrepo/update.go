@@ -39,7 +39,7 @@ func (p *HelmRepoUpdateHandler) ServeHTTP(w http.ResponseWriter, r *http.Request
 		return
 	}
 
-	helmRepo, err := p.Repo().HelmRepo().ReadHelmRepo(proj.ID, helmRepoID)
+	helmRepo, err := p.Repo().HelmRepo().ReadHelmRepo(r.Context(), proj.ID, helmRepoID)
 	if err != nil {
 		if errors.Is(err, gorm.ErrRecordNotFound) {
 			p.HandleAPIError(w, r, apierrors.NewErrNotFound(fmt.Errorf("no such helm repo")))
@@ -61,7 +61,7 @@ func (p *HelmRepoUpdateHandler) ServeHTTP(w http.ResponseWriter, r *http.Request
 	if request.BasicIntegrationID != 0 &&
 		helmRepo.BasicAuthIntegrationID != 0 &&
 		request.BasicIntegrationID != helmRepo.BasicAuthIntegrationID {
-		bi, err := p.Repo().BasicIntegration().ReadBasicIntegration(proj.ID, helmRepo.BasicAuthIntegrationID)
+		bi, err := p.Repo().BasicIntegration().ReadBasicIntegration(r.Context(), proj.ID, helmRepo.BasicAuthIntegrationID)
 
 		if err != nil {
 			if !errors.Is(err, gorm.ErrRecordNotFound) {
@@ -69,7 +69,7 @@ func (p *HelmRepoUpdateHandler) ServeHTTP(w http.ResponseWriter, r *http.Request
 				return
 			}
 		} else {
-			_, err = p.Repo().BasicIntegration().DeleteBasicIntegration(bi)
+			_, err = p.Repo().BasicIntegration().DeleteBasicIntegration(r.Context(), bi)
 
 			if err != nil {
 				p.HandleAPIError(w, r, apierrors.NewErrInternal(err))
@@ -80,7 +80,7 @@ func (p *HelmRepoUpdateHandler) ServeHTTP(w http.ResponseWriter, r *http.Request
 
 	// if a basic integration is specified, verify that it exists in the project
 	if request.BasicIntegrationID != 0 {
-		_, err := p.Repo().BasicIntegration().ReadBasicIntegration(proj.ID, request.BasicIntegrationID)
+		_, err := p.Repo().BasicIntegration().ReadBasicIntegration(r.Context(), proj.ID, request.BasicIntegrationID)
 		if err != nil {
 			if errors.Is(err, gorm.ErrRecordNotFound) {
 				p.HandleAPIError(w, r, apierrors.NewErrForbidden(
@@ -99,7 +99,7 @@ func (p *HelmRepoUpdateHandler) ServeHTTP(w http.ResponseWriter, r *http.Request
 	helmRepo.RepoURL = request.URL
 	helmRepo.BasicAuthIntegrationID = request.BasicIntegrationID
 
-	helmRepo, err = p.Repo().HelmRepo().UpdateHelmRepo(helmRepo)
+	helmRepo, err = p.Repo().HelmRepo().UpdateHelmRepo(r.Context(), helmRepo)
 
 	if err != nil {
 		p.HandleAPIError(w, r, apierrors.NewErrInternal(err))
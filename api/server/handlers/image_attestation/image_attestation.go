@@ -0,0 +1,108 @@
+package image_attestation
+
+import (
+	"encoding/base64"
+	"errors"
+	"fmt"
+	"net/http"
+	"strings"
+
+	"github.com/porter-dev/porter/api/server/handlers"
+	"github.com/porter-dev/porter/api/server/shared"
+	"github.com/porter-dev/porter/api/server/shared/apierrors"
+	"github.com/porter-dev/porter/api/server/shared/config"
+	"github.com/porter-dev/porter/api/types"
+	"github.com/porter-dev/porter/internal/attestation"
+	"github.com/porter-dev/porter/internal/models"
+	"github.com/porter-dev/porter/internal/telemetry"
+	"gorm.io/gorm"
+)
+
+// CreateImageAttestationHandler records the build provenance attestation of an image pushed by the CLI
+type CreateImageAttestationHandler struct {
+	handlers.PorterHandlerReadWriter
+}
+
+// NewCreateImageAttestationHandler returns a new CreateImageAttestationHandler
+func NewCreateImageAttestationHandler(
+	config *config.Config,
+	decoderValidator shared.RequestDecoderValidator,
+	writer shared.ResultWriter,
+) *CreateImageAttestationHandler {
+	return &CreateImageAttestationHandler{
+		PorterHandlerReadWriter: handlers.NewDefaultPorterHandler(config, decoderValidator, writer),
+	}
+}
+
+func (c *CreateImageAttestationHandler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	ctx, span := telemetry.NewSpan(r.Context(), "serve-create-image-attestation")
+	defer span.End()
+
+	project, _ := ctx.Value(types.ProjectScope).(*models.Project)
+
+	request := &types.CreateImageAttestationRequest{}
+	if ok := c.DecodeAndValidate(w, r, request); !ok {
+		_ = telemetry.Error(ctx, span, nil, "error decoding request")
+		return
+	}
+
+	repository := strings.TrimPrefix(request.Repository, "https://")
+
+	telemetry.WithAttributes(span,
+		telemetry.AttributeKV{Key: "app-name", Value: request.AppName},
+		telemetry.AttributeKV{Key: "repository", Value: repository},
+		telemetry.AttributeKV{Key: "tag", Value: request.Tag},
+		telemetry.AttributeKV{Key: "keyless", Value: request.Certificate != ""},
+	)
+
+	envelope, err := base64.StdEncoding.DecodeString(request.B64Envelope)
+	if err != nil {
+		c.HandleAPIError(w, r, apierrors.NewErrPassThroughToClient(telemetry.Error(ctx, span, err, "error decoding attestation envelope"), http.StatusBadRequest))
+		return
+	}
+
+	_, statement, err := attestation.ParseEnvelope(envelope)
+	if err != nil {
+		c.HandleAPIError(w, r, apierrors.NewErrPassThroughToClient(telemetry.Error(ctx, span, err, "invalid attestation envelope"), http.StatusBadRequest))
+		return
+	}
+
+	if !statement.HasSubject(repository, request.Digest) {
+		err := fmt.Errorf("attestation is not about image %s@%s", repository, request.Digest)
+		c.HandleAPIError(w, r, apierrors.NewErrPassThroughToClient(telemetry.Error(ctx, span, err, "attestation subject does not match image"), http.StatusBadRequest))
+		return
+	}
+
+	imageAttestation := &models.ImageAttestation{
+		ProjectID:        project.ID,
+		AppName:          request.AppName,
+		Repository:       repository,
+		Tag:              request.Tag,
+		Digest:           request.Digest,
+		Reference:        request.Reference,
+		Envelope:         string(envelope),
+		Certificate:      request.Certificate,
+		CertificateChain: request.CertificateChain,
+	}
+
+	// attestations which would not pass an enforced policy are rejected when they are uploaded rather than when the app is deployed
+	policy, err := c.Repo().ImageSigningPolicy().ReadImageSigningPolicy(ctx, project.ID)
+	if err != nil && !errors.Is(err, gorm.ErrRecordNotFound) {
+		c.HandleAPIError(w, r, apierrors.NewErrInternal(telemetry.Error(ctx, span, err, "error reading image signing policy")))
+		return
+	}
+	if policy != nil && policy.Enforce {
+		if _, err := attestation.Verify(imageAttestation.ToAttestation(), policy.ToAttestationPolicy(), repository, request.Digest); err != nil {
+			c.HandleAPIError(w, r, apierrors.NewErrPassThroughToClient(telemetry.Error(ctx, span, err, "attestation does not satisfy image signing policy"), http.StatusBadRequest))
+			return
+		}
+	}
+
+	imageAttestation, err = c.Repo().ImageAttestation().CreateImageAttestation(ctx, imageAttestation)
+	if err != nil {
+		c.HandleAPIError(w, r, apierrors.NewErrInternal(telemetry.Error(ctx, span, err, "error saving image attestation")))
+		return
+	}
+
+	c.WriteResult(w, r, imageAttestation.ToImageAttestationType())
+}
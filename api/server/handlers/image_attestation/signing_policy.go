@@ -0,0 +1,122 @@
+package image_attestation
+
+import (
+	"errors"
+	"net/http"
+
+	"github.com/porter-dev/porter/api/server/handlers"
+	"github.com/porter-dev/porter/api/server/shared"
+	"github.com/porter-dev/porter/api/server/shared/apierrors"
+	"github.com/porter-dev/porter/api/server/shared/config"
+	"github.com/porter-dev/porter/api/types"
+	"github.com/porter-dev/porter/internal/attestation"
+	"github.com/porter-dev/porter/internal/models"
+	"github.com/porter-dev/porter/internal/telemetry"
+	"gorm.io/gorm"
+)
+
+// GetImageSigningPolicyHandler returns the image signing policy of a project
+type GetImageSigningPolicyHandler struct {
+	handlers.PorterHandlerWriter
+}
+
+// NewGetImageSigningPolicyHandler returns a new GetImageSigningPolicyHandler
+func NewGetImageSigningPolicyHandler(
+	config *config.Config,
+	writer shared.ResultWriter,
+) *GetImageSigningPolicyHandler {
+	return &GetImageSigningPolicyHandler{
+		PorterHandlerWriter: handlers.NewDefaultPorterHandler(config, nil, writer),
+	}
+}
+
+func (c *GetImageSigningPolicyHandler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	ctx, span := telemetry.NewSpan(r.Context(), "serve-get-image-signing-policy")
+	defer span.End()
+
+	project, _ := ctx.Value(types.ProjectScope).(*models.Project)
+
+	policy, err := c.Repo().ImageSigningPolicy().ReadImageSigningPolicy(ctx, project.ID)
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			// projects without a policy do not enforce image signing
+			c.WriteResult(w, r, &types.ImageSigningPolicy{ProjectID: project.ID})
+			return
+		}
+
+		c.HandleAPIError(w, r, apierrors.NewErrInternal(telemetry.Error(ctx, span, err, "error reading image signing policy")))
+		return
+	}
+
+	c.WriteResult(w, r, policy.ToImageSigningPolicyType())
+}
+
+// UpdateImageSigningPolicyHandler creates or replaces the image signing policy of a project
+type UpdateImageSigningPolicyHandler struct {
+	handlers.PorterHandlerReadWriter
+}
+
+// NewUpdateImageSigningPolicyHandler returns a new UpdateImageSigningPolicyHandler
+func NewUpdateImageSigningPolicyHandler(
+	config *config.Config,
+	decoderValidator shared.RequestDecoderValidator,
+	writer shared.ResultWriter,
+) *UpdateImageSigningPolicyHandler {
+	return &UpdateImageSigningPolicyHandler{
+		PorterHandlerReadWriter: handlers.NewDefaultPorterHandler(config, decoderValidator, writer),
+	}
+}
+
+func (c *UpdateImageSigningPolicyHandler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	ctx, span := telemetry.NewSpan(r.Context(), "serve-update-image-signing-policy")
+	defer span.End()
+
+	project, _ := ctx.Value(types.ProjectScope).(*models.Project)
+
+	request := &types.UpdateImageSigningPolicyRequest{}
+	if ok := c.DecodeAndValidate(w, r, request); !ok {
+		_ = telemetry.Error(ctx, span, nil, "error decoding request")
+		return
+	}
+
+	telemetry.WithAttributes(span,
+		telemetry.AttributeKV{Key: "enforce", Value: request.Enforce},
+		telemetry.AttributeKV{Key: "keyless", Value: request.PublicKey == ""},
+	)
+
+	policy, err := c.Repo().ImageSigningPolicy().ReadImageSigningPolicy(ctx, project.ID)
+	if err != nil && !errors.Is(err, gorm.ErrRecordNotFound) {
+		c.HandleAPIError(w, r, apierrors.NewErrInternal(telemetry.Error(ctx, span, err, "error reading image signing policy")))
+		return
+	}
+	if policy == nil {
+		policy = &models.ImageSigningPolicy{ProjectID: project.ID}
+	}
+
+	policy.Enforce = request.Enforce
+	policy.PublicKey = request.PublicKey
+	policy.KeylessIdentity = request.KeylessIdentity
+	policy.KeylessIssuer = request.KeylessIssuer
+	policy.FulcioRoots = request.FulcioRoots
+
+	// a policy which is not enforced may be left empty, so that it can be configured later
+	isEmpty := request.PublicKey == "" && request.KeylessIdentity == "" && request.KeylessIssuer == "" && request.FulcioRoots == ""
+	if request.Enforce || !isEmpty {
+		if err := attestation.ValidatePolicy(policy.ToAttestationPolicy()); err != nil {
+			c.HandleAPIError(w, r, apierrors.NewErrPassThroughToClient(telemetry.Error(ctx, span, err, "invalid image signing policy"), http.StatusBadRequest))
+			return
+		}
+	}
+
+	if policy.ID == 0 {
+		policy, err = c.Repo().ImageSigningPolicy().CreateImageSigningPolicy(ctx, policy)
+	} else {
+		policy, err = c.Repo().ImageSigningPolicy().UpdateImageSigningPolicy(ctx, policy)
+	}
+	if err != nil {
+		c.HandleAPIError(w, r, apierrors.NewErrInternal(telemetry.Error(ctx, span, err, "error saving image signing policy")))
+		return
+	}
+
+	c.WriteResult(w, r, policy.ToImageSigningPolicyType())
+}
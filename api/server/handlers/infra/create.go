@@ -47,7 +47,7 @@ func (c *InfraCreateHandler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
 	var err error
 
 	if req.ClusterID != 0 {
-		cluster, err = c.Repo().Cluster().ReadCluster(proj.ID, req.ClusterID)
+		cluster, err = c.Repo().Cluster().ReadCluster(r.Context(), proj.ID, req.ClusterID)
 
 		if err != nil {
 			if err == gorm.ErrRecordNotFound {
@@ -86,7 +86,7 @@ func (c *InfraCreateHandler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
 	}
 
 	// verify the credentials
-	err = checkInfraCredentials(c.Config(), proj, infra, req.InfraCredentials)
+	err = checkInfraCredentials(r.Context(), c.Config(), proj, infra, req.InfraCredentials)
 
 	if err != nil {
 		c.HandleAPIError(w, r, apierrors.NewErrForbidden(err))
@@ -113,7 +113,7 @@ func (c *InfraCreateHandler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
 	}
 
 	// handle write to the database
-	infra, err = c.Repo().Infra().CreateInfra(infra)
+	infra, err = c.Repo().Infra().CreateInfra(r.Context(), infra)
 
 	if err != nil {
 		c.HandleAPIError(w, r, apierrors.NewErrInternal(err))
@@ -133,13 +133,13 @@ func (c *InfraCreateHandler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
 	c.WriteResult(w, r, resp)
 }
 
-func checkInfraCredentials(config *config.Config, proj *models.Project, infra *models.Infra, req *types.InfraCredentials) error {
+func checkInfraCredentials(ctx context.Context, config *config.Config, proj *models.Project, infra *models.Infra, req *types.InfraCredentials) error {
 	if req == nil {
 		return nil
 	}
 
 	if req.DOIntegrationID != 0 {
-		_, err := config.Repo.OAuthIntegration().ReadOAuthIntegration(proj.ID, req.DOIntegrationID)
+		_, err := config.Repo.OAuthIntegration().ReadOAuthIntegration(ctx, proj.ID, req.DOIntegrationID)
 		if err != nil {
 			return fmt.Errorf("do integration id %d not found in project %d", req.DOIntegrationID, proj.ID)
 		}
@@ -149,7 +149,7 @@ func checkInfraCredentials(config *config.Config, proj *models.Project, infra *m
 		infra.GCPIntegrationID = 0
 		infra.AzureIntegrationID = 0
 	} else if req.AWSIntegrationID != 0 {
-		_, err := config.Repo.AWSIntegration().ReadAWSIntegration(proj.ID, req.AWSIntegrationID)
+		_, err := config.Repo.AWSIntegration().ReadAWSIntegration(ctx, proj.ID, req.AWSIntegrationID)
 		if err != nil {
 			return fmt.Errorf("aws integration id %d not found in project %d", req.AWSIntegrationID, proj.ID)
 		}
@@ -159,7 +159,7 @@ func checkInfraCredentials(config *config.Config, proj *models.Project, infra *m
 		infra.GCPIntegrationID = 0
 		infra.AzureIntegrationID = 0
 	} else if req.GCPIntegrationID != 0 {
-		_, err := config.Repo.GCPIntegration().ReadGCPIntegration(proj.ID, req.GCPIntegrationID)
+		_, err := config.Repo.GCPIntegration().ReadGCPIntegration(ctx, proj.ID, req.GCPIntegrationID)
 		if err != nil {
 			return fmt.Errorf("gcp integration id %d not found in project %d", req.GCPIntegrationID, proj.ID)
 		}
@@ -169,7 +169,7 @@ func checkInfraCredentials(config *config.Config, proj *models.Project, infra *m
 		infra.GCPIntegrationID = req.GCPIntegrationID
 		infra.AzureIntegrationID = 0
 	} else if req.AzureIntegrationID != 0 {
-		_, err := config.Repo.AzureIntegration().ReadAzureIntegration(proj.ID, req.AzureIntegrationID)
+		_, err := config.Repo.AzureIntegration().ReadAzureIntegration(ctx, proj.ID, req.AzureIntegrationID)
 		if err != nil {
 			return fmt.Errorf("azure integration id %d not found in project %d", req.AzureIntegrationID, proj.ID)
 		}
@@ -233,13 +233,13 @@ func (i *InfraRDSPostrenderer) Run(w http.ResponseWriter, r *http.Request, opts
 		values := opts.Values
 
 		// find the corresponding infra id
-		clusterInfra, err := i.config.Repo.Infra().ReadInfra(proj.ID, opts.Cluster.InfraID)
+		clusterInfra, err := i.config.Repo.Infra().ReadInfra(r.Context(), proj.ID, opts.Cluster.InfraID)
 		if err != nil {
 			apierrors.HandleAPIError(i.config.Logger, i.config.Alerter, w, r, apierrors.NewErrForbidden(fmt.Errorf("could not get cluster infra: %v", err)), true)
 			return nil, false
 		}
 
-		clusterInfraOperation, err := i.config.Repo.Infra().GetLatestOperation(clusterInfra)
+		clusterInfraOperation, err := i.config.Repo.Infra().GetLatestOperation(r.Context(), clusterInfra)
 
 		// get the raw state for the cluster
 		rawState, err := i.config.ProvisionerClient.GetRawState(context.Background(), models.GetWorkspaceID(clusterInfra, clusterInfraOperation))
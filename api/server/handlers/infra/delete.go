@@ -41,13 +41,13 @@ func (c *InfraDeleteHandler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
 	}
 
 	// verify the credentials
-	err := checkInfraCredentials(c.Config(), proj, infra, req.InfraCredentials)
+	err := checkInfraCredentials(ctx, c.Config(), proj, infra, req.InfraCredentials)
 	if err != nil {
 		c.HandleAPIError(w, r, apierrors.NewErrForbidden(err))
 		return
 	}
 
-	lastOperation, err := c.Repo().Infra().GetLatestOperation(infra)
+	lastOperation, err := c.Repo().Infra().GetLatestOperation(ctx, infra)
 	if err != nil {
 		c.HandleAPIError(w, r, apierrors.NewErrInternal(err))
 		return
@@ -66,7 +66,7 @@ func (c *InfraDeleteHandler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
 	// mark the infra as destroying
 	infra.Status = types.StatusDestroying
 
-	infra, err = c.Repo().Infra().UpdateInfra(infra)
+	infra, err = c.Repo().Infra().UpdateInfra(ctx, infra)
 
 	if err != nil {
 		c.HandleAPIError(w, r, apierrors.NewErrInternal(err))
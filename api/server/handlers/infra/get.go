@@ -33,7 +33,7 @@ func (c *InfraGetHandler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
 	res := infra.ToInfraType()
 
 	// look for the latest operation and attach it, if it exists
-	operation, err := c.Repo().Infra().GetLatestOperation(infra)
+	operation, err := c.Repo().Infra().GetLatestOperation(r.Context(), infra)
 	if err != nil {
 		if errors.Is(err, gorm.ErrRecordNotFound) {
 			c.HandleAPIError(w, r, apierrors.NewErrInternal(fmt.Errorf("latest operation not found")))
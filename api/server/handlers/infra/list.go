@@ -34,7 +34,7 @@ func (p *InfraListHandler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
-	infras, err := p.Repo().Infra().ListInfrasByProjectID(proj.ID, req.Version)
+	infras, err := p.Repo().Infra().ListInfrasByProjectID(r.Context(), proj.ID, req.Version)
 	if err != nil {
 		p.HandleAPIError(w, r, apierrors.NewErrInternal(err))
 	}
@@ -27,7 +27,7 @@ func NewInfraListOperationsHandler(
 func (c *InfraListOperationsHandler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
 	infra, _ := r.Context().Value(types.InfraScope).(*models.Infra)
 
-	ops, err := c.Repo().Infra().ListOperations(infra.ID)
+	ops, err := c.Repo().Infra().ListOperations(r.Context(), infra.ID)
 	if err != nil {
 		c.HandleAPIError(w, r, apierrors.NewErrInternal(err))
 		return
@@ -40,7 +40,7 @@ func (c *InfraRetryCreateHandler) ServeHTTP(w http.ResponseWriter, r *http.Reque
 	var err error
 
 	if infra.ParentClusterID != 0 {
-		cluster, err = c.Repo().Cluster().ReadCluster(proj.ID, infra.ParentClusterID)
+		cluster, err = c.Repo().Cluster().ReadCluster(r.Context(), proj.ID, infra.ParentClusterID)
 
 		if err != nil {
 			if err == gorm.ErrRecordNotFound {
@@ -56,14 +56,14 @@ func (c *InfraRetryCreateHandler) ServeHTTP(w http.ResponseWriter, r *http.Reque
 	}
 
 	// verify the credentials
-	err = checkInfraCredentials(c.Config(), proj, infra, req.InfraCredentials)
+	err = checkInfraCredentials(r.Context(), c.Config(), proj, infra, req.InfraCredentials)
 
 	if err != nil {
 		c.HandleAPIError(w, r, apierrors.NewErrForbidden(err))
 		return
 	}
 
-	lastOperation, err := c.Repo().Infra().GetLatestOperation(infra)
+	lastOperation, err := c.Repo().Infra().GetLatestOperation(r.Context(), infra)
 	if err != nil {
 		c.HandleAPIError(w, r, apierrors.NewErrInternal(err))
 		return
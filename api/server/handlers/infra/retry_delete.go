@@ -35,13 +35,13 @@ func (c *InfraRetryDeleteHandler) ServeHTTP(w http.ResponseWriter, r *http.Reque
 	}
 
 	// verify the credentials
-	err := checkInfraCredentials(c.Config(), proj, infra, req.InfraCredentials)
+	err := checkInfraCredentials(r.Context(), c.Config(), proj, infra, req.InfraCredentials)
 	if err != nil {
 		c.HandleAPIError(w, r, apierrors.NewErrForbidden(err))
 		return
 	}
 
-	lastOperation, err := c.Repo().Infra().GetLatestOperation(infra)
+	lastOperation, err := c.Repo().Infra().GetLatestOperation(r.Context(), infra)
 	if err != nil {
 		c.HandleAPIError(w, r, apierrors.NewErrInternal(err))
 		return
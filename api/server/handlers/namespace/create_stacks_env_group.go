@@ -107,7 +107,7 @@ func rolloutStacksApplications(
 	ctx context.Context,
 	w http.ResponseWriter,
 ) []error {
-	registries, err := config.Repo.Registry().ListRegistriesByProjectID(cluster.ProjectID)
+	registries, err := config.Repo.Registry().ListRegistriesByProjectID(ctx, cluster.ProjectID)
 	if err != nil {
 		return []error{err}
 	}
@@ -281,9 +281,9 @@ func rolloutStacksApplications(
 			}
 		}()
 
-		app, err := c.Repo().PorterApp().ReadPorterAppByName(cluster.ID, releases[index].Name)
+		app, err := c.Repo().PorterApp().ReadPorterAppByName(ctx, cluster.ID, releases[index].Name)
 		ctx, span := telemetry.NewSpan(ctx, "serve-update-porter-app")
-		updatedPorterApp, err := c.Repo().PorterApp().UpdatePorterApp(app)
+		updatedPorterApp, err := c.Repo().PorterApp().UpdatePorterApp(ctx, app)
 		if err != nil {
 			err = telemetry.Error(ctx, span, err, "error writing updated app to DB")
 			c.HandleAPIError(w, r, apierrors.NewErrPassThroughToClient(err, http.StatusInternalServerError))
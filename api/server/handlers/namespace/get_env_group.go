@@ -63,7 +63,7 @@ func (c *GetEnvGroupHandler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
-	stackId, err := stacks.GetStackForEnvGroup(c.Config(), cluster.ProjectID, cluster.ID, envGroup)
+	stackId, err := stacks.GetStackForEnvGroup(r.Context(), c.Config(), cluster.ProjectID, cluster.ID, envGroup)
 	if err != nil {
 		if errors.Is(err, gorm.ErrRecordNotFound) {
 			c.WriteResult(w, r, &types.GetEnvGroupResponse{EnvGroup: envGroup})
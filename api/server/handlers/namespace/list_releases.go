@@ -78,7 +78,7 @@ func (c *ListReleasesHandler) ServeHTTP(w http.ResponseWriter, r *http.Request)
 			telemetry.AttributeKV{Key: "release_namespace", Value: helmRel.Namespace},
 		)
 
-		rel, err := c.Repo().Release().ReadRelease(cluster.ID, helmRel.Name, helmRel.Namespace)
+		rel, err := c.Repo().Release().ReadRelease(ctx, cluster.ID, helmRel.Name, helmRel.Namespace)
 		if err != nil {
 			telemetry.Error(ctx, span, err, "failed to read release. Not a fatal error")
 		}
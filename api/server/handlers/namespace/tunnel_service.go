@@ -0,0 +1,83 @@
+package namespace
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+
+	"github.com/porter-dev/porter/api/server/authz"
+	"github.com/porter-dev/porter/api/server/handlers"
+	"github.com/porter-dev/porter/api/server/shared"
+	"github.com/porter-dev/porter/api/server/shared/apierrors"
+	"github.com/porter-dev/porter/api/server/shared/config"
+	"github.com/porter-dev/porter/api/server/shared/requestutils"
+	"github.com/porter-dev/porter/api/server/shared/websocket"
+	"github.com/porter-dev/porter/api/types"
+	"github.com/porter-dev/porter/internal/kubernetes"
+	"github.com/porter-dev/porter/internal/models"
+	"github.com/porter-dev/porter/internal/telemetry"
+)
+
+// TunnelServiceHandler tunnels a websocket connection to a port of a service in the cluster
+type TunnelServiceHandler struct {
+	handlers.PorterHandlerReadWriter
+	authz.KubernetesAgentGetter
+}
+
+// NewTunnelServiceHandler returns a new TunnelServiceHandler
+func NewTunnelServiceHandler(
+	config *config.Config,
+	decoderValidator shared.RequestDecoderValidator,
+	writer shared.ResultWriter,
+) *TunnelServiceHandler {
+	return &TunnelServiceHandler{
+		PorterHandlerReadWriter: handlers.NewDefaultPorterHandler(config, decoderValidator, writer),
+		KubernetesAgentGetter:   authz.NewOutOfClusterAgentGetter(config),
+	}
+}
+
+// ServeHTTP forwards the websocket connection to a ready pod of the service until either side closes the connection
+func (c *TunnelServiceHandler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	ctx, span := telemetry.NewSpan(r.Context(), "serve-tunnel-service")
+	defer span.End()
+
+	request := &types.TunnelServiceRequest{}
+	if ok := c.DecodeAndValidate(w, r, request); !ok {
+		return
+	}
+
+	safeRW := ctx.Value(types.RequestCtxWebsocketKey).(*websocket.WebsocketSafeReadWriter)
+	namespace := ctx.Value(types.NamespaceScope).(string)
+	cluster, _ := ctx.Value(types.ClusterScope).(*models.Cluster)
+	name, _ := requestutils.GetURLParamString(r, types.URLParamServiceName)
+
+	telemetry.WithAttributes(span,
+		telemetry.AttributeKV{Key: "namespace", Value: namespace},
+		telemetry.AttributeKV{Key: "service", Value: name},
+		telemetry.AttributeKV{Key: "port", Value: int(request.Port)},
+	)
+
+	agent, err := c.GetAgent(r, cluster, "")
+	if err != nil {
+		c.HandleAPIError(w, r, apierrors.NewErrInternal(telemetry.Error(ctx, span, err, "error getting agent")))
+		return
+	}
+
+	err = agent.TunnelToService(ctx, namespace, name, request.Port, safeRW)
+	if err != nil {
+		var badRequestErr *kubernetes.BadRequestError
+
+		switch {
+		case errors.Is(err, kubernetes.IsNotFoundError):
+			c.HandleAPIError(w, r, apierrors.NewErrPassThroughToClient(
+				telemetry.Error(ctx, span, fmt.Errorf("service %s/%s was not found", namespace, name), "service not found"),
+				http.StatusNotFound,
+			))
+		case errors.As(err, &badRequestErr):
+			c.HandleAPIError(w, r, apierrors.NewErrPassThroughToClient(telemetry.Error(ctx, span, err, "service cannot be tunneled to"), http.StatusBadRequest))
+		default:
+			c.HandleAPIError(w, r, apierrors.NewErrInternal(telemetry.Error(ctx, span, err, "error tunneling to service")))
+		}
+		return
+	}
+}
@@ -62,7 +62,7 @@ func (p *OAuthCallbackSlackHandler) ServeHTTP(w http.ResponseWriter, r *http.Req
 	slackInt.UserID = userID
 	slackInt.ProjectID = projID
 
-	if _, err = p.Repo().SlackIntegration().CreateSlackIntegration(slackInt); err != nil {
+	if _, err = p.Repo().SlackIntegration().CreateSlackIntegration(r.Context(), slackInt); err != nil {
 		p.HandleAPIError(w, r, apierrors.NewErrInternal(err))
 		return
 	}
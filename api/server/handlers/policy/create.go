@@ -6,6 +6,7 @@ import (
 	"net/http"
 	"strings"
 
+	"github.com/porter-dev/porter/api/server/authz/policy"
 	"github.com/porter-dev/porter/api/server/handlers"
 	"github.com/porter-dev/porter/api/server/shared"
 	"github.com/porter-dev/porter/api/server/shared/apierrors"
@@ -40,7 +41,7 @@ func (p *PolicyCreateHandler) ServeHTTP(w http.ResponseWriter, r *http.Request)
 	}
 
 	// policy can't be one of the preset policy names
-	if name := strings.ToLower(req.Name); name == "admin" || name == "developer" || name == "viewer" {
+	if isPresetPolicyName(req.Name) {
 		p.HandleAPIError(w, r, apierrors.NewErrPassThroughToClient(
 			fmt.Errorf("name cannot be one of the preset policy names"),
 			http.StatusBadRequest,
@@ -49,6 +50,11 @@ func (p *PolicyCreateHandler) ServeHTTP(w http.ResponseWriter, r *http.Request)
 		return
 	}
 
+	if err := policy.ValidatePolicy(req.Policy); err != nil {
+		p.HandleAPIError(w, r, apierrors.NewErrPassThroughToClient(err, http.StatusBadRequest))
+		return
+	}
+
 	uid, err := encryption.GenerateRandomBytes(16)
 	if err != nil {
 		p.HandleAPIError(w, r, apierrors.NewErrInternal(err))
@@ -61,7 +67,7 @@ func (p *PolicyCreateHandler) ServeHTTP(w http.ResponseWriter, r *http.Request)
 		return
 	}
 
-	policy := &models.Policy{
+	policyModel := &models.Policy{
 		ProjectID:       proj.ID,
 		UniqueID:        uid,
 		CreatedByUserID: user.ID,
@@ -69,14 +75,14 @@ func (p *PolicyCreateHandler) ServeHTTP(w http.ResponseWriter, r *http.Request)
 		PolicyBytes:     policyBytes,
 	}
 
-	policy, err = p.Repo().Policy().CreatePolicy(policy)
+	policyModel, err = p.Repo().Policy().CreatePolicy(r.Context(), policyModel)
 
 	if err != nil {
 		p.HandleAPIError(w, r, apierrors.NewErrInternal(err))
 		return
 	}
 
-	res, err := policy.ToAPIPolicyType()
+	res, err := policyModel.ToAPIPolicyType()
 	if err != nil {
 		p.HandleAPIError(w, r, apierrors.NewErrInternal(err))
 		return
@@ -84,3 +90,13 @@ func (p *PolicyCreateHandler) ServeHTTP(w http.ResponseWriter, r *http.Request)
 
 	p.WriteResult(w, r, res)
 }
+
+// isPresetPolicyName returns true if the name is the name of a preset policy, which custom policies cannot use
+func isPresetPolicyName(name string) bool {
+	switch types.RoleKind(strings.ToLower(name)) {
+	case types.RoleAdmin, types.RoleDeveloper, types.RoleViewer:
+		return true
+	default:
+		return false
+	}
+}
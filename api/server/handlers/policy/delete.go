@@ -0,0 +1,95 @@
+package policy
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+
+	"github.com/porter-dev/porter/api/server/handlers"
+	"github.com/porter-dev/porter/api/server/shared"
+	"github.com/porter-dev/porter/api/server/shared/apierrors"
+	"github.com/porter-dev/porter/api/server/shared/config"
+	"github.com/porter-dev/porter/api/server/shared/requestutils"
+	"github.com/porter-dev/porter/api/types"
+	"github.com/porter-dev/porter/internal/models"
+	"gorm.io/gorm"
+)
+
+// PolicyDeleteHandler deletes a custom policy which is not assigned to any project member or API token
+type PolicyDeleteHandler struct {
+	handlers.PorterHandlerWriter
+}
+
+func NewPolicyDeleteHandler(
+	config *config.Config,
+	writer shared.ResultWriter,
+) *PolicyDeleteHandler {
+	return &PolicyDeleteHandler{
+		PorterHandlerWriter: handlers.NewDefaultPorterHandler(config, nil, writer),
+	}
+}
+
+func (p *PolicyDeleteHandler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	proj, _ := r.Context().Value(types.ProjectScope).(*models.Project)
+
+	policyID, reqErr := requestutils.GetURLParamString(r, types.URLParamPolicyID)
+
+	if reqErr != nil {
+		p.HandleAPIError(w, r, reqErr)
+		return
+	}
+
+	policyModel, err := p.Repo().Policy().ReadPolicy(r.Context(), proj.ID, policyID)
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			p.HandleAPIError(w, r, apierrors.NewErrPassThroughToClient(
+				fmt.Errorf("policy with id %s not found in project", policyID),
+				http.StatusNotFound,
+			))
+			return
+		}
+
+		p.HandleAPIError(w, r, apierrors.NewErrInternal(err))
+		return
+	}
+
+	// members and tokens assigned a deleted policy would lose all access, so they must be reassigned first
+	roles, err := p.Repo().Project().ListProjectRoles(r.Context(), proj.ID)
+	if err != nil {
+		p.HandleAPIError(w, r, apierrors.NewErrInternal(err))
+		return
+	}
+
+	for _, role := range roles {
+		if role.Kind == types.RoleCustom && role.PolicyUID == policyModel.UniqueID {
+			p.HandleAPIError(w, r, apierrors.NewErrPassThroughToClient(
+				fmt.Errorf("policy is assigned to user %d and cannot be deleted", role.UserID),
+				http.StatusConflict,
+			))
+			return
+		}
+	}
+
+	tokens, err := p.Repo().APIToken().ListAPITokensByProjectID(r.Context(), proj.ID)
+	if err != nil {
+		p.HandleAPIError(w, r, apierrors.NewErrInternal(err))
+		return
+	}
+
+	for _, token := range tokens {
+		if token.PolicyUID == policyModel.UniqueID {
+			p.HandleAPIError(w, r, apierrors.NewErrPassThroughToClient(
+				fmt.Errorf("policy is assigned to api token %s and cannot be deleted", token.Name),
+				http.StatusConflict,
+			))
+			return
+		}
+	}
+
+	if _, err := p.Repo().Policy().DeletePolicy(r.Context(), policyModel); err != nil {
+		p.HandleAPIError(w, r, apierrors.NewErrInternal(err))
+		return
+	}
+
+	w.WriteHeader(http.StatusNoContent)
+}
@@ -40,7 +40,7 @@ func (p *PolicyGetHandler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
-	policy, err := p.Repo().Policy().ReadPolicy(proj.ID, policyID)
+	policy, err := p.Repo().Policy().ReadPolicy(r.Context(), proj.ID, policyID)
 	if err != nil {
 		if errors.Is(err, gorm.ErrRecordNotFound) {
 			p.HandleAPIError(w, r, apierrors.NewErrPassThroughToClient(
@@ -0,0 +1,52 @@
+package porter_app
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+
+	"github.com/porter-dev/porter/api/server/shared/apierrors"
+	"github.com/porter-dev/porter/api/server/shared/config"
+	"github.com/porter-dev/porter/api/types"
+	"github.com/porter-dev/porter/internal/models"
+	"github.com/porter-dev/porter/internal/telemetry"
+)
+
+// claimAppConfigRevision checks the If-Match header of a request which applies the configuration of an app against the config
+// revision of the app, and increments the revision so that other requests made from the same revision are rejected. The
+// header is not required for apps which have never been applied.
+func claimAppConfigRevision(ctx context.Context, config *config.Config, app *models.PorterApp, ifMatch string) apierrors.RequestError {
+	ctx, span := telemetry.NewSpan(ctx, "claim-app-config-revision")
+	defer span.End()
+
+	telemetry.WithAttributes(span,
+		telemetry.AttributeKV{Key: "porter-app-id", Value: app.ID},
+		telemetry.AttributeKV{Key: "config-revision", Value: app.ConfigRevision},
+		telemetry.AttributeKV{Key: "if-match", Value: ifMatch},
+	)
+
+	etag := types.AppConfigETag(app.ConfigRevision)
+
+	if ifMatch == "" && app.ConfigRevision != 0 {
+		err := telemetry.Error(ctx, span, nil, fmt.Sprintf("%s header must be set to the ETag of the app configuration being updated, which is currently %s", types.IfMatchHeader, etag))
+		return apierrors.NewErrPassThroughToClient(err, http.StatusPreconditionRequired)
+	}
+
+	if ifMatch != "" && !types.AppConfigETagMatches(ifMatch, app.ConfigRevision) {
+		err := telemetry.Error(ctx, span, nil, fmt.Sprintf("app configuration has been updated since it was read, the current ETag is %s", etag))
+		return apierrors.NewErrPassThroughToClient(err, http.StatusConflict)
+	}
+
+	ok, err := config.Repo.PorterApp().IncrementPorterAppConfigRevision(ctx, app)
+	if err != nil {
+		err := telemetry.Error(ctx, span, err, "error incrementing app config revision")
+		return apierrors.NewErrPassThroughToClient(err, http.StatusInternalServerError)
+	}
+
+	if !ok {
+		err := telemetry.Error(ctx, span, nil, "app configuration was updated by another request, read the app and try again")
+		return apierrors.NewErrPassThroughToClient(err, http.StatusConflict)
+	}
+
+	return nil
+}
@@ -0,0 +1,263 @@
+package porter_app
+
+import (
+	"fmt"
+	"net/http"
+	"regexp"
+	"sort"
+	"time"
+
+	"connectrpc.com/connect"
+	porterv1 "github.com/porter-dev/api-contracts/generated/go/porter/v1"
+
+	"github.com/porter-dev/porter/api/server/authz"
+	"github.com/porter-dev/porter/api/server/handlers"
+	"github.com/porter-dev/porter/api/server/shared"
+	"github.com/porter-dev/porter/api/server/shared/apierrors"
+	"github.com/porter-dev/porter/api/server/shared/config"
+	"github.com/porter-dev/porter/api/server/shared/requestutils"
+	"github.com/porter-dev/porter/api/types"
+	"github.com/porter-dev/porter/internal/kubernetes/prometheus"
+	"github.com/porter-dev/porter/internal/models"
+	"github.com/porter-dev/porter/internal/telemetry"
+)
+
+// AppMetricsHandler handles requests to the /apps/{porter_app_name}/metrics endpoint
+type AppMetricsHandler struct {
+	handlers.PorterHandlerReadWriter
+	authz.KubernetesAgentGetter
+}
+
+// NewAppMetricsHandler returns a new AppMetricsHandler
+func NewAppMetricsHandler(
+	config *config.Config,
+	decoderValidator shared.RequestDecoderValidator,
+	writer shared.ResultWriter,
+) *AppMetricsHandler {
+	return &AppMetricsHandler{
+		PorterHandlerReadWriter: handlers.NewDefaultPorterHandler(config, decoderValidator, writer),
+		KubernetesAgentGetter:   authz.NewOutOfClusterAgentGetter(config),
+	}
+}
+
+// AppMetricsRequest is the request object for the /apps/{porter_app_name}/metrics endpoint
+type AppMetricsRequest struct {
+	DeploymentTargetID string `schema:"deployment_target_id"`
+	// Service limits the metrics to a single service of the app
+	Service string `schema:"service"`
+	// StartRange and EndRange are unix timestamps, defaulting to the last hour
+	StartRange int64 `schema:"start_range"`
+	EndRange   int64 `schema:"end_range"`
+	// StepSeconds is the resolution of the metrics, defaulting to a resolution which returns about 120 points per metric
+	StepSeconds int `schema:"step_seconds"`
+}
+
+// AppMetricsResponse is the response object for the /apps/{porter_app_name}/metrics endpoint
+type AppMetricsResponse struct {
+	StartRange  int64             `json:"start_range"`
+	EndRange    int64             `json:"end_range"`
+	StepSeconds int               `json:"step_seconds"`
+	Services    []*ServiceMetrics `json:"services"`
+}
+
+// ServiceMetrics are the metrics of a single service. Every metric is returned for every service, with no points if there were
+// no samples, such as latency metrics of services which do not receive ingress traffic.
+type ServiceMetrics struct {
+	Name    string          `json:"name"`
+	Metrics []*MetricSeries `json:"metrics"`
+}
+
+// MetricSeries is the values of a metric of a service over the requested range
+type MetricSeries struct {
+	Metric prometheus.AppMetric     `json:"metric"`
+	Unit   string                   `json:"unit"`
+	Points []prometheus.MetricPoint `json:"points"`
+}
+
+const (
+	// defaultAppMetricsRange is the range of the metrics if the request does not set a start
+	defaultAppMetricsRange = time.Hour
+	// maxAppMetricsRange is the longest range that can be queried, bounded by the retention of the cluster prometheus
+	maxAppMetricsRange = 30 * 24 * time.Hour
+	// appMetricsPoints is the number of points per metric when the request does not set a resolution
+	appMetricsPoints = 120
+	// minAppMetricsStep is the finest resolution of the metrics, which matches the default prometheus scrape interval
+	minAppMetricsStep = 15 * time.Second
+)
+
+// ServeHTTP queries the cluster prometheus for the CPU, memory, network and HTTP latency metrics of each service of an app on a
+// deployment target
+func (c *AppMetricsHandler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	ctx, span := telemetry.NewSpan(r.Context(), "serve-app-metrics")
+	defer span.End()
+
+	project, _ := ctx.Value(types.ProjectScope).(*models.Project)
+	cluster, _ := ctx.Value(types.ClusterScope).(*models.Cluster)
+
+	telemetry.WithAttributes(span,
+		telemetry.AttributeKV{Key: "project-id", Value: project.ID},
+		telemetry.AttributeKV{Key: "cluster-id", Value: cluster.ID},
+	)
+
+	appName, reqErr := requestutils.GetURLParamString(r, types.URLParamPorterAppName)
+	if reqErr != nil {
+		err := telemetry.Error(ctx, span, reqErr, "error parsing app name from url")
+		c.HandleAPIError(w, r, apierrors.NewErrPassThroughToClient(err, http.StatusBadRequest))
+		return
+	}
+
+	request := &AppMetricsRequest{}
+	if ok := c.DecodeAndValidate(w, r, request); !ok {
+		err := telemetry.Error(ctx, span, nil, "error decoding request")
+		c.HandleAPIError(w, r, apierrors.NewErrPassThroughToClient(err, http.StatusBadRequest))
+		return
+	}
+
+	end := time.Now()
+	if request.EndRange > 0 {
+		end = time.Unix(request.EndRange, 0)
+	}
+
+	start := end.Add(-defaultAppMetricsRange)
+	if request.StartRange > 0 {
+		start = time.Unix(request.StartRange, 0)
+	}
+
+	if !start.Before(end) {
+		err := telemetry.Error(ctx, span, nil, "start of range must be before its end")
+		c.HandleAPIError(w, r, apierrors.NewErrPassThroughToClient(err, http.StatusBadRequest))
+		return
+	}
+
+	if end.Sub(start) > maxAppMetricsRange {
+		start = end.Add(-maxAppMetricsRange)
+	}
+
+	step := time.Duration(request.StepSeconds) * time.Second
+	if step <= 0 {
+		step = end.Sub(start) / appMetricsPoints
+	}
+	if step < minAppMetricsStep {
+		step = minAppMetricsStep
+	}
+
+	telemetry.WithAttributes(span,
+		telemetry.AttributeKV{Key: "app-name", Value: appName},
+		telemetry.AttributeKV{Key: "deployment-target-id", Value: request.DeploymentTargetID},
+		telemetry.AttributeKV{Key: "service-name", Value: request.Service},
+		telemetry.AttributeKV{Key: "start-range", Value: start.Unix()},
+		telemetry.AttributeKV{Key: "end-range", Value: end.Unix()},
+		telemetry.AttributeKV{Key: "step-seconds", Value: int(step.Seconds())},
+	)
+
+	deploymentTarget, err := c.Repo().DeploymentTarget().DeploymentTargetByID(ctx, project.ID, request.DeploymentTargetID)
+	if err != nil {
+		err := telemetry.Error(ctx, span, err, "error reading deployment target")
+		c.HandleAPIError(w, r, apierrors.NewErrPassThroughToClient(err, http.StatusBadRequest))
+		return
+	}
+
+	if deploymentTarget.SelectorType != DeploymentTargetSelectorType_Default {
+		err := telemetry.Error(ctx, span, nil, "app metrics are only supported for namespace deployment targets")
+		c.HandleAPIError(w, r, apierrors.NewErrPassThroughToClient(err, http.StatusBadRequest))
+		return
+	}
+	namespace := deploymentTarget.Selector
+
+	porterApp, err := c.Repo().PorterApp().ReadPorterAppByName(ctx, cluster.ID, appName)
+	if err != nil || porterApp == nil || porterApp.ID == 0 {
+		err := telemetry.Error(ctx, span, err, "error reading porter app")
+		c.HandleAPIError(w, r, apierrors.NewErrPassThroughToClient(err, http.StatusNotFound))
+		return
+	}
+
+	revisionResp, err := c.Config().ClusterControlPlaneClient.CurrentAppRevision(ctx, connect.NewRequest(&porterv1.CurrentAppRevisionRequest{
+		ProjectId:          int64(project.ID),
+		AppId:              int64(porterApp.ID),
+		DeploymentTargetId: deploymentTarget.ID.String(),
+	}))
+	if err != nil || revisionResp == nil || revisionResp.Msg == nil || revisionResp.Msg.AppRevision.GetApp() == nil {
+		err := telemetry.Error(ctx, span, err, "error getting current app revision from cluster control plane client")
+		c.HandleAPIError(w, r, apierrors.NewErrPassThroughToClient(err, http.StatusBadRequest))
+		return
+	}
+
+	services := revisionResp.Msg.AppRevision.GetApp().Services
+	if request.Service != "" {
+		if _, ok := services[request.Service]; !ok {
+			err := telemetry.Error(ctx, span, nil, "service not found in app")
+			c.HandleAPIError(w, r, apierrors.NewErrPassThroughToClient(err, http.StatusNotFound))
+			return
+		}
+	}
+
+	agent, err := c.GetAgent(r, cluster, "")
+	if err != nil {
+		err := telemetry.Error(ctx, span, err, "error getting kubernetes agent")
+		c.HandleAPIError(w, r, apierrors.NewErrPassThroughToClient(err, http.StatusInternalServerError))
+		return
+	}
+
+	promSvc, found, err := prometheus.GetPrometheusService(agent.Clientset)
+	if err != nil || !found {
+		err := telemetry.Error(ctx, span, err, "error getting prometheus service")
+		c.HandleAPIError(w, r, apierrors.NewErrPassThroughToClient(err, http.StatusPreconditionFailed))
+		return
+	}
+
+	response := &AppMetricsResponse{
+		StartRange:  start.Unix(),
+		EndRange:    end.Unix(),
+		StepSeconds: int(step.Seconds()),
+		Services:    []*ServiceMetrics{},
+	}
+
+	for serviceName := range services {
+		if request.Service != "" && serviceName != request.Service {
+			continue
+		}
+
+		releaseName := fmt.Sprintf("%s-%s", appName, serviceName)
+
+		opts := &prometheus.AppMetricOpts{
+			Namespace:   namespace,
+			PodRegex:    fmt.Sprintf("%s-.*", regexp.QuoteMeta(releaseName)),
+			ServiceName: releaseName,
+			Start:       start,
+			End:         end,
+			Step:        step,
+		}
+
+		serviceMetrics := &ServiceMetrics{
+			Name:    serviceName,
+			Metrics: []*MetricSeries{},
+		}
+
+		for _, metric := range prometheus.AppMetrics {
+			points, err := prometheus.QueryAppMetric(agent.Clientset, promSvc, metric, opts)
+			if err != nil {
+				telemetry.WithAttributes(span,
+					telemetry.AttributeKV{Key: "service-name", Value: serviceName},
+					telemetry.AttributeKV{Key: "metric", Value: string(metric)},
+				)
+				err := telemetry.Error(ctx, span, err, "error querying app metric")
+				c.HandleAPIError(w, r, apierrors.NewErrPassThroughToClient(err, http.StatusInternalServerError))
+				return
+			}
+
+			serviceMetrics.Metrics = append(serviceMetrics.Metrics, &MetricSeries{
+				Metric: metric,
+				Unit:   metric.Unit(),
+				Points: points,
+			})
+		}
+
+		response.Services = append(response.Services, serviceMetrics)
+	}
+
+	sort.Slice(response.Services, func(i, j int) bool {
+		return response.Services[i].Name < response.Services[j].Name
+	})
+
+	c.WriteResult(w, r, response)
+}
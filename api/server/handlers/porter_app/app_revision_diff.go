@@ -0,0 +1,159 @@
+package porter_app
+
+import (
+	"net/http"
+
+	"connectrpc.com/connect"
+	porterv1 "github.com/porter-dev/api-contracts/generated/go/porter/v1"
+
+	"github.com/porter-dev/porter/api/server/handlers"
+	"github.com/porter-dev/porter/api/server/shared"
+	"github.com/porter-dev/porter/api/server/shared/apierrors"
+	"github.com/porter-dev/porter/api/server/shared/config"
+	"github.com/porter-dev/porter/api/server/shared/requestutils"
+	"github.com/porter-dev/porter/api/types"
+	"github.com/porter-dev/porter/internal/models"
+	"github.com/porter-dev/porter/internal/porter_app"
+	"github.com/porter-dev/porter/internal/telemetry"
+)
+
+// AppRevisionDiffHandler handles requests to the /apps/{porter_app_name}/revisions/{from_revision_number}/diff/{to_revision_number} endpoint
+type AppRevisionDiffHandler struct {
+	handlers.PorterHandlerReadWriter
+}
+
+// NewAppRevisionDiffHandler returns a new AppRevisionDiffHandler
+func NewAppRevisionDiffHandler(
+	config *config.Config,
+	decoderValidator shared.RequestDecoderValidator,
+	writer shared.ResultWriter,
+) *AppRevisionDiffHandler {
+	return &AppRevisionDiffHandler{
+		PorterHandlerReadWriter: handlers.NewDefaultPorterHandler(config, decoderValidator, writer),
+	}
+}
+
+// AppRevisionDiffRequest is the request object for the /apps/{porter_app_name}/revisions/{from_revision_number}/diff/{to_revision_number} endpoint
+type AppRevisionDiffRequest struct {
+	// DeploymentTargetID is the deployment target of the revisions
+	DeploymentTargetID string `schema:"deployment_target_id" form:"required"`
+}
+
+// AppRevisionDiffResponse is the response object for the /apps/{porter_app_name}/revisions/{from_revision_number}/diff/{to_revision_number} endpoint
+type AppRevisionDiffResponse struct {
+	FromRevision porter_app.Revision      `json:"from_revision"`
+	ToRevision   porter_app.Revision      `json:"to_revision"`
+	Changes      []porter_app.FieldChange `json:"changes"`
+}
+
+// ServeHTTP returns the field level changes to the app definition between two revisions
+func (c *AppRevisionDiffHandler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	ctx, span := telemetry.NewSpan(r.Context(), "serve-app-revision-diff")
+	defer span.End()
+
+	project, _ := ctx.Value(types.ProjectScope).(*models.Project)
+	cluster, _ := ctx.Value(types.ClusterScope).(*models.Cluster)
+
+	telemetry.WithAttributes(span,
+		telemetry.AttributeKV{Key: "project-id", Value: project.ID},
+		telemetry.AttributeKV{Key: "cluster-id", Value: cluster.ID},
+	)
+
+	porterApp, status, err := porterAppFromURL(ctx, c.Repo(), r)
+	if err != nil {
+		err := telemetry.Error(ctx, span, err, "error reading porter app")
+		c.HandleAPIError(w, r, apierrors.NewErrPassThroughToClient(err, status))
+		return
+	}
+
+	fromRevisionNumber, reqErr := requestutils.GetURLParamUint(r, types.URLParamFromRevisionNumber)
+	if reqErr != nil {
+		err := telemetry.Error(ctx, span, reqErr, "error parsing from revision number")
+		c.HandleAPIError(w, r, apierrors.NewErrPassThroughToClient(err, http.StatusBadRequest))
+		return
+	}
+
+	toRevisionNumber, reqErr := requestutils.GetURLParamUint(r, types.URLParamToRevisionNumber)
+	if reqErr != nil {
+		err := telemetry.Error(ctx, span, reqErr, "error parsing to revision number")
+		c.HandleAPIError(w, r, apierrors.NewErrPassThroughToClient(err, http.StatusBadRequest))
+		return
+	}
+
+	request := &AppRevisionDiffRequest{}
+	if ok := c.DecodeAndValidate(w, r, request); !ok {
+		err := telemetry.Error(ctx, span, nil, "error decoding request")
+		c.HandleAPIError(w, r, apierrors.NewErrPassThroughToClient(err, http.StatusBadRequest))
+		return
+	}
+
+	telemetry.WithAttributes(span,
+		telemetry.AttributeKV{Key: "app-name", Value: porterApp.Name},
+		telemetry.AttributeKV{Key: "deployment-target-id", Value: request.DeploymentTargetID},
+		telemetry.AttributeKV{Key: "from-revision-number", Value: fromRevisionNumber},
+		telemetry.AttributeKV{Key: "to-revision-number", Value: toRevisionNumber},
+	)
+
+	listAppRevisionsResp, err := c.Config().ClusterControlPlaneClient.ListAppRevisions(ctx, connect.NewRequest(&porterv1.ListAppRevisionsRequest{
+		ProjectId:          int64(project.ID),
+		AppId:              int64(porterApp.ID),
+		DeploymentTargetId: request.DeploymentTargetID,
+	}))
+	if err != nil {
+		err := telemetry.Error(ctx, span, err, "error listing app revisions")
+		c.HandleAPIError(w, r, apierrors.NewErrPassThroughToClient(err, http.StatusInternalServerError))
+		return
+	}
+
+	if listAppRevisionsResp == nil || listAppRevisionsResp.Msg == nil {
+		err := telemetry.Error(ctx, span, nil, "list app revisions response is nil")
+		c.HandleAPIError(w, r, apierrors.NewErrPassThroughToClient(err, http.StatusInternalServerError))
+		return
+	}
+
+	var fromRevision, toRevision *porterv1.AppRevision
+	for _, revision := range listAppRevisionsResp.Msg.AppRevisions {
+		switch revision.RevisionNumber {
+		case uint64(fromRevisionNumber):
+			fromRevision = revision
+		case uint64(toRevisionNumber):
+			toRevision = revision
+		}
+	}
+	if fromRevisionNumber == toRevisionNumber {
+		toRevision = fromRevision
+	}
+
+	if fromRevision == nil || toRevision == nil {
+		err := telemetry.Error(ctx, span, nil, "revision not found")
+		c.HandleAPIError(w, r, apierrors.NewErrPassThroughToClient(err, http.StatusNotFound))
+		return
+	}
+
+	changes, err := porter_app.DiffAppProtos(ctx, fromRevision.App, toRevision.App)
+	if err != nil {
+		err := telemetry.Error(ctx, span, err, "error diffing app revisions")
+		c.HandleAPIError(w, r, apierrors.NewErrPassThroughToClient(err, http.StatusInternalServerError))
+		return
+	}
+
+	res := &AppRevisionDiffResponse{
+		Changes: changes,
+	}
+
+	res.FromRevision, err = porter_app.EncodedRevisionFromProto(ctx, fromRevision)
+	if err != nil {
+		err := telemetry.Error(ctx, span, err, "error encoding from revision")
+		c.HandleAPIError(w, r, apierrors.NewErrPassThroughToClient(err, http.StatusInternalServerError))
+		return
+	}
+
+	res.ToRevision, err = porter_app.EncodedRevisionFromProto(ctx, toRevision)
+	if err != nil {
+		err := telemetry.Error(ctx, span, err, "error encoding to revision")
+		c.HandleAPIError(w, r, apierrors.NewErrPassThroughToClient(err, http.StatusInternalServerError))
+		return
+	}
+
+	c.WriteResult(w, r, res)
+}
@@ -0,0 +1,75 @@
+package porter_app
+
+import (
+	"context"
+	"errors"
+
+	"gorm.io/gorm"
+
+	"github.com/porter-dev/porter/api/server/shared/config"
+	"github.com/porter-dev/porter/internal/models"
+	"github.com/porter-dev/porter/internal/telemetry"
+)
+
+// appRevisionMetadataInput is the metadata recorded when an app revision is applied
+type appRevisionMetadataInput struct {
+	ProjectID          uint
+	PorterAppID        uint
+	DeploymentTargetID string
+	AppRevisionID      string
+	DeployedBy         string
+	CommitSHA          string
+	GitBranch          string
+	GitRepoURL         string
+	// Deployed is true if the revision was deployed by the apply, in which case its revision number is recorded
+	Deployed bool
+}
+
+// recordAppRevisionMetadata records who applied a revision and from which commit, branch and repository. A revision applied
+// again after its build keeps the metadata of its first apply.
+func recordAppRevisionMetadata(ctx context.Context, config *config.Config, input appRevisionMetadataInput) error {
+	ctx, span := telemetry.NewSpan(ctx, "record-app-revision-metadata")
+	defer span.End()
+
+	telemetry.WithAttributes(span,
+		telemetry.AttributeKV{Key: "app-revision-id", Value: input.AppRevisionID},
+		telemetry.AttributeKV{Key: "deployed", Value: input.Deployed},
+	)
+
+	metadata, err := config.Repo.AppRevisionMetadata().ReadAppRevisionMetadata(ctx, input.AppRevisionID)
+	if err != nil && !errors.Is(err, gorm.ErrRecordNotFound) {
+		return telemetry.Error(ctx, span, err, "error reading app revision metadata")
+	}
+
+	if metadata == nil {
+		metadata, err = config.Repo.AppRevisionMetadata().CreateAppRevisionMetadata(ctx, &models.AppRevisionMetadata{
+			PorterAppID:        input.PorterAppID,
+			DeploymentTargetID: input.DeploymentTargetID,
+			AppRevisionID:      input.AppRevisionID,
+			DeployedBy:         input.DeployedBy,
+			CommitSHA:          input.CommitSHA,
+			GitBranch:          input.GitBranch,
+			GitRepoURL:         input.GitRepoURL,
+		})
+		if err != nil {
+			return telemetry.Error(ctx, span, err, "error creating app revision metadata")
+		}
+	}
+
+	if !input.Deployed {
+		return nil
+	}
+
+	// revisions are listed by number, which the cluster control plane only returns for the current revision
+	revision, err := currentAppRevision(ctx, config, input.ProjectID, input.PorterAppID, input.DeploymentTargetID)
+	if err != nil {
+		return telemetry.Error(ctx, span, err, "error getting current app revision")
+	}
+
+	metadata.RevisionNumber = revision.RevisionNumber
+	if _, err := config.Repo.AppRevisionMetadata().UpdateAppRevisionMetadata(ctx, metadata); err != nil {
+		return telemetry.Error(ctx, span, err, "error updating app revision metadata")
+	}
+
+	return nil
+}
@@ -0,0 +1,313 @@
+package porter_app
+
+import (
+	"fmt"
+	"net/http"
+	"sort"
+	"strings"
+	"time"
+
+	"connectrpc.com/connect"
+	porterv1 "github.com/porter-dev/api-contracts/generated/go/porter/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"github.com/porter-dev/porter/api/server/authz"
+	"github.com/porter-dev/porter/api/server/handlers"
+	"github.com/porter-dev/porter/api/server/shared"
+	"github.com/porter-dev/porter/api/server/shared/apierrors"
+	"github.com/porter-dev/porter/api/server/shared/config"
+	"github.com/porter-dev/porter/api/server/shared/requestutils"
+	"github.com/porter-dev/porter/api/types"
+	"github.com/porter-dev/porter/internal/kubernetes"
+	"github.com/porter-dev/porter/internal/models"
+	"github.com/porter-dev/porter/internal/telemetry"
+)
+
+// AppStatusHandler handles requests to the /apps/{porter_app_name}/status endpoint
+type AppStatusHandler struct {
+	handlers.PorterHandlerReadWriter
+	authz.KubernetesAgentGetter
+}
+
+// NewAppStatusHandler returns a new AppStatusHandler
+func NewAppStatusHandler(
+	config *config.Config,
+	decoderValidator shared.RequestDecoderValidator,
+	writer shared.ResultWriter,
+) *AppStatusHandler {
+	return &AppStatusHandler{
+		PorterHandlerReadWriter: handlers.NewDefaultPorterHandler(config, decoderValidator, writer),
+		KubernetesAgentGetter:   authz.NewOutOfClusterAgentGetter(config),
+	}
+}
+
+// AppStatusRequest is the request object for the /apps/{porter_app_name}/status endpoint
+type AppStatusRequest struct {
+	DeploymentTargetID string `schema:"deployment_target_id"`
+}
+
+// AppStatusResponse is the response object for the /apps/{porter_app_name}/status endpoint
+type AppStatusResponse struct {
+	AppName            string `json:"app_name"`
+	DeploymentTargetID string `json:"deployment_target_id"`
+	// RevisionNumber is the number of the last deployed revision of the app
+	RevisionNumber uint64 `json:"revision_number"`
+	// RevisionStatus is the status of the last deployed revision of the app
+	RevisionStatus string `json:"revision_status"`
+	// ImageTag is the image tag of the last deployed revision of the app
+	ImageTag string `json:"image_tag"`
+	// CommitSHA, GitBranch and GitRepoURL are the git source of the last deployed revision of the app, if known
+	CommitSHA  string `json:"commit_sha,omitempty"`
+	GitBranch  string `json:"git_branch,omitempty"`
+	GitRepoURL string `json:"git_repo_url,omitempty"`
+	// DeployedBy is the user who applied the last deployed revision of the app, if known
+	DeployedBy string           `json:"deployed_by,omitempty"`
+	Services   []*ServiceStatus `json:"services"`
+}
+
+// ServiceStatus is the health of a single service of an app
+type ServiceStatus struct {
+	Name            string          `json:"name"`
+	Type            string          `json:"type"`
+	DesiredReplicas int32           `json:"desired_replicas"`
+	ReadyReplicas   int32           `json:"ready_replicas"`
+	Restarts        int32           `json:"restarts"`
+	RecentFailures  []*FailureEvent `json:"recent_failures"`
+}
+
+// FailureEvent is a recent failure of a service, from either the Kubernetes API or the events reported by the event agent
+type FailureEvent struct {
+	Reason    string    `json:"reason"`
+	Message   string    `json:"message"`
+	Timestamp time.Time `json:"timestamp"`
+}
+
+const (
+	// maxRecentFailures is the maximum number of recent failures returned per service
+	maxRecentFailures = 5
+	// recentFailureWindow is how far back failures are reported
+	recentFailureWindow = 24 * time.Hour
+)
+
+// ServeHTTP reports the replica health, restarts and recent failures of each service of an app on a deployment target,
+// along with the last deployed revision of the app
+func (c *AppStatusHandler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	ctx, span := telemetry.NewSpan(r.Context(), "serve-app-status")
+	defer span.End()
+
+	project, _ := ctx.Value(types.ProjectScope).(*models.Project)
+	cluster, _ := ctx.Value(types.ClusterScope).(*models.Cluster)
+
+	telemetry.WithAttributes(span,
+		telemetry.AttributeKV{Key: "project-id", Value: project.ID},
+		telemetry.AttributeKV{Key: "cluster-id", Value: cluster.ID},
+	)
+
+	appName, reqErr := requestutils.GetURLParamString(r, types.URLParamPorterAppName)
+	if reqErr != nil {
+		err := telemetry.Error(ctx, span, reqErr, "error parsing app name from url")
+		c.HandleAPIError(w, r, apierrors.NewErrPassThroughToClient(err, http.StatusBadRequest))
+		return
+	}
+
+	request := &AppStatusRequest{}
+	if ok := c.DecodeAndValidate(w, r, request); !ok {
+		err := telemetry.Error(ctx, span, nil, "error decoding request")
+		c.HandleAPIError(w, r, apierrors.NewErrPassThroughToClient(err, http.StatusBadRequest))
+		return
+	}
+
+	telemetry.WithAttributes(span,
+		telemetry.AttributeKV{Key: "app-name", Value: appName},
+		telemetry.AttributeKV{Key: "deployment-target-id", Value: request.DeploymentTargetID},
+	)
+
+	deploymentTarget, err := c.Repo().DeploymentTarget().DeploymentTargetByID(ctx, project.ID, request.DeploymentTargetID)
+	if err != nil {
+		err := telemetry.Error(ctx, span, err, "error reading deployment target")
+		c.HandleAPIError(w, r, apierrors.NewErrPassThroughToClient(err, http.StatusBadRequest))
+		return
+	}
+
+	if deploymentTarget.SelectorType != DeploymentTargetSelectorType_Default {
+		err := telemetry.Error(ctx, span, nil, "status is only supported for namespace deployment targets")
+		c.HandleAPIError(w, r, apierrors.NewErrPassThroughToClient(err, http.StatusBadRequest))
+		return
+	}
+	namespace := deploymentTarget.Selector
+
+	porterApp, err := c.Repo().PorterApp().ReadPorterAppByName(ctx, cluster.ID, appName)
+	if err != nil || porterApp == nil || porterApp.ID == 0 {
+		err := telemetry.Error(ctx, span, err, "error reading porter app")
+		c.HandleAPIError(w, r, apierrors.NewErrPassThroughToClient(err, http.StatusNotFound))
+		return
+	}
+
+	revisionResp, err := c.Config().ClusterControlPlaneClient.CurrentAppRevision(ctx, connect.NewRequest(&porterv1.CurrentAppRevisionRequest{
+		ProjectId:          int64(project.ID),
+		AppId:              int64(porterApp.ID),
+		DeploymentTargetId: deploymentTarget.ID.String(),
+	}))
+	if err != nil || revisionResp == nil || revisionResp.Msg == nil || revisionResp.Msg.AppRevision.GetApp() == nil {
+		err := telemetry.Error(ctx, span, err, "error getting current app revision from cluster control plane client")
+		c.HandleAPIError(w, r, apierrors.NewErrPassThroughToClient(err, http.StatusBadRequest))
+		return
+	}
+
+	revision := revisionResp.Msg.AppRevision
+	appProto := revision.GetApp()
+
+	agent, err := c.GetAgent(r, cluster, namespace)
+	if err != nil {
+		err := telemetry.Error(ctx, span, err, "error getting kubernetes agent")
+		c.HandleAPIError(w, r, apierrors.NewErrPassThroughToClient(err, http.StatusInternalServerError))
+		return
+	}
+
+	kubeEvents, _, _, err := c.Repo().KubeEvent().ListEventsByProjectID(ctx, project.ID, cluster.ID, &types.ListKubeEventRequest{
+		Namespace: namespace,
+		Limit:     100,
+	})
+	if err != nil {
+		err := telemetry.Error(ctx, span, err, "error listing kube events")
+		c.HandleAPIError(w, r, apierrors.NewErrPassThroughToClient(err, http.StatusInternalServerError))
+		return
+	}
+
+	response := &AppStatusResponse{
+		AppName:            appName,
+		DeploymentTargetID: deploymentTarget.ID.String(),
+		RevisionNumber:     revision.GetRevisionNumber(),
+		RevisionStatus:     revision.GetStatus(),
+		ImageTag:           appProto.GetImage().GetTag(),
+		Services:           []*ServiceStatus{},
+	}
+
+	metadata, err := c.Repo().AppRevisionMetadata().ListAppRevisionMetadata(ctx, porterApp.ID, deploymentTarget.ID.String())
+	if err != nil {
+		err := telemetry.Error(ctx, span, err, "error listing app revision metadata")
+		c.HandleAPIError(w, r, apierrors.NewErrPassThroughToClient(err, http.StatusInternalServerError))
+		return
+	}
+
+	for _, m := range metadata {
+		if m.RevisionNumber == revision.GetRevisionNumber() {
+			response.CommitSHA = m.CommitSHA
+			response.GitBranch = m.GitBranch
+			response.GitRepoURL = m.GitRepoURL
+			response.DeployedBy = m.DeployedBy
+			break
+		}
+	}
+
+	since := time.Now().Add(-recentFailureWindow)
+	for serviceName, service := range appProto.Services {
+		status, err := serviceStatus(r, agent, namespace, appName, serviceName, service, kubeEvents, since)
+		if err != nil {
+			telemetry.WithAttributes(span, telemetry.AttributeKV{Key: "service-name", Value: serviceName})
+			err := telemetry.Error(ctx, span, err, "error getting service status")
+			c.HandleAPIError(w, r, apierrors.NewErrPassThroughToClient(err, http.StatusInternalServerError))
+			return
+		}
+
+		response.Services = append(response.Services, status)
+	}
+
+	sort.Slice(response.Services, func(i, j int) bool {
+		return response.Services[i].Name < response.Services[j].Name
+	})
+
+	c.WriteResult(w, r, response)
+}
+
+// serviceStatus aggregates the status of a service from its deployment and pods, which are selected by the instance label of the
+// service's release, and from the critical events the event agent reported for them
+func serviceStatus(
+	r *http.Request,
+	agent *kubernetes.Agent,
+	namespace, appName, serviceName string,
+	service *porterv1.Service,
+	kubeEvents []*models.KubeEvent,
+	since time.Time,
+) (*ServiceStatus, error) {
+	releaseName := fmt.Sprintf("%s-%s", appName, serviceName)
+	selector := fmt.Sprintf("app.kubernetes.io/instance=%s", releaseName)
+
+	status := &ServiceStatus{
+		Name:           serviceName,
+		Type:           strings.ToLower(strings.TrimPrefix(service.Type.String(), "SERVICE_TYPE_")),
+		RecentFailures: []*FailureEvent{},
+	}
+
+	deployments, err := agent.Clientset.AppsV1().Deployments(namespace).List(r.Context(), metav1.ListOptions{LabelSelector: selector})
+	if err != nil {
+		return nil, fmt.Errorf("error listing deployments: %w", err)
+	}
+
+	for _, deployment := range deployments.Items {
+		if deployment.Spec.Replicas != nil {
+			status.DesiredReplicas += *deployment.Spec.Replicas
+		}
+		status.ReadyReplicas += deployment.Status.ReadyReplicas
+	}
+
+	pods, err := agent.Clientset.CoreV1().Pods(namespace).List(r.Context(), metav1.ListOptions{LabelSelector: selector})
+	if err != nil {
+		return nil, fmt.Errorf("error listing pods: %w", err)
+	}
+
+	for _, pod := range pods.Items {
+		for _, containerStatus := range pod.Status.ContainerStatuses {
+			status.Restarts += containerStatus.RestartCount
+
+			if waiting := containerStatus.State.Waiting; waiting != nil && waiting.Reason != "" && waiting.Reason != "ContainerCreating" {
+				status.RecentFailures = append(status.RecentFailures, &FailureEvent{
+					Reason:    waiting.Reason,
+					Message:   waiting.Message,
+					Timestamp: time.Now(),
+				})
+			}
+
+			if terminated := containerStatus.LastTerminationState.Terminated; terminated != nil && terminated.ExitCode != 0 && terminated.FinishedAt.After(since) {
+				status.RecentFailures = append(status.RecentFailures, &FailureEvent{
+					Reason:    terminated.Reason,
+					Message:   fmt.Sprintf("container %s exited with code %d", containerStatus.Name, terminated.ExitCode),
+					Timestamp: terminated.FinishedAt.Time,
+				})
+			}
+		}
+	}
+
+	for _, event := range kubeEvents {
+		if !belongsToRelease(event.Name, releaseName) && !belongsToRelease(event.OwnerName, releaseName) {
+			continue
+		}
+
+		for _, subEvent := range event.SubEvents {
+			if subEvent.EventType != types.KubeEventTypeCritical || subEvent.Timestamp.Before(since) {
+				continue
+			}
+
+			status.RecentFailures = append(status.RecentFailures, &FailureEvent{
+				Reason:    subEvent.Reason,
+				Message:   subEvent.Message,
+				Timestamp: subEvent.Timestamp,
+			})
+		}
+	}
+
+	sort.Slice(status.RecentFailures, func(i, j int) bool {
+		return status.RecentFailures[i].Timestamp.After(status.RecentFailures[j].Timestamp)
+	})
+
+	if len(status.RecentFailures) > maxRecentFailures {
+		status.RecentFailures = status.RecentFailures[:maxRecentFailures]
+	}
+
+	return status, nil
+}
+
+// belongsToRelease returns true if a kubernetes object name is the release name or is generated from it
+func belongsToRelease(name, releaseName string) bool {
+	return name == releaseName || strings.HasPrefix(name, releaseName+"-")
+}
@@ -1,7 +1,9 @@
 package porter_app
 
 import (
+	"context"
 	"encoding/base64"
+	"errors"
 	"net/http"
 
 	"connectrpc.com/connect"
@@ -11,18 +13,24 @@ import (
 	"github.com/porter-dev/api-contracts/generated/go/helpers"
 
 	"github.com/porter-dev/porter/internal/telemetry"
+	"go.opentelemetry.io/otel/trace"
 
+	"github.com/porter-dev/porter/api/server/authz"
 	"github.com/porter-dev/porter/api/server/handlers"
 	"github.com/porter-dev/porter/api/server/shared"
 	"github.com/porter-dev/porter/api/server/shared/apierrors"
 	"github.com/porter-dev/porter/api/server/shared/config"
 	"github.com/porter-dev/porter/api/types"
+	"github.com/porter-dev/porter/internal/kubernetes"
+	porter_app_kube "github.com/porter-dev/porter/internal/kubernetes/porter_app"
 	"github.com/porter-dev/porter/internal/models"
+	"github.com/porter-dev/porter/internal/quota"
 )
 
 // ApplyPorterAppHandler is the handler for the /apps/parse endpoint
 type ApplyPorterAppHandler struct {
 	handlers.PorterHandlerReadWriter
+	authz.KubernetesAgentGetter
 }
 
 // NewApplyPorterAppHandler handles POST requests to the endpoint /apps/apply
@@ -33,6 +41,7 @@ func NewApplyPorterAppHandler(
 ) *ApplyPorterAppHandler {
 	return &ApplyPorterAppHandler{
 		PorterHandlerReadWriter: handlers.NewDefaultPorterHandler(config, decoderValidator, writer),
+		KubernetesAgentGetter:   authz.NewOutOfClusterAgentGetter(config),
 	}
 }
 
@@ -41,12 +50,23 @@ type ApplyPorterAppRequest struct {
 	Base64AppProto     string `json:"b64_app_proto"`
 	DeploymentTargetId string `json:"deployment_target_id"`
 	AppRevisionID      string `json:"app_revision_id"`
+	// AppName is the name of the app of the revision given by AppRevisionID. It is used with DeploymentTargetId to watch the
+	// rollout of apps with automatic rollback.
+	AppName string `json:"app_name"`
+	// CommitSHA is the commit the revision was built from. It is recorded in the revision history of the app along with
+	// GitBranch and GitRepoURL, and set on the workloads of the app once the revision is deployed.
+	CommitSHA  string `json:"commit_sha"`
+	GitBranch  string `json:"git_branch"`
+	GitRepoURL string `json:"git_repo_url"`
 }
 
 // ApplyPorterAppResponse is the response object for the /apps/apply endpoint
 type ApplyPorterAppResponse struct {
 	AppRevisionId string                 `json:"app_revision_id"`
 	CLIAction     porterv1.EnumCLIAction `json:"cli_action"`
+	// ConfigRevision is the config revision of the app after the apply, whose ETag is sent in the If-Match header of the next apply
+	// of a definition
+	ConfigRevision uint `json:"config_revision"`
 }
 
 // ServeHTTP translates the request into a ApplyPorterApp request, forwards to the cluster control plane, and returns the response
@@ -62,12 +82,6 @@ func (c *ApplyPorterAppHandler) ServeHTTP(w http.ResponseWriter, r *http.Request
 		telemetry.AttributeKV{Key: "cluster-id", Value: cluster.ID},
 	)
 
-	if !project.ValidateApplyV2 {
-		err := telemetry.Error(ctx, span, nil, "project does not have validate apply v2 enabled")
-		c.HandleAPIError(w, r, apierrors.NewErrForbidden(err))
-		return
-	}
-
 	request := &ApplyPorterAppRequest{}
 	if ok := c.DecodeAndValidate(w, r, request); !ok {
 		err := telemetry.Error(ctx, span, nil, "error decoding request")
@@ -75,46 +89,170 @@ func (c *ApplyPorterAppHandler) ServeHTTP(w http.ResponseWriter, r *http.Request
 		return
 	}
 
+	response, err := ApplyApp(ctx, c.Config(), ApplyAppInput{
+		Project: project,
+		Cluster: cluster,
+		GetAgent: func(namespace string) (*kubernetes.Agent, error) {
+			return c.GetAgent(r, cluster, namespace)
+		},
+		Base64AppProto:     request.Base64AppProto,
+		DeploymentTargetID: request.DeploymentTargetId,
+		AppRevisionID:      request.AppRevisionID,
+		AppName:            request.AppName,
+		CommitSHA:          request.CommitSHA,
+		GitBranch:          request.GitBranch,
+		GitRepoURL:         request.GitRepoURL,
+		IfMatch:            r.Header.Get(types.IfMatchHeader),
+	})
+	if err != nil {
+		handlers.HandleServiceError(c.Config(), w, r, err)
+		return
+	}
+
+	if response.ConfigRevision != 0 {
+		w.Header().Set(types.ETagHeader, types.AppConfigETag(response.ConfigRevision))
+	}
+	c.WriteResult(w, r, response)
+}
+
+// ApplyAppInput is the app definition or revision applied by ApplyApp
+type ApplyAppInput struct {
+	Project *models.Project
+	Cluster *models.Cluster
+	// GetAgent returns an agent for a namespace of the cluster, which is used to watch the rollout of deployed revisions
+	GetAgent func(namespace string) (*kubernetes.Agent, error)
+
+	Base64AppProto     string
+	DeploymentTargetID string
+	AppRevisionID      string
+	AppName            string
+	CommitSHA          string
+	GitBranch          string
+	GitRepoURL         string
+	// IfMatch is the If-Match header of the request, which must match the ETag of the configuration of an existing app when
+	// a definition is applied
+	IfMatch string
+}
+
+// ApplyApp applies an app definition, or an existing revision if AppRevisionID is set, with the cluster control plane. Definitions
+// of an existing app are rejected if IfMatch does not match the config revision of the app. It returns an apierrors.RequestError,
+// or a *quota.ExceededError if the app exceeds a quota of the project.
+func ApplyApp(ctx context.Context, config *config.Config, input ApplyAppInput) (*ApplyPorterAppResponse, error) {
+	ctx, span := telemetry.NewSpan(ctx, "apply-app")
+	defer span.End()
+
+	project := input.Project
+	cluster := input.Cluster
+
+	if !project.ValidateApplyV2 {
+		err := telemetry.Error(ctx, span, nil, "project does not have validate apply v2 enabled")
+		return nil, apierrors.NewErrForbidden(err)
+	}
+
 	var appRevisionID string
 	var appProto *porterv1.PorterApp
 	var deploymentTargetID string
+	appName := input.AppName
 
-	if request.AppRevisionID != "" {
-		appRevisionID = request.AppRevisionID
-		telemetry.WithAttributes(span, telemetry.AttributeKV{Key: "app-revision-id", Value: request.AppRevisionID})
+	if input.AppRevisionID != "" {
+		appRevisionID = input.AppRevisionID
+		telemetry.WithAttributes(span, telemetry.AttributeKV{Key: "app-revision-id", Value: input.AppRevisionID})
 	} else {
-		if request.Base64AppProto == "" {
+		if input.Base64AppProto == "" {
 			err := telemetry.Error(ctx, span, nil, "b64 yaml is empty")
-			c.HandleAPIError(w, r, apierrors.NewErrPassThroughToClient(err, http.StatusBadRequest))
-			return
+			return nil, apierrors.NewErrPassThroughToClient(err, http.StatusBadRequest)
 		}
 
-		decoded, err := base64.StdEncoding.DecodeString(request.Base64AppProto)
+		decoded, err := base64.StdEncoding.DecodeString(input.Base64AppProto)
 		if err != nil {
 			err := telemetry.Error(ctx, span, err, "error decoding base yaml")
-			c.HandleAPIError(w, r, apierrors.NewErrPassThroughToClient(err, http.StatusBadRequest))
-			return
+			return nil, apierrors.NewErrPassThroughToClient(err, http.StatusBadRequest)
 		}
 
 		appProto = &porterv1.PorterApp{}
 		err = helpers.UnmarshalContractObject(decoded, appProto)
 		if err != nil {
 			err := telemetry.Error(ctx, span, err, "error unmarshalling app proto")
-			c.HandleAPIError(w, r, apierrors.NewErrPassThroughToClient(err, http.StatusBadRequest))
-			return
+			return nil, apierrors.NewErrPassThroughToClient(err, http.StatusBadRequest)
 		}
 
-		if request.DeploymentTargetId == "" {
+		if input.DeploymentTargetID == "" {
 			err := telemetry.Error(ctx, span, err, "deployment target id is empty")
-			c.HandleAPIError(w, r, apierrors.NewErrPassThroughToClient(err, http.StatusBadRequest))
-			return
+			return nil, apierrors.NewErrPassThroughToClient(err, http.StatusBadRequest)
 		}
-		deploymentTargetID = request.DeploymentTargetId
+		deploymentTargetID = input.DeploymentTargetID
+		appName = appProto.Name
 
 		telemetry.WithAttributes(span,
 			telemetry.AttributeKV{Key: "app-name", Value: appProto.Name},
-			telemetry.AttributeKV{Key: "deployment-target-id", Value: request.DeploymentTargetId},
+			telemetry.AttributeKV{Key: "deployment-target-id", Value: input.DeploymentTargetID},
 		)
+
+		err = resolveAWSEnvReferences(ctx, config.Repo, cluster, appProto)
+		if err != nil {
+			err := telemetry.Error(ctx, span, err, "error resolving aws env references")
+			return nil, apierrors.NewErrPassThroughToClient(err, http.StatusBadRequest)
+		}
+
+		err = injectDatastoreEnv(ctx, config.Repo, cluster, appProto)
+		if err != nil {
+			err := telemetry.Error(ctx, span, err, "error injecting datastore env")
+			return nil, apierrors.NewErrPassThroughToClient(err, http.StatusInternalServerError)
+		}
+
+		err = quota.CheckAppQuota(ctx, config.Repo, quota.CheckAppQuotaInput{
+			Project:            project,
+			App:                appProto,
+			DeploymentTargetID: input.DeploymentTargetID,
+		})
+		if err != nil {
+			var quotaErr *quota.ExceededError
+			if errors.As(err, &quotaErr) {
+				_ = telemetry.Error(ctx, span, err, "app exceeds project quota")
+				return nil, quotaErr
+			}
+
+			err := telemetry.Error(ctx, span, err, "error checking project quotas")
+			return nil, apierrors.NewErrPassThroughToClient(err, http.StatusInternalServerError)
+		}
+	}
+
+	// the app is named in the request body, so policies which restrict apps are checked here rather than by the policy middleware
+	if !authz.HasPorterAppAccess(ctx, appName, types.APIVerbUpdate) {
+		err := telemetry.Error(ctx, span, nil, "policy forbids updating app")
+		return nil, apierrors.NewErrForbidden(err)
+	}
+
+	if err := checkImageSigningPolicy(ctx, config.Repo, project.ID, appName, appProto, input.CommitSHA); err != nil {
+		if errors.Is(err, errUnverifiedImage) {
+			err := telemetry.Error(ctx, span, err, "image does not satisfy image signing policy")
+			return nil, apierrors.NewErrPassThroughToClient(err, http.StatusForbidden)
+		}
+
+		err := telemetry.Error(ctx, span, err, "error checking image signing policy")
+		return nil, apierrors.NewErrPassThroughToClient(err, http.StatusInternalServerError)
+	}
+
+	// the config revision of an existing app is claimed before a new definition is applied, so that concurrent applies made
+	// from the same revision cannot overwrite each other. Applying an existing revision, such as once its images are built,
+	// does not change the configuration of the app.
+	var porterApp *models.PorterApp
+	if appName != "" {
+		app, err := config.Repo.PorterApp().ReadPorterAppByName(ctx, cluster.ID, appName)
+		if err != nil {
+			err := telemetry.Error(ctx, span, err, "error reading porter app")
+			return nil, apierrors.NewErrPassThroughToClient(err, http.StatusInternalServerError)
+		}
+
+		if app != nil && app.ID != 0 {
+			porterApp = app
+		}
+	}
+
+	if porterApp != nil && appProto != nil {
+		if reqErr := claimAppConfigRevision(ctx, config, porterApp, input.IfMatch); reqErr != nil {
+			return nil, reqErr
+		}
 	}
 
 	applyReq := connect.NewRequest(&porterv1.ApplyPorterAppRequest{
@@ -123,44 +261,153 @@ func (c *ApplyPorterAppHandler) ServeHTTP(w http.ResponseWriter, r *http.Request
 		App:                 appProto,
 		PorterAppRevisionId: appRevisionID,
 	})
-	ccpResp, err := c.Config().ClusterControlPlaneClient.ApplyPorterApp(ctx, applyReq)
+	ccpResp, err := config.ClusterControlPlaneClient.ApplyPorterApp(ctx, applyReq)
 	if err != nil {
 		err := telemetry.Error(ctx, span, err, "error calling ccp apply porter app")
-		c.HandleAPIError(w, r, apierrors.NewErrPassThroughToClient(err, http.StatusInternalServerError))
-		return
+		return nil, apierrors.NewErrPassThroughToClient(err, http.StatusInternalServerError)
 	}
 
 	if ccpResp == nil {
 		err := telemetry.Error(ctx, span, err, "ccp resp is nil")
-		c.HandleAPIError(w, r, apierrors.NewErrPassThroughToClient(err, http.StatusInternalServerError))
-		return
+		return nil, apierrors.NewErrPassThroughToClient(err, http.StatusInternalServerError)
 	}
 	if ccpResp.Msg == nil {
 		err := telemetry.Error(ctx, span, err, "ccp resp msg is nil")
-		c.HandleAPIError(w, r, apierrors.NewErrPassThroughToClient(err, http.StatusInternalServerError))
-		return
+		return nil, apierrors.NewErrPassThroughToClient(err, http.StatusInternalServerError)
 	}
 
 	if ccpResp.Msg.PorterAppRevisionId == "" {
 		err := telemetry.Error(ctx, span, err, "ccp resp app revision id is nil")
-		c.HandleAPIError(w, r, apierrors.NewErrPassThroughToClient(err, http.StatusInternalServerError))
-		return
+		return nil, apierrors.NewErrPassThroughToClient(err, http.StatusInternalServerError)
 	}
 
 	telemetry.WithAttributes(span, telemetry.AttributeKV{Key: "resp-app-revision-id", Value: ccpResp.Msg.PorterAppRevisionId})
 
 	if ccpResp.Msg.CliAction == porterv1.EnumCLIAction_ENUM_CLI_ACTION_UNSPECIFIED {
 		err := telemetry.Error(ctx, span, err, "ccp resp cli action is nil")
-		c.HandleAPIError(w, r, apierrors.NewErrPassThroughToClient(err, http.StatusInternalServerError))
-		return
+		return nil, apierrors.NewErrPassThroughToClient(err, http.StatusInternalServerError)
 	}
 
 	telemetry.WithAttributes(span, telemetry.AttributeKV{Key: "cli-action", Value: ccpResp.Msg.CliAction.String()})
 
+	if appProto != nil {
+		// failing to record the domains of the app does not fail the apply, since they are recorded again on the next apply
+		if err := recordAppDomains(ctx, config.Repo, cluster, deploymentTargetID, appProto); err != nil {
+			_ = telemetry.Error(ctx, span, err, "error recording app domains")
+		}
+	}
+
+	if porterApp != nil && input.DeploymentTargetID != "" {
+		deployed := ccpResp.Msg.CliAction == porterv1.EnumCLIAction_ENUM_CLI_ACTION_NONE
+
+		var deployedBy string
+		if user, ok := ctx.Value(types.UserScope).(*models.User); ok && user != nil {
+			deployedBy = user.Email
+		}
+
+		// failing to record the revision metadata does not fail the apply
+		_ = recordAppRevisionMetadata(ctx, config, appRevisionMetadataInput{ // nolint:errcheck
+			ProjectID:          project.ID,
+			PorterAppID:        porterApp.ID,
+			DeploymentTargetID: input.DeploymentTargetID,
+			AppRevisionID:      ccpResp.Msg.PorterAppRevisionId,
+			DeployedBy:         deployedBy,
+			CommitSHA:          input.CommitSHA,
+			GitBranch:          input.GitBranch,
+			GitRepoURL:         input.GitRepoURL,
+			Deployed:           deployed,
+		})
+
+		if appProto != nil {
+			err = quota.RecordAppResourceRequest(ctx, config.Repo, quota.RecordAppResourceRequestInput{
+				ProjectID:          project.ID,
+				PorterAppID:        porterApp.ID,
+				DeploymentTargetID: input.DeploymentTargetID,
+				App:                appProto,
+			})
+			if err != nil {
+				_ = telemetry.Error(ctx, span, err, "error recording app resource request")
+			}
+		}
+
+		if deployed && input.GetAgent != nil {
+			watchDeployedRevision(ctx, config, input.GetAgent, cluster, porterApp, input.DeploymentTargetID, porter_app_kube.GitMetadata{
+				CommitSHA: input.CommitSHA,
+				Branch:    input.GitBranch,
+				RepoURL:   input.GitRepoURL,
+			})
+		}
+	}
+
 	response := &ApplyPorterAppResponse{
 		AppRevisionId: ccpResp.Msg.PorterAppRevisionId,
 		CLIAction:     ccpResp.Msg.CliAction,
 	}
+	if porterApp != nil {
+		response.ConfigRevision = porterApp.ConfigRevision
+	}
 
-	c.WriteResult(w, r, response)
+	return response, nil
+}
+
+// watchDeployedRevision starts stamping the workloads of a deployed app with the git metadata of its revision, and watching the
+// rollout of the app if it has automatic rollback enabled, both in the background. Failing to start either does not fail the apply.
+func watchDeployedRevision(
+	ctx context.Context,
+	config *config.Config,
+	getAgent func(namespace string) (*kubernetes.Agent, error),
+	cluster *models.Cluster,
+	porterApp *models.PorterApp,
+	deploymentTargetID string,
+	gitMetadata porter_app_kube.GitMetadata,
+) {
+	ctx, span := telemetry.NewSpan(ctx, "watch-deployed-revision")
+	defer span.End()
+
+	if !porterApp.AutoRollback && gitMetadata.IsEmpty() {
+		return
+	}
+
+	deploymentTarget, err := config.Repo.DeploymentTarget().DeploymentTargetByID(ctx, cluster.ProjectID, deploymentTargetID)
+	if err != nil {
+		_ = telemetry.Error(ctx, span, err, "error reading deployment target")
+		return
+	}
+
+	if deploymentTarget.SelectorType != DeploymentTargetSelectorType_Default {
+		_ = telemetry.Error(ctx, span, nil, "watching deployed revisions is only supported for namespace deployment targets")
+		return
+	}
+
+	agent, err := getAgent(deploymentTarget.Selector)
+	if err != nil {
+		_ = telemetry.Error(ctx, span, err, "error getting kubernetes agent")
+		return
+	}
+
+	// the request context is canceled once the response is written, so the revision is watched on a detached context
+	watchCtx := trace.ContextWithSpan(context.Background(), trace.SpanFromContext(ctx))
+
+	if !gitMetadata.IsEmpty() {
+		go stampWorkloadGitMetadata(watchCtx, gitMetadataInput{
+			Config:             config,
+			PorterApp:          porterApp,
+			Cluster:            cluster,
+			DeploymentTargetID: deploymentTargetID,
+			Namespace:          deploymentTarget.Selector,
+			Clientset:          agent.Clientset,
+			GitMetadata:        gitMetadata,
+		})
+	}
+
+	if porterApp.AutoRollback {
+		go watchRolloutForAutoRollback(watchCtx, autoRollbackInput{
+			Config:             config,
+			PorterApp:          porterApp,
+			Cluster:            cluster,
+			DeploymentTargetID: deploymentTargetID,
+			Namespace:          deploymentTarget.Selector,
+			Clientset:          agent.Clientset,
+		})
+	}
 }
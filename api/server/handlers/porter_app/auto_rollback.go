@@ -0,0 +1,279 @@
+package porter_app
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"connectrpc.com/connect"
+	porterv1 "github.com/porter-dev/api-contracts/generated/go/porter/v1"
+	"k8s.io/client-go/kubernetes"
+
+	"github.com/porter-dev/porter/api/server/shared/config"
+	"github.com/porter-dev/porter/api/types"
+	porter_app_kube "github.com/porter-dev/porter/internal/kubernetes/porter_app"
+	"github.com/porter-dev/porter/internal/models"
+	"github.com/porter-dev/porter/internal/notifier/webhook"
+	"github.com/porter-dev/porter/internal/telemetry"
+)
+
+const (
+	// defaultAutoRollbackTimeout is how long a rollout can take before it is rolled back, if the app does not set a timeout
+	defaultAutoRollbackTimeout = 10 * time.Minute
+
+	// appRevisionStatus_Deployed is the status of app revisions which were deployed successfully
+	appRevisionStatus_Deployed = "DEPLOYED"
+
+	// autoRollbackDeployer is recorded as the deployer of revisions applied by automatic rollbacks
+	autoRollbackDeployer = "auto-rollback"
+)
+
+// autoRollbackInput is the app and deployment target whose rollout is watched
+type autoRollbackInput struct {
+	Config             *config.Config
+	PorterApp          *models.PorterApp
+	Cluster            *models.Cluster
+	DeploymentTargetID string
+	Namespace          string
+	Clientset          kubernetes.Interface
+}
+
+// watchRolloutForAutoRollback watches the rollout of the current revision of an app, and reverts the app to its previous
+// deployed revision if a service fails to roll out or the rollout does not complete within the timeout of the app. This blocks
+// until the rollout completes, so it should be called in a separate goroutine.
+func watchRolloutForAutoRollback(ctx context.Context, input autoRollbackInput) {
+	ctx, span := telemetry.NewSpan(ctx, "watch-rollout-for-auto-rollback")
+	defer span.End()
+
+	telemetry.WithAttributes(span,
+		telemetry.AttributeKV{Key: "project-id", Value: input.Cluster.ProjectID},
+		telemetry.AttributeKV{Key: "cluster-id", Value: input.Cluster.ID},
+		telemetry.AttributeKV{Key: "app-name", Value: input.PorterApp.Name},
+		telemetry.AttributeKV{Key: "deployment-target-id", Value: input.DeploymentTargetID},
+	)
+
+	timeout := defaultAutoRollbackTimeout
+	if input.PorterApp.AutoRollbackTimeoutSeconds > 0 {
+		timeout = time.Duration(input.PorterApp.AutoRollbackTimeoutSeconds) * time.Second
+	}
+
+	revision, err := currentAppRevision(ctx, input.Config, input.Cluster.ProjectID, input.PorterApp.ID, input.DeploymentTargetID)
+	if err != nil {
+		_ = telemetry.Error(ctx, span, err, "error getting current app revision")
+		return
+	}
+	telemetry.WithAttributes(span, telemetry.AttributeKV{Key: "revision-number", Value: revision.RevisionNumber})
+
+	// jobs do not have a rollout
+	pending := make(map[string]bool)
+	for serviceName, service := range revision.GetApp().GetServices() {
+		if service.Type != porterv1.ServiceType_SERVICE_TYPE_JOB {
+			pending[serviceName] = true
+		}
+	}
+
+	tracker := porter_app_kube.NewRolloutTracker(revision.GetApp().GetImage().GetTag(), time.Now())
+	deadline := time.After(timeout)
+	ticker := time.NewTicker(deployEventsPollInterval)
+	defer ticker.Stop()
+
+	for len(pending) > 0 {
+		for serviceName := range pending {
+			rollout, err := porter_app_kube.GetServiceRollout(ctx, input.Clientset, input.Namespace, input.PorterApp.Name, serviceName)
+			if err != nil {
+				_ = telemetry.Error(ctx, span, err, "error reading service rollout")
+				continue
+			}
+
+			events, rolledOut := tracker.Observe(rollout, time.Now())
+			for _, event := range events {
+				if event.Failed {
+					rollbackRevision(ctx, input, revision, fmt.Sprintf("service %s failed to roll out: %s", serviceName, event.Message))
+					return
+				}
+			}
+
+			if rolledOut {
+				delete(pending, serviceName)
+			}
+		}
+
+		if len(pending) == 0 {
+			return
+		}
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-deadline:
+			rollbackRevision(ctx, input, revision, fmt.Sprintf("rollout did not complete within %s", timeout))
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+// rollbackRevision reverts the app to the deployed revision preceding the failed revision, then records the rollback and
+// notifies the project webhooks. Nothing is rolled back if another revision was applied since the failed revision.
+func rollbackRevision(ctx context.Context, input autoRollbackInput, failedRevision *porterv1.AppRevision, reason string) {
+	ctx, span := telemetry.NewSpan(ctx, "rollback-revision")
+	defer span.End()
+
+	telemetry.WithAttributes(span,
+		telemetry.AttributeKV{Key: "failed-revision-number", Value: failedRevision.RevisionNumber},
+		telemetry.AttributeKV{Key: "reason", Value: reason},
+	)
+
+	currentRevision, err := currentAppRevision(ctx, input.Config, input.Cluster.ProjectID, input.PorterApp.ID, input.DeploymentTargetID)
+	if err != nil {
+		_ = telemetry.Error(ctx, span, err, "error getting current app revision")
+		return
+	}
+
+	if currentRevision.RevisionNumber != failedRevision.RevisionNumber {
+		telemetry.WithAttributes(span, telemetry.AttributeKV{Key: "current-revision-number", Value: currentRevision.RevisionNumber})
+		return
+	}
+
+	revisionsResp, err := input.Config.ClusterControlPlaneClient.ListAppRevisions(ctx, connect.NewRequest(&porterv1.ListAppRevisionsRequest{
+		ProjectId:          int64(input.Cluster.ProjectID),
+		AppId:              int64(input.PorterApp.ID),
+		DeploymentTargetId: input.DeploymentTargetID,
+	}))
+	if err != nil || revisionsResp == nil || revisionsResp.Msg == nil {
+		_ = telemetry.Error(ctx, span, err, "error listing app revisions")
+		return
+	}
+
+	previousRevision := previousDeployedRevision(revisionsResp.Msg.AppRevisions, failedRevision.RevisionNumber)
+	if previousRevision == nil {
+		recordAutoRollback(ctx, input, failedRevision, nil, reason, fmt.Errorf("no deployed revision before revision %d", failedRevision.RevisionNumber))
+		return
+	}
+	telemetry.WithAttributes(span, telemetry.AttributeKV{Key: "previous-revision-number", Value: previousRevision.RevisionNumber})
+
+	applyResp, err := input.Config.ClusterControlPlaneClient.ApplyPorterApp(ctx, connect.NewRequest(&porterv1.ApplyPorterAppRequest{
+		ProjectId:          int64(input.Cluster.ProjectID),
+		DeploymentTargetId: input.DeploymentTargetID,
+		App:                previousRevision.App,
+	}))
+	if err != nil {
+		err = telemetry.Error(ctx, span, err, "error applying previous app revision")
+	} else if applyResp != nil && applyResp.Msg != nil && applyResp.Msg.PorterAppRevisionId != "" {
+		metadataInput := appRevisionMetadataInput{
+			ProjectID:          input.Cluster.ProjectID,
+			PorterAppID:        input.PorterApp.ID,
+			DeploymentTargetID: input.DeploymentTargetID,
+			AppRevisionID:      applyResp.Msg.PorterAppRevisionId,
+			DeployedBy:         autoRollbackDeployer,
+			Deployed:           true,
+		}
+
+		// the restored revision runs the code of the previous revision, so it keeps its git source
+		if previousMetadata, err := input.Config.Repo.AppRevisionMetadata().ListAppRevisionMetadata(ctx, input.PorterApp.ID, input.DeploymentTargetID); err == nil {
+			for _, m := range previousMetadata {
+				if m.RevisionNumber == previousRevision.RevisionNumber {
+					metadataInput.CommitSHA = m.CommitSHA
+					metadataInput.GitBranch = m.GitBranch
+					metadataInput.GitRepoURL = m.GitRepoURL
+					break
+				}
+			}
+		}
+
+		_ = recordAppRevisionMetadata(ctx, input.Config, metadataInput) // nolint:errcheck
+	}
+
+	recordAutoRollback(ctx, input, failedRevision, previousRevision, reason, err)
+}
+
+// previousDeployedRevision returns the most recent deployed revision before the revision number, or nil if there is none
+func previousDeployedRevision(revisions []*porterv1.AppRevision, revisionNumber uint64) *porterv1.AppRevision {
+	var previous *porterv1.AppRevision
+
+	for _, revision := range revisions {
+		if revision.Status != appRevisionStatus_Deployed || revision.RevisionNumber >= revisionNumber || revision.App == nil {
+			continue
+		}
+
+		if previous == nil || revision.RevisionNumber > previous.RevisionNumber {
+			previous = revision
+		}
+	}
+
+	return previous
+}
+
+// recordAutoRollback records the outcome of a rollback as a sub event of the app, and notifies the project webhooks of
+// successful rollbacks
+func recordAutoRollback(ctx context.Context, input autoRollbackInput, failedRevision, previousRevision *porterv1.AppRevision, reason string, rollbackErr error) {
+	ctx, span := telemetry.NewSpan(ctx, "record-auto-rollback")
+	defer span.End()
+
+	container, err := input.Config.Repo.BuildEvent().CreateEventContainer(ctx, &models.EventContainer{
+		PorterAppID: input.PorterApp.ID,
+		Name:        fmt.Sprintf("revision %d", failedRevision.RevisionNumber),
+		ImageTag:    failedRevision.GetApp().GetImage().GetTag(),
+		Kind:        models.EventContainerKind_AutoRollback,
+	})
+	if err != nil {
+		_ = telemetry.Error(ctx, span, err, "error creating event container")
+		return
+	}
+
+	subEvent := &models.SubEvent{
+		EventID: "auto-rollback",
+		Index:   1,
+		Status:  types.EventStatusSuccess,
+		Info:    reason,
+	}
+
+	if rollbackErr != nil {
+		subEvent.Name = fmt.Sprintf("Failed to roll back revision %d", failedRevision.RevisionNumber)
+		subEvent.Status = types.EventStatusFailed
+		subEvent.Info = fmt.Sprintf("%s: %s", reason, rollbackErr.Error())
+	} else {
+		subEvent.Name = fmt.Sprintf("Rolled back revision %d to revision %d", failedRevision.RevisionNumber, previousRevision.RevisionNumber)
+	}
+
+	if err := input.Config.Repo.BuildEvent().AppendEvent(ctx, container, subEvent); err != nil {
+		_ = telemetry.Error(ctx, span, err, "error recording auto rollback")
+	}
+
+	if rollbackErr != nil {
+		return
+	}
+
+	err = webhook.NewDispatcher(input.Config.Repo.ProjectWebhook()).Dispatch(ctx, webhook.DispatchOpts{
+		ProjectID: input.Cluster.ProjectID,
+		ClusterID: input.Cluster.ID,
+		AppName:   input.PorterApp.Name,
+		Event:     types.ProjectWebhookEvent_DeployRolledBack,
+		Data: map[string]any{
+			"failed_revision_number":      failedRevision.RevisionNumber,
+			"rolled_back_revision_number": previousRevision.RevisionNumber,
+			"reason":                      reason,
+		},
+	})
+	if err != nil {
+		_ = telemetry.Error(ctx, span, err, "error dispatching project webhooks")
+	}
+}
+
+// currentAppRevision returns the revision of the app which is currently applied to the deployment target
+func currentAppRevision(ctx context.Context, config *config.Config, projectID uint, porterAppID uint, deploymentTargetID string) (*porterv1.AppRevision, error) {
+	resp, err := config.ClusterControlPlaneClient.CurrentAppRevision(ctx, connect.NewRequest(&porterv1.CurrentAppRevisionRequest{
+		ProjectId:          int64(projectID),
+		AppId:              int64(porterAppID),
+		DeploymentTargetId: deploymentTargetID,
+	}))
+	if err != nil {
+		return nil, err
+	}
+
+	if resp == nil || resp.Msg == nil || resp.Msg.AppRevision == nil {
+		return nil, fmt.Errorf("current app revision is empty")
+	}
+
+	return resp.Msg.AppRevision, nil
+}
@@ -0,0 +1,177 @@
+package porter_app
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"sort"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/arn"
+	"github.com/aws/aws-sdk-go/aws/session"
+	"github.com/aws/aws-sdk-go/service/secretsmanager"
+	"github.com/aws/aws-sdk-go/service/ssm"
+	porterv1 "github.com/porter-dev/api-contracts/generated/go/porter/v1"
+
+	"github.com/porter-dev/porter/internal/models"
+	v2 "github.com/porter-dev/porter/internal/porter_app/v2"
+	"github.com/porter-dev/porter/internal/repository"
+	"github.com/porter-dev/porter/internal/telemetry"
+)
+
+// resolveAWSEnvReferences replaces the fromAws references in the env of an app with the values of the secrets and parameters in AWS.
+// Each reference is read with the project's AWS integration for the account in the reference's ARN, or with the cluster's AWS
+// integration if no integration in the project is linked to that account.
+func resolveAWSEnvReferences(ctx context.Context, repo repository.Repository, cluster *models.Cluster, app *porterv1.PorterApp) error {
+	ctx, span := telemetry.NewSpan(ctx, "resolve-aws-env-references")
+	defer span.End()
+
+	names := make([]string, 0, len(app.Env))
+	for name := range app.Env {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	sessions := make(map[string]*session.Session)
+	var resolved int
+
+	for _, name := range names {
+		source, ok, err := v2.ParseAWSEnvReference(app.Env[name])
+		if err != nil {
+			return telemetry.Error(ctx, span, err, fmt.Sprintf("invalid aws reference for env var %s", name))
+		}
+		if !ok {
+			continue
+		}
+
+		sourceArn := source.SecretArn
+		if sourceArn == "" {
+			sourceArn = source.ParameterArn
+		}
+
+		parsedArn, err := arn.Parse(sourceArn)
+		if err != nil {
+			return telemetry.Error(ctx, span, err, fmt.Sprintf("invalid aws reference for env var %s", name))
+		}
+
+		sessionKey := parsedArn.AccountID + "/" + parsedArn.Region
+		sess, ok := sessions[sessionKey]
+		if !ok {
+			sess, err = awsSessionForAccount(ctx, repo, cluster, parsedArn.AccountID, parsedArn.Region)
+			if err != nil {
+				return telemetry.Error(ctx, span, err, fmt.Sprintf("error getting aws credentials for env var %s", name))
+			}
+			sessions[sessionKey] = sess
+		}
+
+		var value string
+		if source.SecretArn != "" {
+			value, err = awsSecretValue(ctx, sess, source)
+		} else {
+			value, err = awsParameterValue(ctx, sess, source)
+		}
+		if err != nil {
+			return telemetry.Error(ctx, span, err, fmt.Sprintf("error reading aws value for env var %s", name))
+		}
+
+		app.Env[name] = value
+		resolved++
+	}
+
+	telemetry.WithAttributes(span, telemetry.AttributeKV{Key: "resolved-aws-env-references", Value: resolved})
+
+	return nil
+}
+
+// awsSessionForAccount returns a session for the project's AWS integration linked to the account, falling back to the cluster's integration
+func awsSessionForAccount(ctx context.Context, repo repository.Repository, cluster *models.Cluster, accountID string, region string) (*session.Session, error) {
+	integrations, err := repo.AWSIntegration().ListAWSIntegrationsByProjectID(ctx, cluster.ProjectID)
+	if err != nil {
+		return nil, fmt.Errorf("error listing aws integrations: %w", err)
+	}
+
+	integrationID := cluster.AWSIntegrationID
+	for _, integration := range integrations {
+		integrationArn, err := arn.Parse(integration.AWSArn)
+		if err == nil && integrationArn.AccountID == accountID {
+			integrationID = integration.ID
+			break
+		}
+	}
+
+	if integrationID == 0 {
+		return nil, fmt.Errorf("project has no aws integration for account %s", accountID)
+	}
+
+	integration, err := repo.AWSIntegration().ReadAWSIntegration(ctx, cluster.ProjectID, integrationID)
+	if err != nil {
+		return nil, fmt.Errorf("error reading aws integration: %w", err)
+	}
+
+	sess, err := integration.GetSession()
+	if err != nil {
+		return nil, fmt.Errorf("error creating aws session: %w", err)
+	}
+
+	return sess.Copy(&aws.Config{Region: aws.String(region)}), nil
+}
+
+// awsSecretValue reads a Secrets Manager secret, or a single key of a secret which stores a JSON object
+func awsSecretValue(ctx context.Context, sess *session.Session, source v2.AWSEnvSource) (string, error) {
+	out, err := secretsmanager.New(sess).GetSecretValueWithContext(ctx, &secretsmanager.GetSecretValueInput{
+		SecretId: aws.String(source.SecretArn),
+	})
+	if err != nil {
+		return "", err
+	}
+
+	var secret string
+	switch {
+	case out.SecretString != nil:
+		secret = *out.SecretString
+	case out.SecretBinary != nil:
+		secret = string(out.SecretBinary)
+	}
+
+	if source.Key == "" {
+		return secret, nil
+	}
+
+	values := map[string]any{}
+	if err := json.Unmarshal([]byte(secret), &values); err != nil {
+		return "", fmt.Errorf("secret %s is not a JSON object, so key %s cannot be read", source.SecretArn, source.Key)
+	}
+
+	value, ok := values[source.Key]
+	if !ok {
+		return "", fmt.Errorf("secret %s has no key %s", source.SecretArn, source.Key)
+	}
+
+	if str, ok := value.(string); ok {
+		return str, nil
+	}
+
+	encoded, err := json.Marshal(value)
+	if err != nil {
+		return "", err
+	}
+
+	return string(encoded), nil
+}
+
+// awsParameterValue reads an SSM parameter, decrypting SecureString parameters
+func awsParameterValue(ctx context.Context, sess *session.Session, source v2.AWSEnvSource) (string, error) {
+	out, err := ssm.New(sess).GetParameterWithContext(ctx, &ssm.GetParameterInput{
+		Name:           aws.String(source.ParameterArn),
+		WithDecryption: aws.Bool(true),
+	})
+	if err != nil {
+		return "", err
+	}
+
+	if out.Parameter == nil || out.Parameter.Value == nil {
+		return "", fmt.Errorf("parameter %s has no value", source.ParameterArn)
+	}
+
+	return *out.Parameter.Value, nil
+}
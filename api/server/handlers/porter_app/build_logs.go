@@ -0,0 +1,359 @@
+package porter_app
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"time"
+
+	"github.com/porter-dev/porter/api/server/handlers"
+	"github.com/porter-dev/porter/api/server/shared"
+	"github.com/porter-dev/porter/api/server/shared/apierrors"
+	"github.com/porter-dev/porter/api/server/shared/config"
+	"github.com/porter-dev/porter/api/server/shared/requestutils"
+	"github.com/porter-dev/porter/api/types"
+	"github.com/porter-dev/porter/internal/models"
+	"github.com/porter-dev/porter/internal/repository"
+	"github.com/porter-dev/porter/internal/telemetry"
+)
+
+const (
+	// buildLogEventID is the event id of the sub events which hold chunks of a build log
+	buildLogEventID = "build-log"
+	// buildLogListLimit is the number of recent build logs returned for an app
+	buildLogListLimit = 20
+)
+
+// CreateBuildLogRequest is the request object for the POST /apps/{porter_app_name}/build-logs endpoint
+type CreateBuildLogRequest struct {
+	// Name is the name of the build, which is the name of the service for services with their own build
+	Name     string `json:"name" form:"required"`
+	ImageTag string `json:"image_tag"`
+}
+
+// BuildLog is a build of a porter app whose logs were streamed from the CLI
+type BuildLog struct {
+	ID        uint      `json:"id"`
+	Name      string    `json:"name"`
+	ImageTag  string    `json:"image_tag"`
+	CreatedAt time.Time `json:"created_at"`
+}
+
+// ListBuildLogsResponse is the response object for the GET /apps/{porter_app_name}/build-logs endpoint
+type ListBuildLogsResponse struct {
+	BuildLogs []BuildLog `json:"build_logs"`
+}
+
+// BuildLogChunk is a chunk of the output of a build. Chunks are ordered by sequence number, starting from 1.
+type BuildLogChunk struct {
+	Sequence int64  `json:"sequence" form:"required,min=1"`
+	Data     string `json:"data" form:"max=65536"`
+}
+
+// AppendBuildLogRequest is the request object for the POST /apps/{porter_app_name}/build-logs/{build_log_id} endpoint. The
+// status is set once the build has completed, to mark the final chunk as a success or a failure.
+type AppendBuildLogRequest struct {
+	Chunks []BuildLogChunk    `json:"chunks" form:"max=100,dive"`
+	Status *types.EventStatus `json:"status,omitempty" form:"omitempty,oneof=1 2 3"`
+}
+
+// GetBuildLogRequest is the request object for the GET /apps/{porter_app_name}/build-logs/{build_log_id} endpoint. Only chunks
+// with a sequence number greater than AfterSequence are returned, so that the dashboard can poll for new output.
+type GetBuildLogRequest struct {
+	AfterSequence int64 `schema:"after_sequence"`
+}
+
+// GetBuildLogResponse is the response object for the GET /apps/{porter_app_name}/build-logs/{build_log_id} endpoint
+type GetBuildLogResponse struct {
+	BuildLog
+	Status types.EventStatus `json:"status"`
+	Chunks []BuildLogChunk   `json:"chunks"`
+}
+
+// CreateBuildLogHandler handles POST requests to the /apps/{porter_app_name}/build-logs endpoint
+type CreateBuildLogHandler struct {
+	handlers.PorterHandlerReadWriter
+}
+
+// NewCreateBuildLogHandler returns a new CreateBuildLogHandler
+func NewCreateBuildLogHandler(
+	config *config.Config,
+	decoderValidator shared.RequestDecoderValidator,
+	writer shared.ResultWriter,
+) *CreateBuildLogHandler {
+	return &CreateBuildLogHandler{
+		PorterHandlerReadWriter: handlers.NewDefaultPorterHandler(config, decoderValidator, writer),
+	}
+}
+
+// ServeHTTP starts a build log for a build of a porter app which is run by the CLI
+func (c *CreateBuildLogHandler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	ctx, span := telemetry.NewSpan(r.Context(), "serve-create-build-log")
+	defer span.End()
+
+	porterApp, status, err := porterAppFromURL(ctx, c.Repo(), r)
+	if err != nil {
+		err := telemetry.Error(ctx, span, err, "error reading porter app")
+		c.HandleAPIError(w, r, apierrors.NewErrPassThroughToClient(err, status))
+		return
+	}
+
+	telemetry.WithAttributes(span, telemetry.AttributeKV{Key: "app-name", Value: porterApp.Name})
+
+	request := &CreateBuildLogRequest{}
+	if ok := c.DecodeAndValidate(w, r, request); !ok {
+		err := telemetry.Error(ctx, span, nil, "error decoding request")
+		c.HandleAPIError(w, r, apierrors.NewErrPassThroughToClient(err, http.StatusBadRequest))
+		return
+	}
+
+	container, err := c.Repo().BuildEvent().CreateEventContainer(ctx, &models.EventContainer{
+		PorterAppID: porterApp.ID,
+		Name:        request.Name,
+		ImageTag:    request.ImageTag,
+	})
+	if err != nil {
+		err := telemetry.Error(ctx, span, err, "error creating event container")
+		c.HandleAPIError(w, r, apierrors.NewErrPassThroughToClient(err, http.StatusInternalServerError))
+		return
+	}
+
+	c.WriteResult(w, r, toBuildLog(container))
+}
+
+// ListBuildLogsHandler handles GET requests to the /apps/{porter_app_name}/build-logs endpoint
+type ListBuildLogsHandler struct {
+	handlers.PorterHandlerReadWriter
+}
+
+// NewListBuildLogsHandler returns a new ListBuildLogsHandler
+func NewListBuildLogsHandler(
+	config *config.Config,
+	decoderValidator shared.RequestDecoderValidator,
+	writer shared.ResultWriter,
+) *ListBuildLogsHandler {
+	return &ListBuildLogsHandler{
+		PorterHandlerReadWriter: handlers.NewDefaultPorterHandler(config, decoderValidator, writer),
+	}
+}
+
+// ServeHTTP lists the most recent builds of a porter app whose logs were streamed from the CLI
+func (c *ListBuildLogsHandler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	ctx, span := telemetry.NewSpan(r.Context(), "serve-list-build-logs")
+	defer span.End()
+
+	porterApp, status, err := porterAppFromURL(ctx, c.Repo(), r)
+	if err != nil {
+		err := telemetry.Error(ctx, span, err, "error reading porter app")
+		c.HandleAPIError(w, r, apierrors.NewErrPassThroughToClient(err, status))
+		return
+	}
+
+	telemetry.WithAttributes(span, telemetry.AttributeKV{Key: "app-name", Value: porterApp.Name})
+
+	containers, err := c.Repo().BuildEvent().ListEventContainersByPorterAppID(ctx, porterApp.ID, models.EventContainerKind_BuildLog, buildLogListLimit)
+	if err != nil {
+		err := telemetry.Error(ctx, span, err, "error listing event containers")
+		c.HandleAPIError(w, r, apierrors.NewErrPassThroughToClient(err, http.StatusInternalServerError))
+		return
+	}
+
+	res := &ListBuildLogsResponse{
+		BuildLogs: make([]BuildLog, 0, len(containers)),
+	}
+	for _, container := range containers {
+		res.BuildLogs = append(res.BuildLogs, toBuildLog(container))
+	}
+
+	c.WriteResult(w, r, res)
+}
+
+// AppendBuildLogHandler handles POST requests to the /apps/{porter_app_name}/build-logs/{build_log_id} endpoint
+type AppendBuildLogHandler struct {
+	handlers.PorterHandlerReadWriter
+}
+
+// NewAppendBuildLogHandler returns a new AppendBuildLogHandler
+func NewAppendBuildLogHandler(
+	config *config.Config,
+	decoderValidator shared.RequestDecoderValidator,
+	writer shared.ResultWriter,
+) *AppendBuildLogHandler {
+	return &AppendBuildLogHandler{
+		PorterHandlerReadWriter: handlers.NewDefaultPorterHandler(config, decoderValidator, writer),
+	}
+}
+
+// ServeHTTP appends chunks of build output to a build log, as sub events of its event container
+func (c *AppendBuildLogHandler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	ctx, span := telemetry.NewSpan(r.Context(), "serve-append-build-log")
+	defer span.End()
+
+	porterApp, status, err := porterAppFromURL(ctx, c.Repo(), r)
+	if err != nil {
+		err := telemetry.Error(ctx, span, err, "error reading porter app")
+		c.HandleAPIError(w, r, apierrors.NewErrPassThroughToClient(err, status))
+		return
+	}
+
+	telemetry.WithAttributes(span, telemetry.AttributeKV{Key: "app-name", Value: porterApp.Name})
+
+	container, status, err := buildLogFromURL(c.Repo(), r, porterApp)
+	if err != nil {
+		err := telemetry.Error(ctx, span, err, "error reading build log")
+		c.HandleAPIError(w, r, apierrors.NewErrPassThroughToClient(err, status))
+		return
+	}
+
+	telemetry.WithAttributes(span, telemetry.AttributeKV{Key: "build-log-id", Value: container.ID})
+
+	request := &AppendBuildLogRequest{}
+	if ok := c.DecodeAndValidate(w, r, request); !ok {
+		err := telemetry.Error(ctx, span, nil, "error decoding request")
+		c.HandleAPIError(w, r, apierrors.NewErrPassThroughToClient(err, http.StatusBadRequest))
+		return
+	}
+
+	telemetry.WithAttributes(span, telemetry.AttributeKV{Key: "chunks", Value: len(request.Chunks)})
+
+	for i, chunk := range request.Chunks {
+		status := types.EventStatus(types.EventStatusInProgress)
+		if request.Status != nil && i == len(request.Chunks)-1 {
+			status = *request.Status
+		}
+
+		err = c.Repo().BuildEvent().AppendEvent(ctx, container, &models.SubEvent{
+			EventID: buildLogEventID,
+			Name:    container.Name,
+			Index:   chunk.Sequence,
+			Status:  status,
+			Info:    chunk.Data,
+		})
+		if err != nil {
+			err := telemetry.Error(ctx, span, err, "error appending build log chunk")
+			c.HandleAPIError(w, r, apierrors.NewErrPassThroughToClient(err, http.StatusInternalServerError))
+			return
+		}
+	}
+
+	c.WriteResult(w, r, toBuildLog(container))
+}
+
+// GetBuildLogHandler handles GET requests to the /apps/{porter_app_name}/build-logs/{build_log_id} endpoint
+type GetBuildLogHandler struct {
+	handlers.PorterHandlerReadWriter
+}
+
+// NewGetBuildLogHandler returns a new GetBuildLogHandler
+func NewGetBuildLogHandler(
+	config *config.Config,
+	decoderValidator shared.RequestDecoderValidator,
+	writer shared.ResultWriter,
+) *GetBuildLogHandler {
+	return &GetBuildLogHandler{
+		PorterHandlerReadWriter: handlers.NewDefaultPorterHandler(config, decoderValidator, writer),
+	}
+}
+
+// ServeHTTP returns the chunks of a build log after a sequence number, and the status of the build
+func (c *GetBuildLogHandler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	ctx, span := telemetry.NewSpan(r.Context(), "serve-get-build-log")
+	defer span.End()
+
+	porterApp, status, err := porterAppFromURL(ctx, c.Repo(), r)
+	if err != nil {
+		err := telemetry.Error(ctx, span, err, "error reading porter app")
+		c.HandleAPIError(w, r, apierrors.NewErrPassThroughToClient(err, status))
+		return
+	}
+
+	telemetry.WithAttributes(span, telemetry.AttributeKV{Key: "app-name", Value: porterApp.Name})
+
+	container, status, err := buildLogFromURL(c.Repo(), r, porterApp)
+	if err != nil {
+		err := telemetry.Error(ctx, span, err, "error reading build log")
+		c.HandleAPIError(w, r, apierrors.NewErrPassThroughToClient(err, status))
+		return
+	}
+
+	telemetry.WithAttributes(span, telemetry.AttributeKV{Key: "build-log-id", Value: container.ID})
+
+	request := &GetBuildLogRequest{}
+	if ok := c.DecodeAndValidate(w, r, request); !ok {
+		err := telemetry.Error(ctx, span, nil, "error decoding request")
+		c.HandleAPIError(w, r, apierrors.NewErrPassThroughToClient(err, http.StatusBadRequest))
+		return
+	}
+
+	events, err := c.Repo().BuildEvent().ListSubEventsAfterIndex(ctx, container.ID, request.AfterSequence)
+	if err != nil {
+		err := telemetry.Error(ctx, span, err, "error listing build log chunks")
+		c.HandleAPIError(w, r, apierrors.NewErrPassThroughToClient(err, http.StatusInternalServerError))
+		return
+	}
+
+	res := &GetBuildLogResponse{
+		BuildLog: toBuildLog(container),
+		Status:   types.EventStatusInProgress,
+		Chunks:   make([]BuildLogChunk, 0, len(events)),
+	}
+
+	for _, event := range events {
+		if event.EventID != buildLogEventID {
+			continue
+		}
+
+		res.Chunks = append(res.Chunks, BuildLogChunk{
+			Sequence: event.Index,
+			Data:     event.Info,
+		})
+
+		if event.Status != types.EventStatusInProgress {
+			res.Status = event.Status
+		}
+	}
+
+	c.WriteResult(w, r, res)
+}
+
+// porterAppFromURL reads the porter app in the url. It returns the status code to respond with if the app cannot be read.
+func porterAppFromURL(ctx context.Context, repo repository.Repository, r *http.Request) (*models.PorterApp, int, error) {
+	cluster, _ := ctx.Value(types.ClusterScope).(*models.Cluster)
+
+	appName, reqErr := requestutils.GetURLParamString(r, types.URLParamPorterAppName)
+	if reqErr != nil {
+		return nil, http.StatusBadRequest, fmt.Errorf("error parsing app name from url: %w", reqErr)
+	}
+
+	porterApp, err := repo.PorterApp().ReadPorterAppByName(ctx, cluster.ID, appName)
+	if err != nil || porterApp == nil || porterApp.ID == 0 {
+		return nil, http.StatusNotFound, fmt.Errorf("error reading porter app %s: %w", appName, err)
+	}
+
+	return porterApp, 0, nil
+}
+
+// buildLogFromURL reads the event container of the build log in the url, which must belong to the porter app. It returns the
+// status code to respond with if the build log cannot be read.
+func buildLogFromURL(repo repository.Repository, r *http.Request, porterApp *models.PorterApp) (*models.EventContainer, int, error) {
+	buildLogID, reqErr := requestutils.GetURLParamUint(r, types.URLParamBuildLogID)
+	if reqErr != nil {
+		return nil, http.StatusBadRequest, fmt.Errorf("error parsing build log id from url: %w", reqErr)
+	}
+
+	container, err := repo.BuildEvent().ReadEventContainer(r.Context(), buildLogID)
+	if err != nil || container.PorterAppID != porterApp.ID {
+		return nil, http.StatusNotFound, fmt.Errorf("error reading build log %d: %w", buildLogID, err)
+	}
+
+	return container, 0, nil
+}
+
+func toBuildLog(container *models.EventContainer) BuildLog {
+	return BuildLog{
+		ID:        container.ID,
+		Name:      container.Name,
+		ImageTag:  container.ImageTag,
+		CreatedAt: container.CreatedAt,
+	}
+}
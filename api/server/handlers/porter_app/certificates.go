@@ -0,0 +1,199 @@
+package porter_app
+
+import (
+	"net/http"
+	"sort"
+	"time"
+
+	"github.com/porter-dev/porter/api/server/authz"
+	"github.com/porter-dev/porter/api/server/handlers"
+	"github.com/porter-dev/porter/api/server/shared"
+	"github.com/porter-dev/porter/api/server/shared/apierrors"
+	"github.com/porter-dev/porter/api/server/shared/config"
+	"github.com/porter-dev/porter/api/server/shared/requestutils"
+	"github.com/porter-dev/porter/api/types"
+	porter_app_kube "github.com/porter-dev/porter/internal/kubernetes/porter_app"
+	"github.com/porter-dev/porter/internal/models"
+	"github.com/porter-dev/porter/internal/telemetry"
+)
+
+// ListAppCertificatesHandler handles requests to the /apps/{porter_app_name}/certificates endpoint
+type ListAppCertificatesHandler struct {
+	handlers.PorterHandlerWriter
+	authz.KubernetesAgentGetter
+}
+
+// NewListAppCertificatesHandler returns a new ListAppCertificatesHandler
+func NewListAppCertificatesHandler(
+	config *config.Config,
+	writer shared.ResultWriter,
+) *ListAppCertificatesHandler {
+	return &ListAppCertificatesHandler{
+		PorterHandlerWriter:   handlers.NewDefaultPorterHandler(config, nil, writer),
+		KubernetesAgentGetter: authz.NewOutOfClusterAgentGetter(config),
+	}
+}
+
+// ServeHTTP lists the TLS certificates of the domains served by the ingresses of an app, in every namespace the app is deployed to.
+// Certificates are either issued by cert-manager, or uploaded as custom certificates.
+func (c *ListAppCertificatesHandler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	ctx, span := telemetry.NewSpan(r.Context(), "serve-list-app-certificates")
+	defer span.End()
+
+	cluster, _ := ctx.Value(types.ClusterScope).(*models.Cluster)
+
+	appName, reqErr := requestutils.GetURLParamString(r, types.URLParamPorterAppName)
+	if reqErr != nil {
+		err := telemetry.Error(ctx, span, reqErr, "error parsing app name from url")
+		c.HandleAPIError(w, r, apierrors.NewErrPassThroughToClient(err, http.StatusBadRequest))
+		return
+	}
+	telemetry.WithAttributes(span, telemetry.AttributeKV{Key: "app-name", Value: appName})
+
+	agent, err := c.GetAgent(r, cluster, "")
+	if err != nil {
+		err := telemetry.Error(ctx, span, err, "error getting kubernetes agent")
+		c.HandleAPIError(w, r, apierrors.NewErrPassThroughToClient(err, http.StatusInternalServerError))
+		return
+	}
+
+	dynClient, err := c.GetDynamicClient(r, cluster)
+	if err != nil {
+		err := telemetry.Error(ctx, span, err, "error getting dynamic client")
+		c.HandleAPIError(w, r, apierrors.NewErrPassThroughToClient(err, http.StatusInternalServerError))
+		return
+	}
+
+	ingresses, err := porter_app_kube.ListAppIngresses(ctx, agent.Clientset, appName)
+	if err != nil {
+		err := telemetry.Error(ctx, span, err, "error listing ingresses of app")
+		c.HandleAPIError(w, r, apierrors.NewErrPassThroughToClient(err, http.StatusInternalServerError))
+		return
+	}
+
+	customIngresses, err := porter_app_kube.ListCustomCertificateIngresses(ctx, agent.Clientset, appName)
+	if err != nil {
+		err := telemetry.Error(ctx, span, err, "error listing custom certificate ingresses of app")
+		c.HandleAPIError(w, r, apierrors.NewErrPassThroughToClient(err, http.StatusInternalServerError))
+		return
+	}
+
+	// customServed is keyed by namespace and domain
+	customServed := make(map[string]bool)
+	for _, ingress := range customIngresses {
+		customServed[ingress.Namespace+"/"+ingress.Annotations[porter_app_kube.AnnotationCustomCertificateDomain]] = true
+	}
+
+	customCerts, err := c.Repo().CustomCertificate().ListCustomCertificatesByApp(ctx, cluster.ID, appName)
+	if err != nil {
+		err := telemetry.Error(ctx, span, err, "error listing custom certificates of app")
+		c.HandleAPIError(w, r, apierrors.NewErrPassThroughToClient(err, http.StatusInternalServerError))
+		return
+	}
+
+	customCertsByDomain := make(map[string]*models.CustomCertificate)
+	for _, cert := range customCerts {
+		customCertsByDomain[cert.Domain] = cert
+	}
+
+	certificatesByNamespace := make(map[string][]porter_app_kube.Certificate)
+	seen := make(map[string]bool)
+	now := time.Now()
+
+	res := &types.ListAppCertificatesResponse{
+		Certificates: make([]types.AppCertificate, 0),
+	}
+
+	for _, ingress := range ingresses {
+		for _, rule := range ingress.Spec.Rules {
+			if rule.Host == "" || seen[ingress.Namespace+"/"+rule.Host] {
+				continue
+			}
+			seen[ingress.Namespace+"/"+rule.Host] = true
+
+			cert := types.AppCertificate{
+				Domain:    rule.Host,
+				Namespace: ingress.Namespace,
+			}
+
+			if custom, ok := customCertsByDomain[rule.Host]; ok {
+				notAfter := custom.NotAfter
+
+				cert.Source = types.CertificateSource_Custom
+				cert.SecretName = porter_app_kube.CustomCertificateName(rule.Host)
+				cert.Issuer = custom.Issuer
+				cert.NotAfter = &notAfter
+
+				_, managed := porter_app_kube.TLSSecretForHost(ingress, rule.Host)
+
+				switch {
+				case !customServed[ingress.Namespace+"/"+rule.Host] || managed:
+					cert.Status = types.CertificateStatus_NotApplied
+					cert.Message = "the app was deployed after the certificate was uploaded, so it must be uploaded again"
+				case now.After(notAfter):
+					cert.Status = types.CertificateStatus_Expired
+				default:
+					cert.Status = types.CertificateStatus_Ready
+				}
+
+				res.Certificates = append(res.Certificates, cert)
+				continue
+			}
+
+			cert.Source = types.CertificateSource_CertManager
+
+			secretName, ok := porter_app_kube.TLSSecretForHost(ingress, rule.Host)
+			if !ok {
+				cert.Status = types.CertificateStatus_Missing
+				cert.Message = "the domain is served without tls"
+				res.Certificates = append(res.Certificates, cert)
+				continue
+			}
+			cert.SecretName = secretName
+
+			certificates, ok := certificatesByNamespace[ingress.Namespace]
+			if !ok {
+				certificates, err = porter_app_kube.ListCertificates(ctx, dynClient, ingress.Namespace)
+				if err != nil {
+					err := telemetry.Error(ctx, span, err, "error listing certificates")
+					c.HandleAPIError(w, r, apierrors.NewErrPassThroughToClient(err, http.StatusInternalServerError))
+					return
+				}
+				certificatesByNamespace[ingress.Namespace] = certificates
+			}
+
+			cert.Status = types.CertificateStatus_Missing
+			cert.Message = "no certificate is issued for the tls secret of the domain"
+
+			for _, certificate := range certificates {
+				if certificate.SecretName != secretName {
+					continue
+				}
+
+				cert.Message = certificate.Message
+				cert.NotAfter = certificate.NotAfter
+				cert.RenewalTime = certificate.RenewalTime
+
+				switch {
+				case certificate.NotAfter != nil && now.After(*certificate.NotAfter):
+					cert.Status = types.CertificateStatus_Expired
+				case certificate.Ready:
+					cert.Status = types.CertificateStatus_Ready
+				default:
+					cert.Status = types.CertificateStatus_NotReady
+				}
+			}
+
+			res.Certificates = append(res.Certificates, cert)
+		}
+	}
+
+	sort.Slice(res.Certificates, func(i, j int) bool {
+		if res.Certificates[i].Domain != res.Certificates[j].Domain {
+			return res.Certificates[i].Domain < res.Certificates[j].Domain
+		}
+		return res.Certificates[i].Namespace < res.Certificates[j].Namespace
+	})
+
+	c.WriteResult(w, r, res)
+}
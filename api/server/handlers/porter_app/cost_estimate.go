@@ -0,0 +1,125 @@
+package porter_app
+
+import (
+	"context"
+	"encoding/base64"
+	"errors"
+	"fmt"
+	"net/http"
+
+	"github.com/porter-dev/api-contracts/generated/go/helpers"
+	porterv1 "github.com/porter-dev/api-contracts/generated/go/porter/v1"
+
+	"github.com/porter-dev/porter/api/server/handlers"
+	"github.com/porter-dev/porter/api/server/shared"
+	"github.com/porter-dev/porter/api/server/shared/apierrors"
+	"github.com/porter-dev/porter/api/server/shared/config"
+	"github.com/porter-dev/porter/api/types"
+	"github.com/porter-dev/porter/internal/cost"
+	"github.com/porter-dev/porter/internal/models"
+	"github.com/porter-dev/porter/internal/telemetry"
+)
+
+// CostEstimateHandler handles requests to the /apps/cost-estimate endpoint
+type CostEstimateHandler struct {
+	handlers.PorterHandlerReadWriter
+}
+
+// NewCostEstimateHandler returns a new CostEstimateHandler
+func NewCostEstimateHandler(
+	config *config.Config,
+	decoderValidator shared.RequestDecoderValidator,
+	writer shared.ResultWriter,
+) *CostEstimateHandler {
+	return &CostEstimateHandler{
+		PorterHandlerReadWriter: handlers.NewDefaultPorterHandler(config, decoderValidator, writer),
+	}
+}
+
+// CostEstimateRequest is the request object for the /apps/cost-estimate endpoint
+type CostEstimateRequest struct {
+	// Base64AppProto is an app which was validated by the /apps/validate endpoint
+	Base64AppProto string `json:"b64_app_proto"`
+}
+
+// ServeHTTP estimates the monthly cost of the services of a validated app on the application nodes of the cluster
+func (c *CostEstimateHandler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	ctx, span := telemetry.NewSpan(r.Context(), "serve-cost-estimate")
+	defer span.End()
+
+	project, _ := ctx.Value(types.ProjectScope).(*models.Project)
+	cluster, _ := ctx.Value(types.ClusterScope).(*models.Cluster)
+
+	telemetry.WithAttributes(span,
+		telemetry.AttributeKV{Key: "project-id", Value: project.ID},
+		telemetry.AttributeKV{Key: "cluster-id", Value: cluster.ID},
+	)
+
+	request := &CostEstimateRequest{}
+	if ok := c.DecodeAndValidate(w, r, request); !ok {
+		err := telemetry.Error(ctx, span, nil, "error decoding request")
+		c.HandleAPIError(w, r, apierrors.NewErrPassThroughToClient(err, http.StatusBadRequest))
+		return
+	}
+
+	if request.Base64AppProto == "" {
+		err := telemetry.Error(ctx, span, nil, "b64 app proto is empty")
+		c.HandleAPIError(w, r, apierrors.NewErrPassThroughToClient(err, http.StatusBadRequest))
+		return
+	}
+
+	decoded, err := base64.StdEncoding.DecodeString(request.Base64AppProto)
+	if err != nil {
+		err := telemetry.Error(ctx, span, err, "error decoding base64 app proto")
+		c.HandleAPIError(w, r, apierrors.NewErrPassThroughToClient(err, http.StatusBadRequest))
+		return
+	}
+
+	appProto := &porterv1.PorterApp{}
+	err = helpers.UnmarshalContractObject(decoded, appProto)
+	if err != nil {
+		err := telemetry.Error(ctx, span, err, "error unmarshalling app proto")
+		c.HandleAPIError(w, r, apierrors.NewErrPassThroughToClient(err, http.StatusBadRequest))
+		return
+	}
+
+	telemetry.WithAttributes(span, telemetry.AttributeKV{Key: "app-name", Value: appProto.Name})
+
+	estimate, err := EstimateAppCost(ctx, c.Config(), cluster, appProto)
+	if err != nil {
+		err := telemetry.Error(ctx, span, err, "error estimating app cost")
+		c.HandleAPIError(w, r, apierrors.NewErrPassThroughToClient(err, http.StatusPreconditionFailed))
+		return
+	}
+
+	c.WriteResult(w, r, estimate)
+}
+
+// EstimateAppCost estimates the monthly cost of an app on the application node pool of the latest contract of a cluster
+func EstimateAppCost(ctx context.Context, config *config.Config, cluster *models.Cluster, app *porterv1.PorterApp) (*cost.Estimate, error) {
+	if config.PriceCatalog == nil {
+		return nil, errors.New("price catalog is not configured")
+	}
+
+	revisions, err := config.Repo.APIContractRevisioner().List(ctx, cluster.ProjectID, cluster.ID)
+	if err != nil {
+		return nil, fmt.Errorf("error listing cluster contract revisions: %w", err)
+	}
+
+	if len(revisions) == 0 {
+		return nil, errors.New("cluster has no contract, so its nodes are unknown")
+	}
+
+	// revisions are sorted by most recent first
+	contract, err := revisions[0].Contract()
+	if err != nil {
+		return nil, fmt.Errorf("error reading cluster contract: %w", err)
+	}
+
+	nodePool, err := cost.NodePoolFromContract(contract)
+	if err != nil {
+		return nil, err
+	}
+
+	return cost.EstimateApp(ctx, config.PriceCatalog, nodePool, app)
+}
@@ -96,7 +96,7 @@ func (c *CreatePorterAppHandler) ServeHTTP(w http.ResponseWriter, r *http.Reques
 		return
 	}
 	imageInfo := request.ImageInfo
-	registries, err := c.Repo().Registry().ListRegistriesByProjectID(cluster.ProjectID)
+	registries, err := c.Repo().Registry().ListRegistriesByProjectID(ctx, cluster.ProjectID)
 	if err != nil {
 		err = telemetry.Error(ctx, span, err, "error listing registries")
 		telemetry.WithAttributes(span, telemetry.AttributeKV{Key: "porter-yaml-base64", Value: porterYamlBase64})
@@ -134,7 +134,7 @@ func (c *CreatePorterAppHandler) ServeHTTP(w http.ResponseWriter, r *http.Reques
 
 	if request.Builder == "" {
 		// attempt to get builder from db
-		app, err := c.Repo().PorterApp().ReadPorterAppByName(cluster.ID, appName)
+		app, err := c.Repo().PorterApp().ReadPorterAppByName(ctx, cluster.ID, appName)
 		if err == nil {
 			request.Builder = app.Builder
 		}
@@ -262,7 +262,7 @@ func (c *CreatePorterAppHandler) ServeHTTP(w http.ResponseWriter, r *http.Reques
 			return
 		}
 
-		existing, err := c.Repo().PorterApp().ReadPorterAppByName(cluster.ID, appName)
+		existing, err := c.Repo().PorterApp().ReadPorterAppByName(ctx, cluster.ID, appName)
 		if err != nil {
 			err = telemetry.Error(ctx, span, err, "error reading app from DB")
 			telemetry.WithAttributes(span, telemetry.AttributeKV{Key: "porter-yaml-base64", Value: porterYamlBase64})
@@ -293,7 +293,7 @@ func (c *CreatePorterAppHandler) ServeHTTP(w http.ResponseWriter, r *http.Reques
 		}
 
 		// create the db entry
-		porterApp, err := c.Repo().PorterApp().UpdatePorterApp(app)
+		porterApp, err := c.Repo().PorterApp().UpdatePorterApp(ctx, app)
 		if err != nil {
 			err = telemetry.Error(ctx, span, err, "error writing app to DB")
 			telemetry.WithAttributes(span, telemetry.AttributeKV{Key: "porter-yaml-base64", Value: porterYamlBase64})
@@ -418,7 +418,7 @@ func (c *CreatePorterAppHandler) ServeHTTP(w http.ResponseWriter, r *http.Reques
 		}
 
 		// update the DB entry
-		app, err := c.Repo().PorterApp().ReadPorterAppByName(cluster.ID, appName)
+		app, err := c.Repo().PorterApp().ReadPorterAppByName(ctx, cluster.ID, appName)
 		if err != nil {
 			err = telemetry.Error(ctx, span, err, "error reading app from DB")
 			telemetry.WithAttributes(span, telemetry.AttributeKV{Key: "porter-yaml-base64", Value: porterYamlBase64})
@@ -482,7 +482,7 @@ func (c *CreatePorterAppHandler) ServeHTTP(w http.ResponseWriter, r *http.Reques
 			telemetry.AttributeKV{Key: "updated-pull-request-url", Value: app.PullRequestURL},
 		)
 
-		updatedPorterApp, err := c.Repo().PorterApp().UpdatePorterApp(app)
+		updatedPorterApp, err := c.Repo().PorterApp().UpdatePorterApp(ctx, app)
 		if err != nil {
 			err = telemetry.Error(ctx, span, err, "error writing updated app to DB")
 			telemetry.WithAttributes(span, telemetry.AttributeKV{Key: "porter-yaml-base64", Value: porterYamlBase64})
@@ -16,7 +16,9 @@ import (
 	"github.com/porter-dev/porter/api/server/shared/requestutils"
 	"github.com/porter-dev/porter/api/types"
 	"github.com/porter-dev/porter/internal/models"
+	"github.com/porter-dev/porter/internal/notifier/webhook"
 	"github.com/porter-dev/porter/internal/telemetry"
+	"go.opentelemetry.io/otel/trace"
 )
 
 type CreateUpdatePorterAppEventHandler struct {
@@ -120,7 +122,7 @@ func (p *CreateUpdatePorterAppEventHandler) createNewAppEvent(ctx context.Contex
 	ctx, span := telemetry.NewSpan(ctx, "create-porter-app-event")
 	defer span.End()
 
-	app, err := p.Repo().PorterApp().ReadPorterAppByName(cluster.ID, porterAppName)
+	app, err := p.Repo().PorterApp().ReadPorterAppByName(ctx, cluster.ID, porterAppName)
 	if err != nil {
 		return types.PorterAppEvent{}, telemetry.Error(ctx, span, err, "error retrieving porter app by name for cluster")
 	}
@@ -189,6 +191,13 @@ func (p *CreateUpdatePorterAppEventHandler) createNewAppEvent(ctx context.Contex
 		return types.PorterAppEvent{}, telemetry.Error(ctx, span, nil, "porter app event not found")
 	}
 
+	if eventType == string(types.PorterAppEventType_JobRun) {
+		// the run history is derived from the event, so failing to record it should not fail the event
+		_ = recordJobRun(ctx, p.Repo(), cluster, app, event.Status, event.Metadata)
+	}
+
+	p.dispatchProjectWebhooks(ctx, cluster, porterAppName, event.ToPorterAppEvent())
+
 	return event.ToPorterAppEvent(), nil
 }
 
@@ -196,7 +205,7 @@ func (p *CreateUpdatePorterAppEventHandler) updateExistingAppEvent(ctx context.C
 	ctx, span := telemetry.NewSpan(ctx, "update-porter-app-event")
 	defer span.End()
 
-	app, err := p.Repo().PorterApp().ReadPorterAppByName(cluster.ID, porterAppName)
+	app, err := p.Repo().PorterApp().ReadPorterAppByName(ctx, cluster.ID, porterAppName)
 	if err != nil {
 		return types.PorterAppEvent{}, telemetry.Error(ctx, span, err, "error retrieving porter app by name for cluster")
 	}
@@ -220,6 +229,7 @@ func (p *CreateUpdatePorterAppEventHandler) updateExistingAppEvent(ctx context.C
 		return types.PorterAppEvent{}, telemetry.Error(ctx, span, err, "error retrieving porter app event by id")
 	}
 
+	previousStatus := existingAppEvent.Status
 	if submittedEvent.Status != "" {
 		existingAppEvent.Status = string(submittedEvent.Status)
 	}
@@ -235,9 +245,55 @@ func (p *CreateUpdatePorterAppEventHandler) updateExistingAppEvent(ctx context.C
 		return types.PorterAppEvent{}, telemetry.Error(ctx, span, err, "error updating porter app event")
 	}
 
+	if existingAppEvent.Type == string(types.PorterAppEventType_JobRun) {
+		_ = recordJobRun(ctx, p.Repo(), cluster, app, existingAppEvent.Status, existingAppEvent.Metadata)
+	}
+
+	if existingAppEvent.Status != previousStatus {
+		p.dispatchProjectWebhooks(ctx, cluster, porterAppName, existingAppEvent.ToPorterAppEvent())
+	}
+
 	return existingAppEvent.ToPorterAppEvent(), nil
 }
 
+// dispatchProjectWebhooks notifies the project's webhooks if the event has reached a state that external systems can subscribe to.
+// Deliveries are retried in the background, so this does not block the request.
+func (p *CreateUpdatePorterAppEventHandler) dispatchProjectWebhooks(ctx context.Context, cluster models.Cluster, appName string, event types.PorterAppEvent) {
+	var webhookEvent types.ProjectWebhookEvent
+
+	switch {
+	case event.Type == types.PorterAppEventType_Deploy && event.Status == string(types.PorterAppEventStatus_Success):
+		webhookEvent = types.ProjectWebhookEvent_DeploySucceeded
+	case event.Type == types.PorterAppEventType_Deploy && event.Status == string(types.PorterAppEventStatus_Failed):
+		webhookEvent = types.ProjectWebhookEvent_DeployFailed
+	case event.Type == types.PorterAppEventType_Build && event.Status == string(types.PorterAppEventStatus_Failed):
+		webhookEvent = types.ProjectWebhookEvent_BuildFailed
+	case event.Type == types.PorterAppEventType_JobRun && (event.Status == string(types.PorterAppEventStatus_Success) || event.Status == string(types.PorterAppEventStatus_Failed)):
+		webhookEvent = types.ProjectWebhookEvent_JobCompleted
+	default:
+		return
+	}
+
+	opts := webhook.DispatchOpts{
+		ProjectID: cluster.ProjectID,
+		ClusterID: cluster.ID,
+		AppName:   appName,
+		Event:     webhookEvent,
+		Data: map[string]any{
+			"event_id": event.ID,
+			"status":   event.Status,
+			"metadata": event.Metadata,
+		},
+	}
+
+	// the request context is canceled once the response is written, so deliveries run on a detached context
+	dispatchCtx := trace.ContextWithSpan(context.Background(), trace.SpanFromContext(ctx))
+
+	go func() {
+		_ = webhook.NewDispatcher(p.Repo().ProjectWebhook()).Dispatch(dispatchCtx, opts)
+	}()
+}
+
 // updateDeployEvent attempts to update the deploy event with the deploy status of each service given in updatedStatusMetadata
 // an update is only made in the following cases:
 // 1. the deploy event is found
@@ -388,6 +444,13 @@ func (p *CreateUpdatePorterAppEventHandler) updateDeployEvent(ctx context.Contex
 			return matchEvent.ToPorterAppEvent()
 		}
 		telemetry.WithAttributes(span, telemetry.AttributeKV{Key: "updating-deployment-event", Value: true})
+
+		if allServicesDone {
+			cluster, _ := ctx.Value(types.ClusterScope).(*models.Cluster)
+			if cluster != nil {
+				p.dispatchProjectWebhooks(ctx, *cluster, appName, matchEvent.ToPorterAppEvent())
+			}
+		}
 		return matchEvent.ToPorterAppEvent()
 	}
 
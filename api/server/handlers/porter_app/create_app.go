@@ -2,15 +2,18 @@ package porter_app
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"net/http"
 
+	"github.com/porter-dev/porter/api/server/authz"
 	"github.com/porter-dev/porter/api/server/handlers"
 	"github.com/porter-dev/porter/api/server/shared"
 	"github.com/porter-dev/porter/api/server/shared/apierrors"
 	"github.com/porter-dev/porter/api/server/shared/config"
 	"github.com/porter-dev/porter/api/types"
 	"github.com/porter-dev/porter/internal/models"
+	"github.com/porter-dev/porter/internal/quota"
 	"github.com/porter-dev/porter/internal/repository"
 	"github.com/porter-dev/porter/internal/telemetry"
 )
@@ -117,6 +120,13 @@ func (c *CreateAppHandler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
 	}
 	telemetry.WithAttributes(span, telemetry.AttributeKV{Key: "app-name", Value: request.Name})
 
+	// the app is named in the request body, so policies which restrict apps are checked here rather than by the policy middleware
+	if !authz.HasPorterAppAccess(r.Context(), request.Name, types.APIVerbCreate) {
+		err := telemetry.Error(ctx, span, nil, "policy forbids creating app")
+		c.HandleAPIError(w, r, apierrors.NewErrForbidden(err))
+		return
+	}
+
 	if request.SourceType == "" {
 		err := telemetry.Error(ctx, span, nil, "source type is required")
 		c.HandleAPIError(w, r, apierrors.NewErrPassThroughToClient(err, http.StatusBadRequest))
@@ -124,7 +134,7 @@ func (c *CreateAppHandler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
 	}
 	telemetry.WithAttributes(span, telemetry.AttributeKV{Key: "source-type", Value: request.SourceType})
 
-	porterAppDBEntries, err := c.Repo().PorterApp().ReadPorterAppsByProjectIDAndName(project.ID, request.Name)
+	porterAppDBEntries, err := c.Repo().PorterApp().ReadPorterAppsByProjectIDAndName(ctx, project.ID, request.Name)
 	if err != nil {
 		err := telemetry.Error(ctx, span, nil, "error reading porter apps by project id and name")
 		c.HandleAPIError(w, r, apierrors.NewErrPassThroughToClient(err, http.StatusInternalServerError))
@@ -142,6 +152,20 @@ func (c *CreateAppHandler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
+	err = quota.CheckAppCountQuota(ctx, c.Repo(), project, request.Name)
+	if err != nil {
+		var quotaErr *quota.ExceededError
+		if errors.As(err, &quotaErr) {
+			_ = telemetry.Error(ctx, span, err, "app exceeds project quota")
+			handlers.HandleQuotaExceeded(c.Config(), w, r, quotaErr)
+			return
+		}
+
+		err := telemetry.Error(ctx, span, err, "error checking project quotas")
+		c.HandleAPIError(w, r, apierrors.NewErrPassThroughToClient(err, http.StatusInternalServerError))
+		return
+	}
+
 	var porterApp *types.PorterApp
 	switch request.SourceType {
 	case SourceType_Github:
@@ -253,7 +277,7 @@ func createGithubApp(ctx context.Context, input CreateGithubAppInput) (*models.P
 		PorterYamlPath: input.PorterYamlPath,
 	}
 
-	porterApp, err := input.PorterAppRepository.CreatePorterApp(porterApp)
+	porterApp, err := input.PorterAppRepository.CreatePorterApp(ctx, porterApp)
 	if err != nil {
 		return porterApp, telemetry.Error(ctx, span, err, "error creating porter app")
 	}
@@ -272,7 +296,7 @@ func createDockerRegistryApp(ctx context.Context, input CreateDockerRegistryAppI
 		ImageRepoURI: fmt.Sprintf("%s:%s", input.Repository, input.Tag),
 	}
 
-	porterApp, err := input.PorterAppRepository.CreatePorterApp(porterApp)
+	porterApp, err := input.PorterAppRepository.CreatePorterApp(ctx, porterApp)
 	if err != nil {
 		return porterApp, telemetry.Error(ctx, span, err, "error creating porter app")
 	}
@@ -290,7 +314,7 @@ func createLocalApp(ctx context.Context, input CreateLocalAppInput) (*models.Por
 		ClusterID: input.ClusterID,
 	}
 
-	porterApp, err := input.PorterAppRepository.CreatePorterApp(porterApp)
+	porterApp, err := input.PorterAppRepository.CreatePorterApp(ctx, porterApp)
 	if err != nil {
 		return porterApp, telemetry.Error(ctx, span, err, "error creating porter app")
 	}
@@ -131,7 +131,7 @@ func (c *OpenStackPRHandler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
 
 		if request.DeleteWorkflowFilename == "" {
 			// update DB with the PR url
-			porterApp, err := c.Repo().PorterApp().ReadPorterAppByName(cluster.ID, appName)
+			porterApp, err := c.Repo().PorterApp().ReadPorterAppByName(r.Context(), cluster.ID, appName)
 			if err != nil {
 				c.HandleAPIError(w, r, apierrors.NewErrInternal(fmt.Errorf("unable to get porter app db: %w", err)))
 				return
@@ -139,7 +139,7 @@ func (c *OpenStackPRHandler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
 
 			porterApp.PullRequestURL = pr.GetHTMLURL()
 
-			_, err = c.Repo().PorterApp().UpdatePorterApp(porterApp)
+			_, err = c.Repo().PorterApp().UpdatePorterApp(r.Context(), porterApp)
 			if err != nil {
 				c.HandleAPIError(w, r, apierrors.NewErrInternal(fmt.Errorf("unable to write pr url to porter app db: %w", err)))
 				return
@@ -1,8 +1,11 @@
 package porter_app
 
 import (
+	"errors"
 	"net/http"
 
+	"gorm.io/gorm"
+
 	"github.com/porter-dev/porter/internal/telemetry"
 
 	"github.com/porter-dev/porter/api/server/authz"
@@ -14,6 +17,7 @@ import (
 	"github.com/porter-dev/porter/api/types"
 	"github.com/porter-dev/porter/internal/kubernetes/domain"
 	"github.com/porter-dev/porter/internal/models"
+	"github.com/porter-dev/porter/internal/subdomain"
 )
 
 // CreateSubdomainHandler handles requests to the /apps/{porter_app_name}/subdomain endpoint
@@ -37,6 +41,9 @@ func NewCreateSubdomainHandler(
 // CreateSubdomainRequest is the request object for the /apps/{porter_app_name}/subdomain endpoint
 type CreateSubdomainRequest struct {
 	ServiceName string `schema:"service_name"`
+	// DeploymentTargetID is the deployment target the app is deployed to. Apps deployed to a preview environment are allocated
+	// a subdomain of the wildcard domain of the project which includes the name of the preview.
+	DeploymentTargetID string `schema:"deployment_target_id"`
 }
 
 // CreateSubdomainResponse is the response object for the /apps/{porter_app_name}/subdomain endpoint
@@ -45,7 +52,8 @@ type CreateSubdomainResponse struct {
 	Subdomain string `json:"subdomain"`
 }
 
-// ServeHTTP creates a subdomain for the provided service and returns it
+// ServeHTTP creates a subdomain for the provided service and returns it. If the project has a wildcard domain, the subdomain is
+// allocated from it, and otherwise a Porter domain is created.
 func (c *CreateSubdomainHandler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
 	ctx, span := telemetry.NewSpan(r.Context(), "serve-create-subdomain")
 	defer span.End()
@@ -74,6 +82,48 @@ func (c *CreateSubdomainHandler) ServeHTTP(w http.ResponseWriter, r *http.Reques
 	}
 	telemetry.WithAttributes(span, telemetry.AttributeKV{Key: "service-name", Value: request.ServiceName})
 
+	wildcardDomain, err := c.Repo().Subdomain().ReadWildcardDomain(ctx, project.ID)
+	if err != nil && !errors.Is(err, gorm.ErrRecordNotFound) {
+		err := telemetry.Error(ctx, span, err, "error reading wildcard domain")
+		c.HandleAPIError(w, r, apierrors.NewErrPassThroughToClient(err, http.StatusInternalServerError))
+		return
+	}
+
+	if wildcardDomain != nil {
+		allocateInput := subdomain.AllocateInput{
+			ProjectID:   project.ID,
+			ClusterID:   cluster.ID,
+			AppName:     name,
+			ServiceName: request.ServiceName,
+		}
+
+		if request.DeploymentTargetID != "" {
+			deploymentTarget, err := c.Repo().DeploymentTarget().DeploymentTargetByID(ctx, project.ID, request.DeploymentTargetID)
+			if err != nil {
+				err := telemetry.Error(ctx, span, err, "error reading deployment target")
+				c.HandleAPIError(w, r, apierrors.NewErrPassThroughToClient(err, http.StatusBadRequest))
+				return
+			}
+
+			if deploymentTarget.Preview {
+				allocateInput.DeploymentTargetID = request.DeploymentTargetID
+				allocateInput.PreviewName = deploymentTarget.Selector
+			}
+		}
+
+		allocation, err := subdomain.Allocate(ctx, c.Repo().Subdomain(), allocateInput)
+		if err != nil {
+			err := telemetry.Error(ctx, span, err, "error allocating subdomain")
+			c.HandleAPIError(w, r, apierrors.NewErrPassThroughToClient(err, http.StatusInternalServerError))
+			return
+		}
+
+		c.WriteResult(w, r, &CreateSubdomainResponse{
+			Subdomain: allocation.Hostname(wildcardDomain.Domain),
+		})
+		return
+	}
+
 	k8sAgent, err := c.GetAgent(r, cluster, "")
 	if err != nil {
 		err := telemetry.Error(ctx, span, nil, "error getting agent")
@@ -118,7 +168,7 @@ func (c *CreateSubdomainHandler) ServeHTTP(w http.ResponseWriter, r *http.Reques
 	}
 	telemetry.WithAttributes(span, telemetry.AttributeKV{Key: "host-name", Value: record.Hostname})
 
-	record, err = c.Repo().DNSRecord().CreateDNSRecord(record)
+	record, err = c.Repo().DNSRecord().CreateDNSRecord(ctx, record)
 	if err != nil {
 		err := telemetry.Error(ctx, span, nil, "error creating dns record")
 		c.HandleAPIError(w, r, apierrors.NewErrPassThroughToClient(err, http.StatusInternalServerError))
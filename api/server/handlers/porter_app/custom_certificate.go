@@ -0,0 +1,241 @@
+package porter_app
+
+import (
+	"crypto/tls"
+	"crypto/x509"
+	"errors"
+	"fmt"
+	"net/http"
+	"time"
+
+	"github.com/porter-dev/porter/api/server/authz"
+	"github.com/porter-dev/porter/api/server/handlers"
+	"github.com/porter-dev/porter/api/server/shared"
+	"github.com/porter-dev/porter/api/server/shared/apierrors"
+	"github.com/porter-dev/porter/api/server/shared/config"
+	"github.com/porter-dev/porter/api/server/shared/requestutils"
+	"github.com/porter-dev/porter/api/types"
+	porter_app_kube "github.com/porter-dev/porter/internal/kubernetes/porter_app"
+	"github.com/porter-dev/porter/internal/models"
+	"github.com/porter-dev/porter/internal/telemetry"
+	"gorm.io/gorm"
+)
+
+// UploadCustomCertificateHandler handles requests to the /apps/{porter_app_name}/certificates/{domain} endpoint
+type UploadCustomCertificateHandler struct {
+	handlers.PorterHandlerReadWriter
+	authz.KubernetesAgentGetter
+}
+
+// NewUploadCustomCertificateHandler returns a new UploadCustomCertificateHandler
+func NewUploadCustomCertificateHandler(
+	config *config.Config,
+	decoderValidator shared.RequestDecoderValidator,
+	writer shared.ResultWriter,
+) *UploadCustomCertificateHandler {
+	return &UploadCustomCertificateHandler{
+		PorterHandlerReadWriter: handlers.NewDefaultPorterHandler(config, decoderValidator, writer),
+		KubernetesAgentGetter:   authz.NewOutOfClusterAgentGetter(config),
+	}
+}
+
+// ServeHTTP stores a custom TLS certificate for a domain of an app and serves it instead of the certificate issued by cert-manager.
+// Uploading a certificate for a domain which already has one replaces it, and applies it again if the app was deployed since.
+func (c *UploadCustomCertificateHandler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	ctx, span := telemetry.NewSpan(r.Context(), "serve-upload-custom-certificate")
+	defer span.End()
+
+	project, _ := ctx.Value(types.ProjectScope).(*models.Project)
+	cluster, _ := ctx.Value(types.ClusterScope).(*models.Cluster)
+
+	appName, reqErr := requestutils.GetURLParamString(r, types.URLParamPorterAppName)
+	if reqErr != nil {
+		err := telemetry.Error(ctx, span, reqErr, "error parsing app name from url")
+		c.HandleAPIError(w, r, apierrors.NewErrPassThroughToClient(err, http.StatusBadRequest))
+		return
+	}
+
+	domain, reqErr := requestutils.GetURLParamString(r, types.URLParamDomain)
+	if reqErr != nil {
+		err := telemetry.Error(ctx, span, reqErr, "error parsing domain from url")
+		c.HandleAPIError(w, r, apierrors.NewErrPassThroughToClient(err, http.StatusBadRequest))
+		return
+	}
+
+	telemetry.WithAttributes(span,
+		telemetry.AttributeKV{Key: "app-name", Value: appName},
+		telemetry.AttributeKV{Key: "domain", Value: domain},
+	)
+
+	request := &types.UploadCustomCertificateRequest{}
+	if ok := c.DecodeAndValidate(w, r, request); !ok {
+		return
+	}
+
+	leaf, err := parseCustomCertificate(request.Certificate, request.PrivateKey, domain)
+	if err != nil {
+		err := telemetry.Error(ctx, span, err, "invalid certificate")
+		c.HandleAPIError(w, r, apierrors.NewErrPassThroughToClient(err, http.StatusBadRequest))
+		return
+	}
+
+	agent, err := c.GetAgent(r, cluster, "")
+	if err != nil {
+		err := telemetry.Error(ctx, span, err, "error getting kubernetes agent")
+		c.HandleAPIError(w, r, apierrors.NewErrPassThroughToClient(err, http.StatusInternalServerError))
+		return
+	}
+
+	namespaces, err := porter_app_kube.ApplyCustomCertificate(ctx, agent.Clientset, appName, domain, []byte(request.Certificate), []byte(request.PrivateKey))
+	if err != nil {
+		err := telemetry.Error(ctx, span, err, "error applying custom certificate")
+		c.HandleAPIError(w, r, apierrors.NewErrPassThroughToClient(err, http.StatusInternalServerError))
+		return
+	}
+
+	if len(namespaces) == 0 {
+		err := telemetry.Error(ctx, span, nil, "no ingress of the app serves the domain")
+		c.HandleAPIError(w, r, apierrors.NewErrPassThroughToClient(err, http.StatusBadRequest))
+		return
+	}
+
+	cert, err := c.Repo().CustomCertificate().ReadCustomCertificate(ctx, cluster.ID, appName, domain)
+	if err != nil && !errors.Is(err, gorm.ErrRecordNotFound) {
+		err := telemetry.Error(ctx, span, err, "error reading custom certificate")
+		c.HandleAPIError(w, r, apierrors.NewErrPassThroughToClient(err, http.StatusInternalServerError))
+		return
+	}
+
+	if cert == nil {
+		cert = &models.CustomCertificate{
+			ProjectID: project.ID,
+			ClusterID: cluster.ID,
+			AppName:   appName,
+			Domain:    domain,
+		}
+	}
+
+	cert.Issuer = leaf.Issuer.String()
+	cert.NotAfter = leaf.NotAfter
+	cert.Certificate = []byte(request.Certificate)
+	cert.PrivateKey = []byte(request.PrivateKey)
+
+	if cert.ID == 0 {
+		_, err = c.Repo().CustomCertificate().CreateCustomCertificate(ctx, cert)
+	} else {
+		_, err = c.Repo().CustomCertificate().UpdateCustomCertificate(ctx, cert)
+	}
+	if err != nil {
+		err := telemetry.Error(ctx, span, err, "error saving custom certificate")
+		c.HandleAPIError(w, r, apierrors.NewErrPassThroughToClient(err, http.StatusInternalServerError))
+		return
+	}
+
+	c.WriteResult(w, r, &types.UploadCustomCertificateResponse{
+		Domain:     domain,
+		Issuer:     cert.Issuer,
+		NotAfter:   cert.NotAfter,
+		Namespaces: namespaces,
+	})
+}
+
+// parseCustomCertificate checks that a PEM-encoded certificate chain matches its private key, is valid for the domain and has not expired,
+// and returns the certificate of the domain
+func parseCustomCertificate(certPEM, keyPEM, domain string) (*x509.Certificate, error) {
+	keyPair, err := tls.X509KeyPair([]byte(certPEM), []byte(keyPEM))
+	if err != nil {
+		return nil, fmt.Errorf("certificate and private key do not match: %w", err)
+	}
+
+	leaf, err := x509.ParseCertificate(keyPair.Certificate[0])
+	if err != nil {
+		return nil, fmt.Errorf("error parsing certificate: %w", err)
+	}
+
+	if err := leaf.VerifyHostname(domain); err != nil {
+		return nil, fmt.Errorf("certificate is not valid for %s: %w", domain, err)
+	}
+
+	if time.Now().After(leaf.NotAfter) {
+		return nil, fmt.Errorf("certificate expired at %s", leaf.NotAfter.Format(time.RFC3339))
+	}
+
+	return leaf, nil
+}
+
+// DeleteCustomCertificateHandler handles requests to the /apps/{porter_app_name}/certificates/{domain} endpoint
+type DeleteCustomCertificateHandler struct {
+	handlers.PorterHandlerWriter
+	authz.KubernetesAgentGetter
+}
+
+// NewDeleteCustomCertificateHandler returns a new DeleteCustomCertificateHandler
+func NewDeleteCustomCertificateHandler(
+	config *config.Config,
+	writer shared.ResultWriter,
+) *DeleteCustomCertificateHandler {
+	return &DeleteCustomCertificateHandler{
+		PorterHandlerWriter:   handlers.NewDefaultPorterHandler(config, nil, writer),
+		KubernetesAgentGetter: authz.NewOutOfClusterAgentGetter(config),
+	}
+}
+
+// ServeHTTP deletes the custom certificate of a domain of an app, so that cert-manager issues a certificate for the domain again
+func (c *DeleteCustomCertificateHandler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	ctx, span := telemetry.NewSpan(r.Context(), "serve-delete-custom-certificate")
+	defer span.End()
+
+	cluster, _ := ctx.Value(types.ClusterScope).(*models.Cluster)
+
+	appName, reqErr := requestutils.GetURLParamString(r, types.URLParamPorterAppName)
+	if reqErr != nil {
+		err := telemetry.Error(ctx, span, reqErr, "error parsing app name from url")
+		c.HandleAPIError(w, r, apierrors.NewErrPassThroughToClient(err, http.StatusBadRequest))
+		return
+	}
+
+	domain, reqErr := requestutils.GetURLParamString(r, types.URLParamDomain)
+	if reqErr != nil {
+		err := telemetry.Error(ctx, span, reqErr, "error parsing domain from url")
+		c.HandleAPIError(w, r, apierrors.NewErrPassThroughToClient(err, http.StatusBadRequest))
+		return
+	}
+
+	telemetry.WithAttributes(span,
+		telemetry.AttributeKV{Key: "app-name", Value: appName},
+		telemetry.AttributeKV{Key: "domain", Value: domain},
+	)
+
+	cert, err := c.Repo().CustomCertificate().ReadCustomCertificate(ctx, cluster.ID, appName, domain)
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			c.HandleAPIError(w, r, apierrors.NewErrNotFound(err))
+			return
+		}
+
+		err := telemetry.Error(ctx, span, err, "error reading custom certificate")
+		c.HandleAPIError(w, r, apierrors.NewErrPassThroughToClient(err, http.StatusInternalServerError))
+		return
+	}
+
+	agent, err := c.GetAgent(r, cluster, "")
+	if err != nil {
+		err := telemetry.Error(ctx, span, err, "error getting kubernetes agent")
+		c.HandleAPIError(w, r, apierrors.NewErrPassThroughToClient(err, http.StatusInternalServerError))
+		return
+	}
+
+	if err := porter_app_kube.RemoveCustomCertificate(ctx, agent.Clientset, appName, domain); err != nil {
+		err := telemetry.Error(ctx, span, err, "error removing custom certificate")
+		c.HandleAPIError(w, r, apierrors.NewErrPassThroughToClient(err, http.StatusInternalServerError))
+		return
+	}
+
+	if err := c.Repo().CustomCertificate().DeleteCustomCertificate(ctx, cert); err != nil {
+		err := telemetry.Error(ctx, span, err, "error deleting custom certificate")
+		c.HandleAPIError(w, r, apierrors.NewErrPassThroughToClient(err, http.StatusInternalServerError))
+		return
+	}
+
+	w.WriteHeader(http.StatusOK)
+}
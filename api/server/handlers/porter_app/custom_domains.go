@@ -0,0 +1,290 @@
+package porter_app
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net"
+	"net/http"
+	"strings"
+
+	porterv1 "github.com/porter-dev/api-contracts/generated/go/porter/v1"
+	"gorm.io/gorm"
+
+	"github.com/porter-dev/porter/api/server/authz"
+	"github.com/porter-dev/porter/api/server/handlers"
+	"github.com/porter-dev/porter/api/server/shared"
+	"github.com/porter-dev/porter/api/server/shared/apierrors"
+	"github.com/porter-dev/porter/api/server/shared/config"
+	"github.com/porter-dev/porter/api/server/shared/requestutils"
+	"github.com/porter-dev/porter/api/types"
+	"github.com/porter-dev/porter/internal/customdomain"
+	"github.com/porter-dev/porter/internal/models"
+	"github.com/porter-dev/porter/internal/repository"
+	"github.com/porter-dev/porter/internal/telemetry"
+)
+
+// recordAppDomains creates a custom domain for each domain of the web services of an app, and deletes the custom domains which were
+// removed from the app, so that their DNS records are verified in the background. Domains of preview environments are not recorded.
+func recordAppDomains(ctx context.Context, repo repository.Repository, cluster *models.Cluster, deploymentTargetID string, app *porterv1.PorterApp) error {
+	ctx, span := telemetry.NewSpan(ctx, "record-app-domains")
+	defer span.End()
+
+	deploymentTarget, err := repo.DeploymentTarget().DeploymentTargetByID(ctx, cluster.ProjectID, deploymentTargetID)
+	if err != nil {
+		return telemetry.Error(ctx, span, err, "error reading deployment target")
+	}
+
+	if deploymentTarget.Preview {
+		return nil
+	}
+
+	names := make(map[string]bool)
+	for _, service := range app.Services {
+		for _, domain := range service.GetWebConfig().GetDomains() {
+			if domain.GetName() != "" {
+				names[strings.ToLower(domain.GetName())] = true
+			}
+		}
+	}
+
+	existing, err := repo.CustomDomain().ListCustomDomainsByApp(ctx, cluster.ID, app.Name)
+	if err != nil {
+		return telemetry.Error(ctx, span, err, "error listing custom domains of app")
+	}
+
+	for _, domain := range existing {
+		if names[domain.Name] {
+			delete(names, domain.Name)
+			continue
+		}
+
+		if err := repo.CustomDomain().DeleteCustomDomain(ctx, domain); err != nil {
+			return telemetry.Error(ctx, span, err, fmt.Sprintf("error deleting custom domain %s", domain.Name))
+		}
+	}
+
+	for name := range names {
+		_, err := repo.CustomDomain().CreateCustomDomain(ctx, &models.CustomDomain{
+			ProjectID: cluster.ProjectID,
+			ClusterID: cluster.ID,
+			AppName:   app.Name,
+			Name:      name,
+			Status:    types.CustomDomainStatus_Pending,
+		})
+		if err != nil {
+			return telemetry.Error(ctx, span, err, fmt.Sprintf("error creating custom domain %s", name))
+		}
+	}
+
+	return nil
+}
+
+// ListCustomDomainsHandler handles requests to the /apps/{porter_app_name}/domains endpoint
+type ListCustomDomainsHandler struct {
+	handlers.PorterHandlerWriter
+}
+
+// NewListCustomDomainsHandler returns a new ListCustomDomainsHandler
+func NewListCustomDomainsHandler(
+	config *config.Config,
+	writer shared.ResultWriter,
+) *ListCustomDomainsHandler {
+	return &ListCustomDomainsHandler{
+		PorterHandlerWriter: handlers.NewDefaultPorterHandler(config, nil, writer),
+	}
+}
+
+// ServeHTTP lists the custom domains of an app, with the DNS record each domain needs and the status of its verification
+func (c *ListCustomDomainsHandler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	ctx, span := telemetry.NewSpan(r.Context(), "serve-list-custom-domains")
+	defer span.End()
+
+	cluster, _ := ctx.Value(types.ClusterScope).(*models.Cluster)
+
+	appName, reqErr := requestutils.GetURLParamString(r, types.URLParamPorterAppName)
+	if reqErr != nil {
+		err := telemetry.Error(ctx, span, reqErr, "error parsing app name from url")
+		c.HandleAPIError(w, r, apierrors.NewErrPassThroughToClient(err, http.StatusBadRequest))
+		return
+	}
+	telemetry.WithAttributes(span, telemetry.AttributeKV{Key: "app-name", Value: appName})
+
+	domains, err := c.Repo().CustomDomain().ListCustomDomainsByApp(ctx, cluster.ID, appName)
+	if err != nil {
+		err := telemetry.Error(ctx, span, err, "error listing custom domains")
+		c.HandleAPIError(w, r, apierrors.NewErrPassThroughToClient(err, http.StatusInternalServerError))
+		return
+	}
+
+	res := &types.ListCustomDomainsResponse{
+		Domains: make([]types.CustomDomain, 0, len(domains)),
+	}
+
+	for _, domain := range domains {
+		res.Domains = append(res.Domains, domain.ToCustomDomainType())
+	}
+
+	c.WriteResult(w, r, res)
+}
+
+// VerifyCustomDomainHandler handles requests to the /apps/{porter_app_name}/domains/{domain}/verify endpoint
+type VerifyCustomDomainHandler struct {
+	handlers.PorterHandlerWriter
+	authz.KubernetesAgentGetter
+}
+
+// NewVerifyCustomDomainHandler returns a new VerifyCustomDomainHandler
+func NewVerifyCustomDomainHandler(
+	config *config.Config,
+	writer shared.ResultWriter,
+) *VerifyCustomDomainHandler {
+	return &VerifyCustomDomainHandler{
+		PorterHandlerWriter:   handlers.NewDefaultPorterHandler(config, nil, writer),
+		KubernetesAgentGetter: authz.NewOutOfClusterAgentGetter(config),
+	}
+}
+
+// ServeHTTP checks the DNS record of a custom domain right away, instead of waiting for the next background check
+func (c *VerifyCustomDomainHandler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	ctx, span := telemetry.NewSpan(r.Context(), "serve-verify-custom-domain")
+	defer span.End()
+
+	cluster, _ := ctx.Value(types.ClusterScope).(*models.Cluster)
+
+	domain, reqErr := readCustomDomain(ctx, c.Repo(), r, cluster)
+	if reqErr != nil {
+		c.HandleAPIError(w, r, reqErr)
+		return
+	}
+
+	agent, err := c.GetAgent(r, cluster, "")
+	if err != nil {
+		err := telemetry.Error(ctx, span, err, "error getting kubernetes agent")
+		c.HandleAPIError(w, r, apierrors.NewErrPassThroughToClient(err, http.StatusInternalServerError))
+		return
+	}
+
+	if err := customdomain.Check(ctx, c.Repo(), agent.Clientset, net.DefaultResolver, domain); err != nil {
+		err := telemetry.Error(ctx, span, err, "error checking custom domain")
+		c.HandleAPIError(w, r, apierrors.NewErrPassThroughToClient(err, http.StatusInternalServerError))
+		return
+	}
+
+	c.WriteResult(w, r, domain.ToCustomDomainType())
+}
+
+// ConfigureDomainDNSHandler handles requests to the /apps/{porter_app_name}/domains/{domain}/dns endpoint
+type ConfigureDomainDNSHandler struct {
+	handlers.PorterHandlerReadWriter
+	authz.KubernetesAgentGetter
+}
+
+// NewConfigureDomainDNSHandler returns a new ConfigureDomainDNSHandler
+func NewConfigureDomainDNSHandler(
+	config *config.Config,
+	decoderValidator shared.RequestDecoderValidator,
+	writer shared.ResultWriter,
+) *ConfigureDomainDNSHandler {
+	return &ConfigureDomainDNSHandler{
+		PorterHandlerReadWriter: handlers.NewDefaultPorterHandler(config, decoderValidator, writer),
+		KubernetesAgentGetter:   authz.NewOutOfClusterAgentGetter(config),
+	}
+}
+
+// ServeHTTP lets Porter manage the DNS record of a custom domain in Route53 or Cloud DNS, with an integration of the project. The
+// record is created right away if the address of the ingress of the cluster is known, and otherwise by the background check.
+func (c *ConfigureDomainDNSHandler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	ctx, span := telemetry.NewSpan(r.Context(), "serve-configure-domain-dns")
+	defer span.End()
+
+	project, _ := ctx.Value(types.ProjectScope).(*models.Project)
+	cluster, _ := ctx.Value(types.ClusterScope).(*models.Cluster)
+
+	request := &types.ConfigureDomainDNSRequest{}
+	if ok := c.DecodeAndValidate(w, r, request); !ok {
+		return
+	}
+
+	domain, reqErr := readCustomDomain(ctx, c.Repo(), r, cluster)
+	if reqErr != nil {
+		c.HandleAPIError(w, r, reqErr)
+		return
+	}
+
+	telemetry.WithAttributes(span, telemetry.AttributeKV{Key: "dns-provider", Value: string(request.Provider)})
+
+	switch request.Provider {
+	case types.DNSProvider_Route53:
+		if _, err := c.Repo().AWSIntegration().ReadAWSIntegration(ctx, project.ID, request.AWSIntegrationID); err != nil {
+			err := telemetry.Error(ctx, span, err, "aws integration not found in project")
+			c.HandleAPIError(w, r, apierrors.NewErrPassThroughToClient(err, http.StatusBadRequest))
+			return
+		}
+	case types.DNSProvider_CloudDNS:
+		if _, err := c.Repo().GCPIntegration().ReadGCPIntegration(ctx, project.ID, request.GCPIntegrationID); err != nil {
+			err := telemetry.Error(ctx, span, err, "gcp integration not found in project")
+			c.HandleAPIError(w, r, apierrors.NewErrPassThroughToClient(err, http.StatusBadRequest))
+			return
+		}
+	}
+
+	domain.DNSProvider = request.Provider
+	domain.AWSIntegrationID = request.AWSIntegrationID
+	domain.GCPIntegrationID = request.GCPIntegrationID
+	domain.DNSZone = request.Zone
+
+	// the record is created again in the new zone, even if the domain already resolves to the cluster
+	domain.Status = types.CustomDomainStatus_Pending
+
+	agent, err := c.GetAgent(r, cluster, "")
+	if err != nil {
+		err := telemetry.Error(ctx, span, err, "error getting kubernetes agent")
+		c.HandleAPIError(w, r, apierrors.NewErrPassThroughToClient(err, http.StatusInternalServerError))
+		return
+	}
+
+	if err := customdomain.Check(ctx, c.Repo(), agent.Clientset, net.DefaultResolver, domain); err != nil {
+		err := telemetry.Error(ctx, span, err, "error creating dns record of custom domain")
+		c.HandleAPIError(w, r, apierrors.NewErrPassThroughToClient(err, http.StatusBadRequest))
+		return
+	}
+
+	c.WriteResult(w, r, domain.ToCustomDomainType())
+}
+
+// readCustomDomain reads the custom domain named by the url of a request
+func readCustomDomain(ctx context.Context, repo repository.Repository, r *http.Request, cluster *models.Cluster) (*models.CustomDomain, apierrors.RequestError) {
+	ctx, span := telemetry.NewSpan(ctx, "read-custom-domain")
+	defer span.End()
+
+	appName, reqErr := requestutils.GetURLParamString(r, types.URLParamPorterAppName)
+	if reqErr != nil {
+		err := telemetry.Error(ctx, span, reqErr, "error parsing app name from url")
+		return nil, apierrors.NewErrPassThroughToClient(err, http.StatusBadRequest)
+	}
+
+	name, reqErr := requestutils.GetURLParamString(r, types.URLParamDomain)
+	if reqErr != nil {
+		err := telemetry.Error(ctx, span, reqErr, "error parsing domain from url")
+		return nil, apierrors.NewErrPassThroughToClient(err, http.StatusBadRequest)
+	}
+
+	telemetry.WithAttributes(span,
+		telemetry.AttributeKV{Key: "app-name", Value: appName},
+		telemetry.AttributeKV{Key: "domain", Value: name},
+	)
+
+	domain, err := repo.CustomDomain().ReadCustomDomain(ctx, cluster.ID, appName, strings.ToLower(name))
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			err := telemetry.Error(ctx, span, err, "domain is not set on a web service of the app")
+			return nil, apierrors.NewErrPassThroughToClient(err, http.StatusNotFound)
+		}
+
+		err := telemetry.Error(ctx, span, err, "error reading custom domain")
+		return nil, apierrors.NewErrPassThroughToClient(err, http.StatusInternalServerError)
+	}
+
+	return domain, nil
+}
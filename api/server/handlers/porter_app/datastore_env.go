@@ -0,0 +1,70 @@
+package porter_app
+
+import (
+	"context"
+	"fmt"
+
+	porterv1 "github.com/porter-dev/api-contracts/generated/go/porter/v1"
+
+	"github.com/porter-dev/porter/api/types"
+	"github.com/porter-dev/porter/internal/datastore"
+	"github.com/porter-dev/porter/internal/models"
+	"github.com/porter-dev/porter/internal/repository"
+	"github.com/porter-dev/porter/internal/telemetry"
+)
+
+// injectDatastoreEnv sets the connection strings of the datastores linked to an app in the env of the app, with the credentials of
+// the database user of the app. Env vars which are set explicitly are not overwritten, and datastores which are not available yet are skipped.
+func injectDatastoreEnv(ctx context.Context, repo repository.Repository, cluster *models.Cluster, app *porterv1.PorterApp) error {
+	ctx, span := telemetry.NewSpan(ctx, "inject-datastore-env")
+	defer span.End()
+
+	links, err := repo.Datastore().ListDatastoreLinksByApp(ctx, cluster.ProjectID, cluster.ID, app.Name)
+	if err != nil {
+		return telemetry.Error(ctx, span, err, "error listing datastore links of app")
+	}
+
+	var injected, skipped int
+
+	for _, link := range links {
+		if _, ok := app.Env[link.EnvVar]; ok {
+			skipped++
+			continue
+		}
+
+		ds, err := repo.Datastore().ReadDatastore(ctx, cluster.ProjectID, link.DatastoreID)
+		if err != nil {
+			return telemetry.Error(ctx, span, err, fmt.Sprintf("error reading datastore linked to env var %s", link.EnvVar))
+		}
+
+		if ds.Status != types.DatastoreStatus_Available {
+			skipped++
+			continue
+		}
+
+		// links created before apps had dedicated users connect as the master user
+		username, password := ds.MasterUsername, string(ds.MasterPassword)
+		if link.Username != "" {
+			username, password = link.Username, string(link.Password)
+		}
+
+		connStr, err := datastore.ConnectionString(ds, username, password)
+		if err != nil {
+			return telemetry.Error(ctx, span, err, fmt.Sprintf("error building connection string for env var %s", link.EnvVar))
+		}
+
+		if app.Env == nil {
+			app.Env = make(map[string]string)
+		}
+
+		app.Env[link.EnvVar] = connStr
+		injected++
+	}
+
+	telemetry.WithAttributes(span,
+		telemetry.AttributeKV{Key: "injected-datastore-env", Value: injected},
+		telemetry.AttributeKV{Key: "skipped-datastore-env", Value: skipped},
+	)
+
+	return nil
+}
@@ -39,17 +39,29 @@ func (c *DeletePorterAppByNameHandler) ServeHTTP(w http.ResponseWriter, r *http.
 		return
 	}
 
-	porterApp, appErr := c.Repo().PorterApp().ReadPorterAppByName(cluster.ID, appName)
+	porterApp, appErr := c.Repo().PorterApp().ReadPorterAppByName(ctx, cluster.ID, appName)
 	if appErr != nil {
 		c.HandleAPIError(w, r, apierrors.NewErrInternal(appErr))
 		return
 	}
 
-	delApp, delErr := c.Repo().PorterApp().DeletePorterApp(porterApp)
+	delApp, delErr := c.Repo().PorterApp().DeletePorterApp(ctx, porterApp)
 	if delErr != nil {
 		c.HandleAPIError(w, r, apierrors.NewErrInternal(delErr))
 		return
 	}
 
+	// the resources of a deleted app no longer count against the quotas of the project
+	if err := c.Repo().AppResourceRequest().DeleteAppResourceRequestsByPorterAppID(ctx, porterApp.ID); err != nil {
+		c.HandleAPIError(w, r, apierrors.NewErrInternal(err))
+		return
+	}
+
+	// the subdomains allocated to a deleted app can be allocated to other apps
+	if err := c.Repo().Subdomain().DeleteSubdomainAllocationsByApp(ctx, cluster.ID, appName); err != nil {
+		c.HandleAPIError(w, r, apierrors.NewErrInternal(err))
+		return
+	}
+
 	c.WriteResult(w, r, delApp)
 }
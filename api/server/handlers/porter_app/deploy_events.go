@@ -0,0 +1,255 @@
+package porter_app
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"time"
+
+	"connectrpc.com/connect"
+	porterv1 "github.com/porter-dev/api-contracts/generated/go/porter/v1"
+
+	"github.com/porter-dev/porter/api/server/authz"
+	"github.com/porter-dev/porter/api/server/handlers"
+	"github.com/porter-dev/porter/api/server/shared"
+	"github.com/porter-dev/porter/api/server/shared/apierrors"
+	"github.com/porter-dev/porter/api/server/shared/config"
+	"github.com/porter-dev/porter/api/server/shared/requestutils"
+	"github.com/porter-dev/porter/api/types"
+	porter_app_kube "github.com/porter-dev/porter/internal/kubernetes/porter_app"
+	"github.com/porter-dev/porter/internal/models"
+	"github.com/porter-dev/porter/internal/telemetry"
+)
+
+const (
+	// deployEventsPollInterval is how often the rollout state of the services is read
+	deployEventsPollInterval = 2 * time.Second
+	// defaultDeployEventsTimeout is how long rollout progress is streamed if no timeout is requested
+	defaultDeployEventsTimeout = 10 * time.Minute
+	// maxDeployEventsTimeout is the longest rollout progress can be streamed for
+	maxDeployEventsTimeout = 30 * time.Minute
+)
+
+// DeployEventsRequest is the request object for the /apps/{porter_app_name}/deploys/{app_revision_id}/events endpoint
+type DeployEventsRequest struct {
+	DeploymentTargetID string `schema:"deployment_target_id"`
+	// TimeoutSeconds is how long to wait for the rollout to complete, defaulting to 10 minutes
+	TimeoutSeconds int `schema:"timeout_seconds"`
+}
+
+// DeployEventType is the type of a server-sent event of the /apps/{porter_app_name}/deploys/{app_revision_id}/events endpoint
+type DeployEventType string
+
+const (
+	// DeployEventType_Progress events hold a step in the rollout of a service
+	DeployEventType_Progress DeployEventType = "progress"
+	// DeployEventType_Done is the last event of the stream, sent when the rollout completes, fails or times out
+	DeployEventType_Done DeployEventType = "done"
+)
+
+// DeployStatus is the outcome of a rollout
+type DeployStatus string
+
+const (
+	// DeployStatus_Succeeded means every service rolled out the revision
+	DeployStatus_Succeeded DeployStatus = "succeeded"
+	// DeployStatus_Failed means a service cannot roll out the revision
+	DeployStatus_Failed DeployStatus = "failed"
+	// DeployStatus_TimedOut means the rollout did not complete within the timeout
+	DeployStatus_TimedOut DeployStatus = "timed_out"
+)
+
+// DeployDoneEvent is the data of the done event
+type DeployDoneEvent struct {
+	Status  DeployStatus `json:"status"`
+	Message string       `json:"message,omitempty"`
+}
+
+// DeployEventsHandler handles requests to the /apps/{porter_app_name}/deploys/{app_revision_id}/events endpoint
+type DeployEventsHandler struct {
+	handlers.PorterHandlerReadWriter
+	authz.KubernetesAgentGetter
+}
+
+// NewDeployEventsHandler returns a new DeployEventsHandler
+func NewDeployEventsHandler(
+	config *config.Config,
+	decoderValidator shared.RequestDecoderValidator,
+	writer shared.ResultWriter,
+) *DeployEventsHandler {
+	return &DeployEventsHandler{
+		PorterHandlerReadWriter: handlers.NewDefaultPorterHandler(config, decoderValidator, writer),
+		KubernetesAgentGetter:   authz.NewOutOfClusterAgentGetter(config),
+	}
+}
+
+// ServeHTTP streams the rollout progress of an app revision as server-sent events, until every service has rolled out,
+// a service fails to roll out, or the timeout is reached
+func (c *DeployEventsHandler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	ctx, span := telemetry.NewSpan(r.Context(), "serve-deploy-events")
+	defer span.End()
+
+	project, _ := ctx.Value(types.ProjectScope).(*models.Project)
+	cluster, _ := ctx.Value(types.ClusterScope).(*models.Cluster)
+
+	telemetry.WithAttributes(span,
+		telemetry.AttributeKV{Key: "project-id", Value: project.ID},
+		telemetry.AttributeKV{Key: "cluster-id", Value: cluster.ID},
+	)
+
+	appRevisionID, reqErr := requestutils.GetURLParamString(r, types.URLParamAppRevisionID)
+	if reqErr != nil {
+		err := telemetry.Error(ctx, span, reqErr, "error parsing app revision id from url")
+		c.HandleAPIError(w, r, apierrors.NewErrPassThroughToClient(err, http.StatusBadRequest))
+		return
+	}
+
+	porterApp, status, err := porterAppFromURL(ctx, c.Repo(), r)
+	if err != nil {
+		err := telemetry.Error(ctx, span, err, "error reading porter app")
+		c.HandleAPIError(w, r, apierrors.NewErrPassThroughToClient(err, status))
+		return
+	}
+
+	request := &DeployEventsRequest{}
+	if ok := c.DecodeAndValidate(w, r, request); !ok {
+		err := telemetry.Error(ctx, span, nil, "error decoding request")
+		c.HandleAPIError(w, r, apierrors.NewErrPassThroughToClient(err, http.StatusBadRequest))
+		return
+	}
+
+	telemetry.WithAttributes(span,
+		telemetry.AttributeKV{Key: "app-name", Value: porterApp.Name},
+		telemetry.AttributeKV{Key: "app-revision-id", Value: appRevisionID},
+		telemetry.AttributeKV{Key: "deployment-target-id", Value: request.DeploymentTargetID},
+	)
+
+	timeout := defaultDeployEventsTimeout
+	if request.TimeoutSeconds > 0 {
+		timeout = time.Duration(request.TimeoutSeconds) * time.Second
+	}
+	if timeout > maxDeployEventsTimeout {
+		timeout = maxDeployEventsTimeout
+	}
+
+	deploymentTarget, err := c.Repo().DeploymentTarget().DeploymentTargetByID(ctx, project.ID, request.DeploymentTargetID)
+	if err != nil {
+		err := telemetry.Error(ctx, span, err, "error reading deployment target")
+		c.HandleAPIError(w, r, apierrors.NewErrPassThroughToClient(err, http.StatusBadRequest))
+		return
+	}
+
+	if deploymentTarget.SelectorType != DeploymentTargetSelectorType_Default {
+		err := telemetry.Error(ctx, span, nil, "deploy events are only supported for namespace deployment targets")
+		c.HandleAPIError(w, r, apierrors.NewErrPassThroughToClient(err, http.StatusBadRequest))
+		return
+	}
+	namespace := deploymentTarget.Selector
+
+	revisionResp, err := c.Config().ClusterControlPlaneClient.CurrentAppRevision(ctx, connect.NewRequest(&porterv1.CurrentAppRevisionRequest{
+		ProjectId:          int64(project.ID),
+		AppId:              int64(porterApp.ID),
+		DeploymentTargetId: deploymentTarget.ID.String(),
+	}))
+	if err != nil || revisionResp == nil || revisionResp.Msg == nil || revisionResp.Msg.AppRevision.GetApp() == nil {
+		err := telemetry.Error(ctx, span, err, "error getting current app revision from cluster control plane client")
+		c.HandleAPIError(w, r, apierrors.NewErrPassThroughToClient(err, http.StatusBadRequest))
+		return
+	}
+	appProto := revisionResp.Msg.AppRevision.GetApp()
+
+	agent, err := c.GetAgent(r, cluster, namespace)
+	if err != nil {
+		err := telemetry.Error(ctx, span, err, "error getting kubernetes agent")
+		c.HandleAPIError(w, r, apierrors.NewErrPassThroughToClient(err, http.StatusInternalServerError))
+		return
+	}
+
+	// jobs do not have a rollout
+	pending := make(map[string]bool)
+	for serviceName, service := range appProto.Services {
+		if service.Type != porterv1.ServiceType_SERVICE_TYPE_JOB {
+			pending[serviceName] = true
+		}
+	}
+
+	// the stream outlives the server write timeout, so the deadline is extended for this response
+	rc := http.NewResponseController(w)
+	if err := rc.SetWriteDeadline(time.Now().Add(timeout + time.Minute)); err != nil && !errors.Is(err, http.ErrNotSupported) {
+		err := telemetry.Error(ctx, span, err, "error extending write deadline")
+		c.HandleAPIError(w, r, apierrors.NewErrPassThroughToClient(err, http.StatusInternalServerError))
+		return
+	}
+
+	w.Header().Set("Content-Type", "text/event-stream")
+	w.Header().Set("Cache-Control", "no-cache")
+	w.Header().Set("Connection", "keep-alive")
+	w.Header().Set("X-Accel-Buffering", "no")
+	w.WriteHeader(http.StatusOK)
+
+	send := func(eventType DeployEventType, data interface{}) error {
+		dataBytes, err := json.Marshal(data)
+		if err != nil {
+			return err
+		}
+
+		if _, err := fmt.Fprintf(w, "event: %s\ndata: %s\n\n", eventType, dataBytes); err != nil {
+			return err
+		}
+
+		return rc.Flush()
+	}
+
+	tracker := porter_app_kube.NewRolloutTracker(appProto.GetImage().GetTag(), time.Now())
+	deadline := time.After(timeout)
+	ticker := time.NewTicker(deployEventsPollInterval)
+	defer ticker.Stop()
+
+	for {
+		for serviceName := range pending {
+			rollout, err := porter_app_kube.GetServiceRollout(ctx, agent.Clientset, namespace, porterApp.Name, serviceName)
+			if err != nil {
+				_ = telemetry.Error(ctx, span, err, "error reading service rollout")
+				continue
+			}
+
+			events, rolledOut := tracker.Observe(rollout, time.Now())
+			for _, event := range events {
+				if err := send(DeployEventType_Progress, event); err != nil {
+					_ = telemetry.Error(ctx, span, err, "error sending deploy event")
+					return
+				}
+
+				if event.Failed {
+					_ = send(DeployEventType_Done, DeployDoneEvent{ // nolint:errcheck
+						Status:  DeployStatus_Failed,
+						Message: fmt.Sprintf("service %s failed to roll out: %s", serviceName, event.Message),
+					})
+					return
+				}
+			}
+
+			if rolledOut {
+				delete(pending, serviceName)
+			}
+		}
+
+		if len(pending) == 0 {
+			_ = send(DeployEventType_Done, DeployDoneEvent{Status: DeployStatus_Succeeded}) // nolint:errcheck
+			return
+		}
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-deadline:
+			_ = send(DeployEventType_Done, DeployDoneEvent{ // nolint:errcheck
+				Status:  DeployStatus_TimedOut,
+				Message: fmt.Sprintf("rollout did not complete within %s", timeout),
+			})
+			return
+		case <-ticker.C:
+		}
+	}
+}
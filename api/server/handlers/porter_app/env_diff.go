@@ -0,0 +1,336 @@
+package porter_app
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"net/http"
+	"sort"
+
+	"connectrpc.com/connect"
+	porterv1 "github.com/porter-dev/api-contracts/generated/go/porter/v1"
+	v1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"github.com/porter-dev/porter/api/server/authz"
+	"github.com/porter-dev/porter/api/server/handlers"
+	"github.com/porter-dev/porter/api/server/shared"
+	"github.com/porter-dev/porter/api/server/shared/apierrors"
+	"github.com/porter-dev/porter/api/server/shared/config"
+	"github.com/porter-dev/porter/api/server/shared/requestutils"
+	"github.com/porter-dev/porter/api/types"
+	"github.com/porter-dev/porter/internal/kubernetes"
+	"github.com/porter-dev/porter/internal/models"
+	"github.com/porter-dev/porter/internal/telemetry"
+)
+
+// EnvDiffHandler handles requests to the /apps/{porter_app_name}/env-diff endpoint
+type EnvDiffHandler struct {
+	handlers.PorterHandlerReadWriter
+	authz.KubernetesAgentGetter
+}
+
+// NewEnvDiffHandler returns a new EnvDiffHandler
+func NewEnvDiffHandler(
+	config *config.Config,
+	decoderValidator shared.RequestDecoderValidator,
+	writer shared.ResultWriter,
+) *EnvDiffHandler {
+	return &EnvDiffHandler{
+		PorterHandlerReadWriter: handlers.NewDefaultPorterHandler(config, decoderValidator, writer),
+		KubernetesAgentGetter:   authz.NewOutOfClusterAgentGetter(config),
+	}
+}
+
+// EnvDiffRequest is the request object for the /apps/{porter_app_name}/env-diff endpoint. Deployment targets are identified by
+// their namespace selector, e.g. default or preview-pr-12.
+type EnvDiffRequest struct {
+	Base    string `schema:"base"`
+	Compare string `schema:"compare"`
+}
+
+// EnvDiffResponse is the response object for the /apps/{porter_app_name}/env-diff endpoint
+type EnvDiffResponse struct {
+	Base      string             `json:"base"`
+	Compare   string             `json:"compare"`
+	Variables []*EnvVariableDiff `json:"variables"`
+}
+
+// EnvVariableDiffStatus is the result of comparing a variable between two deployment targets
+type EnvVariableDiffStatus string
+
+const (
+	// EnvVariableDiffStatus_Same means the variable has the same value in both deployment targets
+	EnvVariableDiffStatus_Same EnvVariableDiffStatus = "same"
+	// EnvVariableDiffStatus_Different means the variable is set in both deployment targets with different values
+	EnvVariableDiffStatus_Different EnvVariableDiffStatus = "different"
+	// EnvVariableDiffStatus_MissingInBase means the variable is only set in the compare deployment target
+	EnvVariableDiffStatus_MissingInBase EnvVariableDiffStatus = "missing_in_base"
+	// EnvVariableDiffStatus_MissingInCompare means the variable is only set in the base deployment target
+	EnvVariableDiffStatus_MissingInCompare EnvVariableDiffStatus = "missing_in_compare"
+)
+
+// EnvVariableDiff is the comparison of a single variable. Values of secrets are compared, but never returned.
+type EnvVariableDiff struct {
+	Key          string                `json:"key"`
+	Secret       bool                  `json:"secret"`
+	Status       EnvVariableDiffStatus `json:"status"`
+	BaseValue    string                `json:"base_value,omitempty"`
+	CompareValue string                `json:"compare_value,omitempty"`
+}
+
+// envValue is the value of a variable in the effective env of an app
+type envValue struct {
+	value  []byte
+	secret bool
+}
+
+// ServeHTTP compares the effective env of an app, including the variables and secrets of its env groups, between two deployment targets
+func (c *EnvDiffHandler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	ctx, span := telemetry.NewSpan(r.Context(), "serve-env-diff")
+	defer span.End()
+
+	project, _ := ctx.Value(types.ProjectScope).(*models.Project)
+	cluster, _ := ctx.Value(types.ClusterScope).(*models.Cluster)
+
+	telemetry.WithAttributes(span,
+		telemetry.AttributeKV{Key: "project-id", Value: project.ID},
+		telemetry.AttributeKV{Key: "cluster-id", Value: cluster.ID},
+	)
+
+	appName, reqErr := requestutils.GetURLParamString(r, types.URLParamPorterAppName)
+	if reqErr != nil {
+		err := telemetry.Error(ctx, span, reqErr, "error parsing app name from url")
+		c.HandleAPIError(w, r, apierrors.NewErrPassThroughToClient(err, http.StatusBadRequest))
+		return
+	}
+
+	request := &EnvDiffRequest{}
+	if ok := c.DecodeAndValidate(w, r, request); !ok {
+		err := telemetry.Error(ctx, span, nil, "error decoding request")
+		c.HandleAPIError(w, r, apierrors.NewErrPassThroughToClient(err, http.StatusBadRequest))
+		return
+	}
+
+	telemetry.WithAttributes(span,
+		telemetry.AttributeKV{Key: "app-name", Value: appName},
+		telemetry.AttributeKV{Key: "base", Value: request.Base},
+		telemetry.AttributeKV{Key: "compare", Value: request.Compare},
+	)
+
+	if request.Base == "" || request.Compare == "" {
+		err := telemetry.Error(ctx, span, nil, "base and compare deployment targets are required")
+		c.HandleAPIError(w, r, apierrors.NewErrPassThroughToClient(err, http.StatusBadRequest))
+		return
+	}
+
+	porterApp, err := c.Repo().PorterApp().ReadPorterAppByName(ctx, cluster.ID, appName)
+	if err != nil || porterApp == nil || porterApp.ID == 0 {
+		err := telemetry.Error(ctx, span, err, "error reading porter app")
+		c.HandleAPIError(w, r, apierrors.NewErrPassThroughToClient(err, http.StatusNotFound))
+		return
+	}
+
+	agent, err := c.GetAgent(r, cluster, "")
+	if err != nil {
+		err := telemetry.Error(ctx, span, err, "error getting kubernetes agent")
+		c.HandleAPIError(w, r, apierrors.NewErrPassThroughToClient(err, http.StatusInternalServerError))
+		return
+	}
+
+	envs := make([]map[string]envValue, 0, 2)
+	for _, selector := range []string{request.Base, request.Compare} {
+		deploymentTarget, err := c.Repo().DeploymentTarget().DeploymentTargetBySelectorAndSelectorType(ctx, project.ID, cluster.ID, selector, DeploymentTargetSelectorType_Default)
+		if err != nil || deploymentTarget == nil {
+			err := telemetry.Error(ctx, span, err, fmt.Sprintf("error reading deployment target %s", selector))
+			c.HandleAPIError(w, r, apierrors.NewErrPassThroughToClient(err, http.StatusBadRequest))
+			return
+		}
+
+		revisionResp, err := c.Config().ClusterControlPlaneClient.CurrentAppRevision(ctx, connect.NewRequest(&porterv1.CurrentAppRevisionRequest{
+			ProjectId:          int64(project.ID),
+			AppId:              int64(porterApp.ID),
+			DeploymentTargetId: deploymentTarget.ID.String(),
+		}))
+		if err != nil || revisionResp == nil || revisionResp.Msg == nil || revisionResp.Msg.AppRevision.GetApp() == nil {
+			err := telemetry.Error(ctx, span, err, fmt.Sprintf("error getting current app revision in %s from cluster control plane client", selector))
+			c.HandleAPIError(w, r, apierrors.NewErrPassThroughToClient(err, http.StatusBadRequest))
+			return
+		}
+
+		env, err := effectiveEnv(ctx, agent, deploymentTarget.Selector, appName, revisionResp.Msg.AppRevision.GetApp())
+		if err != nil {
+			err := telemetry.Error(ctx, span, err, fmt.Sprintf("error getting effective env in %s", selector))
+			c.HandleAPIError(w, r, apierrors.NewErrPassThroughToClient(err, http.StatusInternalServerError))
+			return
+		}
+
+		envs = append(envs, env)
+	}
+
+	c.WriteResult(w, r, &EnvDiffResponse{
+		Base:      request.Base,
+		Compare:   request.Compare,
+		Variables: diffEnv(envs[0], envs[1]),
+	})
+}
+
+// effectiveEnv returns the env of an app in a namespace: the env of its porter.yaml, and the variables and secrets that the containers of
+// its services load from config maps and secrets, such as those of synced env groups
+func effectiveEnv(ctx context.Context, agent *kubernetes.Agent, namespace, appName string, app *porterv1.PorterApp) (map[string]envValue, error) {
+	env := make(map[string]envValue)
+
+	configMaps := make(map[string]*v1.ConfigMap)
+	secrets := make(map[string]*v1.Secret)
+
+	getConfigMap := func(name string) (*v1.ConfigMap, error) {
+		if cm, ok := configMaps[name]; ok {
+			return cm, nil
+		}
+		cm, err := agent.Clientset.CoreV1().ConfigMaps(namespace).Get(ctx, name, metav1.GetOptions{})
+		if err != nil {
+			return nil, fmt.Errorf("error getting config map %s: %w", name, err)
+		}
+		configMaps[name] = cm
+		return cm, nil
+	}
+
+	getSecret := func(name string) (*v1.Secret, error) {
+		if secret, ok := secrets[name]; ok {
+			return secret, nil
+		}
+		secret, err := agent.Clientset.CoreV1().Secrets(namespace).Get(ctx, name, metav1.GetOptions{})
+		if err != nil {
+			return nil, fmt.Errorf("error getting secret %s: %w", name, err)
+		}
+		secrets[name] = secret
+		return secret, nil
+	}
+
+	serviceNames := make([]string, 0, len(app.Services))
+	for serviceName := range app.Services {
+		serviceNames = append(serviceNames, serviceName)
+	}
+	sort.Strings(serviceNames)
+
+	for _, serviceName := range serviceNames {
+		selector := fmt.Sprintf("app.kubernetes.io/instance=%s-%s", appName, serviceName)
+
+		var containers []v1.Container
+
+		deployments, err := agent.Clientset.AppsV1().Deployments(namespace).List(ctx, metav1.ListOptions{LabelSelector: selector})
+		if err != nil {
+			return nil, fmt.Errorf("error listing deployments: %w", err)
+		}
+		for _, deployment := range deployments.Items {
+			containers = append(containers, deployment.Spec.Template.Spec.Containers...)
+		}
+
+		cronJobs, err := agent.Clientset.BatchV1().CronJobs(namespace).List(ctx, metav1.ListOptions{LabelSelector: selector})
+		if err != nil {
+			return nil, fmt.Errorf("error listing cron jobs: %w", err)
+		}
+		for _, cronJob := range cronJobs.Items {
+			containers = append(containers, cronJob.Spec.JobTemplate.Spec.Template.Spec.Containers...)
+		}
+
+		// the app container is the first container of each pod; the others are sidecars with their own env
+		if len(containers) == 0 {
+			continue
+		}
+		container := containers[0]
+
+		for _, envFrom := range container.EnvFrom {
+			switch {
+			case envFrom.ConfigMapRef != nil:
+				cm, err := getConfigMap(envFrom.ConfigMapRef.Name)
+				if err != nil {
+					return nil, err
+				}
+				for key, val := range cm.Data {
+					env[envFrom.Prefix+key] = envValue{value: []byte(val)}
+				}
+			case envFrom.SecretRef != nil:
+				secret, err := getSecret(envFrom.SecretRef.Name)
+				if err != nil {
+					return nil, err
+				}
+				for key, val := range secret.Data {
+					env[envFrom.Prefix+key] = envValue{value: val, secret: true}
+				}
+			}
+		}
+
+		for _, envVar := range container.Env {
+			switch {
+			case envVar.ValueFrom == nil:
+				env[envVar.Name] = envValue{value: []byte(envVar.Value)}
+			case envVar.ValueFrom.ConfigMapKeyRef != nil:
+				cm, err := getConfigMap(envVar.ValueFrom.ConfigMapKeyRef.Name)
+				if err != nil {
+					return nil, err
+				}
+				env[envVar.Name] = envValue{value: []byte(cm.Data[envVar.ValueFrom.ConfigMapKeyRef.Key])}
+			case envVar.ValueFrom.SecretKeyRef != nil:
+				secret, err := getSecret(envVar.ValueFrom.SecretKeyRef.Name)
+				if err != nil {
+					return nil, err
+				}
+				env[envVar.Name] = envValue{value: secret.Data[envVar.ValueFrom.SecretKeyRef.Key], secret: true}
+			}
+		}
+	}
+
+	// the env of the porter.yaml is the source of truth for the variables it sets, even before they are deployed
+	for key, val := range app.Env {
+		env[key] = envValue{value: []byte(val)}
+	}
+
+	return env, nil
+}
+
+// diffEnv compares two envs, sorted by key. A variable is secret if it is a secret in either env.
+func diffEnv(base, compare map[string]envValue) []*EnvVariableDiff {
+	keys := make(map[string]bool)
+	for key := range base {
+		keys[key] = true
+	}
+	for key := range compare {
+		keys[key] = true
+	}
+
+	diffs := make([]*EnvVariableDiff, 0, len(keys))
+	for key := range keys {
+		baseVal, inBase := base[key]
+		compareVal, inCompare := compare[key]
+
+		diff := &EnvVariableDiff{
+			Key:    key,
+			Secret: baseVal.secret || compareVal.secret,
+		}
+
+		switch {
+		case !inBase:
+			diff.Status = EnvVariableDiffStatus_MissingInBase
+		case !inCompare:
+			diff.Status = EnvVariableDiffStatus_MissingInCompare
+		case bytes.Equal(baseVal.value, compareVal.value):
+			diff.Status = EnvVariableDiffStatus_Same
+		default:
+			diff.Status = EnvVariableDiffStatus_Different
+		}
+
+		if !diff.Secret {
+			diff.BaseValue = string(baseVal.value)
+			diff.CompareValue = string(compareVal.value)
+		}
+
+		diffs = append(diffs, diff)
+	}
+
+	sort.Slice(diffs, func(i, j int) bool {
+		return diffs[i].Key < diffs[j].Key
+	})
+
+	return diffs
+}
@@ -46,7 +46,7 @@ func (c *GetPorterAppHandler) ServeHTTP(w http.ResponseWriter, r *http.Request)
 	}
 	telemetry.WithAttributes(span, telemetry.AttributeKV{Key: "application-name", Value: appName})
 
-	app, err := c.Repo().PorterApp().ReadPorterAppByName(cluster.ID, appName)
+	app, err := c.Repo().PorterApp().ReadPorterAppByName(ctx, cluster.ID, appName)
 	if err != nil {
 		err = telemetry.Error(ctx, span, err, "error reading porter app by name")
 		c.HandleAPIError(w, r, apierrors.NewErrPassThroughToClient(err, http.StatusInternalServerError))
@@ -58,6 +58,9 @@ func (c *GetPorterAppHandler) ServeHTTP(w http.ResponseWriter, r *http.Request)
 		return
 	}
 
+	// the ETag is sent in the If-Match header of requests which apply the app
+	w.Header().Set(types.ETagHeader, types.AppConfigETag(app.ConfigRevision))
+
 	// this is a temporary fix until we figure out how to reconcile the new revisions table
 	// with dependencies on helm releases throuhg the api
 	if project.ValidateApplyV2 {
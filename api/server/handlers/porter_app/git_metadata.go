@@ -0,0 +1,93 @@
+package porter_app
+
+import (
+	"context"
+	"time"
+
+	porterv1 "github.com/porter-dev/api-contracts/generated/go/porter/v1"
+	"k8s.io/client-go/kubernetes"
+
+	"github.com/porter-dev/porter/api/server/shared/config"
+	porter_app_kube "github.com/porter-dev/porter/internal/kubernetes/porter_app"
+	"github.com/porter-dev/porter/internal/models"
+	"github.com/porter-dev/porter/internal/telemetry"
+)
+
+// gitMetadataStampTimeout is how long to wait for the workloads of new services to be created before giving up on stamping them
+const gitMetadataStampTimeout = 5 * time.Minute
+
+// gitMetadataInput is the deployed app whose workloads are stamped with the git metadata of its revision
+type gitMetadataInput struct {
+	Config             *config.Config
+	PorterApp          *models.PorterApp
+	Cluster            *models.Cluster
+	DeploymentTargetID string
+	Namespace          string
+	Clientset          kubernetes.Interface
+	GitMetadata        porter_app_kube.GitMetadata
+}
+
+// stampWorkloadGitMetadata sets the git metadata of a deployed revision on the workloads of each service of the app. Workloads of
+// new services may not exist yet when the revision is applied, so services are retried until they have workloads or the timeout
+// passes. This blocks, so it should be called in a separate goroutine.
+func stampWorkloadGitMetadata(ctx context.Context, input gitMetadataInput) {
+	ctx, span := telemetry.NewSpan(ctx, "stamp-workload-git-metadata")
+	defer span.End()
+
+	telemetry.WithAttributes(span,
+		telemetry.AttributeKV{Key: "project-id", Value: input.Cluster.ProjectID},
+		telemetry.AttributeKV{Key: "cluster-id", Value: input.Cluster.ID},
+		telemetry.AttributeKV{Key: "app-name", Value: input.PorterApp.Name},
+		telemetry.AttributeKV{Key: "deployment-target-id", Value: input.DeploymentTargetID},
+		telemetry.AttributeKV{Key: "commit-sha", Value: input.GitMetadata.CommitSHA},
+	)
+
+	revision, err := currentAppRevision(ctx, input.Config, input.Cluster.ProjectID, input.PorterApp.ID, input.DeploymentTargetID)
+	if err != nil {
+		_ = telemetry.Error(ctx, span, err, "error getting current app revision")
+		return
+	}
+
+	pending := make(map[string]bool)
+	for serviceName, service := range revision.GetApp().GetServices() {
+		if hasWorkload(service) {
+			pending[serviceName] = true
+		}
+	}
+
+	deadline := time.After(gitMetadataStampTimeout)
+	ticker := time.NewTicker(deployEventsPollInterval)
+	defer ticker.Stop()
+
+	for len(pending) > 0 {
+		for serviceName := range pending {
+			stamped, err := porter_app_kube.StampServiceWorkloads(ctx, input.Clientset, input.Namespace, input.PorterApp.Name, serviceName, input.GitMetadata)
+			if err != nil {
+				_ = telemetry.Error(ctx, span, err, "error stamping service workloads")
+				continue
+			}
+
+			if stamped > 0 {
+				delete(pending, serviceName)
+			}
+		}
+
+		if len(pending) == 0 {
+			return
+		}
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-deadline:
+			telemetry.WithAttributes(span, telemetry.AttributeKV{Key: "unstamped-services", Value: len(pending)})
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+// hasWorkload returns false for jobs without a schedule, which have no workload until they are run
+func hasWorkload(service *porterv1.Service) bool {
+	return service.GetType() != porterv1.ServiceType_SERVICE_TYPE_JOB || service.GetJobConfig().GetCron() != ""
+}
@@ -0,0 +1,90 @@
+package porter_app
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+
+	porterv1 "github.com/porter-dev/api-contracts/generated/go/porter/v1"
+	"gorm.io/gorm"
+
+	"github.com/porter-dev/porter/internal/attestation"
+	"github.com/porter-dev/porter/internal/models"
+	"github.com/porter-dev/porter/internal/repository"
+	"github.com/porter-dev/porter/internal/telemetry"
+)
+
+// errUnverifiedImage is returned when an app is deployed with an image without a verified attestation in a project which enforces
+// its image signing policy
+var errUnverifiedImage = errors.New("image signing policy of the project is enforced")
+
+// checkImageSigningPolicy checks that the images an app is deployed with have a build provenance attestation verified by the image
+// signing policy of the project, if the project enforces it. The newest attestation of each image is checked, since an image tag
+// can be pushed again. Attestations are checked against the image digest they were uploaded with; the digest the tag points to in
+// the registry is not resolved.
+//
+// A definition of an app which is built from source returns a build action rather than deploying, so its images are checked when
+// the revision is applied once they are built, using the commit SHA they are tagged with.
+func checkImageSigningPolicy(ctx context.Context, repo repository.Repository, projectID uint, appName string, app *porterv1.PorterApp, commitSHA string) error {
+	ctx, span := telemetry.NewSpan(ctx, "check-image-signing-policy")
+	defer span.End()
+
+	policy, err := repo.ImageSigningPolicy().ReadImageSigningPolicy(ctx, projectID)
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil
+		}
+		return telemetry.Error(ctx, span, err, "error reading image signing policy")
+	}
+
+	telemetry.WithAttributes(span, telemetry.AttributeKV{Key: "enforce", Value: policy.Enforce})
+
+	if !policy.Enforce {
+		return nil
+	}
+
+	var attestations []*models.ImageAttestation
+	var image string
+
+	switch {
+	case app == nil:
+		if commitSHA == "" {
+			return fmt.Errorf("%w: the commit SHA of the revision is required to verify its images", errUnverifiedImage)
+		}
+
+		attestations, err = repo.ImageAttestation().ListImageAttestationsByTag(ctx, projectID, appName, commitSHA)
+		image = fmt.Sprintf("the images of %s tagged %s", appName, commitSHA)
+	case app.Build != nil:
+		return nil
+	case app.Image != nil && app.Image.Repository != "" && app.Image.Tag != "":
+		repository := strings.TrimPrefix(app.Image.Repository, "https://")
+
+		attestations, err = repo.ImageAttestation().ListImageAttestationsByImage(ctx, projectID, repository, app.Image.Tag)
+		image = fmt.Sprintf("image %s:%s", repository, app.Image.Tag)
+	default:
+		return nil
+	}
+	if err != nil {
+		return telemetry.Error(ctx, span, err, "error listing image attestations")
+	}
+
+	if len(attestations) == 0 {
+		return fmt.Errorf("%w: no build provenance attestation was uploaded for %s", errUnverifiedImage, image)
+	}
+
+	checked := make(map[string]bool)
+	for _, att := range attestations {
+		// attestations are listed newest first, so only the newest attestation of each repository is checked
+		if checked[att.Repository] {
+			continue
+		}
+		checked[att.Repository] = true
+
+		if _, err := attestation.Verify(att.ToAttestation(), policy.ToAttestationPolicy(), att.Repository, att.Digest); err != nil {
+			return fmt.Errorf("%w: attestation of image %s:%s could not be verified: %s", errUnverifiedImage, att.Repository, att.Tag, err.Error())
+		}
+	}
+
+	return nil
+}
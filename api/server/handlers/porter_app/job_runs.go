@@ -0,0 +1,198 @@
+package porter_app
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"time"
+
+	"gorm.io/gorm"
+
+	"github.com/porter-dev/porter/api/server/handlers"
+	"github.com/porter-dev/porter/api/server/shared"
+	"github.com/porter-dev/porter/api/server/shared/apierrors"
+	"github.com/porter-dev/porter/api/server/shared/config"
+	"github.com/porter-dev/porter/api/server/shared/requestutils"
+	"github.com/porter-dev/porter/api/types"
+	"github.com/porter-dev/porter/internal/models"
+	"github.com/porter-dev/porter/internal/repository"
+	"github.com/porter-dev/porter/internal/telemetry"
+)
+
+// ListJobRunsHandler handles requests to the /apps/{porter_app_name}/jobs/{job_name}/runs endpoint
+type ListJobRunsHandler struct {
+	handlers.PorterHandlerReadWriter
+}
+
+// NewListJobRunsHandler returns a new ListJobRunsHandler
+func NewListJobRunsHandler(
+	config *config.Config,
+	decoderValidator shared.RequestDecoderValidator,
+	writer shared.ResultWriter,
+) *ListJobRunsHandler {
+	return &ListJobRunsHandler{
+		PorterHandlerReadWriter: handlers.NewDefaultPorterHandler(config, decoderValidator, writer),
+	}
+}
+
+const (
+	// defaultJobRunsLimit is the number of runs returned if the request does not set a limit
+	defaultJobRunsLimit = 50
+	// maxJobRunsLimit is the maximum number of runs that can be requested at once
+	maxJobRunsLimit = 500
+)
+
+// ServeHTTP lists the historical runs of a job service of an app, most recent first
+func (c *ListJobRunsHandler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	ctx, span := telemetry.NewSpan(r.Context(), "serve-list-job-runs")
+	defer span.End()
+
+	cluster, _ := ctx.Value(types.ClusterScope).(*models.Cluster)
+
+	appName, reqErr := requestutils.GetURLParamString(r, types.URLParamPorterAppName)
+	if reqErr != nil {
+		err := telemetry.Error(ctx, span, reqErr, "error parsing app name from url")
+		c.HandleAPIError(w, r, apierrors.NewErrPassThroughToClient(err, http.StatusBadRequest))
+		return
+	}
+
+	jobName, reqErr := requestutils.GetURLParamString(r, types.URLParamJobServiceName)
+	if reqErr != nil {
+		err := telemetry.Error(ctx, span, reqErr, "error parsing job name from url")
+		c.HandleAPIError(w, r, apierrors.NewErrPassThroughToClient(err, http.StatusBadRequest))
+		return
+	}
+
+	telemetry.WithAttributes(span,
+		telemetry.AttributeKV{Key: "cluster-id", Value: cluster.ID},
+		telemetry.AttributeKV{Key: "app-name", Value: appName},
+		telemetry.AttributeKV{Key: "job-name", Value: jobName},
+	)
+
+	request := &types.ListJobRunsRequest{}
+	if ok := c.DecodeAndValidate(w, r, request); !ok {
+		err := telemetry.Error(ctx, span, nil, "error decoding request")
+		c.HandleAPIError(w, r, apierrors.NewErrPassThroughToClient(err, http.StatusBadRequest))
+		return
+	}
+
+	limit := request.Limit
+	if limit <= 0 {
+		limit = defaultJobRunsLimit
+	}
+	if limit > maxJobRunsLimit {
+		limit = maxJobRunsLimit
+	}
+
+	app, err := c.Repo().PorterApp().ReadPorterAppByName(ctx, cluster.ID, appName)
+	if err != nil || app == nil || app.ID == 0 {
+		err := telemetry.Error(ctx, span, err, "error reading porter app")
+		c.HandleAPIError(w, r, apierrors.NewErrPassThroughToClient(err, http.StatusNotFound))
+		return
+	}
+
+	runs, err := c.Repo().JobRun().ListJobRuns(ctx, app.ID, jobName, request.Since, limit)
+	if err != nil {
+		err := telemetry.Error(ctx, span, err, "error listing job runs")
+		c.HandleAPIError(w, r, apierrors.NewErrInternal(err))
+		return
+	}
+
+	res := make(types.ListJobRunsResponse, 0, len(runs))
+	for _, run := range runs {
+		res = append(res, run.ToJobRunType())
+	}
+
+	c.WriteResult(w, r, res)
+}
+
+// recordJobRun creates or updates the job run described by a JOB_RUN event reported by the event agent.
+// Runs are identified by their kubernetes job, so repeated reports for the same run update a single record.
+func recordJobRun(ctx context.Context, repo repository.Repository, cluster models.Cluster, app *models.PorterApp, status string, metadata map[string]any) error {
+	ctx, span := telemetry.NewSpan(ctx, "record-job-run")
+	defer span.End()
+
+	jobName := metadataString(metadata, types.JobRunMetadata_JobName)
+	serviceName := metadataString(metadata, types.JobRunMetadata_ServiceName)
+	if jobName == "" || serviceName == "" {
+		return telemetry.Error(ctx, span, nil, "job run event is missing the job or service name")
+	}
+
+	namespace := metadataString(metadata, types.JobRunMetadata_Namespace)
+
+	telemetry.WithAttributes(span,
+		telemetry.AttributeKV{Key: "job-name", Value: jobName},
+		telemetry.AttributeKV{Key: "service-name", Value: serviceName},
+		telemetry.AttributeKV{Key: "namespace", Value: namespace},
+	)
+
+	run, err := repo.JobRun().ReadJobRunByJobName(ctx, cluster.ID, namespace, jobName)
+	if err != nil && !errors.Is(err, gorm.ErrRecordNotFound) {
+		return telemetry.Error(ctx, span, err, "error reading job run")
+	}
+
+	isNew := run == nil
+	if isNew {
+		run = &models.JobRun{
+			ProjectID:   cluster.ProjectID,
+			ClusterID:   cluster.ID,
+			PorterAppID: app.ID,
+			ServiceName: serviceName,
+			Namespace:   namespace,
+			JobName:     jobName,
+			StartedAt:   time.Now().UTC(),
+		}
+	}
+
+	if status != "" {
+		run.Status = status
+	}
+	if podName := metadataString(metadata, types.JobRunMetadata_PodName); podName != "" {
+		run.PodName = podName
+	}
+	if triggeredBy := metadataString(metadata, types.JobRunMetadata_TriggeredBy); triggeredBy != "" {
+		run.TriggeredBy = triggeredBy
+	}
+	if startTime, ok := metadataTime(metadata, types.JobRunMetadata_StartTime); ok {
+		run.StartedAt = startTime
+	}
+	if endTime, ok := metadataTime(metadata, types.JobRunMetadata_EndTime); ok {
+		run.FinishedAt = &endTime
+	}
+	if exitCode, ok := metadata[types.JobRunMetadata_ExitCode].(float64); ok {
+		code := int(exitCode)
+		run.ExitCode = &code
+	}
+
+	if isNew {
+		_, err = repo.JobRun().CreateJobRun(ctx, run)
+	} else {
+		_, err = repo.JobRun().UpdateJobRun(ctx, run)
+	}
+	if err != nil {
+		return telemetry.Error(ctx, span, err, "error saving job run")
+	}
+
+	return nil
+}
+
+// metadataString returns the string value of a metadata key, or an empty string if it is not a string
+func metadataString(metadata map[string]any, key string) string {
+	val, _ := metadata[key].(string)
+	return val
+}
+
+// metadataTime returns the value of a metadata key parsed as an RFC3339 timestamp
+func metadataTime(metadata map[string]any, key string) (time.Time, bool) {
+	val := metadataString(metadata, key)
+	if val == "" {
+		return time.Time{}, false
+	}
+
+	t, err := time.Parse(time.RFC3339, val)
+	if err != nil {
+		return time.Time{}, false
+	}
+
+	return t.UTC(), true
+}
@@ -1,6 +1,7 @@
 package porter_app
 
 import (
+	"context"
 	"net/http"
 
 	"github.com/porter-dev/porter/api/server/handlers"
@@ -9,6 +10,7 @@ import (
 	"github.com/porter-dev/porter/api/server/shared/config"
 	"github.com/porter-dev/porter/api/types"
 	"github.com/porter-dev/porter/internal/models"
+	"github.com/porter-dev/porter/internal/repository"
 )
 
 type PorterAppListHandler struct {
@@ -28,17 +30,27 @@ func (p *PorterAppListHandler) ServeHTTP(w http.ResponseWriter, r *http.Request)
 	ctx := r.Context()
 	cluster, _ := ctx.Value(types.ClusterScope).(*models.Cluster)
 
-	porterApps, err := p.Repo().PorterApp().ListPorterAppByClusterID(cluster.ID)
+	res, err := ListApps(ctx, p.Repo(), cluster)
 	if err != nil {
 		p.HandleAPIError(w, r, apierrors.NewErrInternal(err))
 		return
 	}
 
+	p.WriteResult(w, r, res)
+}
+
+// ListApps returns the apps of a cluster
+func ListApps(ctx context.Context, repo repository.Repository, cluster *models.Cluster) (types.ListPorterAppResponse, error) {
+	porterApps, err := repo.PorterApp().ListPorterAppByClusterID(ctx, cluster.ID)
+	if err != nil {
+		return nil, err
+	}
+
 	res := make(types.ListPorterAppResponse, 0)
 
 	for _, porterApp := range porterApps {
 		res = append(res, porterApp.ToPorterAppType())
 	}
 
-	p.WriteResult(w, r, res)
+	return res, nil
 }
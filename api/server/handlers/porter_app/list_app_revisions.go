@@ -59,7 +59,7 @@ func (c *ListAppRevisionsHandler) ServeHTTP(w http.ResponseWriter, r *http.Reque
 	}
 	telemetry.WithAttributes(span, telemetry.AttributeKV{Key: "application-name", Value: appName})
 
-	app, err := c.Repo().PorterApp().ReadPorterAppByName(cluster.ID, appName)
+	app, err := c.Repo().PorterApp().ReadPorterAppByName(ctx, cluster.ID, appName)
 	if err != nil {
 		err = telemetry.Error(ctx, span, err, "error reading porter app by name")
 		c.HandleAPIError(w, r, apierrors.NewErrPassThroughToClient(err, http.StatusInternalServerError))
@@ -115,6 +115,21 @@ func (c *ListAppRevisionsHandler) ServeHTTP(w http.ResponseWriter, r *http.Reque
 		appRevisions = []*porterv1.AppRevision{}
 	}
 
+	metadata, err := c.Repo().AppRevisionMetadata().ListAppRevisionMetadata(ctx, app.ID, request.DeploymentTargetID)
+	if err != nil {
+		err := telemetry.Error(ctx, span, err, "error listing app revision metadata")
+		c.HandleAPIError(w, r, apierrors.NewErrPassThroughToClient(err, http.StatusInternalServerError))
+		return
+	}
+
+	metadataByRevisionNumber := make(map[uint64]*models.AppRevisionMetadata)
+	for _, m := range metadata {
+		// metadata is listed newest first, so the most recent record of a revision number wins
+		if _, ok := metadataByRevisionNumber[m.RevisionNumber]; !ok {
+			metadataByRevisionNumber[m.RevisionNumber] = m
+		}
+	}
+
 	res := &ListAppRevisionsResponse{
 		AppRevisions: make([]porter_app.Revision, 0),
 	}
@@ -127,6 +142,13 @@ func (c *ListAppRevisionsHandler) ServeHTTP(w http.ResponseWriter, r *http.Reque
 			return
 		}
 
+		if m, ok := metadataByRevisionNumber[encodedRevision.RevisionNumber]; ok {
+			encodedRevision.DeployedBy = m.DeployedBy
+			encodedRevision.CommitSHA = m.CommitSHA
+			encodedRevision.GitBranch = m.GitBranch
+			encodedRevision.GitRepoURL = m.GitRepoURL
+		}
+
 		res.AppRevisions = append(res.AppRevisions, encodedRevision)
 	}
 
@@ -61,7 +61,7 @@ func (p *PorterAppEventListHandler) ServeHTTP(w http.ResponseWriter, r *http.Req
 		return
 	}
 
-	app, err := p.Repo().PorterApp().ReadPorterAppByName(cluster.ID, appName)
+	app, err := p.Repo().PorterApp().ReadPorterAppByName(ctx, cluster.ID, appName)
 	if err != nil {
 		p.HandleAPIError(w, r, apierrors.NewErrInternal(err))
 		return
@@ -193,7 +193,7 @@ func (p *PorterAppEventListHandler) updateBuildEvent_Github(
 	}
 
 	// read the environment to get the environment id
-	env, err := p.Repo().GithubAppInstallation().ReadGithubAppInstallationByAccountID(int64(githubAccountID))
+	env, err := p.Repo().GithubAppInstallation().ReadGithubAppInstallationByAccountID(ctx, int64(githubAccountID))
 	if err != nil {
 		return telemetry.Error(ctx, span, err, "error reading github environment by owner repo name")
 	}
@@ -273,7 +273,7 @@ func buildUmbrellaChartValues(
 			return nil, fmt.Errorf("error syncing environment group to namespace: %w", err)
 		}
 
-		err = createSubdomainIfRequired(helm_values, opts) // modifies helm_values to add subdomains if necessary
+		err = createSubdomainIfRequired(ctx, helm_values, opts) // modifies helm_values to add subdomains if necessary
 		if err != nil {
 			return nil, err
 		}
@@ -660,6 +660,7 @@ func CopyEnv(env map[string]string) map[string]interface{} {
 }
 
 func createSubdomainIfRequired(
+	ctx context.Context,
 	mergedValues map[string]interface{},
 	opts SubdomainCreateOpts,
 ) error {
@@ -685,7 +686,7 @@ func createSubdomainIfRequired(
 				}
 
 				// in the case of ingress enabled but no custom domain, create subdomain
-				dnsRecord, err := createDNSRecord(opts)
+				dnsRecord, err := createDNSRecord(ctx, opts)
 				if err != nil {
 					return fmt.Errorf("error creating subdomain: %s", err.Error())
 				}
@@ -712,7 +713,7 @@ func createSubdomainIfRequired(
 	return nil
 }
 
-func createDNSRecord(opts SubdomainCreateOpts) (*types.DNSRecord, error) {
+func createDNSRecord(ctx context.Context, opts SubdomainCreateOpts) (*types.DNSRecord, error) {
 	if opts.powerDnsClient == nil {
 		return nil, fmt.Errorf("cannot create subdomain because powerdns client is nil")
 	}
@@ -733,7 +734,7 @@ func createDNSRecord(opts SubdomainCreateOpts) (*types.DNSRecord, error) {
 
 	record := createDomain.NewDNSRecordForEndpoint()
 
-	record, err = opts.dnsRepo.CreateDNSRecord(record)
+	record, err = opts.dnsRepo.CreateDNSRecord(ctx, record)
 
 	if err != nil {
 		return nil, err
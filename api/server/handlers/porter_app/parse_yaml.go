@@ -7,6 +7,7 @@ import (
 	"github.com/porter-dev/api-contracts/generated/go/helpers"
 
 	"github.com/porter-dev/porter/internal/porter_app"
+	v2 "github.com/porter-dev/porter/internal/porter_app/v2"
 
 	"github.com/porter-dev/porter/internal/telemetry"
 
@@ -42,6 +43,8 @@ type ParsePorterYAMLToProtoRequest struct {
 // ParsePorterYAMLToProtoResponse is the response object for the /apps/parse endpoint
 type ParsePorterYAMLToProtoResponse struct {
 	B64AppProto string `json:"b64_app_proto"`
+	// B64PreviewAppProto is the app proto with the previews overrides applied, if the yaml has a previews section
+	B64PreviewAppProto string `json:"b64_preview_app_proto,omitempty"`
 }
 
 // ServeHTTP receives a base64-encoded porter.yaml, parses the version, and then translates it into a base64-encoded app proto object
@@ -82,7 +85,12 @@ func (c *ParsePorterYAMLToProtoHandler) ServeHTTP(w http.ResponseWriter, r *http
 		return
 	}
 
-	appProto, err := porter_app.ParseYAML(ctx, yaml)
+	parseOpts := []v2.ParseOption{
+		v2.WithStrictParsing(c.Config().ServerConf.PorterYAMLStrictParsing),
+		porter_app.ProjectServiceDefaults(project),
+	}
+
+	appProto, err := porter_app.ParseYAML(ctx, yaml, parseOpts...)
 	if err != nil {
 		err := telemetry.Error(ctx, span, err, "error parsing yaml")
 		c.HandleAPIError(w, r, apierrors.NewErrPassThroughToClient(err, http.StatusBadRequest))
@@ -107,5 +115,23 @@ func (c *ParsePorterYAMLToProtoHandler) ServeHTTP(w http.ResponseWriter, r *http
 		B64AppProto: b64,
 	}
 
+	previewProto, err := porter_app.ParsePreviewYAML(ctx, yaml, parseOpts...)
+	if err != nil {
+		err := telemetry.Error(ctx, span, err, "error parsing preview yaml")
+		c.HandleAPIError(w, r, apierrors.NewErrPassThroughToClient(err, http.StatusBadRequest))
+		return
+	}
+
+	if previewProto != nil {
+		by, err := helpers.MarshalContractObject(ctx, previewProto)
+		if err != nil {
+			err := telemetry.Error(ctx, span, err, "error marshalling preview app proto")
+			c.HandleAPIError(w, r, apierrors.NewErrPassThroughToClient(err, http.StatusInternalServerError))
+			return
+		}
+
+		response.B64PreviewAppProto = base64.StdEncoding.EncodeToString(by)
+	}
+
 	c.WriteResult(w, r, response)
 }
@@ -0,0 +1,94 @@
+package porter_app
+
+import (
+	"net/http"
+
+	"github.com/porter-dev/porter/internal/porter_app"
+	"github.com/porter-dev/porter/internal/telemetry"
+
+	"github.com/porter-dev/porter/api/server/authz"
+	"github.com/porter-dev/porter/api/server/handlers"
+	"github.com/porter-dev/porter/api/server/shared"
+	"github.com/porter-dev/porter/api/server/shared/apierrors"
+	"github.com/porter-dev/porter/api/server/shared/config"
+	"github.com/porter-dev/porter/api/types"
+	"github.com/porter-dev/porter/internal/models"
+)
+
+// PreviewDeploymentTargetHandler handles requests to the /preview-deployment-target endpoint
+type PreviewDeploymentTargetHandler struct {
+	handlers.PorterHandlerReadWriter
+	authz.KubernetesAgentGetter
+}
+
+// NewPreviewDeploymentTargetHandler returns a new PreviewDeploymentTargetHandler
+func NewPreviewDeploymentTargetHandler(
+	config *config.Config,
+	decoderValidator shared.RequestDecoderValidator,
+	writer shared.ResultWriter,
+) *PreviewDeploymentTargetHandler {
+	return &PreviewDeploymentTargetHandler{
+		PorterHandlerReadWriter: handlers.NewDefaultPorterHandler(config, decoderValidator, writer),
+		KubernetesAgentGetter:   authz.NewOutOfClusterAgentGetter(config),
+	}
+}
+
+// PreviewDeploymentTargetRequest is the request object for the /preview-deployment-target endpoint
+type PreviewDeploymentTargetRequest struct {
+	// Name is the name of the preview environment, usually derived from a branch or pull request. It is used as the namespace of the target.
+	Name string `json:"name" form:"required"`
+}
+
+// PreviewDeploymentTargetResponse is the response object for the /preview-deployment-target endpoint
+type PreviewDeploymentTargetResponse struct {
+	DeploymentTargetID string `json:"deployment_target_id"`
+	Namespace          string `json:"namespace"`
+}
+
+// ServeHTTP gets or creates an ephemeral deployment target for a preview environment, along with its namespace
+func (c *PreviewDeploymentTargetHandler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	ctx, span := telemetry.NewSpan(r.Context(), "serve-preview-deployment-target")
+	defer span.End()
+
+	project, _ := ctx.Value(types.ProjectScope).(*models.Project)
+	cluster, _ := ctx.Value(types.ClusterScope).(*models.Cluster)
+
+	telemetry.WithAttributes(span,
+		telemetry.AttributeKV{Key: "project-id", Value: project.ID},
+		telemetry.AttributeKV{Key: "cluster-id", Value: cluster.ID},
+	)
+
+	if !project.ValidateApplyV2 {
+		err := telemetry.Error(ctx, span, nil, "project does not have apply v2 enabled")
+		c.HandleAPIError(w, r, apierrors.NewErrForbidden(err))
+		return
+	}
+
+	request := &PreviewDeploymentTargetRequest{}
+	if ok := c.DecodeAndValidate(w, r, request); !ok {
+		err := telemetry.Error(ctx, span, nil, "error decoding request")
+		c.HandleAPIError(w, r, apierrors.NewErrPassThroughToClient(err, http.StatusBadRequest))
+		return
+	}
+
+	agent, err := c.GetAgent(r, cluster, "")
+	if err != nil {
+		err := telemetry.Error(ctx, span, err, "error getting kubernetes agent")
+		c.HandleAPIError(w, r, apierrors.NewErrPassThroughToClient(err, http.StatusInternalServerError))
+		return
+	}
+
+	deploymentTarget, err := porter_app.GetOrCreatePreviewDeploymentTarget(ctx, c.Repo(), agent, project.ID, cluster.ID, request.Name)
+	if err != nil {
+		err := telemetry.Error(ctx, span, err, "error getting preview deployment target")
+		c.HandleAPIError(w, r, apierrors.NewErrPassThroughToClient(err, http.StatusBadRequest))
+		return
+	}
+
+	response := &PreviewDeploymentTargetResponse{
+		DeploymentTargetID: deploymentTarget.ID.String(),
+		Namespace:          request.Name,
+	}
+
+	c.WriteResult(w, r, response)
+}
@@ -0,0 +1,354 @@
+package porter_app
+
+import (
+	"bufio"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/porter-dev/porter/api/server/authz"
+	"github.com/porter-dev/porter/api/server/handlers"
+	"github.com/porter-dev/porter/api/server/shared"
+	"github.com/porter-dev/porter/api/server/shared/apierrors"
+	"github.com/porter-dev/porter/api/server/shared/config"
+	"github.com/porter-dev/porter/api/types"
+	porter_app_kube "github.com/porter-dev/porter/internal/kubernetes/porter_app"
+	"github.com/porter-dev/porter/internal/models"
+	"github.com/porter-dev/porter/internal/registry"
+	"github.com/porter-dev/porter/internal/telemetry"
+)
+
+const (
+	// maxRemoteBuildContextBytes is the largest build context accepted for a remote build
+	maxRemoteBuildContextBytes = 1 << 30
+	// remoteBuildTimeout is how long the build runner of a remote build can run for
+	remoteBuildTimeout = 30 * time.Minute
+	// remoteBuildStartTimeout is how long to wait for the build runner to start, which can include scaling up the cluster
+	remoteBuildStartTimeout = 5 * time.Minute
+)
+
+// RemoteBuildRequest holds the settings of a remote build. It is the "build" part of the multipart body of the
+// /apps/{porter_app_name}/remote-builds endpoint, which must come before the "context" part with the build context as a gzipped tar.
+type RemoteBuildRequest struct {
+	// ServiceName is the name of the service for services with their own build
+	ServiceName string `json:"service_name,omitempty"`
+	Repository  string `json:"repository"`
+	Tag         string `json:"tag"`
+	// Dockerfile is the path of the Dockerfile in the build context
+	Dockerfile string            `json:"dockerfile,omitempty"`
+	BuildArgs  map[string]string `json:"build_args,omitempty"`
+	// Platform is the platform to build for, such as linux/arm64, defaulting to the architecture of the node the build runs on
+	Platform string `json:"platform,omitempty"`
+}
+
+// RemoteBuildEventType is the type of a server-sent event of the /apps/{porter_app_name}/remote-builds endpoint
+type RemoteBuildEventType string
+
+const (
+	// RemoteBuildEventType_Log events hold a line of the output of the build
+	RemoteBuildEventType_Log RemoteBuildEventType = "log"
+	// RemoteBuildEventType_Done is the last event of the stream, sent when the build completes or fails
+	RemoteBuildEventType_Done RemoteBuildEventType = "done"
+)
+
+// RemoteBuildStatus is the outcome of a remote build
+type RemoteBuildStatus string
+
+const (
+	// RemoteBuildStatus_Succeeded means the image was built and pushed
+	RemoteBuildStatus_Succeeded RemoteBuildStatus = "succeeded"
+	// RemoteBuildStatus_Failed means the image could not be built or pushed
+	RemoteBuildStatus_Failed RemoteBuildStatus = "failed"
+)
+
+// RemoteBuildLogEvent is the data of a log event
+type RemoteBuildLogEvent struct {
+	Line string `json:"line"`
+}
+
+// RemoteBuildDoneEvent is the data of the done event
+type RemoteBuildDoneEvent struct {
+	Status  RemoteBuildStatus `json:"status"`
+	Message string            `json:"message,omitempty"`
+}
+
+// RemoteBuildHandler handles POST requests to the /apps/{porter_app_name}/remote-builds endpoint
+type RemoteBuildHandler struct {
+	handlers.PorterHandlerWriter
+	authz.KubernetesAgentGetter
+}
+
+// NewRemoteBuildHandler returns a new RemoteBuildHandler
+func NewRemoteBuildHandler(
+	config *config.Config,
+	writer shared.ResultWriter,
+) *RemoteBuildHandler {
+	return &RemoteBuildHandler{
+		PorterHandlerWriter:   handlers.NewDefaultPorterHandler(config, nil, writer),
+		KubernetesAgentGetter: authz.NewOutOfClusterAgentGetter(config),
+	}
+}
+
+// ServeHTTP builds an image of an app in the cluster of the app, from a build context uploaded by the CLI. The build runner
+// pushes the image to a registry of the project. Once the build context is uploaded, the output of the build is streamed as
+// server-sent events until the build completes.
+func (c *RemoteBuildHandler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	ctx, span := telemetry.NewSpan(r.Context(), "serve-remote-build")
+	defer span.End()
+
+	project, _ := ctx.Value(types.ProjectScope).(*models.Project)
+	cluster, _ := ctx.Value(types.ClusterScope).(*models.Cluster)
+
+	telemetry.WithAttributes(span,
+		telemetry.AttributeKV{Key: "project-id", Value: project.ID},
+		telemetry.AttributeKV{Key: "cluster-id", Value: cluster.ID},
+	)
+
+	porterApp, status, err := porterAppFromURL(ctx, c.Repo(), r)
+	if err != nil {
+		err := telemetry.Error(ctx, span, err, "error reading porter app")
+		c.HandleAPIError(w, r, apierrors.NewErrPassThroughToClient(err, status))
+		return
+	}
+
+	// the upload and the build outlive the server timeouts, so the deadlines are extended for this request
+	rc := http.NewResponseController(w)
+	deadline := time.Now().Add(remoteBuildStartTimeout + remoteBuildTimeout + time.Minute)
+	if err := rc.SetReadDeadline(deadline); err != nil && !errors.Is(err, http.ErrNotSupported) {
+		err := telemetry.Error(ctx, span, err, "error extending read deadline")
+		c.HandleAPIError(w, r, apierrors.NewErrPassThroughToClient(err, http.StatusInternalServerError))
+		return
+	}
+	if err := rc.SetWriteDeadline(deadline); err != nil && !errors.Is(err, http.ErrNotSupported) {
+		err := telemetry.Error(ctx, span, err, "error extending write deadline")
+		c.HandleAPIError(w, r, apierrors.NewErrPassThroughToClient(err, http.StatusInternalServerError))
+		return
+	}
+
+	r.Body = http.MaxBytesReader(w, r.Body, maxRemoteBuildContextBytes)
+
+	multipartReader, err := r.MultipartReader()
+	if err != nil {
+		err := telemetry.Error(ctx, span, err, "error reading multipart body")
+		c.HandleAPIError(w, r, apierrors.NewErrPassThroughToClient(err, http.StatusBadRequest))
+		return
+	}
+
+	buildPart, err := multipartReader.NextPart()
+	if err != nil || buildPart.FormName() != "build" {
+		err := telemetry.Error(ctx, span, err, "first part of body must be the build settings")
+		c.HandleAPIError(w, r, apierrors.NewErrPassThroughToClient(err, http.StatusBadRequest))
+		return
+	}
+
+	request := &RemoteBuildRequest{}
+	if err := json.NewDecoder(buildPart).Decode(request); err != nil {
+		err := telemetry.Error(ctx, span, err, "error decoding build settings")
+		c.HandleAPIError(w, r, apierrors.NewErrPassThroughToClient(err, http.StatusBadRequest))
+		return
+	}
+
+	telemetry.WithAttributes(span,
+		telemetry.AttributeKV{Key: "app-name", Value: porterApp.Name},
+		telemetry.AttributeKV{Key: "service-name", Value: request.ServiceName},
+		telemetry.AttributeKV{Key: "repository", Value: request.Repository},
+		telemetry.AttributeKV{Key: "tag", Value: request.Tag},
+		telemetry.AttributeKV{Key: "platform", Value: request.Platform},
+	)
+
+	if request.Repository == "" || request.Tag == "" {
+		err := telemetry.Error(ctx, span, nil, "repository and tag are required")
+		c.HandleAPIError(w, r, apierrors.NewErrPassThroughToClient(err, http.StatusBadRequest))
+		return
+	}
+	if request.Platform != "" && !strings.HasPrefix(request.Platform, "linux/") {
+		err := telemetry.Error(ctx, span, nil, fmt.Sprintf("platform %s is not supported: remote builds only build linux images", request.Platform))
+		c.HandleAPIError(w, r, apierrors.NewErrPassThroughToClient(err, http.StatusBadRequest))
+		return
+	}
+
+	repository := strings.TrimPrefix(request.Repository, "https://")
+
+	reg, err := projectRegistryForRepository(ctx, c.Config(), project.ID, repository)
+	if err != nil {
+		err := telemetry.Error(ctx, span, err, "error finding registry of repository")
+		c.HandleAPIError(w, r, apierrors.NewErrPassThroughToClient(err, http.StatusBadRequest))
+		return
+	}
+
+	projectRegistry := registry.Registry(*reg)
+	dockerConfig, err := projectRegistry.GetDockerConfigJSON(ctx, c.Repo(), c.Config().DOConf)
+	if err != nil {
+		err := telemetry.Error(ctx, span, err, "error getting registry credentials")
+		c.HandleAPIError(w, r, apierrors.NewErrPassThroughToClient(err, http.StatusInternalServerError))
+		return
+	}
+
+	agent, err := c.GetAgent(r, cluster, "")
+	if err != nil {
+		err := telemetry.Error(ctx, span, err, "error getting kubernetes agent")
+		c.HandleAPIError(w, r, apierrors.NewErrPassThroughToClient(err, http.StatusInternalServerError))
+		return
+	}
+
+	restConf, err := agent.RESTClientGetter.ToRESTConfig()
+	if err != nil {
+		err := telemetry.Error(ctx, span, err, "error getting kubernetes rest config")
+		c.HandleAPIError(w, r, apierrors.NewErrPassThroughToClient(err, http.StatusInternalServerError))
+		return
+	}
+
+	contextPart, err := multipartReader.NextPart()
+	if err != nil || contextPart.FormName() != "context" {
+		err := telemetry.Error(ctx, span, err, "second part of body must be the build context")
+		c.HandleAPIError(w, r, apierrors.NewErrPassThroughToClient(err, http.StatusBadRequest))
+		return
+	}
+
+	build, err := porter_app_kube.StartRemoteBuild(ctx, agent.Clientset, porter_app_kube.RemoteBuildOptions{
+		AppName:          porterApp.Name,
+		ServiceName:      request.ServiceName,
+		Destination:      fmt.Sprintf("%s:%s", repository, request.Tag),
+		Dockerfile:       request.Dockerfile,
+		BuildArgs:        request.BuildArgs,
+		Platform:         request.Platform,
+		DockerConfigJSON: dockerConfig,
+		Timeout:          remoteBuildTimeout,
+	})
+	if build != nil {
+		// the build runner is deleted even if the request is cancelled
+		defer func() {
+			cleanupCtx, cancel := context.WithTimeout(context.Background(), time.Minute)
+			defer cancel()
+
+			if err := build.Delete(cleanupCtx); err != nil {
+				_ = telemetry.Error(ctx, span, err, "error deleting build runner")
+			}
+		}()
+	}
+	if err != nil {
+		err := telemetry.Error(ctx, span, err, "error starting build runner")
+		c.HandleAPIError(w, r, apierrors.NewErrPassThroughToClient(err, http.StatusInternalServerError))
+		return
+	}
+
+	telemetry.WithAttributes(span, telemetry.AttributeKV{Key: "build-pod", Value: build.Pod.Name})
+
+	startCtx, cancelStart := context.WithTimeout(ctx, remoteBuildStartTimeout)
+	err = build.WaitForRunning(startCtx)
+	cancelStart()
+	if err != nil {
+		err := telemetry.Error(ctx, span, err, "error starting build runner")
+		c.HandleAPIError(w, r, apierrors.NewErrPassThroughToClient(err, http.StatusInternalServerError))
+		return
+	}
+
+	// the attach stream stays open until the build runner exits, so the upload is complete once the build context has been read
+	uploadCtx, cancelUpload := context.WithCancel(ctx)
+	defer cancelUpload()
+
+	uploaded := make(chan struct{})
+	uploadErr := make(chan error, 1)
+	go func() {
+		uploadErr <- build.UploadContext(uploadCtx, restConf, &notifyingReader{Reader: contextPart, done: uploaded})
+	}()
+
+	select {
+	case <-uploaded:
+	case err := <-uploadErr:
+		if err == nil {
+			err = errors.New("build runner exited before the build context was uploaded")
+		}
+		err = telemetry.Error(ctx, span, err, "error uploading build context")
+		c.HandleAPIError(w, r, apierrors.NewErrPassThroughToClient(err, http.StatusBadRequest))
+		return
+	}
+
+	w.Header().Set("Content-Type", "text/event-stream")
+	w.Header().Set("Cache-Control", "no-cache")
+	w.Header().Set("Connection", "keep-alive")
+	w.Header().Set("X-Accel-Buffering", "no")
+	w.WriteHeader(http.StatusOK)
+
+	send := func(eventType RemoteBuildEventType, data interface{}) error {
+		dataBytes, err := json.Marshal(data)
+		if err != nil {
+			return err
+		}
+
+		if _, err := fmt.Fprintf(w, "event: %s\ndata: %s\n\n", eventType, dataBytes); err != nil {
+			return err
+		}
+
+		return rc.Flush()
+	}
+
+	logReader, logWriter := io.Pipe()
+	go func() {
+		logWriter.CloseWithError(build.StreamLogs(ctx, logWriter)) // nolint:errcheck
+	}()
+
+	scanner := bufio.NewScanner(logReader)
+	scanner.Buffer(make([]byte, 0, 64*1024), 1024*1024)
+	for scanner.Scan() {
+		if err := send(RemoteBuildEventType_Log, RemoteBuildLogEvent{Line: scanner.Text()}); err != nil {
+			_ = telemetry.Error(ctx, span, err, "error sending build log")
+			logReader.Close() // nolint:errcheck,gosec
+			return
+		}
+	}
+	if err := scanner.Err(); err != nil {
+		_ = telemetry.Error(ctx, span, err, "error reading build logs")
+	}
+
+	waitCtx, cancelWait := context.WithTimeout(ctx, remoteBuildTimeout)
+	defer cancelWait()
+
+	if err := build.Wait(waitCtx); err != nil {
+		_ = telemetry.Error(ctx, span, err, "remote build failed")
+		_ = send(RemoteBuildEventType_Done, RemoteBuildDoneEvent{Status: RemoteBuildStatus_Failed, Message: err.Error()}) // nolint:errcheck
+		return
+	}
+
+	_ = send(RemoteBuildEventType_Done, RemoteBuildDoneEvent{Status: RemoteBuildStatus_Succeeded}) // nolint:errcheck
+}
+
+// projectRegistryForRepository returns the registry of a project which an image repository belongs to
+func projectRegistryForRepository(ctx context.Context, config *config.Config, projectID uint, repository string) (*models.Registry, error) {
+	registries, err := config.Repo.Registry().ListRegistriesByProjectID(ctx, projectID)
+	if err != nil {
+		return nil, fmt.Errorf("error listing registries: %w", err)
+	}
+
+	for _, reg := range registries {
+		url := strings.TrimSuffix(strings.TrimPrefix(reg.URL, "https://"), "/")
+		if url != "" && (repository == url || strings.HasPrefix(repository, url+"/")) {
+			return reg, nil
+		}
+	}
+
+	return nil, fmt.Errorf("repository %s is not in a registry connected to the project", repository)
+}
+
+// notifyingReader closes done once the underlying reader has been read to the end
+type notifyingReader struct {
+	io.Reader
+	done   chan struct{}
+	closed bool
+}
+
+// Read reads from the underlying reader, closing done when it returns io.EOF
+func (n *notifyingReader) Read(p []byte) (int, error) {
+	read, err := n.Reader.Read(p)
+	if errors.Is(err, io.EOF) && !n.closed {
+		n.closed = true
+		close(n.done)
+	}
+
+	return read, err
+}
@@ -0,0 +1,234 @@
+package porter_app
+
+import (
+	"fmt"
+	"math"
+	"net/http"
+	"regexp"
+	"sort"
+	"time"
+
+	"connectrpc.com/connect"
+	porterv1 "github.com/porter-dev/api-contracts/generated/go/porter/v1"
+
+	"github.com/porter-dev/porter/api/server/authz"
+	"github.com/porter-dev/porter/api/server/handlers"
+	"github.com/porter-dev/porter/api/server/shared"
+	"github.com/porter-dev/porter/api/server/shared/apierrors"
+	"github.com/porter-dev/porter/api/server/shared/config"
+	"github.com/porter-dev/porter/api/server/shared/requestutils"
+	"github.com/porter-dev/porter/api/types"
+	"github.com/porter-dev/porter/internal/kubernetes/prometheus"
+	"github.com/porter-dev/porter/internal/models"
+	"github.com/porter-dev/porter/internal/telemetry"
+)
+
+// ResourceRecommendationsHandler handles requests to the /apps/{porter_app_name}/resource-recommendations endpoint
+type ResourceRecommendationsHandler struct {
+	handlers.PorterHandlerReadWriter
+	authz.KubernetesAgentGetter
+}
+
+// NewResourceRecommendationsHandler returns a new ResourceRecommendationsHandler
+func NewResourceRecommendationsHandler(
+	config *config.Config,
+	decoderValidator shared.RequestDecoderValidator,
+	writer shared.ResultWriter,
+) *ResourceRecommendationsHandler {
+	return &ResourceRecommendationsHandler{
+		PorterHandlerReadWriter: handlers.NewDefaultPorterHandler(config, decoderValidator, writer),
+		KubernetesAgentGetter:   authz.NewOutOfClusterAgentGetter(config),
+	}
+}
+
+// ResourceRecommendationsRequest is the request object for the /apps/{porter_app_name}/resource-recommendations endpoint
+type ResourceRecommendationsRequest struct {
+	DeploymentTargetID string `schema:"deployment_target_id"`
+	// WindowHours is how far back usage is analyzed, defaulting to 7 days
+	WindowHours int `schema:"window_hours"`
+}
+
+// ResourceRecommendationsResponse is the response object for the /apps/{porter_app_name}/resource-recommendations endpoint
+type ResourceRecommendationsResponse struct {
+	WindowHours int                              `json:"window_hours"`
+	Services    []*ServiceResourceRecommendation `json:"services"`
+}
+
+// ServiceResourceRecommendation is the observed usage and the recommended resources of a single service
+type ServiceResourceRecommendation struct {
+	Name                string  `json:"name"`
+	CurrentCpuCores     float32 `json:"current_cpu_cores"`
+	CurrentRamMegabytes int32   `json:"current_ram_megabytes"`
+	// HasUsage is false if there were no usage samples for the service, in which case no recommendation is made
+	HasUsage bool `json:"has_usage"`
+	// CpuCoresP95 is the 95th percentile of the CPU usage of the busiest instance
+	CpuCoresP95 float64 `json:"cpu_cores_p95"`
+	// PeakRamMegabytes is the peak memory usage of the busiest instance
+	PeakRamMegabytes        float64 `json:"peak_ram_megabytes"`
+	RecommendedCpuCores     float32 `json:"recommended_cpu_cores"`
+	RecommendedRamMegabytes int32   `json:"recommended_ram_megabytes"`
+}
+
+const (
+	// defaultRecommendationWindowHours is how far back usage is analyzed if the request does not set a window
+	defaultRecommendationWindowHours = 24 * 7
+	// maxRecommendationWindowHours is the longest window that can be analyzed, bounded by the retention of the cluster prometheus
+	maxRecommendationWindowHours = 24 * 30
+	// recommendationHeadroom is the factor applied to observed usage to leave room for spikes
+	recommendationHeadroom = 1.2
+	// minRecommendedCpuCores and minRecommendedRamMegabytes are the smallest resources recommended for any service
+	minRecommendedCpuCores     = 0.1
+	minRecommendedRamMegabytes = 128
+)
+
+// ServeHTTP analyzes the recent CPU and memory usage of each service of an app on a deployment target, and recommends cpuCores and
+// ramMegabytes values for the porter.yaml of the app
+func (c *ResourceRecommendationsHandler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	ctx, span := telemetry.NewSpan(r.Context(), "serve-resource-recommendations")
+	defer span.End()
+
+	project, _ := ctx.Value(types.ProjectScope).(*models.Project)
+	cluster, _ := ctx.Value(types.ClusterScope).(*models.Cluster)
+
+	telemetry.WithAttributes(span,
+		telemetry.AttributeKV{Key: "project-id", Value: project.ID},
+		telemetry.AttributeKV{Key: "cluster-id", Value: cluster.ID},
+	)
+
+	appName, reqErr := requestutils.GetURLParamString(r, types.URLParamPorterAppName)
+	if reqErr != nil {
+		err := telemetry.Error(ctx, span, reqErr, "error parsing app name from url")
+		c.HandleAPIError(w, r, apierrors.NewErrPassThroughToClient(err, http.StatusBadRequest))
+		return
+	}
+
+	request := &ResourceRecommendationsRequest{}
+	if ok := c.DecodeAndValidate(w, r, request); !ok {
+		err := telemetry.Error(ctx, span, nil, "error decoding request")
+		c.HandleAPIError(w, r, apierrors.NewErrPassThroughToClient(err, http.StatusBadRequest))
+		return
+	}
+
+	windowHours := request.WindowHours
+	if windowHours <= 0 {
+		windowHours = defaultRecommendationWindowHours
+	}
+	if windowHours > maxRecommendationWindowHours {
+		windowHours = maxRecommendationWindowHours
+	}
+
+	telemetry.WithAttributes(span,
+		telemetry.AttributeKV{Key: "app-name", Value: appName},
+		telemetry.AttributeKV{Key: "deployment-target-id", Value: request.DeploymentTargetID},
+		telemetry.AttributeKV{Key: "window-hours", Value: windowHours},
+	)
+
+	deploymentTarget, err := c.Repo().DeploymentTarget().DeploymentTargetByID(ctx, project.ID, request.DeploymentTargetID)
+	if err != nil {
+		err := telemetry.Error(ctx, span, err, "error reading deployment target")
+		c.HandleAPIError(w, r, apierrors.NewErrPassThroughToClient(err, http.StatusBadRequest))
+		return
+	}
+
+	if deploymentTarget.SelectorType != DeploymentTargetSelectorType_Default {
+		err := telemetry.Error(ctx, span, nil, "resource recommendations are only supported for namespace deployment targets")
+		c.HandleAPIError(w, r, apierrors.NewErrPassThroughToClient(err, http.StatusBadRequest))
+		return
+	}
+	namespace := deploymentTarget.Selector
+
+	porterApp, err := c.Repo().PorterApp().ReadPorterAppByName(ctx, cluster.ID, appName)
+	if err != nil || porterApp == nil || porterApp.ID == 0 {
+		err := telemetry.Error(ctx, span, err, "error reading porter app")
+		c.HandleAPIError(w, r, apierrors.NewErrPassThroughToClient(err, http.StatusNotFound))
+		return
+	}
+
+	revisionResp, err := c.Config().ClusterControlPlaneClient.CurrentAppRevision(ctx, connect.NewRequest(&porterv1.CurrentAppRevisionRequest{
+		ProjectId:          int64(project.ID),
+		AppId:              int64(porterApp.ID),
+		DeploymentTargetId: deploymentTarget.ID.String(),
+	}))
+	if err != nil || revisionResp == nil || revisionResp.Msg == nil || revisionResp.Msg.AppRevision.GetApp() == nil {
+		err := telemetry.Error(ctx, span, err, "error getting current app revision from cluster control plane client")
+		c.HandleAPIError(w, r, apierrors.NewErrPassThroughToClient(err, http.StatusBadRequest))
+		return
+	}
+
+	agent, err := c.GetAgent(r, cluster, "")
+	if err != nil {
+		err := telemetry.Error(ctx, span, err, "error getting kubernetes agent")
+		c.HandleAPIError(w, r, apierrors.NewErrPassThroughToClient(err, http.StatusInternalServerError))
+		return
+	}
+
+	promSvc, found, err := prometheus.GetPrometheusService(agent.Clientset)
+	if err != nil || !found {
+		err := telemetry.Error(ctx, span, err, "error getting prometheus service")
+		c.HandleAPIError(w, r, apierrors.NewErrPassThroughToClient(err, http.StatusPreconditionFailed))
+		return
+	}
+
+	response := &ResourceRecommendationsResponse{
+		WindowHours: windowHours,
+		Services:    []*ServiceResourceRecommendation{},
+	}
+
+	for serviceName, service := range revisionResp.Msg.AppRevision.GetApp().Services {
+		opts := &prometheus.UsageOpts{
+			Namespace: namespace,
+			PodRegex:  fmt.Sprintf("%s-.*", regexp.QuoteMeta(fmt.Sprintf("%s-%s", appName, serviceName))),
+			Window:    time.Duration(windowHours) * time.Hour,
+		}
+
+		recommendation := &ServiceResourceRecommendation{
+			Name:                serviceName,
+			CurrentCpuCores:     service.CpuCores,
+			CurrentRamMegabytes: service.RamMegabytes,
+		}
+
+		cpu, cpuFound, err := prometheus.GetCPUUsageQuantile(agent.Clientset, promSvc, opts, 0.95)
+		if err != nil {
+			telemetry.WithAttributes(span, telemetry.AttributeKV{Key: "service-name", Value: serviceName})
+			err := telemetry.Error(ctx, span, err, "error querying cpu usage")
+			c.HandleAPIError(w, r, apierrors.NewErrPassThroughToClient(err, http.StatusInternalServerError))
+			return
+		}
+
+		memory, memoryFound, err := prometheus.GetPeakMemoryUsage(agent.Clientset, promSvc, opts)
+		if err != nil {
+			telemetry.WithAttributes(span, telemetry.AttributeKV{Key: "service-name", Value: serviceName})
+			err := telemetry.Error(ctx, span, err, "error querying memory usage")
+			c.HandleAPIError(w, r, apierrors.NewErrPassThroughToClient(err, http.StatusInternalServerError))
+			return
+		}
+
+		if cpuFound && memoryFound {
+			recommendation.HasUsage = true
+			recommendation.CpuCoresP95 = cpu
+			recommendation.PeakRamMegabytes = memory / (1024 * 1024)
+			recommendation.RecommendedCpuCores = recommendCpuCores(cpu)
+			recommendation.RecommendedRamMegabytes = recommendRamMegabytes(recommendation.PeakRamMegabytes)
+		}
+
+		response.Services = append(response.Services, recommendation)
+	}
+
+	sort.Slice(response.Services, func(i, j int) bool {
+		return response.Services[i].Name < response.Services[j].Name
+	})
+
+	c.WriteResult(w, r, response)
+}
+
+// recommendCpuCores adds headroom to the observed CPU usage, rounded up to the nearest 0.05 cores
+func recommendCpuCores(usage float64) float32 {
+	cores := math.Ceil(usage*recommendationHeadroom*20) / 20
+	return float32(math.Max(cores, minRecommendedCpuCores))
+}
+
+// recommendRamMegabytes adds headroom to the observed peak memory usage, rounded up to the nearest 64MB
+func recommendRamMegabytes(peak float64) int32 {
+	megabytes := math.Ceil(peak*recommendationHeadroom/64) * 64
+	return int32(math.Max(megabytes, minRecommendedRamMegabytes))
+}
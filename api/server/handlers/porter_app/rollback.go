@@ -96,7 +96,7 @@ func (c *RollbackPorterAppHandler) ServeHTTP(w http.ResponseWriter, r *http.Requ
 		imageInfo.Tag = "latest"
 	}
 
-	porterApp, err := c.Repo().PorterApp().ReadPorterAppByName(cluster.ID, appName)
+	porterApp, err := c.Repo().PorterApp().ReadPorterAppByName(ctx, cluster.ID, appName)
 	if err != nil {
 		err = telemetry.Error(ctx, span, err, "error getting porter app")
 		c.HandleAPIError(w, r, apierrors.NewErrPassThroughToClient(err, http.StatusInternalServerError))
@@ -105,7 +105,7 @@ func (c *RollbackPorterAppHandler) ServeHTTP(w http.ResponseWriter, r *http.Requ
 	injectLauncher := strings.Contains(porterApp.Builder, "heroku") ||
 		strings.Contains(porterApp.Builder, "paketo")
 
-	registries, err := c.Repo().Registry().ListRegistriesByProjectID(cluster.ProjectID)
+	registries, err := c.Repo().Registry().ListRegistriesByProjectID(ctx, cluster.ProjectID)
 	if err != nil {
 		err = telemetry.Error(ctx, span, err, "error listing registries")
 		c.HandleAPIError(w, r, apierrors.NewErrPassThroughToClient(err, http.StatusInternalServerError))
@@ -58,7 +58,7 @@ func (c *RunPorterAppCommandHandler) ServeHTTP(w http.ResponseWriter, r *http.Re
 	namespace := utils.NamespaceFromPorterAppName(appName)
 	telemetry.WithAttributes(span, telemetry.AttributeKV{Key: "application-name", Value: appName})
 
-	app, err := c.Repo().PorterApp().ReadPorterAppByName(cluster.ID, appName)
+	app, err := c.Repo().PorterApp().ReadPorterAppByName(ctx, cluster.ID, appName)
 	if err != nil {
 		err = telemetry.Error(ctx, span, err, "error reading app from DB")
 		c.HandleAPIError(w, r, apierrors.NewErrPassThroughToClient(err, http.StatusInternalServerError))
@@ -0,0 +1,316 @@
+package porter_app
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"sort"
+	"strings"
+	"time"
+
+	batchv1 "k8s.io/api/batch/v1"
+	v1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"github.com/porter-dev/porter/api/server/authz"
+	"github.com/porter-dev/porter/api/server/handlers"
+	"github.com/porter-dev/porter/api/server/shared"
+	"github.com/porter-dev/porter/api/server/shared/apierrors"
+	"github.com/porter-dev/porter/api/server/shared/config"
+	"github.com/porter-dev/porter/api/server/shared/requestutils"
+	"github.com/porter-dev/porter/api/types"
+	"github.com/porter-dev/porter/internal/kubernetes"
+	"github.com/porter-dev/porter/internal/models"
+	"github.com/porter-dev/porter/internal/telemetry"
+)
+
+// RunJobHandler handles requests to the /apps/{porter_app_name}/jobs/{job_name}/run endpoint
+type RunJobHandler struct {
+	handlers.PorterHandlerReadWriter
+	authz.KubernetesAgentGetter
+}
+
+// NewRunJobHandler returns a new RunJobHandler
+func NewRunJobHandler(
+	config *config.Config,
+	decoderValidator shared.RequestDecoderValidator,
+	writer shared.ResultWriter,
+) *RunJobHandler {
+	return &RunJobHandler{
+		PorterHandlerReadWriter: handlers.NewDefaultPorterHandler(config, decoderValidator, writer),
+		KubernetesAgentGetter:   authz.NewOutOfClusterAgentGetter(config),
+	}
+}
+
+// RunJobRequest is the request object for the /apps/{porter_app_name}/jobs/{job_name}/run endpoint
+type RunJobRequest struct {
+	DeploymentTargetID string `json:"deployment_target_id"`
+	// EnvOverrides are set on the job container for this run only, replacing variables of the same name
+	EnvOverrides map[string]string `json:"env_overrides"`
+	// Command replaces the run command of the job for this run only
+	Command string `json:"command"`
+}
+
+// RunJobResponse is the response object for the /apps/{porter_app_name}/jobs/{job_name}/run endpoint
+type RunJobResponse struct {
+	// JobRunName is the name of the kubernetes job created for the run
+	JobRunName string `json:"job_run_name"`
+	Namespace  string `json:"namespace"`
+}
+
+const (
+	// triggeredByAnnotation records who triggered a manual job run
+	triggeredByAnnotation = "porter.run/triggered-by"
+	// manualRunSuffix is appended to the release name of a job to name its manual runs
+	manualRunSuffix = "manual"
+	// maxJobNameLength leaves room within the 63 character limit on labels for the suffix added to the names of job pods
+	maxJobNameLength = 52
+)
+
+// ServeHTTP launches a one-off run of a job service of an app from the job template of its latest deploy
+func (c *RunJobHandler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	ctx, span := telemetry.NewSpan(r.Context(), "serve-run-job")
+	defer span.End()
+
+	user, _ := ctx.Value(types.UserScope).(*models.User)
+	project, _ := ctx.Value(types.ProjectScope).(*models.Project)
+	cluster, _ := ctx.Value(types.ClusterScope).(*models.Cluster)
+
+	telemetry.WithAttributes(span,
+		telemetry.AttributeKV{Key: "project-id", Value: project.ID},
+		telemetry.AttributeKV{Key: "cluster-id", Value: cluster.ID},
+	)
+
+	appName, reqErr := requestutils.GetURLParamString(r, types.URLParamPorterAppName)
+	if reqErr != nil {
+		err := telemetry.Error(ctx, span, reqErr, "error parsing app name from url")
+		c.HandleAPIError(w, r, apierrors.NewErrPassThroughToClient(err, http.StatusBadRequest))
+		return
+	}
+
+	jobName, reqErr := requestutils.GetURLParamString(r, types.URLParamJobServiceName)
+	if reqErr != nil {
+		err := telemetry.Error(ctx, span, reqErr, "error parsing job name from url")
+		c.HandleAPIError(w, r, apierrors.NewErrPassThroughToClient(err, http.StatusBadRequest))
+		return
+	}
+
+	request := &RunJobRequest{}
+	if ok := c.DecodeAndValidate(w, r, request); !ok {
+		err := telemetry.Error(ctx, span, nil, "error decoding request")
+		c.HandleAPIError(w, r, apierrors.NewErrPassThroughToClient(err, http.StatusBadRequest))
+		return
+	}
+
+	telemetry.WithAttributes(span,
+		telemetry.AttributeKV{Key: "app-name", Value: appName},
+		telemetry.AttributeKV{Key: "job-name", Value: jobName},
+		telemetry.AttributeKV{Key: "deployment-target-id", Value: request.DeploymentTargetID},
+		telemetry.AttributeKV{Key: "command-override", Value: request.Command != ""},
+		telemetry.AttributeKV{Key: "env-override-count", Value: len(request.EnvOverrides)},
+	)
+
+	deploymentTarget, err := c.Repo().DeploymentTarget().DeploymentTargetByID(ctx, project.ID, request.DeploymentTargetID)
+	if err != nil {
+		err := telemetry.Error(ctx, span, err, "error reading deployment target")
+		c.HandleAPIError(w, r, apierrors.NewErrPassThroughToClient(err, http.StatusBadRequest))
+		return
+	}
+
+	if deploymentTarget.SelectorType != DeploymentTargetSelectorType_Default {
+		err := telemetry.Error(ctx, span, nil, "running jobs is only supported for namespace deployment targets")
+		c.HandleAPIError(w, r, apierrors.NewErrPassThroughToClient(err, http.StatusBadRequest))
+		return
+	}
+	namespace := deploymentTarget.Selector
+
+	porterApp, err := c.Repo().PorterApp().ReadPorterAppByName(ctx, cluster.ID, appName)
+	if err != nil || porterApp == nil || porterApp.ID == 0 {
+		err := telemetry.Error(ctx, span, err, "error reading porter app")
+		c.HandleAPIError(w, r, apierrors.NewErrPassThroughToClient(err, http.StatusNotFound))
+		return
+	}
+
+	agent, err := c.GetAgent(r, cluster, namespace)
+	if err != nil {
+		err := telemetry.Error(ctx, span, err, "error getting kubernetes agent")
+		c.HandleAPIError(w, r, apierrors.NewErrPassThroughToClient(err, http.StatusInternalServerError))
+		return
+	}
+
+	releaseName := fmt.Sprintf("%s-%s", appName, jobName)
+
+	template, err := jobTemplateForRelease(ctx, agent, namespace, releaseName)
+	if err != nil {
+		err := telemetry.Error(ctx, span, err, "error getting job template")
+		c.HandleAPIError(w, r, apierrors.NewErrPassThroughToClient(err, http.StatusInternalServerError))
+		return
+	}
+
+	if template == nil {
+		err := telemetry.Error(ctx, span, nil, "no deployed job found for job service")
+		c.HandleAPIError(w, r, apierrors.NewErrPassThroughToClient(err, http.StatusNotFound))
+		return
+	}
+
+	triggeredBy := "manual"
+	if user != nil && user.Email != "" {
+		triggeredBy = user.Email
+	}
+
+	job := manualJobFromTemplate(template, namespace, releaseName, triggeredBy)
+	overrideJobContainer(job, porterApp, request.EnvOverrides, request.Command)
+
+	job, err = agent.Clientset.BatchV1().Jobs(namespace).Create(ctx, job, metav1.CreateOptions{})
+	if err != nil {
+		err := telemetry.Error(ctx, span, err, "error creating job")
+		c.HandleAPIError(w, r, apierrors.NewErrPassThroughToClient(err, http.StatusInternalServerError))
+		return
+	}
+
+	telemetry.WithAttributes(span, telemetry.AttributeKV{Key: "job-run-name", Value: job.Name})
+
+	// the run is recorded here so that it is attributed to the user who triggered it; the events reported by the
+	// event agent for the job update the same record
+	_, err = c.Repo().JobRun().CreateJobRun(ctx, &models.JobRun{
+		ProjectID:   project.ID,
+		ClusterID:   cluster.ID,
+		PorterAppID: porterApp.ID,
+		ServiceName: jobName,
+		Namespace:   namespace,
+		JobName:     job.Name,
+		Status:      string(types.PorterAppEventStatus_Progressing),
+		TriggeredBy: triggeredBy,
+		StartedAt:   time.Now().UTC(),
+	})
+	if err != nil {
+		// the job is already running, so a failure to record it should not fail the request
+		_ = telemetry.Error(ctx, span, err, "error recording job run")
+	}
+
+	c.WriteResult(w, r, &RunJobResponse{
+		JobRunName: job.Name,
+		Namespace:  namespace,
+	})
+}
+
+// jobTemplateForRelease returns the job template of the cron job of a job release or, for jobs without a schedule,
+// the template of the most recent job of the release. It returns nil if the release has no cron job or jobs.
+func jobTemplateForRelease(ctx context.Context, agent *kubernetes.Agent, namespace, releaseName string) (*batchv1.JobTemplateSpec, error) {
+	selector := fmt.Sprintf("app.kubernetes.io/instance=%s", releaseName)
+
+	cronJobs, err := agent.Clientset.BatchV1().CronJobs(namespace).List(ctx, metav1.ListOptions{LabelSelector: selector})
+	if err != nil {
+		return nil, fmt.Errorf("error listing cron jobs: %w", err)
+	}
+
+	if len(cronJobs.Items) > 0 {
+		return cronJobs.Items[0].Spec.JobTemplate.DeepCopy(), nil
+	}
+
+	jobs, err := agent.Clientset.BatchV1().Jobs(namespace).List(ctx, metav1.ListOptions{LabelSelector: selector})
+	if err != nil {
+		return nil, fmt.Errorf("error listing jobs: %w", err)
+	}
+
+	if len(jobs.Items) == 0 {
+		return nil, nil
+	}
+
+	sort.Slice(jobs.Items, func(i, j int) bool {
+		return jobs.Items[i].CreationTimestamp.After(jobs.Items[j].CreationTimestamp.Time)
+	})
+
+	latest := jobs.Items[0].DeepCopy()
+
+	// the selector and the labels generated for an existing job cannot be reused by a new job
+	latest.Spec.Selector = nil
+	latest.Spec.ManualSelector = nil
+	for _, labels := range []map[string]string{latest.Labels, latest.Spec.Template.Labels} {
+		delete(labels, "controller-uid")
+		delete(labels, "job-name")
+		delete(labels, "batch.kubernetes.io/controller-uid")
+		delete(labels, "batch.kubernetes.io/job-name")
+	}
+
+	return &batchv1.JobTemplateSpec{
+		ObjectMeta: metav1.ObjectMeta{
+			Labels:      latest.Labels,
+			Annotations: latest.Annotations,
+		},
+		Spec: latest.Spec,
+	}, nil
+}
+
+// manualJobFromTemplate returns a job for a manual run of a job release, in the same way that `kubectl create job --from` does
+func manualJobFromTemplate(template *batchv1.JobTemplateSpec, namespace, releaseName, triggeredBy string) *batchv1.Job {
+	annotations := map[string]string{}
+	for key, val := range template.Annotations {
+		annotations[key] = val
+	}
+	annotations["cronjob.kubernetes.io/instantiate"] = "manual"
+	annotations[triggeredByAnnotation] = triggeredBy
+
+	suffix := fmt.Sprintf("-%s-%d", manualRunSuffix, time.Now().Unix())
+	if len(releaseName)+len(suffix) > maxJobNameLength {
+		releaseName = strings.TrimRight(releaseName[:maxJobNameLength-len(suffix)], "-")
+	}
+	name := releaseName + suffix
+
+	return &batchv1.Job{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:        name,
+			Namespace:   namespace,
+			Labels:      template.Labels,
+			Annotations: annotations,
+		},
+		Spec: template.Spec,
+	}
+}
+
+// overrideJobContainer applies the env and command overrides of a manual run to the job container, which is the first container of the pod.
+// Other containers, such as the job sidecar, are left unchanged.
+func overrideJobContainer(job *batchv1.Job, app *models.PorterApp, envOverrides map[string]string, command string) {
+	if len(job.Spec.Template.Spec.Containers) == 0 {
+		return
+	}
+
+	container := &job.Spec.Template.Spec.Containers[0]
+
+	keys := make([]string, 0, len(envOverrides))
+	for key := range envOverrides {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+
+	for _, key := range keys {
+		overridden := false
+		for i := range container.Env {
+			if container.Env[i].Name == key {
+				container.Env[i] = v1.EnvVar{Name: key, Value: envOverrides[key]}
+				overridden = true
+			}
+		}
+
+		if !overridden {
+			container.Env = append(container.Env, v1.EnvVar{Name: key, Value: envOverrides[key]})
+		}
+	}
+
+	if command == "" {
+		return
+	}
+
+	execArgs := strings.Fields(command)
+	if app.Builder != "" &&
+		(strings.Contains(app.Builder, "heroku") ||
+			strings.Contains(app.Builder, "paketo")) &&
+		execArgs[0] != "/cnb/lifecycle/launcher" &&
+		execArgs[0] != "launcher" {
+		// this is a buildpacks image, so the command is run through the launcher to set up its environment
+		execArgs = append([]string{"/cnb/lifecycle/launcher"}, execArgs...)
+	}
+
+	container.Command = execArgs
+	container.Args = nil
+}
@@ -0,0 +1,82 @@
+package porter_app
+
+import (
+	"net/http"
+
+	"github.com/porter-dev/porter/api/server/handlers"
+	"github.com/porter-dev/porter/api/server/shared"
+	"github.com/porter-dev/porter/api/server/shared/apierrors"
+	"github.com/porter-dev/porter/api/server/shared/config"
+	"github.com/porter-dev/porter/api/types"
+	"github.com/porter-dev/porter/internal/models"
+	"github.com/porter-dev/porter/internal/telemetry"
+)
+
+// UpdateAutoRollbackHandler handles requests to the /apps/{porter_app_name}/auto-rollback endpoint
+type UpdateAutoRollbackHandler struct {
+	handlers.PorterHandlerReadWriter
+}
+
+// NewUpdateAutoRollbackHandler returns a new UpdateAutoRollbackHandler
+func NewUpdateAutoRollbackHandler(
+	config *config.Config,
+	decoderValidator shared.RequestDecoderValidator,
+	writer shared.ResultWriter,
+) *UpdateAutoRollbackHandler {
+	return &UpdateAutoRollbackHandler{
+		PorterHandlerReadWriter: handlers.NewDefaultPorterHandler(config, decoderValidator, writer),
+	}
+}
+
+// UpdateAutoRollbackRequest is the request object for the /apps/{porter_app_name}/auto-rollback endpoint
+type UpdateAutoRollbackRequest struct {
+	Enabled bool `json:"enabled"`
+	// TimeoutSeconds is how long a rollout can take before it is rolled back. Zero uses the default timeout.
+	TimeoutSeconds int `json:"timeout_seconds" form:"min=0,max=3600"`
+}
+
+// ServeHTTP updates whether failed rollouts of the app are automatically rolled back
+func (c *UpdateAutoRollbackHandler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	ctx, span := telemetry.NewSpan(r.Context(), "serve-update-auto-rollback")
+	defer span.End()
+
+	project, _ := ctx.Value(types.ProjectScope).(*models.Project)
+	cluster, _ := ctx.Value(types.ClusterScope).(*models.Cluster)
+
+	telemetry.WithAttributes(span,
+		telemetry.AttributeKV{Key: "project-id", Value: project.ID},
+		telemetry.AttributeKV{Key: "cluster-id", Value: cluster.ID},
+	)
+
+	porterApp, status, err := porterAppFromURL(ctx, c.Repo(), r)
+	if err != nil {
+		err := telemetry.Error(ctx, span, err, "error reading porter app")
+		c.HandleAPIError(w, r, apierrors.NewErrPassThroughToClient(err, status))
+		return
+	}
+
+	request := &UpdateAutoRollbackRequest{}
+	if ok := c.DecodeAndValidate(w, r, request); !ok {
+		err := telemetry.Error(ctx, span, nil, "error decoding request")
+		c.HandleAPIError(w, r, apierrors.NewErrPassThroughToClient(err, http.StatusBadRequest))
+		return
+	}
+
+	telemetry.WithAttributes(span,
+		telemetry.AttributeKV{Key: "app-name", Value: porterApp.Name},
+		telemetry.AttributeKV{Key: "auto-rollback", Value: request.Enabled},
+		telemetry.AttributeKV{Key: "auto-rollback-timeout-seconds", Value: request.TimeoutSeconds},
+	)
+
+	porterApp.AutoRollback = request.Enabled
+	porterApp.AutoRollbackTimeoutSeconds = request.TimeoutSeconds
+
+	porterApp, err = c.Repo().PorterApp().UpdatePorterApp(ctx, porterApp)
+	if err != nil {
+		err := telemetry.Error(ctx, span, err, "error updating porter app")
+		c.HandleAPIError(w, r, apierrors.NewErrPassThroughToClient(err, http.StatusInternalServerError))
+		return
+	}
+
+	c.WriteResult(w, r, porterApp.ToPorterAppType())
+}
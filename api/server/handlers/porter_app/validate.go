@@ -1,7 +1,9 @@
 package porter_app
 
 import (
+	"context"
 	"encoding/base64"
+	"errors"
 	"net/http"
 
 	"connectrpc.com/connect"
@@ -17,7 +19,9 @@ import (
 	"github.com/porter-dev/porter/api/server/shared/apierrors"
 	"github.com/porter-dev/porter/api/server/shared/config"
 	"github.com/porter-dev/porter/api/types"
+	"github.com/porter-dev/porter/internal/cost"
 	"github.com/porter-dev/porter/internal/models"
+	"github.com/porter-dev/porter/internal/quota"
 )
 
 // ValidatePorterAppHandler is handles requests to the /apps/validate endpoint
@@ -46,6 +50,8 @@ type ValidatePorterAppRequest struct {
 // ValidatePorterAppResponse is the response object for the /apps/validate endpoint
 type ValidatePorterAppResponse struct {
 	ValidatedBase64AppProto string `json:"validate_b64_app_proto"`
+	// CostEstimate is the estimated monthly cost of the validated app, if the nodes of the cluster and their prices are known
+	CostEstimate *cost.Estimate `json:"cost_estimate,omitempty"`
 }
 
 // ServeHTTP translates requests into protobuf objects and forwards them to the cluster control plane, returning the result
@@ -61,12 +67,6 @@ func (c *ValidatePorterAppHandler) ServeHTTP(w http.ResponseWriter, r *http.Requ
 		telemetry.AttributeKV{Key: "cluster-id", Value: cluster.ID},
 	)
 
-	if !project.ValidateApplyV2 {
-		err := telemetry.Error(ctx, span, nil, "project does not have validate apply v2 enabled")
-		c.HandleAPIError(w, r, apierrors.NewErrForbidden(err))
-		return
-	}
-
 	request := &ValidatePorterAppRequest{}
 	if ok := c.DecodeAndValidate(w, r, request); !ok {
 		err := telemetry.Error(ctx, span, nil, "error decoding request")
@@ -74,81 +74,134 @@ func (c *ValidatePorterAppHandler) ServeHTTP(w http.ResponseWriter, r *http.Requ
 		return
 	}
 
-	if request.Base64AppProto == "" {
-		err := telemetry.Error(ctx, span, nil, "b64 yaml is empty")
-		c.HandleAPIError(w, r, apierrors.NewErrPassThroughToClient(err, http.StatusBadRequest))
+	response, err := ValidateApp(ctx, c.Config(), ValidateAppInput{
+		Project:            project,
+		Cluster:            cluster,
+		Base64AppProto:     request.Base64AppProto,
+		DeploymentTargetID: request.DeploymentTargetId,
+		CommitSHA:          request.CommitSHA,
+	})
+	if err != nil {
+		handlers.HandleServiceError(c.Config(), w, r, err)
 		return
 	}
 
-	decoded, err := base64.StdEncoding.DecodeString(request.Base64AppProto)
+	c.WriteResult(w, r, response)
+}
+
+// ValidateAppInput is the app definition validated by ValidateApp
+type ValidateAppInput struct {
+	Project            *models.Project
+	Base64AppProto     string
+	DeploymentTargetID string
+	CommitSHA          string
+	// Cluster is the cluster that the app is deployed to. If set, the cost of the validated app is estimated.
+	Cluster *models.Cluster
+}
+
+// ValidateApp checks an app definition against the project quotas, then validates it with the cluster control plane. It returns
+// an apierrors.RequestError, or a *quota.ExceededError if the app exceeds a quota of the project.
+func ValidateApp(ctx context.Context, config *config.Config, input ValidateAppInput) (*ValidatePorterAppResponse, error) {
+	ctx, span := telemetry.NewSpan(ctx, "validate-app")
+	defer span.End()
+
+	project := input.Project
+
+	if !project.ValidateApplyV2 {
+		err := telemetry.Error(ctx, span, nil, "project does not have validate apply v2 enabled")
+		return nil, apierrors.NewErrForbidden(err)
+	}
+
+	if input.Base64AppProto == "" {
+		err := telemetry.Error(ctx, span, nil, "b64 yaml is empty")
+		return nil, apierrors.NewErrPassThroughToClient(err, http.StatusBadRequest)
+	}
+
+	decoded, err := base64.StdEncoding.DecodeString(input.Base64AppProto)
 	if err != nil {
 		err := telemetry.Error(ctx, span, err, "error decoding base  yaml")
-		c.HandleAPIError(w, r, apierrors.NewErrPassThroughToClient(err, http.StatusBadRequest))
-		return
+		return nil, apierrors.NewErrPassThroughToClient(err, http.StatusBadRequest)
 	}
 
 	appProto := &porterv1.PorterApp{}
 	err = helpers.UnmarshalContractObject(decoded, appProto)
 	if err != nil {
 		err := telemetry.Error(ctx, span, err, "error unmarshalling app proto")
-		c.HandleAPIError(w, r, apierrors.NewErrPassThroughToClient(err, http.StatusBadRequest))
-		return
+		return nil, apierrors.NewErrPassThroughToClient(err, http.StatusBadRequest)
 	}
 
 	if appProto.Name == "" {
 		err := telemetry.Error(ctx, span, err, "app proto name is empty")
-		c.HandleAPIError(w, r, apierrors.NewErrPassThroughToClient(err, http.StatusBadRequest))
-		return
+		return nil, apierrors.NewErrPassThroughToClient(err, http.StatusBadRequest)
 	}
 
 	telemetry.WithAttributes(span,
 		telemetry.AttributeKV{Key: "app-name", Value: appProto.Name},
-		telemetry.AttributeKV{Key: "deployment-target-id", Value: request.DeploymentTargetId},
-		telemetry.AttributeKV{Key: "commit-sha", Value: request.CommitSHA},
+		telemetry.AttributeKV{Key: "deployment-target-id", Value: input.DeploymentTargetID},
+		telemetry.AttributeKV{Key: "commit-sha", Value: input.CommitSHA},
 	)
 
+	err = quota.CheckAppQuota(ctx, config.Repo, quota.CheckAppQuotaInput{
+		Project:            project,
+		App:                appProto,
+		DeploymentTargetID: input.DeploymentTargetID,
+	})
+	if err != nil {
+		var quotaErr *quota.ExceededError
+		if errors.As(err, &quotaErr) {
+			_ = telemetry.Error(ctx, span, err, "app exceeds project quota")
+			return nil, quotaErr
+		}
+
+		err := telemetry.Error(ctx, span, err, "error checking project quotas")
+		return nil, apierrors.NewErrPassThroughToClient(err, http.StatusInternalServerError)
+	}
+
 	validateReq := connect.NewRequest(&porterv1.ValidatePorterAppRequest{
 		ProjectId:          int64(project.ID),
-		DeploymentTargetId: request.DeploymentTargetId,
-		CommitSha:          request.CommitSHA,
+		DeploymentTargetId: input.DeploymentTargetID,
+		CommitSha:          input.CommitSHA,
 		App:                appProto,
 	})
-	ccpResp, err := c.Config().ClusterControlPlaneClient.ValidatePorterApp(ctx, validateReq)
+	ccpResp, err := config.ClusterControlPlaneClient.ValidatePorterApp(ctx, validateReq)
 	if err != nil {
 		err := telemetry.Error(ctx, span, err, "error calling ccp validate porter app")
-		c.HandleAPIError(w, r, apierrors.NewErrPassThroughToClient(err, http.StatusInternalServerError))
-		return
+		return nil, apierrors.NewErrPassThroughToClient(err, http.StatusInternalServerError)
 	}
 
 	if ccpResp == nil {
 		err := telemetry.Error(ctx, span, err, "ccp resp is nil")
-		c.HandleAPIError(w, r, apierrors.NewErrPassThroughToClient(err, http.StatusInternalServerError))
-		return
+		return nil, apierrors.NewErrPassThroughToClient(err, http.StatusInternalServerError)
 	}
 	if ccpResp.Msg == nil {
 		err := telemetry.Error(ctx, span, err, "ccp resp msg is nil")
-		c.HandleAPIError(w, r, apierrors.NewErrPassThroughToClient(err, http.StatusInternalServerError))
-		return
+		return nil, apierrors.NewErrPassThroughToClient(err, http.StatusInternalServerError)
 	}
 
 	if ccpResp.Msg.App == nil {
 		err := telemetry.Error(ctx, span, err, "ccp resp app is nil")
-		c.HandleAPIError(w, r, apierrors.NewErrPassThroughToClient(err, http.StatusInternalServerError))
-		return
+		return nil, apierrors.NewErrPassThroughToClient(err, http.StatusInternalServerError)
 	}
 
 	encoded, err := helpers.MarshalContractObject(ctx, ccpResp.Msg.App)
 	if err != nil {
 		err := telemetry.Error(ctx, span, err, "error marshalling app proto back to json")
-		c.HandleAPIError(w, r, apierrors.NewErrPassThroughToClient(err, http.StatusInternalServerError))
-		return
+		return nil, apierrors.NewErrPassThroughToClient(err, http.StatusInternalServerError)
 	}
 
-	b64 := base64.StdEncoding.EncodeToString(encoded)
-
 	response := &ValidatePorterAppResponse{
-		ValidatedBase64AppProto: b64,
+		ValidatedBase64AppProto: base64.StdEncoding.EncodeToString(encoded),
 	}
 
-	c.WriteResult(w, r, response)
+	// the cost estimate is informational, so failing to estimate the cost does not fail validation
+	if input.Cluster != nil {
+		estimate, err := EstimateAppCost(ctx, config, input.Cluster, ccpResp.Msg.App)
+		if err != nil {
+			telemetry.WithAttributes(span, telemetry.AttributeKV{Key: "cost-estimate-error", Value: err.Error()})
+		}
+
+		response.CostEstimate = estimate
+	}
+
+	return response, nil
 }
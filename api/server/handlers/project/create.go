@@ -1,6 +1,7 @@
 package project
 
 import (
+	"context"
 	"net/http"
 
 	"github.com/porter-dev/porter/api/server/handlers"
@@ -49,7 +50,7 @@ func (p *ProjectCreateHandler) ServeHTTP(w http.ResponseWriter, r *http.Request)
 	}
 
 	var err error
-	proj, _, err = CreateProjectWithUser(p.Repo().Project(), proj, user)
+	proj, _, err = CreateProjectWithUser(r.Context(), p.Repo().Project(), proj, user)
 
 	if err != nil {
 		p.HandleAPIError(w, r, apierrors.NewErrInternal(err))
@@ -57,7 +58,7 @@ func (p *ProjectCreateHandler) ServeHTTP(w http.ResponseWriter, r *http.Request)
 	}
 
 	// create onboarding flow set to the first step
-	_, err = p.Repo().Onboarding().CreateProjectOnboarding(&models.Onboarding{
+	_, err = p.Repo().Onboarding().CreateProjectOnboarding(r.Context(), &models.Onboarding{
 		ProjectID:   proj.ID,
 		CurrentStep: types.StepConnectSource,
 	})
@@ -68,7 +69,7 @@ func (p *ProjectCreateHandler) ServeHTTP(w http.ResponseWriter, r *http.Request)
 	}
 
 	// create default project usage restriction
-	_, err = p.Repo().ProjectUsage().CreateProjectUsage(&models.ProjectUsage{
+	_, err = p.Repo().ProjectUsage().CreateProjectUsage(r.Context(), &models.ProjectUsage{
 		ProjectID:      proj.ID,
 		ResourceCPU:    types.BasicPlan.ResourceCPU,
 		ResourceMemory: types.BasicPlan.ResourceMemory,
@@ -98,17 +99,18 @@ func (p *ProjectCreateHandler) ServeHTTP(w http.ResponseWriter, r *http.Request)
 }
 
 func CreateProjectWithUser(
+	ctx context.Context,
 	projectRepo repository.ProjectRepository,
 	proj *models.Project,
 	user *models.User,
 ) (*models.Project, *models.Role, error) {
-	proj, err := projectRepo.CreateProject(proj)
+	proj, err := projectRepo.CreateProject(ctx, proj)
 	if err != nil {
 		return nil, nil, err
 	}
 
 	// create a new Role with the user as the admin
-	role, err := projectRepo.CreateProjectRole(proj, &models.Role{
+	role, err := projectRepo.CreateProjectRole(ctx, proj, &models.Role{
 		Role: types.Role{
 			UserID:    user.ID,
 			ProjectID: proj.ID,
@@ -120,7 +122,7 @@ func CreateProjectWithUser(
 	}
 
 	// read the project again to get the model with the role attached
-	proj, err = projectRepo.ReadProject(proj.ID)
+	proj, err = projectRepo.ReadProject(ctx, proj.ID)
 
 	if err != nil {
 		return nil, nil, err
@@ -0,0 +1,139 @@
+package project
+
+import (
+	"fmt"
+	"net/http"
+	"time"
+
+	"github.com/porter-dev/porter/api/server/handlers"
+	"github.com/porter-dev/porter/api/server/shared"
+	"github.com/porter-dev/porter/api/server/shared/apierrors"
+	"github.com/porter-dev/porter/api/server/shared/config"
+	"github.com/porter-dev/porter/api/types"
+	"github.com/porter-dev/porter/internal/auth/token"
+	"github.com/porter-dev/porter/internal/encryption"
+	"github.com/porter-dev/porter/internal/models"
+)
+
+type ServiceAccountCreateHandler struct {
+	handlers.PorterHandlerReadWriter
+}
+
+func NewServiceAccountCreateHandler(
+	config *config.Config,
+	decoderValidator shared.RequestDecoderValidator,
+	writer shared.ResultWriter,
+) *ServiceAccountCreateHandler {
+	return &ServiceAccountCreateHandler{
+		PorterHandlerReadWriter: handlers.NewDefaultPorterHandler(config, decoderValidator, writer),
+	}
+}
+
+// ServeHTTP creates a service account with a project role, and returns the service account along with its first token
+func (p *ServiceAccountCreateHandler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	user, _ := r.Context().Value(types.UserScope).(*models.User)
+	proj, _ := r.Context().Value(types.ProjectScope).(*models.Project)
+
+	request := &types.CreateServiceAccountRequest{}
+
+	if ok := p.DecodeAndValidate(w, r, request); !ok {
+		return
+	}
+
+	policyUID, reqErr := validateRoleKind(r.Context(), p.Repo(), proj.ID, types.RoleKind(request.Kind), request.PolicyUID)
+	if reqErr != nil {
+		p.HandleAPIError(w, r, reqErr)
+		return
+	}
+
+	if request.ExpiresAt != nil && !request.ExpiresAt.After(time.Now()) {
+		p.HandleAPIError(w, r, apierrors.NewErrPassThroughToClient(
+			fmt.Errorf("expires_at must be in the future"),
+			http.StatusBadRequest,
+		))
+		return
+	}
+
+	// service accounts cannot log in, so their users are given a unique address which cannot receive email
+	emailID, err := encryption.GenerateRandomBytes(8)
+	if err != nil {
+		p.HandleAPIError(w, r, apierrors.NewErrInternal(err))
+		return
+	}
+
+	saUser, err := p.Repo().User().CreateUser(r.Context(), &models.User{
+		Email:          fmt.Sprintf("sa-%s@project-%d.service-account.invalid", emailID, proj.ID),
+		EmailVerified:  true,
+		ServiceAccount: true,
+		FirstName:      request.Name,
+	})
+	if err != nil {
+		p.HandleAPIError(w, r, apierrors.NewErrInternal(err))
+		return
+	}
+
+	role, err := p.Repo().Project().CreateProjectRole(r.Context(), proj, &models.Role{
+		Role: types.Role{
+			UserID:    saUser.ID,
+			ProjectID: proj.ID,
+			Kind:      types.RoleKind(request.Kind),
+			PolicyUID: policyUID,
+		},
+	})
+	if err != nil {
+		p.HandleAPIError(w, r, apierrors.NewErrInternal(err))
+		return
+	}
+
+	sa := &models.ServiceAccount{
+		ProjectID:       proj.ID,
+		UserID:          saUser.ID,
+		Name:            request.Name,
+		CreatedByUserID: user.ID,
+		ExpiresAt:       request.ExpiresAt,
+	}
+
+	encoded, err := issueServiceAccountToken(p.Config(), sa)
+	if err != nil {
+		p.HandleAPIError(w, r, apierrors.NewErrInternal(err))
+		return
+	}
+
+	sa, err = p.Repo().ServiceAccount().CreateServiceAccount(r.Context(), sa)
+	if err != nil {
+		p.HandleAPIError(w, r, apierrors.NewErrInternal(err))
+		return
+	}
+
+	res := &types.CreateServiceAccountResponse{
+		ServiceAccount: sa.ToServiceAccountType(saUser, role),
+		Token:          encoded,
+	}
+
+	w.WriteHeader(http.StatusCreated)
+	p.WriteResult(w, r, res)
+}
+
+// issueServiceAccountToken generates a new token for a service account and returns the encoded token. The hash of the
+// token replaces the hash of the previous token on the service account, which must then be saved for the token to be valid.
+func issueServiceAccountToken(conf *config.Config, sa *models.ServiceAccount) (string, error) {
+	secret, err := encryption.GenerateRandomBytes(32)
+	if err != nil {
+		return "", err
+	}
+
+	jwt, err := token.GetTokenForServiceAccount(sa.UserID, sa.ProjectID, secret)
+	if err != nil {
+		return "", err
+	}
+
+	encoded, err := jwt.EncodeToken(conf.TokenConf)
+	if err != nil {
+		return "", err
+	}
+
+	sa.TokenHash = token.HashSecret(secret)
+	sa.TokenIssuedAt = jwt.IAt
+
+	return encoded, nil
+}
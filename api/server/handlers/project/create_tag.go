@@ -37,7 +37,7 @@ func (c *CreateTagHandler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
-	tag, err := c.Repo().Tag().CreateTag(&models.Tag{
+	tag, err := c.Repo().Tag().CreateTag(r.Context(), &models.Tag{
 		Name:      newTag.Name,
 		Color:     newTag.Color,
 		ProjectID: project.ID,
@@ -34,7 +34,7 @@ func (p *ProjectDeleteHandler) ServeHTTP(w http.ResponseWriter, r *http.Request)
 	proj, _ := ctx.Value(types.ProjectScope).(*models.Project)
 
 	if proj.CapiProvisionerEnabled {
-		clusters, err := p.Config().Repo.Cluster().ListClustersByProjectID(proj.ID)
+		clusters, err := p.Config().Repo.Cluster().ListClustersByProjectID(ctx, proj.ID)
 		if err != nil {
 			p.HandleAPIError(w, r, apierrors.NewErrInternal(fmt.Errorf("error finding clusters for project: %w", err)))
 			return
@@ -81,7 +81,7 @@ func (p *ProjectDeleteHandler) ServeHTTP(w http.ResponseWriter, r *http.Request)
 		return
 	}
 
-	deletedProject, err := p.Repo().Project().DeleteProject(proj)
+	deletedProject, err := p.Repo().Project().DeleteProject(ctx, proj)
 	if err != nil {
 		p.HandleAPIError(w, r, apierrors.NewErrInternal(err))
 		return
@@ -0,0 +1,73 @@
+package project
+
+import (
+	"errors"
+	"net/http"
+
+	"github.com/porter-dev/porter/api/server/handlers"
+	"github.com/porter-dev/porter/api/server/shared"
+	"github.com/porter-dev/porter/api/server/shared/apierrors"
+	"github.com/porter-dev/porter/api/server/shared/config"
+	"github.com/porter-dev/porter/api/server/shared/requestutils"
+	"github.com/porter-dev/porter/api/types"
+	"github.com/porter-dev/porter/internal/models"
+	"gorm.io/gorm"
+)
+
+type ServiceAccountDeleteHandler struct {
+	handlers.PorterHandlerWriter
+}
+
+func NewServiceAccountDeleteHandler(
+	config *config.Config,
+	writer shared.ResultWriter,
+) *ServiceAccountDeleteHandler {
+	return &ServiceAccountDeleteHandler{
+		PorterHandlerWriter: handlers.NewDefaultPorterHandler(config, nil, writer),
+	}
+}
+
+// ServeHTTP deletes a service account along with its user and project role, which invalidates its token
+func (p *ServiceAccountDeleteHandler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	proj, _ := r.Context().Value(types.ProjectScope).(*models.Project)
+
+	saID, reqErr := requestutils.GetURLParamUint(r, types.URLParamServiceAccountID)
+	if reqErr != nil {
+		p.HandleAPIError(w, r, reqErr)
+		return
+	}
+
+	sa, err := p.Repo().ServiceAccount().ReadServiceAccount(r.Context(), proj.ID, saID)
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			p.HandleAPIError(w, r, apierrors.NewErrNotFound(err))
+			return
+		}
+
+		p.HandleAPIError(w, r, apierrors.NewErrInternal(err))
+		return
+	}
+
+	if _, err := p.Repo().ServiceAccount().DeleteServiceAccount(r.Context(), sa); err != nil {
+		p.HandleAPIError(w, r, apierrors.NewErrInternal(err))
+		return
+	}
+
+	// the role may already have been removed from the collaborators of the project
+	if _, err := p.Repo().Project().DeleteProjectRole(r.Context(), proj.ID, sa.UserID); err != nil && !errors.Is(err, gorm.ErrRecordNotFound) {
+		p.HandleAPIError(w, r, apierrors.NewErrInternal(err))
+		return
+	}
+
+	saUser, err := p.Repo().User().ReadUser(r.Context(), sa.UserID)
+	if err == nil {
+		_, err = p.Repo().User().DeleteUser(r.Context(), saUser)
+	}
+
+	if err != nil && !errors.Is(err, gorm.ErrRecordNotFound) {
+		p.HandleAPIError(w, r, apierrors.NewErrInternal(err))
+		return
+	}
+
+	w.WriteHeader(http.StatusNoContent)
+}
@@ -32,7 +32,7 @@ func (p *ProjectGetBillingHandler) ServeHTTP(w http.ResponseWriter, r *http.Requ
 
 	if sc := p.Config().ServerConf; sc.BillingPrivateKey != "" && sc.BillingPrivateServerURL != "" {
 		// determine if the project has usage attached; if so, set has_billing to true
-		usage, _ := p.Repo().ProjectUsage().ReadProjectUsage(proj.ID)
+		usage, _ := p.Repo().ProjectUsage().ReadProjectUsage(r.Context(), proj.ID)
 
 		res.HasBilling = usage != nil
 	}
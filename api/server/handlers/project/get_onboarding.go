@@ -32,7 +32,7 @@ func (p *OnboardingGetHandler) ServeHTTP(w http.ResponseWriter, r *http.Request)
 	proj, _ := r.Context().Value(types.ProjectScope).(*models.Project)
 
 	// look for onboarding
-	onboarding, err := p.Repo().Onboarding().ReadProjectOnboarding(proj.ID)
+	onboarding, err := p.Repo().Onboarding().ReadProjectOnboarding(r.Context(), proj.ID)
 	isNotFound := errors.Is(gorm.ErrRecordNotFound, err)
 
 	if isNotFound {
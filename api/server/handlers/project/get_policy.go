@@ -32,7 +32,7 @@ func (p *ProjectGetPolicyHandler) ServeHTTP(w http.ResponseWriter, r *http.Reque
 
 	policyDocLoader := policy.NewBasicPolicyDocumentLoader(p.Config().Repo.Project(), p.Config().Repo.Policy())
 
-	policyDocs, err := policyDocLoader.LoadPolicyDocuments(&policy.PolicyLoaderOpts{
+	policyDocs, err := policyDocLoader.LoadPolicyDocuments(r.Context(), &policy.PolicyLoaderOpts{
 		UserID:    user.ID,
 		ProjectID: proj.ID,
 	})
@@ -39,7 +39,7 @@ func (p *ProjectGetUsageHandler) ServeHTTP(w http.ResponseWriter, r *http.Reques
 
 	res := &types.GetProjectUsageResponse{}
 
-	currUsage, limit, usageCache, err := usage.GetUsage(&usage.GetUsageOpts{
+	currUsage, limit, usageCache, err := usage.GetUsage(r.Context(), &usage.GetUsageOpts{
 		Project:                          proj,
 		DOConf:                           p.Config().DOConf,
 		Repo:                             p.Repo(),
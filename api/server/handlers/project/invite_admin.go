@@ -49,10 +49,10 @@ func (p *ProjectInviteAdminHandler) ServeHTTP(w http.ResponseWriter, r *http.Req
 
 	// Create role for admin if it doesn't exist
 	projectRepo := p.Repo().Project()
-	role, _ := projectRepo.ReadProjectRole(proj.ID, adminUserId)
+	role, _ := projectRepo.ReadProjectRole(r.Context(), proj.ID, adminUserId)
 	isPorterUser := strings.Contains(user.Email, "@porter.run")
 	if role == nil && !isPorterUser {
-		projectRepo.CreateProjectRole(proj, &models.Role{
+		projectRepo.CreateProjectRole(r.Context(), proj, &models.Role{
 			Role: types.Role{
 				UserID:    adminUserId,
 				ProjectID: proj.ID,
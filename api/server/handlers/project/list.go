@@ -1,6 +1,7 @@
 package project
 
 import (
+	"context"
 	"net/http"
 
 	"github.com/porter-dev/porter/api/server/handlers"
@@ -9,6 +10,7 @@ import (
 	"github.com/porter-dev/porter/api/server/shared/config"
 	"github.com/porter-dev/porter/api/types"
 	"github.com/porter-dev/porter/internal/models"
+	"github.com/porter-dev/porter/internal/repository"
 )
 
 type ProjectListHandler struct {
@@ -28,18 +30,41 @@ func (p *ProjectListHandler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
 	// read the user from context
 	user, _ := r.Context().Value(types.UserScope).(*models.User)
 
-	// read all projects for this user
-	projects, err := p.Repo().Project().ListProjectsByUserID(user.ID)
+	res, err := ListUserProjects(r.Context(), p.Repo(), user)
 	if err != nil {
 		p.HandleAPIError(w, r, apierrors.NewErrInternal(err))
 		return
 	}
 
-	res := make([]*types.Project, len(projects))
+	p.WriteResult(w, r, res)
+}
+
+// ListUserProjects returns all projects of a user along with the kind of role the user has in each project
+func ListUserProjects(ctx context.Context, repo repository.Repository, user *models.User) (types.ListUserProjectsResponse, error) {
+	projects, err := repo.Project().ListProjectsByUserID(ctx, user.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	res := make(types.ListUserProjectsResponse, len(projects))
 
 	for i, proj := range projects {
-		res[i] = proj.ToProjectType()
+		res[i] = &types.UserProject{
+			Project:  *proj.ToProjectType(),
+			RoleKind: userRoleKind(proj, user.ID),
+		}
 	}
 
-	p.WriteResult(w, r, res)
+	return res, nil
+}
+
+// userRoleKind returns the kind of role the user has in the project, or an empty kind if the user has no role
+func userRoleKind(proj *models.Project, userID uint) types.RoleKind {
+	for _, role := range proj.Roles {
+		if role.UserID == userID {
+			return role.Kind
+		}
+	}
+
+	return ""
 }
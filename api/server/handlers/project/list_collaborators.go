@@ -28,7 +28,7 @@ func NewCollaboratorsListHandler(
 func (p *CollaboratorsListHandler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
 	proj, _ := r.Context().Value(types.ProjectScope).(*models.Project)
 
-	roles, err := p.Repo().Project().ListProjectRoles(proj.ID)
+	roles, err := p.Repo().Project().ListProjectRoles(r.Context(), proj.ID)
 	if err != nil {
 		p.HandleAPIError(w, r, apierrors.NewErrInternal(err))
 		return
@@ -43,7 +43,7 @@ func (p *CollaboratorsListHandler) ServeHTTP(w http.ResponseWriter, r *http.Requ
 		idArr = append(idArr, role.UserID)
 	}
 
-	users, err := p.Repo().User().ListUsersByIDs(idArr)
+	users, err := p.Repo().User().ListUsersByIDs(r.Context(), idArr)
 	if err != nil {
 		p.HandleAPIError(w, r, apierrors.NewErrInternal(err))
 		return
@@ -58,6 +58,11 @@ func (p *CollaboratorsListHandler) ServeHTTP(w http.ResponseWriter, r *http.Requ
 			UserID:    roleMap[user.ID].UserID,
 			Email:     user.Email,
 			ProjectID: roleMap[user.ID].ProjectID,
+			PolicyUID: roleMap[user.ID].PolicyUID,
+
+			ServiceAccount: user.ServiceAccount,
+
+			Restrictions: roleMap[user.ID].ToRoleRestrictionsType(),
 		})
 	}
 
@@ -23,7 +23,7 @@ func NewRolesListHandler(
 }
 
 func (p *RolesListHandler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
-	var res types.ListProjectRolesResponse = []types.RoleKind{types.RoleAdmin, types.RoleDeveloper, types.RoleViewer}
+	var res types.ListProjectRolesResponse = []types.RoleKind{types.RoleAdmin, types.RoleDeveloper, types.RoleViewer, types.RoleCustom}
 
 	p.WriteResult(w, r, res)
 }
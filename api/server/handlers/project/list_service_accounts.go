@@ -0,0 +1,71 @@
+package project
+
+import (
+	"net/http"
+
+	"github.com/porter-dev/porter/api/server/handlers"
+	"github.com/porter-dev/porter/api/server/shared"
+	"github.com/porter-dev/porter/api/server/shared/apierrors"
+	"github.com/porter-dev/porter/api/server/shared/config"
+	"github.com/porter-dev/porter/api/types"
+	"github.com/porter-dev/porter/internal/models"
+)
+
+type ServiceAccountListHandler struct {
+	handlers.PorterHandlerWriter
+}
+
+func NewServiceAccountListHandler(
+	config *config.Config,
+	writer shared.ResultWriter,
+) *ServiceAccountListHandler {
+	return &ServiceAccountListHandler{
+		PorterHandlerWriter: handlers.NewDefaultPorterHandler(config, nil, writer),
+	}
+}
+
+// ServeHTTP lists the service accounts of a project along with their roles
+func (p *ServiceAccountListHandler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	proj, _ := r.Context().Value(types.ProjectScope).(*models.Project)
+
+	sas, err := p.Repo().ServiceAccount().ListServiceAccountsByProjectID(r.Context(), proj.ID)
+	if err != nil {
+		p.HandleAPIError(w, r, apierrors.NewErrInternal(err))
+		return
+	}
+
+	roles, err := p.Repo().Project().ListProjectRoles(r.Context(), proj.ID)
+	if err != nil {
+		p.HandleAPIError(w, r, apierrors.NewErrInternal(err))
+		return
+	}
+
+	roleMap := make(map[uint]*models.Role)
+	for i := range roles {
+		roleMap[roles[i].UserID] = &roles[i]
+	}
+
+	userIDs := make([]uint, 0, len(sas))
+	for _, sa := range sas {
+		userIDs = append(userIDs, sa.UserID)
+	}
+
+	users, err := p.Repo().User().ListUsersByIDs(r.Context(), userIDs)
+	if err != nil {
+		p.HandleAPIError(w, r, apierrors.NewErrInternal(err))
+		return
+	}
+
+	userMap := make(map[uint]*models.User)
+	for _, user := range users {
+		userMap[user.ID] = user
+	}
+
+	var res types.ListServiceAccountsResponse = make([]*types.ServiceAccount, 0, len(sas))
+
+	for _, sa := range sas {
+		res = append(res, sa.ToServiceAccountType(userMap[sa.UserID], roleMap[sa.UserID]))
+	}
+
+	p.WriteResult(w, r, res)
+}
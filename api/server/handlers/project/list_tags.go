@@ -27,7 +27,7 @@ func NewGetTagsHandler(
 
 func (p *GetTagsHandler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
 	proj, _ := r.Context().Value(types.ProjectScope).(*models.Project)
-	tags, err := p.Repo().Tag().ListTagsByProjectId(proj.ID)
+	tags, err := p.Repo().Tag().ListTagsByProjectId(r.Context(), proj.ID)
 	if err != nil {
 		p.HandleAPIError(w, r, apierrors.NewErrInternal(err))
 	}
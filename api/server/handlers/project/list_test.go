@@ -1,6 +1,7 @@
 package project_test
 
 import (
+	"context"
 	"testing"
 
 	"github.com/porter-dev/porter/api/server/handlers/project"
@@ -15,14 +16,14 @@ func TestListProjectsSuccessful(t *testing.T) {
 	// create a test project
 	config := apitest.LoadConfig(t)
 	user := apitest.CreateTestUser(t, config, true)
-	proj1, _, err := project.CreateProjectWithUser(config.Repo.Project(), &models.Project{
+	proj1, _, err := project.CreateProjectWithUser(context.Background(), config.Repo.Project(), &models.Project{
 		Name: "test-project",
 	}, user)
 	if err != nil {
 		t.Fatal(err)
 	}
 
-	proj2, _, err := project.CreateProjectWithUser(config.Repo.Project(), &models.Project{
+	proj2, _, err := project.CreateProjectWithUser(context.Background(), config.Repo.Project(), &models.Project{
 		Name: "test-project-2",
 	}, user)
 	if err != nil {
@@ -40,11 +41,17 @@ func TestListProjectsSuccessful(t *testing.T) {
 
 	handler.ServeHTTP(rr, req)
 
-	expProjects := make([]*types.Project, 0)
-
-	expProjects = append(expProjects, proj1.ToProjectType())
-	expProjects = append(expProjects, proj2.ToProjectType())
-	gotProjects := []*types.Project{}
+	expProjects := make(types.ListUserProjectsResponse, 0)
+
+	expProjects = append(expProjects, &types.UserProject{
+		Project:  *proj1.ToProjectType(),
+		RoleKind: types.RoleAdmin,
+	})
+	expProjects = append(expProjects, &types.UserProject{
+		Project:  *proj2.ToProjectType(),
+		RoleKind: types.RoleAdmin,
+	})
+	gotProjects := types.ListUserProjectsResponse{}
 
 	apitest.AssertResponseExpected(t, rr, &expProjects, &gotProjects)
 }
@@ -0,0 +1,95 @@
+package project
+
+import (
+	"net/http"
+
+	"github.com/porter-dev/porter/api/server/handlers"
+	"github.com/porter-dev/porter/api/server/shared"
+	"github.com/porter-dev/porter/api/server/shared/apierrors"
+	"github.com/porter-dev/porter/api/server/shared/config"
+	"github.com/porter-dev/porter/api/types"
+	"github.com/porter-dev/porter/internal/models"
+	"github.com/porter-dev/porter/internal/telemetry"
+)
+
+// GetResourcePresetsHandler handles GET requests to the /resource-presets endpoint
+type GetResourcePresetsHandler struct {
+	handlers.PorterHandlerWriter
+}
+
+// NewGetResourcePresetsHandler returns a new GetResourcePresetsHandler
+func NewGetResourcePresetsHandler(
+	config *config.Config,
+	writer shared.ResultWriter,
+) *GetResourcePresetsHandler {
+	return &GetResourcePresetsHandler{
+		PorterHandlerWriter: handlers.NewDefaultPorterHandler(config, nil, writer),
+	}
+}
+
+// ServeHTTP returns the resource presets of a project
+func (p *GetResourcePresetsHandler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	ctx, span := telemetry.NewSpan(r.Context(), "serve-get-resource-presets")
+	defer span.End()
+
+	project, _ := ctx.Value(types.ProjectScope).(*models.Project)
+
+	telemetry.WithAttributes(span, telemetry.AttributeKV{Key: "project-id", Value: project.ID})
+
+	presets := project.ToProjectResourcePresetsType()
+	p.WriteResult(w, r, &presets)
+}
+
+// UpdateResourcePresetsHandler handles POST requests to the /resource-presets endpoint
+type UpdateResourcePresetsHandler struct {
+	handlers.PorterHandlerReadWriter
+}
+
+// NewUpdateResourcePresetsHandler returns a new UpdateResourcePresetsHandler
+func NewUpdateResourcePresetsHandler(
+	config *config.Config,
+	decoderValidator shared.RequestDecoderValidator,
+	writer shared.ResultWriter,
+) *UpdateResourcePresetsHandler {
+	return &UpdateResourcePresetsHandler{
+		PorterHandlerReadWriter: handlers.NewDefaultPorterHandler(config, decoderValidator, writer),
+	}
+}
+
+// ServeHTTP replaces the resource presets of a project. The presets are given to services whose porter.yaml does not set
+// them the next time their app is applied.
+func (p *UpdateResourcePresetsHandler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	ctx, span := telemetry.NewSpan(r.Context(), "serve-update-resource-presets")
+	defer span.End()
+
+	project, _ := ctx.Value(types.ProjectScope).(*models.Project)
+
+	telemetry.WithAttributes(span, telemetry.AttributeKV{Key: "project-id", Value: project.ID})
+
+	request := &types.UpdateProjectResourcePresetsRequest{}
+	if ok := p.DecodeAndValidate(w, r, request); !ok {
+		err := telemetry.Error(ctx, span, nil, "error decoding request")
+		p.HandleAPIError(w, r, apierrors.NewErrPassThroughToClient(err, http.StatusBadRequest))
+		return
+	}
+
+	telemetry.WithAttributes(span,
+		telemetry.AttributeKV{Key: "cpu-cores", Value: float64(request.CPUCores)},
+		telemetry.AttributeKV{Key: "ram-megabytes", Value: request.RAMMegabytes},
+		telemetry.AttributeKV{Key: "instances", Value: request.Instances},
+	)
+
+	project.DefaultCPUCores = request.CPUCores
+	project.DefaultRAMMegabytes = request.RAMMegabytes
+	project.DefaultInstances = request.Instances
+
+	project, err := p.Repo().Project().UpdateProject(ctx, project)
+	if err != nil {
+		err := telemetry.Error(ctx, span, err, "error updating project")
+		p.HandleAPIError(w, r, apierrors.NewErrInternal(err))
+		return
+	}
+
+	presets := project.ToProjectResourcePresetsType()
+	p.WriteResult(w, r, &presets)
+}
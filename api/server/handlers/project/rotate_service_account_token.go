@@ -0,0 +1,73 @@
+package project
+
+import (
+	"errors"
+	"net/http"
+
+	"github.com/porter-dev/porter/api/server/handlers"
+	"github.com/porter-dev/porter/api/server/shared"
+	"github.com/porter-dev/porter/api/server/shared/apierrors"
+	"github.com/porter-dev/porter/api/server/shared/config"
+	"github.com/porter-dev/porter/api/server/shared/requestutils"
+	"github.com/porter-dev/porter/api/types"
+	"github.com/porter-dev/porter/internal/models"
+	"gorm.io/gorm"
+)
+
+type ServiceAccountTokenRotateHandler struct {
+	handlers.PorterHandlerWriter
+}
+
+func NewServiceAccountTokenRotateHandler(
+	config *config.Config,
+	writer shared.ResultWriter,
+) *ServiceAccountTokenRotateHandler {
+	return &ServiceAccountTokenRotateHandler{
+		PorterHandlerWriter: handlers.NewDefaultPorterHandler(config, nil, writer),
+	}
+}
+
+// ServeHTTP issues a new token for a service account, which invalidates its previous token
+func (p *ServiceAccountTokenRotateHandler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	proj, _ := r.Context().Value(types.ProjectScope).(*models.Project)
+
+	saID, reqErr := requestutils.GetURLParamUint(r, types.URLParamServiceAccountID)
+	if reqErr != nil {
+		p.HandleAPIError(w, r, reqErr)
+		return
+	}
+
+	sa, err := p.Repo().ServiceAccount().ReadServiceAccount(r.Context(), proj.ID, saID)
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			p.HandleAPIError(w, r, apierrors.NewErrNotFound(err))
+			return
+		}
+
+		p.HandleAPIError(w, r, apierrors.NewErrInternal(err))
+		return
+	}
+
+	if sa.IsExpired() {
+		p.HandleAPIError(w, r, apierrors.NewErrPassThroughToClient(
+			errors.New("service account has expired"),
+			http.StatusBadRequest,
+		))
+		return
+	}
+
+	encoded, err := issueServiceAccountToken(p.Config(), sa)
+	if err != nil {
+		p.HandleAPIError(w, r, apierrors.NewErrInternal(err))
+		return
+	}
+
+	if _, err := p.Repo().ServiceAccount().UpdateServiceAccount(r.Context(), sa); err != nil {
+		p.HandleAPIError(w, r, apierrors.NewErrInternal(err))
+		return
+	}
+
+	p.WriteResult(w, r, &types.RotateServiceAccountTokenResponse{
+		Token: encoded,
+	})
+}
@@ -0,0 +1,120 @@
+package project
+
+import (
+	"errors"
+	"net/http"
+
+	"github.com/porter-dev/porter/api/server/handlers"
+	"github.com/porter-dev/porter/api/server/shared"
+	"github.com/porter-dev/porter/api/server/shared/apierrors"
+	"github.com/porter-dev/porter/api/server/shared/config"
+	"github.com/porter-dev/porter/api/types"
+	"github.com/porter-dev/porter/internal/analytics"
+	"github.com/porter-dev/porter/internal/models"
+	"github.com/porter-dev/porter/internal/telemetry"
+	"gorm.io/gorm"
+)
+
+// TransferProjectOwnershipHandler makes another project member the admin of a project, and demotes the current admin
+type TransferProjectOwnershipHandler struct {
+	handlers.PorterHandlerReadWriter
+}
+
+// NewTransferProjectOwnershipHandler returns a new TransferProjectOwnershipHandler
+func NewTransferProjectOwnershipHandler(
+	config *config.Config,
+	decoderValidator shared.RequestDecoderValidator,
+	writer shared.ResultWriter,
+) *TransferProjectOwnershipHandler {
+	return &TransferProjectOwnershipHandler{
+		PorterHandlerReadWriter: handlers.NewDefaultPorterHandler(config, decoderValidator, writer),
+	}
+}
+
+// ServeHTTP transfers the admin role of the project from the authenticated user to the requested member
+func (p *TransferProjectOwnershipHandler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	ctx, span := telemetry.NewSpan(r.Context(), "serve-transfer-project-ownership")
+	defer span.End()
+
+	user, _ := ctx.Value(types.UserScope).(*models.User)
+	proj, _ := ctx.Value(types.ProjectScope).(*models.Project)
+
+	request := &types.TransferProjectOwnershipRequest{}
+	if ok := p.DecodeAndValidate(w, r, request); !ok {
+		err := telemetry.Error(ctx, span, nil, "error decoding request")
+		p.HandleAPIError(w, r, apierrors.NewErrPassThroughToClient(err, http.StatusBadRequest))
+		return
+	}
+
+	telemetry.WithAttributes(span,
+		telemetry.AttributeKV{Key: "previous-admin-user-id", Value: user.ID},
+		telemetry.AttributeKV{Key: "new-admin-user-id", Value: request.UserID},
+	)
+
+	if request.UserID == user.ID {
+		err := telemetry.Error(ctx, span, nil, "cannot transfer ownership to yourself")
+		p.HandleAPIError(w, r, apierrors.NewErrPassThroughToClient(err, http.StatusBadRequest))
+		return
+	}
+
+	previousAdmin, err := p.Repo().Project().ReadProjectRole(ctx, proj.ID, user.ID)
+	if err != nil {
+		err = telemetry.Error(ctx, span, err, "error reading role of current user")
+		p.HandleAPIError(w, r, apierrors.NewErrInternal(err))
+		return
+	}
+
+	if previousAdmin.Kind != types.RoleAdmin {
+		err := telemetry.Error(ctx, span, nil, "only a project admin can transfer ownership")
+		p.HandleAPIError(w, r, apierrors.NewErrForbidden(err))
+		return
+	}
+
+	newAdmin, err := p.Repo().Project().ReadProjectRole(ctx, proj.ID, request.UserID)
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			err = telemetry.Error(ctx, span, err, "target user is not a member of the project")
+			p.HandleAPIError(w, r, apierrors.NewErrPassThroughToClient(err, http.StatusBadRequest))
+			return
+		}
+
+		err = telemetry.Error(ctx, span, err, "error reading role of target user")
+		p.HandleAPIError(w, r, apierrors.NewErrInternal(err))
+		return
+	}
+
+	// the new admin is promoted before the previous admin is demoted, so that a failure part-way through
+	// never leaves the project without an admin
+	newAdmin.Kind = types.RoleAdmin
+	newAdmin.PolicyUID = ""
+
+	// the project admin has access to all clusters and namespaces of the project
+	newAdmin.SetRestrictions(nil)
+
+	newAdmin, err = p.Repo().Project().UpdateProjectRole(ctx, proj.ID, newAdmin)
+	if err != nil {
+		err = telemetry.Error(ctx, span, err, "error promoting target user to admin")
+		p.HandleAPIError(w, r, apierrors.NewErrInternal(err))
+		return
+	}
+
+	previousAdmin.Kind = types.RoleDeveloper
+
+	previousAdmin, err = p.Repo().Project().UpdateProjectRole(ctx, proj.ID, previousAdmin)
+	if err != nil {
+		err = telemetry.Error(ctx, span, err, "error demoting previous admin")
+		p.HandleAPIError(w, r, apierrors.NewErrInternal(err))
+		return
+	}
+
+	p.Config().AnalyticsClient.Track(analytics.ProjectOwnershipTransferTrack(&analytics.ProjectOwnershipTransferTrackOpts{
+		ProjectScopedTrackOpts: analytics.GetProjectScopedTrackOpts(user.ID, proj.ID),
+		PreviousAdminUserID:    user.ID,
+		NewAdminUserID:         request.UserID,
+	}))
+
+	p.WriteResult(w, r, &types.TransferProjectOwnershipResponse{
+		PreviousAdmin: previousAdmin.ToRoleType(),
+		NewAdmin:      newAdmin.ToRoleType(),
+	})
+}
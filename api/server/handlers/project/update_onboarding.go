@@ -37,7 +37,7 @@ func (p *OnboardingUpdateHandler) ServeHTTP(w http.ResponseWriter, r *http.Reque
 	}
 
 	// look for onboarding
-	onboarding, err := p.Repo().Onboarding().ReadProjectOnboarding(proj.ID)
+	onboarding, err := p.Repo().Onboarding().ReadProjectOnboarding(r.Context(), proj.ID)
 	isNotFound := errors.Is(gorm.ErrRecordNotFound, err)
 
 	if err != nil && !isNotFound {
@@ -67,10 +67,10 @@ func (p *OnboardingUpdateHandler) ServeHTTP(w http.ResponseWriter, r *http.Reque
 
 	if isNotFound {
 		// if not found, create onboarding struct
-		onboarding, err = p.Repo().Onboarding().CreateProjectOnboarding(onboarding)
+		onboarding, err = p.Repo().Onboarding().CreateProjectOnboarding(r.Context(), onboarding)
 	} else {
 		// otherwise, update the onboarding model
-		onboarding, err = p.Repo().Onboarding().UpdateProjectOnboarding(onboarding)
+		onboarding, err = p.Repo().Onboarding().UpdateProjectOnboarding(r.Context(), onboarding)
 	}
 
 	if err != nil {
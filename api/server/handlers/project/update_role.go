@@ -1,6 +1,9 @@
 package project
 
 import (
+	"context"
+	"errors"
+	"fmt"
 	"net/http"
 
 	"github.com/porter-dev/porter/api/server/handlers"
@@ -9,6 +12,8 @@ import (
 	"github.com/porter-dev/porter/api/server/shared/config"
 	"github.com/porter-dev/porter/api/types"
 	"github.com/porter-dev/porter/internal/models"
+	"github.com/porter-dev/porter/internal/repository"
+	"gorm.io/gorm"
 )
 
 type RoleUpdateHandler struct {
@@ -36,15 +41,41 @@ func (p *RoleUpdateHandler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
-	role, err := p.Repo().Project().ReadProjectRole(proj.ID, request.UserID)
+	role, err := p.Repo().Project().ReadProjectRole(r.Context(), proj.ID, request.UserID)
 	if err != nil {
 		http.Error(w, http.StatusText(http.StatusForbidden), http.StatusForbidden)
 		return
 	}
 
+	policyUID, reqErr := validateRoleKind(r.Context(), p.Repo(), proj.ID, types.RoleKind(request.Kind), request.PolicyUID)
+	if reqErr != nil {
+		p.HandleAPIError(w, r, reqErr)
+		return
+	}
+
 	role.Kind = types.RoleKind(request.Kind)
+	role.PolicyUID = policyUID
+
+	if request.Restrictions != nil {
+		for _, clusterID := range request.Restrictions.ClusterIDs {
+			if _, err := p.Repo().Cluster().ReadCluster(r.Context(), proj.ID, clusterID); err != nil {
+				if errors.Is(err, gorm.ErrRecordNotFound) {
+					p.HandleAPIError(w, r, apierrors.NewErrPassThroughToClient(
+						fmt.Errorf("cluster %d not found in project", clusterID),
+						http.StatusBadRequest,
+					))
+					return
+				}
+
+				p.HandleAPIError(w, r, apierrors.NewErrInternal(err))
+				return
+			}
+		}
+
+		role.SetRestrictions(request.Restrictions)
+	}
 
-	role, err = p.Repo().Project().UpdateProjectRole(proj.ID, role)
+	role, err = p.Repo().Project().UpdateProjectRole(r.Context(), proj.ID, role)
 
 	if err != nil {
 		p.HandleAPIError(w, r, apierrors.NewErrInternal(err))
@@ -52,8 +83,44 @@ func (p *RoleUpdateHandler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
 	}
 
 	res := types.UpdateRoleResponse{
-		Role: role.ToRoleType(),
+		Role:         role.ToRoleType(),
+		Restrictions: role.ToRoleRestrictionsType(),
 	}
 
 	p.WriteResult(w, r, res)
 }
+
+// validateRoleKind checks that a role of the kind can be given in the project, and returns the policy uid to set on the
+// role, which is only set for custom roles
+func validateRoleKind(ctx context.Context, repo repository.Repository, projectID uint, kind types.RoleKind, policyUID string) (string, apierrors.RequestError) {
+	switch kind {
+	case types.RoleAdmin, types.RoleDeveloper, types.RoleViewer:
+		return "", nil
+	case types.RoleCustom:
+		if policyUID == "" {
+			return "", apierrors.NewErrPassThroughToClient(
+				fmt.Errorf("policy_uid is required for custom roles"),
+				http.StatusBadRequest,
+			)
+		}
+
+		// custom roles can only be assigned policies of the project, not the preset policies
+		if _, err := repo.Policy().ReadPolicy(ctx, projectID, policyUID); err != nil {
+			if errors.Is(err, gorm.ErrRecordNotFound) {
+				return "", apierrors.NewErrPassThroughToClient(
+					fmt.Errorf("policy not found in project"),
+					http.StatusBadRequest,
+				)
+			}
+
+			return "", apierrors.NewErrInternal(err)
+		}
+
+		return policyUID, nil
+	default:
+		return "", apierrors.NewErrPassThroughToClient(
+			fmt.Errorf("%s is not a valid role", kind),
+			http.StatusBadRequest,
+		)
+	}
+}
@@ -45,7 +45,7 @@ func (p *CreateAWSHandler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
 
 	aws := CreateAWSIntegration(request, project.ID, user.ID)
 
-	aws, err := p.Repo().AWSIntegration().CreateAWSIntegration(aws)
+	aws, err := p.Repo().AWSIntegration().CreateAWSIntegration(ctx, aws)
 	if err != nil {
 		err = telemetry.Error(ctx, span, err, "error creating aws integration")
 		p.HandleAPIError(w, r, apierrors.NewErrPassThroughToClient(err, http.StatusInternalServerError))
@@ -51,7 +51,7 @@ func (p *CreateAzureHandler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
 
 	az := CreateAzureIntegration(request, project.ID, user.ID)
 
-	az, err := p.Repo().AzureIntegration().CreateAzureIntegration(az)
+	az, err := p.Repo().AzureIntegration().CreateAzureIntegration(ctx, az)
 	if err != nil {
 		err = telemetry.Error(ctx, span, err, "error creating azure integration")
 		p.HandleAPIError(w, r, apierrors.NewErrPassThroughToClient(err, http.StatusInternalServerError))
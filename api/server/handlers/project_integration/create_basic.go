@@ -38,7 +38,7 @@ func (p *CreateBasicHandler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
 
 	basic := CreateBasicIntegration(request.Username, request.Password, project.ID, user.ID)
 
-	basic, err := p.Repo().BasicIntegration().CreateBasicIntegration(basic)
+	basic, err := p.Repo().BasicIntegration().CreateBasicIntegration(r.Context(), basic)
 	if err != nil {
 		p.HandleAPIError(w, r, apierrors.NewErrInternal(err))
 		return
@@ -82,7 +82,7 @@ func (p *CreateGCPHandler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
 
 	gcp := CreateGCPIntegration(request, project.ID, user.ID)
 
-	gcp, err := p.Repo().GCPIntegration().CreateGCPIntegration(gcp)
+	gcp, err := p.Repo().GCPIntegration().CreateGCPIntegration(ctx, gcp)
 	if err != nil {
 		p.HandleAPIError(w, r, apierrors.NewErrInternal(err))
 		return
@@ -52,7 +52,7 @@ func (p *CreateGitlabIntegration) ServeHTTP(w http.ResponseWriter, r *http.Reque
 		return
 	}
 
-	gitlabIntegration, err := p.Repo().GitlabIntegration().CreateGitlabIntegration(&ints.GitlabIntegration{
+	gitlabIntegration, err := p.Repo().GitlabIntegration().CreateGitlabIntegration(r.Context(), &ints.GitlabIntegration{
 		ProjectID:       project.ID,
 		InstanceURL:     request.InstanceURL,
 		AppClientID:     []byte(request.AppClientID),
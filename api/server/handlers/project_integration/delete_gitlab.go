@@ -37,7 +37,7 @@ func (p *DeleteGitlabIntegration) ServeHTTP(w http.ResponseWriter, r *http.Reque
 		return
 	}
 
-	err := p.Repo().GitlabIntegration().DeleteGitlabIntegrationByID(gi.ProjectID, gi.ID)
+	err := p.Repo().GitlabIntegration().DeleteGitlabIntegrationByID(r.Context(), gi.ProjectID, gi.ID)
 	if err != nil {
 		p.HandleAPIError(w, r, apierrors.NewErrInternal(fmt.Errorf("error deleting gitlab integration: %w", err)))
 		return
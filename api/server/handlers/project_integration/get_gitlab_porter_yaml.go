@@ -53,7 +53,7 @@ func (p *GitlabRepoPorterYamlContentsHandler) ServeHTTP(w http.ResponseWriter, r
 		return
 	}
 
-	client, err := getGitlabClient(p.Repo(), user.ID, project.ID, gi, p.Config())
+	client, err := getGitlabClient(r.Context(), p.Repo(), user.ID, project.ID, gi, p.Config())
 	if err != nil {
 		if errors.Is(err, errUnauthorizedGitlabUser) {
 			p.HandleAPIError(w, r, apierrors.NewErrPassThroughToClient(errUnauthorizedGitlabUser, http.StatusUnauthorized))
@@ -27,7 +27,7 @@ func NewListAzureHandler(
 func (p *ListAzureHandler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
 	project, _ := r.Context().Value(types.ProjectScope).(*models.Project)
 
-	azInts, err := p.Repo().AzureIntegration().ListAzureIntegrationsByProjectID(project.ID)
+	azInts, err := p.Repo().AzureIntegration().ListAzureIntegrationsByProjectID(r.Context(), project.ID)
 	if err != nil {
 		p.HandleAPIError(w, r, apierrors.NewErrInternal(err))
 		return
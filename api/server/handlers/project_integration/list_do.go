@@ -27,7 +27,7 @@ func NewListDOHandler(
 func (p *ListDOHandler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
 	project, _ := r.Context().Value(types.ProjectScope).(*models.Project)
 
-	oauthInts, err := p.Repo().OAuthIntegration().ListOAuthIntegrationsByProjectID(project.ID)
+	oauthInts, err := p.Repo().OAuthIntegration().ListOAuthIntegrationsByProjectID(r.Context(), project.ID)
 	if err != nil {
 		p.HandleAPIError(w, r, apierrors.NewErrInternal(err))
 		return
@@ -27,7 +27,7 @@ func NewListGCPHandler(
 func (p *ListGCPHandler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
 	project, _ := r.Context().Value(types.ProjectScope).(*models.Project)
 
-	gcpInts, err := p.Repo().GCPIntegration().ListGCPIntegrationsByProjectID(project.ID)
+	gcpInts, err := p.Repo().GCPIntegration().ListGCPIntegrationsByProjectID(r.Context(), project.ID)
 	if err != nil {
 		p.HandleAPIError(w, r, apierrors.NewErrInternal(err))
 		return
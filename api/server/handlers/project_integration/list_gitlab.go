@@ -1,6 +1,7 @@
 package project_integration
 
 import (
+	"context"
 	"net/http"
 
 	ints "github.com/porter-dev/porter/internal/models/integrations"
@@ -30,7 +31,7 @@ func (p *ListGitlabHandler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
 	project, _ := r.Context().Value(types.ProjectScope).(*models.Project)
 	user, _ := r.Context().Value(types.UserScope).(*models.User)
 
-	gitlabInts, err := p.Repo().GitlabIntegration().ListGitlabIntegrationsByProjectID(project.ID)
+	gitlabInts, err := p.Repo().GitlabIntegration().ListGitlabIntegrationsByProjectID(r.Context(), project.ID)
 	if err != nil {
 		p.HandleAPIError(w, r, apierrors.NewErrInternal(err))
 		return
@@ -39,7 +40,7 @@ func (p *ListGitlabHandler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
 	var res types.ListGitlabResponse = make([]*types.GitlabIntegrationWithUsername, 0)
 
 	for _, gitlabInt := range gitlabInts {
-		username := p.getCurrentUsername(user.ID, project.ID, gitlabInt)
+		username := p.getCurrentUsername(r.Context(), user.ID, project.ID, gitlabInt)
 		glit := gitlabInt.ToGitlabIntegrationType()
 		res = append(res,
 			&types.GitlabIntegrationWithUsername{
@@ -52,8 +53,8 @@ func (p *ListGitlabHandler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
 	p.WriteResult(w, r, res)
 }
 
-func (p *ListGitlabHandler) getCurrentUsername(userID uint, projectID uint, gi *ints.GitlabIntegration) string {
-	client, err := getGitlabClient(p.Repo(), userID, projectID, gi, p.Config())
+func (p *ListGitlabHandler) getCurrentUsername(ctx context.Context, userID uint, projectID uint, gi *ints.GitlabIntegration) string {
+	client, err := getGitlabClient(ctx, p.Repo(), userID, projectID, gi, p.Config())
 	if err != nil {
 		return "Unable to connect"
 	}
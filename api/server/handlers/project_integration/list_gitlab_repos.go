@@ -1,6 +1,7 @@
 package project_integration
 
 import (
+	"context"
 	"errors"
 	"fmt"
 	"net/http"
@@ -40,7 +41,7 @@ func (p *ListGitlabReposHandler) ServeHTTP(w http.ResponseWriter, r *http.Reques
 	user, _ := r.Context().Value(types.UserScope).(*models.User)
 	gi, _ := r.Context().Value(types.GitlabIntegrationScope).(*ints.GitlabIntegration)
 
-	client, err := getGitlabClient(p.Repo(), user.ID, project.ID, gi, p.Config())
+	client, err := getGitlabClient(r.Context(), p.Repo(), user.ID, project.ID, gi, p.Config())
 	if err != nil {
 		if errors.Is(err, errUnauthorizedGitlabUser) {
 			p.HandleAPIError(w, r, apierrors.NewErrPassThroughToClient(errUnauthorizedGitlabUser, http.StatusUnauthorized))
@@ -84,12 +85,13 @@ func (p *ListGitlabReposHandler) ServeHTTP(w http.ResponseWriter, r *http.Reques
 }
 
 func getGitlabClient(
+	ctx context.Context,
 	repo repository.Repository,
 	userID, projectID uint,
 	gi *ints.GitlabIntegration,
 	config *config.Config,
 ) (*gitlab.Client, error) {
-	giAppOAuth, err := repo.GitlabAppOAuthIntegration().ReadGitlabAppOAuthIntegration(userID, projectID, gi.ID)
+	giAppOAuth, err := repo.GitlabAppOAuthIntegration().ReadGitlabAppOAuthIntegration(ctx, userID, projectID, gi.ID)
 	if err != nil {
 		if errors.Is(err, gorm.ErrRecordNotFound) {
 			return nil, errUnauthorizedGitlabUser
@@ -98,7 +100,7 @@ func getGitlabClient(
 		return nil, err
 	}
 
-	oauthInt, err := repo.OAuthIntegration().ReadOAuthIntegration(projectID, giAppOAuth.OAuthIntegrationID)
+	oauthInt, err := repo.OAuthIntegration().ReadOAuthIntegration(ctx, projectID, giAppOAuth.OAuthIntegrationID)
 	if err != nil {
 		if errors.Is(err, gorm.ErrRecordNotFound) {
 			return nil, errUnauthorizedGitlabUser
@@ -109,7 +111,7 @@ func getGitlabClient(
 
 	accessToken, _, err := oauth.GetAccessToken(oauthInt.SharedOAuthModel, commonutils.GetGitlabOAuthConf(
 		config, gi,
-	), oauth.MakeUpdateGitlabAppOAuthIntegrationFunction(projectID, giAppOAuth, repo))
+	), oauth.MakeUpdateGitlabAppOAuthIntegrationFunction(ctx, projectID, giAppOAuth, repo))
 	if err != nil {
 		return nil, errUnauthorizedGitlabUser
 	}
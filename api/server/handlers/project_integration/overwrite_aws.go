@@ -35,7 +35,7 @@ func (p *OverwriteAWSHandler) ServeHTTP(w http.ResponseWriter, r *http.Request)
 	}
 
 	// read the aws integration by ID and overwrite the access id/secret
-	awsIntegration, err := p.Repo().AWSIntegration().ReadAWSIntegration(project.ID, request.AWSIntegrationID)
+	awsIntegration, err := p.Repo().AWSIntegration().ReadAWSIntegration(r.Context(), project.ID, request.AWSIntegrationID)
 	if err != nil {
 		p.HandleAPIError(w, r, apierrors.NewErrInternal(err))
 		return
@@ -45,7 +45,7 @@ func (p *OverwriteAWSHandler) ServeHTTP(w http.ResponseWriter, r *http.Request)
 	awsIntegration.AWSSecretAccessKey = []byte(request.AWSSecretAccessKey)
 
 	// handle write to the database
-	awsIntegration, err = p.Repo().AWSIntegration().OverwriteAWSIntegration(awsIntegration)
+	awsIntegration, err = p.Repo().AWSIntegration().OverwriteAWSIntegration(r.Context(), awsIntegration)
 
 	if err != nil {
 		p.HandleAPIError(w, r, apierrors.NewErrInternal(err))
@@ -58,12 +58,12 @@ func (p *OverwriteAWSHandler) ServeHTTP(w http.ResponseWriter, r *http.Request)
 	}
 
 	if request.ClusterID > 0 {
-		cluster, err := p.Repo().Cluster().ReadCluster(project.ID, request.ClusterID)
+		cluster, err := p.Repo().Cluster().ReadCluster(r.Context(), project.ID, request.ClusterID)
 
 		// clear the token
 		cluster.TokenCache.Token = []byte("")
 
-		cluster, err = p.Repo().Cluster().UpdateClusterTokenCache(&cluster.TokenCache)
+		cluster, err = p.Repo().Cluster().UpdateClusterTokenCache(r.Context(), &cluster.TokenCache)
 
 		if err != nil {
 			p.HandleAPIError(w, r, apierrors.NewErrInternal(err))
@@ -47,7 +47,7 @@ func (p *ProjectOAuthGitlabHandler) ServeHTTP(w http.ResponseWriter, r *http.Req
 		return
 	}
 
-	giIntegration, err := p.Repo().GitlabIntegration().ReadGitlabIntegration(proj.ID, uint(integrationID))
+	giIntegration, err := p.Repo().GitlabIntegration().ReadGitlabIntegration(r.Context(), proj.ID, uint(integrationID))
 	if err != nil {
 		if err == gorm.ErrRecordNotFound {
 			p.HandleAPIError(w, r, apierrors.NewErrForbidden(
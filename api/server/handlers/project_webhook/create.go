@@ -0,0 +1,63 @@
+package project_webhook
+
+import (
+	"net/http"
+	"strings"
+
+	"github.com/porter-dev/porter/api/server/handlers"
+	"github.com/porter-dev/porter/api/server/shared"
+	"github.com/porter-dev/porter/api/server/shared/apierrors"
+	"github.com/porter-dev/porter/api/server/shared/config"
+	"github.com/porter-dev/porter/api/types"
+	"github.com/porter-dev/porter/internal/models"
+	"github.com/porter-dev/porter/internal/telemetry"
+)
+
+// CreateProjectWebhookHandler registers a new webhook on a project
+type CreateProjectWebhookHandler struct {
+	handlers.PorterHandlerReadWriter
+}
+
+// NewCreateProjectWebhookHandler returns a new CreateProjectWebhookHandler
+func NewCreateProjectWebhookHandler(
+	config *config.Config,
+	decoderValidator shared.RequestDecoderValidator,
+	writer shared.ResultWriter,
+) *CreateProjectWebhookHandler {
+	return &CreateProjectWebhookHandler{
+		PorterHandlerReadWriter: handlers.NewDefaultPorterHandler(config, decoderValidator, writer),
+	}
+}
+
+func (c *CreateProjectWebhookHandler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	ctx, span := telemetry.NewSpan(r.Context(), "serve-create-project-webhook")
+	defer span.End()
+
+	project, _ := ctx.Value(types.ProjectScope).(*models.Project)
+
+	request := &types.CreateProjectWebhookRequest{}
+	if ok := c.DecodeAndValidate(w, r, request); !ok {
+		return
+	}
+
+	events := make([]string, 0, len(request.Events))
+	for _, event := range request.Events {
+		events = append(events, string(event))
+	}
+
+	webhook, err := c.Repo().ProjectWebhook().CreateProjectWebhook(ctx, &models.ProjectWebhook{
+		ProjectID: project.ID,
+		URL:       request.URL,
+		Events:    strings.Join(events, ","),
+		Enabled:   true,
+		Secret:    []byte(request.Secret),
+	})
+	if err != nil {
+		err = telemetry.Error(ctx, span, err, "error creating project webhook")
+		c.HandleAPIError(w, r, apierrors.NewErrInternal(err))
+		return
+	}
+
+	w.WriteHeader(http.StatusCreated)
+	c.WriteResult(w, r, webhook.ToProjectWebhookType())
+}
@@ -0,0 +1,71 @@
+package project_webhook
+
+import (
+	"errors"
+	"net/http"
+
+	"github.com/porter-dev/porter/api/server/handlers"
+	"github.com/porter-dev/porter/api/server/shared"
+	"github.com/porter-dev/porter/api/server/shared/apierrors"
+	"github.com/porter-dev/porter/api/server/shared/config"
+	"github.com/porter-dev/porter/api/server/shared/requestutils"
+	"github.com/porter-dev/porter/api/types"
+	"github.com/porter-dev/porter/internal/models"
+	"github.com/porter-dev/porter/internal/telemetry"
+	"gorm.io/gorm"
+)
+
+// DeleteProjectWebhookHandler removes a webhook from a project
+type DeleteProjectWebhookHandler struct {
+	handlers.PorterHandlerWriter
+}
+
+// NewDeleteProjectWebhookHandler returns a new DeleteProjectWebhookHandler
+func NewDeleteProjectWebhookHandler(
+	config *config.Config,
+	writer shared.ResultWriter,
+) *DeleteProjectWebhookHandler {
+	return &DeleteProjectWebhookHandler{
+		PorterHandlerWriter: handlers.NewDefaultPorterHandler(config, nil, writer),
+	}
+}
+
+func (c *DeleteProjectWebhookHandler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	ctx, span := telemetry.NewSpan(r.Context(), "serve-delete-project-webhook")
+	defer span.End()
+
+	project, _ := ctx.Value(types.ProjectScope).(*models.Project)
+
+	webhook, reqErr := readProjectWebhook(c.Config(), r, project.ID)
+	if reqErr != nil {
+		c.HandleAPIError(w, r, reqErr)
+		return
+	}
+
+	if err := c.Repo().ProjectWebhook().DeleteProjectWebhook(ctx, webhook); err != nil {
+		err = telemetry.Error(ctx, span, err, "error deleting project webhook")
+		c.HandleAPIError(w, r, apierrors.NewErrInternal(err))
+		return
+	}
+
+	w.WriteHeader(http.StatusOK)
+}
+
+// readProjectWebhook reads the webhook referenced by the webhook id url param
+func readProjectWebhook(config *config.Config, r *http.Request, projectID uint) (*models.ProjectWebhook, apierrors.RequestError) {
+	webhookID, reqErr := requestutils.GetURLParamUint(r, types.URLParamProjectWebhookID)
+	if reqErr != nil {
+		return nil, reqErr
+	}
+
+	webhook, err := config.Repo.ProjectWebhook().ReadProjectWebhook(r.Context(), projectID, webhookID)
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, apierrors.NewErrNotFound(err)
+		}
+
+		return nil, apierrors.NewErrInternal(err)
+	}
+
+	return webhook, nil
+}
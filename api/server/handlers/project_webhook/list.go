@@ -0,0 +1,49 @@
+package project_webhook
+
+import (
+	"net/http"
+
+	"github.com/porter-dev/porter/api/server/handlers"
+	"github.com/porter-dev/porter/api/server/shared"
+	"github.com/porter-dev/porter/api/server/shared/apierrors"
+	"github.com/porter-dev/porter/api/server/shared/config"
+	"github.com/porter-dev/porter/api/types"
+	"github.com/porter-dev/porter/internal/models"
+	"github.com/porter-dev/porter/internal/telemetry"
+)
+
+// ListProjectWebhooksHandler lists the webhooks registered on a project
+type ListProjectWebhooksHandler struct {
+	handlers.PorterHandlerWriter
+}
+
+// NewListProjectWebhooksHandler returns a new ListProjectWebhooksHandler
+func NewListProjectWebhooksHandler(
+	config *config.Config,
+	writer shared.ResultWriter,
+) *ListProjectWebhooksHandler {
+	return &ListProjectWebhooksHandler{
+		PorterHandlerWriter: handlers.NewDefaultPorterHandler(config, nil, writer),
+	}
+}
+
+func (c *ListProjectWebhooksHandler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	ctx, span := telemetry.NewSpan(r.Context(), "serve-list-project-webhooks")
+	defer span.End()
+
+	project, _ := ctx.Value(types.ProjectScope).(*models.Project)
+
+	webhooks, err := c.Repo().ProjectWebhook().ListProjectWebhooksByProjectID(ctx, project.ID)
+	if err != nil {
+		err = telemetry.Error(ctx, span, err, "error listing project webhooks")
+		c.HandleAPIError(w, r, apierrors.NewErrInternal(err))
+		return
+	}
+
+	res := make(types.ListProjectWebhooksResponse, 0, len(webhooks))
+	for _, webhook := range webhooks {
+		res = append(res, webhook.ToProjectWebhookType())
+	}
+
+	c.WriteResult(w, r, res)
+}
@@ -0,0 +1,58 @@
+package project_webhook
+
+import (
+	"net/http"
+
+	"github.com/porter-dev/porter/api/server/handlers"
+	"github.com/porter-dev/porter/api/server/shared"
+	"github.com/porter-dev/porter/api/server/shared/apierrors"
+	"github.com/porter-dev/porter/api/server/shared/config"
+	"github.com/porter-dev/porter/api/types"
+	"github.com/porter-dev/porter/internal/models"
+	"github.com/porter-dev/porter/internal/telemetry"
+)
+
+// maxDeliveries is the number of recent deliveries returned for a webhook
+const maxDeliveries = 100
+
+// ListProjectWebhookDeliveriesHandler lists the delivery history of a project webhook
+type ListProjectWebhookDeliveriesHandler struct {
+	handlers.PorterHandlerWriter
+}
+
+// NewListProjectWebhookDeliveriesHandler returns a new ListProjectWebhookDeliveriesHandler
+func NewListProjectWebhookDeliveriesHandler(
+	config *config.Config,
+	writer shared.ResultWriter,
+) *ListProjectWebhookDeliveriesHandler {
+	return &ListProjectWebhookDeliveriesHandler{
+		PorterHandlerWriter: handlers.NewDefaultPorterHandler(config, nil, writer),
+	}
+}
+
+func (c *ListProjectWebhookDeliveriesHandler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	ctx, span := telemetry.NewSpan(r.Context(), "serve-list-project-webhook-deliveries")
+	defer span.End()
+
+	project, _ := ctx.Value(types.ProjectScope).(*models.Project)
+
+	webhook, reqErr := readProjectWebhook(c.Config(), r, project.ID)
+	if reqErr != nil {
+		c.HandleAPIError(w, r, reqErr)
+		return
+	}
+
+	deliveries, err := c.Repo().ProjectWebhook().ListProjectWebhookDeliveries(ctx, webhook.ID, maxDeliveries)
+	if err != nil {
+		err = telemetry.Error(ctx, span, err, "error listing project webhook deliveries")
+		c.HandleAPIError(w, r, apierrors.NewErrInternal(err))
+		return
+	}
+
+	res := make(types.ListProjectWebhookDeliveriesResponse, 0, len(deliveries))
+	for _, delivery := range deliveries {
+		res = append(res, delivery.ToProjectWebhookDeliveryType())
+	}
+
+	c.WriteResult(w, r, res)
+}
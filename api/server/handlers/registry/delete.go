@@ -27,7 +27,7 @@ func NewRegistryDeleteHandler(
 func (p *RegistryDeleteHandler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
 	reg, _ := r.Context().Value(types.RegistryScope).(*models.Registry)
 
-	if err := p.Repo().Registry().DeleteRegistry(reg); err != nil {
+	if err := p.Repo().Registry().DeleteRegistry(r.Context(), reg); err != nil {
 		p.HandleAPIError(w, r, apierrors.NewErrInternal(err))
 	}
 
@@ -0,0 +1,65 @@
+package registry
+
+import (
+	"errors"
+	"net/http"
+
+	"github.com/porter-dev/porter/api/server/handlers"
+	"github.com/porter-dev/porter/api/server/shared"
+	"github.com/porter-dev/porter/api/server/shared/apierrors"
+	"github.com/porter-dev/porter/api/server/shared/config"
+	"github.com/porter-dev/porter/api/types"
+	"github.com/porter-dev/porter/internal/models"
+	"github.com/porter-dev/porter/internal/registry/gc"
+	"github.com/porter-dev/porter/internal/telemetry"
+	"gorm.io/gorm"
+)
+
+// RegistryRunGCHandler applies the registry garbage collection policy of a project to its registries
+type RegistryRunGCHandler struct {
+	handlers.PorterHandlerReadWriter
+}
+
+// NewRegistryRunGCHandler returns a new RegistryRunGCHandler
+func NewRegistryRunGCHandler(
+	config *config.Config,
+	decoderValidator shared.RequestDecoderValidator,
+	writer shared.ResultWriter,
+) *RegistryRunGCHandler {
+	return &RegistryRunGCHandler{
+		PorterHandlerReadWriter: handlers.NewDefaultPorterHandler(config, decoderValidator, writer),
+	}
+}
+
+func (c *RegistryRunGCHandler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	ctx, span := telemetry.NewSpan(r.Context(), "serve-run-registry-gc")
+	defer span.End()
+
+	proj, _ := ctx.Value(types.ProjectScope).(*models.Project)
+
+	request := &types.RunRegistryGCRequest{}
+	if ok := c.DecodeAndValidate(w, r, request); !ok {
+		_ = telemetry.Error(ctx, span, nil, "error decoding request")
+		return
+	}
+
+	telemetry.WithAttributes(span, telemetry.AttributeKV{Key: "dry-run", Value: request.DryRun})
+
+	policy, err := c.Repo().RegistryGCPolicy().ReadRegistryGCPolicy(ctx, proj.ID)
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			c.HandleAPIError(w, r, apierrors.NewErrPassThroughToClient(telemetry.Error(ctx, span, err, "no registry gc policy configured for project"), http.StatusBadRequest))
+			return
+		}
+		c.HandleAPIError(w, r, apierrors.NewErrInternal(telemetry.Error(ctx, span, err, "error reading registry gc policy")))
+		return
+	}
+
+	res, err := gc.Run(ctx, c.Config(), policy, request.DryRun)
+	if err != nil {
+		c.HandleAPIError(w, r, apierrors.NewErrInternal(telemetry.Error(ctx, span, err, "error running registry gc")))
+		return
+	}
+
+	c.WriteResult(w, r, res)
+}
@@ -0,0 +1,115 @@
+package registry
+
+import (
+	"errors"
+	"net/http"
+
+	"github.com/porter-dev/porter/api/server/handlers"
+	"github.com/porter-dev/porter/api/server/shared"
+	"github.com/porter-dev/porter/api/server/shared/apierrors"
+	"github.com/porter-dev/porter/api/server/shared/config"
+	"github.com/porter-dev/porter/api/types"
+	"github.com/porter-dev/porter/internal/models"
+	"github.com/porter-dev/porter/internal/registry/gc"
+	"github.com/porter-dev/porter/internal/telemetry"
+	"gorm.io/gorm"
+)
+
+// RegistryGetGCPolicyHandler returns the registry garbage collection policy of a project
+type RegistryGetGCPolicyHandler struct {
+	handlers.PorterHandlerWriter
+}
+
+// NewRegistryGetGCPolicyHandler returns a new RegistryGetGCPolicyHandler
+func NewRegistryGetGCPolicyHandler(
+	config *config.Config,
+	writer shared.ResultWriter,
+) *RegistryGetGCPolicyHandler {
+	return &RegistryGetGCPolicyHandler{
+		PorterHandlerWriter: handlers.NewDefaultPorterHandler(config, nil, writer),
+	}
+}
+
+func (c *RegistryGetGCPolicyHandler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	ctx, span := telemetry.NewSpan(r.Context(), "serve-get-registry-gc-policy")
+	defer span.End()
+
+	proj, _ := ctx.Value(types.ProjectScope).(*models.Project)
+
+	policy, err := c.Repo().RegistryGCPolicy().ReadRegistryGCPolicy(ctx, proj.ID)
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			c.HandleAPIError(w, r, apierrors.NewErrNotFound(telemetry.Error(ctx, span, err, "no registry gc policy configured")))
+			return
+		}
+		c.HandleAPIError(w, r, apierrors.NewErrInternal(telemetry.Error(ctx, span, err, "error reading registry gc policy")))
+		return
+	}
+
+	c.WriteResult(w, r, policy.ToRegistryGCPolicyType())
+}
+
+// RegistryUpdateGCPolicyHandler creates or updates the registry garbage collection policy of a project
+type RegistryUpdateGCPolicyHandler struct {
+	handlers.PorterHandlerReadWriter
+}
+
+// NewRegistryUpdateGCPolicyHandler returns a new RegistryUpdateGCPolicyHandler
+func NewRegistryUpdateGCPolicyHandler(
+	config *config.Config,
+	decoderValidator shared.RequestDecoderValidator,
+	writer shared.ResultWriter,
+) *RegistryUpdateGCPolicyHandler {
+	return &RegistryUpdateGCPolicyHandler{
+		PorterHandlerReadWriter: handlers.NewDefaultPorterHandler(config, decoderValidator, writer),
+	}
+}
+
+func (c *RegistryUpdateGCPolicyHandler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	ctx, span := telemetry.NewSpan(r.Context(), "serve-update-registry-gc-policy")
+	defer span.End()
+
+	proj, _ := ctx.Value(types.ProjectScope).(*models.Project)
+
+	request := &types.UpdateRegistryGCPolicyRequest{}
+	if ok := c.DecodeAndValidate(w, r, request); !ok {
+		_ = telemetry.Error(ctx, span, nil, "error decoding request")
+		return
+	}
+
+	policy, err := c.Repo().RegistryGCPolicy().ReadRegistryGCPolicy(ctx, proj.ID)
+	if err != nil && !errors.Is(err, gorm.ErrRecordNotFound) {
+		c.HandleAPIError(w, r, apierrors.NewErrInternal(telemetry.Error(ctx, span, err, "error reading registry gc policy")))
+		return
+	}
+	if policy == nil {
+		policy = &models.RegistryGCPolicy{ProjectID: proj.ID}
+	}
+
+	policy.Enabled = request.Enabled
+	policy.KeepLastN = request.KeepLastN
+	policy.MaxAgeDays = request.MaxAgeDays
+
+	if err := gc.ValidatePolicy(policy); err != nil {
+		c.HandleAPIError(w, r, apierrors.NewErrPassThroughToClient(telemetry.Error(ctx, span, err, "invalid registry gc policy"), http.StatusBadRequest))
+		return
+	}
+
+	telemetry.WithAttributes(span,
+		telemetry.AttributeKV{Key: "enabled", Value: policy.Enabled},
+		telemetry.AttributeKV{Key: "keep-last-n", Value: policy.KeepLastN},
+		telemetry.AttributeKV{Key: "max-age-days", Value: policy.MaxAgeDays},
+	)
+
+	if policy.ID == 0 {
+		policy, err = c.Repo().RegistryGCPolicy().CreateRegistryGCPolicy(ctx, policy)
+	} else {
+		policy, err = c.Repo().RegistryGCPolicy().UpdateRegistryGCPolicy(ctx, policy)
+	}
+	if err != nil {
+		c.HandleAPIError(w, r, apierrors.NewErrInternal(telemetry.Error(ctx, span, err, "error saving registry gc policy")))
+		return
+	}
+
+	c.WriteResult(w, r, policy.ToRegistryGCPolicyType())
+}
@@ -75,7 +75,7 @@ func (c *RegistryGetECRTokenHandler) ServeHTTP(w http.ResponseWriter, r *http.Re
 	}
 
 	// list registries and find one that matches the region
-	regs, err := c.Repo().Registry().ListRegistriesByProjectID(proj.ID)
+	regs, err := c.Repo().Registry().ListRegistriesByProjectID(ctx, proj.ID)
 	if err != nil {
 		c.HandleAPIError(w, r, apierrors.NewErrInternal(err))
 		return
@@ -86,7 +86,7 @@ func (c *RegistryGetECRTokenHandler) ServeHTTP(w http.ResponseWriter, r *http.Re
 
 	for _, reg := range regs {
 		if reg.AWSIntegrationID != 0 {
-			awsInt, err := c.Repo().AWSIntegration().ReadAWSIntegration(reg.ProjectID, reg.AWSIntegrationID)
+			awsInt, err := c.Repo().AWSIntegration().ReadAWSIntegration(ctx, reg.ProjectID, reg.AWSIntegrationID)
 			if err != nil {
 				c.HandleAPIError(w, r, apierrors.NewErrInternal(err))
 				return
@@ -167,7 +167,7 @@ func (c *RegistryGetGCRTokenHandler) ServeHTTP(w http.ResponseWriter, r *http.Re
 	}
 
 	// list registries and find one that matches the region
-	regs, err := c.Repo().Registry().ListRegistriesByProjectID(proj.ID)
+	regs, err := c.Repo().Registry().ListRegistriesByProjectID(ctx, proj.ID)
 	if err != nil {
 		e := telemetry.Error(ctx, span, err, "error listing registries by project id")
 		c.HandleAPIError(w, r, apierrors.NewErrPassThroughToClient(e, http.StatusInternalServerError))
@@ -234,7 +234,7 @@ func (c *RegistryGetGARTokenHandler) ServeHTTP(w http.ResponseWriter, r *http.Re
 	}
 
 	// list registries and find one that matches the region
-	regs, err := c.Repo().Registry().ListRegistriesByProjectID(proj.ID)
+	regs, err := c.Repo().Registry().ListRegistriesByProjectID(ctx, proj.ID)
 	if err != nil {
 		e := telemetry.Error(ctx, span, err, "error listing registries by project id")
 		c.HandleAPIError(w, r, apierrors.NewErrPassThroughToClient(e, http.StatusInternalServerError))
@@ -336,7 +336,7 @@ func (c *RegistryGetDOCRTokenHandler) ServeHTTP(w http.ResponseWriter, r *http.R
 	}
 
 	// list registries and find one that matches the region
-	regs, err := c.Repo().Registry().ListRegistriesByProjectID(proj.ID)
+	regs, err := c.Repo().Registry().ListRegistriesByProjectID(r.Context(), proj.ID)
 	if err != nil {
 		c.HandleAPIError(w, r, apierrors.NewErrInternal(err))
 		return
@@ -347,7 +347,7 @@ func (c *RegistryGetDOCRTokenHandler) ServeHTTP(w http.ResponseWriter, r *http.R
 
 	for _, reg := range regs {
 		if reg.DOIntegrationID != 0 && strings.Contains(reg.URL, request.ServerURL) {
-			oauthInt, err := c.Repo().OAuthIntegration().ReadOAuthIntegration(reg.ProjectID, reg.DOIntegrationID)
+			oauthInt, err := c.Repo().OAuthIntegration().ReadOAuthIntegration(r.Context(), reg.ProjectID, reg.DOIntegrationID)
 			if err != nil {
 				c.HandleAPIError(w, r, apierrors.NewErrInternal(err))
 				return
@@ -356,7 +356,7 @@ func (c *RegistryGetDOCRTokenHandler) ServeHTTP(w http.ResponseWriter, r *http.R
 			tok, expiry, err := oauth.GetAccessToken(
 				oauthInt.SharedOAuthModel,
 				c.Config().DOConf,
-				oauth.MakeUpdateOAuthIntegrationTokenFunction(oauthInt, c.Repo()),
+				oauth.MakeUpdateOAuthIntegrationTokenFunction(r.Context(), oauthInt, c.Repo()),
 			)
 			if err != nil {
 				c.HandleAPIError(w, r, apierrors.NewErrInternal(err))
@@ -395,7 +395,7 @@ func (c *RegistryGetDockerhubTokenHandler) ServeHTTP(w http.ResponseWriter, r *h
 	proj, _ := r.Context().Value(types.ProjectScope).(*models.Project)
 
 	// list registries and find one that matches the region
-	regs, err := c.Repo().Registry().ListRegistriesByProjectID(proj.ID)
+	regs, err := c.Repo().Registry().ListRegistriesByProjectID(r.Context(), proj.ID)
 	if err != nil {
 		c.HandleAPIError(w, r, apierrors.NewErrInternal(err))
 		return
@@ -406,7 +406,7 @@ func (c *RegistryGetDockerhubTokenHandler) ServeHTTP(w http.ResponseWriter, r *h
 
 	for _, reg := range regs {
 		if reg.BasicIntegrationID != 0 && strings.Contains(reg.URL, "index.docker.io") {
-			basic, err := c.Repo().BasicIntegration().ReadBasicIntegration(reg.ProjectID, reg.BasicIntegrationID)
+			basic, err := c.Repo().BasicIntegration().ReadBasicIntegration(r.Context(), reg.ProjectID, reg.BasicIntegrationID)
 			if err != nil {
 				c.HandleAPIError(w, r, apierrors.NewErrInternal(err))
 				return
@@ -428,6 +428,81 @@ func (c *RegistryGetDockerhubTokenHandler) ServeHTTP(w http.ResponseWriter, r *h
 	c.WriteResult(w, r, resp)
 }
 
+// RegistryGetBasicTokenHandler returns the credentials of a registry which authenticates with a username and token,
+// such as ghcr.io or quay.io
+type RegistryGetBasicTokenHandler struct {
+	handlers.PorterHandlerReadWriter
+}
+
+// NewRegistryGetBasicTokenHandler returns a new RegistryGetBasicTokenHandler
+func NewRegistryGetBasicTokenHandler(
+	config *config.Config,
+	decoderValidator shared.RequestDecoderValidator,
+	writer shared.ResultWriter,
+) *RegistryGetBasicTokenHandler {
+	return &RegistryGetBasicTokenHandler{
+		PorterHandlerReadWriter: handlers.NewDefaultPorterHandler(config, decoderValidator, writer),
+	}
+}
+
+func (c *RegistryGetBasicTokenHandler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	ctx, span := telemetry.NewSpan(r.Context(), "serve-basic-registry-token")
+	defer span.End()
+
+	proj, _ := ctx.Value(types.ProjectScope).(*models.Project)
+
+	request := &types.GetRegistryBasicTokenRequest{}
+	if ok := c.DecodeAndValidate(w, r, request); !ok {
+		_ = telemetry.Error(ctx, span, nil, "error decoding request")
+		return
+	}
+
+	serverHost := registryHost(request.ServerURL)
+	if serverHost == "" {
+		c.HandleAPIError(w, r, apierrors.NewErrPassThroughToClient(telemetry.Error(ctx, span, nil, "server_url is required"), http.StatusBadRequest))
+		return
+	}
+	telemetry.WithAttributes(span, telemetry.AttributeKV{Key: "server-host", Value: serverHost})
+
+	regs, err := c.Repo().Registry().ListRegistriesByProjectID(ctx, proj.ID)
+	if err != nil {
+		c.HandleAPIError(w, r, apierrors.NewErrInternal(telemetry.Error(ctx, span, err, "error listing registries")))
+		return
+	}
+
+	for _, reg := range regs {
+		if reg.BasicIntegrationID == 0 || registryHost(reg.URL) != serverHost {
+			continue
+		}
+
+		basic, err := c.Repo().BasicIntegration().ReadBasicIntegration(ctx, reg.ProjectID, reg.BasicIntegrationID)
+		if err != nil {
+			c.HandleAPIError(w, r, apierrors.NewErrInternal(telemetry.Error(ctx, span, err, "error reading basic integration")))
+			return
+		}
+
+		// the token does not expire, so set the same arbitrary 30-day expiry as Docker Hub (this is not enforced)
+		c.WriteResult(w, r, &types.GetRegistryTokenResponse{
+			Token:     base64.StdEncoding.EncodeToString([]byte(string(basic.Username) + ":" + string(basic.Password))),
+			ExpiresAt: time.Now().Add(30 * 24 * time.Hour),
+		})
+		return
+	}
+
+	c.HandleAPIError(w, r, apierrors.NewErrPassThroughToClient(telemetry.Error(ctx, span, nil, "no registry with basic credentials found for server url"), http.StatusNotFound))
+}
+
+// registryHost returns the host of a registry or server url, which may or may not include a scheme and path
+func registryHost(serverURL string) string {
+	if splStr := strings.Split(serverURL, "://"); len(splStr) > 1 {
+		serverURL = splStr[1]
+	}
+
+	host, _, _ := strings.Cut(strings.Trim(serverURL, "/"), "/")
+
+	return host
+}
+
 type RegistryGetACRTokenHandler struct {
 	handlers.PorterHandlerReadWriter
 }
@@ -468,7 +543,7 @@ func (c *RegistryGetACRTokenHandler) ServeHTTP(w http.ResponseWriter, r *http.Re
 	telemetry.WithAttributes(span, telemetry.AttributeKV{Key: "server-url", Value: serverUrl})
 
 	// list registries and find one that matches the region
-	regs, err := c.Repo().Registry().ListRegistriesByProjectID(proj.ID)
+	regs, err := c.Repo().Registry().ListRegistriesByProjectID(ctx, proj.ID)
 	if err != nil {
 		err = telemetry.Error(ctx, span, err, "error getting registries by project id")
 		c.HandleAPIError(w, r, apierrors.NewErrPassThroughToClient(err, http.StatusInternalServerError))
@@ -530,7 +605,7 @@ func (c *RegistryGetACRTokenHandler) ServeHTTP(w http.ResponseWriter, r *http.Re
 		telemetry.WithAttributes(span, telemetry.AttributeKV{Key: "capi-provisioned", Value: false})
 
 		_reg := registry.Registry(*matchingReg)
-		username, pw, err := _reg.GetACRCredentials(c.Repo())
+		username, pw, err := _reg.GetACRCredentials(ctx, c.Repo())
 		if err != nil {
 			err = telemetry.Error(ctx, span, err, "error getting token response from ccp")
 			c.HandleAPIError(w, r, apierrors.NewErrPassThroughToClient(err, http.StatusInternalServerError))
@@ -27,7 +27,7 @@ func NewRegistryListHandler(
 func (c *RegistryListHandler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
 	proj, _ := r.Context().Value(types.ProjectScope).(*models.Project)
 
-	regs, err := c.Repo().Registry().ListRegistriesByProjectID(proj.ID)
+	regs, err := c.Repo().Registry().ListRegistriesByProjectID(r.Context(), proj.ID)
 	if err != nil {
 		c.HandleAPIError(w, r, apierrors.NewErrInternal(err))
 		return
@@ -38,7 +38,7 @@ func (p *RegistryUpdateHandler) ServeHTTP(w http.ResponseWriter, r *http.Request
 
 	reg.Name = request.Name
 
-	reg, err := p.Repo().Registry().UpdateRegistry(reg)
+	reg, err := p.Repo().Registry().UpdateRegistry(r.Context(), reg)
 	if err != nil {
 		p.HandleAPIError(w, r, apierrors.NewErrInternal(err))
 		return
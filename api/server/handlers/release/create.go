@@ -94,7 +94,7 @@ func (c *CreateReleaseHandler) ServeHTTP(w http.ResponseWriter, r *http.Request)
 	// if the repo url is not an addon or application url, validate against the helm repos
 	if request.RepoURL != c.Config().ServerConf.DefaultAddonHelmRepoURL && request.RepoURL != c.Config().ServerConf.DefaultApplicationHelmRepoURL {
 		// load the helm repos in the project
-		hrs, err := c.Repo().HelmRepo().ListHelmReposByProjectID(cluster.ProjectID)
+		hrs, err := c.Repo().HelmRepo().ListHelmReposByProjectID(ctx, cluster.ProjectID)
 		if err != nil {
 			c.HandleAPIError(w, r, apierrors.NewErrInternal(telemetry.Error(ctx, span, err, "error listing helm repos for project")))
 			return
@@ -122,7 +122,7 @@ func (c *CreateReleaseHandler) ServeHTTP(w http.ResponseWriter, r *http.Request)
 		return
 	}
 
-	registries, err := c.Repo().Registry().ListRegistriesByProjectID(cluster.ProjectID)
+	registries, err := c.Repo().Registry().ListRegistriesByProjectID(ctx, cluster.ProjectID)
 	if err != nil {
 		c.HandleAPIError(w, r, apierrors.NewErrInternal(telemetry.Error(ctx, span, err, "error listing registries")))
 		return
@@ -196,7 +196,7 @@ func (c *CreateReleaseHandler) ServeHTTP(w http.ResponseWriter, r *http.Request)
 	}
 
 	if request.Tags != nil {
-		tags, err := c.Repo().Tag().LinkTagsToRelease(request.Tags, release)
+		tags, err := c.Repo().Tag().LinkTagsToRelease(ctx, request.Tags, release)
 
 		if err == nil {
 			release.Tags = append(release.Tags, tags...)
@@ -304,10 +304,11 @@ func CreateAppReleaseFromHelmRelease(
 		StackResourceID: stackResourceID,
 	}
 
-	return config.Repo.Release().CreateRelease(release)
+	return config.Repo.Release().CreateRelease(ctx, release)
 }
 
 func CreateAddonReleaseFromHelmRelease(
+	ctx context.Context,
 	config *config.Config,
 	projectID, clusterID, stackResourceID uint,
 	helmRelease *release.Release,
@@ -320,7 +321,7 @@ func CreateAddonReleaseFromHelmRelease(
 		StackResourceID: stackResourceID,
 	}
 
-	return config.Repo.Release().CreateRelease(release)
+	return config.Repo.Release().CreateRelease(ctx, release)
 }
 
 func createGitAction(
@@ -345,7 +346,7 @@ func createGitAction(
 	// if the registry was provisioned through Porter, create a repository if necessary
 	if release != nil && request.RegistryID != 0 {
 		// read the registry
-		reg, err := config.Repo.Registry().ReadRegistry(projectID, request.RegistryID)
+		reg, err := config.Repo.Registry().ReadRegistry(ctx, projectID, request.RegistryID)
 		if err != nil {
 			return nil, nil, telemetry.Error(ctx, span, err, "could not read repo registry")
 		}
@@ -451,7 +452,7 @@ func createGitAction(
 	}
 
 	// handle write to the database
-	ga, err := config.Repo.GitActionConfig().CreateGitActionConfig(&models.GitActionConfig{
+	ga, err := config.Repo.GitActionConfig().CreateGitActionConfig(ctx, &models.GitActionConfig{
 		ReleaseID:           release.ID,
 		GitRepo:             request.GitRepo,
 		GitBranch:           request.GitBranch,
@@ -470,7 +471,7 @@ func createGitAction(
 	// update the release in the db with the image repo uri
 	release.ImageRepoURI = ga.ImageRepoURI
 
-	_, err = config.Repo.Release().UpdateRelease(release)
+	_, err = config.Repo.Release().UpdateRelease(ctx, release)
 	if err != nil {
 		return nil, nil, telemetry.Error(ctx, span, err, "error updating release")
 	}
@@ -533,7 +534,7 @@ func getToken(
 		PolicyBytes:     policyBytes,
 	}
 
-	policyModel, err = config.Repo.Policy().CreatePolicy(policyModel)
+	policyModel, err = config.Repo.Policy().CreatePolicy(ctx, policyModel)
 
 	if err != nil {
 		return "", telemetry.Error(ctx, span, err, "error creating policy")
@@ -570,7 +571,7 @@ func getToken(
 		SecretKey:       hashedToken,
 	}
 
-	apiToken, err = config.Repo.APIToken().CreateAPIToken(apiToken)
+	apiToken, err = config.Repo.APIToken().CreateAPIToken(ctx, apiToken)
 
 	if err != nil {
 		return "", telemetry.Error(ctx, span, err, "error creating api token")
@@ -600,7 +601,7 @@ func createBuildConfig(
 	}
 
 	// handle write to the database
-	bc, err := config.Repo.BuildConfig().CreateBuildConfig(&models.BuildConfig{
+	bc, err := config.Repo.BuildConfig().CreateBuildConfig(ctx, &models.BuildConfig{
 		Builder:    bcRequest.Builder,
 		Buildpacks: strings.Join(bcRequest.Buildpacks, ","),
 		Config:     data,
@@ -611,7 +612,7 @@ func createBuildConfig(
 
 	release.BuildConfig = bc.ID
 
-	_, err = config.Repo.Release().UpdateRelease(release)
+	_, err = config.Repo.Release().UpdateRelease(ctx, release)
 	if err != nil {
 		return nil, telemetry.Error(ctx, span, err, "error updating release")
 	}
@@ -65,7 +65,7 @@ func (c *CreateAddonHandler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
 		request.TemplateVersion = ""
 	}
 
-	chart, err := LoadChart(c.Config(), &LoadAddonChartOpts{
+	chart, err := LoadChart(r.Context(), c.Config(), &LoadAddonChartOpts{
 		ProjectID:       proj.ID,
 		RepoURL:         request.RepoURL,
 		TemplateName:    request.TemplateName,
@@ -76,7 +76,7 @@ func (c *CreateAddonHandler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
-	registries, err := c.Repo().Registry().ListRegistriesByProjectID(cluster.ProjectID)
+	registries, err := c.Repo().Registry().ListRegistriesByProjectID(r.Context(), cluster.ProjectID)
 	if err != nil {
 		c.HandleAPIError(w, r, apierrors.NewErrInternal(err))
 		return
@@ -122,13 +122,13 @@ type LoadAddonChartOpts struct {
 	RepoURL, TemplateName, TemplateVersion string
 }
 
-func LoadChart(config *config.Config, opts *LoadAddonChartOpts) (*chart.Chart, error) {
+func LoadChart(ctx context.Context, config *config.Config, opts *LoadAddonChartOpts) (*chart.Chart, error) {
 	// if the chart repo url is one of the specified application/addon charts, just load public
 	if opts.RepoURL == config.ServerConf.DefaultAddonHelmRepoURL || opts.RepoURL == config.ServerConf.DefaultApplicationHelmRepoURL {
 		return loader.LoadChartPublic(context.Background(), opts.RepoURL, opts.TemplateName, opts.TemplateVersion)
 	} else {
 		// load the helm repos in the project
-		hrs, err := config.Repo.HelmRepo().ListHelmReposByProjectID(opts.ProjectID)
+		hrs, err := config.Repo.HelmRepo().ListHelmReposByProjectID(ctx, opts.ProjectID)
 		if err != nil {
 			return nil, err
 		}
@@ -137,7 +137,7 @@ func LoadChart(config *config.Config, opts *LoadAddonChartOpts) (*chart.Chart, e
 			if hr.RepoURL == opts.RepoURL {
 				if hr.BasicAuthIntegrationID != 0 {
 					// read the basic integration id
-					basic, err := config.Repo.BasicIntegration().ReadBasicIntegration(opts.ProjectID, hr.BasicAuthIntegrationID)
+					basic, err := config.Repo.BasicIntegration().ReadBasicIntegration(ctx, opts.ProjectID, hr.BasicAuthIntegrationID)
 					if err != nil {
 						return nil, err
 					}
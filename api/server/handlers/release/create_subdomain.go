@@ -57,7 +57,7 @@ func (c *CreateSubdomainHandler) ServeHTTP(w http.ResponseWriter, r *http.Reques
 
 	record := createDomain.NewDNSRecordForEndpoint()
 
-	record, err = c.Repo().DNSRecord().CreateDNSRecord(record)
+	record, err = c.Repo().DNSRecord().CreateDNSRecord(r.Context(), record)
 
 	if err != nil {
 		c.HandleAPIError(w, r, apierrors.NewErrInternal(err))
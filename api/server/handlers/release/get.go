@@ -47,7 +47,7 @@ func (c *ReleaseGetHandler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
 	}
 
 	// look up the release in the database; if not found, do not populate Porter fields
-	release, err := c.Repo().Release().ReadRelease(cluster.ID, helmRelease.Name, helmRelease.Namespace)
+	release, err := c.Repo().Release().ReadRelease(ctx, cluster.ID, helmRelease.Name, helmRelease.Namespace)
 	if err == nil {
 		res.PorterRelease = release.ToReleaseType()
 
@@ -59,7 +59,7 @@ func (c *ReleaseGetHandler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
 		}
 
 		if release.BuildConfig != 0 {
-			bc, err := c.Repo().BuildConfig().GetBuildConfig(release.BuildConfig)
+			bc, err := c.Repo().BuildConfig().GetBuildConfig(ctx, release.BuildConfig)
 			if err != nil {
 				err = telemetry.Error(ctx, span, err, "unable to get build config")
 				c.HandleAPIError(w, r, apierrors.NewErrPassThroughToClient(err, http.StatusInternalServerError))
@@ -70,21 +70,21 @@ func (c *ReleaseGetHandler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
 		}
 
 		if release.StackResourceID != 0 {
-			stackResource, err := c.Repo().Stack().ReadStackResource(release.StackResourceID)
+			stackResource, err := c.Repo().Stack().ReadStackResource(ctx, release.StackResourceID)
 			if err != nil {
 				err = telemetry.Error(ctx, span, err, "unable to get stack resource")
 				c.HandleAPIError(w, r, apierrors.NewErrPassThroughToClient(err, http.StatusInternalServerError))
 				return
 			}
 
-			stackRevision, err := c.Repo().Stack().ReadStackRevision(stackResource.StackRevisionID)
+			stackRevision, err := c.Repo().Stack().ReadStackRevision(ctx, stackResource.StackRevisionID)
 			if err != nil {
 				err = telemetry.Error(ctx, span, err, "unable to get stack revision")
 				c.HandleAPIError(w, r, apierrors.NewErrPassThroughToClient(err, http.StatusInternalServerError))
 				return
 			}
 
-			stack, err := c.Repo().Stack().ReadStackByID(cluster.ProjectID, stackRevision.StackID)
+			stack, err := c.Repo().Stack().ReadStackByID(ctx, cluster.ProjectID, stackRevision.StackID)
 			if err != nil {
 				err = telemetry.Error(ctx, span, err, "unable to get stack")
 				c.HandleAPIError(w, r, apierrors.NewErrPassThroughToClient(err, http.StatusInternalServerError))
@@ -31,7 +31,7 @@ func (c *GetNotificationHandler) ServeHTTP(w http.ResponseWriter, r *http.Reques
 	name, _ := requestutils.GetURLParamString(r, types.URLParamReleaseName)
 	namespace := r.Context().Value(types.NamespaceScope).(string)
 
-	release, err := c.Repo().Release().ReadRelease(cluster.ID, name, namespace)
+	release, err := c.Repo().Release().ReadRelease(r.Context(), cluster.ID, name, namespace)
 	if err != nil {
 		if err == gorm.ErrRecordNotFound {
 			w.WriteHeader(http.StatusNotFound)
@@ -51,7 +51,7 @@ func (c *GetNotificationHandler) ServeHTTP(w http.ResponseWriter, r *http.Reques
 	}
 
 	if release.NotificationConfig != 0 {
-		notifConfig, err := c.Repo().NotificationConfig().ReadNotificationConfig(release.NotificationConfig)
+		notifConfig, err := c.Repo().NotificationConfig().ReadNotificationConfig(r.Context(), release.NotificationConfig)
 		if err != nil {
 			c.HandleAPIError(w, r, apierrors.NewErrInternal(err))
 			return
@@ -31,7 +31,7 @@ func (c *GetWebhookHandler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
 	name, _ := requestutils.GetURLParamString(r, types.URLParamReleaseName)
 	namespace := r.Context().Value(types.NamespaceScope).(string)
 
-	release, err := c.Repo().Release().ReadRelease(cluster.ID, name, namespace)
+	release, err := c.Repo().Release().ReadRelease(r.Context(), cluster.ID, name, namespace)
 	if err != nil {
 		if err == gorm.ErrRecordNotFound {
 			w.WriteHeader(http.StatusNotFound)
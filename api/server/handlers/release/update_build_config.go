@@ -40,7 +40,7 @@ func (c *UpdateBuildConfigHandler) ServeHTTP(w http.ResponseWriter, r *http.Requ
 		return
 	}
 
-	release, err := c.Repo().Release().ReadRelease(cluster.ID, name, namespace)
+	release, err := c.Repo().Release().ReadRelease(r.Context(), cluster.ID, name, namespace)
 	if err != nil {
 		if err == gorm.ErrRecordNotFound {
 			w.WriteHeader(http.StatusNotFound)
@@ -63,7 +63,7 @@ func (c *UpdateBuildConfigHandler) ServeHTTP(w http.ResponseWriter, r *http.Requ
 	}
 
 	buildConfig.ID = release.BuildConfig
-	_, err = c.Repo().BuildConfig().UpdateBuildConfig(buildConfig)
+	_, err = c.Repo().BuildConfig().UpdateBuildConfig(r.Context(), buildConfig)
 
 	if err != nil {
 		c.HandleAPIError(w, r, apierrors.NewErrInternal(err))
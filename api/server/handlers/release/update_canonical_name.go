@@ -53,7 +53,7 @@ func (c *UpdateCanonicalNameHandler) ServeHTTP(w http.ResponseWriter, r *http.Re
 		return
 	}
 
-	release, err := c.Repo().Release().ReadRelease(cluster.ID, helmRelease.Name, helmRelease.Namespace)
+	release, err := c.Repo().Release().ReadRelease(ctx, cluster.ID, helmRelease.Name, helmRelease.Namespace)
 	if err != nil {
 		if errors.Is(err, gorm.ErrRecordNotFound) {
 			err = telemetry.Error(ctx, span, err, "unable to get release")
@@ -77,7 +77,7 @@ func (c *UpdateCanonicalNameHandler) ServeHTTP(w http.ResponseWriter, r *http.Re
 
 		release.CanonicalName = request.CanonicalName
 
-		release, err = c.Repo().Release().UpdateRelease(release)
+		release, err = c.Repo().Release().UpdateRelease(ctx, release)
 
 		if err != nil {
 			err = telemetry.Error(ctx, span, err, "error updating chart")
@@ -48,7 +48,7 @@ func (c *UpdateImageBatchHandler) ServeHTTP(w http.ResponseWriter, r *http.Reque
 		return
 	}
 
-	releases, err := c.Repo().Release().ListReleasesByImageRepoURI(cluster.ID, request.ImageRepoURI)
+	releases, err := c.Repo().Release().ListReleasesByImageRepoURI(r.Context(), cluster.ID, request.ImageRepoURI)
 	if err != nil {
 		c.HandleAPIError(w, r, apierrors.NewErrPassThroughToClient(
 			fmt.Errorf("releases not found with given image repo uri"),
@@ -58,7 +58,7 @@ func (c *UpdateImageBatchHandler) ServeHTTP(w http.ResponseWriter, r *http.Reque
 		return
 	}
 
-	registries, err := c.Repo().Registry().ListRegistriesByProjectID(cluster.ProjectID)
+	registries, err := c.Repo().Registry().ListRegistriesByProjectID(r.Context(), cluster.ProjectID)
 	if err != nil {
 		c.HandleAPIError(w, r, apierrors.NewErrInternal(err))
 		return
@@ -38,7 +38,7 @@ func (c *UpdateNotificationHandler) ServeHTTP(w http.ResponseWriter, r *http.Req
 		return
 	}
 
-	release, err := c.Repo().Release().ReadRelease(cluster.ID, name, namespace)
+	release, err := c.Repo().Release().ReadRelease(r.Context(), cluster.ID, name, namespace)
 	if err != nil {
 		if err == gorm.ErrRecordNotFound {
 			w.WriteHeader(http.StatusNotFound)
@@ -56,7 +56,7 @@ func (c *UpdateNotificationHandler) ServeHTTP(w http.ResponseWriter, r *http.Req
 	}
 
 	if release.NotificationConfig == 0 {
-		newConfig, err = c.Repo().NotificationConfig().CreateNotificationConfig(newConfig)
+		newConfig, err = c.Repo().NotificationConfig().CreateNotificationConfig(r.Context(), newConfig)
 
 		if err != nil {
 			c.HandleAPIError(w, r, apierrors.NewErrInternal(err))
@@ -65,10 +65,10 @@ func (c *UpdateNotificationHandler) ServeHTTP(w http.ResponseWriter, r *http.Req
 
 		release.NotificationConfig = newConfig.ID
 
-		release, err = c.Repo().Release().UpdateRelease(release)
+		release, err = c.Repo().Release().UpdateRelease(r.Context(), release)
 	} else {
 		newConfig.ID = release.NotificationConfig
-		newConfig, err = c.Repo().NotificationConfig().UpdateNotificationConfig(newConfig)
+		newConfig, err = c.Repo().NotificationConfig().UpdateNotificationConfig(r.Context(), newConfig)
 	}
 
 	if err != nil {
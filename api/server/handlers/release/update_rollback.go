@@ -62,10 +62,10 @@ func (c *RollbackReleaseHandler) ServeHTTP(w http.ResponseWriter, r *http.Reques
 
 	// update the github actions env if the release exists and is built from source
 	if cName := helmRelease.Chart.Metadata.Name; cName == "job" || cName == "web" || cName == "worker" {
-		rel, err := c.Repo().Release().ReadRelease(cluster.ID, helmRelease.Name, helmRelease.Namespace)
+		rel, err := c.Repo().Release().ReadRelease(r.Context(), cluster.ID, helmRelease.Name, helmRelease.Namespace)
 
 		if err == nil && rel != nil {
-			err = UpdateReleaseRepo(c.Config(), rel, helmRelease)
+			err = UpdateReleaseRepo(r.Context(), c.Config(), rel, helmRelease)
 
 			if err != nil {
 				c.HandleAPIError(w, r, apierrors.NewErrInternal(err))
@@ -109,7 +109,7 @@ func (c *RollbackReleaseHandler) ServeHTTP(w http.ResponseWriter, r *http.Reques
 	}
 }
 
-func UpdateReleaseRepo(config *config.Config, release *models.Release, helmRelease *release.Release) error {
+func UpdateReleaseRepo(ctx context.Context, config *config.Config, release *models.Release, helmRelease *release.Release) error {
 	repository := helmRelease.Config["image"].(map[string]interface{})["repository"]
 	repoStr, ok := repository.(string)
 
@@ -121,21 +121,21 @@ func UpdateReleaseRepo(config *config.Config, release *models.Release, helmRelea
 		repoStr != "public.ecr.aws/o1j4x7p4/hello-porter" &&
 		repoStr != "public.ecr.aws/o1j4x7p4/hello-porter-job" {
 		release.ImageRepoURI = repoStr
-		_, err := config.Repo.Release().UpdateRelease(release)
+		_, err := config.Repo.Release().UpdateRelease(ctx, release)
 		if err != nil {
 			return err
 		}
 
 		// determine if the git action config is set, and propagate update to that as well
 		if release.GitActionConfig != nil && release.GitActionConfig.ID != 0 {
-			gitActionConfig, err := config.Repo.GitActionConfig().ReadGitActionConfig(release.GitActionConfig.ID)
+			gitActionConfig, err := config.Repo.GitActionConfig().ReadGitActionConfig(ctx, release.GitActionConfig.ID)
 			if err != nil {
 				return err
 			}
 
 			gitActionConfig.ImageRepoURI = repoStr
 
-			err = config.Repo.GitActionConfig().UpdateGitActionConfig(gitActionConfig)
+			err = config.Repo.GitActionConfig().UpdateGitActionConfig(ctx, gitActionConfig)
 
 			if err != nil {
 				return err
@@ -40,7 +40,7 @@ func (c *UpdateReleaseTagsHandler) ServeHTTP(w http.ResponseWriter, r *http.Requ
 		return
 	}
 
-	release, err := c.Repo().Release().ReadRelease(cluster.ID, name, namespace)
+	release, err := c.Repo().Release().ReadRelease(r.Context(), cluster.ID, name, namespace)
 	if err != nil {
 		c.HandleAPIError(w, r, apierrors.NewErrInternal(err))
 		return
@@ -48,21 +48,21 @@ func (c *UpdateReleaseTagsHandler) ServeHTTP(w http.ResponseWriter, r *http.Requ
 
 	tagsToDelete := difference(release.ToReleaseType().Tags, request.Tags)
 
-	err = c.Repo().Tag().UnlinkTagsFromRelease(tagsToDelete, release)
+	err = c.Repo().Tag().UnlinkTagsFromRelease(r.Context(), tagsToDelete, release)
 
 	if err != nil {
 		c.HandleAPIError(w, r, apierrors.NewErrInternal(err))
 		return
 	}
 
-	_, err = c.Repo().Tag().LinkTagsToRelease(request.Tags, release)
+	_, err = c.Repo().Tag().LinkTagsToRelease(r.Context(), request.Tags, release)
 
 	if err != nil {
 		c.HandleAPIError(w, r, apierrors.NewErrInternal(err))
 		return
 	}
 
-	release, err = c.Repo().Release().ReadRelease(cluster.ID, name, namespace)
+	release, err = c.Repo().Release().ReadRelease(r.Context(), cluster.ID, name, namespace)
 
 	if err != nil {
 		c.HandleAPIError(w, r, apierrors.NewErrInternal(err))
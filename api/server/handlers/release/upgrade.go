@@ -57,7 +57,7 @@ func (c *UpgradeReleaseHandler) ServeHTTP(w http.ResponseWriter, r *http.Request
 		return
 	}
 
-	registries, err := c.Repo().Registry().ListRegistriesByProjectID(cluster.ProjectID)
+	registries, err := c.Repo().Registry().ListRegistriesByProjectID(r.Context(), cluster.ProjectID)
 	if err != nil {
 		c.HandleAPIError(w, r, apierrors.NewErrInternal(err))
 		return
@@ -92,7 +92,7 @@ func (c *UpgradeReleaseHandler) ServeHTTP(w http.ResponseWriter, r *http.Request
 			}
 		}
 
-		chart, err := LoadChart(c.Config(), &LoadAddonChartOpts{
+		chart, err := LoadChart(r.Context(), c.Config(), &LoadAddonChartOpts{
 			ProjectID:       cluster.ProjectID,
 			RepoURL:         chartRepoURL,
 			TemplateName:    helmRelease.Chart.Metadata.Name,
@@ -138,7 +138,7 @@ func (c *UpgradeReleaseHandler) ServeHTTP(w http.ResponseWriter, r *http.Request
 	}
 
 	// check if release is part of a stack
-	stacks, err := c.Repo().Stack().ListStacks(cluster.ProjectID, cluster.ID, helmRelease.Namespace)
+	stacks, err := c.Repo().Stack().ListStacks(r.Context(), cluster.ProjectID, cluster.ID, helmRelease.Namespace)
 	if err != nil {
 		c.HandleAPIError(w, r, apierrors.NewErrInternal(err))
 		return
@@ -161,14 +161,14 @@ func (c *UpgradeReleaseHandler) ServeHTTP(w http.ResponseWriter, r *http.Request
 		helmRelease = newHelmRelease
 	}
 
-	slackInts, _ := c.Repo().SlackIntegration().ListSlackIntegrationsByProjectID(cluster.ProjectID)
+	slackInts, _ := c.Repo().SlackIntegration().ListSlackIntegrationsByProjectID(r.Context(), cluster.ProjectID)
 
-	rel, releaseErr := c.Repo().Release().ReadRelease(cluster.ID, helmRelease.Name, helmRelease.Namespace)
+	rel, releaseErr := c.Repo().Release().ReadRelease(r.Context(), cluster.ID, helmRelease.Name, helmRelease.Namespace)
 
 	var notifConf *types.NotificationConfig
 	notifConf = nil
 	if rel != nil && rel.NotificationConfig != 0 {
-		conf, err := c.Repo().NotificationConfig().ReadNotificationConfig(rel.NotificationConfig)
+		conf, err := c.Repo().NotificationConfig().ReadNotificationConfig(r.Context(), rel.NotificationConfig)
 		if err != nil {
 			c.HandleAPIError(w, r, apierrors.NewErrInternal(err))
 			return
@@ -223,7 +223,7 @@ func (c *UpgradeReleaseHandler) ServeHTTP(w http.ResponseWriter, r *http.Request
 	// update the github actions env if the release exists and is built from source
 	if cName := helmRelease.Chart.Metadata.Name; cName == "job" || cName == "web" || cName == "worker" {
 		if releaseErr == nil && rel != nil {
-			err = UpdateReleaseRepo(c.Config(), rel, helmRelease)
+			err = UpdateReleaseRepo(r.Context(), c.Config(), rel, helmRelease)
 
 			if err != nil {
 				c.HandleAPIError(w, r, apierrors.NewErrInternal(err))
@@ -268,7 +268,7 @@ func (c *UpgradeReleaseHandler) ServeHTTP(w http.ResponseWriter, r *http.Request
 
 	c.WriteResult(w, r, nil)
 
-	err = postUpgrade(c.Config(), cluster.ProjectID, cluster.ID, helmRelease)
+	err = postUpgrade(r.Context(), c.Config(), cluster.ProjectID, cluster.ID, helmRelease)
 
 	if err != nil {
 		c.HandleAPIErrorNoWrite(w, r, apierrors.NewErrInternal(err))
@@ -277,7 +277,7 @@ func (c *UpgradeReleaseHandler) ServeHTTP(w http.ResponseWriter, r *http.Request
 }
 
 // postUpgrade runs any necessary scripting after the release has been upgraded.
-func postUpgrade(config *config.Config, projectID, clusterID uint, release *release.Release) error {
+func postUpgrade(ctx context.Context, config *config.Config, projectID, clusterID uint, release *release.Release) error {
 	// update the relevant helm revision number if tied to a stack resource
-	return stacks.UpdateHelmRevision(config, projectID, clusterID, release)
+	return stacks.UpdateHelmRevision(ctx, config, projectID, clusterID, release)
 }
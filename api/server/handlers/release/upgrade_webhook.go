@@ -43,7 +43,7 @@ func (c *WebhookHandler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
 	token, _ := requestutils.GetURLParamString(r, types.URLParamToken)
 
 	// retrieve release by token
-	dbRelease, err := c.Repo().Release().ReadReleaseByWebhookToken(token)
+	dbRelease, err := c.Repo().Release().ReadReleaseByWebhookToken(ctx, token)
 	if err != nil {
 		if err == gorm.ErrRecordNotFound {
 			err = telemetry.Error(ctx, span, err, "release not found with given webhook")
@@ -71,7 +71,7 @@ func (c *WebhookHandler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
 		telemetry.AttributeKV{Key: "project-id", Value: release.ProjectID},
 	)
 
-	cluster, err := c.Repo().Cluster().ReadCluster(release.ProjectID, release.ClusterID)
+	cluster, err := c.Repo().Cluster().ReadCluster(ctx, release.ProjectID, release.ClusterID)
 	if err != nil {
 		if err == gorm.ErrRecordNotFound {
 			err = telemetry.Error(ctx, span, err, "cluster not found for upgrade webhook")
@@ -136,7 +136,7 @@ func (c *WebhookHandler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
-	registries, err := c.Repo().Registry().ListRegistriesByProjectID(release.ProjectID)
+	registries, err := c.Repo().Registry().ListRegistriesByProjectID(ctx, release.ProjectID)
 	if err != nil {
 		err = telemetry.Error(ctx, span, err, "unable to list registries for upgrade webhook")
 		c.HandleAPIError(w, r, apierrors.NewErrPassThroughToClient(err, http.StatusInternalServerError))
@@ -151,12 +151,12 @@ func (c *WebhookHandler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
 		Values:     rel.Config,
 	}
 
-	slackInts, _ := c.Repo().SlackIntegration().ListSlackIntegrationsByProjectID(release.ProjectID)
+	slackInts, _ := c.Repo().SlackIntegration().ListSlackIntegrationsByProjectID(ctx, release.ProjectID)
 
 	var notifConf *types.NotificationConfig
 	notifConf = nil
 	if release.NotificationConfig != 0 {
-		conf, err := c.Repo().NotificationConfig().ReadNotificationConfig(release.NotificationConfig)
+		conf, err := c.Repo().NotificationConfig().ReadNotificationConfig(ctx, release.NotificationConfig)
 		if err != nil {
 			err = telemetry.Error(ctx, span, err, "unable to read notification config for upgrade webhook")
 			c.HandleAPIError(w, r, apierrors.NewErrPassThroughToClient(err, http.StatusInternalServerError))
@@ -220,7 +220,7 @@ func (c *WebhookHandler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
 
 	c.WriteResult(w, r, nil)
 
-	err = postUpgrade(c.Config(), cluster.ProjectID, cluster.ID, rel)
+	err = postUpgrade(ctx, c.Config(), cluster.ProjectID, cluster.ID, rel)
 	if err != nil {
 		err = telemetry.Error(ctx, span, err, "error while running post upgrade hooks")
 		c.HandleAPIError(w, r, apierrors.NewErrPassThroughToClient(err, http.StatusInternalServerError))
@@ -0,0 +1,307 @@
+// Package rpc serves the Connect API defined in api/rpc. Each procedure authorizes the request the same way as the matching
+// REST endpoint, then calls the service function shared with that endpoint.
+package rpc
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+
+	"connectrpc.com/connect"
+	otelconnect "connectrpc.com/otelconnect"
+	"google.golang.org/protobuf/types/known/structpb"
+	"gorm.io/gorm"
+
+	apirpc "github.com/porter-dev/porter/api/rpc"
+	"github.com/porter-dev/porter/api/server/authn"
+	"github.com/porter-dev/porter/api/server/authz"
+	"github.com/porter-dev/porter/api/server/authz/policy"
+	"github.com/porter-dev/porter/api/server/handlers/cluster"
+	"github.com/porter-dev/porter/api/server/handlers/porter_app"
+	"github.com/porter-dev/porter/api/server/handlers/project"
+	"github.com/porter-dev/porter/api/server/shared/apierrors"
+	"github.com/porter-dev/porter/api/server/shared/config"
+	"github.com/porter-dev/porter/api/types"
+	"github.com/porter-dev/porter/internal/kubernetes"
+	"github.com/porter-dev/porter/internal/models"
+	"github.com/porter-dev/porter/internal/quota"
+)
+
+// Handler implements the procedures of the Connect API
+type Handler struct {
+	config      *config.Config
+	loader      policy.PolicyDocumentLoader
+	agentGetter authz.KubernetesAgentGetter
+}
+
+// NewHandler returns an http.Handler which serves the Connect API. Requests are authenticated in the same way as requests
+// to the REST API, so the handler should be mounted under the API path.
+func NewHandler(config *config.Config) http.Handler {
+	h := &Handler{
+		config:      config,
+		loader:      policy.NewBasicPolicyDocumentLoader(config.Repo.Project(), config.Repo.Policy()),
+		agentGetter: authz.NewOutOfClusterAgentGetter(config),
+	}
+
+	opts := []connect.HandlerOption{
+		connect.WithCodec(apirpc.Codec{}),
+		connect.WithInterceptors(otelconnect.NewInterceptor()),
+	}
+
+	mux := http.NewServeMux()
+	mux.Handle(apirpc.ProjectServiceListProjectsProcedure, connect.NewUnaryHandler(apirpc.ProjectServiceListProjectsProcedure, h.ListProjects, opts...))
+	mux.Handle(apirpc.ProjectServiceGetProjectProcedure, connect.NewUnaryHandler(apirpc.ProjectServiceGetProjectProcedure, h.GetProject, opts...))
+	mux.Handle(apirpc.ClusterServiceListClustersProcedure, connect.NewUnaryHandler(apirpc.ClusterServiceListClustersProcedure, h.ListClusters, opts...))
+	mux.Handle(apirpc.AppServiceListAppsProcedure, connect.NewUnaryHandler(apirpc.AppServiceListAppsProcedure, h.ListApps, opts...))
+	mux.Handle(apirpc.AppServiceValidateAppProcedure, connect.NewUnaryHandler(apirpc.AppServiceValidateAppProcedure, h.ValidateApp, opts...))
+	mux.Handle(apirpc.AppServiceApplyAppProcedure, connect.NewUnaryHandler(apirpc.AppServiceApplyAppProcedure, h.ApplyApp, opts...))
+
+	return authn.NewAuthNFactory(config).NewAuthenticated(mux)
+}
+
+// ListProjects lists the projects of the authenticated user
+func (h *Handler) ListProjects(ctx context.Context, req *connect.Request[apirpc.ListProjectsRequest]) (*connect.Response[apirpc.ListProjectsResponse], error) {
+	user, ok := ctx.Value(types.UserScope).(*models.User)
+	if !ok || user == nil {
+		return nil, connect.NewError(connect.CodeUnauthenticated, fmt.Errorf("request is not authenticated"))
+	}
+
+	projects, err := project.ListUserProjects(ctx, h.config.Repo, user)
+	if err != nil {
+		return nil, connectError(err)
+	}
+
+	return connect.NewResponse(&apirpc.ListProjectsResponse{Projects: projects}), nil
+}
+
+// GetProject reads a project
+func (h *Handler) GetProject(ctx context.Context, req *connect.Request[apirpc.GetProjectRequest]) (*connect.Response[apirpc.GetProjectResponse], error) {
+	ctx, err := h.authorize(ctx, req.Msg.ProjectID, 0, types.APIVerbGet)
+	if err != nil {
+		return nil, err
+	}
+
+	proj, _ := ctx.Value(types.ProjectScope).(*models.Project)
+
+	return connect.NewResponse(&apirpc.GetProjectResponse{Project: proj.ToProjectType()}), nil
+}
+
+// ListClusters lists the clusters of a project
+func (h *Handler) ListClusters(ctx context.Context, req *connect.Request[apirpc.ListClustersRequest]) (*connect.Response[apirpc.ListClustersResponse], error) {
+	ctx, err := h.authorize(ctx, req.Msg.ProjectID, 0, types.APIVerbList)
+	if err != nil {
+		return nil, err
+	}
+
+	proj, _ := ctx.Value(types.ProjectScope).(*models.Project)
+
+	clusters, err := cluster.ListClusters(ctx, h.config.Repo, proj)
+	if err != nil {
+		return nil, connectError(err)
+	}
+
+	return connect.NewResponse(&apirpc.ListClustersResponse{Clusters: clusters}), nil
+}
+
+// ListApps lists the apps of a cluster
+func (h *Handler) ListApps(ctx context.Context, req *connect.Request[apirpc.ListAppsRequest]) (*connect.Response[apirpc.ListAppsResponse], error) {
+	ctx, err := h.authorize(ctx, req.Msg.ProjectID, req.Msg.ClusterID, types.APIVerbList)
+	if err != nil {
+		return nil, err
+	}
+
+	cl, _ := ctx.Value(types.ClusterScope).(*models.Cluster)
+
+	apps, err := porter_app.ListApps(ctx, h.config.Repo, cl)
+	if err != nil {
+		return nil, connectError(err)
+	}
+
+	return connect.NewResponse(&apirpc.ListAppsResponse{Apps: apps}), nil
+}
+
+// ValidateApp validates an app definition
+func (h *Handler) ValidateApp(ctx context.Context, req *connect.Request[apirpc.ValidateAppRequest]) (*connect.Response[apirpc.ValidateAppResponse], error) {
+	ctx, err := h.authorize(ctx, req.Msg.ProjectID, req.Msg.ClusterID, types.APIVerbGet)
+	if err != nil {
+		return nil, err
+	}
+
+	proj, _ := ctx.Value(types.ProjectScope).(*models.Project)
+	cl, _ := ctx.Value(types.ClusterScope).(*models.Cluster)
+
+	res, err := porter_app.ValidateApp(ctx, h.config, porter_app.ValidateAppInput{
+		Project:            proj,
+		Cluster:            cl,
+		Base64AppProto:     req.Msg.Base64AppProto,
+		DeploymentTargetID: req.Msg.DeploymentTargetID,
+		CommitSHA:          req.Msg.CommitSHA,
+	})
+	if err != nil {
+		return nil, connectError(err)
+	}
+
+	return connect.NewResponse(&apirpc.ValidateAppResponse{ValidatedBase64AppProto: res.ValidatedBase64AppProto}), nil
+}
+
+// ApplyApp applies an app definition or revision
+func (h *Handler) ApplyApp(ctx context.Context, req *connect.Request[apirpc.ApplyAppRequest]) (*connect.Response[apirpc.ApplyAppResponse], error) {
+	ctx, err := h.authorize(ctx, req.Msg.ProjectID, req.Msg.ClusterID, types.APIVerbUpdate)
+	if err != nil {
+		return nil, err
+	}
+
+	proj, _ := ctx.Value(types.ProjectScope).(*models.Project)
+	cl, _ := ctx.Value(types.ClusterScope).(*models.Cluster)
+
+	res, err := porter_app.ApplyApp(ctx, h.config, porter_app.ApplyAppInput{
+		Project: proj,
+		Cluster: cl,
+		GetAgent: func(namespace string) (*kubernetes.Agent, error) {
+			// the agent getter only reads the scopes of the request from its context
+			r, err := http.NewRequestWithContext(ctx, http.MethodPost, apirpc.AppServiceApplyAppProcedure, nil)
+			if err != nil {
+				return nil, err
+			}
+
+			return h.agentGetter.GetAgent(r, cl, namespace)
+		},
+		Base64AppProto:     req.Msg.Base64AppProto,
+		DeploymentTargetID: req.Msg.DeploymentTargetID,
+		AppRevisionID:      req.Msg.AppRevisionID,
+		AppName:            req.Msg.AppName,
+		CommitSHA:          req.Msg.CommitSHA,
+		GitBranch:          req.Msg.GitBranch,
+		GitRepoURL:         req.Msg.GitRepoURL,
+		IfMatch:            req.Msg.IfMatch,
+	})
+	if err != nil {
+		return nil, connectError(err)
+	}
+
+	return connect.NewResponse(&apirpc.ApplyAppResponse{
+		AppRevisionID:  res.AppRevisionId,
+		CLIAction:      res.CLIAction,
+		ConfigRevision: res.ConfigRevision,
+	}), nil
+}
+
+// authorize checks that the policy of the authenticated user or api token permits the action on the project, and on the
+// cluster if clusterID is set. It returns a context with the project and cluster, as set by the scoped middlewares of the
+// REST API.
+func (h *Handler) authorize(ctx context.Context, projectID uint, clusterID uint, verb types.APIVerb) (context.Context, error) {
+	if projectID == 0 {
+		return nil, connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("project_id is required"))
+	}
+
+	reqScopes := map[types.PermissionScope]*types.RequestAction{
+		types.ProjectScope: {
+			Verb:     verb,
+			Resource: types.NameOrUInt{UInt: projectID},
+		},
+	}
+
+	if clusterID != 0 {
+		reqScopes[types.ClusterScope] = &types.RequestAction{
+			Verb:     verb,
+			Resource: types.NameOrUInt{UInt: clusterID},
+		}
+	}
+
+	ctx, reqErr := authz.AuthorizeRequestScopes(ctx, h.loader, reqScopes)
+	if reqErr != nil {
+		return nil, connectError(reqErr)
+	}
+
+	proj, err := h.config.Repo.Project().ReadProject(ctx, projectID)
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, connect.NewError(connect.CodePermissionDenied, fmt.Errorf("project not found with id %d", projectID))
+		}
+
+		return nil, connectError(err)
+	}
+
+	ctx = authz.NewProjectContext(ctx, proj)
+
+	if clusterID == 0 {
+		return ctx, nil
+	}
+
+	cl, err := h.config.Repo.Cluster().ReadCluster(ctx, projectID, clusterID)
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, connect.NewError(connect.CodePermissionDenied, fmt.Errorf("cluster not found with id %d", clusterID))
+		}
+
+		return nil, connectError(err)
+	}
+
+	return authz.NewClusterContext(ctx, cl), nil
+}
+
+// connectError converts an error returned by a service function into a Connect error. Only the external message of request
+// errors is returned to the client.
+func connectError(err error) error {
+	var quotaErr *quota.ExceededError
+	if errors.As(err, &quotaErr) {
+		connectErr := connect.NewError(connect.CodeResourceExhausted, quotaErr)
+
+		if detail, err := quotaErrorDetail(quotaErr); err == nil {
+			connectErr.AddDetail(detail)
+		}
+
+		return connectErr
+	}
+
+	var reqErr apierrors.RequestError
+	if !errors.As(err, &reqErr) {
+		reqErr = apierrors.NewErrInternal(err)
+	}
+
+	return connect.NewError(connectCode(reqErr.GetStatusCode()), errors.New(reqErr.ExternalError()))
+}
+
+// connectCode returns the Connect code of an HTTP status code
+func connectCode(statusCode int) connect.Code {
+	switch statusCode {
+	case http.StatusBadRequest:
+		return connect.CodeInvalidArgument
+	case http.StatusUnauthorized:
+		return connect.CodeUnauthenticated
+	case http.StatusForbidden:
+		return connect.CodePermissionDenied
+	case http.StatusNotFound:
+		return connect.CodeNotFound
+	case http.StatusConflict:
+		return connect.CodeAlreadyExists
+	case http.StatusPreconditionRequired:
+		return connect.CodeFailedPrecondition
+	case http.StatusTooManyRequests:
+		return connect.CodeResourceExhausted
+	default:
+		return connect.CodeInternal
+	}
+}
+
+// quotaErrorDetail returns the exceeded quota of a quota error as an error detail
+func quotaErrorDetail(quotaErr *quota.ExceededError) (*connect.ErrorDetail, error) {
+	encoded, err := json.Marshal(quotaErr.Details)
+	if err != nil {
+		return nil, err
+	}
+
+	values := make(map[string]any)
+	if err := json.Unmarshal(encoded, &values); err != nil {
+		return nil, err
+	}
+
+	detail, err := structpb.NewStruct(values)
+	if err != nil {
+		return nil, err
+	}
+
+	return connect.NewErrorDetail(detail)
+}
@@ -0,0 +1,103 @@
+package rpc_test
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"connectrpc.com/connect"
+
+	apirpc "github.com/porter-dev/porter/api/rpc"
+	"github.com/porter-dev/porter/api/server/handlers/project"
+	"github.com/porter-dev/porter/api/server/handlers/rpc"
+	"github.com/porter-dev/porter/api/server/shared/apitest"
+	"github.com/porter-dev/porter/internal/models"
+)
+
+func TestProjectAndClusterProcedures(t *testing.T) {
+	config := apitest.LoadConfig(t)
+	user := apitest.CreateTestUser(t, config, true)
+
+	proj, _, err := project.CreateProjectWithUser(context.Background(), config.Repo.Project(), &models.Project{
+		Name: "test-project",
+	}, user)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	otherProj, err := config.Repo.Project().CreateProject(context.Background(), &models.Project{
+		Name: "other-project",
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	cluster, err := config.Repo.Cluster().CreateCluster(context.Background(), &models.Cluster{
+		ProjectID: proj.ID,
+		Name:      "test-cluster",
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	server := httptest.NewServer(http.StripPrefix("/api/rpc", rpc.NewHandler(config)))
+	defer server.Close()
+
+	client := apirpc.NewClient(server.Client(), server.URL+"/api/rpc", apitest.AuthenticateUserWithToken(t, config, user.ID))
+	ctx := context.Background()
+
+	projects, err := client.ListProjects(ctx, &apirpc.ListProjectsRequest{})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if len(projects.Projects) != 1 || projects.Projects[0].ID != proj.ID {
+		t.Fatalf("expected only project %d to be listed, got %v", proj.ID, projects.Projects)
+	}
+
+	getResp, err := client.GetProject(ctx, &apirpc.GetProjectRequest{ProjectID: proj.ID})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if getResp.Project == nil || getResp.Project.Name != "test-project" {
+		t.Fatalf("expected project test-project, got %v", getResp.Project)
+	}
+
+	clusters, err := client.ListClusters(ctx, &apirpc.ListClustersRequest{ProjectID: proj.ID})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if len(clusters.Clusters) != 1 || clusters.Clusters[0].ID != cluster.ID {
+		t.Fatalf("expected only cluster %d to be listed, got %v", cluster.ID, clusters.Clusters)
+	}
+
+	_, err = client.GetProject(ctx, &apirpc.GetProjectRequest{ProjectID: otherProj.ID})
+	if code := connect.CodeOf(err); code != connect.CodePermissionDenied {
+		t.Fatalf("expected permission denied reading a project without a role, got %v", err)
+	}
+
+	_, err = client.GetProject(ctx, &apirpc.GetProjectRequest{})
+	if code := connect.CodeOf(err); code != connect.CodeInvalidArgument {
+		t.Fatalf("expected invalid argument reading a project without an id, got %v", err)
+	}
+}
+
+func TestProceduresRequireAuthentication(t *testing.T) {
+	config := apitest.LoadConfig(t)
+
+	server := httptest.NewServer(http.StripPrefix("/api/rpc", rpc.NewHandler(config)))
+	defer server.Close()
+
+	client := apirpc.NewClient(server.Client(), server.URL+"/api/rpc", "")
+
+	_, err := client.ListProjects(context.Background(), &apirpc.ListProjectsRequest{})
+
+	var connectErr *connect.Error
+	if !errors.As(err, &connectErr) {
+		t.Fatalf("expected a connect error listing projects without a token, got %v", err)
+	}
+}
@@ -0,0 +1,89 @@
+package scim
+
+import (
+	"net/http"
+	"strings"
+
+	"github.com/porter-dev/porter/api/server/handlers"
+	"github.com/porter-dev/porter/api/server/shared"
+	"github.com/porter-dev/porter/api/server/shared/apierrors"
+	"github.com/porter-dev/porter/api/server/shared/config"
+	"github.com/porter-dev/porter/api/types"
+	"github.com/porter-dev/porter/internal/models"
+	"github.com/porter-dev/porter/internal/telemetry"
+)
+
+// CreateGroupHandler handles POST requests to the /scim/v2/Groups endpoint
+type CreateGroupHandler struct {
+	handlers.PorterHandlerReadWriter
+}
+
+// NewCreateGroupHandler returns a new CreateGroupHandler
+func NewCreateGroupHandler(
+	config *config.Config,
+	decoderValidator shared.RequestDecoderValidator,
+	writer shared.ResultWriter,
+) *CreateGroupHandler {
+	return &CreateGroupHandler{
+		PorterHandlerReadWriter: handlers.NewDefaultPorterHandler(config, decoderValidator, writer),
+	}
+}
+
+// ServeHTTP creates a group of provisioned users, and gives its members the project role mapped to the group
+func (c *CreateGroupHandler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	ctx, span := telemetry.NewSpan(r.Context(), "serve-scim-create-group")
+	defer span.End()
+
+	project, _ := ctx.Value(types.ProjectScope).(*models.Project)
+	telemetry.WithAttributes(span, telemetry.AttributeKV{Key: "project-id", Value: project.ID})
+
+	request := &types.SCIMGroup{}
+	if ok := c.DecodeAndValidate(w, r, request); !ok {
+		err := telemetry.Error(ctx, span, nil, "error decoding request")
+		c.HandleAPIError(w, r, apierrors.NewErrPassThroughToClient(err, http.StatusBadRequest))
+		return
+	}
+
+	groups, err := c.Repo().SCIM().ListSCIMGroups(ctx, project.ID)
+	if err != nil {
+		err := telemetry.Error(ctx, span, err, "error listing groups")
+		c.HandleAPIError(w, r, apierrors.NewErrPassThroughToClient(err, http.StatusInternalServerError))
+		return
+	}
+
+	for _, group := range groups {
+		if strings.EqualFold(group.DisplayName, request.DisplayName) {
+			err := telemetry.Error(ctx, span, nil, "group already exists")
+			c.HandleAPIError(w, r, apierrors.NewErrPassThroughToClient(err, http.StatusConflict))
+			return
+		}
+	}
+
+	members, reqErr := groupMembers(ctx, c.Repo(), project, request.Members)
+	if reqErr != nil {
+		_ = telemetry.Error(ctx, span, reqErr, "error reading group members")
+		c.HandleAPIError(w, r, reqErr)
+		return
+	}
+
+	group, err := c.Repo().SCIM().CreateSCIMGroup(ctx, &models.SCIMGroup{
+		ProjectID:   project.ID,
+		ExternalID:  request.ExternalID,
+		DisplayName: request.DisplayName,
+		Members:     members,
+	})
+	if err != nil {
+		err := telemetry.Error(ctx, span, err, "error creating scim group")
+		c.HandleAPIError(w, r, apierrors.NewErrPassThroughToClient(err, http.StatusInternalServerError))
+		return
+	}
+
+	if err := syncProjectRoles(ctx, c.Repo(), project, memberIDs(group.Members)); err != nil {
+		err := telemetry.Error(ctx, span, err, "error syncing project roles")
+		c.HandleAPIError(w, r, apierrors.NewErrPassThroughToClient(err, http.StatusInternalServerError))
+		return
+	}
+
+	w.WriteHeader(http.StatusCreated)
+	c.WriteResult(w, r, toSCIMGroup(group))
+}
@@ -0,0 +1,86 @@
+package scim
+
+import (
+	"net/http"
+	"strings"
+
+	"github.com/porter-dev/porter/api/server/handlers"
+	"github.com/porter-dev/porter/api/server/shared"
+	"github.com/porter-dev/porter/api/server/shared/apierrors"
+	"github.com/porter-dev/porter/api/server/shared/config"
+	"github.com/porter-dev/porter/api/types"
+	"github.com/porter-dev/porter/internal/models"
+	"github.com/porter-dev/porter/internal/telemetry"
+)
+
+// CreateGroupRoleMappingHandler handles POST requests to the /scim/group-role-mappings endpoint
+type CreateGroupRoleMappingHandler struct {
+	handlers.PorterHandlerReadWriter
+}
+
+// NewCreateGroupRoleMappingHandler returns a new CreateGroupRoleMappingHandler
+func NewCreateGroupRoleMappingHandler(
+	config *config.Config,
+	decoderValidator shared.RequestDecoderValidator,
+	writer shared.ResultWriter,
+) *CreateGroupRoleMappingHandler {
+	return &CreateGroupRoleMappingHandler{
+		PorterHandlerReadWriter: handlers.NewDefaultPorterHandler(config, decoderValidator, writer),
+	}
+}
+
+// ServeHTTP maps an identity provider group to a project role, and resyncs the project roles of all provisioned users
+func (c *CreateGroupRoleMappingHandler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	ctx, span := telemetry.NewSpan(r.Context(), "serve-create-scim-group-role-mapping")
+	defer span.End()
+
+	project, _ := ctx.Value(types.ProjectScope).(*models.Project)
+	telemetry.WithAttributes(span, telemetry.AttributeKV{Key: "project-id", Value: project.ID})
+
+	request := &types.CreateSCIMGroupRoleMappingRequest{}
+	if ok := c.DecodeAndValidate(w, r, request); !ok {
+		err := telemetry.Error(ctx, span, nil, "error decoding request")
+		c.HandleAPIError(w, r, apierrors.NewErrPassThroughToClient(err, http.StatusBadRequest))
+		return
+	}
+
+	telemetry.WithAttributes(span,
+		telemetry.AttributeKV{Key: "group-display-name", Value: request.GroupDisplayName},
+		telemetry.AttributeKV{Key: "role-kind", Value: string(request.RoleKind)},
+	)
+
+	mappings, err := c.Repo().SCIM().ListSCIMGroupRoleMappings(ctx, project.ID)
+	if err != nil {
+		err := telemetry.Error(ctx, span, err, "error listing group role mappings")
+		c.HandleAPIError(w, r, apierrors.NewErrPassThroughToClient(err, http.StatusInternalServerError))
+		return
+	}
+
+	for _, mapping := range mappings {
+		if strings.EqualFold(mapping.GroupDisplayName, request.GroupDisplayName) {
+			err := telemetry.Error(ctx, span, nil, "group is already mapped to a role")
+			c.HandleAPIError(w, r, apierrors.NewErrPassThroughToClient(err, http.StatusConflict))
+			return
+		}
+	}
+
+	mapping, err := c.Repo().SCIM().CreateSCIMGroupRoleMapping(ctx, &models.SCIMGroupRoleMapping{
+		ProjectID:        project.ID,
+		GroupDisplayName: request.GroupDisplayName,
+		RoleKind:         request.RoleKind,
+	})
+	if err != nil {
+		err := telemetry.Error(ctx, span, err, "error creating group role mapping")
+		c.HandleAPIError(w, r, apierrors.NewErrPassThroughToClient(err, http.StatusInternalServerError))
+		return
+	}
+
+	if err := syncAllProjectRoles(ctx, c.Repo(), project); err != nil {
+		err := telemetry.Error(ctx, span, err, "error syncing project roles")
+		c.HandleAPIError(w, r, apierrors.NewErrPassThroughToClient(err, http.StatusInternalServerError))
+		return
+	}
+
+	w.WriteHeader(http.StatusCreated)
+	c.WriteResult(w, r, mapping.ToSCIMGroupRoleMappingType())
+}
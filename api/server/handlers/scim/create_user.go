@@ -0,0 +1,117 @@
+package scim
+
+import (
+	"errors"
+	"net/http"
+	"strings"
+
+	"gorm.io/gorm"
+
+	"github.com/porter-dev/porter/api/server/handlers"
+	"github.com/porter-dev/porter/api/server/shared"
+	"github.com/porter-dev/porter/api/server/shared/apierrors"
+	"github.com/porter-dev/porter/api/server/shared/config"
+	"github.com/porter-dev/porter/api/types"
+	"github.com/porter-dev/porter/internal/models"
+	"github.com/porter-dev/porter/internal/telemetry"
+)
+
+// CreateUserHandler handles POST requests to the /scim/v2/Users endpoint
+type CreateUserHandler struct {
+	handlers.PorterHandlerReadWriter
+}
+
+// NewCreateUserHandler returns a new CreateUserHandler
+func NewCreateUserHandler(
+	config *config.Config,
+	decoderValidator shared.RequestDecoderValidator,
+	writer shared.ResultWriter,
+) *CreateUserHandler {
+	return &CreateUserHandler{
+		PorterHandlerReadWriter: handlers.NewDefaultPorterHandler(config, decoderValidator, writer),
+	}
+}
+
+// ServeHTTP provisions a user into the project. The user is linked to the Porter user with the same email, which is created
+// if it does not exist, and is given a project role based on its groups.
+func (c *CreateUserHandler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	ctx, span := telemetry.NewSpan(r.Context(), "serve-scim-create-user")
+	defer span.End()
+
+	project, _ := ctx.Value(types.ProjectScope).(*models.Project)
+	telemetry.WithAttributes(span, telemetry.AttributeKV{Key: "project-id", Value: project.ID})
+
+	request := &types.SCIMUser{}
+	if ok := c.DecodeAndValidate(w, r, request); !ok {
+		err := telemetry.Error(ctx, span, nil, "error decoding request")
+		c.HandleAPIError(w, r, apierrors.NewErrPassThroughToClient(err, http.StatusBadRequest))
+		return
+	}
+
+	users, err := c.Repo().SCIM().ListSCIMUsers(ctx, project.ID)
+	if err != nil {
+		err := telemetry.Error(ctx, span, err, "error listing users")
+		c.HandleAPIError(w, r, apierrors.NewErrPassThroughToClient(err, http.StatusInternalServerError))
+		return
+	}
+
+	for _, user := range users {
+		if strings.EqualFold(user.UserName, request.UserName) {
+			err := telemetry.Error(ctx, span, nil, "user already exists")
+			c.HandleAPIError(w, r, apierrors.NewErrPassThroughToClient(err, http.StatusConflict))
+			return
+		}
+	}
+
+	var givenName, familyName string
+	if request.Name != nil {
+		givenName = request.Name.GivenName
+		familyName = request.Name.FamilyName
+	}
+
+	porterUser, err := c.Repo().User().ReadUserByEmail(ctx, request.UserName)
+	if err != nil && !errors.Is(err, gorm.ErrRecordNotFound) {
+		err := telemetry.Error(ctx, span, err, "error reading user by email")
+		c.HandleAPIError(w, r, apierrors.NewErrPassThroughToClient(err, http.StatusInternalServerError))
+		return
+	}
+
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		// the identity provider has verified the email of the user
+		porterUser, err = c.Repo().User().CreateUser(ctx, &models.User{
+			Email:         request.UserName,
+			EmailVerified: true,
+			FirstName:     givenName,
+			LastName:      familyName,
+		})
+		if err != nil {
+			err := telemetry.Error(ctx, span, err, "error creating user")
+			c.HandleAPIError(w, r, apierrors.NewErrPassThroughToClient(err, http.StatusInternalServerError))
+			return
+		}
+	}
+
+	user, err := c.Repo().SCIM().CreateSCIMUser(ctx, &models.SCIMUser{
+		ProjectID:  project.ID,
+		UserID:     porterUser.ID,
+		ExternalID: request.ExternalID,
+		UserName:   request.UserName,
+		GivenName:  givenName,
+		FamilyName: familyName,
+		Active:     request.Active == nil || *request.Active,
+	})
+	if err != nil {
+		err := telemetry.Error(ctx, span, err, "error creating scim user")
+		c.HandleAPIError(w, r, apierrors.NewErrPassThroughToClient(err, http.StatusInternalServerError))
+		return
+	}
+
+	if err := syncProjectRole(ctx, c.Repo(), project, user); err != nil {
+		err := telemetry.Error(ctx, span, err, "error syncing project role")
+		c.HandleAPIError(w, r, apierrors.NewErrPassThroughToClient(err, http.StatusInternalServerError))
+		return
+	}
+
+	w.WriteHeader(http.StatusCreated)
+	c.WriteResult(w, r, toSCIMUser(user))
+}
@@ -0,0 +1,60 @@
+package scim
+
+import (
+	"net/http"
+
+	"github.com/porter-dev/porter/api/server/handlers"
+	"github.com/porter-dev/porter/api/server/shared"
+	"github.com/porter-dev/porter/api/server/shared/apierrors"
+	"github.com/porter-dev/porter/api/server/shared/config"
+	"github.com/porter-dev/porter/api/types"
+	"github.com/porter-dev/porter/internal/models"
+	"github.com/porter-dev/porter/internal/telemetry"
+)
+
+// DeleteGroupHandler handles DELETE requests to the /scim/v2/Groups/{scim_group_id} endpoint
+type DeleteGroupHandler struct {
+	handlers.PorterHandlerWriter
+}
+
+// NewDeleteGroupHandler returns a new DeleteGroupHandler
+func NewDeleteGroupHandler(
+	config *config.Config,
+	writer shared.ResultWriter,
+) *DeleteGroupHandler {
+	return &DeleteGroupHandler{
+		PorterHandlerWriter: handlers.NewDefaultPorterHandler(config, nil, writer),
+	}
+}
+
+// ServeHTTP deletes a group, and resyncs the project roles of its former members
+func (c *DeleteGroupHandler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	ctx, span := telemetry.NewSpan(r.Context(), "serve-scim-delete-group")
+	defer span.End()
+
+	project, _ := ctx.Value(types.ProjectScope).(*models.Project)
+	telemetry.WithAttributes(span, telemetry.AttributeKV{Key: "project-id", Value: project.ID})
+
+	group, reqErr := scimGroupFromURL(r, c.Repo(), project)
+	if reqErr != nil {
+		_ = telemetry.Error(ctx, span, reqErr, "error reading group")
+		c.HandleAPIError(w, r, reqErr)
+		return
+	}
+
+	formerMembers := memberIDs(group.Members)
+
+	if err := c.Repo().SCIM().DeleteSCIMGroup(ctx, group); err != nil {
+		err := telemetry.Error(ctx, span, err, "error deleting scim group")
+		c.HandleAPIError(w, r, apierrors.NewErrPassThroughToClient(err, http.StatusInternalServerError))
+		return
+	}
+
+	if err := syncProjectRoles(ctx, c.Repo(), project, formerMembers); err != nil {
+		err := telemetry.Error(ctx, span, err, "error syncing project roles")
+		c.HandleAPIError(w, r, apierrors.NewErrPassThroughToClient(err, http.StatusInternalServerError))
+		return
+	}
+
+	w.WriteHeader(http.StatusNoContent)
+}
@@ -0,0 +1,75 @@
+package scim
+
+import (
+	"errors"
+	"net/http"
+
+	"gorm.io/gorm"
+
+	"github.com/porter-dev/porter/api/server/handlers"
+	"github.com/porter-dev/porter/api/server/shared"
+	"github.com/porter-dev/porter/api/server/shared/apierrors"
+	"github.com/porter-dev/porter/api/server/shared/config"
+	"github.com/porter-dev/porter/api/server/shared/requestutils"
+	"github.com/porter-dev/porter/api/types"
+	"github.com/porter-dev/porter/internal/models"
+	"github.com/porter-dev/porter/internal/telemetry"
+)
+
+// DeleteGroupRoleMappingHandler handles DELETE requests to the /scim/group-role-mappings/{scim_group_role_mapping_id} endpoint
+type DeleteGroupRoleMappingHandler struct {
+	handlers.PorterHandlerWriter
+}
+
+// NewDeleteGroupRoleMappingHandler returns a new DeleteGroupRoleMappingHandler
+func NewDeleteGroupRoleMappingHandler(
+	config *config.Config,
+	writer shared.ResultWriter,
+) *DeleteGroupRoleMappingHandler {
+	return &DeleteGroupRoleMappingHandler{
+		PorterHandlerWriter: handlers.NewDefaultPorterHandler(config, nil, writer),
+	}
+}
+
+// ServeHTTP deletes a group role mapping, and resyncs the project roles of all provisioned users
+func (c *DeleteGroupRoleMappingHandler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	ctx, span := telemetry.NewSpan(r.Context(), "serve-delete-scim-group-role-mapping")
+	defer span.End()
+
+	project, _ := ctx.Value(types.ProjectScope).(*models.Project)
+	telemetry.WithAttributes(span, telemetry.AttributeKV{Key: "project-id", Value: project.ID})
+
+	id, reqErr := requestutils.GetURLParamUint(r, types.URLParamSCIMGroupRoleMappingID)
+	if reqErr != nil {
+		_ = telemetry.Error(ctx, span, reqErr, "error parsing group role mapping id")
+		c.HandleAPIError(w, r, reqErr)
+		return
+	}
+
+	mapping, err := c.Repo().SCIM().ReadSCIMGroupRoleMapping(ctx, project.ID, id)
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			err := telemetry.Error(ctx, span, err, "group role mapping not found")
+			c.HandleAPIError(w, r, apierrors.NewErrNotFound(err))
+			return
+		}
+
+		err := telemetry.Error(ctx, span, err, "error reading group role mapping")
+		c.HandleAPIError(w, r, apierrors.NewErrPassThroughToClient(err, http.StatusInternalServerError))
+		return
+	}
+
+	if err := c.Repo().SCIM().DeleteSCIMGroupRoleMapping(ctx, mapping); err != nil {
+		err := telemetry.Error(ctx, span, err, "error deleting group role mapping")
+		c.HandleAPIError(w, r, apierrors.NewErrPassThroughToClient(err, http.StatusInternalServerError))
+		return
+	}
+
+	if err := syncAllProjectRoles(ctx, c.Repo(), project); err != nil {
+		err := telemetry.Error(ctx, span, err, "error syncing project roles")
+		c.HandleAPIError(w, r, apierrors.NewErrPassThroughToClient(err, http.StatusInternalServerError))
+		return
+	}
+
+	w.WriteHeader(http.StatusNoContent)
+}
@@ -0,0 +1,60 @@
+package scim
+
+import (
+	"net/http"
+
+	"github.com/porter-dev/porter/api/server/handlers"
+	"github.com/porter-dev/porter/api/server/shared"
+	"github.com/porter-dev/porter/api/server/shared/apierrors"
+	"github.com/porter-dev/porter/api/server/shared/config"
+	"github.com/porter-dev/porter/api/types"
+	"github.com/porter-dev/porter/internal/models"
+	"github.com/porter-dev/porter/internal/telemetry"
+)
+
+// DeleteUserHandler handles DELETE requests to the /scim/v2/Users/{scim_user_id} endpoint
+type DeleteUserHandler struct {
+	handlers.PorterHandlerWriter
+}
+
+// NewDeleteUserHandler returns a new DeleteUserHandler
+func NewDeleteUserHandler(
+	config *config.Config,
+	writer shared.ResultWriter,
+) *DeleteUserHandler {
+	return &DeleteUserHandler{
+		PorterHandlerWriter: handlers.NewDefaultPorterHandler(config, nil, writer),
+	}
+}
+
+// ServeHTTP deprovisions a user, removing it from the project and its groups. The Porter user itself is kept, since it may
+// belong to other projects.
+func (c *DeleteUserHandler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	ctx, span := telemetry.NewSpan(r.Context(), "serve-scim-delete-user")
+	defer span.End()
+
+	project, _ := ctx.Value(types.ProjectScope).(*models.Project)
+	telemetry.WithAttributes(span, telemetry.AttributeKV{Key: "project-id", Value: project.ID})
+
+	user, reqErr := scimUserFromURL(r, c.Repo(), project)
+	if reqErr != nil {
+		_ = telemetry.Error(ctx, span, reqErr, "error reading user")
+		c.HandleAPIError(w, r, reqErr)
+		return
+	}
+
+	user.Active = false
+	if err := syncProjectRole(ctx, c.Repo(), project, user); err != nil {
+		err := telemetry.Error(ctx, span, err, "error removing project role")
+		c.HandleAPIError(w, r, apierrors.NewErrPassThroughToClient(err, http.StatusInternalServerError))
+		return
+	}
+
+	if err := c.Repo().SCIM().DeleteSCIMUser(ctx, user); err != nil {
+		err := telemetry.Error(ctx, span, err, "error deleting scim user")
+		c.HandleAPIError(w, r, apierrors.NewErrPassThroughToClient(err, http.StatusInternalServerError))
+		return
+	}
+
+	w.WriteHeader(http.StatusNoContent)
+}
@@ -0,0 +1,45 @@
+package scim
+
+import (
+	"net/http"
+
+	"github.com/porter-dev/porter/api/server/handlers"
+	"github.com/porter-dev/porter/api/server/shared"
+	"github.com/porter-dev/porter/api/server/shared/config"
+	"github.com/porter-dev/porter/api/types"
+	"github.com/porter-dev/porter/internal/models"
+	"github.com/porter-dev/porter/internal/telemetry"
+)
+
+// GetGroupHandler handles GET requests to the /scim/v2/Groups/{scim_group_id} endpoint
+type GetGroupHandler struct {
+	handlers.PorterHandlerWriter
+}
+
+// NewGetGroupHandler returns a new GetGroupHandler
+func NewGetGroupHandler(
+	config *config.Config,
+	writer shared.ResultWriter,
+) *GetGroupHandler {
+	return &GetGroupHandler{
+		PorterHandlerWriter: handlers.NewDefaultPorterHandler(config, nil, writer),
+	}
+}
+
+// ServeHTTP returns a group pushed to the project, along with its members
+func (c *GetGroupHandler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	ctx, span := telemetry.NewSpan(r.Context(), "serve-scim-get-group")
+	defer span.End()
+
+	project, _ := ctx.Value(types.ProjectScope).(*models.Project)
+	telemetry.WithAttributes(span, telemetry.AttributeKV{Key: "project-id", Value: project.ID})
+
+	group, reqErr := scimGroupFromURL(r, c.Repo(), project)
+	if reqErr != nil {
+		_ = telemetry.Error(ctx, span, reqErr, "error reading group")
+		c.HandleAPIError(w, r, reqErr)
+		return
+	}
+
+	c.WriteResult(w, r, toSCIMGroup(group))
+}
@@ -0,0 +1,45 @@
+package scim
+
+import (
+	"net/http"
+
+	"github.com/porter-dev/porter/api/server/handlers"
+	"github.com/porter-dev/porter/api/server/shared"
+	"github.com/porter-dev/porter/api/server/shared/config"
+	"github.com/porter-dev/porter/api/types"
+	"github.com/porter-dev/porter/internal/models"
+	"github.com/porter-dev/porter/internal/telemetry"
+)
+
+// GetUserHandler handles GET requests to the /scim/v2/Users/{scim_user_id} endpoint
+type GetUserHandler struct {
+	handlers.PorterHandlerWriter
+}
+
+// NewGetUserHandler returns a new GetUserHandler
+func NewGetUserHandler(
+	config *config.Config,
+	writer shared.ResultWriter,
+) *GetUserHandler {
+	return &GetUserHandler{
+		PorterHandlerWriter: handlers.NewDefaultPorterHandler(config, nil, writer),
+	}
+}
+
+// ServeHTTP returns a user provisioned into the project
+func (c *GetUserHandler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	ctx, span := telemetry.NewSpan(r.Context(), "serve-scim-get-user")
+	defer span.End()
+
+	project, _ := ctx.Value(types.ProjectScope).(*models.Project)
+	telemetry.WithAttributes(span, telemetry.AttributeKV{Key: "project-id", Value: project.ID})
+
+	user, reqErr := scimUserFromURL(r, c.Repo(), project)
+	if reqErr != nil {
+		_ = telemetry.Error(ctx, span, reqErr, "error reading user")
+		c.HandleAPIError(w, r, reqErr)
+		return
+	}
+
+	c.WriteResult(w, r, toSCIMUser(user))
+}
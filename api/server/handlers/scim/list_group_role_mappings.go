@@ -0,0 +1,51 @@
+package scim
+
+import (
+	"net/http"
+
+	"github.com/porter-dev/porter/api/server/handlers"
+	"github.com/porter-dev/porter/api/server/shared"
+	"github.com/porter-dev/porter/api/server/shared/apierrors"
+	"github.com/porter-dev/porter/api/server/shared/config"
+	"github.com/porter-dev/porter/api/types"
+	"github.com/porter-dev/porter/internal/models"
+	"github.com/porter-dev/porter/internal/telemetry"
+)
+
+// ListGroupRoleMappingsHandler handles GET requests to the /scim/group-role-mappings endpoint
+type ListGroupRoleMappingsHandler struct {
+	handlers.PorterHandlerWriter
+}
+
+// NewListGroupRoleMappingsHandler returns a new ListGroupRoleMappingsHandler
+func NewListGroupRoleMappingsHandler(
+	config *config.Config,
+	writer shared.ResultWriter,
+) *ListGroupRoleMappingsHandler {
+	return &ListGroupRoleMappingsHandler{
+		PorterHandlerWriter: handlers.NewDefaultPorterHandler(config, nil, writer),
+	}
+}
+
+// ServeHTTP lists the group role mappings of the project
+func (c *ListGroupRoleMappingsHandler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	ctx, span := telemetry.NewSpan(r.Context(), "serve-list-scim-group-role-mappings")
+	defer span.End()
+
+	project, _ := ctx.Value(types.ProjectScope).(*models.Project)
+	telemetry.WithAttributes(span, telemetry.AttributeKV{Key: "project-id", Value: project.ID})
+
+	mappings, err := c.Repo().SCIM().ListSCIMGroupRoleMappings(ctx, project.ID)
+	if err != nil {
+		err := telemetry.Error(ctx, span, err, "error listing group role mappings")
+		c.HandleAPIError(w, r, apierrors.NewErrPassThroughToClient(err, http.StatusInternalServerError))
+		return
+	}
+
+	res := make(types.ListSCIMGroupRoleMappingsResponse, 0, len(mappings))
+	for _, mapping := range mappings {
+		res = append(res, mapping.ToSCIMGroupRoleMappingType())
+	}
+
+	c.WriteResult(w, r, res)
+}
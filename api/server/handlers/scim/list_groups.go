@@ -0,0 +1,86 @@
+package scim
+
+import (
+	"net/http"
+	"strings"
+
+	"github.com/porter-dev/porter/api/server/handlers"
+	"github.com/porter-dev/porter/api/server/shared"
+	"github.com/porter-dev/porter/api/server/shared/apierrors"
+	"github.com/porter-dev/porter/api/server/shared/config"
+	"github.com/porter-dev/porter/api/types"
+	"github.com/porter-dev/porter/internal/models"
+	"github.com/porter-dev/porter/internal/telemetry"
+)
+
+// ListGroupsHandler handles GET requests to the /scim/v2/Groups endpoint
+type ListGroupsHandler struct {
+	handlers.PorterHandlerReadWriter
+}
+
+// NewListGroupsHandler returns a new ListGroupsHandler
+func NewListGroupsHandler(
+	config *config.Config,
+	decoderValidator shared.RequestDecoderValidator,
+	writer shared.ResultWriter,
+) *ListGroupsHandler {
+	return &ListGroupsHandler{
+		PorterHandlerReadWriter: handlers.NewDefaultPorterHandler(config, decoderValidator, writer),
+	}
+}
+
+// ServeHTTP lists the groups pushed to the project, optionally filtered by displayName or externalId
+func (c *ListGroupsHandler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	ctx, span := telemetry.NewSpan(r.Context(), "serve-scim-list-groups")
+	defer span.End()
+
+	project, _ := ctx.Value(types.ProjectScope).(*models.Project)
+	telemetry.WithAttributes(span, telemetry.AttributeKV{Key: "project-id", Value: project.ID})
+
+	request := &types.SCIMListRequest{}
+	if ok := c.DecodeAndValidate(w, r, request); !ok {
+		err := telemetry.Error(ctx, span, nil, "error decoding request")
+		c.HandleAPIError(w, r, apierrors.NewErrPassThroughToClient(err, http.StatusBadRequest))
+		return
+	}
+
+	f, err := parseFilter(request.Filter)
+	if err != nil {
+		err := telemetry.Error(ctx, span, err, "error parsing filter")
+		c.HandleAPIError(w, r, apierrors.NewErrPassThroughToClient(err, http.StatusBadRequest))
+		return
+	}
+
+	groups, err := c.Repo().SCIM().ListSCIMGroups(ctx, project.ID)
+	if err != nil {
+		err := telemetry.Error(ctx, span, err, "error listing groups")
+		c.HandleAPIError(w, r, apierrors.NewErrPassThroughToClient(err, http.StatusInternalServerError))
+		return
+	}
+
+	resources := make([]*types.SCIMGroup, 0)
+	for _, group := range groups {
+		if f != nil {
+			switch f.Attribute {
+			case "displayname":
+				if !strings.EqualFold(group.DisplayName, f.Value) {
+					continue
+				}
+			case "externalid":
+				if group.ExternalID != f.Value {
+					continue
+				}
+			default:
+				err := telemetry.Error(ctx, span, nil, "unsupported filter attribute")
+				c.HandleAPIError(w, r, apierrors.NewErrPassThroughToClient(err, http.StatusBadRequest))
+				return
+			}
+		}
+
+		resources = append(resources, toSCIMGroup(group))
+	}
+
+	paged, startIndex := page(resources, request)
+
+	c.WriteResult(w, r, listResponse(paged, len(resources), startIndex))
+}
@@ -0,0 +1,86 @@
+package scim
+
+import (
+	"net/http"
+	"strings"
+
+	"github.com/porter-dev/porter/api/server/handlers"
+	"github.com/porter-dev/porter/api/server/shared"
+	"github.com/porter-dev/porter/api/server/shared/apierrors"
+	"github.com/porter-dev/porter/api/server/shared/config"
+	"github.com/porter-dev/porter/api/types"
+	"github.com/porter-dev/porter/internal/models"
+	"github.com/porter-dev/porter/internal/telemetry"
+)
+
+// ListUsersHandler handles GET requests to the /scim/v2/Users endpoint
+type ListUsersHandler struct {
+	handlers.PorterHandlerReadWriter
+}
+
+// NewListUsersHandler returns a new ListUsersHandler
+func NewListUsersHandler(
+	config *config.Config,
+	decoderValidator shared.RequestDecoderValidator,
+	writer shared.ResultWriter,
+) *ListUsersHandler {
+	return &ListUsersHandler{
+		PorterHandlerReadWriter: handlers.NewDefaultPorterHandler(config, decoderValidator, writer),
+	}
+}
+
+// ServeHTTP lists the users provisioned into the project, optionally filtered by userName or externalId
+func (c *ListUsersHandler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	ctx, span := telemetry.NewSpan(r.Context(), "serve-scim-list-users")
+	defer span.End()
+
+	project, _ := ctx.Value(types.ProjectScope).(*models.Project)
+	telemetry.WithAttributes(span, telemetry.AttributeKV{Key: "project-id", Value: project.ID})
+
+	request := &types.SCIMListRequest{}
+	if ok := c.DecodeAndValidate(w, r, request); !ok {
+		err := telemetry.Error(ctx, span, nil, "error decoding request")
+		c.HandleAPIError(w, r, apierrors.NewErrPassThroughToClient(err, http.StatusBadRequest))
+		return
+	}
+
+	f, err := parseFilter(request.Filter)
+	if err != nil {
+		err := telemetry.Error(ctx, span, err, "error parsing filter")
+		c.HandleAPIError(w, r, apierrors.NewErrPassThroughToClient(err, http.StatusBadRequest))
+		return
+	}
+
+	users, err := c.Repo().SCIM().ListSCIMUsers(ctx, project.ID)
+	if err != nil {
+		err := telemetry.Error(ctx, span, err, "error listing users")
+		c.HandleAPIError(w, r, apierrors.NewErrPassThroughToClient(err, http.StatusInternalServerError))
+		return
+	}
+
+	resources := make([]*types.SCIMUser, 0)
+	for _, user := range users {
+		if f != nil {
+			switch f.Attribute {
+			case "username", "emails.value":
+				if !strings.EqualFold(user.UserName, f.Value) {
+					continue
+				}
+			case "externalid":
+				if user.ExternalID != f.Value {
+					continue
+				}
+			default:
+				err := telemetry.Error(ctx, span, nil, "unsupported filter attribute")
+				c.HandleAPIError(w, r, apierrors.NewErrPassThroughToClient(err, http.StatusBadRequest))
+				return
+			}
+		}
+
+		resources = append(resources, toSCIMUser(user))
+	}
+
+	paged, startIndex := page(resources, request)
+
+	c.WriteResult(w, r, listResponse(paged, len(resources), startIndex))
+}
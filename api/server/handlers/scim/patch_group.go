@@ -0,0 +1,204 @@
+package scim
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"strings"
+
+	"github.com/porter-dev/porter/api/server/handlers"
+	"github.com/porter-dev/porter/api/server/shared"
+	"github.com/porter-dev/porter/api/server/shared/apierrors"
+	"github.com/porter-dev/porter/api/server/shared/config"
+	"github.com/porter-dev/porter/api/types"
+	"github.com/porter-dev/porter/internal/models"
+	"github.com/porter-dev/porter/internal/repository"
+	"github.com/porter-dev/porter/internal/telemetry"
+)
+
+// PatchGroupHandler handles PATCH requests to the /scim/v2/Groups/{scim_group_id} endpoint
+type PatchGroupHandler struct {
+	handlers.PorterHandlerReadWriter
+}
+
+// NewPatchGroupHandler returns a new PatchGroupHandler
+func NewPatchGroupHandler(
+	config *config.Config,
+	decoderValidator shared.RequestDecoderValidator,
+	writer shared.ResultWriter,
+) *PatchGroupHandler {
+	return &PatchGroupHandler{
+		PorterHandlerReadWriter: handlers.NewDefaultPorterHandler(config, decoderValidator, writer),
+	}
+}
+
+// ServeHTTP adds or removes members of a group or renames it, and resyncs the project roles of its former and current members
+func (c *PatchGroupHandler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	ctx, span := telemetry.NewSpan(r.Context(), "serve-scim-patch-group")
+	defer span.End()
+
+	project, _ := ctx.Value(types.ProjectScope).(*models.Project)
+	telemetry.WithAttributes(span, telemetry.AttributeKV{Key: "project-id", Value: project.ID})
+
+	group, reqErr := scimGroupFromURL(r, c.Repo(), project)
+	if reqErr != nil {
+		_ = telemetry.Error(ctx, span, reqErr, "error reading group")
+		c.HandleAPIError(w, r, reqErr)
+		return
+	}
+
+	request := &types.SCIMPatchRequest{}
+	if ok := c.DecodeAndValidate(w, r, request); !ok {
+		err := telemetry.Error(ctx, span, nil, "error decoding request")
+		c.HandleAPIError(w, r, apierrors.NewErrPassThroughToClient(err, http.StatusBadRequest))
+		return
+	}
+
+	affected := memberIDs(group.Members)
+
+	for _, op := range request.Operations {
+		if reqErr := applyGroupPatchOperation(ctx, c.Repo(), project, group, op); reqErr != nil {
+			_ = telemetry.Error(ctx, span, reqErr, "error applying patch operation")
+			c.HandleAPIError(w, r, reqErr)
+			return
+		}
+	}
+
+	affected = append(affected, memberIDs(group.Members)...)
+
+	group, err := c.Repo().SCIM().UpdateSCIMGroup(ctx, group)
+	if err != nil {
+		err := telemetry.Error(ctx, span, err, "error updating scim group")
+		c.HandleAPIError(w, r, apierrors.NewErrPassThroughToClient(err, http.StatusInternalServerError))
+		return
+	}
+
+	if err := syncProjectRoles(ctx, c.Repo(), project, affected); err != nil {
+		err := telemetry.Error(ctx, span, err, "error syncing project roles")
+		c.HandleAPIError(w, r, apierrors.NewErrPassThroughToClient(err, http.StatusInternalServerError))
+		return
+	}
+
+	c.WriteResult(w, r, toSCIMGroup(group))
+}
+
+// applyGroupPatchOperation applies an operation to the name or members of a group. Members are removed either by a
+// members[value eq "id"] path, or by a members path with the members to remove as the value.
+func applyGroupPatchOperation(ctx context.Context, repo repository.Repository, project *models.Project, group *models.SCIMGroup, op types.SCIMPatchOperation) apierrors.RequestError {
+	badRequest := func(err error) apierrors.RequestError {
+		return apierrors.NewErrPassThroughToClient(err, http.StatusBadRequest)
+	}
+
+	path := strings.ToLower(op.Path)
+
+	switch strings.ToLower(op.Op) {
+	case "add", "replace":
+		if path == "" {
+			values, ok := op.Value.(map[string]any)
+			if !ok {
+				return badRequest(fmt.Errorf("patch operation without a path must have an object value"))
+			}
+
+			for key, value := range values {
+				if reqErr := applyGroupPatchOperation(ctx, repo, project, group, types.SCIMPatchOperation{Op: op.Op, Path: key, Value: value}); reqErr != nil {
+					return reqErr
+				}
+			}
+
+			return nil
+		}
+
+		switch path {
+		case "displayname":
+			displayName, ok := op.Value.(string)
+			if !ok || displayName == "" {
+				return badRequest(fmt.Errorf("invalid displayName %v", op.Value))
+			}
+			group.DisplayName = displayName
+		case "externalid":
+			externalID, _ := op.Value.(string)
+			group.ExternalID = externalID
+		case "members":
+			members, reqErr := patchMembers(ctx, repo, project, op.Value)
+			if reqErr != nil {
+				return reqErr
+			}
+
+			if strings.EqualFold(op.Op, "replace") {
+				group.Members = members
+				return nil
+			}
+
+			existing := make(map[uint]bool)
+			for _, member := range group.Members {
+				existing[member.ID] = true
+			}
+
+			for _, member := range members {
+				if !existing[member.ID] {
+					group.Members = append(group.Members, member)
+				}
+			}
+		default:
+			return badRequest(fmt.Errorf("unsupported patch path %q for groups", op.Path))
+		}
+	case "remove":
+		remove := make(map[uint]bool)
+
+		if match := memberFilterPathRegex.FindStringSubmatch(op.Path); match != nil {
+			members, reqErr := groupMembers(ctx, repo, project, []types.SCIMGroupMember{{Value: match[1]}})
+			if reqErr != nil {
+				return reqErr
+			}
+			remove[members[0].ID] = true
+		} else if path == "members" {
+			if op.Value == nil {
+				group.Members = []models.SCIMUser{}
+				return nil
+			}
+
+			members, reqErr := patchMembers(ctx, repo, project, op.Value)
+			if reqErr != nil {
+				return reqErr
+			}
+			for _, member := range members {
+				remove[member.ID] = true
+			}
+		} else {
+			return badRequest(fmt.Errorf("unsupported patch path %q for groups", op.Path))
+		}
+
+		members := make([]models.SCIMUser, 0, len(group.Members))
+		for _, member := range group.Members {
+			if !remove[member.ID] {
+				members = append(members, member)
+			}
+		}
+		group.Members = members
+	default:
+		return badRequest(fmt.Errorf("unsupported patch operation %q", op.Op))
+	}
+
+	return nil
+}
+
+// patchMembers resolves the members in the value of a patch operation, which is a list of objects with a value
+func patchMembers(ctx context.Context, repo repository.Repository, project *models.Project, value any) ([]models.SCIMUser, apierrors.RequestError) {
+	values, ok := value.([]any)
+	if !ok {
+		return nil, apierrors.NewErrPassThroughToClient(fmt.Errorf("members must be a list"), http.StatusBadRequest)
+	}
+
+	members := make([]types.SCIMGroupMember, 0, len(values))
+	for _, v := range values {
+		member, ok := v.(map[string]any)
+		if !ok {
+			return nil, apierrors.NewErrPassThroughToClient(fmt.Errorf("invalid member %v", v), http.StatusBadRequest)
+		}
+
+		id, _ := member["value"].(string)
+		members = append(members, types.SCIMGroupMember{Value: id})
+	}
+
+	return groupMembers(ctx, repo, project, members)
+}
@@ -0,0 +1,145 @@
+package scim
+
+import (
+	"fmt"
+	"net/http"
+	"strings"
+
+	"github.com/porter-dev/porter/api/server/handlers"
+	"github.com/porter-dev/porter/api/server/shared"
+	"github.com/porter-dev/porter/api/server/shared/apierrors"
+	"github.com/porter-dev/porter/api/server/shared/config"
+	"github.com/porter-dev/porter/api/types"
+	"github.com/porter-dev/porter/internal/models"
+	"github.com/porter-dev/porter/internal/telemetry"
+)
+
+// PatchUserHandler handles PATCH requests to the /scim/v2/Users/{scim_user_id} endpoint
+type PatchUserHandler struct {
+	handlers.PorterHandlerReadWriter
+}
+
+// NewPatchUserHandler returns a new PatchUserHandler
+func NewPatchUserHandler(
+	config *config.Config,
+	decoderValidator shared.RequestDecoderValidator,
+	writer shared.ResultWriter,
+) *PatchUserHandler {
+	return &PatchUserHandler{
+		PorterHandlerReadWriter: handlers.NewDefaultPorterHandler(config, decoderValidator, writer),
+	}
+}
+
+// ServeHTTP updates attributes of a provisioned user. Identity providers deprovision users by setting active to false, which
+// removes the user from the project.
+func (c *PatchUserHandler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	ctx, span := telemetry.NewSpan(r.Context(), "serve-scim-patch-user")
+	defer span.End()
+
+	project, _ := ctx.Value(types.ProjectScope).(*models.Project)
+	telemetry.WithAttributes(span, telemetry.AttributeKV{Key: "project-id", Value: project.ID})
+
+	user, reqErr := scimUserFromURL(r, c.Repo(), project)
+	if reqErr != nil {
+		_ = telemetry.Error(ctx, span, reqErr, "error reading user")
+		c.HandleAPIError(w, r, reqErr)
+		return
+	}
+
+	request := &types.SCIMPatchRequest{}
+	if ok := c.DecodeAndValidate(w, r, request); !ok {
+		err := telemetry.Error(ctx, span, nil, "error decoding request")
+		c.HandleAPIError(w, r, apierrors.NewErrPassThroughToClient(err, http.StatusBadRequest))
+		return
+	}
+
+	for _, op := range request.Operations {
+		if err := applyUserPatchOperation(user, op); err != nil {
+			err := telemetry.Error(ctx, span, err, "error applying patch operation")
+			c.HandleAPIError(w, r, apierrors.NewErrPassThroughToClient(err, http.StatusBadRequest))
+			return
+		}
+	}
+
+	user, err := c.Repo().SCIM().UpdateSCIMUser(ctx, user)
+	if err != nil {
+		err := telemetry.Error(ctx, span, err, "error updating scim user")
+		c.HandleAPIError(w, r, apierrors.NewErrPassThroughToClient(err, http.StatusInternalServerError))
+		return
+	}
+
+	if err := syncProjectRole(ctx, c.Repo(), project, user); err != nil {
+		err := telemetry.Error(ctx, span, err, "error syncing project role")
+		c.HandleAPIError(w, r, apierrors.NewErrPassThroughToClient(err, http.StatusInternalServerError))
+		return
+	}
+
+	c.WriteResult(w, r, toSCIMUser(user))
+}
+
+// applyUserPatchOperation applies an add or replace operation to a user. Operations without a path set each attribute of
+// their value.
+func applyUserPatchOperation(user *models.SCIMUser, op types.SCIMPatchOperation) error {
+	switch strings.ToLower(op.Op) {
+	case "add", "replace":
+	default:
+		return fmt.Errorf("unsupported patch operation %q for users", op.Op)
+	}
+
+	if op.Path != "" {
+		return setUserAttribute(user, op.Path, op.Value)
+	}
+
+	values, ok := op.Value.(map[string]any)
+	if !ok {
+		return fmt.Errorf("patch operation without a path must have an object value")
+	}
+
+	for path, value := range values {
+		if err := setUserAttribute(user, path, value); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func setUserAttribute(user *models.SCIMUser, path string, value any) error {
+	switch strings.ToLower(path) {
+	case "active":
+		active, err := isTrue(value)
+		if err != nil {
+			return err
+		}
+		user.Active = active
+	case "username":
+		userName, ok := value.(string)
+		if !ok || userName == "" {
+			return fmt.Errorf("invalid userName %v", value)
+		}
+		user.UserName = userName
+	case "externalid":
+		externalID, _ := value.(string)
+		user.ExternalID = externalID
+	case "name.givenname":
+		givenName, _ := value.(string)
+		user.GivenName = givenName
+	case "name.familyname":
+		familyName, _ := value.(string)
+		user.FamilyName = familyName
+	case "name":
+		name, ok := value.(map[string]any)
+		if !ok {
+			return fmt.Errorf("invalid name %v", value)
+		}
+		for key, v := range name {
+			if err := setUserAttribute(user, "name."+key, v); err != nil {
+				return err
+			}
+		}
+	default:
+		// attributes which are not stored, such as emails and titles, are ignored
+	}
+
+	return nil
+}
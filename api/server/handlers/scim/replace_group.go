@@ -0,0 +1,98 @@
+package scim
+
+import (
+	"net/http"
+	"strings"
+
+	"github.com/porter-dev/porter/api/server/handlers"
+	"github.com/porter-dev/porter/api/server/shared"
+	"github.com/porter-dev/porter/api/server/shared/apierrors"
+	"github.com/porter-dev/porter/api/server/shared/config"
+	"github.com/porter-dev/porter/api/types"
+	"github.com/porter-dev/porter/internal/models"
+	"github.com/porter-dev/porter/internal/telemetry"
+)
+
+// ReplaceGroupHandler handles PUT requests to the /scim/v2/Groups/{scim_group_id} endpoint
+type ReplaceGroupHandler struct {
+	handlers.PorterHandlerReadWriter
+}
+
+// NewReplaceGroupHandler returns a new ReplaceGroupHandler
+func NewReplaceGroupHandler(
+	config *config.Config,
+	decoderValidator shared.RequestDecoderValidator,
+	writer shared.ResultWriter,
+) *ReplaceGroupHandler {
+	return &ReplaceGroupHandler{
+		PorterHandlerReadWriter: handlers.NewDefaultPorterHandler(config, decoderValidator, writer),
+	}
+}
+
+// ServeHTTP replaces the name and members of a group, and resyncs the project roles of its former and current members
+func (c *ReplaceGroupHandler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	ctx, span := telemetry.NewSpan(r.Context(), "serve-scim-replace-group")
+	defer span.End()
+
+	project, _ := ctx.Value(types.ProjectScope).(*models.Project)
+	telemetry.WithAttributes(span, telemetry.AttributeKV{Key: "project-id", Value: project.ID})
+
+	group, reqErr := scimGroupFromURL(r, c.Repo(), project)
+	if reqErr != nil {
+		_ = telemetry.Error(ctx, span, reqErr, "error reading group")
+		c.HandleAPIError(w, r, reqErr)
+		return
+	}
+
+	request := &types.SCIMGroup{}
+	if ok := c.DecodeAndValidate(w, r, request); !ok {
+		err := telemetry.Error(ctx, span, nil, "error decoding request")
+		c.HandleAPIError(w, r, apierrors.NewErrPassThroughToClient(err, http.StatusBadRequest))
+		return
+	}
+
+	if !strings.EqualFold(group.DisplayName, request.DisplayName) {
+		groups, err := c.Repo().SCIM().ListSCIMGroups(ctx, project.ID)
+		if err != nil {
+			err := telemetry.Error(ctx, span, err, "error listing groups")
+			c.HandleAPIError(w, r, apierrors.NewErrPassThroughToClient(err, http.StatusInternalServerError))
+			return
+		}
+
+		for _, other := range groups {
+			if other.ID != group.ID && strings.EqualFold(other.DisplayName, request.DisplayName) {
+				err := telemetry.Error(ctx, span, nil, "group name is already taken")
+				c.HandleAPIError(w, r, apierrors.NewErrPassThroughToClient(err, http.StatusConflict))
+				return
+			}
+		}
+	}
+
+	members, reqErr := groupMembers(ctx, c.Repo(), project, request.Members)
+	if reqErr != nil {
+		_ = telemetry.Error(ctx, span, reqErr, "error reading group members")
+		c.HandleAPIError(w, r, reqErr)
+		return
+	}
+
+	affected := append(memberIDs(group.Members), memberIDs(members)...)
+
+	group.DisplayName = request.DisplayName
+	group.ExternalID = request.ExternalID
+	group.Members = members
+
+	group, err := c.Repo().SCIM().UpdateSCIMGroup(ctx, group)
+	if err != nil {
+		err := telemetry.Error(ctx, span, err, "error updating scim group")
+		c.HandleAPIError(w, r, apierrors.NewErrPassThroughToClient(err, http.StatusInternalServerError))
+		return
+	}
+
+	if err := syncProjectRoles(ctx, c.Repo(), project, affected); err != nil {
+		err := telemetry.Error(ctx, span, err, "error syncing project roles")
+		c.HandleAPIError(w, r, apierrors.NewErrPassThroughToClient(err, http.StatusInternalServerError))
+		return
+	}
+
+	c.WriteResult(w, r, toSCIMGroup(group))
+}
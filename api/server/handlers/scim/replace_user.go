@@ -0,0 +1,95 @@
+package scim
+
+import (
+	"net/http"
+	"strings"
+
+	"github.com/porter-dev/porter/api/server/handlers"
+	"github.com/porter-dev/porter/api/server/shared"
+	"github.com/porter-dev/porter/api/server/shared/apierrors"
+	"github.com/porter-dev/porter/api/server/shared/config"
+	"github.com/porter-dev/porter/api/types"
+	"github.com/porter-dev/porter/internal/models"
+	"github.com/porter-dev/porter/internal/telemetry"
+)
+
+// ReplaceUserHandler handles PUT requests to the /scim/v2/Users/{scim_user_id} endpoint
+type ReplaceUserHandler struct {
+	handlers.PorterHandlerReadWriter
+}
+
+// NewReplaceUserHandler returns a new ReplaceUserHandler
+func NewReplaceUserHandler(
+	config *config.Config,
+	decoderValidator shared.RequestDecoderValidator,
+	writer shared.ResultWriter,
+) *ReplaceUserHandler {
+	return &ReplaceUserHandler{
+		PorterHandlerReadWriter: handlers.NewDefaultPorterHandler(config, decoderValidator, writer),
+	}
+}
+
+// ServeHTTP replaces the attributes of a provisioned user, and removes the user from the project if it is deactivated
+func (c *ReplaceUserHandler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	ctx, span := telemetry.NewSpan(r.Context(), "serve-scim-replace-user")
+	defer span.End()
+
+	project, _ := ctx.Value(types.ProjectScope).(*models.Project)
+	telemetry.WithAttributes(span, telemetry.AttributeKV{Key: "project-id", Value: project.ID})
+
+	user, reqErr := scimUserFromURL(r, c.Repo(), project)
+	if reqErr != nil {
+		_ = telemetry.Error(ctx, span, reqErr, "error reading user")
+		c.HandleAPIError(w, r, reqErr)
+		return
+	}
+
+	request := &types.SCIMUser{}
+	if ok := c.DecodeAndValidate(w, r, request); !ok {
+		err := telemetry.Error(ctx, span, nil, "error decoding request")
+		c.HandleAPIError(w, r, apierrors.NewErrPassThroughToClient(err, http.StatusBadRequest))
+		return
+	}
+
+	if !strings.EqualFold(user.UserName, request.UserName) {
+		users, err := c.Repo().SCIM().ListSCIMUsers(ctx, project.ID)
+		if err != nil {
+			err := telemetry.Error(ctx, span, err, "error listing users")
+			c.HandleAPIError(w, r, apierrors.NewErrPassThroughToClient(err, http.StatusInternalServerError))
+			return
+		}
+
+		for _, other := range users {
+			if other.ID != user.ID && strings.EqualFold(other.UserName, request.UserName) {
+				err := telemetry.Error(ctx, span, nil, "user name is already taken")
+				c.HandleAPIError(w, r, apierrors.NewErrPassThroughToClient(err, http.StatusConflict))
+				return
+			}
+		}
+	}
+
+	user.UserName = request.UserName
+	user.ExternalID = request.ExternalID
+	user.GivenName = ""
+	user.FamilyName = ""
+	if request.Name != nil {
+		user.GivenName = request.Name.GivenName
+		user.FamilyName = request.Name.FamilyName
+	}
+	user.Active = request.Active == nil || *request.Active
+
+	user, err := c.Repo().SCIM().UpdateSCIMUser(ctx, user)
+	if err != nil {
+		err := telemetry.Error(ctx, span, err, "error updating scim user")
+		c.HandleAPIError(w, r, apierrors.NewErrPassThroughToClient(err, http.StatusInternalServerError))
+		return
+	}
+
+	if err := syncProjectRole(ctx, c.Repo(), project, user); err != nil {
+		err := telemetry.Error(ctx, span, err, "error syncing project role")
+		c.HandleAPIError(w, r, apierrors.NewErrPassThroughToClient(err, http.StatusInternalServerError))
+		return
+	}
+
+	c.WriteResult(w, r, toSCIMUser(user))
+}
@@ -0,0 +1,366 @@
+package scim
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"regexp"
+	"strconv"
+	"strings"
+
+	"gorm.io/gorm"
+
+	"github.com/porter-dev/porter/api/server/shared/apierrors"
+	"github.com/porter-dev/porter/api/server/shared/requestutils"
+	"github.com/porter-dev/porter/api/types"
+	"github.com/porter-dev/porter/internal/models"
+	"github.com/porter-dev/porter/internal/repository"
+)
+
+const (
+	// defaultPageSize is the number of resources returned by list requests which do not set a count
+	defaultPageSize = 100
+	// maxPageSize is the maximum number of resources returned by a list request
+	maxPageSize = 1000
+)
+
+// filterRegex matches the `attribute eq "value"` filters sent by identity providers to look up resources
+var filterRegex = regexp.MustCompile(`(?i)^\s*([a-z.]+)\s+eq\s+"([^"]*)"\s*$`)
+
+// memberFilterPathRegex matches the `members[value eq "id"]` paths of patch operations which remove a single group member
+var memberFilterPathRegex = regexp.MustCompile(`(?i)^members\[\s*value\s+eq\s+"([^"]*)"\s*\]$`)
+
+// filter is an equality filter on a single attribute of a resource
+type filter struct {
+	Attribute string
+	Value     string
+}
+
+// parseFilter parses a SCIM filter. An empty filter matches every resource.
+func parseFilter(value string) (*filter, error) {
+	if value == "" {
+		return nil, nil
+	}
+
+	match := filterRegex.FindStringSubmatch(value)
+	if match == nil {
+		return nil, fmt.Errorf("unsupported filter %q, only filters of the form attribute eq \"value\" are supported", value)
+	}
+
+	return &filter{Attribute: strings.ToLower(match[1]), Value: match[2]}, nil
+}
+
+// page returns the 1-indexed page of a list request, along with the start index of the page
+func page[T any](items []T, request *types.SCIMListRequest) ([]T, int) {
+	startIndex := request.StartIndex
+	if startIndex < 1 {
+		startIndex = 1
+	}
+
+	count := request.Count
+	if count <= 0 {
+		count = defaultPageSize
+	}
+	if count > maxPageSize {
+		count = maxPageSize
+	}
+
+	if startIndex > len(items) {
+		return []T{}, startIndex
+	}
+
+	end := startIndex - 1 + count
+	if end > len(items) {
+		end = len(items)
+	}
+
+	return items[startIndex-1 : end], startIndex
+}
+
+func listResponse[T any](resources []T, totalResults int, startIndex int) *types.SCIMListResponse {
+	return &types.SCIMListResponse{
+		Schemas:      []string{types.SCIMSchemaListResponse},
+		TotalResults: totalResults,
+		StartIndex:   startIndex,
+		ItemsPerPage: len(resources),
+		Resources:    resources,
+	}
+}
+
+// scimUserFromURL reads the SCIM user of the project given by the scim_user_id URL param
+func scimUserFromURL(r *http.Request, repo repository.Repository, project *models.Project) (*models.SCIMUser, apierrors.RequestError) {
+	id, reqErr := requestutils.GetURLParamUint(r, types.URLParamSCIMUserID)
+	if reqErr != nil {
+		return nil, reqErr
+	}
+
+	user, err := repo.SCIM().ReadSCIMUser(r.Context(), project.ID, id)
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, apierrors.NewErrNotFound(fmt.Errorf("user %d not found", id))
+		}
+		return nil, apierrors.NewErrInternal(err)
+	}
+
+	return user, nil
+}
+
+// scimGroupFromURL reads the SCIM group of the project given by the scim_group_id URL param
+func scimGroupFromURL(r *http.Request, repo repository.Repository, project *models.Project) (*models.SCIMGroup, apierrors.RequestError) {
+	id, reqErr := requestutils.GetURLParamUint(r, types.URLParamSCIMGroupID)
+	if reqErr != nil {
+		return nil, reqErr
+	}
+
+	group, err := repo.SCIM().ReadSCIMGroup(r.Context(), project.ID, id)
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, apierrors.NewErrNotFound(fmt.Errorf("group %d not found", id))
+		}
+		return nil, apierrors.NewErrInternal(err)
+	}
+
+	return group, nil
+}
+
+func toSCIMUser(user *models.SCIMUser) *types.SCIMUser {
+	active := user.Active
+
+	return &types.SCIMUser{
+		Schemas:    []string{types.SCIMSchemaUser},
+		ID:         strconv.FormatUint(uint64(user.ID), 10),
+		ExternalID: user.ExternalID,
+		UserName:   user.UserName,
+		Name: &types.SCIMName{
+			GivenName:  user.GivenName,
+			FamilyName: user.FamilyName,
+		},
+		Emails: []types.SCIMEmail{{Value: user.UserName, Type: "work", Primary: true}},
+		Active: &active,
+		Meta: &types.SCIMMeta{
+			ResourceType: "User",
+			Created:      user.CreatedAt,
+			LastModified: user.UpdatedAt,
+		},
+	}
+}
+
+func toSCIMGroup(group *models.SCIMGroup) *types.SCIMGroup {
+	members := make([]types.SCIMGroupMember, 0, len(group.Members))
+	for _, member := range group.Members {
+		members = append(members, types.SCIMGroupMember{
+			Value:   strconv.FormatUint(uint64(member.ID), 10),
+			Display: member.UserName,
+		})
+	}
+
+	return &types.SCIMGroup{
+		Schemas:     []string{types.SCIMSchemaGroup},
+		ID:          strconv.FormatUint(uint64(group.ID), 10),
+		ExternalID:  group.ExternalID,
+		DisplayName: group.DisplayName,
+		Members:     members,
+		Meta: &types.SCIMMeta{
+			ResourceType: "Group",
+			Created:      group.CreatedAt,
+			LastModified: group.UpdatedAt,
+		},
+	}
+}
+
+// groupMembers resolves group members, which reference SCIM users of the project by id
+func groupMembers(ctx context.Context, repo repository.Repository, project *models.Project, members []types.SCIMGroupMember) ([]models.SCIMUser, apierrors.RequestError) {
+	res := make([]models.SCIMUser, 0, len(members))
+	seen := make(map[uint]bool)
+
+	for _, member := range members {
+		id, err := strconv.ParseUint(member.Value, 10, 64)
+		if err != nil {
+			return nil, apierrors.NewErrPassThroughToClient(fmt.Errorf("invalid member %q", member.Value), http.StatusBadRequest)
+		}
+
+		if seen[uint(id)] {
+			continue
+		}
+		seen[uint(id)] = true
+
+		user, err := repo.SCIM().ReadSCIMUser(ctx, project.ID, uint(id))
+		if err != nil {
+			if errors.Is(err, gorm.ErrRecordNotFound) {
+				return nil, apierrors.NewErrPassThroughToClient(fmt.Errorf("member %d not found", id), http.StatusBadRequest)
+			}
+			return nil, apierrors.NewErrInternal(err)
+		}
+
+		res = append(res, *user)
+	}
+
+	return res, nil
+}
+
+// roleRank orders the project roles which can be mapped to groups by privilege
+var roleRank = map[types.RoleKind]int{
+	types.RoleViewer:    1,
+	types.RoleDeveloper: 2,
+	types.RoleAdmin:     3,
+}
+
+// syncProjectRole gives a provisioned user the most privileged project role mapped to any of its groups. Users which are
+// not in a mapped group are added to the project as viewers, and users which were linked to an existing project member keep
+// their role until they join a mapped group. Users which leave all of their mapped groups become viewers. Deactivated users
+// are removed from the project.
+func syncProjectRole(ctx context.Context, repo repository.Repository, project *models.Project, user *models.SCIMUser) error {
+	role, err := repo.Project().ReadProjectRole(ctx, project.ID, user.UserID)
+	if err != nil && !errors.Is(err, gorm.ErrRecordNotFound) {
+		return fmt.Errorf("error reading project role: %w", err)
+	}
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		role = nil
+	}
+
+	if !user.Active {
+		if role == nil {
+			return nil
+		}
+
+		if _, err := repo.Project().DeleteProjectRole(ctx, project.ID, user.UserID); err != nil {
+			return fmt.Errorf("error deleting project role: %w", err)
+		}
+
+		return nil
+	}
+
+	mappedKind, err := mappedRoleKind(ctx, repo, project, user)
+	if err != nil {
+		return err
+	}
+
+	kind := mappedKind
+	if kind == "" && (role == nil || user.MappedRoleKind != "") {
+		kind = types.RoleViewer
+	}
+
+	if user.MappedRoleKind != mappedKind {
+		user.MappedRoleKind = mappedKind
+		if _, err := repo.SCIM().UpdateSCIMUser(ctx, user); err != nil {
+			return fmt.Errorf("error updating scim user: %w", err)
+		}
+	}
+
+	switch {
+	case role == nil:
+		_, err := repo.Project().CreateProjectRole(ctx, project, &models.Role{
+			Role: types.Role{
+				UserID:    user.UserID,
+				ProjectID: project.ID,
+				Kind:      kind,
+			},
+		})
+		if err != nil {
+			return fmt.Errorf("error creating project role: %w", err)
+		}
+	case kind != "" && role.Kind != kind:
+		role.Kind = kind
+		role.PolicyUID = ""
+		if _, err := repo.Project().UpdateProjectRole(ctx, project.ID, role); err != nil {
+			return fmt.Errorf("error updating project role: %w", err)
+		}
+	}
+
+	return nil
+}
+
+// mappedRoleKind returns the most privileged project role mapped to a group of the user, or an empty role if none of the
+// groups of the user are mapped
+func mappedRoleKind(ctx context.Context, repo repository.Repository, project *models.Project, user *models.SCIMUser) (types.RoleKind, error) {
+	mappings, err := repo.SCIM().ListSCIMGroupRoleMappings(ctx, project.ID)
+	if err != nil {
+		return "", fmt.Errorf("error listing group role mappings: %w", err)
+	}
+
+	groups, err := repo.SCIM().ListSCIMGroups(ctx, project.ID)
+	if err != nil {
+		return "", fmt.Errorf("error listing groups: %w", err)
+	}
+
+	var kind types.RoleKind
+
+	for _, group := range groups {
+		isMember := false
+		for _, member := range group.Members {
+			if member.ID == user.ID {
+				isMember = true
+				break
+			}
+		}
+		if !isMember {
+			continue
+		}
+
+		for _, mapping := range mappings {
+			if strings.EqualFold(mapping.GroupDisplayName, group.DisplayName) && roleRank[mapping.RoleKind] > roleRank[kind] {
+				kind = mapping.RoleKind
+			}
+		}
+	}
+
+	return kind, nil
+}
+
+// syncProjectRoles syncs the project roles of the provisioned users with the given ids
+func syncProjectRoles(ctx context.Context, repo repository.Repository, project *models.Project, userIDs []uint) error {
+	for _, id := range userIDs {
+		user, err := repo.SCIM().ReadSCIMUser(ctx, project.ID, id)
+		if err != nil {
+			if errors.Is(err, gorm.ErrRecordNotFound) {
+				continue
+			}
+			return fmt.Errorf("error reading user: %w", err)
+		}
+
+		if err := syncProjectRole(ctx, repo, project, user); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// syncAllProjectRoles syncs the project roles of every provisioned user of the project
+func syncAllProjectRoles(ctx context.Context, repo repository.Repository, project *models.Project) error {
+	users, err := repo.SCIM().ListSCIMUsers(ctx, project.ID)
+	if err != nil {
+		return fmt.Errorf("error listing users: %w", err)
+	}
+
+	for _, user := range users {
+		if err := syncProjectRole(ctx, repo, project, user); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// memberIDs returns the ids of the members of a group
+func memberIDs(members []models.SCIMUser) []uint {
+	ids := make([]uint, 0, len(members))
+	for _, member := range members {
+		ids = append(ids, member.ID)
+	}
+
+	return ids
+}
+
+// isTrue reads a boolean attribute of a patch operation, which some identity providers send as a string
+func isTrue(value any) (bool, error) {
+	switch v := value.(type) {
+	case bool:
+		return v, nil
+	case string:
+		return strconv.ParseBool(v)
+	default:
+		return false, fmt.Errorf("invalid boolean value %v", value)
+	}
+}
@@ -27,7 +27,7 @@ func (p *SlackIntegrationDelete) ServeHTTP(w http.ResponseWriter, r *http.Reques
 	project, _ := r.Context().Value(types.ProjectScope).(*models.Project)
 	integrationID, _ := requestutils.GetURLParamUint(r, types.URLParamSlackIntegrationID)
 
-	slackInts, err := p.Repo().SlackIntegration().ListSlackIntegrationsByProjectID(project.ID)
+	slackInts, err := p.Repo().SlackIntegration().ListSlackIntegrationsByProjectID(r.Context(), project.ID)
 	if err != nil {
 		p.HandleAPIError(w, r, apierrors.NewErrInternal(err))
 		return
@@ -35,7 +35,7 @@ func (p *SlackIntegrationDelete) ServeHTTP(w http.ResponseWriter, r *http.Reques
 
 	for _, slackInt := range slackInts {
 		if slackInt.ID == integrationID {
-			err = p.Repo().SlackIntegration().DeleteSlackIntegration(slackInt.ID)
+			err = p.Repo().SlackIntegration().DeleteSlackIntegration(r.Context(), slackInt.ID)
 			if err != nil {
 				p.HandleAPIError(w, r, apierrors.NewErrInternal(err))
 				return
@@ -52,7 +52,7 @@ func (p *StackAddApplicationHandler) ServeHTTP(w http.ResponseWriter, r *http.Re
 		return
 	}
 
-	latestRevision, err := p.Repo().Stack().ReadStackRevisionByNumber(stack.ID, stack.Revisions[0].RevisionNumber)
+	latestRevision, err := p.Repo().Stack().ReadStackRevisionByNumber(r.Context(), stack.ID, stack.Revisions[0].RevisionNumber)
 	if err != nil {
 		p.HandleAPIError(w, r, apierrors.NewErrInternal(err))
 		return
@@ -105,21 +105,21 @@ func (p *StackAddApplicationHandler) ServeHTTP(w http.ResponseWriter, r *http.Re
 		EnvGroups:      envGroups,
 	}
 
-	revision, err := p.Repo().Stack().AppendNewRevision(newRevision)
+	revision, err := p.Repo().Stack().AppendNewRevision(r.Context(), newRevision)
 	if err != nil {
 		p.HandleAPIError(w, r, apierrors.NewErrInternal(err))
 		return
 	}
 
 	// re-read the stack to get the most upto date information
-	stack, err = p.Repo().Stack().ReadStackByID(proj.ID, stack.ID)
+	stack, err = p.Repo().Stack().ReadStackByID(r.Context(), proj.ID, stack.ID)
 
 	if err != nil {
 		p.HandleAPIError(w, r, apierrors.NewErrInternal(err))
 		return
 	}
 
-	registries, err := p.Repo().Registry().ListRegistriesByProjectID(cluster.ProjectID)
+	registries, err := p.Repo().Registry().ListRegistriesByProjectID(r.Context(), cluster.ProjectID)
 	if err != nil {
 		p.HandleAPIError(w, r, apierrors.NewErrInternal(err))
 		return
@@ -164,7 +164,7 @@ func (p *StackAddApplicationHandler) ServeHTTP(w http.ResponseWriter, r *http.Re
 		revision.Status = string(types.StackRevisionStatusDeployed)
 	}
 
-	revision, err = p.Repo().Stack().UpdateStackRevision(revision)
+	revision, err = p.Repo().Stack().UpdateStackRevision(r.Context(), revision)
 
 	if err != nil {
 		p.HandleAPIError(w, r, apierrors.NewErrInternal(err))
@@ -187,7 +187,7 @@ func (p *StackAddApplicationHandler) ServeHTTP(w http.ResponseWriter, r *http.Re
 		revision.Reason = "SaveError"
 		revision.Message = strings.Join(saveErrs, " , ")
 
-		_, err = p.Repo().Stack().UpdateStackRevision(revision)
+		_, err = p.Repo().Stack().UpdateStackRevision(r.Context(), revision)
 
 		if err != nil {
 			p.HandleAPIError(w, r, apierrors.NewErrInternal(err))
@@ -197,7 +197,7 @@ func (p *StackAddApplicationHandler) ServeHTTP(w http.ResponseWriter, r *http.Re
 		revision.Reason = "AddAppSuccess"
 		revision.Message = "New application " + req.Name + " added successfully."
 
-		_, err = p.Repo().Stack().UpdateStackRevision(revision)
+		_, err = p.Repo().Stack().UpdateStackRevision(r.Context(), revision)
 		if err != nil {
 			p.HandleAPIError(w, r, apierrors.NewErrInternal(err))
 			return
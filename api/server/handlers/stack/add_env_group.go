@@ -51,7 +51,7 @@ func (p *StackAddEnvGroupHandler) ServeHTTP(w http.ResponseWriter, r *http.Reque
 		return
 	}
 
-	latestRevision, err := p.Repo().Stack().ReadStackRevisionByNumber(stack.ID, stack.Revisions[0].RevisionNumber)
+	latestRevision, err := p.Repo().Stack().ReadStackRevisionByNumber(r.Context(), stack.ID, stack.Revisions[0].RevisionNumber)
 	if err != nil {
 		p.HandleAPIError(w, r, apierrors.NewErrInternal(err))
 		return
@@ -104,7 +104,7 @@ func (p *StackAddEnvGroupHandler) ServeHTTP(w http.ResponseWriter, r *http.Reque
 		EnvGroups:      envGroups,
 	}
 
-	revision, err := p.Repo().Stack().AppendNewRevision(newRevision)
+	revision, err := p.Repo().Stack().AppendNewRevision(r.Context(), newRevision)
 	if err != nil {
 		p.HandleAPIError(w, r, apierrors.NewErrInternal(err))
 		return
@@ -147,7 +147,7 @@ func (p *StackAddEnvGroupHandler) ServeHTTP(w http.ResponseWriter, r *http.Reque
 		revision.Message = "Env Group " + req.Name + " added successfully."
 	}
 
-	_, err = p.Repo().Stack().UpdateStackRevision(revision)
+	_, err = p.Repo().Stack().UpdateStackRevision(r.Context(), revision)
 
 	if err != nil {
 		p.HandleAPIError(w, r, apierrors.NewErrInternal(err))
@@ -112,7 +112,7 @@ func (p *StackCreateHandler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
 		},
 	}
 
-	stack, err = p.Repo().Stack().CreateStack(stack)
+	stack, err = p.Repo().Stack().CreateStack(r.Context(), stack)
 
 	if err != nil {
 		p.HandleAPIError(w, r, apierrors.NewErrInternal(err))
@@ -157,7 +157,7 @@ func (p *StackCreateHandler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
 		revision.Reason = "EnvGroupDeployErr"
 		revision.Message = strings.Join(envGroupDeployErrors, " , ")
 
-		revision, err = p.Repo().Stack().UpdateStackRevision(revision)
+		revision, err = p.Repo().Stack().UpdateStackRevision(r.Context(), revision)
 
 		if err != nil {
 			p.HandleAPIError(w, r, apierrors.NewErrInternal(err))
@@ -165,7 +165,7 @@ func (p *StackCreateHandler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
 		}
 	} else {
 		// apply all app resources
-		registries, err := p.Repo().Registry().ListRegistriesByProjectID(cluster.ProjectID)
+		registries, err := p.Repo().Registry().ListRegistriesByProjectID(r.Context(), cluster.ProjectID)
 		if err != nil {
 			p.HandleAPIError(w, r, apierrors.NewErrInternal(err))
 			return
@@ -210,7 +210,7 @@ func (p *StackCreateHandler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
 			revision.Status = string(types.StackRevisionStatusDeployed)
 		}
 
-		revision, err = p.Repo().Stack().UpdateStackRevision(revision)
+		revision, err = p.Repo().Stack().UpdateStackRevision(r.Context(), revision)
 
 		if err != nil {
 			p.HandleAPIError(w, r, apierrors.NewErrInternal(err))
@@ -233,7 +233,7 @@ func (p *StackCreateHandler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
 			revision.Reason = "SaveError"
 			revision.Message = strings.Join(saveErrs, " , ")
 
-			revision, err = p.Repo().Stack().UpdateStackRevision(revision)
+			revision, err = p.Repo().Stack().UpdateStackRevision(r.Context(), revision)
 
 			if err != nil {
 				p.HandleAPIError(w, r, apierrors.NewErrInternal(err))
@@ -246,7 +246,7 @@ func (p *StackCreateHandler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
 		revision.Reason = "CreationSuccess"
 		revision.Message = "Stack deployed successfully"
 
-		revision, err = p.Repo().Stack().UpdateStackRevision(revision)
+		revision, err = p.Repo().Stack().UpdateStackRevision(r.Context(), revision)
 
 		if err != nil {
 			p.HandleAPIError(w, r, apierrors.NewErrInternal(err))
@@ -255,7 +255,7 @@ func (p *StackCreateHandler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
 	}
 
 	// read the stack again to get the latest revision info
-	stack, err = p.Repo().Stack().ReadStackByStringID(proj.ID, stack.UID)
+	stack, err = p.Repo().Stack().ReadStackByStringID(r.Context(), proj.ID, stack.UID)
 
 	if err != nil {
 		p.HandleAPIError(w, r, apierrors.NewErrInternal(err))
@@ -34,7 +34,7 @@ func (p *StackDeleteHandler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
 	namespace, _ := r.Context().Value(types.NamespaceScope).(string)
 
 	if len(stack.Revisions) > 0 {
-		revision, err := p.Repo().Stack().ReadStackRevisionByNumber(stack.ID, stack.Revisions[0].RevisionNumber)
+		revision, err := p.Repo().Stack().ReadStackRevisionByNumber(r.Context(), stack.ID, stack.Revisions[0].RevisionNumber)
 		if err != nil {
 			p.HandleAPIError(w, r, apierrors.NewErrInternal(err))
 			return
@@ -66,7 +66,7 @@ func (p *StackDeleteHandler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
 		}
 	}
 
-	stack, err := p.Repo().Stack().DeleteStack(stack)
+	stack, err := p.Repo().Stack().DeleteStack(r.Context(), stack)
 	if err != nil {
 		p.HandleAPIError(w, r, apierrors.NewErrInternal(err))
 		return
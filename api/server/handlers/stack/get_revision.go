@@ -36,7 +36,7 @@ func (p *StackGetRevisionHandler) ServeHTTP(w http.ResponseWriter, r *http.Reque
 		return
 	}
 
-	revision, err := p.Repo().Stack().ReadStackRevisionByNumber(stack.ID, revNumber)
+	revision, err := p.Repo().Stack().ReadStackRevisionByNumber(r.Context(), stack.ID, revNumber)
 	if err != nil {
 		p.HandleAPIError(w, r, apierrors.NewErrInternal(err))
 		return
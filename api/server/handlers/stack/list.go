@@ -29,7 +29,7 @@ func (p *StackListHandler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
 	cluster, _ := r.Context().Value(types.ClusterScope).(*models.Cluster)
 	namespace, _ := r.Context().Value(types.NamespaceScope).(string)
 
-	stacks, err := p.Repo().Stack().ListStacks(proj.ID, cluster.ID, namespace)
+	stacks, err := p.Repo().Stack().ListStacks(r.Context(), proj.ID, cluster.ID, namespace)
 	if err != nil {
 		p.HandleAPIError(w, r, apierrors.NewErrInternal(err))
 		return
@@ -49,7 +49,7 @@ func (p *StackRemoveApplicationHandler) ServeHTTP(w http.ResponseWriter, r *http
 		return
 	}
 
-	revision, err := p.Repo().Stack().ReadStackRevisionByNumber(stack.ID, stack.Revisions[0].RevisionNumber)
+	revision, err := p.Repo().Stack().ReadStackRevisionByNumber(r.Context(), stack.ID, stack.Revisions[0].RevisionNumber)
 	if err != nil {
 		p.HandleAPIError(w, r, apierrors.NewErrInternal(err))
 		return
@@ -90,7 +90,7 @@ func (p *StackRemoveApplicationHandler) ServeHTTP(w http.ResponseWriter, r *http
 		EnvGroups:      envGroups,
 	}
 
-	revision, err = p.Repo().Stack().AppendNewRevision(newRevision)
+	revision, err = p.Repo().Stack().AppendNewRevision(r.Context(), newRevision)
 
 	if err != nil {
 		p.HandleAPIError(w, r, apierrors.NewErrInternal(err))
@@ -118,7 +118,7 @@ func (p *StackRemoveApplicationHandler) ServeHTTP(w http.ResponseWriter, r *http
 		revision.Message = err.Error()
 	}
 
-	_, err = p.Repo().Stack().UpdateStackRevision(revision)
+	_, err = p.Repo().Stack().UpdateStackRevision(r.Context(), revision)
 
 	if err != nil {
 		p.HandleAPIError(w, r, apierrors.NewErrInternal(err))
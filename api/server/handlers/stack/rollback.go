@@ -52,14 +52,14 @@ func (p *StackRollbackHandler) ServeHTTP(w http.ResponseWriter, r *http.Request)
 	}
 
 	// read the target revision
-	revision, err := p.Repo().Stack().ReadStackRevisionByNumber(stack.ID, req.TargetRevision)
+	revision, err := p.Repo().Stack().ReadStackRevisionByNumber(r.Context(), stack.ID, req.TargetRevision)
 	if err != nil {
 		p.HandleAPIError(w, r, apierrors.NewErrInternal(err))
 		return
 	}
 
 	// read the latest revision
-	latestRevision, err := p.Repo().Stack().ReadStackRevisionByNumber(stack.ID, stack.Revisions[0].RevisionNumber)
+	latestRevision, err := p.Repo().Stack().ReadStackRevisionByNumber(r.Context(), stack.ID, stack.Revisions[0].RevisionNumber)
 	if err != nil {
 		p.HandleAPIError(w, r, apierrors.NewErrInternal(err))
 		return
@@ -92,7 +92,7 @@ func (p *StackRollbackHandler) ServeHTTP(w http.ResponseWriter, r *http.Request)
 	revision.Resources = appResources
 	revision.EnvGroups = envGroups
 
-	revision, err = p.Repo().Stack().AppendNewRevision(revision)
+	revision, err = p.Repo().Stack().AppendNewRevision(r.Context(), revision)
 
 	if err != nil {
 		p.HandleAPIError(w, r, apierrors.NewErrInternal(err))
@@ -123,7 +123,7 @@ func (p *StackRollbackHandler) ServeHTTP(w http.ResponseWriter, r *http.Request)
 		revision.Message = fmt.Sprintf("The stack was rolled back to version %d", req.TargetRevision)
 	}
 
-	revision, err = p.Repo().Stack().UpdateStackRevision(revision)
+	revision, err = p.Repo().Stack().UpdateStackRevision(r.Context(), revision)
 
 	if err != nil {
 		p.HandleAPIError(w, r, apierrors.NewErrInternal(err))
@@ -131,7 +131,7 @@ func (p *StackRollbackHandler) ServeHTTP(w http.ResponseWriter, r *http.Request)
 	}
 
 	// read the stack again to get the latest revision info
-	stack, err = p.Repo().Stack().ReadStackByStringID(proj.ID, stack.UID)
+	stack, err = p.Repo().Stack().ReadStackByStringID(r.Context(), proj.ID, stack.UID)
 
 	if err != nil {
 		p.HandleAPIError(w, r, apierrors.NewErrInternal(err))
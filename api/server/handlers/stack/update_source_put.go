@@ -51,7 +51,7 @@ func (p *StackPutSourceConfigHandler) ServeHTTP(w http.ResponseWriter, r *http.R
 	}
 
 	// read the latest revision
-	revision, err := p.Repo().Stack().ReadStackRevisionByNumber(stack.ID, stack.Revisions[0].RevisionNumber)
+	revision, err := p.Repo().Stack().ReadStackRevisionByNumber(r.Context(), stack.ID, stack.Revisions[0].RevisionNumber)
 	if err != nil {
 		p.HandleAPIError(w, r, apierrors.NewErrInternal(err))
 		return
@@ -77,7 +77,7 @@ func (p *StackPutSourceConfigHandler) ServeHTTP(w http.ResponseWriter, r *http.R
 
 	revision.Resources = clonedAppResources
 
-	revision, err = p.Repo().Stack().AppendNewRevision(revision)
+	revision, err = p.Repo().Stack().AppendNewRevision(r.Context(), revision)
 
 	if err != nil {
 		p.HandleAPIError(w, r, apierrors.NewErrInternal(err))
@@ -85,7 +85,7 @@ func (p *StackPutSourceConfigHandler) ServeHTTP(w http.ResponseWriter, r *http.R
 	}
 
 	// apply to cluster
-	registries, err := p.Repo().Registry().ListRegistriesByProjectID(cluster.ProjectID)
+	registries, err := p.Repo().Registry().ListRegistriesByProjectID(r.Context(), cluster.ProjectID)
 	if err != nil {
 		p.HandleAPIError(w, r, apierrors.NewErrInternal(err))
 		return
@@ -94,7 +94,7 @@ func (p *StackPutSourceConfigHandler) ServeHTTP(w http.ResponseWriter, r *http.R
 	deployErrs := make([]string, 0)
 
 	// read the stack again to get the latest revision info
-	stack, err = p.Repo().Stack().ReadStackByStringID(proj.ID, stack.UID)
+	stack, err = p.Repo().Stack().ReadStackByStringID(r.Context(), proj.ID, stack.UID)
 
 	if err != nil {
 		p.HandleAPIError(w, r, apierrors.NewErrInternal(err))
@@ -143,7 +143,7 @@ func (p *StackPutSourceConfigHandler) ServeHTTP(w http.ResponseWriter, r *http.R
 		revision.Message = fmt.Sprintf("The source configuration was updated")
 	}
 
-	revision, err = p.Repo().Stack().UpdateStackRevision(revision)
+	revision, err = p.Repo().Stack().UpdateStackRevision(r.Context(), revision)
 
 	if err != nil {
 		p.HandleAPIError(w, r, apierrors.NewErrInternal(err))
@@ -151,7 +151,7 @@ func (p *StackPutSourceConfigHandler) ServeHTTP(w http.ResponseWriter, r *http.R
 	}
 
 	// read the stack again to get the latest revision info
-	stack, err = p.Repo().Stack().ReadStackByStringID(proj.ID, stack.UID)
+	stack, err = p.Repo().Stack().ReadStackByStringID(r.Context(), proj.ID, stack.UID)
 
 	if err != nil {
 		p.HandleAPIError(w, r, apierrors.NewErrInternal(err))
@@ -43,7 +43,7 @@ func (p *StackUpdateStack) ServeHTTP(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
-	stack, err := p.Repo().Stack().ReadStackByID(proj.ID, stack.ID)
+	stack, err := p.Repo().Stack().ReadStackByID(r.Context(), proj.ID, stack.ID)
 	if err != nil {
 		p.HandleAPIError(w, r, apierrors.NewErrInternal(err))
 		return
@@ -52,7 +52,7 @@ func (p *StackUpdateStack) ServeHTTP(w http.ResponseWriter, r *http.Request) {
 	// Update stack name
 	stack.Name = req.Name
 
-	newStack, err := p.Repo().Stack().UpdateStack(stack)
+	newStack, err := p.Repo().Stack().UpdateStack(r.Context(), stack)
 	if err != nil {
 		p.HandleAPIError(w, r, apierrors.NewErrInternal(err))
 		return
@@ -0,0 +1,213 @@
+package subdomain
+
+import (
+	"context"
+	"errors"
+	"net/http"
+
+	"github.com/porter-dev/porter/api/server/handlers"
+	"github.com/porter-dev/porter/api/server/shared"
+	"github.com/porter-dev/porter/api/server/shared/apierrors"
+	"github.com/porter-dev/porter/api/server/shared/config"
+	"github.com/porter-dev/porter/api/server/shared/requestutils"
+	"github.com/porter-dev/porter/api/types"
+	"github.com/porter-dev/porter/internal/models"
+	"github.com/porter-dev/porter/internal/subdomain"
+	"github.com/porter-dev/porter/internal/telemetry"
+	"gorm.io/gorm"
+)
+
+// ListSubdomainAllocationsHandler lists the subdomains allocated from the wildcard domain of a project
+type ListSubdomainAllocationsHandler struct {
+	handlers.PorterHandlerWriter
+}
+
+// NewListSubdomainAllocationsHandler returns a new ListSubdomainAllocationsHandler
+func NewListSubdomainAllocationsHandler(
+	config *config.Config,
+	writer shared.ResultWriter,
+) *ListSubdomainAllocationsHandler {
+	return &ListSubdomainAllocationsHandler{
+		PorterHandlerWriter: handlers.NewDefaultPorterHandler(config, nil, writer),
+	}
+}
+
+// ServeHTTP returns the wildcard domain of a project and the subdomains allocated from it. The response is empty if the project
+// has no wildcard domain.
+func (c *ListSubdomainAllocationsHandler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	ctx, span := telemetry.NewSpan(r.Context(), "serve-list-subdomain-allocations")
+	defer span.End()
+
+	project, _ := ctx.Value(types.ProjectScope).(*models.Project)
+
+	res := &types.ListSubdomainAllocationsResponse{
+		Allocations: []types.SubdomainAllocation{},
+	}
+
+	wildcardDomain, err := c.Repo().Subdomain().ReadWildcardDomain(ctx, project.ID)
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			c.WriteResult(w, r, res)
+			return
+		}
+
+		err := telemetry.Error(ctx, span, err, "error reading wildcard domain")
+		c.HandleAPIError(w, r, apierrors.NewErrInternal(err))
+		return
+	}
+
+	allocations, err := c.Repo().Subdomain().ListSubdomainAllocations(ctx, project.ID)
+	if err != nil {
+		err := telemetry.Error(ctx, span, err, "error listing subdomain allocations")
+		c.HandleAPIError(w, r, apierrors.NewErrInternal(err))
+		return
+	}
+
+	res.WildcardDomain = wildcardDomain.Domain
+
+	for _, allocation := range allocations {
+		res.Allocations = append(res.Allocations, allocation.ToSubdomainAllocationType(wildcardDomain.Domain))
+	}
+
+	c.WriteResult(w, r, res)
+}
+
+// ReserveSubdomainHandler reserves a subdomain of the wildcard domain of a project
+type ReserveSubdomainHandler struct {
+	handlers.PorterHandlerReadWriter
+}
+
+// NewReserveSubdomainHandler returns a new ReserveSubdomainHandler
+func NewReserveSubdomainHandler(
+	config *config.Config,
+	decoderValidator shared.RequestDecoderValidator,
+	writer shared.ResultWriter,
+) *ReserveSubdomainHandler {
+	return &ReserveSubdomainHandler{
+		PorterHandlerReadWriter: handlers.NewDefaultPorterHandler(config, decoderValidator, writer),
+	}
+}
+
+// ServeHTTP reserves a subdomain so that it is not allocated automatically. A subdomain reserved for a service of an app is
+// allocated to that service the next time the app is deployed without a domain.
+func (c *ReserveSubdomainHandler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	ctx, span := telemetry.NewSpan(r.Context(), "serve-reserve-subdomain")
+	defer span.End()
+
+	project, _ := ctx.Value(types.ProjectScope).(*models.Project)
+
+	request := &types.ReserveSubdomainRequest{}
+	if ok := c.DecodeAndValidate(w, r, request); !ok {
+		return
+	}
+
+	telemetry.WithAttributes(span, telemetry.AttributeKV{Key: "subdomain", Value: request.Name})
+
+	wildcardDomain, reqErr := readWildcardDomain(ctx, c.Config(), project.ID)
+	if reqErr != nil {
+		c.HandleAPIError(w, r, reqErr)
+		return
+	}
+
+	if request.ClusterID != 0 {
+		if _, err := c.Repo().Cluster().ReadCluster(ctx, project.ID, request.ClusterID); err != nil {
+			err := telemetry.Error(ctx, span, err, "cluster not found in project")
+			c.HandleAPIError(w, r, apierrors.NewErrPassThroughToClient(err, http.StatusBadRequest))
+			return
+		}
+	}
+
+	allocation, err := subdomain.Reserve(ctx, c.Repo().Subdomain(), &models.SubdomainAllocation{
+		ProjectID:   project.ID,
+		Name:        request.Name,
+		ClusterID:   request.ClusterID,
+		AppName:     request.AppName,
+		ServiceName: request.ServiceName,
+	})
+	if err != nil {
+		if errors.Is(err, subdomain.ErrNameTaken) {
+			err := telemetry.Error(ctx, span, err, "subdomain is already allocated")
+			c.HandleAPIError(w, r, apierrors.NewErrPassThroughToClient(err, http.StatusConflict))
+			return
+		}
+
+		err := telemetry.Error(ctx, span, err, "error reserving subdomain")
+		c.HandleAPIError(w, r, apierrors.NewErrPassThroughToClient(err, http.StatusBadRequest))
+		return
+	}
+
+	c.WriteResult(w, r, allocation.ToSubdomainAllocationType(wildcardDomain.Domain))
+}
+
+// ReleaseSubdomainHandler releases a subdomain of the wildcard domain of a project
+type ReleaseSubdomainHandler struct {
+	handlers.PorterHandlerWriter
+}
+
+// NewReleaseSubdomainHandler returns a new ReleaseSubdomainHandler
+func NewReleaseSubdomainHandler(
+	config *config.Config,
+	writer shared.ResultWriter,
+) *ReleaseSubdomainHandler {
+	return &ReleaseSubdomainHandler{
+		PorterHandlerWriter: handlers.NewDefaultPorterHandler(config, nil, writer),
+	}
+}
+
+// ServeHTTP releases a subdomain so that its name can be allocated again. An app deployed with the subdomain keeps serving it
+// until the app is redeployed, or the name is allocated to another app.
+func (c *ReleaseSubdomainHandler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	ctx, span := telemetry.NewSpan(r.Context(), "serve-release-subdomain")
+	defer span.End()
+
+	project, _ := ctx.Value(types.ProjectScope).(*models.Project)
+
+	name, reqErr := requestutils.GetURLParamString(r, types.URLParamSubdomainName)
+	if reqErr != nil {
+		err := telemetry.Error(ctx, span, reqErr, "error parsing subdomain from url")
+		c.HandleAPIError(w, r, apierrors.NewErrPassThroughToClient(err, http.StatusBadRequest))
+		return
+	}
+
+	telemetry.WithAttributes(span, telemetry.AttributeKV{Key: "subdomain", Value: name})
+
+	allocation, err := c.Repo().Subdomain().ReadSubdomainAllocation(ctx, project.ID, name)
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			err := telemetry.Error(ctx, span, err, "subdomain is not allocated")
+			c.HandleAPIError(w, r, apierrors.NewErrNotFound(err))
+			return
+		}
+
+		err := telemetry.Error(ctx, span, err, "error reading subdomain allocation")
+		c.HandleAPIError(w, r, apierrors.NewErrInternal(err))
+		return
+	}
+
+	if err := c.Repo().Subdomain().DeleteSubdomainAllocation(ctx, allocation); err != nil {
+		err := telemetry.Error(ctx, span, err, "error releasing subdomain")
+		c.HandleAPIError(w, r, apierrors.NewErrInternal(err))
+		return
+	}
+
+	w.WriteHeader(http.StatusOK)
+}
+
+// readWildcardDomain reads the wildcard domain of a project, which subdomains can only be allocated from once it is set
+func readWildcardDomain(ctx context.Context, config *config.Config, projectID uint) (*models.WildcardDomain, apierrors.RequestError) {
+	ctx, span := telemetry.NewSpan(ctx, "read-wildcard-domain")
+	defer span.End()
+
+	wildcardDomain, err := config.Repo.Subdomain().ReadWildcardDomain(ctx, projectID)
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			err := telemetry.Error(ctx, span, err, "project has no wildcard domain")
+			return nil, apierrors.NewErrPassThroughToClient(err, http.StatusBadRequest)
+		}
+
+		err := telemetry.Error(ctx, span, err, "error reading wildcard domain")
+		return nil, apierrors.NewErrInternal(err)
+	}
+
+	return wildcardDomain, nil
+}
@@ -0,0 +1,112 @@
+package subdomain
+
+import (
+	"errors"
+	"net/http"
+
+	"github.com/porter-dev/porter/api/server/handlers"
+	"github.com/porter-dev/porter/api/server/shared"
+	"github.com/porter-dev/porter/api/server/shared/apierrors"
+	"github.com/porter-dev/porter/api/server/shared/config"
+	"github.com/porter-dev/porter/api/types"
+	"github.com/porter-dev/porter/internal/models"
+	"github.com/porter-dev/porter/internal/subdomain"
+	"github.com/porter-dev/porter/internal/telemetry"
+	"gorm.io/gorm"
+)
+
+// SetWildcardDomainHandler sets the wildcard domain of a project
+type SetWildcardDomainHandler struct {
+	handlers.PorterHandlerReadWriter
+}
+
+// NewSetWildcardDomainHandler returns a new SetWildcardDomainHandler
+func NewSetWildcardDomainHandler(
+	config *config.Config,
+	decoderValidator shared.RequestDecoderValidator,
+	writer shared.ResultWriter,
+) *SetWildcardDomainHandler {
+	return &SetWildcardDomainHandler{
+		PorterHandlerReadWriter: handlers.NewDefaultPorterHandler(config, decoderValidator, writer),
+	}
+}
+
+// ServeHTTP sets the wildcard domain of a project, from which new apps and preview environments are allocated subdomains.
+// Changing the domain keeps the allocated names, but apps keep the hostnames they were deployed with until they are redeployed.
+func (c *SetWildcardDomainHandler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	ctx, span := telemetry.NewSpan(r.Context(), "serve-set-wildcard-domain")
+	defer span.End()
+
+	project, _ := ctx.Value(types.ProjectScope).(*models.Project)
+
+	request := &types.SetWildcardDomainRequest{}
+	if ok := c.DecodeAndValidate(w, r, request); !ok {
+		return
+	}
+
+	domain, err := subdomain.NormalizeWildcardDomain(request.Domain)
+	if err != nil {
+		err := telemetry.Error(ctx, span, err, "invalid wildcard domain")
+		c.HandleAPIError(w, r, apierrors.NewErrPassThroughToClient(err, http.StatusBadRequest))
+		return
+	}
+
+	telemetry.WithAttributes(span, telemetry.AttributeKV{Key: "wildcard-domain", Value: domain})
+
+	wildcardDomain, err := c.Repo().Subdomain().SaveWildcardDomain(ctx, &models.WildcardDomain{
+		ProjectID: project.ID,
+		Domain:    domain,
+	})
+	if err != nil {
+		err := telemetry.Error(ctx, span, err, "error saving wildcard domain")
+		c.HandleAPIError(w, r, apierrors.NewErrInternal(err))
+		return
+	}
+
+	c.WriteResult(w, r, wildcardDomain.ToWildcardDomainType())
+}
+
+// DeleteWildcardDomainHandler deletes the wildcard domain of a project
+type DeleteWildcardDomainHandler struct {
+	handlers.PorterHandlerWriter
+}
+
+// NewDeleteWildcardDomainHandler returns a new DeleteWildcardDomainHandler
+func NewDeleteWildcardDomainHandler(
+	config *config.Config,
+	writer shared.ResultWriter,
+) *DeleteWildcardDomainHandler {
+	return &DeleteWildcardDomainHandler{
+		PorterHandlerWriter: handlers.NewDefaultPorterHandler(config, nil, writer),
+	}
+}
+
+// ServeHTTP deletes the wildcard domain of a project and releases the subdomains allocated from it. Apps which are allocated
+// subdomains afterwards get Porter domains instead.
+func (c *DeleteWildcardDomainHandler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	ctx, span := telemetry.NewSpan(r.Context(), "serve-delete-wildcard-domain")
+	defer span.End()
+
+	project, _ := ctx.Value(types.ProjectScope).(*models.Project)
+
+	wildcardDomain, err := c.Repo().Subdomain().ReadWildcardDomain(ctx, project.ID)
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			err := telemetry.Error(ctx, span, err, "project has no wildcard domain")
+			c.HandleAPIError(w, r, apierrors.NewErrNotFound(err))
+			return
+		}
+
+		err := telemetry.Error(ctx, span, err, "error reading wildcard domain")
+		c.HandleAPIError(w, r, apierrors.NewErrInternal(err))
+		return
+	}
+
+	if err := c.Repo().Subdomain().DeleteWildcardDomain(ctx, wildcardDomain); err != nil {
+		err := telemetry.Error(ctx, span, err, "error deleting wildcard domain")
+		c.HandleAPIError(w, r, apierrors.NewErrInternal(err))
+		return
+	}
+
+	w.WriteHeader(http.StatusOK)
+}
@@ -34,7 +34,7 @@ func (c *CanCreateProject) ServeHTTP(w http.ResponseWriter, r *http.Request) {
 
 	user, _ := r.Context().Value(types.UserScope).(*models.User)
 
-	exists, err := c.Repo().Allowlist().UserEmailExists(user.Email)
+	exists, err := c.Repo().Allowlist().UserEmailExists(r.Context(), user.Email)
 	if err != nil {
 		err = fmt.Errorf("couldn't retrieve user: %s", err.Error())
 		c.HandleAPIError(w, r, apierrors.NewErrInternal(err))
@@ -78,7 +78,7 @@ func (c *CLILoginHandler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
 		Expiry:            &expiry,
 	}
 
-	authCode, err = c.Repo().AuthCode().CreateAuthCode(authCode)
+	authCode, err = c.Repo().AuthCode().CreateAuthCode(r.Context(), authCode)
 
 	if err != nil {
 		c.HandleAPIError(w, r, apierrors.NewErrInternal(err))
@@ -112,7 +112,7 @@ func (c *CLILoginExchangeHandler) ServeHTTP(w http.ResponseWriter, r *http.Reque
 	}
 
 	// look up the auth code and exchange it for a token
-	authCode, err := c.Repo().AuthCode().ReadAuthCode(request.AuthorizationCode)
+	authCode, err := c.Repo().AuthCode().ReadAuthCode(r.Context(), request.AuthorizationCode)
 
 	if err != nil || authCode.IsExpired() {
 		http.Error(w, http.StatusText(http.StatusForbidden), http.StatusForbidden)
@@ -1,6 +1,7 @@
 package user
 
 import (
+	"context"
 	"fmt"
 	"net/http"
 
@@ -48,7 +49,7 @@ func (u *UserCreateHandler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
 	}
 
 	// check if user exists
-	doesExist := doesUserExist(u.Repo().User(), user)
+	doesExist := doesUserExist(r.Context(), u.Repo().User(), user)
 
 	if doesExist {
 		err := fmt.Errorf("email already taken")
@@ -71,14 +72,14 @@ func (u *UserCreateHandler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
 	user.Password = string(hashedPw)
 
 	// write the user to the db
-	user, err = u.Repo().User().CreateUser(user)
+	user, err = u.Repo().User().CreateUser(r.Context(), user)
 
 	if err != nil {
 		u.HandleAPIError(w, r, apierrors.NewErrInternal(err))
 		return
 	}
 
-	err = addUserToDefaultProject(u.Config(), user)
+	err = addUserToDefaultProject(r.Context(), u.Config(), user)
 
 	if err != nil {
 		u.HandleAPIError(w, r, apierrors.NewErrInternal(err))
@@ -120,26 +121,26 @@ func (u *UserCreateHandler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
 	u.WriteResult(w, r, user.ToUserType())
 }
 
-func doesUserExist(userRepo repository.UserRepository, user *models.User) bool {
-	user, err := userRepo.ReadUserByEmail(user.Email)
+func doesUserExist(ctx context.Context, userRepo repository.UserRepository, user *models.User) bool {
+	user, err := userRepo.ReadUserByEmail(ctx, user.Email)
 
 	return user != nil && err == nil
 }
 
 // addUserToDefaultProject adds the created user to any default projects if required by
 // config variables.
-func addUserToDefaultProject(config *config.Config, user *models.User) error {
+func addUserToDefaultProject(ctx context.Context, config *config.Config, user *models.User) error {
 	if config.ServerConf.InitInCluster {
 		// if this is the first user, add the user to the default project
 		if user.ID == 1 {
 			// read the default project
-			project, err := config.Repo.Project().ReadProject(1)
+			project, err := config.Repo.Project().ReadProject(ctx, 1)
 			if err != nil {
 				return err
 			}
 
 			// create a new Role with the user as the admin
-			_, err = config.Repo.Project().CreateProjectRole(project, &models.Role{
+			_, err = config.Repo.Project().CreateProjectRole(ctx, project, &models.Role{
 				Role: types.Role{
 					UserID:    user.ID,
 					ProjectID: project.ID,
@@ -28,7 +28,7 @@ func NewUserDeleteHandler(
 func (u *UserDeleteHandler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
 	user, _ := r.Context().Value(types.UserScope).(*models.User)
 
-	user, err := u.Repo().User().DeleteUser(user)
+	user, err := u.Repo().User().DeleteUser(r.Context(), user)
 	if err != nil {
 		u.HandleAPIError(w, r, apierrors.NewErrInternal(err))
 		return
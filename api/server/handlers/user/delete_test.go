@@ -1,6 +1,7 @@
 package user_test
 
 import (
+	"context"
 	"testing"
 
 	"github.com/porter-dev/porter/api/server/handlers/user"
@@ -45,7 +46,7 @@ func TestDeleteUserSuccessful(t *testing.T) {
 	apitest.AssertResponseExpected(t, rr, expUser, gotUser)
 
 	// assert that the user has been deleted
-	authUser, err := config.Repo.User().ReadUser(1)
+	authUser, err := config.Repo.User().ReadUser(context.Background(), 1)
 
 	targetErr := gorm.ErrRecordNotFound
 
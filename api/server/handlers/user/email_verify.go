@@ -60,6 +60,7 @@ func (v *VerifyEmailFinalizeHandler) ServeHTTP(w http.ResponseWriter, r *http.Re
 	}
 
 	token, err := VerifyToken(
+		r.Context(),
 		v.Repo().PWResetToken(),
 		handlers.IgnoreAPIError,
 		w,
@@ -74,7 +75,7 @@ func (v *VerifyEmailFinalizeHandler) ServeHTTP(w http.ResponseWriter, r *http.Re
 
 	user.EmailVerified = true
 
-	user, err = v.Repo().User().UpdateUser(user)
+	user, err = v.Repo().User().UpdateUser(r.Context(), user)
 
 	if err != nil {
 		http.Redirect(w, r, "/dashboard?error="+url.QueryEscape("Could not verify email address"), 302)
@@ -84,7 +85,7 @@ func (v *VerifyEmailFinalizeHandler) ServeHTTP(w http.ResponseWriter, r *http.Re
 	// invalidate the token
 	token.IsValid = false
 
-	_, err = v.Repo().PWResetToken().UpdatePWResetToken(token)
+	_, err = v.Repo().PWResetToken().UpdatePWResetToken(r.Context(), token)
 
 	if err != nil {
 		http.Redirect(w, r, "/dashboard?error="+url.QueryEscape("Could not verify email address"), 302)
@@ -106,6 +107,7 @@ func startEmailVerification(
 	user *models.User,
 ) error {
 	pwReset, rawToken, err := CreatePWResetTokenForEmail(
+		r.Context(),
 		config.Repo.PWResetToken(),
 		handlers.IgnoreAPIError,
 		w,
@@ -1,6 +1,7 @@
 package user_test
 
 import (
+	"context"
 	"fmt"
 	"net/url"
 	"testing"
@@ -42,7 +43,7 @@ func TestEmailVerifyInitiateSuccessful(t *testing.T) {
 	}
 
 	// read token from the DB
-	token, err := config.Repo.PWResetToken().ReadPWResetToken(1)
+	token, err := config.Repo.PWResetToken().ReadPWResetToken(context.Background(), 1)
 	if err != nil {
 		t.Fatal(err)
 	}
@@ -60,6 +61,7 @@ func TestEmailVerifyFinalizeSuccessful(t *testing.T) {
 
 	// create a token in the DB to use for testing
 	pwReset, rawToken, err := user.CreatePWResetTokenForEmail(
+		context.Background(),
 		config.Repo.PWResetToken(),
 		handlers.IgnoreAPIError,
 		nil,
@@ -92,7 +94,7 @@ func TestEmailVerifyFinalizeSuccessful(t *testing.T) {
 	handler.ServeHTTP(rr, req)
 
 	// read the user and check that their email has been verified
-	authUser, err = config.Repo.User().ReadUser(authUser.ID)
+	authUser, err = config.Repo.User().ReadUser(context.Background(), authUser.ID)
 
 	assert.True(t, authUser.EmailVerified)
 }
@@ -63,7 +63,7 @@ func (p *UserOAuthGithubCallbackHandler) ServeHTTP(w http.ResponseWriter, r *htt
 	}
 
 	// otherwise, create the user if not exists
-	user, err := upsertUserFromToken(p.Config(), token)
+	user, err := upsertUserFromToken(r.Context(), p.Config(), token)
 
 	if err != nil && strings.Contains(err.Error(), "already registered") {
 		http.Redirect(w, r, "/login?error="+url.QueryEscape(err.Error()), 302)
@@ -99,7 +99,7 @@ func (p *UserOAuthGithubCallbackHandler) ServeHTTP(w http.ResponseWriter, r *htt
 	http.Redirect(w, r, "/dashboard", 302)
 }
 
-func upsertUserFromToken(config *config.Config, tok *oauth2.Token) (*models.User, error) {
+func upsertUserFromToken(ctx context.Context, config *config.Config, tok *oauth2.Token) (*models.User, error) {
 	// determine if the user already exists
 	client := github.NewClient(config.GithubConf.Client(oauth2.NoContext, tok))
 
@@ -108,7 +108,7 @@ func upsertUserFromToken(config *config.Config, tok *oauth2.Token) (*models.User
 		return nil, err
 	}
 
-	user, err := config.Repo.User().ReadUserByGithubUserID(*githubUser.ID)
+	user, err := config.Repo.User().ReadUserByGithubUserID(ctx, *githubUser.ID)
 
 	// if the user does not exist, create new user
 	if err != nil && err == gorm.ErrRecordNotFound {
@@ -138,7 +138,7 @@ func upsertUserFromToken(config *config.Config, tok *oauth2.Token) (*models.User
 		}
 
 		// check if a user with that email address already exists
-		_, err = config.Repo.User().ReadUserByEmail(primary)
+		_, err = config.Repo.User().ReadUserByEmail(ctx, primary)
 
 		if err == gorm.ErrRecordNotFound {
 			user = &models.User{
@@ -147,13 +147,13 @@ func upsertUserFromToken(config *config.Config, tok *oauth2.Token) (*models.User
 				GithubUserID:  githubUser.GetID(),
 			}
 
-			user, err = config.Repo.User().CreateUser(user)
+			user, err = config.Repo.User().CreateUser(ctx, user)
 
 			if err != nil {
 				return nil, err
 			}
 
-			err = addUserToDefaultProject(config, user)
+			err = addUserToDefaultProject(ctx, config, user)
 
 			if err != nil {
 				return nil, err
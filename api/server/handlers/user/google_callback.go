@@ -1,6 +1,7 @@
 package user
 
 import (
+	"context"
 	"encoding/json"
 	"fmt"
 	"io/ioutil"
@@ -63,7 +64,7 @@ func (p *UserOAuthGoogleCallbackHandler) ServeHTTP(w http.ResponseWriter, r *htt
 	}
 
 	// otherwise, create the user if not exists
-	user, err := upsertGoogleUserFromToken(p.Config(), token)
+	user, err := upsertGoogleUserFromToken(r.Context(), p.Config(), token)
 
 	if err != nil && strings.Contains(err.Error(), "already registered") {
 		http.Redirect(w, r, "/login?error="+url.QueryEscape(err.Error()), 302)
@@ -102,7 +103,7 @@ func (p *UserOAuthGoogleCallbackHandler) ServeHTTP(w http.ResponseWriter, r *htt
 	http.Redirect(w, r, "/dashboard", 302)
 }
 
-func upsertGoogleUserFromToken(config *config.Config, tok *oauth2.Token) (*models.User, error) {
+func upsertGoogleUserFromToken(ctx context.Context, config *config.Config, tok *oauth2.Token) (*models.User, error) {
 	gInfo, err := getGoogleUserInfoFromToken(tok)
 	if err != nil {
 		return nil, err
@@ -119,12 +120,12 @@ func upsertGoogleUserFromToken(config *config.Config, tok *oauth2.Token) (*model
 		}
 	}
 
-	user, err := config.Repo.User().ReadUserByGoogleUserID(gInfo.Sub)
+	user, err := config.Repo.User().ReadUserByGoogleUserID(ctx, gInfo.Sub)
 
 	// if the user does not exist, create new user
 	if err != nil && err == gorm.ErrRecordNotFound {
 		// check if a user with that email address already exists
-		_, err = config.Repo.User().ReadUserByEmail(gInfo.Email)
+		_, err = config.Repo.User().ReadUserByEmail(ctx, gInfo.Email)
 
 		if err == gorm.ErrRecordNotFound {
 			user = &models.User{
@@ -133,13 +134,13 @@ func upsertGoogleUserFromToken(config *config.Config, tok *oauth2.Token) (*model
 				GoogleUserID:  gInfo.Sub,
 			}
 
-			user, err = config.Repo.User().CreateUser(user)
+			user, err = config.Repo.User().CreateUser(ctx, user)
 
 			if err != nil {
 				return nil, err
 			}
 
-			err = addUserToDefaultProject(config, user)
+			err = addUserToDefaultProject(ctx, config, user)
 
 			if err != nil {
 				return nil, err
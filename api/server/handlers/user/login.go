@@ -16,6 +16,10 @@ import (
 	"gorm.io/gorm"
 )
 
+// errMFACodeRequired is returned when a user who enabled multi-factor authentication logs in without a code, so that
+// the client can prompt for one
+var errMFACodeRequired = fmt.Errorf("multi-factor authentication code required")
+
 type UserLoginHandler struct {
 	handlers.PorterHandlerReadWriter
 }
@@ -43,7 +47,7 @@ func (u *UserLoginHandler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
 	}
 
 	// check that passwords match
-	storedUser, err := u.Repo().User().ReadUserByEmail(request.Email)
+	storedUser, err := u.Repo().User().ReadUserByEmail(r.Context(), request.Email)
 	// case on user not existing, send forbidden error if not exist
 	if err != nil {
 		if targetErr := gorm.ErrRecordNotFound; errors.Is(err, targetErr) {
@@ -61,8 +65,30 @@ func (u *UserLoginHandler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
+	// users who enabled multi-factor authentication must also provide a code
+	saveUserAuthenticated := authn.SaveUserAuthenticated
+	if storedUser.MFAEnabled {
+		if request.MFACode == "" {
+			u.HandleAPIError(w, r, apierrors.NewErrPassThroughToClient(errMFACodeRequired, http.StatusUnauthorized))
+			return
+		}
+
+		ok, err := authn.VerifyMFACode(r.Context(), u.Repo(), storedUser, request.MFACode)
+		if err != nil {
+			u.HandleAPIError(w, r, apierrors.NewErrInternal(err))
+			return
+		}
+
+		if !ok {
+			u.HandleAPIError(w, r, apierrors.NewErrPassThroughToClient(fmt.Errorf("invalid multi-factor authentication code"), http.StatusUnauthorized))
+			return
+		}
+
+		saveUserAuthenticated = authn.SaveUserAuthenticatedWithMFA
+	}
+
 	// save the user as authenticated in the session
-	redirect, err := authn.SaveUserAuthenticated(w, r, u.Config(), storedUser)
+	redirect, err := saveUserAuthenticated(w, r, u.Config(), storedUser)
 	if err != nil {
 		u.HandleAPIError(w, r, apierrors.NewErrInternal(err))
 		return
@@ -1,14 +1,18 @@
 package user_test
 
 import (
+	"context"
 	"fmt"
 	"net/http"
 	"testing"
+	"time"
 
 	"github.com/porter-dev/porter/api/server/handlers/user"
 	"github.com/porter-dev/porter/api/server/shared"
 	"github.com/porter-dev/porter/api/server/shared/apitest"
 	"github.com/porter-dev/porter/api/types"
+	"github.com/porter-dev/porter/internal/auth/totp"
+	"github.com/porter-dev/porter/internal/models"
 	"github.com/porter-dev/porter/internal/repository/test"
 )
 
@@ -178,3 +182,84 @@ func TestLoginUserFailingReadUserByEmailMethod(t *testing.T) {
 
 	apitest.AssertResponseInternalServerError(t, rr)
 }
+
+func TestLoginUserMFA(t *testing.T) {
+	config := apitest.LoadConfig(t)
+	storedUser := apitest.CreateTestUser(t, config, true)
+
+	secret, err := totp.GenerateSecret()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if _, err := config.Repo.UserMFA().CreateUserMFA(context.Background(), &models.UserMFA{UserID: storedUser.ID, TOTPSecret: []byte(secret)}); err != nil {
+		t.Fatal(err)
+	}
+
+	storedUser.MFAEnabled = true
+	if _, err := config.Repo.User().UpdateUser(context.Background(), storedUser); err != nil {
+		t.Fatal(err)
+	}
+
+	handler := user.NewUserLoginHandler(
+		config,
+		shared.NewDefaultRequestDecoderValidator(config.Logger, config.Alerter),
+		shared.NewDefaultResultWriter(config.Logger, config.Alerter),
+	)
+
+	req, rr := apitest.GetRequestAndRecorder(
+		t,
+		string(types.HTTPVerbPost),
+		"/api/login",
+		&types.LoginUserRequest{
+			Email:    "mrp@porter.run",
+			Password: "hello",
+		},
+	)
+
+	handler.ServeHTTP(rr, req)
+
+	apitest.AssertResponseError(t, rr, http.StatusUnauthorized, &types.ExternalError{
+		Error: "multi-factor authentication code required",
+	})
+
+	code, err := totp.GenerateCode(secret, totp.Step(time.Now()))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	req, rr = apitest.GetRequestAndRecorder(
+		t,
+		string(types.HTTPVerbPost),
+		"/api/login",
+		&types.LoginUserRequest{
+			Email:    "mrp@porter.run",
+			Password: "hello",
+			MFACode:  code,
+		},
+	)
+
+	handler.ServeHTTP(rr, req)
+
+	if rr.Code != http.StatusOK {
+		t.Fatalf("expected login with a valid code to succeed, got status %d: %s", rr.Code, rr.Body.String())
+	}
+
+	// a code cannot be used twice
+	req, rr = apitest.GetRequestAndRecorder(
+		t,
+		string(types.HTTPVerbPost),
+		"/api/login",
+		&types.LoginUserRequest{
+			Email:    "mrp@porter.run",
+			Password: "hello",
+			MFACode:  code,
+		},
+	)
+
+	handler.ServeHTTP(rr, req)
+
+	apitest.AssertResponseError(t, rr, http.StatusUnauthorized, &types.ExternalError{
+		Error: "invalid multi-factor authentication code",
+	})
+}
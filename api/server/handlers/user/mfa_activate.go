@@ -0,0 +1,108 @@
+package user
+
+import (
+	"errors"
+	"net/http"
+
+	"gorm.io/gorm"
+
+	"github.com/porter-dev/porter/api/server/authn"
+	"github.com/porter-dev/porter/api/server/handlers"
+	"github.com/porter-dev/porter/api/server/shared"
+	"github.com/porter-dev/porter/api/server/shared/apierrors"
+	"github.com/porter-dev/porter/api/server/shared/config"
+	"github.com/porter-dev/porter/api/types"
+	"github.com/porter-dev/porter/internal/models"
+	"github.com/porter-dev/porter/internal/telemetry"
+)
+
+// UserActivateMFAHandler handles POST requests to the /api/users/current/mfa/activate endpoint
+type UserActivateMFAHandler struct {
+	handlers.PorterHandlerReadWriter
+}
+
+// NewUserActivateMFAHandler returns a new UserActivateMFAHandler
+func NewUserActivateMFAHandler(
+	config *config.Config,
+	decoderValidator shared.RequestDecoderValidator,
+	writer shared.ResultWriter,
+) *UserActivateMFAHandler {
+	return &UserActivateMFAHandler{
+		PorterHandlerReadWriter: handlers.NewDefaultPorterHandler(config, decoderValidator, writer),
+	}
+}
+
+// ServeHTTP enables multi-factor authentication for the user once they verify a code from their authenticator app, and
+// returns their recovery codes
+func (u *UserActivateMFAHandler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	ctx, span := telemetry.NewSpan(r.Context(), "serve-activate-mfa")
+	defer span.End()
+
+	user, _ := ctx.Value(types.UserScope).(*models.User)
+	telemetry.WithAttributes(span, telemetry.AttributeKV{Key: "user-id", Value: user.ID})
+
+	request := &types.VerifyMFACodeRequest{}
+	if ok := u.DecodeAndValidate(w, r, request); !ok {
+		return
+	}
+
+	if user.MFAEnabled {
+		err := telemetry.Error(ctx, span, nil, "multi-factor authentication is already enabled")
+		u.HandleAPIError(w, r, apierrors.NewErrPassThroughToClient(err, http.StatusBadRequest))
+		return
+	}
+
+	ok, err := authn.VerifyMFACode(ctx, u.Repo(), user, request.Code)
+	if err != nil {
+		err = telemetry.Error(ctx, span, err, "error verifying mfa code")
+		u.HandleAPIError(w, r, apierrors.NewErrInternal(err))
+		return
+	}
+
+	if !ok {
+		err := telemetry.Error(ctx, span, nil, "invalid mfa code")
+		u.HandleAPIError(w, r, apierrors.NewErrPassThroughToClient(err, http.StatusBadRequest))
+		return
+	}
+
+	mfa, err := u.Repo().UserMFA().ReadUserMFA(ctx, user.ID)
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			err := telemetry.Error(ctx, span, err, "user has not enrolled in multi-factor authentication")
+			u.HandleAPIError(w, r, apierrors.NewErrPassThroughToClient(err, http.StatusBadRequest))
+			return
+		}
+
+		err = telemetry.Error(ctx, span, err, "error reading mfa enrollment")
+		u.HandleAPIError(w, r, apierrors.NewErrInternal(err))
+		return
+	}
+
+	codes, err := generateRecoveryCodes(mfa)
+	if err != nil {
+		err = telemetry.Error(ctx, span, err, "error generating recovery codes")
+		u.HandleAPIError(w, r, apierrors.NewErrInternal(err))
+		return
+	}
+
+	if _, err := u.Repo().UserMFA().UpdateUserMFA(ctx, mfa); err != nil {
+		err = telemetry.Error(ctx, span, err, "error updating mfa enrollment")
+		u.HandleAPIError(w, r, apierrors.NewErrInternal(err))
+		return
+	}
+
+	user.MFAEnabled = true
+	if _, err := u.Repo().User().UpdateUser(ctx, user); err != nil {
+		err = telemetry.Error(ctx, span, err, "error enabling mfa for user")
+		u.HandleAPIError(w, r, apierrors.NewErrInternal(err))
+		return
+	}
+
+	if _, err := authn.SaveUserMFAElevated(w, r, u.Config()); err != nil {
+		err = telemetry.Error(ctx, span, err, "error elevating session")
+		u.HandleAPIError(w, r, apierrors.NewErrInternal(err))
+		return
+	}
+
+	u.WriteResult(w, r, &types.MFARecoveryCodesResponse{RecoveryCodes: codes})
+}
@@ -0,0 +1,65 @@
+package user
+
+import (
+	"errors"
+	"net/http"
+
+	"gorm.io/gorm"
+
+	"github.com/porter-dev/porter/api/server/handlers"
+	"github.com/porter-dev/porter/api/server/shared"
+	"github.com/porter-dev/porter/api/server/shared/apierrors"
+	"github.com/porter-dev/porter/api/server/shared/config"
+	"github.com/porter-dev/porter/api/types"
+	"github.com/porter-dev/porter/internal/models"
+	"github.com/porter-dev/porter/internal/telemetry"
+)
+
+// UserDisableMFAHandler handles DELETE requests to the /api/users/current/mfa endpoint
+type UserDisableMFAHandler struct {
+	handlers.PorterHandlerWriter
+}
+
+// NewUserDisableMFAHandler returns a new UserDisableMFAHandler
+func NewUserDisableMFAHandler(
+	config *config.Config,
+	writer shared.ResultWriter,
+) *UserDisableMFAHandler {
+	return &UserDisableMFAHandler{
+		PorterHandlerWriter: handlers.NewDefaultPorterHandler(config, nil, writer),
+	}
+}
+
+// ServeHTTP disables multi-factor authentication for the user and deletes their TOTP secret and recovery codes
+func (u *UserDisableMFAHandler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	ctx, span := telemetry.NewSpan(r.Context(), "serve-disable-mfa")
+	defer span.End()
+
+	user, _ := ctx.Value(types.UserScope).(*models.User)
+	telemetry.WithAttributes(span, telemetry.AttributeKV{Key: "user-id", Value: user.ID})
+
+	mfa, err := u.Repo().UserMFA().ReadUserMFA(ctx, user.ID)
+	if err != nil && !errors.Is(err, gorm.ErrRecordNotFound) {
+		err = telemetry.Error(ctx, span, err, "error reading mfa enrollment")
+		u.HandleAPIError(w, r, apierrors.NewErrInternal(err))
+		return
+	}
+
+	if mfa != nil {
+		if err := u.Repo().UserMFA().DeleteUserMFA(ctx, mfa); err != nil {
+			err = telemetry.Error(ctx, span, err, "error deleting mfa enrollment")
+			u.HandleAPIError(w, r, apierrors.NewErrInternal(err))
+			return
+		}
+	}
+
+	user.MFAEnabled = false
+	user, err = u.Repo().User().UpdateUser(ctx, user)
+	if err != nil {
+		err = telemetry.Error(ctx, span, err, "error disabling mfa for user")
+		u.HandleAPIError(w, r, apierrors.NewErrInternal(err))
+		return
+	}
+
+	u.WriteResult(w, r, user.ToUserType())
+}
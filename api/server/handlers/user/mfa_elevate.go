@@ -0,0 +1,72 @@
+package user
+
+import (
+	"net/http"
+
+	"github.com/porter-dev/porter/api/server/authn"
+	"github.com/porter-dev/porter/api/server/handlers"
+	"github.com/porter-dev/porter/api/server/shared"
+	"github.com/porter-dev/porter/api/server/shared/apierrors"
+	"github.com/porter-dev/porter/api/server/shared/config"
+	"github.com/porter-dev/porter/api/types"
+	"github.com/porter-dev/porter/internal/models"
+	"github.com/porter-dev/porter/internal/telemetry"
+)
+
+// UserElevateMFAHandler handles POST requests to the /api/users/current/mfa/elevate endpoint
+type UserElevateMFAHandler struct {
+	handlers.PorterHandlerReadWriter
+}
+
+// NewUserElevateMFAHandler returns a new UserElevateMFAHandler
+func NewUserElevateMFAHandler(
+	config *config.Config,
+	decoderValidator shared.RequestDecoderValidator,
+	writer shared.ResultWriter,
+) *UserElevateMFAHandler {
+	return &UserElevateMFAHandler{
+		PorterHandlerReadWriter: handlers.NewDefaultPorterHandler(config, decoderValidator, writer),
+	}
+}
+
+// ServeHTTP elevates the session of the user for sensitive operations, such as deleting a project, once they verify a code
+func (u *UserElevateMFAHandler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	ctx, span := telemetry.NewSpan(r.Context(), "serve-elevate-mfa")
+	defer span.End()
+
+	user, _ := ctx.Value(types.UserScope).(*models.User)
+	telemetry.WithAttributes(span, telemetry.AttributeKV{Key: "user-id", Value: user.ID})
+
+	request := &types.VerifyMFACodeRequest{}
+	if ok := u.DecodeAndValidate(w, r, request); !ok {
+		return
+	}
+
+	if !user.MFAEnabled {
+		err := telemetry.Error(ctx, span, nil, "multi-factor authentication is not enabled")
+		u.HandleAPIError(w, r, apierrors.NewErrPassThroughToClient(err, http.StatusBadRequest))
+		return
+	}
+
+	ok, err := authn.VerifyMFACode(ctx, u.Repo(), user, request.Code)
+	if err != nil {
+		err = telemetry.Error(ctx, span, err, "error verifying mfa code")
+		u.HandleAPIError(w, r, apierrors.NewErrInternal(err))
+		return
+	}
+
+	if !ok {
+		err := telemetry.Error(ctx, span, nil, "invalid mfa code")
+		u.HandleAPIError(w, r, apierrors.NewErrPassThroughToClient(err, http.StatusForbidden))
+		return
+	}
+
+	elevatedUntil, err := authn.SaveUserMFAElevated(w, r, u.Config())
+	if err != nil {
+		err = telemetry.Error(ctx, span, err, "error elevating session")
+		u.HandleAPIError(w, r, apierrors.NewErrInternal(err))
+		return
+	}
+
+	u.WriteResult(w, r, &types.ElevateMFAResponse{ElevatedUntil: elevatedUntil})
+}
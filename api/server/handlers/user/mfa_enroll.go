@@ -0,0 +1,112 @@
+package user
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+	"strings"
+
+	"gorm.io/gorm"
+
+	"github.com/porter-dev/porter/api/server/handlers"
+	"github.com/porter-dev/porter/api/server/shared"
+	"github.com/porter-dev/porter/api/server/shared/apierrors"
+	"github.com/porter-dev/porter/api/server/shared/config"
+	"github.com/porter-dev/porter/api/types"
+	"github.com/porter-dev/porter/internal/auth/totp"
+	"github.com/porter-dev/porter/internal/models"
+	"github.com/porter-dev/porter/internal/telemetry"
+)
+
+const (
+	// mfaIssuer is the name that authenticator apps show for Porter codes
+	mfaIssuer = "Porter"
+
+	// mfaRecoveryCodeCount is the number of recovery codes generated for a user
+	mfaRecoveryCodeCount = 10
+)
+
+// UserEnrollMFAHandler handles POST requests to the /api/users/current/mfa/enroll endpoint
+type UserEnrollMFAHandler struct {
+	handlers.PorterHandlerWriter
+}
+
+// NewUserEnrollMFAHandler returns a new UserEnrollMFAHandler
+func NewUserEnrollMFAHandler(
+	config *config.Config,
+	writer shared.ResultWriter,
+) *UserEnrollMFAHandler {
+	return &UserEnrollMFAHandler{
+		PorterHandlerWriter: handlers.NewDefaultPorterHandler(config, nil, writer),
+	}
+}
+
+// ServeHTTP generates a new TOTP secret for the user. Multi-factor authentication is only enabled once the user activates
+// the enrollment with a code from their authenticator app, so enrolling again replaces a secret which was never activated.
+func (u *UserEnrollMFAHandler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	ctx, span := telemetry.NewSpan(r.Context(), "serve-enroll-mfa")
+	defer span.End()
+
+	user, _ := ctx.Value(types.UserScope).(*models.User)
+	telemetry.WithAttributes(span, telemetry.AttributeKV{Key: "user-id", Value: user.ID})
+
+	if user.Password == "" {
+		err := telemetry.Error(ctx, span, nil, "multi-factor authentication is only available for password accounts")
+		u.HandleAPIError(w, r, apierrors.NewErrPassThroughToClient(err, http.StatusBadRequest))
+		return
+	}
+
+	if user.MFAEnabled {
+		err := telemetry.Error(ctx, span, nil, "multi-factor authentication is already enabled")
+		u.HandleAPIError(w, r, apierrors.NewErrPassThroughToClient(err, http.StatusBadRequest))
+		return
+	}
+
+	secret, err := totp.GenerateSecret()
+	if err != nil {
+		err = telemetry.Error(ctx, span, err, "error generating totp secret")
+		u.HandleAPIError(w, r, apierrors.NewErrInternal(err))
+		return
+	}
+
+	mfa, err := u.Repo().UserMFA().ReadUserMFA(ctx, user.ID)
+	switch {
+	case errors.Is(err, gorm.ErrRecordNotFound):
+		_, err = u.Repo().UserMFA().CreateUserMFA(ctx, &models.UserMFA{
+			UserID:     user.ID,
+			TOTPSecret: []byte(secret),
+		})
+	case err == nil:
+		mfa.TOTPSecret = []byte(secret)
+		mfa.LastUsedStep = 0
+		mfa.RecoveryCodeHashes = ""
+		_, err = u.Repo().UserMFA().UpdateUserMFA(ctx, mfa)
+	}
+	if err != nil {
+		err = telemetry.Error(ctx, span, err, "error saving mfa enrollment")
+		u.HandleAPIError(w, r, apierrors.NewErrInternal(err))
+		return
+	}
+
+	u.WriteResult(w, r, &types.EnrollMFAResponse{
+		Secret: secret,
+		KeyURI: totp.KeyURI(mfaIssuer, user.Email, secret),
+	})
+}
+
+// generateRecoveryCodes replaces the recovery codes of the enrollment, and returns the new codes
+func generateRecoveryCodes(mfa *models.UserMFA) ([]string, error) {
+	codes, err := totp.GenerateRecoveryCodes(mfaRecoveryCodeCount)
+	if err != nil {
+		return nil, fmt.Errorf("error generating recovery codes: %w", err)
+	}
+
+	hashes := make([]string, 0, len(codes))
+	for _, code := range codes {
+		hashes = append(hashes, totp.HashRecoveryCode(code))
+	}
+
+	mfa.RecoveryCodeHashes = strings.Join(hashes, ",")
+
+	return codes, nil
+}
@@ -0,0 +1,59 @@
+package user
+
+import (
+	"errors"
+	"net/http"
+
+	"gorm.io/gorm"
+
+	"github.com/porter-dev/porter/api/server/handlers"
+	"github.com/porter-dev/porter/api/server/shared"
+	"github.com/porter-dev/porter/api/server/shared/apierrors"
+	"github.com/porter-dev/porter/api/server/shared/config"
+	"github.com/porter-dev/porter/api/types"
+	"github.com/porter-dev/porter/internal/models"
+	"github.com/porter-dev/porter/internal/telemetry"
+)
+
+// UserGetMFAHandler handles GET requests to the /api/users/current/mfa endpoint
+type UserGetMFAHandler struct {
+	handlers.PorterHandlerWriter
+}
+
+// NewUserGetMFAHandler returns a new UserGetMFAHandler
+func NewUserGetMFAHandler(
+	config *config.Config,
+	writer shared.ResultWriter,
+) *UserGetMFAHandler {
+	return &UserGetMFAHandler{
+		PorterHandlerWriter: handlers.NewDefaultPorterHandler(config, nil, writer),
+	}
+}
+
+// ServeHTTP returns whether the user enabled multi-factor authentication, and how many recovery codes they have left
+func (u *UserGetMFAHandler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	ctx, span := telemetry.NewSpan(r.Context(), "serve-get-mfa")
+	defer span.End()
+
+	user, _ := ctx.Value(types.UserScope).(*models.User)
+	telemetry.WithAttributes(span, telemetry.AttributeKV{Key: "user-id", Value: user.ID})
+
+	res := &types.GetMFAResponse{
+		Enabled: user.MFAEnabled,
+	}
+
+	if user.MFAEnabled {
+		mfa, err := u.Repo().UserMFA().ReadUserMFA(ctx, user.ID)
+		if err != nil && !errors.Is(err, gorm.ErrRecordNotFound) {
+			err = telemetry.Error(ctx, span, err, "error reading mfa enrollment")
+			u.HandleAPIError(w, r, apierrors.NewErrInternal(err))
+			return
+		}
+
+		if mfa != nil {
+			res.RemainingRecoveryCodes = mfa.RemainingRecoveryCodes()
+		}
+	}
+
+	u.WriteResult(w, r, res)
+}
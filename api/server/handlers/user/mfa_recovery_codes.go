@@ -0,0 +1,65 @@
+package user
+
+import (
+	"net/http"
+
+	"github.com/porter-dev/porter/api/server/handlers"
+	"github.com/porter-dev/porter/api/server/shared"
+	"github.com/porter-dev/porter/api/server/shared/apierrors"
+	"github.com/porter-dev/porter/api/server/shared/config"
+	"github.com/porter-dev/porter/api/types"
+	"github.com/porter-dev/porter/internal/models"
+	"github.com/porter-dev/porter/internal/telemetry"
+)
+
+// UserRegenerateMFARecoveryCodesHandler handles POST requests to the /api/users/current/mfa/recovery-codes endpoint
+type UserRegenerateMFARecoveryCodesHandler struct {
+	handlers.PorterHandlerWriter
+}
+
+// NewUserRegenerateMFARecoveryCodesHandler returns a new UserRegenerateMFARecoveryCodesHandler
+func NewUserRegenerateMFARecoveryCodesHandler(
+	config *config.Config,
+	writer shared.ResultWriter,
+) *UserRegenerateMFARecoveryCodesHandler {
+	return &UserRegenerateMFARecoveryCodesHandler{
+		PorterHandlerWriter: handlers.NewDefaultPorterHandler(config, nil, writer),
+	}
+}
+
+// ServeHTTP replaces the recovery codes of the user, invalidating any unused codes
+func (u *UserRegenerateMFARecoveryCodesHandler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	ctx, span := telemetry.NewSpan(r.Context(), "serve-regenerate-mfa-recovery-codes")
+	defer span.End()
+
+	user, _ := ctx.Value(types.UserScope).(*models.User)
+	telemetry.WithAttributes(span, telemetry.AttributeKV{Key: "user-id", Value: user.ID})
+
+	if !user.MFAEnabled {
+		err := telemetry.Error(ctx, span, nil, "multi-factor authentication is not enabled")
+		u.HandleAPIError(w, r, apierrors.NewErrPassThroughToClient(err, http.StatusBadRequest))
+		return
+	}
+
+	mfa, err := u.Repo().UserMFA().ReadUserMFA(ctx, user.ID)
+	if err != nil {
+		err = telemetry.Error(ctx, span, err, "error reading mfa enrollment")
+		u.HandleAPIError(w, r, apierrors.NewErrInternal(err))
+		return
+	}
+
+	codes, err := generateRecoveryCodes(mfa)
+	if err != nil {
+		err = telemetry.Error(ctx, span, err, "error generating recovery codes")
+		u.HandleAPIError(w, r, apierrors.NewErrInternal(err))
+		return
+	}
+
+	if _, err := u.Repo().UserMFA().UpdateUserMFA(ctx, mfa); err != nil {
+		err = telemetry.Error(ctx, span, err, "error updating mfa enrollment")
+		u.HandleAPIError(w, r, apierrors.NewErrInternal(err))
+		return
+	}
+
+	u.WriteResult(w, r, &types.MFARecoveryCodesResponse{RecoveryCodes: codes})
+}
@@ -1,6 +1,7 @@
 package user
 
 import (
+	"context"
 	"fmt"
 	"net/http"
 	"net/url"
@@ -43,7 +44,7 @@ func (c *UserPasswordInitiateResetHandler) ServeHTTP(w http.ResponseWriter, r *h
 	}
 
 	// check that the email exists; return 200 status code even if it doesn't
-	user, err := c.Repo().User().ReadUserByEmail(request.Email)
+	user, err := c.Repo().User().ReadUserByEmail(r.Context(), request.Email)
 
 	if err == gorm.ErrRecordNotFound {
 		w.WriteHeader(http.StatusOK)
@@ -76,6 +77,7 @@ func (c *UserPasswordInitiateResetHandler) ServeHTTP(w http.ResponseWriter, r *h
 	}
 
 	pwReset, rawToken, err := CreatePWResetTokenForEmail(
+		r.Context(),
 		c.Repo().PWResetToken(),
 		c.HandleAPIError,
 		w,
@@ -132,6 +134,7 @@ func (c *UserPasswordVerifyResetHandler) ServeHTTP(w http.ResponseWriter, r *htt
 	}
 
 	VerifyToken(
+		r.Context(),
 		c.Repo().PWResetToken(),
 		c.HandleAPIError,
 		w,
@@ -165,6 +168,7 @@ func (c *UserPasswordFinalizeResetHandler) ServeHTTP(w http.ResponseWriter, r *h
 	}
 
 	token, err := VerifyToken(
+		r.Context(),
 		c.Repo().PWResetToken(),
 		c.HandleAPIError,
 		w,
@@ -177,7 +181,7 @@ func (c *UserPasswordFinalizeResetHandler) ServeHTTP(w http.ResponseWriter, r *h
 	}
 
 	// check that the email exists
-	user, err := c.Repo().User().ReadUserByEmail(request.Email)
+	user, err := c.Repo().User().ReadUserByEmail(r.Context(), request.Email)
 	if err != nil {
 		if err == gorm.ErrRecordNotFound {
 			err = fmt.Errorf("finalize password reset failed: email does not exist")
@@ -197,7 +201,7 @@ func (c *UserPasswordFinalizeResetHandler) ServeHTTP(w http.ResponseWriter, r *h
 
 	user.Password = string(hashedPW)
 
-	user, err = c.Repo().User().UpdateUser(user)
+	user, err = c.Repo().User().UpdateUser(r.Context(), user)
 
 	if err != nil {
 		c.HandleAPIError(w, r, apierrors.NewErrInternal(err))
@@ -207,7 +211,7 @@ func (c *UserPasswordFinalizeResetHandler) ServeHTTP(w http.ResponseWriter, r *h
 	// invalidate the token
 	token.IsValid = false
 
-	_, err = c.Repo().PWResetToken().UpdatePWResetToken(token)
+	_, err = c.Repo().PWResetToken().UpdatePWResetToken(r.Context(), token)
 
 	if err != nil {
 		c.HandleAPIError(w, r, apierrors.NewErrInternal(err))
@@ -219,6 +223,7 @@ func (c *UserPasswordFinalizeResetHandler) ServeHTTP(w http.ResponseWriter, r *h
 }
 
 func VerifyToken(
+	ctx context.Context,
 	pwResetRepo repository.PWResetTokenRepository,
 	handleErr func(w http.ResponseWriter, r *http.Request, err apierrors.RequestError),
 	w http.ResponseWriter,
@@ -226,7 +231,7 @@ func VerifyToken(
 	request *types.VerifyTokenFinalizeRequest,
 	email string,
 ) (*models.PWResetToken, error) {
-	token, err := pwResetRepo.ReadPWResetToken(request.TokenID)
+	token, err := pwResetRepo.ReadPWResetToken(ctx, request.TokenID)
 	if err != nil {
 		if err == gorm.ErrRecordNotFound {
 			err = fmt.Errorf("verify token failed: token does not exist")
@@ -267,6 +272,7 @@ func VerifyToken(
 }
 
 func CreatePWResetTokenForEmail(
+	ctx context.Context,
 	pwResetRepo repository.PWResetTokenRepository,
 	handleErr func(w http.ResponseWriter, r *http.Request, err apierrors.RequestError),
 	w http.ResponseWriter,
@@ -296,7 +302,7 @@ func CreatePWResetTokenForEmail(
 	}
 
 	// handle write to the database
-	pwReset, err = pwResetRepo.CreatePWResetToken(pwReset)
+	pwReset, err = pwResetRepo.CreatePWResetToken(ctx, pwReset)
 
 	if err != nil {
 		handleErr(w, r, apierrors.NewErrInternal(err))
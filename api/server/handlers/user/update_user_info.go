@@ -48,7 +48,7 @@ func (v *UpdateUserInfoHandler) ServeHTTP(w http.ResponseWriter, r *http.Request
 		CompanyName:         user.CompanyName,
 	}))
 
-	user, err := v.Repo().User().UpdateUser(user)
+	user, err := v.Repo().User().UpdateUser(r.Context(), user)
 	if err != nil {
 		v.HandleAPIError(w, r, apierrors.NewErrInternal(err))
 		return
@@ -108,7 +108,7 @@ func (c *CreateEnvGroupHandler) ServeHTTP(w http.ResponseWriter, r *http.Request
 		Variables: envGroup.Variables,
 	}
 
-	stackId, err := stacks.GetStackForEnvGroup(c.Config(), cluster.ProjectID, cluster.ID, envGroup)
+	stackId, err := stacks.GetStackForEnvGroup(r.Context(), c.Config(), cluster.ProjectID, cluster.ID, envGroup)
 
 	if err == nil && len(stackId) > 0 {
 		res.StackID = stackId
@@ -117,7 +117,7 @@ func (c *CreateEnvGroupHandler) ServeHTTP(w http.ResponseWriter, r *http.Request
 	c.WriteResult(w, r, res)
 
 	// trigger rollout of new applications after writing the result
-	errors := rolloutApplications(c.Config(), cluster, helmAgent, envGroup, configMap, releases)
+	errors := rolloutApplications(r.Context(), c.Config(), cluster, helmAgent, envGroup, configMap, releases)
 
 	if len(errors) > 0 {
 		errStrArr := make([]string, 0)
@@ -130,7 +130,7 @@ func (c *CreateEnvGroupHandler) ServeHTTP(w http.ResponseWriter, r *http.Request
 		return
 	}
 
-	err = postUpgrade(c.Config(), cluster.ProjectID, cluster.ID, envGroup)
+	err = postUpgrade(r.Context(), c.Config(), cluster.ProjectID, cluster.ID, envGroup)
 
 	if err != nil {
 		c.HandleAPIErrorNoWrite(w, r, apierrors.NewErrInternal(err))
@@ -139,6 +139,7 @@ func (c *CreateEnvGroupHandler) ServeHTTP(w http.ResponseWriter, r *http.Request
 }
 
 func rolloutApplications(
+	ctx context.Context,
 	config *config.Config,
 	cluster *models.Cluster,
 	helmAgent *helm.Agent,
@@ -146,7 +147,7 @@ func rolloutApplications(
 	configMap *v1.ConfigMap,
 	releases []*release.Release,
 ) []error {
-	registries, err := config.Repo.Registry().ListRegistriesByProjectID(cluster.ProjectID)
+	registries, err := config.Repo.Registry().ListRegistriesByProjectID(ctx, cluster.ProjectID)
 	if err != nil {
 		return []error{err}
 	}
@@ -386,7 +387,7 @@ func getNestedMap(obj map[string]interface{}, fields ...string) (map[string]inte
 }
 
 // postUpgrade runs any necessary scripting after the release has been upgraded.
-func postUpgrade(config *config.Config, projectID, clusterID uint, envGroup *types.EnvGroup) error {
+func postUpgrade(ctx context.Context, config *config.Config, projectID, clusterID uint, envGroup *types.EnvGroup) error {
 	// update the relevant env group version number if tied to a stack resource
-	return stacks.UpdateEnvGroupVersion(config, projectID, clusterID, envGroup)
+	return stacks.UpdateEnvGroupVersion(ctx, config, projectID, clusterID, envGroup)
 }
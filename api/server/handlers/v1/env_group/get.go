@@ -76,7 +76,7 @@ func (c *GetEnvGroupHandler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
 		Variables: envGroup.Variables,
 	}
 
-	stackId, err := stacks.GetStackForEnvGroup(c.Config(), cluster.ProjectID, cluster.ID, envGroup)
+	stackId, err := stacks.GetStackForEnvGroup(r.Context(), c.Config(), cluster.ProjectID, cluster.ID, envGroup)
 
 	if err == nil && len(stackId) > 0 {
 		res.StackID = stackId
@@ -80,7 +80,7 @@ func (c *GetEnvGroupAllVersionsHandler) ServeHTTP(w http.ResponseWriter, r *http
 			Variables: eg.Variables,
 		}
 
-		stackId, err := stacks.GetStackForEnvGroup(c.Config(), cluster.ProjectID, cluster.ID, eg)
+		stackId, err := stacks.GetStackForEnvGroup(r.Context(), c.Config(), cluster.ProjectID, cluster.ID, eg)
 
 		if err == nil && len(stackId) > 0 {
 			elem.StackID = stackId
@@ -59,7 +59,7 @@ func (c *ListEnvGroupsHandler) ServeHTTP(w http.ResponseWriter, r *http.Request)
 			Name:      eg.Name,
 		}
 
-		stackId, err := stacks.GetStackForEnvGroup(c.Config(), cluster.ProjectID, cluster.ID, eg)
+		stackId, err := stacks.GetStackForEnvGroup(r.Context(), c.Config(), cluster.ProjectID, cluster.ID, eg)
 
 		if err == nil && len(stackId) > 0 {
 			elem.StackID = stackId
@@ -86,7 +86,7 @@ func (c *ListEnvGroupsHandler) ServeHTTP(w http.ResponseWriter, r *http.Request)
 			Name:      eg.Name,
 		}
 
-		stackId, err := stacks.GetStackForEnvGroup(c.Config(), cluster.ProjectID, cluster.ID, eg)
+		stackId, err := stacks.GetStackForEnvGroup(r.Context(), c.Config(), cluster.ProjectID, cluster.ID, eg)
 
 		if err == nil && len(stackId) > 0 {
 			elem.StackID = stackId
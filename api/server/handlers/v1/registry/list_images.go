@@ -68,7 +68,7 @@ func (c *RegistryListImagesHandler) ServeHTTP(w http.ResponseWriter, r *http.Req
 			nextToken = &request.Next
 		}
 
-		imgs, nextToken, err := regAPI.GetECRPaginatedImages(repoName, c.Repo(), request.Num, nextToken)
+		imgs, nextToken, err := regAPI.GetECRPaginatedImages(ctx, repoName, c.Repo(), request.Num, nextToken)
 		if err != nil {
 			c.HandleAPIError(w, r, apierrors.NewErrInternal(err))
 			return
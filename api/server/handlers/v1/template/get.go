@@ -47,7 +47,7 @@ func (t *TemplateGetHandler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
 		request.RepoURL = t.Config().ServerConf.DefaultApplicationHelmRepoURL
 	}
 
-	hrs, err := t.Repo().HelmRepo().ListHelmReposByProjectID(project.ID)
+	hrs, err := t.Repo().HelmRepo().ListHelmReposByProjectID(r.Context(), project.ID)
 	if err != nil {
 		t.HandleAPIError(w, r, apierrors.NewErrInternal(err))
 		return
@@ -43,7 +43,7 @@ func (t *TemplateGetUpgradeNotesHandler) ServeHTTP(w http.ResponseWriter, r *htt
 		return
 	}
 
-	hrs, err := t.Repo().HelmRepo().ListHelmReposByProjectID(project.ID)
+	hrs, err := t.Repo().HelmRepo().ListHelmReposByProjectID(r.Context(), project.ID)
 	if err != nil {
 		t.HandleAPIError(w, r, apierrors.NewErrInternal(err))
 		return
@@ -45,7 +45,7 @@ func (t *TemplateListHandler) ServeHTTP(w http.ResponseWriter, r *http.Request)
 		repoURL = t.Config().ServerConf.DefaultApplicationHelmRepoURL
 	}
 
-	hrs, err := t.Repo().HelmRepo().ListHelmReposByProjectID(project.ID)
+	hrs, err := t.Repo().HelmRepo().ListHelmReposByProjectID(r.Context(), project.ID)
 	if err != nil {
 		t.HandleAPIError(w, r, apierrors.NewErrInternal(err))
 		return
@@ -80,7 +80,7 @@ func (c *GithubIncomingWebhookHandler) processPullRequestEvent(event *github.Pul
 	owner := event.GetRepo().GetOwner().GetLogin()
 	repo := event.GetRepo().GetName()
 
-	env, err := c.Repo().Environment().ReadEnvironmentByWebhookIDOwnerRepoName(webhookID, owner, repo)
+	env, err := c.Repo().Environment().ReadEnvironmentByWebhookIDOwnerRepoName(r.Context(), webhookID, owner, repo)
 	if err != nil {
 		if errors.Is(err, gorm.ErrRecordNotFound) {
 			return nil
@@ -147,7 +147,7 @@ func (c *GithubIncomingWebhookHandler) processPullRequestEvent(event *github.Pul
 			PRBranchInto:  event.GetPullRequest().GetBase().GetRef(),
 		}
 
-		_, err = c.Repo().Environment().CreateDeployment(depl)
+		_, err = c.Repo().Environment().CreateDeployment(r.Context(), depl)
 
 		if err != nil {
 			return fmt.Errorf("[webhookID: %s, owner: %s, repo: %s, environmentID: %d, prNumber: %d] "+
@@ -172,6 +172,7 @@ func (c *GithubIncomingWebhookHandler) processPullRequestEvent(event *github.Pul
 		}
 	} else if event.GetAction() == "synchronize" || event.GetAction() == "closed" || event.GetAction() == "edited" {
 		depl, err := c.Repo().Environment().ReadDeploymentByGitDetails(
+			r.Context(),
 			env.ID, owner, repo, uint(event.GetPullRequest().GetNumber()),
 		)
 		if err != nil {
@@ -269,7 +270,7 @@ func (c *GithubIncomingWebhookHandler) processPullRequestEvent(event *github.Pul
 			}
 
 			if shouldUpdate {
-				_, err := c.Repo().Environment().UpdateDeployment(depl)
+				_, err := c.Repo().Environment().UpdateDeployment(r.Context(), depl)
 				if err != nil {
 					return fmt.Errorf("[webhookID: %s, owner: %s, repo: %s, environmentID: %d, deploymentID: %d, prNumber: %d] "+
 						"error updating deployment to reflect changes in the pull request %w", webhookID, owner, repo, env.ID, depl.ID,
@@ -288,7 +289,7 @@ func (c *GithubIncomingWebhookHandler) deleteDeployment(
 	env *models.Environment,
 	client *github.Client,
 ) error {
-	cluster, err := c.Repo().Cluster().ReadCluster(env.ProjectID, env.ClusterID)
+	cluster, err := c.Repo().Cluster().ReadCluster(r.Context(), env.ProjectID, env.ClusterID)
 	if err != nil {
 		return fmt.Errorf("[projectID: %d, clusterID: %d] error reading cluster when deleting existing deployment: %w",
 			env.ProjectID, env.ClusterID, err)
@@ -324,7 +325,7 @@ func (c *GithubIncomingWebhookHandler) deleteDeployment(
 		&deploymentStatusRequest,
 	)
 
-	_, err = c.Repo().Environment().DeleteDeployment(depl)
+	_, err = c.Repo().Environment().DeleteDeployment(r.Context(), depl)
 
 	if err != nil {
 		return fmt.Errorf("[owner: %s, repo: %s, environmentID: %d, deploymentID: %d] error updating deployment: %w",
@@ -349,7 +350,7 @@ func (c *GithubIncomingWebhookHandler) processPushEvent(event *github.PushEvent,
 	owner := event.GetRepo().GetOwner().GetLogin()
 	repo := event.GetRepo().GetName()
 
-	env, err := c.Repo().Environment().ReadEnvironmentByWebhookIDOwnerRepoName(webhookID, owner, repo)
+	env, err := c.Repo().Environment().ReadEnvironmentByWebhookIDOwnerRepoName(r.Context(), webhookID, owner, repo)
 	if err != nil {
 		if errors.Is(err, gorm.ErrRecordNotFound) {
 			return nil
@@ -386,10 +387,10 @@ func (c *GithubIncomingWebhookHandler) processPushEvent(event *github.PushEvent,
 
 	var deplID uint
 
-	depl, err := c.Repo().Environment().ReadDeploymentForBranch(env.ID, owner, repo, branch)
+	depl, err := c.Repo().Environment().ReadDeploymentForBranch(r.Context(), env.ID, owner, repo, branch)
 
 	if err != nil && errors.Is(err, gorm.ErrRecordNotFound) {
-		depl, err := c.Repo().Environment().CreateDeployment(&models.Deployment{
+		depl, err := c.Repo().Environment().CreateDeployment(r.Context(), &models.Deployment{
 			EnvironmentID: env.ID,
 			Status:        types.DeploymentStatusCreating,
 			PRName:        fmt.Sprintf("Deployment for branch %s", branch),
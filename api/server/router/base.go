@@ -5,9 +5,11 @@ import (
 
 	"github.com/go-chi/chi/v5"
 	"github.com/porter-dev/porter/api/server/handlers/credentials"
+	"github.com/porter-dev/porter/api/server/handlers/external_secrets"
 	"github.com/porter-dev/porter/api/server/handlers/gitinstallation"
 	"github.com/porter-dev/porter/api/server/handlers/healthcheck"
 	"github.com/porter-dev/porter/api/server/handlers/metadata"
+	"github.com/porter-dev/porter/api/server/handlers/porter_app"
 	"github.com/porter-dev/porter/api/server/handlers/release"
 	"github.com/porter-dev/porter/api/server/handlers/user"
 	"github.com/porter-dev/porter/api/server/handlers/webhook"
@@ -104,6 +106,30 @@ func GetBaseRoutes(
 		Router:   r,
 	})
 
+	// GET /api/porter-yaml/v2/schema.json -> porter_app.NewPorterYAMLSchemaHandler
+	getPorterYAMLSchemaEndpoint := factory.NewAPIEndpoint(
+		&types.APIRequestMetadata{
+			Verb:   types.APIVerbGet,
+			Method: types.HTTPVerbGet,
+			Path: &types.Path{
+				Parent:       basePath,
+				RelativePath: "/porter-yaml/v2/schema.json",
+			},
+			Quiet: true,
+		},
+	)
+
+	getPorterYAMLSchemaHandler := porter_app.NewPorterYAMLSchemaHandler(
+		config,
+		factory.GetResultWriter(),
+	)
+
+	routes = append(routes, &router.Route{
+		Endpoint: getPorterYAMLSchemaEndpoint,
+		Handler:  getPorterYAMLSchemaHandler,
+		Router:   r,
+	})
+
 	// GET /api/integrations/cluster -> metadata.NewListClusterIntegrationsHandler
 	listClusterIntsEndpoint := factory.NewAPIEndpoint(
 		&types.APIRequestMetadata{
@@ -182,6 +208,7 @@ func GetBaseRoutes(
 				Parent:       basePath,
 				RelativePath: "/users",
 			},
+			RateLimit: signupRateLimit,
 		},
 	)
 
@@ -206,6 +233,7 @@ func GetBaseRoutes(
 				Parent:       basePath,
 				RelativePath: "/login",
 			},
+			RateLimit: loginRateLimit,
 		},
 	)
 
@@ -254,6 +282,7 @@ func GetBaseRoutes(
 				Parent:       basePath,
 				RelativePath: "/password/reset/initiate",
 			},
+			RateLimit: passwordResetRateLimit,
 		},
 	)
 
@@ -515,6 +544,31 @@ func GetBaseRoutes(
 		Router:   r,
 	})
 
+	// POST /api/integrations/external-secrets/webhooks/{external_secrets_webhook_id} -> external_secrets.NewExternalSecretsWebhookHandler
+	externalSecretsWebhookEndpoint := factory.NewAPIEndpoint(
+		&types.APIRequestMetadata{
+			Verb:   types.APIVerbUpdate,
+			Method: types.HTTPVerbPost,
+			Path: &types.Path{
+				Parent:       basePath,
+				RelativePath: fmt.Sprintf("/integrations/external-secrets/webhooks/{%s}", types.URLParamExternalSecretsWebhookID),
+			},
+			Scopes: []types.PermissionScope{},
+		},
+	)
+
+	externalSecretsWebhookHandler := external_secrets.NewExternalSecretsWebhookHandler(
+		config,
+		factory.GetDecoderValidator(),
+		factory.GetResultWriter(),
+	)
+
+	routes = append(routes, &router.Route{
+		Endpoint: externalSecretsWebhookEndpoint,
+		Handler:  externalSecretsWebhookHandler,
+		Router:   r,
+	})
+
 	if config.ServerConf.GithubIncomingWebhookSecret != "" {
 		// POST /api/github/incoming_webhook/{webhook_id} -> webhook.NewGithubIncomingWebhook
 		githubIncomingWebhookEndpoint := factory.NewAPIEndpoint(
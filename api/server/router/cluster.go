@@ -8,6 +8,7 @@ import (
 	"github.com/porter-dev/porter/api/server/handlers/database"
 	"github.com/porter-dev/porter/api/server/handlers/environment"
 	"github.com/porter-dev/porter/api/server/handlers/environment_groups"
+	"github.com/porter-dev/porter/api/server/handlers/external_secrets"
 	"github.com/porter-dev/porter/api/server/shared"
 	"github.com/porter-dev/porter/api/server/shared/config"
 	"github.com/porter-dev/porter/api/server/shared/router"
@@ -101,6 +102,7 @@ func getClusterRoutes(
 			},
 			CheckUsage:  true,
 			UsageMetric: types.Clusters,
+			RateLimit:   kubeconfigUploadRateLimit,
 		},
 	)
 
@@ -116,6 +118,36 @@ func getClusterRoutes(
 		Router:   r,
 	})
 
+	// POST /api/projects/{project_id}/clusters/candidates/upload -> cluster.NewUploadClusterCandidatesHandler
+	uploadCandidatesEndpoint := factory.NewAPIEndpoint(
+		&types.APIRequestMetadata{
+			Verb:   types.APIVerbCreate,
+			Method: types.HTTPVerbPost,
+			Path: &types.Path{
+				Parent:       basePath,
+				RelativePath: "/clusters/candidates/upload",
+			},
+			Scopes: []types.PermissionScope{
+				types.UserScope,
+				types.ProjectScope,
+			},
+			CheckUsage:  true,
+			UsageMetric: types.Clusters,
+			RateLimit:   kubeconfigUploadRateLimit,
+		},
+	)
+
+	uploadCandidatesHandler := cluster.NewUploadClusterCandidatesHandler(
+		config,
+		factory.GetResultWriter(),
+	)
+
+	routes = append(routes, &router.Route{
+		Endpoint: uploadCandidatesEndpoint,
+		Handler:  uploadCandidatesHandler,
+		Router:   r,
+	})
+
 	// GET /api/projects/{project_id}/clusters/candidates -> project.NewListClusterCandidatesHandler
 	listCandidatesEndpoint := factory.NewAPIEndpoint(
 		&types.APIRequestMetadata{
@@ -176,6 +208,36 @@ func getClusterRoutes(
 		Router:   r,
 	})
 
+	// DELETE /api/projects/{project_id}/clusters/candidates/{candidate_id} -> cluster.NewDeleteClusterCandidateHandler
+	deleteCandidateEndpoint := factory.NewAPIEndpoint(
+		&types.APIRequestMetadata{
+			Verb:   types.APIVerbDelete,
+			Method: types.HTTPVerbDelete,
+			Path: &types.Path{
+				Parent: basePath,
+				RelativePath: fmt.Sprintf(
+					"/clusters/candidates/{%s}",
+					types.URLParamCandidateID,
+				),
+			},
+			Scopes: []types.PermissionScope{
+				types.UserScope,
+				types.ProjectScope,
+			},
+		},
+	)
+
+	deleteCandidateHandler := cluster.NewDeleteClusterCandidateHandler(
+		config,
+		factory.GetResultWriter(),
+	)
+
+	routes = append(routes, &router.Route{
+		Endpoint: deleteCandidateEndpoint,
+		Handler:  deleteCandidateHandler,
+		Router:   r,
+	})
+
 	// POST /api/projects/{project_id}/clusters/{cluster_id} -> project.NewClusterUpdateHandler
 	updateClusterEndpoint := factory.NewAPIEndpoint(
 		&types.APIRequestMetadata{
@@ -859,6 +921,120 @@ func getClusterRoutes(
 		Router:   r,
 	})
 
+	// GET /api/projects/{project_id}/clusters/{cluster_id}/node-pools -> cluster.NewListNodePoolsHandler
+	listNodePoolsEndpoint := factory.NewAPIEndpoint(
+		&types.APIRequestMetadata{
+			Verb:   types.APIVerbGet,
+			Method: types.HTTPVerbGet,
+			Path: &types.Path{
+				Parent:       basePath,
+				RelativePath: relPath + "/node-pools",
+			},
+			Scopes: []types.PermissionScope{
+				types.UserScope,
+				types.ProjectScope,
+				types.ClusterScope,
+			},
+		},
+	)
+
+	listNodePoolsHandler := cluster.NewListNodePoolsHandler(
+		config,
+		factory.GetResultWriter(),
+	)
+
+	routes = append(routes, &router.Route{
+		Endpoint: listNodePoolsEndpoint,
+		Handler:  listNodePoolsHandler,
+		Router:   r,
+	})
+
+	// GET /api/projects/{project_id}/clusters/{cluster_id}/helm_releases -> cluster.NewListHelmReleasesHandler
+	listHelmReleasesEndpoint := factory.NewAPIEndpoint(
+		&types.APIRequestMetadata{
+			Verb:   types.APIVerbList,
+			Method: types.HTTPVerbGet,
+			Path: &types.Path{
+				Parent:       basePath,
+				RelativePath: relPath + "/helm_releases",
+			},
+			Scopes: []types.PermissionScope{
+				types.UserScope,
+				types.ProjectScope,
+				types.ClusterScope,
+			},
+		},
+	)
+
+	listHelmReleasesHandler := cluster.NewListHelmReleasesHandler(
+		config,
+		factory.GetDecoderValidator(),
+		factory.GetResultWriter(),
+	)
+
+	routes = append(routes, &router.Route{
+		Endpoint: listHelmReleasesEndpoint,
+		Handler:  listHelmReleasesHandler,
+		Router:   r,
+	})
+
+	// GET /api/projects/{project_id}/clusters/{cluster_id}/helm_releases/{namespace}/{name} -> cluster.NewGetHelmReleaseHandler
+	getHelmReleaseEndpoint := factory.NewAPIEndpoint(
+		&types.APIRequestMetadata{
+			Verb:   types.APIVerbGet,
+			Method: types.HTTPVerbGet,
+			Path: &types.Path{
+				Parent:       basePath,
+				RelativePath: fmt.Sprintf("%s/helm_releases/{%s}/{%s}", relPath, types.URLParamNamespace, types.URLParamReleaseName),
+			},
+			Scopes: []types.PermissionScope{
+				types.UserScope,
+				types.ProjectScope,
+				types.ClusterScope,
+			},
+		},
+	)
+
+	getHelmReleaseHandler := cluster.NewGetHelmReleaseHandler(
+		config,
+		factory.GetDecoderValidator(),
+		factory.GetResultWriter(),
+	)
+
+	routes = append(routes, &router.Route{
+		Endpoint: getHelmReleaseEndpoint,
+		Handler:  getHelmReleaseHandler,
+		Router:   r,
+	})
+
+	// GET /api/projects/{project_id}/clusters/{cluster_id}/helm_releases/{namespace}/{name}/history -> cluster.NewGetHelmReleaseHistoryHandler
+	getHelmReleaseHistoryEndpoint := factory.NewAPIEndpoint(
+		&types.APIRequestMetadata{
+			Verb:   types.APIVerbGet,
+			Method: types.HTTPVerbGet,
+			Path: &types.Path{
+				Parent:       basePath,
+				RelativePath: fmt.Sprintf("%s/helm_releases/{%s}/{%s}/history", relPath, types.URLParamNamespace, types.URLParamReleaseName),
+			},
+			Scopes: []types.PermissionScope{
+				types.UserScope,
+				types.ProjectScope,
+				types.ClusterScope,
+			},
+		},
+	)
+
+	getHelmReleaseHistoryHandler := cluster.NewGetHelmReleaseHistoryHandler(
+		config,
+		factory.GetResultWriter(),
+	)
+
+	routes = append(routes, &router.Route{
+		Endpoint: getHelmReleaseHistoryEndpoint,
+		Handler:  getHelmReleaseHistoryHandler,
+		Router:   r,
+	})
+
 	// GET /api/projects/{project_id}/clusters/{cluster_id}/nodes/{node_name} -> cluster.NewGetNodeHandler
 	getNodeEndpoint := factory.NewAPIEndpoint(
 		&types.APIRequestMetadata{
@@ -1286,6 +1462,68 @@ func getClusterRoutes(
 		Router:   r,
 	})
 
+	// POST /api/projects/{project_id}/clusters/{cluster_id}/kube_events -> cluster.NewCreateKubeEventHandler
+	createKubeEventEndpoint := factory.NewAPIEndpoint(
+		&types.APIRequestMetadata{
+			Verb:   types.APIVerbCreate,
+			Method: types.HTTPVerbPost,
+			Path: &types.Path{
+				Parent:       basePath,
+				RelativePath: relPath + "/kube_events",
+			},
+			Scopes: []types.PermissionScope{
+				types.UserScope,
+				types.ProjectScope,
+				types.ClusterScope,
+			},
+			Request:  &types.CreateKubeEventRequest{},
+			Response: &types.KubeEvent{},
+		},
+	)
+
+	createKubeEventHandler := cluster.NewCreateKubeEventHandler(
+		config,
+		factory.GetDecoderValidator(),
+		factory.GetResultWriter(),
+	)
+
+	routes = append(routes, &router.Route{
+		Endpoint: createKubeEventEndpoint,
+		Handler:  createKubeEventHandler,
+		Router:   r,
+	})
+
+	// GET /api/projects/{project_id}/clusters/{cluster_id}/kube_events/search -> cluster.NewSearchKubeEventsHandler
+	searchKubeEventsEndpoint := factory.NewAPIEndpoint(
+		&types.APIRequestMetadata{
+			Verb:   types.APIVerbGet,
+			Method: types.HTTPVerbGet,
+			Path: &types.Path{
+				Parent:       basePath,
+				RelativePath: relPath + "/kube_events/search",
+			},
+			Scopes: []types.PermissionScope{
+				types.UserScope,
+				types.ProjectScope,
+				types.ClusterScope,
+			},
+			Request:  &types.SearchKubeEventsRequest{},
+			Response: &types.SearchKubeEventsResponse{},
+		},
+	)
+
+	searchKubeEventsHandler := cluster.NewSearchKubeEventsHandler(
+		config,
+		factory.GetDecoderValidator(),
+		factory.GetResultWriter(),
+	)
+
+	routes = append(routes, &router.Route{
+		Endpoint: searchKubeEventsEndpoint,
+		Handler:  searchKubeEventsHandler,
+		Router:   r,
+	})
+
 	// GET /api/projects/{project_id}/clusters/{cluster_id}/incidents -> cluster.NewListIncidentsHandler
 	listIncidentsEndpoint := factory.NewAPIEndpoint(
 		&types.APIRequestMetadata{
@@ -1618,6 +1856,7 @@ func getClusterRoutes(
 				types.UserScope,
 				types.ProjectScope,
 				types.ClusterScope,
+				types.EnvGroupScope,
 			},
 		},
 	)
@@ -1647,6 +1886,7 @@ func getClusterRoutes(
 				types.UserScope,
 				types.ProjectScope,
 				types.ClusterScope,
+				types.EnvGroupScope,
 			},
 		},
 	)
@@ -1676,6 +1916,7 @@ func getClusterRoutes(
 				types.UserScope,
 				types.ProjectScope,
 				types.ClusterScope,
+				types.EnvGroupScope,
 			},
 		},
 	)
@@ -1692,5 +1933,209 @@ func getClusterRoutes(
 		Router:   r,
 	})
 
+	// GET /api/projects/{project_id}/clusters/{cluster_id}/environment-groups/{name}/versions -> environment_groups.NewListEnvironmentGroupVersionsHandler
+	listEnvironmentGroupVersionsEndpoint := factory.NewAPIEndpoint(
+		&types.APIRequestMetadata{
+			Verb:   types.APIVerbList,
+			Method: types.HTTPVerbGet,
+			Path: &types.Path{
+				Parent:       basePath,
+				RelativePath: fmt.Sprintf("%s/environment-groups/{%s}/versions", relPath, types.URLParamEnvGroupName),
+			},
+			Scopes: []types.PermissionScope{
+				types.UserScope,
+				types.ProjectScope,
+				types.ClusterScope,
+				types.EnvGroupScope,
+			},
+		},
+	)
+
+	listEnvironmentGroupVersionsHandler := environment_groups.NewListEnvironmentGroupVersionsHandler(
+		config,
+		factory.GetDecoderValidator(),
+		factory.GetResultWriter(),
+	)
+
+	routes = append(routes, &router.Route{
+		Endpoint: listEnvironmentGroupVersionsEndpoint,
+		Handler:  listEnvironmentGroupVersionsHandler,
+		Router:   r,
+	})
+
+	// GET /api/projects/{project_id}/clusters/{cluster_id}/environment-groups/{name}/linked-apps -> environment_groups.NewListLinkedApplicationsHandler
+	listEnvironmentGroupLinkedAppsEndpoint := factory.NewAPIEndpoint(
+		&types.APIRequestMetadata{
+			Verb:   types.APIVerbList,
+			Method: types.HTTPVerbGet,
+			Path: &types.Path{
+				Parent:       basePath,
+				RelativePath: fmt.Sprintf("%s/environment-groups/{%s}/linked-apps", relPath, types.URLParamEnvGroupName),
+			},
+			Scopes: []types.PermissionScope{
+				types.UserScope,
+				types.ProjectScope,
+				types.ClusterScope,
+				types.EnvGroupScope,
+			},
+		},
+	)
+
+	listEnvironmentGroupLinkedAppsHandler := environment_groups.NewListLinkedApplicationsHandler(
+		config,
+		factory.GetDecoderValidator(),
+		factory.GetResultWriter(),
+	)
+
+	routes = append(routes, &router.Route{
+		Endpoint: listEnvironmentGroupLinkedAppsEndpoint,
+		Handler:  listEnvironmentGroupLinkedAppsHandler,
+		Router:   r,
+	})
+
+	// POST /api/projects/{project_id}/clusters/{cluster_id}/environment-groups/{name}/sync -> environment_groups.NewSyncLinkedApplicationsHandler
+	syncEnvironmentGroupLinkedAppsEndpoint := factory.NewAPIEndpoint(
+		&types.APIRequestMetadata{
+			Verb:   types.APIVerbUpdate,
+			Method: types.HTTPVerbPost,
+			Path: &types.Path{
+				Parent:       basePath,
+				RelativePath: fmt.Sprintf("%s/environment-groups/{%s}/sync", relPath, types.URLParamEnvGroupName),
+			},
+			Scopes: []types.PermissionScope{
+				types.UserScope,
+				types.ProjectScope,
+				types.ClusterScope,
+				types.EnvGroupScope,
+			},
+		},
+	)
+
+	syncEnvironmentGroupLinkedAppsHandler := environment_groups.NewSyncLinkedApplicationsHandler(
+		config,
+		factory.GetDecoderValidator(),
+		factory.GetResultWriter(),
+	)
+
+	routes = append(routes, &router.Route{
+		Endpoint: syncEnvironmentGroupLinkedAppsEndpoint,
+		Handler:  syncEnvironmentGroupLinkedAppsHandler,
+		Router:   r,
+	})
+
+	// POST /api/projects/{project_id}/clusters/{cluster_id}/integrations/external-secrets -> external_secrets.NewCreateExternalSecretsIntegrationHandler
+	createExternalSecretsIntegrationEndpoint := factory.NewAPIEndpoint(
+		&types.APIRequestMetadata{
+			Verb:   types.APIVerbCreate,
+			Method: types.HTTPVerbPost,
+			Path: &types.Path{
+				Parent:       basePath,
+				RelativePath: relPath + "/integrations/external-secrets",
+			},
+			Scopes: []types.PermissionScope{
+				types.UserScope,
+				types.ProjectScope,
+				types.ClusterScope,
+			},
+		},
+	)
+
+	createExternalSecretsIntegrationHandler := external_secrets.NewCreateExternalSecretsIntegrationHandler(
+		config,
+		factory.GetDecoderValidator(),
+		factory.GetResultWriter(),
+	)
+
+	routes = append(routes, &router.Route{
+		Endpoint: createExternalSecretsIntegrationEndpoint,
+		Handler:  createExternalSecretsIntegrationHandler,
+		Router:   r,
+	})
+
+	// GET /api/projects/{project_id}/clusters/{cluster_id}/integrations/external-secrets -> external_secrets.NewListExternalSecretsIntegrationsHandler
+	listExternalSecretsIntegrationsEndpoint := factory.NewAPIEndpoint(
+		&types.APIRequestMetadata{
+			Verb:   types.APIVerbList,
+			Method: types.HTTPVerbGet,
+			Path: &types.Path{
+				Parent:       basePath,
+				RelativePath: relPath + "/integrations/external-secrets",
+			},
+			Scopes: []types.PermissionScope{
+				types.UserScope,
+				types.ProjectScope,
+				types.ClusterScope,
+			},
+		},
+	)
+
+	listExternalSecretsIntegrationsHandler := external_secrets.NewListExternalSecretsIntegrationsHandler(
+		config,
+		factory.GetResultWriter(),
+	)
+
+	routes = append(routes, &router.Route{
+		Endpoint: listExternalSecretsIntegrationsEndpoint,
+		Handler:  listExternalSecretsIntegrationsHandler,
+		Router:   r,
+	})
+
+	// DELETE /api/projects/{project_id}/clusters/{cluster_id}/integrations/external-secrets/{external_secrets_integration_id} -> external_secrets.NewDeleteExternalSecretsIntegrationHandler
+	deleteExternalSecretsIntegrationEndpoint := factory.NewAPIEndpoint(
+		&types.APIRequestMetadata{
+			Verb:   types.APIVerbDelete,
+			Method: types.HTTPVerbDelete,
+			Path: &types.Path{
+				Parent:       basePath,
+				RelativePath: fmt.Sprintf("%s/integrations/external-secrets/{%s}", relPath, types.URLParamExternalSecretsIntegrationID),
+			},
+			Scopes: []types.PermissionScope{
+				types.UserScope,
+				types.ProjectScope,
+				types.ClusterScope,
+			},
+		},
+	)
+
+	deleteExternalSecretsIntegrationHandler := external_secrets.NewDeleteExternalSecretsIntegrationHandler(
+		config,
+		factory.GetResultWriter(),
+	)
+
+	routes = append(routes, &router.Route{
+		Endpoint: deleteExternalSecretsIntegrationEndpoint,
+		Handler:  deleteExternalSecretsIntegrationHandler,
+		Router:   r,
+	})
+
+	// POST /api/projects/{project_id}/clusters/{cluster_id}/integrations/external-secrets/{external_secrets_integration_id}/sync -> external_secrets.NewSyncExternalSecretsHandler
+	syncExternalSecretsEndpoint := factory.NewAPIEndpoint(
+		&types.APIRequestMetadata{
+			Verb:   types.APIVerbUpdate,
+			Method: types.HTTPVerbPost,
+			Path: &types.Path{
+				Parent:       basePath,
+				RelativePath: fmt.Sprintf("%s/integrations/external-secrets/{%s}/sync", relPath, types.URLParamExternalSecretsIntegrationID),
+			},
+			Scopes: []types.PermissionScope{
+				types.UserScope,
+				types.ProjectScope,
+				types.ClusterScope,
+			},
+		},
+	)
+
+	syncExternalSecretsHandler := external_secrets.NewSyncExternalSecretsHandler(
+		config,
+		factory.GetDecoderValidator(),
+		factory.GetResultWriter(),
+	)
+
+	routes = append(routes, &router.Route{
+		Endpoint: syncExternalSecretsEndpoint,
+		Handler:  syncExternalSecretsHandler,
+		Router:   r,
+	})
+
 	return routes, newPath
 }
@@ -82,5 +82,34 @@ func getClusterIntegrationRoutes(
 		Router:   r,
 	})
 
+	// GET /api/projects/{project_id}/clusters/{cluster_id}/integrations/aws/egress_ips -> awsClusterInt.NewGetEgressIPsHandler
+	getEgressIPsEndpoint := factory.NewAPIEndpoint(
+		&types.APIRequestMetadata{
+			Verb:   types.APIVerbGet,
+			Method: types.HTTPVerbGet,
+			Path: &types.Path{
+				Parent:       basePath,
+				RelativePath: relPath + "/aws/egress_ips",
+			},
+			Scopes: []types.PermissionScope{
+				types.UserScope,
+				types.ProjectScope,
+				types.ClusterScope,
+			},
+		},
+	)
+
+	getEgressIPsHandler := awsClusterInt.NewGetEgressIPsHandler(
+		config,
+		factory.GetDecoderValidator(),
+		factory.GetResultWriter(),
+	)
+
+	routes = append(routes, &router.Route{
+		Endpoint: getEgressIPsEndpoint,
+		Handler:  getEgressIPsHandler,
+		Router:   r,
+	})
+
 	return routes, newPath
 }
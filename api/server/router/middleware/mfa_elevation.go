@@ -0,0 +1,72 @@
+package middleware
+
+import (
+	"fmt"
+	"net/http"
+
+	"github.com/porter-dev/porter/api/server/authn"
+	"github.com/porter-dev/porter/api/server/shared/apierrors"
+	"github.com/porter-dev/porter/api/server/shared/config"
+	"github.com/porter-dev/porter/api/types"
+	"github.com/porter-dev/porter/internal/models"
+	"github.com/porter-dev/porter/internal/telemetry"
+)
+
+// ErrMFAElevationRequired is returned to users who enabled multi-factor authentication and have not verified a code recently
+var ErrMFAElevationRequired = fmt.Errorf("this operation requires a recent multi-factor authentication code: verify a code at /api/users/current/mfa/elevate or send one in the %s header", types.MFACodeHeader)
+
+// MFAElevationMiddleware rejects requests from users who enabled multi-factor authentication, unless their session was
+// elevated recently or the request carries a valid code
+type MFAElevationMiddleware struct {
+	config *config.Config
+}
+
+// NewMFAElevationMiddleware returns a new MFAElevationMiddleware
+func NewMFAElevationMiddleware(config *config.Config) *MFAElevationMiddleware {
+	return &MFAElevationMiddleware{config}
+}
+
+// Middleware checks the elevation of the request before calling the next handler
+func (m *MFAElevationMiddleware) Middleware(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		ctx, span := telemetry.NewSpan(r.Context(), "middleware-mfa-elevation")
+		defer span.End()
+
+		// API tokens are not tied to a user, so they are not subject to the multi-factor authentication of a user
+		user, _ := ctx.Value(types.UserScope).(*models.User)
+		if user == nil || user.ID == 0 || !user.MFAEnabled {
+			next.ServeHTTP(w, r)
+			return
+		}
+
+		telemetry.WithAttributes(span, telemetry.AttributeKV{Key: "user-id", Value: user.ID})
+
+		if code := r.Header.Get(types.MFACodeHeader); code != "" {
+			ok, err := authn.VerifyMFACode(ctx, m.config.Repo, user, code)
+			if err != nil {
+				err = telemetry.Error(ctx, span, err, "error verifying mfa code")
+				apierrors.HandleAPIError(m.config.Logger, m.config.Alerter, w, r, apierrors.NewErrInternal(err), true)
+				return
+			}
+
+			if !ok {
+				_ = telemetry.Error(ctx, span, nil, "invalid mfa code")
+				apierrors.HandleAPIError(m.config.Logger, m.config.Alerter, w, r,
+					apierrors.NewErrPassThroughToClient(fmt.Errorf("invalid multi-factor authentication code"), http.StatusForbidden), true)
+				return
+			}
+
+			next.ServeHTTP(w, r)
+			return
+		}
+
+		if !authn.IsSessionMFAElevated(r, m.config) {
+			_ = telemetry.Error(ctx, span, nil, "session is not mfa elevated")
+			apierrors.HandleAPIError(m.config.Logger, m.config.Alerter, w, r,
+				apierrors.NewErrPassThroughToClient(ErrMFAElevationRequired, http.StatusForbidden), true)
+			return
+		}
+
+		next.ServeHTTP(w, r)
+	})
+}
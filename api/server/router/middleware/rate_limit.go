@@ -0,0 +1,104 @@
+package middleware
+
+import (
+	"fmt"
+	"math"
+	"net"
+	"net/http"
+	"strconv"
+	"strings"
+
+	"github.com/porter-dev/porter/api/server/shared/apierrors"
+	"github.com/porter-dev/porter/api/server/shared/config"
+	"github.com/porter-dev/porter/api/types"
+	"github.com/porter-dev/porter/internal/models"
+	"github.com/porter-dev/porter/internal/ratelimit"
+	"github.com/porter-dev/porter/internal/telemetry"
+)
+
+// RateLimitMiddleware rejects requests with a 429 once a client exceeds the rate limit of an endpoint
+type RateLimitMiddleware struct {
+	config *config.Config
+	limit  types.RateLimit
+}
+
+// NewRateLimitMiddleware returns a new RateLimitMiddleware for the limit of an endpoint
+func NewRateLimitMiddleware(config *config.Config, limit types.RateLimit) *RateLimitMiddleware {
+	return &RateLimitMiddleware{config, limit}
+}
+
+// Middleware counts the request against the limit before calling the next handler. Requests are allowed if they cannot
+// be counted, so that an unavailable store does not take down the endpoints which are limited.
+func (m *RateLimitMiddleware) Middleware(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		ctx, span := telemetry.NewSpan(r.Context(), "middleware-rate-limit")
+		defer span.End()
+
+		subject := m.subject(r)
+
+		telemetry.WithAttributes(span,
+			telemetry.AttributeKV{Key: "rate-limit-name", Value: m.limit.Name},
+			telemetry.AttributeKV{Key: "rate-limit-subject", Value: subject},
+		)
+
+		res, limit, err := m.config.RateLimiter.Allow(ctx, m.limit.Name, subject, ratelimit.Limit{
+			Requests: m.limit.Requests,
+			Window:   m.limit.Window,
+		})
+		if err != nil {
+			_ = telemetry.Error(ctx, span, err, "error counting request against rate limit")
+			next.ServeHTTP(w, r)
+			return
+		}
+
+		w.Header().Set("X-RateLimit-Limit", strconv.Itoa(limit.Requests))
+		w.Header().Set("X-RateLimit-Remaining", strconv.Itoa(res.Remaining))
+
+		if !res.Allowed {
+			retryAfter := int(math.Ceil(res.RetryAfter.Seconds()))
+			telemetry.WithAttributes(span, telemetry.AttributeKV{Key: "retry-after-seconds", Value: retryAfter})
+
+			w.Header().Set("Retry-After", strconv.Itoa(retryAfter))
+			apierrors.HandleAPIError(m.config.Logger, m.config.Alerter, w, r,
+				apierrors.NewErrPassThroughToClient(fmt.Errorf("too many requests: try again in %d seconds", retryAfter), http.StatusTooManyRequests), true)
+			return
+		}
+
+		next.ServeHTTP(w, r)
+	})
+}
+
+// subject returns what the request is counted by, depending on the key of the limit. Requests fall back to being counted
+// by client IP address if the user or project is not known.
+func (m *RateLimitMiddleware) subject(r *http.Request) string {
+	switch m.limit.Key {
+	case types.RateLimitKeyUser:
+		if user, _ := r.Context().Value(types.UserScope).(*models.User); user != nil && user.ID != 0 {
+			return fmt.Sprintf("user:%d", user.ID)
+		}
+	case types.RateLimitKeyProject:
+		if project, _ := r.Context().Value(types.ProjectScope).(*models.Project); project != nil {
+			return fmt.Sprintf("project:%d", project.ID)
+		}
+	}
+
+	return fmt.Sprintf("ip:%s", clientIP(r, m.config.ServerConf.RateLimitIPHeader))
+}
+
+// clientIP returns the IP address of the client from the header set by a proxy, or from the remote address of the request
+func clientIP(r *http.Request, header string) string {
+	if header != "" {
+		// proxies append the addresses they received the request from, so the first address is the client
+		if value := r.Header.Get(header); value != "" {
+			first, _, _ := strings.Cut(value, ",")
+			return strings.TrimSpace(first)
+		}
+	}
+
+	host, _, err := net.SplitHostPort(r.RemoteAddr)
+	if err != nil {
+		return r.RemoteAddr
+	}
+
+	return host
+}
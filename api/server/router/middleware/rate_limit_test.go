@@ -0,0 +1,60 @@
+package middleware_test
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/porter-dev/porter/api/server/router/middleware"
+	"github.com/porter-dev/porter/api/server/shared/apitest"
+	"github.com/porter-dev/porter/api/types"
+	"github.com/porter-dev/porter/internal/ratelimit"
+)
+
+func TestRateLimitMiddleware(t *testing.T) {
+	config := apitest.LoadConfig(t)
+	config.RateLimiter = ratelimit.NewLimiter(ratelimit.NewMemoryStore(), nil)
+	config.ServerConf.RateLimitIPHeader = "X-Forwarded-For"
+
+	mw := middleware.NewRateLimitMiddleware(config, types.RateLimit{
+		Name:     "login",
+		Key:      types.RateLimitKeyIP,
+		Requests: 2,
+		Window:   time.Minute,
+	})
+
+	handler := mw.Middleware(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusOK)
+	}))
+
+	send := func(forwardedFor string) *httptest.ResponseRecorder {
+		req := httptest.NewRequest(http.MethodPost, "/api/login", nil)
+		req.Header.Set("X-Forwarded-For", forwardedFor)
+
+		rr := httptest.NewRecorder()
+		handler.ServeHTTP(rr, req)
+
+		return rr
+	}
+
+	for i := 0; i < 2; i++ {
+		if rr := send("203.0.113.1, 10.0.0.1"); rr.Code != http.StatusOK {
+			t.Fatalf("expected request %d to be allowed, got status %d", i+1, rr.Code)
+		}
+	}
+
+	rr := send("203.0.113.1, 10.0.0.2")
+	if rr.Code != http.StatusTooManyRequests {
+		t.Fatalf("expected request over the limit to be rejected, got status %d", rr.Code)
+	}
+
+	if retryAfter := rr.Header().Get("Retry-After"); retryAfter != "60" {
+		t.Errorf("expected Retry-After of 60 seconds, got %s", retryAfter)
+	}
+
+	// requests from other clients are counted separately
+	if rr := send("203.0.113.2"); rr.Code != http.StatusOK {
+		t.Errorf("expected request from another client to be allowed, got status %d", rr.Code)
+	}
+}
@@ -32,6 +32,17 @@ func (rw *requestLoggerResponseWriter) Hijack() (net.Conn, *bufio.ReadWriter, er
 	return h.Hijack()
 }
 
+func (rw *requestLoggerResponseWriter) Flush() {
+	if f, ok := rw.ResponseWriter.(http.Flusher); ok {
+		f.Flush()
+	}
+}
+
+// Unwrap allows http.ResponseController to reach the underlying ResponseWriter
+func (rw *requestLoggerResponseWriter) Unwrap() http.ResponseWriter {
+	return rw.ResponseWriter
+}
+
 type RequestLoggerMiddleware struct {
 	logger *logger.Logger
 }
@@ -33,7 +33,7 @@ func (b *UsageMiddleware) Middleware(next http.Handler) http.Handler {
 		telemetry.WithAttributes(span, telemetry.AttributeKV{Key: "project-id", Value: proj.ID})
 
 		// get the project usage limits
-		currentUsage, limit, _, err := usage.GetUsage(&usage.GetUsageOpts{
+		currentUsage, limit, _, err := usage.GetUsage(ctx, &usage.GetUsageOpts{
 			Project:                          proj,
 			DOConf:                           b.config.DOConf,
 			Repo:                             b.config.Repo,
@@ -776,5 +776,42 @@ func getNamespaceRoutes(
 		Router:   r,
 	})
 
+	// GET /api/projects/{project_id}/clusters/{cluster_id}/namespaces/{namespace}/services/{name}/tunnel ->
+	// namespace.NewTunnelServiceHandler
+	tunnelServiceEndpoint := factory.NewAPIEndpoint(
+		&types.APIRequestMetadata{
+			// tunnels can write to the services they reach, so they require the update verb rather than read access
+			Verb:   types.APIVerbUpdate,
+			Method: types.HTTPVerbGet,
+			Path: &types.Path{
+				Parent: basePath,
+				RelativePath: fmt.Sprintf(
+					"%s/services/{%s}/tunnel",
+					relPath,
+					types.URLParamServiceName,
+				),
+			},
+			Scopes: []types.PermissionScope{
+				types.UserScope,
+				types.ProjectScope,
+				types.ClusterScope,
+				types.NamespaceScope,
+			},
+			IsWebsocket: true,
+		},
+	)
+
+	tunnelServiceHandler := namespace.NewTunnelServiceHandler(
+		config,
+		factory.GetDecoderValidator(),
+		factory.GetResultWriter(),
+	)
+
+	routes = append(routes, &router.Route{
+		Endpoint: tunnelServiceEndpoint,
+		Handler:  tunnelServiceHandler,
+		Router:   r,
+	})
+
 	return routes, newPath
 }
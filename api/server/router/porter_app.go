@@ -9,6 +9,7 @@ import (
 	"github.com/porter-dev/porter/api/server/shared/config"
 	"github.com/porter-dev/porter/api/server/shared/router"
 	"github.com/porter-dev/porter/api/types"
+	"github.com/porter-dev/porter/internal/cost"
 )
 
 func NewPorterAppScopedRegisterer(children ...*router.Registerer) *router.Registerer {
@@ -68,6 +69,7 @@ func getPorterAppRoutes(
 				types.UserScope,
 				types.ProjectScope,
 				types.ClusterScope,
+				types.PorterAppScope,
 			},
 		},
 	)
@@ -96,6 +98,7 @@ func getPorterAppRoutes(
 				types.UserScope,
 				types.ProjectScope,
 				types.ClusterScope,
+				types.PorterAppScope,
 			},
 		},
 	)
@@ -124,6 +127,7 @@ func getPorterAppRoutes(
 				types.UserScope,
 				types.ProjectScope,
 				types.ClusterScope,
+				types.PorterAppScope,
 			},
 		},
 	)
@@ -152,6 +156,7 @@ func getPorterAppRoutes(
 				types.UserScope,
 				types.ProjectScope,
 				types.ClusterScope,
+				types.PorterAppScope,
 			},
 		},
 	)
@@ -181,6 +186,7 @@ func getPorterAppRoutes(
 				types.ProjectScope,
 				types.ClusterScope,
 			},
+			Response: types.ListPorterAppResponse{},
 		},
 	)
 
@@ -208,6 +214,7 @@ func getPorterAppRoutes(
 				types.UserScope,
 				types.ProjectScope,
 				types.ClusterScope,
+				types.PorterAppScope,
 			},
 		},
 	)
@@ -237,6 +244,7 @@ func getPorterAppRoutes(
 				types.UserScope,
 				types.ProjectScope,
 				types.ClusterScope,
+				types.PorterAppScope,
 			},
 		},
 	)
@@ -266,6 +274,7 @@ func getPorterAppRoutes(
 				types.UserScope,
 				types.ProjectScope,
 				types.ClusterScope,
+				types.PorterAppScope,
 			},
 		},
 	)
@@ -295,6 +304,7 @@ func getPorterAppRoutes(
 				types.UserScope,
 				types.ProjectScope,
 				types.ClusterScope,
+				types.PorterAppScope,
 			},
 		},
 	)
@@ -324,6 +334,7 @@ func getPorterAppRoutes(
 				types.UserScope,
 				types.ProjectScope,
 				types.ClusterScope,
+				types.PorterAppScope,
 			},
 		},
 	)
@@ -352,6 +363,7 @@ func getPorterAppRoutes(
 				types.UserScope,
 				types.ProjectScope,
 				types.ClusterScope,
+				types.PorterAppScope,
 			},
 		},
 	)
@@ -467,6 +479,7 @@ func getPorterAppRoutes(
 				types.UserScope,
 				types.ProjectScope,
 				types.ClusterScope,
+				types.PorterAppScope,
 			},
 		},
 	)
@@ -498,6 +511,7 @@ func getPorterAppRoutes(
 				types.UserScope,
 				types.ProjectScope,
 				types.ClusterScope,
+				types.PorterAppScope,
 			},
 		},
 	)
@@ -526,6 +540,7 @@ func getPorterAppRoutes(
 				types.UserScope,
 				types.ProjectScope,
 				types.ClusterScope,
+				types.PorterAppScope,
 			},
 		},
 	)
@@ -555,6 +570,7 @@ func getPorterAppRoutes(
 				types.UserScope,
 				types.ProjectScope,
 				types.ClusterScope,
+				types.PorterAppScope,
 			},
 		},
 	)
@@ -614,6 +630,8 @@ func getPorterAppRoutes(
 				types.ProjectScope,
 				types.ClusterScope,
 			},
+			Request:  &porter_app.ValidatePorterAppRequest{},
+			Response: &porter_app.ValidatePorterAppResponse{},
 		},
 	)
 
@@ -629,6 +647,37 @@ func getPorterAppRoutes(
 		Router:   r,
 	})
 
+	// POST /api/projects/{project_id}/clusters/{cluster_id}/apps/cost-estimate -> porter_app.NewCostEstimateHandler
+	costEstimateEndpoint := factory.NewAPIEndpoint(
+		&types.APIRequestMetadata{
+			Verb:   types.APIVerbGet,
+			Method: types.HTTPVerbPost,
+			Path: &types.Path{
+				Parent:       basePath,
+				RelativePath: "/apps/cost-estimate",
+			},
+			Scopes: []types.PermissionScope{
+				types.UserScope,
+				types.ProjectScope,
+				types.ClusterScope,
+			},
+			Request:  &porter_app.CostEstimateRequest{},
+			Response: &cost.Estimate{},
+		},
+	)
+
+	costEstimateHandler := porter_app.NewCostEstimateHandler(
+		config,
+		factory.GetDecoderValidator(),
+		factory.GetResultWriter(),
+	)
+
+	routes = append(routes, &router.Route{
+		Endpoint: costEstimateEndpoint,
+		Handler:  costEstimateHandler,
+		Router:   r,
+	})
+
 	// POST /api/projects/{project_id}/clusters/{cluster_id}/apps/create -> porter_app.NewCreateAppHandler
 	createAppEndpoint := factory.NewAPIEndpoint(
 		&types.APIRequestMetadata{
@@ -643,6 +692,8 @@ func getPorterAppRoutes(
 				types.ProjectScope,
 				types.ClusterScope,
 			},
+			Request:  &porter_app.CreateAppRequest{},
+			Response: &types.PorterApp{},
 		},
 	)
 
@@ -672,6 +723,8 @@ func getPorterAppRoutes(
 				types.ProjectScope,
 				types.ClusterScope,
 			},
+			Request:  &porter_app.ApplyPorterAppRequest{},
+			Response: &porter_app.ApplyPorterAppResponse{},
 		},
 	)
 
@@ -716,6 +769,424 @@ func getPorterAppRoutes(
 		Router:   r,
 	})
 
+	// POST /api/projects/{project_id}/clusters/{cluster_id}/preview-deployment-target -> porter_app.NewPreviewDeploymentTargetHandler
+	previewDeploymentTargetEndpoint := factory.NewAPIEndpoint(
+		&types.APIRequestMetadata{
+			Verb:   types.APIVerbCreate,
+			Method: types.HTTPVerbPost,
+			Path: &types.Path{
+				Parent:       basePath,
+				RelativePath: "/preview-deployment-target",
+			},
+			Scopes: []types.PermissionScope{
+				types.UserScope,
+				types.ProjectScope,
+				types.ClusterScope,
+			},
+		},
+	)
+
+	previewDeploymentTargetHandler := porter_app.NewPreviewDeploymentTargetHandler(
+		config,
+		factory.GetDecoderValidator(),
+		factory.GetResultWriter(),
+	)
+
+	routes = append(routes, &router.Route{
+		Endpoint: previewDeploymentTargetEndpoint,
+		Handler:  previewDeploymentTargetHandler,
+		Router:   r,
+	})
+
+	// GET /api/projects/{project_id}/clusters/{cluster_id}/apps/{porter_app_name}/status -> porter_app.NewAppStatusHandler
+	appStatusEndpoint := factory.NewAPIEndpoint(
+		&types.APIRequestMetadata{
+			Verb:   types.APIVerbGet,
+			Method: types.HTTPVerbGet,
+			Path: &types.Path{
+				Parent:       basePath,
+				RelativePath: fmt.Sprintf("/apps/{%s}/status", types.URLParamPorterAppName),
+			},
+			Scopes: []types.PermissionScope{
+				types.UserScope,
+				types.ProjectScope,
+				types.ClusterScope,
+				types.PorterAppScope,
+			},
+		},
+	)
+
+	appStatusHandler := porter_app.NewAppStatusHandler(
+		config,
+		factory.GetDecoderValidator(),
+		factory.GetResultWriter(),
+	)
+
+	routes = append(routes, &router.Route{
+		Endpoint: appStatusEndpoint,
+		Handler:  appStatusHandler,
+		Router:   r,
+	})
+
+	// GET /api/projects/{project_id}/clusters/{cluster_id}/apps/{porter_app_name}/jobs/{job_name}/runs -> porter_app.NewListJobRunsHandler
+	listJobRunsEndpoint := factory.NewAPIEndpoint(
+		&types.APIRequestMetadata{
+			Verb:   types.APIVerbList,
+			Method: types.HTTPVerbGet,
+			Path: &types.Path{
+				Parent:       basePath,
+				RelativePath: fmt.Sprintf("/apps/{%s}/jobs/{%s}/runs", types.URLParamPorterAppName, types.URLParamJobServiceName),
+			},
+			Scopes: []types.PermissionScope{
+				types.UserScope,
+				types.ProjectScope,
+				types.ClusterScope,
+				types.PorterAppScope,
+			},
+		},
+	)
+
+	listJobRunsHandler := porter_app.NewListJobRunsHandler(
+		config,
+		factory.GetDecoderValidator(),
+		factory.GetResultWriter(),
+	)
+
+	routes = append(routes, &router.Route{
+		Endpoint: listJobRunsEndpoint,
+		Handler:  listJobRunsHandler,
+		Router:   r,
+	})
+
+	// POST /api/projects/{project_id}/clusters/{cluster_id}/apps/{porter_app_name}/jobs/{job_name}/run -> porter_app.NewRunJobHandler
+	runJobEndpoint := factory.NewAPIEndpoint(
+		&types.APIRequestMetadata{
+			Verb:   types.APIVerbCreate,
+			Method: types.HTTPVerbPost,
+			Path: &types.Path{
+				Parent:       basePath,
+				RelativePath: fmt.Sprintf("/apps/{%s}/jobs/{%s}/run", types.URLParamPorterAppName, types.URLParamJobServiceName),
+			},
+			Scopes: []types.PermissionScope{
+				types.UserScope,
+				types.ProjectScope,
+				types.ClusterScope,
+				types.PorterAppScope,
+			},
+		},
+	)
+
+	runJobHandler := porter_app.NewRunJobHandler(
+		config,
+		factory.GetDecoderValidator(),
+		factory.GetResultWriter(),
+	)
+
+	routes = append(routes, &router.Route{
+		Endpoint: runJobEndpoint,
+		Handler:  runJobHandler,
+		Router:   r,
+	})
+
+	// GET /api/projects/{project_id}/clusters/{cluster_id}/apps/{porter_app_name}/resource-recommendations -> porter_app.NewResourceRecommendationsHandler
+	resourceRecommendationsEndpoint := factory.NewAPIEndpoint(
+		&types.APIRequestMetadata{
+			Verb:   types.APIVerbGet,
+			Method: types.HTTPVerbGet,
+			Path: &types.Path{
+				Parent:       basePath,
+				RelativePath: fmt.Sprintf("/apps/{%s}/resource-recommendations", types.URLParamPorterAppName),
+			},
+			Scopes: []types.PermissionScope{
+				types.UserScope,
+				types.ProjectScope,
+				types.ClusterScope,
+				types.PorterAppScope,
+			},
+		},
+	)
+
+	resourceRecommendationsHandler := porter_app.NewResourceRecommendationsHandler(
+		config,
+		factory.GetDecoderValidator(),
+		factory.GetResultWriter(),
+	)
+
+	routes = append(routes, &router.Route{
+		Endpoint: resourceRecommendationsEndpoint,
+		Handler:  resourceRecommendationsHandler,
+		Router:   r,
+	})
+
+	// GET /api/projects/{project_id}/clusters/{cluster_id}/apps/{porter_app_name}/metrics -> porter_app.NewAppMetricsHandler
+	appMetricsEndpoint := factory.NewAPIEndpoint(
+		&types.APIRequestMetadata{
+			Verb:   types.APIVerbGet,
+			Method: types.HTTPVerbGet,
+			Path: &types.Path{
+				Parent:       basePath,
+				RelativePath: fmt.Sprintf("/apps/{%s}/metrics", types.URLParamPorterAppName),
+			},
+			Scopes: []types.PermissionScope{
+				types.UserScope,
+				types.ProjectScope,
+				types.ClusterScope,
+				types.PorterAppScope,
+			},
+		},
+	)
+
+	appMetricsHandler := porter_app.NewAppMetricsHandler(
+		config,
+		factory.GetDecoderValidator(),
+		factory.GetResultWriter(),
+	)
+
+	routes = append(routes, &router.Route{
+		Endpoint: appMetricsEndpoint,
+		Handler:  appMetricsHandler,
+		Router:   r,
+	})
+
+	// GET /api/projects/{project_id}/clusters/{cluster_id}/apps/{porter_app_name}/env-diff -> porter_app.NewEnvDiffHandler
+	envDiffEndpoint := factory.NewAPIEndpoint(
+		&types.APIRequestMetadata{
+			Verb:   types.APIVerbGet,
+			Method: types.HTTPVerbGet,
+			Path: &types.Path{
+				Parent:       basePath,
+				RelativePath: fmt.Sprintf("/apps/{%s}/env-diff", types.URLParamPorterAppName),
+			},
+			Scopes: []types.PermissionScope{
+				types.UserScope,
+				types.ProjectScope,
+				types.ClusterScope,
+				types.PorterAppScope,
+			},
+		},
+	)
+
+	envDiffHandler := porter_app.NewEnvDiffHandler(
+		config,
+		factory.GetDecoderValidator(),
+		factory.GetResultWriter(),
+	)
+
+	routes = append(routes, &router.Route{
+		Endpoint: envDiffEndpoint,
+		Handler:  envDiffHandler,
+		Router:   r,
+	})
+
+	// POST /api/projects/{project_id}/clusters/{cluster_id}/apps/{porter_app_name}/build-logs -> porter_app.NewCreateBuildLogHandler
+	createBuildLogEndpoint := factory.NewAPIEndpoint(
+		&types.APIRequestMetadata{
+			Verb:   types.APIVerbCreate,
+			Method: types.HTTPVerbPost,
+			Path: &types.Path{
+				Parent:       basePath,
+				RelativePath: fmt.Sprintf("/apps/{%s}/build-logs", types.URLParamPorterAppName),
+			},
+			Scopes: []types.PermissionScope{
+				types.UserScope,
+				types.ProjectScope,
+				types.ClusterScope,
+				types.PorterAppScope,
+			},
+		},
+	)
+
+	createBuildLogHandler := porter_app.NewCreateBuildLogHandler(
+		config,
+		factory.GetDecoderValidator(),
+		factory.GetResultWriter(),
+	)
+
+	routes = append(routes, &router.Route{
+		Endpoint: createBuildLogEndpoint,
+		Handler:  createBuildLogHandler,
+		Router:   r,
+	})
+
+	// POST /api/projects/{project_id}/clusters/{cluster_id}/apps/{porter_app_name}/remote-builds -> porter_app.NewRemoteBuildHandler
+	remoteBuildEndpoint := factory.NewAPIEndpoint(
+		&types.APIRequestMetadata{
+			Verb:   types.APIVerbCreate,
+			Method: types.HTTPVerbPost,
+			Path: &types.Path{
+				Parent:       basePath,
+				RelativePath: fmt.Sprintf("/apps/{%s}/remote-builds", types.URLParamPorterAppName),
+			},
+			Scopes: []types.PermissionScope{
+				types.UserScope,
+				types.ProjectScope,
+				types.ClusterScope,
+				types.PorterAppScope,
+			},
+		},
+	)
+
+	remoteBuildHandler := porter_app.NewRemoteBuildHandler(
+		config,
+		factory.GetResultWriter(),
+	)
+
+	routes = append(routes, &router.Route{
+		Endpoint: remoteBuildEndpoint,
+		Handler:  remoteBuildHandler,
+		Router:   r,
+	})
+
+	// GET /api/projects/{project_id}/clusters/{cluster_id}/apps/{porter_app_name}/build-logs -> porter_app.NewListBuildLogsHandler
+	listBuildLogsEndpoint := factory.NewAPIEndpoint(
+		&types.APIRequestMetadata{
+			Verb:   types.APIVerbList,
+			Method: types.HTTPVerbGet,
+			Path: &types.Path{
+				Parent:       basePath,
+				RelativePath: fmt.Sprintf("/apps/{%s}/build-logs", types.URLParamPorterAppName),
+			},
+			Scopes: []types.PermissionScope{
+				types.UserScope,
+				types.ProjectScope,
+				types.ClusterScope,
+				types.PorterAppScope,
+			},
+		},
+	)
+
+	listBuildLogsHandler := porter_app.NewListBuildLogsHandler(
+		config,
+		factory.GetDecoderValidator(),
+		factory.GetResultWriter(),
+	)
+
+	routes = append(routes, &router.Route{
+		Endpoint: listBuildLogsEndpoint,
+		Handler:  listBuildLogsHandler,
+		Router:   r,
+	})
+
+	// POST /api/projects/{project_id}/clusters/{cluster_id}/apps/{porter_app_name}/build-logs/{build_log_id} -> porter_app.NewAppendBuildLogHandler
+	appendBuildLogEndpoint := factory.NewAPIEndpoint(
+		&types.APIRequestMetadata{
+			Verb:   types.APIVerbUpdate,
+			Method: types.HTTPVerbPost,
+			Path: &types.Path{
+				Parent:       basePath,
+				RelativePath: fmt.Sprintf("/apps/{%s}/build-logs/{%s}", types.URLParamPorterAppName, types.URLParamBuildLogID),
+			},
+			Scopes: []types.PermissionScope{
+				types.UserScope,
+				types.ProjectScope,
+				types.ClusterScope,
+				types.PorterAppScope,
+			},
+		},
+	)
+
+	appendBuildLogHandler := porter_app.NewAppendBuildLogHandler(
+		config,
+		factory.GetDecoderValidator(),
+		factory.GetResultWriter(),
+	)
+
+	routes = append(routes, &router.Route{
+		Endpoint: appendBuildLogEndpoint,
+		Handler:  appendBuildLogHandler,
+		Router:   r,
+	})
+
+	// GET /api/projects/{project_id}/clusters/{cluster_id}/apps/{porter_app_name}/build-logs/{build_log_id} -> porter_app.NewGetBuildLogHandler
+	getBuildLogEndpoint := factory.NewAPIEndpoint(
+		&types.APIRequestMetadata{
+			Verb:   types.APIVerbGet,
+			Method: types.HTTPVerbGet,
+			Path: &types.Path{
+				Parent:       basePath,
+				RelativePath: fmt.Sprintf("/apps/{%s}/build-logs/{%s}", types.URLParamPorterAppName, types.URLParamBuildLogID),
+			},
+			Scopes: []types.PermissionScope{
+				types.UserScope,
+				types.ProjectScope,
+				types.ClusterScope,
+				types.PorterAppScope,
+			},
+		},
+	)
+
+	getBuildLogHandler := porter_app.NewGetBuildLogHandler(
+		config,
+		factory.GetDecoderValidator(),
+		factory.GetResultWriter(),
+	)
+
+	routes = append(routes, &router.Route{
+		Endpoint: getBuildLogEndpoint,
+		Handler:  getBuildLogHandler,
+		Router:   r,
+	})
+
+	// GET /api/projects/{project_id}/clusters/{cluster_id}/apps/{porter_app_name}/deploys/{app_revision_id}/events -> porter_app.NewDeployEventsHandler
+	deployEventsEndpoint := factory.NewAPIEndpoint(
+		&types.APIRequestMetadata{
+			Verb:   types.APIVerbGet,
+			Method: types.HTTPVerbGet,
+			Path: &types.Path{
+				Parent:       basePath,
+				RelativePath: fmt.Sprintf("/apps/{%s}/deploys/{%s}/events", types.URLParamPorterAppName, types.URLParamAppRevisionID),
+			},
+			Scopes: []types.PermissionScope{
+				types.UserScope,
+				types.ProjectScope,
+				types.ClusterScope,
+				types.PorterAppScope,
+			},
+		},
+	)
+
+	deployEventsHandler := porter_app.NewDeployEventsHandler(
+		config,
+		factory.GetDecoderValidator(),
+		factory.GetResultWriter(),
+	)
+
+	routes = append(routes, &router.Route{
+		Endpoint: deployEventsEndpoint,
+		Handler:  deployEventsHandler,
+		Router:   r,
+	})
+
+	// POST /api/projects/{project_id}/clusters/{cluster_id}/apps/{porter_app_name}/auto-rollback -> porter_app.NewUpdateAutoRollbackHandler
+	updateAutoRollbackEndpoint := factory.NewAPIEndpoint(
+		&types.APIRequestMetadata{
+			Verb:   types.APIVerbUpdate,
+			Method: types.HTTPVerbPost,
+			Path: &types.Path{
+				Parent:       basePath,
+				RelativePath: fmt.Sprintf("/apps/{%s}/auto-rollback", types.URLParamPorterAppName),
+			},
+			Scopes: []types.PermissionScope{
+				types.UserScope,
+				types.ProjectScope,
+				types.ClusterScope,
+				types.PorterAppScope,
+			},
+		},
+	)
+
+	updateAutoRollbackHandler := porter_app.NewUpdateAutoRollbackHandler(
+		config,
+		factory.GetDecoderValidator(),
+		factory.GetResultWriter(),
+	)
+
+	routes = append(routes, &router.Route{
+		Endpoint: updateAutoRollbackEndpoint,
+		Handler:  updateAutoRollbackHandler,
+		Router:   r,
+	})
+
 	// GET /api/projects/{project_id}/clusters/{cluster_id}/apps/{porter_app_name}/latest -> porter_app.NewCurrentAppRevisionHandler
 	currentAppRevisionEndpoint := factory.NewAPIEndpoint(
 		&types.APIRequestMetadata{
@@ -729,6 +1200,7 @@ func getPorterAppRoutes(
 				types.UserScope,
 				types.ProjectScope,
 				types.ClusterScope,
+				types.PorterAppScope,
 			},
 		},
 	)
@@ -758,6 +1230,7 @@ func getPorterAppRoutes(
 				types.UserScope,
 				types.ProjectScope,
 				types.ClusterScope,
+				types.PorterAppScope,
 			},
 		},
 	)
@@ -774,6 +1247,36 @@ func getPorterAppRoutes(
 		Router:   r,
 	})
 
+	// GET /api/projects/{project_id}/clusters/{cluster_id}/apps/{porter_app_name}/revisions/{from_revision_number}/diff/{to_revision_number} -> porter_app.NewAppRevisionDiffHandler
+	appRevisionDiffEndpoint := factory.NewAPIEndpoint(
+		&types.APIRequestMetadata{
+			Verb:   types.APIVerbGet,
+			Method: types.HTTPVerbGet,
+			Path: &types.Path{
+				Parent:       basePath,
+				RelativePath: fmt.Sprintf("/apps/{%s}/revisions/{%s}/diff/{%s}", types.URLParamPorterAppName, types.URLParamFromRevisionNumber, types.URLParamToRevisionNumber),
+			},
+			Scopes: []types.PermissionScope{
+				types.UserScope,
+				types.ProjectScope,
+				types.ClusterScope,
+				types.PorterAppScope,
+			},
+		},
+	)
+
+	appRevisionDiffHandler := porter_app.NewAppRevisionDiffHandler(
+		config,
+		factory.GetDecoderValidator(),
+		factory.GetResultWriter(),
+	)
+
+	routes = append(routes, &router.Route{
+		Endpoint: appRevisionDiffEndpoint,
+		Handler:  appRevisionDiffHandler,
+		Router:   r,
+	})
+
 	// POST /api/projects/{project_id}/clusters/{cluster_id}/apps/{porter_app_name}/subdomain -> porter_app.NewCreateSubdomainHandler
 	createSubdomainEndpoint := factory.NewAPIEndpoint(
 		&types.APIRequestMetadata{
@@ -787,6 +1290,7 @@ func getPorterAppRoutes(
 				types.UserScope,
 				types.ProjectScope,
 				types.ClusterScope,
+				types.PorterAppScope,
 			},
 		},
 	)
@@ -803,5 +1307,181 @@ func getPorterAppRoutes(
 		Router:   r,
 	})
 
+	// GET /api/projects/{project_id}/clusters/{cluster_id}/apps/{porter_app_name}/certificates -> porter_app.NewListAppCertificatesHandler
+	listAppCertificatesEndpoint := factory.NewAPIEndpoint(
+		&types.APIRequestMetadata{
+			Verb:   types.APIVerbGet,
+			Method: types.HTTPVerbGet,
+			Path: &types.Path{
+				Parent:       basePath,
+				RelativePath: fmt.Sprintf("/apps/{%s}/certificates", types.URLParamPorterAppName),
+			},
+			Scopes: []types.PermissionScope{
+				types.UserScope,
+				types.ProjectScope,
+				types.ClusterScope,
+				types.PorterAppScope,
+			},
+		},
+	)
+
+	listAppCertificatesHandler := porter_app.NewListAppCertificatesHandler(
+		config,
+		factory.GetResultWriter(),
+	)
+
+	routes = append(routes, &router.Route{
+		Endpoint: listAppCertificatesEndpoint,
+		Handler:  listAppCertificatesHandler,
+		Router:   r,
+	})
+
+	// POST /api/projects/{project_id}/clusters/{cluster_id}/apps/{porter_app_name}/certificates/{domain} -> porter_app.NewUploadCustomCertificateHandler
+	uploadCustomCertificateEndpoint := factory.NewAPIEndpoint(
+		&types.APIRequestMetadata{
+			Verb:   types.APIVerbUpdate,
+			Method: types.HTTPVerbPost,
+			Path: &types.Path{
+				Parent:       basePath,
+				RelativePath: fmt.Sprintf("/apps/{%s}/certificates/{%s}", types.URLParamPorterAppName, types.URLParamDomain),
+			},
+			Scopes: []types.PermissionScope{
+				types.UserScope,
+				types.ProjectScope,
+				types.ClusterScope,
+				types.PorterAppScope,
+			},
+		},
+	)
+
+	uploadCustomCertificateHandler := porter_app.NewUploadCustomCertificateHandler(
+		config,
+		factory.GetDecoderValidator(),
+		factory.GetResultWriter(),
+	)
+
+	routes = append(routes, &router.Route{
+		Endpoint: uploadCustomCertificateEndpoint,
+		Handler:  uploadCustomCertificateHandler,
+		Router:   r,
+	})
+
+	// DELETE /api/projects/{project_id}/clusters/{cluster_id}/apps/{porter_app_name}/certificates/{domain} -> porter_app.NewDeleteCustomCertificateHandler
+	deleteCustomCertificateEndpoint := factory.NewAPIEndpoint(
+		&types.APIRequestMetadata{
+			Verb:   types.APIVerbUpdate,
+			Method: types.HTTPVerbDelete,
+			Path: &types.Path{
+				Parent:       basePath,
+				RelativePath: fmt.Sprintf("/apps/{%s}/certificates/{%s}", types.URLParamPorterAppName, types.URLParamDomain),
+			},
+			Scopes: []types.PermissionScope{
+				types.UserScope,
+				types.ProjectScope,
+				types.ClusterScope,
+				types.PorterAppScope,
+			},
+		},
+	)
+
+	deleteCustomCertificateHandler := porter_app.NewDeleteCustomCertificateHandler(
+		config,
+		factory.GetResultWriter(),
+	)
+
+	routes = append(routes, &router.Route{
+		Endpoint: deleteCustomCertificateEndpoint,
+		Handler:  deleteCustomCertificateHandler,
+		Router:   r,
+	})
+
+	// GET /api/projects/{project_id}/clusters/{cluster_id}/apps/{porter_app_name}/domains -> porter_app.NewListCustomDomainsHandler
+	listCustomDomainsEndpoint := factory.NewAPIEndpoint(
+		&types.APIRequestMetadata{
+			Verb:   types.APIVerbGet,
+			Method: types.HTTPVerbGet,
+			Path: &types.Path{
+				Parent:       basePath,
+				RelativePath: fmt.Sprintf("/apps/{%s}/domains", types.URLParamPorterAppName),
+			},
+			Scopes: []types.PermissionScope{
+				types.UserScope,
+				types.ProjectScope,
+				types.ClusterScope,
+				types.PorterAppScope,
+			},
+		},
+	)
+
+	listCustomDomainsHandler := porter_app.NewListCustomDomainsHandler(
+		config,
+		factory.GetResultWriter(),
+	)
+
+	routes = append(routes, &router.Route{
+		Endpoint: listCustomDomainsEndpoint,
+		Handler:  listCustomDomainsHandler,
+		Router:   r,
+	})
+
+	// POST /api/projects/{project_id}/clusters/{cluster_id}/apps/{porter_app_name}/domains/{domain}/verify -> porter_app.NewVerifyCustomDomainHandler
+	verifyCustomDomainEndpoint := factory.NewAPIEndpoint(
+		&types.APIRequestMetadata{
+			Verb:   types.APIVerbUpdate,
+			Method: types.HTTPVerbPost,
+			Path: &types.Path{
+				Parent:       basePath,
+				RelativePath: fmt.Sprintf("/apps/{%s}/domains/{%s}/verify", types.URLParamPorterAppName, types.URLParamDomain),
+			},
+			Scopes: []types.PermissionScope{
+				types.UserScope,
+				types.ProjectScope,
+				types.ClusterScope,
+				types.PorterAppScope,
+			},
+		},
+	)
+
+	verifyCustomDomainHandler := porter_app.NewVerifyCustomDomainHandler(
+		config,
+		factory.GetResultWriter(),
+	)
+
+	routes = append(routes, &router.Route{
+		Endpoint: verifyCustomDomainEndpoint,
+		Handler:  verifyCustomDomainHandler,
+		Router:   r,
+	})
+
+	// POST /api/projects/{project_id}/clusters/{cluster_id}/apps/{porter_app_name}/domains/{domain}/dns -> porter_app.NewConfigureDomainDNSHandler
+	configureDomainDNSEndpoint := factory.NewAPIEndpoint(
+		&types.APIRequestMetadata{
+			Verb:   types.APIVerbUpdate,
+			Method: types.HTTPVerbPost,
+			Path: &types.Path{
+				Parent:       basePath,
+				RelativePath: fmt.Sprintf("/apps/{%s}/domains/{%s}/dns", types.URLParamPorterAppName, types.URLParamDomain),
+			},
+			Scopes: []types.PermissionScope{
+				types.UserScope,
+				types.ProjectScope,
+				types.ClusterScope,
+				types.PorterAppScope,
+			},
+		},
+	)
+
+	configureDomainDNSHandler := porter_app.NewConfigureDomainDNSHandler(
+		config,
+		factory.GetDecoderValidator(),
+		factory.GetResultWriter(),
+	)
+
+	routes = append(routes, &router.Route{
+		Endpoint: configureDomainDNSEndpoint,
+		Handler:  configureDomainDNSHandler,
+		Router:   r,
+	})
+
 	return routes, newPath
 }
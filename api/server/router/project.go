@@ -6,10 +6,12 @@ import (
 	"github.com/go-chi/chi/v5"
 	apiContract "github.com/porter-dev/porter/api/server/handlers/api_contract"
 	"github.com/porter-dev/porter/api/server/handlers/api_token"
+	"github.com/porter-dev/porter/api/server/handlers/app_template"
 	"github.com/porter-dev/porter/api/server/handlers/billing"
 	"github.com/porter-dev/porter/api/server/handlers/cluster"
 	"github.com/porter-dev/porter/api/server/handlers/gitinstallation"
 	"github.com/porter-dev/porter/api/server/handlers/helmrepo"
+	"github.com/porter-dev/porter/api/server/handlers/image_attestation"
 	"github.com/porter-dev/porter/api/server/handlers/infra"
 	"github.com/porter-dev/porter/api/server/handlers/policy"
 	"github.com/porter-dev/porter/api/server/handlers/project"
@@ -77,6 +79,7 @@ func getProjectRoutes(
 				types.UserScope,
 				types.ProjectScope,
 			},
+			Response: &types.ReadProjectResponse{},
 		},
 	)
 
@@ -104,6 +107,7 @@ func getProjectRoutes(
 				types.UserScope,
 				types.ProjectScope,
 			},
+			RequiresMFAElevation: true,
 		},
 	)
 
@@ -145,6 +149,281 @@ func getProjectRoutes(
 		Router:   r,
 	})
 
+	// GET /api/projects/{project_id}/resource-presets -> project.NewGetResourcePresetsHandler
+	getResourcePresetsEndpoint := factory.NewAPIEndpoint(
+		&types.APIRequestMetadata{
+			Verb:   types.APIVerbGet,
+			Method: types.HTTPVerbGet,
+			Path: &types.Path{
+				Parent:       basePath,
+				RelativePath: relPath + "/resource-presets",
+			},
+			Scopes: []types.PermissionScope{
+				types.UserScope,
+				types.ProjectScope,
+			},
+		},
+	)
+
+	getResourcePresetsHandler := project.NewGetResourcePresetsHandler(
+		config,
+		factory.GetResultWriter(),
+	)
+
+	routes = append(routes, &router.Route{
+		Endpoint: getResourcePresetsEndpoint,
+		Handler:  getResourcePresetsHandler,
+		Router:   r,
+	})
+
+	// POST /api/projects/{project_id}/resource-presets -> project.NewUpdateResourcePresetsHandler
+	updateResourcePresetsEndpoint := factory.NewAPIEndpoint(
+		&types.APIRequestMetadata{
+			Verb:   types.APIVerbUpdate,
+			Method: types.HTTPVerbPost,
+			Path: &types.Path{
+				Parent:       basePath,
+				RelativePath: relPath + "/resource-presets",
+			},
+			Scopes: []types.PermissionScope{
+				types.UserScope,
+				types.ProjectScope,
+			},
+		},
+	)
+
+	updateResourcePresetsHandler := project.NewUpdateResourcePresetsHandler(
+		config,
+		factory.GetDecoderValidator(),
+		factory.GetResultWriter(),
+	)
+
+	routes = append(routes, &router.Route{
+		Endpoint: updateResourcePresetsEndpoint,
+		Handler:  updateResourcePresetsHandler,
+		Router:   r,
+	})
+
+	// GET /api/projects/{project_id}/app-templates -> app_template.NewListAppTemplatesHandler
+	listAppTemplatesEndpoint := factory.NewAPIEndpoint(
+		&types.APIRequestMetadata{
+			Verb:   types.APIVerbList,
+			Method: types.HTTPVerbGet,
+			Path: &types.Path{
+				Parent:       basePath,
+				RelativePath: relPath + "/app-templates",
+			},
+			Scopes: []types.PermissionScope{
+				types.UserScope,
+				types.ProjectScope,
+			},
+		},
+	)
+
+	listAppTemplatesHandler := app_template.NewListAppTemplatesHandler(
+		config,
+		factory.GetResultWriter(),
+	)
+
+	routes = append(routes, &router.Route{
+		Endpoint: listAppTemplatesEndpoint,
+		Handler:  listAppTemplatesHandler,
+		Router:   r,
+	})
+
+	// POST /api/projects/{project_id}/app-templates -> app_template.NewCreateAppTemplateHandler
+	createAppTemplateEndpoint := factory.NewAPIEndpoint(
+		&types.APIRequestMetadata{
+			Verb:   types.APIVerbCreate,
+			Method: types.HTTPVerbPost,
+			Path: &types.Path{
+				Parent:       basePath,
+				RelativePath: relPath + "/app-templates",
+			},
+			Scopes: []types.PermissionScope{
+				types.UserScope,
+				types.ProjectScope,
+			},
+		},
+	)
+
+	createAppTemplateHandler := app_template.NewCreateAppTemplateHandler(
+		config,
+		factory.GetDecoderValidator(),
+		factory.GetResultWriter(),
+	)
+
+	routes = append(routes, &router.Route{
+		Endpoint: createAppTemplateEndpoint,
+		Handler:  createAppTemplateHandler,
+		Router:   r,
+	})
+
+	// GET /api/projects/{project_id}/app-templates/{app_template_name} -> app_template.NewGetAppTemplateHandler
+	getAppTemplateEndpoint := factory.NewAPIEndpoint(
+		&types.APIRequestMetadata{
+			Verb:   types.APIVerbGet,
+			Method: types.HTTPVerbGet,
+			Path: &types.Path{
+				Parent:       basePath,
+				RelativePath: fmt.Sprintf("%s/app-templates/{%s}", relPath, types.URLParamAppTemplateName),
+			},
+			Scopes: []types.PermissionScope{
+				types.UserScope,
+				types.ProjectScope,
+			},
+		},
+	)
+
+	getAppTemplateHandler := app_template.NewGetAppTemplateHandler(
+		config,
+		factory.GetResultWriter(),
+	)
+
+	routes = append(routes, &router.Route{
+		Endpoint: getAppTemplateEndpoint,
+		Handler:  getAppTemplateHandler,
+		Router:   r,
+	})
+
+	// DELETE /api/projects/{project_id}/app-templates/{app_template_name} -> app_template.NewDeleteAppTemplateHandler
+	deleteAppTemplateEndpoint := factory.NewAPIEndpoint(
+		&types.APIRequestMetadata{
+			Verb:   types.APIVerbDelete,
+			Method: types.HTTPVerbDelete,
+			Path: &types.Path{
+				Parent:       basePath,
+				RelativePath: fmt.Sprintf("%s/app-templates/{%s}", relPath, types.URLParamAppTemplateName),
+			},
+			Scopes: []types.PermissionScope{
+				types.UserScope,
+				types.ProjectScope,
+			},
+		},
+	)
+
+	deleteAppTemplateHandler := app_template.NewDeleteAppTemplateHandler(
+		config,
+		factory.GetResultWriter(),
+	)
+
+	routes = append(routes, &router.Route{
+		Endpoint: deleteAppTemplateEndpoint,
+		Handler:  deleteAppTemplateHandler,
+		Router:   r,
+	})
+
+	// POST /api/projects/{project_id}/app-templates/{app_template_name}/instantiate -> app_template.NewInstantiateAppTemplateHandler
+	instantiateAppTemplateEndpoint := factory.NewAPIEndpoint(
+		&types.APIRequestMetadata{
+			Verb:   types.APIVerbCreate,
+			Method: types.HTTPVerbPost,
+			Path: &types.Path{
+				Parent:       basePath,
+				RelativePath: fmt.Sprintf("%s/app-templates/{%s}/instantiate", relPath, types.URLParamAppTemplateName),
+			},
+			Scopes: []types.PermissionScope{
+				types.UserScope,
+				types.ProjectScope,
+			},
+		},
+	)
+
+	instantiateAppTemplateHandler := app_template.NewInstantiateAppTemplateHandler(
+		config,
+		factory.GetDecoderValidator(),
+		factory.GetResultWriter(),
+	)
+
+	routes = append(routes, &router.Route{
+		Endpoint: instantiateAppTemplateEndpoint,
+		Handler:  instantiateAppTemplateHandler,
+		Router:   r,
+	})
+
+	// POST /api/projects/{project_id}/image-attestations -> image_attestation.NewCreateImageAttestationHandler
+	createImageAttestationEndpoint := factory.NewAPIEndpoint(
+		&types.APIRequestMetadata{
+			Verb:   types.APIVerbCreate,
+			Method: types.HTTPVerbPost,
+			Path: &types.Path{
+				Parent:       basePath,
+				RelativePath: relPath + "/image-attestations",
+			},
+			Scopes: []types.PermissionScope{
+				types.UserScope,
+				types.ProjectScope,
+			},
+		},
+	)
+
+	createImageAttestationHandler := image_attestation.NewCreateImageAttestationHandler(
+		config,
+		factory.GetDecoderValidator(),
+		factory.GetResultWriter(),
+	)
+
+	routes = append(routes, &router.Route{
+		Endpoint: createImageAttestationEndpoint,
+		Handler:  createImageAttestationHandler,
+		Router:   r,
+	})
+
+	// GET /api/projects/{project_id}/image-signing-policy -> image_attestation.NewGetImageSigningPolicyHandler
+	getImageSigningPolicyEndpoint := factory.NewAPIEndpoint(
+		&types.APIRequestMetadata{
+			Verb:   types.APIVerbGet,
+			Method: types.HTTPVerbGet,
+			Path: &types.Path{
+				Parent:       basePath,
+				RelativePath: relPath + "/image-signing-policy",
+			},
+			Scopes: []types.PermissionScope{
+				types.UserScope,
+				types.ProjectScope,
+			},
+		},
+	)
+
+	getImageSigningPolicyHandler := image_attestation.NewGetImageSigningPolicyHandler(
+		config,
+		factory.GetResultWriter(),
+	)
+
+	routes = append(routes, &router.Route{
+		Endpoint: getImageSigningPolicyEndpoint,
+		Handler:  getImageSigningPolicyHandler,
+		Router:   r,
+	})
+
+	// POST /api/projects/{project_id}/image-signing-policy -> image_attestation.NewUpdateImageSigningPolicyHandler
+	updateImageSigningPolicyEndpoint := factory.NewAPIEndpoint(
+		&types.APIRequestMetadata{
+			Verb:   types.APIVerbUpdate,
+			Method: types.HTTPVerbPost,
+			Path: &types.Path{
+				Parent:       basePath,
+				RelativePath: relPath + "/image-signing-policy",
+			},
+			Scopes: []types.PermissionScope{
+				types.UserScope,
+				types.ProjectScope,
+			},
+		},
+	)
+
+	updateImageSigningPolicyHandler := image_attestation.NewUpdateImageSigningPolicyHandler(
+		config,
+		factory.GetDecoderValidator(),
+		factory.GetResultWriter(),
+	)
+
+	routes = append(routes, &router.Route{
+		Endpoint: updateImageSigningPolicyEndpoint,
+		Handler:  updateImageSigningPolicyHandler,
+		Router:   r,
+	})
+
 	// GET /api/projects/{project_id}/onboarding -> project.NewProjectGetOnboardingHandler
 	getOnboardingEndpoint := factory.NewAPIEndpoint(
 		&types.APIRequestMetadata{
@@ -375,6 +654,7 @@ func getProjectRoutes(
 				types.UserScope,
 				types.ProjectScope,
 			},
+			Response: types.ListClusterResponse{},
 		},
 	)
 
@@ -498,6 +778,35 @@ func getProjectRoutes(
 		Router:   r,
 	})
 
+	// POST /api/projects/{project_id}/transfer -> project.NewTransferProjectOwnershipHandler
+	transferOwnershipEndpoint := factory.NewAPIEndpoint(
+		&types.APIRequestMetadata{
+			Verb:   types.APIVerbUpdate,
+			Method: types.HTTPVerbPost,
+			Path: &types.Path{
+				Parent:       basePath,
+				RelativePath: relPath + "/transfer",
+			},
+			Scopes: []types.PermissionScope{
+				types.UserScope,
+				types.ProjectScope,
+				types.SettingsScope,
+			},
+		},
+	)
+
+	transferOwnershipHandler := project.NewTransferProjectOwnershipHandler(
+		config,
+		factory.GetDecoderValidator(),
+		factory.GetResultWriter(),
+	)
+
+	routes = append(routes, &router.Route{
+		Endpoint: transferOwnershipEndpoint,
+		Handler:  transferOwnershipHandler,
+		Router:   r,
+	})
+
 	// DELETE /api/projects/{project_id}/roles -> project.NewRoleDeleteHandler
 	deleteRoleEndpoint := factory.NewAPIEndpoint(
 		&types.APIRequestMetadata{
@@ -581,6 +890,89 @@ func getProjectRoutes(
 		Router:   r,
 	})
 
+	// GET /api/projects/{project_id}/registries/gc-policy -> registry.NewRegistryGetGCPolicyHandler
+	getRegistryGCPolicyEndpoint := factory.NewAPIEndpoint(
+		&types.APIRequestMetadata{
+			Verb:   types.APIVerbGet,
+			Method: types.HTTPVerbGet,
+			Path: &types.Path{
+				Parent:       basePath,
+				RelativePath: relPath + "/registries/gc-policy",
+			},
+			Scopes: []types.PermissionScope{
+				types.UserScope,
+				types.ProjectScope,
+			},
+		},
+	)
+
+	getRegistryGCPolicyHandler := registry.NewRegistryGetGCPolicyHandler(
+		config,
+		factory.GetResultWriter(),
+	)
+
+	routes = append(routes, &router.Route{
+		Endpoint: getRegistryGCPolicyEndpoint,
+		Handler:  getRegistryGCPolicyHandler,
+		Router:   r,
+	})
+
+	// POST /api/projects/{project_id}/registries/gc-policy -> registry.NewRegistryUpdateGCPolicyHandler
+	updateRegistryGCPolicyEndpoint := factory.NewAPIEndpoint(
+		&types.APIRequestMetadata{
+			Verb:   types.APIVerbUpdate,
+			Method: types.HTTPVerbPost,
+			Path: &types.Path{
+				Parent:       basePath,
+				RelativePath: relPath + "/registries/gc-policy",
+			},
+			Scopes: []types.PermissionScope{
+				types.UserScope,
+				types.ProjectScope,
+			},
+		},
+	)
+
+	updateRegistryGCPolicyHandler := registry.NewRegistryUpdateGCPolicyHandler(
+		config,
+		factory.GetDecoderValidator(),
+		factory.GetResultWriter(),
+	)
+
+	routes = append(routes, &router.Route{
+		Endpoint: updateRegistryGCPolicyEndpoint,
+		Handler:  updateRegistryGCPolicyHandler,
+		Router:   r,
+	})
+
+	// POST /api/projects/{project_id}/registries/gc -> registry.NewRegistryRunGCHandler
+	runRegistryGCEndpoint := factory.NewAPIEndpoint(
+		&types.APIRequestMetadata{
+			Verb:   types.APIVerbUpdate,
+			Method: types.HTTPVerbPost,
+			Path: &types.Path{
+				Parent:       basePath,
+				RelativePath: relPath + "/registries/gc",
+			},
+			Scopes: []types.PermissionScope{
+				types.UserScope,
+				types.ProjectScope,
+			},
+		},
+	)
+
+	runRegistryGCHandler := registry.NewRegistryRunGCHandler(
+		config,
+		factory.GetDecoderValidator(),
+		factory.GetResultWriter(),
+	)
+
+	routes = append(routes, &router.Route{
+		Endpoint: runRegistryGCEndpoint,
+		Handler:  runRegistryGCHandler,
+		Router:   r,
+	})
+
 	//  GET /api/projects/{project_id}/registries/ecr/token -> registry.NewRegistryGetECRTokenHandler
 	getECRTokenEndpoint := factory.NewAPIEndpoint(
 		&types.APIRequestMetadata{
@@ -749,6 +1141,34 @@ func getProjectRoutes(
 		Router:   r,
 	})
 
+	//  GET /api/projects/{project_id}/registries/basic/token -> registry.NewRegistryGetBasicTokenHandler
+	getBasicTokenEndpoint := factory.NewAPIEndpoint(
+		&types.APIRequestMetadata{
+			Verb:   types.APIVerbGet,
+			Method: types.HTTPVerbGet,
+			Path: &types.Path{
+				Parent:       basePath,
+				RelativePath: relPath + "/registries/basic/token",
+			},
+			Scopes: []types.PermissionScope{
+				types.UserScope,
+				types.ProjectScope,
+			},
+		},
+	)
+
+	getBasicTokenHandler := registry.NewRegistryGetBasicTokenHandler(
+		config,
+		factory.GetDecoderValidator(),
+		factory.GetResultWriter(),
+	)
+
+	routes = append(routes, &router.Route{
+		Endpoint: getBasicTokenEndpoint,
+		Handler:  getBasicTokenHandler,
+		Router:   r,
+	})
+
 	// POST /api/projects/{project_id}/infras -> infra.NewInfraCreateHandler
 	createInfraEndpoint := factory.NewAPIEndpoint(
 		&types.APIRequestMetadata{
@@ -1092,6 +1512,63 @@ func getProjectRoutes(
 		Router:   r,
 	})
 
+	//  POST /api/projects/{project_id}/policy/{policy_id} -> policy.NewPolicyUpdateHandler
+	policyUpdateEndpoint := factory.NewAPIEndpoint(
+		&types.APIRequestMetadata{
+			Verb:   types.APIVerbUpdate,
+			Method: types.HTTPVerbPost,
+			Path: &types.Path{
+				Parent:       basePath,
+				RelativePath: fmt.Sprintf("%s/policy/{%s}", relPath, types.URLParamPolicyID),
+			},
+			Scopes: []types.PermissionScope{
+				types.UserScope,
+				types.ProjectScope,
+				types.SettingsScope,
+			},
+		},
+	)
+
+	policyUpdateHandler := policy.NewPolicyUpdateHandler(
+		config,
+		factory.GetDecoderValidator(),
+		factory.GetResultWriter(),
+	)
+
+	routes = append(routes, &router.Route{
+		Endpoint: policyUpdateEndpoint,
+		Handler:  policyUpdateHandler,
+		Router:   r,
+	})
+
+	//  DELETE /api/projects/{project_id}/policy/{policy_id} -> policy.NewPolicyDeleteHandler
+	policyDeleteEndpoint := factory.NewAPIEndpoint(
+		&types.APIRequestMetadata{
+			Verb:   types.APIVerbDelete,
+			Method: types.HTTPVerbDelete,
+			Path: &types.Path{
+				Parent:       basePath,
+				RelativePath: fmt.Sprintf("%s/policy/{%s}", relPath, types.URLParamPolicyID),
+			},
+			Scopes: []types.PermissionScope{
+				types.UserScope,
+				types.ProjectScope,
+				types.SettingsScope,
+			},
+		},
+	)
+
+	policyDeleteHandler := policy.NewPolicyDeleteHandler(
+		config,
+		factory.GetResultWriter(),
+	)
+
+	routes = append(routes, &router.Route{
+		Endpoint: policyDeleteEndpoint,
+		Handler:  policyDeleteHandler,
+		Router:   r,
+	})
+
 	//  POST /api/projects/{project_id}/api_token -> api_token.NewAPITokenCreateHandler
 	apiTokenCreateEndpoint := factory.NewAPIEndpoint(
 		&types.APIRequestMetadata{
@@ -1106,6 +1583,7 @@ func getProjectRoutes(
 				types.ProjectScope,
 				types.SettingsScope,
 			},
+			RequiresMFAElevation: true,
 		},
 	)
 
@@ -1208,6 +1686,125 @@ func getProjectRoutes(
 		Router:   r,
 	})
 
+	// POST /api/projects/{project_id}/service_accounts -> project.NewServiceAccountCreateHandler
+	createServiceAccountEndpoint := factory.NewAPIEndpoint(
+		&types.APIRequestMetadata{
+			Verb:   types.APIVerbCreate,
+			Method: types.HTTPVerbPost,
+			Path: &types.Path{
+				Parent:       basePath,
+				RelativePath: relPath + "/service_accounts",
+			},
+			Scopes: []types.PermissionScope{
+				types.UserScope,
+				types.ProjectScope,
+				types.SettingsScope,
+			},
+			RequiresMFAElevation: true,
+			Request:              &types.CreateServiceAccountRequest{},
+			Response:             &types.CreateServiceAccountResponse{},
+		},
+	)
+
+	createServiceAccountHandler := project.NewServiceAccountCreateHandler(
+		config,
+		factory.GetDecoderValidator(),
+		factory.GetResultWriter(),
+	)
+
+	routes = append(routes, &router.Route{
+		Endpoint: createServiceAccountEndpoint,
+		Handler:  createServiceAccountHandler,
+		Router:   r,
+	})
+
+	// GET /api/projects/{project_id}/service_accounts -> project.NewServiceAccountListHandler
+	listServiceAccountsEndpoint := factory.NewAPIEndpoint(
+		&types.APIRequestMetadata{
+			Verb:   types.APIVerbList,
+			Method: types.HTTPVerbGet,
+			Path: &types.Path{
+				Parent:       basePath,
+				RelativePath: relPath + "/service_accounts",
+			},
+			Scopes: []types.PermissionScope{
+				types.UserScope,
+				types.ProjectScope,
+				types.SettingsScope,
+			},
+			Response: types.ListServiceAccountsResponse{},
+		},
+	)
+
+	listServiceAccountsHandler := project.NewServiceAccountListHandler(
+		config,
+		factory.GetResultWriter(),
+	)
+
+	routes = append(routes, &router.Route{
+		Endpoint: listServiceAccountsEndpoint,
+		Handler:  listServiceAccountsHandler,
+		Router:   r,
+	})
+
+	// POST /api/projects/{project_id}/service_accounts/{service_account_id}/token -> project.NewServiceAccountTokenRotateHandler
+	rotateServiceAccountTokenEndpoint := factory.NewAPIEndpoint(
+		&types.APIRequestMetadata{
+			Verb:   types.APIVerbUpdate,
+			Method: types.HTTPVerbPost,
+			Path: &types.Path{
+				Parent:       basePath,
+				RelativePath: fmt.Sprintf("%s/service_accounts/{%s}/token", relPath, types.URLParamServiceAccountID),
+			},
+			Scopes: []types.PermissionScope{
+				types.UserScope,
+				types.ProjectScope,
+				types.SettingsScope,
+			},
+			RequiresMFAElevation: true,
+			Response:             &types.RotateServiceAccountTokenResponse{},
+		},
+	)
+
+	rotateServiceAccountTokenHandler := project.NewServiceAccountTokenRotateHandler(
+		config,
+		factory.GetResultWriter(),
+	)
+
+	routes = append(routes, &router.Route{
+		Endpoint: rotateServiceAccountTokenEndpoint,
+		Handler:  rotateServiceAccountTokenHandler,
+		Router:   r,
+	})
+
+	// DELETE /api/projects/{project_id}/service_accounts/{service_account_id} -> project.NewServiceAccountDeleteHandler
+	deleteServiceAccountEndpoint := factory.NewAPIEndpoint(
+		&types.APIRequestMetadata{
+			Verb:   types.APIVerbDelete,
+			Method: types.HTTPVerbDelete,
+			Path: &types.Path{
+				Parent:       basePath,
+				RelativePath: fmt.Sprintf("%s/service_accounts/{%s}", relPath, types.URLParamServiceAccountID),
+			},
+			Scopes: []types.PermissionScope{
+				types.UserScope,
+				types.ProjectScope,
+				types.SettingsScope,
+			},
+		},
+	)
+
+	deleteServiceAccountHandler := project.NewServiceAccountDeleteHandler(
+		config,
+		factory.GetResultWriter(),
+	)
+
+	routes = append(routes, &router.Route{
+		Endpoint: deleteServiceAccountEndpoint,
+		Handler:  deleteServiceAccountHandler,
+		Router:   r,
+	})
+
 	//  POST /api/projects/{project_id}/helmrepos -> helmrepo.NewHelmRepoCreateHandler
 	hrCreateEndpoint := factory.NewAPIEndpoint(
 		&types.APIRequestMetadata{
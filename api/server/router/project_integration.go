@@ -122,6 +122,7 @@ func getProjectIntegrationRoutes(
 				types.UserScope,
 				types.ProjectScope,
 			},
+			RequiresMFAElevation: true,
 		},
 	)
 
@@ -150,6 +151,7 @@ func getProjectIntegrationRoutes(
 				types.UserScope,
 				types.ProjectScope,
 			},
+			RequiresMFAElevation: true,
 		},
 	)
 
@@ -205,6 +207,7 @@ func getProjectIntegrationRoutes(
 				types.UserScope,
 				types.ProjectScope,
 			},
+			RequiresMFAElevation: true,
 		},
 	)
 
@@ -316,6 +319,7 @@ func getProjectIntegrationRoutes(
 				types.UserScope,
 				types.ProjectScope,
 			},
+			RequiresMFAElevation: true,
 		},
 	)
 
@@ -371,6 +375,7 @@ func getProjectIntegrationRoutes(
 				types.UserScope,
 				types.ProjectScope,
 			},
+			RequiresMFAElevation: true,
 		},
 	)
 
@@ -426,6 +431,7 @@ func getProjectIntegrationRoutes(
 				types.UserScope,
 				types.ProjectScope,
 			},
+			RequiresMFAElevation: true,
 		},
 	)
 
@@ -457,6 +463,7 @@ func getProjectIntegrationRoutes(
 				types.ProjectScope,
 				types.GitlabIntegrationScope,
 			},
+			RequiresMFAElevation: true,
 		},
 	)
 
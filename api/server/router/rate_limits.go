@@ -0,0 +1,26 @@
+package router
+
+import (
+	"time"
+
+	"github.com/porter-dev/porter/api/types"
+)
+
+// The default rate limits of endpoints which are targets for abuse. Each limit can be overridden by name with the
+// RATE_LIMIT_OVERRIDES server setting.
+var (
+	// loginRateLimit limits password guessing against the login endpoint
+	loginRateLimit = &types.RateLimit{Name: "login", Key: types.RateLimitKeyIP, Requests: 10, Window: time.Minute}
+
+	// signupRateLimit limits the creation of accounts from a single address
+	signupRateLimit = &types.RateLimit{Name: "signup", Key: types.RateLimitKeyIP, Requests: 20, Window: time.Hour}
+
+	// passwordResetRateLimit limits the password reset emails sent from a single address
+	passwordResetRateLimit = &types.RateLimit{Name: "password-reset", Key: types.RateLimitKeyIP, Requests: 10, Window: time.Hour}
+
+	// mfaVerifyRateLimit limits guessing of multi-factor authentication codes by a logged in user
+	mfaVerifyRateLimit = &types.RateLimit{Name: "mfa-verify", Key: types.RateLimitKeyUser, Requests: 10, Window: 5 * time.Minute}
+
+	// kubeconfigUploadRateLimit limits the kubeconfigs uploaded to a project, which are parsed and stored as cluster candidates
+	kubeconfigUploadRateLimit = &types.RateLimit{Name: "kubeconfig-upload", Key: types.RateLimitKeyProject, Requests: 20, Window: time.Hour}
+)
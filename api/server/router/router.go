@@ -11,10 +11,12 @@ import (
 	"github.com/porter-dev/porter/api/server/authn"
 	"github.com/porter-dev/porter/api/server/authz"
 	"github.com/porter-dev/porter/api/server/authz/policy"
+	"github.com/porter-dev/porter/api/server/handlers/rpc"
 	"github.com/porter-dev/porter/api/server/router/middleware"
 	v1 "github.com/porter-dev/porter/api/server/router/v1"
 	"github.com/porter-dev/porter/api/server/shared"
 	"github.com/porter-dev/porter/api/server/shared/config"
+	"github.com/porter-dev/porter/api/server/shared/openapi"
 	"github.com/porter-dev/porter/api/server/shared/router"
 	"github.com/porter-dev/porter/api/types"
 	"github.com/riandyrn/otelchi"
@@ -32,7 +34,8 @@ func NewAPIRouter(config *config.Config) *chi.Mux {
 	namespaceRegisterer := NewNamespaceScopedRegisterer(releaseRegisterer)
 	clusterIntegrationRegisterer := NewClusterIntegrationScopedRegisterer()
 	stackRegisterer := NewPorterAppScopedRegisterer()
-	clusterRegisterer := NewClusterScopedRegisterer(namespaceRegisterer, clusterIntegrationRegisterer, stackRegisterer)
+	addonRegisterer := NewAddonScopedRegisterer()
+	clusterRegisterer := NewClusterScopedRegisterer(namespaceRegisterer, clusterIntegrationRegisterer, stackRegisterer, addonRegisterer)
 	infraRegisterer := NewInfraScopedRegisterer()
 	gitInstallationRegisterer := NewGitInstallationScopedRegisterer()
 	registryRegisterer := NewRegistryScopedRegisterer()
@@ -41,6 +44,12 @@ func NewAPIRouter(config *config.Config) *chi.Mux {
 	projectIntegrationRegisterer := NewProjectIntegrationScopedRegisterer()
 	projectOAuthRegisterer := NewProjectOAuthScopedRegisterer()
 	slackIntegrationRegisterer := NewSlackIntegrationScopedRegisterer()
+	projectWebhookRegisterer := NewProjectWebhookScopedRegisterer()
+	alertRuleRegisterer := NewAlertRuleScopedRegisterer()
+	eventSinkRegisterer := NewEventSinkScopedRegisterer()
+	datastoreRegisterer := NewDatastoreScopedRegisterer()
+	subdomainRegisterer := NewSubdomainScopedRegisterer()
+	scimRegisterer := NewSCIMScopedRegisterer()
 	projRegisterer := NewProjectScopedRegisterer(
 		clusterRegisterer,
 		registryRegisterer,
@@ -51,12 +60,21 @@ func NewAPIRouter(config *config.Config) *chi.Mux {
 		projectIntegrationRegisterer,
 		projectOAuthRegisterer,
 		slackIntegrationRegisterer,
+		projectWebhookRegisterer,
+		alertRuleRegisterer,
+		eventSinkRegisterer,
+		datastoreRegisterer,
+		subdomainRegisterer,
+		scimRegisterer,
 	)
 	statusRegisterer := NewStatusScopedRegisterer()
 
 	userRegisterer := NewUserScopedRegisterer(projRegisterer, statusRegisterer)
 	panicMW := middleware.NewPanicMiddleware(config)
 
+	// routes registered on the API routers, which are described by the OpenAPI document
+	var documentedRoutes []*router.Route
+
 	if config.ServerConf.PprofEnabled {
 		r.Mount("/debug", chiMiddleware.Profiler())
 	}
@@ -113,6 +131,10 @@ func NewAPIRouter(config *config.Config) *chi.Mux {
 		}
 
 		registerRoutes(config, allRoutes)
+		documentedRoutes = append(documentedRoutes, allRoutes...)
+
+		// the Connect API serves the core project, cluster and app operations to RPC clients
+		r.Mount("/rpc", http.StripPrefix("/api/rpc", rpc.NewHandler(config)))
 	})
 
 	r.Route("/api/v1", func(r chi.Router) {
@@ -157,8 +179,20 @@ func NewAPIRouter(config *config.Config) *chi.Mux {
 		allRoutes = append(allRoutes, v1Routes...)
 
 		registerRoutes(config, allRoutes)
+		documentedRoutes = append(documentedRoutes, allRoutes...)
 	})
 
+	// GET /swagger.json -> openapi.NewHandler
+	r.Method(http.MethodGet, "/swagger.json", openapi.NewHandler(config, openapi.GenerateOpts{
+		Info: openapi.Info{
+			Title:   "Porter API",
+			Version: apiVersion(config),
+		},
+		Mux:               r,
+		Routes:            documentedRoutes,
+		SessionCookieName: config.ServerConf.CookieName,
+	}))
+
 	staticFilePath := config.ServerConf.StaticFilePath
 	fs := http.FileServer(http.Dir(staticFilePath))
 
@@ -183,6 +217,15 @@ func NewAPIRouter(config *config.Config) *chi.Mux {
 	return r
 }
 
+// apiVersion returns the version of the server, which versions the OpenAPI document
+func apiVersion(config *config.Config) string {
+	if config.Metadata == nil || config.Metadata.Version == "" {
+		return "dev"
+	}
+
+	return config.Metadata.Version
+}
+
 func registerRoutes(config *config.Config, routes []*router.Route) {
 	// Create a new "user-scoped" factory which will create a new user-scoped request
 	// after authentication. Each subsequent http.Handler can lookup the user in context.
@@ -241,6 +284,9 @@ func registerRoutes(config *config.Config, routes []*router.Route) {
 	// websocket middleware for upgrading requests
 	websocketMw := middleware.NewWebsocketMiddleware(config)
 
+	// mfa elevation middleware for sensitive operations
+	mfaElevationMw := middleware.NewMFAElevationMiddleware(config)
+
 	// gitlab integration middleware to handle gitlab integrations for a specific project
 	gitlabIntFactory := authz.NewGitlabIntegrationScopedFactory(config)
 
@@ -308,6 +354,15 @@ func registerRoutes(config *config.Config, routes []*router.Route) {
 			atomicGroup.Use(usageMW.Middleware)
 		}
 
+		if route.Endpoint.Metadata.RateLimit != nil && config.RateLimiter != nil {
+			rateLimitMw := middleware.NewRateLimitMiddleware(config, *route.Endpoint.Metadata.RateLimit)
+			atomicGroup.Use(rateLimitMw.Middleware)
+		}
+
+		if route.Endpoint.Metadata.RequiresMFAElevation {
+			atomicGroup.Use(mfaElevationMw.Middleware)
+		}
+
 		atomicGroup.Use(middleware.HydrateTraces)
 
 		atomicGroup.Method(
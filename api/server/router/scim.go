@@ -0,0 +1,493 @@
+package router
+
+import (
+	"fmt"
+
+	"github.com/go-chi/chi/v5"
+	"github.com/porter-dev/porter/api/server/handlers/scim"
+	"github.com/porter-dev/porter/api/server/shared"
+	"github.com/porter-dev/porter/api/server/shared/config"
+	"github.com/porter-dev/porter/api/server/shared/router"
+	"github.com/porter-dev/porter/api/types"
+)
+
+// NewSCIMScopedRegisterer returns a registerer for the SCIM provisioning routes
+func NewSCIMScopedRegisterer(children ...*router.Registerer) *router.Registerer {
+	return &router.Registerer{
+		GetRoutes: GetSCIMScopedRoutes,
+		Children:  children,
+	}
+}
+
+// GetSCIMScopedRoutes returns the SCIM provisioning routes and the routes of any children
+func GetSCIMScopedRoutes(
+	r chi.Router,
+	config *config.Config,
+	basePath *types.Path,
+	factory shared.APIEndpointFactory,
+	children ...*router.Registerer,
+) []*router.Route {
+	routes, scimPath := getSCIMRoutes(r, config, basePath, factory)
+
+	if len(children) > 0 {
+		r.Route(scimPath.RelativePath, func(r chi.Router) {
+			for _, child := range children {
+				childRoutes := child.GetRoutes(r, config, basePath, factory, child.Children...)
+
+				routes = append(routes, childRoutes...)
+			}
+		})
+	}
+
+	return routes
+}
+
+// getSCIMRoutes returns the routes used by identity providers to provision users and groups, and the routes which map
+// provisioned groups to project roles. All routes require the settings scope, so they can only be called by project admins
+// and admin API tokens.
+func getSCIMRoutes(
+	r chi.Router,
+	config *config.Config,
+	basePath *types.Path,
+	factory shared.APIEndpointFactory,
+) ([]*router.Route, *types.Path) {
+	relPath := "/scim"
+
+	newPath := &types.Path{
+		Parent:       basePath,
+		RelativePath: relPath,
+	}
+
+	routes := make([]*router.Route, 0)
+
+	// GET /api/projects/{project_id}/scim/v2/Users -> scim.NewListUsersHandler
+	listUsersEndpoint := factory.NewAPIEndpoint(
+		&types.APIRequestMetadata{
+			Verb:   types.APIVerbList,
+			Method: types.HTTPVerbGet,
+			Path: &types.Path{
+				Parent:       basePath,
+				RelativePath: relPath + "/v2/Users",
+			},
+			Scopes: []types.PermissionScope{
+				types.UserScope,
+				types.ProjectScope,
+				types.SettingsScope,
+			},
+		},
+	)
+
+	listUsersHandler := scim.NewListUsersHandler(
+		config,
+		factory.GetDecoderValidator(),
+		factory.GetResultWriter(),
+	)
+
+	routes = append(routes, &router.Route{
+		Endpoint: listUsersEndpoint,
+		Handler:  listUsersHandler,
+		Router:   r,
+	})
+
+	// POST /api/projects/{project_id}/scim/v2/Users -> scim.NewCreateUserHandler
+	createUserEndpoint := factory.NewAPIEndpoint(
+		&types.APIRequestMetadata{
+			Verb:   types.APIVerbCreate,
+			Method: types.HTTPVerbPost,
+			Path: &types.Path{
+				Parent:       basePath,
+				RelativePath: relPath + "/v2/Users",
+			},
+			Scopes: []types.PermissionScope{
+				types.UserScope,
+				types.ProjectScope,
+				types.SettingsScope,
+			},
+		},
+	)
+
+	createUserHandler := scim.NewCreateUserHandler(
+		config,
+		factory.GetDecoderValidator(),
+		factory.GetResultWriter(),
+	)
+
+	routes = append(routes, &router.Route{
+		Endpoint: createUserEndpoint,
+		Handler:  createUserHandler,
+		Router:   r,
+	})
+
+	// GET /api/projects/{project_id}/scim/v2/Users/{scim_user_id} -> scim.NewGetUserHandler
+	getUserEndpoint := factory.NewAPIEndpoint(
+		&types.APIRequestMetadata{
+			Verb:   types.APIVerbGet,
+			Method: types.HTTPVerbGet,
+			Path: &types.Path{
+				Parent:       basePath,
+				RelativePath: fmt.Sprintf("%s/v2/Users/{%s}", relPath, types.URLParamSCIMUserID),
+			},
+			Scopes: []types.PermissionScope{
+				types.UserScope,
+				types.ProjectScope,
+				types.SettingsScope,
+			},
+		},
+	)
+
+	getUserHandler := scim.NewGetUserHandler(
+		config,
+		factory.GetResultWriter(),
+	)
+
+	routes = append(routes, &router.Route{
+		Endpoint: getUserEndpoint,
+		Handler:  getUserHandler,
+		Router:   r,
+	})
+
+	// PUT /api/projects/{project_id}/scim/v2/Users/{scim_user_id} -> scim.NewReplaceUserHandler
+	replaceUserEndpoint := factory.NewAPIEndpoint(
+		&types.APIRequestMetadata{
+			Verb:   types.APIVerbUpdate,
+			Method: types.HTTPVerbPut,
+			Path: &types.Path{
+				Parent:       basePath,
+				RelativePath: fmt.Sprintf("%s/v2/Users/{%s}", relPath, types.URLParamSCIMUserID),
+			},
+			Scopes: []types.PermissionScope{
+				types.UserScope,
+				types.ProjectScope,
+				types.SettingsScope,
+			},
+		},
+	)
+
+	replaceUserHandler := scim.NewReplaceUserHandler(
+		config,
+		factory.GetDecoderValidator(),
+		factory.GetResultWriter(),
+	)
+
+	routes = append(routes, &router.Route{
+		Endpoint: replaceUserEndpoint,
+		Handler:  replaceUserHandler,
+		Router:   r,
+	})
+
+	// PATCH /api/projects/{project_id}/scim/v2/Users/{scim_user_id} -> scim.NewPatchUserHandler
+	patchUserEndpoint := factory.NewAPIEndpoint(
+		&types.APIRequestMetadata{
+			Verb:   types.APIVerbUpdate,
+			Method: types.HTTPVerbPatch,
+			Path: &types.Path{
+				Parent:       basePath,
+				RelativePath: fmt.Sprintf("%s/v2/Users/{%s}", relPath, types.URLParamSCIMUserID),
+			},
+			Scopes: []types.PermissionScope{
+				types.UserScope,
+				types.ProjectScope,
+				types.SettingsScope,
+			},
+		},
+	)
+
+	patchUserHandler := scim.NewPatchUserHandler(
+		config,
+		factory.GetDecoderValidator(),
+		factory.GetResultWriter(),
+	)
+
+	routes = append(routes, &router.Route{
+		Endpoint: patchUserEndpoint,
+		Handler:  patchUserHandler,
+		Router:   r,
+	})
+
+	// DELETE /api/projects/{project_id}/scim/v2/Users/{scim_user_id} -> scim.NewDeleteUserHandler
+	deleteUserEndpoint := factory.NewAPIEndpoint(
+		&types.APIRequestMetadata{
+			Verb:   types.APIVerbDelete,
+			Method: types.HTTPVerbDelete,
+			Path: &types.Path{
+				Parent:       basePath,
+				RelativePath: fmt.Sprintf("%s/v2/Users/{%s}", relPath, types.URLParamSCIMUserID),
+			},
+			Scopes: []types.PermissionScope{
+				types.UserScope,
+				types.ProjectScope,
+				types.SettingsScope,
+			},
+		},
+	)
+
+	deleteUserHandler := scim.NewDeleteUserHandler(
+		config,
+		factory.GetResultWriter(),
+	)
+
+	routes = append(routes, &router.Route{
+		Endpoint: deleteUserEndpoint,
+		Handler:  deleteUserHandler,
+		Router:   r,
+	})
+
+	// GET /api/projects/{project_id}/scim/v2/Groups -> scim.NewListGroupsHandler
+	listGroupsEndpoint := factory.NewAPIEndpoint(
+		&types.APIRequestMetadata{
+			Verb:   types.APIVerbList,
+			Method: types.HTTPVerbGet,
+			Path: &types.Path{
+				Parent:       basePath,
+				RelativePath: relPath + "/v2/Groups",
+			},
+			Scopes: []types.PermissionScope{
+				types.UserScope,
+				types.ProjectScope,
+				types.SettingsScope,
+			},
+		},
+	)
+
+	listGroupsHandler := scim.NewListGroupsHandler(
+		config,
+		factory.GetDecoderValidator(),
+		factory.GetResultWriter(),
+	)
+
+	routes = append(routes, &router.Route{
+		Endpoint: listGroupsEndpoint,
+		Handler:  listGroupsHandler,
+		Router:   r,
+	})
+
+	// POST /api/projects/{project_id}/scim/v2/Groups -> scim.NewCreateGroupHandler
+	createGroupEndpoint := factory.NewAPIEndpoint(
+		&types.APIRequestMetadata{
+			Verb:   types.APIVerbCreate,
+			Method: types.HTTPVerbPost,
+			Path: &types.Path{
+				Parent:       basePath,
+				RelativePath: relPath + "/v2/Groups",
+			},
+			Scopes: []types.PermissionScope{
+				types.UserScope,
+				types.ProjectScope,
+				types.SettingsScope,
+			},
+		},
+	)
+
+	createGroupHandler := scim.NewCreateGroupHandler(
+		config,
+		factory.GetDecoderValidator(),
+		factory.GetResultWriter(),
+	)
+
+	routes = append(routes, &router.Route{
+		Endpoint: createGroupEndpoint,
+		Handler:  createGroupHandler,
+		Router:   r,
+	})
+
+	// GET /api/projects/{project_id}/scim/v2/Groups/{scim_group_id} -> scim.NewGetGroupHandler
+	getGroupEndpoint := factory.NewAPIEndpoint(
+		&types.APIRequestMetadata{
+			Verb:   types.APIVerbGet,
+			Method: types.HTTPVerbGet,
+			Path: &types.Path{
+				Parent:       basePath,
+				RelativePath: fmt.Sprintf("%s/v2/Groups/{%s}", relPath, types.URLParamSCIMGroupID),
+			},
+			Scopes: []types.PermissionScope{
+				types.UserScope,
+				types.ProjectScope,
+				types.SettingsScope,
+			},
+		},
+	)
+
+	getGroupHandler := scim.NewGetGroupHandler(
+		config,
+		factory.GetResultWriter(),
+	)
+
+	routes = append(routes, &router.Route{
+		Endpoint: getGroupEndpoint,
+		Handler:  getGroupHandler,
+		Router:   r,
+	})
+
+	// PUT /api/projects/{project_id}/scim/v2/Groups/{scim_group_id} -> scim.NewReplaceGroupHandler
+	replaceGroupEndpoint := factory.NewAPIEndpoint(
+		&types.APIRequestMetadata{
+			Verb:   types.APIVerbUpdate,
+			Method: types.HTTPVerbPut,
+			Path: &types.Path{
+				Parent:       basePath,
+				RelativePath: fmt.Sprintf("%s/v2/Groups/{%s}", relPath, types.URLParamSCIMGroupID),
+			},
+			Scopes: []types.PermissionScope{
+				types.UserScope,
+				types.ProjectScope,
+				types.SettingsScope,
+			},
+		},
+	)
+
+	replaceGroupHandler := scim.NewReplaceGroupHandler(
+		config,
+		factory.GetDecoderValidator(),
+		factory.GetResultWriter(),
+	)
+
+	routes = append(routes, &router.Route{
+		Endpoint: replaceGroupEndpoint,
+		Handler:  replaceGroupHandler,
+		Router:   r,
+	})
+
+	// PATCH /api/projects/{project_id}/scim/v2/Groups/{scim_group_id} -> scim.NewPatchGroupHandler
+	patchGroupEndpoint := factory.NewAPIEndpoint(
+		&types.APIRequestMetadata{
+			Verb:   types.APIVerbUpdate,
+			Method: types.HTTPVerbPatch,
+			Path: &types.Path{
+				Parent:       basePath,
+				RelativePath: fmt.Sprintf("%s/v2/Groups/{%s}", relPath, types.URLParamSCIMGroupID),
+			},
+			Scopes: []types.PermissionScope{
+				types.UserScope,
+				types.ProjectScope,
+				types.SettingsScope,
+			},
+		},
+	)
+
+	patchGroupHandler := scim.NewPatchGroupHandler(
+		config,
+		factory.GetDecoderValidator(),
+		factory.GetResultWriter(),
+	)
+
+	routes = append(routes, &router.Route{
+		Endpoint: patchGroupEndpoint,
+		Handler:  patchGroupHandler,
+		Router:   r,
+	})
+
+	// DELETE /api/projects/{project_id}/scim/v2/Groups/{scim_group_id} -> scim.NewDeleteGroupHandler
+	deleteGroupEndpoint := factory.NewAPIEndpoint(
+		&types.APIRequestMetadata{
+			Verb:   types.APIVerbDelete,
+			Method: types.HTTPVerbDelete,
+			Path: &types.Path{
+				Parent:       basePath,
+				RelativePath: fmt.Sprintf("%s/v2/Groups/{%s}", relPath, types.URLParamSCIMGroupID),
+			},
+			Scopes: []types.PermissionScope{
+				types.UserScope,
+				types.ProjectScope,
+				types.SettingsScope,
+			},
+		},
+	)
+
+	deleteGroupHandler := scim.NewDeleteGroupHandler(
+		config,
+		factory.GetResultWriter(),
+	)
+
+	routes = append(routes, &router.Route{
+		Endpoint: deleteGroupEndpoint,
+		Handler:  deleteGroupHandler,
+		Router:   r,
+	})
+
+	// GET /api/projects/{project_id}/scim/group-role-mappings -> scim.NewListGroupRoleMappingsHandler
+	listGroupRoleMappingsEndpoint := factory.NewAPIEndpoint(
+		&types.APIRequestMetadata{
+			Verb:   types.APIVerbList,
+			Method: types.HTTPVerbGet,
+			Path: &types.Path{
+				Parent:       basePath,
+				RelativePath: relPath + "/group-role-mappings",
+			},
+			Scopes: []types.PermissionScope{
+				types.UserScope,
+				types.ProjectScope,
+				types.SettingsScope,
+			},
+		},
+	)
+
+	listGroupRoleMappingsHandler := scim.NewListGroupRoleMappingsHandler(
+		config,
+		factory.GetResultWriter(),
+	)
+
+	routes = append(routes, &router.Route{
+		Endpoint: listGroupRoleMappingsEndpoint,
+		Handler:  listGroupRoleMappingsHandler,
+		Router:   r,
+	})
+
+	// POST /api/projects/{project_id}/scim/group-role-mappings -> scim.NewCreateGroupRoleMappingHandler
+	createGroupRoleMappingEndpoint := factory.NewAPIEndpoint(
+		&types.APIRequestMetadata{
+			Verb:   types.APIVerbCreate,
+			Method: types.HTTPVerbPost,
+			Path: &types.Path{
+				Parent:       basePath,
+				RelativePath: relPath + "/group-role-mappings",
+			},
+			Scopes: []types.PermissionScope{
+				types.UserScope,
+				types.ProjectScope,
+				types.SettingsScope,
+			},
+		},
+	)
+
+	createGroupRoleMappingHandler := scim.NewCreateGroupRoleMappingHandler(
+		config,
+		factory.GetDecoderValidator(),
+		factory.GetResultWriter(),
+	)
+
+	routes = append(routes, &router.Route{
+		Endpoint: createGroupRoleMappingEndpoint,
+		Handler:  createGroupRoleMappingHandler,
+		Router:   r,
+	})
+
+	// DELETE /api/projects/{project_id}/scim/group-role-mappings/{scim_group_role_mapping_id} -> scim.NewDeleteGroupRoleMappingHandler
+	deleteGroupRoleMappingEndpoint := factory.NewAPIEndpoint(
+		&types.APIRequestMetadata{
+			Verb:   types.APIVerbDelete,
+			Method: types.HTTPVerbDelete,
+			Path: &types.Path{
+				Parent:       basePath,
+				RelativePath: fmt.Sprintf("%s/group-role-mappings/{%s}", relPath, types.URLParamSCIMGroupRoleMappingID),
+			},
+			Scopes: []types.PermissionScope{
+				types.UserScope,
+				types.ProjectScope,
+				types.SettingsScope,
+			},
+		},
+	)
+
+	deleteGroupRoleMappingHandler := scim.NewDeleteGroupRoleMappingHandler(
+		config,
+		factory.GetResultWriter(),
+	)
+
+	routes = append(routes, &router.Route{
+		Endpoint: deleteGroupRoleMappingEndpoint,
+		Handler:  deleteGroupRoleMappingHandler,
+		Router:   r,
+	})
+
+	return routes, newPath
+}
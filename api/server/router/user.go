@@ -179,7 +179,8 @@ func getUserRoutes(
 				Parent:       basePath,
 				RelativePath: "/users/current",
 			},
-			Scopes: []types.PermissionScope{types.UserScope},
+			Scopes:               []types.PermissionScope{types.UserScope},
+			RequiresMFAElevation: true,
 		},
 	)
 
@@ -194,6 +195,156 @@ func getUserRoutes(
 		Router:   r,
 	})
 
+	// GET /api/users/current/mfa -> user.NewUserGetMFAHandler
+	getMFAEndpoint := factory.NewAPIEndpoint(
+		&types.APIRequestMetadata{
+			Verb:   types.APIVerbGet,
+			Method: types.HTTPVerbGet,
+			Path: &types.Path{
+				Parent:       basePath,
+				RelativePath: "/users/current/mfa",
+			},
+			Scopes: []types.PermissionScope{types.UserScope},
+		},
+	)
+
+	getMFAHandler := user.NewUserGetMFAHandler(
+		config,
+		factory.GetResultWriter(),
+	)
+
+	routes = append(routes, &router.Route{
+		Endpoint: getMFAEndpoint,
+		Handler:  getMFAHandler,
+		Router:   r,
+	})
+
+	// POST /api/users/current/mfa/enroll -> user.NewUserEnrollMFAHandler
+	enrollMFAEndpoint := factory.NewAPIEndpoint(
+		&types.APIRequestMetadata{
+			Verb:   types.APIVerbCreate,
+			Method: types.HTTPVerbPost,
+			Path: &types.Path{
+				Parent:       basePath,
+				RelativePath: "/users/current/mfa/enroll",
+			},
+			Scopes: []types.PermissionScope{types.UserScope},
+		},
+	)
+
+	enrollMFAHandler := user.NewUserEnrollMFAHandler(
+		config,
+		factory.GetResultWriter(),
+	)
+
+	routes = append(routes, &router.Route{
+		Endpoint: enrollMFAEndpoint,
+		Handler:  enrollMFAHandler,
+		Router:   r,
+	})
+
+	// POST /api/users/current/mfa/activate -> user.NewUserActivateMFAHandler
+	activateMFAEndpoint := factory.NewAPIEndpoint(
+		&types.APIRequestMetadata{
+			Verb:   types.APIVerbUpdate,
+			Method: types.HTTPVerbPost,
+			Path: &types.Path{
+				Parent:       basePath,
+				RelativePath: "/users/current/mfa/activate",
+			},
+			Scopes:    []types.PermissionScope{types.UserScope},
+			RateLimit: mfaVerifyRateLimit,
+		},
+	)
+
+	activateMFAHandler := user.NewUserActivateMFAHandler(
+		config,
+		factory.GetDecoderValidator(),
+		factory.GetResultWriter(),
+	)
+
+	routes = append(routes, &router.Route{
+		Endpoint: activateMFAEndpoint,
+		Handler:  activateMFAHandler,
+		Router:   r,
+	})
+
+	// POST /api/users/current/mfa/elevate -> user.NewUserElevateMFAHandler
+	elevateMFAEndpoint := factory.NewAPIEndpoint(
+		&types.APIRequestMetadata{
+			Verb:   types.APIVerbUpdate,
+			Method: types.HTTPVerbPost,
+			Path: &types.Path{
+				Parent:       basePath,
+				RelativePath: "/users/current/mfa/elevate",
+			},
+			Scopes:    []types.PermissionScope{types.UserScope},
+			RateLimit: mfaVerifyRateLimit,
+		},
+	)
+
+	elevateMFAHandler := user.NewUserElevateMFAHandler(
+		config,
+		factory.GetDecoderValidator(),
+		factory.GetResultWriter(),
+	)
+
+	routes = append(routes, &router.Route{
+		Endpoint: elevateMFAEndpoint,
+		Handler:  elevateMFAHandler,
+		Router:   r,
+	})
+
+	// POST /api/users/current/mfa/recovery-codes -> user.NewUserRegenerateMFARecoveryCodesHandler
+	regenerateMFARecoveryCodesEndpoint := factory.NewAPIEndpoint(
+		&types.APIRequestMetadata{
+			Verb:   types.APIVerbUpdate,
+			Method: types.HTTPVerbPost,
+			Path: &types.Path{
+				Parent:       basePath,
+				RelativePath: "/users/current/mfa/recovery-codes",
+			},
+			Scopes:               []types.PermissionScope{types.UserScope},
+			RequiresMFAElevation: true,
+		},
+	)
+
+	regenerateMFARecoveryCodesHandler := user.NewUserRegenerateMFARecoveryCodesHandler(
+		config,
+		factory.GetResultWriter(),
+	)
+
+	routes = append(routes, &router.Route{
+		Endpoint: regenerateMFARecoveryCodesEndpoint,
+		Handler:  regenerateMFARecoveryCodesHandler,
+		Router:   r,
+	})
+
+	// DELETE /api/users/current/mfa -> user.NewUserDisableMFAHandler
+	disableMFAEndpoint := factory.NewAPIEndpoint(
+		&types.APIRequestMetadata{
+			Verb:   types.APIVerbDelete,
+			Method: types.HTTPVerbDelete,
+			Path: &types.Path{
+				Parent:       basePath,
+				RelativePath: "/users/current/mfa",
+			},
+			Scopes:               []types.PermissionScope{types.UserScope},
+			RequiresMFAElevation: true,
+		},
+	)
+
+	disableMFAHandler := user.NewUserDisableMFAHandler(
+		config,
+		factory.GetResultWriter(),
+	)
+
+	routes = append(routes, &router.Route{
+		Endpoint: disableMFAEndpoint,
+		Handler:  disableMFAHandler,
+		Router:   r,
+	})
+
 	// POST /api/projects -> project.NewProjectCreateHandler
 	createEndpoint := factory.NewAPIEndpoint(
 		&types.APIRequestMetadata{
@@ -203,7 +354,9 @@ func getUserRoutes(
 				Parent:       basePath,
 				RelativePath: "/projects",
 			},
-			Scopes: []types.PermissionScope{types.UserScope},
+			Scopes:   []types.PermissionScope{types.UserScope},
+			Request:  &types.CreateProjectRequest{},
+			Response: &types.CreateProjectResponse{},
 		},
 	)
 
@@ -228,7 +381,8 @@ func getUserRoutes(
 				Parent:       basePath,
 				RelativePath: "/projects",
 			},
-			Scopes: []types.PermissionScope{types.UserScope},
+			Scopes:   []types.PermissionScope{types.UserScope},
+			Response: types.ListUserProjectsResponse{},
 		},
 	)
 
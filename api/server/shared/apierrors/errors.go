@@ -118,7 +118,8 @@ func (e *ErrNotFound) GetStatusCode() int {
 }
 
 type ErrorOpts struct {
-	Code uint
+	Code    uint
+	Details any
 }
 
 func HandleAPIError(
@@ -162,6 +163,7 @@ func HandleAPIError(
 
 		if len(opts) > 0 {
 			resp.Code = opts[0].Code
+			resp.Details = opts[0].Details
 		}
 
 		// write the status code
@@ -1,6 +1,7 @@
 package apitest
 
 import (
+	"context"
 	"testing"
 
 	"github.com/porter-dev/porter/api/server/shared/config"
@@ -11,7 +12,7 @@ import (
 func CreateTestUser(t *testing.T, config *config.Config, verified bool) *models.User {
 	hashedPw, _ := bcrypt.GenerateFromPassword([]byte("hello"), 8)
 
-	user, err := config.Repo.User().CreateUser(&models.User{
+	user, err := config.Repo.User().CreateUser(context.Background(), &models.User{
 		FirstName:     "Mister",
 		LastName:      "Porter",
 		CompanyName:   "Porter Technologies, Inc.",
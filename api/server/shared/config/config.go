@@ -9,11 +9,13 @@ import (
 	"github.com/porter-dev/porter/internal/analytics"
 	"github.com/porter-dev/porter/internal/auth/token"
 	"github.com/porter-dev/porter/internal/billing"
+	"github.com/porter-dev/porter/internal/cost"
 	"github.com/porter-dev/porter/internal/helm/urlcache"
 	"github.com/porter-dev/porter/internal/integrations/powerdns"
 	"github.com/porter-dev/porter/internal/nats"
 	"github.com/porter-dev/porter/internal/notifier"
 	"github.com/porter-dev/porter/internal/oauth"
+	"github.com/porter-dev/porter/internal/ratelimit"
 	"github.com/porter-dev/porter/internal/repository"
 	"github.com/porter-dev/porter/internal/repository/credentials"
 	"github.com/porter-dev/porter/internal/telemetry"
@@ -50,6 +52,9 @@ type Config struct {
 	// RedisConf is the set of configuration variables for the redis instance
 	RedisConf *env.RedisConf
 
+	// RateLimiter counts requests to rate limited endpoints, and is nil if rate limiting is disabled
+	RateLimiter *ratelimit.Limiter
+
 	// TokenConf contains the config for generating and validating JWT tokens
 	TokenConf *token.TokenGeneratorConf
 
@@ -99,6 +104,9 @@ type Config struct {
 	// ClusterControlPlaneClient is a client for ClusterControlPlane
 	ClusterControlPlaneClient porterv1connect.ClusterControlPlaneServiceClient
 
+	// PriceCatalog holds the instance prices used to estimate the cost of apps
+	PriceCatalog *cost.Catalog
+
 	// CredentialBackend is the backend for credential storage, if external cred storage (like Vault)
 	// is used
 	CredentialBackend credentials.CredentialStorage
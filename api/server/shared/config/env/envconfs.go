@@ -29,9 +29,16 @@ type ServerConf struct {
 	IsTesting            bool          `env:"IS_TESTING,default=false"`
 	AppRootDomain        string        `env:"APP_ROOT_DOMAIN,default=porter.run"`
 
+	// ClusterCandidateTTL is how long an unresolved cluster candidate is kept before it is deleted
+	ClusterCandidateTTL time.Duration `env:"CLUSTER_CANDIDATE_TTL,default=168h"`
+
 	DefaultApplicationHelmRepoURL string `env:"HELM_APP_REPO_URL,default=https://charts.dev.getporter.dev"`
 	DefaultAddonHelmRepoURL       string `env:"HELM_ADD_ON_REPO_URL,default=https://chart-addons.dev.getporter.dev"`
 
+	// PriceCatalogURL serves instance prices which extend the embedded price catalog used to estimate the cost of apps
+	PriceCatalogURL string        `env:"PRICE_CATALOG_URL"`
+	PriceCatalogTTL time.Duration `env:"PRICE_CATALOG_TTL,default=24h"`
+
 	BasicLoginEnabled bool `env:"BASIC_LOGIN_ENABLED,default=true"`
 
 	GithubClientID     string `env:"GITHUB_CLIENT_ID"`
@@ -128,11 +135,26 @@ type ServerConf struct {
 	// /api/projects/{project_id}/clusters/{cluster_id}/kubeconfig will be disabled.
 	DisableTemporaryKubeconfig bool `env:"DISABLE_TEMPORARY_KUBECONFIG,default=false"`
 
+	// PorterYAMLStrictParsing rejects porter.yaml files with fields which are not part of a porter.yaml, such as a misspelled
+	// healthCheck. It can be turned off while existing porter.yaml files are fixed.
+	PorterYAMLStrictParsing bool `env:"PORTER_YAML_STRICT_PARSING,default=true"`
+
 	// EnableCAPIProvisioner disables checks for ClusterControlPlaneClient and NATS, if set to true
 	EnableCAPIProvisioner bool `env:"ENABLE_CAPI_PROVISIONER"`
 	// NATSUrl is the URL of the NATS cluster. This is required if ENABLE_CAPI_PROVISIONER is true
 	NATSUrl string `env:"NATS_URL"`
 
+	// RateLimitEnabled enables the rate limits of endpoints such as login
+	RateLimitEnabled bool `env:"RATE_LIMIT_ENABLED,default=true"`
+	// RateLimitStore is where request counts are stored, either memory or redis. Deployments with more than one
+	// replica of the server should use redis, so that counts are shared between replicas.
+	RateLimitStore string `env:"RATE_LIMIT_STORE,default=memory"`
+	// RateLimitIPHeader is the header which holds the client IP address when the server is behind a proxy, such as
+	// X-Forwarded-For. The remote address of the request is used if it is empty.
+	RateLimitIPHeader string `env:"RATE_LIMIT_IP_HEADER"`
+	// RateLimitOverrides overrides the limits of endpoints, as a list of name=requests/window, such as login=20/1m
+	RateLimitOverrides []string `env:"RATE_LIMIT_OVERRIDES"`
+
 	// TelemetryName is the name that will group this service during collection
 	TelemetryName string `env:"TELEMETRY_NAME"`
 	// TelemetryCollectorURL is the URL (host:port) for collecting spans
@@ -152,6 +174,14 @@ type DBConf struct {
 	DbName   string `env:"DB_NAME,default=porter"`
 	ForceSSL bool   `env:"DB_FORCE_SSL,default=false"`
 
+	// StatementTimeout is how long postgres runs a statement before canceling it. Zero disables the timeout.
+	StatementTimeout time.Duration `env:"DB_STATEMENT_TIMEOUT,default=0s"`
+
+	// ReadReplicaDSN is the DSN of a read-only replica which heavy list queries are sent to. All queries use the primary if unset.
+	ReadReplicaDSN string `env:"DB_READ_REPLICA_DSN"`
+	// ReadReplicaPrimaryRepositories are the repositories which keep reading from the primary when a replica is set, e.g. "cluster"
+	ReadReplicaPrimaryRepositories []string `env:"DB_READ_REPLICA_PRIMARY_REPOSITORIES"`
+
 	SQLLite     bool   `env:"SQL_LITE,default=false"`
 	SQLLitePath string `env:"SQL_LITE_PATH,default=/porter/porter.db"`
 
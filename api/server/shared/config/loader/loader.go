@@ -23,11 +23,13 @@ import (
 	"github.com/porter-dev/porter/internal/auth/sessionstore"
 	"github.com/porter-dev/porter/internal/auth/token"
 	"github.com/porter-dev/porter/internal/billing"
+	"github.com/porter-dev/porter/internal/cost"
 	"github.com/porter-dev/porter/internal/helm/urlcache"
 	"github.com/porter-dev/porter/internal/integrations/powerdns"
 	"github.com/porter-dev/porter/internal/notifier"
 	"github.com/porter-dev/porter/internal/notifier/sendgrid"
 	"github.com/porter-dev/porter/internal/oauth"
+	"github.com/porter-dev/porter/internal/ratelimit"
 	"github.com/porter-dev/porter/internal/repository/credentials"
 	"github.com/porter-dev/porter/internal/repository/gorm"
 	"github.com/porter-dev/porter/internal/telemetry"
@@ -40,6 +42,8 @@ var (
 	InstanceBillingManager billing.BillingManager
 	InstanceEnvConf        *envloader.EnvConf
 	InstanceDB             *pgorm.DB
+	// InstanceReadReplicaDB is the read replica of the instance database, which is nil if no replica is configured
+	InstanceReadReplicaDB *pgorm.DB
 )
 
 type EnvConfigLoader struct {
@@ -60,6 +64,12 @@ func sharedInit() {
 		panic(err)
 	}
 
+	InstanceReadReplicaDB, err = adapter.NewReadReplica(InstanceEnvConf.DBConf)
+
+	if err != nil {
+		panic(err)
+	}
+
 	InstanceBillingManager = &billing.NoopBillingManager{}
 }
 
@@ -106,7 +116,15 @@ func (e *EnvConfigLoader) LoadConfig() (res *config.Config, err error) {
 	}
 
 	res.Logger.Info().Msg("Creating new gorm repository")
-	res.Repo = gorm.NewRepository(InstanceDB, &key, instanceCredentialBackend)
+	var repoOpts []gorm.RepositoryOption
+	if InstanceReadReplicaDB != nil {
+		repoOpts = append(repoOpts,
+			gorm.WithReadReplica(InstanceReadReplicaDB),
+			gorm.WithPrimaryReads(envConf.DBConf.ReadReplicaPrimaryRepositories...),
+		)
+	}
+
+	res.Repo = gorm.NewRepository(InstanceDB, &key, instanceCredentialBackend, repoOpts...)
 	res.Logger.Info().Msg("Created new gorm repository")
 
 	res.Logger.Info().Msg("Creating new session store")
@@ -124,6 +142,31 @@ func (e *EnvConfigLoader) LoadConfig() (res *config.Config, err error) {
 	}
 	res.Logger.Info().Msg("Created new session store")
 
+	if sc.RateLimitEnabled {
+		overrides, err := ratelimit.ParseOverrides(sc.RateLimitOverrides)
+		if err != nil {
+			return nil, err
+		}
+
+		var store ratelimit.Store
+		switch sc.RateLimitStore {
+		case "redis":
+			redisClient, err := adapter.NewRedisClient(envConf.RedisConf)
+			if err != nil {
+				return nil, fmt.Errorf("error connecting to redis for rate limiting: %w", err)
+			}
+
+			store = ratelimit.NewRedisStore(redisClient, "porter:ratelimit:")
+		case "memory":
+			store = ratelimit.NewMemoryStore()
+		default:
+			return nil, fmt.Errorf("invalid rate limit store %s: must be memory or redis", sc.RateLimitStore)
+		}
+
+		res.RateLimiter = ratelimit.NewLimiter(store, overrides)
+		res.Logger.Info().Msgf("Created %s rate limiter", sc.RateLimitStore)
+	}
+
 	res.TokenConf = &token.TokenGeneratorConf{
 		TokenSecret: envConf.ServerConf.TokenGeneratorSecret,
 	}
@@ -285,6 +328,12 @@ func (e *EnvConfigLoader) LoadConfig() (res *config.Config, err error) {
 	res.URLCache = urlcache.Init(sc.DefaultApplicationHelmRepoURL, sc.DefaultAddonHelmRepoURL)
 	res.Logger.Info().Msg("Created URL Cache")
 
+	priceCatalog, err := cost.NewCatalog(sc.PriceCatalogURL, sc.PriceCatalogTTL)
+	if err != nil {
+		return nil, fmt.Errorf("could not create price catalog: %w", err)
+	}
+	res.PriceCatalog = priceCatalog
+
 	res.Logger.Info().Msg("Creating provisioner service client")
 	provClient, err := getProvisionerServiceClient(sc)
 	if err == nil && provClient != nil {
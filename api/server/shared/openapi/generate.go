@@ -0,0 +1,331 @@
+package openapi
+
+import (
+	"fmt"
+	"net/http"
+	"reflect"
+	"regexp"
+	"sort"
+	"strings"
+	"unicode"
+
+	"github.com/go-chi/chi/v5"
+
+	"github.com/porter-dev/porter/api/server/shared/router"
+	"github.com/porter-dev/porter/api/types"
+)
+
+const (
+	// bearerAuth is the security scheme of API tokens and user JWTs
+	bearerAuth = "bearerAuth"
+	// cookieAuth is the security scheme of dashboard sessions
+	cookieAuth = "cookieAuth"
+
+	// wildcardParam is the name of the path parameter which documents wildcard routes
+	wildcardParam = "path"
+)
+
+// uintURLParams are the path parameters which are read as unsigned integers
+var uintURLParams = map[string]bool{
+	string(types.URLParamProjectID):         true,
+	string(types.URLParamClusterID):         true,
+	string(types.URLParamRegistryID):        true,
+	string(types.URLParamHelmRepoID):        true,
+	string(types.URLParamGitInstallationID): true,
+	string(types.URLParamInfraID):           true,
+	string(types.URLParamInviteID):          true,
+	string(types.URLParamIntegrationID):     true,
+	string(types.URLParamServiceAccountID):  true,
+}
+
+// routeParamRegex matches the path parameters of chi routes, which may restrict their values with a regex
+var routeParamRegex = regexp.MustCompile(`\{([^}:]+)(:[^}]*)?\}`)
+
+// GenerateOpts are the options for generating a document
+type GenerateOpts struct {
+	Info Info
+	// Mux is the router which the routes are registered on
+	Mux chi.Routes
+	// Routes are the routes to document. Routes of the router which are not in this list, such as static files, are left out.
+	Routes []*router.Route
+	// SessionCookieName is the name of the cookie of dashboard sessions
+	SessionCookieName string
+}
+
+// Generate returns the document of the routes registered on a router
+func Generate(opts GenerateOpts) (*Document, error) {
+	doc := &Document{
+		OpenAPI: Version,
+		Info:    opts.Info,
+		Paths:   make(map[string]*PathItem),
+		Components: Components{
+			SecuritySchemes: map[string]*SecurityScheme{
+				bearerAuth: {Type: "http", Scheme: "bearer", BearerFormat: "JWT"},
+			},
+		},
+	}
+
+	if opts.SessionCookieName != "" {
+		doc.Components.SecuritySchemes[cookieAuth] = &SecurityScheme{Type: "apiKey", In: "cookie", Name: opts.SessionCookieName}
+	}
+
+	endpoints, err := registeredEndpoints(opts.Mux, opts.Routes)
+	if err != nil {
+		return nil, err
+	}
+
+	schemas := newSchemaRegistry()
+	errorSchema := schemas.schemaFor(reflect.TypeOf(types.ExternalError{}))
+	operationIDs := make(map[string]bool)
+
+	for _, endpoint := range endpoints {
+		metadata := endpoint.route.Endpoint.Metadata
+		docPath, params := documentPath(endpoint.pattern)
+
+		op := &Operation{
+			OperationID: uniqueOperationID(operationIDs, endpoint.route.Handler, endpoint.method),
+			Tags:        []string{operationTag(metadata)},
+			Parameters:  params,
+			Responses: map[string]*Response{
+				"default": {
+					Description: "Error",
+					Content:     map[string]*MediaType{"application/json": {Schema: errorSchema}},
+				},
+			},
+		}
+
+		addRequest(schemas, op, endpoint.method, metadata.Request)
+		addResponse(schemas, op, metadata.Response)
+
+		for _, scope := range metadata.Scopes {
+			if scope == types.UserScope {
+				op.Security = []SecurityRequirement{{bearerAuth: {}}}
+				if opts.SessionCookieName != "" {
+					op.Security = append(op.Security, SecurityRequirement{cookieAuth: {}})
+				}
+			}
+		}
+
+		item, ok := doc.Paths[docPath]
+		if !ok {
+			item = &PathItem{}
+			doc.Paths[docPath] = item
+		}
+		(*item)[strings.ToLower(endpoint.method)] = op
+	}
+
+	doc.Components.Schemas = schemas.schemas
+
+	return doc, nil
+}
+
+// registeredEndpoint is a route along with the full pattern it is served at
+type registeredEndpoint struct {
+	method  string
+	pattern string
+	route   *router.Route
+}
+
+// registeredEndpoints returns the full patterns of routes registered on a router, sorted by pattern and method
+func registeredEndpoints(mux chi.Routes, routes []*router.Route) ([]registeredEndpoint, error) {
+	// the router only stores the handlers of routes, so routes are matched to their endpoint metadata by handler
+	routesByHandler := make(map[http.Handler]*router.Route)
+	for _, route := range routes {
+		if route.Handler != nil && route.Endpoint != nil && route.Endpoint.Metadata != nil && reflect.TypeOf(route.Handler).Comparable() {
+			routesByHandler[route.Handler] = route
+		}
+	}
+
+	var endpoints []registeredEndpoint
+	walked := make(map[*router.Route]bool)
+	routerPrefixes := make(map[chi.Router]string)
+
+	err := chi.Walk(mux, func(method string, pattern string, handler http.Handler, _ ...func(http.Handler) http.Handler) error {
+		if handler == nil || !reflect.TypeOf(handler).Comparable() {
+			return nil
+		}
+
+		route, ok := routesByHandler[handler]
+		if !ok {
+			return nil
+		}
+
+		endpoints = append(endpoints, registeredEndpoint{method: method, pattern: pattern, route: route})
+		walked[route] = true
+
+		if relativePath := route.Endpoint.Metadata.Path.RelativePath; route.Router != nil && strings.HasSuffix(pattern, relativePath) {
+			routerPrefixes[route.Router] = strings.TrimSuffix(pattern, relativePath)
+		}
+
+		return nil
+	})
+	if err != nil {
+		return nil, fmt.Errorf("error walking routes: %w", err)
+	}
+
+	// chi does not walk routes whose pattern is also the prefix of a subrouter, such as GET /api/projects/{project_id}, so
+	// their pattern is found from the other routes registered on the same router
+	for _, route := range routesByHandler {
+		if walked[route] || route.Router == nil {
+			continue
+		}
+
+		prefix, ok := routerPrefixes[route.Router]
+		if !ok {
+			continue
+		}
+
+		endpoints = append(endpoints, registeredEndpoint{
+			method:  string(route.Endpoint.Metadata.Method),
+			pattern: prefix + route.Endpoint.Metadata.Path.RelativePath,
+			route:   route,
+		})
+	}
+
+	sort.Slice(endpoints, func(i, j int) bool {
+		if endpoints[i].pattern != endpoints[j].pattern {
+			return endpoints[i].pattern < endpoints[j].pattern
+		}
+
+		return endpoints[i].method < endpoints[j].method
+	})
+
+	return endpoints, nil
+}
+
+// documentPath converts a chi route pattern into an OpenAPI path, and returns the parameters of the path
+func documentPath(pattern string) (string, []*Parameter) {
+	if strings.HasSuffix(pattern, "/*") {
+		pattern = strings.TrimSuffix(pattern, "*") + "{" + wildcardParam + "}"
+	}
+
+	if len(pattern) > 1 {
+		pattern = strings.TrimSuffix(pattern, "/")
+	}
+
+	var params []*Parameter
+	docPath := routeParamRegex.ReplaceAllStringFunc(pattern, func(segment string) string {
+		name := routeParamRegex.FindStringSubmatch(segment)[1]
+
+		schema := &Schema{Type: "string"}
+		if uintURLParams[name] {
+			minimum := float64(1)
+			schema = &Schema{Type: "integer", Minimum: &minimum}
+		}
+
+		params = append(params, &Parameter{Name: name, In: "path", Required: true, Schema: schema})
+
+		return "{" + name + "}"
+	})
+
+	return docPath, params
+}
+
+// addRequest documents the request type of an endpoint, which is read from the query string of GET and DELETE requests and
+// from the JSON body of other requests
+func addRequest(schemas *schemaRegistry, op *Operation, method string, request any) {
+	if request == nil {
+		return
+	}
+
+	t := reflect.TypeOf(request)
+	for t.Kind() == reflect.Pointer {
+		t = t.Elem()
+	}
+
+	if method != http.MethodGet && method != http.MethodDelete {
+		op.RequestBody = &RequestBody{
+			Required: true,
+			Content:  map[string]*MediaType{"application/json": {Schema: schemas.schemaFor(t)}},
+		}
+
+		return
+	}
+
+	if t.Kind() == reflect.Struct {
+		op.Parameters = append(op.Parameters, queryParams(schemas, t)...)
+	}
+}
+
+// queryParams returns the query parameters of the fields of a struct, with the fields of embedded structs inlined
+func queryParams(schemas *schemaRegistry, t reflect.Type) []*Parameter {
+	var params []*Parameter
+
+	for i := 0; i < t.NumField(); i++ {
+		field := t.Field(i)
+
+		if field.Anonymous {
+			embedded := field.Type
+			for embedded.Kind() == reflect.Pointer {
+				embedded = embedded.Elem()
+			}
+
+			if embedded.Kind() == reflect.Struct {
+				params = append(params, queryParams(schemas, embedded)...)
+				continue
+			}
+		}
+
+		name, ok := queryFieldName(field)
+		if !ok {
+			continue
+		}
+
+		params = append(params, &Parameter{
+			Name:     name,
+			In:       "query",
+			Required: isRequired(field),
+			Schema:   schemas.schemaFor(field.Type),
+		})
+	}
+
+	return params
+}
+
+// addResponse documents the successful response of an endpoint
+func addResponse(schemas *schemaRegistry, op *Operation, response any) {
+	res := &Response{Description: "Successful response"}
+
+	if response != nil {
+		res.Content = map[string]*MediaType{
+			"application/json": {Schema: schemas.schemaFor(reflect.TypeOf(response))},
+		}
+	}
+
+	op.Responses["200"] = res
+}
+
+// operationTag groups an operation by the most specific scope of its endpoint
+func operationTag(metadata *types.APIRequestMetadata) string {
+	if len(metadata.Scopes) == 0 {
+		return "base"
+	}
+
+	return string(metadata.Scopes[len(metadata.Scopes)-1])
+}
+
+// uniqueOperationID returns an operation id named after the handler type, e.g. applyPorterApp for ApplyPorterAppHandler.
+// Handler types which serve several routes are numbered after their first route.
+func uniqueOperationID(taken map[string]bool, handler http.Handler, method string) string {
+	t := reflect.TypeOf(handler)
+	for t.Kind() == reflect.Pointer {
+		t = t.Elem()
+	}
+
+	id := strings.TrimSuffix(t.Name(), "Handler")
+	if id == "" {
+		id = strings.ToLower(method)
+	}
+
+	runes := []rune(id)
+	runes[0] = unicode.ToLower(runes[0])
+	id = string(runes)
+
+	unique := id
+	for i := 2; taken[unique]; i++ {
+		unique = fmt.Sprintf("%s%d", id, i)
+	}
+	taken[unique] = true
+
+	return unique
+}
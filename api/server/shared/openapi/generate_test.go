@@ -0,0 +1,212 @@
+package openapi_test
+
+import (
+	"net/http"
+	"testing"
+
+	"github.com/go-chi/chi/v5"
+
+	"github.com/porter-dev/porter/api/server/shared"
+	"github.com/porter-dev/porter/api/server/shared/openapi"
+	"github.com/porter-dev/porter/api/server/shared/router"
+	"github.com/porter-dev/porter/api/types"
+)
+
+type testHandler struct{}
+
+func (h *testHandler) ServeHTTP(w http.ResponseWriter, r *http.Request) {}
+
+type ListWidgetsHandler struct{ testHandler }
+
+type CreateWidgetHandler struct{ testHandler }
+
+type createWidgetRequest struct {
+	Name   string            `json:"name" form:"required"`
+	Labels map[string]string `json:"labels,omitempty"`
+	Parent *widget           `json:"parent"`
+	Secret string            `json:"-"`
+}
+
+type listWidgetsRequest struct {
+	Namespace string `schema:"namespace" form:"required"`
+	Limit     uint   `schema:"limit"`
+}
+
+type widget struct {
+	ID       uint     `json:"id"`
+	Children []widget `json:"children"`
+}
+
+func TestGenerate(t *testing.T) {
+	r := chi.NewRouter()
+
+	listHandler := &ListWidgetsHandler{}
+	createHandler := &CreateWidgetHandler{}
+
+	var routes []*router.Route
+
+	r.Route("/api/projects/{project_id}", func(r chi.Router) {
+		routes = append(routes,
+			&router.Route{
+				Endpoint: &shared.APIEndpoint{Metadata: &types.APIRequestMetadata{
+					Method:   types.HTTPVerbGet,
+					Path:     &types.Path{RelativePath: "/widgets/{name}"},
+					Scopes:   []types.PermissionScope{types.UserScope, types.ProjectScope},
+					Request:  &listWidgetsRequest{},
+					Response: []*widget{},
+				}},
+				Handler: listHandler,
+				Router:  r,
+			},
+			&router.Route{
+				Endpoint: &shared.APIEndpoint{Metadata: &types.APIRequestMetadata{
+					Method:   types.HTTPVerbPost,
+					Path:     &types.Path{RelativePath: "/widgets"},
+					Scopes:   []types.PermissionScope{types.UserScope, types.ProjectScope},
+					Request:  &createWidgetRequest{},
+					Response: &widget{},
+				}},
+				Handler: createHandler,
+				Router:  r,
+			},
+		)
+
+		// routes are registered in groups with middleware, as done by the API router
+		for _, route := range routes {
+			group := route.Router.Group(nil)
+			group.Use(func(next http.Handler) http.Handler { return next })
+			group.Method(string(route.Endpoint.Metadata.Method), route.Endpoint.Metadata.Path.RelativePath, route.Handler)
+		}
+	})
+
+	// routes which are not registered as API routes are left out
+	r.Get("/*", func(w http.ResponseWriter, r *http.Request) {})
+
+	doc, err := openapi.Generate(openapi.GenerateOpts{
+		Info:              openapi.Info{Title: "Test", Version: "v1"},
+		Mux:               r,
+		Routes:            routes,
+		SessionCookieName: "porter",
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if len(doc.Paths) != 2 {
+		t.Fatalf("expected 2 paths, got %d", len(doc.Paths))
+	}
+
+	listItem, ok := doc.Paths["/api/projects/{project_id}/widgets/{name}"]
+	if !ok {
+		t.Fatalf("expected widget list path, got %v", doc.Paths)
+	}
+
+	list := (*listItem)["get"]
+	if list == nil || list.OperationID != "listWidgets" || list.Tags[0] != string(types.ProjectScope) {
+		t.Fatalf("unexpected list operation %+v", list)
+	}
+
+	params := make(map[string]*openapi.Parameter)
+	for _, param := range list.Parameters {
+		params[param.In+":"+param.Name] = param
+	}
+
+	if p := params["path:project_id"]; p == nil || p.Schema.Type != "integer" || !p.Required {
+		t.Errorf("expected required integer project_id path parameter, got %+v", p)
+	}
+	if p := params["path:name"]; p == nil || p.Schema.Type != "string" {
+		t.Errorf("expected string name path parameter, got %+v", p)
+	}
+	if p := params["query:namespace"]; p == nil || !p.Required {
+		t.Errorf("expected required namespace query parameter, got %+v", p)
+	}
+	if p := params["query:limit"]; p == nil || p.Required || p.Schema.Type != "integer" {
+		t.Errorf("expected optional integer limit query parameter, got %+v", p)
+	}
+
+	if items := list.Responses["200"].Content["application/json"].Schema.Items; items == nil || items.Ref != "#/components/schemas/widget" {
+		t.Errorf("expected list response to reference the widget schema, got %+v", list.Responses["200"])
+	}
+
+	if len(list.Security) != 2 {
+		t.Errorf("expected bearer and cookie security, got %v", list.Security)
+	}
+
+	create := (*doc.Paths["/api/projects/{project_id}/widgets"])["post"]
+	if create == nil || create.RequestBody == nil {
+		t.Fatalf("expected create operation with a request body, got %+v", create)
+	}
+
+	body := create.RequestBody.Content["application/json"].Schema
+	if body.Ref != "#/components/schemas/createWidgetRequest" {
+		t.Fatalf("expected request body to reference createWidgetRequest, got %+v", body)
+	}
+
+	request := doc.Components.Schemas["createWidgetRequest"]
+	if _, ok := request.Properties["Secret"]; ok {
+		t.Errorf("expected ignored fields to be left out, got %v", request.Properties)
+	}
+	if len(request.Required) != 1 || request.Required[0] != "name" {
+		t.Errorf("expected name to be required, got %v", request.Required)
+	}
+	if labels := request.Properties["labels"]; labels.Type != "object" || labels.AdditionalProperties.Type != "string" {
+		t.Errorf("expected labels to be a map of strings, got %+v", labels)
+	}
+
+	// recursive types reference themselves
+	if children := doc.Components.Schemas["widget"].Properties["children"]; children.Items.Ref != "#/components/schemas/widget" {
+		t.Errorf("expected widget children to reference the widget schema, got %+v", children)
+	}
+
+	if _, ok := doc.Components.Schemas["ExternalError"]; !ok {
+		t.Errorf("expected the error schema to be a component")
+	}
+}
+
+type GetProjectHandler struct{ testHandler }
+
+type ListProjectsHandler struct{ testHandler }
+
+func TestGenerateRouteWithSubrouterPrefix(t *testing.T) {
+	r := chi.NewRouter()
+
+	var routes []*router.Route
+
+	r.Route("/api", func(r chi.Router) {
+		routes = append(routes,
+			&router.Route{
+				Endpoint: &shared.APIEndpoint{Metadata: &types.APIRequestMetadata{
+					Method: types.HTTPVerbGet,
+					Path:   &types.Path{RelativePath: "/projects"},
+				}},
+				Handler: &ListProjectsHandler{},
+				Router:  r,
+			},
+			&router.Route{
+				Endpoint: &shared.APIEndpoint{Metadata: &types.APIRequestMetadata{
+					Method: types.HTTPVerbGet,
+					Path:   &types.Path{RelativePath: "/projects/{project_id}"},
+				}},
+				Handler: &GetProjectHandler{},
+				Router:  r,
+			},
+		)
+
+		// the project routes are registered on a subrouter of the same pattern as the project endpoint
+		r.Route("/projects/{project_id}", func(r chi.Router) {})
+
+		for _, route := range routes {
+			route.Router.Method(string(route.Endpoint.Metadata.Method), route.Endpoint.Metadata.Path.RelativePath, route.Handler)
+		}
+	})
+
+	doc, err := openapi.Generate(openapi.GenerateOpts{Mux: r, Routes: routes})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	item, ok := doc.Paths["/api/projects/{project_id}"]
+	if !ok || (*item)["get"] == nil || (*item)["get"].OperationID != "getProject" {
+		t.Fatalf("expected project endpoint to be documented, got %v", doc.Paths)
+	}
+}
@@ -0,0 +1,49 @@
+package openapi
+
+import (
+	"encoding/json"
+	"net/http"
+	"sync"
+
+	"github.com/porter-dev/porter/api/server/shared/apierrors"
+	"github.com/porter-dev/porter/api/server/shared/config"
+)
+
+// Handler writes the document of the API
+type Handler struct {
+	config *config.Config
+	opts   GenerateOpts
+
+	once    sync.Once
+	encoded []byte
+	err     error
+}
+
+// NewHandler returns a handler which writes the document of the routes registered on a router. The document is generated
+// on the first request, after all routes have been registered.
+func NewHandler(config *config.Config, opts GenerateOpts) *Handler {
+	return &Handler{
+		config: config,
+		opts:   opts,
+	}
+}
+
+func (h *Handler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	h.once.Do(func() {
+		doc, err := Generate(h.opts)
+		if err != nil {
+			h.err = err
+			return
+		}
+
+		h.encoded, h.err = json.Marshal(doc)
+	})
+
+	if h.err != nil {
+		apierrors.HandleAPIError(h.config.Logger, h.config.Alerter, w, r, apierrors.NewErrInternal(h.err), true)
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	_, _ = w.Write(h.encoded) // nolint:errcheck
+}
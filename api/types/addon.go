@@ -0,0 +1,105 @@
+package types
+
+import "time"
+
+// URLParamAddonName is the url param for the name of an addon
+const URLParamAddonName URLParam = "addon_name"
+
+// AddonType is a curated Helm chart which can be installed into a cluster from the addon marketplace
+type AddonType string
+
+const (
+	// AddonType_Redis installs a Redis instance
+	AddonType_Redis AddonType = "redis"
+	// AddonType_Postgres installs a PostgreSQL database
+	AddonType_Postgres AddonType = "postgres"
+	// AddonType_Kafka installs a Kafka cluster
+	AddonType_Kafka AddonType = "kafka"
+)
+
+// AddonFormFieldType is the type of the value of an addon form field
+type AddonFormFieldType string
+
+const (
+	// AddonFormFieldType_String is a free-form string
+	AddonFormFieldType_String AddonFormFieldType = "string"
+	// AddonFormFieldType_Integer is a whole number
+	AddonFormFieldType_Integer AddonFormFieldType = "integer"
+	// AddonFormFieldType_Boolean is true or false
+	AddonFormFieldType_Boolean AddonFormFieldType = "boolean"
+)
+
+// AddonFormField is an input of the install form of an addon template
+type AddonFormField struct {
+	Key         string             `json:"key"`
+	Label       string             `json:"label"`
+	Description string             `json:"description,omitempty"`
+	Type        AddonFormFieldType `json:"type"`
+	Default     string             `json:"default,omitempty"`
+	Required    bool               `json:"required,omitempty"`
+	// Options restricts the value of the field to one of the given values
+	Options []string `json:"options,omitempty"`
+	// Secret fields, such as passwords, are never returned once set
+	Secret bool `json:"secret,omitempty"`
+}
+
+// AddonTemplate describes a curated chart of the addon marketplace and the form used to install it
+type AddonTemplate struct {
+	Type        AddonType        `json:"type"`
+	Name        string           `json:"name"`
+	Description string           `json:"description"`
+	ChartName   string           `json:"chart_name"`
+	Form        []AddonFormField `json:"form"`
+}
+
+// ListAddonTemplatesResponse is the list of curated charts which can be installed as addons
+type ListAddonTemplatesResponse struct {
+	Templates []AddonTemplate `json:"templates"`
+}
+
+// Addon is a curated chart installed into a cluster
+type Addon struct {
+	ID           uint      `json:"id"`
+	ProjectID    uint      `json:"project_id"`
+	ClusterID    uint      `json:"cluster_id"`
+	Name         string    `json:"name"`
+	Namespace    string    `json:"namespace"`
+	Type         AddonType `json:"type"`
+	ChartVersion string    `json:"chart_version,omitempty"`
+	// Inputs are the values of the install form. The values of secret fields are left out.
+	Inputs map[string]string `json:"inputs"`
+	// Status is the status of the latest revision of the Helm release of the addon
+	Status    string    `json:"status,omitempty"`
+	CreatedAt time.Time `json:"created_at"`
+	UpdatedAt time.Time `json:"updated_at"`
+}
+
+// ListAddonsResponse is the list of addons installed into a cluster
+type ListAddonsResponse struct {
+	Addons []Addon `json:"addons"`
+}
+
+// InstallAddonRequest installs a curated chart into a cluster
+type InstallAddonRequest struct {
+	Type AddonType `json:"type" form:"required,oneof=redis postgres kafka"`
+	// Name is the name of the addon and of its Helm release, and must be unique in the cluster
+	Name string `json:"name" form:"required,dns1123"`
+	// Namespace defaults to the default namespace
+	Namespace string `json:"namespace"`
+	// ChartVersion defaults to the latest version of the chart
+	ChartVersion string `json:"chart_version"`
+	// Inputs are the values of the install form of the addon template
+	Inputs map[string]string `json:"inputs"`
+	// Values are raw Helm values, which take precedence over the values set by the inputs
+	Values map[string]interface{} `json:"values"`
+}
+
+// UpgradeAddonRequest changes the chart version or values of an addon
+type UpgradeAddonRequest struct {
+	// ChartVersion defaults to the version the addon is installed with
+	ChartVersion string `json:"chart_version"`
+	// Inputs are merged into the inputs the addon is installed with
+	Inputs map[string]string `json:"inputs"`
+	// Values replace the raw Helm values of the addon, if set
+	Values map[string]interface{} `json:"values"`
+}
@@ -0,0 +1,120 @@
+package types
+
+import (
+	"strings"
+	"time"
+)
+
+// URLParamAlertRuleID is the url param for the id of an alert rule
+const URLParamAlertRuleID URLParam = "alert_rule_id"
+
+// KubeEventSeverity is how severe a kube event is, which is computed from the type and reason of the event
+type KubeEventSeverity string
+
+const (
+	// KubeEventSeverity_Info is the severity of events which are part of normal operation
+	KubeEventSeverity_Info KubeEventSeverity = "info"
+	// KubeEventSeverity_Warning is the severity of events which may degrade an application, such as failing probes
+	KubeEventSeverity_Warning KubeEventSeverity = "warning"
+	// KubeEventSeverity_Critical is the severity of events which stop an application from running, such as OOM kills
+	KubeEventSeverity_Critical KubeEventSeverity = "critical"
+)
+
+// kubeEventSeverityRanks orders the severities from least to most severe
+var kubeEventSeverityRanks = map[KubeEventSeverity]int{
+	KubeEventSeverity_Info:     0,
+	KubeEventSeverity_Warning:  1,
+	KubeEventSeverity_Critical: 2,
+}
+
+// criticalKubeEventReasons are the reasons of events which stop a workload from running
+var criticalKubeEventReasons = []string{
+	"oom",
+	"crashloopbackoff",
+	"evicted",
+	"failedscheduling",
+	"nodenotready",
+	"failedcreatepodsandbox",
+}
+
+// warningKubeEventReasons are the reasons of events which may degrade a workload
+var warningKubeEventReasons = []string{
+	"backoff",
+	"unhealthy",
+	"failedmount",
+	"failedattachvolume",
+	"failedpull",
+	"errimagepull",
+	"imagepullbackoff",
+	"killing",
+	"failed",
+}
+
+// AtLeast returns true if the severity is at least as severe as another severity
+func (s KubeEventSeverity) AtLeast(other KubeEventSeverity) bool {
+	return kubeEventSeverityRanks[s] >= kubeEventSeverityRanks[other]
+}
+
+// ClassifyKubeEventSeverity computes the severity of an event from its type and reason. Reasons are
+// matched by prefix, so that reasons such as "OOMKilled: memory limit exceeded" are classified.
+func ClassifyKubeEventSeverity(eventType KubeEventType, reason string) KubeEventSeverity {
+	if eventType == KubeEventTypeCritical {
+		return KubeEventSeverity_Critical
+	}
+
+	reason = strings.ToLower(strings.TrimSpace(reason))
+
+	for _, prefix := range criticalKubeEventReasons {
+		if strings.HasPrefix(reason, prefix) {
+			return KubeEventSeverity_Critical
+		}
+	}
+
+	for _, prefix := range warningKubeEventReasons {
+		if strings.HasPrefix(reason, prefix) {
+			return KubeEventSeverity_Warning
+		}
+	}
+
+	return KubeEventSeverity_Info
+}
+
+// AlertRule sends notifications for the kube events of a project which match it
+type AlertRule struct {
+	ID        uint      `json:"id"`
+	ProjectID uint      `json:"project_id"`
+	Name      string    `json:"name"`
+	CreatedAt time.Time `json:"created_at"`
+
+	// Severity is the minimum severity of the events which match the rule
+	Severity KubeEventSeverity `json:"severity"`
+	// ResourceType only matches events of a resource type, such as "pod" or "node". An empty value matches all resource types.
+	ResourceType string `json:"resource_type"`
+	// Namespace only matches events in a namespace. An empty value matches all namespaces.
+	Namespace string `json:"namespace"`
+
+	// NotifyWebhooks sends matched events to the project webhooks subscribed to kube_event.alert
+	NotifyWebhooks bool `json:"notify_webhooks"`
+	// NotifySlack sends matched events to the Slack integrations of the project
+	NotifySlack bool `json:"notify_slack"`
+}
+
+// CreateAlertRuleRequest is the request to create an alert rule on a project
+type CreateAlertRuleRequest struct {
+	Name           string            `json:"name" form:"required,max=255"`
+	Severity       KubeEventSeverity `json:"severity" form:"required,oneof=info warning critical"`
+	ResourceType   string            `json:"resource_type"`
+	Namespace      string            `json:"namespace"`
+	NotifyWebhooks bool              `json:"notify_webhooks"`
+	NotifySlack    bool              `json:"notify_slack"`
+}
+
+// ListAlertRulesResponse is the response for listing the alert rules of a project
+type ListAlertRulesResponse []*AlertRule
+
+// KubeEventAlert is a kube event which matched an alert rule
+type KubeEventAlert struct {
+	Rule     *AlertRule    `json:"rule"`
+	Event    *KubeEvent    `json:"event"`
+	SubEvent *KubeSubEvent `json:"sub_event"`
+}
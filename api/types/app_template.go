@@ -0,0 +1,50 @@
+package types
+
+// AppTemplate is a parameterized porter.yaml file which new apps in a project can be created from
+type AppTemplate struct {
+	ID        uint   `json:"id"`
+	ProjectID uint   `json:"project_id"`
+	Name      string `json:"name"`
+	// Description is a short summary of the app the template creates, e.g. "Rails web app with a Postgres database"
+	Description string `json:"description"`
+	// B64PorterYAML is the base64-encoded porter.yaml of the template, in which ${var.NAME} expressions are replaced by the
+	// values of the variables of the template
+	B64PorterYAML string                `json:"b64_porter_yaml"`
+	Variables     []AppTemplateVariable `json:"variables"`
+}
+
+// AppTemplateVariable is a variable of an app template, which is set when an app is created from the template
+type AppTemplateVariable struct {
+	Name        string `json:"name" form:"required"`
+	Description string `json:"description,omitempty"`
+	// Default is the value of the variable if it is not set
+	Default string `json:"default,omitempty"`
+	// Required is true if the variable must have a value, either set when the app is created or from its default
+	Required bool `json:"required,omitempty"`
+}
+
+// CreateAppTemplateRequest is the request to create an app template, or to replace the app template with the same name
+type CreateAppTemplateRequest struct {
+	Name          string                `json:"name" form:"required,max=255"`
+	Description   string                `json:"description"`
+	B64PorterYAML string                `json:"b64_porter_yaml" form:"required"`
+	Variables     []AppTemplateVariable `json:"variables"`
+}
+
+// ListAppTemplatesResponse is the response to a request to list the app templates of a project
+type ListAppTemplatesResponse struct {
+	AppTemplates []AppTemplate `json:"app_templates"`
+}
+
+// InstantiateAppTemplateRequest is the request to create the porter.yaml of a new app from an app template
+type InstantiateAppTemplateRequest struct {
+	AppName string `json:"app_name" form:"required,max=255"`
+	// Variables are the values of the variables of the template. Variables which are not set use their defaults.
+	Variables map[string]string `json:"variables"`
+}
+
+// InstantiateAppTemplateResponse is the porter.yaml of a new app created from an app template
+type InstantiateAppTemplateResponse struct {
+	AppName       string `json:"app_name"`
+	B64PorterYAML string `json:"b64_porter_yaml"`
+}
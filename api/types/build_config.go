@@ -5,6 +5,14 @@ type BuildConfig struct {
 	Builder    string   `json:"builder"`
 	Buildpacks []string `json:"buildpacks"`
 	Config     []byte   `json:"config"`
+
+	// RunImage replaces the run image of the stack of the builder, which the built image is based on
+	RunImage string `json:"run_image,omitempty"`
+	// LifecycleImage replaces the lifecycle image used to build with an untrusted builder
+	LifecycleImage string `json:"lifecycle_image,omitempty"`
+	// TrustBuilder sets whether the builder is trusted with registry credentials, so that all lifecycle phases run in the builder.
+	// The builders suggested by pack, such as the heroku and paketo builders, are trusted if it is nil.
+	TrustBuilder *bool `json:"trust_builder,omitempty"`
 }
 
 // The build configuration for this new release
@@ -0,0 +1,70 @@
+package types
+
+import "time"
+
+// URLParamDomain is the url param for a domain of an app
+const URLParamDomain URLParam = "domain"
+
+// CertificateSource is what provides the TLS certificate of a domain
+type CertificateSource string
+
+const (
+	// CertificateSource_CertManager certificates are issued and renewed by cert-manager, such as with Let's Encrypt
+	CertificateSource_CertManager CertificateSource = "cert_manager"
+	// CertificateSource_Custom certificates are uploaded, and must be renewed by uploading them again
+	CertificateSource_Custom CertificateSource = "custom"
+)
+
+// CertificateStatus is the state of the TLS certificate of a domain
+type CertificateStatus string
+
+const (
+	// CertificateStatus_Ready means the certificate is valid and served
+	CertificateStatus_Ready CertificateStatus = "ready"
+	// CertificateStatus_NotReady means cert-manager has not issued the certificate yet, or could not issue it
+	CertificateStatus_NotReady CertificateStatus = "not_ready"
+	// CertificateStatus_Expired means the certificate is past its expiry
+	CertificateStatus_Expired CertificateStatus = "expired"
+	// CertificateStatus_NotApplied means a custom certificate is not served, such as after the app is deployed again,
+	// and must be uploaded again
+	CertificateStatus_NotApplied CertificateStatus = "not_applied"
+	// CertificateStatus_Missing means the domain is served without TLS, or without a certificate for its TLS secret
+	CertificateStatus_Missing CertificateStatus = "missing"
+)
+
+// AppCertificate is the TLS certificate of a domain of an app, in a namespace the app is deployed to
+type AppCertificate struct {
+	Domain    string            `json:"domain"`
+	Namespace string            `json:"namespace"`
+	Source    CertificateSource `json:"source"`
+	Status    CertificateStatus `json:"status"`
+	Message   string            `json:"message,omitempty"`
+	// SecretName is the TLS secret the certificate is served from
+	SecretName string     `json:"secret_name,omitempty"`
+	Issuer     string     `json:"issuer,omitempty"`
+	NotAfter   *time.Time `json:"not_after,omitempty"`
+	// RenewalTime is when cert-manager renews the certificate
+	RenewalTime *time.Time `json:"renewal_time,omitempty"`
+}
+
+// ListAppCertificatesResponse is the list of the TLS certificates of the domains of an app
+type ListAppCertificatesResponse struct {
+	Certificates []AppCertificate `json:"certificates"`
+}
+
+// UploadCustomCertificateRequest sets a custom TLS certificate for a domain of an app, instead of the certificate issued by cert-manager
+type UploadCustomCertificateRequest struct {
+	// Certificate is the PEM-encoded certificate chain, starting with the certificate of the domain
+	Certificate string `json:"certificate" form:"required"`
+	// PrivateKey is the PEM-encoded private key of the certificate
+	PrivateKey string `json:"private_key" form:"required"`
+}
+
+// UploadCustomCertificateResponse is the custom certificate of a domain, and the namespaces it is served in
+type UploadCustomCertificateResponse struct {
+	Domain   string    `json:"domain"`
+	Issuer   string    `json:"issuer"`
+	NotAfter time.Time `json:"not_after"`
+	// Namespaces are the namespaces where an ingress of the app serves the domain
+	Namespaces []string `json:"namespaces"`
+}
@@ -1,6 +1,8 @@
 package types
 
 import (
+	"time"
+
 	"github.com/porter-dev/porter/internal/kubernetes/prometheus"
 )
 
@@ -83,6 +85,9 @@ type ClusterCandidate struct {
 	// The best-guess for the AWSClusterID, which is required by aws auth mechanisms
 	// See https://github.com/kubernetes-sigs/aws-iam-authenticator#what-is-a-cluster-id
 	AWSClusterIDGuess string `json:"aws_cluster_id_guess"`
+
+	// ExpiresAt is the time after which the candidate is deleted if it has not been resolved
+	ExpiresAt *time.Time `json:"expires_at,omitempty"`
 }
 
 type ClusterResolver struct {
@@ -122,6 +127,7 @@ type ClusterResolverAll struct {
 	AWSAccessKeyID     string `json:"aws_access_key_id"`
 	AWSSecretAccessKey string `json:"aws_secret_access_key"`
 	AWSClusterID       string `json:"aws_cluster_id"`
+	DOIntegrationID    uint   `json:"do_integration_id,omitempty"`
 }
 
 // ClusterResolverInfo contains the information for actions to be
@@ -169,6 +175,10 @@ var ClusterResolverInfos = map[ClusterResolverName]ClusterResolverInfo{
 		Docs:   "https://github.com/porter-dev/porter",
 		Fields: "aws_access_key_id,aws_secret_access_key,aws_cluster_id",
 	},
+	DOData: {
+		Docs:   "https://github.com/porter-dev/porter",
+		Fields: "do_integration_id",
+	},
 }
 
 // ClusterResolverData is a map of key names to fields, which gets marshaled from
@@ -219,6 +229,7 @@ const (
 	TokenData        ClusterResolverName = "upload-token-data"
 	GCPKeyData       ClusterResolverName = "upload-gcp-key-data"
 	AWSData          ClusterResolverName = "upload-aws-data"
+	DOData           ClusterResolverName = "upload-do-data"
 )
 
 // NamespaceResponse represents the response type of requests to the namespace resource
@@ -241,6 +252,71 @@ type NamespaceResponse struct {
 	// enum: active,terminating
 	// example: active
 	Status string `json:"status" form:"required"`
+
+	// the labels of the namespace
+	Labels map[string]string `json:"labels,omitempty"`
+
+	// the resource quota that Porter applied to the namespace, if any
+	ResourceQuota *NamespaceResourceQuota `json:"resource_quota,omitempty"`
+
+	// the container limit range that Porter applied to the namespace, if any
+	LimitRange *NamespaceLimitRange `json:"limit_range,omitempty"`
+}
+
+// NamespaceResourceQuota is the template of the ResourceQuota that Porter applies to a namespace.
+// Quantities use the Kubernetes quantity format, and empty quantities are not limited.
+//
+// swagger:model
+type NamespaceResourceQuota struct {
+	// the total CPU that the pods of the namespace may request
+	// example: 4
+	RequestsCPU string `json:"requests_cpu,omitempty"`
+
+	// the total memory that the pods of the namespace may request
+	// example: 8Gi
+	RequestsMemory string `json:"requests_memory,omitempty"`
+
+	// the total CPU limit of the pods of the namespace
+	// example: 8
+	LimitsCPU string `json:"limits_cpu,omitempty"`
+
+	// the total memory limit of the pods of the namespace
+	// example: 16Gi
+	LimitsMemory string `json:"limits_memory,omitempty"`
+
+	// the maximum number of pods in the namespace
+	// example: 50
+	Pods string `json:"pods,omitempty"`
+}
+
+// NamespaceLimitRange is the template of the LimitRange that Porter applies to the containers of a
+// namespace. Quantities use the Kubernetes quantity format.
+//
+// swagger:model
+type NamespaceLimitRange struct {
+	// the CPU request of containers that do not set one
+	// example: 100m
+	DefaultRequestCPU string `json:"default_request_cpu,omitempty"`
+
+	// the memory request of containers that do not set one
+	// example: 128Mi
+	DefaultRequestMemory string `json:"default_request_memory,omitempty"`
+
+	// the CPU limit of containers that do not set one
+	// example: 500m
+	DefaultLimitCPU string `json:"default_limit_cpu,omitempty"`
+
+	// the memory limit of containers that do not set one
+	// example: 512Mi
+	DefaultLimitMemory string `json:"default_limit_memory,omitempty"`
+
+	// the maximum CPU limit of a single container
+	// example: 2
+	MaxCPU string `json:"max_cpu,omitempty"`
+
+	// the maximum memory limit of a single container
+	// example: 4Gi
+	MaxMemory string `json:"max_memory,omitempty"`
 }
 
 // ListNamespacesResponse represents the list of all namespaces
@@ -258,6 +334,12 @@ type CreateNamespaceRequest struct {
 
 	// labels for the kubernetes namespace, if any
 	Labels map[string]string `json:"labels,omitempty"`
+
+	// the resource quota to apply to the namespace, if any
+	ResourceQuota *NamespaceResourceQuota `json:"resource_quota,omitempty"`
+
+	// the container limit range to apply to the namespace, if any
+	LimitRange *NamespaceLimitRange `json:"limit_range,omitempty"`
 }
 
 type GetTemporaryKubeconfigResponse struct {
@@ -14,3 +14,9 @@ type GetAWSClusterInfoResponse struct {
 	Status     string       `json:"status"`
 	Subnets    []*AWSSubnet `json:"subnets"`
 }
+
+// GetEgressIPsResponse is the response object for the /integrations/aws/egress_ips endpoint
+type GetEgressIPsResponse struct {
+	// EgressIPs are the public IPs that traffic from the cluster to the internet leaves from
+	EgressIPs []string `json:"egress_ips"`
+}
@@ -0,0 +1,59 @@
+package types
+
+import "time"
+
+// CustomDomainStatus is the DNS verification status of a custom domain
+type CustomDomainStatus string
+
+const (
+	// CustomDomainStatus_Pending is set until a DNS record is found for the domain
+	CustomDomainStatus_Pending CustomDomainStatus = "pending"
+	// CustomDomainStatus_Verified is set once the domain resolves to the ingress of the cluster
+	CustomDomainStatus_Verified CustomDomainStatus = "verified"
+	// CustomDomainStatus_Misconfigured is set if the domain resolves, but not to the ingress of the cluster
+	CustomDomainStatus_Misconfigured CustomDomainStatus = "misconfigured"
+)
+
+// DNSProvider is a DNS service which Porter can create the records of custom domains in
+type DNSProvider string
+
+const (
+	// DNSProvider_Route53 creates records in a Route53 hosted zone, with an AWS integration
+	DNSProvider_Route53 DNSProvider = "route53"
+	// DNSProvider_CloudDNS creates records in a Cloud DNS managed zone, with a GCP integration
+	DNSProvider_CloudDNS DNSProvider = "cloud_dns"
+)
+
+// CustomDomain is a domain of a web service of an app, set in porter.yaml, and the DNS record it needs
+// to point to the cluster
+type CustomDomain struct {
+	Name string `json:"name"`
+	// RecordType is the type of the record the domain needs, CNAME if the ingress of the cluster has a
+	// hostname and A if it has an IP address. It is empty until the address of the ingress is known.
+	RecordType string `json:"record_type,omitempty"`
+	// Target is the address of the ingress of the cluster, which the record must point to
+	Target        string             `json:"target,omitempty"`
+	Status        CustomDomainStatus `json:"status"`
+	StatusMessage string             `json:"status_message,omitempty"`
+	LastCheckedAt *time.Time         `json:"last_checked_at,omitempty"`
+	VerifiedAt    *time.Time         `json:"verified_at,omitempty"`
+	// DNSProvider is set if Porter manages the record of the domain
+	DNSProvider DNSProvider `json:"dns_provider,omitempty"`
+	// DNSZone is the Route53 hosted zone id or Cloud DNS managed zone name of the record
+	DNSZone string `json:"dns_zone,omitempty"`
+}
+
+// ListCustomDomainsResponse is the list of the custom domains of an app
+type ListCustomDomainsResponse struct {
+	Domains []CustomDomain `json:"domains"`
+}
+
+// ConfigureDomainDNSRequest lets Porter manage the DNS record of a custom domain, with an integration of
+// the project. Exactly one of AWSIntegrationID and GCPIntegrationID must be set, matching the provider.
+type ConfigureDomainDNSRequest struct {
+	Provider         DNSProvider `json:"provider" form:"required,oneof=route53 cloud_dns"`
+	AWSIntegrationID uint        `json:"aws_integration_id" form:"required_if=Provider route53"`
+	GCPIntegrationID uint        `json:"gcp_integration_id" form:"required_if=Provider cloud_dns"`
+	// Zone is the Route53 hosted zone id or the Cloud DNS managed zone name which holds the domain
+	Zone string `json:"zone" form:"required"`
+}
@@ -0,0 +1,111 @@
+package types
+
+import "time"
+
+const (
+	// URLParamDatastoreID is the url param for the id of a datastore
+	URLParamDatastoreID URLParam = "datastore_id"
+	// URLParamDatastoreLinkID is the url param for the id of a link between a datastore and an app
+	URLParamDatastoreLinkID URLParam = "datastore_link_id"
+)
+
+// DatastoreEngine is the database engine of a managed datastore
+type DatastoreEngine string
+
+const (
+	// DatastoreEngine_Postgres is an RDS for PostgreSQL instance
+	DatastoreEngine_Postgres DatastoreEngine = "postgres"
+	// DatastoreEngine_MySQL is an RDS for MySQL instance
+	DatastoreEngine_MySQL DatastoreEngine = "mysql"
+)
+
+// DatastoreStatus is the lifecycle state of a managed datastore
+type DatastoreStatus string
+
+const (
+	// DatastoreStatus_Creating is set while the instance is provisioned
+	DatastoreStatus_Creating DatastoreStatus = "creating"
+	// DatastoreStatus_Available is set once the instance accepts connections
+	DatastoreStatus_Available DatastoreStatus = "available"
+	// DatastoreStatus_Updating is set while the instance is modified or under maintenance
+	DatastoreStatus_Updating DatastoreStatus = "updating"
+	// DatastoreStatus_Failed is set if the instance could not be provisioned or is unusable
+	DatastoreStatus_Failed DatastoreStatus = "failed"
+	// DatastoreStatus_Deleting is set while the instance is deleted. The datastore is removed once the
+	// instance no longer exists.
+	DatastoreStatus_Deleting DatastoreStatus = "deleting"
+)
+
+// Datastore is a managed database instance provisioned with an AWS integration of a project
+type Datastore struct {
+	ID               uint            `json:"id"`
+	ProjectID        uint            `json:"project_id"`
+	AWSIntegrationID uint            `json:"aws_integration_id"`
+	Name             string          `json:"name"`
+	Region           string          `json:"region"`
+	Engine           DatastoreEngine `json:"engine"`
+	EngineVersion    string          `json:"engine_version,omitempty"`
+	InstanceClass    string          `json:"instance_class"`
+	StorageGB        int64           `json:"storage_gb"`
+	DatabaseName     string          `json:"database_name"`
+	Status           DatastoreStatus `json:"status"`
+	// StatusMessage explains a failed status
+	StatusMessage string `json:"status_message,omitempty"`
+	// InstanceIdentifier is the identifier of the RDS instance
+	InstanceIdentifier string          `json:"instance_identifier"`
+	Endpoint           string          `json:"endpoint,omitempty"`
+	Port               int64           `json:"port,omitempty"`
+	Links              []DatastoreLink `json:"links"`
+	CreatedAt          time.Time       `json:"created_at"`
+}
+
+// DatastoreLink injects the connection string of a datastore into the env of an app when the app is deployed.
+// Each linked app connects with a dedicated database user.
+type DatastoreLink struct {
+	ID        uint   `json:"id"`
+	ClusterID uint   `json:"cluster_id"`
+	AppName   string `json:"app_name"`
+	EnvVar    string `json:"env_var"`
+	// Username is the database user of the app
+	Username string `json:"username,omitempty"`
+	// CredentialsRotatedAt is the time the password of the user was last rotated, if ever
+	CredentialsRotatedAt *time.Time `json:"credentials_rotated_at,omitempty"`
+}
+
+// ListDatastoresResponse is the list of the datastores of a project
+type ListDatastoresResponse struct {
+	Datastores []Datastore `json:"datastores"`
+}
+
+// CreateDatastoreRequest provisions a managed datastore
+type CreateDatastoreRequest struct {
+	// Name must be unique in the project, and is part of the identifier of the RDS instance
+	Name             string          `json:"name" form:"required,dns1123,max=50"`
+	AWSIntegrationID uint            `json:"aws_integration_id" form:"required"`
+	Region           string          `json:"region" form:"required"`
+	Engine           DatastoreEngine `json:"engine" form:"required,oneof=postgres mysql"`
+	// EngineVersion defaults to the default version of the engine in RDS
+	EngineVersion string `json:"engine_version"`
+	// InstanceClass defaults to db.t4g.micro
+	InstanceClass string `json:"instance_class"`
+	// StorageGB defaults to 20
+	StorageGB int64 `json:"storage_gb" form:"omitempty,min=20,max=65536"`
+	// DatabaseName is the database created on the instance, and defaults to the name of the datastore
+	DatabaseName string `json:"database_name"`
+}
+
+// LinkDatastoreRequest links a datastore to an app
+type LinkDatastoreRequest struct {
+	ClusterID uint   `json:"cluster_id" form:"required"`
+	AppName   string `json:"app_name" form:"required"`
+	// EnvVar is the env var set to the connection string, and defaults to DATABASE_URL
+	EnvVar string `json:"env_var"`
+}
+
+// RotateDatastoreCredentialsResponse is the link whose credentials were rotated, and the workloads of the app
+// which were redeployed with the new credentials
+type RotateDatastoreCredentialsResponse struct {
+	Link DatastoreLink `json:"link"`
+	// RedeployedWorkloads are the deployments and cron jobs of the app, as namespace/name
+	RedeployedWorkloads []string `json:"redeployed_workloads"`
+}
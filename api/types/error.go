@@ -2,6 +2,9 @@ package types
 
 const (
 	ErrCodeUnavailable uint = 601
+	// ErrCodeQuotaExceeded is returned when a request would exceed one of the quotas of a project. The error details are
+	// a QuotaExceededDetails.
+	ErrCodeQuotaExceeded uint = 602
 )
 
 type ExternalError struct {
@@ -9,4 +12,7 @@ type ExternalError struct {
 	Code uint `json:"code,omitempty"`
 
 	Error string `json:"error"`
+
+	// Optional details of well-known error types, whose type depends on the error code
+	Details any `json:"details,omitempty"`
 }
@@ -0,0 +1,77 @@
+package types
+
+import "time"
+
+// URLParamEventSinkID is the url param for the id of an event sink
+const URLParamEventSinkID URLParam = "event_sink_id"
+
+// EventSinkType is an external system which kube events are forwarded to
+type EventSinkType string
+
+const (
+	// EventSinkType_Datadog forwards events to the Datadog logs intake
+	EventSinkType_Datadog EventSinkType = "datadog"
+	// EventSinkType_CloudWatch forwards events to a CloudWatch Logs log stream
+	EventSinkType_CloudWatch EventSinkType = "cloudwatch"
+	// EventSinkType_Loki forwards events to the push API of a Loki instance
+	EventSinkType_Loki EventSinkType = "loki"
+)
+
+// DatadogEventSinkConfig is the config of a Datadog event sink
+type DatadogEventSinkConfig struct {
+	// Site is the Datadog site of the account, such as datadoghq.eu. Defaults to datadoghq.com.
+	Site string `json:"site"`
+	// APIKey is only set on requests, and is never returned
+	APIKey string `json:"api_key,omitempty"`
+}
+
+// CloudWatchEventSinkConfig is the config of a CloudWatch Logs event sink
+type CloudWatchEventSinkConfig struct {
+	// AWSIntegrationID is the AWS integration of the project whose credentials are used to write logs
+	AWSIntegrationID uint   `json:"aws_integration_id"`
+	Region           string `json:"region"`
+	LogGroup         string `json:"log_group"`
+	// LogStream is created in the log group if it does not exist
+	LogStream string `json:"log_stream"`
+}
+
+// LokiEventSinkConfig is the config of a Loki event sink
+type LokiEventSinkConfig struct {
+	// URL is the base URL of the Loki instance, which events are pushed to at /loki/api/v1/push
+	URL string `json:"url"`
+	// TenantID is sent in the X-Scope-OrgID header for multi-tenant instances
+	TenantID string `json:"tenant_id,omitempty"`
+	Username string `json:"username,omitempty"`
+	// Password is only set on requests, and is never returned
+	Password string `json:"password,omitempty"`
+}
+
+// EventSinkConfig holds the config of the type of an event sink
+type EventSinkConfig struct {
+	Datadog    *DatadogEventSinkConfig    `json:"datadog,omitempty"`
+	CloudWatch *CloudWatchEventSinkConfig `json:"cloudwatch,omitempty"`
+	Loki       *LokiEventSinkConfig       `json:"loki,omitempty"`
+}
+
+// EventSink forwards the kube events of a project to an external system
+type EventSink struct {
+	ID        uint          `json:"id"`
+	ProjectID uint          `json:"project_id"`
+	Name      string        `json:"name"`
+	Type      EventSinkType `json:"type"`
+	CreatedAt time.Time     `json:"created_at"`
+
+	EventSinkConfig
+}
+
+// CreateEventSinkRequest is the request to create an event sink on a project. The config of the
+// type of the sink must be set.
+type CreateEventSinkRequest struct {
+	Name string        `json:"name" form:"required,max=255"`
+	Type EventSinkType `json:"type" form:"required,oneof=datadog cloudwatch loki"`
+
+	EventSinkConfig
+}
+
+// ListEventSinksResponse is the response for listing the event sinks of a project
+type ListEventSinksResponse []*EventSink
@@ -0,0 +1,93 @@
+package types
+
+import "time"
+
+// URLParamExternalSecretsIntegrationID is the url param for the id of an external secrets integration
+const URLParamExternalSecretsIntegrationID URLParam = "external_secrets_integration_id"
+
+// URLParamExternalSecretsWebhookID is the url param for the webhook id of an external secrets integration
+const URLParamExternalSecretsWebhookID URLParam = "external_secrets_webhook_id"
+
+// ExternalSecretsProvider is the system of record that secrets are synced from
+type ExternalSecretsProvider string
+
+const (
+	// ExternalSecretsProvider_Vault syncs secrets from a HashiCorp Vault KV secret
+	ExternalSecretsProvider_Vault ExternalSecretsProvider = "vault"
+	// ExternalSecretsProvider_Doppler syncs secrets from a Doppler config
+	ExternalSecretsProvider_Doppler ExternalSecretsProvider = "doppler"
+)
+
+// ExternalSecretsIntegration syncs secrets from an external provider into an env group. Provider credentials are never returned.
+type ExternalSecretsIntegration struct {
+	ID        uint      `json:"id"`
+	CreatedAt time.Time `json:"created_at"`
+	ProjectID uint      `json:"project_id"`
+	ClusterID uint      `json:"cluster_id"`
+
+	Provider ExternalSecretsProvider `json:"provider"`
+
+	// EnvironmentGroupName is the env group whose secret variables are replaced by the secrets in the provider
+	EnvironmentGroupName string `json:"environment_group_name"`
+
+	VaultAddress    string `json:"vault_address,omitempty"`
+	VaultNamespace  string `json:"vault_namespace,omitempty"`
+	VaultSecretPath string `json:"vault_secret_path,omitempty"`
+
+	DopplerProject string `json:"doppler_project,omitempty"`
+	DopplerConfig  string `json:"doppler_config,omitempty"`
+
+	// SyncIntervalMinutes is how often secrets are synced. If 0, secrets are only synced manually or via webhook.
+	SyncIntervalMinutes int `json:"sync_interval_minutes"`
+
+	// RedeployLinkedApps syncs each new env group version to the applications linked to the env group
+	RedeployLinkedApps bool `json:"redeploy_linked_apps"`
+
+	// WebhookID identifies the integration in the webhook url, if a webhook secret was configured
+	WebhookID string `json:"webhook_id,omitempty"`
+
+	LastSyncedAt      *time.Time `json:"last_synced_at,omitempty"`
+	LastSyncedVersion int        `json:"last_synced_version,omitempty"`
+	LastSyncError     string     `json:"last_sync_error,omitempty"`
+}
+
+// CreateExternalSecretsIntegrationRequest is the request to create an external secrets integration
+type CreateExternalSecretsIntegrationRequest struct {
+	Provider             ExternalSecretsProvider `json:"provider" form:"required,oneof=vault doppler"`
+	EnvironmentGroupName string                  `json:"environment_group_name" form:"required"`
+
+	VaultAddress    string `json:"vault_address" form:"required_if=Provider vault"`
+	VaultNamespace  string `json:"vault_namespace"`
+	VaultSecretPath string `json:"vault_secret_path" form:"required_if=Provider vault"`
+
+	// DopplerProject and DopplerConfig may be omitted when Token is a Doppler service token, which is scoped to a single config
+	DopplerProject string `json:"doppler_project" form:"required_with=DopplerConfig"`
+	DopplerConfig  string `json:"doppler_config" form:"required_with=DopplerProject"`
+
+	// Token is the Vault token or Doppler token used to read secrets
+	Token string `json:"token" form:"required"`
+
+	SyncIntervalMinutes int  `json:"sync_interval_minutes" form:"min=0"`
+	RedeployLinkedApps  bool `json:"redeploy_linked_apps"`
+
+	// WebhookSecret enables the sync webhook. Webhook requests must be signed with an HMAC-SHA256 of the body using this secret.
+	WebhookSecret string `json:"webhook_secret"`
+}
+
+// CreateExternalSecretsIntegrationResponse is the response to creating an external secrets integration
+type CreateExternalSecretsIntegrationResponse struct {
+	*ExternalSecretsIntegration
+}
+
+// ListExternalSecretsIntegrationsResponse lists the external secrets integrations of a cluster
+type ListExternalSecretsIntegrationsResponse []*ExternalSecretsIntegration
+
+// SyncExternalSecretsResponse is the result of syncing secrets from an external provider
+type SyncExternalSecretsResponse struct {
+	// Changed is false if the secrets in the provider already matched the latest env group version, in which case no version was created
+	Changed bool `json:"changed"`
+	// Version is the latest version of the env group after the sync
+	Version int `json:"version"`
+	// RedeployedApps are the namespaces of the linked applications which were redeployed with the new version
+	RedeployedApps []string `json:"redeployed_apps,omitempty"`
+}
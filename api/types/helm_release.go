@@ -1,7 +1,60 @@
 package types
 
+import "time"
+
 type StreamHelmReleaseRequest struct {
 	Selectors string   `schema:"selectors"`
 	Charts    []string `schema:"charts"`
 	Namespace string   `schema:"namespace"`
 }
+
+// HelmReleaseSummary describes a revision of a Helm release in a cluster, whether or not the
+// release is managed by Porter
+type HelmReleaseSummary struct {
+	Name         string    `json:"name"`
+	Namespace    string    `json:"namespace"`
+	Revision     int       `json:"revision"`
+	Status       string    `json:"status"`
+	Chart        string    `json:"chart"`
+	ChartVersion string    `json:"chart_version"`
+	AppVersion   string    `json:"app_version,omitempty"`
+	Description  string    `json:"description,omitempty"`
+	UpdatedAt    time.Time `json:"updated_at"`
+}
+
+// ListHelmReleasesRequest filters the Helm releases of a cluster
+type ListHelmReleasesRequest struct {
+	// Namespace limits the releases to a single namespace. All namespaces are listed if empty.
+	Namespace string `schema:"namespace"`
+
+	// Statuses limits the releases to those whose latest revision has one of the given statuses,
+	// such as deployed or failed. Releases with any status other than superseded are listed if empty.
+	Statuses []string `schema:"status"`
+}
+
+// ListHelmReleasesResponse is the latest revision of each Helm release of a cluster
+type ListHelmReleasesResponse struct {
+	Releases []HelmReleaseSummary `json:"releases"`
+}
+
+// GetHelmReleaseRequest selects the revision and values of a Helm release
+type GetHelmReleaseRequest struct {
+	// Revision is the revision to get. The latest revision is returned if zero.
+	Revision int `schema:"revision"`
+
+	// AllValues returns the values of the release merged with the chart defaults, instead of only
+	// the values that were supplied when the release was installed or upgraded
+	AllValues bool `schema:"all_values"`
+}
+
+// GetHelmReleaseResponse is a revision of a Helm release with its values
+type GetHelmReleaseResponse struct {
+	Release HelmReleaseSummary     `json:"release"`
+	Values  map[string]interface{} `json:"values"`
+	Notes   string                 `json:"notes,omitempty"`
+}
+
+// GetHelmReleaseHistoryResponse is every stored revision of a Helm release, newest first
+type GetHelmReleaseHistoryResponse struct {
+	Revisions []HelmReleaseSummary `json:"revisions"`
+}
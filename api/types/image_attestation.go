@@ -0,0 +1,60 @@
+package types
+
+import "time"
+
+// ImageAttestation is a signed build provenance attestation of an image built for an app
+type ImageAttestation struct {
+	ID         uint      `json:"id"`
+	CreatedAt  time.Time `json:"created_at"`
+	AppName    string    `json:"app_name"`
+	Repository string    `json:"repository"`
+	Tag        string    `json:"tag"`
+	Digest     string    `json:"digest"`
+	// Reference is where the attestation is stored in the registry
+	Reference string `json:"reference"`
+	// Keyless is true if the attestation was signed with a short-lived Fulcio certificate rather than a key
+	Keyless bool `json:"keyless"`
+}
+
+// CreateImageAttestationRequest is the request to upload the build provenance attestation of an image after it is pushed
+type CreateImageAttestationRequest struct {
+	AppName    string `json:"app_name" form:"required,max=255"`
+	Repository string `json:"repository" form:"required"`
+	Tag        string `json:"tag" form:"required,max=255"`
+	// Digest is the digest of the attested image, e.g. sha256:abc
+	Digest    string `json:"digest" form:"required,startswith=sha256:"`
+	Reference string `json:"reference"`
+	// B64Envelope is the base64-encoded DSSE envelope of the attestation
+	B64Envelope string `json:"b64_envelope" form:"required"`
+	// Certificate and CertificateChain are the PEM-encoded signing certificate of a keyless attestation and its intermediates
+	Certificate      string `json:"certificate,omitempty"`
+	CertificateChain string `json:"certificate_chain,omitempty"`
+}
+
+// ImageSigningPolicy configures how the build provenance attestations of the images of a project are verified. Attestations
+// are either signed with a key, verified with PublicKey, or keyless, verified with KeylessIdentity, KeylessIssuer and FulcioRoots.
+type ImageSigningPolicy struct {
+	ProjectID uint `json:"project_id"`
+
+	// Enforce is true if apps are only deployed with images which have a verified attestation
+	Enforce bool `json:"enforce"`
+
+	// PublicKey is the PEM-encoded public key attestations are signed with, such as cosign.pub
+	PublicKey string `json:"public_key,omitempty"`
+
+	// KeylessIdentity is a regular expression which must match the whole email or URI of the signer of a keyless attestation
+	KeylessIdentity string `json:"keyless_identity,omitempty"`
+	// KeylessIssuer is the OIDC issuer of the identity of the signer, e.g. https://token.actions.githubusercontent.com
+	KeylessIssuer string `json:"keyless_issuer,omitempty"`
+	// FulcioRoots are the PEM-encoded certificates of the Fulcio certificate authorities trusted to issue signing certificates
+	FulcioRoots string `json:"fulcio_roots,omitempty"`
+}
+
+// UpdateImageSigningPolicyRequest is the request to create or update the image signing policy of a project
+type UpdateImageSigningPolicyRequest struct {
+	Enforce         bool   `json:"enforce"`
+	PublicKey       string `json:"public_key"`
+	KeylessIdentity string `json:"keyless_identity"`
+	KeylessIssuer   string `json:"keyless_issuer"`
+	FulcioRoots     string `json:"fulcio_roots"`
+}
@@ -0,0 +1,46 @@
+package types
+
+import "time"
+
+const (
+	// URLParamJobServiceName is the name of a job service of a porter app
+	URLParamJobServiceName URLParam = "job_name"
+)
+
+// Keys of the metadata of JOB_RUN porter app events that describe the run
+const (
+	JobRunMetadata_ServiceName = "service_name"
+	JobRunMetadata_JobName     = "job_name"
+	JobRunMetadata_Namespace   = "namespace"
+	JobRunMetadata_PodName     = "pod_name"
+	JobRunMetadata_ExitCode    = "exit_code"
+	JobRunMetadata_TriggeredBy = "triggered_by"
+	JobRunMetadata_StartTime   = "start_time"
+	JobRunMetadata_EndTime     = "end_time"
+)
+
+// JobRun is a single run of a job service of a porter app
+type JobRun struct {
+	ID          uint   `json:"id"`
+	ServiceName string `json:"service_name"`
+	JobName     string `json:"job_name"`
+	Namespace   string `json:"namespace"`
+	// PodName is the pod that ran the job, which can be used to fetch the logs of the run
+	PodName     string     `json:"pod_name"`
+	Status      string     `json:"status"`
+	ExitCode    *int       `json:"exit_code,omitempty"`
+	TriggeredBy string     `json:"triggered_by"`
+	StartedAt   time.Time  `json:"started_at"`
+	FinishedAt  *time.Time `json:"finished_at,omitempty"`
+}
+
+// ListJobRunsRequest is the request object for listing the runs of a job service
+type ListJobRunsRequest struct {
+	// Since only returns runs that started after this time
+	Since time.Time `schema:"since,omitempty"`
+	// Limit is the maximum number of runs returned, defaulting to 50
+	Limit int `schema:"limit,omitempty"`
+}
+
+// ListJobRunsResponse is the response object for listing the runs of a job service, most recent first
+type ListJobRunsResponse []*JobRun
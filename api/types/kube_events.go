@@ -1,6 +1,12 @@
 package types
 
-import "time"
+import (
+	"encoding/base64"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+)
 
 const (
 	URLParamKubeEventID = "kube_event_id"
@@ -55,12 +61,21 @@ type KubeSubEvent struct {
 	Message   string        `json:"message"`
 	Reason    string        `json:"reason"`
 	Timestamp time.Time     `json:"timestamp"`
+
+	// Count is the number of times the sub event occurred, with Timestamp being the latest occurrence
+	Count uint `json:"count"`
+
+	Severity KubeEventSeverity `json:"severity"`
 }
 
 type ListKubeEventRequest struct {
 	Limit int `schema:"limit"`
 	Skip  int `schema:"skip"`
 
+	// Cursor is the next_cursor of the previous page. When set, events are listed after the cursor
+	// and Skip is ignored.
+	Cursor string `schema:"cursor"`
+
 	Namespace string `schema:"namespace,omitempty"`
 
 	// can only be "timestamp" for now
@@ -77,6 +92,58 @@ type ListKubeEventsResponse struct {
 	Limit int   `json:"limit"`
 	Skip  int   `json:"skip"`
 
+	// NextCursor is the cursor of the next page, which is empty on the last page
+	NextCursor string `json:"next_cursor,omitempty"`
+
+	KubeEvents []*KubeEvent `json:"kube_events"`
+}
+
+// EncodeKubeEventCursor returns the cursor which lists the kube events after an event, which are
+// ordered by updated_at and id
+func EncodeKubeEventCursor(updatedAt time.Time, id uint) string {
+	return base64.RawURLEncoding.EncodeToString(
+		[]byte(fmt.Sprintf("%s,%d", updatedAt.UTC().Format(time.RFC3339Nano), id)),
+	)
+}
+
+// DecodeKubeEventCursor returns the updated_at and id of the event that a cursor was created from
+func DecodeKubeEventCursor(cursor string) (time.Time, uint, error) {
+	decoded, err := base64.RawURLEncoding.DecodeString(cursor)
+	if err != nil {
+		return time.Time{}, 0, fmt.Errorf("invalid cursor: %w", err)
+	}
+
+	updatedAtStr, idStr, ok := strings.Cut(string(decoded), ",")
+	if !ok {
+		return time.Time{}, 0, fmt.Errorf("invalid cursor")
+	}
+
+	updatedAt, err := time.Parse(time.RFC3339Nano, updatedAtStr)
+	if err != nil {
+		return time.Time{}, 0, fmt.Errorf("invalid cursor timestamp: %w", err)
+	}
+
+	id, err := strconv.ParseUint(idStr, 10, 64)
+	if err != nil {
+		return time.Time{}, 0, fmt.Errorf("invalid cursor id: %w", err)
+	}
+
+	return updatedAt, uint(id), nil
+}
+
+// SearchKubeEventsRequest is the request for searching the kube events of a cluster
+type SearchKubeEventsRequest struct {
+	// Query is matched against the names of events and the messages and reasons of their sub events
+	Query string `schema:"query" form:"required"`
+
+	// Since only matches events which were updated after the given time
+	Since time.Time `schema:"since,omitempty"`
+
+	Limit int `schema:"limit"`
+}
+
+// SearchKubeEventsResponse is the response for searching the kube events of a cluster
+type SearchKubeEventsResponse struct {
 	KubeEvents []*KubeEvent `json:"kube_events"`
 }
 
@@ -12,6 +12,7 @@ const (
 	URLParamIngressName     URLParam = "name"
 	URLParamEnvGroupName    URLParam = "name"
 	URLParamEnvGroupVersion URLParam = "version"
+	URLParamServiceName     URLParam = "name"
 )
 
 // ReleaseListFilter is a struct that represents the various filter options used for
@@ -218,6 +219,12 @@ type GetPodLogsRequest struct {
 	Container string `schema:"container_name"`
 }
 
+// TunnelServiceRequest is the request to open a tunnel to a port of a service in the cluster
+type TunnelServiceRequest struct {
+	// Port is the port of the service to tunnel to
+	Port int32 `schema:"port" form:"required"`
+}
+
 type GetPreviousPodLogsRequest struct {
 	Container string `schema:"container_name"`
 }
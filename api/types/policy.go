@@ -21,6 +21,8 @@ const (
 	GitlabIntegrationScope   PermissionScope = "gitlab_integration"
 	PreviewEnvironmentScope  PermissionScope = "preview_environment"
 	APIContractRevisionScope PermissionScope = "contract_revision"
+	PorterAppScope           PermissionScope = "porter_app"
+	EnvGroupScope            PermissionScope = "env_group"
 )
 
 type NameOrUInt struct {
@@ -50,6 +52,8 @@ var ScopeHeirarchy = ScopeTree{
 				ReleaseScope: {},
 			},
 			PreviewEnvironmentScope: {},
+			PorterAppScope:          {},
+			EnvGroupScope:           {},
 		},
 		RegistryScope:        {},
 		HelmRepoScope:        {},
@@ -180,6 +184,13 @@ type CreatePolicy struct {
 	Policy []*PolicyDocument `json:"policy" form:"required"`
 }
 
+// UpdatePolicyRequest replaces the name and documents of a custom policy. Members and API tokens assigned the policy
+// are granted the new permissions on their next request.
+type UpdatePolicyRequest struct {
+	Name   string            `json:"name" form:"required"`
+	Policy []*PolicyDocument `json:"policy" form:"required"`
+}
+
 const URLParamPolicyID URLParam = "policy_id"
 
 type APIPolicyMeta struct {
@@ -1,9 +1,39 @@
 package types
 
 import (
+	"fmt"
+	"strings"
 	"time"
 )
 
+const (
+	// IfMatchHeader is the header which requests that update the configuration of an app use to send the ETag of the
+	// configuration they were made from
+	IfMatchHeader = "If-Match"
+	// ETagHeader is the header which responses use to send the ETag of the current configuration of an app
+	ETagHeader = "ETag"
+)
+
+// AppConfigETag returns the ETag of a revision of the configuration of an app
+func AppConfigETag(configRevision uint) string {
+	return fmt.Sprintf(`"%d"`, configRevision)
+}
+
+// AppConfigETagMatches returns whether an If-Match header matches a revision of the configuration of an app. The header may
+// list several ETags, and "*" matches any revision.
+func AppConfigETagMatches(ifMatch string, configRevision uint) bool {
+	etag := AppConfigETag(configRevision)
+
+	for _, candidate := range strings.Split(ifMatch, ",") {
+		candidate = strings.TrimSpace(candidate)
+		if candidate == "*" || candidate == etag {
+			return true
+		}
+	}
+
+	return false
+}
+
 type PorterApp struct {
 	ID        uint `json:"id"`
 	ProjectID uint `json:"project_id"`
@@ -31,6 +61,14 @@ type PorterApp struct {
 
 	// Helm
 	HelmRevisionNumber int `json:"helm_revision_number,omitempty"`
+
+	// AutoRollback reverts the app to its previous revision when a rollout fails
+	AutoRollback bool `json:"auto_rollback"`
+	// AutoRollbackTimeoutSeconds is how long a rollout can take before it is rolled back. Zero uses the default timeout.
+	AutoRollbackTimeoutSeconds int `json:"auto_rollback_timeout_seconds,omitempty"`
+
+	// ConfigRevision is the revision of the configuration of the app, which is sent in the If-Match header of apply requests
+	ConfigRevision uint `json:"config_revision"`
 }
 
 // swagger:model
@@ -109,6 +147,8 @@ const (
 	PorterAppEventType_PreDeploy PorterAppEventType = "PRE_DEPLOY"
 	// PorterAppEventType_AppEvent represents a Porter Stack App Event which occurred whilst the application was running, such as an OutOfMemory (OOM) error
 	PorterAppEventType_AppEvent PorterAppEventType = "APP_EVENT"
+	// PorterAppEventType_JobRun represents a run of a Porter Stack job service. The run is described by the JobRunMetadata_ keys of the event metadata
+	PorterAppEventType_JobRun PorterAppEventType = "JOB_RUN"
 )
 
 // PorterAppEventStatus is an alias for a string that represents a Porter Stack Event Status
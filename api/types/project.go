@@ -17,6 +17,25 @@ type Project struct {
 	FullAddOns             bool    `json:"full_add_ons"`
 	EnableReprovision      bool    `json:"enable_reprovision"`
 	ValidateApplyV2        bool    `json:"validate_apply_v2"`
+
+	Quotas          ProjectQuotas          `json:"quotas"`
+	ResourcePresets ProjectResourcePresets `json:"resource_presets"`
+}
+
+// ProjectResourcePresets are the resources given to the services of the apps of a project when their porter.yaml does not set
+// them. A value set in porter.yaml takes precedence over a preset, and a preset of 0 leaves the cluster default in place.
+type ProjectResourcePresets struct {
+	CPUCores     float32 `json:"cpu_cores"`
+	RAMMegabytes int     `json:"ram_megabytes"`
+	// Instances is only given to web and worker services
+	Instances int `json:"instances"`
+}
+
+// UpdateProjectResourcePresetsRequest is the request to update the resource presets of a project
+type UpdateProjectResourcePresetsRequest struct {
+	CPUCores     float32 `json:"cpu_cores" form:"min=0,max=64"`
+	RAMMegabytes int     `json:"ram_megabytes" form:"min=0,max=262144"`
+	Instances    int     `json:"instances" form:"min=0,max=100"`
 }
 
 type FeatureFlags struct {
@@ -71,6 +90,12 @@ type Collaborator struct {
 	UserID    uint   `json:"user_id"`
 	Email     string `json:"email"`
 	ProjectID uint   `json:"project_id"`
+	PolicyUID string `json:"policy_uid,omitempty"`
+
+	// ServiceAccount is true if the collaborator is a service account rather than a person
+	ServiceAccount bool `json:"service_account,omitempty"`
+
+	Restrictions *RoleRestrictions `json:"restrictions,omitempty"`
 }
 
 type ListCollaboratorsResponse []*Collaborator
@@ -78,10 +103,29 @@ type ListCollaboratorsResponse []*Collaborator
 type UpdateRoleRequest struct {
 	UserID uint   `json:"user_id,required"`
 	Kind   string `json:"kind,required"`
+	// PolicyUID is the uid of the project policy to assign, required when the kind is custom
+	PolicyUID string `json:"policy_uid"`
+	// Restrictions replaces the cluster and namespace restrictions of the role. The restrictions are kept if unset,
+	// and removed if set without any clusters or namespaces.
+	Restrictions *RoleRestrictions `json:"restrictions"`
 }
 
 type UpdateRoleResponse struct {
 	*Role
+
+	Restrictions *RoleRestrictions `json:"restrictions,omitempty"`
+}
+
+// TransferProjectOwnershipRequest is the request to make another project member the project admin
+type TransferProjectOwnershipRequest struct {
+	// UserID is the id of the member who becomes the project admin. The user must already be a member of the project.
+	UserID uint `json:"user_id" form:"required"`
+}
+
+// TransferProjectOwnershipResponse contains the updated roles of the previous and new project admins
+type TransferProjectOwnershipResponse struct {
+	PreviousAdmin *Role `json:"previous_admin"`
+	NewAdmin      *Role `json:"new_admin"`
 }
 
 type DeleteRoleRequest struct {
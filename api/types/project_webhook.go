@@ -0,0 +1,87 @@
+package types
+
+import "time"
+
+// URLParamProjectWebhookID is the url param for the id of a project webhook
+const URLParamProjectWebhookID URLParam = "webhook_id"
+
+// ProjectWebhookEvent is an event that external systems can subscribe to via a project webhook
+type ProjectWebhookEvent string
+
+const (
+	// ProjectWebhookEvent_DeploySucceeded is sent when an app deploy finishes successfully
+	ProjectWebhookEvent_DeploySucceeded ProjectWebhookEvent = "deploy.succeeded"
+	// ProjectWebhookEvent_DeployFailed is sent when an app deploy fails
+	ProjectWebhookEvent_DeployFailed ProjectWebhookEvent = "deploy.failed"
+	// ProjectWebhookEvent_DeployRolledBack is sent when a failed rollout is automatically rolled back to the previous revision
+	ProjectWebhookEvent_DeployRolledBack ProjectWebhookEvent = "deploy.rolled_back"
+	// ProjectWebhookEvent_BuildFailed is sent when an app build fails
+	ProjectWebhookEvent_BuildFailed ProjectWebhookEvent = "build.failed"
+	// ProjectWebhookEvent_JobCompleted is sent when a job run finishes, regardless of its outcome
+	ProjectWebhookEvent_JobCompleted ProjectWebhookEvent = "job.completed"
+	// ProjectWebhookEvent_KubeEventAlert is sent when a kube event matches an alert rule of the project
+	ProjectWebhookEvent_KubeEventAlert ProjectWebhookEvent = "kube_event.alert"
+)
+
+// ProjectWebhookEvents is the list of all events a project webhook can subscribe to
+var ProjectWebhookEvents = []ProjectWebhookEvent{
+	ProjectWebhookEvent_DeploySucceeded,
+	ProjectWebhookEvent_DeployFailed,
+	ProjectWebhookEvent_DeployRolledBack,
+	ProjectWebhookEvent_BuildFailed,
+	ProjectWebhookEvent_JobCompleted,
+	ProjectWebhookEvent_KubeEventAlert,
+}
+
+// ProjectWebhook is a registered endpoint that receives signed event payloads for a project
+type ProjectWebhook struct {
+	ID        uint      `json:"id"`
+	ProjectID uint      `json:"project_id"`
+	URL       string    `json:"url"`
+	CreatedAt time.Time `json:"created_at"`
+
+	// Events is the list of events this webhook is subscribed to. An empty list subscribes to all events.
+	Events []ProjectWebhookEvent `json:"events"`
+	// Enabled determines whether payloads are dispatched to this webhook
+	Enabled bool `json:"enabled"`
+}
+
+// CreateProjectWebhookRequest is the request to register a new project webhook
+type CreateProjectWebhookRequest struct {
+	URL string `json:"url" form:"required,url"`
+	// Secret is used to sign payloads with HMAC-SHA256. The signature is sent in the X-Porter-Signature header.
+	Secret string                `json:"secret" form:"required"`
+	Events []ProjectWebhookEvent `json:"events" form:"dive,oneof=deploy.succeeded deploy.failed deploy.rolled_back build.failed job.completed kube_event.alert"`
+}
+
+// ListProjectWebhooksResponse is the response for listing project webhooks
+type ListProjectWebhooksResponse []*ProjectWebhook
+
+// ProjectWebhookPayload is the JSON body sent to a project webhook
+type ProjectWebhookPayload struct {
+	// DeliveryID is a unique identifier for this delivery, shared across retries
+	DeliveryID string              `json:"delivery_id"`
+	Event      ProjectWebhookEvent `json:"event"`
+	ProjectID  uint                `json:"project_id"`
+	ClusterID  uint                `json:"cluster_id,omitempty"`
+	AppName    string              `json:"app_name,omitempty"`
+	Timestamp  time.Time           `json:"timestamp"`
+	Data       map[string]any      `json:"data,omitempty"`
+}
+
+// ProjectWebhookDelivery is a record of an attempt to deliver a payload to a project webhook
+type ProjectWebhookDelivery struct {
+	ID         uint                `json:"id"`
+	WebhookID  uint                `json:"webhook_id"`
+	DeliveryID string              `json:"delivery_id"`
+	Event      ProjectWebhookEvent `json:"event"`
+	Attempts   int                 `json:"attempts"`
+	StatusCode int                 `json:"status_code"`
+	Success    bool                `json:"success"`
+	Error      string              `json:"error,omitempty"`
+	CreatedAt  time.Time           `json:"created_at"`
+	UpdatedAt  time.Time           `json:"updated_at"`
+}
+
+// ListProjectWebhookDeliveriesResponse is the response for listing the delivery history of a webhook
+type ListProjectWebhookDeliveriesResponse []*ProjectWebhookDelivery
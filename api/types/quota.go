@@ -0,0 +1,35 @@
+package types
+
+// ProjectQuota is the name of a limit on the resources of a project
+type ProjectQuota string
+
+const (
+	// ProjectQuota_Apps limits the number of apps in a project
+	ProjectQuota_Apps ProjectQuota = "apps"
+	// ProjectQuota_Clusters limits the number of clusters in a project
+	ProjectQuota_Clusters ProjectQuota = "clusters"
+	// ProjectQuota_CPUCores limits the total cpu cores requested by the apps of a project
+	ProjectQuota_CPUCores ProjectQuota = "cpu_cores"
+	// ProjectQuota_RAMMegabytes limits the total memory in megabytes requested by the apps of a project
+	ProjectQuota_RAMMegabytes ProjectQuota = "ram_megabytes"
+)
+
+// ProjectQuotas are the limits on the resources of a project. A limit of 0 means the resource is unlimited.
+type ProjectQuotas struct {
+	MaxApps         uint    `json:"max_apps"`
+	MaxClusters     uint    `json:"max_clusters"`
+	MaxCPUCores     float64 `json:"max_cpu_cores"`
+	MaxRAMMegabytes int64   `json:"max_ram_megabytes"`
+}
+
+// QuotaExceededDetails are the details of an error with code ErrCodeQuotaExceeded
+type QuotaExceededDetails struct {
+	// Quota is the quota which would be exceeded
+	Quota ProjectQuota `json:"quota"`
+	// Limit is the limit of the quota
+	Limit float64 `json:"limit"`
+	// Current is the amount of the quota used by the project, excluding the app or cluster of the request
+	Current float64 `json:"current"`
+	// Requested is the amount of the quota used by the app or cluster of the request
+	Requested float64 `json:"requested"`
+}
@@ -102,6 +102,8 @@ const (
 	ACR       RegistryService = "acr"
 	DOCR      RegistryService = "docr"
 	DockerHub RegistryService = "dockerhub"
+	GHCR      RegistryService = "ghcr"
+	Quay      RegistryService = "quay"
 )
 
 // swagger:model ListRegistriesResponse
@@ -180,6 +182,12 @@ type GetRegistryACRTokenRequest struct {
 	ServerURL string `schema:"server_url"`
 }
 
+// GetRegistryBasicTokenRequest is the request for the credentials of a registry which authenticates with a
+// username and token, such as ghcr.io or quay.io
+type GetRegistryBasicTokenRequest struct {
+	ServerURL string `schema:"server_url"`
+}
+
 type GetRegistryGCRTokenRequest struct {
 	ServerURL string `schema:"server_url"`
 }
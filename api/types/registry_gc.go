@@ -0,0 +1,62 @@
+package types
+
+import "time"
+
+// RegistryGCPolicy configures which image tags are garbage collected from the registries connected to a project.
+// Tags which are referenced by a workload in one of the project's clusters are never deleted.
+type RegistryGCPolicy struct {
+	ProjectID uint `json:"project_id"`
+
+	// Enabled is true if the policy is applied periodically by a background job
+	Enabled bool `json:"enabled"`
+
+	// KeepLastN is the number of most recently pushed tags which are always kept in each repository
+	KeepLastN uint `json:"keep_last_n"`
+
+	// MaxAgeDays is the age in days after which a tag which is not one of the most recent KeepLastN tags is deleted.
+	// If unset, every tag which is not one of the most recent KeepLastN tags is deleted.
+	MaxAgeDays uint `json:"max_age_days"`
+
+	// LastRunAt is the last time the policy was applied by the background job
+	LastRunAt *time.Time `json:"last_run_at,omitempty"`
+}
+
+// UpdateRegistryGCPolicyRequest is the request to create or update the registry garbage collection policy of a project
+type UpdateRegistryGCPolicyRequest struct {
+	Enabled    bool `json:"enabled"`
+	KeepLastN  uint `json:"keep_last_n"`
+	MaxAgeDays uint `json:"max_age_days"`
+}
+
+// RunRegistryGCRequest is the request to garbage collect the registries connected to a project
+type RunRegistryGCRequest struct {
+	// DryRun lists the tags which would be deleted without deleting them
+	DryRun bool `json:"dry_run"`
+}
+
+// RegistryGCTag is an image tag which was, or would be, deleted by garbage collection
+type RegistryGCTag struct {
+	RepositoryName string     `json:"repository_name"`
+	Tag            string     `json:"tag"`
+	Digest         string     `json:"digest,omitempty"`
+	PushedAt       *time.Time `json:"pushed_at,omitempty"`
+}
+
+// RegistryGCResult is the result of garbage collecting a single registry
+type RegistryGCResult struct {
+	RegistryID   uint            `json:"registry_id"`
+	RegistryName string          `json:"registry_name"`
+	DeletedTags  []RegistryGCTag `json:"deleted_tags"`
+
+	// Errors lists the repositories which could not be garbage collected
+	Errors []string `json:"errors,omitempty"`
+
+	// Skipped is set with the reason if the registry type does not support garbage collection
+	Skipped string `json:"skipped,omitempty"`
+}
+
+// RunRegistryGCResponse is the response from garbage collecting the registries connected to a project
+type RunRegistryGCResponse struct {
+	DryRun     bool               `json:"dry_run"`
+	Registries []RegistryGCResult `json:"registries"`
+}
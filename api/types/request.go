@@ -1,5 +1,7 @@
 package types
 
+import "time"
+
 type APIVerb string
 
 const (
@@ -33,25 +35,33 @@ const (
 type URLParam string
 
 const (
-	URLParamProjectID             URLParam = "project_id"
-	URLParamClusterID             URLParam = "cluster_id"
-	URLParamRegistryID            URLParam = "registry_id"
-	URLParamHelmRepoID            URLParam = "helm_repo_id"
-	URLParamGitInstallationID     URLParam = "git_installation_id"
-	URLParamInfraID               URLParam = "infra_id"
-	URLParamOperationID           URLParam = "operation_id"
-	URLParamInviteID              URLParam = "invite_id"
-	URLParamNamespace             URLParam = "namespace"
-	URLParamReleaseName           URLParam = "name"
-	URLParamPorterAppID           URLParam = "porter_app_id"
-	URLParamStackID               URLParam = "stack_id"
-	URLParamReleaseVersion        URLParam = "version"
-	URLParamWildcard              URLParam = "*"
-	URLParamIntegrationID         URLParam = "integration_id"
-	URLParamAPIContractRevisionID URLParam = "contract_revision_id"
-	URLParamStackEventID          URLParam = "stack_event_id"
-	URLParamPorterAppName         URLParam = "porter_app_name"
-	URLParamPorterAppEventID      URLParam = "porter_app_event_id"
+	URLParamProjectID              URLParam = "project_id"
+	URLParamClusterID              URLParam = "cluster_id"
+	URLParamRegistryID             URLParam = "registry_id"
+	URLParamHelmRepoID             URLParam = "helm_repo_id"
+	URLParamGitInstallationID      URLParam = "git_installation_id"
+	URLParamInfraID                URLParam = "infra_id"
+	URLParamOperationID            URLParam = "operation_id"
+	URLParamInviteID               URLParam = "invite_id"
+	URLParamNamespace              URLParam = "namespace"
+	URLParamReleaseName            URLParam = "name"
+	URLParamPorterAppID            URLParam = "porter_app_id"
+	URLParamStackID                URLParam = "stack_id"
+	URLParamReleaseVersion         URLParam = "version"
+	URLParamWildcard               URLParam = "*"
+	URLParamIntegrationID          URLParam = "integration_id"
+	URLParamAPIContractRevisionID  URLParam = "contract_revision_id"
+	URLParamStackEventID           URLParam = "stack_event_id"
+	URLParamPorterAppName          URLParam = "porter_app_name"
+	URLParamPorterAppEventID       URLParam = "porter_app_event_id"
+	URLParamBuildLogID             URLParam = "build_log_id"
+	URLParamAppRevisionID          URLParam = "app_revision_id"
+	URLParamFromRevisionNumber     URLParam = "from_revision_number"
+	URLParamToRevisionNumber       URLParam = "to_revision_number"
+	URLParamSCIMUserID             URLParam = "scim_user_id"
+	URLParamSCIMGroupID            URLParam = "scim_group_id"
+	URLParamSCIMGroupRoleMappingID URLParam = "scim_group_role_mapping_id"
+	URLParamAppTemplateName        URLParam = "app_template_name"
 )
 
 type Path struct {
@@ -77,10 +87,47 @@ type APIRequestMetadata struct {
 
 	// The usage metric that the request should check for, if CheckUsage
 	UsageMetric UsageMetric
+
+	// Whether users who enabled multi-factor authentication must have verified a code recently
+	RequiresMFAElevation bool
+
+	// The limit on how many requests a client can send to the endpoint, if the endpoint is rate limited
+	RateLimit *RateLimit
+
+	// The request and response types of the endpoint, e.g. &ApplyPorterAppRequest{}, which describe the endpoint in the
+	// OpenAPI document of the API. The request is read from the query string of GET and DELETE requests.
+	Request  any
+	Response any
+}
+
+// RateLimitKey is what requests are grouped by when they are counted against a rate limit
+type RateLimitKey string
+
+const (
+	// RateLimitKeyIP counts requests per client IP address
+	RateLimitKeyIP RateLimitKey = "ip"
+	// RateLimitKeyUser counts requests per authenticated user, or per client IP address for API tokens
+	RateLimitKeyUser RateLimitKey = "user"
+	// RateLimitKeyProject counts requests per project
+	RateLimitKeyProject RateLimitKey = "project"
+)
+
+// RateLimit limits the number of requests to an endpoint in a window of time
+type RateLimit struct {
+	// Name identifies the limit. Endpoints with the same name share their counts, and the requests and window of a limit
+	// can be overridden by name with the RATE_LIMIT_OVERRIDES server setting.
+	Name     string
+	Key      RateLimitKey
+	Requests int
+	Window   time.Duration
 }
 
 const RequestScopeCtxKey = "requestscopes"
 
+// PolicyDocumentsCtxKey is the context key of the policy documents which authorized a request, for handlers which
+// check access to resources that are not named in the request path
+const PolicyDocumentsCtxKey = "policydocuments"
+
 type RequestAction struct {
 	Verb     APIVerb
 	Resource NameOrUInt
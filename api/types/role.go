@@ -13,4 +13,17 @@ type Role struct {
 	Kind      RoleKind `json:"kind"`
 	UserID    uint     `json:"user_id"`
 	ProjectID uint     `json:"project_id"`
+
+	// PolicyUID is the uid of the project policy which grants the permissions of a custom role. It is only set when
+	// the kind is custom.
+	PolicyUID string `json:"policy_uid,omitempty"`
+}
+
+// RoleRestrictions limits a project role to some of the clusters and namespaces of the project, e.g. to give a
+// contractor access to staging clusters only. Restrictions apply on top of the permissions of the role kind.
+type RoleRestrictions struct {
+	// ClusterIDs are the ids of the clusters the role applies to. The role applies to all clusters if empty.
+	ClusterIDs []uint `json:"cluster_ids"`
+	// Namespaces are the namespaces the role applies to, in each of its clusters. The role applies to all namespaces if empty.
+	Namespaces []string `json:"namespaces" form:"dive,required,max=63,excludesall=0x2C"`
 }
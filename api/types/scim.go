@@ -0,0 +1,110 @@
+package types
+
+import "time"
+
+// The schema URNs of the SCIM v2 resources and messages, see RFC 7643 and RFC 7644
+const (
+	SCIMSchemaUser         = "urn:ietf:params:scim:schemas:core:2.0:User"
+	SCIMSchemaGroup        = "urn:ietf:params:scim:schemas:core:2.0:Group"
+	SCIMSchemaListResponse = "urn:ietf:params:scim:api:messages:2.0:ListResponse"
+	SCIMSchemaPatchOp      = "urn:ietf:params:scim:api:messages:2.0:PatchOp"
+)
+
+// SCIMName is the name of a SCIM user
+type SCIMName struct {
+	GivenName  string `json:"givenName,omitempty"`
+	FamilyName string `json:"familyName,omitempty"`
+}
+
+// SCIMEmail is an email address of a SCIM user
+type SCIMEmail struct {
+	Value   string `json:"value"`
+	Type    string `json:"type,omitempty"`
+	Primary bool   `json:"primary,omitempty"`
+}
+
+// SCIMMeta is the metadata of a SCIM resource
+type SCIMMeta struct {
+	ResourceType string    `json:"resourceType"`
+	Created      time.Time `json:"created"`
+	LastModified time.Time `json:"lastModified"`
+}
+
+// SCIMUser is a user resource as sent and received by identity providers
+type SCIMUser struct {
+	Schemas    []string `json:"schemas"`
+	ID         string   `json:"id,omitempty"`
+	ExternalID string   `json:"externalId,omitempty"`
+	// UserName is the email of the user
+	UserName string      `json:"userName" form:"required,email"`
+	Name     *SCIMName   `json:"name,omitempty"`
+	Emails   []SCIMEmail `json:"emails,omitempty"`
+	// Active defaults to true if it is not sent
+	Active *bool     `json:"active,omitempty"`
+	Meta   *SCIMMeta `json:"meta,omitempty"`
+}
+
+// SCIMGroupMember is a member of a SCIM group, referenced by the id of its SCIM user
+type SCIMGroupMember struct {
+	Value   string `json:"value"`
+	Display string `json:"display,omitempty"`
+}
+
+// SCIMGroup is a group resource as sent and received by identity providers
+type SCIMGroup struct {
+	Schemas     []string          `json:"schemas"`
+	ID          string            `json:"id,omitempty"`
+	ExternalID  string            `json:"externalId,omitempty"`
+	DisplayName string            `json:"displayName" form:"required"`
+	Members     []SCIMGroupMember `json:"members"`
+	Meta        *SCIMMeta         `json:"meta,omitempty"`
+}
+
+// SCIMListRequest is the query of a SCIM list request. Only filters of the form `attribute eq "value"` are supported.
+type SCIMListRequest struct {
+	Filter     string `schema:"filter"`
+	StartIndex int    `schema:"startIndex"`
+	Count      int    `schema:"count"`
+
+	// Attributes and ExcludedAttributes are accepted but ignored, since resources are always returned in full
+	Attributes         string `schema:"attributes"`
+	ExcludedAttributes string `schema:"excludedAttributes"`
+}
+
+// SCIMListResponse is a page of SCIM resources
+type SCIMListResponse struct {
+	Schemas      []string `json:"schemas"`
+	TotalResults int      `json:"totalResults"`
+	StartIndex   int      `json:"startIndex"`
+	ItemsPerPage int      `json:"itemsPerPage"`
+	Resources    any      `json:"Resources"`
+}
+
+// SCIMPatchRequest is a SCIM PATCH request
+type SCIMPatchRequest struct {
+	Schemas    []string             `json:"schemas"`
+	Operations []SCIMPatchOperation `json:"Operations" form:"required,min=1"`
+}
+
+// SCIMPatchOperation is a single operation of a SCIM PATCH request. Op is one of add, remove or replace, in any case.
+type SCIMPatchOperation struct {
+	Op    string `json:"op" form:"required"`
+	Path  string `json:"path"`
+	Value any    `json:"value"`
+}
+
+// SCIMGroupRoleMapping maps an identity provider group to the project role given to its members
+type SCIMGroupRoleMapping struct {
+	ID               uint     `json:"id"`
+	GroupDisplayName string   `json:"group_display_name"`
+	RoleKind         RoleKind `json:"role_kind"`
+}
+
+// CreateSCIMGroupRoleMappingRequest is the request to map an identity provider group to a project role
+type CreateSCIMGroupRoleMappingRequest struct {
+	GroupDisplayName string   `json:"group_display_name" form:"required"`
+	RoleKind         RoleKind `json:"role_kind" form:"required,oneof=admin developer viewer"`
+}
+
+// ListSCIMGroupRoleMappingsResponse is the list of group role mappings of a project
+type ListSCIMGroupRoleMappingsResponse []*SCIMGroupRoleMapping
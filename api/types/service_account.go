@@ -0,0 +1,50 @@
+package types
+
+import "time"
+
+const URLParamServiceAccountID URLParam = "service_account_id"
+
+// ServiceAccount is a non-human user of a project, such as a CI pipeline, which authenticates with a token rather than
+// logging in. Service accounts are granted a project role like any other collaborator.
+type ServiceAccount struct {
+	ID        uint   `json:"id"`
+	ProjectID uint   `json:"project_id"`
+	UserID    uint   `json:"user_id"`
+	Name      string `json:"name"`
+	Email     string `json:"email"`
+
+	CreatedByUserID uint      `json:"created_by_user_id"`
+	CreatedAt       time.Time `json:"created_at"`
+
+	// ExpiresAt is when the service account stops being able to authenticate. Service accounts without an expiry
+	// authenticate until they are deleted.
+	ExpiresAt *time.Time `json:"expires_at,omitempty"`
+
+	// TokenIssuedAt is when the current token of the service account was issued
+	TokenIssuedAt *time.Time `json:"token_issued_at,omitempty"`
+
+	Role *Role `json:"role,omitempty"`
+}
+
+type CreateServiceAccountRequest struct {
+	Name string `json:"name" form:"required,max=255"`
+	Kind string `json:"kind" form:"required"`
+	// PolicyUID is the uid of the project policy to assign, required when the kind is custom
+	PolicyUID string     `json:"policy_uid"`
+	ExpiresAt *time.Time `json:"expires_at"`
+}
+
+// CreateServiceAccountResponse is the created service account along with its token, which is only returned once
+type CreateServiceAccountResponse struct {
+	*ServiceAccount
+
+	Token string `json:"token"`
+}
+
+type ListServiceAccountsResponse []*ServiceAccount
+
+// RotateServiceAccountTokenResponse is the new token of a service account. The previous token stops working once the
+// new token is issued.
+type RotateServiceAccountTokenResponse struct {
+	Token string `json:"token"`
+}
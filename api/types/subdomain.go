@@ -0,0 +1,49 @@
+package types
+
+import "time"
+
+// URLParamSubdomainName is the url param for the name of an allocated subdomain
+const URLParamSubdomainName URLParam = "subdomain_name"
+
+// WildcardDomain is the domain of a project from which apps are allocated subdomains, such as apps.example.com. A wildcard
+// DNS record for the domain must point to the ingress of the clusters of the project.
+type WildcardDomain struct {
+	Domain    string    `json:"domain"`
+	CreatedAt time.Time `json:"created_at"`
+}
+
+// SetWildcardDomainRequest sets the wildcard domain of a project
+type SetWildcardDomainRequest struct {
+	// Domain is the parent domain of the subdomains, with or without the leading *.
+	Domain string `json:"domain" form:"required"`
+}
+
+// SubdomainAllocation is a subdomain of the wildcard domain of a project, allocated to a service of an app or reserved so that
+// it is not allocated automatically
+type SubdomainAllocation struct {
+	// Name is the label of the subdomain under the wildcard domain
+	Name string `json:"name"`
+	// Hostname is the name and the wildcard domain of the project
+	Hostname  string `json:"hostname"`
+	ClusterID uint   `json:"cluster_id,omitempty"`
+	AppName   string `json:"app_name,omitempty"`
+	// ServiceName is the web service the subdomain routes to, and is empty if the subdomain is only reserved
+	ServiceName string `json:"service_name,omitempty"`
+	// DeploymentTargetID is set if the subdomain was allocated to a preview environment, and is released with it
+	DeploymentTargetID string    `json:"deployment_target_id,omitempty"`
+	CreatedAt          time.Time `json:"created_at"`
+}
+
+// ListSubdomainAllocationsResponse is the wildcard domain of a project and the subdomains allocated from it
+type ListSubdomainAllocationsResponse struct {
+	WildcardDomain string                `json:"wildcard_domain"`
+	Allocations    []SubdomainAllocation `json:"allocations"`
+}
+
+// ReserveSubdomainRequest reserves a subdomain of the wildcard domain of a project, optionally for a service of an app
+type ReserveSubdomainRequest struct {
+	Name        string `json:"name" form:"required,dns1123"`
+	ClusterID   uint   `json:"cluster_id" form:"required_with=AppName"`
+	AppName     string `json:"app_name" form:"required_with=ServiceName"`
+	ServiceName string `json:"service_name"`
+}
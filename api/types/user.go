@@ -1,12 +1,20 @@
 package types
 
+import "time"
+
+// MFACodeHeader is the header which clients without a session, such as the CLI, use to send a multi-factor authentication
+// code for operations which require an elevated session
+const MFACodeHeader = "X-Porter-MFA-Code"
+
 type User struct {
-	ID            uint   `json:"id"`
-	Email         string `json:"email"`
-	EmailVerified bool   `json:"email_verified"`
-	FirstName     string `json:"first_name"`
-	LastName      string `json:"last_name"`
-	CompanyName   string `json:"company_name"`
+	ID             uint   `json:"id"`
+	Email          string `json:"email"`
+	EmailVerified  bool   `json:"email_verified"`
+	MFAEnabled     bool   `json:"mfa_enabled"`
+	ServiceAccount bool   `json:"service_account,omitempty"`
+	FirstName      string `json:"first_name"`
+	LastName       string `json:"last_name"`
+	CompanyName    string `json:"company_name"`
 }
 
 type CreateUserRequest struct {
@@ -25,6 +33,8 @@ type GetAuthenticatedUserResponse User
 type LoginUserRequest struct {
 	Email    string `json:"email" form:"required,max=255,email"`
 	Password string `json:"password" form:"required,max=255"`
+	// MFACode is a TOTP code or recovery code, which is required if the user has enabled multi-factor authentication
+	MFACode string `json:"mfa_code,omitempty" form:"max=255"`
 }
 
 type LoginUserResponse User
@@ -66,7 +76,15 @@ type FinalizeResetUserPasswordRequest struct {
 	NewPassword string `json:"new_password" form:"required,max=255"`
 }
 
-type ListUserProjectsResponse []*Project
+type ListUserProjectsResponse []*UserProject
+
+// UserProject is a project along with the role of the authenticated user in that project
+type UserProject struct {
+	Project
+
+	// RoleKind is the kind of role the authenticated user has in the project
+	RoleKind RoleKind `json:"role_kind"`
+}
 
 type WelcomeWebhookRequest struct {
 	Email     string `json:"email" schema:"email"`
@@ -81,3 +99,32 @@ type UpdateUserInfoRequest struct {
 	LastName    string `json:"last_name" form:"required,max=255"`
 	CompanyName string `json:"company_name" form:"required,max=255"`
 }
+
+// EnrollMFAResponse is the TOTP secret of a new multi-factor authentication enrollment, which the user adds to their
+// authenticator app before activating the enrollment
+type EnrollMFAResponse struct {
+	Secret string `json:"secret"`
+	// KeyURI is the otpauth:// URI of the secret, which is usually shown as a QR code
+	KeyURI string `json:"key_uri"`
+}
+
+// VerifyMFACodeRequest is a TOTP code or recovery code of the authenticated user
+type VerifyMFACodeRequest struct {
+	Code string `json:"code" form:"required,max=255"`
+}
+
+// MFARecoveryCodesResponse is the list of recovery codes of the authenticated user, which are only shown once
+type MFARecoveryCodesResponse struct {
+	RecoveryCodes []string `json:"recovery_codes"`
+}
+
+// ElevateMFAResponse is returned when the session of the authenticated user is elevated for sensitive operations
+type ElevateMFAResponse struct {
+	ElevatedUntil time.Time `json:"elevated_until"`
+}
+
+// GetMFAResponse is the multi-factor authentication status of the authenticated user
+type GetMFAResponse struct {
+	Enabled                bool `json:"enabled"`
+	RemainingRecoveryCodes int  `json:"remaining_recovery_codes"`
+}
@@ -0,0 +1,294 @@
+package commands
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"strings"
+	"text/tabwriter"
+
+	"github.com/fatih/color"
+	"github.com/ghodss/yaml"
+	api "github.com/porter-dev/porter/api/client"
+	"github.com/porter-dev/porter/api/types"
+	"github.com/porter-dev/porter/cli/cmd/config"
+	"github.com/porter-dev/porter/cli/cmd/utils"
+	"github.com/spf13/cobra"
+)
+
+var (
+	addonName         string
+	addonNamespace    string
+	addonChartVersion string
+	addonInputs       map[string]string
+	addonValuesFile   string
+)
+
+func registerCommand_Addon(cliConf config.CLIConfig) *cobra.Command {
+	addonCmd := &cobra.Command{
+		Use:     "addon",
+		Aliases: []string{"addons"},
+		Short:   "Commands that install curated charts, such as Redis or PostgreSQL, into the current cluster",
+	}
+
+	addonTemplatesCmd := &cobra.Command{
+		Use:   "templates",
+		Short: "Lists the addons which can be installed and the inputs of their install forms",
+		Run: func(cmd *cobra.Command, args []string) {
+			err := checkLoginAndRunWithConfig(cmd.Context(), cliConf, args, listAddonTemplates)
+			if err != nil {
+				os.Exit(1)
+			}
+		},
+	}
+	addonCmd.AddCommand(addonTemplatesCmd)
+
+	addonListCmd := &cobra.Command{
+		Use:   "list",
+		Short: "Lists the addons installed into the current cluster",
+		Run: func(cmd *cobra.Command, args []string) {
+			err := checkLoginAndRunWithConfig(cmd.Context(), cliConf, args, listInstalledAddons)
+			if err != nil {
+				os.Exit(1)
+			}
+		},
+	}
+	addonCmd.AddCommand(addonListCmd)
+
+	addonInstallCmd := &cobra.Command{
+		Use:   "install [type]",
+		Args:  cobra.ExactArgs(1),
+		Short: "Installs an addon into the current cluster",
+		Long: fmt.Sprintf(`
+%s
+
+Installs an addon into the current cluster. The inputs of the install form of the addon are set
+with --input, and can be listed with "porter addon templates". Inputs which are not set use their
+defaults. For example, to install a PostgreSQL database named app-db:
+
+  %s
+
+Raw Helm values, which take precedence over the inputs, can be passed with --values.
+`,
+			color.New(color.FgBlue, color.Bold).Sprintf("Help for \"porter addon install\":"),
+			color.New(color.FgGreen, color.Bold).Sprintf("porter addon install postgres --name app-db --input password=secret"),
+		),
+		Run: func(cmd *cobra.Command, args []string) {
+			err := checkLoginAndRunWithConfig(cmd.Context(), cliConf, args, installAddon)
+			if err != nil {
+				os.Exit(1)
+			}
+		},
+	}
+	addonInstallCmd.Flags().StringVar(&addonName, "name", "", "the name of the addon, which defaults to its type")
+	addonInstallCmd.Flags().StringVarP(&addonNamespace, "namespace", "n", "default", "the namespace to install the addon into")
+	addonInstallCmd.Flags().StringVar(&addonChartVersion, "version", "", "the version of the chart, which defaults to the latest version")
+	addonInstallCmd.Flags().StringToStringVar(&addonInputs, "input", nil, "an input of the install form, as key=value")
+	addonInstallCmd.Flags().StringVar(&addonValuesFile, "values", "", "path to a file of raw Helm values")
+	addonCmd.AddCommand(addonInstallCmd)
+
+	addonUpgradeCmd := &cobra.Command{
+		Use:   "upgrade [name]",
+		Args:  cobra.ExactArgs(1),
+		Short: "Changes the inputs or chart version of an addon",
+		Run: func(cmd *cobra.Command, args []string) {
+			err := checkLoginAndRunWithConfig(cmd.Context(), cliConf, args, upgradeAddon)
+			if err != nil {
+				os.Exit(1)
+			}
+		},
+	}
+	addonUpgradeCmd.Flags().StringVar(&addonChartVersion, "version", "", "the version of the chart to upgrade to")
+	addonUpgradeCmd.Flags().StringToStringVar(&addonInputs, "input", nil, "an input of the install form to change, as key=value")
+	addonUpgradeCmd.Flags().StringVar(&addonValuesFile, "values", "", "path to a file of raw Helm values, which replace the current raw values")
+	addonCmd.AddCommand(addonUpgradeCmd)
+
+	addonUninstallCmd := &cobra.Command{
+		Use:   "uninstall [name]",
+		Args:  cobra.ExactArgs(1),
+		Short: "Uninstalls an addon from the current cluster",
+		Run: func(cmd *cobra.Command, args []string) {
+			err := checkLoginAndRunWithConfig(cmd.Context(), cliConf, args, uninstallAddon)
+			if err != nil {
+				os.Exit(1)
+			}
+		},
+	}
+	addonCmd.AddCommand(addonUninstallCmd)
+
+	return addonCmd
+}
+
+func listAddonTemplates(ctx context.Context, _ *types.GetAuthenticatedUserResponse, client api.Client, cliConf config.CLIConfig, args []string) error {
+	resp, err := client.ListAddonTemplates(ctx, cliConf.Project, cliConf.Cluster)
+	if err != nil {
+		return fmt.Errorf("error listing addon templates: %w", err)
+	}
+
+	if utils.IsStructuredOutput() {
+		return utils.PrintStructured(resp)
+	}
+
+	for _, template := range resp.Templates {
+		color.New(color.FgGreen, color.Bold).Printf("%s (%s)\n", template.Name, template.Type)
+		fmt.Printf("%s\n\n", template.Description)
+
+		w := new(tabwriter.Writer)
+		w.Init(os.Stdout, 3, 8, 2, ' ', 0)
+
+		fmt.Fprintf(w, "  %s\t%s\t%s\t%s\n", "INPUT", "TYPE", "DEFAULT", "DESCRIPTION")
+
+		for _, field := range template.Form {
+			fieldType := string(field.Type)
+			if len(field.Options) > 0 {
+				fieldType = strings.Join(field.Options, "|")
+			}
+
+			defaultValue := field.Default
+			if field.Required && defaultValue == "" {
+				defaultValue = "(required)"
+			}
+
+			fmt.Fprintf(w, "  %s\t%s\t%s\t%s\n", field.Key, fieldType, defaultValue, field.Description)
+		}
+
+		w.Flush()
+		fmt.Println()
+	}
+
+	return nil
+}
+
+func listInstalledAddons(ctx context.Context, _ *types.GetAuthenticatedUserResponse, client api.Client, cliConf config.CLIConfig, args []string) error {
+	resp, err := client.ListAddons(ctx, cliConf.Project, cliConf.Cluster)
+	if err != nil {
+		return fmt.Errorf("error listing addons: %w", err)
+	}
+
+	if utils.IsStructuredOutput() {
+		return utils.PrintStructured(resp)
+	}
+
+	if len(resp.Addons) == 0 {
+		fmt.Println("No addons found")
+		return nil
+	}
+
+	w := new(tabwriter.Writer)
+	w.Init(os.Stdout, 3, 8, 2, ' ', 0)
+
+	fmt.Fprintf(w, "%s\t%s\t%s\t%s\t%s\n", "NAME", "NAMESPACE", "TYPE", "VERSION", "STATUS")
+
+	for _, addon := range resp.Addons {
+		version := addon.ChartVersion
+		if version == "" {
+			version = "latest"
+		}
+
+		status := addon.Status
+		if status == "" {
+			status = "missing"
+		}
+
+		fmt.Fprintf(w, "%s\t%s\t%s\t%s\t%s\n", addon.Name, addon.Namespace, addon.Type, version, status)
+	}
+
+	w.Flush()
+
+	return nil
+}
+
+func installAddon(ctx context.Context, _ *types.GetAuthenticatedUserResponse, client api.Client, cliConf config.CLIConfig, args []string) error {
+	values, err := readAddonValuesFile()
+	if err != nil {
+		return err
+	}
+
+	name := addonName
+	if name == "" {
+		name = args[0]
+	}
+
+	color.New(color.FgGreen).Printf("Installing %s addon %s into namespace %s\n", args[0], name, addonNamespace)
+
+	addon, err := client.InstallAddon(ctx, cliConf.Project, cliConf.Cluster, &types.InstallAddonRequest{
+		Type:         types.AddonType(args[0]),
+		Name:         name,
+		Namespace:    addonNamespace,
+		ChartVersion: addonChartVersion,
+		Inputs:       addonInputs,
+		Values:       values,
+	})
+	if err != nil {
+		return fmt.Errorf("error installing addon: %w", err)
+	}
+
+	color.New(color.FgGreen).Printf("Installed addon %s\n", addon.Name)
+
+	return nil
+}
+
+func upgradeAddon(ctx context.Context, _ *types.GetAuthenticatedUserResponse, client api.Client, cliConf config.CLIConfig, args []string) error {
+	values, err := readAddonValuesFile()
+	if err != nil {
+		return err
+	}
+
+	addon, err := client.UpgradeAddon(ctx, cliConf.Project, cliConf.Cluster, args[0], &types.UpgradeAddonRequest{
+		ChartVersion: addonChartVersion,
+		Inputs:       addonInputs,
+		Values:       values,
+	})
+	if err != nil {
+		return fmt.Errorf("error upgrading addon: %w", err)
+	}
+
+	color.New(color.FgGreen).Printf("Upgraded addon %s\n", addon.Name)
+
+	return nil
+}
+
+func uninstallAddon(ctx context.Context, _ *types.GetAuthenticatedUserResponse, client api.Client, cliConf config.CLIConfig, args []string) error {
+	userResp, err := utils.PromptPlaintext(
+		fmt.Sprintf(
+			`Are you sure you'd like to uninstall the addon %s? Its data may be deleted. %s `,
+			args[0],
+			color.New(color.FgCyan).Sprintf("[y/n]"),
+		),
+	)
+	if err != nil {
+		return err
+	}
+
+	if userResp := strings.ToLower(userResp); userResp != "y" && userResp != "yes" {
+		return nil
+	}
+
+	if err := client.UninstallAddon(ctx, cliConf.Project, cliConf.Cluster, args[0]); err != nil {
+		return fmt.Errorf("error uninstalling addon: %w", err)
+	}
+
+	color.New(color.FgGreen).Printf("Uninstalled addon %s\n", args[0])
+
+	return nil
+}
+
+// readAddonValuesFile reads the raw Helm values passed with --values, if any
+func readAddonValuesFile() (map[string]interface{}, error) {
+	if addonValuesFile == "" {
+		return nil, nil
+	}
+
+	bytes, err := os.ReadFile(addonValuesFile)
+	if err != nil {
+		return nil, fmt.Errorf("error reading values file: %w", err)
+	}
+
+	values := make(map[string]interface{})
+
+	if err := yaml.Unmarshal(bytes, &values); err != nil {
+		return nil, fmt.Errorf("error parsing values file: %w", err)
+	}
+
+	return values, nil
+}
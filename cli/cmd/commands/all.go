@@ -22,23 +22,45 @@ func RegisterCommands() (*cobra.Command, error) {
 		Long:  `Porter is a tool for creating, versioning, and updating Kubernetes deployments using a visual dashboard. For more information, visit github.com/porter-dev/porter`,
 	}
 	rootCmd.PersistentFlags().AddFlagSet(utils.DefaultFlagSet)
+	rootCmd.PersistentFlags().StringVarP(
+		&utils.OutputFormat,
+		"output",
+		"o",
+		"",
+		"print the results of list and get commands as \"json\" or \"yaml\" instead of a table",
+	)
+	// the context is read from the args when the config is loaded, the flag is registered so that it is parsed
+	rootCmd.PersistentFlags().String(
+		"context",
+		"",
+		"name of the saved context to use instead of the current context",
+	)
 
+	// completion scripts are generated by the completion command, which also completes api resources
+	rootCmd.CompletionOptions.DisableDefaultCmd = true
+	registerCompletions(rootCmd, cliConf)
+
+	rootCmd.AddCommand(registerCommand_Addon(cliConf))
 	rootCmd.AddCommand(registerCommand_App(cliConf))
 	rootCmd.AddCommand(registerCommand_Apply(cliConf))
 	rootCmd.AddCommand(registerCommand_Auth(cliConf))
 	rootCmd.AddCommand(registerCommand_Cluster(cliConf))
+	rootCmd.AddCommand(registerCommand_Completion(cliConf))
 	rootCmd.AddCommand(registerCommand_Config(cliConf))
 	rootCmd.AddCommand(registerCommand_Connect(cliConf))
 	rootCmd.AddCommand(registerCommand_Create(cliConf))
+	rootCmd.AddCommand(registerCommand_Datastore(cliConf))
 	rootCmd.AddCommand(registerCommand_Delete(cliConf))
 	rootCmd.AddCommand(registerCommand_Deploy(cliConf))
 	rootCmd.AddCommand(registerCommand_Docker(cliConf))
+	rootCmd.AddCommand(registerCommand_Events(cliConf))
 	rootCmd.AddCommand(registerCommand_Get(cliConf))
 	rootCmd.AddCommand(registerCommand_Helm(cliConf))
 	rootCmd.AddCommand(registerCommand_Job(cliConf))
 	rootCmd.AddCommand(registerCommand_Kubectl(cliConf))
 	rootCmd.AddCommand(registerCommand_List(cliConf))
 	rootCmd.AddCommand(registerCommand_Logs(cliConf))
+	rootCmd.AddCommand(registerCommand_Namespace(cliConf))
 	rootCmd.AddCommand(registerCommand_Open(cliConf))
 	rootCmd.AddCommand(registerCommand_PortForward(cliConf))
 	rootCmd.AddCommand(registerCommand_Project(cliConf))
@@ -46,6 +68,8 @@ func RegisterCommands() (*cobra.Command, error) {
 	rootCmd.AddCommand(registerCommand_Run(cliConf))
 	rootCmd.AddCommand(registerCommand_Server(cliConf))
 	rootCmd.AddCommand(registerCommand_Stack(cliConf))
+	rootCmd.AddCommand(registerCommand_Subdomain(cliConf))
+	rootCmd.AddCommand(registerCommand_Tunnel(cliConf))
 	rootCmd.AddCommand(registerCommand_Update(cliConf))
 	rootCmd.AddCommand(registerCommand_Version(cliConf))
 	return rootCmd, nil
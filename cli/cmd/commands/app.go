@@ -7,6 +7,7 @@ import (
 	"io"
 	"os"
 	"strings"
+	"text/tabwriter"
 	"time"
 
 	"github.com/fatih/color"
@@ -14,6 +15,8 @@ import (
 	"github.com/porter-dev/porter/api/types"
 	"github.com/porter-dev/porter/cli/cmd/config"
 	"github.com/porter-dev/porter/cli/cmd/utils"
+	v2 "github.com/porter-dev/porter/cli/cmd/v2"
+	"github.com/porter-dev/porter/internal/integrations/ci/gitlab"
 	"github.com/spf13/cobra"
 	batchv1 "k8s.io/api/batch/v1"
 	v1 "k8s.io/api/core/v1"
@@ -41,6 +44,47 @@ var (
 	appTag           string
 	appCpuMilli      int
 	appMemoryMi      int
+
+	pipelineProvider   string
+	pipelinePorterYAML string
+	pipelineBranch     string
+	pipelineOutput     string
+	pipelinePreviews   bool
+
+	validatePorterYAMLs []string
+	validateLocal       bool
+	validateAllowEnv    []string
+
+	migratePorterYAML  string
+	migrateAppName     string
+	migrateApplication string
+	migrateWrite       string
+
+	pullConfigWrite string
+
+	createTemplate  string
+	createVariables []string
+	createWrite     string
+	createApply     bool
+
+	recommendPorterYAML  string
+	recommendWrite       bool
+	recommendWindowHours int
+
+	metricsService string
+	metricsSince   time.Duration
+
+	envDiffBase     string
+	envDiffCompare  string
+	envDiffShowSame bool
+
+	certFile    string
+	certKeyFile string
+
+	domainDNSProvider      string
+	domainAWSIntegrationID uint
+	domainGCPIntegrationID uint
+	domainDNSZone          string
 )
 
 func registerCommand_App(cliConf config.CLIConfig) *cobra.Command {
@@ -51,9 +95,10 @@ func registerCommand_App(cliConf config.CLIConfig) *cobra.Command {
 
 	// appRunCmd represents the "porter app run" subcommand
 	appRunCmd := &cobra.Command{
-		Use:   "run [application] -- COMMAND [args...]",
-		Args:  cobra.MinimumNArgs(2),
-		Short: "Runs a command inside a connected cluster container.",
+		Use:               "run [application] -- COMMAND [args...]",
+		Args:              cobra.MinimumNArgs(2),
+		ValidArgsFunction: firstArgCompletion(completeAppNames(cliConf)),
+		Short:             "Runs a command inside a connected cluster container.",
 		Run: func(cmd *cobra.Command, args []string) {
 			err := checkLoginAndRunWithConfig(cmd.Context(), cliConf, args, appRun)
 			if err != nil {
@@ -80,9 +125,10 @@ func registerCommand_App(cliConf config.CLIConfig) *cobra.Command {
 
 	// appUpdateTagCmd represents the "porter app update-tag" subcommand
 	appUpdateTagCmd := &cobra.Command{
-		Use:   "update-tag [application]",
-		Args:  cobra.MinimumNArgs(1),
-		Short: "Updates the image tag for an application.",
+		Use:               "update-tag [application]",
+		Args:              cobra.MinimumNArgs(1),
+		ValidArgsFunction: firstArgCompletion(completeAppNames(cliConf)),
+		Short:             "Updates the image tag for an application.",
 		Run: func(cmd *cobra.Command, args []string) {
 			err := checkLoginAndRunWithConfig(cmd.Context(), cliConf, args, appUpdateTag)
 			if err != nil {
@@ -100,9 +146,806 @@ func registerCommand_App(cliConf config.CLIConfig) *cobra.Command {
 	)
 	appCmd.AddCommand(appUpdateTagCmd)
 
+	// appStatusCmd represents the "porter app status" subcommand
+	appStatusCmd := &cobra.Command{
+		Use:               "status [application]",
+		Args:              cobra.ExactArgs(1),
+		ValidArgsFunction: firstArgCompletion(completeAppNames(cliConf)),
+		Short:             "Shows the replica health, restarts and recent failures of each service of an application.",
+		Run: func(cmd *cobra.Command, args []string) {
+			err := checkLoginAndRunWithConfig(cmd.Context(), cliConf, args, appStatus)
+			if err != nil {
+				os.Exit(1)
+			}
+		},
+	}
+	appCmd.AddCommand(appStatusCmd)
+
+	// appRecommendResourcesCmd represents the "porter app recommend-resources" subcommand
+	appRecommendResourcesCmd := &cobra.Command{
+		Use:               "recommend-resources [application]",
+		Args:              cobra.ExactArgs(1),
+		ValidArgsFunction: firstArgCompletion(completeAppNames(cliConf)),
+		Short:             "Recommends cpuCores and ramMegabytes for each service of an application from its recent usage.",
+		Long: fmt.Sprintf(`
+%s
+
+Analyzes the recent CPU and memory usage of each service of an application, and recommends cpuCores
+and ramMegabytes values with headroom for spikes. For example:
+
+  %s
+
+To update the services in porter.yaml with the recommended values, use the --write flag:
+
+  %s
+`,
+			color.New(color.FgBlue, color.Bold).Sprintf("Help for \"porter app recommend-resources\":"),
+			color.New(color.FgGreen, color.Bold).Sprintf("porter app recommend-resources my-app"),
+			color.New(color.FgGreen, color.Bold).Sprintf("porter app recommend-resources my-app -f porter.yaml --write"),
+		),
+		Run: func(cmd *cobra.Command, args []string) {
+			err := checkLoginAndRunWithConfig(cmd.Context(), cliConf, args, appRecommendResources)
+			if err != nil {
+				os.Exit(1)
+			}
+		},
+	}
+
+	appRecommendResourcesCmd.Flags().StringVarP(&recommendPorterYAML, "file", "f", "porter.yaml", "path to the porter.yaml updated by --write")
+	appRecommendResourcesCmd.Flags().BoolVar(&recommendWrite, "write", false, "update the services in porter.yaml with the recommended values")
+	appRecommendResourcesCmd.Flags().IntVar(&recommendWindowHours, "window", 24*7, "the number of hours of usage to analyze")
+	appCmd.AddCommand(appRecommendResourcesCmd)
+
+	// appMetricsCmd represents the "porter app metrics" subcommand
+	appMetricsCmd := &cobra.Command{
+		Use:               "metrics [application]",
+		Args:              cobra.ExactArgs(1),
+		ValidArgsFunction: firstArgCompletion(completeAppNames(cliConf)),
+		Short:             "Shows the CPU, memory, network and HTTP latency metrics of each service of an application.",
+		Long: fmt.Sprintf(`
+%s
+
+Shows the latest, average and peak CPU, memory, network and HTTP latency metrics of each service of an
+application, as recorded by the Prometheus of the cluster. For example:
+
+  %s
+
+By default, metrics from the last hour are shown. To change the range, use the --since flag. To show the
+metrics of a single service, use the --service flag.
+`,
+			color.New(color.FgBlue, color.Bold).Sprintf("Help for \"porter app metrics\":"),
+			color.New(color.FgGreen, color.Bold).Sprintf("porter app metrics my-app --service web --since 24h"),
+		),
+		Run: func(cmd *cobra.Command, args []string) {
+			err := checkLoginAndRunWithConfig(cmd.Context(), cliConf, args, appMetrics)
+			if err != nil {
+				os.Exit(1)
+			}
+		},
+	}
+
+	appMetricsCmd.Flags().StringVar(&metricsService, "service", "", "only show the metrics of this service")
+	appMetricsCmd.Flags().DurationVar(&metricsSince, "since", time.Hour, "show metrics from this far back, such as 30m or 24h")
+	appCmd.AddCommand(appMetricsCmd)
+
+	// appEnvCmd represents the "porter app env" base command
+	appEnvCmd := &cobra.Command{
+		Use:   "env",
+		Short: "Commands for the env of an application.",
+	}
+
+	// appEnvDiffCmd represents the "porter app env diff" subcommand
+	appEnvDiffCmd := &cobra.Command{
+		Use:               "diff [application]",
+		Args:              cobra.ExactArgs(1),
+		ValidArgsFunction: firstArgCompletion(completeAppNames(cliConf)),
+		Short:             "Compares the env and secrets of an application between two deployment targets.",
+		Long: fmt.Sprintf(`
+%s
+
+Compares the effective env of an application, including the variables and secrets of its env groups,
+between two deployment targets, and highlights variables that are missing or different. Secret values
+are never printed. The command exits with code 1 if the env differs, so it can be used to catch drift
+before a deploy. For example:
+
+  %s
+`,
+			color.New(color.FgBlue, color.Bold).Sprintf("Help for \"porter app env diff\":"),
+			color.New(color.FgGreen, color.Bold).Sprintf("porter app env diff my-app --base default --compare preview-pr-12"),
+		),
+		Run: func(cmd *cobra.Command, args []string) {
+			err := checkLoginAndRunWithConfig(cmd.Context(), cliConf, args, appEnvDiff)
+			if err != nil {
+				os.Exit(1)
+			}
+		},
+	}
+
+	appEnvDiffCmd.Flags().StringVar(&envDiffBase, "base", "default", "the namespace of the deployment target to compare against")
+	appEnvDiffCmd.Flags().StringVar(&envDiffCompare, "compare", "", "the namespace of the deployment target to compare")
+	appEnvDiffCmd.Flags().BoolVar(&envDiffShowSame, "all", false, "also list variables that are the same in both deployment targets")
+	_ = appEnvDiffCmd.MarkFlagRequired("compare")
+	appEnvCmd.AddCommand(appEnvDiffCmd)
+	appCmd.AddCommand(appEnvCmd)
+
+	// appCertsCmd represents the "porter app certs" base command
+	appCertsCmd := &cobra.Command{
+		Use:     "certs",
+		Aliases: []string{"certificates"},
+		Short:   "Commands for the TLS certificates of the domains of an application.",
+	}
+
+	// appCertsListCmd represents the "porter app certs list" subcommand
+	appCertsListCmd := &cobra.Command{
+		Use:               "list [application]",
+		Args:              cobra.ExactArgs(1),
+		ValidArgsFunction: firstArgCompletion(completeAppNames(cliConf)),
+		Short:             "Lists the TLS certificates of the domains of an application, and when they expire.",
+		Run: func(cmd *cobra.Command, args []string) {
+			err := checkLoginAndRunWithConfig(cmd.Context(), cliConf, args, appCertsList)
+			if err != nil {
+				os.Exit(1)
+			}
+		},
+	}
+	appCertsCmd.AddCommand(appCertsListCmd)
+
+	// appCertsUploadCmd represents the "porter app certs upload" subcommand
+	appCertsUploadCmd := &cobra.Command{
+		Use:               "upload [application] [domain]",
+		Args:              cobra.ExactArgs(2),
+		ValidArgsFunction: firstArgCompletion(completeAppNames(cliConf)),
+		Short:             "Serves a domain of an application with a custom TLS certificate, instead of the certificate issued by cert-manager.",
+		Long: fmt.Sprintf(`
+%s
+
+Serves a domain of an application with a custom TLS certificate, such as a certificate issued by your
+organization's certificate authority. The certificate chain and private key are read from PEM files.
+For example:
+
+  %s
+
+To go back to the certificate issued by cert-manager, run "porter app certs delete".
+`,
+			color.New(color.FgBlue, color.Bold).Sprintf("Help for \"porter app certs upload\":"),
+			color.New(color.FgGreen, color.Bold).Sprintf("porter app certs upload my-app api.example.com --cert fullchain.pem --key privkey.pem"),
+		),
+		Run: func(cmd *cobra.Command, args []string) {
+			err := checkLoginAndRunWithConfig(cmd.Context(), cliConf, args, appCertsUpload)
+			if err != nil {
+				os.Exit(1)
+			}
+		},
+	}
+	appCertsUploadCmd.Flags().StringVar(&certFile, "cert", "", "path to the PEM-encoded certificate chain")
+	appCertsUploadCmd.Flags().StringVar(&certKeyFile, "key", "", "path to the PEM-encoded private key")
+	_ = appCertsUploadCmd.MarkFlagRequired("cert")
+	_ = appCertsUploadCmd.MarkFlagRequired("key")
+	appCertsCmd.AddCommand(appCertsUploadCmd)
+
+	// appCertsDeleteCmd represents the "porter app certs delete" subcommand
+	appCertsDeleteCmd := &cobra.Command{
+		Use:               "delete [application] [domain]",
+		Args:              cobra.ExactArgs(2),
+		ValidArgsFunction: firstArgCompletion(completeAppNames(cliConf)),
+		Short:             "Deletes the custom TLS certificate of a domain of an application, so the domain is served with the certificate issued by cert-manager.",
+		Run: func(cmd *cobra.Command, args []string) {
+			err := checkLoginAndRunWithConfig(cmd.Context(), cliConf, args, appCertsDelete)
+			if err != nil {
+				os.Exit(1)
+			}
+		},
+	}
+	appCertsCmd.AddCommand(appCertsDeleteCmd)
+	appCmd.AddCommand(appCertsCmd)
+
+	// appDomainsCmd represents the "porter app domains" base command
+	appDomainsCmd := &cobra.Command{
+		Use:   "domains",
+		Short: "Commands for the custom domains of an application.",
+	}
+
+	// appDomainsListCmd represents the "porter app domains list" subcommand
+	appDomainsListCmd := &cobra.Command{
+		Use:               "list [application]",
+		Args:              cobra.ExactArgs(1),
+		ValidArgsFunction: firstArgCompletion(completeAppNames(cliConf)),
+		Short:             "Lists the custom domains of an application, the DNS records they need and whether the records are verified.",
+		Run: func(cmd *cobra.Command, args []string) {
+			err := checkLoginAndRunWithConfig(cmd.Context(), cliConf, args, appDomainsList)
+			if err != nil {
+				os.Exit(1)
+			}
+		},
+	}
+	appDomainsCmd.AddCommand(appDomainsListCmd)
+
+	// appDomainsVerifyCmd represents the "porter app domains verify" subcommand
+	appDomainsVerifyCmd := &cobra.Command{
+		Use:               "verify [application] [domain]",
+		Args:              cobra.ExactArgs(2),
+		ValidArgsFunction: firstArgCompletion(completeAppNames(cliConf)),
+		Short:             "Checks the DNS record of a custom domain right away, instead of waiting for the next background check.",
+		Run: func(cmd *cobra.Command, args []string) {
+			err := checkLoginAndRunWithConfig(cmd.Context(), cliConf, args, appDomainsVerify)
+			if err != nil {
+				os.Exit(1)
+			}
+		},
+	}
+	appDomainsCmd.AddCommand(appDomainsVerifyCmd)
+
+	// appDomainsConfigureDNSCmd represents the "porter app domains configure-dns" subcommand
+	appDomainsConfigureDNSCmd := &cobra.Command{
+		Use:               "configure-dns [application] [domain]",
+		Args:              cobra.ExactArgs(2),
+		ValidArgsFunction: firstArgCompletion(completeAppNames(cliConf)),
+		Short:             "Lets Porter create the DNS record of a custom domain in Route53 or Cloud DNS.",
+		Long: fmt.Sprintf(`
+%s
+
+Lets Porter create and update the DNS record of a custom domain, using an AWS integration of the project
+for a Route53 hosted zone, or a GCP integration for a Cloud DNS managed zone. For example:
+
+  %s
+`,
+			color.New(color.FgBlue, color.Bold).Sprintf("Help for \"porter app domains configure-dns\":"),
+			color.New(color.FgGreen, color.Bold).Sprintf("porter app domains configure-dns my-app api.example.com --provider route53 --aws-integration-id 3 --zone Z0123456789"),
+		),
+		Run: func(cmd *cobra.Command, args []string) {
+			err := checkLoginAndRunWithConfig(cmd.Context(), cliConf, args, appDomainsConfigureDNS)
+			if err != nil {
+				os.Exit(1)
+			}
+		},
+	}
+	appDomainsConfigureDNSCmd.Flags().StringVar(&domainDNSProvider, "provider", "route53", "the DNS provider, one of route53 or cloud_dns")
+	appDomainsConfigureDNSCmd.Flags().UintVar(&domainAWSIntegrationID, "aws-integration-id", 0, "the AWS integration used to manage Route53 records")
+	appDomainsConfigureDNSCmd.Flags().UintVar(&domainGCPIntegrationID, "gcp-integration-id", 0, "the GCP integration used to manage Cloud DNS records")
+	appDomainsConfigureDNSCmd.Flags().StringVar(&domainDNSZone, "zone", "", "the Route53 hosted zone id or Cloud DNS managed zone name of the domain")
+	_ = appDomainsConfigureDNSCmd.MarkFlagRequired("zone")
+	appDomainsCmd.AddCommand(appDomainsConfigureDNSCmd)
+	appCmd.AddCommand(appDomainsCmd)
+
+	// appValidateCmd represents the "porter app validate" subcommand
+	appValidateCmd := &cobra.Command{
+		Use:   "validate",
+		Args:  cobra.NoArgs,
+		Short: "Validates a porter.yaml.",
+		Long: fmt.Sprintf(`
+%s
+
+Validates a porter.yaml and prints each problem found with the line of the field it refers to, such as
+an invalid service type or a field which does not apply to the type of a service. For example:
+
+  %s
+
+By default, the porter.yaml is also parsed by the Porter API after it is validated locally. To validate
+the porter.yaml without logging in or calling the Porter API, such as in a pre-commit hook, use the
+--local flag:
+
+  %s
+
+Override files are merged over the base porter.yaml the same way as in porter apply, and the merged
+file is validated:
+
+  %s
+`,
+			color.New(color.FgBlue, color.Bold).Sprintf("Help for \"porter app validate\":"),
+			color.New(color.FgGreen, color.Bold).Sprintf("porter app validate -f porter.yaml"),
+			color.New(color.FgGreen, color.Bold).Sprintf("porter app validate -f porter.yaml --local"),
+			color.New(color.FgGreen, color.Bold).Sprintf("porter app validate -f porter.yaml -f porter.prod.yaml --local"),
+		),
+		Run: func(cmd *cobra.Command, args []string) {
+			var err error
+			if validateLocal {
+				err = v2.ValidateLocal(cmd.Context(), validatePorterYAMLs, validateAllowEnv)
+				if err != nil {
+					_, _ = color.New(color.FgRed).Fprintf(os.Stderr, "Error: %s\n", err.Error())
+				}
+			} else {
+				err = checkLoginAndRunWithConfig(cmd.Context(), cliConf, args, appValidate)
+			}
+
+			if err != nil {
+				os.Exit(1)
+			}
+
+			_, _ = color.New(color.FgGreen).Printf("%s is valid\n", strings.Join(validatePorterYAMLs, " + "))
+		},
+	}
+
+	appValidateCmd.Flags().StringArrayVarP(&validatePorterYAMLs, "file", "f", []string{"porter.yaml"}, "path to the porter.yaml to validate, which can be repeated to merge override files over it")
+	appValidateCmd.Flags().BoolVar(&validateLocal, "local", false, "validate the porter.yaml without calling the Porter API")
+	appValidateCmd.Flags().StringSliceVar(&validateAllowEnv, "allow-env", nil, "env vars which are interpolated into the porter.yaml where it uses ${NAME}")
+	appCmd.AddCommand(appValidateCmd)
+
+	// appSchemaCmd represents the "porter app schema" subcommand
+	appSchemaCmd := &cobra.Command{
+		Use:   "schema",
+		Args:  cobra.NoArgs,
+		Short: "Prints the JSON Schema of porter.yaml files.",
+		Long: fmt.Sprintf(`
+%s
+
+Prints the JSON Schema of v2 porter.yaml files, which editors can use to complete and validate
+porter.yaml files. For example, to save the schema to a file:
+
+  %s
+
+The schema is also served by the Porter API. Editors with a YAML language server, such as VS Code
+with the YAML extension, use the schema when porter.yaml starts with a modeline:
+
+  %s
+`,
+			color.New(color.FgBlue, color.Bold).Sprintf("Help for \"porter app schema\":"),
+			color.New(color.FgGreen, color.Bold).Sprintf("porter app schema > porter.schema.json"),
+			color.New(color.FgGreen, color.Bold).Sprintf("# yaml-language-server: $schema=%s", v2.SchemaURL(cliConf.Host)),
+		),
+		Run: func(cmd *cobra.Command, args []string) {
+			err := v2.Schema()
+			if err != nil {
+				_, _ = color.New(color.FgRed).Fprintf(os.Stderr, "Error: %s\n", err.Error())
+				os.Exit(1)
+			}
+		},
+	}
+	appCmd.AddCommand(appSchemaCmd)
+
+	// appMigrateConfigCmd represents the "porter app migrate-config" subcommand
+	appMigrateConfigCmd := &cobra.Command{
+		Use:   "migrate-config",
+		Args:  cobra.NoArgs,
+		Short: "Converts a v1 porter.yaml to a v2 porter.yaml.",
+		Long: fmt.Sprintf(`
+%s
+
+Converts a legacy v1 porter.yaml or stack config to an equivalent v2 porter.yaml. The settings of
+each service, which are helm values in v1 files, are converted to the fields of v2 services, and
+settings which have no v2 equivalent, such as env groups or resource limits, are printed as warnings.
+For example, to print the converted file:
+
+  %s
+
+v1 files do not include the name of the app, which is set with the --name flag. If the v1 file defines
+several applications, the --app flag selects the application to convert. To write the converted file
+instead of printing it, use the --write flag:
+
+  %s
+`,
+			color.New(color.FgBlue, color.Bold).Sprintf("Help for \"porter app migrate-config\":"),
+			color.New(color.FgGreen, color.Bold).Sprintf("porter app migrate-config -f porter.yaml --name my-app"),
+			color.New(color.FgGreen, color.Bold).Sprintf("porter app migrate-config -f porter.yaml --name my-app --write porter.v2.yaml"),
+		),
+		Run: func(cmd *cobra.Command, args []string) {
+			err := v2.MigrateConfig(cmd.Context(), migratePorterYAML, migrateApplication, migrateAppName, migrateWrite)
+			if err != nil {
+				_, _ = color.New(color.FgRed).Fprintf(os.Stderr, "Error: %s\n", err.Error())
+				os.Exit(1)
+			}
+		},
+	}
+
+	appMigrateConfigCmd.Flags().StringVarP(&migratePorterYAML, "file", "f", "porter.yaml", "path to the v1 porter.yaml to convert")
+	appMigrateConfigCmd.Flags().StringVar(&migrateAppName, "name", "", "name of the app in the converted porter.yaml")
+	appMigrateConfigCmd.Flags().StringVar(&migrateApplication, "app", "", "application to convert, if the v1 porter.yaml defines several applications")
+	appMigrateConfigCmd.Flags().StringVar(&migrateWrite, "write", "", "path to write the converted porter.yaml to, instead of printing it")
+	appCmd.AddCommand(appMigrateConfigCmd)
+
+	// appCreateCmd represents the "porter app create" subcommand
+	appCreateCmd := &cobra.Command{
+		Use:   "create [application]",
+		Args:  cobra.ExactArgs(1),
+		Short: "Creates the porter.yaml of a new application from an app template of the project.",
+		Long: fmt.Sprintf(`
+%s
+
+Creates a new application from one of the app templates of the project, which are porter.yaml files
+with variables set by platform teams. The porter.yaml of the new application is written to porter.yaml,
+or the path given with --write. Variables of the template are set with --var. For example:
+
+  %s
+
+To deploy the new application immediately, use the --apply flag:
+
+  %s
+`,
+			color.New(color.FgBlue, color.Bold).Sprintf("Help for \"porter app create\":"),
+			color.New(color.FgGreen, color.Bold).Sprintf("porter app create my-app --template rails-postgres --var database_name=my_app"),
+			color.New(color.FgGreen, color.Bold).Sprintf("porter app create my-app --template rails-postgres --apply"),
+		),
+		Run: func(cmd *cobra.Command, args []string) {
+			err := checkLoginAndRunWithConfig(cmd.Context(), cliConf, args, appCreate)
+			if err != nil {
+				os.Exit(1)
+			}
+		},
+	}
+
+	appCreateCmd.Flags().StringVar(&createTemplate, "template", "", "the name of the app template to create the application from")
+	appCreateCmd.Flags().StringArrayVar(&createVariables, "var", nil, "a variable of the template in the form NAME=VALUE, which can be repeated")
+	appCreateCmd.Flags().StringVar(&createWrite, "write", "porter.yaml", "path to write the porter.yaml of the new application to")
+	appCreateCmd.Flags().BoolVar(&createApply, "apply", false, "deploy the new application with porter apply after writing its porter.yaml")
+	appCmd.AddCommand(appCreateCmd)
+
+	// appPullConfigCmd represents the "porter app pull-config" subcommand
+	appPullConfigCmd := &cobra.Command{
+		Use:               "pull-config [application]",
+		Args:              cobra.ExactArgs(1),
+		ValidArgsFunction: firstArgCompletion(completeAppNames(cliConf)),
+		Short:             "Prints the config of an application as a porter.yaml.",
+		Long: fmt.Sprintf(`
+%s
+
+Converts the current config of an application, such as an application configured in the dashboard,
+to a v2 porter.yaml, so that the application can be deployed from git with porter apply afterwards.
+For example:
+
+  %s
+
+To write the porter.yaml to a file instead of printing it, use the --write flag:
+
+  %s
+`,
+			color.New(color.FgBlue, color.Bold).Sprintf("Help for \"porter app pull-config\":"),
+			color.New(color.FgGreen, color.Bold).Sprintf("porter app pull-config my-app"),
+			color.New(color.FgGreen, color.Bold).Sprintf("porter app pull-config my-app --write porter.yaml"),
+		),
+		Run: func(cmd *cobra.Command, args []string) {
+			err := checkLoginAndRunWithConfig(cmd.Context(), cliConf, args, appPullConfig)
+			if err != nil {
+				os.Exit(1)
+			}
+		},
+	}
+
+	appPullConfigCmd.Flags().StringVar(&pullConfigWrite, "write", "", "path to write the porter.yaml to, instead of printing it")
+	appCmd.AddCommand(appPullConfigCmd)
+
+	// appGeneratePipelineCmd represents the "porter app generate-pipeline" subcommand
+	appGeneratePipelineCmd := &cobra.Command{
+		Use:   "generate-pipeline",
+		Args:  cobra.NoArgs,
+		Short: "Generates a CI pipeline that runs porter apply for your application.",
+		Long: fmt.Sprintf(`
+%s
+
+Generates a CI pipeline that deploys the app defined in porter.yaml on every push to the default branch,
+using the project and cluster of the current CLI config. For example:
+
+  %s
+
+The pipeline reads the Porter token from a masked CI/CD variable named %s, which must be added
+to the GitLab project settings before the pipeline runs.
+`,
+			color.New(color.FgBlue, color.Bold).Sprintf("Help for \"porter app generate-pipeline\":"),
+			color.New(color.FgGreen, color.Bold).Sprintf("porter app generate-pipeline --provider gitlab -f porter.yaml"),
+			gitlab.PipelineTokenVariable,
+		),
+		Run: func(cmd *cobra.Command, args []string) {
+			err := appGeneratePipeline(cliConf)
+			if err != nil {
+				_, _ = color.New(color.FgRed).Fprintf(os.Stderr, "Error: %s\n", err.Error())
+				os.Exit(1)
+			}
+		},
+	}
+
+	appGeneratePipelineCmd.Flags().StringVar(&pipelineProvider, "provider", "gitlab", "the CI provider to generate a pipeline for (only gitlab is supported)")
+	appGeneratePipelineCmd.Flags().StringVarP(&pipelinePorterYAML, "file", "f", "porter.yaml", "path to porter.yaml, relative to the repository root")
+	appGeneratePipelineCmd.Flags().StringVar(&pipelineBranch, "branch", "main", "the branch to deploy from")
+	appGeneratePipelineCmd.Flags().StringVarP(&pipelineOutput, "output", "o", ".gitlab-ci.yml", "the file to write the pipeline to, or - for stdout")
+	appGeneratePipelineCmd.Flags().BoolVar(&pipelinePreviews, "previews", false, "add a job that deploys a preview environment for every merge request")
+	appCmd.AddCommand(appGeneratePipelineCmd)
+
 	return appCmd
 }
 
+func appValidate(ctx context.Context, _ *types.GetAuthenticatedUserResponse, client api.Client, cliConf config.CLIConfig, _ []string) error {
+	project, err := client.GetProject(ctx, cliConf.Project)
+	if err != nil {
+		return fmt.Errorf("could not retrieve project from Porter API. Please contact support@porter.run")
+	}
+
+	if !project.ValidateApplyV2 {
+		return errors.New("porter app validate is only supported for apps deployed with porter apply v2, use porter apply validate instead")
+	}
+
+	return v2.Validate(ctx, cliConf, client, validatePorterYAMLs, validateAllowEnv)
+}
+
+func appStatus(ctx context.Context, _ *types.GetAuthenticatedUserResponse, client api.Client, cliConf config.CLIConfig, args []string) error {
+	project, err := client.GetProject(ctx, cliConf.Project)
+	if err != nil {
+		return fmt.Errorf("could not retrieve project from Porter API. Please contact support@porter.run")
+	}
+
+	if !project.ValidateApplyV2 {
+		return errors.New("porter app status is only supported for apps deployed with porter apply v2")
+	}
+
+	return v2.AppStatus(ctx, cliConf, client, args[0])
+}
+
+func appRecommendResources(ctx context.Context, _ *types.GetAuthenticatedUserResponse, client api.Client, cliConf config.CLIConfig, args []string) error {
+	project, err := client.GetProject(ctx, cliConf.Project)
+	if err != nil {
+		return fmt.Errorf("could not retrieve project from Porter API. Please contact support@porter.run")
+	}
+
+	if !project.ValidateApplyV2 {
+		return errors.New("porter app recommend-resources is only supported for apps deployed with porter apply v2")
+	}
+
+	return v2.RecommendResources(ctx, cliConf, client, args[0], recommendWindowHours, recommendPorterYAML, recommendWrite)
+}
+
+func appCreate(ctx context.Context, _ *types.GetAuthenticatedUserResponse, client api.Client, cliConf config.CLIConfig, args []string) error {
+	project, err := client.GetProject(ctx, cliConf.Project)
+	if err != nil {
+		return fmt.Errorf("could not retrieve project from Porter API. Please contact support@porter.run")
+	}
+
+	if !project.ValidateApplyV2 {
+		return errors.New("porter app create is only supported for apps deployed with porter apply v2")
+	}
+
+	return v2.CreateApp(ctx, cliConf, client, v2.CreateAppInput{
+		AppName:      args[0],
+		TemplateName: createTemplate,
+		Variables:    createVariables,
+		OutputPath:   createWrite,
+		Apply:        createApply,
+	})
+}
+
+func appPullConfig(ctx context.Context, _ *types.GetAuthenticatedUserResponse, client api.Client, cliConf config.CLIConfig, args []string) error {
+	project, err := client.GetProject(ctx, cliConf.Project)
+	if err != nil {
+		return fmt.Errorf("could not retrieve project from Porter API. Please contact support@porter.run")
+	}
+
+	if !project.ValidateApplyV2 {
+		return errors.New("porter app pull-config is only supported for apps deployed with porter apply v2")
+	}
+
+	return v2.PullConfig(ctx, cliConf, client, args[0], pullConfigWrite)
+}
+
+func appMetrics(ctx context.Context, _ *types.GetAuthenticatedUserResponse, client api.Client, cliConf config.CLIConfig, args []string) error {
+	project, err := client.GetProject(ctx, cliConf.Project)
+	if err != nil {
+		return fmt.Errorf("could not retrieve project from Porter API. Please contact support@porter.run")
+	}
+
+	if !project.ValidateApplyV2 {
+		return errors.New("porter app metrics is only supported for apps deployed with porter apply v2")
+	}
+
+	return v2.AppMetrics(ctx, cliConf, client, args[0], metricsService, metricsSince)
+}
+
+func appEnvDiff(ctx context.Context, _ *types.GetAuthenticatedUserResponse, client api.Client, cliConf config.CLIConfig, args []string) error {
+	project, err := client.GetProject(ctx, cliConf.Project)
+	if err != nil {
+		return fmt.Errorf("could not retrieve project from Porter API. Please contact support@porter.run")
+	}
+
+	if !project.ValidateApplyV2 {
+		return errors.New("porter app env diff is only supported for apps deployed with porter apply v2")
+	}
+
+	return v2.EnvDiff(ctx, cliConf, client, args[0], envDiffBase, envDiffCompare, envDiffShowSame)
+}
+
+func appCertsList(ctx context.Context, _ *types.GetAuthenticatedUserResponse, client api.Client, cliConf config.CLIConfig, args []string) error {
+	resp, err := client.ListAppCertificates(ctx, cliConf.Project, cliConf.Cluster, args[0])
+	if err != nil {
+		return fmt.Errorf("error listing certificates: %w", err)
+	}
+
+	if utils.IsStructuredOutput() {
+		return utils.PrintStructured(resp)
+	}
+
+	if len(resp.Certificates) == 0 {
+		fmt.Println("No certificates found")
+		return nil
+	}
+
+	w := new(tabwriter.Writer)
+	w.Init(os.Stdout, 3, 8, 2, ' ', 0)
+
+	fmt.Fprintf(w, "%s\t%s\t%s\t%s\t%s\n", "DOMAIN", "NAMESPACE", "SOURCE", "STATUS", "EXPIRES")
+
+	for _, cert := range resp.Certificates {
+		expires := "-"
+		if cert.NotAfter != nil {
+			expires = cert.NotAfter.Local().Format(time.RFC822)
+		}
+
+		fmt.Fprintf(w, "%s\t%s\t%s\t%s\t%s\n", cert.Domain, cert.Namespace, cert.Source, cert.Status, expires)
+	}
+
+	w.Flush()
+
+	return nil
+}
+
+func appCertsUpload(ctx context.Context, _ *types.GetAuthenticatedUserResponse, client api.Client, cliConf config.CLIConfig, args []string) error {
+	certificate, err := os.ReadFile(certFile)
+	if err != nil {
+		return fmt.Errorf("error reading certificate: %w", err)
+	}
+
+	privateKey, err := os.ReadFile(certKeyFile)
+	if err != nil {
+		return fmt.Errorf("error reading private key: %w", err)
+	}
+
+	resp, err := client.UploadCustomCertificate(ctx, cliConf.Project, cliConf.Cluster, args[0], args[1], &types.UploadCustomCertificateRequest{
+		Certificate: string(certificate),
+		PrivateKey:  string(privateKey),
+	})
+	if err != nil {
+		return fmt.Errorf("error uploading certificate: %w", err)
+	}
+
+	color.New(color.FgGreen).Printf("Serving %s in %s with the certificate issued by %s, which expires %s\n",
+		resp.Domain, strings.Join(resp.Namespaces, ", "), resp.Issuer, resp.NotAfter.Local().Format(time.RFC822))
+
+	return nil
+}
+
+func appCertsDelete(ctx context.Context, _ *types.GetAuthenticatedUserResponse, client api.Client, cliConf config.CLIConfig, args []string) error {
+	userResp, err := utils.PromptPlaintext(
+		fmt.Sprintf(
+			`Are you sure you'd like to delete the custom certificate of %s? The domain will be served with the certificate issued by cert-manager. %s `,
+			args[1],
+			color.New(color.FgCyan).Sprintf("[y/n]"),
+		),
+	)
+	if err != nil {
+		return err
+	}
+
+	if userResp := strings.ToLower(userResp); userResp != "y" && userResp != "yes" {
+		return nil
+	}
+
+	if err := client.DeleteCustomCertificate(ctx, cliConf.Project, cliConf.Cluster, args[0], args[1]); err != nil {
+		return fmt.Errorf("error deleting certificate: %w", err)
+	}
+
+	color.New(color.FgGreen).Printf("Deleted the custom certificate of %s\n", args[1])
+
+	return nil
+}
+
+func appDomainsList(ctx context.Context, _ *types.GetAuthenticatedUserResponse, client api.Client, cliConf config.CLIConfig, args []string) error {
+	resp, err := client.ListCustomDomains(ctx, cliConf.Project, cliConf.Cluster, args[0])
+	if err != nil {
+		return fmt.Errorf("error listing domains: %w", err)
+	}
+
+	if utils.IsStructuredOutput() {
+		return utils.PrintStructured(resp)
+	}
+
+	if len(resp.Domains) == 0 {
+		fmt.Println("No custom domains found")
+		return nil
+	}
+
+	w := new(tabwriter.Writer)
+	w.Init(os.Stdout, 3, 8, 2, ' ', 0)
+
+	fmt.Fprintf(w, "%s\t%s\t%s\t%s\t%s\n", "DOMAIN", "RECORD", "TARGET", "STATUS", "MANAGED BY")
+
+	for _, domain := range resp.Domains {
+		recordType, target, managedBy := domain.RecordType, domain.Target, string(domain.DNSProvider)
+		if recordType == "" {
+			recordType, target = "-", "-"
+		}
+		if managedBy == "" {
+			managedBy = "-"
+		}
+
+		fmt.Fprintf(w, "%s\t%s\t%s\t%s\t%s\n", domain.Name, recordType, target, domain.Status, managedBy)
+	}
+
+	w.Flush()
+
+	for _, domain := range resp.Domains {
+		if domain.StatusMessage != "" {
+			fmt.Printf("%s: %s\n", domain.Name, domain.StatusMessage)
+		}
+	}
+
+	return nil
+}
+
+func appDomainsVerify(ctx context.Context, _ *types.GetAuthenticatedUserResponse, client api.Client, cliConf config.CLIConfig, args []string) error {
+	domain, err := client.VerifyCustomDomain(ctx, cliConf.Project, cliConf.Cluster, args[0], args[1])
+	if err != nil {
+		return fmt.Errorf("error verifying domain: %w", err)
+	}
+
+	printCustomDomainStatus(domain)
+
+	return nil
+}
+
+func appDomainsConfigureDNS(ctx context.Context, _ *types.GetAuthenticatedUserResponse, client api.Client, cliConf config.CLIConfig, args []string) error {
+	domain, err := client.ConfigureDomainDNS(ctx, cliConf.Project, cliConf.Cluster, args[0], args[1], &types.ConfigureDomainDNSRequest{
+		Provider:         types.DNSProvider(domainDNSProvider),
+		AWSIntegrationID: domainAWSIntegrationID,
+		GCPIntegrationID: domainGCPIntegrationID,
+		Zone:             domainDNSZone,
+	})
+	if err != nil {
+		return fmt.Errorf("error configuring dns: %w", err)
+	}
+
+	printCustomDomainStatus(domain)
+
+	return nil
+}
+
+// printCustomDomainStatus prints the verification status of a custom domain, and the record it needs if it is not verified
+func printCustomDomainStatus(domain *types.CustomDomain) {
+	if domain.Status == types.CustomDomainStatus_Verified {
+		color.New(color.FgGreen).Printf("%s is verified\n", domain.Name)
+		return
+	}
+
+	color.New(color.FgYellow).Printf("%s is %s: %s\n", domain.Name, domain.Status, domain.StatusMessage)
+
+	if domain.Target != "" && domain.DNSProvider == "" {
+		fmt.Printf("Create a %s record for %s pointing to %s\n", domain.RecordType, domain.Name, domain.Target)
+	}
+}
+
+func appGeneratePipeline(cliConf config.CLIConfig) error {
+	if pipelineProvider != "gitlab" {
+		return fmt.Errorf("unsupported provider %s: GitHub Actions workflows are created from the Porter dashboard", pipelineProvider)
+	}
+
+	if cliConf.Project == 0 || cliConf.Cluster == 0 {
+		return errors.New("a project and cluster must be set, run porter config set-project and porter config set-cluster")
+	}
+
+	contents, err := gitlab.GetApplyPipelineYAML(&gitlab.ApplyPipelineOpts{
+		ServerURL:      cliConf.Host,
+		ProjectID:      cliConf.Project,
+		ClusterID:      cliConf.Cluster,
+		PorterYamlPath: pipelinePorterYAML,
+		DefaultBranch:  pipelineBranch,
+		Previews:       pipelinePreviews,
+	})
+	if err != nil {
+		return fmt.Errorf("error generating pipeline: %w", err)
+	}
+
+	if pipelineOutput == "-" {
+		_, err = os.Stdout.Write(contents)
+		return err
+	}
+
+	if _, err := os.Stat(pipelineOutput); err == nil {
+		return fmt.Errorf("%s already exists, use --output - to print the pipeline instead", pipelineOutput)
+	}
+
+	err = os.WriteFile(pipelineOutput, contents, 0o600)
+	if err != nil {
+		return fmt.Errorf("error writing pipeline: %w", err)
+	}
+
+	_, _ = color.New(color.FgGreen).Printf("Wrote %s. Add a masked CI/CD variable named %s with a Porter token to your GitLab project to finish setup.\n", pipelineOutput, gitlab.PipelineTokenVariable)
+
+	return nil
+}
+
 func appRunFlags(appRunCmd *cobra.Command) {
 	appRunCmd.PersistentFlags().BoolVarP(
 		&appExistingPod,
@@ -39,7 +39,18 @@ import (
 	"gopkg.in/yaml.v2"
 )
 
-var porterYAML string
+var (
+	porterYAMLs      []string
+	applyPreview     bool
+	applyBuildOnly   bool
+	applySkipBuild   bool
+	applyImageTag    string
+	applyNoWait      bool
+	applyShowCost    bool
+	applyChangedOnly bool
+	applyRemoteBuild bool
+	applyAllowEnv    []string
+)
 
 func registerCommand_Apply(cliConf config.CLIConfig) *cobra.Command {
 	applyCmd := &cobra.Command{
@@ -68,9 +79,54 @@ applying a configuration:
   PORTER_SOURCE_REPO          The URL of the Helm charts registry
   PORTER_SOURCE_VERSION       The version of the Helm chart to use
   PORTER_TAG                  The Docker image tag to use (like the git commit hash)
+  PORTER_PREVIEW_NAME         The name of the preview environment to deploy to with --preview
+
+The build and deploy phases can be run in separate jobs, by building and pushing the image with
+--build-only, and then deploying the pushed image with --skip-build. The image is tagged with the
+commit SHA, unless another tag is passed to both phases with --image-tag:
+
+  %s
+  %s
+
+In a monorepo, pass --changed-only to only build the images whose build context or Dockerfile
+changed since the commit of the current revision. The other images are reused from that revision,
+and the deploy is skipped if no image and no porter.yaml changed:
+
+  %s
+
+On machines where building is slow, or whose architecture differs from the cluster, such as ARM
+Macs, pass --remote-build to upload the build context and build and push the images in the cluster
+of the app. Remote builds stream their output back and only support the docker build method:
+
+  %s
+
+Once the app is deployed, the rollout progress of each service is printed until the rollout
+completes or fails. Pass --no-wait to return as soon as the app is deployed.
+
+The same porter.yaml can be deployed to several environments by using ${NAME} in its values, such
+as instances: ${REPLICAS}. Only the env vars passed with --allow-env are interpolated, so that other
+${...} expressions, such as shell expansions in run commands, are left as they are:
+
+  %s
+
+Values can also refer to other values of the porter.yaml, such as ${porter.name} or
+${porter.image.tag}, and top-level keys starting with x- are ignored, so that they can hold YAML
+anchors shared by several services.
+
+Environment-specific settings can be kept in override files, which are merged over the base
+porter.yaml in the order they are passed. Services are merged by name and env vars by key, lists
+are replaced, and a null value removes a key from the files before it:
+
+  %s
 	`,
 			color.New(color.FgBlue, color.Bold).Sprintf("Help for \"porter apply\":"),
 			color.New(color.FgGreen, color.Bold).Sprintf("porter apply -f porter.yaml"),
+			color.New(color.FgGreen, color.Bold).Sprintf("porter apply -f porter.yaml --build-only --image-tag v1.2.0"),
+			color.New(color.FgGreen, color.Bold).Sprintf("porter apply -f porter.yaml --skip-build --image-tag v1.2.0"),
+			color.New(color.FgGreen, color.Bold).Sprintf("porter apply -f porter.yaml --changed-only"),
+			color.New(color.FgGreen, color.Bold).Sprintf("porter apply -f porter.yaml --remote-build"),
+			color.New(color.FgGreen, color.Bold).Sprintf("REPLICAS=3 porter apply -f porter.yaml --allow-env REPLICAS"),
+			color.New(color.FgGreen, color.Bold).Sprintf("porter apply -f porter.yaml -f porter.prod.yaml"),
 		),
 		Run: func(cmd *cobra.Command, args []string) {
 			err := checkLoginAndRunWithConfig(cmd.Context(), cliConf, args, apply)
@@ -102,8 +158,17 @@ applying a configuration:
 
 	applyCmd.AddCommand(applyValidateCmd)
 
-	applyCmd.PersistentFlags().StringVarP(&porterYAML, "file", "f", "", "path to porter.yaml")
+	applyCmd.PersistentFlags().StringArrayVarP(&porterYAMLs, "file", "f", nil, "path to porter.yaml, which can be repeated to merge override files over it")
 	applyCmd.MarkFlagRequired("file")
+	applyCmd.Flags().BoolVar(&applyPreview, "preview", false, "deploy the app with the previews overrides to an ephemeral environment for the current branch or pull request")
+	applyCmd.Flags().BoolVar(&applyBuildOnly, "build-only", false, "build and push the app image without deploying it")
+	applyCmd.Flags().BoolVar(&applySkipBuild, "skip-build", false, "deploy an app image which was already pushed with --build-only, without building it")
+	applyCmd.Flags().StringVar(&applyImageTag, "image-tag", "", "the tag of the app image to build or deploy, defaulting to the commit SHA")
+	applyCmd.Flags().BoolVar(&applyNoWait, "no-wait", false, "do not wait for the rollout of the app to complete")
+	applyCmd.Flags().BoolVar(&applyChangedOnly, "changed-only", false, "only build the images with changes since the current revision, and skip the deploy if nothing changed")
+	applyCmd.Flags().BoolVar(&applyRemoteBuild, "remote-build", false, "build the images in the cluster of the app instead of with the local docker daemon")
+	applyCmd.Flags().BoolVar(&applyShowCost, "show-cost", false, "print the estimated monthly cost of the services of the app before it is deployed")
+	applyCmd.Flags().StringSliceVar(&applyAllowEnv, "allow-env", nil, "env vars which are interpolated into porter.yaml where it uses ${NAME}")
 
 	return applyCmd
 }
@@ -115,13 +180,26 @@ func apply(ctx context.Context, _ *types.GetAuthenticatedUserResponse, client ap
 	}
 
 	if project.ValidateApplyV2 {
-		err = v2.Apply(ctx, cliConfig, client, porterYAML)
+		var previewName string
+		if applyPreview {
+			previewName, err = v2.PreviewName()
+			if err != nil {
+				return err
+			}
+		}
+
+		err = v2.Apply(ctx, cliConfig, client, porterYAMLs, previewName, applyImageTag, applyBuildOnly, applySkipBuild, applyNoWait, applyShowCost, applyChangedOnly, applyRemoteBuild, applyAllowEnv)
 		if err != nil {
 			return err
 		}
 		return nil
 	}
 
+	porterYAML, err := v1PorterYAML()
+	if err != nil {
+		return err
+	}
+
 	fileBytes, err := os.ReadFile(porterYAML) //nolint:errcheck,gosec // do not want to change logic of CLI. New linter error
 	if err != nil {
 		stackName := os.Getenv("PORTER_STACK_NAME")
@@ -305,7 +383,25 @@ func apply(ctx context.Context, _ *types.GetAuthenticatedUserResponse, client ap
 	return
 }
 
+// v1PorterYAML returns the porter.yaml passed to commands for apps which do not use validate apply v2, which cannot merge
+// several porter.yaml files
+func v1PorterYAML() (string, error) {
+	if len(porterYAMLs) > 1 {
+		return "", errors.New("several porter.yaml files can only be merged for apps using validate apply v2")
+	}
+	if len(porterYAMLs) == 0 {
+		return "", nil
+	}
+
+	return porterYAMLs[0], nil
+}
+
 func applyValidate() error {
+	porterYAML, err := v1PorterYAML()
+	if err != nil {
+		return err
+	}
+
 	fileBytes, err := ioutil.ReadFile(porterYAML)
 	if err != nil {
 		return fmt.Errorf("error reading porter.yaml: %w", err)
@@ -12,10 +12,18 @@ import (
 	api "github.com/porter-dev/porter/api/client"
 	"github.com/porter-dev/porter/api/types"
 	"github.com/porter-dev/porter/cli/cmd/config"
+	"github.com/porter-dev/porter/cli/cmd/connect"
 	"github.com/porter-dev/porter/cli/cmd/utils"
 	"github.com/spf13/cobra"
 )
 
+var (
+	eksClusterName string
+	eksRegion      string
+	gkeOpts        connect.GKEOpts
+	aksOpts        connect.AKSOpts
+)
+
 func registerCommand_Cluster(cliConf config.CLIConfig) *cobra.Command {
 	clusterCmd := &cobra.Command{
 		Use:     "cluster",
@@ -36,9 +44,10 @@ func registerCommand_Cluster(cliConf config.CLIConfig) *cobra.Command {
 	clusterCmd.AddCommand(clusterListCmd)
 
 	clusterDeleteCmd := &cobra.Command{
-		Use:   "delete [id]",
-		Args:  cobra.ExactArgs(1),
-		Short: "Deletes the cluster with the given id",
+		Use:               "delete [id]",
+		Args:              cobra.ExactArgs(1),
+		ValidArgsFunction: firstArgCompletion(completeClusterIDs(cliConf)),
+		Short:             "Deletes the cluster with the given id",
 		Run: func(cmd *cobra.Command, args []string) {
 			err := checkLoginAndRunWithConfig(cmd.Context(), cliConf, args, deleteCluster)
 			if err != nil {
@@ -48,6 +57,29 @@ func registerCommand_Cluster(cliConf config.CLIConfig) *cobra.Command {
 	}
 	clusterCmd.AddCommand(clusterDeleteCmd)
 
+	clusterEgressIPsCmd := &cobra.Command{
+		Use:   "egress-ips",
+		Short: "Lists the public IPs that traffic from the cluster to the internet leaves from",
+		Long: fmt.Sprintf(`
+%s
+
+Lists the public IPs of the NAT gateways of the current cluster, which is currently supported for AWS clusters. Every
+service of the cluster reaches the internet from these IPs, so they can be allowlisted with third parties.
+
+  %s
+`,
+			color.New(color.FgBlue, color.Bold).Sprintf("Help for \"porter cluster egress-ips\":"),
+			color.GreenString("porter cluster egress-ips"),
+		),
+		Run: func(cmd *cobra.Command, args []string) {
+			err := checkLoginAndRunWithConfig(cmd.Context(), cliConf, args, listEgressIPs)
+			if err != nil {
+				os.Exit(1)
+			}
+		},
+	}
+	clusterCmd.AddCommand(clusterEgressIPsCmd)
+
 	clusterNamespaceCmd := &cobra.Command{
 		Use:     "namespace",
 		Aliases: []string{"namespaces"},
@@ -67,15 +99,119 @@ func registerCommand_Cluster(cliConf config.CLIConfig) *cobra.Command {
 	}
 	clusterNamespaceCmd.AddCommand(clusterNamespaceListCmd)
 
+	clusterConnectCmd := &cobra.Command{
+		Use:   "connect",
+		Short: "Commands that connect a cluster from a cloud provider to the current project",
+	}
+	clusterCmd.AddCommand(clusterConnectCmd)
+
+	clusterConnectEKSCmd := &cobra.Command{
+		Use:   "eks",
+		Short: "Connects an EKS cluster using local AWS credentials",
+		Long: `Connects an EKS cluster using the AWS credentials of the current environment.
+
+The command reads the cluster endpoint from the EKS API, creates an IAM user for Porter with the
+AmazonEKSClusterPolicy attached, and maps that user to the cluster in the aws-auth config map. Your
+AWS identity must be able to manage IAM users and must have access to the cluster.`,
+		Run: func(cmd *cobra.Command, args []string) {
+			err := checkLoginAndRunWithConfig(cmd.Context(), cliConf, args, runConnectEKS)
+			if err != nil {
+				os.Exit(1)
+			}
+		},
+	}
+	clusterConnectEKSCmd.Flags().StringVar(&eksClusterName, "name", "", "the name of the EKS cluster")
+	clusterConnectEKSCmd.Flags().StringVar(&eksRegion, "region", "", "the AWS region of the EKS cluster")
+	clusterConnectCmd.AddCommand(clusterConnectEKSCmd)
+
+	clusterConnectGKECmd := &cobra.Command{
+		Use:   "gke",
+		Short: "Connects a GKE cluster using the gcloud CLI",
+		Long: `Connects a GKE cluster using the gcloud CLI of the current environment.
+
+The command reads the cluster endpoint with gcloud. Porter accesses the cluster with the service
+account key passed with --key-file, or offers to create a service account in the cluster's GCP project.`,
+		Run: func(cmd *cobra.Command, args []string) {
+			err := checkLoginAndRunWithConfig(cmd.Context(), cliConf, args, runConnectGKE)
+			if err != nil {
+				os.Exit(1)
+			}
+		},
+	}
+	clusterConnectGKECmd.Flags().StringVar(&gkeOpts.ClusterName, "name", "", "the name of the GKE cluster")
+	clusterConnectGKECmd.Flags().StringVar(&gkeOpts.Location, "location", "", "the zone or region of the GKE cluster")
+	clusterConnectGKECmd.Flags().StringVar(&gkeOpts.GCPProjectID, "gcp-project", "", "the GCP project of the cluster, if not the gcloud default")
+	clusterConnectGKECmd.Flags().StringVar(&gkeOpts.KeyFile, "key-file", "", "path to a service account key used by Porter to access the cluster")
+	clusterConnectCmd.AddCommand(clusterConnectGKECmd)
+
+	clusterConnectAKSCmd := &cobra.Command{
+		Use:   "aks",
+		Short: "Connects an AKS cluster using the az CLI",
+		Long: `Connects an AKS cluster using the az CLI of the current environment.
+
+The command reads the cluster admin credentials with az. To use a service principal instead of the
+current az login, pass --client-id and --tenant-id; the client secret is prompted for if not set.`,
+		Run: func(cmd *cobra.Command, args []string) {
+			err := checkLoginAndRunWithConfig(cmd.Context(), cliConf, args, runConnectAKS)
+			if err != nil {
+				os.Exit(1)
+			}
+		},
+	}
+	clusterConnectAKSCmd.Flags().StringVar(&aksOpts.ClusterName, "name", "", "the name of the AKS cluster")
+	clusterConnectAKSCmd.Flags().StringVar(&aksOpts.ResourceGroup, "resource-group", "", "the resource group of the AKS cluster")
+	clusterConnectAKSCmd.Flags().StringVar(&aksOpts.SubscriptionID, "subscription", "", "the subscription of the cluster, if not the az default")
+	clusterConnectAKSCmd.Flags().StringVar(&aksOpts.ClientID, "client-id", "", "the client id of a service principal to log in with")
+	clusterConnectAKSCmd.Flags().StringVar(&aksOpts.ClientSecret, "client-secret", "", "the client secret of the service principal")
+	clusterConnectAKSCmd.Flags().StringVar(&aksOpts.TenantID, "tenant-id", "", "the tenant id of the service principal")
+	clusterConnectCmd.AddCommand(clusterConnectAKSCmd)
+
 	return clusterCmd
 }
 
+func runConnectEKS(ctx context.Context, _ *types.GetAuthenticatedUserResponse, client api.Client, cliConf config.CLIConfig, _ []string) error {
+	id, err := connect.EKS(
+		ctx,
+		client,
+		cliConf.Project,
+		eksClusterName,
+		eksRegion,
+	)
+	if err != nil {
+		return err
+	}
+
+	return cliConf.SetCluster(id)
+}
+
+func runConnectGKE(ctx context.Context, _ *types.GetAuthenticatedUserResponse, client api.Client, cliConf config.CLIConfig, _ []string) error {
+	id, err := connect.GKE(ctx, client, cliConf.Project, gkeOpts)
+	if err != nil {
+		return err
+	}
+
+	return cliConf.SetCluster(id)
+}
+
+func runConnectAKS(ctx context.Context, _ *types.GetAuthenticatedUserResponse, client api.Client, cliConf config.CLIConfig, _ []string) error {
+	id, err := connect.AKS(ctx, client, cliConf.Project, aksOpts)
+	if err != nil {
+		return err
+	}
+
+	return cliConf.SetCluster(id)
+}
+
 func listClusters(ctx context.Context, user *types.GetAuthenticatedUserResponse, client api.Client, cliConf config.CLIConfig, args []string) error {
 	resp, err := client.ListProjectClusters(ctx, cliConf.Project)
 	if err != nil {
 		return err
 	}
 
+	if utils.IsStructuredOutput() {
+		return utils.PrintStructured(resp)
+	}
+
 	clusters := *resp
 
 	w := new(tabwriter.Writer)
@@ -144,6 +280,10 @@ func listNamespaces(ctx context.Context, user *types.GetAuthenticatedUserRespons
 		return err
 	}
 
+	if utils.IsStructuredOutput() {
+		return utils.PrintStructured(namespaceList)
+	}
+
 	w := new(tabwriter.Writer)
 	w.Init(os.Stdout, 3, 8, 0, '\t', tabwriter.AlignRight)
 
@@ -159,3 +299,25 @@ func listNamespaces(ctx context.Context, user *types.GetAuthenticatedUserRespons
 
 	return nil
 }
+
+func listEgressIPs(ctx context.Context, _ *types.GetAuthenticatedUserResponse, client api.Client, cliConf config.CLIConfig, _ []string) error {
+	resp, err := client.GetClusterEgressIPs(ctx, cliConf.Project, cliConf.Cluster)
+	if err != nil {
+		return err
+	}
+
+	if utils.IsStructuredOutput() {
+		return utils.PrintStructured(resp)
+	}
+
+	if len(resp.EgressIPs) == 0 {
+		_, _ = color.New(color.FgYellow).Fprintln(os.Stderr, "No egress IPs were found for the cluster")
+		return nil
+	}
+
+	for _, ip := range resp.EgressIPs {
+		fmt.Println(ip)
+	}
+
+	return nil
+}
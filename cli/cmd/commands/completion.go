@@ -0,0 +1,215 @@
+package commands
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/fatih/color"
+	api "github.com/porter-dev/porter/api/client"
+	"github.com/porter-dev/porter/cli/cmd/config"
+	"github.com/spf13/cobra"
+)
+
+// completionTimeout bounds the API calls made while completing, so that a slow or unreachable server does not hang the shell
+const completionTimeout = 5 * time.Second
+
+// completionFunc is the signature of cobra's dynamic completion functions for args and flags
+type completionFunc func(cmd *cobra.Command, args []string, toComplete string) ([]string, cobra.ShellCompDirective)
+
+func registerCommand_Completion(_ config.CLIConfig) *cobra.Command {
+	completionCmd := &cobra.Command{
+		Use:                   "completion [bash|zsh|fish|powershell]",
+		Short:                 "Generates a shell completion script",
+		DisableFlagsInUseLine: true,
+		ValidArgs:             []string{"bash", "zsh", "fish", "powershell"},
+		Args:                  cobra.ExactValidArgs(1),
+		Long: fmt.Sprintf(`
+%s
+
+Generates a script which completes the commands and flags of the Porter CLI, along with the names of
+apps, projects and clusters, which are looked up from the Porter API as you type. To load completions
+for the current bash session:
+
+  %s
+
+To load completions for every session, write the script to the completion directory of your shell,
+for example:
+
+  bash:       porter completion bash > /etc/bash_completion.d/porter
+  zsh:        porter completion zsh > "${fpath[1]}/_porter"
+  fish:       porter completion fish > ~/.config/fish/completions/porter.fish
+  powershell: porter completion powershell | Out-String | Invoke-Expression
+`,
+			color.New(color.FgBlue, color.Bold).Sprintf("Help for \"porter completion\":"),
+			color.New(color.FgGreen, color.Bold).Sprintf("source <(porter completion bash)"),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			root := cmd.Root()
+
+			switch args[0] {
+			case "bash":
+				return root.GenBashCompletionV2(os.Stdout, true)
+			case "zsh":
+				return root.GenZshCompletion(os.Stdout)
+			case "fish":
+				return root.GenFishCompletion(os.Stdout, true)
+			case "powershell":
+				return root.GenPowerShellCompletionWithDesc(os.Stdout)
+			}
+
+			return fmt.Errorf("unsupported shell %s", args[0])
+		},
+	}
+
+	return completionCmd
+}
+
+// registerCompletions registers the completion of the flags shared by all commands
+func registerCompletions(rootCmd *cobra.Command, cliConf config.CLIConfig) {
+	rootCmd.RegisterFlagCompletionFunc("project", completeProjectIDs(cliConf)) // nolint:errcheck,gosec
+	rootCmd.RegisterFlagCompletionFunc("cluster", completeClusterIDs(cliConf)) // nolint:errcheck,gosec
+	rootCmd.RegisterFlagCompletionFunc("context", completeContextNames)        // nolint:errcheck,gosec
+}
+
+// completionClient returns an API client for completing args, without checking the login or printing errors, since
+// any output would be interpreted as completions
+func completionClient(ctx context.Context, cliConf config.CLIConfig) (api.Client, error) {
+	return api.NewClientWithConfig(ctx, api.NewClientInput{
+		BaseURL:        fmt.Sprintf("%s/api", cliConf.Host),
+		BearerToken:    cliConf.Token,
+		CookieFileName: "cookie.json",
+	})
+}
+
+// completeAppNames completes with the names of the apps in the current cluster
+func completeAppNames(cliConf config.CLIConfig) completionFunc {
+	return func(cmd *cobra.Command, _ []string, toComplete string) ([]string, cobra.ShellCompDirective) {
+		ctx, cancel := context.WithTimeout(cmd.Context(), completionTimeout)
+		defer cancel()
+
+		client, err := completionClient(ctx, cliConf)
+		if err != nil {
+			return nil, cobra.ShellCompDirectiveNoFileComp
+		}
+
+		apps, err := client.ListPorterApps(ctx, completionProject(cmd, cliConf), completionCluster(cmd, cliConf))
+		if err != nil {
+			return nil, cobra.ShellCompDirectiveNoFileComp
+		}
+
+		var completions []string
+		for _, app := range apps {
+			if strings.HasPrefix(app.Name, toComplete) {
+				completions = append(completions, app.Name)
+			}
+		}
+
+		return completions, cobra.ShellCompDirectiveNoFileComp
+	}
+}
+
+// completeProjectIDs completes with the ids of the projects of the current user, described by their names
+func completeProjectIDs(cliConf config.CLIConfig) completionFunc {
+	return func(cmd *cobra.Command, _ []string, toComplete string) ([]string, cobra.ShellCompDirective) {
+		ctx, cancel := context.WithTimeout(cmd.Context(), completionTimeout)
+		defer cancel()
+
+		client, err := completionClient(ctx, cliConf)
+		if err != nil {
+			return nil, cobra.ShellCompDirectiveNoFileComp
+		}
+
+		projects, err := client.ListUserProjects(ctx)
+		if err != nil {
+			return nil, cobra.ShellCompDirectiveNoFileComp
+		}
+
+		var completions []string
+		for _, project := range *projects {
+			id := strconv.FormatUint(uint64(project.ID), 10)
+			if strings.HasPrefix(id, toComplete) {
+				completions = append(completions, fmt.Sprintf("%s\t%s", id, project.Name))
+			}
+		}
+
+		return completions, cobra.ShellCompDirectiveNoFileComp
+	}
+}
+
+// completeClusterIDs completes with the ids of the clusters of the current project, described by their names
+func completeClusterIDs(cliConf config.CLIConfig) completionFunc {
+	return func(cmd *cobra.Command, _ []string, toComplete string) ([]string, cobra.ShellCompDirective) {
+		ctx, cancel := context.WithTimeout(cmd.Context(), completionTimeout)
+		defer cancel()
+
+		client, err := completionClient(ctx, cliConf)
+		if err != nil {
+			return nil, cobra.ShellCompDirectiveNoFileComp
+		}
+
+		clusters, err := client.ListProjectClusters(ctx, completionProject(cmd, cliConf))
+		if err != nil {
+			return nil, cobra.ShellCompDirectiveNoFileComp
+		}
+
+		var completions []string
+		for _, cluster := range *clusters {
+			id := strconv.FormatUint(uint64(cluster.ID), 10)
+			if strings.HasPrefix(id, toComplete) {
+				completions = append(completions, fmt.Sprintf("%s\t%s", id, cluster.Name))
+			}
+		}
+
+		return completions, cobra.ShellCompDirectiveNoFileComp
+	}
+}
+
+// completeContextNames completes with the names of the saved contexts
+func completeContextNames(_ *cobra.Command, _ []string, toComplete string) ([]string, cobra.ShellCompDirective) {
+	contexts, err := config.ListContexts()
+	if err != nil {
+		return nil, cobra.ShellCompDirectiveNoFileComp
+	}
+
+	var completions []string
+	for _, name := range config.ContextNames(contexts) {
+		if strings.HasPrefix(name, toComplete) {
+			completions = append(completions, fmt.Sprintf("%s\t%s", name, contexts[name].Host))
+		}
+	}
+
+	return completions, cobra.ShellCompDirectiveNoFileComp
+}
+
+// firstArgCompletion restricts a completion to the first arg of a command
+func firstArgCompletion(complete completionFunc) completionFunc {
+	return func(cmd *cobra.Command, args []string, toComplete string) ([]string, cobra.ShellCompDirective) {
+		if len(args) != 0 {
+			return nil, cobra.ShellCompDirectiveNoFileComp
+		}
+
+		return complete(cmd, args, toComplete)
+	}
+}
+
+// completionProject returns the project passed with the --project flag, falling back to the project in the config
+func completionProject(cmd *cobra.Command, cliConf config.CLIConfig) uint {
+	if project, err := cmd.Flags().GetUint("project"); err == nil && cmd.Flags().Changed("project") {
+		return project
+	}
+
+	return cliConf.Project
+}
+
+// completionCluster returns the cluster passed with the --cluster flag, falling back to the cluster in the config
+func completionCluster(cmd *cobra.Command, cliConf config.CLIConfig) uint {
+	if cluster, err := cmd.Flags().GetUint("cluster"); err == nil && cmd.Flags().Changed("cluster") {
+		return cluster
+	}
+
+	return cliConf.Cluster
+}
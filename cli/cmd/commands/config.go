@@ -7,6 +7,7 @@ import (
 	"path/filepath"
 	"strconv"
 	"strings"
+	"text/tabwriter"
 	"time"
 
 	"github.com/briandowns/spinner"
@@ -31,9 +32,10 @@ func registerCommand_Config(cliConf config.CLIConfig) *cobra.Command {
 	}
 
 	configSetProjectCmd := &cobra.Command{
-		Use:   "set-project [id]",
-		Args:  cobra.MaximumNArgs(1),
-		Short: "Saves the project id in the default configuration",
+		Use:               "set-project [id]",
+		Args:              cobra.MaximumNArgs(1),
+		ValidArgsFunction: firstArgCompletion(completeProjectIDs(cliConf)),
+		Short:             "Saves the project id in the default configuration",
 		Run: func(cmd *cobra.Command, args []string) {
 			client, err := api.NewClientWithConfig(cmd.Context(), api.NewClientInput{
 				BaseURL:        fmt.Sprintf("%s/api", cliConf.Host),
@@ -67,9 +69,10 @@ func registerCommand_Config(cliConf config.CLIConfig) *cobra.Command {
 	}
 
 	configSetClusterCmd := &cobra.Command{
-		Use:   "set-cluster [id]",
-		Args:  cobra.MaximumNArgs(1),
-		Short: "Saves the cluster id in the default configuration",
+		Use:               "set-cluster [id]",
+		Args:              cobra.MaximumNArgs(1),
+		ValidArgsFunction: firstArgCompletion(completeClusterIDs(cliConf)),
+		Short:             "Saves the cluster id in the default configuration",
 		Run: func(cmd *cobra.Command, args []string) {
 			if len(args) == 0 {
 				err := checkLoginAndRunWithConfig(cmd.Context(), cliConf, args, listAndSetCluster)
@@ -166,12 +169,82 @@ func registerCommand_Config(cliConf config.CLIConfig) *cobra.Command {
 		},
 	}
 
+	configGetContextsCmd := &cobra.Command{
+		Use:   "get-contexts",
+		Args:  cobra.NoArgs,
+		Short: "Lists the saved contexts, marking the current context with *",
+		Run: func(cmd *cobra.Command, args []string) {
+			if err := printContexts(cliConf); err != nil {
+				_, _ = color.New(color.FgRed).Fprintf(os.Stderr, "An error occurred: %s\n", err.Error())
+				os.Exit(1)
+			}
+		},
+	}
+
+	configSetContextCmd := &cobra.Command{
+		Use:   "set-context [name]",
+		Args:  cobra.ExactArgs(1),
+		Short: "Saves the current host, project, cluster and token as a named context",
+		Long: fmt.Sprintf(`
+%s
+
+Saves the current host, project, cluster and auth token as a named context, replacing any context
+with the same name. Contexts let you switch between Porter servers and projects without setting them
+and logging in again. For example:
+
+  %s
+
+Once a context is in use, "porter auth login" and the "porter config set-*" commands for the host,
+project and cluster update the context. A context can be used for a single command with the --context
+flag or the PORTER_CONTEXT env var.
+`,
+			color.New(color.FgBlue, color.Bold).Sprintf("Help for \"porter config set-context\":"),
+			color.New(color.FgGreen, color.Bold).Sprintf("porter config set-context acme && porter config use-context acme"),
+		),
+		Run: func(cmd *cobra.Command, args []string) {
+			if err := cliConf.SaveContext(args[0]); err != nil {
+				_, _ = color.New(color.FgRed).Fprintf(os.Stderr, "An error occurred: %s\n", err.Error())
+				os.Exit(1)
+			}
+		},
+	}
+
+	configUseContextCmd := &cobra.Command{
+		Use:               "use-context [name]",
+		Args:              cobra.ExactArgs(1),
+		ValidArgsFunction: firstArgCompletion(completeContextNames),
+		Short:             "Switches to a saved context",
+		Run: func(cmd *cobra.Command, args []string) {
+			if err := cliConf.UseContext(args[0]); err != nil {
+				_, _ = color.New(color.FgRed).Fprintf(os.Stderr, "An error occurred: %s\n", err.Error())
+				os.Exit(1)
+			}
+		},
+	}
+
+	configDeleteContextCmd := &cobra.Command{
+		Use:               "delete-context [name]",
+		Args:              cobra.ExactArgs(1),
+		ValidArgsFunction: firstArgCompletion(completeContextNames),
+		Short:             "Deletes a saved context",
+		Run: func(cmd *cobra.Command, args []string) {
+			if err := cliConf.DeleteContext(args[0]); err != nil {
+				_, _ = color.New(color.FgRed).Fprintf(os.Stderr, "An error occurred: %s\n", err.Error())
+				os.Exit(1)
+			}
+		},
+	}
+
 	configCmd.AddCommand(configSetProjectCmd)
 	configCmd.AddCommand(configSetClusterCmd)
 	configCmd.AddCommand(configSetHostCmd)
 	configCmd.AddCommand(configSetRegistryCmd)
 	configCmd.AddCommand(configSetHelmRepoCmd)
 	configCmd.AddCommand(configSetKubeconfigCmd)
+	configCmd.AddCommand(configGetContextsCmd)
+	configCmd.AddCommand(configSetContextCmd)
+	configCmd.AddCommand(configUseContextCmd)
+	configCmd.AddCommand(configDeleteContextCmd)
 	return configCmd
 }
 
@@ -186,6 +259,60 @@ func printConfig() error {
 	return nil
 }
 
+// listedContext is a saved context printed by "porter config get-contexts". The token of the context is left out.
+type listedContext struct {
+	Name    string `json:"name"`
+	Current bool   `json:"current"`
+	Host    string `json:"host"`
+	Project uint   `json:"project"`
+	Cluster uint   `json:"cluster"`
+}
+
+func printContexts(cliConf config.CLIConfig) error {
+	contexts, err := config.ListContexts()
+	if err != nil {
+		return err
+	}
+
+	listed := make([]listedContext, 0, len(contexts))
+	for _, name := range config.ContextNames(contexts) {
+		listed = append(listed, listedContext{
+			Name:    name,
+			Current: name == cliConf.Context,
+			Host:    contexts[name].Host,
+			Project: contexts[name].Project,
+			Cluster: contexts[name].Cluster,
+		})
+	}
+
+	if utils.IsStructuredOutput() {
+		return utils.PrintStructured(listed)
+	}
+
+	if len(listed) == 0 {
+		fmt.Println("No contexts found, save the current settings as one with porter config set-context")
+		return nil
+	}
+
+	w := new(tabwriter.Writer)
+	w.Init(os.Stdout, 3, 8, 2, ' ', 0)
+
+	fmt.Fprintf(w, "%s\t%s\t%s\t%s\t%s\n", "CURRENT", "NAME", "HOST", "PROJECT", "CLUSTER")
+
+	for _, entry := range listed {
+		current := ""
+		if entry.Current {
+			current = "*"
+		}
+
+		fmt.Fprintf(w, "%s\t%s\t%s\t%d\t%d\n", current, entry.Name, entry.Host, entry.Project, entry.Cluster)
+	}
+
+	w.Flush()
+
+	return nil
+}
+
 func listAndSetProject(ctx context.Context, _ *types.GetAuthenticatedUserResponse, client api.Client, cliConf config.CLIConfig, args []string) error {
 	s := spinner.New(spinner.CharSets[9], 100*time.Millisecond)
 	_ = s.Color("cyan")
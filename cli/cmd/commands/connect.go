@@ -56,6 +56,28 @@ func registerCommand_Connect(cliConf config.CLIConfig) *cobra.Command {
 		},
 	}
 
+	connectGHCRCmd := &cobra.Command{
+		Use:   "ghcr",
+		Short: "Adds a GitHub Container Registry integration to a project",
+		Run: func(cmd *cobra.Command, args []string) {
+			err := checkLoginAndRunWithConfig(cmd.Context(), cliConf, args, runConnectGHCR)
+			if err != nil {
+				os.Exit(1)
+			}
+		},
+	}
+
+	connectQuayCmd := &cobra.Command{
+		Use:   "quay",
+		Short: "Adds a Quay.io registry integration to a project",
+		Run: func(cmd *cobra.Command, args []string) {
+			err := checkLoginAndRunWithConfig(cmd.Context(), cliConf, args, runConnectQuay)
+			if err != nil {
+				os.Exit(1)
+			}
+		},
+	}
+
 	connectRegistryCmd := &cobra.Command{
 		Use:   "registry",
 		Short: "Adds a custom image registry to a project",
@@ -131,6 +153,8 @@ func registerCommand_Connect(cliConf config.CLIConfig) *cobra.Command {
 	connectCmd.AddCommand(connectECRCmd)
 	connectCmd.AddCommand(connectRegistryCmd)
 	connectCmd.AddCommand(connectDockerhubCmd)
+	connectCmd.AddCommand(connectGHCRCmd)
+	connectCmd.AddCommand(connectQuayCmd)
 	connectCmd.AddCommand(connectGCRCmd)
 	connectCmd.AddCommand(connectGARCmd)
 	connectCmd.AddCommand(connectDOCRCmd)
@@ -225,6 +249,32 @@ func runConnectDockerhub(ctx context.Context, _ *types.GetAuthenticatedUserRespo
 	return cliConf.SetRegistry(regID)
 }
 
+func runConnectGHCR(ctx context.Context, _ *types.GetAuthenticatedUserResponse, client api.Client, cliConf config.CLIConfig, _ []string) error {
+	regID, err := connect.GHCR(
+		ctx,
+		client,
+		cliConf.Project,
+	)
+	if err != nil {
+		return err
+	}
+
+	return cliConf.SetRegistry(regID)
+}
+
+func runConnectQuay(ctx context.Context, _ *types.GetAuthenticatedUserResponse, client api.Client, cliConf config.CLIConfig, _ []string) error {
+	regID, err := connect.Quay(
+		ctx,
+		client,
+		cliConf.Project,
+	)
+	if err != nil {
+		return err
+	}
+
+	return cliConf.SetRegistry(regID)
+}
+
 func runConnectRegistry(ctx context.Context, _ *types.GetAuthenticatedUserResponse, client api.Client, cliConf config.CLIConfig, _ []string) error {
 	regID, err := connect.Registry(
 		ctx,
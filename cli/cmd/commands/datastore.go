@@ -0,0 +1,350 @@
+package commands
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"strings"
+	"text/tabwriter"
+
+	"github.com/fatih/color"
+	api "github.com/porter-dev/porter/api/client"
+	"github.com/porter-dev/porter/api/types"
+	"github.com/porter-dev/porter/cli/cmd/config"
+	"github.com/porter-dev/porter/cli/cmd/utils"
+	"github.com/spf13/cobra"
+)
+
+var (
+	datastoreEngine           string
+	datastoreEngineVersion    string
+	datastoreAWSIntegrationID uint
+	datastoreRegion           string
+	datastoreInstanceClass    string
+	datastoreStorageGB        int64
+	datastoreDatabaseName     string
+	datastoreApp              string
+	datastoreEnvVar           string
+)
+
+func registerCommand_Datastore(cliConf config.CLIConfig) *cobra.Command {
+	datastoreCmd := &cobra.Command{
+		Use:     "datastore",
+		Aliases: []string{"datastores"},
+		Short:   "Commands that provision managed databases with an AWS integration of the current project",
+	}
+
+	datastoreCreateCmd := &cobra.Command{
+		Use:   "create [name]",
+		Args:  cobra.ExactArgs(1),
+		Short: "Provisions an RDS instance as a datastore of the current project",
+		Long: fmt.Sprintf(`
+%s
+
+Provisions an RDS instance with the credentials of an AWS integration of the current project. The
+instance takes several minutes to become available, and its status can be checked with
+"porter datastore list". For example:
+
+  %s
+
+Once available, the datastore can be linked to apps with "porter datastore link".
+`,
+			color.New(color.FgBlue, color.Bold).Sprintf("Help for \"porter datastore create\":"),
+			color.New(color.FgGreen, color.Bold).Sprintf("porter datastore create app-db --engine postgres --aws-integration-id 1 --region us-east-1"),
+		),
+		Run: func(cmd *cobra.Command, args []string) {
+			err := checkLoginAndRunWithConfig(cmd.Context(), cliConf, args, createDatastore)
+			if err != nil {
+				os.Exit(1)
+			}
+		},
+	}
+	datastoreCreateCmd.Flags().StringVar(&datastoreEngine, "engine", string(types.DatastoreEngine_Postgres), "the database engine, either postgres or mysql")
+	datastoreCreateCmd.Flags().StringVar(&datastoreEngineVersion, "engine-version", "", "the version of the engine, which defaults to the default version in RDS")
+	datastoreCreateCmd.Flags().UintVar(&datastoreAWSIntegrationID, "aws-integration-id", 0, "the id of the AWS integration to provision the datastore with")
+	datastoreCreateCmd.Flags().StringVar(&datastoreRegion, "region", "", "the AWS region to provision the datastore in")
+	datastoreCreateCmd.Flags().StringVar(&datastoreInstanceClass, "instance-class", "", "the RDS instance class, which defaults to db.t4g.micro")
+	datastoreCreateCmd.Flags().Int64Var(&datastoreStorageGB, "storage", 0, "the allocated storage in GB, which defaults to 20")
+	datastoreCreateCmd.Flags().StringVar(&datastoreDatabaseName, "database", "", "the name of the database to create, which defaults to the name of the datastore")
+	datastoreCmd.AddCommand(datastoreCreateCmd)
+
+	datastoreListCmd := &cobra.Command{
+		Use:   "list",
+		Short: "Lists the datastores of the current project",
+		Run: func(cmd *cobra.Command, args []string) {
+			err := checkLoginAndRunWithConfig(cmd.Context(), cliConf, args, listDatastores)
+			if err != nil {
+				os.Exit(1)
+			}
+		},
+	}
+	datastoreCmd.AddCommand(datastoreListCmd)
+
+	datastoreDeleteCmd := &cobra.Command{
+		Use:   "delete [name]",
+		Args:  cobra.ExactArgs(1),
+		Short: "Deletes the RDS instance of a datastore, after taking a final snapshot",
+		Run: func(cmd *cobra.Command, args []string) {
+			err := checkLoginAndRunWithConfig(cmd.Context(), cliConf, args, deleteDatastore)
+			if err != nil {
+				os.Exit(1)
+			}
+		},
+	}
+	datastoreCmd.AddCommand(datastoreDeleteCmd)
+
+	datastoreLinkCmd := &cobra.Command{
+		Use:   "link [name]",
+		Args:  cobra.ExactArgs(1),
+		Short: "Sets the connection string of a datastore in the env of an app of the current cluster on its next deploy",
+		Run: func(cmd *cobra.Command, args []string) {
+			err := checkLoginAndRunWithConfig(cmd.Context(), cliConf, args, linkDatastore)
+			if err != nil {
+				os.Exit(1)
+			}
+		},
+	}
+	datastoreLinkCmd.Flags().StringVar(&datastoreApp, "app", "", "the name of the app to link the datastore to")
+	datastoreLinkCmd.RegisterFlagCompletionFunc("app", completeAppNames(cliConf)) // nolint:errcheck,gosec
+	datastoreLinkCmd.Flags().StringVar(&datastoreEnvVar, "env-var", "DATABASE_URL", "the env var to set to the connection string")
+	datastoreCmd.AddCommand(datastoreLinkCmd)
+
+	datastoreUnlinkCmd := &cobra.Command{
+		Use:   "unlink [name]",
+		Args:  cobra.ExactArgs(1),
+		Short: "Unlinks a datastore from an app of the current cluster",
+		Run: func(cmd *cobra.Command, args []string) {
+			err := checkLoginAndRunWithConfig(cmd.Context(), cliConf, args, unlinkDatastore)
+			if err != nil {
+				os.Exit(1)
+			}
+		},
+	}
+	datastoreUnlinkCmd.Flags().StringVar(&datastoreApp, "app", "", "the name of the app to unlink the datastore from")
+	datastoreUnlinkCmd.RegisterFlagCompletionFunc("app", completeAppNames(cliConf)) // nolint:errcheck,gosec
+	datastoreCmd.AddCommand(datastoreUnlinkCmd)
+
+	datastoreRotateCmd := &cobra.Command{
+		Use:   "rotate [name]",
+		Args:  cobra.ExactArgs(1),
+		Short: "Issues a new password for the database user of an app of the current cluster, and redeploys the app",
+		Run: func(cmd *cobra.Command, args []string) {
+			err := checkLoginAndRunWithConfig(cmd.Context(), cliConf, args, rotateDatastoreCredentials)
+			if err != nil {
+				os.Exit(1)
+			}
+		},
+	}
+	datastoreRotateCmd.Flags().StringVar(&datastoreApp, "app", "", "the name of the app whose credentials are rotated")
+	datastoreRotateCmd.RegisterFlagCompletionFunc("app", completeAppNames(cliConf)) // nolint:errcheck,gosec
+	datastoreCmd.AddCommand(datastoreRotateCmd)
+
+	return datastoreCmd
+}
+
+func createDatastore(ctx context.Context, _ *types.GetAuthenticatedUserResponse, client api.Client, cliConf config.CLIConfig, args []string) error {
+	if datastoreAWSIntegrationID == 0 {
+		return fmt.Errorf("--aws-integration-id must be set")
+	}
+
+	if datastoreRegion == "" {
+		return fmt.Errorf("--region must be set")
+	}
+
+	datastore, err := client.CreateDatastore(ctx, cliConf.Project, &types.CreateDatastoreRequest{
+		Name:             args[0],
+		AWSIntegrationID: datastoreAWSIntegrationID,
+		Region:           datastoreRegion,
+		Engine:           types.DatastoreEngine(datastoreEngine),
+		EngineVersion:    datastoreEngineVersion,
+		InstanceClass:    datastoreInstanceClass,
+		StorageGB:        datastoreStorageGB,
+		DatabaseName:     datastoreDatabaseName,
+	})
+	if err != nil {
+		return fmt.Errorf("error creating datastore: %w", err)
+	}
+
+	color.New(color.FgGreen).Printf("Provisioning datastore %s as RDS instance %s\n", datastore.Name, datastore.InstanceIdentifier)
+
+	return nil
+}
+
+func listDatastores(ctx context.Context, _ *types.GetAuthenticatedUserResponse, client api.Client, cliConf config.CLIConfig, args []string) error {
+	resp, err := client.ListDatastores(ctx, cliConf.Project)
+	if err != nil {
+		return fmt.Errorf("error listing datastores: %w", err)
+	}
+
+	if utils.IsStructuredOutput() {
+		return utils.PrintStructured(resp)
+	}
+
+	if len(resp.Datastores) == 0 {
+		fmt.Println("No datastores found")
+		return nil
+	}
+
+	w := new(tabwriter.Writer)
+	w.Init(os.Stdout, 3, 8, 2, ' ', 0)
+
+	fmt.Fprintf(w, "%s\t%s\t%s\t%s\t%s\t%s\t%s\n", "NAME", "ENGINE", "REGION", "INSTANCE CLASS", "STATUS", "ENDPOINT", "LINKED APPS")
+
+	for _, datastore := range resp.Datastores {
+		engine := string(datastore.Engine)
+		if datastore.EngineVersion != "" {
+			engine = fmt.Sprintf("%s %s", engine, datastore.EngineVersion)
+		}
+
+		status := string(datastore.Status)
+		if datastore.StatusMessage != "" {
+			status = fmt.Sprintf("%s (%s)", status, datastore.StatusMessage)
+		}
+
+		apps := make([]string, 0, len(datastore.Links))
+		for _, link := range datastore.Links {
+			apps = append(apps, fmt.Sprintf("%s:%s", link.AppName, link.EnvVar))
+		}
+
+		fmt.Fprintf(w, "%s\t%s\t%s\t%s\t%s\t%s\t%s\n", datastore.Name, engine, datastore.Region, datastore.InstanceClass, status, datastore.Endpoint, strings.Join(apps, ","))
+	}
+
+	w.Flush()
+
+	return nil
+}
+
+func deleteDatastore(ctx context.Context, _ *types.GetAuthenticatedUserResponse, client api.Client, cliConf config.CLIConfig, args []string) error {
+	datastore, err := findDatastore(ctx, client, cliConf, args[0])
+	if err != nil {
+		return err
+	}
+
+	userResp, err := utils.PromptPlaintext(
+		fmt.Sprintf(
+			`Are you sure you'd like to delete the datastore %s? A final snapshot is taken before its instance is deleted. %s `,
+			datastore.Name,
+			color.New(color.FgCyan).Sprintf("[y/n]"),
+		),
+	)
+	if err != nil {
+		return err
+	}
+
+	if userResp := strings.ToLower(userResp); userResp != "y" && userResp != "yes" {
+		return nil
+	}
+
+	if err := client.DeleteDatastore(ctx, cliConf.Project, datastore.ID); err != nil {
+		return fmt.Errorf("error deleting datastore: %w", err)
+	}
+
+	color.New(color.FgGreen).Printf("Deleting datastore %s\n", datastore.Name)
+
+	return nil
+}
+
+func linkDatastore(ctx context.Context, _ *types.GetAuthenticatedUserResponse, client api.Client, cliConf config.CLIConfig, args []string) error {
+	if datastoreApp == "" {
+		return fmt.Errorf("--app must be set")
+	}
+
+	datastore, err := findDatastore(ctx, client, cliConf, args[0])
+	if err != nil {
+		return err
+	}
+
+	link, err := client.LinkDatastore(ctx, cliConf.Project, datastore.ID, &types.LinkDatastoreRequest{
+		ClusterID: cliConf.Cluster,
+		AppName:   datastoreApp,
+		EnvVar:    datastoreEnvVar,
+	})
+	if err != nil {
+		return fmt.Errorf("error linking datastore: %w", err)
+	}
+
+	color.New(color.FgGreen).Printf("Linked datastore %s to app %s as database user %s. %s is set on the next deploy of the app.\n", datastore.Name, link.AppName, link.Username, link.EnvVar)
+
+	return nil
+}
+
+func unlinkDatastore(ctx context.Context, _ *types.GetAuthenticatedUserResponse, client api.Client, cliConf config.CLIConfig, args []string) error {
+	if datastoreApp == "" {
+		return fmt.Errorf("--app must be set")
+	}
+
+	datastore, err := findDatastore(ctx, client, cliConf, args[0])
+	if err != nil {
+		return err
+	}
+
+	var unlinked bool
+
+	for _, link := range datastore.Links {
+		if link.ClusterID != cliConf.Cluster || link.AppName != datastoreApp {
+			continue
+		}
+
+		if err := client.UnlinkDatastore(ctx, cliConf.Project, datastore.ID, link.ID); err != nil {
+			return fmt.Errorf("error unlinking datastore: %w", err)
+		}
+
+		unlinked = true
+	}
+
+	if !unlinked {
+		return fmt.Errorf("datastore %s is not linked to app %s", datastore.Name, datastoreApp)
+	}
+
+	color.New(color.FgGreen).Printf("Unlinked datastore %s from app %s\n", datastore.Name, datastoreApp)
+
+	return nil
+}
+
+func rotateDatastoreCredentials(ctx context.Context, _ *types.GetAuthenticatedUserResponse, client api.Client, cliConf config.CLIConfig, args []string) error {
+	if datastoreApp == "" {
+		return fmt.Errorf("--app must be set")
+	}
+
+	datastore, err := findDatastore(ctx, client, cliConf, args[0])
+	if err != nil {
+		return err
+	}
+
+	for _, link := range datastore.Links {
+		if link.ClusterID != cliConf.Cluster || link.AppName != datastoreApp {
+			continue
+		}
+
+		resp, err := client.RotateDatastoreCredentials(ctx, cliConf.Project, datastore.ID, link.ID)
+		if err != nil {
+			return fmt.Errorf("error rotating credentials: %w", err)
+		}
+
+		color.New(color.FgGreen).Printf("Rotated the password of database user %s\n", resp.Link.Username)
+
+		for _, workload := range resp.RedeployedWorkloads {
+			fmt.Printf("Redeploying %s\n", workload)
+		}
+
+		return nil
+	}
+
+	return fmt.Errorf("datastore %s is not linked to app %s", datastore.Name, datastoreApp)
+}
+
+// findDatastore returns the datastore of the current project with the given name
+func findDatastore(ctx context.Context, client api.Client, cliConf config.CLIConfig, name string) (*types.Datastore, error) {
+	resp, err := client.ListDatastores(ctx, cliConf.Project)
+	if err != nil {
+		return nil, fmt.Errorf("error listing datastores: %w", err)
+	}
+
+	for _, datastore := range resp.Datastores {
+		if datastore.Name == name {
+			return &datastore, nil
+		}
+	}
+
+	return nil, fmt.Errorf("datastore %s not found", name)
+}
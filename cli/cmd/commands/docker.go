@@ -2,14 +2,21 @@ package commands
 
 import (
 	"context"
+	"fmt"
 	"os"
+	"path/filepath"
 
+	"github.com/docker/docker-credential-helpers/credentials"
+	"github.com/fatih/color"
 	api "github.com/porter-dev/porter/api/client"
 	ptypes "github.com/porter-dev/porter/api/types"
 	"github.com/porter-dev/porter/cli/cmd/config"
+	"github.com/porter-dev/porter/cmd/docker-credential-porter/helper"
 	"github.com/spf13/cobra"
 )
 
+var dockerConfigStdout bool
+
 func registerCommand_Docker(cliConf config.CLIConfig) *cobra.Command {
 	dockerCmd := &cobra.Command{
 		Use:   "docker",
@@ -19,6 +26,23 @@ func registerCommand_Docker(cliConf config.CLIConfig) *cobra.Command {
 	configureCmd := &cobra.Command{
 		Use:   "configure",
 		Short: "Configures the host's Docker instance",
+		Long: fmt.Sprintf(`
+%s
+
+Configures the host's Docker instance to authenticate with the registries of the current project,
+using short-lived credentials from the porter credential helper. For example:
+
+  %s
+
+To print a Docker config.json for the registries of the project instead of modifying the host's
+Docker config, use the --stdout flag. This can be used by tools such as skaffold or docker compose:
+
+  %s
+`,
+			color.New(color.FgBlue, color.Bold).Sprintf("Help for \"porter docker configure\":"),
+			color.New(color.FgGreen, color.Bold).Sprintf("porter docker configure"),
+			color.New(color.FgGreen, color.Bold).Sprintf("DOCKER_CONFIG=$(mktemp -d) && porter docker configure --stdout > $DOCKER_CONFIG/config.json"),
+		),
 		Run: func(cmd *cobra.Command, args []string) {
 			err := checkLoginAndRunWithConfig(cmd.Context(), cliConf, args, dockerConfig)
 			if err != nil {
@@ -27,10 +51,74 @@ func registerCommand_Docker(cliConf config.CLIConfig) *cobra.Command {
 		},
 	}
 
+	configureCmd.Flags().BoolVar(
+		&dockerConfigStdout,
+		"stdout",
+		false,
+		"print the Docker config to stdout instead of modifying the host's Docker config",
+	)
+
+	credentialHelperCmd := &cobra.Command{
+		Use:       "credential-helper [get|store|erase|list|version]",
+		Short:     "Runs the docker credential helper protocol",
+		Hidden:    true,
+		Args:      cobra.ExactValidArgs(1),
+		ValidArgs: []string{"get", "store", "erase", "list", "version"},
+		Run: func(cmd *cobra.Command, args []string) {
+			if err := runDockerCredentialHelper(args[0]); err != nil {
+				os.Exit(1)
+			}
+		},
+	}
+
 	dockerCmd.AddCommand(configureCmd)
+	dockerCmd.AddCommand(credentialHelperCmd)
 	return dockerCmd
 }
 
 func dockerConfig(ctx context.Context, user *ptypes.GetAuthenticatedUserResponse, client api.Client, cliConf config.CLIConfig, args []string) error {
+	if dockerConfigStdout {
+		return config.WriteDockerConfig(ctx, client, cliConf.Project, os.Stdout)
+	}
+
 	return config.SetDockerConfig(ctx, client, cliConf.Project)
 }
+
+// IsDockerCredentialHelper returns true if the CLI was invoked as the docker credential helper, docker-credential-porter
+func IsDockerCredentialHelper() bool {
+	return filepath.Base(os.Args[0]) == config.DockerCredentialHelperName
+}
+
+// ServeDockerCredentialHelper runs the docker credential helper protocol, for when the CLI is invoked as docker-credential-porter
+func ServeDockerCredentialHelper() {
+	if len(os.Args) != 2 {
+		fmt.Fprintf(os.Stderr, "Usage: %s <get|store|erase|list|version>\n", config.DockerCredentialHelperName)
+		os.Exit(1)
+	}
+
+	if err := runDockerCredentialHelper(os.Args[1]); err != nil {
+		os.Exit(1)
+	}
+}
+
+func runDockerCredentialHelper(action string) error {
+	// docker-credential-porter --version is used to check that the credential helper matches the CLI
+	if action == "--version" || action == "version" {
+		fmt.Println(config.Version)
+		return nil
+	}
+
+	porterHelper, err := helper.NewPorterHelper(config.Version == "dev")
+	if err != nil {
+		color.New(color.FgRed).Fprintf(os.Stderr, "%s\n", err.Error()) //nolint:errcheck,gosec
+		return err
+	}
+
+	err = credentials.HandleCommand(porterHelper, action, os.Stdin, os.Stdout)
+	if err != nil {
+		fmt.Fprintln(os.Stdout, err) //nolint:errcheck,gosec
+		return err
+	}
+
+	return nil
+}
@@ -0,0 +1,119 @@
+package commands
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"text/tabwriter"
+	"time"
+
+	"github.com/fatih/color"
+	api "github.com/porter-dev/porter/api/client"
+	"github.com/porter-dev/porter/api/types"
+	"github.com/porter-dev/porter/cli/cmd/config"
+	"github.com/porter-dev/porter/cli/cmd/utils"
+	"github.com/spf13/cobra"
+)
+
+var (
+	eventsSearchSince time.Duration
+	eventsSearchLimit int
+)
+
+func registerCommand_Events(cliConf config.CLIConfig) *cobra.Command {
+	eventsCmd := &cobra.Command{
+		Use:     "events",
+		Aliases: []string{"event"},
+		Short:   "Commands that read the Kubernetes events of the current cluster",
+	}
+
+	eventsSearchCmd := &cobra.Command{
+		Use:   "search [query]",
+		Args:  cobra.ExactArgs(1),
+		Short: "Searches the Kubernetes events of the current cluster.",
+		Long: fmt.Sprintf(`
+%s
+
+Searches the Kubernetes events of the current cluster, most recent first. The query is matched
+against the names of the objects of events and the messages and reasons of the events. For
+example, to find the pods that were killed for running out of memory in the last day:
+
+  %s
+
+By default, events from the last 7 days are searched. To change how far back events are searched,
+use the --since flag.
+`,
+			color.New(color.FgBlue, color.Bold).Sprintf("Help for \"porter events search\":"),
+			color.New(color.FgGreen, color.Bold).Sprintf("porter events search OOMKilled --since 24h"),
+		),
+		Run: func(cmd *cobra.Command, args []string) {
+			err := checkLoginAndRunWithConfig(cmd.Context(), cliConf, args, searchEvents)
+			if err != nil {
+				os.Exit(1)
+			}
+		},
+	}
+
+	eventsSearchCmd.Flags().DurationVar(
+		&eventsSearchSince,
+		"since",
+		7*24*time.Hour,
+		"Only search events that were updated within this duration, such as 24h or 30m.",
+	)
+
+	eventsSearchCmd.Flags().IntVar(
+		&eventsSearchLimit,
+		"limit",
+		50,
+		"The maximum number of events to list.",
+	)
+
+	eventsCmd.AddCommand(eventsSearchCmd)
+
+	return eventsCmd
+}
+
+func searchEvents(ctx context.Context, _ *types.GetAuthenticatedUserResponse, client api.Client, cliConf config.CLIConfig, args []string) error {
+	resp, err := client.SearchKubeEvents(ctx, cliConf.Project, cliConf.Cluster, &types.SearchKubeEventsRequest{
+		Query: args[0],
+		Since: time.Now().Add(-eventsSearchSince),
+		Limit: eventsSearchLimit,
+	})
+	if err != nil {
+		return fmt.Errorf("error searching events: %w", err)
+	}
+
+	if utils.IsStructuredOutput() {
+		return utils.PrintStructured(resp)
+	}
+
+	if len(resp.KubeEvents) == 0 {
+		fmt.Println("No events found")
+		return nil
+	}
+
+	w := new(tabwriter.Writer)
+	w.Init(os.Stdout, 3, 8, 2, ' ', 0)
+
+	fmt.Fprintf(w, "%s\t%s\t%s\t%s\t%s\t%s\n", "LAST SEEN", "NAMESPACE", "TYPE", "NAME", "REASON", "MESSAGE")
+
+	for _, event := range resp.KubeEvents {
+		var reason, message string
+
+		// sub events are stored oldest first, so the last one is the most recent
+		if len(event.SubEvents) > 0 {
+			latest := event.SubEvents[len(event.SubEvents)-1]
+			reason = latest.Reason
+			message = latest.Message
+		}
+
+		fmt.Fprintf(
+			w, "%s\t%s\t%s\t%s\t%s\t%s\n",
+			event.UpdatedAt.Local().Format(time.RFC822), event.Namespace, event.ResourceType, event.Name, reason, message,
+		)
+	}
+
+	w.Flush()
+
+	return nil
+}
@@ -2,22 +2,19 @@ package commands
 
 import (
 	"context"
-	"encoding/json"
 	"fmt"
 	"os"
 
 	"github.com/porter-dev/porter/cli/cmd/config"
+	"github.com/porter-dev/porter/cli/cmd/utils"
 	v2 "github.com/porter-dev/porter/cli/cmd/v2"
 
 	api "github.com/porter-dev/porter/api/client"
 	"github.com/porter-dev/porter/api/types"
 	"github.com/spf13/cobra"
 	"github.com/stefanmcshane/helm/pkg/time"
-	"gopkg.in/yaml.v2"
 )
 
-var output string
-
 func registerCommand_Get(cliConf config.CLIConfig) *cobra.Command {
 	getCmd := &cobra.Command{
 		Use:   "get [release]",
@@ -51,13 +48,6 @@ func registerCommand_Get(cliConf config.CLIConfig) *cobra.Command {
 		"the namespace of the release",
 	)
 
-	getCmd.PersistentFlags().StringVar(
-		&output,
-		"output",
-		"",
-		"the output format to use (\"yaml\" or \"json\")",
-	)
-
 	getCmd.AddCommand(getValuesCmd)
 
 	return getCmd
@@ -98,28 +88,16 @@ func get(ctx context.Context, _ *types.GetAuthenticatedUserResponse, client api.
 		RevisionID:   rel.Release.Version,
 	}
 
-	if output == "yaml" {
-		bytes, err := yaml.Marshal(relInfo)
-		if err != nil {
-			return err
-		}
-
-		fmt.Println(string(bytes))
-	} else if output == "json" {
-		bytes, err := json.Marshal(relInfo)
-		if err != nil {
-			return err
-		}
-
-		fmt.Println(string(bytes))
-	} else {
-		fmt.Printf("Name:          %s\n", relInfo.Name)
-		fmt.Printf("Namespace:     %s\n", relInfo.Namespace)
-		fmt.Printf("Last deployed: %s\n", relInfo.LastDeployed)
-		fmt.Printf("Release type:  %s\n", relInfo.ReleaseType)
-		fmt.Printf("Revision ID:   %d\n", relInfo.RevisionID)
+	if utils.IsStructuredOutput() {
+		return utils.PrintStructured(relInfo)
 	}
 
+	fmt.Printf("Name:          %s\n", relInfo.Name)
+	fmt.Printf("Namespace:     %s\n", relInfo.Namespace)
+	fmt.Printf("Last deployed: %s\n", relInfo.LastDeployed)
+	fmt.Printf("Release type:  %s\n", relInfo.ReleaseType)
+	fmt.Printf("Revision ID:   %d\n", relInfo.RevisionID)
+
 	return nil
 }
 
@@ -142,23 +120,11 @@ func getValues(ctx context.Context, _ *types.GetAuthenticatedUserResponse, clien
 		return err
 	}
 
-	values := rel.Config
-
-	if output == "json" {
-		bytes, err := json.Marshal(values)
-		if err != nil {
-			return err
-		}
-
-		fmt.Println(string(bytes))
-	} else { // yaml is the default
-		bytes, err := yaml.Marshal(values)
-		if err != nil {
-			return err
-		}
-
-		fmt.Println(string(bytes))
+	// values are printed as yaml by default
+	format := utils.OutputFormat
+	if format == "" {
+		format = utils.OutputFormatYAML
 	}
 
-	return nil
+	return utils.WriteStructured(os.Stdout, format, rel.Config)
 }
@@ -5,17 +5,33 @@ import (
 	"fmt"
 	"os"
 	"os/exec"
+	"text/tabwriter"
+	"time"
 
+	"github.com/ghodss/yaml"
 	api "github.com/porter-dev/porter/api/client"
 	"github.com/porter-dev/porter/api/types"
 	"github.com/porter-dev/porter/cli/cmd/config"
+	"github.com/porter-dev/porter/cli/cmd/utils"
 	"github.com/spf13/cobra"
 )
 
+var (
+	helmNamespace string
+	helmStatuses  []string
+	helmRevision  int
+	helmAllValues bool
+)
+
 func registerCommand_Helm(cliConf config.CLIConfig) *cobra.Command {
 	helmCmd := &cobra.Command{
 		Use:   "helm",
 		Short: "Use helm to interact with a Porter cluster",
+		Long: `Use helm to interact with a Porter cluster.
+
+The list, get and history commands read the Helm releases of the current cluster through the Porter
+API. Any other arguments are passed to the helm CLI, which is run with a temporary kubeconfig for
+the current cluster.`,
 		Run: func(cmd *cobra.Command, args []string) {
 			err := checkLoginAndRunWithConfig(cmd.Context(), cliConf, args, runHelm)
 			if err != nil {
@@ -24,9 +40,140 @@ func registerCommand_Helm(cliConf config.CLIConfig) *cobra.Command {
 		},
 	}
 
+	helmListCmd := &cobra.Command{
+		Use:   "list",
+		Args:  cobra.NoArgs,
+		Short: "Lists the Helm releases of the current cluster",
+		Run: func(cmd *cobra.Command, args []string) {
+			err := checkLoginAndRunWithConfig(cmd.Context(), cliConf, args, listHelmReleases)
+			if err != nil {
+				os.Exit(1)
+			}
+		},
+	}
+	helmListCmd.Flags().StringVarP(&helmNamespace, "namespace", "n", "", "only list the releases of this namespace, instead of all namespaces")
+	helmListCmd.Flags().StringSliceVar(&helmStatuses, "status", nil, "only list the releases with these statuses, such as deployed or failed")
+	helmCmd.AddCommand(helmListCmd)
+
+	helmGetCmd := &cobra.Command{
+		Use:   "get [release]",
+		Args:  cobra.ExactArgs(1),
+		Short: "Prints the values of a Helm release in the current cluster",
+		Run: func(cmd *cobra.Command, args []string) {
+			err := checkLoginAndRunWithConfig(cmd.Context(), cliConf, args, getHelmRelease)
+			if err != nil {
+				os.Exit(1)
+			}
+		},
+	}
+	helmGetCmd.Flags().StringVarP(&helmNamespace, "namespace", "n", "default", "the namespace of the release")
+	helmGetCmd.Flags().IntVar(&helmRevision, "revision", 0, "the revision of the release, instead of the latest revision")
+	helmGetCmd.Flags().BoolVarP(&helmAllValues, "all", "a", false, "print the values merged with the chart defaults, instead of only the supplied values")
+	helmCmd.AddCommand(helmGetCmd)
+
+	helmHistoryCmd := &cobra.Command{
+		Use:   "history [release]",
+		Args:  cobra.ExactArgs(1),
+		Short: "Lists the revisions of a Helm release in the current cluster",
+		Run: func(cmd *cobra.Command, args []string) {
+			err := checkLoginAndRunWithConfig(cmd.Context(), cliConf, args, getHelmReleaseHistory)
+			if err != nil {
+				os.Exit(1)
+			}
+		},
+	}
+	helmHistoryCmd.Flags().StringVarP(&helmNamespace, "namespace", "n", "default", "the namespace of the release")
+	helmCmd.AddCommand(helmHistoryCmd)
+
 	return helmCmd
 }
 
+func listHelmReleases(ctx context.Context, _ *types.GetAuthenticatedUserResponse, client api.Client, cliConf config.CLIConfig, args []string) error {
+	resp, err := client.ListHelmReleases(ctx, cliConf.Project, cliConf.Cluster, &types.ListHelmReleasesRequest{
+		Namespace: helmNamespace,
+		Statuses:  helmStatuses,
+	})
+	if err != nil {
+		return fmt.Errorf("error listing helm releases: %w", err)
+	}
+
+	if utils.IsStructuredOutput() {
+		return utils.PrintStructured(resp)
+	}
+
+	if len(resp.Releases) == 0 {
+		fmt.Println("No releases found")
+		return nil
+	}
+
+	w := new(tabwriter.Writer)
+	w.Init(os.Stdout, 3, 8, 2, ' ', 0)
+
+	fmt.Fprintf(w, "%s\t%s\t%s\t%s\t%s\t%s\t%s\n", "NAME", "NAMESPACE", "REVISION", "UPDATED", "STATUS", "CHART", "APP VERSION")
+
+	for _, rel := range resp.Releases {
+		fmt.Fprintf(
+			w, "%s\t%s\t%d\t%s\t%s\t%s-%s\t%s\n",
+			rel.Name, rel.Namespace, rel.Revision, rel.UpdatedAt.Local().Format(time.RFC822), rel.Status, rel.Chart, rel.ChartVersion, rel.AppVersion,
+		)
+	}
+
+	w.Flush()
+
+	return nil
+}
+
+func getHelmRelease(ctx context.Context, _ *types.GetAuthenticatedUserResponse, client api.Client, cliConf config.CLIConfig, args []string) error {
+	resp, err := client.GetHelmRelease(ctx, cliConf.Project, cliConf.Cluster, helmNamespace, args[0], &types.GetHelmReleaseRequest{
+		Revision:  helmRevision,
+		AllValues: helmAllValues,
+	})
+	if err != nil {
+		return fmt.Errorf("error getting helm release: %w", err)
+	}
+
+	if utils.IsStructuredOutput() {
+		return utils.PrintStructured(resp)
+	}
+
+	values, err := yaml.Marshal(resp.Values)
+	if err != nil {
+		return fmt.Errorf("error marshaling release values: %w", err)
+	}
+
+	fmt.Printf("# %s, revision %d (%s)\n", resp.Release.Name, resp.Release.Revision, resp.Release.Status)
+	fmt.Print(string(values))
+
+	return nil
+}
+
+func getHelmReleaseHistory(ctx context.Context, _ *types.GetAuthenticatedUserResponse, client api.Client, cliConf config.CLIConfig, args []string) error {
+	resp, err := client.GetHelmReleaseHistory(ctx, cliConf.Project, cliConf.Cluster, helmNamespace, args[0])
+	if err != nil {
+		return fmt.Errorf("error getting helm release history: %w", err)
+	}
+
+	if utils.IsStructuredOutput() {
+		return utils.PrintStructured(resp)
+	}
+
+	w := new(tabwriter.Writer)
+	w.Init(os.Stdout, 3, 8, 2, ' ', 0)
+
+	fmt.Fprintf(w, "%s\t%s\t%s\t%s\t%s\t%s\n", "REVISION", "UPDATED", "STATUS", "CHART", "APP VERSION", "DESCRIPTION")
+
+	for _, rel := range resp.Revisions {
+		fmt.Fprintf(
+			w, "%d\t%s\t%s\t%s-%s\t%s\t%s\n",
+			rel.Revision, rel.UpdatedAt.Local().Format(time.RFC822), rel.Status, rel.Chart, rel.ChartVersion, rel.AppVersion, rel.Description,
+		)
+	}
+
+	w.Flush()
+
+	return nil
+}
+
 func runHelm(ctx context.Context, _ *types.GetAuthenticatedUserResponse, client api.Client, cliConf config.CLIConfig, args []string) error {
 	_, err := exec.LookPath("helm")
 	if err != nil {
@@ -4,6 +4,7 @@ import (
 	"context"
 	"fmt"
 	"os"
+	"time"
 
 	"github.com/porter-dev/porter/cli/cmd/config"
 	v2 "github.com/porter-dev/porter/cli/cmd/v2"
@@ -16,7 +17,13 @@ import (
 	"github.com/spf13/cobra"
 )
 
-var imageRepoURI string
+var (
+	imageRepoURI  string
+	jobRunsSince  time.Duration
+	jobRunApp     string
+	jobRunEnv     []string
+	jobRunCommand string
+)
 
 func registerCommand_Job(cliConf config.CLIConfig) *cobra.Command {
 	jobCmd := &cobra.Command{
@@ -101,10 +108,17 @@ This command is namespace-scoped and uses the default namespace. To specify a di
 use the --namespace flag:
 
   %s
+
+For job services of apps deployed with porter.yaml, pass the app with the --app flag. The run can
+override env variables of the job with --env, and its command with --command. Manual runs of apps do
+not wait for the job to complete; use "porter job runs" to check their status:
+
+  %s
 `,
 			color.New(color.FgBlue, color.Bold).Sprintf("Help for \"porter job run\":"),
 			color.New(color.FgGreen, color.Bold).Sprintf("porter job run --name job-example"),
 			color.New(color.FgGreen, color.Bold).Sprintf("porter job run --name job-example --namespace custom-namespace"),
+			color.New(color.FgGreen, color.Bold).Sprintf("porter job run --app my-app --name my-job --env DRY_RUN=true --command \"python backfill.py\""),
 		),
 		Run: func(cmd *cobra.Command, args []string) {
 			err := checkLoginAndRunWithConfig(cmd.Context(), cliConf, args, runJob)
@@ -114,6 +128,44 @@ use the --namespace flag:
 		},
 	}
 
+	jobRunsCmd := &cobra.Command{
+		Use:               "runs [application] [job]",
+		Args:              cobra.ExactArgs(2),
+		ValidArgsFunction: firstArgCompletion(completeAppNames(cliConf)),
+		Short:             "Lists the recent runs of a job service of an application.",
+		Long: fmt.Sprintf(`
+%s
+
+Lists the runs of a job service of an application, most recent first, along with their duration,
+exit code and the pod that ran them. For example:
+
+  %s
+
+By default, runs from the last 7 days are listed. To change how far back runs are listed, use the
+--since flag:
+
+  %s
+`,
+			color.New(color.FgBlue, color.Bold).Sprintf("Help for \"porter job runs\":"),
+			color.New(color.FgGreen, color.Bold).Sprintf("porter job runs my-app my-job"),
+			color.New(color.FgGreen, color.Bold).Sprintf("porter job runs my-app my-job --since 24h"),
+		),
+		Run: func(cmd *cobra.Command, args []string) {
+			err := checkLoginAndRunWithConfig(cmd.Context(), cliConf, args, listJobRuns)
+			if err != nil {
+				os.Exit(1)
+			}
+		},
+	}
+
+	jobRunsCmd.Flags().DurationVar(
+		&jobRunsSince,
+		"since",
+		7*24*time.Hour,
+		"Only list runs that started within this duration, such as 24h or 30m.",
+	)
+
+	jobCmd.AddCommand(jobRunsCmd)
 	jobCmd.AddCommand(batchImageUpdateCmd)
 	jobCmd.AddCommand(waitCmd)
 	jobCmd.AddCommand(runJobCmd)
@@ -173,6 +225,27 @@ use the --namespace flag:
 		"The name of the job.",
 	)
 
+	runJobCmd.PersistentFlags().StringVar(
+		&jobRunApp,
+		"app",
+		"",
+		"The app of the job, for apps deployed with porter.yaml.",
+	)
+
+	runJobCmd.PersistentFlags().StringArrayVar(
+		&jobRunEnv,
+		"env",
+		nil,
+		"An env variable to set for this run only, as KEY=VALUE. Can be repeated. Only supported with --app.",
+	)
+
+	runJobCmd.PersistentFlags().StringVar(
+		&jobRunCommand,
+		"command",
+		"",
+		"The command to run instead of the job's command, for this run only. Only supported with --app.",
+	)
+
 	runJobCmd.MarkPersistentFlagRequired("name")
 	return jobCmd
 }
@@ -205,6 +278,20 @@ func batchImageUpdate(ctx context.Context, _ *types.GetAuthenticatedUserResponse
 	)
 }
 
+// lists the runs of a job service of an app
+func listJobRuns(ctx context.Context, _ *types.GetAuthenticatedUserResponse, client api.Client, cliConf config.CLIConfig, args []string) error {
+	project, err := client.GetProject(ctx, cliConf.Project)
+	if err != nil {
+		return fmt.Errorf("could not retrieve project from Porter API. Please contact support@porter.run")
+	}
+
+	if !project.ValidateApplyV2 {
+		return fmt.Errorf("porter job runs is only supported for apps deployed with porter apply v2")
+	}
+
+	return v2.ListJobRuns(ctx, cliConf, client, args[0], args[1], time.Now().Add(-jobRunsSince))
+}
+
 // waits for a job with a given name/namespace
 func waitForJob(ctx context.Context, _ *types.GetAuthenticatedUserResponse, client api.Client, cliConf config.CLIConfig, args []string) error {
 	project, err := client.GetProject(ctx, cliConf.Project)
@@ -235,7 +322,7 @@ func runJob(ctx context.Context, authRes *types.GetAuthenticatedUserResponse, cl
 	}
 
 	if project.ValidateApplyV2 {
-		err = v2.RunJob(ctx)
+		err = v2.RunJob(ctx, cliConf, client, jobRunApp, name, jobRunEnv, jobRunCommand)
 		if err != nil {
 			return err
 		}
@@ -7,6 +7,7 @@ import (
 	"text/tabwriter"
 
 	"github.com/porter-dev/porter/cli/cmd/config"
+	"github.com/porter-dev/porter/cli/cmd/utils"
 	v2 "github.com/porter-dev/porter/cli/cmd/v2"
 
 	"github.com/fatih/color"
@@ -210,26 +211,59 @@ func writeReleases(ctx context.Context, client api.Client, cliConf config.CLICon
 		releases = append(releases, resp...)
 	}
 
-	w := new(tabwriter.Writer)
-	w.Init(os.Stdout, 3, 8, 2, '\t', tabwriter.AlignRight)
-
-	fmt.Fprintf(w, "%s\t%s\t%s\t%s\n", "NAME", "NAMESPACE", "STATUS", "KIND")
+	var listed []listedRelease
 
 	for _, rel := range releases {
 		chartName := rel.Chart.Name()
 
-		if kind == "all" {
-			fmt.Fprintf(w, "%s\t%s\t%s\t%s\n", rel.Name, rel.Namespace, rel.Info.Status, chartName)
-		} else if kind == "application" && (chartName == "web" || chartName == "worker") {
-			fmt.Fprintf(w, "%s\t%s\t%s\t%s\n", rel.Name, rel.Namespace, rel.Info.Status, chartName)
-		} else if kind == "job" && chartName == "job" {
-			fmt.Fprintf(w, "%s\t%s\t%s\t%s\n", rel.Name, rel.Namespace, rel.Info.Status, chartName)
-		} else if kind == "addon" && chartName != "web" && chartName != "worker" && chartName != "job" {
-			fmt.Fprintf(w, "%s\t%s\t%s\t%s\n", rel.Name, rel.Namespace, rel.Info.Status, chartName)
+		if releaseMatchesKind(kind, chartName) {
+			listed = append(listed, listedRelease{
+				Name:      rel.Name,
+				Namespace: rel.Namespace,
+				Status:    rel.Info.Status.String(),
+				Kind:      chartName,
+			})
 		}
 	}
 
+	if utils.IsStructuredOutput() {
+		return utils.PrintStructured(listed)
+	}
+
+	w := new(tabwriter.Writer)
+	w.Init(os.Stdout, 3, 8, 2, '\t', tabwriter.AlignRight)
+
+	fmt.Fprintf(w, "%s\t%s\t%s\t%s\n", "NAME", "NAMESPACE", "STATUS", "KIND")
+
+	for _, rel := range listed {
+		fmt.Fprintf(w, "%s\t%s\t%s\t%s\n", rel.Name, rel.Namespace, rel.Status, rel.Kind)
+	}
+
 	w.Flush()
 
 	return nil
 }
+
+// listedRelease is a release printed by the list commands
+type listedRelease struct {
+	Name      string `json:"name"`
+	Namespace string `json:"namespace"`
+	Status    string `json:"status"`
+	Kind      string `json:"kind"`
+}
+
+// releaseMatchesKind returns whether a release with the given chart is listed for a kind of release
+func releaseMatchesKind(kind, chartName string) bool {
+	switch kind {
+	case "all":
+		return true
+	case "application":
+		return chartName == "web" || chartName == "worker"
+	case "job":
+		return chartName == "job"
+	case "addon":
+		return chartName != "web" && chartName != "worker" && chartName != "job"
+	}
+
+	return false
+}
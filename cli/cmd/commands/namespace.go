@@ -0,0 +1,207 @@
+package commands
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"sort"
+	"strings"
+	"text/tabwriter"
+
+	"github.com/fatih/color"
+	api "github.com/porter-dev/porter/api/client"
+	"github.com/porter-dev/porter/api/types"
+	"github.com/porter-dev/porter/cli/cmd/config"
+	"github.com/porter-dev/porter/cli/cmd/utils"
+	"github.com/spf13/cobra"
+)
+
+var (
+	namespaceLabels     map[string]string
+	namespaceQuota      types.NamespaceResourceQuota
+	namespaceLimitRange types.NamespaceLimitRange
+)
+
+func registerCommand_Namespace(cliConf config.CLIConfig) *cobra.Command {
+	namespaceCmd := &cobra.Command{
+		Use:     "namespace",
+		Aliases: []string{"namespaces", "ns"},
+		Short:   "Commands that manage the namespaces of the current cluster",
+	}
+
+	namespaceListCmd := &cobra.Command{
+		Use:   "list",
+		Short: "Lists the namespaces of the current cluster",
+		Run: func(cmd *cobra.Command, args []string) {
+			err := checkLoginAndRunWithConfig(cmd.Context(), cliConf, args, listNamespacesWithQuotas)
+			if err != nil {
+				os.Exit(1)
+			}
+		},
+	}
+	namespaceCmd.AddCommand(namespaceListCmd)
+
+	namespaceCreateCmd := &cobra.Command{
+		Use:   "create [name]",
+		Args:  cobra.ExactArgs(1),
+		Short: "Creates a namespace in the current cluster",
+		Long: fmt.Sprintf(`
+%s
+
+Creates a namespace in the current cluster. Labels are set with --label, and the resources of the
+namespace can be capped with a ResourceQuota and container defaults set with a LimitRange. For
+example, to create a namespace for preview environments that may request at most 4 CPUs and 8Gi of
+memory, and where containers request 100m CPU and 128Mi of memory by default:
+
+  %s
+`,
+			color.New(color.FgBlue, color.Bold).Sprintf("Help for \"porter namespace create\":"),
+			color.New(color.FgGreen, color.Bold).Sprintf("porter namespace create previews --label team=web --quota-requests-cpu 4 --quota-requests-memory 8Gi --default-request-cpu 100m --default-request-memory 128Mi"),
+		),
+		Run: func(cmd *cobra.Command, args []string) {
+			err := checkLoginAndRunWithConfig(cmd.Context(), cliConf, args, createNamespace)
+			if err != nil {
+				os.Exit(1)
+			}
+		},
+	}
+
+	namespaceCreateCmd.Flags().StringToStringVar(&namespaceLabels, "label", nil, "a label to set on the namespace, as key=value")
+	namespaceCreateCmd.Flags().StringVar(&namespaceQuota.RequestsCPU, "quota-requests-cpu", "", "the total CPU that the pods of the namespace may request")
+	namespaceCreateCmd.Flags().StringVar(&namespaceQuota.RequestsMemory, "quota-requests-memory", "", "the total memory that the pods of the namespace may request")
+	namespaceCreateCmd.Flags().StringVar(&namespaceQuota.LimitsCPU, "quota-limits-cpu", "", "the total CPU limit of the pods of the namespace")
+	namespaceCreateCmd.Flags().StringVar(&namespaceQuota.LimitsMemory, "quota-limits-memory", "", "the total memory limit of the pods of the namespace")
+	namespaceCreateCmd.Flags().StringVar(&namespaceQuota.Pods, "quota-pods", "", "the maximum number of pods in the namespace")
+	namespaceCreateCmd.Flags().StringVar(&namespaceLimitRange.DefaultRequestCPU, "default-request-cpu", "", "the CPU request of containers that do not set one")
+	namespaceCreateCmd.Flags().StringVar(&namespaceLimitRange.DefaultRequestMemory, "default-request-memory", "", "the memory request of containers that do not set one")
+	namespaceCreateCmd.Flags().StringVar(&namespaceLimitRange.DefaultLimitCPU, "default-limit-cpu", "", "the CPU limit of containers that do not set one")
+	namespaceCreateCmd.Flags().StringVar(&namespaceLimitRange.DefaultLimitMemory, "default-limit-memory", "", "the memory limit of containers that do not set one")
+	namespaceCreateCmd.Flags().StringVar(&namespaceLimitRange.MaxCPU, "max-cpu", "", "the maximum CPU limit of a single container")
+	namespaceCreateCmd.Flags().StringVar(&namespaceLimitRange.MaxMemory, "max-memory", "", "the maximum memory limit of a single container")
+	namespaceCmd.AddCommand(namespaceCreateCmd)
+
+	namespaceDeleteCmd := &cobra.Command{
+		Use:   "delete [name]",
+		Args:  cobra.ExactArgs(1),
+		Short: "Deletes a namespace and everything in it from the current cluster",
+		Run: func(cmd *cobra.Command, args []string) {
+			err := checkLoginAndRunWithConfig(cmd.Context(), cliConf, args, deleteNamespace)
+			if err != nil {
+				os.Exit(1)
+			}
+		},
+	}
+	namespaceCmd.AddCommand(namespaceDeleteCmd)
+
+	return namespaceCmd
+}
+
+func listNamespacesWithQuotas(ctx context.Context, _ *types.GetAuthenticatedUserResponse, client api.Client, cliConf config.CLIConfig, args []string) error {
+	namespaceList, err := client.GetK8sNamespaces(ctx, cliConf.Project, cliConf.Cluster)
+	if err != nil {
+		return fmt.Errorf("error listing namespaces: %w", err)
+	}
+
+	if utils.IsStructuredOutput() {
+		return utils.PrintStructured(namespaceList)
+	}
+
+	w := new(tabwriter.Writer)
+	w.Init(os.Stdout, 3, 8, 2, ' ', 0)
+
+	fmt.Fprintf(w, "%s\t%s\t%s\t%s\n", "NAME", "STATUS", "LABELS", "QUOTA")
+
+	for _, namespace := range *namespaceList {
+		fmt.Fprintf(w, "%s\t%s\t%s\t%s\n", namespace.Name, namespace.Status, formatLabels(namespace.Labels), formatQuota(namespace.ResourceQuota))
+	}
+
+	w.Flush()
+
+	return nil
+}
+
+func createNamespace(ctx context.Context, _ *types.GetAuthenticatedUserResponse, client api.Client, cliConf config.CLIConfig, args []string) error {
+	req := &types.CreateNamespaceRequest{
+		Name:   args[0],
+		Labels: namespaceLabels,
+	}
+
+	if namespaceQuota != (types.NamespaceResourceQuota{}) {
+		req.ResourceQuota = &namespaceQuota
+	}
+
+	if namespaceLimitRange != (types.NamespaceLimitRange{}) {
+		req.LimitRange = &namespaceLimitRange
+	}
+
+	namespace, err := client.CreateNewK8sNamespace(ctx, cliConf.Project, cliConf.Cluster, req)
+	if err != nil {
+		return fmt.Errorf("error creating namespace: %w", err)
+	}
+
+	color.New(color.FgGreen).Printf("Created namespace %s\n", namespace.Name)
+
+	return nil
+}
+
+func deleteNamespace(ctx context.Context, _ *types.GetAuthenticatedUserResponse, client api.Client, cliConf config.CLIConfig, args []string) error {
+	userResp, err := utils.PromptPlaintext(
+		fmt.Sprintf(
+			`Are you sure you'd like to delete the namespace %s and everything in it? %s `,
+			args[0],
+			color.New(color.FgCyan).Sprintf("[y/n]"),
+		),
+	)
+	if err != nil {
+		return err
+	}
+
+	if userResp := strings.ToLower(userResp); userResp != "y" && userResp != "yes" {
+		return nil
+	}
+
+	if err := client.DeleteK8sNamespace(ctx, cliConf.Project, cliConf.Cluster, args[0]); err != nil {
+		return fmt.Errorf("error deleting namespace: %w", err)
+	}
+
+	color.New(color.FgGreen).Printf("Deleted namespace %s\n", args[0])
+
+	return nil
+}
+
+func formatLabels(labels map[string]string) string {
+	if len(labels) == 0 {
+		return "-"
+	}
+
+	pairs := make([]string, 0, len(labels))
+	for key, value := range labels {
+		pairs = append(pairs, fmt.Sprintf("%s=%s", key, value))
+	}
+
+	sort.Strings(pairs)
+
+	return strings.Join(pairs, ",")
+}
+
+func formatQuota(quota *types.NamespaceResourceQuota) string {
+	if quota == nil {
+		return "-"
+	}
+
+	var limits []string
+
+	for _, limit := range []struct{ name, value string }{
+		{"requests.cpu", quota.RequestsCPU},
+		{"requests.memory", quota.RequestsMemory},
+		{"limits.cpu", quota.LimitsCPU},
+		{"limits.memory", quota.LimitsMemory},
+		{"pods", quota.Pods},
+	} {
+		if limit.value != "" {
+			limits = append(limits, fmt.Sprintf("%s=%s", limit.name, limit.value))
+		}
+	}
+
+	return strings.Join(limits, ",")
+}
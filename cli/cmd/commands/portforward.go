@@ -1,21 +1,395 @@
 package commands
 
 import (
+	"context"
+	"errors"
 	"fmt"
+	"io"
+	"net/http"
+	"os"
+	"os/signal"
+	"path/filepath"
+	"sort"
+	"sync"
+	"syscall"
+	"time"
 
 	"github.com/fatih/color"
+	"github.com/ghodss/yaml"
+	api "github.com/porter-dev/porter/api/client"
+	"github.com/porter-dev/porter/api/types"
 	"github.com/porter-dev/porter/cli/cmd/config"
 	"github.com/spf13/cobra"
+	v1 "k8s.io/api/core/v1"
+	k8serrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/tools/portforward"
+	"k8s.io/client-go/transport/spdy"
 )
 
-func registerCommand_PortForward(_ config.CLIConfig) *cobra.Command {
+const (
+	// defaultForwardFile is the path of the port forward config, relative to the working directory
+	defaultForwardFile = ".porter/forward.yaml"
+	// defaultForwardNamespace is the namespace of the default deployment target of a cluster
+	defaultForwardNamespace = "default"
+
+	forwardPodCheckInterval = 5 * time.Second
+	forwardMaxBackoff       = 30 * time.Second
+)
+
+var (
+	forwardFile      string
+	forwardNamespace string
+)
+
+// forwardConfig is the contents of a port forward config file, which maps the services of an app to local ports
+type forwardConfig struct {
+	// App is the name of the app whose services are forwarded. It is overridden by the app passed as an argument.
+	App string `json:"app"`
+	// Namespace is the namespace the app is deployed in. It defaults to the namespace of the default deployment target.
+	Namespace string `json:"namespace"`
+	// Services maps the name of each service to forward to its ports
+	Services map[string]forwardServiceConfig `json:"services"`
+}
+
+// forwardServiceConfig is the ports of a single forwarded service
+type forwardServiceConfig struct {
+	// LocalPort is the port to listen on locally
+	LocalPort int `json:"local_port"`
+	// RemotePort is the port of the service container. It defaults to the first port exposed by the container, or the local port.
+	RemotePort int `json:"remote_port"`
+}
+
+func registerCommand_PortForward(cliConf config.CLIConfig) *cobra.Command {
 	portForwardCmd := &cobra.Command{
-		Use: "port-forward [release] [LOCAL_PORT:]REMOTE_PORT [...[LOCAL_PORT_N:]REMOTE_PORT_N]",
-		Deprecated: fmt.Sprintf("please use the %s command instead.",
-			color.New(color.FgYellow, color.Bold).Sprintf("porter kubectl -- port-forward"),
+		Use:               "port-forward [app]",
+		Args:              cobra.MaximumNArgs(1),
+		ValidArgsFunction: firstArgCompletion(completeAppNames(cliConf)),
+		Short:             "Forwards local ports to the services of an app",
+		Long: fmt.Sprintf(`
+%s
+
+Forwards local ports to one or more services of an app, based on a config file which maps the name
+of each service to a local port. By default, the config is read from %s:
+
+  app: my-app
+  services:
+    web:
+      local_port: 8080
+    worker:
+      local_port: 9090
+      remote_port: 3000
+
+The pods of each service are resolved by the name of the app and service, and forwarding reconnects
+to a new pod when the pod is restarted or replaced. If the remote port is not set, the first port
+exposed by the service container is used. Forwarding runs until interrupted:
+
+  %s
+
+The app set in the config file can be overridden by passing it as an argument.
+`,
+			color.New(color.FgBlue, color.Bold).Sprintf("Help for \"porter port-forward\":"),
+			defaultForwardFile,
+			color.New(color.FgGreen, color.Bold).Sprintf("porter port-forward my-app --file .porter/forward.yaml"),
 		),
-		DisableFlagParsing: true,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			return checkLoginAndRunWithConfig(cmd.Context(), cliConf, args, portForward)
+		},
 	}
 
+	portForwardCmd.Flags().StringVarP(
+		&forwardFile,
+		"file",
+		"f",
+		defaultForwardFile,
+		"path to the port forward config file",
+	)
+
+	portForwardCmd.Flags().StringVarP(
+		&forwardNamespace,
+		"namespace",
+		"n",
+		"",
+		"namespace the app is deployed in, which overrides the namespace in the config file",
+	)
+
 	return portForwardCmd
 }
+
+func portForward(ctx context.Context, _ *types.GetAuthenticatedUserResponse, client api.Client, cliConfig config.CLIConfig, args []string) error {
+	forwardConf, err := readForwardConfig(forwardFile)
+	if err != nil {
+		return err
+	}
+
+	if len(args) > 0 {
+		forwardConf.App = args[0]
+	}
+	if forwardNamespace != "" {
+		forwardConf.Namespace = forwardNamespace
+	}
+
+	if forwardConf.App == "" {
+		return errors.New("an app must be passed as an argument or set in the config file")
+	}
+	if forwardConf.Namespace == "" {
+		forwardConf.Namespace = defaultForwardNamespace
+	}
+
+	sharedConf := &AppPorterRunSharedConfig{
+		Client:    client,
+		CLIConfig: cliConfig,
+	}
+
+	err = sharedConf.setSharedConfig(ctx)
+	if err != nil {
+		return fmt.Errorf("Could not retrieve kube credentials: %s", err.Error())
+	}
+
+	ctx, stop := signal.NotifyContext(ctx, os.Interrupt, syscall.SIGTERM)
+	defer stop()
+
+	serviceNames := make([]string, 0, len(forwardConf.Services))
+	for name := range forwardConf.Services {
+		serviceNames = append(serviceNames, name)
+	}
+	sort.Strings(serviceNames)
+
+	var wg sync.WaitGroup
+
+	for _, name := range serviceNames {
+		forwarder := &serviceForwarder{
+			sharedConf:  sharedConf,
+			namespace:   forwardConf.Namespace,
+			appName:     forwardConf.App,
+			serviceName: name,
+			ports:       forwardConf.Services[name],
+		}
+
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			forwarder.run(ctx)
+		}()
+	}
+
+	wg.Wait()
+
+	return nil
+}
+
+// readForwardConfig reads and validates the port forward config at the given path
+func readForwardConfig(path string) (forwardConfig, error) {
+	var forwardConf forwardConfig
+
+	fileBytes, err := os.ReadFile(filepath.Clean(path))
+	if err != nil {
+		return forwardConf, fmt.Errorf("could not read port forward config file: %w", err)
+	}
+
+	err = yaml.Unmarshal(fileBytes, &forwardConf)
+	if err != nil {
+		return forwardConf, fmt.Errorf("could not parse port forward config file: %w", err)
+	}
+
+	if len(forwardConf.Services) == 0 {
+		return forwardConf, errors.New("port forward config file must map at least one service to a local port")
+	}
+
+	localPorts := make(map[int]string)
+
+	for name, ports := range forwardConf.Services {
+		if ports.LocalPort <= 0 || ports.LocalPort > 65535 {
+			return forwardConf, fmt.Errorf("service %s must have a local port between 1 and 65535", name)
+		}
+		if ports.RemotePort < 0 || ports.RemotePort > 65535 {
+			return forwardConf, fmt.Errorf("service %s must have a remote port between 1 and 65535", name)
+		}
+		if other, ok := localPorts[ports.LocalPort]; ok {
+			return forwardConf, fmt.Errorf("services %s and %s cannot both use local port %d", other, name, ports.LocalPort)
+		}
+		localPorts[ports.LocalPort] = name
+	}
+
+	return forwardConf, nil
+}
+
+// serviceForwarder forwards a local port to a pod of a single service, moving to a new pod whenever the current one goes away
+type serviceForwarder struct {
+	sharedConf  *AppPorterRunSharedConfig
+	namespace   string
+	appName     string
+	serviceName string
+	ports       forwardServiceConfig
+}
+
+func (f *serviceForwarder) run(ctx context.Context) {
+	backoff := time.Second
+
+	for {
+		connected, err := f.forward(ctx)
+		if ctx.Err() != nil {
+			return
+		}
+
+		if err != nil {
+			color.New(color.FgRed).Fprintf(os.Stderr, "[%s] %s, retrying in %s\n", f.serviceName, err.Error(), backoff) // nolint:errcheck,gosec
+		} else {
+			color.New(color.FgYellow).Printf("[%s] connection to pod lost, reconnecting\n", f.serviceName) // nolint:errcheck,gosec
+		}
+
+		if connected {
+			backoff = time.Second
+		}
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-time.After(backoff):
+		}
+
+		if !connected {
+			backoff *= 2
+			if backoff > forwardMaxBackoff {
+				backoff = forwardMaxBackoff
+			}
+		}
+	}
+}
+
+// forward forwards to a running pod of the service until the pod stops running, the connection is lost or the context is
+// cancelled. It returns whether the forward was established.
+func (f *serviceForwarder) forward(ctx context.Context) (bool, error) {
+	pod, err := f.runningPod(ctx)
+	if err != nil {
+		return false, err
+	}
+
+	remotePort := f.remotePort(pod)
+
+	transport, upgrader, err := spdy.RoundTripperFor(f.sharedConf.RestConf)
+	if err != nil {
+		return false, fmt.Errorf("error creating port forward transport: %w", err)
+	}
+
+	req := f.sharedConf.RestClient.Post().
+		Resource("pods").
+		Namespace(pod.Namespace).
+		Name(pod.Name).
+		SubResource("portforward")
+
+	dialer := spdy.NewDialer(upgrader, &http.Client{Transport: transport}, http.MethodPost, req.URL())
+
+	stopChan := make(chan struct{})
+	readyChan := make(chan struct{})
+
+	forwarder, err := portforward.NewOnAddresses(
+		dialer,
+		[]string{"localhost"},
+		[]string{fmt.Sprintf("%d:%d", f.ports.LocalPort, remotePort)},
+		stopChan,
+		readyChan,
+		io.Discard,
+		io.Discard,
+	)
+	if err != nil {
+		return false, fmt.Errorf("error creating port forward: %w", err)
+	}
+
+	errChan := make(chan error, 1)
+	go func() {
+		errChan <- forwarder.ForwardPorts()
+	}()
+
+	select {
+	case <-readyChan:
+	case err := <-errChan:
+		return false, fmt.Errorf("error forwarding to pod %s: %w", pod.Name, err)
+	case <-ctx.Done():
+		close(stopChan)
+		return false, nil
+	}
+
+	color.New(color.FgGreen).Printf("[%s] forwarding localhost:%d -> %s:%d\n", f.serviceName, f.ports.LocalPort, pod.Name, remotePort) // nolint:errcheck,gosec
+
+	ticker := time.NewTicker(forwardPodCheckInterval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case err := <-errChan:
+			if err != nil {
+				return true, fmt.Errorf("error forwarding to pod %s: %w", pod.Name, err)
+			}
+			return true, nil
+		case <-ctx.Done():
+			close(stopChan)
+			return true, nil
+		case <-ticker.C:
+			if !f.podRunning(ctx, pod) {
+				close(stopChan)
+				<-errChan
+				return true, nil
+			}
+		}
+	}
+}
+
+// runningPod returns a running and ready pod of the service, preferring the most recently started one
+func (f *serviceForwarder) runningPod(ctx context.Context) (v1.Pod, error) {
+	selector := fmt.Sprintf("app.kubernetes.io/instance=%s-%s", f.appName, f.serviceName)
+
+	pods, err := f.sharedConf.Clientset.CoreV1().Pods(f.namespace).List(ctx, metav1.ListOptions{LabelSelector: selector})
+	if err != nil {
+		return v1.Pod{}, fmt.Errorf("error listing pods: %w", err)
+	}
+
+	var selected *v1.Pod
+
+	for i := range pods.Items {
+		pod := &pods.Items[i]
+		if !isPodForwardable(pod) {
+			continue
+		}
+		if selected == nil || pod.CreationTimestamp.After(selected.CreationTimestamp.Time) {
+			selected = pod
+		}
+	}
+
+	if selected == nil {
+		return v1.Pod{}, fmt.Errorf("no running pods found for service %s of app %s in namespace %s", f.serviceName, f.appName, f.namespace)
+	}
+
+	return *selected, nil
+}
+
+// podRunning returns whether the pod still exists, is running and has not been replaced by a pod with the same name.
+// Errors other than the pod not being found are treated as the pod running, so that a transient error does not drop the forward.
+func (f *serviceForwarder) podRunning(ctx context.Context, pod v1.Pod) bool {
+	current, err := f.sharedConf.Clientset.CoreV1().Pods(pod.Namespace).Get(ctx, pod.Name, metav1.GetOptions{})
+	if err != nil {
+		return ctx.Err() == nil && !k8serrors.IsNotFound(err)
+	}
+
+	return current.UID == pod.UID && isPodForwardable(current)
+}
+
+// remotePort returns the configured remote port, falling back to the first port of the service container and then the local port
+func (f *serviceForwarder) remotePort(pod v1.Pod) int {
+	if f.ports.RemotePort != 0 {
+		return f.ports.RemotePort
+	}
+
+	for _, container := range pod.Spec.Containers {
+		if len(container.Ports) > 0 {
+			return int(container.Ports[0].ContainerPort)
+		}
+	}
+
+	return f.ports.LocalPort
+}
+
+// isPodForwardable returns whether the pod is running, ready and not being deleted
+func isPodForwardable(pod *v1.Pod) bool {
+	return pod.DeletionTimestamp == nil && pod.Status.Phase == v1.PodRunning && isPodReady(pod)
+}
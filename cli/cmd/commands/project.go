@@ -37,9 +37,10 @@ func registerCommand_Project(cliConf config.CLIConfig) *cobra.Command {
 	projectCmd.AddCommand(createProjectCmd)
 
 	deleteProjectCmd := &cobra.Command{
-		Use:   "delete [id]",
-		Args:  cobra.ExactArgs(1),
-		Short: "Deletes the project with the given id",
+		Use:               "delete [id]",
+		Args:              cobra.ExactArgs(1),
+		ValidArgsFunction: firstArgCompletion(completeProjectIDs(cliConf)),
+		Short:             "Deletes the project with the given id",
 		Run: func(cmd *cobra.Command, args []string) {
 			err := checkLoginAndRunWithConfig(cmd.Context(), cliConf, args, deleteProject)
 			if err != nil {
@@ -83,20 +84,24 @@ func listProjects(ctx context.Context, user *types.GetAuthenticatedUserResponse,
 		return err
 	}
 
+	if utils.IsStructuredOutput() {
+		return utils.PrintStructured(resp)
+	}
+
 	projects := *resp
 
 	w := new(tabwriter.Writer)
 	w.Init(os.Stdout, 3, 8, 0, '\t', tabwriter.AlignRight)
 
-	fmt.Fprintf(w, "%s\t%s\n", "ID", "NAME")
+	fmt.Fprintf(w, "%s\t%s\t%s\n", "ID", "NAME", "ROLE")
 
 	currProjectID := cliConf.Project
 
 	for _, project := range projects {
 		if currProjectID == project.ID {
-			color.New(color.FgGreen).Fprintf(w, "%d\t%s (current project)\n", project.ID, project.Name)
+			color.New(color.FgGreen).Fprintf(w, "%d\t%s (current project)\t%s\n", project.ID, project.Name, project.RoleKind)
 		} else {
-			fmt.Fprintf(w, "%d\t%s\n", project.ID, project.Name)
+			fmt.Fprintf(w, "%d\t%s\t%s\n", project.ID, project.Name, project.RoleKind)
 		}
 	}
 
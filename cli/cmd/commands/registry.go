@@ -16,6 +16,13 @@ import (
 	"github.com/spf13/cobra"
 )
 
+var (
+	registryGCDryRun     bool
+	registryGCEnabled    bool
+	registryGCKeepLastN  uint
+	registryGCMaxAgeDays uint
+)
+
 func registerCommand_Registry(cliConf config.CLIConfig) *cobra.Command {
 	registryCmd := &cobra.Command{
 		Use:     "registry",
@@ -81,6 +88,56 @@ func registerCommand_Registry(cliConf config.CLIConfig) *cobra.Command {
 		},
 	}
 
+	registryGCCmd := &cobra.Command{
+		Use:   "gc",
+		Short: "Deletes stale image tags from the registries linked to a project",
+		Long: fmt.Sprintf(`%s
+
+Deletes the image tags which are not referenced by any workload in the project's clusters, according to the
+project's registry garbage collection policy. Use --dry-run to list the tags which would be deleted.
+
+  %s
+
+To configure the policy, use "porter registry gc policy set".`,
+			color.New(color.FgBlue, color.Bold).Sprintf("Help for \"porter registry gc\":"),
+			color.New(color.FgGreen, color.Bold).Sprintf("porter registry gc --dry-run"),
+		),
+		Run: func(cmd *cobra.Command, args []string) {
+			err := checkLoginAndRunWithConfig(cmd.Context(), cliConf, args, runRegistryGC)
+			if err != nil {
+				os.Exit(1)
+			}
+		},
+	}
+
+	registryGCCmd.Flags().BoolVar(&registryGCDryRun, "dry-run", false, "list the tags which would be deleted without deleting them")
+
+	registryGCPolicyCmd := &cobra.Command{
+		Use:   "policy",
+		Short: "Shows the registry garbage collection policy of a project",
+		Run: func(cmd *cobra.Command, args []string) {
+			err := checkLoginAndRunWithConfig(cmd.Context(), cliConf, args, getRegistryGCPolicy)
+			if err != nil {
+				os.Exit(1)
+			}
+		},
+	}
+
+	registryGCPolicySetCmd := &cobra.Command{
+		Use:   "set",
+		Short: "Sets the registry garbage collection policy of a project",
+		Run: func(cmd *cobra.Command, args []string) {
+			err := checkLoginAndRunWithConfig(cmd.Context(), cliConf, args, setRegistryGCPolicy)
+			if err != nil {
+				os.Exit(1)
+			}
+		},
+	}
+
+	registryGCPolicySetCmd.Flags().UintVar(&registryGCKeepLastN, "keep-last", 0, "number of most recently pushed tags to always keep in each repository")
+	registryGCPolicySetCmd.Flags().UintVar(&registryGCMaxAgeDays, "max-age-days", 0, "delete tags older than this many days, other than the most recent tags kept by --keep-last")
+	registryGCPolicySetCmd.Flags().BoolVar(&registryGCEnabled, "enabled", false, "apply the policy daily in the background")
+
 	registryCmd.PersistentFlags().AddFlagSet(utils.RegistryFlagSet)
 
 	registryCmd.AddCommand(registryReposCmd)
@@ -92,6 +149,10 @@ func registerCommand_Registry(cliConf config.CLIConfig) *cobra.Command {
 	registryCmd.AddCommand(registryImageCmd)
 	registryImageCmd.AddCommand(registryImageListCmd)
 
+	registryCmd.AddCommand(registryGCCmd)
+	registryGCCmd.AddCommand(registryGCPolicyCmd)
+	registryGCPolicyCmd.AddCommand(registryGCPolicySetCmd)
+
 	return registryCmd
 }
 
@@ -107,6 +168,10 @@ func listRegistries(ctx context.Context, user *types.GetAuthenticatedUserRespons
 		return err
 	}
 
+	if utils.IsStructuredOutput() {
+		return utils.PrintStructured(resp)
+	}
+
 	registries := *resp
 
 	w := new(tabwriter.Writer)
@@ -173,6 +238,10 @@ func listRepos(ctx context.Context, user *types.GetAuthenticatedUserResponse, cl
 		return err
 	}
 
+	if utils.IsStructuredOutput() {
+		return utils.PrintStructured(resp)
+	}
+
 	repos := *resp
 
 	w := new(tabwriter.Writer)
@@ -205,6 +274,10 @@ func listImages(ctx context.Context, user *types.GetAuthenticatedUserResponse, c
 		return err
 	}
 
+	if utils.IsStructuredOutput() {
+		return utils.PrintStructured(resp)
+	}
+
 	imgs := *resp
 
 	w := new(tabwriter.Writer)
@@ -220,3 +293,113 @@ func listImages(ctx context.Context, user *types.GetAuthenticatedUserResponse, c
 
 	return nil
 }
+
+func runRegistryGC(ctx context.Context, user *types.GetAuthenticatedUserResponse, client api.Client, cliConf config.CLIConfig, args []string) error {
+	if !registryGCDryRun {
+		userResp, err := utils.PromptPlaintext(
+			fmt.Sprintf(
+				`Are you sure you'd like to delete the stale image tags from the registries in project %d? %s `,
+				cliConf.Project,
+				color.New(color.FgCyan).Sprintf("[y/n]"),
+			),
+		)
+		if err != nil {
+			return err
+		}
+
+		if userResp := strings.ToLower(userResp); userResp != "y" && userResp != "yes" {
+			return nil
+		}
+	}
+
+	resp, err := client.RunRegistryGC(ctx, cliConf.Project, &types.RunRegistryGCRequest{
+		DryRun: registryGCDryRun,
+	})
+	if err != nil {
+		return err
+	}
+
+	if utils.IsStructuredOutput() {
+		return utils.PrintStructured(resp)
+	}
+
+	verb := "Deleted"
+	if resp.DryRun {
+		verb = "Would delete"
+	}
+
+	w := new(tabwriter.Writer)
+	w.Init(os.Stdout, 3, 8, 0, '\t', tabwriter.AlignRight)
+
+	fmt.Fprintf(w, "%s\t%s\t%s\n", "REGISTRY", "IMAGE", "PUSHED")
+
+	var total int
+	for _, registry := range resp.Registries {
+		for _, tag := range registry.DeletedTags {
+			pushedAt := ""
+			if tag.PushedAt != nil {
+				pushedAt = tag.PushedAt.Format("2006-01-02")
+			}
+
+			fmt.Fprintf(w, "%s\t%s\t%s\n", registry.RegistryName, tag.RepositoryName+":"+tag.Tag, pushedAt)
+			total++
+		}
+	}
+
+	w.Flush()
+
+	for _, registry := range resp.Registries {
+		if registry.Skipped != "" {
+			color.New(color.FgYellow).Printf("Skipped registry %s: %s\n", registry.RegistryName, registry.Skipped)
+		}
+
+		for _, registryErr := range registry.Errors {
+			color.New(color.FgRed).Printf("Error in registry %s: %s\n", registry.RegistryName, registryErr)
+		}
+	}
+
+	color.New(color.FgGreen).Printf("%s %d stale image tags\n", verb, total)
+
+	return nil
+}
+
+func getRegistryGCPolicy(ctx context.Context, user *types.GetAuthenticatedUserResponse, client api.Client, cliConf config.CLIConfig, args []string) error {
+	policy, err := client.GetRegistryGCPolicy(ctx, cliConf.Project)
+	if err != nil {
+		return err
+	}
+
+	printRegistryGCPolicy(policy)
+
+	return nil
+}
+
+func setRegistryGCPolicy(ctx context.Context, user *types.GetAuthenticatedUserResponse, client api.Client, cliConf config.CLIConfig, args []string) error {
+	policy, err := client.UpdateRegistryGCPolicy(ctx, cliConf.Project, &types.UpdateRegistryGCPolicyRequest{
+		Enabled:    registryGCEnabled,
+		KeepLastN:  registryGCKeepLastN,
+		MaxAgeDays: registryGCMaxAgeDays,
+	})
+	if err != nil {
+		return err
+	}
+
+	color.New(color.FgGreen).Println("Updated registry garbage collection policy")
+	printRegistryGCPolicy(policy)
+
+	return nil
+}
+
+func printRegistryGCPolicy(policy *types.RegistryGCPolicy) {
+	w := new(tabwriter.Writer)
+	w.Init(os.Stdout, 3, 8, 0, '\t', tabwriter.AlignRight)
+
+	fmt.Fprintf(w, "%s\t%t\n", "ENABLED", policy.Enabled)
+	fmt.Fprintf(w, "%s\t%d\n", "KEEP LAST", policy.KeepLastN)
+	fmt.Fprintf(w, "%s\t%d\n", "MAX AGE DAYS", policy.MaxAgeDays)
+	if policy.LastRunAt != nil {
+		fmt.Fprintf(w, "%s\t%s\n", "LAST RUN", policy.LastRunAt.String())
+	}
+
+	w.Flush()
+}
@@ -0,0 +1,232 @@
+package commands
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"strings"
+	"text/tabwriter"
+
+	"github.com/fatih/color"
+	api "github.com/porter-dev/porter/api/client"
+	"github.com/porter-dev/porter/api/types"
+	"github.com/porter-dev/porter/cli/cmd/config"
+	"github.com/porter-dev/porter/cli/cmd/utils"
+	"github.com/spf13/cobra"
+)
+
+var (
+	subdomainApp     string
+	subdomainService string
+)
+
+func registerCommand_Subdomain(cliConf config.CLIConfig) *cobra.Command {
+	subdomainCmd := &cobra.Command{
+		Use:     "subdomain",
+		Aliases: []string{"subdomains"},
+		Short:   "Commands that manage the wildcard domain of the current project and the subdomains allocated from it",
+	}
+
+	subdomainSetWildcardCmd := &cobra.Command{
+		Use:   "set-wildcard [domain]",
+		Args:  cobra.ExactArgs(1),
+		Short: "Sets the wildcard domain from which new apps and preview environments are allocated subdomains",
+		Long: fmt.Sprintf(`
+%s
+
+Sets the wildcard domain of the current project. Web services deployed without a domain are then
+allocated a subdomain of it, named after the service and app, instead of a Porter domain. Preview
+environments get a subdomain which also includes the name of the preview. For example:
+
+  %s
+
+A wildcard DNS record for the domain, such as *.apps.example.com, must point to the ingress of the
+clusters of the project.
+`,
+			color.New(color.FgBlue, color.Bold).Sprintf("Help for \"porter subdomain set-wildcard\":"),
+			color.New(color.FgGreen, color.Bold).Sprintf("porter subdomain set-wildcard apps.example.com"),
+		),
+		Run: func(cmd *cobra.Command, args []string) {
+			err := checkLoginAndRunWithConfig(cmd.Context(), cliConf, args, setWildcardDomain)
+			if err != nil {
+				os.Exit(1)
+			}
+		},
+	}
+	subdomainCmd.AddCommand(subdomainSetWildcardCmd)
+
+	subdomainDeleteWildcardCmd := &cobra.Command{
+		Use:   "delete-wildcard",
+		Args:  cobra.NoArgs,
+		Short: "Deletes the wildcard domain of the current project and releases all of its subdomains",
+		Run: func(cmd *cobra.Command, args []string) {
+			err := checkLoginAndRunWithConfig(cmd.Context(), cliConf, args, deleteWildcardDomain)
+			if err != nil {
+				os.Exit(1)
+			}
+		},
+	}
+	subdomainCmd.AddCommand(subdomainDeleteWildcardCmd)
+
+	subdomainListCmd := &cobra.Command{
+		Use:   "list",
+		Args:  cobra.NoArgs,
+		Short: "Lists the subdomains allocated from the wildcard domain of the current project",
+		Run: func(cmd *cobra.Command, args []string) {
+			err := checkLoginAndRunWithConfig(cmd.Context(), cliConf, args, listSubdomains)
+			if err != nil {
+				os.Exit(1)
+			}
+		},
+	}
+	subdomainCmd.AddCommand(subdomainListCmd)
+
+	subdomainReserveCmd := &cobra.Command{
+		Use:   "reserve [name]",
+		Args:  cobra.ExactArgs(1),
+		Short: "Reserves a subdomain so that it is not allocated automatically, optionally for a service of an app",
+		Run: func(cmd *cobra.Command, args []string) {
+			err := checkLoginAndRunWithConfig(cmd.Context(), cliConf, args, reserveSubdomain)
+			if err != nil {
+				os.Exit(1)
+			}
+		},
+	}
+	subdomainReserveCmd.Flags().StringVar(&subdomainApp, "app", "", "the app on the current cluster to reserve the subdomain for")
+	subdomainReserveCmd.Flags().StringVar(&subdomainService, "service", "", "the web service of the app to reserve the subdomain for")
+	subdomainReserveCmd.RegisterFlagCompletionFunc("app", completeAppNames(cliConf)) // nolint:errcheck,gosec
+	subdomainCmd.AddCommand(subdomainReserveCmd)
+
+	subdomainReleaseCmd := &cobra.Command{
+		Use:   "release [name]",
+		Args:  cobra.ExactArgs(1),
+		Short: "Releases a subdomain so that it can be allocated again",
+		Run: func(cmd *cobra.Command, args []string) {
+			err := checkLoginAndRunWithConfig(cmd.Context(), cliConf, args, releaseSubdomain)
+			if err != nil {
+				os.Exit(1)
+			}
+		},
+	}
+	subdomainCmd.AddCommand(subdomainReleaseCmd)
+
+	return subdomainCmd
+}
+
+func setWildcardDomain(ctx context.Context, _ *types.GetAuthenticatedUserResponse, client api.Client, cliConf config.CLIConfig, args []string) error {
+	domain, err := client.SetWildcardDomain(ctx, cliConf.Project, &types.SetWildcardDomainRequest{
+		Domain: args[0],
+	})
+	if err != nil {
+		return fmt.Errorf("error setting wildcard domain: %w", err)
+	}
+
+	color.New(color.FgGreen).Printf("Set the wildcard domain of the project to %s\n", domain.Domain)
+	fmt.Printf("Make sure a DNS record for *.%s points to the ingress of your clusters\n", domain.Domain)
+
+	return nil
+}
+
+func deleteWildcardDomain(ctx context.Context, _ *types.GetAuthenticatedUserResponse, client api.Client, cliConf config.CLIConfig, args []string) error {
+	userResp, err := utils.PromptPlaintext(
+		fmt.Sprintf(
+			`Are you sure you'd like to delete the wildcard domain? All of its subdomains will be released. %s `,
+			color.New(color.FgCyan).Sprintf("[y/n]"),
+		),
+	)
+	if err != nil {
+		return err
+	}
+
+	if userResp := strings.ToLower(userResp); userResp != "y" && userResp != "yes" {
+		return nil
+	}
+
+	if err := client.DeleteWildcardDomain(ctx, cliConf.Project); err != nil {
+		return fmt.Errorf("error deleting wildcard domain: %w", err)
+	}
+
+	color.New(color.FgGreen).Println("Deleted the wildcard domain of the project")
+
+	return nil
+}
+
+func listSubdomains(ctx context.Context, _ *types.GetAuthenticatedUserResponse, client api.Client, cliConf config.CLIConfig, args []string) error {
+	resp, err := client.ListSubdomainAllocations(ctx, cliConf.Project)
+	if err != nil {
+		return fmt.Errorf("error listing subdomains: %w", err)
+	}
+
+	if utils.IsStructuredOutput() {
+		return utils.PrintStructured(resp)
+	}
+
+	if resp.WildcardDomain == "" {
+		fmt.Println("The project has no wildcard domain, set one with porter subdomain set-wildcard")
+		return nil
+	}
+
+	fmt.Printf("Wildcard domain: %s\n\n", resp.WildcardDomain)
+
+	if len(resp.Allocations) == 0 {
+		fmt.Println("No subdomains allocated")
+		return nil
+	}
+
+	w := new(tabwriter.Writer)
+	w.Init(os.Stdout, 3, 8, 2, ' ', 0)
+
+	fmt.Fprintf(w, "%s\t%s\t%s\t%s\n", "HOSTNAME", "APP", "SERVICE", "PREVIEW")
+
+	for _, allocation := range resp.Allocations {
+		app, service := allocation.AppName, allocation.ServiceName
+		if app == "" {
+			app = "(reserved)"
+		}
+		if service == "" {
+			service = "-"
+		}
+
+		preview := "no"
+		if allocation.DeploymentTargetID != "" {
+			preview = "yes"
+		}
+
+		fmt.Fprintf(w, "%s\t%s\t%s\t%s\n", allocation.Hostname, app, service, preview)
+	}
+
+	w.Flush()
+
+	return nil
+}
+
+func reserveSubdomain(ctx context.Context, _ *types.GetAuthenticatedUserResponse, client api.Client, cliConf config.CLIConfig, args []string) error {
+	req := &types.ReserveSubdomainRequest{
+		Name:        args[0],
+		AppName:     subdomainApp,
+		ServiceName: subdomainService,
+	}
+
+	if subdomainApp != "" {
+		req.ClusterID = cliConf.Cluster
+	}
+
+	allocation, err := client.ReserveSubdomain(ctx, cliConf.Project, req)
+	if err != nil {
+		return fmt.Errorf("error reserving subdomain: %w", err)
+	}
+
+	color.New(color.FgGreen).Printf("Reserved %s\n", allocation.Hostname)
+
+	return nil
+}
+
+func releaseSubdomain(ctx context.Context, _ *types.GetAuthenticatedUserResponse, client api.Client, cliConf config.CLIConfig, args []string) error {
+	if err := client.ReleaseSubdomain(ctx, cliConf.Project, args[0]); err != nil {
+		return fmt.Errorf("error releasing subdomain: %w", err)
+	}
+
+	color.New(color.FgGreen).Printf("Released %s\n", args[0])
+
+	return nil
+}
@@ -0,0 +1,204 @@
+package commands
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net"
+	"os"
+	"os/signal"
+	"sync"
+	"syscall"
+
+	"github.com/fatih/color"
+	"github.com/gorilla/websocket"
+	api "github.com/porter-dev/porter/api/client"
+	"github.com/porter-dev/porter/api/types"
+	"github.com/porter-dev/porter/cli/cmd/config"
+	"github.com/spf13/cobra"
+)
+
+// tunnelBufferSize is the size of the chunks read from a local connection and sent over the tunnel
+const tunnelBufferSize = 32 * 1024
+
+var (
+	tunnelPort      int
+	tunnelLocalPort int
+	tunnelNamespace string
+)
+
+func registerCommand_Tunnel(cliConf config.CLIConfig) *cobra.Command {
+	tunnelCmd := &cobra.Command{
+		Use:   "tunnel [service]",
+		Args:  cobra.ExactArgs(1),
+		Short: "Opens a local tunnel to a port of a private service in the current cluster",
+		Long: fmt.Sprintf(`
+%s
+
+Opens a local TCP listener which tunnels connections through the Porter API to a port of a service in
+the current cluster, such as an internal database, without exposing the service or connecting to a
+VPN. Tunnels require permission to update resources in the namespace of the service. For example:
+
+  %s
+
+Connections to localhost:5432 then reach port 5432 of the "postgres" service in the "default"
+namespace. The local port defaults to the port of the service. The tunnel stays open until interrupted.
+`,
+			color.New(color.FgBlue, color.Bold).Sprintf("Help for \"porter tunnel\":"),
+			color.New(color.FgGreen, color.Bold).Sprintf("porter tunnel postgres --port 5432 --namespace default"),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			return checkLoginAndRunWithConfig(cmd.Context(), cliConf, args, tunnel)
+		},
+	}
+
+	tunnelCmd.Flags().IntVarP(
+		&tunnelPort,
+		"port",
+		"p",
+		0,
+		"port of the service to tunnel to",
+	)
+
+	tunnelCmd.Flags().IntVar(
+		&tunnelLocalPort,
+		"local-port",
+		0,
+		"local port to listen on, which defaults to the port of the service",
+	)
+
+	tunnelCmd.Flags().StringVarP(
+		&tunnelNamespace,
+		"namespace",
+		"n",
+		"default",
+		"namespace of the service",
+	)
+
+	tunnelCmd.MarkFlagRequired("port") // nolint:errcheck,gosec
+
+	return tunnelCmd
+}
+
+func tunnel(ctx context.Context, _ *types.GetAuthenticatedUserResponse, client api.Client, cliConfig config.CLIConfig, args []string) error {
+	service := args[0]
+
+	if tunnelPort <= 0 || tunnelPort > 65535 {
+		return errors.New("port must be between 1 and 65535")
+	}
+
+	localPort := tunnelLocalPort
+	if localPort == 0 {
+		localPort = tunnelPort
+	}
+
+	listener, err := net.Listen("tcp", fmt.Sprintf("127.0.0.1:%d", localPort))
+	if err != nil {
+		return fmt.Errorf("could not listen on local port %d: %w", localPort, err)
+	}
+
+	ctx, stop := signal.NotifyContext(ctx, os.Interrupt, syscall.SIGTERM)
+	defer stop()
+
+	go func() {
+		<-ctx.Done()
+		listener.Close() // nolint:errcheck,gosec
+	}()
+
+	color.New(color.FgGreen).Printf("Tunneling localhost:%d to %s/%s:%d, press Ctrl+C to stop\n", localPort, tunnelNamespace, service, tunnelPort) // nolint:errcheck,gosec
+
+	var wg sync.WaitGroup
+	defer wg.Wait()
+
+	for {
+		localConn, err := listener.Accept()
+		if err != nil {
+			if ctx.Err() != nil {
+				return nil
+			}
+
+			return fmt.Errorf("error accepting connection: %w", err)
+		}
+
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			defer localConn.Close() // nolint:errcheck
+
+			err := tunnelConnection(ctx, client, cliConfig, service, localConn)
+			if err != nil {
+				color.New(color.FgRed).Fprintf(os.Stderr, "Error tunneling connection from %s: %s\n", localConn.RemoteAddr(), err.Error()) // nolint:errcheck,gosec
+			}
+		}()
+	}
+}
+
+// tunnelConnection copies data between a local connection and a websocket tunnel to the service, until either side closes
+func tunnelConnection(ctx context.Context, client api.Client, cliConfig config.CLIConfig, service string, localConn net.Conn) error {
+	wsConn, err := client.TunnelService(ctx, cliConfig.Project, cliConfig.Cluster, tunnelNamespace, service, int32(tunnelPort))
+	if err != nil {
+		return err
+	}
+	defer wsConn.Close() // nolint:errcheck
+
+	// every goroutine sends at most one value, so neither blocks once the tunnel is closed
+	errorchan := make(chan error, 2)
+
+	go func() {
+		buf := make([]byte, tunnelBufferSize)
+
+		for {
+			n, err := localConn.Read(buf)
+			if n > 0 {
+				if writeErr := wsConn.WriteMessage(websocket.BinaryMessage, buf[:n]); writeErr != nil {
+					errorchan <- writeErr
+					return
+				}
+			}
+
+			if err != nil {
+				errorchan <- nil
+				return
+			}
+		}
+	}()
+
+	go func() {
+		for {
+			messageType, data, err := wsConn.ReadMessage()
+			if err != nil {
+				if websocket.IsCloseError(err, websocket.CloseNormalClosure) || errors.Is(err, net.ErrClosed) {
+					err = nil
+				}
+				errorchan <- err
+				return
+			}
+
+			// errors from the server are sent as text messages, while data from the service is sent as binary messages
+			if messageType == websocket.TextMessage {
+				var errRes types.ExternalError
+				if jsonErr := json.Unmarshal(data, &errRes); jsonErr == nil && errRes.Error != "" {
+					errorchan <- errors.New(errRes.Error)
+				} else {
+					errorchan <- errors.New(string(data))
+				}
+				return
+			}
+
+			if _, err := localConn.Write(data); err != nil {
+				errorchan <- nil
+				return
+			}
+		}
+	}()
+
+	select {
+	case err = <-errorchan:
+	case <-ctx.Done():
+	}
+
+	wsConn.WriteMessage(websocket.CloseMessage, websocket.FormatCloseMessage(websocket.CloseNormalClosure, "")) // nolint:errcheck,gosec
+
+	return err
+}
@@ -34,6 +34,10 @@ type CLIConfig struct {
 	Registry   uint   `yaml:"registry"`
 	HelmRepo   uint   `yaml:"helm_repo"`
 	Kubeconfig string `yaml:"kubeconfig"`
+
+	// Context is the name of the context in use, whose host, project, cluster and token take the place of the ones at the
+	// top level of the config file. It is empty when no context is in use.
+	Context string `yaml:"-" mapstructure:"-"`
 }
 
 // InitAndLoadConfig populates the config object with the following precedence rules:
@@ -151,6 +155,11 @@ func initAndLoadConfig() (CLIConfig, error) {
 		return config, fmt.Errorf("unable to unmarshal porter config: %w", err)
 	}
 
+	err = config.loadContext()
+	if err != nil {
+		return config, fmt.Errorf("unable to load porter context: %w", err)
+	}
+
 	return config, nil
 }
 
@@ -227,12 +236,12 @@ func (c *CLIConfig) SetHost(host string) error {
 	// a trailing / can lead to errors with the api server
 	host = strings.TrimRight(host, "/")
 
-	viper.Set("host", host)
+	c.setConfigValue("host", host)
 
 	// let us clear the project ID, cluster ID, and token when we reset a host
-	viper.Set("project", 0)
-	viper.Set("cluster", 0)
-	viper.Set("token", "")
+	c.setConfigValue("project", 0)
+	c.setConfigValue("cluster", 0)
+	c.setConfigValue("token", "")
 
 	err := viper.WriteConfig()
 	if err != nil {
@@ -251,7 +260,7 @@ func (c *CLIConfig) SetHost(host string) error {
 
 // SetProject sets a project for all API commands
 func (c *CLIConfig) SetProject(ctx context.Context, apiClient api.Client, projectID uint) error {
-	viper.Set("project", projectID)
+	c.setConfigValue("project", projectID)
 
 	color.New(color.FgGreen).Printf("Set the current project as %d\n", projectID)
 
@@ -278,7 +287,7 @@ func (c *CLIConfig) SetProject(ctx context.Context, apiClient api.Client, projec
 }
 
 func (c *CLIConfig) SetCluster(clusterID uint) error {
-	viper.Set("cluster", clusterID)
+	c.setConfigValue("cluster", clusterID)
 
 	color.New(color.FgGreen).Printf("Set the current cluster as %d\n", clusterID)
 
@@ -297,7 +306,7 @@ func (c *CLIConfig) SetCluster(clusterID uint) error {
 }
 
 func (c *CLIConfig) SetToken(token string) error {
-	viper.Set("token", token)
+	c.setConfigValue("token", token)
 	err := viper.WriteConfig()
 	if err != nil {
 		return err
@@ -0,0 +1,233 @@
+package config
+
+import (
+	"bytes"
+	"fmt"
+	"os"
+	"regexp"
+	"sort"
+	"strings"
+
+	"github.com/fatih/color"
+	"github.com/spf13/viper"
+	"gopkg.in/yaml.v2"
+)
+
+const (
+	// contextsKey is the key of the named contexts in the config file
+	contextsKey = "contexts"
+	// currentContextKey is the key of the name of the context in use in the config file
+	currentContextKey = "current_context"
+	// contextEnvVar selects a context for a single command, like the --context flag
+	contextEnvVar = "PORTER_CONTEXT"
+)
+
+// contextNameRegex matches valid context names. Names are lowercase since viper lowercases keys, and cannot contain
+// dots since viper uses them to separate nested keys.
+var contextNameRegex = regexp.MustCompile(`^[a-z0-9][a-z0-9_-]*$`)
+
+// Context is a named set of connection settings, so that users of several Porter servers or projects can switch between
+// them without setting the host, project and cluster or logging in again
+type Context struct {
+	Host    string `mapstructure:"host" yaml:"host"`
+	Project uint   `mapstructure:"project" yaml:"project"`
+	Cluster uint   `mapstructure:"cluster" yaml:"cluster"`
+	Token   string `mapstructure:"token" yaml:"token"`
+}
+
+// ListContexts returns the contexts saved in the config file by name
+func ListContexts() (map[string]Context, error) {
+	contexts := make(map[string]Context)
+
+	err := viper.UnmarshalKey(contextsKey, &contexts)
+	if err != nil {
+		return nil, fmt.Errorf("unable to read contexts from porter config: %w", err)
+	}
+
+	return contexts, nil
+}
+
+// ContextNames returns the names of the saved contexts in alphabetical order
+func ContextNames(contexts map[string]Context) []string {
+	names := make([]string, 0, len(contexts))
+	for name := range contexts {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	return names
+}
+
+// SaveContext saves the current host, project, cluster and token as a named context, replacing any context with the same name
+func (c *CLIConfig) SaveContext(name string) error {
+	if !contextNameRegex.MatchString(name) {
+		return fmt.Errorf("invalid context name %q, names must be lowercase letters, numbers, dashes and underscores", name)
+	}
+
+	viper.Set(contextKey(name, "host"), c.Host)
+	viper.Set(contextKey(name, "project"), c.Project)
+	viper.Set(contextKey(name, "cluster"), c.Cluster)
+	viper.Set(contextKey(name, "token"), c.Token)
+
+	err := viper.WriteConfig()
+	if err != nil {
+		return err
+	}
+
+	color.New(color.FgGreen).Printf("Saved the current settings as context %s\n", name)
+
+	return nil
+}
+
+// UseContext makes a saved context the current context, so that the settings of the context are used by every command
+func (c *CLIConfig) UseContext(name string) error {
+	contexts, err := ListContexts()
+	if err != nil {
+		return err
+	}
+
+	porterContext, ok := contexts[name]
+	if !ok {
+		return fmt.Errorf("context %s does not exist, run 'porter config get-contexts' to list contexts", name)
+	}
+
+	viper.Set(currentContextKey, name)
+
+	err = viper.WriteConfig()
+	if err != nil {
+		return err
+	}
+
+	c.Context = name
+	c.applyContext(porterContext)
+
+	color.New(color.FgGreen).Printf("Switched to context %s\n", name)
+
+	return nil
+}
+
+// DeleteContext deletes a saved context, and stops using it if it is the current context
+func (c *CLIConfig) DeleteContext(name string) error {
+	contexts, err := ListContexts()
+	if err != nil {
+		return err
+	}
+
+	if _, ok := contexts[name]; !ok {
+		return fmt.Errorf("context %s does not exist", name)
+	}
+
+	// viper cannot unset keys, so the config is reloaded without the context before it is written
+	settings := viper.AllSettings()
+	if savedContexts, ok := settings[contextsKey].(map[string]interface{}); ok {
+		delete(savedContexts, name)
+	}
+	if settings[currentContextKey] == name {
+		settings[currentContextKey] = ""
+	}
+
+	settingsBytes, err := yaml.Marshal(settings)
+	if err != nil {
+		return fmt.Errorf("unable to marshal porter config: %w", err)
+	}
+
+	err = viper.ReadConfig(bytes.NewReader(settingsBytes))
+	if err != nil {
+		return fmt.Errorf("unable to reload porter config: %w", err)
+	}
+
+	err = viper.WriteConfig()
+	if err != nil {
+		return err
+	}
+
+	if c.Context == name {
+		c.Context = ""
+	}
+
+	color.New(color.FgGreen).Printf("Deleted context %s\n", name)
+
+	return nil
+}
+
+// loadContext applies the selected context to a config. The context is selected by the --context flag, then the
+// PORTER_CONTEXT env var, then the current context in the config file.
+func (c *CLIConfig) loadContext() error {
+	name := contextFromArgs(os.Args[1:])
+	if name == "" {
+		name = os.Getenv(contextEnvVar)
+	}
+	if name == "" {
+		name = viper.GetString(currentContextKey)
+	}
+
+	if name == "" {
+		return nil
+	}
+
+	contexts, err := ListContexts()
+	if err != nil {
+		return err
+	}
+
+	porterContext, ok := contexts[name]
+	if !ok {
+		return fmt.Errorf("context %s does not exist, run 'porter config get-contexts' to list contexts", name)
+	}
+
+	c.Context = name
+	c.applyContext(porterContext)
+
+	return nil
+}
+
+// applyContext sets the settings of a context on the config. Settings passed as env vars take precedence over the context,
+// so that commands run in CI can still override them.
+func (c *CLIConfig) applyContext(porterContext Context) {
+	if porterContext.Host != "" && os.Getenv("PORTER_HOST") == "" {
+		c.Host = porterContext.Host
+	}
+	if os.Getenv("PORTER_PROJECT") == "" {
+		c.Project = porterContext.Project
+	}
+	if os.Getenv("PORTER_CLUSTER") == "" {
+		c.Cluster = porterContext.Cluster
+	}
+	if os.Getenv("PORTER_TOKEN") == "" {
+		c.Token = porterContext.Token
+	}
+}
+
+// setConfigValue sets a value in the current context, or at the top level of the config file when no context is in use
+func (c *CLIConfig) setConfigValue(key string, value interface{}) {
+	if c.Context != "" {
+		viper.Set(contextKey(c.Context, key), value)
+		return
+	}
+
+	viper.Set(key, value)
+}
+
+func contextKey(name, key string) string {
+	return fmt.Sprintf("%s.%s.%s", contextsKey, name, key)
+}
+
+// contextFromArgs returns the value of the --context flag, which is read before the flags are parsed since the config
+// is loaded before the commands are registered
+func contextFromArgs(args []string) string {
+	for i, arg := range args {
+		if arg == "--" {
+			return ""
+		}
+
+		if value, ok := strings.CutPrefix(arg, "--context="); ok {
+			return value
+		}
+
+		if arg == "--context" && i+1 < len(args) {
+			return args[i+1]
+		}
+	}
+
+	return ""
+}
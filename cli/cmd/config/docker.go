@@ -4,6 +4,7 @@ import (
 	"context"
 	"encoding/base64"
 	"fmt"
+	"io"
 	"io/ioutil"
 	"net/url"
 	"os"
@@ -18,40 +19,18 @@ import (
 	"github.com/porter-dev/porter/cli/cmd/github"
 )
 
+// DockerCredentialHelperName is the binary which docker runs to get credentials for registries whose credential helper is "porter".
+// The porter CLI serves the credential helper protocol when it is invoked with this name.
+const DockerCredentialHelperName = "docker-credential-porter"
+
 // SetDockerConfig sets up the docker config.json
 func SetDockerConfig(ctx context.Context, client api.Client, pID uint) error {
 	// get all registries that should be added
-	regToAdd := make([]string, 0)
-
-	// get the list of namespaces
-	resp, err := client.ListRegistries(
-		ctx,
-		pID,
-	)
+	regToAdd, err := listRegistryHosts(ctx, client, pID)
 	if err != nil {
 		return err
 	}
 
-	registries := *resp
-
-	for _, registry := range registries {
-		if registry.URL != "" {
-			rURL := registry.URL
-
-			if !strings.Contains(rURL, "http") {
-				rURL = "http://" + rURL
-			}
-
-			// strip the protocol
-			regURL, err := url.Parse(rURL)
-			if err != nil {
-				continue
-			}
-
-			regToAdd = append(regToAdd, regURL.Host)
-		}
-	}
-
 	// create a docker dir if it does not exist
 	dockerDir := filepath.Join(home, ".docker")
 
@@ -81,27 +60,15 @@ func SetDockerConfig(ctx context.Context, client api.Client, pID uint) error {
 	// 	return err
 	// }
 
-	// check if the docker credential helper exists
-	if !commandExists("docker-credential-porter") {
-		err := downloadCredMatchingRelease(ctx)
-		if err != nil {
-			color.New(color.FgRed).Println("Failed to download credential helper binary:", err.Error())
-			os.Exit(1)
-		}
-	}
-
-	// otherwise, check the version flag of the binary
-	cmdVersionCred := exec.Command("docker-credential-porter", "--version")
-	writer := &VersionWriter{}
-	cmdVersionCred.Stdout = writer
-
-	err = cmdVersionCred.Run()
-
-	if err != nil || writer.Version != Version {
-		err := downloadCredMatchingRelease(ctx)
-		if err != nil {
-			color.New(color.FgRed).Println("Failed to download credential helper binary:", err.Error())
-			os.Exit(1)
+	// check if the docker credential helper exists and matches the version of the CLI
+	if !dockerCredentialHelperIsCurrent() {
+		// prefer linking the CLI itself as the credential helper, and fall back to downloading the release binary
+		if err := linkDockerCredentialHelper(); err != nil {
+			err := downloadCredMatchingRelease(ctx)
+			if err != nil {
+				color.New(color.FgRed).Println("Failed to download credential helper binary:", err.Error())
+				os.Exit(1)
+			}
 		}
 	}
 
@@ -115,6 +82,78 @@ func SetDockerConfig(ctx context.Context, client api.Client, pID uint) error {
 	// 	return err
 	// }
 
+	err = addRegistriesToDockerConfig(ctx, client, pID, configFile, regToAdd)
+	if err != nil {
+		return err
+	}
+
+	return configFile.Save()
+}
+
+// WriteDockerConfig writes a docker config.json which authenticates with the registries of a project to w, without modifying the host's
+// docker config, so that it can be used by tools such as skaffold or docker compose. Registries other than Docker Hub use the porter
+// credential helper, which is linked to the CLI if it does not exist.
+func WriteDockerConfig(ctx context.Context, client api.Client, pID uint, w io.Writer) error {
+	regToAdd, err := listRegistryHosts(ctx, client, pID)
+	if err != nil {
+		return err
+	}
+
+	// the release binary is not downloaded here, since that would write to stdout
+	if !dockerCredentialHelperIsCurrent() {
+		if err := linkDockerCredentialHelper(); err != nil {
+			color.New(color.FgYellow).Fprintf(os.Stderr, "Unable to install %s, which is required to use this config: %s\n", DockerCredentialHelperName, err.Error()) //nolint:errcheck,gosec
+		}
+	}
+
+	configFile := configfile.New("")
+
+	err = addRegistriesToDockerConfig(ctx, client, pID, configFile, regToAdd)
+	if err != nil {
+		return err
+	}
+
+	return configFile.SaveToWriter(w)
+}
+
+// listRegistryHosts returns the hosts of the registries connected to a project
+func listRegistryHosts(ctx context.Context, client api.Client, pID uint) ([]string, error) {
+	regToAdd := make([]string, 0)
+
+	// get the list of namespaces
+	resp, err := client.ListRegistries(
+		ctx,
+		pID,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	registries := *resp
+
+	for _, registry := range registries {
+		if registry.URL != "" {
+			rURL := registry.URL
+
+			if !strings.Contains(rURL, "http") {
+				rURL = "http://" + rURL
+			}
+
+			// strip the protocol
+			regURL, err := url.Parse(rURL)
+			if err != nil {
+				continue
+			}
+
+			regToAdd = append(regToAdd, regURL.Host)
+		}
+	}
+
+	return regToAdd, nil
+}
+
+// addRegistriesToDockerConfig configures the porter credential helper for each registry, or a token for Docker Hub registries
+func addRegistriesToDockerConfig(ctx context.Context, client api.Client, pID uint, configFile *configfile.ConfigFile, regToAdd []string) error {
 	if configFile.CredentialHelpers == nil {
 		configFile.CredentialHelpers = make(map[string]string)
 	}
@@ -167,7 +206,54 @@ func SetDockerConfig(ctx context.Context, client api.Client, pID uint) error {
 		}
 	}
 
-	return configFile.Save()
+	return nil
+}
+
+// dockerCredentialHelperIsCurrent checks if the docker credential helper exists and has the same version as the CLI
+func dockerCredentialHelperIsCurrent() bool {
+	if !commandExists(DockerCredentialHelperName) {
+		return false
+	}
+
+	cmdVersionCred := exec.Command(DockerCredentialHelperName, "--version")
+	writer := &VersionWriter{}
+	cmdVersionCred.Stdout = writer
+
+	err := cmdVersionCred.Run()
+
+	return err == nil && writer.Version == Version
+}
+
+// linkDockerCredentialHelper installs the CLI as the docker credential helper, by linking it as docker-credential-porter
+// in the directory of the CLI binary
+func linkDockerCredentialHelper() error {
+	exe, err := os.Executable()
+	if err != nil {
+		return err
+	}
+
+	exe, err = filepath.EvalSymlinks(exe)
+	if err != nil {
+		return err
+	}
+
+	link := filepath.Join(filepath.Dir(exe), DockerCredentialHelperName)
+
+	if _, err := os.Lstat(link); err == nil {
+		if err := os.Remove(link); err != nil {
+			return err
+		}
+	}
+
+	if err := os.Symlink(exe, link); err != nil {
+		return err
+	}
+
+	if !commandExists(DockerCredentialHelperName) {
+		return fmt.Errorf("%s is not in PATH", filepath.Dir(exe))
+	}
+
+	return nil
 }
 
 func commandExists(cmd string) bool {
@@ -0,0 +1,127 @@
+package connect
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"os/exec"
+
+	"github.com/fatih/color"
+	api "github.com/porter-dev/porter/api/client"
+	"github.com/porter-dev/porter/api/types"
+	"github.com/porter-dev/porter/cli/cmd/utils"
+)
+
+// AKSOpts are the options for connecting an AKS cluster
+type AKSOpts struct {
+	// ClusterName is the name of the AKS cluster
+	ClusterName string
+	// ResourceGroup is the resource group of the cluster
+	ResourceGroup string
+	// SubscriptionID is the subscription of the cluster. If empty, the az default subscription is used.
+	SubscriptionID string
+
+	// ClientID, ClientSecret and TenantID identify a service principal which is used to log in to the az CLI
+	// before reading the cluster. If ClientID is empty, the current az login is used.
+	ClientID     string
+	ClientSecret string
+	TenantID     string
+}
+
+// AKS connects an AKS cluster to a project. It reads the cluster admin credentials with the az CLI, optionally
+// logging in as a service principal first, so that the cluster is created without further actions.
+func AKS(
+	ctx context.Context,
+	client api.Client,
+	projectID uint,
+	opts AKSOpts,
+) (uint, error) {
+	// if project ID is 0, ask the user to set the project ID or create a project
+	if projectID == 0 {
+		return 0, fmt.Errorf("no project set, please run porter project set [id]")
+	}
+
+	var err error
+
+	if opts.ClusterName == "" {
+		opts.ClusterName, err = utils.PromptPlaintext("AKS cluster name: ")
+		if err != nil {
+			return 0, err
+		}
+	}
+
+	if opts.ResourceGroup == "" {
+		opts.ResourceGroup, err = utils.PromptPlaintext("Resource group of the cluster: ")
+		if err != nil {
+			return 0, err
+		}
+	}
+
+	if opts.ClientID != "" {
+		err = azLoginServicePrincipal(ctx, opts)
+		if err != nil {
+			return 0, err
+		}
+	}
+
+	color.New(color.FgBlue).Printf("Reading credentials for cluster %s in %s\n", opts.ClusterName, opts.ResourceGroup) // nolint:errcheck,gosec
+
+	args := []string{
+		"aks", "get-credentials",
+		"--name", opts.ClusterName,
+		"--resource-group", opts.ResourceGroup,
+		"--admin",
+		"--file", "-",
+	}
+	if opts.SubscriptionID != "" {
+		args = append(args, "--subscription", opts.SubscriptionID)
+	}
+
+	cmd := exec.CommandContext(ctx, "az", args...)
+	cmd.Stderr = os.Stderr
+
+	kubeconfig, err := cmd.Output()
+	if err != nil {
+		return 0, fmt.Errorf("error reading aks credentials with az: %w", err)
+	}
+
+	// admin credentials use client certificates, which are stored in the cluster without further actions
+	return createClustersFromKubeconfig(ctx, client, projectID, kubeconfig, func(_ *types.ClusterCandidate, resolver types.ClusterResolver, _ *types.ClusterResolverAll) error {
+		return unexpectedResolverError(resolver)
+	})
+}
+
+// azLoginServicePrincipal logs in to the az CLI as the service principal in opts
+func azLoginServicePrincipal(ctx context.Context, opts AKSOpts) error {
+	if opts.TenantID == "" {
+		return fmt.Errorf("tenant id is required to log in as a service principal")
+	}
+
+	secret := opts.ClientSecret
+	if secret == "" {
+		var err error
+
+		secret, err = utils.PromptPassword("Service principal client secret: ")
+		if err != nil {
+			return err
+		}
+	}
+
+	cmd := exec.CommandContext(
+		ctx,
+		"az", "login", "--service-principal",
+		"--username", opts.ClientID,
+		"--password", secret,
+		"--tenant", opts.TenantID,
+		"--output", "none",
+	)
+	cmd.Stdout = os.Stdout
+	cmd.Stderr = os.Stderr
+
+	err := cmd.Run()
+	if err != nil {
+		return fmt.Errorf("error logging in to az as service principal: %w", err)
+	}
+
+	return nil
+}
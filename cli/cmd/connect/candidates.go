@@ -0,0 +1,86 @@
+package connect
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/fatih/color"
+	api "github.com/porter-dev/porter/api/client"
+	"github.com/porter-dev/porter/api/types"
+)
+
+// resolveCandidateFunc fills in the resolver payload for a single required action of a cluster candidate
+type resolveCandidateFunc func(cc *types.ClusterCandidate, resolver types.ClusterResolver, all *types.ClusterResolverAll) error
+
+// createClustersFromKubeconfig creates cluster candidates from a generated kubeconfig, resolves any actions they require
+// with resolve, and returns the id of the last cluster created.
+func createClustersFromKubeconfig(
+	ctx context.Context,
+	client api.Client,
+	projectID uint,
+	kubeconfig []byte,
+	resolve resolveCandidateFunc,
+) (uint, error) {
+	resp, err := client.CreateProjectCandidates(
+		ctx,
+		projectID,
+		&types.CreateClusterCandidateRequest{
+			Kubeconfig: string(kubeconfig),
+		},
+	)
+	if err != nil {
+		return 0, err
+	}
+
+	var lastClusterID uint
+
+	for _, cc := range *resp {
+		var cluster *types.Cluster
+
+		if len(cc.Resolvers) > 0 {
+			allResolver := &types.ClusterResolverAll{}
+
+			for _, resolver := range cc.Resolvers {
+				err := resolve(cc, resolver, allResolver)
+				if err != nil {
+					return 0, err
+				}
+			}
+
+			clusterResp, err := client.CreateProjectCluster(
+				ctx,
+				projectID,
+				cc.ID,
+				allResolver,
+			)
+			if err != nil {
+				return 0, err
+			}
+
+			clExt := types.Cluster(*clusterResp)
+
+			cluster = &clExt
+		} else {
+			clusterResp, err := client.GetProjectCluster(
+				ctx,
+				projectID,
+				cc.CreatedClusterID,
+			)
+			if err != nil {
+				return 0, err
+			}
+
+			cluster = clusterResp.Cluster
+		}
+
+		color.New(color.FgGreen).Printf("created cluster %s with id %d\n", cluster.Name, cluster.ID) // nolint:errcheck,gosec
+		lastClusterID = cluster.ID
+	}
+
+	return lastClusterID, nil
+}
+
+// unexpectedResolverError is returned when a generated kubeconfig requires an action that the guided flow cannot perform
+func unexpectedResolverError(resolver types.ClusterResolver) error {
+	return fmt.Errorf("unexpected action %s required to connect the cluster", resolver.Name)
+}
@@ -0,0 +1,163 @@
+package connect
+
+import (
+	"context"
+	"encoding/base64"
+	"fmt"
+
+	awssdk "github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/session"
+	"github.com/aws/aws-sdk-go/service/eks"
+	"github.com/aws/aws-sdk-go/service/iam"
+	"github.com/fatih/color"
+	api "github.com/porter-dev/porter/api/client"
+	"github.com/porter-dev/porter/api/types"
+	"github.com/porter-dev/porter/cli/cmd/providers/aws"
+	"github.com/porter-dev/porter/cli/cmd/utils"
+	"k8s.io/client-go/kubernetes"
+	"k8s.io/client-go/tools/clientcmd"
+	clientcmdapi "k8s.io/client-go/tools/clientcmd/api"
+)
+
+// EKS connects an EKS cluster to a project using the local AWS credentials. It reads the cluster endpoint from the
+// EKS API, creates an IAM user for Porter which is mapped to the cluster, and creates the cluster from the resulting
+// cluster candidate, so that no local kubeconfig is required.
+func EKS(
+	ctx context.Context,
+	client api.Client,
+	projectID uint,
+	clusterName string,
+	region string,
+) (uint, error) {
+	// if project ID is 0, ask the user to set the project ID or create a project
+	if projectID == 0 {
+		return 0, fmt.Errorf("no project set, please run porter project set [id]")
+	}
+
+	var err error
+
+	if clusterName == "" {
+		clusterName, err = utils.PromptPlaintext("EKS cluster name: ")
+		if err != nil {
+			return 0, err
+		}
+	}
+
+	if region == "" {
+		region, err = utils.PromptPlaintext("AWS region of the cluster: ")
+		if err != nil {
+			return 0, err
+		}
+	}
+
+	sess, err := session.NewSession(&awssdk.Config{
+		Region: awssdk.String(region),
+	})
+	if err != nil {
+		return 0, fmt.Errorf("error creating aws session: %w", err)
+	}
+
+	color.New(color.FgBlue).Printf("Reading cluster %s in %s\n", clusterName, region) // nolint:errcheck,gosec
+
+	describeResp, err := eks.New(sess).DescribeClusterWithContext(ctx, &eks.DescribeClusterInput{
+		Name: awssdk.String(clusterName),
+	})
+	if err != nil {
+		return 0, fmt.Errorf("error describing eks cluster: %w", err)
+	}
+
+	kubeconfig, err := eksKubeconfig(describeResp.Cluster, region)
+	if err != nil {
+		return 0, err
+	}
+
+	kubeconfigBytes, err := clientcmd.Write(*kubeconfig)
+	if err != nil {
+		return 0, fmt.Errorf("error writing kubeconfig: %w", err)
+	}
+
+	restConf, err := clientcmd.NewDefaultClientConfig(*kubeconfig, &clientcmd.ConfigOverrides{}).ClientConfig()
+	if err != nil {
+		return 0, fmt.Errorf("error creating kubernetes client config: %w", err)
+	}
+
+	clientset, err := kubernetes.NewForConfig(restConf)
+	if err != nil {
+		return 0, fmt.Errorf("error creating kubernetes client: %w", err)
+	}
+
+	agent := &aws.Agent{
+		Session:    sess,
+		IAMService: iam.New(sess),
+		Clientset:  clientset,
+	}
+
+	return createClustersFromKubeconfig(ctx, client, projectID, kubeconfigBytes, func(_ *types.ClusterCandidate, resolver types.ClusterResolver, all *types.ClusterResolverAll) error {
+		if resolver.Name != types.AWSData {
+			return unexpectedResolverError(resolver)
+		}
+
+		color.New(color.FgBlue).Println("Creating an IAM user for Porter and mapping it to the cluster") // nolint:errcheck,gosec
+
+		creds, err := agent.CreateIAMKubernetesMapping(clusterName)
+		if err != nil {
+			return fmt.Errorf("error creating iam user for the cluster: %w", err)
+		}
+
+		all.AWSAccessKeyID = creds.AWSAccessKeyID
+		all.AWSSecretAccessKey = creds.AWSSecretAccessKey
+		all.AWSClusterID = creds.AWSClusterID
+
+		return nil
+	})
+}
+
+// eksKubeconfig returns a kubeconfig for an EKS cluster which authenticates with `aws eks get-token`
+func eksKubeconfig(cluster *eks.Cluster, region string) (*clientcmdapi.Config, error) {
+	if cluster == nil || cluster.Name == nil || cluster.Endpoint == nil {
+		return nil, fmt.Errorf("eks cluster is missing a name or endpoint")
+	}
+
+	if cluster.CertificateAuthority == nil || cluster.CertificateAuthority.Data == nil {
+		return nil, fmt.Errorf("eks cluster %s is missing certificate authority data", *cluster.Name)
+	}
+
+	caData, err := base64.StdEncoding.DecodeString(*cluster.CertificateAuthority.Data)
+	if err != nil {
+		return nil, fmt.Errorf("error decoding certificate authority data: %w", err)
+	}
+
+	name := *cluster.Name
+	if cluster.Arn != nil {
+		name = *cluster.Arn
+	}
+
+	conf := clientcmdapi.NewConfig()
+
+	conf.Clusters[name] = &clientcmdapi.Cluster{
+		Server:                   *cluster.Endpoint,
+		CertificateAuthorityData: caData,
+	}
+
+	conf.AuthInfos[name] = &clientcmdapi.AuthInfo{
+		Exec: &clientcmdapi.ExecConfig{
+			APIVersion: "client.authentication.k8s.io/v1beta1",
+			Command:    "aws",
+			Args: []string{
+				"--region", region,
+				"eks", "get-token",
+				"--cluster-name", *cluster.Name,
+			},
+			InteractiveMode: clientcmdapi.NeverExecInteractiveMode,
+		},
+	}
+
+	conf.Contexts[name] = &clientcmdapi.Context{
+		Cluster:  name,
+		AuthInfo: name,
+	}
+
+	conf.CurrentContext = name
+
+	return conf, nil
+}
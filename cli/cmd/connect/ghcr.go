@@ -0,0 +1,44 @@
+package connect
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	api "github.com/porter-dev/porter/api/client"
+	"github.com/porter-dev/porter/cli/cmd/utils"
+)
+
+// GHCR creates a GitHub Container Registry integration
+func GHCR(
+	ctx context.Context,
+	client api.Client,
+	projectID uint,
+) (uint, error) {
+	// if project ID is 0, ask the user to set the project ID or create a project
+	if projectID == 0 {
+		return 0, fmt.Errorf("no project set, please run porter project set [id]")
+	}
+
+	owner, err := utils.PromptPlaintext("Provide the GitHub user or organization that owns the images. For example, porter-dev.\nOwner: ")
+	if err != nil {
+		return 0, err
+	}
+
+	owner = strings.TrimSpace(owner)
+	if owner == "" || strings.Contains(owner, "/") {
+		return 0, fmt.Errorf("invalid GitHub owner: %s", owner)
+	}
+
+	username, err := utils.PromptPlaintext("GitHub username: ")
+	if err != nil {
+		return 0, err
+	}
+
+	password, err := utils.PromptPassword("Provide a GitHub personal access token with the read:packages and write:packages scopes.\nToken: ")
+	if err != nil {
+		return 0, err
+	}
+
+	return createBasicRegistry(ctx, client, projectID, username, password, fmt.Sprintf("ghcr.io/%s", owner), fmt.Sprintf("ghcr-%s", owner))
+}
@@ -0,0 +1,158 @@
+package connect
+
+import (
+	"context"
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"os"
+	"os/exec"
+
+	"github.com/fatih/color"
+	api "github.com/porter-dev/porter/api/client"
+	"github.com/porter-dev/porter/api/types"
+	"github.com/porter-dev/porter/cli/cmd/utils"
+	"k8s.io/client-go/tools/clientcmd"
+	clientcmdapi "k8s.io/client-go/tools/clientcmd/api"
+)
+
+// GKEOpts are the options for connecting a GKE cluster
+type GKEOpts struct {
+	// ClusterName is the name of the GKE cluster
+	ClusterName string
+	// Location is the zone or region of the cluster
+	Location string
+	// GCPProjectID is the GCP project of the cluster. If empty, the gcloud default project is used.
+	GCPProjectID string
+	// KeyFile is the path to a service account key which Porter uses to access the cluster. If empty, Porter
+	// offers to create a service account with the local gcloud credentials.
+	KeyFile string
+}
+
+// gkeClusterDescription is the subset of `gcloud container clusters describe` output used to build a kubeconfig
+type gkeClusterDescription struct {
+	Name       string `json:"name"`
+	Endpoint   string `json:"endpoint"`
+	MasterAuth struct {
+		ClusterCACertificate string `json:"clusterCaCertificate"`
+	} `json:"masterAuth"`
+}
+
+// GKE connects a GKE cluster to a project. It reads the cluster endpoint with the gcloud CLI, and authenticates
+// Porter with a service account key, which is either provided or created in the cluster's GCP project.
+func GKE(
+	ctx context.Context,
+	client api.Client,
+	projectID uint,
+	opts GKEOpts,
+) (uint, error) {
+	// if project ID is 0, ask the user to set the project ID or create a project
+	if projectID == 0 {
+		return 0, fmt.Errorf("no project set, please run porter project set [id]")
+	}
+
+	var err error
+
+	if opts.ClusterName == "" {
+		opts.ClusterName, err = utils.PromptPlaintext("GKE cluster name: ")
+		if err != nil {
+			return 0, err
+		}
+	}
+
+	if opts.Location == "" {
+		opts.Location, err = utils.PromptPlaintext("Zone or region of the cluster: ")
+		if err != nil {
+			return 0, err
+		}
+	}
+
+	var keyData []byte
+	if opts.KeyFile != "" {
+		keyData, err = os.ReadFile(opts.KeyFile)
+		if err != nil {
+			return 0, fmt.Errorf("error reading service account key file: %w", err)
+		}
+	}
+
+	color.New(color.FgBlue).Printf("Reading cluster %s in %s\n", opts.ClusterName, opts.Location) // nolint:errcheck,gosec
+
+	args := []string{
+		"container", "clusters", "describe", opts.ClusterName,
+		"--location", opts.Location,
+		"--format", "json",
+	}
+	if opts.GCPProjectID != "" {
+		args = append(args, "--project", opts.GCPProjectID)
+	}
+
+	cmd := exec.CommandContext(ctx, "gcloud", args...)
+	cmd.Stderr = os.Stderr
+
+	out, err := cmd.Output()
+	if err != nil {
+		return 0, fmt.Errorf("error describing gke cluster with gcloud: %w", err)
+	}
+
+	cluster := &gkeClusterDescription{}
+	if err := json.Unmarshal(out, cluster); err != nil {
+		return 0, fmt.Errorf("error parsing gke cluster description: %w", err)
+	}
+
+	kubeconfig, err := gkeKubeconfig(cluster)
+	if err != nil {
+		return 0, err
+	}
+
+	kubeconfigBytes, err := clientcmd.Write(*kubeconfig)
+	if err != nil {
+		return 0, fmt.Errorf("error writing kubeconfig: %w", err)
+	}
+
+	return createClustersFromKubeconfig(ctx, client, projectID, kubeconfigBytes, func(cc *types.ClusterCandidate, resolver types.ClusterResolver, all *types.ClusterResolverAll) error {
+		if resolver.Name != types.GCPKeyData {
+			return unexpectedResolverError(resolver)
+		}
+
+		if len(keyData) > 0 {
+			all.GCPKeyData = string(keyData)
+			return nil
+		}
+
+		return resolveGCPKeyAction(ctx, cc.Server, cc.Name, all)
+	})
+}
+
+// gkeKubeconfig returns a kubeconfig for a GKE cluster which authenticates with gcp credentials
+func gkeKubeconfig(cluster *gkeClusterDescription) (*clientcmdapi.Config, error) {
+	if cluster.Name == "" || cluster.Endpoint == "" {
+		return nil, fmt.Errorf("gke cluster is missing a name or endpoint")
+	}
+
+	caData, err := base64.StdEncoding.DecodeString(cluster.MasterAuth.ClusterCACertificate)
+	if err != nil {
+		return nil, fmt.Errorf("error decoding certificate authority data: %w", err)
+	}
+
+	conf := clientcmdapi.NewConfig()
+
+	conf.Clusters[cluster.Name] = &clientcmdapi.Cluster{
+		Server:                   "https://" + cluster.Endpoint,
+		CertificateAuthorityData: caData,
+	}
+
+	conf.AuthInfos[cluster.Name] = &clientcmdapi.AuthInfo{
+		AuthProvider: &clientcmdapi.AuthProviderConfig{
+			Name: "gcp",
+		},
+	}
+
+	conf.Contexts[cluster.Name] = &clientcmdapi.Context{
+		Cluster:  cluster.Name,
+		AuthInfo: cluster.Name,
+	}
+
+	conf.CurrentContext = cluster.Name
+
+	return conf, nil
+}
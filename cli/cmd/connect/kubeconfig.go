@@ -187,6 +187,18 @@ func Kubeconfig(
 					if err != nil {
 						return 0, err
 					}
+				case types.DOData:
+					err := resolveDOAction(
+						ctx,
+						client,
+						projectID,
+						cc.Server,
+						cc.Name,
+						allResolver,
+					)
+					if err != nil {
+						return 0, err
+					}
 				}
 			}
 
@@ -373,6 +385,60 @@ Would you like to proceed? %s `,
 	return resolveGCPKeyActionManual(endpoint, clusterName, resolver)
 }
 
+// resolveDOAction selects the DigitalOcean OAuth integration which is used to connect to a DOKS cluster
+func resolveDOAction(
+	ctx context.Context,
+	client api.Client,
+	projectID uint,
+	endpoint string,
+	clusterName string,
+	resolver *types.ClusterResolverAll,
+) error {
+	resp, err := client.ListOAuthIntegrations(ctx, projectID)
+	if err != nil {
+		return err
+	}
+
+	doIntegrations := make(map[string]uint)
+	options := make([]string, 0)
+
+	for _, oauthInt := range *resp {
+		if oauthInt.Client != types.OAuthDigitalOcean {
+			continue
+		}
+
+		option := fmt.Sprintf("%d", oauthInt.ID)
+		if oauthInt.TargetName != "" || oauthInt.TargetEmail != "" {
+			option = fmt.Sprintf("%d (%s %s)", oauthInt.ID, oauthInt.TargetName, oauthInt.TargetEmail)
+		}
+
+		doIntegrations[option] = oauthInt.ID
+		options = append(options, option)
+	}
+
+	if len(options) == 0 {
+		return fmt.Errorf("detected DOKS cluster in kubeconfig for the endpoint %s (%s), but no DigitalOcean integration exists in project %d: "+
+			"connect your DigitalOcean account in the Porter dashboard and try again", endpoint, clusterName, projectID)
+	}
+
+	if len(options) == 1 {
+		resolver.DOIntegrationID = doIntegrations[options[0]]
+		return nil
+	}
+
+	selected, err := utils.PromptSelect(
+		fmt.Sprintf("Detected DOKS cluster in kubeconfig for the endpoint %s (%s). Select the DigitalOcean integration to connect with", endpoint, clusterName),
+		options,
+	)
+	if err != nil {
+		return err
+	}
+
+	resolver.DOIntegrationID = doIntegrations[selected]
+
+	return nil
+}
+
 func resolveGCPKeyActionManual(
 	endpoint string,
 	clusterName string,
@@ -0,0 +1,44 @@
+package connect
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	api "github.com/porter-dev/porter/api/client"
+	"github.com/porter-dev/porter/cli/cmd/utils"
+)
+
+// Quay creates a Quay.io registry integration
+func Quay(
+	ctx context.Context,
+	client api.Client,
+	projectID uint,
+) (uint, error) {
+	// if project ID is 0, ask the user to set the project ID or create a project
+	if projectID == 0 {
+		return 0, fmt.Errorf("no project set, please run porter project set [id]")
+	}
+
+	namespace, err := utils.PromptPlaintext("Provide the Quay.io user or organization that owns the repositories. For example, porter-dev.\nNamespace: ")
+	if err != nil {
+		return 0, err
+	}
+
+	namespace = strings.TrimSpace(namespace)
+	if namespace == "" || strings.Contains(namespace, "/") {
+		return 0, fmt.Errorf("invalid Quay.io namespace: %s", namespace)
+	}
+
+	username, err := utils.PromptPlaintext("Quay.io username or robot account name, for example porter-dev+deployer: ")
+	if err != nil {
+		return 0, err
+	}
+
+	password, err := utils.PromptPassword("Provide the password or robot account token.\nToken: ")
+	if err != nil {
+		return 0, err
+	}
+
+	return createBasicRegistry(ctx, client, projectID, username, password, fmt.Sprintf("quay.io/%s", namespace), fmt.Sprintf("quay-%s", namespace))
+}
@@ -72,3 +72,46 @@ Username: `))
 
 	return reg.ID, nil
 }
+
+// createBasicRegistry creates a basic auth integration with the credentials, and a registry which uses it
+func createBasicRegistry(
+	ctx context.Context,
+	client api.Client,
+	projectID uint,
+	username string,
+	password string,
+	registryURL string,
+	registryName string,
+) (uint, error) {
+	// create the basic auth integration
+	integration, err := client.CreateBasicAuthIntegration(
+		ctx,
+		projectID,
+		&types.CreateBasicRequest{
+			Username: username,
+			Password: password,
+		},
+	)
+	if err != nil {
+		return 0, err
+	}
+
+	color.New(color.FgGreen).Printf("created basic auth integration with id %d\n", integration.ID)
+
+	reg, err := client.CreateRegistry(
+		ctx,
+		projectID,
+		&types.CreateRegistryRequest{
+			URL:                registryURL,
+			Name:               registryName,
+			BasicIntegrationID: integration.ID,
+		},
+	)
+	if err != nil {
+		return 0, err
+	}
+
+	color.New(color.FgGreen).Printf("created private registry with id %d and name %s\n", reg.ID, reg.Name)
+
+	return reg.ID, nil
+}
@@ -61,6 +61,8 @@ func (a *AuthGetter) GetCredentials(ctx context.Context, serverURL string) (user
 		return a.GetDockerHubCredentials(ctx, serverURL, a.ProjectID)
 	} else if strings.Contains(serverURL, "azurecr.io") {
 		return a.GetACRCredentials(ctx, serverURL, a.ProjectID)
+	} else if strings.Contains(serverURL, "ghcr.io") || strings.Contains(serverURL, "quay.io") {
+		return a.GetBasicRegistryCredentials(ctx, serverURL, a.ProjectID)
 	}
 
 	return a.GetECRCredentials(ctx, serverURL, a.ProjectID)
@@ -284,6 +286,35 @@ func (a *AuthGetter) GetACRCredentials(ctx context.Context, serverURL string, pr
 	return decodeDockerToken(token)
 }
 
+// GetBasicRegistryCredentials returns the credentials of a registry which authenticates with a username and token,
+// such as ghcr.io or quay.io
+func (a *AuthGetter) GetBasicRegistryCredentials(ctx context.Context, serverURL string, projID uint) (user string, secret string, err error) {
+	cachedEntry := a.Cache.Get(serverURL)
+	var token string
+
+	if cachedEntry != nil && cachedEntry.IsValid(time.Now()) {
+		token = cachedEntry.AuthorizationToken
+	} else {
+		req := &types.GetRegistryBasicTokenRequest{ServerURL: serverURL}
+		tokenResp, err := a.Client.GetBasicRegistryAuthorizationToken(ctx, projID, req)
+		if err != nil {
+			return "", "", err
+		}
+
+		token = tokenResp.Token
+
+		// set the token in cache
+		a.Cache.Set(serverURL, &AuthEntry{
+			AuthorizationToken: token,
+			RequestedAt:        time.Now(),
+			ExpiresAt:          tokenResp.ExpiresAt,
+			ProxyEndpoint:      serverURL,
+		})
+	}
+
+	return decodeDockerToken(token)
+}
+
 func decodeDockerToken(token string) (string, string, error) {
 	decodedToken, err := base64.StdEncoding.DecodeString(token)
 	if err != nil {
@@ -3,11 +3,13 @@ package docker
 import (
 	"archive/tar"
 	"bytes"
+	"compress/gzip"
 	"context"
 	"fmt"
 	"io"
 	"io/ioutil"
 	"os"
+	"strings"
 	"time"
 
 	"github.com/docker/docker/api/types"
@@ -29,22 +31,130 @@ type BuildOpts struct {
 	IsDockerfileInCtx bool
 	UseCache          bool
 
+	// CacheFrom is a list of images in the registry whose layers are used as a cache for the build, in addition to the current tag
+	CacheFrom []string
+	// PullCache pulls the cache images before building, so that a daemon without local layers (such as in CI) can use them.
+	// Cache images that do not exist in the registry are skipped.
+	PullCache bool
+
+	// BuildKit builds the image with BuildKit rather than the classic builder. BuildKit is always used when
+	// secrets or ssh forwarding are requested.
+	BuildKit bool
+	// Secrets are mounted into BuildKit builds without being stored in the image
+	Secrets []BuildSecret
+	// SSH is a list of ssh agents or keys forwarded to BuildKit builds, in the same format as `docker build --ssh`
+	SSH []string
+
+	// Platforms are the platforms to build the image for, such as linux/amd64 and linux/arm64. Images are built for linux/amd64
+	// if no platforms are given. Images for more than one platform are built with buildx and pushed as a manifest list.
+	Platforms []string
+
+	// LogWriter receives a copy of the build output, which is always written to stderr
+	LogWriter io.Writer
+
 	Env map[string]string
 }
 
 // BuildLocal
 func (a *Agent) BuildLocal(ctx context.Context, opts *BuildOpts) (err error) {
+	cacheFrom := []string{
+		fmt.Sprintf("%s:%s", opts.ImageRepo, opts.CurrentTag),
+	}
+	cacheFrom = append(cacheFrom, opts.CacheFrom...)
+
+	// multi-platform builds read the cache from the registry, since the local daemon only holds a single platform
+	if opts.PullCache && !opts.IsMultiPlatform() {
+		cacheFrom = a.pullCacheImages(ctx, cacheFrom)
+	}
+
+	if opts.IsMultiPlatform() {
+		return a.buildWithBuildx(ctx, opts, cacheFrom)
+	}
+
+	if opts.useBuildKit() {
+		return a.buildWithBuildKit(ctx, opts, cacheFrom)
+	}
+
+	tar, dockerfilePath, err := buildContextTar(opts)
+	if err != nil {
+		return err
+	}
+
+	buildArgs := make(map[string]*string)
+
+	for key, val := range opts.Env {
+		valCopy := val
+		buildArgs[key] = &valCopy
+	}
+
+	// attach BUILDKIT_INLINE_CACHE=1 by default, to take advantage of caching
+	inlineCacheVal := "1"
+	buildArgs["BUILDKIT_INLINE_CACHE"] = &inlineCacheVal
+
+	out, err := a.ImageBuild(ctx, tar, types.ImageBuildOptions{
+		Dockerfile: dockerfilePath,
+		BuildArgs:  buildArgs,
+		Tags: []string{
+			fmt.Sprintf("%s:%s", opts.ImageRepo, opts.Tag),
+		},
+		CacheFrom: cacheFrom,
+		Remove:    true,
+		Platform:  opts.platform(),
+	})
+	if err != nil {
+		return err
+	}
+
+	defer out.Body.Close()
+
+	termFd, isTerm := term.GetFdInfo(os.Stderr)
+
+	// progress bars are only rendered when the output is not copied to a log writer
+	if opts.LogWriter != nil {
+		isTerm = false
+	}
+
+	return jsonmessage.DisplayJSONMessagesStream(out.Body, opts.Output(), termFd, isTerm, nil)
+}
+
+// BuildContextArchive returns the build context of a build as a gzipped tar, excluding the files matched by .dockerignore, along
+// with the path of the Dockerfile in the archive. A Dockerfile outside of the build context is added to the archive.
+func BuildContextArchive(opts *BuildOpts) (io.ReadCloser, string, error) {
+	tar, dockerfilePath, err := buildContextTar(opts)
+	if err != nil {
+		return nil, "", err
+	}
+
+	reader, writer := io.Pipe()
+
+	go func() {
+		defer tar.Close()
+
+		gz := gzip.NewWriter(writer)
+		_, err := io.Copy(gz, tar)
+		if closeErr := gz.Close(); err == nil {
+			err = closeErr
+		}
+		writer.CloseWithError(err) // nolint:errcheck
+	}()
+
+	return reader, dockerfilePath, nil
+}
+
+// buildContextTar returns the build context of a build as a tar, along with the path of the Dockerfile in the tar
+func buildContextTar(opts *BuildOpts) (io.ReadCloser, string, error) {
 	dockerfilePath := opts.DockerfilePath
 
 	// attempt to read dockerignore file and paths
 	dockerIgnoreBytes, _ := ioutil.ReadFile(".dockerignore")
 	var excludes []string
+	var err error
 
 	if len(dockerIgnoreBytes) != 0 {
 		excludes, err = dockerignore.ReadAll(bytes.NewBuffer(dockerIgnoreBytes))
 
 		if err != nil {
-			return err
+			return nil, "", err
 		}
 	}
 
@@ -54,57 +164,55 @@ func (a *Agent) BuildLocal(ctx context.Context, opts *BuildOpts) (err error) {
 		ExcludePatterns: excludes,
 	})
 	if err != nil {
-		return err
+		return nil, "", err
 	}
 
 	if !opts.IsDockerfileInCtx {
 		dockerfileCtx, err := os.Open(dockerfilePath)
 		if err != nil {
-			return errors.Errorf("unable to open Dockerfile: %v", err)
+			return nil, "", errors.Errorf("unable to open Dockerfile: %v", err)
 		}
 
-		defer dockerfileCtx.Close()
-
 		// add the dockerfile to the build context
 		tar, dockerfilePath, err = AddDockerfileToBuildContext(dockerfileCtx, tar)
 
 		if err != nil {
-			return err
+			return nil, "", err
 		}
 	}
 
-	buildArgs := make(map[string]*string)
+	return tar, dockerfilePath, nil
+}
 
-	for key, val := range opts.Env {
-		valCopy := val
-		buildArgs[key] = &valCopy
+// Output returns the writer for build output, which is stderr and the log writer if there is one
+func (opts *BuildOpts) Output() io.Writer {
+	if opts.LogWriter == nil {
+		return os.Stderr
 	}
 
-	// attach BUILDKIT_INLINE_CACHE=1 by default, to take advantage of caching
-	inlineCacheVal := "1"
-	buildArgs["BUILDKIT_INLINE_CACHE"] = &inlineCacheVal
+	return io.MultiWriter(os.Stderr, opts.LogWriter)
+}
 
-	out, err := a.ImageBuild(ctx, tar, types.ImageBuildOptions{
-		Dockerfile: dockerfilePath,
-		BuildArgs:  buildArgs,
-		Tags: []string{
-			fmt.Sprintf("%s:%s", opts.ImageRepo, opts.Tag),
-		},
-		CacheFrom: []string{
-			fmt.Sprintf("%s:%s", opts.ImageRepo, opts.CurrentTag),
-		},
-		Remove:   true,
-		Platform: "linux/amd64",
-	})
-	if err != nil {
-		return err
-	}
+// pullCacheImages pulls each of the cache images, and returns the ones which are available locally. A missing cache
+// image is expected on the first build for a repository, so it is skipped rather than failing the build.
+func (a *Agent) pullCacheImages(ctx context.Context, images []string) []string {
+	pulled := make([]string, 0, len(images))
 
-	defer out.Body.Close()
+	for _, image := range images {
+		if strings.HasSuffix(image, ":") {
+			continue
+		}
 
-	termFd, isTerm := term.GetFdInfo(os.Stderr)
+		err := a.PullImage(ctx, image)
+		if err != nil {
+			fmt.Fprintf(os.Stderr, "skipping cache image %s: %s\n", image, err.Error())
+			continue
+		}
+
+		pulled = append(pulled, image)
+	}
 
-	return jsonmessage.DisplayJSONMessagesStream(out.Body, os.Stderr, termFd, isTerm, nil)
+	return pulled
 }
 
 func trimBuildFilesFromExcludes(excludes []string, dockerfile string) []string {
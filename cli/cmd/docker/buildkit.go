@@ -0,0 +1,104 @@
+package docker
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"sort"
+)
+
+// BuildSecret is a secret which is mounted into a BuildKit build with `RUN --mount=type=secret,id=<ID>`,
+// without being stored in any image layer
+type BuildSecret struct {
+	// ID is the id used to reference the secret in the Dockerfile
+	ID string
+	// Src is the path to a file containing the secret
+	Src string
+	// Env is the name of an environment variable containing the secret, used if Src is empty
+	Env string
+}
+
+// useBuildKit returns true if the build requires features that are only available in BuildKit
+func (opts *BuildOpts) useBuildKit() bool {
+	return opts.BuildKit || len(opts.Secrets) > 0 || len(opts.SSH) > 0
+}
+
+// buildWithBuildKit builds the image with BuildKit through the docker CLI, which manages the session used to expose
+// secrets and ssh agents to the daemon. The resulting image is loaded into the local daemon so that it can be pushed.
+func (a *Agent) buildWithBuildKit(ctx context.Context, opts *BuildOpts, cacheFrom []string) error {
+	args := []string{
+		"build",
+		"--tag", fmt.Sprintf("%s:%s", opts.ImageRepo, opts.Tag),
+		"--platform", opts.platform(),
+		"--build-arg", "BUILDKIT_INLINE_CACHE=1",
+	}
+
+	buildArgs, err := buildKitArgs(opts, cacheFrom)
+	if err != nil {
+		return err
+	}
+
+	args = append(args, buildArgs...)
+
+	cmd := exec.CommandContext(ctx, "docker", args...)
+	cmd.Env = append(os.Environ(), "DOCKER_BUILDKIT=1")
+	cmd.Stdout = opts.Output()
+	cmd.Stderr = opts.Output()
+
+	err = cmd.Run()
+	if err != nil {
+		return fmt.Errorf("error running buildkit build: %w", err)
+	}
+
+	return nil
+}
+
+// buildKitArgs returns the arguments shared by BuildKit and buildx builds: the dockerfile, build args, cache images,
+// secrets and ssh agents, followed by the build context
+func buildKitArgs(opts *BuildOpts, cacheFrom []string) ([]string, error) {
+	dockerfilePath := opts.DockerfilePath
+	if opts.IsDockerfileInCtx && !filepath.IsAbs(dockerfilePath) {
+		dockerfilePath = filepath.Join(opts.BuildContext, dockerfilePath)
+	}
+
+	args := []string{"--file", dockerfilePath}
+
+	envKeys := make([]string, 0, len(opts.Env))
+	for key := range opts.Env {
+		envKeys = append(envKeys, key)
+	}
+	sort.Strings(envKeys)
+
+	for _, key := range envKeys {
+		args = append(args, "--build-arg", fmt.Sprintf("%s=%s", key, opts.Env[key]))
+	}
+
+	for _, image := range cacheFrom {
+		args = append(args, "--cache-from", image)
+	}
+
+	for _, secret := range opts.Secrets {
+		if secret.ID == "" {
+			return nil, fmt.Errorf("build secret is missing an id")
+		}
+
+		switch {
+		case secret.Src != "":
+			args = append(args, "--secret", fmt.Sprintf("id=%s,src=%s", secret.ID, secret.Src))
+		case secret.Env != "":
+			args = append(args, "--secret", fmt.Sprintf("id=%s,env=%s", secret.ID, secret.Env))
+		default:
+			return nil, fmt.Errorf("build secret %s must specify a src file or env variable", secret.ID)
+		}
+	}
+
+	for _, spec := range opts.SSH {
+		args = append(args, "--ssh", spec)
+	}
+
+	args = append(args, opts.BuildContext)
+
+	return args, nil
+}
@@ -0,0 +1,258 @@
+package docker
+
+import (
+	"bytes"
+	"context"
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"io"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"strings"
+
+	"github.com/docker/distribution/reference"
+)
+
+const (
+	// defaultPlatform is the platform images are built for when no platforms are specified
+	defaultPlatform = "linux/amd64"
+
+	// buildxBuilderName is the name of the buildx builder used for multi-platform builds. The default docker driver cannot
+	// build for multiple platforms, so a builder using the docker-container driver is created if it does not exist.
+	buildxBuilderName = "porter-multiplatform"
+)
+
+// platform returns the platform to build a single-platform image for
+func (opts *BuildOpts) platform() string {
+	if len(opts.Platforms) == 1 {
+		return opts.Platforms[0]
+	}
+
+	return defaultPlatform
+}
+
+// IsMultiPlatform returns true if the image is built for more than one platform. Multi-platform images are pushed
+// by the build as a manifest list, since they cannot be loaded into the local daemon.
+func (opts *BuildOpts) IsMultiPlatform() bool {
+	return len(opts.Platforms) > 1
+}
+
+// buildWithBuildx builds the image for each of the platforms with buildx, and pushes a single manifest list which references
+// the image for each platform
+func (a *Agent) buildWithBuildx(ctx context.Context, opts *BuildOpts, cacheFrom []string) error {
+	image := fmt.Sprintf("%s:%s", opts.ImageRepo, opts.Tag)
+
+	args := []string{
+		"build",
+		"--builder", buildxBuilderName,
+		"--tag", image,
+		"--platform", strings.Join(opts.Platforms, ","),
+		"--cache-to", "type=inline",
+		"--push",
+	}
+
+	buildArgs, err := buildKitArgs(opts, cacheFrom)
+	if err != nil {
+		return err
+	}
+
+	args = append(args, buildArgs...)
+
+	env, cleanup, err := a.buildxEnv(ctx, image)
+	if err != nil {
+		return err
+	}
+	defer cleanup()
+
+	err = ensureBuildxBuilder(ctx, env)
+	if err != nil {
+		return err
+	}
+
+	err = runBuildx(ctx, env, opts.Output(), args...)
+	if err != nil {
+		return fmt.Errorf("error running multi-platform build for %s, emulation for other platforms may need to be installed with `docker run --privileged --rm tonistiigi/binfmt --install all`: %w", strings.Join(opts.Platforms, ","), err)
+	}
+
+	return nil
+}
+
+// TagRemoteImage tags an image in a registry without pulling it, which copies every platform of a multi-platform image
+func (a *Agent) TagRemoteImage(ctx context.Context, src, dst string) error {
+	env, cleanup, err := a.buildxEnv(ctx, dst)
+	if err != nil {
+		return err
+	}
+	defer cleanup()
+
+	err = runBuildx(ctx, env, os.Stderr, "imagetools", "create", "--tag", dst, src)
+	if err != nil {
+		return fmt.Errorf("error tagging %s as %s: %w", src, dst, err)
+	}
+
+	return nil
+}
+
+// ImageDigest returns the digest of an image in a registry, such as sha256:abc. The digest of a multi-platform image is the
+// digest of its index.
+func (a *Agent) ImageDigest(ctx context.Context, image string) (string, error) {
+	env, cleanup, err := a.buildxEnv(ctx, image)
+	if err != nil {
+		return "", err
+	}
+	defer cleanup()
+
+	out, err := inspectBuildx(ctx, env, "imagetools", "inspect", "--format", "{{.Manifest.Digest}}", image)
+	if err != nil {
+		return "", fmt.Errorf("error inspecting %s: %w", image, err)
+	}
+
+	return strings.TrimSpace(out), nil
+}
+
+// RawManifest returns the manifest of an image in a registry, as JSON
+func (a *Agent) RawManifest(ctx context.Context, image string) ([]byte, error) {
+	env, cleanup, err := a.buildxEnv(ctx, image)
+	if err != nil {
+		return nil, err
+	}
+	defer cleanup()
+
+	out, err := inspectBuildx(ctx, env, "imagetools", "inspect", "--raw", image)
+	if err != nil {
+		return nil, fmt.Errorf("error inspecting %s: %w", image, err)
+	}
+
+	return []byte(out), nil
+}
+
+// RegistryEnv returns the environment for commands other than docker which read the registry credentials for an image from the
+// docker config, such as cosign. The cleanup function must be called once the commands have run.
+func (a *Agent) RegistryEnv(ctx context.Context, image string) ([]string, func(), error) {
+	return a.buildxEnv(ctx, image)
+}
+
+// inspectBuildx runs a buildx command and returns its output
+func inspectBuildx(ctx context.Context, env []string, args ...string) (string, error) {
+	var stdout, stderr bytes.Buffer
+
+	cmd := exec.CommandContext(ctx, "docker", append([]string{"buildx"}, args...)...)
+	cmd.Env = env
+	cmd.Stdout = &stdout
+	cmd.Stderr = &stderr
+
+	if err := cmd.Run(); err != nil {
+		if msg := strings.TrimSpace(stderr.String()); msg != "" {
+			return "", fmt.Errorf("%w: %s", err, msg)
+		}
+		return "", err
+	}
+
+	return stdout.String(), nil
+}
+
+// ensureBuildxBuilder creates the builder used for multi-platform builds if it does not exist
+func ensureBuildxBuilder(ctx context.Context, env []string) error {
+	inspect := exec.CommandContext(ctx, "docker", "buildx", "inspect", buildxBuilderName)
+	inspect.Env = env
+
+	if err := inspect.Run(); err == nil {
+		return nil
+	}
+
+	err := runBuildx(ctx, env, os.Stderr, "create", "--name", buildxBuilderName, "--driver", "docker-container")
+	if err != nil {
+		return fmt.Errorf("error creating buildx builder: %w", err)
+	}
+
+	return nil
+}
+
+func runBuildx(ctx context.Context, env []string, out io.Writer, args ...string) error {
+	cmd := exec.CommandContext(ctx, "docker", append([]string{"buildx"}, args...)...)
+	cmd.Env = env
+	cmd.Stdout = out
+	cmd.Stderr = out
+
+	return cmd.Run()
+}
+
+// buildxEnv returns the environment for buildx commands which push the image. Since buildx pushes with the credentials in
+// the docker config rather than through the daemon, a temporary docker config is written with short-lived credentials
+// for the registry of the image. The buildx config is kept in its usual location, so that builders are reused between builds.
+func (a *Agent) buildxEnv(ctx context.Context, image string) ([]string, func(), error) {
+	env := os.Environ()
+	cleanup := func() {}
+
+	// without an auth getter, the credentials in the host's docker config are used
+	if a.authGetter == nil {
+		return env, cleanup, nil
+	}
+
+	dockerConfigDir := os.Getenv("DOCKER_CONFIG")
+	if dockerConfigDir == "" {
+		home, err := os.UserHomeDir()
+		if err != nil {
+			return nil, nil, fmt.Errorf("error getting home directory: %w", err)
+		}
+
+		dockerConfigDir = filepath.Join(home, ".docker")
+	}
+
+	buildxConfigDir := os.Getenv("BUILDX_CONFIG")
+	if buildxConfigDir == "" {
+		buildxConfigDir = filepath.Join(dockerConfigDir, "buildx")
+	}
+
+	serverURL, err := GetServerURLFromTag(image)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	user, secret, err := a.authGetter.GetCredentials(ctx, serverURL)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	named, err := reference.ParseNormalizedNamed(image)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	registryHost := reference.Domain(named)
+	if registryHost == "docker.io" {
+		registryHost = "https://index.docker.io/v1/"
+	}
+
+	configBytes, err := json.Marshal(map[string]interface{}{
+		"auths": map[string]interface{}{
+			registryHost: map[string]string{
+				"auth": base64.StdEncoding.EncodeToString([]byte(fmt.Sprintf("%s:%s", user, secret))),
+			},
+		},
+	})
+	if err != nil {
+		return nil, nil, fmt.Errorf("unable to marshal docker config: %w", err)
+	}
+
+	tmpDir, err := os.MkdirTemp("", "porter-buildx-")
+	if err != nil {
+		return nil, nil, fmt.Errorf("error creating docker config directory: %w", err)
+	}
+
+	cleanup = func() {
+		os.RemoveAll(tmpDir) //nolint:errcheck,gosec
+	}
+
+	err = os.WriteFile(filepath.Join(tmpDir, "config.json"), configBytes, 0o600)
+	if err != nil {
+		cleanup()
+		return nil, nil, fmt.Errorf("error writing docker config: %w", err)
+	}
+
+	env = append(env, fmt.Sprintf("DOCKER_CONFIG=%s", tmpDir), fmt.Sprintf("BUILDX_CONFIG=%s", buildxConfigDir))
+
+	return env, cleanup, nil
+}
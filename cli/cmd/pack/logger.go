@@ -5,7 +5,6 @@ import (
 	"io"
 	"io/ioutil"
 	"log"
-	"os"
 	"strings"
 
 	"github.com/buildpacks/pack/pkg/logging"
@@ -18,9 +17,9 @@ type packLogger struct {
 }
 
 // Replicate the exact behavior of https://github.com/buildpacks/pack/blob/main/pkg/logging/logger_simple.go
-func newPackLogger() logging.Logger {
+func newPackLogger(out io.Writer) logging.Logger {
 	discard := log.New(ioutil.Discard, "", log.LstdFlags|log.Lmicroseconds)
-	stderr := log.New(os.Stderr, "", log.LstdFlags|log.Lmicroseconds)
+	stderr := log.New(out, "", log.LstdFlags|log.Lmicroseconds)
 
 	return &packLogger{
 		outDiscard: discard,
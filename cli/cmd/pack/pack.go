@@ -5,6 +5,7 @@ import (
 	"fmt"
 	"io/ioutil"
 	"net/url"
+	"os"
 	"path/filepath"
 	"regexp"
 	"strings"
@@ -22,7 +23,7 @@ var sharedPackClient *packclient.Client
 func init() {
 	var err error
 	// initialize a pack client
-	logger := newPackLogger()
+	logger := newPackLogger(os.Stderr)
 
 	sharedPackClient, err = packclient.NewClient(packclient.WithLogger(logger))
 
@@ -57,6 +58,15 @@ func (a *Agent) Build(ctx context.Context, opts *docker.BuildOpts, buildConfig *
 
 	if buildConfig != nil {
 		buildOpts.Builder = buildConfig.Builder
+		buildOpts.RunImage = buildConfig.RunImage
+		buildOpts.LifecycleImage = buildConfig.LifecycleImage
+		if buildConfig.TrustBuilder != nil {
+			trusted := *buildConfig.TrustBuilder
+			buildOpts.TrustBuilder = func(string) bool { return trusted }
+		} else if buildConfig.LifecycleImage != "" {
+			// the lifecycle image is only used when the builder is not trusted
+			buildOpts.TrustBuilder = func(string) bool { return false }
+		}
 		for i := range buildConfig.Buildpacks {
 			bp := buildConfig.Buildpacks[i]
 			if bp == "" {
@@ -144,5 +154,13 @@ func (a *Agent) Build(ctx context.Context, opts *docker.BuildOpts, buildConfig *
 		buildOpts.Buildpacks = append(buildOpts.Buildpacks, "heroku/procfile@1.0.1")
 	}
 
-	return sharedPackClient.Build(ctx, buildOpts)
+	packClient := sharedPackClient
+	if opts.LogWriter != nil {
+		packClient, err = packclient.NewClient(packclient.WithLogger(newPackLogger(opts.Output())))
+		if err != nil {
+			return fmt.Errorf("error creating pack client: %w", err)
+		}
+	}
+
+	return packClient.Build(ctx, buildOpts)
 }
@@ -0,0 +1,426 @@
+package porter_app
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+
+	v2 "github.com/porter-dev/porter/internal/porter_app/v2"
+	"gopkg.in/yaml.v2"
+	"k8s.io/apimachinery/pkg/api/resource"
+)
+
+// MigrationWarning is a part of a v1 porter.yaml which has no exact equivalent in v2, and was changed or dropped by the migration
+type MigrationWarning struct {
+	Field   string
+	Message string
+}
+
+// the dashboard writes these timings for every probe of a v1 web service, so they are not reported when they are dropped
+const (
+	defaultV1ProbeFailureThreshold = 3
+	defaultV1ProbePeriodSeconds    = 5
+)
+
+// MigrateToV2 converts a legacy v1 porter.yaml or stack config to a v2 porter.yaml, along with warnings for the parts of the
+// file which were changed or dropped. If the v1 file defines several applications, application selects the application
+// which is migrated. appName is the name of the app in the v2 file, and defaults to the name of the migrated application.
+func MigrateToV2(ctx context.Context, raw []byte, application string, appName string) ([]byte, []MigrationWarning, error) {
+	parsed := &PorterStackYAML{}
+	err := yaml.Unmarshal(raw, parsed)
+	if err != nil {
+		return nil, nil, fmt.Errorf("error parsing porter.yaml: %w", err)
+	}
+
+	if parsed.Version != nil && *parsed.Version == "v2" {
+		return nil, nil, errors.New("porter.yaml is already a v2 porter.yaml")
+	}
+
+	m := &migrator{}
+
+	services := mergeServices(parsed.Apps, parsed.Services)
+	build, env, release := parsed.Build, parsed.Env, parsed.Release
+	prefix := ""
+
+	if len(parsed.Applications) > 0 {
+		name := application
+		if name == "" {
+			if len(parsed.Applications) > 1 {
+				return nil, nil, fmt.Errorf("porter.yaml defines the applications %s, pass the name of the application to migrate", strings.Join(sortedKeys(parsed.Applications), ", "))
+			}
+			name = sortedKeys(parsed.Applications)[0]
+		}
+
+		selected, ok := parsed.Applications[name]
+		if !ok || selected == nil {
+			return nil, nil, fmt.Errorf("porter.yaml does not define the application %s", name)
+		}
+
+		if appName == "" {
+			appName = name
+		}
+		prefix = fmt.Sprintf("applications.%s.", name)
+		services = selected.Services
+		env = mergeStringMaps(env, selected.Env)
+		if selected.Build != nil {
+			build = selected.Build
+		}
+		if selected.Release != nil {
+			release = selected.Release
+		}
+	}
+
+	migrated := v2.PorterYAML{
+		Name:     appName,
+		Services: make(map[string]v2.Service),
+	}
+	if len(env) > 0 {
+		migrated.Env = make(map[string]v2.EnvValue, len(env))
+		for key, value := range env {
+			migrated.Env[key] = v2.EnvValue{Value: value}
+		}
+	}
+
+	if appName == "" {
+		m.warn("name", "v1 porter.yaml files do not name the app, add the name of the app before applying the file")
+	}
+
+	m.build(prefix+"build", build, &migrated)
+
+	if len(parsed.SyncedEnv) > 0 {
+		var names []string
+		for _, section := range parsed.SyncedEnv {
+			names = append(names, section.Name)
+		}
+		m.warn("synced_env", fmt.Sprintf("env groups are not set in v2 porter.yaml files, attach the env groups %s to the app in the dashboard", strings.Join(names, ", ")))
+	}
+
+	for _, name := range sortedKeys(services) {
+		if services[name] == nil {
+			continue
+		}
+		migrated.Services[name] = m.service(fmt.Sprintf("%sservices.%s", prefix, name), name, services[name])
+	}
+
+	if release != nil {
+		predeploy := m.service(prefix+"release", "job", release)
+		migrated.Predeploy = v2.PredeploySteps{{Service: predeploy}}
+	}
+
+	out, err := v2.MarshalPorterYAML(migrated)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	// problems with the migrated file are reported rather than returned, so that they can be fixed in the written file
+	for _, validationErr := range v2.ValidateYAML(ctx, out) {
+		m.warn(validationErr.Field, fmt.Sprintf("the migrated value is not valid: %s", validationErr.Message))
+	}
+
+	return out, m.warnings, nil
+}
+
+type migrator struct {
+	warnings []MigrationWarning
+}
+
+func (m *migrator) warn(field, message string) {
+	m.warnings = append(m.warnings, MigrationWarning{Field: field, Message: message})
+}
+
+func (m *migrator) build(field string, build *Build, migrated *v2.PorterYAML) {
+	if build == nil {
+		return
+	}
+
+	switch build.GetMethod() {
+	case "registry":
+		repository, tag := splitImage(build.GetImage())
+		migrated.Image = &v2.Image{Repository: repository, Tag: tag}
+	case "pack", "docker":
+		migrated.Build = &v2.Build{
+			Context:    build.GetContext(),
+			Method:     build.GetMethod(),
+			Builder:    build.GetBuilder(),
+			Buildpacks: build.GetBuildpacks(),
+			Dockerfile: build.GetDockerfile(),
+		}
+	default:
+		m.warn(field+".method", fmt.Sprintf("unknown build method '%s', the build was dropped", build.GetMethod()))
+	}
+}
+
+// service converts a v1 service, whose settings are the helm values of its chart, to a v2 service
+func (m *migrator) service(field, name string, service *Service) v2.Service {
+	migrated := v2.Service{
+		Type: v1ServiceType(name, service),
+	}
+	if service.Run != nil {
+		migrated.Run = *service.Run
+	}
+
+	config, _ := convertMap(service.Config).(map[string]interface{})
+	for _, key := range sortedKeys(config) {
+		value := config[key]
+		keyField := fmt.Sprintf("%s.config.%s", field, key)
+
+		switch key {
+		case "replicaCount":
+			migrated.Instances = m.integer(keyField, value)
+		case "resources":
+			m.resources(keyField, value, &migrated)
+		case "container":
+			m.container(keyField, value, &migrated)
+		case "autoscaling":
+			m.autoscaling(keyField, value, &migrated)
+		case "ingress":
+			m.ingress(keyField, value, &migrated)
+		case "health":
+			m.health(keyField, value, &migrated)
+		case "schedule":
+			schedule := asMap(value)
+			cron, _ := schedule["value"].(string)
+			migrated.Cron = cron
+			if enabled, ok := schedule["enabled"].(bool); ok && !enabled && cron != "" {
+				migrated.Suspended = true
+			}
+		case "allowConcurrent":
+			migrated.AllowConcurrent, _ = value.(bool)
+		case "service", "paused":
+			// the service port is the container port in v2, and porter apply always runs the predeploy job
+		case "cloudsql":
+			if enabled, _ := asMap(value)["enabled"].(bool); enabled {
+				m.warn(keyField, "the Cloud SQL proxy sidecar has no equivalent in v2 porter.yaml files and was dropped")
+			}
+		default:
+			m.warn(keyField, "has no equivalent in v2 porter.yaml files and was dropped")
+		}
+	}
+
+	return migrated
+}
+
+func (m *migrator) resources(field string, value interface{}, migrated *v2.Service) {
+	resources := asMap(value)
+
+	requests := asMap(resources["requests"])
+	if cpu, ok := requests["cpu"]; ok {
+		quantity, err := resource.ParseQuantity(fmt.Sprint(cpu))
+		if err != nil {
+			m.warn(field+".requests.cpu", fmt.Sprintf("'%v' is not a valid cpu quantity and was dropped", cpu))
+		} else {
+			migrated.CpuCores = float32(quantity.MilliValue()) / 1000
+		}
+	}
+	if memory, ok := requests["memory"]; ok {
+		quantity, err := resource.ParseQuantity(fmt.Sprint(memory))
+		if err != nil {
+			m.warn(field+".requests.memory", fmt.Sprintf("'%v' is not a valid memory quantity and was dropped", memory))
+		} else {
+			migrated.RamMegabytes = int(quantity.Value() / (1024 * 1024))
+		}
+	}
+
+	if _, ok := resources["limits"]; ok {
+		m.warn(field+".limits", "resource limits are set by Porter from cpuCores and ramMegabytes in v2, and were dropped")
+	}
+}
+
+func (m *migrator) container(field string, value interface{}, migrated *v2.Service) {
+	container := asMap(value)
+
+	for _, key := range sortedKeys(container) {
+		switch key {
+		case "command":
+			if command, _ := container[key].(string); command != "" && migrated.Run == "" {
+				migrated.Run = command
+			}
+		case "port":
+			migrated.Port = m.integer(field+".port", container[key])
+		case "env":
+			m.warn(field+".env", "the env of a service is not supported in v2 porter.yaml files, move it to the env of the app")
+		default:
+			m.warn(fmt.Sprintf("%s.%s", field, key), "has no equivalent in v2 porter.yaml files and was dropped")
+		}
+	}
+}
+
+func (m *migrator) autoscaling(field string, value interface{}, migrated *v2.Service) {
+	autoscaling := asMap(value)
+	if enabled, _ := autoscaling["enabled"].(bool); !enabled {
+		return
+	}
+
+	if migrated.Type == "job" {
+		m.warn(field, "jobs cannot be autoscaled, the autoscaling settings were dropped")
+		return
+	}
+
+	migrated.Autoscaling = &v2.AutoScaling{
+		Enabled:                true,
+		MinInstances:           m.integer(field+".minReplicas", autoscaling["minReplicas"]),
+		MaxInstances:           m.integer(field+".maxReplicas", autoscaling["maxReplicas"]),
+		CpuThresholdPercent:    m.integer(field+".targetCPUUtilizationPercentage", autoscaling["targetCPUUtilizationPercentage"]),
+		MemoryThresholdPercent: m.integer(field+".targetMemoryUtilizationPercentage", autoscaling["targetMemoryUtilizationPercentage"]),
+	}
+}
+
+func (m *migrator) ingress(field string, value interface{}, migrated *v2.Service) {
+	if migrated.Type != "web" {
+		return
+	}
+
+	ingress := asMap(value)
+	if enabled, ok := ingress["enabled"].(bool); ok && !enabled {
+		migrated.Internal = true
+		return
+	}
+
+	var annotations map[string]string
+	for key, annotation := range asMap(ingress["annotations"]) {
+		if annotations == nil {
+			annotations = make(map[string]string)
+		}
+		annotations[key] = fmt.Sprint(annotation)
+	}
+
+	hosts, _ := ingress["hosts"].([]interface{})
+	for _, host := range hosts {
+		if name, _ := host.(string); name != "" {
+			migrated.Domains = append(migrated.Domains, v2.Domains{Name: name, Annotations: annotations})
+		}
+	}
+
+	if len(annotations) > 0 && len(migrated.Domains) == 0 {
+		m.warn(field+".annotations", "ingress annotations are set on custom domains in v2, and the service has no custom domains")
+	}
+
+	if paths, _ := ingress["custom_paths"].([]interface{}); len(paths) > 0 {
+		m.warn(field+".custom_paths", "custom paths are set with the pathPrefix of a domain in v2, and were dropped")
+	}
+}
+
+// health converts either the single health check of older v1 files, or the separate probes written by the dashboard
+func (m *migrator) health(field string, value interface{}, migrated *v2.Service) {
+	if migrated.Type == "job" {
+		return
+	}
+
+	health := asMap(value)
+	if path, ok := health["path"].(string); ok {
+		if enabled, _ := health["enabled"].(bool); enabled {
+			migrated.HealthCheck = &v2.HealthCheck{Enabled: true, HttpPath: path}
+		}
+		return
+	}
+
+	var httpPath string
+	for _, probeName := range []string{"readinessProbe", "livenessProbe", "startupProbe"} {
+		probe := asMap(health[probeName])
+		if enabled, _ := probe["enabled"].(bool); !enabled {
+			continue
+		}
+
+		probeField := fmt.Sprintf("%s.%s", field, probeName)
+		path, _ := probe["path"].(string)
+		if httpPath == "" {
+			httpPath = path
+		} else if path != httpPath {
+			m.warn(probeField+".path", fmt.Sprintf("v2 services have a single health check path, %s is used instead of %s", httpPath, path))
+		}
+
+		if threshold, ok := probe["failureThreshold"]; ok && m.integer(probeField+".failureThreshold", threshold) != defaultV1ProbeFailureThreshold {
+			m.warn(probeField+".failureThreshold", "probe timings are not supported in v2 porter.yaml files and were dropped")
+		}
+		if period, ok := probe["periodSeconds"]; ok && m.integer(probeField+".periodSeconds", period) != defaultV1ProbePeriodSeconds {
+			m.warn(probeField+".periodSeconds", "probe timings are not supported in v2 porter.yaml files and were dropped")
+		}
+		if delay, ok := probe["initialDelaySeconds"]; ok && m.integer(probeField+".initialDelaySeconds", delay) != 0 {
+			m.warn(probeField+".initialDelaySeconds", "probe timings are not supported in v2 porter.yaml files and were dropped")
+		}
+	}
+
+	if httpPath != "" {
+		migrated.HealthCheck = &v2.HealthCheck{Enabled: true, HttpPath: httpPath}
+	}
+}
+
+// integer reads a number from helm values, where the dashboard writes numbers as strings
+func (m *migrator) integer(field string, value interface{}) int {
+	switch v := value.(type) {
+	case nil:
+		return 0
+	case int:
+		return v
+	case float64:
+		return int(v)
+	case string:
+		if v == "" {
+			return 0
+		}
+
+		i, err := strconv.Atoi(v)
+		if err == nil {
+			return i
+		}
+	}
+
+	m.warn(field, fmt.Sprintf("'%v' is not a number and was dropped", value))
+	return 0
+}
+
+// v1ServiceType returns the type of a v1 service the same way as the server, which runs services without a type as workers
+func v1ServiceType(name string, service *Service) string {
+	if service.Type != nil {
+		return *service.Type
+	}
+	if strings.Contains(name, "web") {
+		return "web"
+	}
+	if strings.Contains(name, "job") {
+		return "job"
+	}
+
+	return "worker"
+}
+
+// mergeServices merges the services of the apps and services keys, which are both accepted by v1 files
+func mergeServices(apps, services map[string]*Service) map[string]*Service {
+	merged := make(map[string]*Service)
+	for name, service := range apps {
+		merged[name] = service
+	}
+	for name, service := range services {
+		merged[name] = service
+	}
+
+	return merged
+}
+
+// splitImage splits an image into its repository and tag, ignoring the port of the registry
+func splitImage(image string) (string, string) {
+	colon := strings.LastIndex(image, ":")
+	if colon == -1 || colon < strings.LastIndex(image, "/") {
+		return image, "latest"
+	}
+
+	return image[:colon], image[colon+1:]
+}
+
+func asMap(value interface{}) map[string]interface{} {
+	m, _ := value.(map[string]interface{})
+	return m
+}
+
+func sortedKeys[V any](m map[string]V) []string {
+	keys := make([]string, 0, len(m))
+	for key := range m {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+
+	return keys
+}
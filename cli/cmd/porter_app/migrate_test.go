@@ -0,0 +1,112 @@
+package porter_app
+
+import (
+	"context"
+	"testing"
+
+	"github.com/ghodss/yaml"
+	"github.com/matryer/is"
+
+	v2 "github.com/porter-dev/porter/internal/porter_app/v2"
+)
+
+const v1PorterYAML = `
+version: v1stack
+build:
+  method: registry
+  image: my-registry.io:5000/my-app:v1
+env:
+  PORT: "8080"
+apps:
+  web:
+    run: node index.js
+    config:
+      replicaCount: "2"
+      resources:
+        requests:
+          cpu: 250m
+          memory: 512Mi
+      container:
+        port: "8080"
+      ingress:
+        hosts:
+          - example.com
+      health:
+        enabled: true
+        path: /healthz
+      pvc:
+        enabled: true
+  worker:
+    config:
+      container:
+        command: node worker.js
+release:
+  run: node migrate.js
+`
+
+func TestMigrateToV2(t *testing.T) {
+	is := is.New(t)
+
+	out, warnings, err := MigrateToV2(context.Background(), []byte(v1PorterYAML), "", "my-app")
+	is.NoErr(err)
+
+	migrated := v2.PorterYAML{}
+	err = yaml.Unmarshal(out, &migrated)
+	is.NoErr(err)
+
+	is.Equal(migrated.Name, "my-app")
+	is.Equal(migrated.Image.Repository, "my-registry.io:5000/my-app")
+	is.Equal(migrated.Image.Tag, "v1")
+	is.Equal(migrated.Build, nil)
+	is.Equal(len(migrated.Predeploy), 1)
+	is.Equal(migrated.Predeploy[0].Run, "node migrate.js")
+	is.Equal(migrated.Predeploy[0].Type, "job")
+
+	web := migrated.Services["web"]
+	is.Equal(web.Type, "web")
+	is.Equal(web.Instances, 2)
+	is.Equal(web.CpuCores, float32(0.25))
+	is.Equal(web.RamMegabytes, 512)
+	is.Equal(web.Port, 8080)
+	is.Equal(len(web.Domains), 1)
+	is.Equal(web.Domains[0].Name, "example.com")
+	is.Equal(web.HealthCheck.HttpPath, "/healthz")
+
+	worker := migrated.Services["worker"]
+	is.Equal(worker.Type, "worker")
+	is.Equal(worker.Run, "node worker.js")
+
+	is.Equal(warnings, []MigrationWarning{
+		{Field: "services.web.config.pvc", Message: "has no equivalent in v2 porter.yaml files and was dropped"},
+	})
+}
+
+func TestMigrateToV2Applications(t *testing.T) {
+	is := is.New(t)
+
+	applications := `
+applications:
+  api:
+    services:
+      web:
+        run: node index.js
+  admin:
+    services:
+      web:
+        run: node admin.js
+`
+
+	_, _, err := MigrateToV2(context.Background(), []byte(applications), "", "")
+	is.True(err != nil)
+	is.Equal(err.Error(), "porter.yaml defines the applications admin, api, pass the name of the application to migrate")
+
+	out, _, err := MigrateToV2(context.Background(), []byte(applications), "admin", "")
+	is.NoErr(err)
+
+	migrated := v2.PorterYAML{}
+	err = yaml.Unmarshal(out, &migrated)
+	is.NoErr(err)
+
+	is.Equal(migrated.Name, "admin")
+	is.Equal(migrated.Services["web"].Run, "node admin.js")
+}
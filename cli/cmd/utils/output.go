@@ -0,0 +1,56 @@
+package utils
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"os"
+
+	"github.com/ghodss/yaml"
+)
+
+const (
+	// OutputFormatJSON prints the results of a command as indented JSON
+	OutputFormatJSON = "json"
+	// OutputFormatYAML prints the results of a command as YAML
+	OutputFormatYAML = "yaml"
+)
+
+// OutputFormat is the format which commands print their results in, set with the global --output flag. When it is
+// empty, commands print results for humans, usually as a table. The flag is not part of the default flag set, since the
+// flags in that set are saved to the config file.
+var OutputFormat string
+
+// IsStructuredOutput returns whether results should be printed with PrintStructured rather than for humans
+func IsStructuredOutput() bool {
+	return OutputFormat != ""
+}
+
+// PrintStructured prints a value to stdout in the output format. Values are marshaled by their json tags in both formats,
+// so the YAML output has the same keys as the JSON output.
+func PrintStructured(v interface{}) error {
+	return WriteStructured(os.Stdout, OutputFormat, v)
+}
+
+// WriteStructured writes a value to w as JSON or YAML
+func WriteStructured(w io.Writer, format string, v interface{}) error {
+	var out []byte
+	var err error
+
+	switch format {
+	case OutputFormatJSON:
+		out, err = json.MarshalIndent(v, "", "  ")
+		out = append(out, '\n')
+	case OutputFormatYAML:
+		out, err = yaml.Marshal(v)
+	default:
+		return fmt.Errorf("unsupported output format %q, must be %q or %q", format, OutputFormatJSON, OutputFormatYAML)
+	}
+
+	if err != nil {
+		return fmt.Errorf("error marshaling output: %w", err)
+	}
+
+	_, err = w.Write(out)
+	return err
+}
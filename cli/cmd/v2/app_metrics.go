@@ -0,0 +1,87 @@
+package v2
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"os"
+	"text/tabwriter"
+	"time"
+
+	api "github.com/porter-dev/porter/api/client"
+	"github.com/porter-dev/porter/api/server/handlers/porter_app"
+	"github.com/porter-dev/porter/cli/cmd/config"
+	"github.com/porter-dev/porter/internal/kubernetes/prometheus"
+)
+
+// AppMetrics implements the functionality of the `porter app metrics` command for validate apply v2 projects. It prints the latest,
+// average and peak value of each metric of the services of an app over the last since duration.
+func AppMetrics(ctx context.Context, cliConf config.CLIConfig, client api.Client, appName string, serviceName string, since time.Duration) error {
+	targetResp, err := client.DefaultDeploymentTarget(ctx, cliConf.Project, cliConf.Cluster)
+	if err != nil {
+		return fmt.Errorf("error calling default deployment target endpoint: %w", err)
+	}
+
+	if targetResp.DeploymentTargetID == "" {
+		return errors.New("deployment target id is empty")
+	}
+
+	now := time.Now()
+
+	resp, err := client.AppMetrics(ctx, cliConf.Project, cliConf.Cluster, appName, &porter_app.AppMetricsRequest{
+		DeploymentTargetID: targetResp.DeploymentTargetID,
+		Service:            serviceName,
+		StartRange:         now.Add(-since).Unix(),
+		EndRange:           now.Unix(),
+	})
+	if err != nil {
+		return fmt.Errorf("error getting app metrics: %w", err)
+	}
+
+	fmt.Printf("Metrics over the last %s:\n\n", since)
+
+	w := new(tabwriter.Writer)
+	w.Init(os.Stdout, 3, 8, 2, ' ', 0)
+
+	fmt.Fprintln(w, "SERVICE\tMETRIC\tLATEST\tAVERAGE\tMAX")
+	for _, service := range resp.Services {
+		for _, series := range service.Metrics {
+			if len(series.Points) == 0 {
+				fmt.Fprintf(w, "%s\t%s\t-\t-\t-\n", service.Name, series.Metric)
+				continue
+			}
+
+			var sum, peak float64
+			for i, point := range series.Points {
+				sum += point.Value
+				if i == 0 || point.Value > peak {
+					peak = point.Value
+				}
+			}
+
+			fmt.Fprintf(w, "%s\t%s\t%s\t%s\t%s\n",
+				service.Name,
+				series.Metric,
+				formatMetricValue(series.Metric, series.Points[len(series.Points)-1].Value),
+				formatMetricValue(series.Metric, sum/float64(len(series.Points))),
+				formatMetricValue(series.Metric, peak),
+			)
+		}
+	}
+
+	return w.Flush()
+}
+
+// formatMetricValue formats a value in the unit of its metric
+func formatMetricValue(metric prometheus.AppMetric, value float64) string {
+	switch metric.Unit() {
+	case "cores":
+		return fmt.Sprintf("%.3f cores", value)
+	case "bytes":
+		return fmt.Sprintf("%.1fMB", value/(1024*1024))
+	case "bytes_per_second":
+		return fmt.Sprintf("%.1fKB/s", value/1024)
+	default:
+		return fmt.Sprintf("%.0fms", value*1000)
+	}
+}
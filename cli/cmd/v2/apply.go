@@ -10,23 +10,55 @@ import (
 	"strconv"
 
 	"github.com/cli/cli/git"
+	"github.com/ghodss/yaml"
 
 	"github.com/fatih/color"
 	"github.com/porter-dev/api-contracts/generated/go/helpers"
 	porterv1 "github.com/porter-dev/api-contracts/generated/go/porter/v1"
 	api "github.com/porter-dev/porter/api/client"
+	"github.com/porter-dev/porter/api/types"
 	"github.com/porter-dev/porter/cli/cmd/config"
+	v2 "github.com/porter-dev/porter/internal/porter_app/v2"
 )
 
-// Apply implements the functionality of the `porter apply` command for validate apply v2 projects
-func Apply(ctx context.Context, cliConf config.CLIConfig, client api.Client, porterYamlPath string) error {
-	if len(porterYamlPath) == 0 {
+// Apply implements the functionality of the `porter apply` command for validate apply v2 projects. If previewName is set,
+// the app is deployed with the previews overrides from the porter yaml to an ephemeral deployment target with that name. If
+// several porter yaml files are passed, each file is merged over the files before it.
+//
+// The build and deploy phases can be run separately, for example in different CI jobs. If buildOnly is set, the app is built
+// and pushed with the image tag, but not deployed. If skipBuild is set, the app is deployed with an image which was already
+// pushed with the image tag. The image tag defaults to the commit SHA.
+//
+// Once applied, the rollout of the revision is tailed until it completes or fails, unless noWait is set. If showCost is set, the
+// estimated monthly cost of the validated app is printed before it is built and deployed.
+//
+// If changedOnly is set, the files changed since the commit of the current revision of the app are compared against the build
+// context of each image. Images with no changes are tagged from the current revision instead of being rebuilt, and the apply is
+// skipped if no image and no porter yaml file changed. Since every service of an app is deployed with the same image tag, all
+// services are still rolled out when any of them changed.
+//
+// If remoteBuild is set, the build context of each image is uploaded to Porter and built and pushed in the cluster of the app,
+// rather than with the local docker daemon.
+func Apply(ctx context.Context, cliConf config.CLIConfig, client api.Client, porterYamlPaths []string, previewName string, imageTag string, buildOnly bool, skipBuild bool, noWait bool, showCost bool, changedOnly bool, remoteBuild bool, allowedEnv []string) error {
+	if len(porterYamlPaths) == 0 {
 		return fmt.Errorf("porter yaml is empty")
 	}
 
-	porterYaml, err := os.ReadFile(filepath.Clean(porterYamlPath))
+	if buildOnly && skipBuild {
+		return errors.New("--build-only and --skip-build cannot be used together")
+	}
+
+	if changedOnly && skipBuild {
+		return errors.New("--changed-only and --skip-build cannot be used together")
+	}
+
+	if remoteBuild && skipBuild {
+		return errors.New("--remote-build and --skip-build cannot be used together")
+	}
+
+	porterYaml, err := readPorterYAML(porterYamlPaths, allowedEnv)
 	if err != nil {
-		return fmt.Errorf("could not read porter yaml file: %w", err)
+		return err
 	}
 
 	b64YAML := base64.StdEncoding.EncodeToString(porterYaml)
@@ -39,13 +71,32 @@ func Apply(ctx context.Context, cliConf config.CLIConfig, client api.Client, por
 	if parseResp.B64AppProto == "" {
 		return errors.New("b64 app proto is empty")
 	}
+	b64AppProto := parseResp.B64AppProto
 
-	targetResp, err := client.DefaultDeploymentTarget(ctx, cliConf.Project, cliConf.Cluster)
-	if err != nil {
-		return fmt.Errorf("error calling default deployment target endpoint: %w", err)
+	var deploymentTargetID string
+	if previewName != "" {
+		if parseResp.B64PreviewAppProto == "" {
+			return errors.New("porter yaml does not have a previews section")
+		}
+		b64AppProto = parseResp.B64PreviewAppProto
+
+		targetResp, err := client.PreviewDeploymentTarget(ctx, cliConf.Project, cliConf.Cluster, previewName)
+		if err != nil {
+			return fmt.Errorf("error calling preview deployment target endpoint: %w", err)
+		}
+
+		color.New(color.FgGreen).Printf("Deploying preview environment to namespace %s\n", targetResp.Namespace) // nolint:errcheck,gosec
+		deploymentTargetID = targetResp.DeploymentTargetID
+	} else {
+		targetResp, err := client.DefaultDeploymentTarget(ctx, cliConf.Project, cliConf.Cluster)
+		if err != nil {
+			return fmt.Errorf("error calling default deployment target endpoint: %w", err)
+		}
+
+		deploymentTargetID = targetResp.DeploymentTargetID
 	}
 
-	if targetResp.DeploymentTargetID == "" {
+	if deploymentTargetID == "" {
 		return errors.New("deployment target id is empty")
 	}
 
@@ -56,7 +107,16 @@ func Apply(ctx context.Context, cliConf config.CLIConfig, client api.Client, por
 		commitSHA = commit.Sha
 	}
 
-	validateResp, err := client.ValidatePorterApp(ctx, cliConf.Project, cliConf.Cluster, parseResp.B64AppProto, targetResp.DeploymentTargetID, commitSHA)
+	// the image tag is passed to the deploy phase as the commit SHA, which is used to tag the image of the app
+	if imageTag != "" {
+		commitSHA = imageTag
+	}
+
+	if (buildOnly || skipBuild) && commitSHA == "" {
+		return errors.New("Image tag cannot be identified. Please pass --image-tag, set the PORTER_COMMIT_SHA environment variable or run apply in git repository with access to the git CLI.")
+	}
+
+	validateResp, err := client.ValidatePorterApp(ctx, cliConf.Project, cliConf.Cluster, b64AppProto, deploymentTargetID, commitSHA)
 	if err != nil {
 		return fmt.Errorf("error calling validate endpoint: %w", err)
 	}
@@ -66,6 +126,52 @@ func Apply(ctx context.Context, cliConf config.CLIConfig, client api.Client, por
 	}
 	base64AppProto := validateResp.ValidatedBase64AppProto
 
+	if showCost {
+		printCostEstimate(validateResp.CostEstimate)
+	}
+
+	var changes *changeSet
+	if changedOnly {
+		appInput, err := createPorterAppDbEntryInputFromProtoAndEnv(base64AppProto)
+		if err != nil {
+			return fmt.Errorf("error reading app name from proto: %w", err)
+		}
+
+		// apps deployed from an existing image have nothing to build, so their changes are not compared
+		if appInput.ImageRepository == "" {
+			changes, err = changesSinceDeployedCommit(ctx, cliConf, client, appInput.AppName, deploymentTargetID)
+			if err != nil {
+				return err
+			}
+
+			if changes == nil {
+				color.New(color.FgYellow).Printf("Could not find the commit of the current revision of %s in this checkout, building all images\n", appInput.AppName) // nolint:errcheck,gosec
+			}
+		}
+
+		if changes != nil && !buildOnly {
+			buildInputs, err := appBuildInputs(ctx, cliConf, client, porterYaml, base64AppProto, deploymentTargetID)
+			if err != nil {
+				return err
+			}
+
+			if !changes.touches(porterYamlPaths...) && !anyBuildChanged(buildInputs, changes) {
+				color.New(color.FgGreen).Printf("Skipped deploy of %s: no changes to its images or porter.yaml since %s\n", appInput.AppName, changes.BaseCommit) // nolint:errcheck,gosec
+				return nil
+			}
+		}
+	}
+
+	if buildOnly {
+		err = buildFromAppProto(ctx, cliConf, client, porterYaml, base64AppProto, deploymentTargetID, changes, remoteBuild)
+		if err != nil {
+			return err
+		}
+
+		color.New(color.FgGreen).Printf("Successfully built and pushed image with tag %s, deploy it with porter apply --skip-build --image-tag %s\n", commitSHA, commitSHA) // nolint:errcheck,gosec
+		return nil
+	}
+
 	createPorterAppDBEntryInp, err := createPorterAppDbEntryInputFromProtoAndEnv(validateResp.ValidatedBase64AppProto)
 	if err != nil {
 		return fmt.Errorf("error creating porter app db entry input from proto: %w", err)
@@ -76,12 +182,26 @@ func Apply(ctx context.Context, cliConf config.CLIConfig, client api.Client, por
 		return fmt.Errorf("error creating porter app db entry: %w", err)
 	}
 
-	base64AppProtoWithSubdomains, err := addPorterSubdomainsIfNecessary(ctx, client, cliConf.Project, cliConf.Cluster, base64AppProto)
+	err = updateDeploySettingsFromYaml(ctx, cliConf, client, porterYaml, createPorterAppDBEntryInp.AppName)
+	if err != nil {
+		return err
+	}
+
+	gitBranch, gitRepoURL := gitSource()
+
+	base64AppProtoWithSubdomains, err := addPorterSubdomainsIfNecessary(ctx, client, cliConf.Project, cliConf.Cluster, deploymentTargetID, base64AppProto)
 	if err != nil {
 		return fmt.Errorf("error creating subdomains: %w", err)
 	}
 
-	applyResp, err := client.ApplyPorterApp(ctx, cliConf.Project, cliConf.Cluster, base64AppProtoWithSubdomains, targetResp.DeploymentTargetID, "")
+	// porter.yaml is the source of truth for the app, so it is applied over the current configuration of the app. The apply is
+	// only rejected if the app is updated after it is read here.
+	porterApp, err := client.GetPorterApp(ctx, cliConf.Project, cliConf.Cluster, createPorterAppDBEntryInp.AppName)
+	if err != nil {
+		return fmt.Errorf("error getting porter app: %w", err)
+	}
+
+	applyResp, err := client.ApplyPorterApp(ctx, cliConf.Project, cliConf.Cluster, base64AppProtoWithSubdomains, deploymentTargetID, "", "", commitSHA, gitBranch, gitRepoURL, types.AppConfigETag(porterApp.ConfigRevision))
 	if err != nil {
 		return fmt.Errorf("error calling apply endpoint: %w", err)
 	}
@@ -95,50 +215,94 @@ func Apply(ctx context.Context, cliConf config.CLIConfig, client api.Client, por
 			return errors.New("Build is required but commit SHA cannot be identified. Please set the PORTER_COMMIT_SHA environment variable or run apply in git repository with access to the git CLI.")
 		}
 
-		buildSettings, err := buildSettingsFromBase64AppProto(base64AppProto)
-		if err != nil {
-			return fmt.Errorf("error building settings from base64 app proto: %w", err)
+		if skipBuild {
+			color.New(color.FgGreen).Printf("Skipping build, deploying image with tag %s\n", commitSHA) // nolint:errcheck,gosec
+		} else {
+			err = buildFromAppProto(ctx, cliConf, client, porterYaml, base64AppProto, deploymentTargetID, changes, remoteBuild)
+			if err != nil {
+				return err
+			}
 		}
 
-		currentAppRevisionResp, err := client.CurrentAppRevision(ctx, cliConf.Project, cliConf.Cluster, buildSettings.AppName, targetResp.DeploymentTargetID)
+		applyResp, err = client.ApplyPorterApp(ctx, cliConf.Project, cliConf.Cluster, "", deploymentTargetID, applyResp.AppRevisionId, createPorterAppDBEntryInp.AppName, commitSHA, gitBranch, gitRepoURL, "")
 		if err != nil {
-			return fmt.Errorf("error getting current app revision: %w", err)
+			return fmt.Errorf("error calling apply endpoint after build: %w", err)
 		}
+	}
 
-		if currentAppRevisionResp == nil {
-			return errors.New("current app revision is nil")
-		}
+	if applyResp.CLIAction != porterv1.EnumCLIAction_ENUM_CLI_ACTION_NONE {
+		return fmt.Errorf("unexpected CLI action: %s", applyResp.CLIAction)
+	}
 
-		appRevision := currentAppRevisionResp.AppRevision
-		if appRevision.B64AppProto == "" {
-			return errors.New("current app revision b64 app proto is empty")
-		}
+	color.New(color.FgGreen).Printf("Successfully applied Porter YAML as revision %v, next action: %v\n", applyResp.AppRevisionId, applyResp.CLIAction) // nolint:errcheck,gosec
 
-		currentImageTag, err := imageTagFromBase64AppProto(appRevision.B64AppProto)
-		if err != nil {
-			return fmt.Errorf("error getting image tag from current app revision: %w", err)
-		}
+	if noWait {
+		return nil
+	}
+
+	return waitForRollout(ctx, cliConf, client, createPorterAppDBEntryInp.AppName, applyResp.AppRevisionId, deploymentTargetID)
+}
+
+// buildFromAppProto builds and pushes the images of a validated app, using the current revision of the app in the deployment
+// target as a layer cache if there is one. If changes is set, images with no changes since the current revision are reused.
+// If remote is set, the images are built in the cluster of the app.
+func buildFromAppProto(ctx context.Context, cliConf config.CLIConfig, client api.Client, porterYaml []byte, base64AppProto string, deploymentTargetID string, changes *changeSet, remote bool) error {
+	buildInputs, err := appBuildInputs(ctx, cliConf, client, porterYaml, base64AppProto, deploymentTargetID)
+	if err != nil {
+		return err
+	}
 
-		buildSettings.CurrentImageTag = currentImageTag
-		buildSettings.ProjectID = cliConf.Project
+	for i := range buildInputs {
+		buildInputs[i].Remote = remote
+	}
 
-		err = build(ctx, client, buildSettings)
+	if changes != nil {
+		buildInputs, err = reuseUnchangedBuilds(ctx, client, buildInputs, changes)
 		if err != nil {
-			return fmt.Errorf("error building app: %w", err)
+			return err
 		}
+	}
+
+	parallelism, err := buildParallelism()
+	if err != nil {
+		return err
+	}
+
+	err = buildConcurrently(ctx, client, buildInputs, parallelism)
+	if err != nil {
+		return fmt.Errorf("error building app: %w", err)
+	}
+
+	return nil
+}
+
+// appBuildInputs returns the app build of a validated app and the builds of its services which declare their own build
+func appBuildInputs(ctx context.Context, cliConf config.CLIConfig, client api.Client, porterYaml []byte, base64AppProto string, deploymentTargetID string) ([]buildInput, error) {
+	buildSettings, err := buildSettingsFromBase64AppProto(base64AppProto)
+	if err != nil {
+		return nil, fmt.Errorf("error building settings from base64 app proto: %w", err)
+	}
 
-		applyResp, err = client.ApplyPorterApp(ctx, cliConf.Project, cliConf.Cluster, "", "", applyResp.AppRevisionId)
+	// the app has no revision in the deployment target if it is built before it is first deployed
+	var currentImageTag string
+	currentAppRevisionResp, err := client.CurrentAppRevision(ctx, cliConf.Project, cliConf.Cluster, buildSettings.AppName, deploymentTargetID)
+	if err == nil && currentAppRevisionResp != nil && currentAppRevisionResp.AppRevision.B64AppProto != "" {
+		currentImageTag, err = imageTagFromBase64AppProto(currentAppRevisionResp.AppRevision.B64AppProto)
 		if err != nil {
-			return fmt.Errorf("error calling apply endpoint after build: %w", err)
+			return nil, fmt.Errorf("error getting image tag from current app revision: %w", err)
 		}
 	}
 
-	if applyResp.CLIAction != porterv1.EnumCLIAction_ENUM_CLI_ACTION_NONE {
-		return fmt.Errorf("unexpected CLI action: %s", applyResp.CLIAction)
+	buildSettings.CurrentImageTag = currentImageTag
+	buildSettings.ProjectID = cliConf.Project
+	buildSettings.ClusterID = cliConf.Cluster
+
+	buildInputs, err := buildInputsFromYaml(porterYaml, buildSettings)
+	if err != nil {
+		return nil, fmt.Errorf("error reading build settings from porter yaml: %w", err)
 	}
 
-	color.New(color.FgGreen).Printf("Successfully applied Porter YAML as revision %v, next action: %v\n", applyResp.AppRevisionId, applyResp.CLIAction) // nolint:errcheck,gosec
-	return nil
+	return buildInputs, nil
 }
 
 func createPorterAppDbEntryInputFromProtoAndEnv(base64AppProto string) (api.CreatePorterAppDBEntryInput, error) {
@@ -185,7 +349,33 @@ func createPorterAppDbEntryInputFromProtoAndEnv(base64AppProto string) (api.Crea
 	return input, fmt.Errorf("app does not contain build or image settings")
 }
 
-func addPorterSubdomainsIfNecessary(ctx context.Context, client api.Client, project uint, cluster uint, base64AppProto string) (string, error) {
+// updateDeploySettingsFromYaml updates the rollout settings of the app from the deploy section of a v2 porter.yaml. Settings
+// are left unchanged if the porter.yaml has no deploy section, so that they can be managed from the dashboard.
+func updateDeploySettingsFromYaml(ctx context.Context, cliConf config.CLIConfig, client api.Client, porterYaml []byte, appName string) error {
+	resolvedYaml, err := v2.ResolveReferences(porterYaml)
+	if err != nil {
+		return err
+	}
+
+	parsed := &v2.PorterYAML{}
+	err = yaml.Unmarshal(resolvedYaml, parsed)
+	if err != nil {
+		return fmt.Errorf("error parsing porter yaml: %w", err)
+	}
+
+	if parsed.Deploy == nil {
+		return nil
+	}
+
+	_, err = client.UpdateAutoRollback(ctx, cliConf.Project, cliConf.Cluster, appName, parsed.Deploy.AutoRollback, parsed.Deploy.RolloutTimeoutSeconds)
+	if err != nil {
+		return fmt.Errorf("error updating deploy settings: %w", err)
+	}
+
+	return nil
+}
+
+func addPorterSubdomainsIfNecessary(ctx context.Context, client api.Client, project uint, cluster uint, deploymentTargetID string, base64AppProto string) (string, error) {
 	var editedB64AppProto string
 
 	decoded, err := base64.StdEncoding.DecodeString(base64AppProto)
@@ -209,7 +399,7 @@ func addPorterSubdomainsIfNecessary(ctx context.Context, client api.Client, proj
 
 			if !webConfig.Private && len(webConfig.Domains) == 0 {
 				color.New(color.FgYellow).Printf("Service %s is public but does not contain any domains, creating Porter domain\n", serviceName) // nolint:errcheck,gosec
-				domain, err := client.CreateSubdomain(ctx, project, cluster, app.Name, serviceName)
+				domain, err := client.CreateSubdomain(ctx, project, cluster, app.Name, serviceName, deploymentTargetID)
 				if err != nil {
 					return editedB64AppProto, fmt.Errorf("error creating subdomain: %w", err)
 				}
@@ -299,3 +489,29 @@ func imageTagFromBase64AppProto(base64AppProto string) (string, error) {
 
 	return app.Image.Tag, nil
 }
+
+// readPorterYAML reads the porter.yaml files at porterYamlPaths, interpolating the env vars in allowedEnv into their values, and
+// merges each file over the files before it
+func readPorterYAML(porterYamlPaths []string, allowedEnv []string) ([]byte, error) {
+	porterYamls := make([][]byte, 0, len(porterYamlPaths))
+	for _, porterYamlPath := range porterYamlPaths {
+		porterYaml, err := os.ReadFile(filepath.Clean(porterYamlPath))
+		if err != nil {
+			return nil, fmt.Errorf("could not read porter yaml file: %w", err)
+		}
+
+		porterYaml, err = v2.InterpolateEnv(porterYaml, allowedEnv, os.LookupEnv)
+		if err != nil {
+			return nil, fmt.Errorf("error interpolating env vars into %s: %w", porterYamlPath, err)
+		}
+
+		porterYamls = append(porterYamls, porterYaml)
+	}
+
+	porterYaml, err := v2.MergePorterYAML(porterYamls...)
+	if err != nil {
+		return nil, fmt.Errorf("error merging porter yaml files: %w", err)
+	}
+
+	return porterYaml, nil
+}
@@ -4,12 +4,20 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"io"
 	"os"
 	"path/filepath"
+	"sort"
+	"strconv"
 	"strings"
+	"time"
+
+	"github.com/ghodss/yaml"
+	"golang.org/x/sync/errgroup"
 
 	"github.com/porter-dev/porter/api/types"
 	"github.com/porter-dev/porter/cli/cmd/pack"
+	v2 "github.com/porter-dev/porter/internal/porter_app/v2"
 
 	"github.com/porter-dev/porter/cli/cmd/docker"
 
@@ -19,11 +27,17 @@ import (
 const (
 	buildMethodPack   = "pack"
 	buildMethodDocker = "docker"
+
+	// defaultBuildParallelism is the number of images built at the same time when services declare their own builds
+	defaultBuildParallelism = 3
 )
 
 // buildInput is the input struct for the build method
 type buildInput struct {
 	ProjectID uint
+	ClusterID uint
+	// ServiceName is the name of the service being built, if the service declares its own build. It is empty for the app build.
+	ServiceName string
 	// AppName is the name of the application being built and is used to name the repository
 	AppName      string
 	BuildContext string
@@ -37,10 +51,37 @@ type buildInput struct {
 	// CurrentImageTag is used in docker build to cache from
 	CurrentImageTag string
 	RepositoryURL   string
+	// CacheFrom is a list of additional registry images to use as a layer cache in docker builds
+	CacheFrom []string
+	// CacheTag is a tag in the repository which is pulled as a cache before the build, and pushed after it
+	CacheTag string
+	// Secrets are mounted into docker builds without being stored in the image
+	Secrets []docker.BuildSecret
+	// SSH is a list of ssh agents or keys forwarded to docker builds
+	SSH []string
+	// Platforms are the platforms to build docker images for, defaulting to linux/amd64
+	Platforms []string
+	// Provenance configures the signed build provenance attestation of the image, if it is enabled
+	Provenance *v2.BuildProvenance
+	// RunImage and LifecycleImage replace the run image of the builder and the lifecycle image in pack builds
+	RunImage       string
+	LifecycleImage string
+	// TrustBuilder sets whether the builder of pack builds is trusted, defaulting to trusting the builders suggested by pack
+	TrustBuilder *bool
+	// BuildpackEnv is the environment of pack builds, by buildpack
+	BuildpackEnv map[string]map[string]string
+	// Env is the build environment of pack builds, and build args of docker builds. Args are build args of docker builds.
+	Env  map[string]v2.BuildEnvValue
+	Args map[string]v2.BuildEnvValue
+	// Remote builds the image in the cluster of the app rather than on this machine. Only docker builds can be built remotely.
+	Remote bool
 }
 
-// build will create an image repository if it does not exist, and then build and push the image
-func build(ctx context.Context, client api.Client, inp buildInput) error {
+// build will create an image repository if it does not exist, and then build and push the image. The build output is
+// streamed to the server, so that it is available in the dashboard.
+func build(ctx context.Context, client api.Client, inp buildInput) (err error) {
+	startedOn := time.Now().UTC()
+
 	if inp.ProjectID == 0 {
 		return errors.New("must specify a project id")
 	}
@@ -56,18 +97,53 @@ func build(ctx context.Context, client api.Client, inp buildInput) error {
 	}
 	imageURL := strings.TrimPrefix(inp.RepositoryURL, "https://")
 
-	err := createImageRepositoryIfNotExists(ctx, client, projectID, imageURL)
+	err = createImageRepositoryIfNotExists(ctx, client, projectID, imageURL)
 	if err != nil {
 		return fmt.Errorf("error creating image repository: %w", err)
 	}
 
-	dockerAgent, err := docker.NewAgentWithAuthGetter(ctx, client, projectID)
+	attest := inp.Provenance != nil && inp.Provenance.Enabled
+
+	// remote builds are pushed by the build runner, so the local docker daemon is only needed to attest them
+	var dockerAgent *docker.Agent
+	if !inp.Remote || attest {
+		dockerAgent, err = docker.NewAgentWithAuthGetter(ctx, client, projectID)
+		if err != nil {
+			return fmt.Errorf("error getting docker agent: %w", err)
+		}
+	}
+
+	buildName := inp.AppName
+	if inp.ServiceName != "" {
+		buildName = inp.ServiceName
+	}
+
+	resolver := &buildEnvResolver{client: client}
+
+	buildEnv, err := resolver.resolve(ctx, inp, inp.Env)
+	if err != nil {
+		return err
+	}
+
+	buildArgs, err := resolver.resolve(ctx, inp, inp.Args)
 	if err != nil {
-		return fmt.Errorf("error getting docker agent: %w", err)
+		return err
+	}
+
+	var logWriter io.Writer
+	if logs := newBuildLogStreamer(ctx, client, projectID, inp.ClusterID, inp.AppName, buildName, tag); logs != nil {
+		logWriter = logs
+		defer func() {
+			logs.Close(err)
+		}()
 	}
 
 	switch inp.BuildMethod {
 	case buildMethodDocker:
+		if inp.RunImage != "" || inp.LifecycleImage != "" || inp.TrustBuilder != nil || len(inp.BuildpackEnv) > 0 {
+			return errors.New("runImage, lifecycleImage, trustBuilder and buildpackEnv are only supported with the pack build method")
+		}
+
 		basePath, err := filepath.Abs(".")
 		if err != nil {
 			return fmt.Errorf("error getting absolute path: %w", err)
@@ -82,6 +158,16 @@ func build(ctx context.Context, client api.Client, inp buildInput) error {
 			return fmt.Errorf("error resolving docker paths: %w", err)
 		}
 
+		args, err := mergeBuildVariables(buildEnv, "build env", buildArgs, "build args")
+		if err != nil {
+			return err
+		}
+
+		cacheFrom := append([]string{}, inp.CacheFrom...)
+		if inp.CacheTag != "" {
+			cacheFrom = append(cacheFrom, fmt.Sprintf("%s:%s", imageURL, inp.CacheTag))
+		}
+
 		opts := &docker.BuildOpts{
 			ImageRepo:         inp.RepositoryURL,
 			Tag:               tag,
@@ -89,6 +175,21 @@ func build(ctx context.Context, client api.Client, inp buildInput) error {
 			BuildContext:      buildCtx,
 			DockerfilePath:    dockerfilePath,
 			IsDockerfileInCtx: isDockerfileInCtx,
+			CacheFrom:         cacheFrom,
+			PullCache:         len(cacheFrom) > 0,
+			Secrets:           inp.Secrets,
+			SSH:               inp.SSH,
+			Platforms:         inp.Platforms,
+			Env:               args,
+			LogWriter:         logWriter,
+		}
+
+		if inp.Remote {
+			err = buildRemote(ctx, client, inp, opts)
+			if err != nil {
+				return err
+			}
+			break
 		}
 
 		err = dockerAgent.BuildLocal(
@@ -99,20 +200,51 @@ func build(ctx context.Context, client api.Client, inp buildInput) error {
 			return fmt.Errorf("error building image with docker: %w", err)
 		}
 	case buildMethodPack:
+		if inp.Remote {
+			return errors.New("remote builds are only supported with the docker build method")
+		}
+
+		if len(inp.Platforms) > 1 {
+			return errors.New("multi-platform builds are only supported with the docker build method")
+		}
+
+		if inp.LifecycleImage != "" && inp.TrustBuilder != nil && *inp.TrustBuilder {
+			return errors.New("lifecycleImage is only used with an untrusted builder, so it cannot be set with trustBuilder: true")
+		}
+
+		if len(buildArgs) > 0 {
+			return errors.New("build args are only supported with the docker build method, use build env for pack builds")
+		}
+
+		buildpackEnv, err := mergeBuildpackEnv(inp.BuildpackEnv)
+		if err != nil {
+			return err
+		}
+
+		env, err := mergeBuildVariables(buildEnv, "build env", buildpackEnv, "buildpackEnv")
+		if err != nil {
+			return err
+		}
+
 		packAgent := &pack.Agent{}
 
 		opts := &docker.BuildOpts{
 			ImageRepo:    imageURL,
 			Tag:          tag,
 			BuildContext: inp.BuildContext,
+			Env:          env,
+			LogWriter:    logWriter,
 		}
 
 		buildConfig := &types.BuildConfig{
-			Builder:    inp.Builder,
-			Buildpacks: inp.BuildPacks,
+			Builder:        inp.Builder,
+			Buildpacks:     inp.BuildPacks,
+			RunImage:       inp.RunImage,
+			LifecycleImage: inp.LifecycleImage,
+			TrustBuilder:   inp.TrustBuilder,
 		}
 
-		err := packAgent.Build(ctx, opts, buildConfig, "")
+		err = packAgent.Build(ctx, opts, buildConfig, "")
 		if err != nil {
 			return fmt.Errorf("error building image with pack: %w", err)
 		}
@@ -120,14 +252,182 @@ func build(ctx context.Context, client api.Client, inp buildInput) error {
 		return fmt.Errorf("invalid build method: %s", inp.BuildMethod)
 	}
 
-	err = dockerAgent.PushImage(ctx, fmt.Sprintf("%s:%s", imageURL, tag))
-	if err != nil {
-		return fmt.Errorf("error pushing image url: %w\n", err)
+	switch {
+	case inp.Remote:
+		// remote builds are pushed by the build runner
+	case len(inp.Platforms) > 1:
+		// multi-platform images are pushed by the build, since they cannot be loaded into the local daemon
+		if inp.CacheTag != "" {
+			err = dockerAgent.TagRemoteImage(ctx, fmt.Sprintf("%s:%s", imageURL, tag), fmt.Sprintf("%s:%s", imageURL, inp.CacheTag))
+			if err != nil {
+				return fmt.Errorf("error tagging cache image: %w", err)
+			}
+		}
+	default:
+		err = dockerAgent.PushImage(ctx, fmt.Sprintf("%s:%s", imageURL, tag))
+		if err != nil {
+			return fmt.Errorf("error pushing image url: %w\n", err)
+		}
+
+		if inp.CacheTag != "" && inp.BuildMethod == buildMethodDocker {
+			cacheImage := fmt.Sprintf("%s:%s", imageURL, inp.CacheTag)
+
+			err = dockerAgent.TagImage(ctx, fmt.Sprintf("%s:%s", imageURL, tag), cacheImage)
+			if err != nil {
+				return fmt.Errorf("error tagging cache image: %w", err)
+			}
+
+			err = dockerAgent.PushImage(ctx, cacheImage)
+			if err != nil {
+				return fmt.Errorf("error pushing cache image: %w", err)
+			}
+		}
+	}
+
+	if attest {
+		err = attestImage(ctx, client, dockerAgent, inp, startedOn)
+		if err != nil {
+			return fmt.Errorf("error attesting build provenance: %w", err)
+		}
 	}
 
 	return nil
 }
 
+// buildConcurrently builds and pushes each of the given images, running at most parallelism builds at once.
+// The first build to fail cancels the builds that have not yet completed.
+func buildConcurrently(ctx context.Context, client api.Client, inputs []buildInput, parallelism int) error {
+	if parallelism < 1 {
+		parallelism = 1
+	}
+
+	g, ctx := errgroup.WithContext(ctx)
+	g.SetLimit(parallelism)
+
+	for _, inp := range inputs {
+		inp := inp
+
+		g.Go(func() error {
+			err := build(ctx, client, inp)
+			if err != nil {
+				if inp.ServiceName != "" {
+					return fmt.Errorf("error building service %s: %w", inp.ServiceName, err)
+				}
+				return err
+			}
+
+			return nil
+		})
+	}
+
+	return g.Wait()
+}
+
+// buildParallelism returns the number of concurrent builds to run, which can be overridden with the PORTER_BUILD_PARALLELISM environment variable
+func buildParallelism() (int, error) {
+	env := os.Getenv("PORTER_BUILD_PARALLELISM")
+	if env == "" {
+		return defaultBuildParallelism, nil
+	}
+
+	parallelism, err := strconv.Atoi(env)
+	if err != nil || parallelism < 1 {
+		return 0, fmt.Errorf("PORTER_BUILD_PARALLELISM must be a positive integer, got '%s'", env)
+	}
+
+	return parallelism, nil
+}
+
+// buildInputsFromYaml completes the app build with the settings in a v2 porter.yaml which are only used by the CLI, such as
+// layer caching, and returns it along with an input for each service which declares its own build. Service builds fall back
+// to the app build for any settings they do not declare. Service images are pushed to a repository named after the app
+// repository and the service name.
+func buildInputsFromYaml(porterYaml []byte, appBuild buildInput) ([]buildInput, error) {
+	resolvedYaml, err := v2.ResolveReferences(porterYaml)
+	if err != nil {
+		return nil, err
+	}
+
+	parsed := &v2.PorterYAML{}
+	err = yaml.Unmarshal(resolvedYaml, parsed)
+	if err != nil {
+		return nil, fmt.Errorf("error parsing porter yaml: %w", err)
+	}
+
+	if parsed.Build != nil {
+		appBuild.CacheFrom = parsed.Build.CacheFrom
+		appBuild.CacheTag = parsed.Build.CacheTag
+		appBuild.Secrets = buildSecretsFromYaml(parsed.Build.Secrets)
+		appBuild.SSH = parsed.Build.SSH
+		appBuild.Platforms = parsed.Build.Platforms
+		appBuild.Provenance = parsed.Build.Provenance
+		appBuild.RunImage = parsed.Build.RunImage
+		appBuild.LifecycleImage = parsed.Build.LifecycleImage
+		appBuild.TrustBuilder = parsed.Build.TrustBuilder
+		appBuild.BuildpackEnv = parsed.Build.BuildpackEnv
+		appBuild.Env = parsed.Build.Env
+		appBuild.Args = parsed.Build.Args
+	}
+
+	serviceNames := make([]string, 0, len(parsed.Services))
+	for name, service := range parsed.Services {
+		if service.Build != nil {
+			serviceNames = append(serviceNames, name)
+		}
+	}
+	sort.Strings(serviceNames)
+
+	inputs := []buildInput{appBuild}
+	for _, name := range serviceNames {
+		serviceBuild := parsed.Services[name].Build
+
+		inp := appBuild
+		inp.ServiceName = name
+		inp.RepositoryURL = fmt.Sprintf("%s-%s", appBuild.RepositoryURL, name)
+		if serviceBuild.Context != "" {
+			inp.BuildContext = serviceBuild.Context
+		}
+		if serviceBuild.Dockerfile != "" {
+			inp.Dockerfile = serviceBuild.Dockerfile
+		}
+		if serviceBuild.Method != "" {
+			inp.BuildMethod = serviceBuild.Method
+		}
+		if serviceBuild.Builder != "" {
+			inp.Builder = serviceBuild.Builder
+		}
+		if len(serviceBuild.Buildpacks) > 0 {
+			inp.BuildPacks = serviceBuild.Buildpacks
+		}
+		inp.CacheFrom = serviceBuild.CacheFrom
+		inp.CacheTag = serviceBuild.CacheTag
+		inp.Secrets = buildSecretsFromYaml(serviceBuild.Secrets)
+		inp.Env = serviceBuild.Env
+		inp.Args = serviceBuild.Args
+		inp.SSH = serviceBuild.SSH
+		if len(serviceBuild.Platforms) > 0 {
+			inp.Platforms = serviceBuild.Platforms
+		}
+		if serviceBuild.Provenance != nil {
+			inp.Provenance = serviceBuild.Provenance
+		}
+		// the pack settings of the app build only apply to service builds which also build with its builder
+		if serviceBuild.Builder != "" || inp.BuildMethod != buildMethodPack {
+			inp.RunImage = serviceBuild.RunImage
+			inp.LifecycleImage = serviceBuild.LifecycleImage
+			inp.TrustBuilder = serviceBuild.TrustBuilder
+			inp.BuildpackEnv = serviceBuild.BuildpackEnv
+		}
+
+		// the current tag of the app image does not exist in the service repository, so it cannot be used as a cache
+		inp.CurrentImageTag = ""
+
+		inputs = append(inputs, inp)
+	}
+
+	return inputs, nil
+}
+
 func createImageRepositoryIfNotExists(ctx context.Context, client api.Client, projectID uint, imageURL string) error {
 	if projectID == 0 {
 		return errors.New("must specify a project id")
@@ -159,7 +459,7 @@ func createImageRepositoryIfNotExists(ctx context.Context, client api.Client, pr
 	}
 
 	if registryID == 0 {
-		return errors.New("no registries match url")
+		return fmt.Errorf("no registries match url %s: connect the registry to the project, for example with porter connect ghcr", imageURL)
 	}
 
 	err = client.CreateRepository(
@@ -217,3 +517,50 @@ func resolveDockerPaths(basePath string, buildContextPath string, dockerfilePath
 
 	return absoluteBuildContextPath, outputDockerfilePath, isDockerfileRelative, nil
 }
+
+// buildSecretsFromYaml converts the build secrets in a porter.yaml to the secrets passed to the docker agent
+func buildSecretsFromYaml(secrets []v2.BuildSecret) []docker.BuildSecret {
+	res := make([]docker.BuildSecret, 0, len(secrets))
+	for _, secret := range secrets {
+		res = append(res, docker.BuildSecret{
+			ID:  secret.ID,
+			Src: secret.Src,
+			Env: secret.Env,
+		})
+	}
+
+	return res
+}
+
+// mergeBuildpackEnv returns the environment of a pack build from the environment of each of its buildpacks. Buildpacks share
+// a single build environment, so a variable set to different values for two buildpacks is rejected.
+func mergeBuildpackEnv(buildpackEnv map[string]map[string]string) (map[string]string, error) {
+	if len(buildpackEnv) == 0 {
+		return nil, nil
+	}
+
+	buildpacks := make([]string, 0, len(buildpackEnv))
+	for buildpack := range buildpackEnv {
+		buildpacks = append(buildpacks, buildpack)
+	}
+	sort.Strings(buildpacks)
+
+	env := make(map[string]string)
+	setBy := make(map[string]string)
+	for _, buildpack := range buildpacks {
+		for key, val := range buildpackEnv[buildpack] {
+			if key == "" || strings.Contains(key, "=") {
+				return nil, fmt.Errorf("invalid environment variable '%s' for buildpack %s", key, buildpack)
+			}
+
+			if prev, ok := env[key]; ok && prev != val {
+				return nil, fmt.Errorf("environment variable %s is set to different values for buildpacks %s and %s, but buildpacks share the build environment", key, setBy[key], buildpack)
+			}
+
+			env[key] = val
+			setBy[key] = buildpack
+		}
+	}
+
+	return env, nil
+}
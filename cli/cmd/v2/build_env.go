@@ -0,0 +1,140 @@
+package v2
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+
+	api "github.com/porter-dev/porter/api/client"
+	"github.com/porter-dev/porter/api/types"
+	v2 "github.com/porter-dev/porter/internal/porter_app/v2"
+)
+
+// buildEnvResolver resolves the build env vars and args of builds, reading secret values from the env groups of the cluster.
+// The env groups are listed once, the first time a secret is resolved.
+type buildEnvResolver struct {
+	client    api.Client
+	envGroups []types.EnvironmentGroupListItem
+	listed    bool
+}
+
+// resolve returns the values of build env vars or args. Secret values are read from the env group they name, or otherwise
+// from the env groups linked to the app, which must not disagree on the value.
+func (r *buildEnvResolver) resolve(ctx context.Context, inp buildInput, values map[string]v2.BuildEnvValue) (map[string]string, error) {
+	if len(values) == 0 {
+		return nil, nil
+	}
+
+	names := make([]string, 0, len(values))
+	for name := range values {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	res := make(map[string]string, len(values))
+	for _, name := range names {
+		if name == "" || strings.Contains(name, "=") {
+			return nil, fmt.Errorf("invalid build variable name '%s'", name)
+		}
+
+		value := values[name]
+		if !value.Secret {
+			res[name] = value.Value
+			continue
+		}
+
+		if !r.listed {
+			resp, err := r.client.ListEnvGroups(ctx, inp.ProjectID, inp.ClusterID)
+			if err != nil {
+				return nil, fmt.Errorf("error listing env groups for build secrets: %w", err)
+			}
+			r.envGroups = resp.EnvironmentGroups
+			r.listed = true
+		}
+
+		secret, err := r.secretValue(inp.AppName, name, value)
+		if err != nil {
+			return nil, err
+		}
+		res[name] = secret
+	}
+
+	return res, nil
+}
+
+func (r *buildEnvResolver) secretValue(appName string, name string, value v2.BuildEnvValue) (string, error) {
+	key := value.Key
+	if key == "" {
+		key = name
+	}
+
+	var found []string
+	var foundIn []string
+	for _, envGroup := range r.envGroups {
+		if value.EnvGroup != "" && envGroup.Name != value.EnvGroup {
+			continue
+		}
+		if value.EnvGroup == "" && !linkedTo(envGroup, appName) {
+			continue
+		}
+
+		if secret, ok := envGroup.SecretVariables[key]; ok {
+			found = append(found, secret)
+			foundIn = append(foundIn, envGroup.Name)
+		} else if variable, ok := envGroup.Variables[key]; ok {
+			found = append(found, variable)
+			foundIn = append(foundIn, envGroup.Name)
+		}
+	}
+
+	if len(found) == 0 {
+		if value.EnvGroup != "" {
+			return "", fmt.Errorf("build variable %s: env group %s has no variable %s", name, value.EnvGroup, key)
+		}
+		return "", fmt.Errorf("build variable %s: no env group linked to app %s has a variable %s, set envGroup to read it from another env group", name, appName, key)
+	}
+
+	for _, secret := range found[1:] {
+		if secret != found[0] {
+			return "", fmt.Errorf("build variable %s: env groups %s have different values for %s, set envGroup to choose one", name, strings.Join(foundIn, ", "), key)
+		}
+	}
+
+	return found[0], nil
+}
+
+// linkedTo returns true if an env group is linked to an app
+func linkedTo(envGroup types.EnvironmentGroupListItem, appName string) bool {
+	for _, app := range envGroup.LinkedApplications {
+		if app == appName {
+			return true
+		}
+	}
+
+	return false
+}
+
+// mergeBuildVariables merges two sets of build variables, named aName and bName in errors, rejecting a variable set to
+// different values in each
+func mergeBuildVariables(a map[string]string, aName string, b map[string]string, bName string) (map[string]string, error) {
+	if len(a) == 0 {
+		return b, nil
+	}
+	if len(b) == 0 {
+		return a, nil
+	}
+
+	res := make(map[string]string, len(a)+len(b))
+	for key, val := range a {
+		res[key] = val
+	}
+	for key, val := range b {
+		if prev, ok := res[key]; ok && prev != val {
+			return nil, fmt.Errorf("build variable %s is set to different values in %s and %s", key, aName, bName)
+		}
+		res[key] = val
+	}
+
+	return res, nil
+}
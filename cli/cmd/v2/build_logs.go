@@ -0,0 +1,170 @@
+package v2
+
+import (
+	"bytes"
+	"context"
+	"os"
+	"strconv"
+	"sync"
+	"time"
+
+	"github.com/fatih/color"
+	api "github.com/porter-dev/porter/api/client"
+	"github.com/porter-dev/porter/api/server/handlers/porter_app"
+	"github.com/porter-dev/porter/api/types"
+)
+
+const (
+	// buildLogFlushInterval is how often build output is sent to the server
+	buildLogFlushInterval = time.Second
+	// buildLogChunkSize is the maximum size of a chunk of build output
+	buildLogChunkSize = 32 * 1024
+	// buildLogChunksPerRequest is the maximum number of chunks sent in a single request
+	buildLogChunksPerRequest = 100
+)
+
+// buildLogStreamer is a writer which streams build output to the server in chunks with sequence numbers, so that builds run by
+// the CLI have live logs in the dashboard. Failing to stream logs never fails the build: the streamer stops sending output after
+// the first error.
+type buildLogStreamer struct {
+	ctx        context.Context
+	client     api.Client
+	projectID  uint
+	clusterID  uint
+	appName    string
+	buildLogID uint
+
+	// mu guards buf, which holds output that has not been sent yet
+	mu  sync.Mutex
+	buf bytes.Buffer
+
+	// sendMu orders requests to the server, and guards sequence and failed
+	sendMu   sync.Mutex
+	sequence int64
+	failed   bool
+
+	done    chan struct{}
+	stopped sync.WaitGroup
+}
+
+// newBuildLogStreamer starts a build log on the server, and returns a streamer for the build output. It returns nil if streaming
+// is disabled with PORTER_DISABLE_BUILD_LOGS, or if the build log could not be started.
+func newBuildLogStreamer(ctx context.Context, client api.Client, projectID, clusterID uint, appName, name, imageTag string) *buildLogStreamer {
+	if disabled, _ := strconv.ParseBool(os.Getenv("PORTER_DISABLE_BUILD_LOGS")); disabled {
+		return nil
+	}
+
+	buildLog, err := client.CreateBuildLog(ctx, projectID, clusterID, appName, name, imageTag)
+	if err != nil {
+		color.New(color.FgYellow).Fprintf(os.Stderr, "Unable to stream build logs for %s to Porter: %s\n", name, err.Error()) // nolint:errcheck,gosec
+		return nil
+	}
+
+	s := &buildLogStreamer{
+		ctx:        ctx,
+		client:     client,
+		projectID:  projectID,
+		clusterID:  clusterID,
+		appName:    appName,
+		buildLogID: buildLog.ID,
+		done:       make(chan struct{}),
+	}
+
+	s.stopped.Add(1)
+	go s.flushPeriodically()
+
+	return s
+}
+
+// Write buffers build output, which is sent to the server on the next flush
+func (s *buildLogStreamer) Write(p []byte) (int, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	return s.buf.Write(p)
+}
+
+// Close sends the remaining build output, and marks the build log as succeeded or failed depending on buildErr
+func (s *buildLogStreamer) Close(buildErr error) {
+	close(s.done)
+	s.stopped.Wait()
+
+	status := types.EventStatus(types.EventStatusSuccess)
+	if buildErr != nil {
+		status = types.EventStatusFailed
+	}
+
+	s.flush(&status)
+}
+
+func (s *buildLogStreamer) flushPeriodically() {
+	defer s.stopped.Done()
+
+	ticker := time.NewTicker(buildLogFlushInterval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-s.done:
+			return
+		case <-ticker.C:
+			s.flush(nil)
+		}
+	}
+}
+
+// flush sends the buffered output to the server. If status is set, a final chunk is always sent to record the status.
+func (s *buildLogStreamer) flush(status *types.EventStatus) {
+	s.mu.Lock()
+	data := s.buf.String()
+	s.buf.Reset()
+	s.mu.Unlock()
+
+	if data == "" && status == nil {
+		return
+	}
+
+	s.sendMu.Lock()
+	defer s.sendMu.Unlock()
+
+	if s.failed {
+		return
+	}
+
+	chunks := make([]porter_app.BuildLogChunk, 0)
+	for len(data) > 0 || (status != nil && len(chunks) == 0) {
+		size := buildLogChunkSize
+		if len(data) < size {
+			size = len(data)
+		}
+
+		s.sequence++
+		chunks = append(chunks, porter_app.BuildLogChunk{
+			Sequence: s.sequence,
+			Data:     data[:size],
+		})
+		data = data[size:]
+	}
+
+	for len(chunks) > 0 {
+		n := buildLogChunksPerRequest
+		if len(chunks) < n {
+			n = len(chunks)
+		}
+
+		// the status applies to the last chunk of the build
+		var requestStatus *types.EventStatus
+		if n == len(chunks) {
+			requestStatus = status
+		}
+
+		err := s.client.AppendBuildLog(s.ctx, s.projectID, s.clusterID, s.appName, s.buildLogID, chunks[:n], requestStatus)
+		if err != nil {
+			color.New(color.FgYellow).Fprintf(os.Stderr, "Unable to stream build logs to Porter, the build will continue: %s\n", err.Error()) // nolint:errcheck,gosec
+			s.failed = true
+			return
+		}
+
+		chunks = chunks[n:]
+	}
+}
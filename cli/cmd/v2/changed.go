@@ -0,0 +1,191 @@
+package v2
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"strings"
+
+	"github.com/fatih/color"
+
+	api "github.com/porter-dev/porter/api/client"
+	"github.com/porter-dev/porter/cli/cmd/config"
+	"github.com/porter-dev/porter/cli/cmd/docker"
+)
+
+// changeSet is the set of files changed in the git checkout since the commit of the current revision of an app
+type changeSet struct {
+	// BaseCommit is the commit of the current revision, which is also the tag of its images
+	BaseCommit string
+	// repoRoot is the absolute path of the top level of the git checkout
+	repoRoot string
+	// files are the changed files, relative to repoRoot
+	files []string
+}
+
+// changesSinceDeployedCommit returns the files changed since the commit the current revision of an app was built from,
+// including uncommitted changes to tracked files. It returns nil if the changes cannot be determined, for example if the app
+// has not been deployed, or if the image tag of the current revision is not a commit of the checkout.
+func changesSinceDeployedCommit(ctx context.Context, cliConf config.CLIConfig, client api.Client, appName string, deploymentTargetID string) (*changeSet, error) {
+	revisionResp, err := client.CurrentAppRevision(ctx, cliConf.Project, cliConf.Cluster, appName, deploymentTargetID)
+	if err != nil || revisionResp == nil || revisionResp.AppRevision.B64AppProto == "" {
+		return nil, nil
+	}
+
+	baseCommit, err := imageTagFromBase64AppProto(revisionResp.AppRevision.B64AppProto)
+	if err != nil {
+		return nil, nil
+	}
+
+	if _, err := runGit(ctx, "cat-file", "-e", baseCommit+"^{commit}"); err != nil {
+		return nil, nil
+	}
+
+	repoRoot, err := runGit(ctx, "rev-parse", "--show-toplevel")
+	if err != nil {
+		return nil, fmt.Errorf("error finding root of git checkout: %w", err)
+	}
+
+	diff, err := runGit(ctx, "diff", "--name-only", baseCommit, "--")
+	if err != nil {
+		return nil, fmt.Errorf("error listing files changed since %s: %w", baseCommit, err)
+	}
+
+	changes := &changeSet{
+		BaseCommit: baseCommit,
+		repoRoot:   strings.TrimSpace(repoRoot),
+	}
+	for _, file := range strings.Split(diff, "\n") {
+		if file = strings.TrimSpace(file); file != "" {
+			changes.files = append(changes.files, filepath.FromSlash(file))
+		}
+	}
+
+	return changes, nil
+}
+
+// touches returns true if a changed file is one of the given paths, or is inside one of them. Paths are relative to the
+// working directory, like the build contexts and porter.yaml paths passed to porter apply.
+func (c *changeSet) touches(paths ...string) bool {
+	for _, path := range paths {
+		if path == "" {
+			continue
+		}
+
+		absPath, err := filepath.Abs(path)
+		if err != nil {
+			return true
+		}
+
+		relPath, err := filepath.Rel(c.repoRoot, absPath)
+		if err != nil || relPath == ".." || strings.HasPrefix(relPath, ".."+string(os.PathSeparator)) {
+			// paths outside of the checkout cannot be compared, so they are always considered changed
+			return true
+		}
+
+		for _, file := range c.files {
+			if relPath == "." || file == relPath || strings.HasPrefix(file, relPath+string(os.PathSeparator)) {
+				return true
+			}
+		}
+	}
+
+	return false
+}
+
+// buildChanged returns true if a changed file is in the build context or is the Dockerfile of a build
+func (c *changeSet) buildChanged(inp buildInput) bool {
+	buildContext := inp.BuildContext
+	if buildContext == "" {
+		buildContext = "."
+	}
+
+	return c.touches(buildContext, inp.Dockerfile)
+}
+
+// anyBuildChanged returns true if any of the builds of an app has changes
+func anyBuildChanged(inputs []buildInput, changes *changeSet) bool {
+	for _, inp := range inputs {
+		if changes.buildChanged(inp) {
+			return true
+		}
+	}
+
+	return false
+}
+
+// reuseUnchangedBuilds tags the images of the current revision of an app with the new image tag for each build with no
+// changes since the current revision, instead of building them again. It returns the builds which must still be run, which
+// includes any unchanged build whose previous image could not be tagged, such as a service build added since the revision.
+func reuseUnchangedBuilds(ctx context.Context, client api.Client, inputs []buildInput, changes *changeSet) ([]buildInput, error) {
+	var toBuild []buildInput
+	var dockerAgent *docker.Agent
+
+	for _, inp := range inputs {
+		if changes.buildChanged(inp) {
+			toBuild = append(toBuild, inp)
+			continue
+		}
+
+		// the image of the current revision already has the new tag when the app is applied again from the same commit
+		if inp.ImageTag == changes.BaseCommit {
+			color.New(color.FgGreen).Printf("Skipped build of %s: no changes since %s\n", buildLabel(inp), changes.BaseCommit) // nolint:errcheck,gosec
+			continue
+		}
+
+		if dockerAgent == nil {
+			agent, err := docker.NewAgentWithAuthGetter(ctx, client, inp.ProjectID)
+			if err != nil {
+				return nil, fmt.Errorf("error getting docker agent: %w", err)
+			}
+			dockerAgent = agent
+		}
+
+		imageURL := strings.TrimPrefix(inp.RepositoryURL, "https://")
+		err := dockerAgent.TagRemoteImage(ctx, fmt.Sprintf("%s:%s", imageURL, changes.BaseCommit), fmt.Sprintf("%s:%s", imageURL, inp.ImageTag))
+		if err != nil {
+			color.New(color.FgYellow).Printf("Could not reuse the image of %s from %s, building it instead: %s\n", buildLabel(inp), changes.BaseCommit, err.Error()) // nolint:errcheck,gosec
+			toBuild = append(toBuild, inp)
+			continue
+		}
+
+		color.New(color.FgGreen).Printf("Skipped build of %s: no changes since %s, reused its image\n", buildLabel(inp), changes.BaseCommit) // nolint:errcheck,gosec
+
+		if inp.Provenance != nil && inp.Provenance.Enabled {
+			if err := attestReusedImage(ctx, client, dockerAgent, inp); err != nil {
+				return nil, fmt.Errorf("error attesting build provenance of %s: %w", buildLabel(inp), err)
+			}
+		}
+	}
+
+	return toBuild, nil
+}
+
+// buildLabel names a build in the output of porter apply
+func buildLabel(inp buildInput) string {
+	if inp.ServiceName != "" {
+		return fmt.Sprintf("service %s", inp.ServiceName)
+	}
+
+	return fmt.Sprintf("app %s", inp.AppName)
+}
+
+func runGit(ctx context.Context, args ...string) (string, error) {
+	var stdout, stderr bytes.Buffer
+
+	cmd := exec.CommandContext(ctx, "git", args...)
+	cmd.Stdout = &stdout
+	cmd.Stderr = &stderr
+
+	if err := cmd.Run(); err != nil {
+		if msg := strings.TrimSpace(stderr.String()); msg != "" {
+			return "", fmt.Errorf("%w: %s", err, msg)
+		}
+		return "", err
+	}
+
+	return stdout.String(), nil
+}
@@ -0,0 +1,48 @@
+package v2
+
+import (
+	"fmt"
+	"os"
+	"text/tabwriter"
+
+	"github.com/fatih/color"
+
+	"github.com/porter-dev/porter/internal/cost"
+)
+
+// printCostEstimate prints the estimated monthly cost of each service of an app, as returned by the validate endpoint
+func printCostEstimate(estimate *cost.Estimate) {
+	if estimate == nil {
+		_, _ = color.New(color.FgYellow).Println("Cost estimate is not available for this cluster")
+		return
+	}
+
+	fmt.Printf("Estimated monthly cost on %s %s nodes in %s:\n\n", estimate.CloudProvider, estimate.InstanceType, estimate.Region)
+
+	w := new(tabwriter.Writer)
+	w.Init(os.Stdout, 3, 8, 2, ' ', 0)
+
+	fmt.Fprintln(w, "SERVICE\tCPU CORES\tRAM MEGABYTES\tINSTANCES\tMONTHLY COST")
+	for _, service := range estimate.Services {
+		if service.MaxInstances != service.Instances {
+			fmt.Fprintf(w, "%s\t%s\t%d\t%d-%d\t$%.2f-$%.2f\n",
+				service.Name, formatCpuCores(service.CpuCores), service.RamMegabytes,
+				service.Instances, service.MaxInstances, service.MonthlyCost, service.MaxMonthlyCost,
+			)
+			continue
+		}
+
+		fmt.Fprintf(w, "%s\t%s\t%d\t%d\t$%.2f\n",
+			service.Name, formatCpuCores(service.CpuCores), service.RamMegabytes, service.Instances, service.MonthlyCost,
+		)
+	}
+
+	_ = w.Flush()
+
+	if estimate.MaxMonthlyCost != estimate.MonthlyCost {
+		fmt.Printf("\nTotal: $%.2f-$%.2f per month\n\n", estimate.MonthlyCost, estimate.MaxMonthlyCost)
+		return
+	}
+
+	fmt.Printf("\nTotal: $%.2f per month\n\n", estimate.MonthlyCost)
+}
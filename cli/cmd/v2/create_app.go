@@ -0,0 +1,81 @@
+package v2
+
+import (
+	"context"
+	"encoding/base64"
+	"errors"
+	"fmt"
+	"os"
+	"strings"
+
+	"github.com/fatih/color"
+
+	api "github.com/porter-dev/porter/api/client"
+	"github.com/porter-dev/porter/api/types"
+	"github.com/porter-dev/porter/cli/cmd/config"
+)
+
+// CreateAppInput is the input to the `porter app create` command
+type CreateAppInput struct {
+	// AppName is the name of the new app
+	AppName string
+	// TemplateName is the name of the app template of the project to create the app from
+	TemplateName string
+	// Variables are the values of the variables of the template, each in the form NAME=VALUE
+	Variables []string
+	// OutputPath is the path the porter.yaml of the new app is written to
+	OutputPath string
+	// Apply deploys the new app with porter apply after writing its porter.yaml
+	Apply bool
+}
+
+// CreateApp implements the functionality of the `porter app create` command. It renders an app template of the project to the
+// porter.yaml of a new app, which is written to the output path and then optionally applied.
+func CreateApp(ctx context.Context, cliConf config.CLIConfig, client api.Client, input CreateAppInput) error {
+	if input.TemplateName == "" {
+		return errors.New("a template must be specified with --template")
+	}
+	if input.OutputPath == "" {
+		return errors.New("output path is empty")
+	}
+
+	variables := make(map[string]string, len(input.Variables))
+	for _, variable := range input.Variables {
+		name, value, ok := strings.Cut(variable, "=")
+		if !ok || name == "" {
+			return fmt.Errorf("invalid variable '%s': must be in the form NAME=VALUE", variable)
+		}
+		variables[name] = value
+	}
+
+	if _, err := os.Stat(input.OutputPath); err == nil {
+		return fmt.Errorf("%s already exists, use --write to choose another path", input.OutputPath)
+	}
+
+	resp, err := client.InstantiateAppTemplate(ctx, cliConf.Project, input.TemplateName, &types.InstantiateAppTemplateRequest{
+		AppName:   input.AppName,
+		Variables: variables,
+	})
+	if err != nil {
+		return fmt.Errorf("error creating app from template %s: %w", input.TemplateName, err)
+	}
+
+	porterYaml, err := base64.StdEncoding.DecodeString(resp.B64PorterYAML)
+	if err != nil {
+		return fmt.Errorf("unable to decode porter yaml: %w", err)
+	}
+
+	err = os.WriteFile(input.OutputPath, porterYaml, 0o600)
+	if err != nil {
+		return fmt.Errorf("error writing %s: %w", input.OutputPath, err)
+	}
+
+	_, _ = color.New(color.FgGreen).Fprintf(os.Stderr, "Wrote the config of %s from template %s to %s\n", input.AppName, input.TemplateName, input.OutputPath)
+
+	if !input.Apply {
+		_, _ = color.New(color.FgBlue).Fprintf(os.Stderr, "Deploy the app with: porter apply -f %s\n", input.OutputPath)
+		return nil
+	}
+
+	return Apply(ctx, cliConf, client, []string{input.OutputPath}, "", "", false, false, false, false, false, false, nil)
+}
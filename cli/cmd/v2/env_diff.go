@@ -0,0 +1,72 @@
+package v2
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"text/tabwriter"
+
+	"github.com/fatih/color"
+
+	api "github.com/porter-dev/porter/api/client"
+	"github.com/porter-dev/porter/api/server/handlers/porter_app"
+	"github.com/porter-dev/porter/cli/cmd/config"
+)
+
+// EnvDiff implements the functionality of the `porter app env diff` command for validate apply v2 projects.
+// It returns an error if the env differs between the deployment targets, so that drift can fail a CI step.
+func EnvDiff(ctx context.Context, cliConf config.CLIConfig, client api.Client, appName, base, compare string, showSame bool) error {
+	resp, err := client.EnvDiff(ctx, cliConf.Project, cliConf.Cluster, appName, base, compare)
+	if err != nil {
+		return fmt.Errorf("error getting env diff: %w", err)
+	}
+
+	w := new(tabwriter.Writer)
+	w.Init(os.Stdout, 3, 8, 2, ' ', 0)
+
+	fmt.Fprintf(w, "KEY\t%s\t%s\tSTATUS\n", base, compare)
+
+	drift := 0
+	for _, variable := range resp.Variables {
+		if variable.Status == porter_app.EnvVariableDiffStatus_Same && !showSame {
+			continue
+		}
+
+		baseValue, compareValue := variable.BaseValue, variable.CompareValue
+		if variable.Secret {
+			baseValue, compareValue = "********", "********"
+		}
+
+		var status string
+		switch variable.Status {
+		case porter_app.EnvVariableDiffStatus_Same:
+			status = "same"
+		case porter_app.EnvVariableDiffStatus_Different:
+			status = color.New(color.FgYellow).Sprint("different")
+			drift++
+		case porter_app.EnvVariableDiffStatus_MissingInBase:
+			baseValue = "-"
+			status = color.New(color.FgRed).Sprintf("missing in %s", base)
+			drift++
+		case porter_app.EnvVariableDiffStatus_MissingInCompare:
+			compareValue = "-"
+			status = color.New(color.FgRed).Sprintf("missing in %s", compare)
+			drift++
+		}
+
+		fmt.Fprintf(w, "%s\t%s\t%s\t%s\n", variable.Key, baseValue, compareValue, status)
+	}
+
+	err = w.Flush()
+	if err != nil {
+		return err
+	}
+
+	if drift > 0 {
+		return fmt.Errorf("%d variable(s) differ between %s and %s", drift, base, compare)
+	}
+
+	_, _ = color.New(color.FgGreen).Printf("The env of %s is the same in %s and %s\n", appName, base, compare)
+
+	return nil
+}
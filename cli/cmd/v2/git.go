@@ -0,0 +1,60 @@
+package v2
+
+import (
+	"fmt"
+	"os"
+
+	"github.com/cli/cli/git"
+)
+
+// gitSource returns the branch and repository URL the app is applied from, which are recorded on the revision. Each is read
+// from PORTER_GIT_BRANCH and PORTER_GIT_REPO_URL if set, then from the GitHub Actions or GitLab CI environment, and finally
+// from the current git checkout. Either is empty if it cannot be determined.
+func gitSource() (branch string, repoURL string) {
+	branch = firstEnv("PORTER_GIT_BRANCH", "GITHUB_HEAD_REF", "GITHUB_REF_NAME", "CI_COMMIT_REF_NAME")
+	if branch == "" {
+		if currentBranch, err := git.CurrentBranch(); err == nil {
+			branch = currentBranch
+		}
+	}
+
+	repoURL = firstEnv("PORTER_GIT_REPO_URL", "CI_PROJECT_URL")
+	if repoURL == "" && os.Getenv("GITHUB_SERVER_URL") != "" && os.Getenv("GITHUB_REPOSITORY") != "" {
+		repoURL = fmt.Sprintf("%s/%s", os.Getenv("GITHUB_SERVER_URL"), os.Getenv("GITHUB_REPOSITORY"))
+	}
+	if repoURL == "" {
+		repoURL = originURL()
+	}
+
+	return branch, repoURL
+}
+
+// originURL returns the fetch URL of the origin remote of the current git checkout, without credentials
+func originURL() string {
+	remotes, err := git.Remotes()
+	if err != nil {
+		return ""
+	}
+
+	for _, remote := range remotes {
+		if remote.Name != "origin" || remote.FetchURL == nil {
+			continue
+		}
+
+		fetchURL := *remote.FetchURL
+		fetchURL.User = nil
+		return fetchURL.String()
+	}
+
+	return ""
+}
+
+func firstEnv(keys ...string) string {
+	for _, key := range keys {
+		if value := os.Getenv(key); value != "" {
+			return value
+		}
+	}
+
+	return ""
+}
@@ -2,7 +2,16 @@ package v2
 
 import (
 	"context"
+	"errors"
 	"fmt"
+	"os"
+	"strings"
+	"text/tabwriter"
+	"time"
+
+	"github.com/fatih/color"
+	api "github.com/porter-dev/porter/api/client"
+	"github.com/porter-dev/porter/cli/cmd/config"
 )
 
 // BatchImageUpdate implements the functionality of the `porter job update-images` command for validate apply v2 projects
@@ -18,7 +27,70 @@ func WaitForJob(ctx context.Context) error {
 }
 
 // RunJob implements the functionality of the `porter job run` command for validate apply v2 projects
-func RunJob(ctx context.Context) error {
-	fmt.Println("This command is not supported for your project. Contact support@porter.run for more information.")
+func RunJob(ctx context.Context, cliConf config.CLIConfig, client api.Client, appName, jobName string, envOverrides []string, command string) error {
+	if appName == "" {
+		return errors.New("the --app flag is required to run a job of an app")
+	}
+
+	env := make(map[string]string, len(envOverrides))
+	for _, override := range envOverrides {
+		key, val, ok := strings.Cut(override, "=")
+		if !ok || key == "" {
+			return fmt.Errorf("invalid env override %q: must be of the form KEY=VALUE", override)
+		}
+		env[key] = val
+	}
+
+	targetResp, err := client.DefaultDeploymentTarget(ctx, cliConf.Project, cliConf.Cluster)
+	if err != nil {
+		return fmt.Errorf("error calling default deployment target endpoint: %w", err)
+	}
+
+	if targetResp.DeploymentTargetID == "" {
+		return errors.New("deployment target id is empty")
+	}
+
+	resp, err := client.RunJob(ctx, cliConf.Project, cliConf.Cluster, appName, jobName, targetResp.DeploymentTargetID, env, command)
+	if err != nil {
+		return fmt.Errorf("error running job: %w", err)
+	}
+
+	_, _ = color.New(color.FgGreen).Printf("Started run %s of job %s in namespace %s\n", resp.JobRunName, jobName, resp.Namespace)
+	fmt.Printf("Use `porter job runs %s %s` to check the status of the run.\n", appName, jobName)
+
 	return nil
 }
+
+// ListJobRuns implements the functionality of the `porter job runs` command for validate apply v2 projects
+func ListJobRuns(ctx context.Context, cliConf config.CLIConfig, client api.Client, appName, jobName string, since time.Time) error {
+	runs, err := client.ListJobRuns(ctx, cliConf.Project, cliConf.Cluster, appName, jobName, since)
+	if err != nil {
+		return fmt.Errorf("error listing job runs: %w", err)
+	}
+
+	if runs == nil || len(*runs) == 0 {
+		fmt.Printf("No runs of %s found since %s\n", jobName, since.Local().Format(time.RFC1123))
+		return nil
+	}
+
+	w := new(tabwriter.Writer)
+	w.Init(os.Stdout, 3, 8, 2, ' ', 0)
+
+	fmt.Fprintln(w, "STARTED\tDURATION\tSTATUS\tEXIT CODE\tTRIGGERED BY\tPOD")
+	for _, run := range *runs {
+		duration := "-"
+		if run.FinishedAt != nil {
+			duration = run.FinishedAt.Sub(run.StartedAt).Round(time.Second).String()
+		}
+
+		exitCode := "-"
+		if run.ExitCode != nil {
+			exitCode = fmt.Sprintf("%d", *run.ExitCode)
+		}
+
+		fmt.Fprintf(w, "%s\t%s\t%s\t%s\t%s\t%s\n",
+			run.StartedAt.Local().Format("2006-01-02 15:04:05"), duration, run.Status, exitCode, run.TriggeredBy, run.PodName)
+	}
+
+	return w.Flush()
+}
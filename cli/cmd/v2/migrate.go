@@ -0,0 +1,46 @@
+package v2
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"path/filepath"
+
+	"github.com/fatih/color"
+
+	v1 "github.com/porter-dev/porter/cli/cmd/porter_app"
+)
+
+// MigrateConfig implements the functionality of the `porter app migrate-config` command. It converts the application of the
+// v1 porter.yaml at porterYamlPath to a v2 porter.yaml named appName, which is written to outputPath, or printed if outputPath
+// is empty. The parts of the v1 file which have no v2 equivalent are printed as warnings.
+func MigrateConfig(ctx context.Context, porterYamlPath string, application string, appName string, outputPath string) error {
+	porterYaml, err := os.ReadFile(filepath.Clean(porterYamlPath))
+	if err != nil {
+		return fmt.Errorf("could not read porter yaml file: %w", err)
+	}
+
+	migrated, warnings, err := v1.MigrateToV2(ctx, porterYaml, application, appName)
+	if err != nil {
+		return err
+	}
+
+	yellow := color.New(color.FgYellow)
+	for _, warning := range warnings {
+		yellow.Fprintf(os.Stderr, "Warning: %s: %s\n", warning.Field, warning.Message) // nolint:errcheck,gosec
+	}
+
+	if outputPath == "" {
+		_, err = os.Stdout.Write(migrated)
+		return err
+	}
+
+	err = os.WriteFile(outputPath, migrated, 0o600)
+	if err != nil {
+		return fmt.Errorf("error writing %s: %w", outputPath, err)
+	}
+
+	_, _ = color.New(color.FgGreen).Fprintf(os.Stderr, "Wrote v2 porter.yaml to %s with %d warning(s)\n", outputPath, len(warnings))
+
+	return nil
+}
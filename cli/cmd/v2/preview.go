@@ -0,0 +1,56 @@
+package v2
+
+import (
+	"errors"
+	"fmt"
+	"os"
+	"regexp"
+	"strings"
+
+	"github.com/cli/cli/git"
+)
+
+// invalidPreviewNameChars matches characters that cannot be used in a kubernetes namespace name
+var invalidPreviewNameChars = regexp.MustCompile(`[^a-z0-9-]+`)
+
+// PreviewName returns the name of the preview environment for the current branch or pull request. The name is read from
+// PORTER_PREVIEW_NAME if set, then from the GitHub Actions environment, and finally from the current git branch.
+func PreviewName() (string, error) {
+	name := os.Getenv("PORTER_PREVIEW_NAME")
+
+	if name == "" && os.Getenv("GITHUB_EVENT_NAME") == "pull_request" {
+		// GITHUB_REF is refs/pull/<number>/merge for pull request events
+		parts := strings.Split(os.Getenv("GITHUB_REF"), "/")
+		if len(parts) == 4 && parts[1] == "pull" {
+			name = fmt.Sprintf("pr-%s", parts[2])
+		}
+	}
+
+	if name == "" {
+		name = os.Getenv("GITHUB_HEAD_REF")
+	}
+
+	if name == "" {
+		name = os.Getenv("GITHUB_REF_NAME")
+	}
+
+	if name == "" {
+		branch, err := git.CurrentBranch()
+		if err != nil {
+			return "", fmt.Errorf("could not determine preview name from git branch, please set PORTER_PREVIEW_NAME: %w", err)
+		}
+		name = branch
+	}
+
+	name = invalidPreviewNameChars.ReplaceAllString(strings.ToLower(name), "-")
+	name = strings.Trim(fmt.Sprintf("preview-%s", name), "-")
+	if len(name) > 63 {
+		name = strings.TrimRight(name[:63], "-")
+	}
+
+	if name == "preview" {
+		return "", errors.New("preview name is empty, please set PORTER_PREVIEW_NAME")
+	}
+
+	return name, nil
+}
@@ -0,0 +1,307 @@
+package v2
+
+import (
+	"bufio"
+	"bytes"
+	"context"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"os/exec"
+	"strings"
+	"time"
+
+	"github.com/cli/cli/git"
+	"github.com/fatih/color"
+
+	api "github.com/porter-dev/porter/api/client"
+	"github.com/porter-dev/porter/api/types"
+	"github.com/porter-dev/porter/cli/cmd/docker"
+	"github.com/porter-dev/porter/internal/attestation"
+)
+
+const (
+	// cosignCertificateAnnotation and cosignChainAnnotation are the annotations of the layers of a cosign attestation image
+	// with the signing certificate of a keyless attestation and its intermediates
+	cosignCertificateAnnotation = "dev.sigstore.cosign/certificate"
+	cosignChainAnnotation       = "dev.sigstore.cosign/chain"
+)
+
+// attestImage signs a SLSA provenance attestation of a pushed image with cosign, which stores it in the registry next to the
+// image, and uploads it to Porter so that deploys of the image can be verified against the image signing policy of the project.
+// Attestations are signed with the key of the build settings, or keyless if no key is set.
+func attestImage(ctx context.Context, client api.Client, dockerAgent *docker.Agent, inp buildInput, startedOn time.Time) error {
+	imageURL := strings.TrimPrefix(inp.RepositoryURL, "https://")
+	image := fmt.Sprintf("%s:%s", imageURL, inp.ImageTag)
+
+	digest, err := dockerAgent.ImageDigest(ctx, image)
+	if err != nil {
+		return err
+	}
+
+	env, cleanup, err := dockerAgent.RegistryEnv(ctx, image)
+	if err != nil {
+		return err
+	}
+	defer cleanup()
+
+	err = signProvenance(ctx, env, inp, imageURL, digest, startedOn)
+	if err != nil {
+		return err
+	}
+
+	err = uploadAttestation(ctx, client, dockerAgent, env, inp, imageURL, digest)
+	if err != nil {
+		return err
+	}
+
+	color.New(color.FgGreen).Printf("Signed build provenance of %s\n", buildLabel(inp)) // nolint:errcheck,gosec
+
+	return nil
+}
+
+// attestReusedImage uploads the attestation of an image which was tagged with a new tag rather than built again, since the
+// attestations of the project are looked up by tag when the app is deployed. The image is attested again if it has no attestation.
+func attestReusedImage(ctx context.Context, client api.Client, dockerAgent *docker.Agent, inp buildInput) error {
+	imageURL := strings.TrimPrefix(inp.RepositoryURL, "https://")
+	image := fmt.Sprintf("%s:%s", imageURL, inp.ImageTag)
+
+	digest, err := dockerAgent.ImageDigest(ctx, image)
+	if err != nil {
+		return err
+	}
+
+	env, cleanup, err := dockerAgent.RegistryEnv(ctx, image)
+	if err != nil {
+		return err
+	}
+	defer cleanup()
+
+	err = uploadAttestation(ctx, client, dockerAgent, env, inp, imageURL, digest)
+	if err == nil {
+		return nil
+	}
+	if !errors.Is(err, errNoAttestation) {
+		return err
+	}
+
+	err = signProvenance(ctx, env, inp, imageURL, digest, time.Now().UTC())
+	if err != nil {
+		return err
+	}
+
+	return uploadAttestation(ctx, client, dockerAgent, env, inp, imageURL, digest)
+}
+
+// errNoAttestation is returned when an image has no provenance attestation in the registry
+var errNoAttestation = errors.New("image has no build provenance attestation")
+
+// signProvenance writes the SLSA provenance of a build and signs it as an attestation of the image with cosign
+func signProvenance(ctx context.Context, env []string, inp buildInput, imageURL string, digest string, startedOn time.Time) error {
+	predicate, err := json.Marshal(buildProvenance(inp, startedOn))
+	if err != nil {
+		return fmt.Errorf("error encoding provenance: %w", err)
+	}
+
+	predicateFile, err := os.CreateTemp("", "porter-provenance-*.json")
+	if err != nil {
+		return fmt.Errorf("error creating provenance file: %w", err)
+	}
+	defer os.Remove(predicateFile.Name()) // nolint:errcheck
+
+	_, err = predicateFile.Write(predicate)
+	if closeErr := predicateFile.Close(); err == nil {
+		err = closeErr
+	}
+	if err != nil {
+		return fmt.Errorf("error writing provenance file: %w", err)
+	}
+
+	args := []string{"attest", "--yes", "--type", "slsaprovenance1", "--predicate", predicateFile.Name()}
+	if inp.Provenance.Key != "" {
+		args = append(args, "--key", inp.Provenance.Key)
+	}
+	args = append(args, fmt.Sprintf("%s@%s", imageURL, digest))
+
+	cmd := exec.CommandContext(ctx, "cosign", args...)
+	cmd.Env = env
+	cmd.Stdout = os.Stderr
+	cmd.Stderr = os.Stderr
+	cmd.Stdin = os.Stdin
+
+	if err := cmd.Run(); err != nil {
+		if errors.Is(err, exec.ErrNotFound) {
+			return errors.New("cosign must be installed to sign build provenance: see https://docs.sigstore.dev/cosign/system_config/installation/")
+		}
+		return fmt.Errorf("error signing provenance with cosign: %w", err)
+	}
+
+	return nil
+}
+
+// buildProvenance returns the SLSA provenance of a build, which records the build settings and the commit it was built from
+func buildProvenance(inp buildInput, startedOn time.Time) attestation.Provenance {
+	parameters := map[string]interface{}{
+		"app":         inp.AppName,
+		"buildMethod": inp.BuildMethod,
+		"context":     inp.BuildContext,
+		"tag":         inp.ImageTag,
+	}
+	if inp.ServiceName != "" {
+		parameters["service"] = inp.ServiceName
+	}
+	if inp.Dockerfile != "" {
+		parameters["dockerfile"] = inp.Dockerfile
+	}
+	if inp.Builder != "" {
+		parameters["builder"] = inp.Builder
+	}
+	if len(inp.BuildPacks) > 0 {
+		parameters["buildpacks"] = inp.BuildPacks
+	}
+	if len(inp.Platforms) > 0 {
+		parameters["platforms"] = inp.Platforms
+	}
+
+	var dependencies []attestation.ResourceDescriptor
+	_, repoURL := gitSource()
+	commitSHA := os.Getenv("PORTER_COMMIT_SHA")
+	if commitSHA == "" {
+		if commit, err := git.LastCommit(); err == nil && commit != nil {
+			commitSHA = commit.Sha
+		}
+	}
+	if repoURL != "" && commitSHA != "" {
+		dependencies = append(dependencies, attestation.ResourceDescriptor{
+			URI:    fmt.Sprintf("git+%s", repoURL),
+			Digest: map[string]string{"gitCommit": commitSHA},
+		})
+	}
+
+	finishedOn := time.Now().UTC()
+
+	return attestation.Provenance{
+		BuildDefinition: attestation.BuildDefinition{
+			BuildType:            attestation.BuildTypePorterCLI,
+			ExternalParameters:   parameters,
+			ResolvedDependencies: dependencies,
+		},
+		RunDetails: attestation.RunDetails{
+			Builder: attestation.Builder{ID: attestation.BuilderIDPorterCLI},
+			Metadata: attestation.BuildMetadata{
+				StartedOn:  &startedOn,
+				FinishedOn: &finishedOn,
+			},
+		},
+	}
+}
+
+// uploadAttestation uploads the newest provenance attestation of an image in the registry to Porter, along with its signing
+// certificate if it was signed keyless. It returns errNoAttestation if the image has no provenance attestation.
+func uploadAttestation(ctx context.Context, client api.Client, dockerAgent *docker.Agent, env []string, inp buildInput, imageURL string, digest string) error {
+	imageRef := fmt.Sprintf("%s@%s", imageURL, digest)
+
+	var stdout, stderr bytes.Buffer
+	cmd := exec.CommandContext(ctx, "cosign", "download", "attestation", "--predicate-type", attestation.SLSAProvenancePredicateType, imageRef)
+	cmd.Env = env
+	cmd.Stdout = &stdout
+	cmd.Stderr = &stderr
+
+	if err := cmd.Run(); err != nil {
+		if errors.Is(err, exec.ErrNotFound) {
+			return errors.New("cosign must be installed to sign build provenance: see https://docs.sigstore.dev/cosign/system_config/installation/")
+		}
+		if strings.Contains(stderr.String(), "no attestations") || strings.Contains(stderr.String(), "MANIFEST_UNKNOWN") {
+			return errNoAttestation
+		}
+		return fmt.Errorf("error downloading attestation of %s: %w: %s", imageRef, err, strings.TrimSpace(stderr.String()))
+	}
+
+	// cosign prints one envelope per line, in the order the attestations were signed
+	var envelope []byte
+	scanner := bufio.NewScanner(&stdout)
+	scanner.Buffer(make([]byte, 0, 64*1024), 16*1024*1024)
+	for scanner.Scan() {
+		if line := bytes.TrimSpace(scanner.Bytes()); len(line) > 0 {
+			envelope = append([]byte{}, line...)
+		}
+	}
+	if err := scanner.Err(); err != nil {
+		return fmt.Errorf("error reading attestation of %s: %w", imageRef, err)
+	}
+	if envelope == nil {
+		return errNoAttestation
+	}
+
+	// attestations are stored by cosign in the tag sha256-<digest>.att of the image repository
+	reference := fmt.Sprintf("%s:%s.att", imageURL, strings.Replace(digest, ":", "-", 1))
+
+	req := &types.CreateImageAttestationRequest{
+		AppName:     inp.AppName,
+		Repository:  imageURL,
+		Tag:         inp.ImageTag,
+		Digest:      digest,
+		Reference:   reference,
+		B64Envelope: base64.StdEncoding.EncodeToString(envelope),
+	}
+
+	if inp.Provenance.Key == "" {
+		certificate, chain, err := attestationCertificate(ctx, dockerAgent, reference, envelope)
+		if err != nil {
+			return err
+		}
+		req.Certificate = certificate
+		req.CertificateChain = chain
+	}
+
+	_, err := client.CreateImageAttestation(ctx, inp.ProjectID, req)
+	if err != nil {
+		return fmt.Errorf("error uploading attestation of %s: %w", imageRef, err)
+	}
+
+	return nil
+}
+
+// attestationCertificate returns the signing certificate and chain of a keyless attestation, which cosign stores in the
+// annotations of the layer of the attestation image with the envelope
+func attestationCertificate(ctx context.Context, dockerAgent *docker.Agent, reference string, envelope []byte) (string, string, error) {
+	raw, err := dockerAgent.RawManifest(ctx, reference)
+	if err != nil {
+		return "", "", err
+	}
+
+	manifest := struct {
+		Layers []struct {
+			Digest      string            `json:"digest"`
+			Annotations map[string]string `json:"annotations"`
+		} `json:"layers"`
+	}{}
+	if err := json.Unmarshal(raw, &manifest); err != nil {
+		return "", "", fmt.Errorf("error parsing attestation manifest %s: %w", reference, err)
+	}
+
+	if len(manifest.Layers) == 0 {
+		return "", "", fmt.Errorf("attestation manifest %s has no layers", reference)
+	}
+
+	// the layer with the envelope is matched by digest, falling back to the newest layer in case the envelope was reformatted
+	sum := sha256.Sum256(envelope)
+	layer := manifest.Layers[len(manifest.Layers)-1]
+	for _, l := range manifest.Layers {
+		if l.Digest == "sha256:"+hex.EncodeToString(sum[:]) {
+			layer = l
+			break
+		}
+	}
+
+	certificate := layer.Annotations[cosignCertificateAnnotation]
+	if certificate == "" {
+		return "", "", fmt.Errorf("attestation %s has no signing certificate: set a key in the provenance settings if it was not signed keyless", reference)
+	}
+
+	return certificate, layer.Annotations[cosignChainAnnotation], nil
+}
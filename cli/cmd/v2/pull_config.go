@@ -0,0 +1,69 @@
+package v2
+
+import (
+	"context"
+	"encoding/base64"
+	"errors"
+	"fmt"
+	"os"
+
+	"github.com/fatih/color"
+	"github.com/porter-dev/api-contracts/generated/go/helpers"
+	porterv1 "github.com/porter-dev/api-contracts/generated/go/porter/v1"
+
+	api "github.com/porter-dev/porter/api/client"
+	"github.com/porter-dev/porter/cli/cmd/config"
+	v2 "github.com/porter-dev/porter/internal/porter_app/v2"
+)
+
+// PullConfig implements the functionality of the `porter app pull-config` command. It converts the current revision of an app
+// in the default deployment target to a v2 porter.yaml, which is written to outputPath, or printed if outputPath is empty.
+func PullConfig(ctx context.Context, cliConf config.CLIConfig, client api.Client, appName string, outputPath string) error {
+	targetResp, err := client.DefaultDeploymentTarget(ctx, cliConf.Project, cliConf.Cluster)
+	if err != nil {
+		return fmt.Errorf("error calling default deployment target endpoint: %w", err)
+	}
+
+	if targetResp.DeploymentTargetID == "" {
+		return errors.New("deployment target id is empty")
+	}
+
+	revisionResp, err := client.CurrentAppRevision(ctx, cliConf.Project, cliConf.Cluster, appName, targetResp.DeploymentTargetID)
+	if err != nil {
+		return fmt.Errorf("error getting current app revision: %w", err)
+	}
+
+	if revisionResp == nil || revisionResp.AppRevision.B64AppProto == "" {
+		return fmt.Errorf("app %s has not been deployed", appName)
+	}
+
+	decoded, err := base64.StdEncoding.DecodeString(revisionResp.AppRevision.B64AppProto)
+	if err != nil {
+		return fmt.Errorf("unable to decode base64 app for revision: %w", err)
+	}
+
+	app := &porterv1.PorterApp{}
+	err = helpers.UnmarshalContractObject(decoded, app)
+	if err != nil {
+		return fmt.Errorf("unable to unmarshal app for revision: %w", err)
+	}
+
+	porterYaml, err := v2.AppYamlFromProto(ctx, app)
+	if err != nil {
+		return fmt.Errorf("error converting app to porter yaml: %w", err)
+	}
+
+	if outputPath == "" {
+		_, err = os.Stdout.Write(porterYaml)
+		return err
+	}
+
+	err = os.WriteFile(outputPath, porterYaml, 0o600)
+	if err != nil {
+		return fmt.Errorf("error writing %s: %w", outputPath, err)
+	}
+
+	_, _ = color.New(color.FgGreen).Fprintf(os.Stderr, "Wrote the config of %s to %s\n", appName, outputPath)
+
+	return nil
+}
@@ -0,0 +1,167 @@
+package v2
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"os"
+	"strconv"
+	"text/tabwriter"
+
+	"github.com/fatih/color"
+	"gopkg.in/yaml.v3"
+
+	api "github.com/porter-dev/porter/api/client"
+	"github.com/porter-dev/porter/api/server/handlers/porter_app"
+	"github.com/porter-dev/porter/cli/cmd/config"
+)
+
+// RecommendResources implements the functionality of the `porter app recommend-resources` command for validate apply v2 projects.
+// If write is set, the recommended values are written to the services of the porter.yaml at porterYamlPath.
+func RecommendResources(ctx context.Context, cliConf config.CLIConfig, client api.Client, appName string, windowHours int, porterYamlPath string, write bool) error {
+	targetResp, err := client.DefaultDeploymentTarget(ctx, cliConf.Project, cliConf.Cluster)
+	if err != nil {
+		return fmt.Errorf("error calling default deployment target endpoint: %w", err)
+	}
+
+	if targetResp.DeploymentTargetID == "" {
+		return errors.New("deployment target id is empty")
+	}
+
+	resp, err := client.ResourceRecommendations(ctx, cliConf.Project, cliConf.Cluster, appName, targetResp.DeploymentTargetID, windowHours)
+	if err != nil {
+		return fmt.Errorf("error getting resource recommendations: %w", err)
+	}
+
+	fmt.Printf("Usage over the last %d hours:\n\n", resp.WindowHours)
+
+	w := new(tabwriter.Writer)
+	w.Init(os.Stdout, 3, 8, 2, ' ', 0)
+
+	fmt.Fprintln(w, "SERVICE\tCPU P95\tCPU CORES\tPEAK RAM\tRAM MEGABYTES")
+	for _, service := range resp.Services {
+		if !service.HasUsage {
+			fmt.Fprintf(w, "%s\t-\t%s\t-\t%d\n", service.Name, formatCpuCores(service.CurrentCpuCores), service.CurrentRamMegabytes)
+			continue
+		}
+
+		fmt.Fprintf(w, "%s\t%.3f\t%s -> %s\t%.0fMB\t%d -> %d\n",
+			service.Name,
+			service.CpuCoresP95,
+			formatCpuCores(service.CurrentCpuCores), formatCpuCores(service.RecommendedCpuCores),
+			service.PeakRamMegabytes,
+			service.CurrentRamMegabytes, service.RecommendedRamMegabytes,
+		)
+	}
+
+	err = w.Flush()
+	if err != nil {
+		return err
+	}
+
+	if !write {
+		fmt.Println("\nRun with --write to update porter.yaml with the recommended values.")
+		return nil
+	}
+
+	updated, err := writeRecommendedResources(porterYamlPath, resp.Services)
+	if err != nil {
+		return fmt.Errorf("error updating %s: %w", porterYamlPath, err)
+	}
+
+	_, _ = color.New(color.FgGreen).Printf("\nUpdated resources of %d service(s) in %s\n", updated, porterYamlPath)
+
+	return nil
+}
+
+// writeRecommendedResources sets the cpuCores and ramMegabytes of each service with a recommendation in a porter.yaml, keeping the rest of
+// the file, including comments, as it is. It returns the number of services that were updated.
+func writeRecommendedResources(porterYamlPath string, recommendations []*porter_app.ServiceResourceRecommendation) (int, error) {
+	contents, err := os.ReadFile(porterYamlPath) //nolint:gosec // the path is provided by the user running the command
+	if err != nil {
+		return 0, err
+	}
+
+	var doc yaml.Node
+	err = yaml.Unmarshal(contents, &doc)
+	if err != nil {
+		return 0, fmt.Errorf("error parsing porter.yaml: %w", err)
+	}
+
+	if doc.Kind != yaml.DocumentNode || len(doc.Content) == 0 {
+		return 0, errors.New("porter.yaml is empty")
+	}
+
+	services := mappingValue(doc.Content[0], "services")
+	if services == nil || services.Kind != yaml.MappingNode {
+		return 0, errors.New("porter.yaml does not contain any services")
+	}
+
+	updated := 0
+	for _, recommendation := range recommendations {
+		if !recommendation.HasUsage {
+			continue
+		}
+
+		service := mappingValue(services, recommendation.Name)
+		if service == nil || service.Kind != yaml.MappingNode {
+			continue
+		}
+
+		setMappingScalar(service, "cpuCores", formatCpuCores(recommendation.RecommendedCpuCores), "!!float")
+		setMappingScalar(service, "ramMegabytes", strconv.Itoa(int(recommendation.RecommendedRamMegabytes)), "!!int")
+		updated++
+	}
+
+	var buf bytes.Buffer
+	encoder := yaml.NewEncoder(&buf)
+	encoder.SetIndent(2)
+
+	err = encoder.Encode(&doc)
+	if err != nil {
+		return 0, err
+	}
+
+	err = encoder.Close()
+	if err != nil {
+		return 0, err
+	}
+
+	return updated, os.WriteFile(porterYamlPath, buf.Bytes(), 0o600)
+}
+
+// mappingValue returns the value node of a key of a mapping node, or nil if the key does not exist
+func mappingValue(mapping *yaml.Node, key string) *yaml.Node {
+	if mapping.Kind != yaml.MappingNode {
+		return nil
+	}
+
+	for i := 0; i+1 < len(mapping.Content); i += 2 {
+		if mapping.Content[i].Value == key {
+			return mapping.Content[i+1]
+		}
+	}
+
+	return nil
+}
+
+// setMappingScalar sets the value of a key of a mapping node, adding the key if it does not exist
+func setMappingScalar(mapping *yaml.Node, key, value, tag string) {
+	if existing := mappingValue(mapping, key); existing != nil {
+		existing.Kind = yaml.ScalarNode
+		existing.Tag = tag
+		existing.Value = value
+		existing.Content = nil
+		return
+	}
+
+	mapping.Content = append(mapping.Content,
+		&yaml.Node{Kind: yaml.ScalarNode, Tag: "!!str", Value: key},
+		&yaml.Node{Kind: yaml.ScalarNode, Tag: tag, Value: value},
+	)
+}
+
+func formatCpuCores(cores float32) string {
+	return strconv.FormatFloat(float64(cores), 'f', -1, 32)
+}
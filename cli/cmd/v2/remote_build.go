@@ -0,0 +1,84 @@
+package v2
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+
+	"github.com/fatih/color"
+
+	api "github.com/porter-dev/porter/api/client"
+	"github.com/porter-dev/porter/api/server/handlers/porter_app"
+	"github.com/porter-dev/porter/cli/cmd/docker"
+)
+
+// buildRemote uploads the build context of a docker build to Porter, which builds and pushes the image in the cluster of the
+// app, so that images are built on the architecture of the cluster rather than of the machine running porter apply. The output
+// of the build is written to stderr and the log writer.
+func buildRemote(ctx context.Context, client api.Client, inp buildInput, opts *docker.BuildOpts) error {
+	if len(inp.Secrets) > 0 || len(inp.SSH) > 0 {
+		return errors.New("build secrets and ssh forwarding are not supported with remote builds")
+	}
+	if len(inp.Platforms) > 1 {
+		return errors.New("multi-platform builds are not supported with remote builds")
+	}
+	if len(inp.CacheFrom) > 0 || inp.CacheTag != "" {
+		return errors.New("cacheFrom and cacheTag are not supported with remote builds")
+	}
+
+	buildContext, dockerfilePath, err := docker.BuildContextArchive(opts)
+	if err != nil {
+		return fmt.Errorf("error archiving build context: %w", err)
+	}
+	defer buildContext.Close() // nolint:errcheck
+
+	req := &porter_app.RemoteBuildRequest{
+		ServiceName: inp.ServiceName,
+		Repository:  inp.RepositoryURL,
+		Tag:         inp.ImageTag,
+		Dockerfile:  filepath.ToSlash(dockerfilePath),
+		BuildArgs:   opts.Env,
+	}
+	if len(inp.Platforms) == 1 {
+		req.Platform = inp.Platforms[0]
+	}
+
+	output := opts.Output()
+	fmt.Fprintf(output, "Uploading build context of %s to build it remotely\n", buildLabel(inp)) // nolint:errcheck,gosec
+
+	var done *porter_app.RemoteBuildDoneEvent
+	err = client.CreateRemoteBuild(ctx, inp.ProjectID, inp.ClusterID, inp.AppName, req, buildContext, func(event porter_app.RemoteBuildEventType, data []byte) error {
+		switch event {
+		case porter_app.RemoteBuildEventType_Log:
+			logEvent := porter_app.RemoteBuildLogEvent{}
+			if err := json.Unmarshal(data, &logEvent); err != nil {
+				return fmt.Errorf("error decoding build log: %w", err)
+			}
+			fmt.Fprintln(output, logEvent.Line) // nolint:errcheck,gosec
+		case porter_app.RemoteBuildEventType_Done:
+			done = &porter_app.RemoteBuildDoneEvent{}
+			if err := json.Unmarshal(data, done); err != nil {
+				return fmt.Errorf("error decoding build result: %w", err)
+			}
+		}
+
+		return nil
+	})
+	if err != nil {
+		return fmt.Errorf("error running remote build: %w", err)
+	}
+
+	if done == nil {
+		return errors.New("remote build stream ended before the build completed")
+	}
+	if done.Status != porter_app.RemoteBuildStatus_Succeeded {
+		return fmt.Errorf("remote build failed: %s", done.Message)
+	}
+
+	color.New(color.FgGreen).Fprintf(os.Stderr, "Built and pushed %s remotely\n", buildLabel(inp)) // nolint:errcheck,gosec
+
+	return nil
+}
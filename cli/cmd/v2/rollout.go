@@ -0,0 +1,79 @@
+package v2
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"os"
+
+	"github.com/fatih/color"
+	api "github.com/porter-dev/porter/api/client"
+	"github.com/porter-dev/porter/api/server/handlers/porter_app"
+	"github.com/porter-dev/porter/cli/cmd/config"
+	porter_app_kube "github.com/porter-dev/porter/internal/kubernetes/porter_app"
+)
+
+// waitForRollout tails the rollout progress of an app revision, returning an error if the rollout fails or times out. If the
+// progress cannot be streamed, for example from an older Porter server, a warning is printed and the rollout is not waited on.
+func waitForRollout(ctx context.Context, cliConf config.CLIConfig, client api.Client, appName, appRevisionID, deploymentTargetID string) error {
+	color.New(color.FgGreen).Printf("Waiting for the rollout of revision %s to complete\n", appRevisionID) // nolint:errcheck,gosec
+
+	var done *porter_app.DeployDoneEvent
+
+	err := client.StreamDeployEvents(ctx, cliConf.Project, cliConf.Cluster, appName, appRevisionID, deploymentTargetID, func(eventType porter_app.DeployEventType, data []byte) error {
+		switch eventType {
+		case porter_app.DeployEventType_Progress:
+			event := porter_app_kube.RolloutEvent{}
+			if err := json.Unmarshal(data, &event); err != nil {
+				return fmt.Errorf("error parsing deploy event: %w", err)
+			}
+
+			printRolloutEvent(event)
+		case porter_app.DeployEventType_Done:
+			done = &porter_app.DeployDoneEvent{}
+			if err := json.Unmarshal(data, done); err != nil {
+				return fmt.Errorf("error parsing deploy event: %w", err)
+			}
+		}
+
+		return nil
+	})
+	if err != nil {
+		color.New(color.FgYellow).Fprintf(os.Stderr, "Unable to stream rollout progress, not waiting for the rollout to complete: %s\n", err.Error()) // nolint:errcheck,gosec
+		return nil
+	}
+
+	if done == nil {
+		color.New(color.FgYellow).Fprintf(os.Stderr, "Rollout progress stream ended before the rollout completed\n") // nolint:errcheck,gosec
+		return nil
+	}
+
+	switch done.Status {
+	case porter_app.DeployStatus_Succeeded:
+		color.New(color.FgGreen).Printf("Rollout of revision %s completed\n", appRevisionID) // nolint:errcheck,gosec
+		return nil
+	case porter_app.DeployStatus_TimedOut:
+		return fmt.Errorf("rollout of revision %s timed out: %s", appRevisionID, done.Message)
+	default:
+		return fmt.Errorf("rollout of revision %s failed: %s", appRevisionID, done.Message)
+	}
+}
+
+func printRolloutEvent(event porter_app_kube.RolloutEvent) {
+	c := color.New(color.FgCyan)
+	if event.Failed {
+		c = color.New(color.FgRed)
+	} else if event.Type == porter_app_kube.RolloutEventType_SchedulingFailed {
+		c = color.New(color.FgYellow)
+	}
+
+	line := fmt.Sprintf("[%s] %s", event.Service, event.Type)
+	if event.Pod != "" {
+		line = fmt.Sprintf("%s %s", line, event.Pod)
+	}
+	if event.Message != "" {
+		line = fmt.Sprintf("%s: %s", line, event.Message)
+	}
+
+	c.Println(line) // nolint:errcheck,gosec
+}
@@ -0,0 +1,27 @@
+package v2
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"strings"
+
+	v2 "github.com/porter-dev/porter/internal/porter_app/v2"
+)
+
+// Schema implements the functionality of the `porter app schema` command. It prints the JSON Schema of v2 porter.yaml files,
+// which is generated by the CLI so that it matches the porter.yaml format the CLI validates.
+func Schema() error {
+	schema, err := json.MarshalIndent(v2.PorterYAMLJSONSchema(), "", "  ")
+	if err != nil {
+		return fmt.Errorf("error marshaling porter yaml schema: %w", err)
+	}
+
+	_, err = fmt.Fprintln(os.Stdout, string(schema))
+	return err
+}
+
+// SchemaURL returns the URL at which a Porter server serves the JSON Schema of v2 porter.yaml files
+func SchemaURL(host string) string {
+	return strings.TrimSuffix(host, "/") + v2.JSONSchemaPath
+}
@@ -0,0 +1,73 @@
+package v2
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"os"
+	"text/tabwriter"
+
+	"github.com/fatih/color"
+	api "github.com/porter-dev/porter/api/client"
+	"github.com/porter-dev/porter/cli/cmd/config"
+)
+
+// AppStatus implements the functionality of the `porter app status` command for validate apply v2 projects
+func AppStatus(ctx context.Context, cliConf config.CLIConfig, client api.Client, appName string) error {
+	targetResp, err := client.DefaultDeploymentTarget(ctx, cliConf.Project, cliConf.Cluster)
+	if err != nil {
+		return fmt.Errorf("error calling default deployment target endpoint: %w", err)
+	}
+
+	if targetResp.DeploymentTargetID == "" {
+		return errors.New("deployment target id is empty")
+	}
+
+	status, err := client.AppStatus(ctx, cliConf.Project, cliConf.Cluster, appName, targetResp.DeploymentTargetID)
+	if err != nil {
+		return fmt.Errorf("error getting app status: %w", err)
+	}
+
+	fmt.Printf("App:      %s\n", status.AppName)
+	fmt.Printf("Revision: %d (%s)\n", status.RevisionNumber, status.RevisionStatus)
+	fmt.Printf("Image:    %s\n", status.ImageTag)
+	if status.CommitSHA != "" {
+		fmt.Printf("Commit:   %s\n", status.CommitSHA)
+	}
+	if status.GitBranch != "" {
+		fmt.Printf("Branch:   %s\n", status.GitBranch)
+	}
+	if status.GitRepoURL != "" {
+		fmt.Printf("Repo:     %s\n", status.GitRepoURL)
+	}
+	if status.DeployedBy != "" {
+		fmt.Printf("Deployer: %s\n", status.DeployedBy)
+	}
+	fmt.Println()
+
+	w := new(tabwriter.Writer)
+	w.Init(os.Stdout, 3, 8, 2, ' ', 0)
+
+	fmt.Fprintln(w, "SERVICE\tTYPE\tREADY\tRESTARTS")
+	for _, service := range status.Services {
+		fmt.Fprintf(w, "%s\t%s\t%d/%d\t%d\n", service.Name, service.Type, service.ReadyReplicas, service.DesiredReplicas, service.Restarts)
+	}
+
+	err = w.Flush()
+	if err != nil {
+		return err
+	}
+
+	for _, service := range status.Services {
+		if len(service.RecentFailures) == 0 {
+			continue
+		}
+
+		_, _ = color.New(color.FgYellow).Printf("\nRecent failures for %s:\n", service.Name)
+		for _, failure := range service.RecentFailures {
+			fmt.Printf("  %s  %s: %s\n", failure.Timestamp.Local().Format("2006-01-02 15:04:05"), failure.Reason, failure.Message)
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,74 @@
+package v2
+
+import (
+	"context"
+	"encoding/base64"
+	"fmt"
+	"os"
+	"strings"
+
+	"github.com/fatih/color"
+
+	api "github.com/porter-dev/porter/api/client"
+	"github.com/porter-dev/porter/cli/cmd/config"
+	"github.com/porter-dev/porter/internal/porter_app"
+)
+
+// ValidateLocal implements the functionality of the `porter app validate --local` command. It validates the porter.yaml files at
+// porterYamlPaths, merged and with the env vars in allowedEnv interpolated, without calling the Porter API, and prints each
+// problem found. Problems are printed with the line of the field they refer to, unless several files are merged.
+func ValidateLocal(ctx context.Context, porterYamlPaths []string, allowedEnv []string) error {
+	porterYaml, err := readPorterYAML(porterYamlPaths, allowedEnv)
+	if err != nil {
+		return err
+	}
+
+	validationErrs, err := porter_app.ValidateYAML(ctx, porterYaml)
+	if err != nil {
+		return err
+	}
+
+	name := strings.Join(porterYamlPaths, " + ")
+	if len(validationErrs) > 0 {
+		red := color.New(color.FgRed)
+		for _, validationErr := range validationErrs {
+			position := name
+			// the lines of a merged file do not match the lines of the files it was merged from
+			if validationErr.Line != 0 && len(porterYamlPaths) == 1 {
+				position = fmt.Sprintf("%s:%d:%d", name, validationErr.Line, validationErr.Column)
+			}
+
+			message := validationErr.Message
+			if validationErr.Field != "" {
+				message = fmt.Sprintf("%s %s", validationErr.Field, validationErr.Message)
+			}
+
+			red.Fprintf(os.Stderr, "%s: %s\n", position, message) // nolint:errcheck,gosec
+		}
+
+		return fmt.Errorf("%s has %d problem(s)", name, len(validationErrs))
+	}
+
+	return nil
+}
+
+// Validate implements the functionality of the `porter app validate` command for validate apply v2 projects. The porter.yaml
+// files are validated locally, then parsed by the Porter API to catch problems which depend on the server version.
+func Validate(ctx context.Context, cliConf config.CLIConfig, client api.Client, porterYamlPaths []string, allowedEnv []string) error {
+	err := ValidateLocal(ctx, porterYamlPaths, allowedEnv)
+	if err != nil {
+		return err
+	}
+
+	porterYaml, err := readPorterYAML(porterYamlPaths, allowedEnv)
+	if err != nil {
+		return err
+	}
+
+	_, err = client.ParseYAML(ctx, cliConf.Project, cliConf.Cluster, base64.StdEncoding.EncodeToString(porterYaml))
+	if err != nil {
+		return fmt.Errorf("error calling parse yaml endpoint: %w", err)
+	}
+
+	return nil
+}
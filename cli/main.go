@@ -35,6 +35,12 @@ func main() {
 		defer sentry.Flush(2 * time.Second)
 	}
 
+	// the CLI is linked as docker-credential-porter by "porter docker configure"
+	if commands.IsDockerCredentialHelper() {
+		commands.ServeDockerCredentialHelper()
+		return
+	}
+
 	err := commands.Execute(ctx)
 	if err != nil {
 		color.New(color.FgRed).Fprintf(os.Stderr, "error executing command: %s\n", err)
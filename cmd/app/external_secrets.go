@@ -0,0 +1,68 @@
+package main
+
+import (
+	"context"
+	"time"
+
+	"github.com/porter-dev/porter/api/server/shared/config"
+	externalsecrets "github.com/porter-dev/porter/internal/integrations/external_secrets"
+	"github.com/porter-dev/porter/internal/kubernetes"
+)
+
+// externalSecretsSyncInterval is how often external secrets integrations are checked for a scheduled sync
+const externalSecretsSyncInterval = time.Minute
+
+// syncExternalSecrets periodically syncs the external secrets integrations whose sync interval has elapsed, until ctx is cancelled
+func syncExternalSecrets(ctx context.Context, conf *config.Config) {
+	ticker := time.NewTicker(externalSecretsSyncInterval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+
+		integrations, err := conf.Repo.ExternalSecretsIntegration().ListScheduledExternalSecretsIntegrations(ctx)
+		if err != nil {
+			conf.Logger.Error().Err(err).Msg("error listing external secrets integrations")
+			continue
+		}
+
+		now := time.Now()
+		for _, integration := range integrations {
+			if !integration.SyncDue(now) {
+				continue
+			}
+
+			cluster, err := conf.Repo.Cluster().ReadCluster(ctx, integration.ProjectID, integration.ClusterID)
+			if err != nil {
+				conf.Logger.Error().Err(err).Uint("integration_id", integration.ID).Msg("error reading cluster for external secrets integration")
+				continue
+			}
+
+			agent, err := kubernetes.GetAgentOutOfClusterConfig(ctx, &kubernetes.OutOfClusterConfig{
+				Repo:                        conf.Repo,
+				DigitalOceanOAuth:           conf.DOConf,
+				Cluster:                     cluster,
+				AllowInClusterConnections:   conf.ServerConf.InitInCluster,
+				CAPIManagementClusterClient: conf.ClusterControlPlaneClient,
+			})
+			if err != nil {
+				conf.Logger.Error().Err(err).Uint("integration_id", integration.ID).Msg("error connecting to cluster for external secrets integration")
+				continue
+			}
+
+			result, err := externalsecrets.Sync(ctx, agent, conf.Repo, integration)
+			if err != nil {
+				conf.Logger.Error().Err(err).Uint("integration_id", integration.ID).Msg("error syncing external secrets")
+				continue
+			}
+
+			if result.Changed {
+				conf.Logger.Info().Uint("integration_id", integration.ID).Msgf("synced external secrets to %s version %d", integration.EnvironmentGroupName, result.Version)
+			}
+		}
+	}
+}
@@ -55,7 +55,7 @@ func main() {
 	}
 
 	config.Logger.Info().Msg("Initializing data")
-	err = initData(config)
+	err = initData(ctx, config)
 	if err != nil {
 		log.Fatal("Data initialization failed: ", err)
 	}
@@ -100,6 +100,26 @@ func main() {
 			config.Logger.Info().Msg("Shutting down PorterAPI server")
 			return nil
 		})
+
+		g.Go(func() error {
+			sweepClusterCandidates(ctx, config)
+			return nil
+		})
+
+		g.Go(func() error {
+			syncExternalSecrets(ctx, config)
+			return nil
+		})
+
+		g.Go(func() error {
+			checkCustomDomains(ctx, config)
+			return nil
+		})
+
+		g.Go(func() error {
+			collectRegistryGarbage(ctx, config)
+			return nil
+		})
 	}
 
 	termFunc := func() error {
@@ -129,7 +149,7 @@ const (
 	defaultClusterName = "cluster-1"
 )
 
-func initData(conf *config.Config) error {
+func initData(ctx context.Context, conf *config.Config) error {
 	// if the config specifies in-cluster connections are permitted, create a new project with a
 	// cluster that uses the in-cluster config. this will be the default project for this instance.
 	if conf.ServerConf.InitInCluster {
@@ -137,12 +157,12 @@ func initData(conf *config.Config) error {
 		l.Debug().Msg("in-cluster config variable set: checking for default project and cluster")
 
 		// look for a project with id 1 with name of defaultProjectName
-		_, err := conf.Repo.Project().ReadProject(1)
+		_, err := conf.Repo.Project().ReadProject(ctx, 1)
 
 		if err != nil && errors.Is(err, gorm.ErrRecordNotFound) {
 			l.Debug().Msg("default project not found: attempting creation")
 
-			_, err = conf.Repo.Project().CreateProject(&models.Project{
+			_, err = conf.Repo.Project().CreateProject(ctx, &models.Project{
 				Name: defaultProjectName,
 			})
 
@@ -156,7 +176,7 @@ func initData(conf *config.Config) error {
 		}
 
 		// determine if there are any clusters in the project already
-		clusters, err := conf.Repo.Cluster().ListClustersByProjectID(1)
+		clusters, err := conf.Repo.Cluster().ListClustersByProjectID(ctx, 1)
 		if err != nil {
 			return err
 		}
@@ -171,12 +191,12 @@ func initData(conf *config.Config) error {
 			}
 		}
 
-		_, err = conf.Repo.Cluster().ReadCluster(1, 1)
+		_, err = conf.Repo.Cluster().ReadCluster(ctx, 1, 1)
 
 		if err != nil && errors.Is(err, gorm.ErrRecordNotFound) {
 			l.Debug().Msg("default cluster not found: attempting creation")
 
-			_, err = conf.Repo.Cluster().CreateCluster(&models.Cluster{
+			_, err = conf.Repo.Cluster().CreateCluster(ctx, &models.Cluster{
 				Name:                defaultClusterName,
 				AuthMechanism:       models.InCluster,
 				ProjectID:           1,
@@ -0,0 +1,65 @@
+package main
+
+import (
+	"context"
+	"time"
+
+	"github.com/porter-dev/porter/api/server/shared/config"
+	"github.com/porter-dev/porter/internal/registry/gc"
+)
+
+const (
+	// registryGCCheckInterval is how often registry garbage collection policies are checked for a scheduled run
+	registryGCCheckInterval = time.Hour
+
+	// registryGCRunInterval is how often an enabled registry garbage collection policy is applied
+	registryGCRunInterval = 24 * time.Hour
+)
+
+// collectRegistryGarbage periodically applies the enabled registry garbage collection policies, until ctx is cancelled
+func collectRegistryGarbage(ctx context.Context, conf *config.Config) {
+	ticker := time.NewTicker(registryGCCheckInterval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+
+		policies, err := conf.Repo.RegistryGCPolicy().ListEnabledRegistryGCPolicies(ctx)
+		if err != nil {
+			conf.Logger.Error().Err(err).Msg("error listing registry gc policies")
+			continue
+		}
+
+		for _, policy := range policies {
+			now := time.Now()
+			if policy.LastRunAt != nil && now.Sub(*policy.LastRunAt) < registryGCRunInterval {
+				continue
+			}
+
+			res, err := gc.Run(ctx, conf, policy, false)
+			if err != nil {
+				conf.Logger.Error().Err(err).Uint("project_id", policy.ProjectID).Msg("error running registry gc")
+				continue
+			}
+
+			for _, result := range res.Registries {
+				for _, resultErr := range result.Errors {
+					conf.Logger.Error().Uint("project_id", policy.ProjectID).Uint("registry_id", result.RegistryID).Msg(resultErr)
+				}
+
+				if len(result.DeletedTags) > 0 {
+					conf.Logger.Info().Uint("project_id", policy.ProjectID).Uint("registry_id", result.RegistryID).Msgf("deleted %d stale image tags", len(result.DeletedTags))
+				}
+			}
+
+			policy.LastRunAt = &now
+			if _, err := conf.Repo.RegistryGCPolicy().UpdateRegistryGCPolicy(ctx, policy); err != nil {
+				conf.Logger.Error().Err(err).Uint("project_id", policy.ProjectID).Msg("error updating registry gc policy")
+			}
+		}
+	}
+}
@@ -0,0 +1,32 @@
+package main
+
+import (
+	"context"
+	"time"
+
+	"github.com/porter-dev/porter/api/server/shared/config"
+)
+
+// clusterCandidateSweepInterval is how often expired cluster candidates are deleted
+const clusterCandidateSweepInterval = time.Hour
+
+// sweepClusterCandidates periodically deletes cluster candidates which expired without being resolved, until ctx is cancelled
+func sweepClusterCandidates(ctx context.Context, conf *config.Config) {
+	ticker := time.NewTicker(clusterCandidateSweepInterval)
+	defer ticker.Stop()
+
+	for {
+		deleted, err := conf.Repo.Cluster().DeleteExpiredClusterCandidates(ctx, time.Now())
+		if err != nil {
+			conf.Logger.Error().Err(err).Msg("error deleting expired cluster candidates")
+		} else if deleted > 0 {
+			conf.Logger.Info().Msgf("deleted %d expired cluster candidates", deleted)
+		}
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
@@ -1,6 +1,7 @@
 package enable_cluster_preview_envs
 
 import (
+	"context"
 	"testing"
 
 	lr "github.com/porter-dev/porter/pkg/logger"
@@ -26,7 +27,7 @@ func TestEnableForProjectEnabled(t *testing.T) {
 		return
 	}
 
-	cluster, err := tester.repo.Cluster().ReadCluster(1, 1)
+	cluster, err := tester.repo.Cluster().ReadCluster(context.Background(), 1, 1)
 	if err != nil {
 		t.Fatalf("%v\n", err)
 		return
@@ -57,7 +58,7 @@ func TestEnableForProjectDisabled(t *testing.T) {
 		return
 	}
 
-	cluster, err := tester.repo.Cluster().ReadCluster(1, 1)
+	cluster, err := tester.repo.Cluster().ReadCluster(context.Background(), 1, 1)
 	if err != nil {
 		t.Fatalf("%v\n", err)
 		return
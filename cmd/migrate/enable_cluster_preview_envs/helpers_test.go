@@ -1,6 +1,7 @@
 package enable_cluster_preview_envs
 
 import (
+	"context"
 	"os"
 	"testing"
 	"time"
@@ -80,7 +81,7 @@ func initUser(tester *tester, t *testing.T) {
 		Password: "hello1234",
 	}
 
-	user, err := tester.repo.User().CreateUser(user)
+	user, err := tester.repo.User().CreateUser(context.Background(), user)
 	if err != nil {
 		t.Fatalf("%v\n", err)
 	}
@@ -109,7 +110,7 @@ func initCluster(tester *tester, t *testing.T) {
 		},
 	}
 
-	cluster, err := tester.repo.Cluster().CreateCluster(cluster)
+	cluster, err := tester.repo.Cluster().CreateCluster(context.Background(), cluster)
 	if err != nil {
 		t.Fatalf("%v\n", err)
 	}
@@ -125,7 +126,7 @@ func initProjectPreviewEnabled(tester *tester, t *testing.T) {
 		PreviewEnvsEnabled: true,
 	}
 
-	proj, err := tester.repo.Project().CreateProject(proj)
+	proj, err := tester.repo.Project().CreateProject(context.Background(), proj)
 	if err != nil {
 		t.Fatalf("%v\n", err)
 	}
@@ -140,7 +141,7 @@ func initProjectPreviewDisabled(tester *tester, t *testing.T) {
 		Name: "project-test",
 	}
 
-	proj, err := tester.repo.Project().CreateProject(proj)
+	proj, err := tester.repo.Project().CreateProject(context.Background(), proj)
 	if err != nil {
 		t.Fatalf("%v\n", err)
 	}
@@ -167,7 +168,7 @@ func initKubeIntegration(tester *tester, t *testing.T) {
 		Password:              []byte("password"),
 	}
 
-	ki, err := tester.repo.KubeIntegration().CreateKubeIntegration(ki)
+	ki, err := tester.repo.KubeIntegration().CreateKubeIntegration(context.Background(), ki)
 	if err != nil {
 		t.Fatalf("%v\n", err)
 	}
@@ -0,0 +1,60 @@
+package encrypt_sub_events
+
+import (
+	"github.com/porter-dev/porter/internal/models"
+	"github.com/porter-dev/porter/internal/repository/gorm"
+	lr "github.com/porter-dev/porter/pkg/logger"
+	_gorm "gorm.io/gorm"
+)
+
+// process 100 records at a time
+const stepSize = 100
+
+// EncryptSubEvents encrypts the info of all sub events which were stored in plaintext. Sub events which
+// are already encrypted are left unchanged, so the migration can be run more than once.
+func EncryptSubEvents(db *_gorm.DB, key *[32]byte, logger *lr.Logger) error {
+	logger.Info().Msg("Initiated sub event encryption")
+
+	repo := gorm.NewBuildEventRepository(db, key).(*gorm.BuildEventRepository)
+
+	var lastID uint
+	updatedCount := 0
+
+	for {
+		subEvents := make([]*models.SubEvent, 0)
+
+		if err := db.Where("id > ?", lastID).Order("id asc").Limit(stepSize).Find(&subEvents).Error; err != nil {
+			logger.Error().Msgf("failed to get sub events %v", err)
+			return err
+		}
+
+		if len(subEvents) == 0 {
+			break
+		}
+
+		for _, subEvent := range subEvents {
+			lastID = subEvent.ID
+
+			info := subEvent.Info
+
+			if err := repo.EncryptSubEventData(subEvent, key); err != nil {
+				logger.Error().Msgf("failed to encrypt sub event %d: %v", subEvent.ID, err)
+				return err
+			}
+
+			if subEvent.Info == info {
+				continue
+			}
+
+			if err := db.Model(subEvent).UpdateColumn("info", subEvent.Info).Error; err != nil {
+				logger.Error().Msgf("failed to update sub event %d: %v", subEvent.ID, err)
+				return err
+			}
+
+			updatedCount++
+		}
+	}
+
+	logger.Info().Msgf("sub event encryption completed, %d sub events updated", updatedCount)
+	return nil
+}
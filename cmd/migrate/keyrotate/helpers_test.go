@@ -1,6 +1,7 @@
 package keyrotate_test
 
 import (
+	"context"
 	"os"
 	"testing"
 	"time"
@@ -66,6 +67,7 @@ func setupTestEnv(tester *tester, t *testing.T) {
 		&models.Cluster{},
 		&models.ClusterCandidate{},
 		&models.ClusterResolver{},
+		&models.SubEvent{},
 		&models.Infra{},
 		&models.GitActionConfig{},
 		&models.Onboarding{},
@@ -112,7 +114,7 @@ func initUser(tester *tester, t *testing.T) {
 		Password: "hello1234",
 	}
 
-	user, err := tester.repo.User().CreateUser(user)
+	user, err := tester.repo.User().CreateUser(context.Background(), user)
 	if err != nil {
 		t.Fatalf("%v\n", err)
 	}
@@ -127,7 +129,7 @@ func initProject(tester *tester, t *testing.T) {
 		Name: "project-test",
 	}
 
-	proj, err := tester.repo.Project().CreateProject(proj)
+	proj, err := tester.repo.Project().CreateProject(context.Background(), proj)
 	if err != nil {
 		t.Fatalf("%v\n", err)
 	}
@@ -146,7 +148,7 @@ func initProjectRole(tester *tester, t *testing.T) {
 		},
 	}
 
-	role, err := tester.repo.Project().CreateProjectRole(tester.initProjects[0], role)
+	role, err := tester.repo.Project().CreateProjectRole(context.Background(), tester.initProjects[0], role)
 	if err != nil {
 		t.Fatalf("%v\n", err)
 	}
@@ -175,7 +177,7 @@ func initKubeIntegration(tester *tester, t *testing.T) {
 		Password:              []byte("password"),
 	}
 
-	ki, err := tester.repo.KubeIntegration().CreateKubeIntegration(ki)
+	ki, err := tester.repo.KubeIntegration().CreateKubeIntegration(context.Background(), ki)
 	if err != nil {
 		t.Fatalf("%v\n", err)
 	}
@@ -201,7 +203,7 @@ func initBasicIntegration(tester *tester, t *testing.T) {
 		Password:  []byte("password"),
 	}
 
-	basic, err := tester.repo.BasicIntegration().CreateBasicIntegration(basic)
+	basic, err := tester.repo.BasicIntegration().CreateBasicIntegration(context.Background(), basic)
 	if err != nil {
 		t.Fatalf("%v\n", err)
 	}
@@ -232,7 +234,7 @@ func initOIDCIntegration(tester *tester, t *testing.T) {
 		RefreshToken:             []byte("refreshtoken"),
 	}
 
-	oidc, err := tester.repo.OIDCIntegration().CreateOIDCIntegration(oidc)
+	oidc, err := tester.repo.OIDCIntegration().CreateOIDCIntegration(context.Background(), oidc)
 	if err != nil {
 		t.Fatalf("%v\n", err)
 	}
@@ -262,7 +264,7 @@ func initOAuthIntegration(tester *tester, t *testing.T) {
 		UserID:    tester.initUsers[0].ID,
 	}
 
-	oauth, err := tester.repo.OAuthIntegration().CreateOAuthIntegration(oauth)
+	oauth, err := tester.repo.OAuthIntegration().CreateOAuthIntegration(context.Background(), oauth)
 	if err != nil {
 		t.Fatalf("%v\n", err)
 	}
@@ -289,7 +291,7 @@ func initGCPIntegration(tester *tester, t *testing.T) {
 		GCPKeyData:   []byte("{\"test\":\"key\"}"),
 	}
 
-	gcp, err := tester.repo.GCPIntegration().CreateGCPIntegration(gcp)
+	gcp, err := tester.repo.GCPIntegration().CreateGCPIntegration(context.Background(), gcp)
 	if err != nil {
 		t.Fatalf("%v\n", err)
 	}
@@ -317,7 +319,7 @@ func initAWSIntegration(tester *tester, t *testing.T) {
 		AWSSessionToken:    []byte("optional"),
 	}
 
-	aws, err := tester.repo.AWSIntegration().CreateAWSIntegration(aws)
+	aws, err := tester.repo.AWSIntegration().CreateAWSIntegration(context.Background(), aws)
 	if err != nil {
 		t.Fatalf("%v\n", err)
 	}
@@ -344,7 +346,7 @@ func initClusterCandidate(tester *tester, t *testing.T) {
 		Kubeconfig:        []byte("current-context: testing\n"),
 	}
 
-	cc, err := tester.repo.Cluster().CreateClusterCandidate(cc)
+	cc, err := tester.repo.Cluster().CreateClusterCandidate(context.Background(), cc)
 	if err != nil {
 		t.Fatalf("%v\n", err)
 	}
@@ -377,7 +379,7 @@ func initCluster(tester *tester, t *testing.T) {
 		},
 	}
 
-	cluster, err := tester.repo.Cluster().CreateCluster(cluster)
+	cluster, err := tester.repo.Cluster().CreateCluster(context.Background(), cluster)
 	if err != nil {
 		t.Fatalf("%v\n", err)
 	}
@@ -402,7 +404,7 @@ func initGitRepo(tester *tester, t *testing.T) {
 		OAuthIntegrationID: tester.initOAuths[0].ID,
 	}
 
-	gr, err := tester.repo.GitRepo().CreateGitRepo(gr)
+	gr, err := tester.repo.GitRepo().CreateGitRepo(context.Background(), gr)
 	if err != nil {
 		t.Fatalf("%v\n", err)
 	}
@@ -428,7 +430,7 @@ func initRegistry(tester *tester, t *testing.T) {
 		},
 	}
 
-	reg, err := tester.repo.Registry().CreateRegistry(reg)
+	reg, err := tester.repo.Registry().CreateRegistry(context.Background(), reg)
 	if err != nil {
 		t.Fatalf("%v\n", err)
 	}
@@ -455,7 +457,7 @@ func initHelmRepo(tester *tester, t *testing.T) {
 		},
 	}
 
-	hr, err := tester.repo.HelmRepo().CreateHelmRepo(hr)
+	hr, err := tester.repo.HelmRepo().CreateHelmRepo(context.Background(), hr)
 	if err != nil {
 		t.Fatalf("%v\n", err)
 	}
@@ -477,7 +479,7 @@ func initInfra(tester *tester, t *testing.T) {
 		LastApplied: []byte("testing"),
 	}
 
-	infra, err := tester.repo.Infra().CreateInfra(infra)
+	infra, err := tester.repo.Infra().CreateInfra(context.Background(), infra)
 	if err != nil {
 		t.Fatalf("%v\n", err)
 	}
@@ -500,7 +502,7 @@ func initRelease(tester *tester, t *testing.T) {
 		WebhookToken: "abcdefgh",
 	}
 
-	release, err := tester.repo.Release().CreateRelease(release)
+	release, err := tester.repo.Release().CreateRelease(context.Background(), release)
 	if err != nil {
 		t.Fatalf("%v\n", err)
 	}
@@ -107,6 +107,14 @@ func Rotate(db *_gorm.DB, oldKey, newKey *[32]byte) error {
 		return err
 	}
 
+	err = rotateSubEventModel(db, oldKey, newKey)
+
+	if err != nil {
+		fmt.Printf("failed on sub event rotation: %v\n", err)
+
+		return err
+	}
+
 	return nil
 }
 
@@ -704,3 +712,54 @@ func rotateAWSIntegrationModel(db *_gorm.DB, oldKey, newKey *[32]byte) error {
 
 	return nil
 }
+
+func rotateSubEventModel(db *_gorm.DB, oldKey, newKey *[32]byte) error {
+	// get count of model
+	var count int64
+
+	if err := db.Model(&models.SubEvent{}).Count(&count).Error; err != nil {
+		return err
+	}
+
+	// build-event-scoped repository
+	repo := gorm.NewBuildEventRepository(db, oldKey).(*gorm.BuildEventRepository)
+
+	// iterate (count / stepSize) + 1 times using Limit and Offset
+	for i := 0; i < (int(count)/stepSize)+1; i++ {
+		subEvents := []*models.SubEvent{}
+
+		if err := db.Order("id asc").Offset(i * stepSize).Limit(stepSize).Find(&subEvents).Error; err != nil {
+			return err
+		}
+
+		// decrypt with the old key
+		for _, subEvent := range subEvents {
+			err := repo.DecryptSubEventData(subEvent, oldKey)
+			if err != nil {
+				fmt.Printf("error decrypting sub event %d\n", subEvent.ID)
+
+				// in these cases we'll wipe the data -- if it can't be decrypted, we can't
+				// recover it
+				subEvent.Info = ""
+			}
+		}
+
+		// encrypt with the new key and re-insert
+		for _, subEvent := range subEvents {
+			err := repo.EncryptSubEventData(subEvent, newKey)
+			if err != nil {
+				fmt.Printf("error encrypting sub event %d\n", subEvent.ID)
+
+				return err
+			}
+
+			if err := db.Save(subEvent).Error; err != nil {
+				return err
+			}
+		}
+	}
+
+	fmt.Printf("rotated %d sub events\n", count)
+
+	return nil
+}
@@ -1,6 +1,7 @@
 package keyrotate_test
 
 import (
+	"context"
 	"testing"
 
 	"github.com/porter-dev/porter/cmd/migrate/keyrotate"
@@ -44,7 +45,7 @@ func TestClusterModelRotation(t *testing.T) {
 
 	// decrypt with the old key
 	for _, c := range clusters {
-		cluster, err := repo.ReadCluster(c.ProjectID, c.ID)
+		cluster, err := repo.ReadCluster(context.Background(), c.ProjectID, c.ID)
 		if err != nil {
 			t.Fatalf("error reading cluster: %v\n", err)
 		}
@@ -94,7 +95,7 @@ func TestClusterCandidateModelRotation(t *testing.T) {
 
 	// decrypt with the old key
 	for _, c := range ccs {
-		cc, err := repo.ReadClusterCandidate(c.ProjectID, c.ID)
+		cc, err := repo.ReadClusterCandidate(context.Background(), c.ProjectID, c.ID)
 		if err != nil {
 			t.Fatalf("error reading cluster: %v\n", err)
 		}
@@ -144,7 +145,7 @@ func TestRegistryModelRotation(t *testing.T) {
 
 	// decrypt with the old key
 	for _, r := range regs {
-		registry, err := repo.ReadRegistry(r.ProjectID, r.ID)
+		registry, err := repo.ReadRegistry(context.Background(), r.ProjectID, r.ID)
 		if err != nil {
 			t.Fatalf("error reading registry: %v\n", err)
 		}
@@ -190,7 +191,7 @@ func TestHelmRepoModelRotation(t *testing.T) {
 
 	// decrypt with the old key
 	for _, h := range hrs {
-		hr, err := repo.ReadHelmRepo(h.ProjectID, h.ID)
+		hr, err := repo.ReadHelmRepo(context.Background(), h.ProjectID, h.ID)
 		if err != nil {
 			t.Fatalf("error reading helm repo: %v\n", err)
 		}
@@ -236,7 +237,7 @@ func TestInfraModelRotation(t *testing.T) {
 
 	// decrypt with the old key
 	for _, i := range infras {
-		infra, err := repo.ReadInfra(i.ProjectID, i.ID)
+		infra, err := repo.ReadInfra(context.Background(), i.ProjectID, i.ID)
 		if err != nil {
 			t.Fatalf("error reading infra: %v\n", err)
 		}
@@ -282,7 +283,7 @@ func TestKubeIntegrationModelRotation(t *testing.T) {
 
 	// decrypt with the old key
 	for _, k := range kis {
-		ki, err := repo.ReadKubeIntegration(k.ProjectID, k.ID)
+		ki, err := repo.ReadKubeIntegration(context.Background(), k.ProjectID, k.ID)
 		if err != nil {
 			t.Fatalf("error reading infra: %v\n", err)
 		}
@@ -348,7 +349,7 @@ func TestBasicIntegrationModelRotation(t *testing.T) {
 
 	// decrypt with the old key
 	for _, k := range basics {
-		basic, err := repo.ReadBasicIntegration(k.ProjectID, k.ID)
+		basic, err := repo.ReadBasicIntegration(context.Background(), k.ProjectID, k.ID)
 		if err != nil {
 			t.Fatalf("error reading infra: %v\n", err)
 		}
@@ -398,7 +399,7 @@ func TestOIDCIntegrationModelRotation(t *testing.T) {
 
 	// decrypt with the old key
 	for _, k := range oidcs {
-		oidc, err := repo.ReadOIDCIntegration(k.ProjectID, k.ID)
+		oidc, err := repo.ReadOIDCIntegration(context.Background(), k.ProjectID, k.ID)
 		if err != nil {
 			t.Fatalf("error reading infra: %v\n", err)
 		}
@@ -464,7 +465,7 @@ func TestOAuthIntegrationModelRotation(t *testing.T) {
 
 	// decrypt with the old key
 	for _, k := range oauths {
-		oauth, err := repo.ReadOAuthIntegration(k.ProjectID, k.ID)
+		oauth, err := repo.ReadOAuthIntegration(context.Background(), k.ProjectID, k.ID)
 		if err != nil {
 			t.Fatalf("error reading infra: %v\n", err)
 		}
@@ -518,7 +519,7 @@ func TestGCPIntegrationModelRotation(t *testing.T) {
 
 	// decrypt with the old key
 	for _, k := range gcps {
-		gcp, err := repo.ReadGCPIntegration(k.ProjectID, k.ID)
+		gcp, err := repo.ReadGCPIntegration(context.Background(), k.ProjectID, k.ID)
 		if err != nil {
 			t.Fatalf("error reading infra: %v\n", err)
 		}
@@ -564,7 +565,7 @@ func TestAWSIntegrationModelRotation(t *testing.T) {
 
 	// decrypt with the old key
 	for _, k := range awss {
-		aws, err := repo.ReadAWSIntegration(k.ProjectID, k.ID)
+		aws, err := repo.ReadAWSIntegration(context.Background(), k.ProjectID, k.ID)
 		if err != nil {
 			t.Fatalf("error reading infra: %v\n", err)
 		}
@@ -586,3 +587,53 @@ func TestAWSIntegrationModelRotation(t *testing.T) {
 		}
 	}
 }
+
+func TestSubEventModelRotation(t *testing.T) {
+	var newKey [32]byte
+
+	for i, b := range []byte("__r3n3o3_s3r3n3_3n3r3p3i3n_k3y__") {
+		newKey[i] = b
+	}
+
+	tester := &tester{
+		dbFileName: "./porter_sub_event_rotate.db",
+	}
+
+	setupTestEnv(tester, t)
+
+	for i := 0; i < 128; i++ {
+		_, err := tester.repo.BuildEvent().CreateSubEvent(context.Background(), &models.SubEvent{
+			EventContainerID: 1,
+			Name:             "build",
+			Info:             "secret-build-output",
+		})
+		if err != nil {
+			t.Fatalf("%v\n", err)
+		}
+	}
+
+	defer cleanup(tester, t)
+
+	err := keyrotate.Rotate(tester.DB, tester.Key, &newKey)
+	if err != nil {
+		t.Fatalf("error rotating: %v\n", err)
+	}
+
+	// very all sub events decoded properly
+	repo := gorm.NewBuildEventRepository(tester.DB, &newKey)
+
+	subEvents, err := repo.ReadEventsByContainerID(context.Background(), 1)
+	if err != nil {
+		t.Fatalf("error reading sub events: %v\n", err)
+	}
+
+	if len(subEvents) != 128 {
+		t.Fatalf("expected 128 sub events, got %d\n", len(subEvents))
+	}
+
+	for _, subEvent := range subEvents {
+		if subEvent.Info != "secret-build-output" {
+			t.Errorf("%s\n", subEvent.Info)
+		}
+	}
+}
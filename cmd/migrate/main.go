@@ -6,6 +6,7 @@ import (
 	"log"
 
 	"github.com/porter-dev/porter/api/server/shared/config/envloader"
+	"github.com/porter-dev/porter/cmd/migrate/encrypt_sub_events"
 	"github.com/porter-dev/porter/cmd/migrate/keyrotate"
 	"github.com/porter-dev/porter/cmd/migrate/populate_source_config_display_name"
 	"github.com/porter-dev/porter/cmd/migrate/startup_migrations"
@@ -145,6 +146,16 @@ func main() {
 		}
 	}
 
+	if shouldEncryptSubEvents() {
+		key := [32]byte{}
+		copy(key[:], []byte(envConf.DBConf.EncryptionKey))
+
+		err := encrypt_sub_events.EncryptSubEvents(db, &key, logger)
+		if err != nil {
+			logger.Fatal().Err(err).Msg("failed to encrypt sub events")
+		}
+	}
+
 	if err := InstanceMigrate(db, envConf.DBConf); err != nil {
 		logger.Fatal().Err(err).Msg("vault migration failed")
 	}
@@ -186,3 +197,22 @@ func shouldPopulateSourceConfigDisplayName() bool {
 
 	return c.PopulateSourceConfigDisplayName
 }
+
+type EncryptSubEventsConf struct {
+	// we add a dummy field to avoid empty struct issue with envdecode
+	DummyField string `env:"ASDF,default=asdf"`
+
+	// if true, will encrypt the info of all sub events stored in plaintext
+	EncryptSubEvents bool `env:"ENCRYPT_SUB_EVENTS"`
+}
+
+func shouldEncryptSubEvents() bool {
+	var c EncryptSubEventsConf
+
+	if err := envdecode.StrictDecode(&c); err != nil {
+		log.Fatalf("Failed to decode migration conf: %s", err)
+		return false
+	}
+
+	return c.EncryptSubEvents
+}
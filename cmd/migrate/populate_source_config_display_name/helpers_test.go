@@ -1,6 +1,7 @@
 package populate_source_config_display_name_test
 
 import (
+	"context"
 	"fmt"
 	"os"
 	"testing"
@@ -88,7 +89,7 @@ func initUser(tester *tester, t *testing.T) {
 		Password: "hello1234",
 	}
 
-	user, err := tester.repo.User().CreateUser(user)
+	user, err := tester.repo.User().CreateUser(context.Background(), user)
 	if err != nil {
 		t.Fatalf("%v\n", err)
 	}
@@ -121,7 +122,7 @@ func initCluster(tester *tester, t *testing.T) {
 		},
 	}
 
-	cluster, err := tester.repo.Cluster().CreateCluster(cluster)
+	cluster, err := tester.repo.Cluster().CreateCluster(context.Background(), cluster)
 	if err != nil {
 		t.Fatalf("%v\n", err)
 	}
@@ -136,7 +137,7 @@ func initProject(tester *tester, t *testing.T) {
 		Name: "project-test",
 	}
 
-	proj, err := tester.repo.Project().CreateProject(proj)
+	proj, err := tester.repo.Project().CreateProject(context.Background(), proj)
 	if err != nil {
 		t.Fatalf("%v\n", err)
 	}
@@ -167,7 +168,7 @@ func initKubeIntegration(tester *tester, t *testing.T) {
 		Password:              []byte("password"),
 	}
 
-	ki, err := tester.repo.KubeIntegration().CreateKubeIntegration(ki)
+	ki, err := tester.repo.KubeIntegration().CreateKubeIntegration(context.Background(), ki)
 	if err != nil {
 		t.Fatalf("%v\n", err)
 	}
@@ -208,7 +209,7 @@ func initEmptyStack(tester *tester, t *testing.T, stackName string) {
 		},
 	}
 
-	newStack, err := tester.repo.Stack().CreateStack(stack)
+	newStack, err := tester.repo.Stack().CreateStack(context.Background(), stack)
 	if err != nil {
 		t.Fatalf("%v\n", err)
 	}
@@ -258,7 +259,7 @@ func initStack(tester *tester, t *testing.T, stackName string) {
 		},
 	}
 
-	newStack, err := tester.repo.Stack().CreateStack(stack)
+	newStack, err := tester.repo.Stack().CreateStack(context.Background(), stack)
 	if err != nil {
 		t.Fatalf("%v\n", err)
 	}
@@ -312,7 +313,7 @@ func createNewStackRevision(tester *tester, t *testing.T, stackName string) {
 		StackID:        stack.ID,
 	}
 
-	tester.repo.Stack().AppendNewRevision(&newRevision)
+	tester.repo.Stack().AppendNewRevision(context.Background(), &newRevision)
 }
 
 func findLatestRevisionByRevisionNumber(t *testing.T, revisions []models.StackRevision) *models.StackRevision {
@@ -354,5 +355,5 @@ func appendNewSourceConfig(t *testing.T, tester *tester, stack *models.Stack, so
 		StackID:        stack.ID,
 	}
 
-	tester.repo.Stack().AppendNewRevision(&newRevision)
+	tester.repo.Stack().AppendNewRevision(context.Background(), &newRevision)
 }
@@ -1,6 +1,7 @@
 package main
 
 import (
+	"context"
 	"flag"
 	"fmt"
 	"log"
@@ -58,7 +59,7 @@ func main() {
 
 		errorChan := make(chan error)
 
-		go redis_stream.GlobalStreamListener(redis, config, config.Repo, nil, errorChan)
+		go redis_stream.GlobalStreamListener(context.Background(), redis, config, config.Repo, nil, errorChan)
 	}
 
 	appRouter := router.NewAPIRouter(config)
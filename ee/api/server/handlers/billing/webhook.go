@@ -61,7 +61,7 @@ func (c *BillingWebhookHandler) ServeHTTP(w http.ResponseWriter, r *http.Request
 	}
 
 	// update the project's usage
-	existingUsage, err := c.Repo().ProjectUsage().ReadProjectUsage(newUsage.ProjectID)
+	existingUsage, err := c.Repo().ProjectUsage().ReadProjectUsage(r.Context(), newUsage.ProjectID)
 	notFound := errors.Is(err, gorm.ErrRecordNotFound)
 
 	if !notFound && err != nil {
@@ -70,10 +70,10 @@ func (c *BillingWebhookHandler) ServeHTTP(w http.ResponseWriter, r *http.Request
 	}
 
 	if notFound {
-		_, err = c.Repo().ProjectUsage().CreateProjectUsage(newUsage)
+		_, err = c.Repo().ProjectUsage().CreateProjectUsage(r.Context(), newUsage)
 	} else {
 		newUsage.ID = existingUsage.ID
-		_, err = c.Repo().ProjectUsage().UpdateProjectUsage(newUsage)
+		_, err = c.Repo().ProjectUsage().UpdateProjectUsage(r.Context(), newUsage)
 	}
 
 	if err != nil {
@@ -82,7 +82,7 @@ func (c *BillingWebhookHandler) ServeHTTP(w http.ResponseWriter, r *http.Request
 	}
 
 	// update the feature flags
-	project, err := c.Repo().Project().ReadProject(newUsage.ProjectID)
+	project, err := c.Repo().Project().ReadProject(r.Context(), newUsage.ProjectID)
 	if err != nil {
 		c.HandleAPIError(w, r, apierrors.NewErrInternal(err))
 		return
@@ -108,7 +108,7 @@ func (c *BillingWebhookHandler) ServeHTTP(w http.ResponseWriter, r *http.Request
 		project.CapiProvisionerEnabled = capiProvisionerEnabled
 	}
 
-	_, err = c.Repo().Project().UpdateProject(project)
+	_, err = c.Repo().Project().UpdateProject(r.Context(), project)
 
 	if err != nil {
 		c.HandleAPIError(w, r, apierrors.NewErrInternal(err))
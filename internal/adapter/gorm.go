@@ -53,6 +53,11 @@ func New(conf *env.DBConf) (*gorm.DB, error) {
 	postgresDSN := baseDSN + " database=postgres"
 	targetDSN := baseDSN + " database=" + conf.DbName
 
+	// the timeout is set as a runtime parameter of each connection, so that it applies to every statement of the app
+	if conf.StatementTimeout > 0 {
+		targetDSN = fmt.Sprintf("%s statement_timeout=%d", targetDSN, conf.StatementTimeout.Milliseconds())
+	}
+
 	defaultDB, err := gorm.Open(postgres.Open(postgresDSN), &gorm.Config{
 		FullSaveAssociations: true,
 		Logger:               logger,
@@ -95,3 +100,27 @@ func New(conf *env.DBConf) (*gorm.DB, error) {
 
 	return res, err
 }
+
+// NewReadReplica returns a gorm database instance for the read replica of the database, or nil if no replica is configured
+func NewReadReplica(conf *env.DBConf) (*gorm.DB, error) {
+	if conf.SQLLite || conf.ReadReplicaDSN == "" {
+		return nil, nil
+	}
+
+	dsn := conf.ReadReplicaDSN
+
+	if conf.StatementTimeout > 0 {
+		dsn = fmt.Sprintf("%s statement_timeout=%d", dsn, conf.StatementTimeout.Milliseconds())
+	}
+
+	return gorm.Open(postgres.Open(dsn), &gorm.Config{
+		Logger: logger.New(
+			log.New(os.Stdout, "\r\n", log.LstdFlags),
+			logger.Config{
+				SlowThreshold: time.Second,
+				LogLevel:      logger.Silent,
+				Colorful:      false,
+			},
+		),
+	})
+}
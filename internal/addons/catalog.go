@@ -0,0 +1,338 @@
+// Package addons holds the curated charts of the addon marketplace and renders the Helm values of
+// an addon from the inputs of its install form.
+package addons
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/porter-dev/porter/api/types"
+)
+
+// field is an input of an install form, with the path of the Helm value that it sets
+type field struct {
+	types.AddonFormField
+
+	// valuePath is the dot-separated path of the Helm value set by the field, such as auth.password
+	valuePath string
+}
+
+// template is a curated chart of the addon marketplace
+type template struct {
+	addonType   types.AddonType
+	name        string
+	description string
+	chartName   string
+	fields      []field
+}
+
+// catalog is the list of curated charts. The charts are loaded from the addon chart repository
+// configured on the server, whose charts follow the values layout of the Bitnami charts.
+var catalog = []template{
+	{
+		addonType:   types.AddonType_Redis,
+		name:        "Redis",
+		description: "An in-memory key-value store, for caches, queues and session storage.",
+		chartName:   "redis",
+		fields: []field{
+			{
+				AddonFormField: types.AddonFormField{
+					Key:         "password",
+					Label:       "Password",
+					Description: "The password of the default user. A random password is generated if empty.",
+					Type:        types.AddonFormFieldType_String,
+					Secret:      true,
+				},
+				valuePath: "auth.password",
+			},
+			{
+				AddonFormField: types.AddonFormField{
+					Key:         "architecture",
+					Label:       "Architecture",
+					Description: "Whether to run a single instance, or a primary with read replicas.",
+					Type:        types.AddonFormFieldType_String,
+					Default:     "standalone",
+					Options:     []string{"standalone", "replication"},
+				},
+				valuePath: "architecture",
+			},
+			{
+				AddonFormField: types.AddonFormField{
+					Key:         "replicas",
+					Label:       "Read replicas",
+					Description: "The number of read replicas, when using the replication architecture.",
+					Type:        types.AddonFormFieldType_Integer,
+					Default:     "1",
+				},
+				valuePath: "replica.replicaCount",
+			},
+			{
+				AddonFormField: types.AddonFormField{
+					Key:     "storage",
+					Label:   "Storage",
+					Type:    types.AddonFormFieldType_String,
+					Default: "8Gi",
+				},
+				valuePath: "master.persistence.size",
+			},
+		},
+	},
+	{
+		addonType:   types.AddonType_Postgres,
+		name:        "PostgreSQL",
+		description: "A relational database.",
+		chartName:   "postgresql",
+		fields: []field{
+			{
+				AddonFormField: types.AddonFormField{
+					Key:      "database",
+					Label:    "Database",
+					Type:     types.AddonFormFieldType_String,
+					Default:  "postgres",
+					Required: true,
+				},
+				valuePath: "auth.database",
+			},
+			{
+				AddonFormField: types.AddonFormField{
+					Key:      "username",
+					Label:    "Username",
+					Type:     types.AddonFormFieldType_String,
+					Default:  "postgres",
+					Required: true,
+				},
+				valuePath: "auth.username",
+			},
+			{
+				AddonFormField: types.AddonFormField{
+					Key:      "password",
+					Label:    "Password",
+					Type:     types.AddonFormFieldType_String,
+					Required: true,
+					Secret:   true,
+				},
+				valuePath: "auth.password",
+			},
+			{
+				AddonFormField: types.AddonFormField{
+					Key:     "storage",
+					Label:   "Storage",
+					Type:    types.AddonFormFieldType_String,
+					Default: "8Gi",
+				},
+				valuePath: "primary.persistence.size",
+			},
+		},
+	},
+	{
+		addonType:   types.AddonType_Kafka,
+		name:        "Kafka",
+		description: "A distributed event streaming platform, run in KRaft mode.",
+		chartName:   "kafka",
+		fields: []field{
+			{
+				AddonFormField: types.AddonFormField{
+					Key:         "brokers",
+					Label:       "Brokers",
+					Description: "The number of combined controller and broker nodes.",
+					Type:        types.AddonFormFieldType_Integer,
+					Default:     "1",
+					Required:    true,
+				},
+				valuePath: "controller.replicaCount",
+			},
+			{
+				AddonFormField: types.AddonFormField{
+					Key:     "storage",
+					Label:   "Storage per broker",
+					Type:    types.AddonFormFieldType_String,
+					Default: "8Gi",
+				},
+				valuePath: "controller.persistence.size",
+			},
+		},
+	},
+}
+
+// Templates returns the curated charts of the addon marketplace
+func Templates() []types.AddonTemplate {
+	res := make([]types.AddonTemplate, 0, len(catalog))
+
+	for _, t := range catalog {
+		res = append(res, t.toAddonTemplate())
+	}
+
+	return res
+}
+
+// Template returns the curated chart of the given addon type
+func Template(addonType types.AddonType) (types.AddonTemplate, bool) {
+	t, ok := findTemplate(addonType)
+	if !ok {
+		return types.AddonTemplate{}, false
+	}
+
+	return t.toAddonTemplate(), true
+}
+
+// SecretKeys returns the keys of the secret fields of the install form of the given addon type
+func SecretKeys(addonType types.AddonType) map[string]bool {
+	res := make(map[string]bool)
+
+	t, _ := findTemplate(addonType)
+
+	for _, f := range t.fields {
+		if f.Secret {
+			res[f.Key] = true
+		}
+	}
+
+	return res
+}
+
+// Values renders the Helm values of an addon from the inputs of its install form. Fields without an
+// input use their default, and the raw overrides are merged over the rendered values.
+func Values(addonType types.AddonType, inputs map[string]string, overrides map[string]interface{}) (map[string]interface{}, error) {
+	t, ok := findTemplate(addonType)
+	if !ok {
+		return nil, fmt.Errorf("unknown addon type %s", addonType)
+	}
+
+	for key := range inputs {
+		if _, ok := t.field(key); !ok {
+			return nil, fmt.Errorf("unknown input %s for addon type %s", key, addonType)
+		}
+	}
+
+	values := make(map[string]interface{})
+
+	for _, f := range t.fields {
+		input, ok := inputs[f.Key]
+		if !ok || input == "" {
+			input = f.Default
+		}
+
+		if input == "" {
+			if f.Required {
+				return nil, fmt.Errorf("input %s is required", f.Key)
+			}
+
+			continue
+		}
+
+		value, err := f.parse(input)
+		if err != nil {
+			return nil, err
+		}
+
+		setPath(values, strings.Split(f.valuePath, "."), value)
+	}
+
+	return mergeValues(values, overrides), nil
+}
+
+func findTemplate(addonType types.AddonType) (template, bool) {
+	for _, t := range catalog {
+		if t.addonType == addonType {
+			return t, true
+		}
+	}
+
+	return template{}, false
+}
+
+func (t template) field(key string) (field, bool) {
+	for _, f := range t.fields {
+		if f.Key == key {
+			return f, true
+		}
+	}
+
+	return field{}, false
+}
+
+func (t template) toAddonTemplate() types.AddonTemplate {
+	form := make([]types.AddonFormField, 0, len(t.fields))
+
+	for _, f := range t.fields {
+		form = append(form, f.AddonFormField)
+	}
+
+	return types.AddonTemplate{
+		Type:        t.addonType,
+		Name:        t.name,
+		Description: t.description,
+		ChartName:   t.chartName,
+		Form:        form,
+	}
+}
+
+// parse converts an input to the type of the field
+func (f field) parse(input string) (interface{}, error) {
+	if len(f.Options) > 0 {
+		valid := false
+
+		for _, option := range f.Options {
+			if input == option {
+				valid = true
+				break
+			}
+		}
+
+		if !valid {
+			return nil, fmt.Errorf("input %s must be one of %s", f.Key, strings.Join(f.Options, ", "))
+		}
+	}
+
+	switch f.Type {
+	case types.AddonFormFieldType_Integer:
+		value, err := strconv.Atoi(input)
+		if err != nil {
+			return nil, fmt.Errorf("input %s must be a whole number", f.Key)
+		}
+
+		return value, nil
+	case types.AddonFormFieldType_Boolean:
+		value, err := strconv.ParseBool(input)
+		if err != nil {
+			return nil, fmt.Errorf("input %s must be true or false", f.Key)
+		}
+
+		return value, nil
+	default:
+		return input, nil
+	}
+}
+
+func setPath(values map[string]interface{}, path []string, value interface{}) {
+	if len(path) == 1 {
+		values[path[0]] = value
+		return
+	}
+
+	child, ok := values[path[0]].(map[string]interface{})
+	if !ok {
+		child = make(map[string]interface{})
+		values[path[0]] = child
+	}
+
+	setPath(child, path[1:], value)
+}
+
+// mergeValues merges the overrides into base, recursing into maps which are set in both
+func mergeValues(base, overrides map[string]interface{}) map[string]interface{} {
+	for key, override := range overrides {
+		baseMap, baseIsMap := base[key].(map[string]interface{})
+		overrideMap, overrideIsMap := override.(map[string]interface{})
+
+		if baseIsMap && overrideIsMap {
+			base[key] = mergeValues(baseMap, overrideMap)
+			continue
+		}
+
+		base[key] = override
+	}
+
+	return base
+}
@@ -0,0 +1,65 @@
+package addons
+
+import (
+	"testing"
+
+	"github.com/matryer/is"
+	"github.com/porter-dev/porter/api/types"
+)
+
+func TestValues(t *testing.T) {
+	is := is.New(t)
+
+	values, err := Values(types.AddonType_Postgres, map[string]string{
+		"database": "app",
+		"password": "secret",
+	}, map[string]interface{}{
+		"primary": map[string]interface{}{
+			"resources": map[string]interface{}{"limits": map[string]interface{}{"memory": "1Gi"}},
+		},
+	})
+	is.NoErr(err)
+
+	auth := values["auth"].(map[string]interface{})
+	is.Equal(auth["database"], "app")
+	is.Equal(auth["username"], "postgres") // defaults are used for missing inputs
+	is.Equal(auth["password"], "secret")
+
+	primary := values["primary"].(map[string]interface{})
+	is.Equal(primary["persistence"], map[string]interface{}{"size": "8Gi"}) // overrides are merged, not replaced
+	is.True(primary["resources"] != nil)
+}
+
+func TestValuesTypes(t *testing.T) {
+	is := is.New(t)
+
+	values, err := Values(types.AddonType_Kafka, map[string]string{"brokers": "3"}, nil)
+	is.NoErr(err)
+	is.Equal(values["controller"].(map[string]interface{})["replicaCount"], 3)
+
+	_, err = Values(types.AddonType_Kafka, map[string]string{"brokers": "three"}, nil)
+	is.True(err != nil)
+
+	_, err = Values(types.AddonType_Redis, map[string]string{"architecture": "cluster"}, nil)
+	is.True(err != nil) // not one of the options
+}
+
+func TestValuesValidation(t *testing.T) {
+	is := is.New(t)
+
+	_, err := Values(types.AddonType_Postgres, map[string]string{}, nil)
+	is.True(err != nil) // password is required and has no default
+
+	_, err = Values(types.AddonType_Redis, map[string]string{"unknown": "value"}, nil)
+	is.True(err != nil)
+
+	_, err = Values("mongodb", nil, nil)
+	is.True(err != nil)
+}
+
+func TestSecretKeys(t *testing.T) {
+	is := is.New(t)
+
+	is.Equal(SecretKeys(types.AddonType_Postgres), map[string]bool{"password": true})
+	is.Equal(SecretKeys(types.AddonType_Kafka), map[string]bool{})
+}
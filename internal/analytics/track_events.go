@@ -9,6 +9,8 @@ const (
 	ProjectCreate   SegmentEvent = "New Project Event"
 	ProjectDelete   SegmentEvent = "Project Deleted"
 
+	ProjectOwnershipTransfer SegmentEvent = "Project Ownership Transferred"
+
 	CostConsentOpened           SegmentEvent = "Cost Consent Opened"
 	CostConsentComplete         SegmentEvent = "Cost Consent Complete"
 	CredentialStepComplete      SegmentEvent = "Credential Step Complete"
@@ -156,6 +156,26 @@ func ProjectDeleteTrack(opts *ProjectCreateDeleteTrackOpts) segmentTrack {
 	)
 }
 
+// ProjectOwnershipTransferTrackOpts are the options for creating a track when project ownership is transferred
+type ProjectOwnershipTransferTrackOpts struct {
+	*ProjectScopedTrackOpts
+
+	PreviousAdminUserID uint
+	NewAdminUserID      uint
+}
+
+// ProjectOwnershipTransferTrack returns a track for when the admin role of a project is transferred to another member
+func ProjectOwnershipTransferTrack(opts *ProjectOwnershipTransferTrackOpts) segmentTrack {
+	additionalProps := make(map[string]interface{})
+	additionalProps["previous_admin_user_id"] = opts.PreviousAdminUserID
+	additionalProps["new_admin_user_id"] = opts.NewAdminUserID
+
+	return getSegmentProjectTrack(
+		opts.ProjectScopedTrackOpts,
+		getDefaultSegmentTrack(additionalProps, ProjectOwnershipTransfer),
+	)
+}
+
 // CostConsentOpenedTrackOpts are the options for creating a track when a user opens the cost consent
 type CostConsentOpenedTrackOpts struct {
 	*UserScopedTrackOpts
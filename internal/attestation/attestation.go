@@ -0,0 +1,360 @@
+// Package attestation verifies signed build provenance attestations of the images deployed by Porter. Attestations are
+// in-toto statements with a SLSA provenance predicate, wrapped in a DSSE envelope, as created by `cosign attest`.
+package attestation
+
+import (
+	"crypto"
+	"crypto/ecdsa"
+	"crypto/ed25519"
+	"crypto/rsa"
+	"crypto/sha256"
+	"crypto/x509"
+	"encoding/asn1"
+	"encoding/base64"
+	"encoding/json"
+	"encoding/pem"
+	"errors"
+	"fmt"
+	"regexp"
+	"strings"
+)
+
+const (
+	// InTotoPayloadType is the DSSE payload type of in-toto statements
+	InTotoPayloadType = "application/vnd.in-toto+json"
+	// InTotoStatementType is the type of in-toto v0.1 statements, which cosign creates
+	InTotoStatementType = "https://in-toto.io/Statement/v0.1"
+	// SLSAProvenancePredicateType is the predicate type of SLSA v1 provenance
+	SLSAProvenancePredicateType = "https://slsa.dev/provenance/v1"
+)
+
+var (
+	// oidIssuerV1 is the Fulcio certificate extension with the OIDC issuer of the identity of the signer, as a raw string
+	oidIssuerV1 = asn1.ObjectIdentifier{1, 3, 6, 1, 4, 1, 57264, 1, 1}
+	// oidIssuerV2 is the Fulcio certificate extension with the OIDC issuer of the identity of the signer, as a DER UTF8String
+	oidIssuerV2 = asn1.ObjectIdentifier{1, 3, 6, 1, 4, 1, 57264, 1, 8}
+)
+
+// Envelope is a DSSE envelope
+type Envelope struct {
+	PayloadType string `json:"payloadType"`
+	// Payload is the base64-encoded in-toto statement
+	Payload    string      `json:"payload"`
+	Signatures []Signature `json:"signatures"`
+}
+
+// Signature is a signature of a DSSE envelope
+type Signature struct {
+	KeyID string `json:"keyid"`
+	// Sig is the base64-encoded signature of the pre-authentication encoding of the envelope
+	Sig string `json:"sig"`
+}
+
+// Statement is an in-toto statement about a set of artifacts, such as the images of an app
+type Statement struct {
+	Type          string          `json:"_type"`
+	Subject       []Subject       `json:"subject"`
+	PredicateType string          `json:"predicateType"`
+	Predicate     json.RawMessage `json:"predicate"`
+}
+
+// Subject is an artifact of an in-toto statement
+type Subject struct {
+	Name   string            `json:"name"`
+	Digest map[string]string `json:"digest"`
+}
+
+// Policy is how the attestations of the images of a project are verified. Attestations are either signed with a key, whose
+// public key is PublicKeyPEM, or keyless with a Fulcio certificate, which must chain to one of RootsPEM and be issued to an
+// identity matching Identity by Issuer.
+type Policy struct {
+	PublicKeyPEM string
+	// Identity is a regular expression which must match the whole email or URI of the signer of a keyless attestation, e.g.
+	// the workflow of a GitHub Actions job
+	Identity string
+	// Issuer is the OIDC issuer of the identity of the signer of a keyless attestation, e.g. https://token.actions.githubusercontent.com
+	Issuer string
+	// RootsPEM are the certificates of the Fulcio certificate authorities trusted to issue keyless signing certificates
+	RootsPEM string
+}
+
+// Attestation is a signed attestation of an image
+type Attestation struct {
+	// Envelope is the DSSE envelope of the attestation, as JSON
+	Envelope []byte
+	// CertificatePEM is the signing certificate of a keyless attestation
+	CertificatePEM string
+	// ChainPEM are the intermediate certificates of the signing certificate of a keyless attestation
+	ChainPEM string
+}
+
+// ValidatePolicy checks that a policy can verify attestations, either with a public key or keyless
+func ValidatePolicy(policy Policy) error {
+	if policy.PublicKeyPEM != "" {
+		if policy.Identity != "" || policy.Issuer != "" || policy.RootsPEM != "" {
+			return errors.New("a policy with a public key cannot also set a keyless identity, issuer or roots")
+		}
+
+		_, err := parsePublicKey(policy.PublicKeyPEM)
+		return err
+	}
+
+	if policy.Identity == "" || policy.Issuer == "" || policy.RootsPEM == "" {
+		return errors.New("a policy must set either a public key, or a keyless identity, issuer and roots")
+	}
+
+	if _, err := regexp.Compile(policy.Identity); err != nil {
+		return fmt.Errorf("invalid identity: %w", err)
+	}
+
+	roots := x509.NewCertPool()
+	if !roots.AppendCertsFromPEM([]byte(policy.RootsPEM)) {
+		return errors.New("roots must contain at least one PEM-encoded certificate")
+	}
+
+	return nil
+}
+
+// ParseEnvelope parses a DSSE envelope with an in-toto statement, without verifying its signatures
+func ParseEnvelope(envelopeJSON []byte) (*Envelope, *Statement, error) {
+	envelope := &Envelope{}
+	if err := json.Unmarshal(envelopeJSON, envelope); err != nil {
+		return nil, nil, fmt.Errorf("error parsing attestation envelope: %w", err)
+	}
+
+	if envelope.PayloadType != InTotoPayloadType {
+		return nil, nil, fmt.Errorf("attestation payload type must be %s, got '%s'", InTotoPayloadType, envelope.PayloadType)
+	}
+
+	if len(envelope.Signatures) == 0 {
+		return nil, nil, errors.New("attestation envelope is not signed")
+	}
+
+	payload, err := base64.StdEncoding.DecodeString(envelope.Payload)
+	if err != nil {
+		return nil, nil, fmt.Errorf("error decoding attestation payload: %w", err)
+	}
+
+	statement := &Statement{}
+	if err := json.Unmarshal(payload, statement); err != nil {
+		return nil, nil, fmt.Errorf("error parsing attestation statement: %w", err)
+	}
+
+	if !strings.HasPrefix(statement.PredicateType, "https://slsa.dev/provenance/") {
+		return nil, nil, fmt.Errorf("attestation must be a SLSA provenance attestation, got predicate type '%s'", statement.PredicateType)
+	}
+
+	return envelope, statement, nil
+}
+
+// Verify checks that an attestation is signed according to a policy and is about the image with the given repository and
+// digest, e.g. registry.example.com/app and sha256:abc. It returns the verified statement.
+//
+// The signing certificates of keyless attestations are checked against the roots of the policy at the time they were issued,
+// since they are only valid for a few minutes. The transparency log entries of keyless attestations are not checked.
+func Verify(attestation Attestation, policy Policy, repository string, digest string) (*Statement, error) {
+	envelope, statement, err := ParseEnvelope(attestation.Envelope)
+	if err != nil {
+		return nil, err
+	}
+
+	var publicKey crypto.PublicKey
+	if policy.PublicKeyPEM != "" {
+		publicKey, err = parsePublicKey(policy.PublicKeyPEM)
+		if err != nil {
+			return nil, err
+		}
+	} else {
+		publicKey, err = verifyCertificate(attestation, policy)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	payload, err := base64.StdEncoding.DecodeString(envelope.Payload)
+	if err != nil {
+		return nil, fmt.Errorf("error decoding attestation payload: %w", err)
+	}
+
+	message := preAuthEncoding(envelope.PayloadType, payload)
+
+	verified := false
+	for _, signature := range envelope.Signatures {
+		sig, err := base64.StdEncoding.DecodeString(signature.Sig)
+		if err != nil {
+			continue
+		}
+
+		if verifySignature(publicKey, message, sig) == nil {
+			verified = true
+			break
+		}
+	}
+
+	if !verified {
+		return nil, errors.New("attestation signature does not match the key of the signing policy")
+	}
+
+	if !statement.HasSubject(repository, digest) {
+		return nil, fmt.Errorf("attestation is not about image %s@%s", repository, digest)
+	}
+
+	return statement, nil
+}
+
+// HasSubject returns true if the statement is about the image with the given repository and digest
+func (s *Statement) HasSubject(repository string, digest string) bool {
+	algorithm, hex, ok := strings.Cut(digest, ":")
+	if !ok {
+		return false
+	}
+
+	repository = normalizeRepository(repository)
+	for _, subject := range s.Subject {
+		if normalizeRepository(subject.Name) == repository && subject.Digest[algorithm] == hex {
+			return true
+		}
+	}
+
+	return false
+}
+
+// verifyCertificate checks that the signing certificate of a keyless attestation was issued by one of the roots of the policy to
+// its identity and issuer, and returns its public key
+func verifyCertificate(attestation Attestation, policy Policy) (crypto.PublicKey, error) {
+	if policy.Identity == "" || policy.Issuer == "" || policy.RootsPEM == "" {
+		return nil, errors.New("signing policy has no public key or keyless identity")
+	}
+
+	cert, err := parseCertificate(attestation.CertificatePEM)
+	if err != nil {
+		return nil, fmt.Errorf("error parsing signing certificate: %w", err)
+	}
+
+	roots := x509.NewCertPool()
+	if !roots.AppendCertsFromPEM([]byte(policy.RootsPEM)) {
+		return nil, errors.New("signing policy has no valid roots")
+	}
+
+	intermediates := x509.NewCertPool()
+	if attestation.ChainPEM != "" {
+		intermediates.AppendCertsFromPEM([]byte(attestation.ChainPEM))
+	}
+
+	_, err = cert.Verify(x509.VerifyOptions{
+		Roots:         roots,
+		Intermediates: intermediates,
+		CurrentTime:   cert.NotBefore,
+		KeyUsages:     []x509.ExtKeyUsage{x509.ExtKeyUsageCodeSigning},
+	})
+	if err != nil {
+		return nil, fmt.Errorf("signing certificate is not issued by a trusted root: %w", err)
+	}
+
+	identityRegex, err := regexp.Compile(fmt.Sprintf("^(?:%s)$", policy.Identity))
+	if err != nil {
+		return nil, fmt.Errorf("invalid identity in signing policy: %w", err)
+	}
+
+	identities := append([]string{}, cert.EmailAddresses...)
+	for _, uri := range cert.URIs {
+		identities = append(identities, uri.String())
+	}
+
+	identityMatched := false
+	for _, identity := range identities {
+		if identityRegex.MatchString(identity) {
+			identityMatched = true
+			break
+		}
+	}
+	if !identityMatched {
+		return nil, fmt.Errorf("signing certificate identity %s does not match %s", strings.Join(identities, ", "), policy.Identity)
+	}
+
+	issuer := certificateIssuer(cert)
+	if issuer != policy.Issuer {
+		return nil, fmt.Errorf("signing certificate was issued for identities of '%s', not '%s'", issuer, policy.Issuer)
+	}
+
+	return cert.PublicKey, nil
+}
+
+// certificateIssuer returns the OIDC issuer recorded by Fulcio in a signing certificate
+func certificateIssuer(cert *x509.Certificate) string {
+	var issuer string
+	for _, ext := range cert.Extensions {
+		switch {
+		case ext.Id.Equal(oidIssuerV2):
+			var value string
+			if _, err := asn1.Unmarshal(ext.Value, &value); err == nil {
+				return value
+			}
+		case ext.Id.Equal(oidIssuerV1):
+			issuer = string(ext.Value)
+		}
+	}
+
+	return issuer
+}
+
+// preAuthEncoding returns the DSSE pre-authentication encoding of a payload, which is the message that is signed
+func preAuthEncoding(payloadType string, payload []byte) []byte {
+	return []byte(fmt.Sprintf("DSSEv1 %d %s %d %s", len(payloadType), payloadType, len(payload), payload))
+}
+
+func verifySignature(publicKey crypto.PublicKey, message []byte, sig []byte) error {
+	digest := sha256.Sum256(message)
+
+	switch key := publicKey.(type) {
+	case *ecdsa.PublicKey:
+		if !ecdsa.VerifyASN1(key, digest[:], sig) {
+			return errors.New("invalid ecdsa signature")
+		}
+		return nil
+	case *rsa.PublicKey:
+		if err := rsa.VerifyPKCS1v15(key, crypto.SHA256, digest[:], sig); err == nil {
+			return nil
+		}
+		return rsa.VerifyPSS(key, crypto.SHA256, digest[:], sig, nil)
+	case ed25519.PublicKey:
+		if !ed25519.Verify(key, message, sig) {
+			return errors.New("invalid ed25519 signature")
+		}
+		return nil
+	default:
+		return fmt.Errorf("unsupported public key type %T", publicKey)
+	}
+}
+
+func parsePublicKey(publicKeyPEM string) (crypto.PublicKey, error) {
+	block, _ := pem.Decode([]byte(publicKeyPEM))
+	if block == nil {
+		return nil, errors.New("public key must be PEM-encoded")
+	}
+
+	publicKey, err := x509.ParsePKIXPublicKey(block.Bytes)
+	if err != nil {
+		return nil, fmt.Errorf("error parsing public key: %w", err)
+	}
+
+	return publicKey, nil
+}
+
+func parseCertificate(certificatePEM string) (*x509.Certificate, error) {
+	block, _ := pem.Decode([]byte(certificatePEM))
+	if block == nil {
+		return nil, errors.New("certificate must be PEM-encoded")
+	}
+
+	return x509.ParseCertificate(block.Bytes)
+}
+
+// normalizeRepository removes the scheme and default docker hub registry of an image repository, so that repositories can be compared
+func normalizeRepository(repository string) string {
+	repository = strings.TrimPrefix(repository, "https://")
+	repository = strings.TrimPrefix(repository, "index.docker.io/")
+	repository = strings.TrimPrefix(repository, "docker.io/")
+
+	return strings.TrimSuffix(repository, "/")
+}
@@ -0,0 +1,199 @@
+package attestation
+
+import (
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/sha256"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/asn1"
+	"encoding/base64"
+	"encoding/json"
+	"encoding/pem"
+	"math/big"
+	"net/url"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/matryer/is"
+)
+
+const (
+	testRepository = "registry.example.com/app"
+	testDigest     = "sha256:0123456789abcdef0123456789abcdef0123456789abcdef0123456789abcdef"
+)
+
+func signedEnvelope(t *testing.T, key *ecdsa.PrivateKey, statement Statement) []byte {
+	t.Helper()
+
+	payload, err := json.Marshal(statement)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	digest := sha256.Sum256(preAuthEncoding(InTotoPayloadType, payload))
+	sig, err := ecdsa.SignASN1(rand.Reader, key, digest[:])
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	envelope, err := json.Marshal(Envelope{
+		PayloadType: InTotoPayloadType,
+		Payload:     base64.StdEncoding.EncodeToString(payload),
+		Signatures:  []Signature{{Sig: base64.StdEncoding.EncodeToString(sig)}},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	return envelope
+}
+
+func provenanceStatement(repository string) Statement {
+	return Statement{
+		Type:          InTotoStatementType,
+		Subject:       []Subject{{Name: repository, Digest: map[string]string{"sha256": strings.TrimPrefix(testDigest, "sha256:")}}},
+		PredicateType: SLSAProvenancePredicateType,
+		Predicate:     json.RawMessage(`{"buildDefinition":{"buildType":"https://porter.run/build-types/cli@v1"}}`),
+	}
+}
+
+func publicKeyPEM(t *testing.T, key *ecdsa.PrivateKey) string {
+	t.Helper()
+
+	der, err := x509.MarshalPKIXPublicKey(&key.PublicKey)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	return string(pem.EncodeToMemory(&pem.Block{Type: "PUBLIC KEY", Bytes: der}))
+}
+
+func TestVerifyWithKey(t *testing.T) {
+	is := is.New(t)
+
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	is.NoErr(err)
+	otherKey, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	is.NoErr(err)
+
+	policy := Policy{PublicKeyPEM: publicKeyPEM(t, key)}
+	is.NoErr(ValidatePolicy(policy))
+
+	envelope := signedEnvelope(t, key, provenanceStatement(testRepository))
+
+	statement, err := Verify(Attestation{Envelope: envelope}, policy, "https://"+testRepository, testDigest)
+	is.NoErr(err)
+	is.Equal(statement.PredicateType, SLSAProvenancePredicateType)
+
+	_, err = Verify(Attestation{Envelope: envelope}, Policy{PublicKeyPEM: publicKeyPEM(t, otherKey)}, testRepository, testDigest)
+	is.True(err != nil && strings.Contains(err.Error(), "signature does not match"))
+
+	_, err = Verify(Attestation{Envelope: envelope}, policy, "registry.example.com/other", testDigest)
+	is.True(err != nil && strings.Contains(err.Error(), "is not about image"))
+
+	_, err = Verify(Attestation{Envelope: envelope}, policy, testRepository, "sha256:ffff")
+	is.True(err != nil && strings.Contains(err.Error(), "is not about image"))
+
+	// a statement which was changed after it was signed is rejected
+	tampered := &Envelope{}
+	is.NoErr(json.Unmarshal(envelope, tampered))
+	payload, err := json.Marshal(provenanceStatement("registry.example.com/other"))
+	is.NoErr(err)
+	tampered.Payload = base64.StdEncoding.EncodeToString(payload)
+	tamperedJSON, err := json.Marshal(tampered)
+	is.NoErr(err)
+
+	_, err = Verify(Attestation{Envelope: tamperedJSON}, policy, "registry.example.com/other", testDigest)
+	is.True(err != nil && strings.Contains(err.Error(), "signature does not match"))
+
+	statementWithoutProvenance := provenanceStatement(testRepository)
+	statementWithoutProvenance.PredicateType = "https://cosign.sigstore.dev/attestation/v1"
+	_, err = Verify(Attestation{Envelope: signedEnvelope(t, key, statementWithoutProvenance)}, policy, testRepository, testDigest)
+	is.True(err != nil && strings.Contains(err.Error(), "must be a SLSA provenance attestation"))
+}
+
+func TestVerifyKeyless(t *testing.T) {
+	is := is.New(t)
+
+	rootKey, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	is.NoErr(err)
+
+	notBefore := time.Now().Add(-time.Hour)
+	rootTemplate := &x509.Certificate{
+		SerialNumber:          big.NewInt(1),
+		Subject:               pkix.Name{CommonName: "test-fulcio"},
+		NotBefore:             notBefore,
+		NotAfter:              notBefore.Add(24 * time.Hour),
+		IsCA:                  true,
+		BasicConstraintsValid: true,
+		KeyUsage:              x509.KeyUsageCertSign,
+	}
+	rootDER, err := x509.CreateCertificate(rand.Reader, rootTemplate, rootTemplate, &rootKey.PublicKey, rootKey)
+	is.NoErr(err)
+	root, err := x509.ParseCertificate(rootDER)
+	is.NoErr(err)
+
+	issuer, err := asn1.Marshal("https://token.actions.githubusercontent.com")
+	is.NoErr(err)
+
+	// the signing certificate is only valid for a few minutes, and has expired by the time the attestation is verified
+	signingKey, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	is.NoErr(err)
+	workflow, err := url.Parse("https://github.com/acme/app/.github/workflows/deploy.yml@refs/heads/main")
+	is.NoErr(err)
+	leafTemplate := &x509.Certificate{
+		SerialNumber:    big.NewInt(2),
+		NotBefore:       notBefore,
+		NotAfter:        notBefore.Add(10 * time.Minute),
+		KeyUsage:        x509.KeyUsageDigitalSignature,
+		ExtKeyUsage:     []x509.ExtKeyUsage{x509.ExtKeyUsageCodeSigning},
+		URIs:            []*url.URL{workflow},
+		ExtraExtensions: []pkix.Extension{{Id: oidIssuerV2, Value: issuer}},
+	}
+	leafDER, err := x509.CreateCertificate(rand.Reader, leafTemplate, root, &signingKey.PublicKey, rootKey)
+	is.NoErr(err)
+
+	attestation := Attestation{
+		Envelope:       signedEnvelope(t, signingKey, provenanceStatement(testRepository)),
+		CertificatePEM: string(pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: leafDER})),
+	}
+	policy := Policy{
+		Identity: `https://github\.com/acme/app/\.github/workflows/deploy\.yml@refs/heads/.*`,
+		Issuer:   "https://token.actions.githubusercontent.com",
+		RootsPEM: string(pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: rootDER})),
+	}
+	is.NoErr(ValidatePolicy(policy))
+
+	_, err = Verify(attestation, policy, testRepository, testDigest)
+	is.NoErr(err)
+
+	otherIdentity := policy
+	otherIdentity.Identity = `https://github\.com/acme/other/.*`
+	_, err = Verify(attestation, otherIdentity, testRepository, testDigest)
+	is.True(err != nil && strings.Contains(err.Error(), "does not match"))
+
+	otherIssuer := policy
+	otherIssuer.Issuer = "https://accounts.google.com"
+	_, err = Verify(attestation, otherIssuer, testRepository, testDigest)
+	is.True(err != nil && strings.Contains(err.Error(), "was issued for identities of"))
+
+	otherRootKey, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	is.NoErr(err)
+	otherRootDER, err := x509.CreateCertificate(rand.Reader, rootTemplate, rootTemplate, &otherRootKey.PublicKey, otherRootKey)
+	is.NoErr(err)
+	otherRoot := policy
+	otherRoot.RootsPEM = string(pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: otherRootDER}))
+	_, err = Verify(attestation, otherRoot, testRepository, testDigest)
+	is.True(err != nil && strings.Contains(err.Error(), "not issued by a trusted root"))
+}
+
+func TestValidatePolicy(t *testing.T) {
+	is := is.New(t)
+
+	is.True(ValidatePolicy(Policy{}) != nil)
+	is.True(ValidatePolicy(Policy{PublicKeyPEM: "not a key"}) != nil)
+	is.True(ValidatePolicy(Policy{Identity: "(", Issuer: "https://accounts.google.com", RootsPEM: "x"}) != nil)
+}
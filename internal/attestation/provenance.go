@@ -0,0 +1,47 @@
+package attestation
+
+import "time"
+
+const (
+	// BuildTypePorterCLI identifies images built by `porter apply` in SLSA provenance
+	BuildTypePorterCLI = "https://porter.run/build-types/cli@v1"
+	// BuilderIDPorterCLI identifies the Porter CLI as the builder in SLSA provenance
+	BuilderIDPorterCLI = "https://porter.run/cli"
+)
+
+// Provenance is a SLSA v1 provenance predicate, which describes how an image was built
+type Provenance struct {
+	BuildDefinition BuildDefinition `json:"buildDefinition"`
+	RunDetails      RunDetails      `json:"runDetails"`
+}
+
+// BuildDefinition is the inputs of a build
+type BuildDefinition struct {
+	BuildType            string                 `json:"buildType"`
+	ExternalParameters   map[string]interface{} `json:"externalParameters"`
+	ResolvedDependencies []ResourceDescriptor   `json:"resolvedDependencies,omitempty"`
+}
+
+// ResourceDescriptor is an artifact used by a build, such as the git commit it was built from
+type ResourceDescriptor struct {
+	URI    string            `json:"uri"`
+	Digest map[string]string `json:"digest,omitempty"`
+}
+
+// RunDetails is the builder and time of a build
+type RunDetails struct {
+	Builder  Builder       `json:"builder"`
+	Metadata BuildMetadata `json:"metadata"`
+}
+
+// Builder is the entity which ran a build
+type Builder struct {
+	ID string `json:"id"`
+}
+
+// BuildMetadata is the metadata of a run of a build
+type BuildMetadata struct {
+	InvocationID string     `json:"invocationId,omitempty"`
+	StartedOn    *time.Time `json:"startedOn,omitempty"`
+	FinishedOn   *time.Time `json:"finishedOn,omitempty"`
+}
@@ -3,6 +3,7 @@
 package sessionstore
 
 import (
+	"context"
 	"encoding/base32"
 	"net/http"
 	"strings"
@@ -58,8 +59,8 @@ func (store *PGStore) MaxAge(age int) {
 
 // load fetches a session by ID from the database and decodes its content
 // into session.Values.
-func (store *PGStore) load(session *sessions.Session) error {
-	res, err := store.Repo.SelectSession(&models.Session{Key: session.ID})
+func (store *PGStore) load(ctx context.Context, session *sessions.Session) error {
+	res, err := store.Repo.SelectSession(ctx, &models.Session{Key: session.ID})
 	if err != nil {
 		return err
 	}
@@ -69,7 +70,7 @@ func (store *PGStore) load(session *sessions.Session) error {
 
 // save writes encoded session.Values to a database record.
 // writes to http_sessions table by default.
-func (store *PGStore) save(session *sessions.Session) error {
+func (store *PGStore) save(ctx context.Context, session *sessions.Session) error {
 	encoded, err := securecookie.EncodeMulti(session.Name(), session.Values, store.Codecs...)
 	if err != nil {
 		return err
@@ -97,11 +98,11 @@ func (store *PGStore) save(session *sessions.Session) error {
 	repo := store.Repo
 
 	if session.IsNew {
-		_, createErr := repo.CreateSession(s)
+		_, createErr := repo.CreateSession(ctx, s)
 		return createErr
 	}
 
-	_, updateErr := repo.UpdateSession(s)
+	_, updateErr := repo.UpdateSession(ctx, s)
 	return updateErr
 }
 
@@ -172,7 +173,7 @@ func (store *PGStore) New(r *http.Request, name string) (*sessions.Session, erro
 	if c, errCookie := r.Cookie(name); errCookie == nil {
 		err = securecookie.DecodeMulti(name, c.Value, &session.ID, store.Codecs...)
 		if err == nil {
-			err = store.load(session)
+			err = store.load(r.Context(), session)
 
 			if err != nil {
 				if err == gorm.ErrRecordNotFound {
@@ -198,7 +199,7 @@ func (store *PGStore) Save(r *http.Request, w http.ResponseWriter, session *sess
 
 	// Set delete if max-age is < 0
 	if session.Options.MaxAge < 0 {
-		if _, err := repo.DeleteSession(&models.Session{Key: session.ID}); err != nil {
+		if _, err := repo.DeleteSession(r.Context(), &models.Session{Key: session.ID}); err != nil {
 			return err
 		}
 		http.SetCookie(w, sessions.NewCookie(session.Name(), "", session.Options))
@@ -213,7 +214,7 @@ func (store *PGStore) Save(r *http.Request, w http.ResponseWriter, session *sess
 			), "=")
 	}
 
-	if err := store.save(session); err != nil {
+	if err := store.save(r.Context(), session); err != nil {
 		return err
 	}
 
@@ -1,6 +1,8 @@
 package token
 
 import (
+	"crypto/sha256"
+	"encoding/hex"
 	"fmt"
 	"strconv"
 	"time"
@@ -13,6 +15,10 @@ type Subject string
 const (
 	User Subject = "user"
 	API  Subject = "api"
+
+	// ServiceAccount tokens authenticate as the user of a service account, and are only valid while the secret of the
+	// token matches the current token of the service account
+	ServiceAccount Subject = "service_account"
 )
 
 type TokenGeneratorConf struct {
@@ -80,6 +86,36 @@ func GetStoredTokenForAPI(userID, projID uint, tokenID, secret string) (*Token,
 	}, nil
 }
 
+// GetTokenForServiceAccount returns a token which authenticates as the user of a service account
+func GetTokenForServiceAccount(userID, projID uint, secret string) (*Token, error) {
+	if userID == 0 || projID == 0 {
+		return nil, fmt.Errorf("id cannot be 0")
+	}
+
+	if secret == "" {
+		return nil, fmt.Errorf("secret cannot be empty")
+	}
+
+	iat := time.Now()
+
+	return &Token{
+		SubKind:   ServiceAccount,
+		Sub:       fmt.Sprintf("%d", userID),
+		ProjectID: projID,
+		IBy:       userID,
+		IAt:       &iat,
+		Secret:    secret,
+	}, nil
+}
+
+// HashSecret returns the hash of a token secret, which is stored in place of the secret. Secrets are random, so they do
+// not need a slow password hash.
+func HashSecret(secret string) string {
+	sum := sha256.Sum256([]byte(secret))
+
+	return hex.EncodeToString(sum[:])
+}
+
 func (t *Token) EncodeToken(conf *TokenGeneratorConf) (string, error) {
 	token := jwt.NewWithClaims(jwt.SigningMethodHS256, jwt.MapClaims{
 		"sub_kind":   t.SubKind,
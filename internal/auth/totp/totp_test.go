@@ -0,0 +1,88 @@
+package totp_test
+
+import (
+	"encoding/base32"
+	"testing"
+	"time"
+
+	"github.com/porter-dev/porter/internal/auth/totp"
+)
+
+// rfcSecret is the SHA1 test secret of RFC 6238
+var rfcSecret = base32.StdEncoding.EncodeToString([]byte("12345678901234567890"))
+
+func TestGenerateCode(t *testing.T) {
+	// the test vectors of RFC 6238 are 8 digits long, so the expected codes are their last 6 digits
+	tests := []struct {
+		unix int64
+		code string
+	}{
+		{59, "287082"},
+		{1111111109, "081804"},
+		{1111111111, "050471"},
+		{1234567890, "005924"},
+		{2000000000, "279037"},
+	}
+
+	for _, tt := range tests {
+		code, err := totp.GenerateCode(rfcSecret, totp.Step(time.Unix(tt.unix, 0)))
+		if err != nil {
+			t.Fatalf("%v\n", err)
+		}
+
+		if code != tt.code {
+			t.Errorf("incorrect code at %d: expected %s, got %s", tt.unix, tt.code, code)
+		}
+	}
+}
+
+func TestValidate(t *testing.T) {
+	secret, err := totp.GenerateSecret()
+	if err != nil {
+		t.Fatalf("%v\n", err)
+	}
+
+	now := time.Unix(1700000000, 0)
+
+	previous, err := totp.GenerateCode(secret, totp.Step(now)-1)
+	if err != nil {
+		t.Fatalf("%v\n", err)
+	}
+
+	step, ok, err := totp.Validate(secret, previous, now)
+	if err != nil {
+		t.Fatalf("%v\n", err)
+	}
+
+	if !ok || step != totp.Step(now)-1 {
+		t.Errorf("expected code of the previous period to be accepted, got step %d, ok %t", step, ok)
+	}
+
+	expired, err := totp.GenerateCode(secret, totp.Step(now)-3)
+	if err != nil {
+		t.Fatalf("%v\n", err)
+	}
+
+	if _, ok, _ := totp.Validate(secret, expired, now); ok {
+		t.Errorf("expected expired code to be rejected")
+	}
+
+	if _, ok, _ := totp.Validate(secret, "12345", now); ok {
+		t.Errorf("expected short code to be rejected")
+	}
+}
+
+func TestHashRecoveryCode(t *testing.T) {
+	codes, err := totp.GenerateRecoveryCodes(2)
+	if err != nil {
+		t.Fatalf("%v\n", err)
+	}
+
+	if len(codes) != 2 || len(codes[0]) != 11 || codes[0] == codes[1] {
+		t.Fatalf("incorrect recovery codes: %v", codes)
+	}
+
+	if totp.HashRecoveryCode(codes[0]) != totp.HashRecoveryCode(" "+codes[0][:5]+codes[0][6:]+" ") {
+		t.Errorf("expected recovery code hash to ignore dashes and whitespace")
+	}
+}
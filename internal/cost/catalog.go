@@ -0,0 +1,164 @@
+// Package cost estimates the monthly cost of running the services of an app on the nodes of a cluster
+package cost
+
+import (
+	"context"
+	_ "embed"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"sync"
+	"time"
+)
+
+// CloudProvider is a cloud provider of the price catalog
+type CloudProvider string
+
+const (
+	CloudProvider_AWS   CloudProvider = "aws"
+	CloudProvider_GCP   CloudProvider = "gcp"
+	CloudProvider_Azure CloudProvider = "azure"
+)
+
+// defaultRegions are the regions whose prices are used when a catalog has no prices for the region of a cluster
+var defaultRegions = map[CloudProvider]string{
+	CloudProvider_AWS:   "us-east-1",
+	CloudProvider_GCP:   "us-central1",
+	CloudProvider_Azure: "eastus",
+}
+
+// InstancePrice is the size and the on-demand price of an instance type
+type InstancePrice struct {
+	CPUCores  float64 `json:"cpu_cores"`
+	MemoryGB  float64 `json:"memory_gb"`
+	HourlyUSD float64 `json:"hourly_usd"`
+}
+
+// prices are the instance prices of a catalog by cloud provider, region and instance type
+type prices map[CloudProvider]map[string]map[string]InstancePrice
+
+//go:embed default_catalog.json
+var defaultCatalog []byte
+
+// Catalog holds the on-demand prices of the instance types of each cloud provider. Prices are read from an embedded catalog
+// of common instance types, which can be extended by a catalog served at a URL. The served catalog is cached for a TTL.
+type Catalog struct {
+	url    string
+	ttl    time.Duration
+	client *http.Client
+
+	mu        sync.Mutex
+	prices    prices
+	fetchedAt time.Time
+}
+
+// NewCatalog returns a catalog of the embedded prices. If url is set, the prices served at url are fetched once the catalog
+// is first read and every ttl after, and take precedence over the embedded prices.
+func NewCatalog(url string, ttl time.Duration) (*Catalog, error) {
+	defaults := prices{}
+
+	if err := json.Unmarshal(defaultCatalog, &defaults); err != nil {
+		return nil, fmt.Errorf("error parsing default price catalog: %w", err)
+	}
+
+	return &Catalog{
+		url: url,
+		ttl: ttl,
+		client: &http.Client{
+			Timeout: 10 * time.Second,
+		},
+		prices: defaults,
+	}, nil
+}
+
+// InstancePrice returns the price of an instance type in a region, and the region whose price was returned. If the catalog has no
+// prices for the region, the price in the default region of the cloud provider is returned.
+func (c *Catalog) InstancePrice(ctx context.Context, provider CloudProvider, region, instanceType string) (InstancePrice, string, error) {
+	c.refresh(ctx)
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	regions, ok := c.prices[provider]
+	if !ok {
+		return InstancePrice{}, "", fmt.Errorf("no prices for cloud provider %q", provider)
+	}
+
+	if _, ok := regions[region]; !ok {
+		region = defaultRegions[provider]
+	}
+
+	price, ok := regions[region][instanceType]
+	if !ok {
+		return InstancePrice{}, "", fmt.Errorf("no price for instance type %s in %s", instanceType, region)
+	}
+
+	return price, region, nil
+}
+
+// refresh fetches the served catalog if the cached copy has expired. Errors are not returned, so the last fetched or the embedded
+// prices are used until the next refresh.
+func (c *Catalog) refresh(ctx context.Context) {
+	if c.url == "" {
+		return
+	}
+
+	c.mu.Lock()
+	if !c.fetchedAt.IsZero() && time.Since(c.fetchedAt) < c.ttl {
+		c.mu.Unlock()
+		return
+	}
+
+	// marked as fetched before the request, so concurrent reads do not fetch the catalog again
+	c.fetchedAt = time.Now()
+	c.mu.Unlock()
+
+	fetched, err := c.fetch(ctx)
+	if err != nil {
+		return
+	}
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	for provider, regions := range fetched {
+		if c.prices[provider] == nil {
+			c.prices[provider] = make(map[string]map[string]InstancePrice)
+		}
+
+		for region, instanceTypes := range regions {
+			if c.prices[provider][region] == nil {
+				c.prices[provider][region] = make(map[string]InstancePrice)
+			}
+
+			for instanceType, price := range instanceTypes {
+				c.prices[provider][region][instanceType] = price
+			}
+		}
+	}
+}
+
+func (c *Catalog) fetch(ctx context.Context) (prices, error) {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, c.url, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := c.client.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("received status code %d fetching price catalog", resp.StatusCode)
+	}
+
+	fetched := prices{}
+
+	if err := json.NewDecoder(resp.Body).Decode(&fetched); err != nil {
+		return nil, fmt.Errorf("error parsing price catalog: %w", err)
+	}
+
+	return fetched, nil
+}
@@ -0,0 +1,152 @@
+package cost
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"math"
+	"sort"
+
+	porterv1 "github.com/porter-dev/api-contracts/generated/go/porter/v1"
+)
+
+// hoursPerMonth is the average number of hours in a month, which cloud providers use to quote monthly prices
+const hoursPerMonth = 730
+
+// NodePool is the instance type that the services of apps are scheduled on in a cluster
+type NodePool struct {
+	CloudProvider CloudProvider
+	Region        string
+	InstanceType  string
+}
+
+// NodePoolFromContract returns the application node pool of the cluster of a contract
+func NodePoolFromContract(contract *porterv1.Contract) (NodePool, error) {
+	cluster := contract.GetCluster()
+	if cluster == nil {
+		return NodePool{}, errors.New("contract has no cluster")
+	}
+
+	if eks := cluster.GetEksKind(); eks != nil {
+		for _, nodeGroup := range eks.NodeGroups {
+			if nodeGroup.NodeGroupType == porterv1.NodeGroupType_NODE_GROUP_TYPE_APPLICATION {
+				return NodePool{CloudProvider: CloudProvider_AWS, Region: eks.Region, InstanceType: nodeGroup.InstanceType}, nil
+			}
+		}
+	}
+
+	if gke := cluster.GetGkeKind(); gke != nil {
+		for _, nodePool := range gke.NodePools {
+			if nodePool.NodePoolType == porterv1.GKENodePoolType_GKE_NODE_POOL_TYPE_APPLICATION {
+				return NodePool{CloudProvider: CloudProvider_GCP, Region: gke.Region, InstanceType: nodePool.InstanceType}, nil
+			}
+		}
+	}
+
+	if aks := cluster.GetAksKind(); aks != nil {
+		for _, nodePool := range aks.NodePools {
+			if nodePool.NodePoolType == porterv1.NodePoolType_NODE_POOL_TYPE_APPLICATION {
+				return NodePool{CloudProvider: CloudProvider_Azure, Region: aks.Location, InstanceType: nodePool.InstanceType}, nil
+			}
+		}
+	}
+
+	return NodePool{}, errors.New("cluster has no application node pool")
+}
+
+// Estimate is the estimated monthly cost of the services of an app, in USD
+type Estimate struct {
+	CloudProvider CloudProvider `json:"cloud_provider"`
+	// Region is the region whose prices were used, which is a default region of the cloud provider if the catalog has no prices
+	// for the region of the cluster
+	Region       string             `json:"region"`
+	InstanceType string             `json:"instance_type"`
+	Services     []*ServiceEstimate `json:"services"`
+	// MonthlyCost is the cost of running the minimum instances of every service
+	MonthlyCost float64 `json:"monthly_cost"`
+	// MaxMonthlyCost is the cost of running every autoscaled service at its maximum instances
+	MaxMonthlyCost float64 `json:"max_monthly_cost"`
+}
+
+// ServiceEstimate is the estimated monthly cost of a service
+type ServiceEstimate struct {
+	Name         string  `json:"name"`
+	CpuCores     float32 `json:"cpu_cores"`
+	RamMegabytes int32   `json:"ram_megabytes"`
+	Instances    int32   `json:"instances"`
+	MaxInstances int32   `json:"max_instances"`
+	MonthlyCost  float64 `json:"monthly_cost"`
+	// MaxMonthlyCost is the same as MonthlyCost, unless the service is autoscaled
+	MaxMonthlyCost float64 `json:"max_monthly_cost"`
+}
+
+// EstimateApp estimates the monthly cost of the services of an app on a node pool. Each instance of a service is charged for the share
+// of a node which it reserves, by the larger of its share of the CPU and of the memory of the node. Jobs only run on demand, so they are
+// not included.
+func EstimateApp(ctx context.Context, catalog *Catalog, nodePool NodePool, app *porterv1.PorterApp) (*Estimate, error) {
+	price, region, err := catalog.InstancePrice(ctx, nodePool.CloudProvider, nodePool.Region, nodePool.InstanceType)
+	if err != nil {
+		return nil, err
+	}
+
+	if price.CPUCores <= 0 || price.MemoryGB <= 0 {
+		return nil, fmt.Errorf("instance type %s has no cpu or memory in the price catalog", nodePool.InstanceType)
+	}
+
+	estimate := &Estimate{
+		CloudProvider: nodePool.CloudProvider,
+		Region:        region,
+		InstanceType:  nodePool.InstanceType,
+		Services:      []*ServiceEstimate{},
+	}
+
+	for name, service := range app.GetServices() {
+		if service.GetType() == porterv1.ServiceType_SERVICE_TYPE_JOB || service.GetJobConfig() != nil {
+			continue
+		}
+
+		instances, maxInstances := service.GetInstances(), service.GetInstances()
+
+		autoscaling := service.GetWebConfig().GetAutoscaling()
+		if autoscaling == nil {
+			autoscaling = service.GetWorkerConfig().GetAutoscaling()
+		}
+
+		if autoscaling.GetEnabled() {
+			instances, maxInstances = autoscaling.GetMinInstances(), autoscaling.GetMaxInstances()
+		}
+
+		nodeShare := math.Max(
+			float64(service.GetCpuCores())/price.CPUCores,
+			float64(service.GetRamMegabytes())/1024/price.MemoryGB,
+		)
+		instanceCost := nodeShare * price.HourlyUSD * hoursPerMonth
+
+		serviceEstimate := &ServiceEstimate{
+			Name:           name,
+			CpuCores:       service.GetCpuCores(),
+			RamMegabytes:   service.GetRamMegabytes(),
+			Instances:      instances,
+			MaxInstances:   maxInstances,
+			MonthlyCost:    roundCents(instanceCost * float64(instances)),
+			MaxMonthlyCost: roundCents(instanceCost * float64(maxInstances)),
+		}
+
+		estimate.MonthlyCost += serviceEstimate.MonthlyCost
+		estimate.MaxMonthlyCost += serviceEstimate.MaxMonthlyCost
+		estimate.Services = append(estimate.Services, serviceEstimate)
+	}
+
+	sort.Slice(estimate.Services, func(i, j int) bool {
+		return estimate.Services[i].Name < estimate.Services[j].Name
+	})
+
+	estimate.MonthlyCost = roundCents(estimate.MonthlyCost)
+	estimate.MaxMonthlyCost = roundCents(estimate.MaxMonthlyCost)
+
+	return estimate, nil
+}
+
+func roundCents(usd float64) float64 {
+	return math.Round(usd*100) / 100
+}
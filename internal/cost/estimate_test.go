@@ -0,0 +1,139 @@
+package cost
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/matryer/is"
+	porterv1 "github.com/porter-dev/api-contracts/generated/go/porter/v1"
+)
+
+func TestEstimateApp(t *testing.T) {
+	is := is.New(t)
+
+	catalog, err := NewCatalog("", 0)
+	is.NoErr(err)
+
+	app := &porterv1.PorterApp{
+		Name: "my-app",
+		Services: map[string]*porterv1.Service{
+			"web": {
+				Type:         porterv1.ServiceType_SERVICE_TYPE_WEB,
+				Instances:    1,
+				CpuCores:     1,
+				RamMegabytes: 2048,
+				Config: &porterv1.Service_WebConfig{WebConfig: &porterv1.WebServiceConfig{
+					Autoscaling: &porterv1.Autoscaling{Enabled: true, MinInstances: 2, MaxInstances: 4},
+				}},
+			},
+			// memory bound, reserving half of the memory of a node
+			"worker": {
+				Type:         porterv1.ServiceType_SERVICE_TYPE_WORKER,
+				Instances:    1,
+				CpuCores:     0.25,
+				RamMegabytes: 4096,
+			},
+			"cron": {
+				Type:         porterv1.ServiceType_SERVICE_TYPE_JOB,
+				CpuCores:     4,
+				RamMegabytes: 8192,
+			},
+		},
+	}
+
+	estimate, err := EstimateApp(context.Background(), catalog, NodePool{
+		CloudProvider: CloudProvider_AWS,
+		Region:        "us-east-1",
+		InstanceType:  "t3.large",
+	}, app)
+	is.NoErr(err)
+
+	is.Equal(len(estimate.Services), 2) // jobs should not be estimated
+	is.Equal(estimate.Services[0].Name, "web")
+	is.Equal(estimate.Services[0].Instances, int32(2))
+	is.Equal(estimate.Services[0].MonthlyCost, 60.74)     // 2 x half of a $0.0832/h node
+	is.Equal(estimate.Services[0].MaxMonthlyCost, 121.47) // 4 x half of a $0.0832/h node
+	is.Equal(estimate.Services[1].MonthlyCost, 30.37)
+	is.Equal(estimate.MonthlyCost, 91.11)
+	is.Equal(estimate.MaxMonthlyCost, 151.84)
+}
+
+func TestEstimateAppUnknownRegion(t *testing.T) {
+	is := is.New(t)
+
+	catalog, err := NewCatalog("", 0)
+	is.NoErr(err)
+
+	estimate, err := EstimateApp(context.Background(), catalog, NodePool{
+		CloudProvider: CloudProvider_GCP,
+		Region:        "asia-east1",
+		InstanceType:  "e2-standard-2",
+	}, &porterv1.PorterApp{})
+	is.NoErr(err)
+	is.Equal(estimate.Region, "us-central1") // prices of the default region should be used
+
+	_, err = EstimateApp(context.Background(), catalog, NodePool{
+		CloudProvider: CloudProvider_GCP,
+		Region:        "us-central1",
+		InstanceType:  "a3-highgpu-8g",
+	}, &porterv1.PorterApp{})
+	is.True(err != nil) // instance types missing from the catalog should fail
+}
+
+func TestCatalogRefresh(t *testing.T) {
+	is := is.New(t)
+
+	requests := 0
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requests++
+		_ = json.NewEncoder(w).Encode(prices{
+			CloudProvider_AWS: {
+				"us-east-1":  {"t3.large": {CPUCores: 2, MemoryGB: 8, HourlyUSD: 0.05}},
+				"ap-south-1": {"t3.large": {CPUCores: 2, MemoryGB: 8, HourlyUSD: 0.0896}},
+			},
+		})
+	}))
+	defer server.Close()
+
+	catalog, err := NewCatalog(server.URL, time.Hour)
+	is.NoErr(err)
+
+	price, _, err := catalog.InstancePrice(context.Background(), CloudProvider_AWS, "us-east-1", "t3.large")
+	is.NoErr(err)
+	is.Equal(price.HourlyUSD, 0.05) // served prices should take precedence
+
+	price, region, err := catalog.InstancePrice(context.Background(), CloudProvider_AWS, "ap-south-1", "t3.large")
+	is.NoErr(err)
+	is.Equal(region, "ap-south-1")
+	is.Equal(price.HourlyUSD, 0.0896)
+
+	_, _, err = catalog.InstancePrice(context.Background(), CloudProvider_AWS, "us-east-1", "m5.large")
+	is.NoErr(err)         // embedded prices should be kept
+	is.Equal(requests, 1) // the served catalog should be cached
+}
+
+func TestNodePoolFromContract(t *testing.T) {
+	is := is.New(t)
+
+	nodePool, err := NodePoolFromContract(&porterv1.Contract{
+		Cluster: &porterv1.Cluster{
+			KindValues: &porterv1.Cluster_EksKind{EksKind: &porterv1.EKS{
+				Region: "us-west-2",
+				NodeGroups: []*porterv1.EKSNodeGroup{
+					{InstanceType: "t3.medium", NodeGroupType: porterv1.NodeGroupType_NODE_GROUP_TYPE_SYSTEM},
+					{InstanceType: "m5.xlarge", NodeGroupType: porterv1.NodeGroupType_NODE_GROUP_TYPE_APPLICATION},
+				},
+			}},
+		},
+	})
+	is.NoErr(err)
+	is.Equal(nodePool, NodePool{CloudProvider: CloudProvider_AWS, Region: "us-west-2", InstanceType: "m5.xlarge"})
+
+	_, err = NodePoolFromContract(&porterv1.Contract{})
+	is.True(err != nil) // contracts without a cluster should fail
+}
@@ -0,0 +1,149 @@
+package customdomain
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"strings"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/route53"
+	"github.com/aws/aws-sdk-go/service/route53/route53iface"
+	"github.com/porter-dev/porter/api/types"
+	"github.com/porter-dev/porter/internal/models"
+	ints "github.com/porter-dev/porter/internal/models/integrations"
+	"github.com/porter-dev/porter/internal/repository"
+	"google.golang.org/api/dns/v1"
+	"google.golang.org/api/googleapi"
+	"google.golang.org/api/option"
+)
+
+// recordTTL is the TTL in seconds of the DNS records created for custom domains
+const recordTTL = 300
+
+// Provider creates the DNS records of custom domains
+type Provider interface {
+	// UpsertRecord points name to target with a record of the given type in a zone, replacing the record of the same type if it exists
+	UpsertRecord(ctx context.Context, zone, name, recordType, target string) error
+}
+
+// NewProviderForDomain returns the DNS provider which manages the record of a custom domain, with the
+// integration of the project set on the domain
+func NewProviderForDomain(ctx context.Context, repo repository.Repository, d *models.CustomDomain) (Provider, error) {
+	switch d.DNSProvider {
+	case types.DNSProvider_Route53:
+		awsInt, err := repo.AWSIntegration().ReadAWSIntegration(ctx, d.ProjectID, d.AWSIntegrationID)
+		if err != nil {
+			return nil, fmt.Errorf("error reading aws integration: %w", err)
+		}
+
+		return NewRoute53Provider(awsInt)
+	case types.DNSProvider_CloudDNS:
+		gcpInt, err := repo.GCPIntegration().ReadGCPIntegration(ctx, d.ProjectID, d.GCPIntegrationID)
+		if err != nil {
+			return nil, fmt.Errorf("error reading gcp integration: %w", err)
+		}
+
+		return NewCloudDNSProvider(ctx, gcpInt)
+	default:
+		return nil, fmt.Errorf("unsupported dns provider %s", d.DNSProvider)
+	}
+}
+
+type route53Provider struct {
+	client route53iface.Route53API
+}
+
+// NewRoute53Provider returns a provider which creates records in Route53 hosted zones with the credentials of an AWS integration
+func NewRoute53Provider(awsInt *ints.AWSIntegration) (Provider, error) {
+	sess, err := awsInt.GetSession()
+	if err != nil {
+		return nil, err
+	}
+
+	return NewRoute53ProviderWithClient(route53.New(sess)), nil
+}
+
+// NewRoute53ProviderWithClient returns a provider which creates records in Route53 hosted zones with the given client
+func NewRoute53ProviderWithClient(client route53iface.Route53API) Provider {
+	return &route53Provider{client}
+}
+
+// UpsertRecord points name to target in a Route53 hosted zone
+func (p *route53Provider) UpsertRecord(ctx context.Context, zone, name, recordType, target string) error {
+	_, err := p.client.ChangeResourceRecordSetsWithContext(ctx, &route53.ChangeResourceRecordSetsInput{
+		HostedZoneId: aws.String(zone),
+		ChangeBatch: &route53.ChangeBatch{
+			Comment: aws.String(fmt.Sprintf("porter: point %s to the ingress of its cluster", name)),
+			Changes: []*route53.Change{
+				{
+					Action: aws.String(route53.ChangeActionUpsert),
+					ResourceRecordSet: &route53.ResourceRecordSet{
+						Name: aws.String(name),
+						Type: aws.String(recordType),
+						TTL:  aws.Int64(recordTTL),
+						ResourceRecords: []*route53.ResourceRecord{
+							{Value: aws.String(target)},
+						},
+					},
+				},
+			},
+		},
+	})
+
+	return err
+}
+
+type cloudDNSProvider struct {
+	service *dns.Service
+	project string
+}
+
+// NewCloudDNSProvider returns a provider which creates records in the Cloud DNS managed zones of the GCP project of a GCP integration
+func NewCloudDNSProvider(ctx context.Context, gcpInt *ints.GCPIntegration) (Provider, error) {
+	service, err := dns.NewService(ctx, option.WithCredentialsJSON(gcpInt.GCPKeyData))
+	if err != nil {
+		return nil, err
+	}
+
+	return &cloudDNSProvider{service, gcpInt.GCPProjectID}, nil
+}
+
+// UpsertRecord points name to target in a Cloud DNS managed zone. Cloud DNS expects fully qualified names, with a trailing dot.
+func (p *cloudDNSProvider) UpsertRecord(ctx context.Context, zone, name, recordType, target string) error {
+	data := target
+	if recordType == "CNAME" {
+		data = fqdn(target)
+	}
+
+	rrset := &dns.ResourceRecordSet{
+		Name:    fqdn(name),
+		Type:    recordType,
+		Ttl:     recordTTL,
+		Rrdatas: []string{data},
+	}
+
+	_, err := p.service.ResourceRecordSets.Get(p.project, zone, rrset.Name, recordType).Context(ctx).Do()
+	if err == nil {
+		_, err = p.service.ResourceRecordSets.Patch(p.project, zone, rrset.Name, recordType, rrset).Context(ctx).Do()
+		return err
+	}
+
+	var apiErr *googleapi.Error
+	if !errors.As(err, &apiErr) || apiErr.Code != http.StatusNotFound {
+		return err
+	}
+
+	_, err = p.service.ResourceRecordSets.Create(p.project, zone, rrset).Context(ctx).Do()
+
+	return err
+}
+
+func fqdn(name string) string {
+	if strings.HasSuffix(name, ".") {
+		return name
+	}
+
+	return name + "."
+}
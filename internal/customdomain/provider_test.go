@@ -0,0 +1,48 @@
+package customdomain
+
+import (
+	"context"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/request"
+	"github.com/aws/aws-sdk-go/service/route53"
+	"github.com/aws/aws-sdk-go/service/route53/route53iface"
+	"github.com/matryer/is"
+)
+
+type fakeRoute53 struct {
+	route53iface.Route53API
+
+	input *route53.ChangeResourceRecordSetsInput
+}
+
+func (f *fakeRoute53) ChangeResourceRecordSetsWithContext(_ aws.Context, input *route53.ChangeResourceRecordSetsInput, _ ...request.Option) (*route53.ChangeResourceRecordSetsOutput, error) {
+	f.input = input
+	return &route53.ChangeResourceRecordSetsOutput{}, nil
+}
+
+func TestRoute53UpsertRecord(t *testing.T) {
+	is := is.New(t)
+
+	client := &fakeRoute53{}
+
+	err := NewRoute53ProviderWithClient(client).UpsertRecord(context.Background(), "Z123", "api.example.com", "CNAME", lbHostname)
+	is.NoErr(err)
+
+	is.Equal(aws.StringValue(client.input.HostedZoneId), "Z123")
+	is.Equal(len(client.input.ChangeBatch.Changes), 1)
+
+	change := client.input.ChangeBatch.Changes[0]
+	is.Equal(aws.StringValue(change.Action), route53.ChangeActionUpsert)
+	is.Equal(aws.StringValue(change.ResourceRecordSet.Name), "api.example.com")
+	is.Equal(aws.StringValue(change.ResourceRecordSet.Type), "CNAME")
+	is.Equal(aws.StringValue(change.ResourceRecordSet.ResourceRecords[0].Value), lbHostname)
+}
+
+func TestFQDN(t *testing.T) {
+	is := is.New(t)
+
+	is.Equal(fqdn("api.example.com"), "api.example.com.")
+	is.Equal(fqdn("api.example.com."), "api.example.com.")
+}
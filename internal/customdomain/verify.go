@@ -0,0 +1,169 @@
+// Package customdomain verifies that the custom domains of apps resolve to the ingress of their cluster,
+// and creates their DNS records in Route53 or Cloud DNS for projects which let Porter manage them.
+package customdomain
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net"
+	"strings"
+	"time"
+
+	"github.com/porter-dev/porter/api/types"
+	"github.com/porter-dev/porter/internal/kubernetes/domain"
+	"github.com/porter-dev/porter/internal/models"
+	"github.com/porter-dev/porter/internal/repository"
+	"k8s.io/client-go/kubernetes"
+)
+
+// wildcardLabel replaces the wildcard of a wildcard domain when it is looked up, since any label
+// resolves through a wildcard record
+const wildcardLabel = "porter-verify"
+
+// Resolver looks up DNS records, and is implemented by net.Resolver
+type Resolver interface {
+	LookupCNAME(ctx context.Context, host string) (string, error)
+	LookupHost(ctx context.Context, host string) ([]string, error)
+}
+
+// Check refreshes the target of a custom domain from the ingress of its cluster, creates its DNS record if
+// Porter manages it and the domain is not verified yet, verifies the record and saves the domain
+func Check(ctx context.Context, repo repository.Repository, clientset kubernetes.Interface, resolver Resolver, d *models.CustomDomain) error {
+	target, found, err := domain.GetNGINXIngressServiceIP(clientset)
+	if err != nil {
+		return fmt.Errorf("error getting address of ingress: %w", err)
+	}
+
+	// the address of the load balancer is kept if it is not reported, such as while the service is updated
+	if found {
+		d.Target = target
+	}
+
+	if d.DNSProvider != "" && d.Target != "" && d.Status != types.CustomDomainStatus_Verified {
+		provider, err := NewProviderForDomain(ctx, repo, d)
+		if err != nil {
+			return err
+		}
+
+		if err := provider.UpsertRecord(ctx, d.DNSZone, d.Name, d.RecordType(), d.Target); err != nil {
+			return fmt.Errorf("error creating dns record: %w", err)
+		}
+	}
+
+	if err := Verify(ctx, resolver, d, time.Now()); err != nil {
+		return err
+	}
+
+	if _, err := repo.CustomDomain().UpdateCustomDomain(ctx, d); err != nil {
+		return fmt.Errorf("error updating custom domain: %w", err)
+	}
+
+	return nil
+}
+
+// Verify looks up a custom domain and sets its status, depending on whether it resolves to its target. A
+// domain with a hostname target is verified if it is a CNAME of the target, or if it resolves to the same
+// addresses, such as through an ALIAS record at the apex of a zone. Lookups which fail for reasons other
+// than a missing record return an error and leave the status unchanged.
+func Verify(ctx context.Context, resolver Resolver, d *models.CustomDomain, now time.Time) error {
+	if d.Target == "" {
+		setStatus(d, types.CustomDomainStatus_Pending, "the address of the ingress of the cluster is not known yet", now)
+		return nil
+	}
+
+	host := d.Name
+	if strings.HasPrefix(host, "*.") {
+		host = wildcardLabel + host[1:]
+	}
+
+	if net.ParseIP(d.Target) == nil {
+		cname, err := resolver.LookupCNAME(ctx, host)
+		if err != nil && !isNotFound(err) {
+			return fmt.Errorf("error looking up cname of %s: %w", host, err)
+		}
+
+		if err == nil && strings.EqualFold(trimDot(cname), trimDot(d.Target)) {
+			setStatus(d, types.CustomDomainStatus_Verified, "", now)
+			return nil
+		}
+
+		if err == nil && !strings.EqualFold(trimDot(cname), trimDot(host)) {
+			targetAddrs, targetErr := resolver.LookupHost(ctx, d.Target)
+			hostAddrs, hostErr := resolver.LookupHost(ctx, host)
+
+			if targetErr != nil || hostErr != nil || !overlaps(hostAddrs, targetAddrs) {
+				setStatus(d, types.CustomDomainStatus_Misconfigured, fmt.Sprintf("%s is a CNAME of %s instead of %s", d.Name, trimDot(cname), d.Target), now)
+				return nil
+			}
+
+			setStatus(d, types.CustomDomainStatus_Verified, "", now)
+			return nil
+		}
+	}
+
+	addrs, err := resolver.LookupHost(ctx, host)
+	if err != nil {
+		if isNotFound(err) {
+			setStatus(d, types.CustomDomainStatus_Pending, fmt.Sprintf("no DNS record found for %s", d.Name), now)
+			return nil
+		}
+
+		return fmt.Errorf("error looking up %s: %w", host, err)
+	}
+
+	targetAddrs := []string{d.Target}
+	if net.ParseIP(d.Target) == nil {
+		targetAddrs, err = resolver.LookupHost(ctx, d.Target)
+		if err != nil {
+			return fmt.Errorf("error looking up %s: %w", d.Target, err)
+		}
+	}
+
+	if !overlaps(addrs, targetAddrs) {
+		setStatus(d, types.CustomDomainStatus_Misconfigured, fmt.Sprintf("%s resolves to %s instead of %s", d.Name, strings.Join(addrs, ", "), d.Target), now)
+		return nil
+	}
+
+	setStatus(d, types.CustomDomainStatus_Verified, "", now)
+
+	return nil
+}
+
+func setStatus(d *models.CustomDomain, status types.CustomDomainStatus, message string, now time.Time) {
+	if status == types.CustomDomainStatus_Verified && (d.Status != status || d.VerifiedAt == nil) {
+		d.VerifiedAt = &now
+	}
+
+	if status != types.CustomDomainStatus_Verified {
+		d.VerifiedAt = nil
+	}
+
+	d.Status = status
+	d.StatusMessage = message
+	d.LastCheckedAt = &now
+}
+
+func isNotFound(err error) bool {
+	var dnsErr *net.DNSError
+	return errors.As(err, &dnsErr) && dnsErr.IsNotFound
+}
+
+func overlaps(a, b []string) bool {
+	set := make(map[string]bool, len(a))
+	for _, addr := range a {
+		set[addr] = true
+	}
+
+	for _, addr := range b {
+		if set[addr] {
+			return true
+		}
+	}
+
+	return false
+}
+
+func trimDot(name string) string {
+	return strings.TrimSuffix(name, ".")
+}
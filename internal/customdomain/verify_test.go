@@ -0,0 +1,112 @@
+package customdomain
+
+import (
+	"context"
+	"net"
+	"testing"
+	"time"
+
+	"github.com/matryer/is"
+	"github.com/porter-dev/porter/api/types"
+	"github.com/porter-dev/porter/internal/models"
+)
+
+type fakeResolver struct {
+	cnames map[string]string
+	hosts  map[string][]string
+}
+
+func (f fakeResolver) LookupCNAME(_ context.Context, host string) (string, error) {
+	if cname, ok := f.cnames[host]; ok {
+		return cname, nil
+	}
+
+	if _, ok := f.hosts[host]; ok {
+		return host + ".", nil
+	}
+
+	return "", &net.DNSError{Name: host, IsNotFound: true}
+}
+
+func (f fakeResolver) LookupHost(_ context.Context, host string) ([]string, error) {
+	if cname, ok := f.cnames[host]; ok {
+		return f.LookupHost(context.Background(), trimDot(cname))
+	}
+
+	if addrs, ok := f.hosts[host]; ok {
+		return addrs, nil
+	}
+
+	return nil, &net.DNSError{Name: host, IsNotFound: true}
+}
+
+const lbHostname = "abc123.elb.us-east-1.amazonaws.com"
+
+var resolver = fakeResolver{
+	cnames: map[string]string{
+		"api.example.com":           lbHostname + ".",
+		"porter-verify.example.com": lbHostname + ".",
+		"old.example.com":           "other.example.net.",
+	},
+	hosts: map[string][]string{
+		lbHostname:             {"3.3.3.3", "4.4.4.4"},
+		"example.com":          {"4.4.4.4"},
+		"other.example.net":    {"5.5.5.5"},
+		"ip.example.com":       {"1.2.3.4"},
+		"wrong-ip.example.com": {"9.9.9.9"},
+	},
+}
+
+func TestVerifyHostnameTarget(t *testing.T) {
+	is := is.New(t)
+	now := time.Now()
+
+	tests := []struct {
+		name   string
+		status types.CustomDomainStatus
+	}{
+		{"api.example.com", types.CustomDomainStatus_Verified},
+		{"*.example.com", types.CustomDomainStatus_Verified},
+		{"example.com", types.CustomDomainStatus_Verified}, // apex alias records resolve to the addresses of the load balancer
+		{"old.example.com", types.CustomDomainStatus_Misconfigured},
+		{"missing.example.com", types.CustomDomainStatus_Pending},
+	}
+
+	for _, tt := range tests {
+		d := &models.CustomDomain{Name: tt.name, Target: lbHostname}
+
+		is.NoErr(Verify(context.Background(), resolver, d, now))
+		is.Equal(d.Status, tt.status)
+		is.Equal(*d.LastCheckedAt, now)
+		is.Equal(d.VerifiedAt != nil, tt.status == types.CustomDomainStatus_Verified)
+	}
+}
+
+func TestVerifyIPTarget(t *testing.T) {
+	is := is.New(t)
+	now := time.Now()
+
+	d := &models.CustomDomain{Name: "ip.example.com", Target: "1.2.3.4"}
+	is.NoErr(Verify(context.Background(), resolver, d, now))
+	is.Equal(d.Status, types.CustomDomainStatus_Verified)
+	is.Equal(d.RecordType(), "A")
+
+	d = &models.CustomDomain{Name: "wrong-ip.example.com", Target: "1.2.3.4"}
+	is.NoErr(Verify(context.Background(), resolver, d, now))
+	is.Equal(d.Status, types.CustomDomainStatus_Misconfigured)
+	is.Equal(d.StatusMessage, "wrong-ip.example.com resolves to 9.9.9.9 instead of 1.2.3.4")
+}
+
+func TestVerifyKeepsVerifiedAt(t *testing.T) {
+	is := is.New(t)
+
+	verifiedAt := time.Now().Add(-time.Hour)
+	d := &models.CustomDomain{Name: "api.example.com", Target: lbHostname, Status: types.CustomDomainStatus_Verified, VerifiedAt: &verifiedAt}
+
+	is.NoErr(Verify(context.Background(), resolver, d, time.Now()))
+	is.Equal(*d.VerifiedAt, verifiedAt)
+
+	d = &models.CustomDomain{Name: "api.example.com"}
+	is.NoErr(Verify(context.Background(), resolver, d, time.Now()))
+	is.Equal(d.Status, types.CustomDomainStatus_Pending) // the target is not known yet
+}
@@ -0,0 +1,255 @@
+// Package datastore provisions the managed datastores of a project as RDS instances, using the
+// credentials of an AWS integration.
+package datastore
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/url"
+	"strconv"
+	"strings"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/awserr"
+	"github.com/aws/aws-sdk-go/service/rds"
+	"github.com/aws/aws-sdk-go/service/rds/rdsiface"
+	"github.com/porter-dev/porter/api/types"
+	"github.com/porter-dev/porter/internal/models"
+	ints "github.com/porter-dev/porter/internal/models/integrations"
+	"github.com/porter-dev/porter/internal/random"
+)
+
+const (
+	// DefaultInstanceClass is the instance class of a datastore if none is set
+	DefaultInstanceClass = "db.t4g.micro"
+	// DefaultStorageGB is the allocated storage of a datastore if none is set
+	DefaultStorageGB = 20
+
+	masterUsername = "porter"
+	passwordLength = 32
+	passwordChars  = "abcdefghijklmnopqrstuvwxyzABCDEFGHIJKLMNOPQRSTUVWXYZ0123456789"
+)
+
+// ErrInstanceNotFound is returned by Refresh when the RDS instance of a datastore no longer exists
+var ErrInstanceNotFound = errors.New("rds instance not found")
+
+// Provisioner creates, inspects and deletes the RDS instances of datastores
+type Provisioner struct {
+	client rdsiface.RDSAPI
+}
+
+// NewProvisioner returns a provisioner which calls RDS in the given region with the credentials of an AWS integration
+func NewProvisioner(awsInt *ints.AWSIntegration, region string) (*Provisioner, error) {
+	sess, err := awsInt.GetSession()
+	if err != nil {
+		return nil, err
+	}
+
+	awsConf := aws.NewConfig()
+
+	if region != "" {
+		awsConf = awsConf.WithRegion(region)
+	}
+
+	return NewProvisionerWithClient(rds.New(sess, awsConf)), nil
+}
+
+// NewProvisionerWithClient returns a provisioner which calls RDS with the given client
+func NewProvisionerWithClient(client rdsiface.RDSAPI) *Provisioner {
+	return &Provisioner{client}
+}
+
+// InstanceIdentifier returns the identifier of the RDS instance of a datastore. The project id is
+// part of the identifier, so that projects sharing an AWS account do not clash.
+func InstanceIdentifier(projectID uint, name string) string {
+	return fmt.Sprintf("porter-%d-%s", projectID, name)
+}
+
+// DefaultDatabaseName returns the name of the database created on the instance of a datastore if
+// none is set. RDS only allows letters and digits for MySQL, and the name must start with a letter.
+func DefaultDatabaseName(name string) string {
+	dbName := strings.ReplaceAll(name, "-", "")
+
+	if dbName == "" || dbName[0] < 'a' || dbName[0] > 'z' {
+		dbName = "db" + dbName
+	}
+
+	return dbName
+}
+
+// Create provisions the RDS instance of a datastore. The identifier, credentials and defaults of the
+// datastore are set before the instance is created, and the datastore is left in the creating state.
+func (p *Provisioner) Create(ctx context.Context, datastore *models.Datastore) error {
+	if datastore.InstanceIdentifier == "" {
+		datastore.InstanceIdentifier = InstanceIdentifier(datastore.ProjectID, datastore.Name)
+	}
+
+	if datastore.InstanceClass == "" {
+		datastore.InstanceClass = DefaultInstanceClass
+	}
+
+	if datastore.StorageGB == 0 {
+		datastore.StorageGB = DefaultStorageGB
+	}
+
+	if datastore.DatabaseName == "" {
+		datastore.DatabaseName = DefaultDatabaseName(datastore.Name)
+	}
+
+	if datastore.MasterUsername == "" {
+		datastore.MasterUsername = masterUsername
+	}
+
+	if len(datastore.MasterPassword) == 0 {
+		password, err := GeneratePassword()
+		if err != nil {
+			return fmt.Errorf("error generating master password: %w", err)
+		}
+
+		datastore.MasterPassword = []byte(password)
+	}
+
+	input := &rds.CreateDBInstanceInput{
+		DBInstanceIdentifier: aws.String(datastore.InstanceIdentifier),
+		DBInstanceClass:      aws.String(datastore.InstanceClass),
+		Engine:               aws.String(string(datastore.Engine)),
+		AllocatedStorage:     aws.Int64(datastore.StorageGB),
+		DBName:               aws.String(datastore.DatabaseName),
+		MasterUsername:       aws.String(datastore.MasterUsername),
+		MasterUserPassword:   aws.String(string(datastore.MasterPassword)),
+		PubliclyAccessible:   aws.Bool(false),
+		StorageEncrypted:     aws.Bool(true),
+		Tags: []*rds.Tag{
+			{Key: aws.String("porter.run/managed"), Value: aws.String("true")},
+			{Key: aws.String("porter.run/project-id"), Value: aws.String(strconv.FormatUint(uint64(datastore.ProjectID), 10))},
+			{Key: aws.String("porter.run/datastore-name"), Value: aws.String(datastore.Name)},
+		},
+	}
+
+	if datastore.EngineVersion != "" {
+		input.EngineVersion = aws.String(datastore.EngineVersion)
+	}
+
+	if _, err := p.client.CreateDBInstanceWithContext(ctx, input); err != nil {
+		return fmt.Errorf("error creating rds instance: %w", err)
+	}
+
+	datastore.Status = types.DatastoreStatus_Creating
+	datastore.StatusMessage = ""
+
+	return nil
+}
+
+// Refresh updates the status, endpoint and engine version of a datastore from its RDS instance.
+// ErrInstanceNotFound is returned if the instance no longer exists.
+func (p *Provisioner) Refresh(ctx context.Context, datastore *models.Datastore) error {
+	out, err := p.client.DescribeDBInstancesWithContext(ctx, &rds.DescribeDBInstancesInput{
+		DBInstanceIdentifier: aws.String(datastore.InstanceIdentifier),
+	})
+	if err != nil {
+		if isInstanceNotFound(err) {
+			return ErrInstanceNotFound
+		}
+
+		return fmt.Errorf("error describing rds instance: %w", err)
+	}
+
+	if len(out.DBInstances) == 0 {
+		return ErrInstanceNotFound
+	}
+
+	instance := out.DBInstances[0]
+	instanceStatus := aws.StringValue(instance.DBInstanceStatus)
+
+	datastore.Status = status(instanceStatus)
+	datastore.StatusMessage = ""
+
+	if datastore.Status == types.DatastoreStatus_Failed {
+		datastore.StatusMessage = fmt.Sprintf("rds instance status is %s", instanceStatus)
+	}
+
+	if instance.Endpoint != nil {
+		datastore.Endpoint = aws.StringValue(instance.Endpoint.Address)
+		datastore.Port = aws.Int64Value(instance.Endpoint.Port)
+	}
+
+	if instance.EngineVersion != nil {
+		datastore.EngineVersion = aws.StringValue(instance.EngineVersion)
+	}
+
+	return nil
+}
+
+// Delete deletes the RDS instance of a datastore, after taking a final snapshot of it. The
+// datastore is left in the deleting state.
+func (p *Provisioner) Delete(ctx context.Context, datastore *models.Datastore) error {
+	_, err := p.client.DeleteDBInstanceWithContext(ctx, &rds.DeleteDBInstanceInput{
+		DBInstanceIdentifier:      aws.String(datastore.InstanceIdentifier),
+		FinalDBSnapshotIdentifier: aws.String(datastore.InstanceIdentifier + "-final"),
+	})
+	if err != nil && !isInstanceNotFound(err) {
+		return fmt.Errorf("error deleting rds instance: %w", err)
+	}
+
+	datastore.Status = types.DatastoreStatus_Deleting
+	datastore.StatusMessage = ""
+
+	return nil
+}
+
+// GeneratePassword returns a random password which is valid for every engine
+func GeneratePassword() (string, error) {
+	return random.StringWithCharset(passwordLength, passwordChars)
+}
+
+// ConnectionString returns the URL to connect to the database of a datastore with the credentials of the given user
+func ConnectionString(datastore *models.Datastore, username, password string) (string, error) {
+	if datastore.Endpoint == "" {
+		return "", fmt.Errorf("datastore %s has no endpoint", datastore.Name)
+	}
+
+	scheme := string(datastore.Engine)
+
+	host := datastore.Endpoint
+	if datastore.Port != 0 {
+		host = fmt.Sprintf("%s:%d", host, datastore.Port)
+	}
+
+	u := url.URL{
+		Scheme: scheme,
+		User:   url.UserPassword(username, password),
+		Host:   host,
+		Path:   "/" + datastore.DatabaseName,
+	}
+
+	return u.String(), nil
+}
+
+// IsTerminal returns whether the status of a datastore no longer changes without an action
+func IsTerminal(status types.DatastoreStatus) bool {
+	return status == types.DatastoreStatus_Available || status == types.DatastoreStatus_Failed
+}
+
+// status maps the status of an RDS instance to the status of a datastore
+func status(instanceStatus string) types.DatastoreStatus {
+	switch {
+	case instanceStatus == "available" || instanceStatus == "storage-optimization":
+		return types.DatastoreStatus_Available
+	case instanceStatus == "deleting":
+		return types.DatastoreStatus_Deleting
+	case instanceStatus == "failed" || instanceStatus == "storage-full" || strings.HasPrefix(instanceStatus, "incompatible-") ||
+		instanceStatus == "inaccessible-encryption-credentials" || instanceStatus == "stopped":
+		return types.DatastoreStatus_Failed
+	case instanceStatus == "creating" || instanceStatus == "backing-up":
+		return types.DatastoreStatus_Creating
+	default:
+		return types.DatastoreStatus_Updating
+	}
+}
+
+func isInstanceNotFound(err error) bool {
+	var aerr awserr.Error
+
+	return errors.As(err, &aerr) && aerr.Code() == rds.ErrCodeDBInstanceNotFoundFault
+}
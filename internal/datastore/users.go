@@ -0,0 +1,143 @@
+package datastore
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/jackc/pgx/v4"
+	// registers the pgx driver with database/sql
+	_ "github.com/jackc/pgx/v4/stdlib"
+	"github.com/porter-dev/porter/api/types"
+	"github.com/porter-dev/porter/internal/models"
+)
+
+// maxUsernameLength is the maximum length of an identifier in PostgreSQL
+const maxUsernameLength = 63
+
+// ErrUnsupportedEngine is returned when app users cannot be managed for the engine of a datastore
+var ErrUnsupportedEngine = errors.New("app users are only supported for postgres datastores")
+
+// Users manages the dedicated database users that apps connect to a datastore with
+type Users interface {
+	// Create creates a user which can use the database of the datastore
+	Create(ctx context.Context, username, password string) error
+	// SetPassword changes the password of a user. Open connections of the user are not closed.
+	SetPassword(ctx context.Context, username, password string) error
+	// Drop reassigns the objects owned by a user to the master user, then drops the user
+	Drop(ctx context.Context, username string) error
+	// Close closes the connection to the datastore
+	Close() error
+}
+
+// AppUsername returns the name of the database user of an app
+func AppUsername(clusterID uint, appName string) string {
+	username := fmt.Sprintf("app_%d_%s", clusterID, strings.ReplaceAll(appName, "-", "_"))
+
+	if len(username) > maxUsernameLength {
+		username = username[:maxUsernameLength]
+	}
+
+	return username
+}
+
+// ConnectUsers connects to the database of an available datastore as its master user
+func ConnectUsers(ctx context.Context, datastore *models.Datastore) (Users, error) {
+	if datastore.Engine != types.DatastoreEngine_Postgres {
+		return nil, ErrUnsupportedEngine
+	}
+
+	connStr, err := ConnectionString(datastore, datastore.MasterUsername, string(datastore.MasterPassword))
+	if err != nil {
+		return nil, err
+	}
+
+	db, err := sql.Open("pgx", connStr)
+	if err != nil {
+		return nil, fmt.Errorf("error opening connection to datastore: %w", err)
+	}
+
+	if err := db.PingContext(ctx); err != nil {
+		db.Close()
+		return nil, fmt.Errorf("error connecting to datastore: %w", err)
+	}
+
+	return newPostgresUsers(db, datastore), nil
+}
+
+// execer is the subset of *sql.DB used to manage users, so that statements can be checked in tests
+type execer interface {
+	ExecContext(ctx context.Context, query string, args ...any) (sql.Result, error)
+	Close() error
+}
+
+// postgresUsers manages users with the master user of a PostgreSQL datastore
+type postgresUsers struct {
+	db             execer
+	databaseName   string
+	masterUsername string
+}
+
+func newPostgresUsers(db execer, datastore *models.Datastore) *postgresUsers {
+	return &postgresUsers{
+		db:             db,
+		databaseName:   datastore.DatabaseName,
+		masterUsername: datastore.MasterUsername,
+	}
+}
+
+// Create creates a login role with all privileges on the database and its public schema. The master
+// user is granted the role, so that it can drop the role and reassign its objects later.
+func (u *postgresUsers) Create(ctx context.Context, username, password string) error {
+	user := quoteIdentifier(username)
+
+	return u.exec(ctx,
+		fmt.Sprintf("CREATE ROLE %s WITH LOGIN PASSWORD %s", user, quoteLiteral(password)),
+		fmt.Sprintf("GRANT %s TO %s", user, quoteIdentifier(u.masterUsername)),
+		fmt.Sprintf("GRANT ALL PRIVILEGES ON DATABASE %s TO %s", quoteIdentifier(u.databaseName), user),
+		fmt.Sprintf("GRANT ALL ON SCHEMA public TO %s", user),
+	)
+}
+
+// SetPassword changes the password of a role
+func (u *postgresUsers) SetPassword(ctx context.Context, username, password string) error {
+	return u.exec(ctx,
+		fmt.Sprintf("ALTER ROLE %s WITH PASSWORD %s", quoteIdentifier(username), quoteLiteral(password)),
+	)
+}
+
+// Drop reassigns the tables and other objects of a role to the master user, so that the data of the app is kept, then drops the role
+func (u *postgresUsers) Drop(ctx context.Context, username string) error {
+	user := quoteIdentifier(username)
+
+	return u.exec(ctx,
+		fmt.Sprintf("REASSIGN OWNED BY %s TO %s", user, quoteIdentifier(u.masterUsername)),
+		fmt.Sprintf("DROP OWNED BY %s", user),
+		fmt.Sprintf("DROP ROLE IF EXISTS %s", user),
+	)
+}
+
+func (u *postgresUsers) Close() error {
+	return u.db.Close()
+}
+
+func (u *postgresUsers) exec(ctx context.Context, statements ...string) error {
+	for _, statement := range statements {
+		if _, err := u.db.ExecContext(ctx, statement); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func quoteIdentifier(identifier string) string {
+	return pgx.Identifier{identifier}.Sanitize()
+}
+
+// quoteLiteral quotes a string literal, since statements such as CREATE ROLE do not accept parameters
+func quoteLiteral(literal string) string {
+	return "'" + strings.ReplaceAll(literal, "'", "''") + "'"
+}
@@ -0,0 +1,63 @@
+package datastore
+
+import (
+	"context"
+	"database/sql"
+	"testing"
+
+	"github.com/matryer/is"
+	"github.com/porter-dev/porter/api/types"
+	"github.com/porter-dev/porter/internal/models"
+)
+
+type fakeExecer struct {
+	statements []string
+}
+
+func (f *fakeExecer) ExecContext(_ context.Context, query string, _ ...any) (sql.Result, error) {
+	f.statements = append(f.statements, query)
+	return nil, nil
+}
+
+func (f *fakeExecer) Close() error {
+	return nil
+}
+
+func TestPostgresUsers(t *testing.T) {
+	is := is.New(t)
+	ctx := context.Background()
+
+	db := &fakeExecer{}
+	users := newPostgresUsers(db, &models.Datastore{DatabaseName: "appdb", MasterUsername: "porter"})
+
+	is.NoErr(users.Create(ctx, "app_1_web", "pass'word"))
+	is.Equal(db.statements, []string{
+		`CREATE ROLE "app_1_web" WITH LOGIN PASSWORD 'pass''word'`,
+		`GRANT "app_1_web" TO "porter"`,
+		`GRANT ALL PRIVILEGES ON DATABASE "appdb" TO "app_1_web"`,
+		`GRANT ALL ON SCHEMA public TO "app_1_web"`,
+	})
+
+	db.statements = nil
+	is.NoErr(users.SetPassword(ctx, "app_1_web", "rotated"))
+	is.Equal(db.statements, []string{`ALTER ROLE "app_1_web" WITH PASSWORD 'rotated'`})
+
+	db.statements = nil
+	is.NoErr(users.Drop(ctx, "app_1_web"))
+	is.Equal(db.statements[0], `REASSIGN OWNED BY "app_1_web" TO "porter"`) // the data of the app is kept
+	is.Equal(db.statements[2], `DROP ROLE IF EXISTS "app_1_web"`)
+}
+
+func TestAppUsername(t *testing.T) {
+	is := is.New(t)
+
+	is.Equal(AppUsername(3, "my-app"), "app_3_my_app")
+	is.Equal(len(AppUsername(3, "a-very-long-app-name-which-does-not-fit-in-a-postgres-identifier")), maxUsernameLength)
+}
+
+func TestConnectUsersUnsupportedEngine(t *testing.T) {
+	is := is.New(t)
+
+	_, err := ConnectUsers(context.Background(), &models.Datastore{Engine: types.DatastoreEngine_MySQL})
+	is.Equal(err, ErrUnsupportedEngine)
+}
@@ -0,0 +1,92 @@
+package eventsink
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/cloudwatchlogs"
+	"github.com/aws/aws-sdk-go/service/cloudwatchlogs/cloudwatchlogsiface"
+	"github.com/porter-dev/porter/api/types"
+	ints "github.com/porter-dev/porter/internal/models/integrations"
+)
+
+// CloudWatchSink puts records to a log stream of CloudWatch Logs
+type CloudWatchSink struct {
+	client    cloudwatchlogsiface.CloudWatchLogsAPI
+	logGroup  string
+	logStream string
+}
+
+// NewCloudWatchSink returns a sink which puts records to CloudWatch Logs with the credentials of an AWS integration
+func NewCloudWatchSink(awsInt *ints.AWSIntegration, config *types.CloudWatchEventSinkConfig) (*CloudWatchSink, error) {
+	sess, err := awsInt.GetSession()
+	if err != nil {
+		return nil, err
+	}
+
+	awsConf := aws.NewConfig()
+
+	if config.Region != "" {
+		awsConf = awsConf.WithRegion(config.Region)
+	}
+
+	return NewCloudWatchSinkWithClient(cloudwatchlogs.New(sess, awsConf), config), nil
+}
+
+// NewCloudWatchSinkWithClient returns a sink which puts records to CloudWatch Logs with the given client
+func NewCloudWatchSinkWithClient(client cloudwatchlogsiface.CloudWatchLogsAPI, config *types.CloudWatchEventSinkConfig) *CloudWatchSink {
+	logStream := config.LogStream
+	if logStream == "" {
+		logStream = "porter-kube-events"
+	}
+
+	return &CloudWatchSink{
+		client:    client,
+		logGroup:  config.LogGroup,
+		logStream: logStream,
+	}
+}
+
+// Send puts a record as a JSON log event. The log group must already exist, while the log stream is
+// created on the first record sent to it.
+func (s *CloudWatchSink) Send(ctx context.Context, record *Record) error {
+	message, err := json.Marshal(record)
+	if err != nil {
+		return err
+	}
+
+	input := &cloudwatchlogs.PutLogEventsInput{
+		LogGroupName:  aws.String(s.logGroup),
+		LogStreamName: aws.String(s.logStream),
+		LogEvents: []*cloudwatchlogs.InputLogEvent{
+			{
+				Message:   aws.String(string(message)),
+				Timestamp: aws.Int64(record.Timestamp.UnixMilli()),
+			},
+		},
+	}
+
+	_, err = s.client.PutLogEventsWithContext(ctx, input)
+
+	var notFoundErr *cloudwatchlogs.ResourceNotFoundException
+	if !errors.As(err, &notFoundErr) {
+		return err
+	}
+
+	_, err = s.client.CreateLogStreamWithContext(ctx, &cloudwatchlogs.CreateLogStreamInput{
+		LogGroupName:  aws.String(s.logGroup),
+		LogStreamName: aws.String(s.logStream),
+	})
+	if err != nil {
+		var existsErr *cloudwatchlogs.ResourceAlreadyExistsException
+		if !errors.As(err, &existsErr) {
+			return err
+		}
+	}
+
+	_, err = s.client.PutLogEventsWithContext(ctx, input)
+
+	return err
+}
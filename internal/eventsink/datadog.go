@@ -0,0 +1,96 @@
+package eventsink
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+
+	"github.com/porter-dev/porter/api/types"
+)
+
+const defaultDatadogSite = "datadoghq.com"
+
+// DatadogSink sends records to the logs intake of a Datadog account
+type DatadogSink struct {
+	apiKey string
+	// endpoint is the logs intake URL of the Datadog site of the account
+	endpoint string
+}
+
+// NewDatadogSink returns a sink which sends records to Datadog
+func NewDatadogSink(config *types.DatadogEventSinkConfig) *DatadogSink {
+	site := config.Site
+	if site == "" {
+		site = defaultDatadogSite
+	}
+
+	return &DatadogSink{
+		apiKey:   config.APIKey,
+		endpoint: fmt.Sprintf("https://http-intake.logs.%s/api/v2/logs", site),
+	}
+}
+
+type datadogLog struct {
+	Source    string  `json:"ddsource"`
+	Service   string  `json:"service"`
+	Tags      string  `json:"ddtags"`
+	Status    string  `json:"status"`
+	Message   string  `json:"message"`
+	KubeEvent *Record `json:"kube_event"`
+}
+
+// Send posts a record as a log, tagged with its project, cluster, namespace and resource type
+func (s *DatadogSink) Send(ctx context.Context, record *Record) error {
+	status := "info"
+
+	switch record.Severity {
+	case types.KubeEventSeverity_Critical:
+		status = "error"
+	case types.KubeEventSeverity_Warning:
+		status = "warn"
+	}
+
+	body, err := json.Marshal([]datadogLog{
+		{
+			Source:  "porter",
+			Service: "porter",
+			Tags: fmt.Sprintf(
+				"project_id:%d,cluster_id:%d,kube_namespace:%s,resource_type:%s,severity:%s",
+				record.ProjectID, record.ClusterID, record.Namespace, record.ResourceType, record.Severity,
+			),
+			Status:    status,
+			Message:   fmt.Sprintf("%s %s: %s: %s", record.ResourceType, record.Name, record.Reason, record.Message),
+			KubeEvent: record,
+		},
+	})
+	if err != nil {
+		return err
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, s.endpoint, bytes.NewReader(body))
+	if err != nil {
+		return err
+	}
+
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set("DD-API-KEY", s.apiKey)
+
+	return sendRequest(req)
+}
+
+// sendRequest sends a request to a sink and returns an error for non-2xx responses
+func sendRequest(req *http.Request) error {
+	resp, err := httpClient.Do(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode < 200 || resp.StatusCode >= 300 {
+		return fmt.Errorf("received status code %d from %s", resp.StatusCode, req.URL.Host)
+	}
+
+	return nil
+}
@@ -0,0 +1,66 @@
+package eventsink
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/porter-dev/porter/internal/models"
+	"github.com/porter-dev/porter/internal/repository"
+	"github.com/porter-dev/porter/internal/telemetry"
+)
+
+// Forwarder sends kube events to the event sinks of their project
+type Forwarder struct {
+	repo repository.Repository
+
+	// newSink returns the sink of an event sink of a project
+	newSink func(ctx context.Context, repo repository.Repository, sink *models.EventSink) (Sink, error)
+}
+
+// NewForwarder returns a Forwarder which reads event sinks from the given repository
+func NewForwarder(repo repository.Repository) *Forwarder {
+	return &Forwarder{
+		repo:    repo,
+		newSink: New,
+	}
+}
+
+// Forward sends a sub event of an event to every event sink of the project. A failing sink does not
+// keep the event from being sent to the other sinks.
+func (f *Forwarder) Forward(ctx context.Context, event *models.KubeEvent, subEvent *models.KubeSubEvent) error {
+	ctx, span := telemetry.NewSpan(ctx, "forward-kube-event")
+	defer span.End()
+
+	telemetry.WithAttributes(span,
+		telemetry.AttributeKV{Key: "project-id", Value: event.ProjectID},
+		telemetry.AttributeKV{Key: "kube-event-id", Value: event.ID},
+	)
+
+	sinks, err := f.repo.EventSink().ListEventSinksByProjectID(ctx, event.ProjectID)
+	if err != nil {
+		return telemetry.Error(ctx, span, err, "error listing event sinks")
+	}
+
+	record := NewRecord(event, subEvent)
+
+	var errs []error
+
+	for _, sink := range sinks {
+		s, err := f.newSink(ctx, f.repo, sink)
+		if err != nil {
+			errs = append(errs, fmt.Errorf("error creating event sink %d: %w", sink.ID, err))
+			continue
+		}
+
+		if err := s.Send(ctx, record); err != nil {
+			errs = append(errs, fmt.Errorf("error sending kube event to %s event sink %d: %w", sink.Type, sink.ID, err))
+		}
+	}
+
+	if len(errs) > 0 {
+		return telemetry.Error(ctx, span, errors.Join(errs...), "error forwarding kube event")
+	}
+
+	return nil
+}
@@ -0,0 +1,86 @@
+package eventsink
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strconv"
+	"strings"
+
+	"github.com/porter-dev/porter/api/types"
+)
+
+// LokiSink pushes records to a Loki instance
+type LokiSink struct {
+	config *types.LokiEventSinkConfig
+}
+
+// NewLokiSink returns a sink which pushes records to Loki
+func NewLokiSink(config *types.LokiEventSinkConfig) *LokiSink {
+	return &LokiSink{
+		config: config,
+	}
+}
+
+type lokiPushRequest struct {
+	Streams []lokiStream `json:"streams"`
+}
+
+type lokiStream struct {
+	Stream map[string]string `json:"stream"`
+	Values [][2]string       `json:"values"`
+}
+
+// Send pushes a record as a JSON log line. Records are labeled with their project, cluster, namespace,
+// resource type and severity, which have a low cardinality.
+func (s *LokiSink) Send(ctx context.Context, record *Record) error {
+	line, err := json.Marshal(record)
+	if err != nil {
+		return err
+	}
+
+	body, err := json.Marshal(lokiPushRequest{
+		Streams: []lokiStream{
+			{
+				Stream: map[string]string{
+					"source":        "porter",
+					"project_id":    strconv.FormatUint(uint64(record.ProjectID), 10),
+					"cluster_id":    strconv.FormatUint(uint64(record.ClusterID), 10),
+					"namespace":     record.Namespace,
+					"resource_type": record.ResourceType,
+					"severity":      string(record.Severity),
+				},
+				Values: [][2]string{
+					{strconv.FormatInt(record.Timestamp.UnixNano(), 10), string(line)},
+				},
+			},
+		},
+	})
+	if err != nil {
+		return err
+	}
+
+	req, err := http.NewRequestWithContext(
+		ctx,
+		http.MethodPost,
+		fmt.Sprintf("%s/loki/api/v1/push", strings.TrimSuffix(s.config.URL, "/")),
+		bytes.NewReader(body),
+	)
+	if err != nil {
+		return err
+	}
+
+	req.Header.Set("Content-Type", "application/json")
+
+	if s.config.TenantID != "" {
+		req.Header.Set("X-Scope-OrgID", s.config.TenantID)
+	}
+
+	if s.config.Username != "" || s.config.Password != "" {
+		req.SetBasicAuth(s.config.Username, s.config.Password)
+	}
+
+	return sendRequest(req)
+}
@@ -0,0 +1,102 @@
+// Package eventsink forwards the kube events of a project to external observability systems, such as
+// Datadog, CloudWatch Logs and Loki, which are configured per project as event sinks.
+package eventsink
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"time"
+
+	"github.com/porter-dev/porter/api/types"
+	"github.com/porter-dev/porter/internal/models"
+	"github.com/porter-dev/porter/internal/repository"
+)
+
+// Sink sends kube event records to an external system
+type Sink interface {
+	Send(ctx context.Context, record *Record) error
+}
+
+// Record is a single occurrence of a kube event, flattened from the event and one of its sub events
+type Record struct {
+	Timestamp    time.Time               `json:"timestamp"`
+	ProjectID    uint                    `json:"project_id"`
+	ClusterID    uint                    `json:"cluster_id"`
+	ResourceType string                  `json:"resource_type"`
+	Name         string                  `json:"name"`
+	Namespace    string                  `json:"namespace"`
+	OwnerType    string                  `json:"owner_type,omitempty"`
+	OwnerName    string                  `json:"owner_name,omitempty"`
+	EventType    types.KubeEventType     `json:"event_type"`
+	Severity     types.KubeEventSeverity `json:"severity"`
+	Reason       string                  `json:"reason"`
+	Message      string                  `json:"message"`
+	Count        uint                    `json:"count"`
+}
+
+// NewRecord returns the record of a sub event of an event
+func NewRecord(event *models.KubeEvent, subEvent *models.KubeSubEvent) *Record {
+	timestamp := subEvent.Timestamp
+	if timestamp.IsZero() {
+		timestamp = time.Now()
+	}
+
+	return &Record{
+		Timestamp:    timestamp.UTC(),
+		ProjectID:    event.ProjectID,
+		ClusterID:    event.ClusterID,
+		ResourceType: event.ResourceType,
+		Name:         event.Name,
+		Namespace:    event.Namespace,
+		OwnerType:    event.OwnerType,
+		OwnerName:    event.OwnerName,
+		EventType:    subEvent.EventType,
+		Severity:     subEvent.Severity,
+		Reason:       subEvent.Reason,
+		Message:      subEvent.Message,
+		Count:        subEvent.Count,
+	}
+}
+
+// httpClient is the client of the sinks which send records over HTTP
+var httpClient = &http.Client{
+	Timeout: time.Second * 10,
+}
+
+// New returns the sink of an event sink of a project. The credentials of CloudWatch sinks are read from the
+// AWS integration of the project set in the sink config.
+func New(ctx context.Context, repo repository.Repository, sink *models.EventSink) (Sink, error) {
+	config, err := sink.SinkConfig()
+	if err != nil {
+		return nil, fmt.Errorf("error decoding event sink config: %w", err)
+	}
+
+	switch sink.Type {
+	case types.EventSinkType_Datadog:
+		if config.Datadog == nil {
+			return nil, fmt.Errorf("datadog event sink %d has no datadog config", sink.ID)
+		}
+
+		return NewDatadogSink(config.Datadog), nil
+	case types.EventSinkType_Loki:
+		if config.Loki == nil {
+			return nil, fmt.Errorf("loki event sink %d has no loki config", sink.ID)
+		}
+
+		return NewLokiSink(config.Loki), nil
+	case types.EventSinkType_CloudWatch:
+		if config.CloudWatch == nil {
+			return nil, fmt.Errorf("cloudwatch event sink %d has no cloudwatch config", sink.ID)
+		}
+
+		awsInt, err := repo.AWSIntegration().ReadAWSIntegration(ctx, sink.ProjectID, config.CloudWatch.AWSIntegrationID)
+		if err != nil {
+			return nil, fmt.Errorf("error reading aws integration of cloudwatch event sink %d: %w", sink.ID, err)
+		}
+
+		return NewCloudWatchSink(awsInt, config.CloudWatch)
+	default:
+		return nil, fmt.Errorf("unknown event sink type %q", sink.Type)
+	}
+}
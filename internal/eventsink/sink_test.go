@@ -0,0 +1,197 @@
+package eventsink
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/request"
+	"github.com/aws/aws-sdk-go/service/cloudwatchlogs"
+	"github.com/aws/aws-sdk-go/service/cloudwatchlogs/cloudwatchlogsiface"
+	"github.com/matryer/is"
+	"github.com/porter-dev/porter/api/types"
+	"github.com/porter-dev/porter/internal/models"
+	"github.com/porter-dev/porter/internal/repository"
+	"github.com/porter-dev/porter/internal/repository/test"
+)
+
+func testRecord() *Record {
+	return NewRecord(
+		&models.KubeEvent{
+			ProjectID:    1,
+			ClusterID:    2,
+			ResourceType: "pod",
+			Name:         "web-7d9f",
+			Namespace:    "production",
+		},
+		&models.KubeSubEvent{
+			EventType: types.KubeEventTypeCritical,
+			Severity:  types.KubeEventSeverity_Critical,
+			Reason:    "OOMKilled",
+			Message:   "container web was killed",
+			Count:     3,
+			Timestamp: time.Date(2024, 1, 2, 3, 4, 5, 0, time.UTC),
+		},
+	)
+}
+
+func TestDatadogSink(t *testing.T) {
+	is := is.New(t)
+
+	var logs []datadogLog
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		is.Equal(r.Header.Get("DD-API-KEY"), "key")     // api key should be set
+		is.NoErr(json.NewDecoder(r.Body).Decode(&logs)) // body should be valid json
+		w.WriteHeader(http.StatusAccepted)
+	}))
+	defer server.Close()
+
+	sink := NewDatadogSink(&types.DatadogEventSinkConfig{APIKey: "key"})
+	is.Equal(sink.endpoint, "https://http-intake.logs.datadoghq.com/api/v2/logs") // site should default to datadoghq.com
+
+	sink.endpoint = server.URL
+
+	is.NoErr(sink.Send(context.Background(), testRecord()))
+	is.Equal(len(logs), 1)
+	is.Equal(logs[0].Status, "error")
+	is.Equal(logs[0].Tags, "project_id:1,cluster_id:2,kube_namespace:production,resource_type:pod,severity:critical")
+	is.Equal(logs[0].KubeEvent.Count, uint(3))
+}
+
+func TestDatadogSinkErrorStatus(t *testing.T) {
+	is := is.New(t)
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusForbidden)
+	}))
+	defer server.Close()
+
+	sink := NewDatadogSink(&types.DatadogEventSinkConfig{APIKey: "bad"})
+	sink.endpoint = server.URL
+
+	is.True(sink.Send(context.Background(), testRecord()) != nil) // non-2xx responses should fail
+}
+
+func TestLokiSink(t *testing.T) {
+	is := is.New(t)
+
+	var push lokiPushRequest
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		is.Equal(r.URL.Path, "/loki/api/v1/push")
+		is.Equal(r.Header.Get("X-Scope-OrgID"), "tenant")
+
+		username, password, ok := r.BasicAuth()
+		is.True(ok) // basic auth should be set
+		is.Equal(username, "user")
+		is.Equal(password, "pass")
+
+		is.NoErr(json.NewDecoder(r.Body).Decode(&push)) // body should be valid json
+		w.WriteHeader(http.StatusNoContent)
+	}))
+	defer server.Close()
+
+	sink := NewLokiSink(&types.LokiEventSinkConfig{
+		URL:      server.URL + "/",
+		TenantID: "tenant",
+		Username: "user",
+		Password: "pass",
+	})
+
+	is.NoErr(sink.Send(context.Background(), testRecord()))
+	is.Equal(len(push.Streams), 1)
+	is.Equal(push.Streams[0].Stream["namespace"], "production")
+	is.Equal(push.Streams[0].Stream["severity"], "critical")
+	is.Equal(push.Streams[0].Values[0][0], "1704164645000000000")
+
+	var record Record
+	is.NoErr(json.Unmarshal([]byte(push.Streams[0].Values[0][1]), &record)) // log line should be the json record
+	is.Equal(record.Reason, "OOMKilled")
+}
+
+type fakeCloudWatchLogs struct {
+	cloudwatchlogsiface.CloudWatchLogsAPI
+
+	streams map[string]bool
+	events  []*cloudwatchlogs.InputLogEvent
+}
+
+func (f *fakeCloudWatchLogs) PutLogEventsWithContext(_ aws.Context, input *cloudwatchlogs.PutLogEventsInput, _ ...request.Option) (*cloudwatchlogs.PutLogEventsOutput, error) {
+	if !f.streams[*input.LogStreamName] {
+		return nil, &cloudwatchlogs.ResourceNotFoundException{}
+	}
+
+	f.events = append(f.events, input.LogEvents...)
+
+	return &cloudwatchlogs.PutLogEventsOutput{}, nil
+}
+
+func (f *fakeCloudWatchLogs) CreateLogStreamWithContext(_ aws.Context, input *cloudwatchlogs.CreateLogStreamInput, _ ...request.Option) (*cloudwatchlogs.CreateLogStreamOutput, error) {
+	f.streams[*input.LogStreamName] = true
+
+	return &cloudwatchlogs.CreateLogStreamOutput{}, nil
+}
+
+func TestCloudWatchSink(t *testing.T) {
+	is := is.New(t)
+
+	client := &fakeCloudWatchLogs{streams: make(map[string]bool)}
+
+	sink := NewCloudWatchSinkWithClient(client, &types.CloudWatchEventSinkConfig{LogGroup: "porter"})
+
+	is.NoErr(sink.Send(context.Background(), testRecord()))
+	is.True(client.streams["porter-kube-events"]) // log stream should be created on the first record
+	is.NoErr(sink.Send(context.Background(), testRecord()))
+	is.Equal(len(client.events), 2)
+	is.Equal(*client.events[0].Timestamp, int64(1704164645000))
+}
+
+type fakeSink struct {
+	err     error
+	records []*Record
+}
+
+func (f *fakeSink) Send(_ context.Context, record *Record) error {
+	f.records = append(f.records, record)
+	return f.err
+}
+
+func TestForward(t *testing.T) {
+	is := is.New(t)
+	ctx := context.Background()
+
+	repo := test.NewRepository(true)
+
+	for _, sink := range []*models.EventSink{
+		{ProjectID: 1, Name: "datadog", Type: types.EventSinkType_Datadog},
+		{ProjectID: 1, Name: "loki", Type: types.EventSinkType_Loki},
+		{ProjectID: 2, Name: "other project", Type: types.EventSinkType_Datadog},
+	} {
+		_, err := repo.EventSink().CreateEventSink(ctx, sink)
+		is.NoErr(err)
+	}
+
+	sinks := map[string]*fakeSink{
+		"datadog": {},
+		"loki":    {err: errors.New("loki is down")},
+	}
+
+	forwarder := NewForwarder(repo)
+	forwarder.newSink = func(_ context.Context, _ repository.Repository, sink *models.EventSink) (Sink, error) {
+		return sinks[sink.Name], nil
+	}
+
+	event := &models.KubeEvent{ProjectID: 1, ClusterID: 2, ResourceType: "pod", Name: "web"}
+	subEvent := &models.KubeSubEvent{Reason: "BackOff", Severity: types.KubeEventSeverity_Warning}
+
+	is.True(forwarder.Forward(ctx, event, subEvent) != nil) // failing sinks should be reported
+	is.Equal(len(sinks["datadog"].records), 1)              // a failing sink should not block the others
+	is.Equal(len(sinks["loki"].records), 1)
+	is.Equal(sinks["datadog"].records[0].Reason, "BackOff")
+}
@@ -2,6 +2,7 @@ package helm
 
 import (
 	"bytes"
+	"context"
 	"fmt"
 	"io"
 	"net/url"
@@ -145,7 +146,7 @@ func (d *DockerSecretsPostRenderer) Run(
 ) (modifiedManifests *bytes.Buffer, err error) {
 	bufCopy := bytes.NewBuffer(renderedManifests.Bytes())
 
-	linkedRegs, err := d.getRegistriesToLink(bufCopy)
+	linkedRegs, err := d.getRegistriesToLink(context.TODO(), bufCopy)
 	// if we encountered an error here, we'll render the manifests anyway
 	// without modification
 	if err != nil {
@@ -249,7 +250,7 @@ func (d *DockerSecretsPostRenderer) Run(
 	return modifiedManifests, nil
 }
 
-func (d *DockerSecretsPostRenderer) getRegistriesToLink(renderedManifests *bytes.Buffer) (map[string]*models.Registry, error) {
+func (d *DockerSecretsPostRenderer) getRegistriesToLink(ctx context.Context, renderedManifests *bytes.Buffer) (map[string]*models.Registry, error) {
 	// create a map of registry names to registries: these are the registries
 	// that a secret will be generated for, if it does not exist
 	linkedRegs := make(map[string]*models.Registry)
@@ -276,7 +277,7 @@ func (d *DockerSecretsPostRenderer) getRegistriesToLink(renderedManifests *bytes
 			}
 
 			// check if the integration is native to the cluster/registry combination
-			isNative := d.isRegistryNative(regName)
+			isNative := d.isRegistryNative(ctx, regName)
 
 			if isNative {
 				continue
@@ -455,7 +456,7 @@ func (d *DockerSecretsPostRenderer) getImageList(podSpec resource) []string {
 
 var ecrPattern = regexp.MustCompile(`(^[a-zA-Z0-9][a-zA-Z0-9-_]*)\.dkr\.ecr(\-fips)?\.([a-zA-Z0-9][a-zA-Z0-9-_]*)\.amazonaws\.com(\.cn)?`)
 
-func (d *DockerSecretsPostRenderer) isRegistryNative(regName string) bool {
+func (d *DockerSecretsPostRenderer) isRegistryNative(ctx context.Context, regName string) bool {
 	isNative := false
 
 	if strings.Contains(regName, "gcr") && d.Cluster.AuthMechanism == models.GCP {
@@ -490,7 +491,7 @@ func (d *DockerSecretsPostRenderer) isRegistryNative(regName string) bool {
 		eksAccountID := matches[1]
 		eksRegion := matches[3]
 
-		awsInt, err := d.Repo.AWSIntegration().ReadAWSIntegration(d.Cluster.ProjectID, d.Cluster.AWSIntegrationID)
+		awsInt, err := d.Repo.AWSIntegration().ReadAWSIntegration(ctx, d.Cluster.ProjectID, d.Cluster.AWSIntegrationID)
 		if err != nil {
 			return false
 		}
@@ -16,9 +16,9 @@ import (
 type HelmRepo models.HelmRepo
 
 // ListCharts lists Porter charts for a given helm repo
-func (hr *HelmRepo) ListCharts(repo repository.Repository) (types.ListTemplatesResponse, error) {
+func (hr *HelmRepo) ListCharts(ctx context.Context, repo repository.Repository) (types.ListTemplatesResponse, error) {
 	if hr.BasicAuthIntegrationID != 0 {
-		return hr.listChartsBasic(repo)
+		return hr.listChartsBasic(ctx, repo)
 	}
 
 	return nil, fmt.Errorf("error listing charts")
@@ -26,21 +26,24 @@ func (hr *HelmRepo) ListCharts(repo repository.Repository) (types.ListTemplatesR
 
 // GetChart retrieves a Porter chart for a given helm repo
 func (hr *HelmRepo) GetChart(
+	ctx context.Context,
 	repo repository.Repository,
 	chartName, chartVersion string,
 ) (*chart.Chart, error) {
 	if hr.BasicAuthIntegrationID != 0 {
-		return hr.getChartBasic(repo, chartName, chartVersion)
+		return hr.getChartBasic(ctx, repo, chartName, chartVersion)
 	}
 
 	return nil, fmt.Errorf("error listing charts")
 }
 
 func (hr *HelmRepo) listChartsBasic(
+	ctx context.Context,
 	repo repository.Repository,
 ) (types.ListTemplatesResponse, error) {
 	// get the basic auth integration
 	basic, err := repo.BasicIntegration().ReadBasicIntegration(
+		ctx,
 		hr.ProjectID,
 		hr.BasicAuthIntegrationID,
 	)
@@ -62,11 +65,13 @@ func (hr *HelmRepo) listChartsBasic(
 }
 
 func (hr *HelmRepo) getChartBasic(
+	ctx context.Context,
 	repo repository.Repository,
 	chartName, chartVersion string,
 ) (*chart.Chart, error) {
 	// get the basic auth integration
 	basic, err := repo.BasicIntegration().ReadBasicIntegration(
+		ctx,
 		hr.ProjectID,
 		hr.BasicAuthIntegrationID,
 	)
@@ -61,7 +61,7 @@ type GithubActions struct {
 var deleteWebhookAndEnvSecretsConstraint, _ = semver.NewConstraint(" < 0.1.0")
 
 func (g *GithubActions) Setup() ([]byte, error) {
-	client, err := g.getClient()
+	client, err := g.getClient(context.TODO())
 	if err != nil {
 		return nil, err
 	}
@@ -161,7 +161,7 @@ func (g *GithubActions) Setup() ([]byte, error) {
 }
 
 func (g *GithubActions) Cleanup() error {
-	client, err := g.getClient()
+	client, err := g.getClient(context.TODO())
 	if err != nil {
 		return err
 	}
@@ -271,17 +271,17 @@ func (g *GithubActions) GetGithubActionYAML() ([]byte, error) {
 	return yaml.Marshal(actionYAML)
 }
 
-func (g *GithubActions) getClient() (*github.Client, error) {
+func (g *GithubActions) getClient(ctx context.Context) (*github.Client, error) {
 	// in the case that this still uses the oauth integration
 	if g.GithubOAuthIntegration != nil {
 
 		// get the oauth integration
-		oauthInt, err := g.Repo.OAuthIntegration().ReadOAuthIntegration(g.ProjectID, g.GithubOAuthIntegration.OAuthIntegrationID)
+		oauthInt, err := g.Repo.OAuthIntegration().ReadOAuthIntegration(ctx, g.ProjectID, g.GithubOAuthIntegration.OAuthIntegrationID)
 		if err != nil {
 			return nil, err
 		}
 
-		_, _, err = oauth.GetAccessToken(oauthInt.SharedOAuthModel, g.GithubConf, oauth.MakeUpdateOAuthIntegrationTokenFunction(oauthInt, g.Repo))
+		_, _, err = oauth.GetAccessToken(oauthInt.SharedOAuthModel, g.GithubConf, oauth.MakeUpdateOAuthIntegrationTokenFunction(ctx, oauthInt, g.Repo))
 
 		if err != nil {
 			return nil, err
@@ -368,7 +368,7 @@ func (g *GithubActions) deleteGithubSecret(
 }
 
 func (g *GithubActions) CreateEnvSecret() error {
-	client, err := g.getClient()
+	client, err := g.getClient(context.TODO())
 	if err != nil {
 		return err
 	}
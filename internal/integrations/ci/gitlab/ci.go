@@ -1,6 +1,7 @@
 package gitlab
 
 import (
+	"context"
 	"fmt"
 	"net/http"
 	"net/url"
@@ -38,7 +39,7 @@ type GitlabCI struct {
 }
 
 func (g *GitlabCI) Setup() error {
-	client, err := g.getClient()
+	client, err := g.getClient(context.TODO())
 	if err != nil {
 		return err
 	}
@@ -182,7 +183,7 @@ func (g *GitlabCI) Setup() error {
 }
 
 func (g *GitlabCI) Cleanup() error {
-	client, err := g.getClient()
+	client, err := g.getClient(context.TODO())
 	if err != nil {
 		return err
 	}
@@ -294,18 +295,18 @@ func (g *GitlabCI) Cleanup() error {
 	return nil
 }
 
-func (g *GitlabCI) getClient() (*gitlab.Client, error) {
-	gi, err := g.Repo.GitlabIntegration().ReadGitlabIntegration(g.ProjectID, g.IntegrationID)
+func (g *GitlabCI) getClient(ctx context.Context) (*gitlab.Client, error) {
+	gi, err := g.Repo.GitlabIntegration().ReadGitlabIntegration(ctx, g.ProjectID, g.IntegrationID)
 	if err != nil {
 		return nil, err
 	}
 
-	giOAuthInt, err := g.Repo.GitlabAppOAuthIntegration().ReadGitlabAppOAuthIntegration(g.UserID, g.ProjectID, g.IntegrationID)
+	giOAuthInt, err := g.Repo.GitlabAppOAuthIntegration().ReadGitlabAppOAuthIntegration(ctx, g.UserID, g.ProjectID, g.IntegrationID)
 	if err != nil {
 		return nil, err
 	}
 
-	oauthInt, err := g.Repo.OAuthIntegration().ReadOAuthIntegration(g.ProjectID, giOAuthInt.OAuthIntegrationID)
+	oauthInt, err := g.Repo.OAuthIntegration().ReadOAuthIntegration(ctx, g.ProjectID, giOAuthInt.OAuthIntegrationID)
 	if err != nil {
 		return nil, err
 	}
@@ -313,7 +314,7 @@ func (g *GitlabCI) getClient() (*gitlab.Client, error) {
 	accessToken, _, err := oauth.GetAccessToken(
 		oauthInt.SharedOAuthModel,
 		commonutils.GetGitlabOAuthConf(g.PorterConf, gi),
-		oauth.MakeUpdateGitlabAppOAuthIntegrationFunction(g.ProjectID, giOAuthInt, g.Repo),
+		oauth.MakeUpdateGitlabAppOAuthIntegrationFunction(ctx, g.ProjectID, giOAuthInt, g.Repo),
 	)
 	if err != nil {
 		return nil, err
@@ -0,0 +1,115 @@
+package gitlab
+
+import (
+	"errors"
+	"fmt"
+
+	"gopkg.in/yaml.v2"
+)
+
+// PipelineTokenVariable is the name of the masked GitLab CI/CD variable that holds the Porter token used by generated pipelines.
+// The CLI reads it from the job environment directly.
+const PipelineTokenVariable = "PORTER_TOKEN"
+
+// ApplyPipelineOpts are the options for generating a .gitlab-ci.yml that runs `porter apply`
+type ApplyPipelineOpts struct {
+	ServerURL      string
+	ProjectID      uint
+	ClusterID      uint
+	PorterYamlPath string
+	DefaultBranch  string
+
+	// Previews adds a job that deploys a preview environment for every merge request
+	Previews bool
+}
+
+// GetApplyPipelineYAML returns the contents of a .gitlab-ci.yml that deploys the app in porter.yaml on every push to the
+// default branch. The Porter token is read from the masked PORTER_TOKEN variable of the GitLab project.
+func GetApplyPipelineYAML(opts *ApplyPipelineOpts) ([]byte, error) {
+	if opts == nil {
+		return nil, errors.New("pipeline options cannot be nil")
+	}
+
+	if opts.ServerURL == "" || opts.ProjectID == 0 || opts.ClusterID == 0 {
+		return nil, errors.New("server url, project id and cluster id are required")
+	}
+
+	porterYamlPath := opts.PorterYamlPath
+	if porterYamlPath == "" {
+		porterYamlPath = "porter.yaml"
+	}
+
+	defaultBranch := opts.DefaultBranch
+	if defaultBranch == "" {
+		defaultBranch = "main"
+	}
+
+	stages := []string{"deploy"}
+	if opts.Previews {
+		stages = append(stages, "preview")
+	}
+
+	res := yaml.MapSlice{
+		{Key: "stages", Value: stages},
+		{
+			Key: "variables",
+			Value: yaml.MapSlice{
+				{Key: "PORTER_HOST", Value: opts.ServerURL},
+				{Key: "PORTER_PROJECT", Value: fmt.Sprintf("%d", opts.ProjectID)},
+				{Key: "PORTER_CLUSTER", Value: fmt.Sprintf("%d", opts.ClusterID)},
+			},
+		},
+		{
+			Key: "porter-deploy",
+			Value: getApplyJob(
+				"deploy",
+				fmt.Sprintf("$CI_COMMIT_BRANCH == \"%s\" && $CI_PIPELINE_SOURCE == \"push\"", defaultBranch),
+				fmt.Sprintf("porter apply -f \"%s\"", porterYamlPath),
+				nil,
+			),
+		},
+	}
+
+	if opts.Previews {
+		res = append(res, yaml.MapItem{
+			Key: "porter-preview",
+			Value: getApplyJob(
+				"preview",
+				"$CI_PIPELINE_SOURCE == \"merge_request_event\"",
+				fmt.Sprintf("porter apply -f \"%s\" --preview", porterYamlPath),
+				yaml.MapSlice{
+					{Key: "PORTER_PREVIEW_NAME", Value: "mr-$CI_MERGE_REQUEST_IID"},
+				},
+			),
+		})
+	}
+
+	return yaml.Marshal(res)
+}
+
+// getApplyJob returns a job that runs a porter command in the porter-cli image, with docker available for builds
+func getApplyJob(stage, rule, command string, variables yaml.MapSlice) yaml.MapSlice {
+	jobVariables := yaml.MapSlice{
+		{Key: "GIT_STRATEGY", Value: "clone"},
+		{Key: "DOCKER_HOST", Value: "tcp://docker:2375"},
+		{Key: "DOCKER_TLS_CERTDIR", Value: ""},
+		{Key: "PORTER_COMMIT_SHA", Value: "$CI_COMMIT_SHA"},
+	}
+	jobVariables = append(jobVariables, variables...)
+
+	return yaml.MapSlice{
+		{Key: "stage", Value: stage},
+		{
+			Key: "image",
+			Value: yaml.MapSlice{
+				{Key: "name", Value: "public.ecr.aws/o1j4x7p4/porter-cli:latest"},
+				{Key: "entrypoint", Value: []string{""}},
+			},
+		},
+		{Key: "services", Value: []string{"docker:dind"}},
+		{Key: "rules", Value: []map[string]string{{"if": rule}}},
+		{Key: "variables", Value: jobVariables},
+		{Key: "script", Value: []string{command}},
+		{Key: "timeout", Value: "30 minutes"},
+	}
+}
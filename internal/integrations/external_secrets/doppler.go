@@ -0,0 +1,76 @@
+package external_secrets
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"strings"
+)
+
+// DefaultDopplerURL is the url of the Doppler API
+const DefaultDopplerURL = "https://api.doppler.com"
+
+// DopplerProvider reads the secrets of a Doppler config
+type DopplerProvider struct {
+	BaseURL string
+	// Project and Config may be empty if Token is a service token, which is scoped to a single config
+	Project string
+	Config  string
+	Token   string
+
+	httpClient *http.Client
+}
+
+// dopplerMetadataPrefix prefixes the secrets which Doppler adds to every config to describe the config itself
+const dopplerMetadataPrefix = "DOPPLER_"
+
+// Secrets returns the secrets of the Doppler config, excluding the DOPPLER_ metadata secrets
+func (d *DopplerProvider) Secrets(ctx context.Context) (map[string]string, error) {
+	query := url.Values{}
+	query.Set("format", "json")
+	if d.Project != "" {
+		query.Set("project", d.Project)
+	}
+	if d.Config != "" {
+		query.Set("config", d.Config)
+	}
+
+	reqURL := fmt.Sprintf("%s/v3/configs/config/secrets/download?%s", strings.TrimSuffix(d.BaseURL, "/"), query.Encode())
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, reqURL, nil)
+	if err != nil {
+		return nil, fmt.Errorf("error creating doppler request: %w", err)
+	}
+
+	req.Header.Set("Authorization", "Bearer "+d.Token)
+	req.Header.Set("Accept", "application/json")
+
+	resp, err := d.httpClient.Do(req)
+	if err != nil {
+		return nil, fmt.Errorf("error reading secrets from doppler: %w", err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		body, _ := io.ReadAll(io.LimitReader(resp.Body, 1024))
+		return nil, fmt.Errorf("doppler returned status %d: %s", resp.StatusCode, strings.TrimSpace(string(body)))
+	}
+
+	downloaded := map[string]string{}
+	if err := json.NewDecoder(resp.Body).Decode(&downloaded); err != nil {
+		return nil, fmt.Errorf("error decoding doppler secrets: %w", err)
+	}
+
+	secrets := make(map[string]string, len(downloaded))
+	for k, v := range downloaded {
+		if strings.HasPrefix(k, dopplerMetadataPrefix) {
+			continue
+		}
+		secrets[k] = v
+	}
+
+	return secrets, nil
+}
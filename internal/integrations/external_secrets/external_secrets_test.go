@@ -0,0 +1,128 @@
+package external_secrets
+
+import (
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/matryer/is"
+)
+
+func TestVaultProviderSecrets(t *testing.T) {
+	tests := []struct {
+		name     string
+		response string
+		want     map[string]string
+	}{
+		{
+			name:     "kv version 1",
+			response: `{"data": {"API_KEY": "abc", "PORT": 8080}}`,
+			want:     map[string]string{"API_KEY": "abc", "PORT": "8080"},
+		},
+		{
+			name:     "kv version 2",
+			response: `{"data": {"data": {"API_KEY": "abc", "OPTIONS": {"a": true}}, "metadata": {"version": 3}}}`,
+			want:     map[string]string{"API_KEY": "abc", "OPTIONS": `{"a":true}`},
+		},
+		{
+			name:     "kv version 1 with a secret named data",
+			response: `{"data": {"data": {"nested": "value"}}}`,
+			want:     map[string]string{"data": `{"nested":"value"}`},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			is := is.New(t)
+
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				is.Equal(r.URL.Path, "/v1/secret/data/my-app")
+				is.Equal(r.Header.Get("X-Vault-Token"), "token")
+				is.Equal(r.Header.Get("X-Vault-Namespace"), "team")
+				_, _ = w.Write([]byte(tt.response))
+			}))
+			defer server.Close()
+
+			provider := &VaultProvider{
+				Address:    server.URL + "/",
+				Namespace:  "team",
+				SecretPath: "/secret/data/my-app",
+				Token:      "token",
+				httpClient: server.Client(),
+			}
+
+			secrets, err := provider.Secrets(context.Background())
+			is.NoErr(err)
+			is.Equal(secrets, tt.want)
+		})
+	}
+}
+
+func TestVaultProviderSecretsError(t *testing.T) {
+	is := is.New(t)
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusForbidden)
+		_, _ = w.Write([]byte(`{"errors": ["permission denied"]}`))
+	}))
+	defer server.Close()
+
+	provider := &VaultProvider{Address: server.URL, SecretPath: "secret/my-app", httpClient: server.Client()}
+
+	_, err := provider.Secrets(context.Background())
+	is.True(err != nil)
+}
+
+func TestDopplerProviderSecrets(t *testing.T) {
+	is := is.New(t)
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		is.Equal(r.URL.Path, "/v3/configs/config/secrets/download")
+		is.Equal(r.URL.Query().Get("project"), "backend")
+		is.Equal(r.URL.Query().Get("config"), "prd")
+		is.Equal(r.Header.Get("Authorization"), "Bearer dp.st.token")
+		_, _ = w.Write([]byte(`{"API_KEY": "abc", "DOPPLER_PROJECT": "backend", "DOPPLER_CONFIG": "prd"}`))
+	}))
+	defer server.Close()
+
+	provider := &DopplerProvider{
+		BaseURL:    server.URL,
+		Project:    "backend",
+		Config:     "prd",
+		Token:      "dp.st.token",
+		httpClient: server.Client(),
+	}
+
+	secrets, err := provider.Secrets(context.Background())
+	is.NoErr(err)
+	is.Equal(secrets, map[string]string{"API_KEY": "abc"})
+}
+
+func TestValidateSecretKeys(t *testing.T) {
+	is := is.New(t)
+
+	is.NoErr(validateSecretKeys(map[string]string{"API_KEY": "", "db.url": ""}))
+	is.True(validateSecretKeys(map[string]string{"1KEY": "", "has space": ""}) != nil)
+}
+
+func TestVerifyWebhookSignature(t *testing.T) {
+	is := is.New(t)
+
+	secret := []byte("webhook-secret")
+	body := []byte(`{"type": "config.secrets.update"}`)
+
+	mac := hmac.New(sha256.New, secret)
+	mac.Write(body)
+	signature := hex.EncodeToString(mac.Sum(nil))
+
+	is.True(VerifyWebhookSignature(secret, body, signature))
+	is.True(VerifyWebhookSignature(secret, body, "sha256="+signature))
+	is.True(!VerifyWebhookSignature(secret, []byte(`{}`), signature))
+	is.True(!VerifyWebhookSignature([]byte("other"), body, signature))
+	is.True(!VerifyWebhookSignature(nil, body, signature))
+	is.True(!VerifyWebhookSignature(secret, body, "not-hex"))
+}
@@ -0,0 +1,45 @@
+package external_secrets
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"time"
+
+	"github.com/porter-dev/porter/api/types"
+	ints "github.com/porter-dev/porter/internal/models/integrations"
+)
+
+// Provider reads secrets from an external system of record
+type Provider interface {
+	// Secrets returns the secrets in the provider, keyed by env var name
+	Secrets(ctx context.Context) (map[string]string, error)
+}
+
+// NewProvider returns the provider client for an external secrets integration
+func NewProvider(es *ints.ExternalSecretsIntegration) (Provider, error) {
+	httpClient := &http.Client{
+		Timeout: time.Minute,
+	}
+
+	switch types.ExternalSecretsProvider(es.Provider) {
+	case types.ExternalSecretsProvider_Vault:
+		return &VaultProvider{
+			Address:    es.VaultAddress,
+			Namespace:  es.VaultNamespace,
+			SecretPath: es.VaultSecretPath,
+			Token:      string(es.Token),
+			httpClient: httpClient,
+		}, nil
+	case types.ExternalSecretsProvider_Doppler:
+		return &DopplerProvider{
+			BaseURL:    DefaultDopplerURL,
+			Project:    es.DopplerProject,
+			Config:     es.DopplerConfig,
+			Token:      string(es.Token),
+			httpClient: httpClient,
+		}, nil
+	default:
+		return nil, fmt.Errorf("unsupported external secrets provider %q", es.Provider)
+	}
+}
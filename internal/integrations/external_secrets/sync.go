@@ -0,0 +1,197 @@
+package external_secrets
+
+import (
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/porter-dev/porter/internal/kubernetes"
+	"github.com/porter-dev/porter/internal/kubernetes/environment_groups"
+	"github.com/porter-dev/porter/internal/models"
+	ints "github.com/porter-dev/porter/internal/models/integrations"
+	"github.com/porter-dev/porter/internal/repository"
+	"github.com/porter-dev/porter/internal/telemetry"
+	"k8s.io/apimachinery/pkg/util/validation"
+)
+
+// SyncResult is the outcome of syncing an external secrets integration
+type SyncResult struct {
+	// Changed is false if the secrets already matched the latest env group version
+	Changed bool
+	// Version is the latest version of the env group after the sync
+	Version int
+	// RedeployedApps are the namespaces of the linked applications which were redeployed
+	RedeployedApps []string
+}
+
+// Sync replaces the secret variables of the integration's env group with the secrets in the external provider, creating a new env group
+// version only if the secrets changed. Variables which are not secret are kept. The outcome of the sync, including any error, is recorded
+// on the integration.
+func Sync(ctx context.Context, agent *kubernetes.Agent, repo repository.Repository, es *ints.ExternalSecretsIntegration) (SyncResult, error) {
+	ctx, span := telemetry.NewSpan(ctx, "sync-external-secrets")
+	defer span.End()
+
+	telemetry.WithAttributes(span,
+		telemetry.AttributeKV{Key: "external-secrets-integration-id", Value: es.ID},
+		telemetry.AttributeKV{Key: "provider", Value: es.Provider},
+		telemetry.AttributeKV{Key: "environment-group-name", Value: es.EnvironmentGroupName},
+	)
+
+	result, syncErr := syncSecrets(ctx, agent, repo, es)
+
+	now := time.Now().UTC()
+	es.LastSyncedAt = &now
+	es.LastSyncError = ""
+	if syncErr != nil {
+		es.LastSyncError = syncErr.Error()
+	} else {
+		es.LastSyncedVersion = result.Version
+	}
+
+	if _, err := repo.ExternalSecretsIntegration().UpdateExternalSecretsIntegration(ctx, es); err != nil {
+		return result, telemetry.Error(ctx, span, err, "error recording external secrets sync")
+	}
+
+	if syncErr != nil {
+		return result, telemetry.Error(ctx, span, syncErr, "error syncing external secrets")
+	}
+
+	telemetry.WithAttributes(span,
+		telemetry.AttributeKV{Key: "changed", Value: result.Changed},
+		telemetry.AttributeKV{Key: "version", Value: result.Version},
+	)
+
+	return result, nil
+}
+
+func syncSecrets(ctx context.Context, agent *kubernetes.Agent, repo repository.Repository, es *ints.ExternalSecretsIntegration) (SyncResult, error) {
+	var result SyncResult
+
+	provider, err := NewProvider(es)
+	if err != nil {
+		return result, err
+	}
+
+	secrets, err := provider.Secrets(ctx)
+	if err != nil {
+		return result, err
+	}
+
+	if err := validateSecretKeys(secrets); err != nil {
+		return result, err
+	}
+
+	latest, err := environment_groups.LatestBaseEnvironmentGroup(ctx, agent, es.EnvironmentGroupName)
+	if err != nil {
+		return result, fmt.Errorf("unable to get latest environment group: %w", err)
+	}
+
+	if latest.Version != 0 && secretsEqual(latest.SecretVariables, secrets) {
+		result.Version = latest.Version
+		return result, nil
+	}
+
+	secretVariables := make(map[string][]byte, len(secrets))
+	for k, v := range secrets {
+		secretVariables[k] = []byte(v)
+	}
+
+	version, err := environment_groups.CreateOrUpdateBaseEnvironmentGroup(ctx, agent, environment_groups.EnvironmentGroup{
+		Name:            es.EnvironmentGroupName,
+		Variables:       latest.Variables,
+		SecretVariables: secretVariables,
+		CreatedAtUTC:    time.Now().UTC(),
+	})
+	if err != nil {
+		return result, fmt.Errorf("unable to update environment group: %w", err)
+	}
+
+	result.Changed = true
+	result.Version = version
+
+	_, err = repo.EnvironmentGroupVersion().CreateEnvironmentGroupVersion(ctx, &models.EnvironmentGroupVersion{
+		ProjectID: es.ProjectID,
+		ClusterID: es.ClusterID,
+		Name:      es.EnvironmentGroupName,
+		Version:   version,
+		CreatedBy: fmt.Sprintf("external-secrets:%s", es.Provider),
+	})
+	if err != nil {
+		return result, fmt.Errorf("unable to record environment group version: %w", err)
+	}
+
+	if !es.RedeployLinkedApps {
+		return result, nil
+	}
+
+	synced, err := environment_groups.SyncLatestVersionToLinkedApplications(ctx, agent, es.EnvironmentGroupName)
+	if err != nil {
+		return result, fmt.Errorf("unable to sync environment group to linked applications: %w", err)
+	}
+
+	seen := make(map[string]bool)
+	for _, app := range synced {
+		if !seen[app.Namespace] {
+			seen[app.Namespace] = true
+			result.RedeployedApps = append(result.RedeployedApps, app.Namespace)
+		}
+	}
+	sort.Strings(result.RedeployedApps)
+
+	return result, nil
+}
+
+// validateSecretKeys checks that every secret can be loaded as an environment variable
+func validateSecretKeys(secrets map[string]string) error {
+	var invalid []string
+	for k := range secrets {
+		if len(validation.IsEnvVarName(k)) > 0 {
+			invalid = append(invalid, k)
+		}
+	}
+
+	if len(invalid) > 0 {
+		sort.Strings(invalid)
+		return fmt.Errorf("secrets have names which are not valid environment variable names: %s", strings.Join(invalid, ", "))
+	}
+
+	return nil
+}
+
+func secretsEqual(existing map[string][]byte, secrets map[string]string) bool {
+	if len(existing) != len(secrets) {
+		return false
+	}
+
+	for k, v := range secrets {
+		existingValue, ok := existing[k]
+		if !ok || string(existingValue) != v {
+			return false
+		}
+	}
+
+	return true
+}
+
+// VerifyWebhookSignature checks that signature is the hex encoded HMAC-SHA256 of body using secret. The signature may be prefixed
+// with "sha256=", which is the format Doppler uses for webhook signatures.
+func VerifyWebhookSignature(secret []byte, body []byte, signature string) bool {
+	if len(secret) == 0 || signature == "" {
+		return false
+	}
+
+	signatureBytes, err := hex.DecodeString(strings.TrimPrefix(signature, "sha256="))
+	if err != nil {
+		return false
+	}
+
+	mac := hmac.New(sha256.New, secret)
+	mac.Write(body)
+
+	return hmac.Equal(mac.Sum(nil), signatureBytes)
+}
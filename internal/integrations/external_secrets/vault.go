@@ -0,0 +1,89 @@
+package external_secrets
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"strings"
+)
+
+// VaultProvider reads a single KV secret from HashiCorp Vault. Both version 1 and version 2 of the KV secrets engine are supported;
+// for version 2, SecretPath must include the data segment, e.g. secret/data/my-app.
+type VaultProvider struct {
+	Address    string
+	Namespace  string
+	SecretPath string
+	Token      string
+
+	httpClient *http.Client
+}
+
+type vaultSecretResponse struct {
+	Data map[string]any `json:"data"`
+}
+
+// Secrets returns the keys of the KV secret
+func (v *VaultProvider) Secrets(ctx context.Context) (map[string]string, error) {
+	url := fmt.Sprintf("%s/v1/%s", strings.TrimSuffix(v.Address, "/"), strings.TrimPrefix(v.SecretPath, "/"))
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, url, nil)
+	if err != nil {
+		return nil, fmt.Errorf("error creating vault request: %w", err)
+	}
+
+	req.Header.Set("X-Vault-Token", v.Token)
+	if v.Namespace != "" {
+		req.Header.Set("X-Vault-Namespace", v.Namespace)
+	}
+
+	resp, err := v.httpClient.Do(req)
+	if err != nil {
+		return nil, fmt.Errorf("error reading secret from vault: %w", err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		body, _ := io.ReadAll(io.LimitReader(resp.Body, 1024))
+		return nil, fmt.Errorf("vault returned status %d: %s", resp.StatusCode, strings.TrimSpace(string(body)))
+	}
+
+	secret := &vaultSecretResponse{}
+	if err := json.NewDecoder(resp.Body).Decode(secret); err != nil {
+		return nil, fmt.Errorf("error decoding vault secret: %w", err)
+	}
+
+	data := secret.Data
+
+	// KV version 2 nests the secret under data.data, next to the version metadata
+	if nested, ok := data["data"].(map[string]any); ok {
+		if _, ok := data["metadata"]; ok {
+			data = nested
+		}
+	}
+
+	return stringValues(data)
+}
+
+// stringValues converts the values of a secret to strings, encoding values which are not strings as JSON
+func stringValues(data map[string]any) (map[string]string, error) {
+	secrets := make(map[string]string, len(data))
+
+	for k, v := range data {
+		switch val := v.(type) {
+		case string:
+			secrets[k] = val
+		case nil:
+			secrets[k] = ""
+		default:
+			encoded, err := json.Marshal(val)
+			if err != nil {
+				return nil, fmt.Errorf("error encoding value of secret %s: %w", k, err)
+			}
+			secrets[k] = string(encoded)
+		}
+	}
+
+	return secrets, nil
+}
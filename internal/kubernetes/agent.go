@@ -1915,7 +1915,7 @@ func (a *Agent) CreateImagePullSecrets(
 	for key, val := range linkedRegs {
 		_reg := registry.Registry(*val)
 
-		data, err := _reg.GetDockerConfigJSON(repo, doAuth)
+		data, err := _reg.GetDockerConfigJSON(context.TODO(), repo, doAuth)
 		if err != nil {
 			return nil, err
 		}
@@ -369,6 +369,7 @@ func (conf *OutOfClusterConfig) GetClientConfigFromCluster(ctx context.Context)
 		telemetry.WithAttributes(span, telemetry.AttributeKV{Key: "local-provisioned", Value: true})
 
 		kubeAuth, err := conf.Repo.KubeIntegration().ReadKubeIntegration(
+			ctx,
 			conf.Cluster.ProjectID,
 			conf.Cluster.KubeIntegrationID,
 		)
@@ -438,6 +439,7 @@ func (conf *OutOfClusterConfig) CreateRawConfigFromCluster(ctx context.Context)
 	switch cluster.AuthMechanism {
 	case models.X509:
 		kubeAuth, err := conf.Repo.KubeIntegration().ReadKubeIntegration(
+			ctx,
 			cluster.ProjectID,
 			cluster.KubeIntegrationID,
 		)
@@ -453,6 +455,7 @@ func (conf *OutOfClusterConfig) CreateRawConfigFromCluster(ctx context.Context)
 		authInfoMap[authInfoName].ClientKeyData = kubeAuth.ClientKeyData
 	case models.Basic:
 		kubeAuth, err := conf.Repo.KubeIntegration().ReadKubeIntegration(
+			ctx,
 			cluster.ProjectID,
 			cluster.KubeIntegrationID,
 		)
@@ -467,6 +470,7 @@ func (conf *OutOfClusterConfig) CreateRawConfigFromCluster(ctx context.Context)
 		authInfoMap[authInfoName].Password = string(kubeAuth.Password)
 	case models.Bearer:
 		kubeAuth, err := conf.Repo.KubeIntegration().ReadKubeIntegration(
+			ctx,
 			cluster.ProjectID,
 			cluster.KubeIntegrationID,
 		)
@@ -477,6 +481,7 @@ func (conf *OutOfClusterConfig) CreateRawConfigFromCluster(ctx context.Context)
 		authInfoMap[authInfoName].Token = string(kubeAuth.Token)
 	case models.OIDC:
 		oidcAuth, err := conf.Repo.OIDCIntegration().ReadOIDCIntegration(
+			ctx,
 			cluster.ProjectID,
 			cluster.OIDCIntegrationID,
 		)
@@ -500,6 +505,7 @@ func (conf *OutOfClusterConfig) CreateRawConfigFromCluster(ctx context.Context)
 		}
 	case models.GCP:
 		gcpAuth, err := conf.Repo.GCPIntegration().ReadGCPIntegration(
+			ctx,
 			cluster.ProjectID,
 			cluster.GCPIntegrationID,
 		)
@@ -528,6 +534,7 @@ func (conf *OutOfClusterConfig) CreateRawConfigFromCluster(ctx context.Context)
 		authInfoMap[authInfoName].Token = tok.AccessToken
 	case models.AWS:
 		awsAuth, err := conf.Repo.AWSIntegration().ReadAWSIntegration(
+			ctx,
 			cluster.ProjectID,
 			cluster.AWSIntegrationID,
 		)
@@ -556,6 +563,7 @@ func (conf *OutOfClusterConfig) CreateRawConfigFromCluster(ctx context.Context)
 		authInfoMap[authInfoName].Token = tok
 	case models.DO:
 		oauthInt, err := conf.Repo.OAuthIntegration().ReadOAuthIntegration(
+			ctx,
 			cluster.ProjectID,
 			cluster.DOIntegrationID,
 		)
@@ -566,7 +574,7 @@ func (conf *OutOfClusterConfig) CreateRawConfigFromCluster(ctx context.Context)
 			telemetry.AttributeKV{Key: "integration-id", Value: cluster.DOIntegrationID},
 		)
 
-		tok, _, err := oauth.GetAccessToken(oauthInt.SharedOAuthModel, conf.DigitalOceanOAuth, oauth.MakeUpdateOAuthIntegrationTokenFunction(oauthInt, conf.Repo))
+		tok, _, err := oauth.GetAccessToken(oauthInt.SharedOAuthModel, conf.DigitalOceanOAuth, oauth.MakeUpdateOAuthIntegrationTokenFunction(ctx, oauthInt, conf.Repo))
 		if err != nil {
 			return nil, telemetry.Error(ctx, span, err, "unable to get oauth access token for Digital Ocean")
 		}
@@ -575,6 +583,7 @@ func (conf *OutOfClusterConfig) CreateRawConfigFromCluster(ctx context.Context)
 		authInfoMap[authInfoName].Token = tok
 	case models.Azure:
 		azInt, err := conf.Repo.AzureIntegration().ReadAzureIntegration(
+			ctx,
 			cluster.ProjectID,
 			cluster.AzureIntegrationID,
 		)
@@ -613,6 +622,7 @@ func (conf *OutOfClusterConfig) getTokenCache(ctx context.Context) (tok *ints.To
 
 func (conf *OutOfClusterConfig) setTokenCache(ctx context.Context, token string, expiry time.Time) error {
 	_, err := conf.Repo.Cluster().UpdateClusterTokenCache(
+		ctx,
 		&ints.ClusterTokenCache{
 			ClusterID: conf.Cluster.ID,
 			TokenCache: ints.TokenCache{
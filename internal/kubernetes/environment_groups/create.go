@@ -15,32 +15,32 @@ import (
 // CreateOrUpdateBaseEnvironmentGroup creates a new environment group in the porter-env-group namespace. If porter-env-group does not exist, it will be created.
 // If no existing environmentGroup exists by this name, a new one will be created as version 1, denoted by the label "porter.run/environment-group-version: 1".
 // If an environmentGroup already exists by this name, a new version will be created, and the label will be updated to reflect the new version.
-// Providing the Version field to this function will be ignored in order to not accidentally overwrite versions
-func CreateOrUpdateBaseEnvironmentGroup(ctx context.Context, a *kubernetes.Agent, environmentGroup EnvironmentGroup) error {
+// Providing the Version field to this function will be ignored in order to not accidentally overwrite versions. The version which was created is returned.
+func CreateOrUpdateBaseEnvironmentGroup(ctx context.Context, a *kubernetes.Agent, environmentGroup EnvironmentGroup) (int, error) {
 	ctx, span := telemetry.NewSpan(ctx, "create-environment-group")
 	defer span.End()
 	telemetry.WithAttributes(span, telemetry.AttributeKV{Key: "environment-group", Value: environmentGroup.Name})
 
 	if environmentGroup.Name == "" {
-		return telemetry.Error(ctx, span, nil, "environment group name cannot be empty")
+		return 0, telemetry.Error(ctx, span, nil, "environment group name cannot be empty")
 	}
 
 	_, err := a.Clientset.CoreV1().Namespaces().Get(ctx, Namespace_EnvironmentGroups, metav1.GetOptions{})
 	if err != nil {
 		if !k8serror.IsNotFound(err) {
-			return telemetry.Error(ctx, span, err, "unable to check if global environment group exists")
+			return 0, telemetry.Error(ctx, span, err, "unable to check if global environment group exists")
 		}
 
 		_, err = a.Clientset.CoreV1().Namespaces().Create(ctx, &v1.Namespace{ObjectMeta: metav1.ObjectMeta{Name: Namespace_EnvironmentGroups}}, metav1.CreateOptions{})
 		if err != nil {
-			return telemetry.Error(ctx, span, err, "unable to create global environment group")
+			return 0, telemetry.Error(ctx, span, err, "unable to create global environment group")
 		}
 	}
 	telemetry.WithAttributes(span, telemetry.AttributeKV{Key: "environment-group-namespace", Value: Namespace_EnvironmentGroups})
 
 	latestEnvironmentGroup, err := LatestBaseEnvironmentGroup(ctx, a, environmentGroup.Name)
 	if err != nil {
-		return telemetry.Error(ctx, span, err, "unable to get latest base environment group by name")
+		return 0, telemetry.Error(ctx, span, err, "unable to get latest base environment group by name")
 	}
 
 	newEnvironmentGroup := EnvironmentGroup{
@@ -53,10 +53,10 @@ func CreateOrUpdateBaseEnvironmentGroup(ctx context.Context, a *kubernetes.Agent
 
 	err = createVersionedEnvironmentGroupInNamespace(ctx, a, newEnvironmentGroup, Namespace_EnvironmentGroups)
 	if err != nil {
-		return telemetry.Error(ctx, span, err, "unable to create new versioned environment group")
+		return 0, telemetry.Error(ctx, span, err, "unable to create new versioned environment group")
 	}
 
-	return nil
+	return newEnvironmentGroup.Version, nil
 }
 
 // createEnvironmentGroupInTargetNamespace creates a new environment group in the target namespace. If you want to create a new base environment group, use CreateOrUpdateBaseEnvironmentGroup instead.
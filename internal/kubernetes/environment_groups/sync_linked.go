@@ -0,0 +1,283 @@
+package environment_groups
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/porter-dev/porter/internal/kubernetes"
+	"github.com/porter-dev/porter/internal/telemetry"
+	appsv1 "k8s.io/api/apps/v1"
+	batchv1 "k8s.io/api/batch/v1"
+	v1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// AnnotationKey_SyncedEnvironmentGroups is set on the pod template of a linked application when an environment group is synced to it,
+// so that the application is redeployed even if the versioned environment group name it references is unchanged
+const AnnotationKey_SyncedEnvironmentGroups = "porter.run/synced-environment-groups"
+
+// SyncedApplication is a linked application which was redeployed with the latest version of an environment group
+type SyncedApplication struct {
+	LinkedPorterApplication
+
+	// Kind is either Deployment or CronJob
+	Kind string
+
+	// EnvironmentGroupVersionedName is the configmap and secret name that the application now references
+	EnvironmentGroupVersionedName string
+}
+
+// SyncLatestVersionToLinkedApplications copies the latest version of an environment group into the namespace of every linked application, then
+// updates the linked deployments and cronjobs to reference that version, which redeploys them. All namespaces are synced before any application is
+// updated, and if any application fails to update, the applications which were already updated are restored so that the linked applications
+// are either all redeployed or left as they were.
+func SyncLatestVersionToLinkedApplications(ctx context.Context, a *kubernetes.Agent, environmentGroupName string) ([]SyncedApplication, error) {
+	ctx, span := telemetry.NewSpan(ctx, "sync-env-group-to-linked-applications")
+	defer span.End()
+
+	if environmentGroupName == "" {
+		return nil, telemetry.Error(ctx, span, nil, "environment group cannot be empty")
+	}
+	telemetry.WithAttributes(span, telemetry.AttributeKV{Key: "environment-group-name", Value: environmentGroupName})
+
+	deployments, cronJobs, err := linkedWorkloads(ctx, a, environmentGroupName)
+	if err != nil {
+		return nil, telemetry.Error(ctx, span, err, "unable to list linked applications")
+	}
+
+	versionedNames := make(map[string]string)
+	for _, namespace := range linkedNamespaces(deployments, cronJobs) {
+		output, err := SyncLatestVersionToNamespace(ctx, a, SyncLatestVersionToNamespaceInput{
+			BaseEnvironmentGroupName: environmentGroupName,
+			TargetNamespace:          namespace,
+		})
+		if err != nil {
+			telemetry.WithAttributes(span, telemetry.AttributeKV{Key: "target-namespace", Value: namespace})
+			return nil, telemetry.Error(ctx, span, err, "unable to sync environment group to linked application namespace")
+		}
+		versionedNames[namespace] = output.EnvironmentGroupVersionedName
+	}
+
+	var synced []SyncedApplication
+	var restores []func(context.Context) error
+
+	rollback := func() {
+		for i := len(restores) - 1; i >= 0; i-- {
+			if err := restores[i](ctx); err != nil {
+				_ = telemetry.Error(ctx, span, err, "unable to restore linked application after failed sync")
+			}
+		}
+	}
+
+	for _, d := range deployments {
+		versionedName := versionedNames[d.Namespace]
+
+		updated := d.DeepCopy()
+		syncPodTemplate(&updated.Spec.Template, environmentGroupName, versionedName)
+
+		_, err := a.Clientset.AppsV1().Deployments(d.Namespace).Update(ctx, updated, metav1.UpdateOptions{})
+		if err != nil {
+			rollback()
+			telemetry.WithAttributes(span, telemetry.AttributeKV{Key: "deployment-name", Value: d.Name}, telemetry.AttributeKV{Key: "deployment-namespace", Value: d.Namespace})
+			return nil, telemetry.Error(ctx, span, err, "unable to update linked deployment")
+		}
+
+		original := d
+		restores = append(restores, func(ctx context.Context) error {
+			current, err := a.Clientset.AppsV1().Deployments(original.Namespace).Get(ctx, original.Name, metav1.GetOptions{})
+			if err != nil {
+				return err
+			}
+			current.Spec.Template = original.Spec.Template
+			_, err = a.Clientset.AppsV1().Deployments(original.Namespace).Update(ctx, current, metav1.UpdateOptions{})
+			return err
+		})
+
+		synced = append(synced, SyncedApplication{
+			LinkedPorterApplication:       LinkedPorterApplication{Name: d.Name, Namespace: d.Namespace},
+			Kind:                          "Deployment",
+			EnvironmentGroupVersionedName: versionedName,
+		})
+	}
+
+	for _, c := range cronJobs {
+		versionedName := versionedNames[c.Namespace]
+
+		updated := c.DeepCopy()
+		syncPodTemplate(&updated.Spec.JobTemplate.Spec.Template, environmentGroupName, versionedName)
+
+		_, err := a.Clientset.BatchV1().CronJobs(c.Namespace).Update(ctx, updated, metav1.UpdateOptions{})
+		if err != nil {
+			rollback()
+			telemetry.WithAttributes(span, telemetry.AttributeKV{Key: "cronjob-name", Value: c.Name}, telemetry.AttributeKV{Key: "cronjob-namespace", Value: c.Namespace})
+			return nil, telemetry.Error(ctx, span, err, "unable to update linked cronjob")
+		}
+
+		original := c
+		restores = append(restores, func(ctx context.Context) error {
+			current, err := a.Clientset.BatchV1().CronJobs(original.Namespace).Get(ctx, original.Name, metav1.GetOptions{})
+			if err != nil {
+				return err
+			}
+			current.Spec.JobTemplate.Spec.Template = original.Spec.JobTemplate.Spec.Template
+			_, err = a.Clientset.BatchV1().CronJobs(original.Namespace).Update(ctx, current, metav1.UpdateOptions{})
+			return err
+		})
+
+		synced = append(synced, SyncedApplication{
+			LinkedPorterApplication:       LinkedPorterApplication{Name: c.Name, Namespace: c.Namespace},
+			Kind:                          "CronJob",
+			EnvironmentGroupVersionedName: versionedName,
+		})
+	}
+
+	telemetry.WithAttributes(span, telemetry.AttributeKV{Key: "synced-applications", Value: len(synced)})
+
+	return synced, nil
+}
+
+// linkedWorkloads returns the deployments and cronjobs which are linked to the given environment group
+func linkedWorkloads(ctx context.Context, a *kubernetes.Agent, environmentGroupName string) ([]appsv1.Deployment, []batchv1.CronJob, error) {
+	deployListResp, err := a.Clientset.AppsV1().Deployments(metav1.NamespaceAll).List(ctx,
+		metav1.ListOptions{
+			LabelSelector: LabelKey_LinkedEnvironmentGroup,
+		})
+	if err != nil {
+		return nil, nil, fmt.Errorf("unable to list linked deployment applications: %w", err)
+	}
+
+	var deployments []appsv1.Deployment
+	for _, d := range deployListResp.Items {
+		if isLinkedToEnvironmentGroup(d.Labels, environmentGroupName) {
+			deployments = append(deployments, d)
+		}
+	}
+
+	cronListResp, err := a.Clientset.BatchV1().CronJobs(metav1.NamespaceAll).List(ctx,
+		metav1.ListOptions{
+			LabelSelector: LabelKey_LinkedEnvironmentGroup,
+		})
+	if err != nil {
+		return nil, nil, fmt.Errorf("unable to list linked cronjob applications: %w", err)
+	}
+
+	var cronJobs []batchv1.CronJob
+	for _, c := range cronListResp.Items {
+		if isLinkedToEnvironmentGroup(c.Labels, environmentGroupName) {
+			cronJobs = append(cronJobs, c)
+		}
+	}
+
+	return deployments, cronJobs, nil
+}
+
+func isLinkedToEnvironmentGroup(labels map[string]string, environmentGroupName string) bool {
+	for _, linkedEnvironmentGroup := range strings.Split(labels[LabelKey_LinkedEnvironmentGroup], ".") {
+		if linkedEnvironmentGroup == environmentGroupName {
+			return true
+		}
+	}
+
+	return false
+}
+
+func linkedNamespaces(deployments []appsv1.Deployment, cronJobs []batchv1.CronJob) []string {
+	seen := make(map[string]bool)
+	var namespaces []string
+
+	add := func(namespace string) {
+		if namespace == "" || seen[namespace] {
+			return
+		}
+		seen[namespace] = true
+		namespaces = append(namespaces, namespace)
+	}
+
+	for _, d := range deployments {
+		add(d.Namespace)
+	}
+	for _, c := range cronJobs {
+		add(c.Namespace)
+	}
+
+	return namespaces
+}
+
+// syncPodTemplate points every reference to a version of the environment group in the pod template at the versioned name,
+// and records the synced version on the template so that the pods are replaced
+func syncPodTemplate(template *v1.PodTemplateSpec, environmentGroupName string, versionedName string) {
+	repoint := func(name string) string {
+		if isEnvironmentGroupVersionedName(name, environmentGroupName) {
+			return versionedName
+		}
+		return name
+	}
+
+	containers := append([]*v1.Container{}, containerPointers(template.Spec.InitContainers)...)
+	containers = append(containers, containerPointers(template.Spec.Containers)...)
+
+	for _, container := range containers {
+		for i := range container.EnvFrom {
+			if ref := container.EnvFrom[i].ConfigMapRef; ref != nil {
+				ref.Name = repoint(ref.Name)
+			}
+			if ref := container.EnvFrom[i].SecretRef; ref != nil {
+				ref.Name = repoint(ref.Name)
+			}
+		}
+
+		for i := range container.Env {
+			if container.Env[i].ValueFrom == nil {
+				continue
+			}
+			if ref := container.Env[i].ValueFrom.ConfigMapKeyRef; ref != nil {
+				ref.Name = repoint(ref.Name)
+			}
+			if ref := container.Env[i].ValueFrom.SecretKeyRef; ref != nil {
+				ref.Name = repoint(ref.Name)
+			}
+		}
+	}
+
+	if template.Annotations == nil {
+		template.Annotations = make(map[string]string)
+	}
+	template.Annotations[AnnotationKey_SyncedEnvironmentGroups] = syncedEnvironmentGroupsAnnotation(
+		template.Annotations[AnnotationKey_SyncedEnvironmentGroups], environmentGroupName, versionedName,
+	)
+}
+
+func containerPointers(containers []v1.Container) []*v1.Container {
+	pointers := make([]*v1.Container, 0, len(containers))
+	for i := range containers {
+		pointers = append(pointers, &containers[i])
+	}
+
+	return pointers
+}
+
+// isEnvironmentGroupVersionedName checks if name is of the format "<environment-group-name>.<version>"
+func isEnvironmentGroupVersionedName(name string, environmentGroupName string) bool {
+	version, ok := strings.CutPrefix(name, environmentGroupName+".")
+	if !ok {
+		return false
+	}
+
+	_, err := strconv.Atoi(version)
+	return err == nil
+}
+
+// syncedEnvironmentGroupsAnnotation replaces the entry for the environment group in a comma-separated list of versioned environment group names
+func syncedEnvironmentGroupsAnnotation(existing string, environmentGroupName string, versionedName string) string {
+	entries := []string{versionedName}
+	for _, entry := range strings.Split(existing, ",") {
+		if entry == "" || isEnvironmentGroupVersionedName(entry, environmentGroupName) {
+			continue
+		}
+		entries = append(entries, entry)
+	}
+
+	return strings.Join(entries, ",")
+}
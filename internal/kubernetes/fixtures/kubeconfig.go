@@ -268,6 +268,37 @@ users:
         - "cluster-test-aws-id-guess"
 `
 
+const DOKSDoctlExec = `
+apiVersion: v1
+clusters:
+- cluster:
+    server: https://10.10.10.10
+    certificate-authority-data: LS0tLS1CRUdJTiBDRVJ=
+  name: cluster-test
+contexts:
+- context:
+    cluster: cluster-test
+    user: test-admin
+  name: context-test
+current-context: context-test
+kind: Config
+preferences: {}
+users:
+- name: test-admin
+  user:
+    exec:
+      apiVersion: client.authentication.k8s.io/v1beta1
+      command: doctl
+      args:
+        - "kubernetes"
+        - "cluster"
+        - "kubeconfig"
+        - "exec-credential"
+        - "--version=v1beta1"
+        - "--context=default"
+        - "0a1b2c3d-doks-cluster-id"
+`
+
 const OIDCAuthWithoutData = `
 apiVersion: v1
 clusters:
@@ -4,6 +4,7 @@ import (
 	"encoding/json"
 	"errors"
 	"net/url"
+	"strings"
 
 	"github.com/porter-dev/porter/api/types"
 	"github.com/porter-dev/porter/internal/models"
@@ -102,7 +103,7 @@ func GetRawConfigFromBytes(kubeconfig []byte) (*api.Config, error) {
 // Parsing rules are:
 //
 // (1) If a client certificate + client key exist, uses x509 auth mechanism
-// (2) If an oidc/gcp/aws plugin exists, uses that auth mechanism
+// (2) If an oidc/gcp/aws/doctl plugin exists, uses that auth mechanism
 // (3) If a bearer token exists, uses bearer token auth mechanism
 // (4) If a username/password exist, uses basic auth mechanism
 // (5) Otherwise, the config gets skipped
@@ -184,6 +185,23 @@ func parseAuthInfoForResolvers(authInfo *api.AuthInfo) (authMechanism models.Clu
 				},
 			}
 		}
+
+		if authInfo.Exec.Command == "doctl" {
+			resolver := models.ClusterResolver{
+				Name:     types.DOData,
+				Resolved: false,
+			}
+
+			if doksClusterID := parseAuthInfoForDOKSClusterID(authInfo); doksClusterID != "" {
+				data := map[string]string{
+					"doks_cluster_id": doksClusterID,
+				}
+
+				resolver.Data, _ = json.Marshal(&data)
+			}
+
+			return models.DO, []models.ClusterResolver{resolver}
+		}
 	}
 
 	if authInfo.Token != "" || authInfo.TokenFile != "" {
@@ -268,6 +286,27 @@ func parseAuthInfoForAWSClusterID(authInfo *api.AuthInfo, fallback string) strin
 	return fallback
 }
 
+// parseAuthInfoForDOKSClusterID returns the DOKS cluster ID passed to
+// "doctl kubernetes cluster kubeconfig exec-credential", which is the only positional argument
+func parseAuthInfoForDOKSClusterID(authInfo *api.AuthInfo) string {
+	var positional []string
+
+	for _, arg := range authInfo.Exec.Args {
+		if strings.HasPrefix(arg, "-") {
+			continue
+		}
+
+		positional = append(positional, arg)
+	}
+
+	// positional arguments are "kubernetes cluster kubeconfig exec-credential <cluster-id>"
+	if len(positional) == 5 && positional[3] == "exec-credential" {
+		return positional[4]
+	}
+
+	return ""
+}
+
 // getConfigForContext returns the raw kubeconfig associated with only a
 // single context of the raw config
 func getConfigForContext(
@@ -249,6 +249,28 @@ var ClusterCandidatesTests = []ccsTest{
 			},
 		},
 	},
+	{
+		name: "doks doctl exec-credential test",
+		raw:  []byte(fixtures.DOKSDoctlExec),
+		expected: []*models.ClusterCandidate{
+			{
+				AuthMechanism: models.DO,
+				ProjectID:     1,
+				Resolvers: []models.ClusterResolver{
+					{
+						Name:     "upload-do-data",
+						Resolved: false,
+						Data:     []byte(`{"doks_cluster_id":"0a1b2c3d-doks-cluster-id"}`),
+					},
+				},
+				Name:              "cluster-test",
+				Server:            "https://10.10.10.10",
+				ContextName:       "context-test",
+				Kubeconfig:        []byte(fixtures.DOKSDoctlExec),
+				AWSClusterIDGuess: []byte{},
+			},
+		},
+	},
 	{
 		name: "oidc without ca data",
 		raw:  []byte(fixtures.OIDCAuthWithoutData),
@@ -0,0 +1,112 @@
+package kubernetes
+
+import (
+	"context"
+	"fmt"
+
+	v1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+const (
+	// PorterResourceQuotaName is the name of the ResourceQuota that Porter manages in a namespace
+	PorterResourceQuotaName = "porter-quota"
+
+	// PorterLimitRangeName is the name of the LimitRange that Porter manages in a namespace
+	PorterLimitRangeName = "porter-limits"
+)
+
+// ApplyNamespaceResourceQuota creates or updates the ResourceQuota managed by Porter in the given
+// namespace, so that it enforces the given hard limits.
+func (a *Agent) ApplyNamespaceResourceQuota(namespace string, hard v1.ResourceList) (*v1.ResourceQuota, error) {
+	quotas := a.Clientset.CoreV1().ResourceQuotas(namespace)
+
+	quota, err := quotas.Get(context.TODO(), PorterResourceQuotaName, metav1.GetOptions{})
+	if err != nil {
+		if !errors.IsNotFound(err) {
+			return nil, fmt.Errorf("error getting resource quota: %w", err)
+		}
+
+		return quotas.Create(context.TODO(), &v1.ResourceQuota{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      PorterResourceQuotaName,
+				Namespace: namespace,
+			},
+			Spec: v1.ResourceQuotaSpec{
+				Hard: hard,
+			},
+		}, metav1.CreateOptions{})
+	}
+
+	quota.Spec.Hard = hard
+
+	return quotas.Update(context.TODO(), quota, metav1.UpdateOptions{})
+}
+
+// ApplyNamespaceLimitRange creates or updates the LimitRange managed by Porter in the given
+// namespace, so that it enforces the given container limits.
+func (a *Agent) ApplyNamespaceLimitRange(namespace string, limits v1.LimitRangeItem) (*v1.LimitRange, error) {
+	limits.Type = v1.LimitTypeContainer
+
+	limitRanges := a.Clientset.CoreV1().LimitRanges(namespace)
+
+	limitRange, err := limitRanges.Get(context.TODO(), PorterLimitRangeName, metav1.GetOptions{})
+	if err != nil {
+		if !errors.IsNotFound(err) {
+			return nil, fmt.Errorf("error getting limit range: %w", err)
+		}
+
+		return limitRanges.Create(context.TODO(), &v1.LimitRange{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      PorterLimitRangeName,
+				Namespace: namespace,
+			},
+			Spec: v1.LimitRangeSpec{
+				Limits: []v1.LimitRangeItem{limits},
+			},
+		}, metav1.CreateOptions{})
+	}
+
+	limitRange.Spec.Limits = []v1.LimitRangeItem{limits}
+
+	return limitRanges.Update(context.TODO(), limitRange, metav1.UpdateOptions{})
+}
+
+// ListNamespaceResourceQuotas returns the ResourceQuotas managed by Porter in the cluster, keyed
+// by namespace.
+func (a *Agent) ListNamespaceResourceQuotas() (map[string]*v1.ResourceQuota, error) {
+	list, err := a.Clientset.CoreV1().ResourceQuotas(v1.NamespaceAll).List(context.TODO(), metav1.ListOptions{})
+	if err != nil {
+		return nil, fmt.Errorf("error listing resource quotas: %w", err)
+	}
+
+	res := make(map[string]*v1.ResourceQuota)
+
+	for i := range list.Items {
+		if list.Items[i].Name == PorterResourceQuotaName {
+			res[list.Items[i].Namespace] = &list.Items[i]
+		}
+	}
+
+	return res, nil
+}
+
+// ListNamespaceLimitRanges returns the LimitRanges managed by Porter in the cluster, keyed by
+// namespace.
+func (a *Agent) ListNamespaceLimitRanges() (map[string]*v1.LimitRange, error) {
+	list, err := a.Clientset.CoreV1().LimitRanges(v1.NamespaceAll).List(context.TODO(), metav1.ListOptions{})
+	if err != nil {
+		return nil, fmt.Errorf("error listing limit ranges: %w", err)
+	}
+
+	res := make(map[string]*v1.LimitRange)
+
+	for i := range list.Items {
+		if list.Items[i].Name == PorterLimitRangeName {
+			res[list.Items[i].Namespace] = &list.Items[i]
+		}
+	}
+
+	return res, nil
+}
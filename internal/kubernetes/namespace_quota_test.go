@@ -0,0 +1,83 @@
+package kubernetes_test
+
+import (
+	"testing"
+
+	"github.com/porter-dev/porter/internal/kubernetes"
+	v1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+func TestApplyNamespaceResourceQuota(t *testing.T) {
+	agent := newAgentFixture(t, &v1.Namespace{
+		ObjectMeta: metav1.ObjectMeta{Name: "preview"},
+	})
+
+	_, err := agent.ApplyNamespaceResourceQuota("preview", v1.ResourceList{
+		v1.ResourceRequestsCPU: resource.MustParse("2"),
+	})
+	if err != nil {
+		t.Fatalf("unexpected error creating quota: %v", err)
+	}
+
+	// applying again updates the existing quota instead of failing
+	_, err = agent.ApplyNamespaceResourceQuota("preview", v1.ResourceList{
+		v1.ResourceRequestsCPU: resource.MustParse("4"),
+		v1.ResourcePods:        resource.MustParse("20"),
+	})
+	if err != nil {
+		t.Fatalf("unexpected error updating quota: %v", err)
+	}
+
+	quotas, err := agent.ListNamespaceResourceQuotas()
+	if err != nil {
+		t.Fatalf("unexpected error listing quotas: %v", err)
+	}
+
+	quota, ok := quotas["preview"]
+	if !ok {
+		t.Fatalf("expected quota %s in namespace preview", kubernetes.PorterResourceQuotaName)
+	}
+
+	if cpu := quota.Spec.Hard[v1.ResourceRequestsCPU]; cpu.String() != "4" {
+		t.Errorf("expected cpu requests of 4, got %s", cpu.String())
+	}
+
+	if pods := quota.Spec.Hard[v1.ResourcePods]; pods.String() != "20" {
+		t.Errorf("expected 20 pods, got %s", pods.String())
+	}
+}
+
+func TestApplyNamespaceLimitRange(t *testing.T) {
+	agent := newAgentFixture(t, &v1.LimitRange{
+		ObjectMeta: metav1.ObjectMeta{Name: "other-limits", Namespace: "preview"},
+	})
+
+	_, err := agent.ApplyNamespaceLimitRange("preview", v1.LimitRangeItem{
+		DefaultRequest: v1.ResourceList{
+			v1.ResourceMemory: resource.MustParse("128Mi"),
+		},
+	})
+	if err != nil {
+		t.Fatalf("unexpected error creating limit range: %v", err)
+	}
+
+	limitRanges, err := agent.ListNamespaceLimitRanges()
+	if err != nil {
+		t.Fatalf("unexpected error listing limit ranges: %v", err)
+	}
+
+	if len(limitRanges) != 1 {
+		t.Fatalf("expected only the porter limit range to be listed, got %d", len(limitRanges))
+	}
+
+	limits := limitRanges["preview"].Spec.Limits
+	if len(limits) != 1 || limits[0].Type != v1.LimitTypeContainer {
+		t.Fatalf("expected a single container limit, got %v", limits)
+	}
+
+	if mem := limits[0].DefaultRequest[v1.ResourceMemory]; mem.String() != "128Mi" {
+		t.Errorf("expected default memory request of 128Mi, got %s", mem.String())
+	}
+}
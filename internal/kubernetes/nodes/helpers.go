@@ -111,6 +111,11 @@ func DescribeNodeResource(nodeNonTerminatedPodsList *corev1.PodList, node *corev
 	}
 
 	return &NodeUsage{
+		requested: NodeResources{
+			CPUMillicores: cpuReqs.MilliValue(),
+			MemoryBytes:   memoryReqs.Value(),
+			Pods:          int64(len(nodeNonTerminatedPodsList.Items)),
+		},
 		cpuReqs:                        cpuReqs.String(),
 		memoryReqs:                     memoryReqs.String(),
 		ephemeralStorageReqs:           ephemeralstorageReqs.String(),
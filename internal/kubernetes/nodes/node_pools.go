@@ -0,0 +1,166 @@
+package nodes
+
+import (
+	"sort"
+
+	porterv1 "github.com/porter-dev/api-contracts/generated/go/porter/v1"
+)
+
+// NodePool is a group of nodes of the same workload kind and instance type
+type NodePool struct {
+	// WorkloadKind is the type of the pool, such as system, monitoring, application or custom. Nodes which are not part of
+	// a Porter node pool have no workload kind.
+	WorkloadKind string `json:"workload_kind"`
+	InstanceType string `json:"instance_type"`
+	// Managed is true if the pool is declared in the contract of the cluster, in which case its autoscaling bounds are set
+	Managed      bool   `json:"managed"`
+	MinInstances uint32 `json:"min_instances,omitempty"`
+	MaxInstances uint32 `json:"max_instances,omitempty"`
+	// Nodes are the names of the running nodes of the pool
+	Nodes       []string      `json:"nodes"`
+	Allocatable NodeResources `json:"allocatable"`
+	Requested   NodeResources `json:"requested"`
+}
+
+// NodePoolsFromContract returns the node pools declared in the contract of an EKS, GKE or AKS cluster
+func NodePoolsFromContract(contract *porterv1.Contract) []*NodePool {
+	var pools []*NodePool
+
+	cluster := contract.GetCluster()
+
+	for _, nodeGroup := range cluster.GetEksKind().GetNodeGroups() {
+		pools = append(pools, &NodePool{
+			WorkloadKind: workloadKindFromEKS(nodeGroup.NodeGroupType),
+			InstanceType: nodeGroup.InstanceType,
+			Managed:      true,
+			MinInstances: nodeGroup.MinInstances,
+			MaxInstances: nodeGroup.MaxInstances,
+		})
+	}
+
+	for _, nodePool := range cluster.GetGkeKind().GetNodePools() {
+		pools = append(pools, &NodePool{
+			WorkloadKind: workloadKindFromGKE(nodePool.NodePoolType),
+			InstanceType: nodePool.InstanceType,
+			Managed:      true,
+			MinInstances: nodePool.MinInstances,
+			MaxInstances: nodePool.MaxInstances,
+		})
+	}
+
+	for _, nodePool := range cluster.GetAksKind().GetNodePools() {
+		pools = append(pools, &NodePool{
+			WorkloadKind: workloadKindFromAKS(nodePool.NodePoolType),
+			InstanceType: nodePool.InstanceType,
+			Managed:      true,
+			MinInstances: nodePool.MinInstances,
+			MaxInstances: nodePool.MaxInstances,
+		})
+	}
+
+	return pools
+}
+
+// GroupNodePools groups nodes into the node pools declared for the cluster by their workload kind and instance type. Nodes which
+// do not match a declared pool are grouped into undeclared pools.
+func GroupNodePools(nodes []*NodeWithUsageData, declared []*NodePool) []*NodePool {
+	type poolKey struct {
+		workloadKind string
+		instanceType string
+	}
+
+	pools := make(map[poolKey]*NodePool)
+
+	for _, pool := range declared {
+		pool.Nodes = []string{}
+		pools[poolKey{pool.WorkloadKind, pool.InstanceType}] = pool
+	}
+
+	for _, node := range nodes {
+		key := poolKey{node.WorkloadKind, node.InstanceType}
+
+		pool, ok := pools[key]
+		if !ok {
+			pool = &NodePool{
+				WorkloadKind: node.WorkloadKind,
+				InstanceType: node.InstanceType,
+				Nodes:        []string{},
+			}
+
+			pools[key] = pool
+		}
+
+		pool.Nodes = append(pool.Nodes, node.Name)
+		pool.Allocatable = addResources(pool.Allocatable, node.Allocatable)
+		pool.Requested = addResources(pool.Requested, node.Requested)
+	}
+
+	res := make([]*NodePool, 0, len(pools))
+	for _, pool := range pools {
+		sort.Strings(pool.Nodes)
+		res = append(res, pool)
+	}
+
+	sort.Slice(res, func(i, j int) bool {
+		if res[i].WorkloadKind != res[j].WorkloadKind {
+			return res[i].WorkloadKind < res[j].WorkloadKind
+		}
+
+		return res[i].InstanceType < res[j].InstanceType
+	})
+
+	return res
+}
+
+func addResources(a, b NodeResources) NodeResources {
+	return NodeResources{
+		CPUMillicores: a.CPUMillicores + b.CPUMillicores,
+		MemoryBytes:   a.MemoryBytes + b.MemoryBytes,
+		Pods:          a.Pods + b.Pods,
+	}
+}
+
+func workloadKindFromEKS(t porterv1.NodeGroupType) string {
+	switch t {
+	case porterv1.NodeGroupType_NODE_GROUP_TYPE_SYSTEM:
+		return "system"
+	case porterv1.NodeGroupType_NODE_GROUP_TYPE_MONITORING:
+		return "monitoring"
+	case porterv1.NodeGroupType_NODE_GROUP_TYPE_APPLICATION:
+		return "application"
+	case porterv1.NodeGroupType_NODE_GROUP_TYPE_CUSTOM:
+		return "custom"
+	default:
+		return ""
+	}
+}
+
+func workloadKindFromGKE(t porterv1.GKENodePoolType) string {
+	switch t {
+	case porterv1.GKENodePoolType_GKE_NODE_POOL_TYPE_SYSTEM:
+		return "system"
+	case porterv1.GKENodePoolType_GKE_NODE_POOL_TYPE_MONITORING:
+		return "monitoring"
+	case porterv1.GKENodePoolType_GKE_NODE_POOL_TYPE_APPLICATION:
+		return "application"
+	case porterv1.GKENodePoolType_GKE_NODE_POOL_TYPE_CUSTOM:
+		return "custom"
+	default:
+		return ""
+	}
+}
+
+func workloadKindFromAKS(t porterv1.NodePoolType) string {
+	switch t {
+	case porterv1.NodePoolType_NODE_POOL_TYPE_SYSTEM:
+		return "system"
+	case porterv1.NodePoolType_NODE_POOL_TYPE_MONITORING:
+		return "monitoring"
+	case porterv1.NodePoolType_NODE_POOL_TYPE_APPLICATION:
+		return "application"
+	case porterv1.NodePoolType_NODE_POOL_TYPE_CUSTOM:
+		return "custom"
+	default:
+		return ""
+	}
+}
@@ -0,0 +1,104 @@
+package nodes
+
+import (
+	"testing"
+
+	"github.com/matryer/is"
+	porterv1 "github.com/porter-dev/api-contracts/generated/go/porter/v1"
+	v1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+func testNode(name, workloadKind, instanceType string) v1.Node {
+	resources := v1.ResourceList{
+		v1.ResourceCPU:    resource.MustParse("2"),
+		v1.ResourceMemory: resource.MustParse("8Gi"),
+		v1.ResourcePods:   resource.MustParse("110"),
+	}
+
+	return v1.Node{
+		ObjectMeta: metav1.ObjectMeta{
+			Name: name,
+			Labels: map[string]string{
+				LabelWorkloadKind:          workloadKind,
+				v1.LabelInstanceTypeStable: instanceType,
+			},
+		},
+		Spec: v1.NodeSpec{
+			Taints: []v1.Taint{{Key: LabelWorkloadKind, Value: workloadKind, Effect: v1.TaintEffectNoSchedule}},
+		},
+		Status: v1.NodeStatus{
+			Capacity:    resources,
+			Allocatable: resources,
+		},
+	}
+}
+
+func TestDescribeNodeResource(t *testing.T) {
+	is := is.New(t)
+
+	node := testNode("node-1", "application", "t3.large")
+
+	pods := &v1.PodList{Items: []v1.Pod{
+		{Spec: v1.PodSpec{Containers: []v1.Container{{Resources: v1.ResourceRequirements{Requests: v1.ResourceList{
+			v1.ResourceCPU:    resource.MustParse("500m"),
+			v1.ResourceMemory: resource.MustParse("1Gi"),
+		}}}}}},
+		{Spec: v1.PodSpec{Containers: []v1.Container{{Resources: v1.ResourceRequirements{Requests: v1.ResourceList{
+			v1.ResourceCPU: resource.MustParse("250m"),
+		}}}}}},
+	}}
+
+	res := DescribeNodeResource(pods, &node).Externalize(node)
+
+	is.Equal(res.InstanceType, "t3.large")
+	is.Equal(res.WorkloadKind, "application")
+	is.Equal(res.Allocatable, NodeResources{CPUMillicores: 2000, MemoryBytes: 8 << 30, Pods: 110})
+	is.Equal(res.Requested, NodeResources{CPUMillicores: 750, MemoryBytes: 1 << 30, Pods: 2})
+	is.Equal(len(res.Taints), 1)
+	is.Equal(res.FractionCpuReqs, 37.5)
+}
+
+func TestGroupNodePools(t *testing.T) {
+	is := is.New(t)
+
+	declared := NodePoolsFromContract(&porterv1.Contract{
+		Cluster: &porterv1.Cluster{
+			KindValues: &porterv1.Cluster_EksKind{EksKind: &porterv1.EKS{
+				NodeGroups: []*porterv1.EKSNodeGroup{
+					{InstanceType: "t3.medium", MinInstances: 1, MaxInstances: 3, NodeGroupType: porterv1.NodeGroupType_NODE_GROUP_TYPE_SYSTEM},
+					{InstanceType: "t3.large", MinInstances: 1, MaxInstances: 10, NodeGroupType: porterv1.NodeGroupType_NODE_GROUP_TYPE_APPLICATION},
+					{InstanceType: "g4dn.xlarge", MinInstances: 0, MaxInstances: 2, NodeGroupType: porterv1.NodeGroupType_NODE_GROUP_TYPE_CUSTOM},
+				},
+			}},
+		},
+	})
+	is.Equal(len(declared), 3)
+
+	var nodes []*NodeWithUsageData
+	for _, node := range []v1.Node{
+		testNode("app-2", "application", "t3.large"),
+		testNode("app-1", "application", "t3.large"),
+		testNode("system-1", "system", "t3.medium"),
+		testNode("other-1", "", "m5.large"),
+	} {
+		node := node
+		nodes = append(nodes, DescribeNodeResource(&v1.PodList{}, &node).Externalize(node))
+	}
+
+	pools := GroupNodePools(nodes, declared)
+	is.Equal(len(pools), 4) // nodes outside of declared pools should be grouped into their own pool
+
+	is.Equal(pools[0].WorkloadKind, "")
+	is.True(!pools[0].Managed)
+	is.Equal(pools[0].Nodes, []string{"other-1"})
+
+	is.Equal(pools[1].WorkloadKind, "application")
+	is.Equal(pools[1].MaxInstances, uint32(10))
+	is.Equal(pools[1].Nodes, []string{"app-1", "app-2"})
+	is.Equal(pools[1].Allocatable.CPUMillicores, int64(4000))
+
+	is.Equal(pools[2].WorkloadKind, "custom")
+	is.Equal(pools[2].Nodes, []string{}) // declared pools without nodes should be listed
+}
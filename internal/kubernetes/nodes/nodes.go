@@ -10,6 +10,9 @@ import (
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 )
 
+// LabelWorkloadKind is the label of the nodes of Porter node pools, whose value is the type of the pool, such as application or monitoring
+const LabelWorkloadKind = "porter.run/workload-kind"
+
 type TotalAllocatable struct {
 	CPU    uint
 	Memory uint
@@ -37,7 +40,15 @@ func GetAllocatableResources(clientset kubernetes.Interface) (*TotalAllocatable,
 	}, nil
 }
 
+// NodeResources are amounts of the resources of a node
+type NodeResources struct {
+	CPUMillicores int64 `json:"cpu_millicores"`
+	MemoryBytes   int64 `json:"memory_bytes"`
+	Pods          int64 `json:"pods"`
+}
+
 type NodeUsage struct {
+	requested                      NodeResources
 	cpuReqs                        string
 	memoryReqs                     string
 	ephemeralStorageReqs           string
@@ -62,6 +73,18 @@ type NodeWithUsageData struct {
 	FractionEphemeralStorageReqs   float64            `json:"fraction_ephemeral_storage_reqs"`
 	FractionEphemeralStorageLimits float64            `json:"fraction_ephemeral_storage_limits"`
 	Condition                      []v1.NodeCondition `json:"node_conditions"`
+
+	// InstanceType is the cloud provider instance type of the node
+	InstanceType string `json:"instance_type,omitempty"`
+	// WorkloadKind is the porter.run/workload-kind label of the node, which is the type of its Porter node pool
+	WorkloadKind string        `json:"workload_kind,omitempty"`
+	Capacity     NodeResources `json:"capacity"`
+	// Allocatable is the capacity of the node which can be requested by pods, after reservations for the system
+	Allocatable NodeResources `json:"allocatable"`
+	// Requested is the sum of the requests of the running pods of the node, and the number of those pods
+	Requested     NodeResources `json:"requested"`
+	Taints        []v1.Taint    `json:"taints"`
+	Unschedulable bool          `json:"unschedulable"`
 }
 
 func (nu *NodeUsage) Externalize(node v1.Node) *NodeWithUsageData {
@@ -78,6 +101,22 @@ func (nu *NodeUsage) Externalize(node v1.Node) *NodeWithUsageData {
 		FractionEphemeralStorageReqs:   nu.fractionEphemeralStorageReqs,
 		FractionEphemeralStorageLimits: nu.fractionEphemeralStorageLimits,
 		Condition:                      node.Status.Conditions,
+		InstanceType:                   node.Labels[v1.LabelInstanceTypeStable],
+		WorkloadKind:                   node.Labels[LabelWorkloadKind],
+		Capacity:                       resourcesFromList(node.Status.Capacity),
+		Allocatable:                    resourcesFromList(node.Status.Allocatable),
+		Requested:                      nu.requested,
+		Taints:                         node.Spec.Taints,
+		Unschedulable:                  node.Spec.Unschedulable,
+	}
+}
+
+// resourcesFromList returns the cpu, memory and pod amounts of a resource list
+func resourcesFromList(list v1.ResourceList) NodeResources {
+	return NodeResources{
+		CPUMillicores: list.Cpu().MilliValue(),
+		MemoryBytes:   list.Memory().Value(),
+		Pods:          list.Pods().Value(),
 	}
 }
 
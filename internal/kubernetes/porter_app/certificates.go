@@ -0,0 +1,371 @@
+package porter_app
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	v1 "k8s.io/api/core/v1"
+	netv1 "k8s.io/api/networking/v1"
+	k8serrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/client-go/dynamic"
+	"k8s.io/client-go/kubernetes"
+)
+
+const (
+	// LabelCustomCertificateApp is set to the name of the app on the secrets and ingresses which serve a custom certificate
+	LabelCustomCertificateApp = "porter.run/custom-certificate-app"
+	// AnnotationCustomCertificateDomain is the domain served by a custom certificate ingress
+	AnnotationCustomCertificateDomain = "porter.run/custom-certificate-domain"
+	// AnnotationReplacedTLSSecret is the TLS secret which served the domain before the custom certificate, so that it can be restored
+	AnnotationReplacedTLSSecret = "porter.run/replaced-tls-secret"
+)
+
+var certificateGVR = schema.GroupVersionResource{Group: "cert-manager.io", Version: "v1", Resource: "certificates"}
+
+// Certificate is a cert-manager certificate
+type Certificate struct {
+	Name       string
+	Namespace  string
+	SecretName string
+	DNSNames   []string
+	// Ready is the status of the Ready condition of the certificate
+	Ready   bool
+	Message string
+	// NotAfter is the expiry of the issued certificate, and RenewalTime is when cert-manager renews it
+	NotAfter    *time.Time
+	RenewalTime *time.Time
+}
+
+// ListAppIngresses lists the ingresses of the services of an app in every namespace. Ingresses which serve custom certificates are not included.
+func ListAppIngresses(ctx context.Context, clientset kubernetes.Interface, appName string) ([]netv1.Ingress, error) {
+	ingresses, err := clientset.NetworkingV1().Ingresses(metav1.NamespaceAll).List(ctx, metav1.ListOptions{LabelSelector: "app.kubernetes.io/instance"})
+	if err != nil {
+		return nil, fmt.Errorf("error listing ingresses: %w", err)
+	}
+
+	var res []netv1.Ingress
+	for _, ingress := range ingresses.Items {
+		if isAppWorkload(ingress.Labels, appName) {
+			res = append(res, ingress)
+		}
+	}
+
+	return res, nil
+}
+
+// ListCustomCertificateIngresses lists the ingresses which serve the custom certificates of an app in every namespace
+func ListCustomCertificateIngresses(ctx context.Context, clientset kubernetes.Interface, appName string) ([]netv1.Ingress, error) {
+	ingresses, err := clientset.NetworkingV1().Ingresses(metav1.NamespaceAll).List(ctx, metav1.ListOptions{
+		LabelSelector: fmt.Sprintf("%s=%s", LabelCustomCertificateApp, appName),
+	})
+	if err != nil {
+		return nil, fmt.Errorf("error listing custom certificate ingresses: %w", err)
+	}
+
+	return ingresses.Items, nil
+}
+
+// ListCertificates lists the cert-manager certificates of a namespace. No certificates are returned if cert-manager is not installed.
+func ListCertificates(ctx context.Context, dynClient dynamic.Interface, namespace string) ([]Certificate, error) {
+	list, err := dynClient.Resource(certificateGVR).Namespace(namespace).List(ctx, metav1.ListOptions{})
+	if err != nil {
+		if k8serrors.IsNotFound(err) {
+			return nil, nil
+		}
+		return nil, fmt.Errorf("error listing certificates: %w", err)
+	}
+
+	certificates := make([]Certificate, 0, len(list.Items))
+	for _, item := range list.Items {
+		certificates = append(certificates, certificateFromUnstructured(item))
+	}
+
+	return certificates, nil
+}
+
+func certificateFromUnstructured(obj unstructured.Unstructured) Certificate {
+	certificate := Certificate{
+		Name:      obj.GetName(),
+		Namespace: obj.GetNamespace(),
+	}
+
+	certificate.SecretName, _, _ = unstructured.NestedString(obj.Object, "spec", "secretName")
+	certificate.DNSNames, _, _ = unstructured.NestedStringSlice(obj.Object, "spec", "dnsNames")
+	certificate.NotAfter = nestedTime(obj, "status", "notAfter")
+	certificate.RenewalTime = nestedTime(obj, "status", "renewalTime")
+
+	conditions, _, _ := unstructured.NestedSlice(obj.Object, "status", "conditions")
+	for _, c := range conditions {
+		condition, ok := c.(map[string]interface{})
+		if !ok || condition["type"] != "Ready" {
+			continue
+		}
+
+		certificate.Ready = condition["status"] == "True"
+		certificate.Message, _ = condition["message"].(string)
+	}
+
+	return certificate
+}
+
+func nestedTime(obj unstructured.Unstructured, fields ...string) *time.Time {
+	value, ok, _ := unstructured.NestedString(obj.Object, fields...)
+	if !ok {
+		return nil
+	}
+
+	t, err := time.Parse(time.RFC3339, value)
+	if err != nil {
+		return nil
+	}
+
+	return &t
+}
+
+// CustomCertificateName returns the name of the secret and ingress which serve the custom certificate of a domain
+func CustomCertificateName(domain string) string {
+	return "porter-tls-" + strings.ReplaceAll(strings.ReplaceAll(domain, "*", "wildcard"), ".", "-")
+}
+
+// ApplyCustomCertificate serves a custom certificate for a domain of an app instead of the certificate issued by cert-manager. In each
+// namespace where an ingress of the app serves the domain, the certificate is stored in a TLS secret and served by a separate ingress for
+// the domain, and the domain is removed from the TLS hosts of the ingress of the app so that cert-manager no longer issues a certificate
+// for it. The namespaces the certificate is applied to are returned. Deploying the app restores the TLS hosts of its ingresses, so the
+// certificate must then be applied again.
+func ApplyCustomCertificate(ctx context.Context, clientset kubernetes.Interface, appName, domain string, certPEM, keyPEM []byte) ([]string, error) {
+	ingresses, err := ListAppIngresses(ctx, clientset, appName)
+	if err != nil {
+		return nil, err
+	}
+
+	name := CustomCertificateName(domain)
+	var namespaces []string
+
+	for _, ingress := range ingresses {
+		if !servesHost(ingress, domain) {
+			continue
+		}
+
+		replacedSecret := removeTLSHost(&ingress, domain)
+		if replacedSecret != "" {
+			if _, err := clientset.NetworkingV1().Ingresses(ingress.Namespace).Update(ctx, &ingress, metav1.UpdateOptions{}); err != nil {
+				return namespaces, fmt.Errorf("error updating ingress %s: %w", ingress.Name, err)
+			}
+		}
+
+		secret := &v1.Secret{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      name,
+				Namespace: ingress.Namespace,
+				Labels:    map[string]string{LabelCustomCertificateApp: appName},
+			},
+			Type: v1.SecretTypeTLS,
+			Data: map[string][]byte{
+				v1.TLSCertKey:       certPEM,
+				v1.TLSPrivateKeyKey: keyPEM,
+			},
+		}
+
+		if err := upsertSecret(ctx, clientset, secret); err != nil {
+			return namespaces, err
+		}
+
+		customIngress := &netv1.Ingress{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      name,
+				Namespace: ingress.Namespace,
+				Labels:    map[string]string{LabelCustomCertificateApp: appName},
+				Annotations: map[string]string{
+					AnnotationCustomCertificateDomain: domain,
+				},
+			},
+			Spec: netv1.IngressSpec{
+				IngressClassName: ingress.Spec.IngressClassName,
+				// the ingress has no paths: it only sets the certificate of the host, whose paths are routed by the ingress of the app
+				Rules: []netv1.IngressRule{{Host: domain}},
+				TLS:   []netv1.IngressTLS{{Hosts: []string{domain}, SecretName: name}},
+			},
+		}
+
+		if replacedSecret != "" {
+			customIngress.Annotations[AnnotationReplacedTLSSecret] = replacedSecret
+		}
+
+		if err := upsertIngress(ctx, clientset, customIngress); err != nil {
+			return namespaces, err
+		}
+
+		namespaces = append(namespaces, ingress.Namespace)
+	}
+
+	return namespaces, nil
+}
+
+// RemoveCustomCertificate deletes the secrets and ingresses which serve the custom certificate of a domain of an app, and adds the domain back
+// to the TLS hosts of the ingresses of the app, so that cert-manager issues a certificate for it again
+func RemoveCustomCertificate(ctx context.Context, clientset kubernetes.Interface, appName, domain string) error {
+	customIngresses, err := ListCustomCertificateIngresses(ctx, clientset, appName)
+	if err != nil {
+		return err
+	}
+
+	ingresses, err := ListAppIngresses(ctx, clientset, appName)
+	if err != nil {
+		return err
+	}
+
+	for _, customIngress := range customIngresses {
+		if customIngress.Annotations[AnnotationCustomCertificateDomain] != domain {
+			continue
+		}
+
+		if replacedSecret := customIngress.Annotations[AnnotationReplacedTLSSecret]; replacedSecret != "" {
+			for _, ingress := range ingresses {
+				if ingress.Namespace != customIngress.Namespace || !servesHost(ingress, domain) || hasTLSHost(ingress, domain) {
+					continue
+				}
+
+				addTLSHost(&ingress, domain, replacedSecret)
+
+				if _, err := clientset.NetworkingV1().Ingresses(ingress.Namespace).Update(ctx, &ingress, metav1.UpdateOptions{}); err != nil {
+					return fmt.Errorf("error updating ingress %s: %w", ingress.Name, err)
+				}
+			}
+		}
+
+		err := clientset.NetworkingV1().Ingresses(customIngress.Namespace).Delete(ctx, customIngress.Name, metav1.DeleteOptions{})
+		if err != nil && !k8serrors.IsNotFound(err) {
+			return fmt.Errorf("error deleting ingress %s: %w", customIngress.Name, err)
+		}
+
+		err = clientset.CoreV1().Secrets(customIngress.Namespace).Delete(ctx, customIngress.Name, metav1.DeleteOptions{})
+		if err != nil && !k8serrors.IsNotFound(err) {
+			return fmt.Errorf("error deleting secret %s: %w", customIngress.Name, err)
+		}
+	}
+
+	return nil
+}
+
+// TLSSecretForHost returns the TLS secret which serves a host on an ingress
+func TLSSecretForHost(ingress netv1.Ingress, host string) (string, bool) {
+	for _, tls := range ingress.Spec.TLS {
+		for _, h := range tls.Hosts {
+			if h == host {
+				return tls.SecretName, true
+			}
+		}
+	}
+
+	return "", false
+}
+
+func servesHost(ingress netv1.Ingress, host string) bool {
+	for _, rule := range ingress.Spec.Rules {
+		if rule.Host == host {
+			return true
+		}
+	}
+
+	return false
+}
+
+func hasTLSHost(ingress netv1.Ingress, host string) bool {
+	_, ok := TLSSecretForHost(ingress, host)
+	return ok
+}
+
+// removeTLSHost removes a host from the TLS entries of an ingress, dropping entries left without hosts, and returns the secret which served it
+func removeTLSHost(ingress *netv1.Ingress, host string) string {
+	var replacedSecret string
+	tlsEntries := make([]netv1.IngressTLS, 0, len(ingress.Spec.TLS))
+
+	for _, tls := range ingress.Spec.TLS {
+		hosts := make([]string, 0, len(tls.Hosts))
+		for _, h := range tls.Hosts {
+			if h == host {
+				replacedSecret = tls.SecretName
+				continue
+			}
+			hosts = append(hosts, h)
+		}
+
+		if len(hosts) > 0 {
+			tls.Hosts = hosts
+			tlsEntries = append(tlsEntries, tls)
+		}
+	}
+
+	ingress.Spec.TLS = tlsEntries
+
+	return replacedSecret
+}
+
+// addTLSHost adds a host to the TLS entry of an ingress with the given secret, creating the entry if the ingress has none
+func addTLSHost(ingress *netv1.Ingress, host, secretName string) {
+	for i, tls := range ingress.Spec.TLS {
+		if tls.SecretName == secretName {
+			ingress.Spec.TLS[i].Hosts = append(ingress.Spec.TLS[i].Hosts, host)
+			return
+		}
+	}
+
+	ingress.Spec.TLS = append(ingress.Spec.TLS, netv1.IngressTLS{Hosts: []string{host}, SecretName: secretName})
+}
+
+func upsertSecret(ctx context.Context, clientset kubernetes.Interface, secret *v1.Secret) error {
+	existing, err := clientset.CoreV1().Secrets(secret.Namespace).Get(ctx, secret.Name, metav1.GetOptions{})
+	if k8serrors.IsNotFound(err) {
+		if _, err := clientset.CoreV1().Secrets(secret.Namespace).Create(ctx, secret, metav1.CreateOptions{}); err != nil {
+			return fmt.Errorf("error creating secret %s: %w", secret.Name, err)
+		}
+		return nil
+	}
+	if err != nil {
+		return fmt.Errorf("error getting secret %s: %w", secret.Name, err)
+	}
+
+	existing.Labels = secret.Labels
+	existing.Data = secret.Data
+
+	if _, err := clientset.CoreV1().Secrets(secret.Namespace).Update(ctx, existing, metav1.UpdateOptions{}); err != nil {
+		return fmt.Errorf("error updating secret %s: %w", secret.Name, err)
+	}
+
+	return nil
+}
+
+func upsertIngress(ctx context.Context, clientset kubernetes.Interface, ingress *netv1.Ingress) error {
+	existing, err := clientset.NetworkingV1().Ingresses(ingress.Namespace).Get(ctx, ingress.Name, metav1.GetOptions{})
+	if k8serrors.IsNotFound(err) {
+		if _, err := clientset.NetworkingV1().Ingresses(ingress.Namespace).Create(ctx, ingress, metav1.CreateOptions{}); err != nil {
+			return fmt.Errorf("error creating ingress %s: %w", ingress.Name, err)
+		}
+		return nil
+	}
+	if err != nil {
+		return fmt.Errorf("error getting ingress %s: %w", ingress.Name, err)
+	}
+
+	// the secret replaced when the certificate was first applied is kept, since the ingress of the app no longer lists the domain
+	if replacedSecret := existing.Annotations[AnnotationReplacedTLSSecret]; replacedSecret != "" {
+		if _, ok := ingress.Annotations[AnnotationReplacedTLSSecret]; !ok {
+			ingress.Annotations[AnnotationReplacedTLSSecret] = replacedSecret
+		}
+	}
+
+	existing.Labels = ingress.Labels
+	existing.Annotations = ingress.Annotations
+	existing.Spec = ingress.Spec
+
+	if _, err := clientset.NetworkingV1().Ingresses(ingress.Namespace).Update(ctx, existing, metav1.UpdateOptions{}); err != nil {
+		return fmt.Errorf("error updating ingress %s: %w", ingress.Name, err)
+	}
+
+	return nil
+}
@@ -0,0 +1,109 @@
+package porter_app
+
+import (
+	"context"
+	"testing"
+
+	"github.com/matryer/is"
+	netv1 "k8s.io/api/networking/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	dynamicfake "k8s.io/client-go/dynamic/fake"
+	"k8s.io/client-go/kubernetes/fake"
+)
+
+func TestApplyAndRemoveCustomCertificate(t *testing.T) {
+	is := is.New(t)
+	ctx := context.Background()
+
+	className := "nginx"
+	clientset := fake.NewSimpleClientset(
+		&netv1.Ingress{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      "app-web",
+				Namespace: "default",
+				Labels:    map[string]string{"app.kubernetes.io/instance": "app-web"},
+			},
+			Spec: netv1.IngressSpec{
+				IngressClassName: &className,
+				Rules:            []netv1.IngressRule{{Host: "app.example.com"}, {Host: "app.porter.run"}},
+				TLS: []netv1.IngressTLS{
+					{Hosts: []string{"app.example.com", "app.porter.run"}, SecretName: "app-web-tls"},
+				},
+			},
+		},
+	)
+
+	namespaces, err := ApplyCustomCertificate(ctx, clientset, "app", "app.example.com", []byte("cert"), []byte("key"))
+	is.NoErr(err)
+	is.Equal(namespaces, []string{"default"})
+
+	ingress, err := clientset.NetworkingV1().Ingresses("default").Get(ctx, "app-web", metav1.GetOptions{})
+	is.NoErr(err)
+	is.Equal(ingress.Spec.TLS, []netv1.IngressTLS{{Hosts: []string{"app.porter.run"}, SecretName: "app-web-tls"}}) // cert-manager no longer issues for the domain
+
+	secret, err := clientset.CoreV1().Secrets("default").Get(ctx, "porter-tls-app-example-com", metav1.GetOptions{})
+	is.NoErr(err)
+	is.Equal(string(secret.Data["tls.crt"]), "cert")
+
+	customIngress, err := clientset.NetworkingV1().Ingresses("default").Get(ctx, "porter-tls-app-example-com", metav1.GetOptions{})
+	is.NoErr(err)
+	is.Equal(customIngress.Spec.TLS, []netv1.IngressTLS{{Hosts: []string{"app.example.com"}, SecretName: "porter-tls-app-example-com"}})
+	is.Equal(*customIngress.Spec.IngressClassName, "nginx")
+	is.Equal(customIngress.Annotations[AnnotationReplacedTLSSecret], "app-web-tls")
+
+	// applying again, such as with a renewed certificate, keeps the replaced secret
+	_, err = ApplyCustomCertificate(ctx, clientset, "app", "app.example.com", []byte("renewed"), []byte("key"))
+	is.NoErr(err)
+	customIngress, err = clientset.NetworkingV1().Ingresses("default").Get(ctx, "porter-tls-app-example-com", metav1.GetOptions{})
+	is.NoErr(err)
+	is.Equal(customIngress.Annotations[AnnotationReplacedTLSSecret], "app-web-tls")
+
+	err = RemoveCustomCertificate(ctx, clientset, "app", "app.example.com")
+	is.NoErr(err)
+
+	ingress, err = clientset.NetworkingV1().Ingresses("default").Get(ctx, "app-web", metav1.GetOptions{})
+	is.NoErr(err)
+	is.Equal(ingress.Spec.TLS, []netv1.IngressTLS{{Hosts: []string{"app.porter.run", "app.example.com"}, SecretName: "app-web-tls"}})
+
+	_, err = clientset.NetworkingV1().Ingresses("default").Get(ctx, "porter-tls-app-example-com", metav1.GetOptions{})
+	is.True(err != nil)
+	_, err = clientset.CoreV1().Secrets("default").Get(ctx, "porter-tls-app-example-com", metav1.GetOptions{})
+	is.True(err != nil)
+}
+
+func TestListCertificates(t *testing.T) {
+	is := is.New(t)
+
+	certificate := &unstructured.Unstructured{Object: map[string]interface{}{
+		"apiVersion": "cert-manager.io/v1",
+		"kind":       "Certificate",
+		"metadata":   map[string]interface{}{"name": "app-web-tls", "namespace": "default"},
+		"spec": map[string]interface{}{
+			"secretName": "app-web-tls",
+			"dnsNames":   []interface{}{"app.porter.run"},
+		},
+		"status": map[string]interface{}{
+			"notAfter":    "2026-01-01T00:00:00Z",
+			"renewalTime": "2025-12-02T00:00:00Z",
+			"conditions": []interface{}{
+				map[string]interface{}{"type": "Ready", "status": "True", "message": "Certificate is up to date and has not expired"},
+			},
+		},
+	}}
+
+	dynClient := dynamicfake.NewSimpleDynamicClientWithCustomListKinds(runtime.NewScheme(),
+		map[schema.GroupVersionResource]string{certificateGVR: "CertificateList"},
+		certificate,
+	)
+
+	certificates, err := ListCertificates(context.Background(), dynClient, "default")
+	is.NoErr(err)
+	is.Equal(len(certificates), 1)
+	is.Equal(certificates[0].SecretName, "app-web-tls")
+	is.Equal(certificates[0].DNSNames, []string{"app.porter.run"})
+	is.True(certificates[0].Ready)
+	is.Equal(certificates[0].NotAfter.Year(), 2026)
+}
@@ -0,0 +1,84 @@
+package porter_app
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	v1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes"
+)
+
+// SetAppEnvVar sets an env var on the app container of every deployment and cron job of an app, in every namespace, which
+// rolls the deployments out with the new value. The workloads of an app are those whose instance label is the name of the
+// app followed by the name of a service. The env var is set explicitly on the container, so it takes precedence over the
+// env the container loads from config maps and secrets until the app is next deployed. The names of the updated workloads
+// are returned as namespace/name.
+func SetAppEnvVar(ctx context.Context, clientset kubernetes.Interface, appName, key, value string) ([]string, error) {
+	var updated []string
+
+	listOpts := metav1.ListOptions{LabelSelector: "app.kubernetes.io/instance"}
+
+	deployments, err := clientset.AppsV1().Deployments(metav1.NamespaceAll).List(ctx, listOpts)
+	if err != nil {
+		return updated, fmt.Errorf("error listing deployments: %w", err)
+	}
+
+	for _, deployment := range deployments.Items {
+		if !isAppWorkload(deployment.Labels, appName) || !setContainerEnv(&deployment.Spec.Template.Spec, key, value) {
+			continue
+		}
+
+		_, err := clientset.AppsV1().Deployments(deployment.Namespace).Update(ctx, &deployment, metav1.UpdateOptions{})
+		if err != nil {
+			return updated, fmt.Errorf("error updating deployment %s: %w", deployment.Name, err)
+		}
+		updated = append(updated, deployment.Namespace+"/"+deployment.Name)
+	}
+
+	cronJobs, err := clientset.BatchV1().CronJobs(metav1.NamespaceAll).List(ctx, listOpts)
+	if err != nil {
+		return updated, fmt.Errorf("error listing cron jobs: %w", err)
+	}
+
+	for _, cronJob := range cronJobs.Items {
+		if !isAppWorkload(cronJob.Labels, appName) || !setContainerEnv(&cronJob.Spec.JobTemplate.Spec.Template.Spec, key, value) {
+			continue
+		}
+
+		_, err := clientset.BatchV1().CronJobs(cronJob.Namespace).Update(ctx, &cronJob, metav1.UpdateOptions{})
+		if err != nil {
+			return updated, fmt.Errorf("error updating cron job %s: %w", cronJob.Name, err)
+		}
+		updated = append(updated, cronJob.Namespace+"/"+cronJob.Name)
+	}
+
+	return updated, nil
+}
+
+func isAppWorkload(labels map[string]string, appName string) bool {
+	return strings.HasPrefix(labels["app.kubernetes.io/instance"], appName+"-")
+}
+
+// setContainerEnv sets an env var on the app container, which is the first container of the pod, and returns whether the pod spec was changed
+func setContainerEnv(spec *v1.PodSpec, key, value string) bool {
+	if len(spec.Containers) == 0 {
+		return false
+	}
+	container := &spec.Containers[0]
+
+	for i, env := range container.Env {
+		if env.Name != key {
+			continue
+		}
+		if env.Value == value && env.ValueFrom == nil {
+			return false
+		}
+		container.Env[i] = v1.EnvVar{Name: key, Value: value}
+		return true
+	}
+
+	container.Env = append(container.Env, v1.EnvVar{Name: key, Value: value})
+	return true
+}
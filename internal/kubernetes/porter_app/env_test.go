@@ -0,0 +1,70 @@
+package porter_app
+
+import (
+	"context"
+	"testing"
+
+	"github.com/matryer/is"
+	appsv1 "k8s.io/api/apps/v1"
+	batchv1 "k8s.io/api/batch/v1"
+	v1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes/fake"
+)
+
+func TestSetAppEnvVar(t *testing.T) {
+	is := is.New(t)
+	ctx := context.Background()
+
+	podSpec := func(env ...v1.EnvVar) v1.PodSpec {
+		return v1.PodSpec{Containers: []v1.Container{{Name: "app", Env: env}, {Name: "sidecar"}}}
+	}
+
+	clientset := fake.NewSimpleClientset(
+		&appsv1.Deployment{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      "app-web",
+				Namespace: "default",
+				Labels:    map[string]string{"app.kubernetes.io/instance": "app-web"},
+			},
+			Spec: appsv1.DeploymentSpec{Template: v1.PodTemplateSpec{Spec: podSpec(v1.EnvVar{Name: "DATABASE_URL", Value: "old"})}},
+		},
+		&appsv1.Deployment{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      "other-web",
+				Namespace: "default",
+				Labels:    map[string]string{"app.kubernetes.io/instance": "other-web"},
+			},
+			Spec: appsv1.DeploymentSpec{Template: v1.PodTemplateSpec{Spec: podSpec()}},
+		},
+		&batchv1.CronJob{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      "app-cron",
+				Namespace: "preview-pr-1",
+				Labels:    map[string]string{"app.kubernetes.io/instance": "app-cron"},
+			},
+			Spec: batchv1.CronJobSpec{JobTemplate: batchv1.JobTemplateSpec{Spec: batchv1.JobSpec{Template: v1.PodTemplateSpec{Spec: podSpec()}}}},
+		},
+	)
+
+	updated, err := SetAppEnvVar(ctx, clientset, "app", "DATABASE_URL", "new")
+	is.NoErr(err)
+	is.Equal(updated, []string{"default/app-web", "preview-pr-1/app-cron"})
+
+	deployment, err := clientset.AppsV1().Deployments("default").Get(ctx, "app-web", metav1.GetOptions{})
+	is.NoErr(err)
+	is.Equal(deployment.Spec.Template.Spec.Containers[0].Env, []v1.EnvVar{{Name: "DATABASE_URL", Value: "new"}})
+	is.Equal(len(deployment.Spec.Template.Spec.Containers[1].Env), 0) // sidecars are unchanged
+
+	cronJob, err := clientset.BatchV1().CronJobs("preview-pr-1").Get(ctx, "app-cron", metav1.GetOptions{})
+	is.NoErr(err)
+	is.Equal(cronJob.Spec.JobTemplate.Spec.Template.Spec.Containers[0].Env, []v1.EnvVar{{Name: "DATABASE_URL", Value: "new"}})
+
+	other, err := clientset.AppsV1().Deployments("default").Get(ctx, "other-web", metav1.GetOptions{})
+	is.NoErr(err)
+	is.Equal(len(other.Spec.Template.Spec.Containers[0].Env), 0) // other apps are unchanged
+
+	updated, err = SetAppEnvVar(ctx, clientset, "app", "DATABASE_URL", "new")
+	is.NoErr(err)
+	is.Equal(len(updated), 0) // workloads which already have the value are not redeployed
+}
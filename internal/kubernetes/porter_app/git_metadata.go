@@ -0,0 +1,105 @@
+package porter_app
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	k8stypes "k8s.io/apimachinery/pkg/types"
+	"k8s.io/apimachinery/pkg/util/validation"
+	"k8s.io/client-go/kubernetes"
+)
+
+const (
+	// LabelCommitSHA is the label set on the workloads of a service to the commit they were deployed from, so that workloads can be
+	// selected by commit. It is only set if the commit SHA is a valid label value.
+	LabelCommitSHA = "porter.run/commit-sha"
+	// AnnotationCommitSHA is the annotation set on the workloads of a service to the commit they were deployed from
+	AnnotationCommitSHA = "porter.run/commit-sha"
+	// AnnotationGitBranch is the annotation set on the workloads of a service to the branch they were deployed from
+	AnnotationGitBranch = "porter.run/git-branch"
+	// AnnotationGitRepoURL is the annotation set on the workloads of a service to the repository they were deployed from
+	AnnotationGitRepoURL = "porter.run/git-repo-url"
+)
+
+// GitMetadata is the git source of a deployed revision
+type GitMetadata struct {
+	CommitSHA string
+	Branch    string
+	RepoURL   string
+}
+
+// IsEmpty returns true if none of the git metadata is known
+func (m GitMetadata) IsEmpty() bool {
+	return m.CommitSHA == "" && m.Branch == "" && m.RepoURL == ""
+}
+
+// StampServiceWorkloads sets the git metadata as labels and annotations on the deployments and cron jobs of a service, and
+// returns the number of workloads which were updated. Only the metadata of the workloads is changed, so no rollout is triggered.
+// Metadata which is not known is removed, so that workloads do not keep the metadata of an earlier revision.
+func StampServiceWorkloads(ctx context.Context, clientset kubernetes.Interface, namespace, appName, serviceName string, metadata GitMetadata) (int, error) {
+	patch, err := gitMetadataPatch(metadata)
+	if err != nil {
+		return 0, err
+	}
+
+	selector := fmt.Sprintf("app.kubernetes.io/instance=%s-%s", appName, serviceName)
+	stamped := 0
+
+	deployments, err := clientset.AppsV1().Deployments(namespace).List(ctx, metav1.ListOptions{LabelSelector: selector})
+	if err != nil {
+		return stamped, fmt.Errorf("error listing deployments: %w", err)
+	}
+
+	for _, deployment := range deployments.Items {
+		_, err := clientset.AppsV1().Deployments(namespace).Patch(ctx, deployment.Name, k8stypes.MergePatchType, patch, metav1.PatchOptions{})
+		if err != nil {
+			return stamped, fmt.Errorf("error patching deployment %s: %w", deployment.Name, err)
+		}
+		stamped++
+	}
+
+	cronJobs, err := clientset.BatchV1().CronJobs(namespace).List(ctx, metav1.ListOptions{LabelSelector: selector})
+	if err != nil {
+		return stamped, fmt.Errorf("error listing cron jobs: %w", err)
+	}
+
+	for _, cronJob := range cronJobs.Items {
+		_, err := clientset.BatchV1().CronJobs(namespace).Patch(ctx, cronJob.Name, k8stypes.MergePatchType, patch, metav1.PatchOptions{})
+		if err != nil {
+			return stamped, fmt.Errorf("error patching cron job %s: %w", cronJob.Name, err)
+		}
+		stamped++
+	}
+
+	return stamped, nil
+}
+
+// gitMetadataPatch returns a merge patch which sets the git metadata on the metadata of an object. Null values remove a key.
+func gitMetadataPatch(metadata GitMetadata) ([]byte, error) {
+	valueOrNull := func(value string) any {
+		if value == "" {
+			return nil
+		}
+		return value
+	}
+
+	var commitLabel any
+	if metadata.CommitSHA != "" && len(validation.IsValidLabelValue(metadata.CommitSHA)) == 0 {
+		commitLabel = metadata.CommitSHA
+	}
+
+	return json.Marshal(map[string]any{
+		"metadata": map[string]any{
+			"labels": map[string]any{
+				LabelCommitSHA: commitLabel,
+			},
+			"annotations": map[string]any{
+				AnnotationCommitSHA:  valueOrNull(metadata.CommitSHA),
+				AnnotationGitBranch:  valueOrNull(metadata.Branch),
+				AnnotationGitRepoURL: valueOrNull(metadata.RepoURL),
+			},
+		},
+	})
+}
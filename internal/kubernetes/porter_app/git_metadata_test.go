@@ -0,0 +1,72 @@
+package porter_app
+
+import (
+	"context"
+	"testing"
+
+	"github.com/matryer/is"
+	appsv1 "k8s.io/api/apps/v1"
+	batchv1 "k8s.io/api/batch/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes/fake"
+)
+
+func TestStampServiceWorkloads(t *testing.T) {
+	is := is.New(t)
+	ctx := context.Background()
+
+	clientset := fake.NewSimpleClientset(
+		&appsv1.Deployment{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:        "app-web",
+				Namespace:   "default",
+				Labels:      map[string]string{"app.kubernetes.io/instance": "app-web"},
+				Annotations: map[string]string{AnnotationGitBranch: "old-branch", "meta.helm.sh/release-name": "app-web"},
+			},
+		},
+		&appsv1.Deployment{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      "app-worker",
+				Namespace: "default",
+				Labels:    map[string]string{"app.kubernetes.io/instance": "app-worker"},
+			},
+		},
+		&batchv1.CronJob{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      "app-cron",
+				Namespace: "default",
+				Labels:    map[string]string{"app.kubernetes.io/instance": "app-cron"},
+			},
+		},
+	)
+
+	stamped, err := StampServiceWorkloads(ctx, clientset, "default", "app", "web", GitMetadata{
+		CommitSHA: "0123456789abcdef0123456789abcdef01234567",
+		RepoURL:   "https://github.com/porter-dev/porter",
+	})
+	is.NoErr(err)
+	is.Equal(stamped, 1)
+
+	deployment, err := clientset.AppsV1().Deployments("default").Get(ctx, "app-web", metav1.GetOptions{})
+	is.NoErr(err)
+	is.Equal(deployment.Labels[LabelCommitSHA], "0123456789abcdef0123456789abcdef01234567")
+	is.Equal(deployment.Annotations[AnnotationGitRepoURL], "https://github.com/porter-dev/porter")
+	is.Equal(deployment.Annotations["meta.helm.sh/release-name"], "app-web") // other annotations are kept
+	_, hasBranch := deployment.Annotations[AnnotationGitBranch]
+	is.True(!hasBranch) // the branch of the earlier revision is removed
+
+	worker, err := clientset.AppsV1().Deployments("default").Get(ctx, "app-worker", metav1.GetOptions{})
+	is.NoErr(err)
+	is.Equal(worker.Annotations[AnnotationCommitSHA], "") // other services are not stamped
+
+	stamped, err = StampServiceWorkloads(ctx, clientset, "default", "app", "cron", GitMetadata{CommitSHA: "not a valid label", Branch: "main"})
+	is.NoErr(err)
+	is.Equal(stamped, 1)
+
+	cronJob, err := clientset.BatchV1().CronJobs("default").Get(ctx, "app-cron", metav1.GetOptions{})
+	is.NoErr(err)
+	is.Equal(cronJob.Annotations[AnnotationCommitSHA], "not a valid label")
+	is.Equal(cronJob.Annotations[AnnotationGitBranch], "main")
+	_, hasLabel := cronJob.Labels[LabelCommitSHA]
+	is.True(!hasLabel) // invalid label values are only set as annotations
+}
@@ -0,0 +1,370 @@
+package porter_app
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"sort"
+	"strings"
+	"time"
+
+	v1 "k8s.io/api/core/v1"
+	k8serrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/rand"
+	"k8s.io/client-go/kubernetes"
+	"k8s.io/client-go/kubernetes/scheme"
+	"k8s.io/client-go/rest"
+	"k8s.io/client-go/tools/remotecommand"
+)
+
+const (
+	// RemoteBuildNamespace is the namespace the build runners of remote builds run in
+	RemoteBuildNamespace = "porter-remote-builds"
+	// RemoteBuildImage is the image of the build runner, which builds the image from a build context read from stdin and pushes it
+	RemoteBuildImage = "gcr.io/kaniko-project/executor:v1.23.2"
+
+	// remoteBuildContainerName is the name of the build runner container in a remote build pod
+	remoteBuildContainerName = "kaniko"
+	// remoteBuildPollInterval is how often the state of a remote build pod is read while it starts
+	remoteBuildPollInterval = 2 * time.Second
+	// remoteBuildDockerConfigPath is where the build runner reads registry credentials from
+	remoteBuildDockerConfigPath = "/kaniko/.docker"
+
+	remoteBuildAppLabel     = "porter.run/app-name"
+	remoteBuildServiceLabel = "porter.run/service-name"
+)
+
+// RemoteBuildOptions are the settings of a remote build
+type RemoteBuildOptions struct {
+	AppName     string
+	ServiceName string
+	// Destination is the image the build is pushed to, as repository:tag
+	Destination string
+	// Dockerfile is the path of the Dockerfile in the build context
+	Dockerfile string
+	BuildArgs  map[string]string
+	// Platform is the platform to build for, such as linux/arm64. The build runs on a node of the architecture of the platform,
+	// since the build runner cannot emulate other architectures.
+	Platform string
+	// DockerConfigJSON is the docker config with the credentials of the registry the image is pushed to
+	DockerConfigJSON []byte
+	// Timeout is how long the build runner can run for before it is stopped
+	Timeout time.Duration
+}
+
+// RemoteBuild is a build runner pod which builds and pushes the image of an app
+type RemoteBuild struct {
+	Pod *v1.Pod
+
+	clientset kubernetes.Interface
+	secret    *v1.Secret
+}
+
+// StartRemoteBuild creates the build runner pod of a remote build, along with a secret holding the registry credentials of the
+// build. The secret is owned by the pod, so that it is deleted along with the pod.
+func StartRemoteBuild(ctx context.Context, clientset kubernetes.Interface, opts RemoteBuildOptions) (*RemoteBuild, error) {
+	if err := ensureRemoteBuildNamespace(ctx, clientset); err != nil {
+		return nil, err
+	}
+
+	name := remoteBuildName(opts)
+
+	secret, err := clientset.CoreV1().Secrets(RemoteBuildNamespace).Create(ctx, &v1.Secret{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      name,
+			Namespace: RemoteBuildNamespace,
+			Labels:    remoteBuildLabels(opts),
+		},
+		Type: v1.SecretTypeDockerConfigJson,
+		Data: map[string][]byte{v1.DockerConfigJsonKey: opts.DockerConfigJSON},
+	}, metav1.CreateOptions{})
+	if err != nil {
+		return nil, fmt.Errorf("error creating registry credentials of build: %w", err)
+	}
+
+	pod, err := clientset.CoreV1().Pods(RemoteBuildNamespace).Create(ctx, remoteBuildPod(name, opts), metav1.CreateOptions{})
+	if err != nil {
+		_ = clientset.CoreV1().Secrets(RemoteBuildNamespace).Delete(ctx, secret.Name, metav1.DeleteOptions{}) // nolint:errcheck
+		return nil, fmt.Errorf("error creating build runner: %w", err)
+	}
+
+	build := &RemoteBuild{Pod: pod, clientset: clientset, secret: secret}
+
+	secret.OwnerReferences = []metav1.OwnerReference{{
+		APIVersion: "v1",
+		Kind:       "Pod",
+		Name:       pod.Name,
+		UID:        pod.UID,
+	}}
+	if _, err := clientset.CoreV1().Secrets(RemoteBuildNamespace).Update(ctx, secret, metav1.UpdateOptions{}); err != nil {
+		return build, fmt.Errorf("error updating registry credentials of build: %w", err)
+	}
+
+	return build, nil
+}
+
+// WaitForRunning waits until the build runner has started, so that the build context can be uploaded to it. It returns an error
+// if the build runner exits or cannot be scheduled before the context is done.
+func (b *RemoteBuild) WaitForRunning(ctx context.Context) error {
+	ticker := time.NewTicker(remoteBuildPollInterval)
+	defer ticker.Stop()
+
+	for {
+		pod, err := b.clientset.CoreV1().Pods(RemoteBuildNamespace).Get(ctx, b.Pod.Name, metav1.GetOptions{})
+		if err != nil {
+			return fmt.Errorf("error reading build runner: %w", err)
+		}
+		b.Pod = pod
+
+		switch pod.Status.Phase {
+		case v1.PodRunning:
+			return nil
+		case v1.PodSucceeded, v1.PodFailed:
+			return fmt.Errorf("build runner exited before the build context was uploaded: %s", podMessage(pod))
+		}
+
+		for _, status := range pod.Status.ContainerStatuses {
+			if waiting := status.State.Waiting; waiting != nil && (waiting.Reason == "ErrImagePull" || waiting.Reason == "ImagePullBackOff") {
+				return fmt.Errorf("error pulling build runner image: %s", waiting.Message)
+			}
+		}
+
+		select {
+		case <-ctx.Done():
+			if message := podMessage(pod); message != "" {
+				return fmt.Errorf("build runner did not start: %s", message)
+			}
+			return fmt.Errorf("build runner did not start: %w", ctx.Err())
+		case <-ticker.C:
+		}
+	}
+}
+
+// UploadContext attaches to the stdin of the build runner and writes the build context to it, as a gzipped tar. The build
+// runner starts building once stdin is closed.
+func (b *RemoteBuild) UploadContext(ctx context.Context, restConf *rest.Config, buildContext io.Reader) error {
+	req := b.clientset.CoreV1().RESTClient().Post().
+		Resource("pods").
+		Name(b.Pod.Name).
+		Namespace(RemoteBuildNamespace).
+		SubResource("attach").
+		VersionedParams(&v1.PodAttachOptions{
+			Container: remoteBuildContainerName,
+			Stdin:     true,
+		}, scheme.ParameterCodec)
+
+	exec, err := remotecommand.NewSPDYExecutor(restConf, "POST", req.URL())
+	if err != nil {
+		return fmt.Errorf("error attaching to build runner: %w", err)
+	}
+
+	err = exec.StreamWithContext(ctx, remotecommand.StreamOptions{Stdin: buildContext})
+	if err != nil {
+		return fmt.Errorf("error uploading build context: %w", err)
+	}
+
+	return nil
+}
+
+// StreamLogs writes the output of the build runner to w until the build runner exits
+func (b *RemoteBuild) StreamLogs(ctx context.Context, w io.Writer) error {
+	stream, err := b.clientset.CoreV1().Pods(RemoteBuildNamespace).GetLogs(b.Pod.Name, &v1.PodLogOptions{
+		Container: remoteBuildContainerName,
+		Follow:    true,
+	}).Stream(ctx)
+	if err != nil {
+		return fmt.Errorf("error streaming build logs: %w", err)
+	}
+	defer stream.Close() // nolint:errcheck
+
+	_, err = io.Copy(w, stream)
+	if err != nil && !errors.Is(err, context.Canceled) {
+		return fmt.Errorf("error streaming build logs: %w", err)
+	}
+
+	return nil
+}
+
+// Wait waits for the build runner to exit, returning an error if the build failed
+func (b *RemoteBuild) Wait(ctx context.Context) error {
+	ticker := time.NewTicker(remoteBuildPollInterval)
+	defer ticker.Stop()
+
+	for {
+		pod, err := b.clientset.CoreV1().Pods(RemoteBuildNamespace).Get(ctx, b.Pod.Name, metav1.GetOptions{})
+		if err != nil {
+			return fmt.Errorf("error reading build runner: %w", err)
+		}
+		b.Pod = pod
+
+		switch pod.Status.Phase {
+		case v1.PodSucceeded:
+			return nil
+		case v1.PodFailed:
+			return fmt.Errorf("build failed: %s", podMessage(pod))
+		}
+
+		select {
+		case <-ctx.Done():
+			return fmt.Errorf("build did not complete: %w", ctx.Err())
+		case <-ticker.C:
+		}
+	}
+}
+
+// Delete deletes the build runner pod, along with the registry credentials it owns
+func (b *RemoteBuild) Delete(ctx context.Context) error {
+	propagation := metav1.DeletePropagationBackground
+
+	err := b.clientset.CoreV1().Pods(RemoteBuildNamespace).Delete(ctx, b.Pod.Name, metav1.DeleteOptions{PropagationPolicy: &propagation})
+	if err != nil && !k8serrors.IsNotFound(err) {
+		return fmt.Errorf("error deleting build runner: %w", err)
+	}
+
+	// the secret is also deleted here in case its owner reference could not be set
+	err = b.clientset.CoreV1().Secrets(RemoteBuildNamespace).Delete(ctx, b.secret.Name, metav1.DeleteOptions{})
+	if err != nil && !k8serrors.IsNotFound(err) {
+		return fmt.Errorf("error deleting registry credentials of build: %w", err)
+	}
+
+	return nil
+}
+
+func ensureRemoteBuildNamespace(ctx context.Context, clientset kubernetes.Interface) error {
+	_, err := clientset.CoreV1().Namespaces().Get(ctx, RemoteBuildNamespace, metav1.GetOptions{})
+	if err == nil {
+		return nil
+	}
+	if !k8serrors.IsNotFound(err) {
+		return fmt.Errorf("error reading namespace %s: %w", RemoteBuildNamespace, err)
+	}
+
+	_, err = clientset.CoreV1().Namespaces().Create(ctx, &v1.Namespace{
+		ObjectMeta: metav1.ObjectMeta{Name: RemoteBuildNamespace},
+	}, metav1.CreateOptions{})
+	if err != nil && !k8serrors.IsAlreadyExists(err) {
+		return fmt.Errorf("error creating namespace %s: %w", RemoteBuildNamespace, err)
+	}
+
+	return nil
+}
+
+// remoteBuildName returns a unique name for the pod and secret of a remote build, which stays within the limits of pod names
+func remoteBuildName(opts RemoteBuildOptions) string {
+	name := opts.AppName
+	if opts.ServiceName != "" {
+		name = fmt.Sprintf("%s-%s", opts.AppName, opts.ServiceName)
+	}
+	if len(name) > 40 {
+		name = name[:40]
+	}
+
+	return fmt.Sprintf("%s-build-%s", strings.TrimSuffix(name, "-"), rand.String(5))
+}
+
+func remoteBuildLabels(opts RemoteBuildOptions) map[string]string {
+	labels := map[string]string{remoteBuildAppLabel: opts.AppName}
+	if opts.ServiceName != "" {
+		labels[remoteBuildServiceLabel] = opts.ServiceName
+	}
+
+	return labels
+}
+
+// remoteBuildPod returns the build runner pod of a remote build, which reads the build context from stdin
+func remoteBuildPod(name string, opts RemoteBuildOptions) *v1.Pod {
+	dockerfile := opts.Dockerfile
+	if dockerfile == "" {
+		dockerfile = "Dockerfile"
+	}
+
+	args := []string{
+		"--context=tar://stdin",
+		fmt.Sprintf("--dockerfile=%s", dockerfile),
+		fmt.Sprintf("--destination=%s", opts.Destination),
+	}
+
+	buildArgs := make([]string, 0, len(opts.BuildArgs))
+	for key := range opts.BuildArgs {
+		buildArgs = append(buildArgs, key)
+	}
+	sort.Strings(buildArgs)
+	for _, key := range buildArgs {
+		args = append(args, fmt.Sprintf("--build-arg=%s=%s", key, opts.BuildArgs[key]))
+	}
+
+	var nodeSelector map[string]string
+	if opts.Platform != "" {
+		args = append(args, fmt.Sprintf("--custom-platform=%s", opts.Platform))
+
+		if parts := strings.Split(opts.Platform, "/"); len(parts) > 1 {
+			nodeSelector = map[string]string{v1.LabelArchStable: parts[1]}
+		}
+	}
+
+	var activeDeadlineSeconds *int64
+	if opts.Timeout > 0 {
+		seconds := int64(opts.Timeout.Seconds())
+		activeDeadlineSeconds = &seconds
+	}
+
+	return &v1.Pod{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      name,
+			Namespace: RemoteBuildNamespace,
+			Labels:    remoteBuildLabels(opts),
+		},
+		Spec: v1.PodSpec{
+			RestartPolicy:         v1.RestartPolicyNever,
+			ActiveDeadlineSeconds: activeDeadlineSeconds,
+			NodeSelector:          nodeSelector,
+			Containers: []v1.Container{{
+				Name:      remoteBuildContainerName,
+				Image:     RemoteBuildImage,
+				Args:      args,
+				Stdin:     true,
+				StdinOnce: true,
+				VolumeMounts: []v1.VolumeMount{{
+					Name:      "docker-config",
+					MountPath: remoteBuildDockerConfigPath,
+					ReadOnly:  true,
+				}},
+			}},
+			Volumes: []v1.Volume{{
+				Name: "docker-config",
+				VolumeSource: v1.VolumeSource{
+					Secret: &v1.SecretVolumeSource{
+						SecretName: name,
+						Items:      []v1.KeyToPath{{Key: v1.DockerConfigJsonKey, Path: "config.json"}},
+					},
+				},
+			}},
+		},
+	}
+}
+
+// podMessage describes why a pod is not running, using the state of its container or the reason of its phase
+func podMessage(pod *v1.Pod) string {
+	for _, status := range pod.Status.ContainerStatuses {
+		if terminated := status.State.Terminated; terminated != nil {
+			message := fmt.Sprintf("exit code %d", terminated.ExitCode)
+			if terminated.Reason != "" {
+				message = fmt.Sprintf("%s (%s)", message, terminated.Reason)
+			}
+			return message
+		}
+		if waiting := status.State.Waiting; waiting != nil && waiting.Message != "" {
+			return waiting.Message
+		}
+	}
+
+	for _, condition := range pod.Status.Conditions {
+		if condition.Type == v1.PodScheduled && condition.Status == v1.ConditionFalse && condition.Message != "" {
+			return condition.Message
+		}
+	}
+
+	return pod.Status.Message
+}
@@ -0,0 +1,100 @@
+package porter_app
+
+import (
+	"context"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/matryer/is"
+	v1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes/fake"
+)
+
+func TestStartRemoteBuild(t *testing.T) {
+	is := is.New(t)
+	ctx := context.Background()
+
+	clientset := fake.NewSimpleClientset()
+
+	build, err := StartRemoteBuild(ctx, clientset, RemoteBuildOptions{
+		AppName:          "app",
+		ServiceName:      "web",
+		Destination:      "registry.example.com/app:v2",
+		Dockerfile:       "docker/Dockerfile",
+		BuildArgs:        map[string]string{"NODE_ENV": "production", "API_URL": "https://api.example.com"},
+		Platform:         "linux/arm64",
+		DockerConfigJSON: []byte(`{"auths":{}}`),
+		Timeout:          30 * time.Minute,
+	})
+	is.NoErr(err)
+
+	_, err = clientset.CoreV1().Namespaces().Get(ctx, RemoteBuildNamespace, metav1.GetOptions{})
+	is.NoErr(err)
+
+	pod, err := clientset.CoreV1().Pods(RemoteBuildNamespace).Get(ctx, build.Pod.Name, metav1.GetOptions{})
+	is.NoErr(err)
+	is.True(strings.HasPrefix(pod.Name, "app-web-build-"))
+	is.Equal(pod.Labels[remoteBuildServiceLabel], "web")
+	is.Equal(pod.Spec.RestartPolicy, v1.RestartPolicyNever)
+	is.Equal(*pod.Spec.ActiveDeadlineSeconds, int64(1800))
+	is.Equal(pod.Spec.NodeSelector[v1.LabelArchStable], "arm64")
+
+	container := pod.Spec.Containers[0]
+	is.True(container.Stdin && container.StdinOnce)
+	is.Equal(container.Args, []string{
+		"--context=tar://stdin",
+		"--dockerfile=docker/Dockerfile",
+		"--destination=registry.example.com/app:v2",
+		"--build-arg=API_URL=https://api.example.com",
+		"--build-arg=NODE_ENV=production",
+		"--custom-platform=linux/arm64",
+	})
+	is.Equal(pod.Spec.Volumes[0].Secret.SecretName, pod.Name)
+
+	// the registry credentials are deleted along with the pod
+	secret, err := clientset.CoreV1().Secrets(RemoteBuildNamespace).Get(ctx, pod.Name, metav1.GetOptions{})
+	is.NoErr(err)
+	is.Equal(secret.Type, v1.SecretTypeDockerConfigJson)
+	is.Equal(len(secret.OwnerReferences), 1)
+	is.Equal(secret.OwnerReferences[0].Name, pod.Name)
+
+	// a second build of the same app reuses the namespace
+	other, err := StartRemoteBuild(ctx, clientset, RemoteBuildOptions{AppName: "app", Destination: "registry.example.com/app:v3"})
+	is.NoErr(err)
+	is.True(other.Pod.Name != build.Pod.Name)
+	is.Equal(other.Pod.Spec.NodeSelector, nil)
+
+	is.NoErr(build.Delete(ctx))
+	_, err = clientset.CoreV1().Pods(RemoteBuildNamespace).Get(ctx, build.Pod.Name, metav1.GetOptions{})
+	is.True(err != nil)
+	_, err = clientset.CoreV1().Secrets(RemoteBuildNamespace).Get(ctx, build.Pod.Name, metav1.GetOptions{})
+	is.True(err != nil)
+}
+
+func TestRemoteBuildWait(t *testing.T) {
+	is := is.New(t)
+	ctx := context.Background()
+
+	clientset := fake.NewSimpleClientset()
+	build, err := StartRemoteBuild(ctx, clientset, RemoteBuildOptions{AppName: "app", Destination: "registry.example.com/app:v2"})
+	is.NoErr(err)
+
+	build.Pod.Status = v1.PodStatus{
+		Phase: v1.PodFailed,
+		ContainerStatuses: []v1.ContainerStatus{{
+			Name:  remoteBuildContainerName,
+			State: v1.ContainerState{Terminated: &v1.ContainerStateTerminated{ExitCode: 1, Reason: "Error"}},
+		}},
+	}
+	_, err = clientset.CoreV1().Pods(RemoteBuildNamespace).UpdateStatus(ctx, build.Pod, metav1.UpdateOptions{})
+	is.NoErr(err)
+
+	err = build.Wait(ctx)
+	is.True(err != nil)
+	is.Equal(err.Error(), "build failed: exit code 1 (Error)")
+
+	err = build.WaitForRunning(ctx)
+	is.True(err != nil && strings.Contains(err.Error(), "exited before the build context was uploaded"))
+}
@@ -0,0 +1,313 @@
+package porter_app
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	appsv1 "k8s.io/api/apps/v1"
+	v1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes"
+)
+
+// RolloutEventType is the type of a step in the rollout of a service
+type RolloutEventType string
+
+const (
+	// RolloutEventType_PodScheduled means a pod of the new revision was scheduled on a node
+	RolloutEventType_PodScheduled RolloutEventType = "pod_scheduled"
+	// RolloutEventType_PodReady means a pod of the new revision passed its readiness checks
+	RolloutEventType_PodReady RolloutEventType = "pod_ready"
+	// RolloutEventType_SchedulingFailed means a pod could not be scheduled yet, for example while the cluster scales up
+	RolloutEventType_SchedulingFailed RolloutEventType = "scheduling_failed"
+	// RolloutEventType_ImagePullError means the image of a pod could not be pulled
+	RolloutEventType_ImagePullError RolloutEventType = "image_pull_error"
+	// RolloutEventType_CrashLoop means a container of a pod is repeatedly crashing
+	RolloutEventType_CrashLoop RolloutEventType = "crash_loop"
+	// RolloutEventType_ProgressDeadlineExceeded means the deployment did not make progress within its deadline
+	RolloutEventType_ProgressDeadlineExceeded RolloutEventType = "progress_deadline_exceeded"
+	// RolloutEventType_ServiceRolledOut means every replica of the service runs the new revision
+	RolloutEventType_ServiceRolledOut RolloutEventType = "service_rolled_out"
+)
+
+const (
+	// deploymentRevisionAnnotation is set by the deployment controller on each of the replica sets of a deployment
+	deploymentRevisionAnnotation = "deployment.kubernetes.io/revision"
+
+	// rolloutSettlePeriod is how long a deployment which has not created a new replica set must stay rolled out before
+	// the rollout is considered complete, since the deployment may not have been updated when tracking starts
+	rolloutSettlePeriod = 15 * time.Second
+)
+
+// RolloutEvent is a step in the rollout of a service
+type RolloutEvent struct {
+	Service   string           `json:"service"`
+	Type      RolloutEventType `json:"type"`
+	Pod       string           `json:"pod,omitempty"`
+	Message   string           `json:"message,omitempty"`
+	Timestamp time.Time        `json:"timestamp"`
+	// Failed is true if the rollout cannot complete without changes to the app
+	Failed bool `json:"failed"`
+}
+
+// ServiceRollout is the state of the rollout of a service: its deployment, the newest replica set of the deployment, and its pods
+// and their events
+type ServiceRollout struct {
+	Service    string
+	Deployment *appsv1.Deployment
+	ReplicaSet *appsv1.ReplicaSet
+	Pods       []v1.Pod
+	Events     []v1.Event
+}
+
+// GetServiceRollout reads the rollout state of a service of an app. The deployment is nil if the service has not been deployed yet.
+func GetServiceRollout(ctx context.Context, clientset kubernetes.Interface, namespace, appName, serviceName string) (ServiceRollout, error) {
+	rollout := ServiceRollout{Service: serviceName}
+	selector := fmt.Sprintf("app.kubernetes.io/instance=%s-%s", appName, serviceName)
+
+	deployments, err := clientset.AppsV1().Deployments(namespace).List(ctx, metav1.ListOptions{LabelSelector: selector})
+	if err != nil {
+		return rollout, fmt.Errorf("error listing deployments: %w", err)
+	}
+
+	if len(deployments.Items) == 0 {
+		return rollout, nil
+	}
+	rollout.Deployment = &deployments.Items[0]
+
+	replicaSets, err := clientset.AppsV1().ReplicaSets(namespace).List(ctx, metav1.ListOptions{LabelSelector: selector})
+	if err != nil {
+		return rollout, fmt.Errorf("error listing replica sets: %w", err)
+	}
+
+	rollout.ReplicaSet = newestReplicaSet(rollout.Deployment, replicaSets.Items)
+	if rollout.ReplicaSet == nil {
+		return rollout, nil
+	}
+
+	pods, err := clientset.CoreV1().Pods(namespace).List(ctx, metav1.ListOptions{LabelSelector: selector})
+	if err != nil {
+		return rollout, fmt.Errorf("error listing pods: %w", err)
+	}
+
+	podNames := make(map[string]bool)
+	for _, pod := range pods.Items {
+		if metav1.IsControlledBy(&pod, rollout.ReplicaSet) {
+			rollout.Pods = append(rollout.Pods, pod)
+			podNames[pod.Name] = true
+		}
+	}
+
+	events, err := clientset.CoreV1().Events(namespace).List(ctx, metav1.ListOptions{FieldSelector: "involvedObject.kind=Pod"})
+	if err != nil {
+		return rollout, fmt.Errorf("error listing events: %w", err)
+	}
+
+	for _, event := range events.Items {
+		if podNames[event.InvolvedObject.Name] {
+			rollout.Events = append(rollout.Events, event)
+		}
+	}
+
+	return rollout, nil
+}
+
+// newestReplicaSet returns the replica set of the deployment with the highest revision
+func newestReplicaSet(deployment *appsv1.Deployment, replicaSets []appsv1.ReplicaSet) *appsv1.ReplicaSet {
+	var newest *appsv1.ReplicaSet
+	var newestRevision int64 = -1
+
+	for i := range replicaSets {
+		rs := &replicaSets[i]
+		if !metav1.IsControlledBy(rs, deployment) {
+			continue
+		}
+
+		revision, err := strconv.ParseInt(rs.Annotations[deploymentRevisionAnnotation], 10, 64)
+		if err != nil {
+			continue
+		}
+
+		if revision > newestRevision {
+			newest = rs
+			newestRevision = revision
+		}
+	}
+
+	return newest
+}
+
+// RolloutTracker turns the rollout state of services into rollout events, reporting each event only once
+type RolloutTracker struct {
+	// ImageTag is the image tag of the revision being rolled out
+	ImageTag string
+	// Since is when the revision was applied
+	Since time.Time
+
+	seen map[string]bool
+}
+
+// NewRolloutTracker returns a RolloutTracker for the rollout of a revision with the image tag, applied at since
+func NewRolloutTracker(imageTag string, since time.Time) *RolloutTracker {
+	return &RolloutTracker{
+		ImageTag: imageTag,
+		Since:    since,
+		seen:     make(map[string]bool),
+	}
+}
+
+// Observe returns the events of a service which have not been reported yet, and whether the service has rolled out
+func (t *RolloutTracker) Observe(rollout ServiceRollout, now time.Time) ([]RolloutEvent, bool) {
+	events := make([]RolloutEvent, 0)
+
+	add := func(event RolloutEvent) {
+		key := fmt.Sprintf("%s/%s/%s/%s", event.Service, event.Type, event.Pod, event.Message)
+		if t.seen[key] {
+			return
+		}
+
+		t.seen[key] = true
+		events = append(events, event)
+	}
+
+	if rollout.Deployment == nil || rollout.ReplicaSet == nil {
+		return events, false
+	}
+
+	pods := append([]v1.Pod{}, rollout.Pods...)
+	sort.Slice(pods, func(i, j int) bool {
+		return pods[i].CreationTimestamp.Before(&pods[j].CreationTimestamp)
+	})
+
+	for _, pod := range pods {
+		for _, condition := range pod.Status.Conditions {
+			if condition.Status != v1.ConditionTrue {
+				continue
+			}
+
+			switch condition.Type {
+			case v1.PodScheduled:
+				add(RolloutEvent{
+					Service:   rollout.Service,
+					Type:      RolloutEventType_PodScheduled,
+					Pod:       pod.Name,
+					Message:   fmt.Sprintf("scheduled on %s", pod.Spec.NodeName),
+					Timestamp: condition.LastTransitionTime.Time,
+				})
+			case v1.PodReady:
+				add(RolloutEvent{
+					Service:   rollout.Service,
+					Type:      RolloutEventType_PodReady,
+					Pod:       pod.Name,
+					Timestamp: condition.LastTransitionTime.Time,
+				})
+			}
+		}
+
+		for _, containerStatus := range pod.Status.ContainerStatuses {
+			waiting := containerStatus.State.Waiting
+			if waiting == nil {
+				continue
+			}
+
+			switch waiting.Reason {
+			case "ErrImagePull", "ImagePullBackOff", "InvalidImageName":
+				add(RolloutEvent{
+					Service:   rollout.Service,
+					Type:      RolloutEventType_ImagePullError,
+					Pod:       pod.Name,
+					Message:   waiting.Message,
+					Timestamp: now,
+					Failed:    true,
+				})
+			case "CrashLoopBackOff":
+				add(RolloutEvent{
+					Service:   rollout.Service,
+					Type:      RolloutEventType_CrashLoop,
+					Pod:       pod.Name,
+					Message:   fmt.Sprintf("container %s is crashing: %s", containerStatus.Name, waiting.Message),
+					Timestamp: now,
+					Failed:    true,
+				})
+			}
+		}
+	}
+
+	for _, event := range rollout.Events {
+		if event.Reason != "FailedScheduling" {
+			continue
+		}
+
+		add(RolloutEvent{
+			Service:   rollout.Service,
+			Type:      RolloutEventType_SchedulingFailed,
+			Pod:       event.InvolvedObject.Name,
+			Message:   event.Message,
+			Timestamp: event.LastTimestamp.Time,
+		})
+	}
+
+	for _, condition := range rollout.Deployment.Status.Conditions {
+		if condition.Type == appsv1.DeploymentProgressing && condition.Reason == "ProgressDeadlineExceeded" {
+			add(RolloutEvent{
+				Service:   rollout.Service,
+				Type:      RolloutEventType_ProgressDeadlineExceeded,
+				Message:   condition.Message,
+				Timestamp: condition.LastUpdateTime.Time,
+				Failed:    true,
+			})
+		}
+	}
+
+	if !t.rolledOut(rollout, now) {
+		return events, false
+	}
+
+	add(RolloutEvent{
+		Service:   rollout.Service,
+		Type:      RolloutEventType_ServiceRolledOut,
+		Message:   fmt.Sprintf("%d/%d replicas ready", rollout.Deployment.Status.AvailableReplicas, rollout.Deployment.Status.Replicas),
+		Timestamp: now,
+	})
+
+	return events, true
+}
+
+// rolledOut returns true if every replica of the deployment runs the image of the revision, and the rollout belongs to the revision
+func (t *RolloutTracker) rolledOut(rollout ServiceRollout, now time.Time) bool {
+	deployment := rollout.Deployment
+
+	if t.ImageTag != "" {
+		matches := false
+		for _, container := range deployment.Spec.Template.Spec.Containers {
+			if strings.HasSuffix(container.Image, ":"+t.ImageTag) {
+				matches = true
+			}
+		}
+
+		if !matches {
+			return false
+		}
+	}
+
+	var replicas int32 = 1
+	if deployment.Spec.Replicas != nil {
+		replicas = *deployment.Spec.Replicas
+	}
+
+	status := deployment.Status
+	if status.ObservedGeneration < deployment.Generation || status.UpdatedReplicas < replicas || status.Replicas > status.UpdatedReplicas || status.AvailableReplicas < status.UpdatedReplicas {
+		return false
+	}
+
+	// a deployment whose newest replica set predates the revision may not have been updated yet
+	if rollout.ReplicaSet.CreationTimestamp.Time.Before(t.Since) && now.Sub(t.Since) < rolloutSettlePeriod {
+		return false
+	}
+
+	return true
+}
@@ -0,0 +1,115 @@
+package porter_app
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/matryer/is"
+	appsv1 "k8s.io/api/apps/v1"
+	v1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/kubernetes/fake"
+)
+
+func TestRolloutTracker(t *testing.T) {
+	is := is.New(t)
+
+	since := time.Now().Add(-time.Minute)
+	replicas := int32(1)
+	labels := map[string]string{"app.kubernetes.io/instance": "app-web"}
+	isController := true
+
+	deployment := &appsv1.Deployment{
+		ObjectMeta: metav1.ObjectMeta{Name: "app-web", Namespace: "default", UID: "deployment", Labels: labels, Generation: 2},
+		Spec: appsv1.DeploymentSpec{
+			Replicas: &replicas,
+			Template: v1.PodTemplateSpec{
+				Spec: v1.PodSpec{Containers: []v1.Container{{Name: "web", Image: "registry.example.com/app:v2"}}},
+			},
+		},
+		Status: appsv1.DeploymentStatus{ObservedGeneration: 2, Replicas: 2, UpdatedReplicas: 1, AvailableReplicas: 1},
+	}
+
+	replicaSet := func(name string, revision string, uid string, created time.Time) *appsv1.ReplicaSet {
+		return &appsv1.ReplicaSet{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:              name,
+				Namespace:         "default",
+				UID:               types.UID(uid),
+				Labels:            labels,
+				Annotations:       map[string]string{deploymentRevisionAnnotation: revision},
+				CreationTimestamp: metav1.NewTime(created),
+				OwnerReferences:   []metav1.OwnerReference{{Kind: "Deployment", Name: "app-web", UID: "deployment", Controller: &isController}},
+			},
+		}
+	}
+
+	pod := &v1.Pod{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:            "app-web-new-1",
+			Namespace:       "default",
+			Labels:          labels,
+			OwnerReferences: []metav1.OwnerReference{{Kind: "ReplicaSet", Name: "app-web-new", UID: "new", Controller: &isController}},
+		},
+		Spec: v1.PodSpec{NodeName: "node-1"},
+		Status: v1.PodStatus{
+			Conditions: []v1.PodCondition{{Type: v1.PodScheduled, Status: v1.ConditionTrue}},
+			ContainerStatuses: []v1.ContainerStatus{{
+				Name:  "web",
+				State: v1.ContainerState{Waiting: &v1.ContainerStateWaiting{Reason: "ImagePullBackOff", Message: "manifest unknown"}},
+			}},
+		},
+	}
+
+	oldPod := &v1.Pod{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:            "app-web-old-1",
+			Namespace:       "default",
+			Labels:          labels,
+			OwnerReferences: []metav1.OwnerReference{{Kind: "ReplicaSet", Name: "app-web-old", UID: "old", Controller: &isController}},
+		},
+		Status: v1.PodStatus{Conditions: []v1.PodCondition{{Type: v1.PodReady, Status: v1.ConditionTrue}}},
+	}
+
+	objects := []runtime.Object{
+		deployment,
+		replicaSet("app-web-old", "1", "old", since.Add(-time.Hour)),
+		replicaSet("app-web-new", "2", "new", since.Add(time.Second)),
+		pod,
+		oldPod,
+	}
+	clientset := fake.NewSimpleClientset(objects...)
+
+	rollout, err := GetServiceRollout(context.Background(), clientset, "default", "app", "web")
+	is.NoErr(err)
+	is.Equal(rollout.ReplicaSet.Name, "app-web-new")
+	is.Equal(len(rollout.Pods), 1) // only pods of the newest replica set are tracked
+
+	tracker := NewRolloutTracker("v2", since)
+
+	events, rolledOut := tracker.Observe(rollout, time.Now())
+	is.True(!rolledOut) // the old replica has not been scaled down
+	is.Equal(len(events), 2)
+	is.Equal(events[0].Type, RolloutEventType_PodScheduled)
+	is.Equal(events[1].Type, RolloutEventType_ImagePullError)
+	is.True(events[1].Failed)
+
+	// events are only reported once
+	events, _ = tracker.Observe(rollout, time.Now())
+	is.Equal(len(events), 0)
+
+	rollout.Deployment.Status.Replicas = 1
+	rollout.Pods[0].Status.ContainerStatuses = nil
+	events, rolledOut = tracker.Observe(rollout, time.Now())
+	is.True(rolledOut)
+	is.Equal(len(events), 1)
+	is.Equal(events[0].Type, RolloutEventType_ServiceRolledOut)
+
+	// a deployment which runs another image has not rolled out the revision
+	tracker = NewRolloutTracker("v3", since)
+	_, rolledOut = tracker.Observe(rollout, time.Now())
+	is.True(!rolledOut)
+}
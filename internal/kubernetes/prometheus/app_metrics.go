@@ -0,0 +1,205 @@
+package prometheus
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"math"
+	"strconv"
+	"strings"
+	"time"
+
+	v1 "k8s.io/api/core/v1"
+	"k8s.io/client-go/kubernetes"
+)
+
+// AppMetric is a metric of a service of an app which can be queried with QueryAppMetric
+type AppMetric string
+
+const (
+	// AppMetric_CPU is the CPU usage of all instances of a service, in cores
+	AppMetric_CPU AppMetric = "cpu"
+	// AppMetric_Memory is the working set memory of all instances of a service, in bytes
+	AppMetric_Memory AppMetric = "memory"
+	// AppMetric_NetworkReceive is the network traffic received by all instances of a service, in bytes per second
+	AppMetric_NetworkReceive AppMetric = "network_receive"
+	// AppMetric_NetworkTransmit is the network traffic sent by all instances of a service, in bytes per second
+	AppMetric_NetworkTransmit AppMetric = "network_transmit"
+	// AppMetric_LatencyP50, AppMetric_LatencyP95 and AppMetric_LatencyP99 are quantiles of the latency of the HTTP
+	// requests served to a service through the NGINX ingress controller, in seconds
+	AppMetric_LatencyP50 AppMetric = "latency_p50"
+	AppMetric_LatencyP95 AppMetric = "latency_p95"
+	AppMetric_LatencyP99 AppMetric = "latency_p99"
+)
+
+// AppMetrics are all metrics which can be queried for a service, in the order that they are returned
+var AppMetrics = []AppMetric{
+	AppMetric_CPU,
+	AppMetric_Memory,
+	AppMetric_NetworkReceive,
+	AppMetric_NetworkTransmit,
+	AppMetric_LatencyP50,
+	AppMetric_LatencyP95,
+	AppMetric_LatencyP99,
+}
+
+// Unit returns the unit of the values of a metric
+func (m AppMetric) Unit() string {
+	switch m {
+	case AppMetric_CPU:
+		return "cores"
+	case AppMetric_Memory:
+		return "bytes"
+	case AppMetric_NetworkReceive, AppMetric_NetworkTransmit:
+		return "bytes_per_second"
+	default:
+		return "seconds"
+	}
+}
+
+// AppMetricOpts selects a service of an app and the range of a metric query
+type AppMetricOpts struct {
+	Namespace string
+	// PodRegex selects the pods of the service
+	PodRegex string
+	// ServiceName is the name of the kubernetes service of the service, which selects its ingress requests
+	ServiceName string
+	Start       time.Time
+	End         time.Time
+	Step        time.Duration
+}
+
+// MetricPoint is the value of a metric at a unix timestamp, in seconds
+type MetricPoint struct {
+	Timestamp int64   `json:"timestamp"`
+	Value     float64 `json:"value"`
+}
+
+// QueryAppMetric returns the values of a metric of a service over a range, summed over all instances of the service.
+// Timestamps for which prometheus has no samples are left out.
+func QueryAppMetric(clientset kubernetes.Interface, service *v1.Service, metric AppMetric, opts *AppMetricOpts) ([]MetricPoint, error) {
+	if len(service.Spec.Ports) == 0 {
+		return nil, fmt.Errorf("prometheus service has no exposed ports to query")
+	}
+
+	query, err := getAppMetricQuery(metric, opts)
+	if err != nil {
+		return nil, err
+	}
+
+	resp := clientset.CoreV1().Services(service.Namespace).ProxyGet(
+		"http",
+		service.Name,
+		fmt.Sprintf("%d", service.Spec.Ports[0].Port),
+		"/api/v1/query_range",
+		map[string]string{
+			"query": query,
+			"start": strconv.FormatInt(opts.Start.Unix(), 10),
+			"end":   strconv.FormatInt(opts.End.Unix(), 10),
+			"step":  fmt.Sprintf("%ds", int(opts.Step.Seconds())),
+		},
+	)
+
+	rawQuery, err := resp.DoRaw(context.TODO())
+	if err != nil {
+		// in this case, it's very likely that prometheus doesn't contain any data for the given labels
+		if strings.Contains(err.Error(), "rejected our request for an unknown reason") {
+			return []MetricPoint{}, nil
+		}
+
+		return nil, err
+	}
+
+	return parseRangeSeries(rawQuery)
+}
+
+func getAppMetricQuery(metric AppMetric, opts *AppMetricOpts) (string, error) {
+	containerSelector := fmt.Sprintf(`namespace="%s",pod=~"%s",container!="POD",container!=""`, opts.Namespace, opts.PodRegex)
+	// network metrics are reported for the pod rather than for its containers
+	podSelector := fmt.Sprintf(`namespace="%s",pod=~"%s"`, opts.Namespace, opts.PodRegex)
+
+	switch metric {
+	case AppMetric_CPU:
+		return fmt.Sprintf(`sum(rate(container_cpu_usage_seconds_total{%s}[5m]))`, containerSelector), nil
+	case AppMetric_Memory:
+		return fmt.Sprintf(`sum(container_memory_working_set_bytes{%s})`, containerSelector), nil
+	case AppMetric_NetworkReceive:
+		return fmt.Sprintf(`sum(rate(container_network_receive_bytes_total{%s}[5m]))`, podSelector), nil
+	case AppMetric_NetworkTransmit:
+		return fmt.Sprintf(`sum(rate(container_network_transmit_bytes_total{%s}[5m]))`, podSelector), nil
+	case AppMetric_LatencyP50:
+		return getLatencyQuantileQuery(0.5, opts), nil
+	case AppMetric_LatencyP95:
+		return getLatencyQuantileQuery(0.95, opts), nil
+	case AppMetric_LatencyP99:
+		return getLatencyQuantileQuery(0.99, opts), nil
+	default:
+		return "", fmt.Errorf("unknown app metric %q", metric)
+	}
+}
+
+// getLatencyQuantileQuery selects the requests of a service by the namespace label of the ingress, which is exported_namespace when
+// the ingress controller is scraped through a service monitor
+func getLatencyQuantileQuery(quantile float64, opts *AppMetricOpts) string {
+	return fmt.Sprintf(
+		`histogram_quantile(%g, sum by (le) (rate(nginx_ingress_controller_request_duration_seconds_bucket{exported_namespace="%s",service="%s"}[5m])) OR sum by (le) (rate(nginx_ingress_controller_request_duration_seconds_bucket{namespace="%s",service="%s"}[5m])))`,
+		quantile, opts.Namespace, opts.ServiceName, opts.Namespace, opts.ServiceName,
+	)
+}
+
+type promRawRangeQuery struct {
+	Data struct {
+		Result []struct {
+			Values [][]interface{} `json:"values"`
+		} `json:"result"`
+	} `json:"data"`
+}
+
+// parseRangeSeries parses the values of a range query that evaluates to at most one series
+func parseRangeSeries(rawQuery []byte) ([]MetricPoint, error) {
+	rawQueryObj := &promRawRangeQuery{}
+
+	err := json.Unmarshal(rawQuery, rawQueryObj)
+	if err != nil {
+		return nil, err
+	}
+
+	points := []MetricPoint{}
+
+	if len(rawQueryObj.Data.Result) == 0 {
+		return points, nil
+	}
+
+	for _, value := range rawQueryObj.Data.Result[0].Values {
+		if len(value) != 2 {
+			continue
+		}
+
+		timestamp, ok := value[0].(float64)
+		if !ok {
+			return nil, fmt.Errorf("unexpected timestamp type in prometheus result")
+		}
+
+		strVal, ok := value[1].(string)
+		if !ok {
+			return nil, fmt.Errorf("unexpected value type in prometheus result")
+		}
+
+		val, err := strconv.ParseFloat(strVal, 64)
+		if err != nil {
+			return nil, fmt.Errorf("error parsing prometheus result: %w", err)
+		}
+
+		// quantiles of ranges without requests are NaN
+		if math.IsNaN(val) || math.IsInf(val, 0) {
+			continue
+		}
+
+		points = append(points, MetricPoint{
+			Timestamp: int64(timestamp),
+			Value:     val,
+		})
+	}
+
+	return points, nil
+}
@@ -53,3 +53,51 @@ func Test_getNginxStatusQuery(t *testing.T) {
 		})
 	}
 }
+
+func Test_parseInstantScalar(t *testing.T) {
+	val, found, err := parseInstantScalar([]byte(`{"status":"success","data":{"resultType":"vector","result":[{"metric":{},"value":[1700000000.123,"0.25"]}]}}`))
+	assert.NoError(t, err)
+	assert.True(t, found)
+	assert.Equal(t, 0.25, val)
+
+	_, found, err = parseInstantScalar([]byte(`{"status":"success","data":{"resultType":"vector","result":[]}}`))
+	assert.NoError(t, err)
+	assert.False(t, found)
+
+	_, _, err = parseInstantScalar([]byte(`{"status":"success","data":{"resultType":"vector","result":[{"metric":{},"value":[1700000000.123,"NaN?"]}]}}`))
+	assert.Error(t, err)
+}
+
+func Test_getAppMetricQuery(t *testing.T) {
+	opts := &AppMetricOpts{
+		Namespace:   "default",
+		PodRegex:    "my-app-web-.*",
+		ServiceName: "my-app-web",
+	}
+
+	query, err := getAppMetricQuery(AppMetric_CPU, opts)
+	assert.NoError(t, err)
+	assert.Equal(t, `sum(rate(container_cpu_usage_seconds_total{namespace="default",pod=~"my-app-web-.*",container!="POD",container!=""}[5m]))`, query)
+
+	query, err = getAppMetricQuery(AppMetric_NetworkReceive, opts)
+	assert.NoError(t, err)
+	assert.Equal(t, `sum(rate(container_network_receive_bytes_total{namespace="default",pod=~"my-app-web-.*"}[5m]))`, query)
+
+	query, err = getAppMetricQuery(AppMetric_LatencyP95, opts)
+	assert.NoError(t, err)
+	assert.Contains(t, query, `histogram_quantile(0.95, `)
+	assert.Contains(t, query, `exported_namespace="default",service="my-app-web"`)
+
+	_, err = getAppMetricQuery("disk", opts)
+	assert.Error(t, err)
+}
+
+func Test_parseRangeSeries(t *testing.T) {
+	points, err := parseRangeSeries([]byte(`{"status":"success","data":{"resultType":"matrix","result":[{"metric":{},"values":[[1700000000,"0.25"],[1700000060,"NaN"],[1700000120,"0.5"]]}]}}`))
+	assert.NoError(t, err)
+	assert.Equal(t, []MetricPoint{{Timestamp: 1700000000, Value: 0.25}, {Timestamp: 1700000120, Value: 0.5}}, points)
+
+	points, err = parseRangeSeries([]byte(`{"status":"success","data":{"resultType":"matrix","result":[]}}`))
+	assert.NoError(t, err)
+	assert.Empty(t, points)
+}
@@ -0,0 +1,116 @@
+package prometheus
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"math"
+	"strconv"
+	"time"
+
+	v1 "k8s.io/api/core/v1"
+	"k8s.io/client-go/kubernetes"
+)
+
+// UsageOpts selects the containers and the time window of a resource usage query
+type UsageOpts struct {
+	Namespace string
+	// PodRegex selects the pods of a single service
+	PodRegex string
+	Window   time.Duration
+}
+
+// GetCPUUsageQuantile returns the given quantile of the CPU usage of the busiest container over the window, in cores.
+// The second return value is false if prometheus has no samples for the containers.
+func GetCPUUsageQuantile(clientset kubernetes.Interface, service *v1.Service, opts *UsageOpts, quantile float64) (float64, bool, error) {
+	query := fmt.Sprintf(
+		`max(quantile_over_time(%f, rate(container_cpu_usage_seconds_total{%s}[5m])[%s:5m]))`,
+		quantile, getUsageContainerSelector(opts), promDuration(opts.Window),
+	)
+
+	return queryInstantScalar(clientset, service, query)
+}
+
+// GetPeakMemoryUsage returns the peak working set memory of the busiest container over the window, in bytes.
+// The second return value is false if prometheus has no samples for the containers.
+func GetPeakMemoryUsage(clientset kubernetes.Interface, service *v1.Service, opts *UsageOpts) (float64, bool, error) {
+	query := fmt.Sprintf(
+		`max(max_over_time(container_memory_working_set_bytes{%s}[%s]))`,
+		getUsageContainerSelector(opts), promDuration(opts.Window),
+	)
+
+	return queryInstantScalar(clientset, service, query)
+}
+
+func getUsageContainerSelector(opts *UsageOpts) string {
+	return fmt.Sprintf(`namespace="%s",pod=~"%s",container!="POD",container!=""`, opts.Namespace, opts.PodRegex)
+}
+
+// promDuration formats a duration in whole minutes, which prometheus accepts in range selectors
+func promDuration(d time.Duration) string {
+	minutes := int(d.Minutes())
+	if minutes < 1 {
+		minutes = 1
+	}
+
+	return fmt.Sprintf("%dm", minutes)
+}
+
+type promRawInstantQuery struct {
+	Data struct {
+		Result []struct {
+			Value []interface{} `json:"value"`
+		} `json:"result"`
+	} `json:"data"`
+}
+
+// queryInstantScalar runs an instant query that evaluates to at most one series and returns its value
+func queryInstantScalar(clientset kubernetes.Interface, service *v1.Service, query string) (float64, bool, error) {
+	if len(service.Spec.Ports) == 0 {
+		return 0, false, fmt.Errorf("prometheus service has no exposed ports to query")
+	}
+
+	resp := clientset.CoreV1().Services(service.Namespace).ProxyGet(
+		"http",
+		service.Name,
+		fmt.Sprintf("%d", service.Spec.Ports[0].Port),
+		"/api/v1/query",
+		map[string]string{"query": query},
+	)
+
+	rawQuery, err := resp.DoRaw(context.TODO())
+	if err != nil {
+		return 0, false, err
+	}
+
+	return parseInstantScalar(rawQuery)
+}
+
+func parseInstantScalar(rawQuery []byte) (float64, bool, error) {
+	rawQueryObj := &promRawInstantQuery{}
+
+	err := json.Unmarshal(rawQuery, rawQueryObj)
+	if err != nil {
+		return 0, false, err
+	}
+
+	if len(rawQueryObj.Data.Result) == 0 || len(rawQueryObj.Data.Result[0].Value) != 2 {
+		return 0, false, nil
+	}
+
+	strVal, ok := rawQueryObj.Data.Result[0].Value[1].(string)
+	if !ok {
+		return 0, false, fmt.Errorf("unexpected value type in prometheus result")
+	}
+
+	val, err := strconv.ParseFloat(strVal, 64)
+	if err != nil {
+		return 0, false, fmt.Errorf("error parsing prometheus result: %w", err)
+	}
+
+	if math.IsNaN(val) || math.IsInf(val, 0) {
+		return 0, false, nil
+	}
+
+	return val, true, nil
+}
@@ -1,6 +1,7 @@
 package resolver
 
 import (
+	"context"
 	"encoding/base64"
 	"errors"
 	"net/url"
@@ -31,9 +32,10 @@ type CandidateResolver struct {
 
 // ResolveIntegration creates an integration in the DB
 func (rcf *CandidateResolver) ResolveIntegration(
+	ctx context.Context,
 	repo repository.Repository,
 ) error {
-	cc, err := repo.Cluster().ReadClusterCandidate(rcf.ProjectID, rcf.ClusterCandidateID)
+	cc, err := repo.Cluster().ReadClusterCandidate(ctx, rcf.ProjectID, rcf.ClusterCandidateID)
 	if err != nil {
 		return err
 	}
@@ -58,19 +60,21 @@ func (rcf *CandidateResolver) ResolveIntegration(
 
 	switch cc.AuthMechanism {
 	case models.X509:
-		id, err = rcf.resolveX509(repo, authInfo)
+		id, err = rcf.resolveX509(ctx, repo, authInfo)
 	case models.Bearer:
-		id, err = rcf.resolveToken(repo, authInfo)
+		id, err = rcf.resolveToken(ctx, repo, authInfo)
 	case models.Basic:
-		id, err = rcf.resolveBasic(repo, authInfo)
+		id, err = rcf.resolveBasic(ctx, repo, authInfo)
 	case models.Local:
-		id, err = rcf.resolveLocal(repo, authInfo)
+		id, err = rcf.resolveLocal(ctx, repo, authInfo)
 	case models.OIDC:
-		id, err = rcf.resolveOIDC(repo, authInfo)
+		id, err = rcf.resolveOIDC(ctx, repo, authInfo)
 	case models.GCP:
-		id, err = rcf.resolveGCP(repo, authInfo)
+		id, err = rcf.resolveGCP(ctx, repo, authInfo)
 	case models.AWS:
-		id, err = rcf.resolveAWS(repo, authInfo)
+		id, err = rcf.resolveAWS(ctx, repo, authInfo)
+	case models.DO:
+		id, err = rcf.resolveDO(ctx, repo, authInfo)
 	}
 
 	if err != nil {
@@ -83,6 +87,7 @@ func (rcf *CandidateResolver) ResolveIntegration(
 }
 
 func (rcf *CandidateResolver) resolveX509(
+	ctx context.Context,
 	repo repository.Repository,
 	authInfo *api.AuthInfo,
 ) (uint, error) {
@@ -126,7 +131,7 @@ func (rcf *CandidateResolver) resolveX509(
 	}
 
 	// return integration id if exists
-	ki, err := repo.KubeIntegration().CreateKubeIntegration(ki)
+	ki, err := repo.KubeIntegration().CreateKubeIntegration(ctx, ki)
 	if err != nil {
 		return 0, err
 	}
@@ -135,6 +140,7 @@ func (rcf *CandidateResolver) resolveX509(
 }
 
 func (rcf *CandidateResolver) resolveToken(
+	ctx context.Context,
 	repo repository.Repository,
 	authInfo *api.AuthInfo,
 ) (uint, error) {
@@ -160,7 +166,7 @@ func (rcf *CandidateResolver) resolveToken(
 	}
 
 	// return integration id if exists
-	ki, err := repo.KubeIntegration().CreateKubeIntegration(ki)
+	ki, err := repo.KubeIntegration().CreateKubeIntegration(ctx, ki)
 	if err != nil {
 		return 0, err
 	}
@@ -169,6 +175,7 @@ func (rcf *CandidateResolver) resolveToken(
 }
 
 func (rcf *CandidateResolver) resolveBasic(
+	ctx context.Context,
 	repo repository.Repository,
 	authInfo *api.AuthInfo,
 ) (uint, error) {
@@ -191,7 +198,7 @@ func (rcf *CandidateResolver) resolveBasic(
 	}
 
 	// return integration id if exists
-	ki, err := repo.KubeIntegration().CreateKubeIntegration(ki)
+	ki, err := repo.KubeIntegration().CreateKubeIntegration(ctx, ki)
 	if err != nil {
 		return 0, err
 	}
@@ -200,6 +207,7 @@ func (rcf *CandidateResolver) resolveBasic(
 }
 
 func (rcf *CandidateResolver) resolveLocal(
+	ctx context.Context,
 	repo repository.Repository,
 	authInfo *api.AuthInfo,
 ) (uint, error) {
@@ -211,7 +219,7 @@ func (rcf *CandidateResolver) resolveLocal(
 	}
 
 	// return integration id if exists
-	ki, err := repo.KubeIntegration().CreateKubeIntegration(ki)
+	ki, err := repo.KubeIntegration().CreateKubeIntegration(ctx, ki)
 	if err != nil {
 		return 0, err
 	}
@@ -220,6 +228,7 @@ func (rcf *CandidateResolver) resolveLocal(
 }
 
 func (rcf *CandidateResolver) resolveOIDC(
+	ctx context.Context,
 	repo repository.Repository,
 	authInfo *api.AuthInfo,
 ) (uint, error) {
@@ -265,7 +274,7 @@ func (rcf *CandidateResolver) resolveOIDC(
 	}
 
 	// return integration id if exists
-	oidc, err := repo.OIDCIntegration().CreateOIDCIntegration(oidc)
+	oidc, err := repo.OIDCIntegration().CreateOIDCIntegration(ctx, oidc)
 	if err != nil {
 		return 0, err
 	}
@@ -274,6 +283,7 @@ func (rcf *CandidateResolver) resolveOIDC(
 }
 
 func (rcf *CandidateResolver) resolveGCP(
+	ctx context.Context,
 	repo repository.Repository,
 	authInfo *api.AuthInfo,
 ) (uint, error) {
@@ -294,7 +304,7 @@ func (rcf *CandidateResolver) resolveGCP(
 	}
 
 	// return integration id if exists
-	gcp, err := repo.GCPIntegration().CreateGCPIntegration(gcp)
+	gcp, err := repo.GCPIntegration().CreateGCPIntegration(ctx, gcp)
 	if err != nil {
 		return 0, err
 	}
@@ -303,6 +313,7 @@ func (rcf *CandidateResolver) resolveGCP(
 }
 
 func (rcf *CandidateResolver) resolveAWS(
+	ctx context.Context,
 	repo repository.Repository,
 	authInfo *api.AuthInfo,
 ) (uint, error) {
@@ -332,7 +343,7 @@ func (rcf *CandidateResolver) resolveAWS(
 	}
 
 	// return integration id if exists
-	aws, err := repo.AWSIntegration().CreateAWSIntegration(aws)
+	aws, err := repo.AWSIntegration().CreateAWSIntegration(ctx, aws)
 	if err != nil {
 		return 0, err
 	}
@@ -340,9 +351,33 @@ func (rcf *CandidateResolver) resolveAWS(
 	return aws.Model.ID, nil
 }
 
+func (rcf *CandidateResolver) resolveDO(
+	ctx context.Context,
+	repo repository.Repository,
+	authInfo *api.AuthInfo,
+) (uint, error) {
+	// DOKS clusters authenticate with the access token of a DigitalOcean OAuth integration,
+	// which is refreshed when the cluster is accessed
+	if rcf.Resolver.DOIntegrationID == 0 {
+		return 0, errors.New("could not resolve do integration")
+	}
+
+	oauthInt, err := repo.OAuthIntegration().ReadOAuthIntegration(ctx, rcf.ProjectID, rcf.Resolver.DOIntegrationID)
+	if err != nil {
+		return 0, err
+	}
+
+	if oauthInt.Client != types.OAuthDigitalOcean {
+		return 0, errors.New("oauth integration is not a digitalocean integration")
+	}
+
+	return oauthInt.Model.ID, nil
+}
+
 // ResolveCluster writes a new cluster to the DB -- this must be called after
 // rcf.ResolveIntegration, since it relies on the previously created integration.
 func (rcf *CandidateResolver) ResolveCluster(
+	ctx context.Context,
 	repo repository.Repository,
 ) (*models.Cluster, error) {
 	// build a cluster from the candidate
@@ -352,7 +387,7 @@ func (rcf *CandidateResolver) ResolveCluster(
 	}
 
 	// save cluster to db
-	return repo.Cluster().CreateCluster(cluster)
+	return repo.Cluster().CreateCluster(ctx, cluster)
 }
 
 func (rcf *CandidateResolver) buildCluster() (*models.Cluster, error) {
@@ -422,6 +457,8 @@ func (rcf *CandidateResolver) buildCluster() (*models.Cluster, error) {
 		cluster.GCPIntegrationID = rcf.integrationID
 	case models.AWS:
 		cluster.AWSIntegrationID = rcf.integrationID
+	case models.DO:
+		cluster.DOIntegrationID = rcf.integrationID
 	}
 
 	return cluster, nil
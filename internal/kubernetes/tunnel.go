@@ -0,0 +1,214 @@
+package kubernetes
+
+import (
+	"context"
+	goerrors "errors"
+	"fmt"
+	"io"
+	"net/http"
+	"strconv"
+
+	"github.com/porter-dev/porter/api/server/shared/websocket"
+	v1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/util/intstr"
+	"k8s.io/client-go/tools/portforward"
+	"k8s.io/client-go/transport/spdy"
+)
+
+// tunnelBufferSize is the size of the chunks read from a forwarded port and written to the websocket
+const tunnelBufferSize = 32 * 1024
+
+// ResolveServicePort returns a ready pod which backs a service, along with the container port of the pod which the
+// given service port targets. Services without a selector, such as external name services, cannot be resolved.
+func (a *Agent) ResolveServicePort(ctx context.Context, namespace, name string, port int32) (*v1.Pod, int32, error) {
+	service, err := a.Clientset.CoreV1().Services(namespace).Get(ctx, name, metav1.GetOptions{})
+	if err != nil && errors.IsNotFound(err) {
+		return nil, 0, IsNotFoundError
+	} else if err != nil {
+		return nil, 0, fmt.Errorf("error getting service %s: %w", name, err)
+	}
+
+	if len(service.Spec.Selector) == 0 {
+		return nil, 0, &BadRequestError{fmt.Sprintf("service %s does not select any pods", name)}
+	}
+
+	var servicePort *v1.ServicePort
+	for i := range service.Spec.Ports {
+		if service.Spec.Ports[i].Port == port {
+			servicePort = &service.Spec.Ports[i]
+			break
+		}
+	}
+
+	if servicePort == nil {
+		return nil, 0, &BadRequestError{fmt.Sprintf("service %s does not expose port %d", name, port)}
+	}
+
+	pods, err := a.Clientset.CoreV1().Pods(namespace).List(ctx, metav1.ListOptions{
+		LabelSelector: labels.SelectorFromSet(service.Spec.Selector).String(),
+	})
+	if err != nil {
+		return nil, 0, fmt.Errorf("error listing pods of service %s: %w", name, err)
+	}
+
+	var pod *v1.Pod
+	for i := range pods.Items {
+		if isTunnelablePod(&pods.Items[i]) {
+			pod = &pods.Items[i]
+			break
+		}
+	}
+
+	if pod == nil {
+		return nil, 0, &BadRequestError{fmt.Sprintf("service %s has no ready pods", name)}
+	}
+
+	switch {
+	case servicePort.TargetPort.Type == intstr.String:
+		for _, container := range pod.Spec.Containers {
+			for _, containerPort := range container.Ports {
+				if containerPort.Name == servicePort.TargetPort.StrVal {
+					return pod, containerPort.ContainerPort, nil
+				}
+			}
+		}
+
+		return nil, 0, &BadRequestError{fmt.Sprintf("pod %s does not have a port named %s", pod.Name, servicePort.TargetPort.StrVal)}
+	case servicePort.TargetPort.IntVal != 0:
+		return pod, servicePort.TargetPort.IntVal, nil
+	default:
+		return pod, servicePort.Port, nil
+	}
+}
+
+// TunnelToService forwards a websocket connection to a port of a service, through a ready pod which backs the service.
+// Binary messages read from the websocket are written to the port and data read from the port is written back as binary
+// messages, until either side closes the connection.
+func (a *Agent) TunnelToService(ctx context.Context, namespace, name string, port int32, rw *websocket.WebsocketSafeReadWriter) error {
+	pod, targetPort, err := a.ResolveServicePort(ctx, namespace, name, port)
+	if err != nil {
+		return err
+	}
+
+	restConf, err := a.RESTClientGetter.ToRESTConfig()
+	if err != nil {
+		return fmt.Errorf("error getting rest config: %w", err)
+	}
+
+	transport, upgrader, err := spdy.RoundTripperFor(restConf)
+	if err != nil {
+		return fmt.Errorf("error creating port forward transport: %w", err)
+	}
+
+	req := a.Clientset.CoreV1().RESTClient().Post().
+		Resource("pods").
+		Namespace(pod.Namespace).
+		Name(pod.Name).
+		SubResource("portforward")
+
+	dialer := spdy.NewDialer(upgrader, &http.Client{Transport: transport}, http.MethodPost, req.URL())
+
+	streamConn, _, err := dialer.Dial(portforward.PortForwardProtocolV1Name)
+	if err != nil {
+		return fmt.Errorf("error connecting to pod %s: %w", pod.Name, err)
+	}
+	defer streamConn.Close()
+
+	headers := http.Header{}
+	headers.Set(v1.StreamType, v1.StreamTypeError)
+	headers.Set(v1.PortHeader, strconv.Itoa(int(targetPort)))
+	headers.Set(v1.PortForwardRequestIDHeader, "0")
+
+	errorStream, err := streamConn.CreateStream(headers)
+	if err != nil {
+		return fmt.Errorf("error creating error stream to pod %s: %w", pod.Name, err)
+	}
+	// the error stream is only read from
+	errorStream.Close()
+
+	headers.Set(v1.StreamType, v1.StreamTypeData)
+
+	dataStream, err := streamConn.CreateStream(headers)
+	if err != nil {
+		return fmt.Errorf("error creating data stream to pod %s: %w", pod.Name, err)
+	}
+
+	// every goroutine sends at most one value, so none of them block once the tunnel is closed
+	errorchan := make(chan error, 3)
+
+	go func() {
+		message, err := io.ReadAll(errorStream)
+		if err != nil {
+			errorchan <- fmt.Errorf("error reading error stream of pod %s: %w", pod.Name, err)
+		} else if len(message) > 0 {
+			errorchan <- fmt.Errorf("error forwarding port %d of pod %s: %s", targetPort, pod.Name, string(message))
+		}
+	}()
+
+	go func() {
+		// listens for websocket closing handshake
+		for {
+			_, data, err := rw.ReadMessage()
+			if err != nil {
+				errorchan <- nil
+				return
+			}
+
+			if _, err := dataStream.Write(data); err != nil {
+				errorchan <- fmt.Errorf("error writing to pod %s: %w", pod.Name, err)
+				return
+			}
+		}
+	}()
+
+	go func() {
+		buf := make([]byte, tunnelBufferSize)
+
+		for {
+			n, err := dataStream.Read(buf)
+			if n > 0 {
+				if _, writeErr := rw.WriteBinary(buf[:n]); writeErr != nil {
+					errorchan <- writeErr
+					return
+				}
+			}
+
+			if err != nil {
+				if goerrors.Is(err, io.EOF) {
+					errorchan <- nil
+				} else {
+					errorchan <- fmt.Errorf("error reading from pod %s: %w", pod.Name, err)
+				}
+				return
+			}
+		}
+	}()
+
+	select {
+	case err = <-errorchan:
+	case <-ctx.Done():
+	}
+
+	rw.Close()
+	dataStream.Close()
+	streamConn.RemoveStreams(dataStream, errorStream)
+
+	return err
+}
+
+func isTunnelablePod(pod *v1.Pod) bool {
+	if pod.DeletionTimestamp != nil || pod.Status.Phase != v1.PodRunning {
+		return false
+	}
+
+	for _, condition := range pod.Status.Conditions {
+		if condition.Type == v1.PodReady {
+			return condition.Status == v1.ConditionTrue
+		}
+	}
+
+	return false
+}
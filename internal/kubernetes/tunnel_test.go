@@ -0,0 +1,108 @@
+package kubernetes_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/porter-dev/porter/internal/kubernetes"
+	v1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/intstr"
+)
+
+func tunnelPods() []*v1.Pod {
+	ready := []v1.PodCondition{{Type: v1.PodReady, Status: v1.ConditionTrue}}
+
+	return []*v1.Pod{
+		{
+			ObjectMeta: metav1.ObjectMeta{Name: "db-pending", Namespace: "default", Labels: map[string]string{"app": "db"}},
+			Status:     v1.PodStatus{Phase: v1.PodPending},
+		},
+		{
+			ObjectMeta: metav1.ObjectMeta{Name: "db-ready", Namespace: "default", Labels: map[string]string{"app": "db"}},
+			Spec: v1.PodSpec{
+				Containers: []v1.Container{{
+					Name:  "postgres",
+					Ports: []v1.ContainerPort{{Name: "postgres", ContainerPort: 5432}},
+				}},
+			},
+			Status: v1.PodStatus{Phase: v1.PodRunning, Conditions: ready},
+		},
+	}
+}
+
+func newTunnelAgent(t *testing.T, targetPort intstr.IntOrString, selector map[string]string) *kubernetes.Agent {
+	t.Helper()
+
+	service := &v1.Service{
+		ObjectMeta: metav1.ObjectMeta{Name: "db", Namespace: "default"},
+		Spec: v1.ServiceSpec{
+			Selector: selector,
+			Ports:    []v1.ServicePort{{Port: 6543, TargetPort: targetPort}},
+		},
+	}
+
+	pods := tunnelPods()
+
+	return newAgentFixture(t, service, pods[0], pods[1])
+}
+
+func TestResolveServicePort(t *testing.T) {
+	tests := []struct {
+		name       string
+		targetPort intstr.IntOrString
+		wantPort   int32
+	}{
+		{name: "numeric target port", targetPort: intstr.FromInt(5433), wantPort: 5433},
+		{name: "named target port", targetPort: intstr.FromString("postgres"), wantPort: 5432},
+		{name: "unset target port", targetPort: intstr.IntOrString{}, wantPort: 6543},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			agent := newTunnelAgent(t, tt.targetPort, map[string]string{"app": "db"})
+
+			pod, port, err := agent.ResolveServicePort(context.Background(), "default", "db", 6543)
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+
+			if pod.Name != "db-ready" {
+				t.Errorf("expected pod db-ready, got %s", pod.Name)
+			}
+
+			if port != tt.wantPort {
+				t.Errorf("expected port %d, got %d", tt.wantPort, port)
+			}
+		})
+	}
+}
+
+func TestResolveServicePortErrors(t *testing.T) {
+	agent := newTunnelAgent(t, intstr.FromString("mysql"), map[string]string{"app": "db"})
+
+	_, _, err := agent.ResolveServicePort(context.Background(), "default", "missing", 6543)
+	if !errors.Is(err, kubernetes.IsNotFoundError) {
+		t.Errorf("expected not found error for missing service, got %v", err)
+	}
+
+	var badRequest *kubernetes.BadRequestError
+
+	_, _, err = agent.ResolveServicePort(context.Background(), "default", "db", 80)
+	if !errors.As(err, &badRequest) {
+		t.Errorf("expected bad request error for unexposed port, got %v", err)
+	}
+
+	_, _, err = agent.ResolveServicePort(context.Background(), "default", "db", 6543)
+	if !errors.As(err, &badRequest) {
+		t.Errorf("expected bad request error for unknown named port, got %v", err)
+	}
+
+	agent = newTunnelAgent(t, intstr.FromInt(5432), nil)
+
+	_, _, err = agent.ResolveServicePort(context.Background(), "default", "db", 6543)
+	if !errors.As(err, &badRequest) {
+		t.Errorf("expected bad request error for service without selector, got %v", err)
+	}
+}
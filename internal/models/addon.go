@@ -0,0 +1,93 @@
+package models
+
+import (
+	"encoding/json"
+
+	"github.com/porter-dev/porter/api/types"
+	"gorm.io/gorm"
+)
+
+// Addon is a curated chart from the addon marketplace installed into a cluster
+type Addon struct {
+	gorm.Model
+
+	ProjectID uint
+	ClusterID uint
+
+	// Name is the name of the addon and of its Helm release
+	Name      string
+	Namespace string
+
+	Type types.AddonType
+
+	// ChartVersion is empty if the addon was installed with the latest version of the chart
+	ChartVersion string
+
+	// ------------------------------------------------------------------
+	// All fields below encrypted before storage.
+	// ------------------------------------------------------------------
+
+	// Inputs are the JSON encoded values of the install form, which may hold passwords
+	Inputs []byte
+
+	// Values are the JSON encoded raw Helm values which override the values set by the inputs
+	Values []byte
+}
+
+// AddonInputs decodes the inputs of the addon
+func (a *Addon) AddonInputs() (map[string]string, error) {
+	inputs := make(map[string]string)
+
+	if len(a.Inputs) == 0 {
+		return inputs, nil
+	}
+
+	if err := json.Unmarshal(a.Inputs, &inputs); err != nil {
+		return nil, err
+	}
+
+	return inputs, nil
+}
+
+// AddonValues decodes the raw Helm values of the addon
+func (a *Addon) AddonValues() (map[string]interface{}, error) {
+	values := make(map[string]interface{})
+
+	if len(a.Values) == 0 {
+		return values, nil
+	}
+
+	if err := json.Unmarshal(a.Values, &values); err != nil {
+		return nil, err
+	}
+
+	return values, nil
+}
+
+// ToAddonType generates an external types.Addon to be shared over REST. The inputs whose keys are
+// in secretKeys are left out.
+func (a *Addon) ToAddonType(secretKeys map[string]bool) (*types.Addon, error) {
+	inputs, err := a.AddonInputs()
+	if err != nil {
+		return nil, err
+	}
+
+	for key := range inputs {
+		if secretKeys[key] {
+			delete(inputs, key)
+		}
+	}
+
+	return &types.Addon{
+		ID:           a.ID,
+		ProjectID:    a.ProjectID,
+		ClusterID:    a.ClusterID,
+		Name:         a.Name,
+		Namespace:    a.Namespace,
+		Type:         a.Type,
+		ChartVersion: a.ChartVersion,
+		Inputs:       inputs,
+		CreatedAt:    a.CreatedAt,
+		UpdatedAt:    a.UpdatedAt,
+	}, nil
+}
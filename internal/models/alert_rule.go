@@ -0,0 +1,63 @@
+package models
+
+import (
+	"strings"
+
+	"github.com/porter-dev/porter/api/types"
+	"gorm.io/gorm"
+)
+
+// AlertRule sends notifications for the kube events of a project which match it
+type AlertRule struct {
+	gorm.Model
+
+	// ProjectID is the ID of the project that the rule belongs to
+	ProjectID uint
+
+	Name string
+
+	// Severity is the minimum severity of the events which match the rule
+	Severity types.KubeEventSeverity
+
+	// (optional) ResourceType and Namespace restrict the rule to the events of a resource type or namespace
+	ResourceType string
+	Namespace    string
+
+	// NotifyWebhooks sends matched events to the project webhooks subscribed to kube_event.alert
+	NotifyWebhooks bool
+
+	// NotifySlack sends matched events to the Slack integrations of the project
+	NotifySlack bool
+}
+
+// Matches returns true if a sub event of an event matches the rule
+func (a *AlertRule) Matches(event *KubeEvent, subEvent *KubeSubEvent) bool {
+	if event.ProjectID != a.ProjectID || !subEvent.Severity.AtLeast(a.Severity) {
+		return false
+	}
+
+	if a.ResourceType != "" && !strings.EqualFold(a.ResourceType, event.ResourceType) {
+		return false
+	}
+
+	if a.Namespace != "" && !strings.EqualFold(a.Namespace, event.Namespace) {
+		return false
+	}
+
+	return true
+}
+
+// ToAlertRuleType generates an external types.AlertRule to be shared over REST
+func (a *AlertRule) ToAlertRuleType() *types.AlertRule {
+	return &types.AlertRule{
+		ID:             a.ID,
+		ProjectID:      a.ProjectID,
+		Name:           a.Name,
+		CreatedAt:      a.CreatedAt,
+		Severity:       a.Severity,
+		ResourceType:   a.ResourceType,
+		Namespace:      a.Namespace,
+		NotifyWebhooks: a.NotifyWebhooks,
+		NotifySlack:    a.NotifySlack,
+	}
+}
@@ -2,9 +2,13 @@ package models
 
 import (
 	"database/sql/driver"
+	"encoding/base64"
 	"encoding/json"
+	"fmt"
 
 	"github.com/google/uuid"
+	"github.com/porter-dev/api-contracts/generated/go/helpers"
+	porterv1 "github.com/porter-dev/api-contracts/generated/go/porter/v1"
 	"gorm.io/gorm"
 )
 
@@ -51,6 +55,22 @@ func (APIContractRevision) TableName() string {
 	return "api_contract_revisions"
 }
 
+// Contract decodes the contract of the revision
+func (r APIContractRevision) Contract() (*porterv1.Contract, error) {
+	decoded, err := base64.StdEncoding.DecodeString(r.Base64Contract)
+	if err != nil {
+		return nil, fmt.Errorf("error decoding base64 contract: %w", err)
+	}
+
+	contract := &porterv1.Contract{}
+
+	if err := helpers.UnmarshalContractObject(decoded, contract); err != nil {
+		return nil, fmt.Errorf("error unmarshalling contract: %w", err)
+	}
+
+	return contract, nil
+}
+
 // JSONB implements the jsonb type in postgres for gorm
 type JSONB map[string]any
 
@@ -0,0 +1,16 @@
+package models
+
+import "gorm.io/gorm"
+
+// AppResourceRequest is the total cpu and memory requested by the services of an app on a deployment target, as of the last
+// time the app was applied. It is used to enforce the resource quotas of a project.
+type AppResourceRequest struct {
+	gorm.Model
+
+	ProjectID          uint   `gorm:"index"`
+	PorterAppID        uint   `gorm:"uniqueIndex:idx_app_resource_request"`
+	DeploymentTargetID string `gorm:"uniqueIndex:idx_app_resource_request"`
+
+	CPUCores     float64
+	RAMMegabytes int64
+}
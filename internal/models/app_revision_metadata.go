@@ -0,0 +1,31 @@
+package models
+
+import (
+	"gorm.io/gorm"
+)
+
+// AppRevisionMetadata holds details of an app revision which are not stored by the cluster control plane, such as who deployed it
+type AppRevisionMetadata struct {
+	gorm.Model
+
+	PorterAppID        uint   `json:"porter_app_id"`
+	DeploymentTargetID string `json:"deployment_target_id"`
+
+	// AppRevisionID is the id of the revision in the cluster control plane
+	AppRevisionID string `json:"app_revision_id" gorm:"index"`
+
+	// RevisionNumber is set once the revision is deployed, since revisions are only listed by number
+	RevisionNumber uint64 `json:"revision_number"`
+
+	// DeployedBy is the email of the user who applied the revision, or the system process which applied it
+	DeployedBy string `json:"deployed_by"`
+
+	// CommitSHA is the commit the revision was built from, if it was applied from a git repository
+	CommitSHA string `json:"commit_sha"`
+
+	// GitBranch is the branch the revision was applied from, if it was applied from a git repository
+	GitBranch string `json:"git_branch"`
+
+	// GitRepoURL is the URL of the repository the revision was applied from, without credentials
+	GitRepoURL string `json:"git_repo_url"`
+}
@@ -0,0 +1,58 @@
+package models
+
+import (
+	"encoding/base64"
+	"encoding/json"
+
+	"github.com/porter-dev/porter/api/types"
+	"gorm.io/gorm"
+)
+
+// AppTemplate is a parameterized porter.yaml file which new apps in a project can be created from
+type AppTemplate struct {
+	gorm.Model
+
+	ProjectID uint `json:"project_id" gorm:"index"`
+
+	// Name identifies the template in its project, e.g. rails-postgres
+	Name string `json:"name"`
+
+	Description string `json:"description"`
+
+	// PorterYAML is the porter.yaml of the template, with ${var.NAME} expressions for its variables
+	PorterYAML string `json:"porter_yaml" gorm:"type:text"`
+
+	// Variables is the JSON-encoded list of types.AppTemplateVariable of the template
+	Variables []byte `json:"variables"`
+}
+
+// TemplateVariables returns the decoded variables of the template
+func (t *AppTemplate) TemplateVariables() ([]types.AppTemplateVariable, error) {
+	variables := []types.AppTemplateVariable{}
+	if len(t.Variables) == 0 {
+		return variables, nil
+	}
+
+	if err := json.Unmarshal(t.Variables, &variables); err != nil {
+		return nil, err
+	}
+
+	return variables, nil
+}
+
+// ToAppTemplateType generates an external types.AppTemplate to be shared over REST
+func (t *AppTemplate) ToAppTemplateType() (*types.AppTemplate, error) {
+	variables, err := t.TemplateVariables()
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.AppTemplate{
+		ID:            t.ID,
+		ProjectID:     t.ProjectID,
+		Name:          t.Name,
+		Description:   t.Description,
+		B64PorterYAML: base64.StdEncoding.EncodeToString([]byte(t.PorterYAML)),
+		Variables:     variables,
+	}, nil
+}
@@ -2,6 +2,7 @@ package models
 
 import (
 	"encoding/json"
+	"time"
 
 	"github.com/porter-dev/porter/api/types"
 	"github.com/porter-dev/porter/internal/models/integrations"
@@ -163,6 +164,10 @@ type ClusterCandidate struct {
 	// Name of the context that this was created from, if it exists
 	ContextName string `json:"context_name"`
 
+	// ExpiresAt is the time after which the candidate is deleted if it has not been resolved.
+	// Candidates created before expiry was introduced have no expiry.
+	ExpiresAt *time.Time `json:"expires_at"`
+
 	// ------------------------------------------------------------------
 	// All fields below this line are encrypted before storage
 	// ------------------------------------------------------------------
@@ -191,6 +196,7 @@ func (cc *ClusterCandidate) ToClusterCandidateType() *types.ClusterCandidate {
 		ContextName:       cc.ContextName,
 		Resolvers:         resolvers,
 		AWSClusterIDGuess: string(cc.AWSClusterIDGuess),
+		ExpiresAt:         cc.ExpiresAt,
 	}
 }
 
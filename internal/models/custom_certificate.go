@@ -0,0 +1,27 @@
+package models
+
+import (
+	"time"
+
+	"gorm.io/gorm"
+)
+
+// CustomCertificate is an uploaded TLS certificate which is served for a domain of an app instead of the certificate issued by cert-manager
+type CustomCertificate struct {
+	gorm.Model
+
+	ProjectID uint
+	ClusterID uint
+	AppName   string
+	Domain    string
+
+	Issuer   string
+	NotAfter time.Time
+
+	// ------------------------------------------------------------------
+	// All fields below encrypted before storage.
+	// ------------------------------------------------------------------
+
+	Certificate []byte
+	PrivateKey  []byte
+}
@@ -0,0 +1,63 @@
+package models
+
+import (
+	"net"
+	"time"
+
+	"github.com/porter-dev/porter/api/types"
+	"gorm.io/gorm"
+)
+
+// CustomDomain is a domain of a web service of an app, and the verification of its DNS record. Custom
+// domains are created and deleted as the domains in porter.yaml change.
+type CustomDomain struct {
+	gorm.Model
+
+	ProjectID uint
+	ClusterID uint
+	AppName   string
+	Name      string
+
+	// Target is the address of the ingress of the cluster, which the domain must resolve to
+	Target string
+
+	Status        types.CustomDomainStatus
+	StatusMessage string
+	LastCheckedAt *time.Time
+	VerifiedAt    *time.Time
+
+	// DNSProvider is set if Porter manages the record of the domain, with the AWS or GCP integration
+	// of the provider
+	DNSProvider      types.DNSProvider
+	AWSIntegrationID uint
+	GCPIntegrationID uint
+	DNSZone          string
+}
+
+// RecordType returns the type of the DNS record which points the domain to its target
+func (d *CustomDomain) RecordType() string {
+	if d.Target == "" {
+		return ""
+	}
+
+	if net.ParseIP(d.Target) != nil {
+		return "A"
+	}
+
+	return "CNAME"
+}
+
+// ToCustomDomainType generates an external types.CustomDomain to be shared over REST
+func (d *CustomDomain) ToCustomDomainType() types.CustomDomain {
+	return types.CustomDomain{
+		Name:          d.Name,
+		RecordType:    d.RecordType(),
+		Target:        d.Target,
+		Status:        d.Status,
+		StatusMessage: d.StatusMessage,
+		LastCheckedAt: d.LastCheckedAt,
+		VerifiedAt:    d.VerifiedAt,
+		DNSProvider:   d.DNSProvider,
+		DNSZone:       d.DNSZone,
+	}
+}
@@ -0,0 +1,106 @@
+package models
+
+import (
+	"time"
+
+	"github.com/porter-dev/porter/api/types"
+	"gorm.io/gorm"
+)
+
+// Datastore is a managed database instance provisioned with an AWS integration of a project
+type Datastore struct {
+	gorm.Model
+
+	ProjectID        uint
+	AWSIntegrationID uint
+
+	Name          string
+	Region        string
+	Engine        types.DatastoreEngine
+	EngineVersion string
+	InstanceClass string
+	StorageGB     int64
+	DatabaseName  string
+
+	// Status is the last known lifecycle state of the instance
+	Status        types.DatastoreStatus
+	StatusMessage string
+
+	InstanceIdentifier string
+	Endpoint           string
+	Port               int64
+
+	MasterUsername string
+
+	// ------------------------------------------------------------------
+	// All fields below encrypted before storage.
+	// ------------------------------------------------------------------
+
+	MasterPassword []byte
+
+	Links []DatastoreLink
+}
+
+// DatastoreLink injects the connection string of a datastore into the env of an app when the app is deployed
+type DatastoreLink struct {
+	gorm.Model
+
+	DatastoreID uint
+	ProjectID   uint
+	ClusterID   uint
+	AppName     string
+	EnvVar      string
+
+	// Username is the dedicated database user of the app
+	Username string
+
+	// CredentialsRotatedAt is set when the password of the user is rotated
+	CredentialsRotatedAt *time.Time
+
+	// ------------------------------------------------------------------
+	// All fields below encrypted before storage.
+	// ------------------------------------------------------------------
+
+	Password []byte
+}
+
+// ToDatastoreLinkType generates an external types.DatastoreLink to be shared over REST. The password is left out.
+func (l *DatastoreLink) ToDatastoreLinkType() types.DatastoreLink {
+	return types.DatastoreLink{
+		ID:                   l.ID,
+		ClusterID:            l.ClusterID,
+		AppName:              l.AppName,
+		EnvVar:               l.EnvVar,
+		Username:             l.Username,
+		CredentialsRotatedAt: l.CredentialsRotatedAt,
+	}
+}
+
+// ToDatastoreType generates an external types.Datastore to be shared over REST. Credentials are left out.
+func (d *Datastore) ToDatastoreType() *types.Datastore {
+	links := make([]types.DatastoreLink, 0, len(d.Links))
+
+	for _, link := range d.Links {
+		links = append(links, link.ToDatastoreLinkType())
+	}
+
+	return &types.Datastore{
+		ID:                 d.ID,
+		ProjectID:          d.ProjectID,
+		AWSIntegrationID:   d.AWSIntegrationID,
+		Name:               d.Name,
+		Region:             d.Region,
+		Engine:             d.Engine,
+		EngineVersion:      d.EngineVersion,
+		InstanceClass:      d.InstanceClass,
+		StorageGB:          d.StorageGB,
+		DatabaseName:       d.DatabaseName,
+		Status:             d.Status,
+		StatusMessage:      d.StatusMessage,
+		InstanceIdentifier: d.InstanceIdentifier,
+		Endpoint:           d.Endpoint,
+		Port:               d.Port,
+		Links:              links,
+		CreatedAt:          d.CreatedAt,
+	}
+}
@@ -23,4 +23,7 @@ type DeploymentTarget struct {
 
 	// SelectorType is the kind of selector (i.e. NAMESPACE or LABEL).
 	SelectorType string `json:"selector_type"`
+
+	// Preview is true if the target is an ephemeral preview environment.
+	Preview bool `json:"preview"`
 }
@@ -0,0 +1,28 @@
+package models
+
+import (
+	"time"
+
+	"gorm.io/gorm"
+)
+
+// EnvironmentGroupVersion records who created a version of an environment group, and when it was last synced to the applications linked to the group.
+// The variables of each version are stored in the cluster, in the porter-env-group namespace.
+type EnvironmentGroupVersion struct {
+	gorm.Model
+
+	ProjectID uint `json:"project_id"`
+	ClusterID uint `json:"cluster_id"`
+
+	// Name is the environment group name
+	Name string `json:"name"`
+
+	// Version is the version of the environment group in the cluster
+	Version int `json:"version"`
+
+	// CreatedBy is the email of the user who created the version
+	CreatedBy string `json:"created_by"`
+
+	// LinkedAppsSyncedAt is the last time the version was synced to the linked applications
+	LinkedAppsSyncedAt *time.Time `json:"linked_apps_synced_at"`
+}
@@ -9,8 +9,26 @@ type EventContainer struct {
 	gorm.Model
 	ReleaseID uint
 	Steps     []SubEvent
+
+	// PorterAppID is set for containers which hold the build logs of a porter app streamed from the CLI
+	PorterAppID uint
+	// Name is the name of the build, which is the name of the service for services with their own build
+	Name     string
+	ImageTag string
+	// Kind distinguishes the containers of a porter app. Build logs have no kind.
+	Kind EventContainerKind
 }
 
+// EventContainerKind is the kind of the event container of a porter app
+type EventContainerKind string
+
+const (
+	// EventContainerKind_BuildLog containers hold the build output streamed from the CLI
+	EventContainerKind_BuildLog EventContainerKind = ""
+	// EventContainerKind_AutoRollback containers record an automatic rollback of a failed rollout
+	EventContainerKind_AutoRollback EventContainerKind = "auto_rollback"
+)
+
 type SubEvent struct {
 	gorm.Model
 
@@ -0,0 +1,65 @@
+package models
+
+import (
+	"encoding/json"
+
+	"github.com/porter-dev/porter/api/types"
+	"gorm.io/gorm"
+)
+
+// EventSink forwards the kube events of a project to an external observability system
+type EventSink struct {
+	gorm.Model
+
+	// ProjectID is the ID of the project that the sink belongs to
+	ProjectID uint
+
+	Name string
+
+	// Type is the system that events are forwarded to
+	Type types.EventSinkType
+
+	// ------------------------------------------------------------------
+	// All fields below encrypted before storage.
+	// ------------------------------------------------------------------
+
+	// Config is the JSON encoded types.EventSinkConfig of the sink, which holds API keys and passwords
+	Config []byte
+}
+
+// SinkConfig decodes the config of the sink
+func (s *EventSink) SinkConfig() (*types.EventSinkConfig, error) {
+	config := &types.EventSinkConfig{}
+
+	if err := json.Unmarshal(s.Config, config); err != nil {
+		return nil, err
+	}
+
+	return config, nil
+}
+
+// ToEventSinkType generates an external types.EventSink to be shared over REST. API keys and passwords
+// are left out of the config.
+func (s *EventSink) ToEventSinkType() (*types.EventSink, error) {
+	config, err := s.SinkConfig()
+	if err != nil {
+		return nil, err
+	}
+
+	if config.Datadog != nil {
+		config.Datadog.APIKey = ""
+	}
+
+	if config.Loki != nil {
+		config.Loki.Password = ""
+	}
+
+	return &types.EventSink{
+		ID:              s.ID,
+		ProjectID:       s.ProjectID,
+		Name:            s.Name,
+		Type:            s.Type,
+		CreatedAt:       s.CreatedAt,
+		EventSinkConfig: *config,
+	}, nil
+}
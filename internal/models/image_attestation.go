@@ -0,0 +1,95 @@
+package models
+
+import (
+	"github.com/porter-dev/porter/api/types"
+	"github.com/porter-dev/porter/internal/attestation"
+	"gorm.io/gorm"
+)
+
+// ImageAttestation is a signed build provenance attestation of an image built for an app, uploaded by the CLI
+type ImageAttestation struct {
+	gorm.Model
+
+	ProjectID uint   `json:"project_id" gorm:"index"`
+	AppName   string `json:"app_name"`
+
+	// Repository, Tag and Digest identify the attested image
+	Repository string `json:"repository"`
+	Tag        string `json:"tag"`
+	Digest     string `json:"digest"`
+
+	// Reference is where the attestation is stored in the registry, e.g. registry.example.com/app:sha256-abc.att
+	Reference string `json:"reference"`
+
+	// Envelope is the DSSE envelope of the attestation, as JSON
+	Envelope string `json:"envelope" gorm:"type:text"`
+
+	// Certificate and CertificateChain are the PEM-encoded signing certificate of a keyless attestation and its intermediates
+	Certificate      string `json:"certificate" gorm:"type:text"`
+	CertificateChain string `json:"certificate_chain" gorm:"type:text"`
+}
+
+// ToImageAttestationType generates an external types.ImageAttestation to be shared over REST
+func (a *ImageAttestation) ToImageAttestationType() *types.ImageAttestation {
+	return &types.ImageAttestation{
+		ID:         a.ID,
+		CreatedAt:  a.CreatedAt,
+		AppName:    a.AppName,
+		Repository: a.Repository,
+		Tag:        a.Tag,
+		Digest:     a.Digest,
+		Reference:  a.Reference,
+		Keyless:    a.Certificate != "",
+	}
+}
+
+// ToAttestation returns the signed attestation, to be verified against an image signing policy
+func (a *ImageAttestation) ToAttestation() attestation.Attestation {
+	return attestation.Attestation{
+		Envelope:       []byte(a.Envelope),
+		CertificatePEM: a.Certificate,
+		ChainPEM:       a.CertificateChain,
+	}
+}
+
+// ImageSigningPolicy configures how the build provenance attestations of the images of a project are verified, and whether
+// apps can only be deployed with images which have a verified attestation
+type ImageSigningPolicy struct {
+	gorm.Model
+
+	ProjectID uint `json:"project_id" gorm:"uniqueIndex"`
+
+	// Enforce is true if apps are only deployed with images which have a verified attestation
+	Enforce bool `json:"enforce"`
+
+	// PublicKey is the PEM-encoded public key of the key attestations are signed with
+	PublicKey string `json:"public_key" gorm:"type:text"`
+
+	// KeylessIdentity, KeylessIssuer and FulcioRoots verify keyless attestations: their signing certificate must be issued by
+	// one of FulcioRoots to an identity matching the KeylessIdentity regular expression, from the OIDC issuer KeylessIssuer
+	KeylessIdentity string `json:"keyless_identity"`
+	KeylessIssuer   string `json:"keyless_issuer"`
+	FulcioRoots     string `json:"fulcio_roots" gorm:"type:text"`
+}
+
+// ToImageSigningPolicyType generates an external types.ImageSigningPolicy to be shared over REST
+func (p *ImageSigningPolicy) ToImageSigningPolicyType() *types.ImageSigningPolicy {
+	return &types.ImageSigningPolicy{
+		ProjectID:       p.ProjectID,
+		Enforce:         p.Enforce,
+		PublicKey:       p.PublicKey,
+		KeylessIdentity: p.KeylessIdentity,
+		KeylessIssuer:   p.KeylessIssuer,
+		FulcioRoots:     p.FulcioRoots,
+	}
+}
+
+// ToAttestationPolicy returns the policy attestations are verified against
+func (p *ImageSigningPolicy) ToAttestationPolicy() attestation.Policy {
+	return attestation.Policy{
+		PublicKeyPEM: p.PublicKey,
+		Identity:     p.KeylessIdentity,
+		Issuer:       p.KeylessIssuer,
+		RootsPEM:     p.FulcioRoots,
+	}
+}
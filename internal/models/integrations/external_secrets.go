@@ -0,0 +1,94 @@
+package integrations
+
+import (
+	"time"
+
+	"github.com/porter-dev/porter/api/types"
+	"gorm.io/gorm"
+)
+
+// ExternalSecretsIntegration syncs secrets from HashiCorp Vault or Doppler into the secret variables of an env group
+type ExternalSecretsIntegration struct {
+	gorm.Model
+
+	// The id of the user that linked this integration
+	UserID uint `json:"user_id"`
+
+	// The project and cluster that this integration belongs to
+	ProjectID uint `json:"project_id"`
+	ClusterID uint `json:"cluster_id"`
+
+	// Provider is either vault or doppler
+	Provider string `json:"provider"`
+
+	// EnvironmentGroupName is the env group that secrets are synced into
+	EnvironmentGroupName string `json:"environment_group_name"`
+
+	// VaultAddress, VaultNamespace and VaultSecretPath locate the KV secret in Vault
+	VaultAddress    string `json:"vault_address"`
+	VaultNamespace  string `json:"vault_namespace"`
+	VaultSecretPath string `json:"vault_secret_path"`
+
+	// DopplerProject and DopplerConfig locate the config in Doppler
+	DopplerProject string `json:"doppler_project"`
+	DopplerConfig  string `json:"doppler_config"`
+
+	// SyncIntervalMinutes is how often secrets are synced, or 0 to only sync manually or via webhook
+	SyncIntervalMinutes int `json:"sync_interval_minutes"`
+
+	// RedeployLinkedApps syncs each new env group version to the applications linked to the env group
+	RedeployLinkedApps bool `json:"redeploy_linked_apps"`
+
+	// WebhookID identifies the integration in the webhook url
+	WebhookID string `json:"webhook_id" gorm:"index"`
+
+	LastSyncedAt      *time.Time `json:"last_synced_at"`
+	LastSyncedVersion int        `json:"last_synced_version"`
+	LastSyncError     string     `json:"last_sync_error"`
+
+	// ------------------------------------------------------------------
+	// All fields encrypted before storage.
+	// ------------------------------------------------------------------
+
+	// Token is the Vault token or Doppler token used to read secrets
+	Token []byte `json:"token"`
+
+	// WebhookSecret is used to verify the signature of webhook requests
+	WebhookSecret []byte `json:"webhook_secret"`
+}
+
+// SyncDue returns true if the integration syncs on a schedule and has not synced within its interval
+func (e *ExternalSecretsIntegration) SyncDue(now time.Time) bool {
+	if e.SyncIntervalMinutes <= 0 {
+		return false
+	}
+
+	if e.LastSyncedAt == nil {
+		return true
+	}
+
+	return !now.Before(e.LastSyncedAt.Add(time.Duration(e.SyncIntervalMinutes) * time.Minute))
+}
+
+// ToExternalSecretsIntegrationType generates an external types.ExternalSecretsIntegration to be shared over REST
+func (e *ExternalSecretsIntegration) ToExternalSecretsIntegrationType() *types.ExternalSecretsIntegration {
+	return &types.ExternalSecretsIntegration{
+		ID:                   e.ID,
+		CreatedAt:            e.CreatedAt,
+		ProjectID:            e.ProjectID,
+		ClusterID:            e.ClusterID,
+		Provider:             types.ExternalSecretsProvider(e.Provider),
+		EnvironmentGroupName: e.EnvironmentGroupName,
+		VaultAddress:         e.VaultAddress,
+		VaultNamespace:       e.VaultNamespace,
+		VaultSecretPath:      e.VaultSecretPath,
+		DopplerProject:       e.DopplerProject,
+		DopplerConfig:        e.DopplerConfig,
+		SyncIntervalMinutes:  e.SyncIntervalMinutes,
+		RedeployLinkedApps:   e.RedeployLinkedApps,
+		WebhookID:            e.WebhookID,
+		LastSyncedAt:         e.LastSyncedAt,
+		LastSyncedVersion:    e.LastSyncedVersion,
+		LastSyncError:        e.LastSyncError,
+	}
+}
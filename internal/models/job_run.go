@@ -0,0 +1,55 @@
+package models
+
+import (
+	"time"
+
+	"github.com/porter-dev/porter/api/types"
+	"gorm.io/gorm"
+)
+
+// JobRun is a single run of a job service of a porter app, recorded from the job run events reported by the event agent
+type JobRun struct {
+	gorm.Model
+
+	ProjectID   uint `json:"project_id"`
+	ClusterID   uint `json:"cluster_id"`
+	PorterAppID uint `json:"porter_app_id"`
+
+	// ServiceName is the name of the job service in the app
+	ServiceName string `json:"service_name"`
+
+	// Namespace and JobName identify the kubernetes job of the run
+	Namespace string `json:"namespace"`
+	JobName   string `json:"job_name"`
+
+	// PodName is the pod that ran the job, which is used to fetch the logs of the run
+	PodName string `json:"pod_name"`
+
+	// Status is one of the porter app event statuses (PROGRESSING, SUCCESS, FAILED, CANCELED)
+	Status string `json:"status"`
+
+	// ExitCode is the exit code of the job container, if the run has finished
+	ExitCode *int `json:"exit_code"`
+
+	// TriggeredBy is the actor that started the run, such as "cron" or the email of the user who ran it manually
+	TriggeredBy string `json:"triggered_by"`
+
+	StartedAt  time.Time  `json:"started_at"`
+	FinishedAt *time.Time `json:"finished_at"`
+}
+
+// ToJobRunType generates an external types.JobRun to be shared over REST
+func (j *JobRun) ToJobRunType() *types.JobRun {
+	return &types.JobRun{
+		ID:          j.ID,
+		ServiceName: j.ServiceName,
+		JobName:     j.JobName,
+		Namespace:   j.Namespace,
+		PodName:     j.PodName,
+		Status:      j.Status,
+		ExitCode:    j.ExitCode,
+		TriggeredBy: j.TriggeredBy,
+		StartedAt:   j.StartedAt,
+		FinishedAt:  j.FinishedAt,
+	}
+}
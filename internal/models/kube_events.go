@@ -39,10 +39,21 @@ type KubeSubEvent struct {
 	KubeEventID uint
 	Message     string
 	Reason      string
-	Timestamp   time.Time
+
+	// Timestamp is the time of the latest occurrence of the sub event
+	Timestamp time.Time
+
+	// MessageHash is the hash of the message, which identifies repeated occurrences of a sub event
+	MessageHash string
+
+	// Count is the number of occurrences of the sub event which have been aggregated into it
+	Count uint `gorm:"default:1"`
 
 	// The event type, such as "critical" or "normal"
 	EventType types.KubeEventType
+
+	// Severity is computed from the event type and reason when the sub event is stored
+	Severity types.KubeEventSeverity
 }
 
 func (k *KubeSubEvent) ToKubeSubEventType() *types.KubeSubEvent {
@@ -51,6 +62,8 @@ func (k *KubeSubEvent) ToKubeSubEventType() *types.KubeSubEvent {
 		Reason:    k.Reason,
 		Timestamp: k.Timestamp,
 		EventType: k.EventType,
+		Count:     k.Count,
+		Severity:  k.Severity,
 	}
 }
 
@@ -30,6 +30,15 @@ type PorterApp struct {
 
 	// Porter YAML
 	PorterYamlPath string
+
+	// AutoRollback reverts the app to its previous revision when a rollout crash-loops or does not complete within
+	// AutoRollbackTimeoutSeconds
+	AutoRollback               bool
+	AutoRollbackTimeoutSeconds int
+
+	// ConfigRevision is incremented each time the configuration of the app is applied, so that stale writes can be rejected.
+	// It is only updated by IncrementPorterAppConfigRevision.
+	ConfigRevision uint
 }
 
 // ToPorterAppType generates an external types.PorterApp to be shared over REST
@@ -49,6 +58,11 @@ func (a *PorterApp) ToPorterAppType() *types.PorterApp {
 		Dockerfile:     a.Dockerfile,
 		PullRequestURL: a.PullRequestURL,
 		PorterYamlPath: a.PorterYamlPath,
+
+		AutoRollback:               a.AutoRollback,
+		AutoRollbackTimeoutSeconds: a.AutoRollbackTimeoutSeconds,
+
+		ConfigRevision: a.ConfigRevision,
 	}
 }
 
@@ -72,5 +86,10 @@ func (a *PorterApp) ToPorterAppTypeWithRevision(revision int) *types.PorterApp {
 		PullRequestURL:     a.PullRequestURL,
 		PorterYamlPath:     a.PorterYamlPath,
 		HelmRevisionNumber: revision,
+
+		AutoRollback:               a.AutoRollback,
+		AutoRollbackTimeoutSeconds: a.AutoRollbackTimeoutSeconds,
+
+		ConfigRevision: a.ConfigRevision,
 	}
 }
@@ -71,6 +71,17 @@ type Project struct {
 	FullAddOns             bool `gorm:"default:false"`
 	ValidateApplyV2        bool `gorm:"default:false"`
 	EnableReprovision      bool `gorm:"default:false"`
+
+	// quotas on the resources of the project, where 0 means unlimited
+	MaxApps         uint
+	MaxClusters     uint
+	MaxCPUCores     float64
+	MaxRAMMegabytes int64
+
+	// resource presets given to the services of the apps of the project which do not set them, where 0 means unset
+	DefaultCPUCores     float32
+	DefaultRAMMegabytes int
+	DefaultInstances    int
 }
 
 // ToProjectType generates an external types.Project to be shared over REST
@@ -98,5 +109,26 @@ func (p *Project) ToProjectType() *types.Project {
 		EnableReprovision:      p.EnableReprovision,
 		ValidateApplyV2:        p.ValidateApplyV2,
 		FullAddOns:             p.FullAddOns,
+		Quotas:                 p.ToProjectQuotasType(),
+		ResourcePresets:        p.ToProjectResourcePresetsType(),
+	}
+}
+
+// ToProjectQuotasType generates an external types.ProjectQuotas to be shared over REST
+func (p *Project) ToProjectQuotasType() types.ProjectQuotas {
+	return types.ProjectQuotas{
+		MaxApps:         p.MaxApps,
+		MaxClusters:     p.MaxClusters,
+		MaxCPUCores:     p.MaxCPUCores,
+		MaxRAMMegabytes: p.MaxRAMMegabytes,
+	}
+}
+
+// ToProjectResourcePresetsType generates an external types.ProjectResourcePresets to be shared over REST
+func (p *Project) ToProjectResourcePresetsType() types.ProjectResourcePresets {
+	return types.ProjectResourcePresets{
+		CPUCores:     p.DefaultCPUCores,
+		RAMMegabytes: p.DefaultRAMMegabytes,
+		Instances:    p.DefaultInstances,
 	}
 }
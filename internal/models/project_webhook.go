@@ -0,0 +1,113 @@
+package models
+
+import (
+	"strings"
+
+	"github.com/porter-dev/porter/api/types"
+	"gorm.io/gorm"
+)
+
+// ProjectWebhook is an external endpoint which receives signed payloads for events in a project
+type ProjectWebhook struct {
+	gorm.Model
+
+	// ProjectID is the ID of the project that the webhook belongs to
+	ProjectID uint `json:"project_id"`
+
+	// URL is the endpoint that payloads are posted to
+	URL string `json:"url"`
+
+	// Events is a comma-separated list of the events the webhook is subscribed to.
+	// An empty value subscribes the webhook to all events.
+	Events string `json:"events"`
+
+	// Enabled determines whether payloads are dispatched to this webhook
+	Enabled bool `json:"enabled"`
+
+	// ------------------------------------------------------------------
+	// All fields below encrypted before storage.
+	// ------------------------------------------------------------------
+
+	// Secret is the key used to sign payloads with HMAC-SHA256
+	Secret []byte `json:"-"`
+}
+
+// SubscribedTo returns true if the webhook should receive the given event
+func (w *ProjectWebhook) SubscribedTo(event types.ProjectWebhookEvent) bool {
+	if w.Events == "" {
+		return true
+	}
+
+	for _, e := range strings.Split(w.Events, ",") {
+		if types.ProjectWebhookEvent(e) == event {
+			return true
+		}
+	}
+
+	return false
+}
+
+// ToProjectWebhookType generates an external types.ProjectWebhook to be shared over REST
+func (w *ProjectWebhook) ToProjectWebhookType() *types.ProjectWebhook {
+	events := make([]types.ProjectWebhookEvent, 0)
+
+	if w.Events != "" {
+		for _, e := range strings.Split(w.Events, ",") {
+			events = append(events, types.ProjectWebhookEvent(e))
+		}
+	}
+
+	return &types.ProjectWebhook{
+		ID:        w.ID,
+		ProjectID: w.ProjectID,
+		URL:       w.URL,
+		CreatedAt: w.CreatedAt,
+		Events:    events,
+		Enabled:   w.Enabled,
+	}
+}
+
+// ProjectWebhookDelivery records the outcome of delivering a single payload to a project webhook
+type ProjectWebhookDelivery struct {
+	gorm.Model
+
+	// WebhookID is the ID of the webhook the payload was sent to
+	WebhookID uint `json:"webhook_id"`
+
+	// DeliveryID is a unique identifier for the delivery, shared across retries
+	DeliveryID string `json:"delivery_id"`
+
+	// Event is the event which triggered the delivery
+	Event string `json:"event"`
+
+	// Payload is the JSON body that was sent
+	Payload []byte `json:"payload"`
+
+	// Attempts is the number of times delivery was attempted
+	Attempts int `json:"attempts"`
+
+	// StatusCode is the HTTP status code of the last attempt
+	StatusCode int `json:"status_code"`
+
+	// Success is true if the last attempt received a 2xx response
+	Success bool `json:"success"`
+
+	// Error is the error from the last attempt, if any
+	Error string `json:"error"`
+}
+
+// ToProjectWebhookDeliveryType generates an external types.ProjectWebhookDelivery to be shared over REST
+func (d *ProjectWebhookDelivery) ToProjectWebhookDeliveryType() *types.ProjectWebhookDelivery {
+	return &types.ProjectWebhookDelivery{
+		ID:         d.ID,
+		WebhookID:  d.WebhookID,
+		DeliveryID: d.DeliveryID,
+		Event:      types.ProjectWebhookEvent(d.Event),
+		Attempts:   d.Attempts,
+		StatusCode: d.StatusCode,
+		Success:    d.Success,
+		Error:      d.Error,
+		CreatedAt:  d.CreatedAt,
+		UpdatedAt:  d.UpdatedAt,
+	}
+}
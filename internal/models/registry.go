@@ -56,6 +56,10 @@ func (r *Registry) ToRegistryType() *types.Registry {
 		serv = types.ACR
 	} else if strings.Contains(r.URL, "index.docker.io") {
 		serv = types.DockerHub
+	} else if strings.Contains(r.URL, "ghcr.io") {
+		serv = types.GHCR
+	} else if strings.Contains(r.URL, "quay.io") {
+		serv = types.Quay
 	}
 
 	uri := r.URL
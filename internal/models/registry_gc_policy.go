@@ -0,0 +1,38 @@
+package models
+
+import (
+	"time"
+
+	"github.com/porter-dev/porter/api/types"
+	"gorm.io/gorm"
+)
+
+// RegistryGCPolicy configures which image tags are garbage collected from the registries connected to a project
+type RegistryGCPolicy struct {
+	gorm.Model
+
+	ProjectID uint `json:"project_id" gorm:"uniqueIndex"`
+
+	// Enabled is true if the policy is applied periodically. Garbage collection can always be run manually.
+	Enabled bool `json:"enabled"`
+
+	// KeepLastN is the number of most recently pushed tags which are always kept in each repository
+	KeepLastN uint `json:"keep_last_n"`
+
+	// MaxAgeDays is the age in days after which a tag which is not one of the most recent KeepLastN tags is deleted
+	MaxAgeDays uint `json:"max_age_days"`
+
+	// LastRunAt is the last time the policy was applied by the background job
+	LastRunAt *time.Time `json:"last_run_at"`
+}
+
+// ToRegistryGCPolicyType generates an external types.RegistryGCPolicy to be shared over REST
+func (p *RegistryGCPolicy) ToRegistryGCPolicyType() *types.RegistryGCPolicy {
+	return &types.RegistryGCPolicy{
+		ProjectID:  p.ProjectID,
+		Enabled:    p.Enabled,
+		KeepLastN:  p.KeepLastN,
+		MaxAgeDays: p.MaxAgeDays,
+		LastRunAt:  p.LastRunAt,
+	}
+}
@@ -1,6 +1,9 @@
 package models
 
 import (
+	"strconv"
+	"strings"
+
 	"github.com/porter-dev/porter/api/types"
 	"gorm.io/gorm"
 )
@@ -16,6 +19,14 @@ const (
 type Role struct {
 	gorm.Model
 	types.Role
+
+	// ClusterRestrictions is a comma-separated list of the cluster ids the role is restricted to. The role
+	// applies to all clusters of the project if it is empty.
+	ClusterRestrictions string
+
+	// NamespaceRestrictions is a comma-separated list of the namespaces the role is restricted to, in each
+	// cluster the role applies to. The role applies to all namespaces if it is empty.
+	NamespaceRestrictions string
 }
 
 func (r *Role) ToRoleType() *types.Role {
@@ -23,5 +34,63 @@ func (r *Role) ToRoleType() *types.Role {
 		Kind:      r.Kind,
 		UserID:    r.UserID,
 		ProjectID: r.ProjectID,
+		PolicyUID: r.PolicyUID,
+	}
+}
+
+// RestrictedClusterIDs returns the ids of the clusters the role is restricted to, or nil if it is not restricted
+func (r *Role) RestrictedClusterIDs() []uint {
+	if r.ClusterRestrictions == "" {
+		return nil
+	}
+
+	res := make([]uint, 0)
+
+	for _, id := range strings.Split(r.ClusterRestrictions, ",") {
+		if parsed, err := strconv.ParseUint(id, 10, 64); err == nil {
+			res = append(res, uint(parsed))
+		}
+	}
+
+	return res
+}
+
+// RestrictedNamespaces returns the namespaces the role is restricted to, or nil if it is not restricted
+func (r *Role) RestrictedNamespaces() []string {
+	if r.NamespaceRestrictions == "" {
+		return nil
+	}
+
+	return strings.Split(r.NamespaceRestrictions, ",")
+}
+
+// SetRestrictions restricts the role to the clusters and namespaces of the restrictions, or removes the
+// restrictions if they are nil
+func (r *Role) SetRestrictions(restrictions *types.RoleRestrictions) {
+	r.ClusterRestrictions = ""
+	r.NamespaceRestrictions = ""
+
+	if restrictions == nil {
+		return
+	}
+
+	clusterIDs := make([]string, 0, len(restrictions.ClusterIDs))
+	for _, id := range restrictions.ClusterIDs {
+		clusterIDs = append(clusterIDs, strconv.FormatUint(uint64(id), 10))
+	}
+
+	r.ClusterRestrictions = strings.Join(clusterIDs, ",")
+	r.NamespaceRestrictions = strings.Join(restrictions.Namespaces, ",")
+}
+
+// ToRoleRestrictionsType returns the restrictions of the role, or nil if the role is not restricted
+func (r *Role) ToRoleRestrictionsType() *types.RoleRestrictions {
+	if r.ClusterRestrictions == "" && r.NamespaceRestrictions == "" {
+		return nil
+	}
+
+	return &types.RoleRestrictions{
+		ClusterIDs: r.RestrictedClusterIDs(),
+		Namespaces: r.RestrictedNamespaces(),
 	}
 }
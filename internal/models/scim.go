@@ -0,0 +1,63 @@
+package models
+
+import (
+	"github.com/porter-dev/porter/api/types"
+	"gorm.io/gorm"
+)
+
+// SCIMUser is a user provisioned into a project by an identity provider over SCIM
+type SCIMUser struct {
+	gorm.Model
+
+	ProjectID uint `json:"project_id" gorm:"index"`
+
+	// UserID is the Porter user the identity provider user is linked to, matched by email
+	UserID uint `json:"user_id"`
+
+	// ExternalID is the id of the user in the identity provider
+	ExternalID string `json:"external_id"`
+
+	// UserName is the email of the user in the identity provider
+	UserName   string `json:"user_name"`
+	GivenName  string `json:"given_name"`
+	FamilyName string `json:"family_name"`
+
+	// Active is false once the user is deprovisioned, which removes the user from the project
+	Active bool `json:"active"`
+
+	// MappedRoleKind is the project role the user was last given by a group role mapping, or empty if its role was never
+	// set by a mapping
+	MappedRoleKind types.RoleKind `json:"mapped_role_kind"`
+}
+
+// SCIMGroup is a group of an identity provider pushed to a project over SCIM. Members of the group are given the
+// project role of the SCIMGroupRoleMapping with the same display name.
+type SCIMGroup struct {
+	gorm.Model
+
+	ProjectID uint `json:"project_id" gorm:"index"`
+
+	// ExternalID is the id of the group in the identity provider
+	ExternalID  string `json:"external_id"`
+	DisplayName string `json:"display_name"`
+
+	Members []SCIMUser `json:"members" gorm:"many2many:scim_group_members"`
+}
+
+// SCIMGroupRoleMapping maps an identity provider group, by display name, to the project role given to its members
+type SCIMGroupRoleMapping struct {
+	gorm.Model
+
+	ProjectID        uint           `json:"project_id" gorm:"index"`
+	GroupDisplayName string         `json:"group_display_name"`
+	RoleKind         types.RoleKind `json:"role_kind"`
+}
+
+// ToSCIMGroupRoleMappingType generates an external types.SCIMGroupRoleMapping to be shared over REST
+func (m *SCIMGroupRoleMapping) ToSCIMGroupRoleMappingType() *types.SCIMGroupRoleMapping {
+	return &types.SCIMGroupRoleMapping{
+		ID:               m.ID,
+		GroupDisplayName: m.GroupDisplayName,
+		RoleKind:         m.RoleKind,
+	}
+}
@@ -0,0 +1,57 @@
+package models
+
+import (
+	"time"
+
+	"github.com/porter-dev/porter/api/types"
+	"gorm.io/gorm"
+)
+
+// ServiceAccount is a non-human user of a project, such as a CI pipeline, which authenticates with a token rather than
+// logging in. Each service account has its own User, which is given a project role like any other collaborator.
+type ServiceAccount struct {
+	gorm.Model
+
+	ProjectID uint `json:"project_id" gorm:"index"`
+	UserID    uint `json:"user_id" gorm:"uniqueIndex"`
+	Name      string
+
+	CreatedByUserID uint
+
+	// TokenHash is the hash of the secret of the current token of the service account. Issuing a new token replaces
+	// the hash, which invalidates the previous token.
+	TokenHash     string
+	TokenIssuedAt *time.Time
+
+	// ExpiresAt is when the service account stops being able to authenticate, if set
+	ExpiresAt *time.Time
+}
+
+// IsExpired returns true if the service account can no longer authenticate
+func (s *ServiceAccount) IsExpired() bool {
+	return s.ExpiresAt != nil && time.Now().After(*s.ExpiresAt)
+}
+
+// ToServiceAccountType generates an external types.ServiceAccount to be shared over REST
+func (s *ServiceAccount) ToServiceAccountType(user *User, role *Role) *types.ServiceAccount {
+	res := &types.ServiceAccount{
+		ID:              s.ID,
+		ProjectID:       s.ProjectID,
+		UserID:          s.UserID,
+		Name:            s.Name,
+		CreatedByUserID: s.CreatedByUserID,
+		CreatedAt:       s.CreatedAt,
+		ExpiresAt:       s.ExpiresAt,
+		TokenIssuedAt:   s.TokenIssuedAt,
+	}
+
+	if user != nil {
+		res.Email = user.Email
+	}
+
+	if role != nil {
+		res.Role = role.ToRoleType()
+	}
+
+	return res
+}
@@ -0,0 +1,56 @@
+package models
+
+import (
+	"fmt"
+
+	"github.com/porter-dev/porter/api/types"
+	"gorm.io/gorm"
+)
+
+// WildcardDomain is the domain of a project from which apps are allocated subdomains
+type WildcardDomain struct {
+	gorm.Model
+
+	ProjectID uint `gorm:"uniqueIndex"`
+	Domain    string
+}
+
+// ToWildcardDomainType generates an external types.WildcardDomain to be shared over REST
+func (d *WildcardDomain) ToWildcardDomainType() types.WildcardDomain {
+	return types.WildcardDomain{
+		Domain:    d.Domain,
+		CreatedAt: d.CreatedAt,
+	}
+}
+
+// SubdomainAllocation is a subdomain of the wildcard domain of a project. Names are unique in a project, so that two apps are
+// never allocated the same hostname.
+type SubdomainAllocation struct {
+	gorm.Model
+
+	ProjectID uint   `gorm:"uniqueIndex:idx_subdomain_allocation_name"`
+	Name      string `gorm:"uniqueIndex:idx_subdomain_allocation_name"`
+
+	ClusterID          uint
+	AppName            string
+	ServiceName        string
+	DeploymentTargetID string
+}
+
+// Hostname returns the hostname of the subdomain under the given wildcard domain
+func (a *SubdomainAllocation) Hostname(wildcardDomain string) string {
+	return fmt.Sprintf("%s.%s", a.Name, wildcardDomain)
+}
+
+// ToSubdomainAllocationType generates an external types.SubdomainAllocation to be shared over REST
+func (a *SubdomainAllocation) ToSubdomainAllocationType(wildcardDomain string) types.SubdomainAllocation {
+	return types.SubdomainAllocation{
+		Name:               a.Name,
+		Hostname:           a.Hostname(wildcardDomain),
+		ClusterID:          a.ClusterID,
+		AppName:            a.AppName,
+		ServiceName:        a.ServiceName,
+		DeploymentTargetID: a.DeploymentTargetID,
+		CreatedAt:          a.CreatedAt,
+	}
+}
@@ -13,6 +13,12 @@ type User struct {
 	Password      string `json:"password"`
 	EmailVerified bool   `json:"email_verified"`
 
+	// MFAEnabled is true if the user must provide a TOTP code to log in with their password
+	MFAEnabled bool `json:"mfa_enabled"`
+
+	// ServiceAccount is true for the users of service accounts, which cannot log in and only authenticate with a token
+	ServiceAccount bool `json:"service_account"`
+
 	FirstName   string `json:"first_name"`
 	LastName    string `json:"last_name"`
 	CompanyName string `json:"company_name"`
@@ -28,11 +34,13 @@ type User struct {
 // ToUserType generates an external types.User to be shared over REST
 func (u *User) ToUserType() *types.User {
 	return &types.User{
-		ID:            u.ID,
-		Email:         u.Email,
-		EmailVerified: u.EmailVerified,
-		FirstName:     u.FirstName,
-		LastName:      u.LastName,
-		CompanyName:   u.CompanyName,
+		ID:             u.ID,
+		Email:          u.Email,
+		EmailVerified:  u.EmailVerified,
+		MFAEnabled:     u.MFAEnabled,
+		ServiceAccount: u.ServiceAccount,
+		FirstName:      u.FirstName,
+		LastName:       u.LastName,
+		CompanyName:    u.CompanyName,
 	}
 }
@@ -0,0 +1,51 @@
+package models
+
+import (
+	"strings"
+
+	"gorm.io/gorm"
+)
+
+// UserMFA is the TOTP multi-factor authentication enrollment of a user. A user can have an enrollment which is not yet enabled,
+// until they confirm that their authenticator app generates valid codes.
+type UserMFA struct {
+	gorm.Model
+
+	UserID uint `json:"user_id" gorm:"unique"`
+
+	// LastUsedStep is the time step of the last TOTP code which was accepted, so that a code cannot be used twice
+	LastUsedStep int64 `json:"-"`
+
+	// RecoveryCodeHashes is a comma-separated list of the hashes of the unused recovery codes of the user
+	RecoveryCodeHashes string `json:"-"`
+
+	// ------------------------------------------------------------------
+	// All fields below encrypted before storage.
+	// ------------------------------------------------------------------
+
+	// TOTPSecret is the base32 encoded secret that TOTP codes are generated from
+	TOTPSecret []byte `json:"-"`
+}
+
+// UseRecoveryCode removes the recovery code with the given hash, and returns false if the user has no such recovery code
+func (m *UserMFA) UseRecoveryCode(hash string) bool {
+	hashes := strings.Split(m.RecoveryCodeHashes, ",")
+
+	for i, h := range hashes {
+		if h != "" && h == hash {
+			m.RecoveryCodeHashes = strings.Join(append(hashes[:i], hashes[i+1:]...), ",")
+			return true
+		}
+	}
+
+	return false
+}
+
+// RemainingRecoveryCodes returns the number of unused recovery codes of the user
+func (m *UserMFA) RemainingRecoveryCodes() int {
+	if m.RecoveryCodeHashes == "" {
+		return 0
+	}
+
+	return len(strings.Split(m.RecoveryCodeHashes, ","))
+}
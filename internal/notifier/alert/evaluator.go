@@ -0,0 +1,113 @@
+// Package alert sends the kube events which match the alert rules of a project to the notification
+// channels of the rules
+package alert
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/porter-dev/porter/api/types"
+	"github.com/porter-dev/porter/internal/models"
+	"github.com/porter-dev/porter/internal/models/integrations"
+	"github.com/porter-dev/porter/internal/notifier"
+	"github.com/porter-dev/porter/internal/notifier/slack"
+	"github.com/porter-dev/porter/internal/notifier/webhook"
+	"github.com/porter-dev/porter/internal/repository"
+	"github.com/porter-dev/porter/internal/telemetry"
+)
+
+// Evaluator matches kube events against the alert rules of their project
+type Evaluator struct {
+	repo       repository.Repository
+	dispatcher *webhook.Dispatcher
+
+	// newSlackNotifier returns the notifier which posts to the Slack integrations of a project
+	newSlackNotifier func(slackInts ...*integrations.SlackIntegration) notifier.KubeEventAlertNotifier
+}
+
+// NewEvaluator returns an Evaluator which reads alert rules, webhooks and Slack integrations from the given repository
+func NewEvaluator(repo repository.Repository) *Evaluator {
+	return &Evaluator{
+		repo:       repo,
+		dispatcher: webhook.NewDispatcher(repo.ProjectWebhook()),
+		newSlackNotifier: func(slackInts ...*integrations.SlackIntegration) notifier.KubeEventAlertNotifier {
+			return slack.NewKubeEventAlertNotifier(slackInts...)
+		},
+	}
+}
+
+// Evaluate sends a sub event of an event to the channels of every alert rule of the project which it
+// matches. Webhook deliveries are retried with exponential backoff, so this should generally be called
+// in a separate goroutine.
+func (e *Evaluator) Evaluate(ctx context.Context, event *models.KubeEvent, subEvent *models.KubeSubEvent) error {
+	ctx, span := telemetry.NewSpan(ctx, "evaluate-alert-rules")
+	defer span.End()
+
+	telemetry.WithAttributes(span,
+		telemetry.AttributeKV{Key: "project-id", Value: event.ProjectID},
+		telemetry.AttributeKV{Key: "kube-event-id", Value: event.ID},
+		telemetry.AttributeKV{Key: "severity", Value: string(subEvent.Severity)},
+	)
+
+	rules, err := e.repo.AlertRule().ListAlertRulesByProjectID(ctx, event.ProjectID)
+	if err != nil {
+		return telemetry.Error(ctx, span, err, "error listing alert rules")
+	}
+
+	var slackNotifier notifier.KubeEventAlertNotifier
+	var errs []error
+
+	for _, rule := range rules {
+		if !rule.Matches(event, subEvent) {
+			continue
+		}
+
+		alert := &types.KubeEventAlert{
+			Rule:     rule.ToAlertRuleType(),
+			Event:    event.ToKubeEventType(),
+			SubEvent: subEvent.ToKubeSubEventType(),
+		}
+
+		// the alert only carries the sub event which matched the rule
+		alert.Event.SubEvents = nil
+
+		if rule.NotifyWebhooks {
+			err := e.dispatcher.Dispatch(ctx, webhook.DispatchOpts{
+				ProjectID: event.ProjectID,
+				ClusterID: event.ClusterID,
+				Event:     types.ProjectWebhookEvent_KubeEventAlert,
+				Data: map[string]any{
+					"rule":      alert.Rule,
+					"event":     alert.Event,
+					"sub_event": alert.SubEvent,
+				},
+			})
+			if err != nil {
+				errs = append(errs, fmt.Errorf("error dispatching alert rule %d to webhooks: %w", rule.ID, err))
+			}
+		}
+
+		if rule.NotifySlack {
+			if slackNotifier == nil {
+				slackInts, err := e.repo.SlackIntegration().ListSlackIntegrationsByProjectID(ctx, event.ProjectID)
+				if err != nil {
+					errs = append(errs, fmt.Errorf("error listing slack integrations: %w", err))
+					continue
+				}
+
+				slackNotifier = e.newSlackNotifier(slackInts...)
+			}
+
+			if err := slackNotifier.NotifyKubeEventAlert(alert); err != nil {
+				errs = append(errs, fmt.Errorf("error notifying slack of alert rule %d: %w", rule.ID, err))
+			}
+		}
+	}
+
+	if len(errs) > 0 {
+		return telemetry.Error(ctx, span, errors.Join(errs...), "error sending alerts")
+	}
+
+	return nil
+}
@@ -0,0 +1,119 @@
+package alert
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/matryer/is"
+	"github.com/porter-dev/porter/api/types"
+	"github.com/porter-dev/porter/internal/models"
+	"github.com/porter-dev/porter/internal/models/integrations"
+	"github.com/porter-dev/porter/internal/notifier"
+	"github.com/porter-dev/porter/internal/repository/test"
+)
+
+type fakeSlackNotifier struct {
+	alerts []*types.KubeEventAlert
+}
+
+func (f *fakeSlackNotifier) NotifyKubeEventAlert(alert *types.KubeEventAlert) error {
+	f.alerts = append(f.alerts, alert)
+	return nil
+}
+
+func TestEvaluate(t *testing.T) {
+	is := is.New(t)
+	ctx := context.Background()
+
+	var payloads []types.ProjectWebhookPayload
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		var payload types.ProjectWebhookPayload
+		is.NoErr(json.NewDecoder(r.Body).Decode(&payload)) // payload should be valid json
+		payloads = append(payloads, payload)
+	}))
+	defer server.Close()
+
+	repo := test.NewRepository(true)
+
+	_, err := repo.ProjectWebhook().CreateProjectWebhook(ctx, &models.ProjectWebhook{
+		ProjectID: 1,
+		URL:       server.URL,
+		Events:    string(types.ProjectWebhookEvent_KubeEventAlert),
+		Enabled:   true,
+		Secret:    []byte("shh"),
+	})
+	is.NoErr(err)
+
+	_, err = repo.SlackIntegration().CreateSlackIntegration(ctx, &integrations.SlackIntegration{ProjectID: 1})
+	is.NoErr(err)
+
+	rules := []*models.AlertRule{
+		{ProjectID: 1, Name: "critical pods", Severity: types.KubeEventSeverity_Critical, ResourceType: "pod", NotifyWebhooks: true},
+		{ProjectID: 1, Name: "production warnings", Severity: types.KubeEventSeverity_Warning, Namespace: "production", NotifySlack: true},
+		{ProjectID: 1, Name: "nodes", Severity: types.KubeEventSeverity_Info, ResourceType: "node", NotifyWebhooks: true, NotifySlack: true},
+		{ProjectID: 2, Name: "other project", Severity: types.KubeEventSeverity_Info, NotifyWebhooks: true, NotifySlack: true},
+	}
+
+	for _, rule := range rules {
+		_, err := repo.AlertRule().CreateAlertRule(ctx, rule)
+		is.NoErr(err)
+	}
+
+	slackNotifier := &fakeSlackNotifier{}
+
+	e := NewEvaluator(repo)
+	e.dispatcher.InitialBackoff = 0
+	e.newSlackNotifier = func(slackInts ...*integrations.SlackIntegration) notifier.KubeEventAlertNotifier {
+		is.Equal(len(slackInts), 1) // only the slack integrations of the project should be notified
+		return slackNotifier
+	}
+
+	event := &models.KubeEvent{
+		ProjectID:    1,
+		ClusterID:    1,
+		Name:         "web-6d4cf56db6-t5pqx",
+		ResourceType: "pod",
+		Namespace:    "production",
+	}
+
+	subEvent := &models.KubeSubEvent{
+		Reason:   "OOMKilled",
+		Message:  "Container web was killed",
+		Severity: types.ClassifyKubeEventSeverity(types.KubeEventTypeNormal, "OOMKilled"),
+		Count:    1,
+	}
+
+	is.NoErr(e.Evaluate(ctx, event, subEvent))
+
+	is.Equal(len(payloads), 1) // the critical pod rule should be sent to webhooks
+	is.Equal(payloads[0].Event, types.ProjectWebhookEvent_KubeEventAlert)
+	is.Equal(payloads[0].Data["rule"].(map[string]any)["name"], "critical pods")
+
+	is.Equal(len(slackNotifier.alerts), 1) // the production warnings rule should be sent to slack
+	is.Equal(slackNotifier.alerts[0].Rule.Name, "production warnings")
+	is.Equal(slackNotifier.alerts[0].SubEvent.Reason, "OOMKilled")
+
+	// a normal event in another namespace does not match any rule
+	subEvent = &models.KubeSubEvent{
+		Reason:   "Scheduled",
+		Severity: types.ClassifyKubeEventSeverity(types.KubeEventTypeNormal, "Scheduled"),
+	}
+	event.Namespace = "default"
+
+	is.NoErr(e.Evaluate(ctx, event, subEvent))
+	is.Equal(len(payloads), 1)
+	is.Equal(len(slackNotifier.alerts), 1)
+}
+
+func TestClassifyKubeEventSeverity(t *testing.T) {
+	is := is.New(t)
+
+	is.Equal(types.ClassifyKubeEventSeverity(types.KubeEventTypeCritical, "Scheduled"), types.KubeEventSeverity_Critical)
+	is.Equal(types.ClassifyKubeEventSeverity(types.KubeEventTypeNormal, "OOMKilled: memory limit exceeded"), types.KubeEventSeverity_Critical)
+	is.Equal(types.ClassifyKubeEventSeverity(types.KubeEventTypeNormal, "BackOff"), types.KubeEventSeverity_Warning)
+	is.Equal(types.ClassifyKubeEventSeverity(types.KubeEventTypeNormal, "Pulled"), types.KubeEventSeverity_Info)
+}
@@ -0,0 +1,8 @@
+package notifier
+
+import "github.com/porter-dev/porter/api/types"
+
+// KubeEventAlertNotifier sends the kube events which matched an alert rule of a project
+type KubeEventAlertNotifier interface {
+	NotifyKubeEventAlert(alert *types.KubeEventAlert) error
+}
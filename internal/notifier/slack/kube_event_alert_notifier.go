@@ -0,0 +1,83 @@
+package slack
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"time"
+
+	"github.com/porter-dev/porter/api/types"
+	"github.com/porter-dev/porter/internal/models/integrations"
+)
+
+// KubeEventAlertNotifier posts the kube events which matched an alert rule to Slack
+type KubeEventAlertNotifier struct {
+	slackInts []*integrations.SlackIntegration
+}
+
+// NewKubeEventAlertNotifier returns a KubeEventAlertNotifier which posts to the given Slack integrations
+func NewKubeEventAlertNotifier(slackInts ...*integrations.SlackIntegration) *KubeEventAlertNotifier {
+	return &KubeEventAlertNotifier{
+		slackInts: slackInts,
+	}
+}
+
+// NotifyKubeEventAlert posts an alert to every Slack integration of the notifier
+func (s *KubeEventAlertNotifier) NotifyKubeEventAlert(alert *types.KubeEventAlert) error {
+	emoji := ":information_source:"
+
+	switch alert.SubEvent.Severity {
+	case types.KubeEventSeverity_Critical:
+		emoji = ":rotating_light:"
+	case types.KubeEventSeverity_Warning:
+		emoji = ":warning:"
+	}
+
+	res := []*SlackBlock{
+		getMarkdownBlock(fmt.Sprintf(
+			"%s Alert rule %s matched a %s event for %s %s.",
+			emoji,
+			"`"+alert.Rule.Name+"`",
+			alert.SubEvent.Severity,
+			alert.Event.ResourceType,
+			"`"+alert.Event.Name+"`",
+		)),
+		getDividerBlock(),
+		getMarkdownBlock(fmt.Sprintf("*Namespace:* %s", "`"+alert.Event.Namespace+"`")),
+		getMarkdownBlock(fmt.Sprintf("*Reason:* %s", "`"+alert.SubEvent.Reason+"`")),
+		getMarkdownBlock(fmt.Sprintf(
+			"*Last seen:* <!date^%d^ {date_num} {time_secs}| %s>",
+			alert.SubEvent.Timestamp.Unix(),
+			alert.SubEvent.Timestamp.Format("2006-01-02 15:04:05 UTC"),
+		)),
+	}
+
+	if alert.SubEvent.Count > 1 {
+		res = append(res, getMarkdownBlock(fmt.Sprintf("*Occurrences:* %d", alert.SubEvent.Count)))
+	}
+
+	res = append(res, getMarkdownBlock(fmt.Sprintf("```\n%s\n```", alert.SubEvent.Message)))
+
+	payload, err := json.Marshal(&SlackPayload{
+		Blocks: res,
+	})
+	if err != nil {
+		return err
+	}
+
+	client := &http.Client{
+		Timeout: time.Second * 5,
+	}
+
+	for _, slackInt := range s.slackInts {
+		resp, err := client.Post(string(slackInt.Webhook), "application/json", bytes.NewReader(payload))
+		if err != nil {
+			return err
+		}
+
+		resp.Body.Close()
+	}
+
+	return nil
+}
@@ -0,0 +1,191 @@
+package webhook
+
+import (
+	"bytes"
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/porter-dev/porter/api/types"
+	"github.com/porter-dev/porter/internal/models"
+	"github.com/porter-dev/porter/internal/repository"
+	"github.com/porter-dev/porter/internal/telemetry"
+)
+
+const (
+	// SignatureHeader is the header containing the HMAC-SHA256 signature of the payload, in the form sha256=<hex>
+	SignatureHeader = "X-Porter-Signature"
+	// EventHeader is the header containing the name of the event that triggered the delivery
+	EventHeader = "X-Porter-Event"
+	// DeliveryHeader is the header containing the unique id of the delivery
+	DeliveryHeader = "X-Porter-Delivery"
+
+	defaultMaxAttempts    = 5
+	defaultInitialBackoff = time.Second
+)
+
+// Dispatcher sends signed event payloads to the webhooks registered on a project
+type Dispatcher struct {
+	repo   repository.ProjectWebhookRepository
+	client *http.Client
+
+	// MaxAttempts is the number of times a delivery is attempted before giving up
+	MaxAttempts int
+	// InitialBackoff is the time waited after the first failed attempt. It doubles after each subsequent failure.
+	InitialBackoff time.Duration
+}
+
+// NewDispatcher returns a Dispatcher which records deliveries in the given repository
+func NewDispatcher(repo repository.ProjectWebhookRepository) *Dispatcher {
+	return &Dispatcher{
+		repo: repo,
+		client: &http.Client{
+			Timeout: time.Second * 10,
+		},
+		MaxAttempts:    defaultMaxAttempts,
+		InitialBackoff: defaultInitialBackoff,
+	}
+}
+
+// DispatchOpts are the details of an event to send to project webhooks
+type DispatchOpts struct {
+	ProjectID uint
+	ClusterID uint
+	AppName   string
+	Event     types.ProjectWebhookEvent
+	Data      map[string]any
+}
+
+// Dispatch sends the event to every enabled webhook in the project subscribed to it. Deliveries
+// are retried with exponential backoff, so this should generally be called in a separate goroutine.
+func (d *Dispatcher) Dispatch(ctx context.Context, opts DispatchOpts) error {
+	ctx, span := telemetry.NewSpan(ctx, "dispatch-project-webhooks")
+	defer span.End()
+
+	telemetry.WithAttributes(span,
+		telemetry.AttributeKV{Key: "project-id", Value: opts.ProjectID},
+		telemetry.AttributeKV{Key: "webhook-event", Value: string(opts.Event)},
+	)
+
+	webhooks, err := d.repo.ListProjectWebhooksByProjectID(ctx, opts.ProjectID)
+	if err != nil {
+		return telemetry.Error(ctx, span, err, "error listing project webhooks")
+	}
+
+	payload := types.ProjectWebhookPayload{
+		DeliveryID: uuid.New().String(),
+		Event:      opts.Event,
+		ProjectID:  opts.ProjectID,
+		ClusterID:  opts.ClusterID,
+		AppName:    opts.AppName,
+		Timestamp:  time.Now().UTC(),
+		Data:       opts.Data,
+	}
+
+	body, err := json.Marshal(payload)
+	if err != nil {
+		return telemetry.Error(ctx, span, err, "error marshaling webhook payload")
+	}
+
+	for _, webhook := range webhooks {
+		if !webhook.Enabled || !webhook.SubscribedTo(opts.Event) {
+			continue
+		}
+
+		err := d.deliver(ctx, webhook, payload.DeliveryID, opts.Event, body)
+		if err != nil {
+			_ = telemetry.Error(ctx, span, err, fmt.Sprintf("error delivering payload to webhook %d", webhook.ID))
+		}
+	}
+
+	return nil
+}
+
+// deliver posts the body to a single webhook, retrying on failure and recording the outcome
+func (d *Dispatcher) deliver(ctx context.Context, webhook *models.ProjectWebhook, deliveryID string, event types.ProjectWebhookEvent, body []byte) error {
+	delivery, err := d.repo.CreateProjectWebhookDelivery(ctx, &models.ProjectWebhookDelivery{
+		WebhookID:  webhook.ID,
+		DeliveryID: deliveryID,
+		Event:      string(event),
+		Payload:    body,
+	})
+	if err != nil {
+		return err
+	}
+
+	backoff := d.InitialBackoff
+
+retry:
+	for attempt := 1; attempt <= d.MaxAttempts; attempt++ {
+		delivery.Attempts = attempt
+		delivery.StatusCode, err = d.post(ctx, webhook, deliveryID, event, body)
+
+		if err == nil {
+			delivery.Success = true
+			delivery.Error = ""
+			break
+		}
+
+		delivery.Error = err.Error()
+
+		if attempt == d.MaxAttempts {
+			break
+		}
+
+		select {
+		case <-ctx.Done():
+			delivery.Error = ctx.Err().Error()
+			break retry
+		case <-time.After(backoff):
+			backoff *= 2
+		}
+	}
+
+	if _, err := d.repo.UpdateProjectWebhookDelivery(ctx, delivery); err != nil {
+		return err
+	}
+
+	if !delivery.Success {
+		return fmt.Errorf("delivery failed after %d attempts: %s", delivery.Attempts, delivery.Error)
+	}
+
+	return nil
+}
+
+func (d *Dispatcher) post(ctx context.Context, webhook *models.ProjectWebhook, deliveryID string, event types.ProjectWebhookEvent, body []byte) (int, error) {
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, webhook.URL, bytes.NewReader(body))
+	if err != nil {
+		return 0, err
+	}
+
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set(EventHeader, string(event))
+	req.Header.Set(DeliveryHeader, deliveryID)
+	req.Header.Set(SignatureHeader, Sign(webhook.Secret, body))
+
+	resp, err := d.client.Do(req)
+	if err != nil {
+		return 0, err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode < 200 || resp.StatusCode >= 300 {
+		return resp.StatusCode, fmt.Errorf("received status code %d", resp.StatusCode)
+	}
+
+	return resp.StatusCode, nil
+}
+
+// Sign computes the value of the signature header for the given body
+func Sign(secret []byte, body []byte) string {
+	mac := hmac.New(sha256.New, secret)
+	mac.Write(body)
+
+	return "sha256=" + hex.EncodeToString(mac.Sum(nil))
+}
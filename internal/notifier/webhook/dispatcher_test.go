@@ -0,0 +1,75 @@
+package webhook
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/matryer/is"
+	"github.com/porter-dev/porter/api/types"
+	"github.com/porter-dev/porter/internal/models"
+	"github.com/porter-dev/porter/internal/repository/test"
+)
+
+func TestDispatchSignsAndRetries(t *testing.T) {
+	is := is.New(t)
+
+	secret := []byte("shh")
+	requests := 0
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requests++
+
+		body, err := io.ReadAll(r.Body)
+		is.NoErr(err)                                               // request body should be readable
+		is.Equal(r.Header.Get(SignatureHeader), Sign(secret, body)) // payload should be signed with the webhook secret
+		is.Equal(r.Header.Get(EventHeader), "deploy.failed")        // event header should be set
+
+		if requests < 2 {
+			w.WriteHeader(http.StatusInternalServerError)
+			return
+		}
+
+		w.WriteHeader(http.StatusOK)
+	}))
+	defer server.Close()
+
+	repo := test.NewProjectWebhookRepository(true)
+
+	subscribed, err := repo.CreateProjectWebhook(context.Background(), &models.ProjectWebhook{
+		ProjectID: 1,
+		URL:       server.URL,
+		Events:    "deploy.failed",
+		Enabled:   true,
+		Secret:    secret,
+	})
+	is.NoErr(err)
+
+	_, err = repo.CreateProjectWebhook(context.Background(), &models.ProjectWebhook{
+		ProjectID: 1,
+		URL:       server.URL,
+		Events:    "build.failed",
+		Enabled:   true,
+		Secret:    secret,
+	})
+	is.NoErr(err)
+
+	d := NewDispatcher(repo)
+	d.InitialBackoff = 0
+
+	err = d.Dispatch(context.Background(), DispatchOpts{
+		ProjectID: 1,
+		AppName:   "test-app",
+		Event:     types.ProjectWebhookEvent_DeployFailed,
+	})
+	is.NoErr(err)
+	is.Equal(requests, 2) // first attempt fails, second succeeds, unsubscribed webhook is skipped
+
+	deliveries, err := repo.ListProjectWebhookDeliveries(context.Background(), subscribed.ID, 0)
+	is.NoErr(err)
+	is.Equal(len(deliveries), 1)
+	is.Equal(deliveries[0].Attempts, 2)
+	is.True(deliveries[0].Success)
+}
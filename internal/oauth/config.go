@@ -123,6 +123,7 @@ func CreateRandomState() string {
 // MakeUpdateOAuthIntegrationTokenFunction creates a function to be passed to GetAccessToken that updates the OauthIntegration
 // if it needs to be updated
 func MakeUpdateOAuthIntegrationTokenFunction(
+	ctx context.Context,
 	o *integrations.OAuthIntegration,
 	repo repository.Repository,
 ) func(accessToken []byte, refreshToken []byte, expiry time.Time) error {
@@ -131,7 +132,7 @@ func MakeUpdateOAuthIntegrationTokenFunction(
 		o.RefreshToken = refreshToken
 		o.Expiry = expiry
 
-		_, err := repo.OAuthIntegration().UpdateOAuthIntegration(o)
+		_, err := repo.OAuthIntegration().UpdateOAuthIntegration(ctx, o)
 
 		return err
 	}
@@ -140,6 +141,7 @@ func MakeUpdateOAuthIntegrationTokenFunction(
 // MakeUpdateGithubAppOauthIntegrationFunction creates a function to be passed to GetAccessToken that updates the GithubAppOauthIntegration
 // if it needs to be updated
 func MakeUpdateGithubAppOauthIntegrationFunction(
+	ctx context.Context,
 	o *integrations.GithubAppOAuthIntegration,
 	repo repository.Repository,
 ) func(accessToken []byte, refreshToken []byte, expiry time.Time) error {
@@ -148,7 +150,7 @@ func MakeUpdateGithubAppOauthIntegrationFunction(
 		o.RefreshToken = refreshToken
 		o.Expiry = expiry
 
-		_, err := repo.GithubAppOAuthIntegration().UpdateGithubAppOauthIntegration(o)
+		_, err := repo.GithubAppOAuthIntegration().UpdateGithubAppOauthIntegration(ctx, o)
 
 		return err
 	}
@@ -157,12 +159,13 @@ func MakeUpdateGithubAppOauthIntegrationFunction(
 // MakeUpdateGitlabAppOAuthIntegrationFunction creates a function to be passed to GetAccessToken that updates the GitlabAppOAuthIntegration
 // if it needs to be updated
 func MakeUpdateGitlabAppOAuthIntegrationFunction(
+	ctx context.Context,
 	projectID uint,
 	o *integrations.GitlabAppOAuthIntegration,
 	repo repository.Repository,
 ) func(accessToken []byte, refreshToken []byte, expiry time.Time) error {
 	return func(accessToken []byte, refreshToken []byte, expiry time.Time) error {
-		o, err := repo.OAuthIntegration().ReadOAuthIntegration(projectID, o.OAuthIntegrationID)
+		o, err := repo.OAuthIntegration().ReadOAuthIntegration(ctx, projectID, o.OAuthIntegrationID)
 		if err != nil {
 			return err
 		}
@@ -171,7 +174,7 @@ func MakeUpdateGitlabAppOAuthIntegrationFunction(
 		o.RefreshToken = refreshToken
 		o.Expiry = expiry
 
-		_, err = repo.OAuthIntegration().UpdateOAuthIntegration(o)
+		_, err = repo.OAuthIntegration().UpdateOAuthIntegration(ctx, o)
 
 		return err
 	}
@@ -0,0 +1,143 @@
+package porter_app
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"reflect"
+	"sort"
+
+	"github.com/porter-dev/api-contracts/generated/go/helpers"
+	porterv1 "github.com/porter-dev/api-contracts/generated/go/porter/v1"
+
+	"github.com/porter-dev/porter/internal/telemetry"
+)
+
+// FieldChangeType is the kind of change made to a field between two revisions
+type FieldChangeType string
+
+const (
+	// FieldChangeType_Added means the field is only set in the newer revision
+	FieldChangeType_Added FieldChangeType = "added"
+	// FieldChangeType_Removed means the field is only set in the older revision
+	FieldChangeType_Removed FieldChangeType = "removed"
+	// FieldChangeType_Modified means the field is set in both revisions with different values
+	FieldChangeType_Modified FieldChangeType = "modified"
+)
+
+// FieldChange is a change to a single field of an app between two revisions
+type FieldChange struct {
+	// Path is the path of the field in the app definition, e.g. services.web.cpuCores or env.PORT. List items
+	// are addressed by index, e.g. services.web.webConfig.domains[0].name.
+	Path string          `json:"path"`
+	Type FieldChangeType `json:"type"`
+	// From is the value of the field in the older revision, unset for added fields
+	From any `json:"from,omitempty"`
+	// To is the value of the field in the newer revision, unset for removed fields
+	To any `json:"to,omitempty"`
+}
+
+// DiffAppProtos returns the field level changes from one app definition to another, sorted by path. Fields are compared
+// by their JSON representation, so fields left at their default values are treated as unset.
+func DiffAppProtos(ctx context.Context, from *porterv1.PorterApp, to *porterv1.PorterApp) ([]FieldChange, error) {
+	ctx, span := telemetry.NewSpan(ctx, "diff-app-protos")
+	defer span.End()
+
+	fromValues, err := appProtoValues(ctx, from)
+	if err != nil {
+		return nil, telemetry.Error(ctx, span, err, "error converting older app proto")
+	}
+
+	toValues, err := appProtoValues(ctx, to)
+	if err != nil {
+		return nil, telemetry.Error(ctx, span, err, "error converting newer app proto")
+	}
+
+	changes := make([]FieldChange, 0)
+	diffValues("", fromValues, toValues, &changes)
+
+	return changes, nil
+}
+
+// appProtoValues converts an app proto into its generic JSON representation
+func appProtoValues(ctx context.Context, app *porterv1.PorterApp) (map[string]any, error) {
+	values := make(map[string]any)
+	if app == nil {
+		return values, nil
+	}
+
+	encoded, err := helpers.MarshalContractObject(ctx, app)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := json.Unmarshal(encoded, &values); err != nil {
+		return nil, err
+	}
+
+	return values, nil
+}
+
+func diffValues(path string, from any, to any, changes *[]FieldChange) {
+	fromMap, fromIsMap := from.(map[string]any)
+	toMap, toIsMap := to.(map[string]any)
+	if fromIsMap && toIsMap {
+		keys := make(map[string]bool)
+		for k := range fromMap {
+			keys[k] = true
+		}
+		for k := range toMap {
+			keys[k] = true
+		}
+
+		sortedKeys := make([]string, 0, len(keys))
+		for k := range keys {
+			sortedKeys = append(sortedKeys, k)
+		}
+		sort.Strings(sortedKeys)
+
+		for _, k := range sortedKeys {
+			childPath := k
+			if path != "" {
+				childPath = fmt.Sprintf("%s.%s", path, k)
+			}
+
+			fromValue, inFrom := fromMap[k]
+			toValue, inTo := toMap[k]
+
+			switch {
+			case !inFrom:
+				*changes = append(*changes, FieldChange{Path: childPath, Type: FieldChangeType_Added, To: toValue})
+			case !inTo:
+				*changes = append(*changes, FieldChange{Path: childPath, Type: FieldChangeType_Removed, From: fromValue})
+			default:
+				diffValues(childPath, fromValue, toValue, changes)
+			}
+		}
+
+		return
+	}
+
+	fromList, fromIsList := from.([]any)
+	toList, toIsList := to.([]any)
+	if fromIsList && toIsList {
+		for i := 0; i < len(fromList) || i < len(toList); i++ {
+			childPath := fmt.Sprintf("%s[%d]", path, i)
+
+			switch {
+			case i >= len(fromList):
+				*changes = append(*changes, FieldChange{Path: childPath, Type: FieldChangeType_Added, To: toList[i]})
+			case i >= len(toList):
+				*changes = append(*changes, FieldChange{Path: childPath, Type: FieldChangeType_Removed, From: fromList[i]})
+			default:
+				diffValues(childPath, fromList[i], toList[i], changes)
+			}
+		}
+
+		return
+	}
+
+	if !reflect.DeepEqual(from, to) {
+		*changes = append(*changes, FieldChange{Path: path, Type: FieldChangeType_Modified, From: from, To: to})
+	}
+}
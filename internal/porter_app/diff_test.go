@@ -0,0 +1,70 @@
+package porter_app
+
+import (
+	"context"
+	"testing"
+
+	"github.com/matryer/is"
+	porterv1 "github.com/porter-dev/api-contracts/generated/go/porter/v1"
+)
+
+func TestDiffAppProtos(t *testing.T) {
+	is := is.New(t)
+
+	from := &porterv1.PorterApp{
+		Name: "app",
+		Env:  map[string]string{"PORT": "8080", "DEBUG": "true"},
+		Image: &porterv1.AppImage{
+			Repository: "registry.example.com/app",
+			Tag:        "v1",
+		},
+		Services: map[string]*porterv1.Service{
+			"web": {
+				Run:       "npm start",
+				Instances: 1,
+				CpuCores:  0.5,
+				Type:      porterv1.ServiceType_SERVICE_TYPE_WEB,
+				Config: &porterv1.Service_WebConfig{WebConfig: &porterv1.WebServiceConfig{
+					Domains: []*porterv1.Domain{{Name: "a.example.com"}},
+				}},
+			},
+		},
+	}
+
+	to := &porterv1.PorterApp{
+		Name: "app",
+		Env:  map[string]string{"PORT": "8080", "LOG_LEVEL": "info"},
+		Image: &porterv1.AppImage{
+			Repository: "registry.example.com/app",
+			Tag:        "v2",
+		},
+		Services: map[string]*porterv1.Service{
+			"web": {
+				Run:       "npm start",
+				Instances: 3,
+				CpuCores:  0.5,
+				Type:      porterv1.ServiceType_SERVICE_TYPE_WEB,
+				Config: &porterv1.Service_WebConfig{WebConfig: &porterv1.WebServiceConfig{
+					Domains: []*porterv1.Domain{{Name: "a.example.com"}, {Name: "b.example.com"}},
+				}},
+			},
+		},
+	}
+
+	changes, err := DiffAppProtos(context.Background(), from, to)
+	is.NoErr(err)
+
+	want := []FieldChange{
+		{Path: "env.DEBUG", Type: FieldChangeType_Removed, From: "true"},
+		{Path: "env.LOG_LEVEL", Type: FieldChangeType_Added, To: "info"},
+		{Path: "image.tag", Type: FieldChangeType_Modified, From: "v1", To: "v2"},
+		{Path: "services.web.instances", Type: FieldChangeType_Modified, From: float64(1), To: float64(3)},
+		{Path: "services.web.webConfig.domains[1]", Type: FieldChangeType_Added, To: map[string]any{"name": "b.example.com"}},
+	}
+	is.Equal(changes, want)
+
+	// identical apps have no changes
+	changes, err = DiffAppProtos(context.Background(), to, to)
+	is.NoErr(err)
+	is.Equal(len(changes), 0)
+}
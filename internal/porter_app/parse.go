@@ -2,12 +2,14 @@ package porter_app
 
 import (
 	"context"
+	"fmt"
 
 	v2 "github.com/porter-dev/porter/internal/porter_app/v2"
 
 	"sigs.k8s.io/yaml"
 
 	porterv1 "github.com/porter-dev/api-contracts/generated/go/porter/v1"
+	"github.com/porter-dev/porter/internal/models"
 	"github.com/porter-dev/porter/internal/telemetry"
 )
 
@@ -19,8 +21,8 @@ const (
 	PorterYamlVersion_V2 PorterYamlVersion = "v2"
 )
 
-// ParseYAML converts a Porter YAML file into a PorterApp proto object
-func ParseYAML(ctx context.Context, porterYaml []byte) (*porterv1.PorterApp, error) {
+// ParseYAML converts a Porter YAML file into a PorterApp proto object. The options are passed to the parser of the version of the file.
+func ParseYAML(ctx context.Context, porterYaml []byte, opts ...v2.ParseOption) (*porterv1.PorterApp, error) {
 	ctx, span := telemetry.NewSpan(ctx, "porter-app-parse-yaml")
 	defer span.End()
 
@@ -37,7 +39,7 @@ func ParseYAML(ctx context.Context, porterYaml []byte) (*porterv1.PorterApp, err
 	var appProto *porterv1.PorterApp
 	switch version.Version {
 	case PorterYamlVersion_V2:
-		appProto, err = v2.AppProtoFromYaml(ctx, porterYaml)
+		appProto, err = v2.AppProtoFromYaml(ctx, porterYaml, opts...)
 		if err != nil {
 			return nil, telemetry.Error(ctx, span, err, "error converting v2 yaml to proto")
 		}
@@ -52,6 +54,67 @@ func ParseYAML(ctx context.Context, porterYaml []byte) (*porterv1.PorterApp, err
 	return appProto, nil
 }
 
+// ParsePreviewYAML converts the previews section of a Porter YAML file into a PorterApp proto object for preview environments.
+// It returns nil if the file does not define any preview overrides.
+func ParsePreviewYAML(ctx context.Context, porterYaml []byte, opts ...v2.ParseOption) (*porterv1.PorterApp, error) {
+	ctx, span := telemetry.NewSpan(ctx, "porter-app-parse-preview-yaml")
+	defer span.End()
+
+	if porterYaml == nil {
+		return nil, telemetry.Error(ctx, span, nil, "porter yaml is nil")
+	}
+
+	version := &yamlVersion{}
+	err := yaml.Unmarshal(porterYaml, version)
+	if err != nil {
+		return nil, telemetry.Error(ctx, span, err, "error unmarshaling porter yaml")
+	}
+
+	var appProto *porterv1.PorterApp
+	switch version.Version {
+	case PorterYamlVersion_V2:
+		appProto, err = v2.PreviewAppProtoFromYaml(ctx, porterYaml, opts...)
+		if err != nil {
+			return nil, telemetry.Error(ctx, span, err, "error converting v2 preview yaml to proto")
+		}
+	default:
+		return nil, telemetry.Error(ctx, span, nil, "porter yaml version not supported")
+	}
+
+	return appProto, nil
+}
+
+// ValidateYAML validates a Porter YAML file without calling the Porter API, returning the problems found in the file along
+// with their positions. An error is returned if the version of the file cannot be read or is not supported.
+func ValidateYAML(ctx context.Context, porterYaml []byte) ([]v2.ValidationError, error) {
+	ctx, span := telemetry.NewSpan(ctx, "porter-app-validate-yaml")
+	defer span.End()
+
+	version := &yamlVersion{}
+	err := yaml.Unmarshal(porterYaml, version)
+	if err != nil {
+		// syntax errors are reported with their line by the validation of the file
+		return v2.ValidateYAML(ctx, porterYaml), nil
+	}
+
+	switch version.Version {
+	case PorterYamlVersion_V2:
+		return v2.ValidateYAML(ctx, porterYaml), nil
+	default:
+		return nil, telemetry.Error(ctx, span, nil, fmt.Sprintf("porter yaml version '%s' is not supported", version.Version))
+	}
+}
+
+// ProjectServiceDefaults returns the parse option which gives the resource presets of a project to the services of a Porter YAML
+// file which do not set them
+func ProjectServiceDefaults(project *models.Project) v2.ParseOption {
+	return v2.WithServiceDefaults(v2.ServiceDefaults{
+		CpuCores:     project.DefaultCPUCores,
+		RamMegabytes: project.DefaultRAMMegabytes,
+		Instances:    project.DefaultInstances,
+	})
+}
+
 // yamlVersion is a struct used to unmarshal the version field of a Porter YAML file
 type yamlVersion struct {
 	Version PorterYamlVersion `yaml:"version"`
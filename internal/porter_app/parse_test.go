@@ -1,15 +1,24 @@
 package porter_app
 
 import (
+	"bytes"
 	"context"
+	"encoding/json"
+	"errors"
 	"fmt"
 	"os"
+	"strings"
 	"testing"
 
 	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/proto"
 
 	porterv1 "github.com/porter-dev/api-contracts/generated/go/porter/v1"
+	"github.com/porter-dev/porter/internal/models"
+	v2 "github.com/porter-dev/porter/internal/porter_app/v2"
+	"github.com/santhosh-tekuri/jsonschema/v5"
 	"github.com/sergi/go-diff/diffmatchpatch"
+	"sigs.k8s.io/yaml"
 
 	"github.com/matryer/is"
 )
@@ -134,3 +143,1025 @@ func diffProtoWithFailTest(t *testing.T, is *is.I, want, got *porterv1.PorterApp
 		t.Errorf("diff between want and got: %s", dmp.DiffPrettyText(diffs))
 	}
 }
+
+func TestParsePreviewYAML(t *testing.T) {
+	is := is.New(t)
+
+	porterYaml, err := os.ReadFile("testdata/v2_input_previews.yaml")
+	is.NoErr(err) // no error expected reading test file
+
+	got, err := ParsePreviewYAML(context.Background(), porterYaml)
+	is.NoErr(err) // previews overrides should merge onto the app without issues
+	is.True(got != nil)
+
+	web := got.Services["example-web"]
+	is.Equal(web.Instances, int32(1))      // instances overridden by previews
+	is.Equal(web.RamMegabytes, int32(128)) // ram overridden by previews
+	is.Equal(web.Run, "node index.js")     // fields not in previews are kept
+	is.Equal(got.Services["example-wkr"].Instances, int32(1))
+	is.Equal(got.Env["NODE_ENV"], "preview") // env overridden by previews
+	is.Equal(got.Env["PORT"], "8080")
+
+	noPreviews, err := os.ReadFile("testdata/v2_input_nobuild.yaml")
+	is.NoErr(err) // no error expected reading test file
+
+	got, err = ParsePreviewYAML(context.Background(), noPreviews)
+	is.NoErr(err)
+	is.True(got == nil) // no preview proto without a previews section
+}
+
+func TestParseYAMLHealthCheckProbes(t *testing.T) {
+	tests := []struct {
+		name     string
+		probes   string
+		wantPath string
+		wantErr  bool
+	}{
+		{"same path", "readiness:\n        httpPath: /ready\n      liveness:\n        httpPath: /ready", "/ready", false},
+		{"different paths", "readiness:\n        httpPath: /ready\n      liveness:\n        httpPath: /live", "", true},
+		{"command probe", "liveness:\n        command: ./healthcheck", "", true},
+		{"probe timings", "startup:\n        httpPath: /ready\n        failureThreshold: 30", "", true},
+		{"path and command", "startup:\n        httpPath: /ready\n        command: ./healthcheck", "", true},
+		{"tcp check", "type: tcp", "", true},
+		{"grpc probe", "readiness:\n        type: grpc\n        grpcService: api.v1.Health", "", true},
+		{"grpc service on http check", "httpPath: /ready\n      grpcService: api.v1.Health", "", true},
+		{"invalid type", "type: udp", "", true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			is := is.New(t)
+
+			porterYaml := fmt.Sprintf(`version: v2
+name: probes-app
+services:
+  web:
+    type: web
+    run: node index.js
+    port: 8080
+    healthCheck:
+      %s
+`, tt.probes)
+
+			got, err := ParseYAML(context.Background(), []byte(porterYaml))
+			if tt.wantErr {
+				is.True(err != nil) // probes that cannot be expressed by the app contract should be rejected
+				return
+			}
+			is.NoErr(err)
+
+			healthCheck := got.Services["web"].GetWebConfig().GetHealthCheck()
+			is.True(healthCheck.Enabled) // configuring probes enables health checks
+			is.Equal(healthCheck.HttpPath, tt.wantPath)
+		})
+	}
+}
+
+func TestParseYAMLDomains(t *testing.T) {
+	tests := []struct {
+		name    string
+		domains string
+		wantErr bool
+	}{
+		{"hostname", "- name: api.example.com", false},
+		{"root path prefix", "- name: api.example.com\n        pathPrefix: /", false},
+		{"invalid hostname", "- name: api_example.com", true},
+		{"relative path prefix", "- name: api.example.com\n        pathPrefix: api", true},
+		{"path prefix", "- name: api.example.com\n        pathPrefix: /api", true},
+		{"annotations", "- name: api.example.com\n        annotations:\n          nginx.ingress.kubernetes.io/proxy-body-size: 50m", true},
+		{"disable tls", "- name: api.example.com\n        disableTls: true", true},
+		{"route shared with another service", "- name: app.example.com", true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			is := is.New(t)
+
+			porterYaml := fmt.Sprintf(`version: v2
+name: domains-app
+services:
+  api:
+    type: web
+    run: node api.js
+    port: 8080
+    domains:
+      %s
+  app:
+    type: web
+    run: node app.js
+    port: 8080
+    domains:
+      - name: app.example.com
+`, tt.domains)
+
+			got, err := ParseYAML(context.Background(), []byte(porterYaml))
+			if tt.wantErr {
+				is.True(err != nil) // invalid domains or domains that cannot be expressed by the app contract should be rejected
+				return
+			}
+			is.NoErr(err)
+			is.Equal(got.Services["api"].GetWebConfig().GetDomains()[0].Name, "api.example.com")
+		})
+	}
+}
+
+func TestParseYAMLInternalService(t *testing.T) {
+	is := is.New(t)
+
+	porterYaml := []byte(`version: v2
+name: internal-app
+services:
+  api:
+    type: web
+    run: node api.js
+    port: 8080
+    internal: true
+`)
+
+	got, err := ParseYAML(context.Background(), porterYaml)
+	is.NoErr(err)
+	is.True(got.Services["api"].GetWebConfig().Private) // internal web services are private to the cluster
+	is.Equal(len(got.Services["api"].GetWebConfig().Domains), 0)
+
+	_, err = ParseYAML(context.Background(), append(porterYaml, []byte("    domains:\n      - name: api.example.com\n")...))
+	is.True(err != nil) // internal web services cannot have public domains
+}
+
+func TestParseYAMLAutoscalingMetrics(t *testing.T) {
+	tests := []struct {
+		name        string
+		serviceType string
+		metrics     string
+	}{
+		{"requests per second", "web", "requestsPerSecond: 50"},
+		{"requests per second on worker", "worker", "requestsPerSecond: 50"},
+		{"external metric", "worker", "externalMetrics:\n        - name: sqs-queue-length\n          selector:\n            queue: jobs\n          targetValuePerInstance: 100"},
+		{"external metric without target", "worker", "externalMetrics:\n        - name: sqs-queue-length"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			is := is.New(t)
+
+			porterYaml := fmt.Sprintf(`version: v2
+name: autoscaling-app
+services:
+  svc:
+    type: %s
+    run: node index.js
+    port: 8080
+    autoscaling:
+      enabled: true
+      minInstances: 1
+      maxInstances: 10
+      %s
+`, tt.serviceType, tt.metrics)
+
+			_, err := ParseYAML(context.Background(), []byte(porterYaml))
+			is.True(err != nil) // custom metrics are validated, then rejected until the app contract supports them
+		})
+	}
+}
+
+func TestParseYAMLRollingUpdate(t *testing.T) {
+	tests := []struct {
+		name    string
+		service string
+		wantErr string
+	}{
+		{"worker rolling update", "wkr:\n    type: worker\n    run: node worker.js\n    deploy:\n      minReadySeconds: 10\n      strategy:\n        maxSurge: 25%\n        maxUnavailable: 0", "rolling update settings are not supported by the current app contract"},
+		{"min ready seconds", "web:\n    type: web\n    run: node index.js\n    deploy:\n      minReadySeconds: 10", "rolling update settings are not supported by the current app contract"},
+		{"no progress", "web:\n    type: web\n    run: node index.js\n    deploy:\n      strategy:\n        maxSurge: 0\n        maxUnavailable: 0%", "maxSurge and maxUnavailable cannot both be 0"},
+		{"invalid percentage", "web:\n    type: web\n    run: node index.js\n    deploy:\n      strategy:\n        maxSurge: 150%", "invalid maxSurge"},
+		{"rolling with canary", "web:\n    type: web\n    run: node index.js\n    deploy:\n      strategy:\n        maxSurge: 1\n        canary:\n          steps:\n            - percentage: 10", "cannot be used with canary or blueGreen"},
+		{"canary on worker", "wkr:\n    type: worker\n    run: node worker.js\n    deploy:\n      strategy:\n        canary:\n          steps:\n            - percentage: 10", "only supported for web services"},
+		{"job", "job:\n    type: job\n    run: node job.js\n    deploy:\n      minReadySeconds: 10", "only supported for web and worker services"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			is := is.New(t)
+
+			porterYaml := fmt.Sprintf(`version: v2
+name: rollout-app
+services:
+  %s
+`, tt.service)
+
+			_, err := ParseYAML(context.Background(), []byte(porterYaml))
+			is.True(err != nil)
+			is.True(strings.Contains(err.Error(), tt.wantErr)) // rollout settings are validated, then rejected until the app contract supports them
+		})
+	}
+}
+
+func TestParseYAMLDisruptionBudget(t *testing.T) {
+	tests := []struct {
+		name    string
+		service string
+		wantErr string
+	}{
+		{"min available", "web:\n    type: web\n    run: node index.js\n    instances: 3\n    disruptionBudget:\n      minAvailable: 2", "disruption budgets are not supported by the current app contract"},
+		{"max unavailable percentage", "wkr:\n    type: worker\n    run: node worker.js\n    disruptionBudget:\n      maxUnavailable: 50%", "disruption budgets are not supported by the current app contract"},
+		{"both", "web:\n    type: web\n    run: node index.js\n    disruptionBudget:\n      minAvailable: 1\n      maxUnavailable: 1", "cannot set both minAvailable and maxUnavailable"},
+		{"neither", "web:\n    type: web\n    run: node index.js\n    disruptionBudget: {}", "must set one of minAvailable or maxUnavailable"},
+		{"every instance", "web:\n    type: web\n    run: node index.js\n    instances: 2\n    disruptionBudget:\n      minAvailable: 2", "blocks node drains"},
+		{"no unavailable instances", "web:\n    type: web\n    run: node index.js\n    disruptionBudget:\n      maxUnavailable: 0", "blocks node drains"},
+		{"job", "job:\n    type: job\n    run: node job.js\n    disruptionBudget:\n      maxUnavailable: 1", "only supported for web and worker services"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			is := is.New(t)
+
+			porterYaml := fmt.Sprintf(`version: v2
+name: budget-app
+services:
+  %s
+`, tt.service)
+
+			_, err := ParseYAML(context.Background(), []byte(porterYaml))
+			is.True(err != nil)
+			is.True(strings.Contains(err.Error(), tt.wantErr)) // disruption budgets are validated, then rejected until the app contract supports them
+		})
+	}
+}
+
+func TestParseYAMLSecurityContext(t *testing.T) {
+	tests := []struct {
+		name            string
+		securityContext string
+		wantErr         string
+	}{
+		{"security context", "runAsNonRoot: true\n      runAsUser: 1000\n      readOnlyRootFilesystem: true\n      capabilities:\n        drop:\n          - ALL", "security contexts are not supported by the current app contract"},
+		{"root user", "runAsNonRoot: true\n      runAsUser: 0", "runAsUser cannot be 0 when runAsNonRoot is set"},
+		{"cap prefix", "capabilities:\n        drop:\n          - CAP_NET_RAW", "without the CAP_ prefix"},
+		{"invalid capability", "capabilities:\n        drop:\n          - net_raw", "invalid capability 'net_raw'"},
+		{"duplicate capability", "capabilities:\n        drop:\n          - NET_RAW\n          - NET_RAW", "duplicate capability 'NET_RAW'"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			is := is.New(t)
+
+			porterYaml := fmt.Sprintf(`version: v2
+name: secure-app
+services:
+  web:
+    type: web
+    run: node index.js
+    port: 8080
+    securityContext:
+      %s
+`, tt.securityContext)
+
+			_, err := ParseYAML(context.Background(), []byte(porterYaml))
+			is.True(err != nil)
+			is.True(strings.Contains(err.Error(), tt.wantErr)) // security contexts are validated, then rejected until the app contract supports them
+		})
+	}
+}
+
+func TestParseYAMLStaticEgress(t *testing.T) {
+	is := is.New(t)
+
+	porterYaml := []byte(`version: v2
+name: egress-app
+services:
+  wkr:
+    type: worker
+    run: node worker.js
+    staticEgress: true
+`)
+
+	_, err := ParseYAML(context.Background(), porterYaml)
+	is.True(err != nil)
+	is.True(strings.Contains(err.Error(), "static egress is not supported by the current app contract")) // static egress is rejected until the app contract supports it
+}
+
+func TestParseYAMLScalingSchedules(t *testing.T) {
+	tests := []struct {
+		name      string
+		schedules string
+		wantErr   string
+	}{
+		{"schedules", "      - name: nights\n        cron: \"0 20 * * *\"\n        minInstances: 1\n        maxInstances: 2\n        timezone: Europe/Berlin\n      - name: weekdays\n        cron: \"0 7 * * 1-5\"\n        minInstances: 2\n        maxInstances: 10", "scheduled scaling is not supported by the current app contract"},
+		{"invalid cron", "      - name: nights\n        cron: \"0 25 * * *\"\n        maxInstances: 2", "invalid value '25' in hour field"},
+		{"too few cron fields", "      - name: nights\n        cron: \"0 20 * *\"\n        maxInstances: 2", "must have 5 fields"},
+		{"duplicate schedule", "      - name: nights\n        cron: \"0 20 * * *\"\n        maxInstances: 2\n      - name: nights\n        cron: \"0 22 * * *\"\n        maxInstances: 1", "duplicate scaling schedule 'nights'"},
+		{"min above max", "      - name: nights\n        cron: \"0 20 * * *\"\n        minInstances: 3\n        maxInstances: 2", "minInstances cannot be greater than maxInstances"},
+		{"invalid timezone", "      - name: nights\n        cron: \"0 20 * * *\"\n        maxInstances: 2\n        timezone: Mars/Olympus", "invalid timezone 'Mars/Olympus'"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			is := is.New(t)
+
+			porterYaml := fmt.Sprintf(`version: v2
+name: schedule-app
+services:
+  web:
+    type: web
+    run: node index.js
+    port: 8080
+    autoscaling:
+      enabled: true
+      minInstances: 2
+      maxInstances: 10
+      cpuThresholdPercent: 60
+      schedules:
+%s
+`, tt.schedules)
+
+			_, err := ParseYAML(context.Background(), []byte(porterYaml))
+			is.True(err != nil)
+			is.True(strings.Contains(err.Error(), tt.wantErr)) // scaling schedules are validated, then rejected until the app contract supports them
+		})
+	}
+}
+
+func TestParseYAMLQueueScaling(t *testing.T) {
+	tests := []struct {
+		name    string
+		worker  string
+		wantErr string
+	}{
+		{"sqs", "scaleOnQueue:\n      type: sqs\n      queueUrl: https://sqs.us-east-1.amazonaws.com/123456789012/jobs\n      targetBacklogPerInstance: 50\n      maxInstances: 20", "scaling on queue length is not supported by the current app contract"},
+		{"redis", "scaleOnQueue:\n      type: redis\n      queueName: jobs\n      connectionFromEnv: REDIS_URL\n      targetBacklogPerInstance: 10\n      maxInstances: 5", "scaling on queue length is not supported by the current app contract"},
+		{"invalid sqs url", "scaleOnQueue:\n      type: sqs\n      queueUrl: https://example.com/jobs\n      targetBacklogPerInstance: 50\n      maxInstances: 20", "is not the URL of an SQS queue"},
+		{"rabbitmq without connection", "scaleOnQueue:\n      type: rabbitmq\n      queueName: jobs\n      targetBacklogPerInstance: 10\n      maxInstances: 5", "connectionFromEnv must be the name of an env var"},
+		{"min above max", "scaleOnQueue:\n      type: redis\n      queueName: jobs\n      connectionFromEnv: REDIS_URL\n      targetBacklogPerInstance: 10\n      minInstances: 6\n      maxInstances: 5", "minInstances cannot be greater than maxInstances"},
+		{"with autoscaling", "autoscaling:\n      enabled: true\n      minInstances: 1\n      maxInstances: 3\n      cpuThresholdPercent: 50\n    scaleOnQueue:\n      type: redis\n      queueName: jobs\n      connectionFromEnv: REDIS_URL\n      targetBacklogPerInstance: 10\n      maxInstances: 5", "cannot use both autoscaling and scaleOnQueue"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			is := is.New(t)
+
+			porterYaml := fmt.Sprintf(`version: v2
+name: queue-app
+services:
+  wkr:
+    type: worker
+    run: node worker.js
+    %s
+`, tt.worker)
+
+			_, err := ParseYAML(context.Background(), []byte(porterYaml))
+			is.True(err != nil)
+			is.True(strings.Contains(err.Error(), tt.wantErr)) // queue scaling is validated, then rejected until the app contract supports it
+		})
+	}
+}
+
+func TestParseYAMLScheduling(t *testing.T) {
+	tests := []struct {
+		name       string
+		scheduling string
+		wantErr    string
+	}{
+		{"node selector", "nodeSelector:\n      kubernetes.io/arch: arm64", "not supported by the current app contract"},
+		{"toleration", "tolerations:\n      - key: nvidia.com/gpu\n        operator: Exists\n        effect: NoSchedule", "not supported by the current app contract"},
+		{"topology spread", "topologySpreadConstraints:\n      - maxSkew: 1\n        topologyKey: topology.kubernetes.io/zone", "not supported by the current app contract"},
+		{"toleration without key", "tolerations:\n      - value: gpu", "key is required"},
+		{"toleration with invalid effect", "tolerations:\n      - key: gpu\n        effect: Evict", "effect must be"},
+		{"topology spread without skew", "topologySpreadConstraints:\n      - topologyKey: topology.kubernetes.io/zone", "maxSkew must be at least 1"},
+		{"spot", "spot: preferred", "spot scheduling is not supported by the current app contract"},
+		{"invalid spot", "spot: sometimes", "invalid spot preference"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			is := is.New(t)
+
+			porterYaml := fmt.Sprintf(`version: v2
+name: scheduling-app
+services:
+  wkr:
+    type: worker
+    run: node worker.js
+    %s
+`, tt.scheduling)
+
+			_, err := ParseYAML(context.Background(), []byte(porterYaml))
+			is.True(err != nil)
+			is.True(strings.Contains(err.Error(), tt.wantErr)) // scheduling is validated, then rejected until the app contract supports it
+		})
+	}
+}
+
+func TestParseYAMLServiceEnv(t *testing.T) {
+	tests := []struct {
+		name    string
+		env     string
+		wantErr string
+	}{
+		{"env", "env:\n      QUEUE_PASSWORD: secret", "not supported by the current app contract"},
+		{"env groups", "envGroups:\n      - worker-credentials", "not supported by the current app contract"},
+		{"invalid env var name", "env:\n      1PASSWORD: secret", "invalid env var name"},
+		{"invalid aws source", "env:\n      QUEUE_PASSWORD:\n        fromAws:\n          secretArn: not-an-arn", "invalid fromAws source"},
+		{"invalid env group name", "envGroups:\n      - Worker_Credentials", "invalid env group name"},
+		{"duplicate env group", "envGroups:\n      - worker-credentials\n      - worker-credentials", "duplicate env group"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			is := is.New(t)
+
+			porterYaml := fmt.Sprintf(`version: v2
+name: service-env-app
+env:
+  NODE_ENV: production
+services:
+  wkr:
+    type: worker
+    run: node worker.js
+    %s
+`, tt.env)
+
+			_, err := ParseYAML(context.Background(), []byte(porterYaml))
+			is.True(err != nil)
+			is.True(strings.Contains(err.Error(), tt.wantErr)) // service env is validated, then rejected until the app contract supports it
+		})
+	}
+}
+
+func TestParseYAMLPredeploySteps(t *testing.T) {
+	tests := []struct {
+		name      string
+		predeploy string
+		wantErr   string
+	}{
+		{"single job", "predeploy:\n  run: npm run migrate", ""},
+		{"single step", "predeploy:\n  - name: migrate\n    run: npm run migrate\n    onFailure: abort", ""},
+		{"multiple steps", "predeploy:\n  - name: migrate\n    run: npm run migrate\n  - name: seed\n    run: npm run seed", "multiple predeploy steps are not supported"},
+		{"step without name", "predeploy:\n  - name: migrate\n    run: npm run migrate\n  - run: npm run seed", "predeploy step 2 must have a name"},
+		{"duplicate step name", "predeploy:\n  - name: migrate\n    run: npm run migrate\n  - name: migrate\n    run: npm run seed", "duplicate predeploy step name"},
+		{"timeout", "predeploy:\n  - name: migrate\n    run: npm run migrate\n    timeoutSeconds: 600", "predeploy timeouts and failure policies are not supported"},
+		{"invalid failure policy", "predeploy:\n  - name: migrate\n    run: npm run migrate\n    onFailure: retry", "invalid onFailure 'retry'"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			is := is.New(t)
+
+			porterYaml := fmt.Sprintf(`version: v2
+name: predeploy-app
+services:
+  web:
+    type: web
+    run: node index.js
+    port: 8080
+%s
+`, tt.predeploy)
+
+			appProto, err := ParseYAML(context.Background(), []byte(porterYaml))
+			if tt.wantErr != "" {
+				is.True(err != nil)
+				is.True(strings.Contains(err.Error(), tt.wantErr)) // predeploy steps are validated, then rejected until the app contract supports them
+				return
+			}
+
+			is.NoErr(err)
+			is.Equal(appProto.Predeploy.Run, "npm run migrate")
+			is.Equal(appProto.Predeploy.Type, porterv1.ServiceType_SERVICE_TYPE_JOB)
+		})
+	}
+}
+
+func TestParseYAMLPostdeploy(t *testing.T) {
+	tests := []struct {
+		name       string
+		postdeploy string
+		wantErr    string
+	}{
+		{"smoke test", "  - name: smoke\n    run: ./smoke.sh\n    timeoutSeconds: 120\n    onFailure: degrade", "postdeploy steps are not supported by the current app contract"},
+		{"step without name", "  - run: ./smoke.sh", "postdeploy step 1 must have a name"},
+		{"duplicate step name", "  - name: smoke\n    run: ./smoke.sh\n  - name: smoke\n    run: ./smoke.sh", "duplicate postdeploy step name"},
+		{"invalid failure policy", "  - name: smoke\n    run: ./smoke.sh\n    onFailure: abort", "invalid onFailure 'abort'"},
+		{"web step", "  - name: smoke\n    type: web\n    run: ./smoke.sh", "postdeploy steps must be jobs"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			is := is.New(t)
+
+			porterYaml := fmt.Sprintf(`version: v2
+name: postdeploy-app
+services:
+  web:
+    type: web
+    run: node index.js
+    port: 8080
+postdeploy:
+%s
+`, tt.postdeploy)
+
+			_, err := ParseYAML(context.Background(), []byte(porterYaml))
+			is.True(err != nil)
+			is.True(strings.Contains(err.Error(), tt.wantErr)) // postdeploy steps are validated, then rejected until the app contract supports them
+		})
+	}
+}
+
+func TestParseYAMLServiceDefaults(t *testing.T) {
+	is := is.New(t)
+
+	porterYaml := []byte(`version: v2
+name: defaults-app
+services:
+  web:
+    type: web
+    run: node index.js
+    port: 8080
+  wkr:
+    type: worker
+    run: node worker.js
+    cpuCores: 1
+    instances: 3
+  job:
+    type: job
+    run: node job.js
+predeploy:
+  run: npm run migrate
+`)
+
+	appProto, err := ParseYAML(context.Background(), porterYaml, v2.WithServiceDefaults(v2.ServiceDefaults{CpuCores: 0.5, RamMegabytes: 512, Instances: 2}))
+	is.NoErr(err)
+
+	web := appProto.Services["web"]
+	is.Equal(web.CpuCores, float32(0.5))
+	is.Equal(web.RamMegabytes, int32(512))
+	is.Equal(web.Instances, int32(2)) // services which omit resources are given the defaults
+
+	wkr := appProto.Services["wkr"]
+	is.Equal(wkr.CpuCores, float32(1))
+	is.Equal(wkr.RamMegabytes, int32(512))
+	is.Equal(wkr.Instances, int32(3)) // values set in the file take precedence over the defaults
+
+	is.Equal(appProto.Services["job"].Instances, int32(0)) // jobs are not given a default number of instances
+	is.Equal(appProto.Predeploy.RamMegabytes, int32(512))
+
+	appProto, err = ParseYAML(context.Background(), porterYaml)
+	is.NoErr(err)
+	is.Equal(appProto.Services["web"].CpuCores, float32(0)) // without defaults, omitted resources are left unset
+}
+
+func TestRenderAppTemplate(t *testing.T) {
+	is := is.New(t)
+
+	template := &models.AppTemplate{
+		Name: "rails-postgres",
+		PorterYAML: `version: v2
+name: rails-template
+services:
+  web:
+    type: web
+    run: bundle exec rails server -p ${var.port}
+    port: ${var.port}
+    cpuCores: ${var.cpu}
+env:
+  DATABASE_NAME: ${var.database_name}
+  APP_NAME: ${porter.name}
+`,
+		Variables: []byte(`[{"name":"port","default":"3000"},{"name":"cpu","default":"0.5"},{"name":"database_name","required":true}]`),
+	}
+	project := &models.Project{DefaultRAMMegabytes: 512}
+
+	porterYaml, err := RenderAppTemplate(context.Background(), project, template, "my-app", map[string]string{"database_name": "my_app", "cpu": "1"})
+	is.NoErr(err)
+
+	appProto, err := ParseYAML(context.Background(), porterYaml)
+	is.NoErr(err)
+	is.Equal(appProto.Name, "my-app") // the name of the template is replaced by the name of the app
+	is.Equal(appProto.Services["web"].Port, int32(3000))
+	is.Equal(appProto.Services["web"].CpuCores, float32(1))
+	is.True(appProto.Services["web"].GetWebConfig() != nil)
+	is.True(strings.Contains(string(porterYaml), "DATABASE_NAME: my_app"))
+	is.True(strings.Contains(string(porterYaml), "${porter.name}")) // references to the file are kept for the parser
+
+	tests := []struct {
+		name      string
+		variables string
+		values    map[string]string
+		wantErr   string
+	}{
+		{"missing required", `[{"name":"port"},{"name":"cpu"},{"name":"database_name","required":true}]`, nil, "template variables database_name are required"},
+		{"unknown value", `[{"name":"port"},{"name":"cpu"},{"name":"database_name"}]`, map[string]string{"db": "x"}, "template has no variables db"},
+		{"undefined variable", `[{"name":"port"},{"name":"cpu"}]`, nil, "template uses undefined variables database_name"},
+		{"invalid rendered file", `[{"name":"port"},{"name":"cpu","default":"lots"},{"name":"database_name"}]`, map[string]string{"port": "3000"}, "cpuCores"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			is := is.New(t)
+
+			invalid := *template
+			invalid.Variables = []byte(tt.variables)
+
+			_, err := RenderAppTemplate(context.Background(), project, &invalid, "my-app", tt.values)
+			is.True(err != nil)
+			is.True(strings.Contains(err.Error(), tt.wantErr))
+		})
+	}
+
+	err = ValidateAppTemplate(context.Background(), []byte("version: v1\nname: old\n"), nil)
+	is.True(err != nil && strings.Contains(err.Error(), "v2 porter yaml"))
+}
+
+func TestParseYAMLAWSEnv(t *testing.T) {
+	is := is.New(t)
+
+	porterYaml := []byte(`version: v2
+name: aws-env-app
+services:
+  api:
+    type: web
+    run: node api.js
+    port: 8080
+env:
+  NODE_ENV: production
+  DB_PASSWORD:
+    fromAws:
+      secretArn: arn:aws:secretsmanager:us-east-1:123456789012:secret:prod/db-AbCdEf
+      key: password
+  API_URL:
+    fromAws:
+      parameterArn: arn:aws:ssm:us-east-1:123456789012:parameter/prod/api-url
+`)
+
+	got, err := ParseYAML(context.Background(), porterYaml)
+	is.NoErr(err)
+	is.Equal(got.Env["NODE_ENV"], "production")
+
+	dbPassword, ok, err := v2.ParseAWSEnvReference(got.Env["DB_PASSWORD"])
+	is.NoErr(err)
+	is.True(ok) // aws sources are passed to the server as references, which are resolved when the app is applied
+	is.Equal(dbPassword.SecretArn, "arn:aws:secretsmanager:us-east-1:123456789012:secret:prod/db-AbCdEf")
+	is.Equal(dbPassword.Key, "password")
+
+	apiURL, ok, err := v2.ParseAWSEnvReference(got.Env["API_URL"])
+	is.NoErr(err)
+	is.True(ok)
+	is.Equal(apiURL.ParameterArn, "arn:aws:ssm:us-east-1:123456789012:parameter/prod/api-url")
+
+	_, ok, err = v2.ParseAWSEnvReference(got.Env["NODE_ENV"])
+	is.NoErr(err)
+	is.True(!ok)
+}
+
+func TestParseYAMLAWSEnvInvalid(t *testing.T) {
+	tests := []struct {
+		name  string
+		value string
+	}{
+		{"no source", "fromAws: {}"},
+		{"both sources", "fromAws:\n      secretArn: arn:aws:secretsmanager:us-east-1:123456789012:secret:db\n      parameterArn: arn:aws:ssm:us-east-1:123456789012:parameter/db"},
+		{"key on parameter", "fromAws:\n      parameterArn: arn:aws:ssm:us-east-1:123456789012:parameter/db\n      key: password"},
+		{"wrong service", "fromAws:\n      secretArn: arn:aws:ssm:us-east-1:123456789012:parameter/db"},
+		{"not an arn", "fromAws:\n      secretArn: prod/db"},
+		{"unknown source", "fromGcp:\n      secret: db"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			is := is.New(t)
+
+			porterYaml := fmt.Sprintf(`version: v2
+name: aws-env-app
+services:
+  api:
+    type: web
+    run: node api.js
+    port: 8080
+env:
+  DB_PASSWORD:
+    %s
+`, tt.value)
+
+			_, err := ParseYAML(context.Background(), []byte(porterYaml))
+			is.True(err != nil)
+		})
+	}
+}
+
+func TestParseYAMLBuildEnv(t *testing.T) {
+	is := is.New(t)
+
+	porterYaml := []byte(`version: v2
+name: build-env-app
+build:
+  context: ./
+  method: docker
+  dockerfile: ./Dockerfile
+  env:
+    NODE_ENV: production
+  args:
+    VERSION: 1.2
+    NPM_TOKEN:
+      secret: true
+      envGroup: npm
+      key: TOKEN
+services:
+  web:
+    type: web
+    run: node index.js
+    port: 8080
+`)
+
+	_, err := ParseYAML(context.Background(), porterYaml)
+	is.NoErr(err) // build env and args are only used by the CLI, and are accepted by the server
+
+	_, err = ParseYAML(context.Background(), []byte(strings.Replace(string(porterYaml), "secret: true", "secret: false", 1)))
+	is.True(err != nil)
+	is.True(strings.Contains(err.Error(), "must be a string or an object with secret: true"))
+}
+
+func TestParseYAMLReferences(t *testing.T) {
+	is := is.New(t)
+
+	porterYaml := []byte(`version: v2
+name: refs-app
+x-defaults: &defaults
+  cpuCores: 0.5
+  ramMegabytes: 512
+image:
+  repository: my-repo/app
+  tag: v1.2.0
+env:
+  APP_NAME: ${porter.name}
+  IMAGE: ${porter.image.repository}:${porter.image.tag}
+services:
+  web:
+    <<: *defaults
+    type: web
+    run: echo ${HOME} && node index.js
+    port: 8080
+    instances: ${porter.services.worker.instances}
+  worker:
+    <<: *defaults
+    type: worker
+    run: node worker.js
+    instances: 2
+`)
+
+	got, err := ParseYAML(context.Background(), porterYaml)
+	is.NoErr(err)
+	is.Equal(got.Env["APP_NAME"], "refs-app")
+	is.Equal(got.Env["IMAGE"], "my-repo/app:v1.2.0")
+	is.Equal(got.Services["web"].Instances, int32(2)) // a value which is only a reference keeps the type of the value it refers to
+	is.Equal(got.Services["web"].CpuCores, float32(0.5))
+	is.Equal(got.Services["web"].Run, "echo ${HOME} && node index.js") // expressions which are not references are left as they are
+
+	validationErrs, err := ValidateYAML(context.Background(), porterYaml)
+	is.NoErr(err)
+	is.Equal(len(validationErrs), 0) // x- keys are not unknown fields
+
+	validationErrs, err = ValidateYAML(context.Background(), bytes.ReplaceAll(porterYaml, []byte("porter.image.tag"), []byte("porter.image.digest")))
+	is.NoErr(err)
+	is.Equal(len(validationErrs), 1)
+	is.Equal(validationErrs[0].Field, "env.IMAGE")
+	is.Equal(validationErrs[0].Line, 11)
+}
+
+func TestInterpolateEnv(t *testing.T) {
+	is := is.New(t)
+
+	porterYaml := []byte(`version: v2
+name: env-app
+image:
+  repository: my-repo/app
+  tag: "${TAG}"
+services:
+  web:
+    type: web
+    run: echo ${HOME}
+    port: 8080
+    instances: ${REPLICAS}
+`)
+	env := map[string]string{"TAG": "123", "REPLICAS": "3", "HOME": "/root"}
+	lookupEnv := func(name string) (string, bool) {
+		value, ok := env[name]
+		return value, ok
+	}
+
+	interpolated, err := v2.InterpolateEnv(porterYaml, []string{"TAG", "REPLICAS"}, lookupEnv)
+	is.NoErr(err)
+
+	got, err := ParseYAML(context.Background(), interpolated)
+	is.NoErr(err)
+	is.Equal(got.Image.Tag, "123") // quoted values stay strings
+	is.Equal(got.Services["web"].Instances, int32(3))
+	is.Equal(got.Services["web"].Run, "echo ${HOME}") // env vars which are not allowed are not interpolated
+
+	_, err = v2.InterpolateEnv(porterYaml, []string{"TAG", "REPLICAS", "UNSET"}, lookupEnv)
+	is.NoErr(err) // allowed env vars only need to be set if they are used
+
+	delete(env, "REPLICAS")
+	_, err = v2.InterpolateEnv(porterYaml, []string{"TAG", "REPLICAS"}, lookupEnv)
+	is.True(err != nil)
+}
+
+func TestParseYAMLUnknownFields(t *testing.T) {
+	is := is.New(t)
+
+	porterYaml := []byte(`version: v2
+name: strict-app
+services:
+  web:
+    type: web
+    run: node index.js
+    port: 8080
+    healtcheck:
+      enabled: true
+      httpPath: /healthz
+    autoscaling:
+      enabled: true
+      minInstances: 1
+      maxInstances: 3
+      cpuThreshold: 50
+`)
+
+	_, err := ParseYAML(context.Background(), porterYaml)
+	is.True(err != nil)
+
+	var unknownErr *v2.UnknownFieldsError
+	is.True(errors.As(err, &unknownErr))
+	is.Equal(unknownErr.Paths, []string{"services.web.autoscaling.cpuThreshold", "services.web.healtcheck"}) // unknown fields are reported with their path
+
+	_, err = ParseYAML(context.Background(), []byte("version: v2\nname: strict-app\nservices:\n  web:\n    run: node index.js\npredeploy:\n  - name: migrate\n    run: npm run migrate\n    timeout: 600\n"))
+	is.True(errors.As(err, &unknownErr))
+	is.Equal(unknownErr.Paths, []string{"predeploy.0.timeout"}) // the keys of predeploy steps are checked
+
+	_, err = ParseYAML(context.Background(), porterYaml, v2.WithStrictParsing(false))
+	is.NoErr(err) // unknown fields are ignored when strict parsing is turned off
+}
+
+func TestMergePorterYAML(t *testing.T) {
+	is := is.New(t)
+
+	base := []byte(`version: v2
+name: merge-app
+image:
+  repository: my-repo/app
+  tag: latest
+env:
+  NODE_ENV: development
+  DEBUG: "true"
+services:
+  web:
+    type: web
+    run: node index.js
+    port: 8080
+    instances: 1
+    domains:
+      - name: staging.example.com
+  worker:
+    type: worker
+    run: node worker.js
+`)
+	prod := []byte(`image:
+  tag: v1.2.0
+env:
+  NODE_ENV: production
+  DEBUG: null
+services:
+  web:
+    instances: 3
+    domains:
+      - name: example.com
+  cron:
+    type: job
+    run: node cron.js
+    cron: "0 * * * *"
+`)
+
+	merged, err := v2.MergePorterYAML(base, prod)
+	is.NoErr(err)
+
+	got, err := ParseYAML(context.Background(), merged)
+	is.NoErr(err)
+	is.Equal(got.Name, "merge-app")
+	is.Equal(got.Image.Tag, "v1.2.0")
+	is.Equal(got.Env["NODE_ENV"], "production")
+	_, ok := got.Env["DEBUG"]
+	is.True(!ok) // null values remove keys of the files before them
+	is.Equal(len(got.Services), 3)
+	is.Equal(got.Services["web"].Run, "node index.js") // services are merged by name
+	is.Equal(got.Services["web"].Instances, int32(3))
+	is.Equal(len(got.Services["web"].GetWebConfig().Domains), 1) // lists are replaced
+	is.Equal(got.Services["web"].GetWebConfig().Domains[0].Name, "example.com")
+
+	again, err := v2.MergePorterYAML(base, prod)
+	is.NoErr(err)
+	is.Equal(string(again), string(merged)) // merging is deterministic
+
+	_, err = v2.MergePorterYAML(base, []byte("version: v1\n"))
+	is.True(err != nil) // overlays cannot change the version
+}
+
+func TestAppYamlFromProto(t *testing.T) {
+	is := is.New(t)
+
+	porterYaml, err := os.ReadFile("testdata/v2_input_nobuild.yaml")
+	is.NoErr(err)
+
+	want, err := ParseYAML(context.Background(), porterYaml)
+	is.NoErr(err)
+	want.Env["DB_PASSWORD"] = v2.AWSEnvReferencePrefix + `{"secretArn":"arn:aws:secretsmanager:us-east-1:123456789012:secret:prod/db-AbCdEf","key":"password"}`
+
+	out, err := v2.AppYamlFromProto(context.Background(), want)
+	is.NoErr(err)
+	is.True(strings.HasPrefix(string(out), "version: v2\n"))
+	is.True(strings.Contains(string(out), "fromAws:")) // aws references are written as fromAws sources
+
+	got, err := ParseYAML(context.Background(), out)
+	is.NoErr(err)
+	is.True(proto.Equal(got, want)) // the written porter.yaml converts back into the same app
+
+	validationErrs, err := ValidateYAML(context.Background(), out)
+	is.NoErr(err)
+	is.Equal(len(validationErrs), 0)
+}
+
+func TestValidateYAML(t *testing.T) {
+	for _, porterYamlFileName := range []string{"v2_input_nobuild", "v2_input_previews"} {
+		t.Run(porterYamlFileName, func(t *testing.T) {
+			is := is.New(t)
+
+			porterYaml, err := os.ReadFile(fmt.Sprintf("testdata/%s.yaml", porterYamlFileName))
+			is.NoErr(err) // no error expected reading test file
+
+			validationErrs, err := ValidateYAML(context.Background(), porterYaml)
+			is.NoErr(err)
+			is.Equal(len(validationErrs), 0) // a valid file has no validation errors
+		})
+	}
+}
+
+func TestValidateYAMLErrors(t *testing.T) {
+	tests := []struct {
+		name      string
+		services  string
+		wantField string
+		wantLine  int
+	}{
+		{"invalid type", "  api:\n    type: cron\n    run: node api.js\n", "services.api.type", 5},
+		{"cron on web service", "  api:\n    type: web\n    run: node api.js\n    cron: \"0 * * * *\"\n", "services.api.cron", 7},
+		{"domains on worker", "  api:\n    type: worker\n    run: node api.js\n    domains:\n      - name: api.example.com\n", "services.api.domains", 7},
+		{"invalid toleration", "  api-web:\n    run: node api.js\n    tolerations:\n      - key: gpu\n        effect: Sometimes\n", "services.api-web.tolerations.0.effect", 8},
+		{"unknown field", "  api:\n    type: web\n    run: node api.js\n    replicas: 2\n", "services.api.replicas", 7},
+		{"wrong type", "  api:\n    type: web\n    run: node api.js\n    port: http\n", "services.api.port", 7},
+		{"uninferable type", "  api:\n    run: node api.js\n", "services.api", 4},
+		{"invalid queue type", "  wkr:\n    type: worker\n    run: node worker.js\n    scaleOnQueue:\n      type: kafka\n      queueName: jobs\n      targetBacklogPerInstance: 50\n      maxInstances: 20\n", "services.wkr.scaleOnQueue.type", 8},
+		{"invalid postdeploy failure policy", "  api:\n    type: web\n    run: node api.js\npostdeploy:\n  - name: smoke\n    run: ./smoke.sh\n    onFailure: retry\n", "postdeploy.0.onFailure", 10},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			is := is.New(t)
+
+			porterYaml := fmt.Sprintf("version: v2\nname: validate-app\nservices:\n%s", tt.services)
+
+			validationErrs, err := ValidateYAML(context.Background(), []byte(porterYaml))
+			is.NoErr(err)
+			is.Equal(len(validationErrs), 1)
+			is.Equal(validationErrs[0].Field, tt.wantField)
+			is.Equal(validationErrs[0].Line, tt.wantLine) // errors point at the line of the field
+		})
+	}
+}
+
+func TestValidateYAMLSyntaxError(t *testing.T) {
+	is := is.New(t)
+
+	validationErrs, err := ValidateYAML(context.Background(), []byte("version: v2\nname: validate-app\nservices:\n  api:\n    type: web\n    run: node: api.js\n"))
+	is.NoErr(err)
+	is.Equal(len(validationErrs), 1)
+	is.Equal(validationErrs[0].Line, 6) // syntax errors point at the line of the error
+}
+
+func TestPorterYAMLJSONSchema(t *testing.T) {
+	is := is.New(t)
+
+	schemaBytes, err := json.Marshal(v2.PorterYAMLJSONSchema())
+	is.NoErr(err)
+
+	compiler := jsonschema.NewCompiler()
+	is.NoErr(compiler.AddResource("schema.json", bytes.NewReader(schemaBytes)))
+
+	schema, err := compiler.Compile("schema.json")
+	is.NoErr(err) // the generated schema is a valid JSON Schema
+
+	for _, porterYamlFileName := range []string{"v2_input_nobuild", "v2_input_previews"} {
+		porterYaml, err := os.ReadFile(fmt.Sprintf("testdata/%s.yaml", porterYamlFileName))
+		is.NoErr(err) // no error expected reading test file
+
+		var value any
+		is.NoErr(yaml.Unmarshal(porterYaml, &value))
+		is.NoErr(schema.Validate(value)) // valid files match the schema
+	}
+
+	var invalid any
+	is.NoErr(yaml.Unmarshal([]byte("version: v2\nname: schema-app\nservices:\n  api:\n    type: cron\n"), &invalid))
+	is.True(schema.Validate(invalid) != nil) // the allowed values of validate tags are part of the schema
+}
@@ -0,0 +1,118 @@
+package porter_app
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"regexp"
+
+	"github.com/porter-dev/porter/internal/kubernetes"
+	"github.com/porter-dev/porter/internal/models"
+	"github.com/porter-dev/porter/internal/repository"
+	"github.com/porter-dev/porter/internal/telemetry"
+)
+
+const (
+	// DeploymentTargetSelector_Default is the selector for the default deployment target in a cluster
+	DeploymentTargetSelector_Default = "default"
+	// DeploymentTargetSelectorType_Namespace is the selector type for deployment targets that are namespaces
+	DeploymentTargetSelectorType_Namespace = "NAMESPACE"
+)
+
+// previewNameRegex matches valid kubernetes namespace names
+var previewNameRegex = regexp.MustCompile(`^[a-z0-9]([-a-z0-9]{0,61}[a-z0-9])?$`)
+
+// PreviewNameForPullRequest returns the name of the preview environment for a pull request
+func PreviewNameForPullRequest(number int) string {
+	return fmt.Sprintf("preview-pr-%d", number)
+}
+
+// GetOrCreatePreviewDeploymentTarget returns the preview deployment target with the given name, creating the target
+// and its namespace if they do not exist
+func GetOrCreatePreviewDeploymentTarget(
+	ctx context.Context,
+	repo repository.Repository,
+	agent *kubernetes.Agent,
+	projectID, clusterID uint,
+	name string,
+) (*models.DeploymentTarget, error) {
+	ctx, span := telemetry.NewSpan(ctx, "porter-app-get-or-create-preview-deployment-target")
+	defer span.End()
+
+	telemetry.WithAttributes(span, telemetry.AttributeKV{Key: "preview-name", Value: name})
+
+	if !previewNameRegex.MatchString(name) || name == DeploymentTargetSelector_Default {
+		return nil, telemetry.Error(ctx, span, nil, "preview name must be a valid namespace name")
+	}
+
+	deploymentTarget, err := repo.DeploymentTarget().DeploymentTargetBySelectorAndSelectorType(ctx, projectID, clusterID, name, DeploymentTargetSelectorType_Namespace)
+	if err != nil {
+		deploymentTarget, err = repo.DeploymentTarget().CreateDeploymentTarget(ctx, &models.DeploymentTarget{
+			ProjectID:    int(projectID),
+			ClusterID:    int(clusterID),
+			Selector:     name,
+			SelectorType: DeploymentTargetSelectorType_Namespace,
+			Preview:      true,
+		})
+		if err != nil {
+			return nil, telemetry.Error(ctx, span, err, "error creating preview deployment target")
+		}
+	}
+
+	if !deploymentTarget.Preview {
+		return nil, telemetry.Error(ctx, span, nil, "deployment target with this name is not a preview environment")
+	}
+
+	telemetry.WithAttributes(span, telemetry.AttributeKV{Key: "deployment-target-id", Value: deploymentTarget.ID.String()})
+
+	_, err = agent.CreateNamespace(name, map[string]string{
+		"porter.run/preview":              "true",
+		"porter.run/deployment-target-id": deploymentTarget.ID.String(),
+	})
+	if err != nil {
+		return nil, telemetry.Error(ctx, span, err, "error creating preview namespace")
+	}
+
+	return deploymentTarget, nil
+}
+
+// DeletePreviewDeploymentTarget deletes the preview deployment target with the given name along with its namespace
+func DeletePreviewDeploymentTarget(
+	ctx context.Context,
+	repo repository.Repository,
+	agent *kubernetes.Agent,
+	projectID, clusterID uint,
+	name string,
+) error {
+	ctx, span := telemetry.NewSpan(ctx, "porter-app-delete-preview-deployment-target")
+	defer span.End()
+
+	telemetry.WithAttributes(span, telemetry.AttributeKV{Key: "preview-name", Value: name})
+
+	deploymentTarget, err := repo.DeploymentTarget().DeploymentTargetBySelectorAndSelectorType(ctx, projectID, clusterID, name, DeploymentTargetSelectorType_Namespace)
+	if err != nil {
+		// nothing to clean up
+		return nil
+	}
+
+	if !deploymentTarget.Preview {
+		return telemetry.Error(ctx, span, errors.New("not a preview environment"), "refusing to delete deployment target")
+	}
+
+	err = agent.DeleteNamespace(name)
+	if err != nil {
+		return telemetry.Error(ctx, span, err, "error deleting preview namespace")
+	}
+
+	err = repo.DeploymentTarget().DeleteDeploymentTarget(ctx, deploymentTarget)
+	if err != nil {
+		return telemetry.Error(ctx, span, err, "error deleting preview deployment target")
+	}
+
+	err = repo.Subdomain().DeleteSubdomainAllocationsByDeploymentTarget(ctx, deploymentTarget.ID.String())
+	if err != nil {
+		return telemetry.Error(ctx, span, err, "error releasing subdomains of preview deployment target")
+	}
+
+	return nil
+}
@@ -22,6 +22,14 @@ type Revision struct {
 	CreatedAt time.Time `json:"created_at"`
 	// UpdatedAt is the time the revision was updated
 	UpdatedAt time.Time `json:"updated_at"`
+	// DeployedBy is the email of the user who applied the revision, if known
+	DeployedBy string `json:"deployed_by,omitempty"`
+	// CommitSHA is the commit the revision was built from, if known
+	CommitSHA string `json:"commit_sha,omitempty"`
+	// GitBranch is the branch the revision was applied from, if known
+	GitBranch string `json:"git_branch,omitempty"`
+	// GitRepoURL is the URL of the repository the revision was applied from, if known
+	GitRepoURL string `json:"git_repo_url,omitempty"`
 }
 
 // EncodedRevisionFromProto converts an AppRevision proto object into a Revision object
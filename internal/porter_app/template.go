@@ -0,0 +1,66 @@
+package porter_app
+
+import (
+	"context"
+
+	"github.com/porter-dev/porter/api/types"
+	"github.com/porter-dev/porter/internal/models"
+	v2 "github.com/porter-dev/porter/internal/porter_app/v2"
+	"github.com/porter-dev/porter/internal/telemetry"
+)
+
+// ValidateAppTemplate checks that a porter.yaml file and its variables can be saved as an app template
+func ValidateAppTemplate(ctx context.Context, porterYaml []byte, variables []types.AppTemplateVariable) error {
+	ctx, span := telemetry.NewSpan(ctx, "porter-app-validate-template")
+	defer span.End()
+
+	err := v2.ValidateTemplate(porterYaml, templateVariables(variables))
+	if err != nil {
+		return telemetry.Error(ctx, span, err, "invalid app template")
+	}
+
+	return nil
+}
+
+// RenderAppTemplate returns the porter.yaml of a new app created from an app template with the given variables. The file is
+// parsed with the resource presets of the project, so that a template which renders to an invalid app is rejected.
+func RenderAppTemplate(ctx context.Context, project *models.Project, template *models.AppTemplate, appName string, values map[string]string) ([]byte, error) {
+	ctx, span := telemetry.NewSpan(ctx, "porter-app-render-template")
+	defer span.End()
+
+	telemetry.WithAttributes(span,
+		telemetry.AttributeKV{Key: "template-name", Value: template.Name},
+		telemetry.AttributeKV{Key: "app-name", Value: appName},
+	)
+
+	variables, err := template.TemplateVariables()
+	if err != nil {
+		return nil, telemetry.Error(ctx, span, err, "error decoding template variables")
+	}
+
+	porterYaml, err := v2.RenderTemplate([]byte(template.PorterYAML), templateVariables(variables), appName, values)
+	if err != nil {
+		return nil, telemetry.Error(ctx, span, err, "error rendering template")
+	}
+
+	_, err = ParseYAML(ctx, porterYaml, ProjectServiceDefaults(project))
+	if err != nil {
+		return nil, telemetry.Error(ctx, span, err, "template does not render to a valid porter yaml file")
+	}
+
+	return porterYaml, nil
+}
+
+func templateVariables(variables []types.AppTemplateVariable) []v2.TemplateVariable {
+	res := make([]v2.TemplateVariable, 0, len(variables))
+	for _, variable := range variables {
+		res = append(res, v2.TemplateVariable{
+			Name:        variable.Name,
+			Description: variable.Description,
+			Default:     variable.Default,
+			Required:    variable.Required,
+		})
+	}
+
+	return res
+}
@@ -0,0 +1,86 @@
+package v2
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+
+	porterv1 "github.com/porter-dev/api-contracts/generated/go/porter/v1"
+	"k8s.io/apimachinery/pkg/util/validation"
+)
+
+// autoscalingProtoFromConfig converts the autoscaling settings of a web or worker service into the autoscaling proto.
+// The app contract only has CPU and memory thresholds, so requests per second, external metric targets and schedules are validated and then
+// rejected rather than silently leaving the service to scale on CPU and memory alone.
+func autoscalingProtoFromConfig(autoscaling *AutoScaling, serviceType porterv1.ServiceType) (*porterv1.Autoscaling, error) {
+	if autoscaling == nil {
+		return nil, nil
+	}
+
+	if err := validateCustomMetrics(autoscaling, serviceType); err != nil {
+		return nil, fmt.Errorf("invalid autoscaling: %w", err)
+	}
+
+	if err := validateScalingSchedules(autoscaling); err != nil {
+		return nil, err
+	}
+
+	if autoscaling.RequestsPerSecond != 0 {
+		return nil, errors.New("autoscaling on requests per second is not supported by the current app contract")
+	}
+
+	if len(autoscaling.ExternalMetrics) > 0 {
+		return nil, errors.New("autoscaling on external metrics is not supported by the current app contract")
+	}
+
+	return &porterv1.Autoscaling{
+		Enabled:                autoscaling.Enabled,
+		MinInstances:           int32(autoscaling.MinInstances),
+		MaxInstances:           int32(autoscaling.MaxInstances),
+		CpuThresholdPercent:    int32(autoscaling.CpuThresholdPercent),
+		MemoryThresholdPercent: int32(autoscaling.MemoryThresholdPercent),
+	}, nil
+}
+
+// validateCustomMetrics checks that the requests per second and external metric targets of a service are well-formed
+func validateCustomMetrics(autoscaling *AutoScaling, serviceType porterv1.ServiceType) error {
+	if autoscaling.RequestsPerSecond < 0 {
+		return errors.New("requestsPerSecond cannot be negative")
+	}
+
+	if autoscaling.RequestsPerSecond > 0 && serviceType != porterv1.ServiceType_SERVICE_TYPE_WEB {
+		return errors.New("requestsPerSecond is only supported for web services")
+	}
+
+	names := make(map[string]bool, len(autoscaling.ExternalMetrics))
+	for _, metric := range autoscaling.ExternalMetrics {
+		if metric.Name == "" {
+			return errors.New("external metric name is required")
+		}
+
+		if errs := validation.IsDNS1123Subdomain(metric.Name); len(errs) > 0 {
+			return fmt.Errorf("invalid external metric name '%s': %s", metric.Name, strings.Join(errs, ", "))
+		}
+
+		if names[metric.Name] {
+			return fmt.Errorf("duplicate external metric '%s'", metric.Name)
+		}
+		names[metric.Name] = true
+
+		if metric.TargetValuePerInstance <= 0 {
+			return fmt.Errorf("external metric '%s' must have a positive targetValuePerInstance", metric.Name)
+		}
+
+		for key, val := range metric.Selector {
+			if errs := validation.IsQualifiedName(key); len(errs) > 0 {
+				return fmt.Errorf("invalid selector key '%s' for external metric '%s': %s", key, metric.Name, strings.Join(errs, ", "))
+			}
+
+			if errs := validation.IsValidLabelValue(val); len(errs) > 0 {
+				return fmt.Errorf("invalid selector value '%s' for external metric '%s': %s", val, metric.Name, strings.Join(errs, ", "))
+			}
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,86 @@
+package v2
+
+import (
+	"encoding/json"
+	"errors"
+	"strings"
+)
+
+// BuildEnvValue is the value of a build env var or build arg in a Porter YAML file. It is either a literal string, or a secret
+// which the CLI reads from an env group of the project when the image is built, so that it is not written in the file:
+//
+//	build:
+//	  args:
+//	    NPM_TOKEN:
+//	      secret: true
+//	      envGroup: npm
+//	      key: TOKEN
+type BuildEnvValue struct {
+	Value string
+	// Secret is true if the value is read from an env group when the image is built
+	Secret bool
+	// EnvGroup is the env group the value is read from. The env groups linked to the app are searched if it is empty.
+	EnvGroup string
+	// Key is the variable of the env group, defaulting to the name of the build env var or arg
+	Key string
+}
+
+type buildEnvValueObject struct {
+	Secret   bool   `json:"secret"`
+	EnvGroup string `json:"envGroup,omitempty"`
+	Key      string `json:"key,omitempty"`
+}
+
+// UnmarshalJSON accepts either a scalar or an object with secret: true. Numbers and booleans are kept as written.
+func (e *BuildEnvValue) UnmarshalJSON(data []byte) error {
+	var value string
+	if err := json.Unmarshal(data, &value); err == nil {
+		*e = BuildEnvValue{Value: value}
+		return nil
+	}
+
+	var scalar any
+	if err := json.Unmarshal(data, &scalar); err == nil {
+		switch scalar.(type) {
+		case float64, bool:
+			*e = BuildEnvValue{Value: strings.TrimSpace(string(data))}
+			return nil
+		}
+	}
+
+	obj := buildEnvValueObject{}
+	decoder := json.NewDecoder(strings.NewReader(string(data)))
+	decoder.DisallowUnknownFields()
+	if err := decoder.Decode(&obj); err != nil || !obj.Secret {
+		return errors.New("build env values must be a string or an object with secret: true")
+	}
+
+	*e = BuildEnvValue{Secret: true, EnvGroup: obj.EnvGroup, Key: obj.Key}
+	return nil
+}
+
+// MarshalJSON writes literal values as strings, so that files without secrets are unchanged
+func (e BuildEnvValue) MarshalJSON() ([]byte, error) {
+	if !e.Secret {
+		return json.Marshal(e.Value)
+	}
+
+	return json.Marshal(buildEnvValueObject{Secret: true, EnvGroup: e.EnvGroup, Key: e.Key})
+}
+
+// MarshalYAML writes build env values the same way as MarshalJSON
+func (e BuildEnvValue) MarshalYAML() (interface{}, error) {
+	if !e.Secret {
+		return e.Value, nil
+	}
+
+	obj := map[string]interface{}{"secret": true}
+	if e.EnvGroup != "" {
+		obj["envGroup"] = e.EnvGroup
+	}
+	if e.Key != "" {
+		obj["key"] = e.Key
+	}
+
+	return obj, nil
+}
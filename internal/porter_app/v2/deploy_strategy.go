@@ -0,0 +1,224 @@
+package v2
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	porterv1 "github.com/porter-dev/api-contracts/generated/go/porter/v1"
+)
+
+// ServiceDeploy represents the rollout settings of a single web or worker service
+type ServiceDeploy struct {
+	Strategy *DeployStrategy `yaml:"strategy,omitempty"`
+	// MinReadySeconds is how long a new instance must be ready before it counts as available, so that a rollout does not
+	// replace instances faster than the new ones can serve
+	MinReadySeconds int `yaml:"minReadySeconds,omitempty" validate:"min=0,max=3600"`
+}
+
+// DeployStrategy is how a new revision of a service replaces the current one. A strategy is either canary or blueGreen, which
+// are only supported for web services, or a rolling update tuned with maxSurge and maxUnavailable. Services without a strategy
+// are replaced with a rolling update with the default settings.
+type DeployStrategy struct {
+	Canary    *CanaryStrategy    `yaml:"canary,omitempty" validate:"excluded_with=BlueGreen"`
+	BlueGreen *BlueGreenStrategy `yaml:"blueGreen,omitempty"`
+
+	// MaxSurge is how many instances can be created above the number of instances of the service during a rolling update,
+	// as a number or a percentage such as 25%
+	MaxSurge IntOrPercent `yaml:"maxSurge,omitempty"`
+	// MaxUnavailable is how many instances of the service can be unavailable during a rolling update, as a number or a
+	// percentage such as 25%. Set it to 0 to keep the full capacity of the service during a rollout.
+	MaxUnavailable IntOrPercent `yaml:"maxUnavailable,omitempty"`
+}
+
+// IntOrPercent is a number, or a percentage written as a string such as 25%. It is empty when it is not set.
+type IntOrPercent string
+
+// UnmarshalJSON accepts either a number or a string
+func (v *IntOrPercent) UnmarshalJSON(data []byte) error {
+	var number int
+	if err := json.Unmarshal(data, &number); err == nil {
+		*v = IntOrPercent(strconv.Itoa(number))
+		return nil
+	}
+
+	var value string
+	if err := json.Unmarshal(data, &value); err != nil {
+		return errors.New("must be a number or a percentage such as 25%")
+	}
+	*v = IntOrPercent(value)
+
+	return nil
+}
+
+// MarshalYAML writes numbers as numbers, so that files are written the same way as they are usually read
+func (v IntOrPercent) MarshalYAML() (interface{}, error) {
+	if number, err := strconv.Atoi(string(v)); err == nil {
+		return number, nil
+	}
+
+	return string(v), nil
+}
+
+// parse returns the value of a number or percentage, and whether it is a percentage
+func (v IntOrPercent) parse() (int, bool, error) {
+	value, isPercent := strings.CutSuffix(string(v), "%")
+
+	number, err := strconv.Atoi(value)
+	if err != nil || number < 0 || (isPercent && number > 100) {
+		return 0, false, fmt.Errorf("'%s' must be a non-negative number or a percentage between 0%% and 100%%", v)
+	}
+
+	return number, isPercent, nil
+}
+
+// CanaryStrategy shifts traffic to the new revision in steps, waiting for the bake time of each step before the next one.
+// The new revision receives all traffic after the last step.
+type CanaryStrategy struct {
+	Steps []CanaryStep `yaml:"steps" validate:"required,min=1,dive"`
+}
+
+// CanaryStep is a step of a canary rollout
+type CanaryStep struct {
+	// Percentage is the percentage of traffic sent to the new revision during the step
+	Percentage int `yaml:"percentage" validate:"min=1,max=100"`
+	// BakeTime is how long the step lasts before traffic is shifted further, e.g. 5m. The rollout waits to be promoted if it is empty.
+	BakeTime string `yaml:"bakeTime,omitempty"`
+}
+
+// BlueGreenStrategy runs the new revision alongside the current one, and switches all traffic to it once it is promoted
+type BlueGreenStrategy struct {
+	// AutoPromoteAfter is how long the new revision runs before it is promoted, e.g. 10m. The new revision waits to be promoted
+	// manually if it is empty.
+	AutoPromoteAfter string `yaml:"autoPromoteAfter,omitempty"`
+}
+
+// validateDeployStrategy checks the rollout settings of a service. The app contract does not yet have fields for deploy strategies
+// or rolling update settings, so they are rejected rather than silently replaced by a rolling update with the default settings.
+func validateDeployStrategy(service Service, serviceType porterv1.ServiceType) error {
+	if service.Deploy == nil {
+		return nil
+	}
+
+	if serviceType == porterv1.ServiceType_SERVICE_TYPE_JOB {
+		return errors.New("deploy settings are only supported for web and worker services")
+	}
+
+	if service.Deploy.MinReadySeconds < 0 {
+		return errors.New("minReadySeconds cannot be negative")
+	}
+
+	strategy := service.Deploy.Strategy
+	if strategy == nil {
+		if service.Deploy.MinReadySeconds > 0 {
+			return errors.New("rolling update settings are not supported by the current app contract")
+		}
+		return nil
+	}
+
+	rolling := strategy.MaxSurge != "" || strategy.MaxUnavailable != ""
+
+	switch {
+	case strategy.Canary != nil && strategy.BlueGreen != nil:
+		return errors.New("deploy strategy cannot be both canary and blueGreen")
+	case (strategy.Canary != nil || strategy.BlueGreen != nil) && rolling:
+		return errors.New("maxSurge and maxUnavailable only apply to rolling updates, and cannot be used with canary or blueGreen")
+	case strategy.Canary != nil || strategy.BlueGreen != nil:
+		if serviceType != porterv1.ServiceType_SERVICE_TYPE_WEB {
+			return errors.New("canary and blueGreen deploy strategies are only supported for web services")
+		}
+	case rolling:
+		if err := validateRollingUpdate(*strategy); err != nil {
+			return err
+		}
+		return errors.New("rolling update settings are not supported by the current app contract")
+	default:
+		return errors.New("deploy strategy must be either canary, blueGreen or a rolling update with maxSurge or maxUnavailable")
+	}
+
+	if strategy.Canary != nil {
+		if err := validateCanaryStrategy(*strategy.Canary); err != nil {
+			return err
+		}
+	}
+
+	if strategy.BlueGreen != nil && strategy.BlueGreen.AutoPromoteAfter != "" {
+		if _, err := parseStrategyDuration(strategy.BlueGreen.AutoPromoteAfter); err != nil {
+			return fmt.Errorf("invalid blueGreen autoPromoteAfter: %w", err)
+		}
+	}
+
+	return errors.New("deploy strategies are not supported by the current app contract")
+}
+
+// validateRollingUpdate checks that maxSurge and maxUnavailable are numbers or percentages, and that a rolling update can make
+// progress, which it cannot if neither new instances can be added nor old ones removed
+func validateRollingUpdate(strategy DeployStrategy) error {
+	// the defaults of kubernetes are 25% for both
+	maxSurge, maxUnavailable := 25, 25
+
+	if strategy.MaxSurge != "" {
+		number, _, err := strategy.MaxSurge.parse()
+		if err != nil {
+			return fmt.Errorf("invalid maxSurge: %w", err)
+		}
+		maxSurge = number
+	}
+
+	if strategy.MaxUnavailable != "" {
+		number, _, err := strategy.MaxUnavailable.parse()
+		if err != nil {
+			return fmt.Errorf("invalid maxUnavailable: %w", err)
+		}
+		maxUnavailable = number
+	}
+
+	if maxSurge == 0 && maxUnavailable == 0 {
+		return errors.New("maxSurge and maxUnavailable cannot both be 0")
+	}
+
+	return nil
+}
+
+// validateCanaryStrategy checks that a canary strategy has at least one step, and that the traffic percentage increases with each step
+func validateCanaryStrategy(canary CanaryStrategy) error {
+	if len(canary.Steps) == 0 {
+		return errors.New("canary strategy must have at least one step")
+	}
+
+	previousPercentage := 0
+	for i, step := range canary.Steps {
+		if step.Percentage < 1 || step.Percentage > 100 {
+			return fmt.Errorf("canary step %d percentage must be between 1 and 100, got %d", i+1, step.Percentage)
+		}
+
+		if step.Percentage <= previousPercentage {
+			return fmt.Errorf("canary step %d percentage must be greater than the previous step", i+1)
+		}
+		previousPercentage = step.Percentage
+
+		if step.BakeTime != "" {
+			if _, err := parseStrategyDuration(step.BakeTime); err != nil {
+				return fmt.Errorf("invalid canary step %d bakeTime: %w", i+1, err)
+			}
+		}
+	}
+
+	return nil
+}
+
+func parseStrategyDuration(value string) (time.Duration, error) {
+	duration, err := time.ParseDuration(value)
+	if err != nil {
+		return 0, err
+	}
+
+	if duration <= 0 {
+		return 0, fmt.Errorf("duration must be positive, got '%s'", value)
+	}
+
+	return duration, nil
+}
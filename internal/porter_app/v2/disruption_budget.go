@@ -0,0 +1,60 @@
+package v2
+
+import (
+	"errors"
+	"fmt"
+
+	porterv1 "github.com/porter-dev/api-contracts/generated/go/porter/v1"
+)
+
+// DisruptionBudget limits how many instances of a service can be evicted at once by voluntary disruptions, such as the node
+// drains of a cluster upgrade. Exactly one of MinAvailable and MaxUnavailable is set, as a number or a percentage such as 50%.
+type DisruptionBudget struct {
+	MinAvailable   IntOrPercent `yaml:"minAvailable,omitempty"`
+	MaxUnavailable IntOrPercent `yaml:"maxUnavailable,omitempty"`
+}
+
+// validateDisruptionBudget checks the disruption budget of a service. The app contract does not yet have a field for disruption
+// budgets, so a budget is rejected rather than leaving every instance of the service to be evicted at once.
+func validateDisruptionBudget(service Service, serviceType porterv1.ServiceType) error {
+	budget := service.DisruptionBudget
+	if budget == nil {
+		return nil
+	}
+
+	if serviceType == porterv1.ServiceType_SERVICE_TYPE_JOB {
+		return errors.New("disruption budgets are only supported for web and worker services")
+	}
+
+	switch {
+	case budget.MinAvailable != "" && budget.MaxUnavailable != "":
+		return errors.New("disruption budget cannot set both minAvailable and maxUnavailable")
+	case budget.MinAvailable != "":
+		minAvailable, isPercent, err := budget.MinAvailable.parse()
+		if err != nil {
+			return fmt.Errorf("invalid disruption budget minAvailable: %w", err)
+		}
+
+		// a budget which keeps every instance available blocks node drains until it is removed
+		blocksDrains := isPercent && minAvailable == 100
+		if !isPercent && (service.Autoscaling == nil || !service.Autoscaling.Enabled) {
+			blocksDrains = service.Instances > 0 && minAvailable >= service.Instances
+		}
+		if blocksDrains {
+			return fmt.Errorf("disruption budget minAvailable %s keeps every instance available, which blocks node drains", budget.MinAvailable)
+		}
+	case budget.MaxUnavailable != "":
+		maxUnavailable, _, err := budget.MaxUnavailable.parse()
+		if err != nil {
+			return fmt.Errorf("invalid disruption budget maxUnavailable: %w", err)
+		}
+
+		if maxUnavailable == 0 {
+			return errors.New("disruption budget maxUnavailable of 0 keeps every instance available, which blocks node drains")
+		}
+	default:
+		return errors.New("disruption budget must set one of minAvailable or maxUnavailable")
+	}
+
+	return errors.New("disruption budgets are not supported by the current app contract")
+}
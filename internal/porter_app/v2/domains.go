@@ -0,0 +1,92 @@
+package v2
+
+import (
+	"errors"
+	"fmt"
+	"sort"
+	"strings"
+
+	porterv1 "github.com/porter-dev/api-contracts/generated/go/porter/v1"
+	"k8s.io/apimachinery/pkg/util/validation"
+)
+
+// domainProtosFromConfig converts the custom domains of a web service into domain protos. The app contract only has the name of a domain,
+// so path prefixes, ingress annotations and disabled TLS are validated and then rejected rather than silently dropped from the ingress.
+func domainProtosFromConfig(domains []Domains) ([]*porterv1.Domain, error) {
+	domainProtos := make([]*porterv1.Domain, 0)
+
+	for _, domain := range domains {
+		if err := validateDomain(domain); err != nil {
+			return nil, fmt.Errorf("invalid domain '%s': %w", domain.Name, err)
+		}
+
+		if domain.PathPrefix != "" && domain.PathPrefix != "/" {
+			return nil, fmt.Errorf("domain '%s': path prefixes are not supported by the current app contract", domain.Name)
+		}
+
+		if len(domain.Annotations) > 0 {
+			return nil, fmt.Errorf("domain '%s': ingress annotations are not supported by the current app contract", domain.Name)
+		}
+
+		if domain.DisableTLS {
+			return nil, fmt.Errorf("domain '%s': disabling TLS is not supported by the current app contract", domain.Name)
+		}
+
+		domainProtos = append(domainProtos, &porterv1.Domain{
+			Name: domain.Name,
+		})
+	}
+
+	return domainProtos, nil
+}
+
+// validateDomain checks that the hostname, path prefix and ingress annotations of a domain are well-formed
+func validateDomain(domain Domains) error {
+	if domain.Name == "" {
+		return errors.New("name is required")
+	}
+
+	if errs := validation.IsDNS1123Subdomain(strings.TrimPrefix(domain.Name, "*.")); len(errs) > 0 {
+		return fmt.Errorf("name must be a valid hostname: %s", strings.Join(errs, ", "))
+	}
+
+	if domain.PathPrefix != "" && !strings.HasPrefix(domain.PathPrefix, "/") {
+		return errors.New("pathPrefix must start with '/'")
+	}
+
+	for key := range domain.Annotations {
+		if errs := validation.IsQualifiedName(key); len(errs) > 0 {
+			return fmt.Errorf("invalid annotation key '%s': %s", key, strings.Join(errs, ", "))
+		}
+	}
+
+	return nil
+}
+
+// validateDomainRoutes checks that no two services of an app route the same hostname and path prefix, which would make the
+// service that receives a request depend on the order in which the ingress controller reads their ingresses
+func validateDomainRoutes(services map[string]Service) error {
+	serviceNames := make([]string, 0, len(services))
+	for name := range services {
+		serviceNames = append(serviceNames, name)
+	}
+	sort.Strings(serviceNames)
+
+	routes := make(map[string]string)
+	for _, serviceName := range serviceNames {
+		for _, domain := range services[serviceName].Domains {
+			pathPrefix := domain.PathPrefix
+			if pathPrefix == "" {
+				pathPrefix = "/"
+			}
+
+			route := strings.ToLower(domain.Name) + pathPrefix
+			if existing, ok := routes[route]; ok && existing != serviceName {
+				return fmt.Errorf("services '%s' and '%s' both route %s", existing, serviceName, route)
+			}
+			routes[route] = serviceName
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,180 @@
+package v2
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/aws/aws-sdk-go/aws/arn"
+)
+
+// EnvValue is the value of an env var in a Porter YAML file. It is either a literal string, or a reference to a secret in AWS
+// which the server resolves when the app is applied:
+//
+//	env:
+//	  DB_PASSWORD:
+//	    fromAws:
+//	      secretArn: arn:aws:secretsmanager:us-east-1:123456789012:secret:prod/db-AbCdEf
+//	      key: password
+type EnvValue struct {
+	Value   string
+	FromAws *AWSEnvSource
+}
+
+// AWSEnvSource is a secret in AWS Secrets Manager or a parameter in SSM Parameter Store
+type AWSEnvSource struct {
+	// SecretArn is the ARN of a Secrets Manager secret
+	SecretArn string `json:"secretArn,omitempty"`
+	// Key selects a single key of a Secrets Manager secret that stores a JSON object. If empty, the whole secret string is used.
+	Key string `json:"key,omitempty"`
+	// ParameterArn is the ARN of an SSM parameter. SecureString parameters are decrypted.
+	ParameterArn string `json:"parameterArn,omitempty"`
+}
+
+type envValueObject struct {
+	FromAws *AWSEnvSource `json:"fromAws"`
+}
+
+// UnmarshalJSON accepts either a scalar or an object with a fromAws source. Numbers and booleans are kept as written.
+func (e *EnvValue) UnmarshalJSON(data []byte) error {
+	var value string
+	if err := json.Unmarshal(data, &value); err == nil {
+		*e = EnvValue{Value: value}
+		return nil
+	}
+
+	var scalar any
+	if err := json.Unmarshal(data, &scalar); err == nil {
+		switch scalar.(type) {
+		case float64, bool:
+			*e = EnvValue{Value: strings.TrimSpace(string(data))}
+			return nil
+		}
+	}
+
+	obj := envValueObject{}
+	decoder := json.NewDecoder(strings.NewReader(string(data)))
+	decoder.DisallowUnknownFields()
+	if err := decoder.Decode(&obj); err != nil || obj.FromAws == nil {
+		return errors.New("env values must be a string or an object with a fromAws source")
+	}
+
+	*e = EnvValue{FromAws: obj.FromAws}
+	return nil
+}
+
+// MarshalJSON writes literal values as strings, so that files without references are unchanged
+func (e EnvValue) MarshalJSON() ([]byte, error) {
+	if e.FromAws == nil {
+		return json.Marshal(e.Value)
+	}
+
+	return json.Marshal(envValueObject{FromAws: e.FromAws})
+}
+
+// MarshalYAML writes env values the same way as MarshalJSON
+func (e EnvValue) MarshalYAML() (interface{}, error) {
+	if e.FromAws == nil {
+		return e.Value, nil
+	}
+
+	source := make(map[string]string)
+	if e.FromAws.SecretArn != "" {
+		source["secretArn"] = e.FromAws.SecretArn
+	}
+	if e.FromAws.Key != "" {
+		source["key"] = e.FromAws.Key
+	}
+	if e.FromAws.ParameterArn != "" {
+		source["parameterArn"] = e.FromAws.ParameterArn
+	}
+
+	return map[string]map[string]string{"fromAws": source}, nil
+}
+
+// AWSEnvReferencePrefix marks an env value in the app proto as a reference to a secret in AWS, which the server resolves when the app is applied
+const AWSEnvReferencePrefix = "porter-aws-ref:"
+
+// envProtoFromConfig converts the env of a Porter YAML file to the env of the app proto. AWS sources are encoded as references.
+func envProtoFromConfig(env map[string]EnvValue) (map[string]string, error) {
+	if env == nil {
+		return nil, nil
+	}
+
+	envProto := make(map[string]string, len(env))
+	for name, value := range env {
+		if value.FromAws == nil {
+			envProto[name] = value.Value
+			continue
+		}
+
+		if err := value.FromAws.Validate(); err != nil {
+			return nil, fmt.Errorf("invalid fromAws source for env var %s: %w", name, err)
+		}
+
+		reference, err := json.Marshal(value.FromAws)
+		if err != nil {
+			return nil, fmt.Errorf("error encoding fromAws source for env var %s: %w", name, err)
+		}
+
+		envProto[name] = AWSEnvReferencePrefix + string(reference)
+	}
+
+	return envProto, nil
+}
+
+// ParseAWSEnvReference returns the AWS source of an env value in the app proto. The second return value is false if the
+// value is a literal.
+func ParseAWSEnvReference(value string) (AWSEnvSource, bool, error) {
+	var source AWSEnvSource
+
+	encoded, ok := strings.CutPrefix(value, AWSEnvReferencePrefix)
+	if !ok {
+		return source, false, nil
+	}
+
+	if err := json.Unmarshal([]byte(encoded), &source); err != nil {
+		return source, true, fmt.Errorf("error decoding aws env reference: %w", err)
+	}
+
+	if err := source.Validate(); err != nil {
+		return source, true, err
+	}
+
+	return source, true, nil
+}
+
+// Validate checks that exactly one of SecretArn and ParameterArn is set to an ARN of the matching service
+func (s AWSEnvSource) Validate() error {
+	switch {
+	case s.SecretArn != "" && s.ParameterArn != "":
+		return errors.New("only one of secretArn and parameterArn can be set")
+	case s.SecretArn != "":
+		return validateAWSArn(s.SecretArn, "secretsmanager")
+	case s.ParameterArn != "":
+		if s.Key != "" {
+			return errors.New("key can only be used with secretArn")
+		}
+		return validateAWSArn(s.ParameterArn, "ssm")
+	default:
+		return errors.New("one of secretArn and parameterArn must be set")
+	}
+}
+
+func validateAWSArn(value string, service string) error {
+	parsed, err := arn.Parse(value)
+	if err != nil {
+		return fmt.Errorf("%s is not a valid arn: %w", value, err)
+	}
+
+	if parsed.Service != service {
+		return fmt.Errorf("%s is not a %s arn", value, service)
+	}
+
+	if parsed.Region == "" || parsed.AccountID == "" {
+		return fmt.Errorf("%s must include a region and account id", value)
+	}
+
+	return nil
+}
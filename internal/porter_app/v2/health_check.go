@@ -0,0 +1,135 @@
+package v2
+
+import (
+	"errors"
+	"fmt"
+
+	porterv1 "github.com/porter-dev/api-contracts/generated/go/porter/v1"
+)
+
+const (
+	// HealthCheckType_HTTP checks that a GET request to a path returns a successful status code
+	HealthCheckType_HTTP = "http"
+	// HealthCheckType_TCP checks that a TCP connection can be opened to a port
+	HealthCheckType_TCP = "tcp"
+	// HealthCheckType_GRPC makes a request to the gRPC health checking protocol of the server
+	HealthCheckType_GRPC = "grpc"
+)
+
+// healthCheckProtoFromConfig converts the health check settings of a web service into the health check proto.
+// The app contract has a single HTTP path which is used for every probe of the service, so separate probes are only accepted
+// when they can be expressed that way: HTTP probes on the service port, all with the same path and with the default timings.
+// Other health check configurations are rejected rather than silently replaced by the single health check.
+func healthCheckProtoFromConfig(healthCheck *HealthCheck) (*porterv1.HealthCheck, error) {
+	if healthCheck == nil {
+		return nil, nil
+	}
+
+	if err := validateCheckType(healthCheck.Type, healthCheck.HttpPath, healthCheck.GrpcService); err != nil {
+		return nil, fmt.Errorf("invalid health check: %w", err)
+	}
+
+	probes := map[string]*Probe{
+		"readiness": healthCheck.Readiness,
+		"liveness":  healthCheck.Liveness,
+		"startup":   healthCheck.Startup,
+	}
+
+	for _, name := range []string{"readiness", "liveness", "startup"} {
+		if probe := probes[name]; probe != nil {
+			if err := validateProbe(probe); err != nil {
+				return nil, fmt.Errorf("invalid %s probe: %w", name, err)
+			}
+		}
+	}
+
+	if healthCheck.Type != "" && healthCheck.Type != HealthCheckType_HTTP {
+		return nil, fmt.Errorf("%s health checks are not supported by the current app contract", healthCheck.Type)
+	}
+
+	enabled := healthCheck.Enabled
+	httpPath := healthCheck.HttpPath
+	for _, name := range []string{"readiness", "liveness", "startup"} {
+		probe := probes[name]
+		if probe == nil {
+			continue
+		}
+
+		if probe.Command != "" {
+			return nil, fmt.Errorf("%s probe: command probes are not supported by the current app contract", name)
+		}
+
+		if probe.Type != "" && probe.Type != HealthCheckType_HTTP {
+			return nil, fmt.Errorf("%s probe: %s probes are not supported by the current app contract", name, probe.Type)
+		}
+
+		if probe.Port != 0 || probe.InitialDelaySeconds != 0 || probe.PeriodSeconds != 0 || probe.FailureThreshold != 0 {
+			return nil, fmt.Errorf("%s probe: probe ports and timings are not supported by the current app contract", name)
+		}
+
+		if httpPath != "" && probe.HttpPath != httpPath {
+			return nil, fmt.Errorf("%s probe: probes with different paths are not supported by the current app contract", name)
+		}
+		httpPath = probe.HttpPath
+		// configuring a probe enables health checks for the service
+		enabled = true
+	}
+
+	return &porterv1.HealthCheck{
+		Enabled:  enabled,
+		HttpPath: httpPath,
+	}, nil
+}
+
+// validateProbe checks that a probe is well-formed
+func validateProbe(probe *Probe) error {
+	if probe.Command != "" {
+		if probe.Type != "" || probe.HttpPath != "" || probe.GrpcService != "" {
+			return errors.New("command probes cannot specify a type, httpPath or grpcService")
+		}
+
+		if probe.Port != 0 {
+			return errors.New("port is not supported for command probes")
+		}
+	} else if err := validateCheckType(probe.Type, probe.HttpPath, probe.GrpcService); err != nil {
+		return err
+	}
+
+	if probe.Type == HealthCheckType_HTTP || (probe.Type == "" && probe.Command == "") {
+		if probe.HttpPath == "" {
+			return errors.New("probe must specify either httpPath or command")
+		}
+	}
+
+	if probe.Port < 0 || probe.Port > 65535 {
+		return fmt.Errorf("invalid port %d", probe.Port)
+	}
+
+	if probe.InitialDelaySeconds < 0 || probe.PeriodSeconds < 0 || probe.FailureThreshold < 0 {
+		return errors.New("probe timings cannot be negative")
+	}
+
+	return nil
+}
+
+// validateCheckType checks that the settings of a health check or probe match its type
+func validateCheckType(checkType, httpPath, grpcService string) error {
+	switch checkType {
+	case "", HealthCheckType_HTTP:
+		if grpcService != "" {
+			return errors.New("grpcService is only supported for grpc checks")
+		}
+	case HealthCheckType_TCP:
+		if httpPath != "" || grpcService != "" {
+			return errors.New("tcp checks cannot specify httpPath or grpcService")
+		}
+	case HealthCheckType_GRPC:
+		if httpPath != "" {
+			return errors.New("grpc checks cannot specify httpPath")
+		}
+	default:
+		return fmt.Errorf("invalid type '%s': must be one of http, tcp or grpc", checkType)
+	}
+
+	return nil
+}
@@ -0,0 +1,314 @@
+package v2
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"regexp"
+	"strconv"
+	"strings"
+
+	"github.com/ghodss/yaml"
+	yamlv3 "gopkg.in/yaml.v3"
+)
+
+const (
+	// extensionKeyPrefix is the prefix of top-level keys which are ignored when a Porter YAML file is parsed, so that they can
+	// hold YAML anchors shared by several services, such as x-defaults: &defaults, or values used by references
+	extensionKeyPrefix = "x-"
+	// referencePrefix is the prefix of references to other values of a Porter YAML file, such as ${porter.image.tag}
+	referencePrefix = "porter."
+)
+
+var (
+	interpolationRegex = regexp.MustCompile(`\$\{([^{}]+)\}`)
+	envVarNameRegex    = regexp.MustCompile(`^[A-Za-z_][A-Za-z0-9_]*$`)
+)
+
+// InterpolateEnv replaces ${NAME} in the values of a Porter YAML file with the value of the env var NAME, for each name in
+// allowed. Other ${...} expressions are left as they are, so that shell expansions in run commands are not changed. An
+// unquoted value is decoded again after it is interpolated, so that instances: ${REPLICAS} is a number. Every allowed env
+// var which is used by the file must be set.
+func InterpolateEnv(porterYamlBytes []byte, allowed []string, lookupEnv func(string) (string, bool)) ([]byte, error) {
+	if len(allowed) == 0 {
+		return porterYamlBytes, nil
+	}
+
+	allowlist := make(map[string]bool)
+	for _, name := range allowed {
+		if !envVarNameRegex.MatchString(name) {
+			return nil, fmt.Errorf("'%s' is not a valid env var name", name)
+		}
+		allowlist[name] = true
+	}
+
+	root := &yamlv3.Node{}
+	err := yamlv3.Unmarshal(porterYamlBytes, root)
+	if err != nil {
+		return nil, fmt.Errorf("error parsing porter yaml: %w", err)
+	}
+
+	var missing []string
+	interpolateNode(root, func(name string) (string, bool) {
+		if !allowlist[name] {
+			return "", false
+		}
+
+		value, ok := lookupEnv(name)
+		if !ok {
+			missing = append(missing, name)
+		}
+		return value, true
+	})
+
+	if len(missing) > 0 {
+		return nil, fmt.Errorf("porter yaml uses the env vars %s, which are not set", strings.Join(uniqueStrings(missing), ", "))
+	}
+
+	var buf bytes.Buffer
+	encoder := yamlv3.NewEncoder(&buf)
+	encoder.SetIndent(2)
+
+	err = encoder.Encode(root)
+	if err != nil {
+		return nil, fmt.Errorf("error marshaling porter yaml: %w", err)
+	}
+
+	return buf.Bytes(), nil
+}
+
+// interpolateNode replaces the ${...} expressions in the scalar values below a node for which lookup returns a value. Mapping
+// keys are not interpolated.
+func interpolateNode(node *yamlv3.Node, lookup func(string) (string, bool)) {
+	switch node.Kind {
+	case yamlv3.ScalarNode:
+		if node.Tag != "!!str" {
+			return
+		}
+
+		interpolated := interpolationRegex.ReplaceAllStringFunc(node.Value, func(expression string) string {
+			value, ok := lookup(expression[2 : len(expression)-1])
+			if !ok {
+				return expression
+			}
+			return value
+		})
+
+		if interpolated != node.Value {
+			node.Value = interpolated
+			if node.Style == 0 {
+				node.Tag = ""
+			}
+		}
+	case yamlv3.MappingNode:
+		for i := 1; i < len(node.Content); i += 2 {
+			interpolateNode(node.Content[i], lookup)
+		}
+	default:
+		for _, child := range node.Content {
+			interpolateNode(child, lookup)
+		}
+	}
+}
+
+// ResolveReferences returns a Porter YAML file with its references to other values of the file, such as ${porter.name} or
+// ${porter.services.web.port}, replaced by the values they refer to, and without its top-level x- keys. A value which is
+// only a reference keeps the type of the value it refers to. The file is returned as JSON, which is also valid YAML.
+func ResolveReferences(porterYamlBytes []byte) ([]byte, error) {
+	jsonBytes, err := yaml.YAMLToJSON(porterYamlBytes)
+	if err != nil {
+		return nil, fmt.Errorf("error parsing porter yaml: %w", err)
+	}
+
+	decoder := json.NewDecoder(bytes.NewReader(jsonBytes))
+	decoder.UseNumber()
+
+	var raw any
+	err = decoder.Decode(&raw)
+	if err != nil {
+		return nil, fmt.Errorf("error parsing porter yaml: %w", err)
+	}
+
+	doc, ok := raw.(map[string]any)
+	if !ok {
+		return jsonBytes, nil
+	}
+
+	r := &referenceResolver{doc: doc, resolving: make(map[string]bool)}
+	resolved, err := r.resolveValue(doc, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	resolvedDoc := resolved.(map[string]any)
+	for key := range resolvedDoc {
+		if strings.HasPrefix(key, extensionKeyPrefix) {
+			delete(resolvedDoc, key)
+		}
+	}
+
+	return json.Marshal(resolvedDoc)
+}
+
+// referenceError is a reference in a Porter YAML file which could not be resolved
+type referenceError struct {
+	// path is the path of the field which contains the reference
+	path      []string
+	reference string
+	message   string
+}
+
+func (e *referenceError) Error() string {
+	return fmt.Sprintf("%s: ${%s} %s", strings.Join(e.path, "."), e.reference, e.message)
+}
+
+type referenceResolver struct {
+	doc map[string]any
+	// resolving holds the references being resolved, so that references which refer to themselves are rejected
+	resolving map[string]bool
+}
+
+func (r *referenceResolver) resolveValue(value any, path []string) (any, error) {
+	switch v := value.(type) {
+	case map[string]any:
+		resolved := make(map[string]any, len(v))
+		for key, child := range v {
+			resolvedChild, err := r.resolveValue(child, append(path[:len(path):len(path)], key))
+			if err != nil {
+				return nil, err
+			}
+			resolved[key] = resolvedChild
+		}
+		return resolved, nil
+	case []any:
+		resolved := make([]any, len(v))
+		for i, child := range v {
+			resolvedChild, err := r.resolveValue(child, append(path[:len(path):len(path)], strconv.Itoa(i)))
+			if err != nil {
+				return nil, err
+			}
+			resolved[i] = resolvedChild
+		}
+		return resolved, nil
+	case string:
+		return r.resolveString(v, path)
+	}
+
+	return value, nil
+}
+
+// resolveString replaces the references in a string. A string which is only a reference is replaced by the value it refers to.
+func (r *referenceResolver) resolveString(s string, path []string) (any, error) {
+	matches := interpolationRegex.FindAllStringSubmatchIndex(s, -1)
+
+	var resolved strings.Builder
+	last := 0
+	for _, match := range matches {
+		reference := s[match[2]:match[3]]
+		if !strings.HasPrefix(reference, referencePrefix) {
+			continue
+		}
+
+		value, err := r.lookup(reference, path)
+		if err != nil {
+			return nil, err
+		}
+
+		if match[0] == 0 && match[1] == len(s) {
+			return value, nil
+		}
+
+		resolved.WriteString(s[last:match[0]])
+		resolved.WriteString(referenceString(value))
+		last = match[1]
+	}
+
+	if last == 0 {
+		return s, nil
+	}
+
+	resolved.WriteString(s[last:])
+	return resolved.String(), nil
+}
+
+// lookup returns the value a reference refers to, with the references in the value resolved
+func (r *referenceResolver) lookup(reference string, path []string) (any, error) {
+	if r.resolving[reference] {
+		return nil, &referenceError{path: path, reference: reference, message: "refers to itself"}
+	}
+
+	var value any = r.doc
+	for _, segment := range strings.Split(strings.TrimPrefix(reference, referencePrefix), ".") {
+		switch v := value.(type) {
+		case map[string]any:
+			value = v[segment]
+		case []any:
+			index, err := strconv.Atoi(segment)
+			if err != nil || index < 0 || index >= len(v) {
+				value = nil
+			} else {
+				value = v[index]
+			}
+		default:
+			value = nil
+		}
+
+		if value == nil {
+			return nil, &referenceError{path: path, reference: reference, message: "does not refer to a value in porter.yaml"}
+		}
+	}
+
+	switch v := value.(type) {
+	case map[string]any, []any:
+		return nil, &referenceError{path: path, reference: reference, message: "refers to a section of porter.yaml, not a value"}
+	case string:
+		r.resolving[reference] = true
+		defer delete(r.resolving, reference)
+
+		return r.resolveString(v, path)
+	}
+
+	return value, nil
+}
+
+func referenceString(value any) string {
+	switch v := value.(type) {
+	case string:
+		return v
+	case json.Number:
+		return v.String()
+	case bool:
+		return strconv.FormatBool(v)
+	}
+
+	return fmt.Sprint(value)
+}
+
+// referenceValidationError converts an error from ResolveReferences to a validation error at the field of the reference
+func referenceValidationError(err error, root *yamlv3.Node) ValidationError {
+	var refErr *referenceError
+	if !errors.As(err, &refErr) {
+		return ValidationError{Message: err.Error()}
+	}
+
+	return ValidationError{
+		Field:   strings.Join(refErr.path, "."),
+		Line:    lineOf(root, refErr.path),
+		Column:  columnOf(root, refErr.path),
+		Message: fmt.Sprintf("${%s} %s", refErr.reference, refErr.message),
+	}
+}
+
+func uniqueStrings(values []string) []string {
+	seen := make(map[string]bool)
+	var unique []string
+	for _, value := range values {
+		if !seen[value] {
+			seen[value] = true
+			unique = append(unique, value)
+		}
+	}
+
+	return unique
+}
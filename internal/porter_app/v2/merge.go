@@ -0,0 +1,76 @@
+package v2
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/ghodss/yaml"
+)
+
+// MergePorterYAML merges Porter YAML files, such as a base file and a file with the overrides of an environment, into a
+// single file. Each file is merged over the files before it: maps, such as services and env, are merged key by key, so that
+// services are merged by name and env vars by key, a null value removes the key from the files before it, and any other
+// value, including a list, replaces the value of the files before it. Overlay files may omit the version, but must not set
+// a different one. YAML anchors cannot be shared between files. A single file is returned as it is.
+func MergePorterYAML(porterYamls ...[]byte) ([]byte, error) {
+	if len(porterYamls) == 0 {
+		return nil, errors.New("no porter yaml files to merge")
+	}
+	if len(porterYamls) == 1 {
+		return porterYamls[0], nil
+	}
+
+	var merged map[string]any
+	for i, porterYaml := range porterYamls {
+		raw := map[string]any{}
+		err := yaml.Unmarshal(porterYaml, &raw)
+		if err != nil {
+			return nil, fmt.Errorf("error unmarshaling porter yaml file %d: %w", i+1, err)
+		}
+
+		if merged == nil {
+			merged = raw
+			continue
+		}
+
+		if version, ok := raw["version"]; ok && merged["version"] != nil && version != merged["version"] {
+			return nil, fmt.Errorf("porter yaml file %d has version %v, but the files before it have version %v", i+1, version, merged["version"])
+		}
+
+		merged = mergeOverlayValues(merged, raw).(map[string]any)
+	}
+
+	out, err := yaml.Marshal(merged)
+	if err != nil {
+		return nil, fmt.Errorf("error marshaling merged porter yaml: %w", err)
+	}
+
+	return out, nil
+}
+
+// mergeOverlayValues merges overlay onto base. Unlike mergeYamlValues, a null value in overlay removes the key from base,
+// so that an overlay can remove an env var or a service of the base file.
+func mergeOverlayValues(base any, overlay any) any {
+	baseMap, baseIsMap := base.(map[string]any)
+	overlayMap, overlayIsMap := overlay.(map[string]any)
+
+	if !baseIsMap || !overlayIsMap {
+		return overlay
+	}
+
+	merged := make(map[string]any, len(baseMap))
+	for key, val := range baseMap {
+		merged[key] = val
+	}
+
+	for key, val := range overlayMap {
+		if val == nil {
+			delete(merged, key)
+			continue
+		}
+
+		merged[key] = mergeOverlayValues(merged[key], val)
+	}
+
+	return merged
+}
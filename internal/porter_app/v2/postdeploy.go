@@ -0,0 +1,73 @@
+package v2
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+
+	porterv1 "github.com/porter-dev/api-contracts/generated/go/porter/v1"
+	"k8s.io/apimachinery/pkg/util/validation"
+)
+
+const (
+	// PostdeployOnFailureRollback rolls the app back to its previous revision when a postdeploy step fails. It is the default failure policy.
+	PostdeployOnFailureRollback = "rollback"
+	// PostdeployOnFailureDegrade keeps the new revision, but marks it as degraded, when a postdeploy step fails
+	PostdeployOnFailureDegrade = "degrade"
+)
+
+// PostdeployStep is a job run after the services of an app are rolled out, such as a smoke test against the new revision
+type PostdeployStep struct {
+	Service `yaml:",inline"`
+
+	// Name identifies the step in the status of a revision
+	Name string `yaml:"name" validate:"required"`
+	// TimeoutSeconds is how long the step can run before it is considered failed. If 0, the step has no timeout.
+	TimeoutSeconds int `yaml:"timeoutSeconds,omitempty" validate:"min=0,max=86400"`
+	// OnFailure is what happens to the new revision when the step fails, one of rollback or degrade. Defaults to rollback.
+	OnFailure string `yaml:"onFailure,omitempty" validate:"omitempty,oneof=rollback degrade"`
+}
+
+// postdeployFromConfig validates the postdeploy steps of a Porter YAML file. The app contract has no postdeploy jobs, so
+// postdeploy steps are rejected rather than silently skipped after a rollout.
+func postdeployFromConfig(steps []PostdeployStep) error {
+	names := make(map[string]bool, len(steps))
+	for i, step := range steps {
+		if step.Name == "" {
+			return fmt.Errorf("postdeploy step %d must have a name", i+1)
+		}
+		if errs := validation.IsDNS1123Label(step.Name); len(errs) > 0 {
+			return fmt.Errorf("invalid postdeploy step name '%s': %s", step.Name, strings.Join(errs, ", "))
+		}
+		if names[step.Name] {
+			return fmt.Errorf("duplicate postdeploy step name '%s'", step.Name)
+		}
+		names[step.Name] = true
+
+		label := fmt.Sprintf("postdeploy step '%s'", step.Name)
+
+		if step.TimeoutSeconds < 0 {
+			return fmt.Errorf("%s: timeoutSeconds must not be negative", label)
+		}
+
+		switch step.OnFailure {
+		case "", PostdeployOnFailureRollback, PostdeployOnFailureDegrade:
+		default:
+			return fmt.Errorf("%s: invalid onFailure '%s', must be one of %s or %s", label, step.OnFailure, PostdeployOnFailureRollback, PostdeployOnFailureDegrade)
+		}
+
+		if step.Type != "" && step.Type != "job" {
+			return fmt.Errorf("%s: postdeploy steps must be jobs", label)
+		}
+
+		if _, err := serviceProtoFromConfig(step.Service, porterv1.ServiceType_SERVICE_TYPE_JOB); err != nil {
+			return fmt.Errorf("%s: %w", label, err)
+		}
+	}
+
+	if len(steps) > 0 {
+		return errors.New("postdeploy steps are not supported by the current app contract")
+	}
+
+	return nil
+}
@@ -0,0 +1,149 @@
+package v2
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"strings"
+
+	porterv1 "github.com/porter-dev/api-contracts/generated/go/porter/v1"
+	"k8s.io/apimachinery/pkg/util/validation"
+)
+
+const (
+	// PredeployOnFailureAbort stops the deploy when a predeploy step fails. It is the default failure policy.
+	PredeployOnFailureAbort = "abort"
+	// PredeployOnFailureContinue runs the next predeploy step when a predeploy step fails
+	PredeployOnFailureContinue = "continue"
+)
+
+// PredeployStep is a job run before the services of an app are deployed, such as a database migration
+type PredeployStep struct {
+	Service `yaml:",inline"`
+
+	// Name identifies the step in a list of steps, and is required when there is more than one step
+	Name string `yaml:"name,omitempty"`
+	// TimeoutSeconds is how long the step can run before it is considered failed. If 0, the step has no timeout.
+	TimeoutSeconds int `yaml:"timeoutSeconds,omitempty" validate:"min=0,max=86400"`
+	// OnFailure is what happens when the step fails, one of abort or continue. Defaults to abort.
+	OnFailure string `yaml:"onFailure,omitempty" validate:"omitempty,oneof=abort continue"`
+}
+
+// PredeploySteps are the predeploy jobs of an app, which are run in order. They are written either as a single job, or as
+// a list of steps:
+//
+//	predeploy:
+//	  - name: migrate
+//	    run: npm run migrate
+//	    timeoutSeconds: 600
+//	  - name: seed
+//	    run: npm run seed
+//	    onFailure: continue
+type PredeploySteps []PredeployStep
+
+// UnmarshalJSON accepts either a single job or a list of steps
+func (p *PredeploySteps) UnmarshalJSON(data []byte) error {
+	trimmed := bytes.TrimSpace(data)
+	if bytes.Equal(trimmed, []byte("null")) {
+		*p = nil
+		return nil
+	}
+
+	if len(trimmed) > 0 && trimmed[0] == '[' {
+		var steps []PredeployStep
+		if err := json.Unmarshal(trimmed, &steps); err != nil {
+			return err
+		}
+		*p = steps
+		return nil
+	}
+
+	var step PredeployStep
+	if err := json.Unmarshal(trimmed, &step); err != nil {
+		return err
+	}
+	*p = PredeploySteps{step}
+
+	return nil
+}
+
+// MarshalJSON writes a single step without any step settings as a single job, so that files with one predeploy job are unchanged
+func (p PredeploySteps) MarshalJSON() ([]byte, error) {
+	if p.isSingleJob() {
+		return json.Marshal(p[0])
+	}
+
+	return json.Marshal([]PredeployStep(p))
+}
+
+// MarshalYAML writes predeploy steps the same way as MarshalJSON
+func (p PredeploySteps) MarshalYAML() (interface{}, error) {
+	if p.isSingleJob() {
+		return p[0], nil
+	}
+
+	return []PredeployStep(p), nil
+}
+
+func (p PredeploySteps) isSingleJob() bool {
+	return len(p) == 1 && p[0].Name == "" && p[0].TimeoutSeconds == 0 && p[0].OnFailure == ""
+}
+
+// predeployProtoFromConfig converts the predeploy steps of a Porter YAML file to the predeploy job of the app proto. The app
+// contract only has a single predeploy job, so every step is validated, and more than one step, timeouts and failure policies
+// are then rejected rather than silently dropped.
+func predeployProtoFromConfig(steps PredeploySteps) (*porterv1.Service, error) {
+	if len(steps) == 0 {
+		return nil, nil
+	}
+
+	var predeployProto *porterv1.Service
+	names := make(map[string]bool, len(steps))
+	for i, step := range steps {
+		label := fmt.Sprintf("predeploy step %d", i+1)
+		if step.Name != "" {
+			label = fmt.Sprintf("predeploy step '%s'", step.Name)
+		}
+
+		switch {
+		case step.Name == "" && len(steps) > 1:
+			return nil, fmt.Errorf("%s must have a name, since there is more than one predeploy step", label)
+		case step.Name != "":
+			if errs := validation.IsDNS1123Label(step.Name); len(errs) > 0 {
+				return nil, fmt.Errorf("invalid predeploy step name '%s': %s", step.Name, strings.Join(errs, ", "))
+			}
+			if names[step.Name] {
+				return nil, fmt.Errorf("duplicate predeploy step name '%s'", step.Name)
+			}
+			names[step.Name] = true
+		}
+
+		if step.TimeoutSeconds < 0 {
+			return nil, fmt.Errorf("%s: timeoutSeconds must not be negative", label)
+		}
+
+		switch step.OnFailure {
+		case "", PredeployOnFailureAbort, PredeployOnFailureContinue:
+		default:
+			return nil, fmt.Errorf("%s: invalid onFailure '%s', must be one of %s or %s", label, step.OnFailure, PredeployOnFailureAbort, PredeployOnFailureContinue)
+		}
+
+		serviceProto, err := serviceProtoFromConfig(step.Service, porterv1.ServiceType_SERVICE_TYPE_JOB)
+		if err != nil {
+			return nil, fmt.Errorf("%s: %w", label, err)
+		}
+		if predeployProto == nil {
+			predeployProto = serviceProto
+		}
+	}
+
+	if len(steps) > 1 {
+		return nil, errors.New("multiple predeploy steps are not supported by the current app contract")
+	}
+	if steps[0].TimeoutSeconds != 0 || steps[0].OnFailure == PredeployOnFailureContinue {
+		return nil, errors.New("predeploy timeouts and failure policies are not supported by the current app contract")
+	}
+
+	return predeployProto, nil
+}
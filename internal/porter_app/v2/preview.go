@@ -0,0 +1,107 @@
+package v2
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/ghodss/yaml"
+	porterv1 "github.com/porter-dev/api-contracts/generated/go/porter/v1"
+	"github.com/porter-dev/porter/internal/telemetry"
+)
+
+// PreviewAppProtoFromYaml converts a Porter YAML file into the PorterApp proto used for preview environments, by
+// applying the overrides in the previews section to the rest of the file. It returns nil if the file has no previews section.
+// The merged file is converted with the same options as AppProtoFromYaml.
+func PreviewAppProtoFromYaml(ctx context.Context, porterYamlBytes []byte, opts ...ParseOption) (*porterv1.PorterApp, error) {
+	ctx, span := telemetry.NewSpan(ctx, "v2-preview-app-proto-from-yaml")
+	defer span.End()
+
+	if porterYamlBytes == nil {
+		return nil, telemetry.Error(ctx, span, nil, "porter yaml is nil")
+	}
+
+	raw := map[string]any{}
+	err := yaml.Unmarshal(porterYamlBytes, &raw)
+	if err != nil {
+		return nil, telemetry.Error(ctx, span, err, "error unmarshaling porter yaml")
+	}
+
+	previews, ok := raw["previews"]
+	if !ok || previews == nil {
+		return nil, nil
+	}
+	delete(raw, "previews")
+
+	previewOverrides, ok := previews.(map[string]any)
+	if !ok {
+		return nil, telemetry.Error(ctx, span, nil, "previews must be a map")
+	}
+
+	for key, override := range previewOverrides {
+		switch key {
+		case "services", "env":
+		default:
+			return nil, telemetry.Error(ctx, span, nil, fmt.Sprintf("previews does not support overriding '%s'", key))
+		}
+
+		if key == "services" {
+			err := validatePreviewServices(raw["services"], override)
+			if err != nil {
+				return nil, telemetry.Error(ctx, span, err, "invalid preview services")
+			}
+		}
+
+		raw[key] = mergeYamlValues(raw[key], override)
+	}
+
+	merged, err := yaml.Marshal(raw)
+	if err != nil {
+		return nil, telemetry.Error(ctx, span, err, "error marshaling preview yaml")
+	}
+
+	appProto, err := AppProtoFromYaml(ctx, merged, opts...)
+	if err != nil {
+		return nil, telemetry.Error(ctx, span, err, "error converting preview yaml to proto")
+	}
+
+	return appProto, nil
+}
+
+// validatePreviewServices checks that preview overrides only refer to services declared in the app
+func validatePreviewServices(services any, overrides any) error {
+	serviceMap, _ := services.(map[string]any)
+
+	overrideMap, ok := overrides.(map[string]any)
+	if !ok {
+		return fmt.Errorf("preview services must be a map")
+	}
+
+	for name := range overrideMap {
+		if _, ok := serviceMap[name]; !ok {
+			return fmt.Errorf("preview overrides service '%s', which is not declared in services", name)
+		}
+	}
+
+	return nil
+}
+
+// mergeYamlValues merges override onto base. Maps are merged recursively, and any other value in override replaces the base value.
+func mergeYamlValues(base any, override any) any {
+	baseMap, baseIsMap := base.(map[string]any)
+	overrideMap, overrideIsMap := override.(map[string]any)
+
+	if !baseIsMap || !overrideIsMap {
+		return override
+	}
+
+	merged := make(map[string]any, len(baseMap))
+	for key, val := range baseMap {
+		merged[key] = val
+	}
+
+	for key, val := range overrideMap {
+		merged[key] = mergeYamlValues(merged[key], val)
+	}
+
+	return merged
+}
@@ -0,0 +1,110 @@
+package v2
+
+import (
+	"errors"
+	"fmt"
+	"net/url"
+	"strings"
+
+	porterv1 "github.com/porter-dev/api-contracts/generated/go/porter/v1"
+	"k8s.io/apimachinery/pkg/util/validation"
+)
+
+const (
+	// QueueTypeSQS scales a worker on the number of visible messages in an SQS queue
+	QueueTypeSQS = "sqs"
+	// QueueTypeRabbitMQ scales a worker on the number of ready messages in a RabbitMQ queue
+	QueueTypeRabbitMQ = "rabbitmq"
+	// QueueTypeRedis scales a worker on the length of a Redis list
+	QueueTypeRedis = "redis"
+)
+
+// QueueScaling scales a worker on the length of the queue it pulls work from, instead of its CPU and memory usage. The number of
+// instances is the backlog of the queue divided by TargetBacklogPerInstance, between MinInstances and MaxInstances:
+//
+//	scaleOnQueue:
+//	  type: sqs
+//	  queueUrl: https://sqs.us-east-1.amazonaws.com/123456789012/jobs
+//	  targetBacklogPerInstance: 50
+//	  maxInstances: 20
+type QueueScaling struct {
+	// Type is the kind of queue, one of sqs, rabbitmq or redis
+	Type string `yaml:"type" validate:"required,oneof=sqs rabbitmq redis"`
+	// QueueURL is the URL of an SQS queue
+	QueueURL string `yaml:"queueUrl,omitempty" validate:"required_if=Type sqs,excluded_unless=Type sqs"`
+	// QueueName is the name of a RabbitMQ queue, or the key of a Redis list
+	QueueName string `yaml:"queueName,omitempty" validate:"excluded_if=Type sqs"`
+	// ConnectionFromEnv is the name of the env var of the service with the connection string of a RabbitMQ or Redis server
+	ConnectionFromEnv string `yaml:"connectionFromEnv,omitempty" validate:"excluded_if=Type sqs"`
+	// TargetBacklogPerInstance is the number of messages in the queue that each instance is expected to handle
+	TargetBacklogPerInstance int `yaml:"targetBacklogPerInstance" validate:"required,min=1"`
+	// MinInstances is the number of instances when the queue is empty, which can be 0 to scale the worker to zero
+	MinInstances int `yaml:"minInstances,omitempty" validate:"min=0"`
+	MaxInstances int `yaml:"maxInstances" validate:"required,min=1"`
+}
+
+// validateQueueScaling checks the queue scaling of a service. The app contract only has CPU and memory autoscaling, so queue
+// scaling is validated and then rejected rather than leaving a worker at a fixed number of instances.
+func validateQueueScaling(service Service, serviceType porterv1.ServiceType) error {
+	if service.ScaleOnQueue == nil {
+		return nil
+	}
+	scaling := service.ScaleOnQueue
+
+	if serviceType != porterv1.ServiceType_SERVICE_TYPE_WORKER {
+		return errors.New("scaleOnQueue is only supported for worker services")
+	}
+
+	if service.Autoscaling != nil && service.Autoscaling.Enabled {
+		return errors.New("a worker cannot use both autoscaling and scaleOnQueue")
+	}
+
+	switch scaling.Type {
+	case QueueTypeSQS:
+		if err := validateSQSQueueURL(scaling.QueueURL); err != nil {
+			return fmt.Errorf("invalid scaleOnQueue: %w", err)
+		}
+		if scaling.QueueName != "" || scaling.ConnectionFromEnv != "" {
+			return errors.New("invalid scaleOnQueue: queueName and connectionFromEnv cannot be used with sqs")
+		}
+	case QueueTypeRabbitMQ, QueueTypeRedis:
+		if scaling.QueueURL != "" {
+			return fmt.Errorf("invalid scaleOnQueue: queueUrl can only be used with %s", QueueTypeSQS)
+		}
+		if scaling.QueueName == "" {
+			return fmt.Errorf("invalid scaleOnQueue: queueName is required for %s", scaling.Type)
+		}
+		if errs := validation.IsEnvVarName(scaling.ConnectionFromEnv); scaling.ConnectionFromEnv == "" || len(errs) > 0 {
+			return fmt.Errorf("invalid scaleOnQueue: connectionFromEnv must be the name of an env var with the connection string of the %s server", scaling.Type)
+		}
+	default:
+		return fmt.Errorf("invalid scaleOnQueue: invalid type '%s', must be one of %s, %s or %s", scaling.Type, QueueTypeSQS, QueueTypeRabbitMQ, QueueTypeRedis)
+	}
+
+	switch {
+	case scaling.TargetBacklogPerInstance <= 0:
+		return errors.New("invalid scaleOnQueue: targetBacklogPerInstance must be positive")
+	case scaling.MinInstances < 0:
+		return errors.New("invalid scaleOnQueue: minInstances cannot be negative")
+	case scaling.MaxInstances <= 0:
+		return errors.New("invalid scaleOnQueue: maxInstances must be positive")
+	case scaling.MinInstances > scaling.MaxInstances:
+		return errors.New("invalid scaleOnQueue: minInstances cannot be greater than maxInstances")
+	}
+
+	return errors.New("scaling on queue length is not supported by the current app contract")
+}
+
+// validateSQSQueueURL checks that a queue URL is an https URL of an SQS queue, e.g. https://sqs.us-east-1.amazonaws.com/123456789012/jobs
+func validateSQSQueueURL(queueURL string) error {
+	parsed, err := url.Parse(queueURL)
+	if err != nil || parsed.Scheme != "https" || !strings.HasPrefix(parsed.Host, "sqs.") {
+		return fmt.Errorf("queueUrl '%s' is not the URL of an SQS queue", queueURL)
+	}
+
+	if parts := strings.Split(strings.Trim(parsed.Path, "/"), "/"); len(parts) != 2 || parts[0] == "" || parts[1] == "" {
+		return fmt.Errorf("queueUrl '%s' must end with the account id and name of the queue", queueURL)
+	}
+
+	return nil
+}
@@ -0,0 +1,139 @@
+package v2
+
+import (
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	"k8s.io/apimachinery/pkg/util/validation"
+)
+
+// ScalingSchedule changes the instance range of an autoscaled service at the times of a cron schedule, e.g. to scale a service
+// down at night. The range applies until the next schedule of the service fires:
+//
+//	autoscaling:
+//	  enabled: true
+//	  minInstances: 2
+//	  maxInstances: 10
+//	  schedules:
+//	    - name: nights
+//	      cron: "0 20 * * *"
+//	      minInstances: 1
+//	      maxInstances: 2
+//	    - name: days
+//	      cron: "0 7 * * 1-5"
+//	      minInstances: 2
+//	      maxInstances: 10
+type ScalingSchedule struct {
+	Name string `yaml:"name" validate:"required"`
+	// Cron is a standard five field cron expression
+	Cron         string `yaml:"cron" validate:"required"`
+	MinInstances int    `yaml:"minInstances" validate:"min=0"`
+	MaxInstances int    `yaml:"maxInstances" validate:"required,min=1"`
+	// Timezone is the name of the tz database time zone in which the cron expression is evaluated, e.g. Europe/Berlin. Defaults to UTC.
+	Timezone string `yaml:"timezone,omitempty"`
+}
+
+// validateScalingSchedules checks the scaling schedules of a service. The app contract only has a single instance range, so
+// schedules are validated and then rejected rather than leaving the service at the same range all day.
+func validateScalingSchedules(autoscaling *AutoScaling) error {
+	if len(autoscaling.Schedules) == 0 {
+		return nil
+	}
+
+	if !autoscaling.Enabled {
+		return errors.New("scaling schedules require autoscaling to be enabled")
+	}
+
+	names := make(map[string]bool, len(autoscaling.Schedules))
+	for _, schedule := range autoscaling.Schedules {
+		if errs := validation.IsDNS1123Label(schedule.Name); len(errs) > 0 {
+			return fmt.Errorf("invalid scaling schedule name '%s': %s", schedule.Name, strings.Join(errs, ", "))
+		}
+		if names[schedule.Name] {
+			return fmt.Errorf("duplicate scaling schedule '%s'", schedule.Name)
+		}
+		names[schedule.Name] = true
+
+		if err := validateCronExpression(schedule.Cron); err != nil {
+			return fmt.Errorf("invalid cron for scaling schedule '%s': %w", schedule.Name, err)
+		}
+
+		switch {
+		case schedule.MinInstances < 0:
+			return fmt.Errorf("scaling schedule '%s': minInstances cannot be negative", schedule.Name)
+		case schedule.MaxInstances <= 0:
+			return fmt.Errorf("scaling schedule '%s': maxInstances must be positive", schedule.Name)
+		case schedule.MinInstances > schedule.MaxInstances:
+			return fmt.Errorf("scaling schedule '%s': minInstances cannot be greater than maxInstances", schedule.Name)
+		}
+
+		if schedule.Timezone != "" {
+			if _, err := time.LoadLocation(schedule.Timezone); err != nil {
+				return fmt.Errorf("invalid timezone '%s' for scaling schedule '%s': must be a name from the tz database, e.g. America/New_York", schedule.Timezone, schedule.Name)
+			}
+		}
+	}
+
+	return errors.New("scheduled scaling is not supported by the current app contract")
+}
+
+// cronFieldRanges are the allowed values of the minute, hour, day of month, month and day of week fields of a cron expression
+var cronFieldRanges = [5]struct {
+	name     string
+	min, max int
+}{
+	{"minute", 0, 59},
+	{"hour", 0, 23},
+	{"day of month", 1, 31},
+	{"month", 1, 12},
+	{"day of week", 0, 7},
+}
+
+// validateCronExpression checks that an expression has five fields, each a list of *, values or ranges with an optional step
+func validateCronExpression(expression string) error {
+	fields := strings.Fields(expression)
+	if len(fields) != len(cronFieldRanges) {
+		return fmt.Errorf("'%s' must have %d fields", expression, len(cronFieldRanges))
+	}
+
+	for i, field := range fields {
+		bounds := cronFieldRanges[i]
+
+		for _, item := range strings.Split(field, ",") {
+			valueRange, step, hasStep := strings.Cut(item, "/")
+			if hasStep {
+				if n, err := strconv.Atoi(step); err != nil || n <= 0 {
+					return fmt.Errorf("invalid step '%s' in %s field", step, bounds.name)
+				}
+			}
+
+			if valueRange == "*" {
+				continue
+			}
+
+			start, end, isRange := strings.Cut(valueRange, "-")
+			if !isRange {
+				end = start
+			}
+
+			startValue, err := strconv.Atoi(start)
+			if err != nil || startValue < bounds.min || startValue > bounds.max {
+				return fmt.Errorf("invalid value '%s' in %s field, must be between %d and %d", start, bounds.name, bounds.min, bounds.max)
+			}
+
+			endValue, err := strconv.Atoi(end)
+			if err != nil || endValue < bounds.min || endValue > bounds.max {
+				return fmt.Errorf("invalid value '%s' in %s field, must be between %d and %d", end, bounds.name, bounds.min, bounds.max)
+			}
+
+			if endValue < startValue {
+				return fmt.Errorf("invalid range '%s' in %s field", valueRange, bounds.name)
+			}
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,119 @@
+package v2
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+
+	"k8s.io/apimachinery/pkg/util/validation"
+)
+
+// Toleration allows the instances of a service to be scheduled on nodes with a matching taint
+type Toleration struct {
+	Key string `yaml:"key,omitempty"`
+	// Operator is Equal, which matches taints with the same key and value, or Exists, which matches any taint with the key. Defaults to Equal.
+	Operator string `yaml:"operator,omitempty" validate:"omitempty,oneof=Equal Exists"`
+	Value    string `yaml:"value,omitempty"`
+	// Effect is the taint effect to tolerate, one of NoSchedule, PreferNoSchedule or NoExecute. If empty, all effects are tolerated.
+	Effect string `yaml:"effect,omitempty" validate:"omitempty,oneof=NoSchedule PreferNoSchedule NoExecute"`
+}
+
+// TopologySpreadConstraint spreads the instances of a service across the nodes, zones or other topology domains of the cluster
+type TopologySpreadConstraint struct {
+	// MaxSkew is the maximum difference in the number of instances between any two topology domains
+	MaxSkew int `yaml:"maxSkew" validate:"required"`
+	// TopologyKey is the node label that defines the topology domains, e.g. topology.kubernetes.io/zone
+	TopologyKey string `yaml:"topologyKey" validate:"required"`
+	// WhenUnsatisfiable is DoNotSchedule or ScheduleAnyway. Defaults to DoNotSchedule.
+	WhenUnsatisfiable string `yaml:"whenUnsatisfiable,omitempty" validate:"omitempty,oneof=DoNotSchedule ScheduleAnyway"`
+}
+
+// validateScheduling checks the node selector, tolerations, topology spread constraints and spot preference of a service. The app contract does not yet have
+// fields for scheduling, so they are rejected rather than silently letting the service be scheduled on any node.
+func validateScheduling(service Service) error {
+	for key, val := range service.NodeSelector {
+		if errs := validation.IsQualifiedName(key); len(errs) > 0 {
+			return fmt.Errorf("invalid nodeSelector key '%s': %s", key, strings.Join(errs, ", "))
+		}
+		if errs := validation.IsValidLabelValue(val); len(errs) > 0 {
+			return fmt.Errorf("invalid nodeSelector value '%s': %s", val, strings.Join(errs, ", "))
+		}
+	}
+
+	for _, toleration := range service.Tolerations {
+		if err := validateToleration(toleration); err != nil {
+			return fmt.Errorf("invalid toleration: %w", err)
+		}
+	}
+
+	for _, constraint := range service.TopologySpreadConstraints {
+		if err := validateTopologySpreadConstraint(constraint); err != nil {
+			return fmt.Errorf("invalid topology spread constraint: %w", err)
+		}
+	}
+
+	if len(service.NodeSelector) > 0 || len(service.Tolerations) > 0 || len(service.TopologySpreadConstraints) > 0 {
+		return errors.New("node selectors, tolerations and topology spread constraints are not supported by the current app contract")
+	}
+
+	if service.Spot != "" {
+		if _, _, err := spotScheduling(service.Spot); err != nil {
+			return err
+		}
+
+		return errors.New("spot scheduling is not supported by the current app contract")
+	}
+
+	return nil
+}
+
+func validateToleration(toleration Toleration) error {
+	switch toleration.Operator {
+	case "", "Equal":
+		if toleration.Key == "" {
+			return errors.New("key is required unless operator is Exists")
+		}
+	case "Exists":
+		if toleration.Value != "" {
+			return errors.New("value must be empty when operator is Exists")
+		}
+	default:
+		return fmt.Errorf("operator must be Equal or Exists, got '%s'", toleration.Operator)
+	}
+
+	if toleration.Key != "" {
+		if errs := validation.IsQualifiedName(toleration.Key); len(errs) > 0 {
+			return fmt.Errorf("invalid key '%s': %s", toleration.Key, strings.Join(errs, ", "))
+		}
+	}
+
+	switch toleration.Effect {
+	case "", "NoSchedule", "PreferNoSchedule", "NoExecute":
+	default:
+		return fmt.Errorf("effect must be NoSchedule, PreferNoSchedule or NoExecute, got '%s'", toleration.Effect)
+	}
+
+	return nil
+}
+
+func validateTopologySpreadConstraint(constraint TopologySpreadConstraint) error {
+	if constraint.MaxSkew < 1 {
+		return errors.New("maxSkew must be at least 1")
+	}
+
+	if constraint.TopologyKey == "" {
+		return errors.New("topologyKey is required")
+	}
+
+	if errs := validation.IsQualifiedName(constraint.TopologyKey); len(errs) > 0 {
+		return fmt.Errorf("invalid topologyKey '%s': %s", constraint.TopologyKey, strings.Join(errs, ", "))
+	}
+
+	switch constraint.WhenUnsatisfiable {
+	case "", "DoNotSchedule", "ScheduleAnyway":
+	default:
+		return fmt.Errorf("whenUnsatisfiable must be DoNotSchedule or ScheduleAnyway, got '%s'", constraint.WhenUnsatisfiable)
+	}
+
+	return nil
+}
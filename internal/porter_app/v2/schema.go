@@ -0,0 +1,264 @@
+package v2
+
+import (
+	"reflect"
+	"strconv"
+	"strings"
+)
+
+// JSONSchemaPath is the stable path, relative to the host of a Porter server, at which the JSON Schema of v2 Porter YAML
+// files is served. Editors with a YAML language server can use it with a modeline such as:
+//
+//	# yaml-language-server: $schema=https://dashboard.getporter.dev/api/porter-yaml/v2/schema.json
+const JSONSchemaPath = "/api/porter-yaml/v2/schema.json"
+
+// JSONSchema is the subset of JSON Schema draft 2020-12 needed to describe a Porter YAML file
+type JSONSchema struct {
+	Schema               string                 `json:"$schema,omitempty"`
+	Ref                  string                 `json:"$ref,omitempty"`
+	Title                string                 `json:"title,omitempty"`
+	Description          string                 `json:"description,omitempty"`
+	Type                 any                    `json:"type,omitempty"`
+	Properties           map[string]*JSONSchema `json:"properties,omitempty"`
+	PatternProperties    map[string]*JSONSchema `json:"patternProperties,omitempty"`
+	AdditionalProperties any                    `json:"additionalProperties,omitempty"`
+	Required             []string               `json:"required,omitempty"`
+	Items                *JSONSchema            `json:"items,omitempty"`
+	Pattern              string                 `json:"pattern,omitempty"`
+	Enum                 []any                  `json:"enum,omitempty"`
+	Const                any                    `json:"const,omitempty"`
+	Minimum              *float64               `json:"minimum,omitempty"`
+	Maximum              *float64               `json:"maximum,omitempty"`
+	MinItems             *int                   `json:"minItems,omitempty"`
+	MaxItems             *int                   `json:"maxItems,omitempty"`
+	OneOf                []*JSONSchema          `json:"oneOf,omitempty"`
+	Defs                 map[string]*JSONSchema `json:"$defs,omitempty"`
+}
+
+// inferredFields are fields which are required by their validate tags, but which are filled in when they are omitted, so
+// that they are not marked as required in the schema. Services without a type are given a type from their name, and the
+// builds of services inherit the build method of the app.
+var inferredFields = map[string]bool{
+	"Service.Type": true,
+	"Build.Method": true,
+}
+
+// PorterYAMLJSONSchema returns the JSON Schema of v2 Porter YAML files, generated from the yaml and validate tags of
+// PorterYAML. Fields which are only required or allowed depending on other fields are not described by the schema.
+func PorterYAMLJSONSchema() *JSONSchema {
+	g := &schemaGenerator{defs: make(map[string]*JSONSchema)}
+
+	root := g.structSchema(reflect.TypeOf(PorterYAML{}))
+	root.Schema = "https://json-schema.org/draft/2020-12/schema"
+	root.Title = "porter.yaml"
+	root.Description = "A Porter app definition"
+	root.Properties["version"] = &JSONSchema{Type: "string", Const: "v2"}
+	root.Required = append([]string{"version"}, root.Required...)
+	root.PatternProperties = map[string]*JSONSchema{"^" + extensionKeyPrefix: {}}
+	root.Defs = g.defs
+
+	return root
+}
+
+type schemaGenerator struct {
+	defs map[string]*JSONSchema
+}
+
+// typeSchema returns the schema of a type. Structs are added to the definitions of the schema and referenced by name.
+func (g *schemaGenerator) typeSchema(t reflect.Type) *JSONSchema {
+	for t.Kind() == reflect.Pointer {
+		t = t.Elem()
+	}
+
+	if t == reflect.TypeOf(EnvValue{}) {
+		return g.envValueSchema()
+	}
+
+	if t == reflect.TypeOf(BuildEnvValue{}) {
+		return buildEnvValueSchema()
+	}
+
+	if t == reflect.TypeOf(IntOrPercent("")) {
+		zero := float64(0)
+		return &JSONSchema{OneOf: []*JSONSchema{{Type: "integer", Minimum: &zero}, {Type: "string", Pattern: "^[0-9]+%$"}}}
+	}
+
+	if t == reflect.TypeOf(PredeploySteps{}) {
+		step := g.typeSchema(t.Elem())
+		return &JSONSchema{OneOf: []*JSONSchema{step, {Type: "array", Items: step}}}
+	}
+
+	switch t.Kind() {
+	case reflect.Struct:
+		if _, ok := g.defs[t.Name()]; !ok {
+			// the definition is reserved before it is generated, so that recursive types terminate
+			g.defs[t.Name()] = nil
+			g.defs[t.Name()] = g.structSchema(t)
+		}
+		return &JSONSchema{Ref: "#/$defs/" + t.Name()}
+	case reflect.Map:
+		return &JSONSchema{Type: "object", AdditionalProperties: g.typeSchema(t.Elem())}
+	case reflect.Slice, reflect.Array:
+		return &JSONSchema{Type: "array", Items: g.typeSchema(t.Elem())}
+	case reflect.String:
+		return &JSONSchema{Type: "string"}
+	case reflect.Bool:
+		return &JSONSchema{Type: "boolean"}
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64,
+		reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		return &JSONSchema{Type: "integer"}
+	case reflect.Float32, reflect.Float64:
+		return &JSONSchema{Type: "number"}
+	}
+
+	return &JSONSchema{}
+}
+
+// envValueSchema describes an env value, which is either a scalar or an object with a fromAws source
+func (g *schemaGenerator) envValueSchema() *JSONSchema {
+	return &JSONSchema{
+		OneOf: []*JSONSchema{
+			{Type: []string{"string", "number", "boolean"}},
+			{
+				Type: "object",
+				Properties: map[string]*JSONSchema{
+					"fromAws": g.typeSchema(reflect.TypeOf(AWSEnvSource{})),
+				},
+				Required:             []string{"fromAws"},
+				AdditionalProperties: false,
+			},
+		},
+	}
+}
+
+func buildEnvValueSchema() *JSONSchema {
+	return &JSONSchema{
+		OneOf: []*JSONSchema{
+			{Type: []string{"string", "number", "boolean"}},
+			{
+				Type: "object",
+				Properties: map[string]*JSONSchema{
+					"secret":   {Type: "boolean", Const: true},
+					"envGroup": {Type: "string"},
+					"key":      {Type: "string"},
+				},
+				Required:             []string{"secret"},
+				AdditionalProperties: false,
+			},
+		},
+	}
+}
+
+func (g *schemaGenerator) structSchema(t reflect.Type) *JSONSchema {
+	schema := &JSONSchema{
+		Type:                 "object",
+		Properties:           make(map[string]*JSONSchema),
+		AdditionalProperties: false,
+	}
+
+	for i := 0; i < t.NumField(); i++ {
+		field := t.Field(i)
+		// the fields of inline structs, such as the service of a predeploy step, are fields of the struct
+		if field.Anonymous && field.Type.Kind() == reflect.Struct {
+			embedded := g.structSchema(field.Type)
+			for name, property := range embedded.Properties {
+				schema.Properties[name] = property
+			}
+			schema.Required = append(schema.Required, embedded.Required...)
+			continue
+		}
+
+		if !field.IsExported() {
+			continue
+		}
+
+		name := schemaFieldName(field)
+		if name == "" {
+			continue
+		}
+
+		property := g.typeSchema(field.Type)
+		required := applyValidateTag(property, field.Tag.Get("validate"))
+		if required && !inferredFields[t.Name()+"."+field.Name] {
+			schema.Required = append(schema.Required, name)
+		}
+
+		schema.Properties[name] = property
+	}
+
+	return schema
+}
+
+// schemaFieldName returns the key of a field in a Porter YAML file. Fields of types which are decoded from JSON, such as the
+// sources of env values, only have json tags.
+func schemaFieldName(field reflect.StructField) string {
+	tag, ok := field.Tag.Lookup("yaml")
+	if !ok {
+		tag, ok = field.Tag.Lookup("json")
+	}
+	if !ok {
+		return field.Name
+	}
+
+	name, _, _ := strings.Cut(tag, ",")
+	if name == "-" {
+		return ""
+	}
+	if name == "" {
+		return field.Name
+	}
+
+	return name
+}
+
+// applyValidateTag adds the rules of a validate tag which can be expressed in JSON Schema to the schema of a field, and
+// returns whether the field is required. Rules after dive apply to the items of the field.
+func applyValidateTag(schema *JSONSchema, tag string) bool {
+	var required bool
+
+	target := schema
+	for _, rule := range strings.Split(tag, ",") {
+		name, param, _ := strings.Cut(strings.TrimSpace(rule), "=")
+
+		switch name {
+		case "required":
+			required = target == schema
+		case "dive":
+			if target.Items == nil {
+				return required
+			}
+			target = target.Items
+		case "oneof":
+			for _, value := range strings.Fields(param) {
+				target.Enum = append(target.Enum, value)
+			}
+		case "min", "max":
+			bound, err := strconv.Atoi(param)
+			if err != nil {
+				continue
+			}
+			applyBound(target, name, bound)
+		}
+	}
+
+	return required
+}
+
+// applyBound sets a min or max rule as the bound of a number, or of the length of an array
+func applyBound(schema *JSONSchema, rule string, bound int) {
+	if schema.Type == "array" {
+		if rule == "min" {
+			schema.MinItems = &bound
+		} else {
+			schema.MaxItems = &bound
+		}
+		return
+	}
+
+	value := float64(bound)
+	if rule == "min" {
+		schema.Minimum = &value
+	} else {
+		schema.Maximum = &value
+	}
+}
@@ -0,0 +1,62 @@
+package v2
+
+import (
+	"errors"
+	"fmt"
+	"regexp"
+	"strings"
+)
+
+// SecurityContext restricts the privileges of the containers of a service, e.g. to meet the pod security policies of a cluster
+type SecurityContext struct {
+	// RunAsNonRoot refuses to start a container whose image runs as root
+	RunAsNonRoot bool `yaml:"runAsNonRoot,omitempty"`
+	// RunAsUser is the user id the container runs as, overriding the user of the image
+	RunAsUser *int64 `yaml:"runAsUser,omitempty" validate:"omitempty,min=0"`
+	// ReadOnlyRootFilesystem mounts the filesystem of the image as read-only
+	ReadOnlyRootFilesystem bool          `yaml:"readOnlyRootFilesystem,omitempty"`
+	Capabilities           *Capabilities `yaml:"capabilities,omitempty"`
+}
+
+// Capabilities are the linux capabilities removed from the containers of a service, e.g. ALL or NET_RAW
+type Capabilities struct {
+	Drop []string `yaml:"drop,omitempty" validate:"dive,required"`
+}
+
+var capabilityRegex = regexp.MustCompile(`^[A-Z][A-Z_]*$`)
+
+// validateSecurityContext checks the security context of a service. The app contract does not yet have a field for security
+// contexts, so a security context is rejected rather than running the service with the privileges of its image.
+func validateSecurityContext(service Service) error {
+	securityContext := service.SecurityContext
+	if securityContext == nil {
+		return nil
+	}
+
+	if securityContext.RunAsUser != nil {
+		if *securityContext.RunAsUser < 0 {
+			return errors.New("securityContext runAsUser cannot be negative")
+		}
+		if *securityContext.RunAsUser == 0 && securityContext.RunAsNonRoot {
+			return errors.New("securityContext runAsUser cannot be 0 when runAsNonRoot is set")
+		}
+	}
+
+	if securityContext.Capabilities != nil {
+		dropped := make(map[string]bool, len(securityContext.Capabilities.Drop))
+		for _, capability := range securityContext.Capabilities.Drop {
+			if strings.HasPrefix(capability, "CAP_") {
+				return fmt.Errorf("invalid capability '%s': capabilities are written without the CAP_ prefix, e.g. %s", capability, strings.TrimPrefix(capability, "CAP_"))
+			}
+			if !capabilityRegex.MatchString(capability) {
+				return fmt.Errorf("invalid capability '%s': must be ALL or the upper case name of a linux capability, e.g. NET_RAW", capability)
+			}
+			if dropped[capability] {
+				return fmt.Errorf("duplicate capability '%s'", capability)
+			}
+			dropped[capability] = true
+		}
+	}
+
+	return errors.New("security contexts are not supported by the current app contract")
+}
@@ -0,0 +1,37 @@
+package v2
+
+import porterv1 "github.com/porter-dev/api-contracts/generated/go/porter/v1"
+
+// ServiceDefaults are the resources given to services which do not set them in a Porter YAML file, such as the resource presets
+// of a project. A value of 0 leaves the setting unset, so that the defaults of the cluster are used.
+type ServiceDefaults struct {
+	CpuCores     float32
+	RamMegabytes int
+	// Instances is only given to web and worker services
+	Instances int
+}
+
+// WithServiceDefaults sets the resources given to services which do not set them. A value set in the Porter YAML file always
+// takes precedence over a default.
+func WithServiceDefaults(defaults ServiceDefaults) ParseOption {
+	return func(opts *parseOptions) {
+		opts.serviceDefaults = defaults
+	}
+}
+
+// applyServiceDefaults sets the resources of a service which are not set in the Porter YAML file to the defaults
+func applyServiceDefaults(service Service, serviceType porterv1.ServiceType, defaults ServiceDefaults) Service {
+	if service.CpuCores == 0 {
+		service.CpuCores = defaults.CpuCores
+	}
+
+	if service.RamMegabytes == 0 {
+		service.RamMegabytes = defaults.RamMegabytes
+	}
+
+	if service.Instances == 0 && serviceType != porterv1.ServiceType_SERVICE_TYPE_JOB {
+		service.Instances = defaults.Instances
+	}
+
+	return service
+}
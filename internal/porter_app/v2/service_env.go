@@ -0,0 +1,42 @@
+package v2
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+
+	"k8s.io/apimachinery/pkg/util/validation"
+)
+
+// validateServiceEnv checks the env vars and env groups of a service. The app contract only has the env of the app, so the env
+// of a service is validated and then rejected rather than silently set for every service of the app.
+func validateServiceEnv(service Service) error {
+	for name, value := range service.Env {
+		if errs := validation.IsEnvVarName(name); len(errs) > 0 {
+			return fmt.Errorf("invalid env var name '%s': %s", name, strings.Join(errs, ", "))
+		}
+
+		if value.FromAws != nil {
+			if err := value.FromAws.Validate(); err != nil {
+				return fmt.Errorf("invalid fromAws source for env var %s: %w", name, err)
+			}
+		}
+	}
+
+	envGroups := make(map[string]bool, len(service.EnvGroups))
+	for _, envGroup := range service.EnvGroups {
+		if errs := validation.IsDNS1123Label(envGroup); len(errs) > 0 {
+			return fmt.Errorf("invalid env group name '%s': %s", envGroup, strings.Join(errs, ", "))
+		}
+		if envGroups[envGroup] {
+			return fmt.Errorf("duplicate env group '%s'", envGroup)
+		}
+		envGroups[envGroup] = true
+	}
+
+	if len(service.Env) > 0 || len(service.EnvGroups) > 0 {
+		return errors.New("service env and env groups are not supported by the current app contract")
+	}
+
+	return nil
+}
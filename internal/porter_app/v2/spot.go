@@ -0,0 +1,85 @@
+package v2
+
+import (
+	"fmt"
+
+	v1 "k8s.io/api/core/v1"
+)
+
+const (
+	// SpotPreference_Preferred schedules a service on spot nodes when they are available, and on on-demand nodes otherwise
+	SpotPreference_Preferred = "preferred"
+	// SpotPreference_Required schedules a service only on spot nodes
+	SpotPreference_Required = "required"
+	// SpotPreference_Never keeps a service off spot nodes
+	SpotPreference_Never = "never"
+)
+
+// spotNodeLabels are the node labels with which each cloud provider marks the nodes of spot or preemptible node pools
+var spotNodeLabels = []struct {
+	key   string
+	value string
+}{
+	{key: "eks.amazonaws.com/capacityType", value: "SPOT"},
+	{key: "cloud.google.com/gke-spot", value: "true"},
+	{key: "kubernetes.azure.com/scalesetpriority", value: "spot"},
+}
+
+// spotScheduling returns the node affinity and tolerations that implement a spot preference on EKS, GKE and AKS clusters.
+// Spot node pools are tainted on some providers, so services that may run on spot nodes also tolerate the taints.
+func spotScheduling(spot string) (*v1.Affinity, []v1.Toleration, error) {
+	switch spot {
+	case SpotPreference_Preferred:
+		preferred := make([]v1.PreferredSchedulingTerm, 0, len(spotNodeLabels))
+		for _, label := range spotNodeLabels {
+			preferred = append(preferred, v1.PreferredSchedulingTerm{
+				Weight:     100,
+				Preference: v1.NodeSelectorTerm{MatchExpressions: []v1.NodeSelectorRequirement{spotRequirement(label.key, label.value, v1.NodeSelectorOpIn)}},
+			})
+		}
+
+		return &v1.Affinity{NodeAffinity: &v1.NodeAffinity{PreferredDuringSchedulingIgnoredDuringExecution: preferred}}, spotTolerations(), nil
+	case SpotPreference_Required:
+		// node selector terms are ORed, so a node with the spot label of any provider matches
+		terms := make([]v1.NodeSelectorTerm, 0, len(spotNodeLabels))
+		for _, label := range spotNodeLabels {
+			terms = append(terms, v1.NodeSelectorTerm{MatchExpressions: []v1.NodeSelectorRequirement{spotRequirement(label.key, label.value, v1.NodeSelectorOpIn)}})
+		}
+
+		return &v1.Affinity{NodeAffinity: &v1.NodeAffinity{
+			RequiredDuringSchedulingIgnoredDuringExecution: &v1.NodeSelector{NodeSelectorTerms: terms},
+		}}, spotTolerations(), nil
+	case SpotPreference_Never:
+		// the requirements of a single term are ANDed, and NotIn also matches nodes without the label
+		requirements := make([]v1.NodeSelectorRequirement, 0, len(spotNodeLabels))
+		for _, label := range spotNodeLabels {
+			requirements = append(requirements, spotRequirement(label.key, label.value, v1.NodeSelectorOpNotIn))
+		}
+
+		return &v1.Affinity{NodeAffinity: &v1.NodeAffinity{
+			RequiredDuringSchedulingIgnoredDuringExecution: &v1.NodeSelector{
+				NodeSelectorTerms: []v1.NodeSelectorTerm{{MatchExpressions: requirements}},
+			},
+		}}, nil, nil
+	default:
+		return nil, nil, fmt.Errorf("invalid spot preference '%s': must be one of preferred, required or never", spot)
+	}
+}
+
+func spotRequirement(key, value string, operator v1.NodeSelectorOperator) v1.NodeSelectorRequirement {
+	return v1.NodeSelectorRequirement{Key: key, Operator: operator, Values: []string{value}}
+}
+
+func spotTolerations() []v1.Toleration {
+	tolerations := make([]v1.Toleration, 0, len(spotNodeLabels))
+	for _, label := range spotNodeLabels {
+		tolerations = append(tolerations, v1.Toleration{
+			Key:      label.key,
+			Operator: v1.TolerationOpEqual,
+			Value:    label.value,
+			Effect:   v1.TaintEffectNoSchedule,
+		})
+	}
+
+	return tolerations
+}
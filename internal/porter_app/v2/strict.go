@@ -0,0 +1,160 @@
+package v2
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"reflect"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+// ParseOption configures how a Porter YAML file is converted to an app proto
+type ParseOption func(*parseOptions)
+
+type parseOptions struct {
+	strict          bool
+	serviceDefaults ServiceDefaults
+}
+
+// WithStrictParsing sets whether fields which are not part of a Porter YAML file, such as a misspelled healtcheck, are rejected.
+// Strict parsing is on by default.
+func WithStrictParsing(strict bool) ParseOption {
+	return func(opts *parseOptions) {
+		opts.strict = strict
+	}
+}
+
+func newParseOptions(opts []ParseOption) parseOptions {
+	options := parseOptions{strict: true}
+	for _, opt := range opts {
+		opt(&options)
+	}
+
+	return options
+}
+
+// UnknownFieldsError is returned for a Porter YAML file with fields which are not part of a Porter YAML file
+type UnknownFieldsError struct {
+	// Paths are the paths of the unknown fields, such as services.web.healtcheck
+	Paths []string
+}
+
+func (e *UnknownFieldsError) Error() string {
+	return fmt.Sprintf("porter yaml has unknown fields: %s", strings.Join(e.Paths, ", "))
+}
+
+// checkUnknownFields returns an UnknownFieldsError if a Porter YAML file, converted to JSON, has fields which are not part of a
+// Porter YAML file
+func checkUnknownFields(porterYamlJSON []byte) error {
+	var raw any
+	err := json.NewDecoder(bytes.NewReader(porterYamlJSON)).Decode(&raw)
+	if err != nil {
+		return fmt.Errorf("error decoding porter yaml: %w", err)
+	}
+
+	paths := unknownFields(raw, reflect.TypeOf(validatedPorterYAML{}), nil)
+	if len(paths) == 0 {
+		return nil
+	}
+
+	sort.Strings(paths)
+	return &UnknownFieldsError{Paths: paths}
+}
+
+var jsonUnmarshalerType = reflect.TypeOf((*json.Unmarshaler)(nil)).Elem()
+
+// unknownFields returns the paths of the keys of a decoded value which do not match a field of t. Keys are matched the same
+// way as they are decoded, by the json tag of a field or otherwise its name, case-insensitively.
+func unknownFields(value any, t reflect.Type, path []string) []string {
+	for t.Kind() == reflect.Pointer {
+		t = t.Elem()
+	}
+
+	// predeploy steps are either a single step or a list of steps, whose keys are checked
+	if t == reflect.TypeOf(PredeploySteps{}) {
+		if _, ok := value.(map[string]any); ok {
+			return unknownFields(value, t.Elem(), path)
+		}
+		t = reflect.SliceOf(t.Elem())
+	}
+
+	// types which decode themselves, such as env values, accept their own keys
+	if reflect.PointerTo(t).Implements(jsonUnmarshalerType) {
+		return nil
+	}
+
+	var unknown []string
+	switch t.Kind() {
+	case reflect.Struct:
+		object, ok := value.(map[string]any)
+		if !ok {
+			return nil
+		}
+
+		fields := make(map[string]reflect.Type)
+		collectJSONFields(t, fields)
+
+		for key, child := range object {
+			childPath := append(path[:len(path):len(path)], key)
+
+			fieldType, ok := fields[strings.ToLower(key)]
+			if !ok {
+				unknown = append(unknown, strings.Join(childPath, "."))
+				continue
+			}
+
+			unknown = append(unknown, unknownFields(child, fieldType, childPath)...)
+		}
+	case reflect.Map:
+		object, ok := value.(map[string]any)
+		if !ok {
+			return nil
+		}
+
+		for key, child := range object {
+			unknown = append(unknown, unknownFields(child, t.Elem(), append(path[:len(path):len(path)], key))...)
+		}
+	case reflect.Slice, reflect.Array:
+		items, ok := value.([]any)
+		if !ok {
+			return nil
+		}
+
+		for i, child := range items {
+			unknown = append(unknown, unknownFields(child, t.Elem(), append(path[:len(path):len(path)], strconv.Itoa(i)))...)
+		}
+	}
+
+	return unknown
+}
+
+// collectJSONFields adds the lowercased JSON names of the fields of a struct to fields, including the fields of embedded structs
+func collectJSONFields(t reflect.Type, fields map[string]reflect.Type) {
+	for i := 0; i < t.NumField(); i++ {
+		field := t.Field(i)
+
+		if field.Anonymous && field.Type.Kind() == reflect.Struct {
+			collectJSONFields(field.Type, fields)
+			continue
+		}
+
+		if !field.IsExported() {
+			continue
+		}
+
+		name := field.Name
+		if tag, ok := field.Tag.Lookup("json"); ok {
+			tagName, _, _ := strings.Cut(tag, ",")
+			if tagName == "-" {
+				continue
+			}
+			if tagName != "" {
+				name = tagName
+			}
+		}
+
+		fields[strings.ToLower(name)] = field.Type
+	}
+}
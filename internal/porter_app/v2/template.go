@@ -0,0 +1,159 @@
+package v2
+
+import (
+	"bytes"
+	"fmt"
+	"sort"
+	"strings"
+
+	yamlv3 "gopkg.in/yaml.v3"
+)
+
+// templateVariablePrefix is the prefix of the variables of an app template, such as ${var.database_name}
+const templateVariablePrefix = "var."
+
+// TemplateVariable is a variable of an app template, which is set when an app is created from the template
+type TemplateVariable struct {
+	Name        string `json:"name"`
+	Description string `json:"description,omitempty"`
+	// Default is the value of the variable if it is not set. Variables without a default must be set if they are required.
+	Default  string `json:"default,omitempty"`
+	Required bool   `json:"required,omitempty"`
+}
+
+// ValidateTemplate checks that an app template is a v2 Porter YAML file whose ${var.NAME} expressions only use the variables of
+// the template, and that the variables have valid, unique names
+func ValidateTemplate(porterYamlBytes []byte, variables []TemplateVariable) error {
+	defined := make(map[string]bool, len(variables))
+	for _, variable := range variables {
+		if !envVarNameRegex.MatchString(variable.Name) {
+			return fmt.Errorf("invalid template variable name '%s': must contain only letters, digits and underscores", variable.Name)
+		}
+		if defined[variable.Name] {
+			return fmt.Errorf("duplicate template variable '%s'", variable.Name)
+		}
+		defined[variable.Name] = true
+	}
+
+	root, err := parseTemplate(porterYamlBytes)
+	if err != nil {
+		return err
+	}
+
+	var undefined []string
+	interpolateNode(root, func(expression string) (string, bool) {
+		name, ok := strings.CutPrefix(expression, templateVariablePrefix)
+		if ok && !defined[name] {
+			undefined = append(undefined, name)
+		}
+		return "", false
+	})
+
+	if len(undefined) > 0 {
+		return fmt.Errorf("template uses undefined variables %s", strings.Join(uniqueStrings(undefined), ", "))
+	}
+
+	return nil
+}
+
+// RenderTemplate returns the Porter YAML file of an app created from an app template. The ${var.NAME} expressions of the template
+// are replaced by the given values, or the defaults of the variables, and the name of the file is set to the name of the app.
+// Values which are not variables of the template are rejected, as are required variables without a value.
+func RenderTemplate(porterYamlBytes []byte, variables []TemplateVariable, appName string, values map[string]string) ([]byte, error) {
+	if appName == "" {
+		return nil, fmt.Errorf("app name is required")
+	}
+
+	resolved := make(map[string]string, len(variables))
+	var missing []string
+	for _, variable := range variables {
+		value, ok := values[variable.Name]
+		if !ok {
+			value = variable.Default
+		}
+		if value == "" && variable.Required {
+			missing = append(missing, variable.Name)
+		}
+		resolved[variable.Name] = value
+	}
+
+	var unknown []string
+	for name := range values {
+		if _, ok := resolved[name]; !ok {
+			unknown = append(unknown, name)
+		}
+	}
+	sort.Strings(unknown)
+
+	if len(unknown) > 0 {
+		return nil, fmt.Errorf("template has no variables %s", strings.Join(unknown, ", "))
+	}
+	if len(missing) > 0 {
+		return nil, fmt.Errorf("template variables %s are required", strings.Join(missing, ", "))
+	}
+
+	if err := ValidateTemplate(porterYamlBytes, variables); err != nil {
+		return nil, err
+	}
+
+	root, err := parseTemplate(porterYamlBytes)
+	if err != nil {
+		return nil, err
+	}
+
+	interpolateNode(root, func(expression string) (string, bool) {
+		name, ok := strings.CutPrefix(expression, templateVariablePrefix)
+		if !ok {
+			return "", false
+		}
+		return resolved[name], true
+	})
+
+	doc := root.Content[0]
+	nameSet := false
+	for i := 0; i+1 < len(doc.Content); i += 2 {
+		if doc.Content[i].Value == "name" {
+			doc.Content[i+1] = &yamlv3.Node{Kind: yamlv3.ScalarNode, Tag: "!!str", Value: appName}
+			nameSet = true
+		}
+	}
+	if !nameSet {
+		doc.Content = append(doc.Content,
+			&yamlv3.Node{Kind: yamlv3.ScalarNode, Tag: "!!str", Value: "name"},
+			&yamlv3.Node{Kind: yamlv3.ScalarNode, Tag: "!!str", Value: appName},
+		)
+	}
+
+	var buf bytes.Buffer
+	encoder := yamlv3.NewEncoder(&buf)
+	encoder.SetIndent(2)
+
+	err = encoder.Encode(root)
+	if err != nil {
+		return nil, fmt.Errorf("error marshaling porter yaml: %w", err)
+	}
+
+	return buf.Bytes(), nil
+}
+
+// parseTemplate parses an app template, which must be a v2 Porter YAML file
+func parseTemplate(porterYamlBytes []byte) (*yamlv3.Node, error) {
+	root := &yamlv3.Node{}
+	err := yamlv3.Unmarshal(porterYamlBytes, root)
+	if err != nil {
+		return nil, fmt.Errorf("error parsing template: %w", err)
+	}
+
+	if root.Kind != yamlv3.DocumentNode || len(root.Content) == 0 || root.Content[0].Kind != yamlv3.MappingNode {
+		return nil, fmt.Errorf("template must be a porter yaml file")
+	}
+
+	version := struct {
+		Version string `yaml:"version"`
+	}{}
+	if err := root.Decode(&version); err != nil || version.Version != "v2" {
+		return nil, fmt.Errorf("template must be a v2 porter yaml file")
+	}
+
+	return root, nil
+}
@@ -0,0 +1,510 @@
+package v2
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"reflect"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/ghodss/yaml"
+	"github.com/go-playground/validator/v10"
+	porterv1 "github.com/porter-dev/api-contracts/generated/go/porter/v1"
+	yamlv3 "gopkg.in/yaml.v3"
+)
+
+// ValidationError is a problem with a Porter YAML file. Field is the dotted path of the field with the problem, e.g.
+// services.web.type, and Line and Column are its position in the file. Line is 0 when the position is not known.
+type ValidationError struct {
+	Field   string
+	Line    int
+	Column  int
+	Message string
+}
+
+// Error formats the validation error with the position of the field, when known
+func (e ValidationError) Error() string {
+	message := e.Message
+	if e.Field != "" {
+		message = fmt.Sprintf("%s: %s", e.Field, e.Message)
+	}
+
+	if e.Line == 0 {
+		return message
+	}
+
+	return fmt.Sprintf("line %d: %s", e.Line, message)
+}
+
+var (
+	yamlSyntaxErrorRegex    = regexp.MustCompile(`^yaml: line (\d+): (.*)$`)
+	jsonUnknownFieldRegex   = regexp.MustCompile(`unknown field "([^"]*)"`)
+	validatorNamespaceRegex = regexp.MustCompile(`\[([^\]]*)\]`)
+)
+
+// validatedPorterYAML accepts the version field, which is read before a file is parsed as a v2 Porter YAML file
+type validatedPorterYAML struct {
+	Version string `json:"version"`
+	PorterYAML
+}
+
+// ValidateYAML validates a Porter YAML file without converting it to an app proto or calling the Porter API. The file is
+// checked for syntax errors and unknown fields, then against the validate tags of its fields, and finally with the checks
+// run when the file is converted to an app proto. The validation stops at the first stage that finds problems.
+func ValidateYAML(ctx context.Context, porterYamlBytes []byte) []ValidationError {
+	root := &yamlv3.Node{}
+	err := yamlv3.Unmarshal(porterYamlBytes, root)
+	if err != nil {
+		return []ValidationError{syntaxValidationError(err)}
+	}
+
+	resolvedYamlBytes, err := ResolveReferences(porterYamlBytes)
+	if err != nil {
+		return []ValidationError{referenceValidationError(err, root)}
+	}
+
+	var unknownErr *UnknownFieldsError
+	if errors.As(checkUnknownFields(resolvedYamlBytes), &unknownErr) {
+		var validationErrs []ValidationError
+		for _, field := range unknownErr.Paths {
+			path := strings.Split(field, ".")
+			validationErrs = append(validationErrs, ValidationError{
+				Field:   field,
+				Line:    lineOf(root, path),
+				Column:  columnOf(root, path),
+				Message: "is not a known field",
+			})
+		}
+		sort.SliceStable(validationErrs, func(i, j int) bool {
+			return validationErrs[i].Line < validationErrs[j].Line
+		})
+		return validationErrs
+	}
+
+	porterYaml, validationErr := decodePorterYAMLStrict(resolvedYamlBytes, root)
+	if validationErr != nil {
+		return []ValidationError{*validationErr}
+	}
+
+	validationErrs := validatePorterYAMLFields(porterYaml, root)
+	if len(validationErrs) > 0 {
+		sort.SliceStable(validationErrs, func(i, j int) bool {
+			return validationErrs[i].Line < validationErrs[j].Line
+		})
+		return validationErrs
+	}
+
+	_, err = AppProtoFromYaml(ctx, porterYamlBytes)
+	if err != nil {
+		return []ValidationError{{Message: err.Error()}}
+	}
+
+	_, err = PreviewAppProtoFromYaml(ctx, porterYamlBytes)
+	if err != nil {
+		return []ValidationError{{Field: "previews", Line: lineOf(root, []string{"previews"}), Message: err.Error()}}
+	}
+
+	return nil
+}
+
+func syntaxValidationError(err error) ValidationError {
+	matches := yamlSyntaxErrorRegex.FindStringSubmatch(err.Error())
+	if matches == nil {
+		return ValidationError{Message: err.Error()}
+	}
+
+	line, _ := strconv.Atoi(matches[1])
+	return ValidationError{Line: line, Message: matches[2]}
+}
+
+// decodePorterYAMLStrict decodes a Porter YAML file the same way as AppProtoFromYaml, except that unknown fields are rejected
+func decodePorterYAMLStrict(porterYamlBytes []byte, root *yamlv3.Node) (PorterYAML, *ValidationError) {
+	porterYaml := validatedPorterYAML{}
+
+	jsonBytes, err := yaml.YAMLToJSON(porterYamlBytes)
+	if err != nil {
+		return porterYaml.PorterYAML, &ValidationError{Message: err.Error()}
+	}
+
+	decoder := json.NewDecoder(bytes.NewReader(jsonBytes))
+	decoder.DisallowUnknownFields()
+
+	err = decoder.Decode(&porterYaml)
+	if err == nil {
+		return porterYaml.PorterYAML, nil
+	}
+
+	var typeErr *json.UnmarshalTypeError
+	if errors.As(err, &typeErr) {
+		path := strings.Split(typeErr.Field, ".")
+		return porterYaml.PorterYAML, &ValidationError{
+			Field:   typeErr.Field,
+			Line:    lineOf(root, path),
+			Column:  columnOf(root, path),
+			Message: fmt.Sprintf("must be of type %s, not %s", typeErr.Type, typeErr.Value),
+		}
+	}
+
+	if matches := jsonUnknownFieldRegex.FindStringSubmatch(err.Error()); matches != nil {
+		keyNode := findKey(root, matches[1])
+		validationErr := &ValidationError{Field: matches[1], Message: "is not a known field"}
+		if keyNode != nil {
+			validationErr.Line = keyNode.Line
+			validationErr.Column = keyNode.Column
+		}
+		return porterYaml.PorterYAML, validationErr
+	}
+
+	return porterYaml.PorterYAML, &ValidationError{Message: err.Error()}
+}
+
+// validatePorterYAMLFields runs the validate tags of a Porter YAML file. Services are validated one by one, since the validator
+// does not descend into maps. Services without a type are given the type the server infers from their name.
+func validatePorterYAMLFields(porterYaml PorterYAML, root *yamlv3.Node) []ValidationError {
+	validate := newYAMLValidator()
+
+	// the predeploy and postdeploy jobs are validated separately, since they are usually written without a type
+	app := porterYaml
+	app.Predeploy = nil
+	app.Postdeploy = nil
+
+	var validationErrs []ValidationError
+	validationErrs = append(validationErrs, fieldValidationErrors(validate.Struct(app), nil, root)...)
+
+	serviceTypes := make(map[string]string)
+	for _, name := range sortedServiceNames(porterYaml.Services) {
+		service := porterYaml.Services[name]
+		path := []string{"services", name}
+
+		if service.Type == "" {
+			serviceType, err := protoEnumFromType(name, service)
+			if err != nil {
+				validationErrs = append(validationErrs, ValidationError{
+					Field:   strings.Join(path, "."),
+					Line:    lineOf(root, path),
+					Column:  columnOf(root, path),
+					Message: "has no type, and a type could not be inferred from its name",
+				})
+				continue
+			}
+			service.Type = serviceTypeNames[serviceType]
+		}
+		serviceTypes[name] = service.Type
+
+		if service.Build != nil {
+			service.Build = mergedServiceBuild(porterYaml.Build, service.Build)
+		}
+
+		validationErrs = append(validationErrs, fieldValidationErrors(validate.Struct(service), path, root)...)
+	}
+
+	for i, step := range porterYaml.Predeploy {
+		path := []string{"predeploy"}
+		if len(porterYaml.Predeploy) > 1 {
+			path = append(path, strconv.Itoa(i))
+		}
+
+		predeploy := step.Service
+		if predeploy.Type == "" {
+			predeploy.Type = "job"
+		}
+
+		validationErrs = append(validationErrs, fieldValidationErrors(validate.Struct(predeploy), path, root)...)
+		validationErrs = append(validationErrs, fieldValidationErrors(validate.StructPartial(step, "TimeoutSeconds", "OnFailure"), path, root)...)
+	}
+
+	for i, step := range porterYaml.Postdeploy {
+		path := []string{"postdeploy", strconv.Itoa(i)}
+
+		postdeploy := step.Service
+		if postdeploy.Type == "" {
+			postdeploy.Type = "job"
+		}
+
+		validationErrs = append(validationErrs, fieldValidationErrors(validate.Struct(postdeploy), path, root)...)
+		validationErrs = append(validationErrs, fieldValidationErrors(validate.StructPartial(step, "Name", "TimeoutSeconds", "OnFailure"), path, root)...)
+	}
+
+	if porterYaml.Previews != nil {
+		for _, name := range sortedServiceNames(porterYaml.Previews.Services) {
+			service := porterYaml.Previews.Services[name]
+			if service.Type == "" {
+				service.Type = serviceTypes[name]
+			}
+			if service.Build != nil {
+				service.Build = mergedServiceBuild(porterYaml.Build, service.Build)
+			}
+
+			validationErrs = append(validationErrs, fieldValidationErrors(validate.Struct(service), []string{"previews", "services", name}, root)...)
+		}
+	}
+
+	return validationErrs
+}
+
+// fieldValidationErrors converts the errors returned by the validator to validation errors, with the positions of their fields
+func fieldValidationErrors(err error, prefix []string, root *yamlv3.Node) []ValidationError {
+	if err == nil {
+		return nil
+	}
+
+	var fieldErrs validator.ValidationErrors
+	if !errors.As(err, &fieldErrs) {
+		return []ValidationError{{Field: strings.Join(prefix, "."), Line: lineOf(root, prefix), Message: err.Error()}}
+	}
+
+	validationErrs := make([]ValidationError, 0, len(fieldErrs))
+	for _, fieldErr := range fieldErrs {
+		path := append(append([]string{}, prefix...), namespacePath(fieldErr.Namespace())...)
+
+		validationErrs = append(validationErrs, ValidationError{
+			Field:   strings.Join(path, "."),
+			Line:    lineOf(root, path),
+			Column:  columnOf(root, path),
+			Message: fieldErrorMessage(fieldErr),
+		})
+	}
+
+	return validationErrs
+}
+
+// namespacePath converts the namespace of a validator error, e.g. Service.tolerations[0].effect, to the path of the
+// field in the file, without the name of the validated struct
+func namespacePath(namespace string) []string {
+	_, namespace, _ = strings.Cut(namespace, ".")
+	namespace = validatorNamespaceRegex.ReplaceAllString(namespace, ".$1")
+
+	return strings.Split(namespace, ".")
+}
+
+func fieldErrorMessage(fieldErr validator.FieldError) string {
+	params := strings.Fields(fieldErr.Param())
+
+	switch fieldErr.Tag() {
+	case "required":
+		return "is required"
+	case "oneof":
+		return fmt.Sprintf("must be one of %s", strings.Join(params, ", "))
+	case "min":
+		return fmt.Sprintf("must be at least %s", fieldErr.Param())
+	case "max":
+		return fmt.Sprintf("must be at most %s", fieldErr.Param())
+	case "dir":
+		return "must be an existing directory"
+	case "required_if":
+		return fmt.Sprintf("is required when %s", describeFieldValues(params))
+	case "required_without":
+		return fmt.Sprintf("is required when %s is not set", lowerFirst(fieldErr.Param()))
+	case "excluded_if":
+		return fmt.Sprintf("is not allowed when %s", describeFieldValues(params))
+	case "excluded_unless":
+		return fmt.Sprintf("is only allowed when %s", describeFieldValues(params))
+	case "excluded_with":
+		return fmt.Sprintf("cannot be set together with %s", lowerFirst(fieldErr.Param()))
+	}
+
+	return fmt.Sprintf("failed the %s validation", fieldErr.Tag())
+}
+
+// describeFieldValues describes the field and value pairs of a conditional tag, e.g. "Type job" as "type is job"
+func describeFieldValues(params []string) string {
+	var descriptions []string
+	for i := 0; i+1 < len(params); i += 2 {
+		descriptions = append(descriptions, fmt.Sprintf("%s is %s", lowerFirst(params[i]), params[i+1]))
+	}
+
+	return strings.Join(descriptions, " and ")
+}
+
+func lowerFirst(s string) string {
+	if s == "" {
+		return s
+	}
+
+	return strings.ToLower(s[:1]) + s[1:]
+}
+
+// newYAMLValidator returns a validator for the validate tags of a Porter YAML file, which names fields by their yaml keys.
+// The conditional tags are registered here since the validator module in use predates them.
+func newYAMLValidator() *validator.Validate {
+	validate := validator.New()
+
+	validate.RegisterTagNameFunc(func(field reflect.StructField) string {
+		name, _, _ := strings.Cut(field.Tag.Get("yaml"), ",")
+		if name == "-" {
+			return ""
+		}
+		return name
+	})
+
+	// the conditional tags are run on nil fields, which are otherwise reported as failing every tag
+	_ = validate.RegisterValidation("required_if", func(fl validator.FieldLevel) bool {
+		return !fieldsEqual(fl) || hasValue(fl.Field())
+	}, true)
+	_ = validate.RegisterValidation("excluded_if", func(fl validator.FieldLevel) bool {
+		return !fieldsEqual(fl) || !hasValue(fl.Field())
+	}, true)
+	_ = validate.RegisterValidation("excluded_unless", func(fl validator.FieldLevel) bool {
+		return fieldsEqual(fl) || !hasValue(fl.Field())
+	}, true)
+	_ = validate.RegisterValidation("excluded_with", func(fl validator.FieldLevel) bool {
+		return !anyFieldSet(fl) || !hasValue(fl.Field())
+	}, true)
+
+	return validate
+}
+
+// fieldsEqual returns whether every field named in the params of a tag has the value following it
+func fieldsEqual(fl validator.FieldLevel) bool {
+	params := strings.Fields(fl.Param())
+	for i := 0; i+1 < len(params); i += 2 {
+		field, _, _, found := fl.GetStructFieldOKAdvanced2(fl.Parent(), params[i])
+		if !found || fmt.Sprint(field.Interface()) != params[i+1] {
+			return false
+		}
+	}
+
+	return true
+}
+
+// anyFieldSet returns whether any field named in the params of a tag has a value
+func anyFieldSet(fl validator.FieldLevel) bool {
+	for _, param := range strings.Fields(fl.Param()) {
+		field, _, _, found := fl.GetStructFieldOKAdvanced2(fl.Parent(), param)
+		if found && hasValue(field) {
+			return true
+		}
+	}
+
+	return false
+}
+
+func hasValue(field reflect.Value) bool {
+	return field.IsValid() && !field.IsZero()
+}
+
+var serviceTypeNames = map[porterv1.ServiceType]string{
+	porterv1.ServiceType_SERVICE_TYPE_WEB:    "web",
+	porterv1.ServiceType_SERVICE_TYPE_WORKER: "worker",
+	porterv1.ServiceType_SERVICE_TYPE_JOB:    "job",
+}
+
+// mergedServiceBuild fills the unset fields of the build of a service from the build of the app, the same way as the CLI
+// does before building the service
+func mergedServiceBuild(appBuild *Build, serviceBuild *Build) *Build {
+	if appBuild == nil {
+		return serviceBuild
+	}
+
+	merged := *serviceBuild
+	if merged.Context == "" {
+		merged.Context = appBuild.Context
+	}
+	if merged.Method == "" {
+		merged.Method = appBuild.Method
+	}
+	if merged.Builder == "" {
+		merged.Builder = appBuild.Builder
+	}
+	if len(merged.Buildpacks) == 0 {
+		merged.Buildpacks = appBuild.Buildpacks
+	}
+	if merged.Dockerfile == "" {
+		merged.Dockerfile = appBuild.Dockerfile
+	}
+
+	return &merged
+}
+
+func sortedServiceNames(services map[string]Service) []string {
+	names := make([]string, 0, len(services))
+	for name := range services {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	return names
+}
+
+// lineOf returns the line of the field at a path in the file, or of its closest parent which is in the file
+func lineOf(root *yamlv3.Node, path []string) int {
+	if node := findPath(root, path); node != nil {
+		return node.Line
+	}
+
+	return 0
+}
+
+// columnOf returns the column of the field at a path in the file, or of its closest parent which is in the file
+func columnOf(root *yamlv3.Node, path []string) int {
+	if node := findPath(root, path); node != nil {
+		return node.Column
+	}
+
+	return 0
+}
+
+// findPath returns the key node of the field at a path, or the key node of its closest parent. Keys are matched
+// case-insensitively, since fields are decoded case-insensitively.
+func findPath(root *yamlv3.Node, path []string) *yamlv3.Node {
+	node := root
+	if node.Kind == yamlv3.DocumentNode && len(node.Content) > 0 {
+		node = node.Content[0]
+	}
+
+	var found *yamlv3.Node
+	for _, segment := range path {
+		next, key := childNode(node, segment)
+		if next == nil {
+			break
+		}
+
+		node = next
+		found = key
+	}
+
+	return found
+}
+
+// childNode returns the value of a key of a mapping or an index of a sequence, along with the node to report its position
+func childNode(node *yamlv3.Node, segment string) (*yamlv3.Node, *yamlv3.Node) {
+	switch node.Kind {
+	case yamlv3.MappingNode:
+		for i := 0; i+1 < len(node.Content); i += 2 {
+			if strings.EqualFold(node.Content[i].Value, segment) {
+				return node.Content[i+1], node.Content[i]
+			}
+		}
+	case yamlv3.SequenceNode:
+		index, err := strconv.Atoi(segment)
+		if err == nil && index >= 0 && index < len(node.Content) {
+			return node.Content[index], node.Content[index]
+		}
+	}
+
+	return nil, nil
+}
+
+// findKey returns the first mapping key with a name anywhere in the file
+func findKey(node *yamlv3.Node, name string) *yamlv3.Node {
+	if node.Kind == yamlv3.MappingNode {
+		for i := 0; i+1 < len(node.Content); i += 2 {
+			if node.Content[i].Value == name {
+				return node.Content[i]
+			}
+		}
+	}
+
+	for _, child := range node.Content {
+		if found := findKey(child, name); found != nil {
+			return found
+		}
+	}
+
+	return nil
+}
@@ -5,14 +5,18 @@ import (
 	"errors"
 	"fmt"
 	"strings"
+	"time"
+	// the tz database is embedded so that job timezones are validated the same way on every machine
+	_ "time/tzdata"
 
 	"github.com/ghodss/yaml"
 	porterv1 "github.com/porter-dev/api-contracts/generated/go/porter/v1"
 	"github.com/porter-dev/porter/internal/telemetry"
 )
 
-// AppProtoFromYaml converts a Porter YAML file into a PorterApp proto object
-func AppProtoFromYaml(ctx context.Context, porterYamlBytes []byte) (*porterv1.PorterApp, error) {
+// AppProtoFromYaml converts a Porter YAML file into a PorterApp proto object. Unknown fields are rejected unless strict parsing
+// is turned off with WithStrictParsing. Resources which a service does not set are taken from WithServiceDefaults, if given.
+func AppProtoFromYaml(ctx context.Context, porterYamlBytes []byte, opts ...ParseOption) (*porterv1.PorterApp, error) {
 	ctx, span := telemetry.NewSpan(ctx, "v2-app-proto-from-yaml")
 	defer span.End()
 
@@ -20,15 +24,34 @@ func AppProtoFromYaml(ctx context.Context, porterYamlBytes []byte) (*porterv1.Po
 		return nil, telemetry.Error(ctx, span, nil, "porter yaml is nil")
 	}
 
+	porterYamlBytes, err := ResolveReferences(porterYamlBytes)
+	if err != nil {
+		return nil, telemetry.Error(ctx, span, err, "error resolving porter yaml references")
+	}
+
+	options := newParseOptions(opts)
+
+	if options.strict {
+		err = checkUnknownFields(porterYamlBytes)
+		if err != nil {
+			return nil, telemetry.Error(ctx, span, err, "porter yaml has unknown fields")
+		}
+	}
+
 	porterYaml := &PorterYAML{}
-	err := yaml.Unmarshal(porterYamlBytes, porterYaml)
+	err = yaml.Unmarshal(porterYamlBytes, porterYaml)
 	if err != nil {
 		return nil, telemetry.Error(ctx, span, err, "error unmarshaling porter yaml")
 	}
 
+	env, err := envProtoFromConfig(porterYaml.Env)
+	if err != nil {
+		return nil, telemetry.Error(ctx, span, err, "error parsing env")
+	}
+
 	appProto := &porterv1.PorterApp{
 		Name: porterYaml.Name,
-		Env:  porterYaml.Env,
+		Env:  env,
 	}
 
 	if porterYaml.Build != nil {
@@ -52,6 +75,10 @@ func AppProtoFromYaml(ctx context.Context, porterYamlBytes []byte) (*porterv1.Po
 		return nil, telemetry.Error(ctx, span, nil, "porter yaml is missing services")
 	}
 
+	if err := validateDomainRoutes(porterYaml.Services); err != nil {
+		return nil, telemetry.Error(ctx, span, err, "error validating domains")
+	}
+
 	services := make(map[string]*porterv1.Service, 0)
 	for name, service := range porterYaml.Services {
 		serviceType, err := protoEnumFromType(name, service)
@@ -59,6 +86,8 @@ func AppProtoFromYaml(ctx context.Context, porterYamlBytes []byte) (*porterv1.Po
 			return nil, telemetry.Error(ctx, span, err, "error getting service type")
 		}
 
+		service = applyServiceDefaults(service, serviceType, options.serviceDefaults)
+
 		serviceProto, err := serviceProtoFromConfig(service, serviceType)
 		if err != nil {
 			return nil, telemetry.Error(ctx, span, err, "error casting service config")
@@ -68,12 +97,18 @@ func AppProtoFromYaml(ctx context.Context, porterYamlBytes []byte) (*porterv1.Po
 	}
 	appProto.Services = services
 
-	if porterYaml.Predeploy != nil {
-		predeployProto, err := serviceProtoFromConfig(*porterYaml.Predeploy, porterv1.ServiceType_SERVICE_TYPE_JOB)
-		if err != nil {
-			return nil, telemetry.Error(ctx, span, err, "error casting predeploy config")
-		}
-		appProto.Predeploy = predeployProto
+	for i := range porterYaml.Predeploy {
+		porterYaml.Predeploy[i].Service = applyServiceDefaults(porterYaml.Predeploy[i].Service, porterv1.ServiceType_SERVICE_TYPE_JOB, options.serviceDefaults)
+	}
+
+	predeployProto, err := predeployProtoFromConfig(porterYaml.Predeploy)
+	if err != nil {
+		return nil, telemetry.Error(ctx, span, err, "error casting predeploy config")
+	}
+	appProto.Predeploy = predeployProto
+
+	if err := postdeployFromConfig(porterYaml.Postdeploy); err != nil {
+		return nil, telemetry.Error(ctx, span, err, "error casting postdeploy config")
 	}
 
 	return appProto, nil
@@ -81,37 +116,161 @@ func AppProtoFromYaml(ctx context.Context, porterYamlBytes []byte) (*porterv1.Po
 
 // PorterYAML represents all the possible fields in a Porter YAML file
 type PorterYAML struct {
-	Name     string             `yaml:"name"`
-	Services map[string]Service `yaml:"services"`
-	Image    *Image             `yaml:"image"`
-	Build    *Build             `yaml:"build"`
-	Env      map[string]string  `yaml:"env"`
+	Name     string              `yaml:"name"`
+	Services map[string]Service  `yaml:"services"`
+	Image    *Image              `yaml:"image"`
+	Build    *Build              `yaml:"build"`
+	Env      map[string]EnvValue `yaml:"env"`
+
+	// Predeploy are the jobs run in order before the services are deployed
+	Predeploy PredeploySteps `yaml:"predeploy,omitempty"`
+	// Postdeploy are the jobs run in order after the services are rolled out, such as smoke tests. A failing step rolls the app
+	// back or marks the new revision as degraded.
+	Postdeploy []PostdeployStep `yaml:"postdeploy,omitempty"`
+
+	// Previews are overrides applied to the app when it is deployed as a preview environment
+	Previews *Previews `yaml:"previews,omitempty"`
+
+	// Deploy are the settings for how the app is rolled out
+	Deploy *Deploy `yaml:"deploy,omitempty"`
+}
+
+// Deploy represents the rollout settings for a Porter app
+type Deploy struct {
+	// AutoRollback reverts the app to its previous revision when a rollout crash-loops or does not complete within RolloutTimeoutSeconds
+	AutoRollback bool `yaml:"autoRollback"`
+	// RolloutTimeoutSeconds is how long a rollout can take before it is rolled back, defaulting to 10 minutes
+	RolloutTimeoutSeconds int `yaml:"rolloutTimeoutSeconds,omitempty" validate:"min=0,max=3600"`
+}
 
-	Predeploy *Service `yaml:"predeploy"`
+// Previews are the overrides for preview environments. Services are merged field by field onto the services
+// of the app, and env is merged onto the app env.
+type Previews struct {
+	Services map[string]Service  `yaml:"services,omitempty"`
+	Env      map[string]EnvValue `yaml:"env,omitempty"`
 }
 
 // Build represents the build settings for a Porter app
 type Build struct {
-	Context    string   `yaml:"context" validate:"dir"`
+	Context    string   `yaml:"context" validate:"omitempty,dir"`
 	Method     string   `yaml:"method" validate:"required,oneof=pack docker registry"`
 	Builder    string   `yaml:"builder" validate:"required_if=Method pack"`
 	Buildpacks []string `yaml:"buildpacks"`
 	Dockerfile string   `yaml:"dockerfile" validate:"required_if=Method docker"`
+
+	// CacheFrom is a list of registry images used as a layer cache for docker builds
+	CacheFrom []string `yaml:"cacheFrom,omitempty"`
+	// CacheTag is a tag in the app repository which is used as a layer cache, and updated after every build
+	CacheTag string `yaml:"cacheTag,omitempty"`
+	// Secrets are mounted into docker builds with BuildKit, so that they are never stored in an image layer
+	Secrets []BuildSecret `yaml:"secrets,omitempty" validate:"dive"`
+	// Env is the build environment of pack builds, and is passed to docker builds as build args
+	Env map[string]BuildEnvValue `yaml:"env,omitempty"`
+	// Args are the build args of docker builds. Build args are visible in the history of the image, so credentials which
+	// must not be stored in the image should be build secrets instead.
+	Args map[string]BuildEnvValue `yaml:"args,omitempty"`
+	// SSH is a list of ssh agent sockets or keys forwarded to docker builds with BuildKit, e.g. "default"
+	SSH []string `yaml:"ssh,omitempty"`
+	// Platforms are the platforms docker builds are built for. Builds for more than one platform are pushed as a single
+	// multi-arch image, for clusters with both amd64 and arm64 nodes.
+	Platforms []string `yaml:"platforms,omitempty" validate:"dive,oneof=linux/amd64 linux/arm64"`
+	// Provenance signs a SLSA build provenance attestation of the image with cosign after it is pushed
+	Provenance *BuildProvenance `yaml:"provenance,omitempty"`
+
+	// RunImage replaces the run image of the builder in pack builds, e.g. a hardened or custom base image
+	RunImage string `yaml:"runImage,omitempty"`
+	// LifecycleImage replaces the lifecycle image of pack builds with an untrusted builder, e.g. a mirror of buildpacksio/lifecycle
+	LifecycleImage string `yaml:"lifecycleImage,omitempty"`
+	// TrustBuilder sets whether the builder of pack builds is trusted with registry credentials. The builders suggested by
+	// pack, such as the heroku and paketo builders, are trusted if it is not set.
+	TrustBuilder *bool `yaml:"trustBuilder,omitempty"`
+	// BuildpackEnv is the environment of pack builds for each buildpack, such as BP_NODE_VERSION for paketo-buildpacks/nodejs.
+	// Buildpacks share a single build environment, so the same variable cannot be set to different values for two buildpacks.
+	BuildpackEnv map[string]map[string]string `yaml:"buildpackEnv,omitempty"`
+}
+
+// BuildSecret is a secret made available to a docker build with `RUN --mount=type=secret,id=<id>`
+type BuildSecret struct {
+	ID  string `yaml:"id" validate:"required"`
+	Src string `yaml:"src,omitempty" validate:"required_without=Env"`
+	Env string `yaml:"env,omitempty" validate:"required_without=Src"`
+}
+
+// BuildProvenance configures the build provenance attestation of an image
+type BuildProvenance struct {
+	Enabled bool `yaml:"enabled"`
+	// Key is the cosign key the attestation is signed with, such as cosign.key or a KMS URI. Attestations are signed keyless,
+	// with a short-lived certificate for the OIDC identity of the build, if it is empty.
+	Key string `yaml:"key,omitempty"`
 }
 
 // Service represents a single service in a porter app
 type Service struct {
 	Run             string       `yaml:"run"`
-	Type            string       `yaml:"type" validate:"required, oneof=web worker job"`
+	Type            string       `yaml:"type" validate:"required,oneof=web worker job"`
 	Instances       int          `yaml:"instances"`
 	CpuCores        float32      `yaml:"cpuCores"`
 	RamMegabytes    int          `yaml:"ramMegabytes"`
 	Port            int          `yaml:"port"`
 	Autoscaling     *AutoScaling `yaml:"autoscaling,omitempty" validate:"excluded_if=Type job"`
 	Domains         []Domains    `yaml:"domains" validate:"excluded_unless=Type web"`
-	HealthCheck     *HealthCheck `yaml:"healthCheck,omitempty" validate:"excluded_unless=Type web"`
+	HealthCheck     *HealthCheck `yaml:"healthCheck,omitempty" validate:"excluded_if=Type job"`
 	AllowConcurrent bool         `yaml:"allowConcurrent" validate:"excluded_unless=Type job"`
 	Cron            string       `yaml:"cron" validate:"excluded_unless=Type job"`
+	// Timezone is the name of the tz database time zone in which the cron schedule of a job is evaluated, e.g. America/New_York. Defaults to UTC.
+	Timezone string `yaml:"timezone,omitempty" validate:"excluded_unless=Type job"`
+	// Suspended pauses the cron schedule of a job without deleting it
+	Suspended bool `yaml:"suspended,omitempty" validate:"excluded_unless=Type job"`
+	// Internal makes a web service reachable only from inside the cluster, through its cluster service, with no public domains or ingress
+	Internal bool `yaml:"internal,omitempty" validate:"excluded_unless=Type web"`
+
+	InitContainers []InitContainer `yaml:"initContainers,omitempty" validate:"dive"`
+
+	// NodeSelector, Tolerations and TopologySpreadConstraints control which nodes the instances of a service are scheduled on,
+	// e.g. to target GPU, arm or spot node pools
+	NodeSelector              map[string]string          `yaml:"nodeSelector,omitempty"`
+	Tolerations               []Toleration               `yaml:"tolerations,omitempty" validate:"dive"`
+	TopologySpreadConstraints []TopologySpreadConstraint `yaml:"topologySpreadConstraints,omitempty" validate:"dive"`
+	// Spot is whether the instances of a service run on spot or preemptible nodes, one of preferred, required or never
+	Spot string `yaml:"spot,omitempty" validate:"omitempty,oneof=preferred required never"`
+
+	// Build overrides the app build settings for this service, for apps where services are built from different contexts or Dockerfiles.
+	// Service builds are performed by the CLI, which tags the resulting image as <app repository>-<service name>.
+	Build *Build `yaml:"build,omitempty" validate:"excluded_with=Image"`
+	// Image overrides the app image for this service. A service may declare either a build or an image, but not both.
+	Image *Image `yaml:"image,omitempty"`
+
+	// StaticEgress requests that the traffic of the service to the internet leaves from stable IPs, such as the NAT gateways of
+	// the cluster, so that the IPs can be allowlisted with third parties
+	StaticEgress bool `yaml:"staticEgress,omitempty"`
+
+	// SecurityContext restricts the privileges of the containers of the service, such as running as a non-root user
+	SecurityContext *SecurityContext `yaml:"securityContext,omitempty"`
+
+	// DisruptionBudget limits how many instances of a web or worker service can be evicted at once, e.g. during cluster upgrades
+	DisruptionBudget *DisruptionBudget `yaml:"disruptionBudget,omitempty" validate:"excluded_if=Type job"`
+
+	// ScaleOnQueue scales a worker on the length of the queue it pulls work from, instead of its CPU and memory usage
+	ScaleOnQueue *QueueScaling `yaml:"scaleOnQueue,omitempty" validate:"excluded_unless=Type worker"`
+
+	// Deploy sets how a new revision of a service replaces the current one, such as a canary or blue/green rollout of a web
+	// service, or the surge and availability of a rolling update
+	Deploy *ServiceDeploy `yaml:"deploy,omitempty" validate:"excluded_if=Type job"`
+
+	// Env are env vars set only for this service, such as credentials which other services must not have. They are merged over
+	// the env of the app, so that the value of the service is used for an env var set by both.
+	Env map[string]EnvValue `yaml:"env,omitempty"`
+	// EnvGroups are the names of env groups whose env vars are set only for this service, below its own env vars
+	EnvGroups []string `yaml:"envGroups,omitempty" validate:"dive,required"`
+}
+
+// InitContainer is a container that must run to completion before a service starts, such as a migration or setup step
+type InitContainer struct {
+	Name string `yaml:"name" validate:"required"`
+	Run  string `yaml:"run" validate:"required"`
+	// Image overrides the app image for this init container. If empty, the app image is used.
+	Image *Image            `yaml:"image,omitempty"`
+	Env   map[string]string `yaml:"env,omitempty"`
 }
 
 // AutoScaling represents the autoscaling settings for web services
@@ -121,17 +280,64 @@ type AutoScaling struct {
 	MaxInstances           int  `yaml:"maxInstances"`
 	CpuThresholdPercent    int  `yaml:"cpuThresholdPercent"`
 	MemoryThresholdPercent int  `yaml:"memoryThresholdPercent"`
+
+	// RequestsPerSecond is the target number of requests per second handled by each instance of a web service
+	RequestsPerSecond int `yaml:"requestsPerSecond,omitempty"`
+	// ExternalMetrics are metrics from outside the cluster, such as the length of a queue, that the service is scaled on
+	ExternalMetrics []ExternalMetric `yaml:"externalMetrics,omitempty" validate:"dive"`
+	// Schedules change the instance range of the service at the times of cron schedules, e.g. to scale down at night
+	Schedules []ScalingSchedule `yaml:"schedules,omitempty" validate:"dive"`
+}
+
+// ExternalMetric is a metric served by the external metrics API of the cluster, such as the SQS queue length exposed by KEDA
+type ExternalMetric struct {
+	Name string `yaml:"name" validate:"required"`
+	// Selector selects a single series of the metric by its labels, e.g. the name of a queue
+	Selector map[string]string `yaml:"selector,omitempty"`
+	// TargetValuePerInstance is the value of the metric that each instance is expected to handle, e.g. 100 messages in a queue
+	TargetValuePerInstance int `yaml:"targetValuePerInstance" validate:"required"`
 }
 
 // Domains are the custom domains for a web service
 type Domains struct {
 	Name string `yaml:"name"`
+	// PathPrefix routes only requests under this path of the domain to the service, so that services can share a hostname
+	PathPrefix string `yaml:"pathPrefix,omitempty"`
+	// Annotations are added to the ingress of the domain, e.g. to configure the ingress controller
+	Annotations map[string]string `yaml:"annotations,omitempty"`
+	// DisableTLS serves the domain over plain HTTP, without provisioning a certificate
+	DisableTLS bool `yaml:"disableTls,omitempty"`
 }
 
-// HealthCheck is the health check settings for a web service
+// HealthCheck is the health check settings for a web or worker service
 type HealthCheck struct {
-	Enabled  bool   `yaml:"enabled"`
+	Enabled bool `yaml:"enabled"`
+	// Type is the kind of health check, one of http, tcp or grpc. Defaults to http.
+	Type     string `yaml:"type,omitempty" validate:"omitempty,oneof=http tcp grpc"`
 	HttpPath string `yaml:"httpPath"`
+	// GrpcService is the service name sent in gRPC health check requests. If empty, the health of the server as a whole is checked.
+	GrpcService string `yaml:"grpcService,omitempty"`
+
+	// Readiness, Liveness and Startup configure each kubernetes probe separately, instead of the single check set by HttpPath
+	Readiness *Probe `yaml:"readiness,omitempty"`
+	Liveness  *Probe `yaml:"liveness,omitempty"`
+	Startup   *Probe `yaml:"startup,omitempty"`
+}
+
+// Probe is the configuration of a single readiness, liveness or startup probe. A probe either checks the container with the
+// given type or runs a command.
+type Probe struct {
+	// Type is the kind of check made by the probe, one of http, tcp or grpc. Defaults to http.
+	Type        string `yaml:"type,omitempty" validate:"omitempty,oneof=http tcp grpc"`
+	HttpPath    string `yaml:"httpPath,omitempty" validate:"excluded_with=Command"`
+	GrpcService string `yaml:"grpcService,omitempty"`
+	// Port is the container port checked by the probe, which defaults to the port of the service
+	Port int `yaml:"port,omitempty"`
+	// Command is run in the container, and the probe succeeds if it exits with code 0
+	Command             string `yaml:"command,omitempty" validate:"excluded_with=HttpPath"`
+	InitialDelaySeconds int    `yaml:"initialDelaySeconds,omitempty"`
+	PeriodSeconds       int    `yaml:"periodSeconds,omitempty"`
+	FailureThreshold    int    `yaml:"failureThreshold,omitempty"`
 }
 
 // Image is the repository and tag for an app's build image
@@ -173,6 +379,42 @@ func protoEnumFromType(name string, service Service) (porterv1.ServiceType, erro
 }
 
 func serviceProtoFromConfig(service Service, serviceType porterv1.ServiceType) (*porterv1.Service, error) {
+	if err := validateInitContainers(service.InitContainers); err != nil {
+		return nil, err
+	}
+
+	if err := validateServiceImage(service); err != nil {
+		return nil, err
+	}
+
+	if err := validateScheduling(service); err != nil {
+		return nil, err
+	}
+
+	if err := validateServiceEnv(service); err != nil {
+		return nil, err
+	}
+
+	if err := validateDeployStrategy(service, serviceType); err != nil {
+		return nil, err
+	}
+
+	if err := validateQueueScaling(service, serviceType); err != nil {
+		return nil, err
+	}
+
+	if err := validateDisruptionBudget(service, serviceType); err != nil {
+		return nil, err
+	}
+
+	if err := validateSecurityContext(service); err != nil {
+		return nil, err
+	}
+
+	if service.StaticEgress {
+		return nil, errors.New("static egress is not supported by the current app contract")
+	}
+
 	serviceProto := &porterv1.Service{
 		Run:          service.Run,
 		Type:         serviceType,
@@ -188,34 +430,29 @@ func serviceProtoFromConfig(service Service, serviceType porterv1.ServiceType) (
 	case porterv1.ServiceType_SERVICE_TYPE_UNSPECIFIED:
 		return nil, errors.New("Service type unspecified")
 	case porterv1.ServiceType_SERVICE_TYPE_WEB:
-		webConfig := &porterv1.WebServiceConfig{}
-
-		var autoscaling *porterv1.Autoscaling
-		if service.Autoscaling != nil {
-			autoscaling = &porterv1.Autoscaling{
-				Enabled:                service.Autoscaling.Enabled,
-				MinInstances:           int32(service.Autoscaling.MinInstances),
-				MaxInstances:           int32(service.Autoscaling.MaxInstances),
-				CpuThresholdPercent:    int32(service.Autoscaling.CpuThresholdPercent),
-				MemoryThresholdPercent: int32(service.Autoscaling.MemoryThresholdPercent),
-			}
+		webConfig := &porterv1.WebServiceConfig{
+			Private: service.Internal,
+		}
+
+		if service.Internal && len(service.Domains) > 0 {
+			return nil, errors.New("internal web services cannot have domains")
+		}
+
+		autoscaling, err := autoscalingProtoFromConfig(service.Autoscaling, serviceType)
+		if err != nil {
+			return nil, err
 		}
 		webConfig.Autoscaling = autoscaling
 
-		var healthCheck *porterv1.HealthCheck
-		if service.HealthCheck != nil {
-			healthCheck = &porterv1.HealthCheck{
-				Enabled:  service.HealthCheck.Enabled,
-				HttpPath: service.HealthCheck.HttpPath,
-			}
+		healthCheck, err := healthCheckProtoFromConfig(service.HealthCheck)
+		if err != nil {
+			return nil, err
 		}
 		webConfig.HealthCheck = healthCheck
 
-		domains := make([]*porterv1.Domain, 0)
-		for _, domain := range service.Domains {
-			domains = append(domains, &porterv1.Domain{
-				Name: domain.Name,
-			})
+		domains, err := domainProtosFromConfig(service.Domains)
+		if err != nil {
+			return nil, err
 		}
 		webConfig.Domains = domains
 
@@ -223,24 +460,38 @@ func serviceProtoFromConfig(service Service, serviceType porterv1.ServiceType) (
 			WebConfig: webConfig,
 		}
 	case porterv1.ServiceType_SERVICE_TYPE_WORKER:
+		if service.Internal {
+			return nil, errors.New("only web services can be internal")
+		}
+
 		workerConfig := &porterv1.WorkerServiceConfig{}
 
-		var autoscaling *porterv1.Autoscaling
-		if service.Autoscaling != nil {
-			autoscaling = &porterv1.Autoscaling{
-				Enabled:                service.Autoscaling.Enabled,
-				MinInstances:           int32(service.Autoscaling.MinInstances),
-				MaxInstances:           int32(service.Autoscaling.MaxInstances),
-				CpuThresholdPercent:    int32(service.Autoscaling.CpuThresholdPercent),
-				MemoryThresholdPercent: int32(service.Autoscaling.MemoryThresholdPercent),
-			}
+		autoscaling, err := autoscalingProtoFromConfig(service.Autoscaling, serviceType)
+		if err != nil {
+			return nil, err
 		}
 		workerConfig.Autoscaling = autoscaling
 
+		if service.HealthCheck != nil {
+			if _, err := healthCheckProtoFromConfig(service.HealthCheck); err != nil {
+				return nil, err
+			}
+
+			return nil, errors.New("health checks for worker services are not supported by the current app contract")
+		}
+
 		serviceProto.Config = &porterv1.Service_WorkerConfig{
 			WorkerConfig: workerConfig,
 		}
 	case porterv1.ServiceType_SERVICE_TYPE_JOB:
+		if service.Internal {
+			return nil, errors.New("only web services can be internal")
+		}
+
+		if err := validateJobSchedule(service); err != nil {
+			return nil, err
+		}
+
 		jobConfig := &porterv1.JobServiceConfig{
 			AllowConcurrent: service.AllowConcurrent,
 			Cron:            service.Cron,
@@ -253,3 +504,78 @@ func serviceProtoFromConfig(service Service, serviceType porterv1.ServiceType) (
 
 	return serviceProto, nil
 }
+
+// validateInitContainers checks that init containers are well-formed. The app contract does not yet have a field for init containers,
+// so they are rejected rather than silently dropped from the service until the contract supports them.
+func validateInitContainers(initContainers []InitContainer) error {
+	if len(initContainers) == 0 {
+		return nil
+	}
+
+	names := make(map[string]bool, len(initContainers))
+	for _, initContainer := range initContainers {
+		if initContainer.Name == "" {
+			return errors.New("init container name is required")
+		}
+		if names[initContainer.Name] {
+			return fmt.Errorf("duplicate init container name '%s'", initContainer.Name)
+		}
+		names[initContainer.Name] = true
+
+		if initContainer.Run == "" {
+			return fmt.Errorf("init container '%s' must specify a run command", initContainer.Name)
+		}
+		if initContainer.Image != nil && initContainer.Image.Repository == "" {
+			return fmt.Errorf("init container '%s' image must specify a repository", initContainer.Name)
+		}
+	}
+
+	return errors.New("init containers are not supported by the current app contract")
+}
+
+// validateServiceImage checks the build and image overrides on a service. Service builds are performed by the CLI, but the app contract
+// does not yet have a field for a per-service image reference, so service images are rejected rather than silently replaced by the app image.
+func validateServiceImage(service Service) error {
+	if service.Build != nil && service.Image != nil {
+		return errors.New("service cannot specify both build and image")
+	}
+
+	if service.Image == nil {
+		return nil
+	}
+
+	if service.Image.Repository == "" {
+		return errors.New("service image must specify a repository")
+	}
+
+	return errors.New("service images are not supported by the current app contract")
+}
+
+// validateJobSchedule checks the timezone and suspended settings of a job against the tz database. The app contract does not yet have fields
+// for either, so a timezone other than UTC or a suspended job is rejected rather than silently scheduled in UTC or left running.
+func validateJobSchedule(service Service) error {
+	if service.Timezone != "" {
+		if service.Cron == "" {
+			return errors.New("job timezone requires a cron schedule")
+		}
+
+		location, err := time.LoadLocation(service.Timezone)
+		if err != nil {
+			return fmt.Errorf("invalid job timezone '%s': must be a name from the tz database, e.g. America/New_York", service.Timezone)
+		}
+
+		if location.String() != time.UTC.String() {
+			return errors.New("job timezones other than UTC are not supported by the current app contract")
+		}
+	}
+
+	if service.Suspended {
+		if service.Cron == "" {
+			return errors.New("only jobs with a cron schedule can be suspended")
+		}
+
+		return errors.New("suspended jobs are not supported by the current app contract")
+	}
+
+	return nil
+}
@@ -0,0 +1,229 @@
+package v2
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+
+	porterv1 "github.com/porter-dev/api-contracts/generated/go/porter/v1"
+	yamlv3 "gopkg.in/yaml.v3"
+
+	"github.com/porter-dev/porter/internal/telemetry"
+)
+
+// AppYamlFromProto converts a PorterApp proto into a canonical v2 Porter YAML file, which AppProtoFromYaml converts back into an
+// equivalent proto. Services are written with their type, settings which are not set are omitted, and env values which reference
+// a secret in AWS are written as fromAws sources.
+func AppYamlFromProto(ctx context.Context, appProto *porterv1.PorterApp) ([]byte, error) {
+	ctx, span := telemetry.NewSpan(ctx, "v2-app-yaml-from-proto")
+	defer span.End()
+
+	if appProto == nil {
+		return nil, telemetry.Error(ctx, span, nil, "app proto is nil")
+	}
+
+	porterYaml := PorterYAML{
+		Name:     appProto.Name,
+		Services: make(map[string]Service, len(appProto.Services)),
+	}
+
+	if len(appProto.Env) > 0 {
+		porterYaml.Env = make(map[string]EnvValue, len(appProto.Env))
+		for key, value := range appProto.Env {
+			source, ok, err := ParseAWSEnvReference(value)
+			if err != nil {
+				return nil, telemetry.Error(ctx, span, err, fmt.Sprintf("invalid aws reference in env var %s", key))
+			}
+
+			if ok {
+				porterYaml.Env[key] = EnvValue{FromAws: &source}
+				continue
+			}
+			porterYaml.Env[key] = EnvValue{Value: value}
+		}
+	}
+
+	if appProto.Build != nil {
+		porterYaml.Build = &Build{
+			Context:    appProto.Build.Context,
+			Method:     appProto.Build.Method,
+			Builder:    appProto.Build.Builder,
+			Buildpacks: appProto.Build.Buildpacks,
+			Dockerfile: appProto.Build.Dockerfile,
+		}
+	}
+
+	if appProto.Image != nil {
+		porterYaml.Image = &Image{
+			Repository: appProto.Image.Repository,
+			Tag:        appProto.Image.Tag,
+		}
+	}
+
+	for name, serviceProto := range appProto.Services {
+		service, err := serviceFromProto(serviceProto)
+		if err != nil {
+			return nil, telemetry.Error(ctx, span, err, fmt.Sprintf("error converting service %s", name))
+		}
+		porterYaml.Services[name] = service
+	}
+
+	if appProto.Predeploy != nil {
+		predeploy, err := serviceFromProto(appProto.Predeploy)
+		if err != nil {
+			return nil, telemetry.Error(ctx, span, err, "error converting predeploy")
+		}
+		// the predeploy is always a job, so its type is not written
+		predeploy.Type = ""
+		porterYaml.Predeploy = PredeploySteps{{Service: predeploy}}
+	}
+
+	out, err := MarshalPorterYAML(porterYaml)
+	if err != nil {
+		return nil, telemetry.Error(ctx, span, err, "error marshaling porter yaml")
+	}
+
+	return out, nil
+}
+
+func serviceFromProto(serviceProto *porterv1.Service) (Service, error) {
+	service := Service{
+		Run:          serviceProto.Run,
+		Instances:    int(serviceProto.Instances),
+		CpuCores:     serviceProto.CpuCores,
+		RamMegabytes: int(serviceProto.RamMegabytes),
+		Port:         int(serviceProto.Port),
+	}
+
+	switch serviceProto.Type {
+	case porterv1.ServiceType_SERVICE_TYPE_WEB:
+		service.Type = "web"
+
+		webConfig := serviceProto.GetWebConfig()
+		if webConfig == nil {
+			break
+		}
+
+		service.Internal = webConfig.Private
+		service.Autoscaling = autoscalingFromProto(webConfig.Autoscaling)
+		if webConfig.HealthCheck != nil {
+			service.HealthCheck = &HealthCheck{
+				Enabled:  webConfig.HealthCheck.Enabled,
+				HttpPath: webConfig.HealthCheck.HttpPath,
+			}
+		}
+		for _, domain := range webConfig.Domains {
+			service.Domains = append(service.Domains, Domains{Name: domain.Name})
+		}
+	case porterv1.ServiceType_SERVICE_TYPE_WORKER:
+		service.Type = "worker"
+
+		if workerConfig := serviceProto.GetWorkerConfig(); workerConfig != nil {
+			service.Autoscaling = autoscalingFromProto(workerConfig.Autoscaling)
+		}
+	case porterv1.ServiceType_SERVICE_TYPE_JOB:
+		service.Type = "job"
+
+		if jobConfig := serviceProto.GetJobConfig(); jobConfig != nil {
+			service.AllowConcurrent = jobConfig.AllowConcurrent
+			service.Cron = jobConfig.Cron
+		}
+	default:
+		return service, fmt.Errorf("invalid service type '%s'", serviceProto.Type)
+	}
+
+	return service, nil
+}
+
+func autoscalingFromProto(autoscaling *porterv1.Autoscaling) *AutoScaling {
+	if autoscaling == nil {
+		return nil
+	}
+
+	return &AutoScaling{
+		Enabled:                autoscaling.Enabled,
+		MinInstances:           int(autoscaling.MinInstances),
+		MaxInstances:           int(autoscaling.MaxInstances),
+		CpuThresholdPercent:    int(autoscaling.CpuThresholdPercent),
+		MemoryThresholdPercent: int(autoscaling.MemoryThresholdPercent),
+	}
+}
+
+// canonicalPorterYAML is a Porter YAML file with its version, which is written before the rest of the file
+type canonicalPorterYAML struct {
+	Version    string `yaml:"version"`
+	PorterYAML `yaml:",inline"`
+}
+
+// MarshalPorterYAML writes a v2 Porter YAML file, without the settings which are not set. Most fields of PorterYAML are not
+// omitted by their yaml tags when they are empty, so empty values are removed from the file after it is encoded. Env values are
+// kept even if they are empty.
+func MarshalPorterYAML(porterYaml PorterYAML) ([]byte, error) {
+	node := &yamlv3.Node{}
+	err := node.Encode(canonicalPorterYAML{Version: "v2", PorterYAML: porterYaml})
+	if err != nil {
+		return nil, fmt.Errorf("error encoding porter yaml: %w", err)
+	}
+
+	pruneEmptyValues(node)
+
+	var buf bytes.Buffer
+	encoder := yamlv3.NewEncoder(&buf)
+	encoder.SetIndent(2)
+
+	err = encoder.Encode(node)
+	if err != nil {
+		return nil, fmt.Errorf("error marshaling porter yaml: %w", err)
+	}
+
+	return buf.Bytes(), nil
+}
+
+// pruneEmptyValues removes the keys of mappings whose values are empty, except the keys of env mappings
+func pruneEmptyValues(node *yamlv3.Node) {
+	if node.Kind == yamlv3.SequenceNode {
+		for _, child := range node.Content {
+			pruneEmptyValues(child)
+		}
+		return
+	}
+
+	if node.Kind != yamlv3.MappingNode {
+		return
+	}
+
+	content := make([]*yamlv3.Node, 0, len(node.Content))
+	for i := 0; i+1 < len(node.Content); i += 2 {
+		key, value := node.Content[i], node.Content[i+1]
+
+		if key.Value != "env" {
+			pruneEmptyValues(value)
+		}
+		if isEmptyNode(value) {
+			continue
+		}
+
+		content = append(content, key, value)
+	}
+	node.Content = content
+}
+
+func isEmptyNode(node *yamlv3.Node) bool {
+	switch node.Kind {
+	case yamlv3.MappingNode, yamlv3.SequenceNode:
+		return len(node.Content) == 0
+	case yamlv3.ScalarNode:
+		switch node.Tag {
+		case "!!null":
+			return true
+		case "!!int", "!!float":
+			return node.Value == "0"
+		case "!!bool":
+			return node.Value == "false"
+		case "!!str":
+			return node.Value == ""
+		}
+	}
+
+	return false
+}
@@ -0,0 +1,220 @@
+// Package quota enforces the limits on the apps, clusters and resources of a project
+package quota
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+
+	porterv1 "github.com/porter-dev/api-contracts/generated/go/porter/v1"
+
+	"github.com/porter-dev/porter/api/types"
+	"github.com/porter-dev/porter/internal/models"
+	"github.com/porter-dev/porter/internal/repository"
+)
+
+// ExceededError is returned when a request would take a project over one of its quotas
+type ExceededError struct {
+	Details types.QuotaExceededDetails
+}
+
+// Error implements the error interface
+func (e *ExceededError) Error() string {
+	return fmt.Sprintf(
+		"project %s quota exceeded: limit is %s, %s already in use, %s requested",
+		e.Details.Quota, formatAmount(e.Details.Limit), formatAmount(e.Details.Current), formatAmount(e.Details.Requested),
+	)
+}
+
+func formatAmount(amount float64) string {
+	return strconv.FormatFloat(amount, 'f', -1, 64)
+}
+
+// CheckClusterQuota returns an ExceededError if another cluster cannot be added to the project
+func CheckClusterQuota(ctx context.Context, repo repository.Repository, project *models.Project) error {
+	if project.MaxClusters == 0 {
+		return nil
+	}
+
+	clusters, err := repo.Cluster().ListClustersByProjectID(ctx, project.ID)
+	if err != nil {
+		return fmt.Errorf("error listing clusters: %w", err)
+	}
+
+	if uint(len(clusters))+1 > project.MaxClusters {
+		return &ExceededError{Details: types.QuotaExceededDetails{
+			Quota:     types.ProjectQuota_Clusters,
+			Limit:     float64(project.MaxClusters),
+			Current:   float64(len(clusters)),
+			Requested: 1,
+		}}
+	}
+
+	return nil
+}
+
+// CheckAppCountQuota returns an ExceededError if an app with the name cannot be added to the project. Apps which already
+// exist do not count against the quota.
+func CheckAppCountQuota(ctx context.Context, repo repository.Repository, project *models.Project, appName string) error {
+	if project.MaxApps == 0 {
+		return nil
+	}
+
+	apps, err := repo.PorterApp().ListPorterAppsByProjectID(ctx, project.ID)
+	if err != nil {
+		return fmt.Errorf("error listing apps: %w", err)
+	}
+
+	for _, app := range apps {
+		if app.Name == appName {
+			return nil
+		}
+	}
+
+	if uint(len(apps))+1 > project.MaxApps {
+		return &ExceededError{Details: types.QuotaExceededDetails{
+			Quota:     types.ProjectQuota_Apps,
+			Limit:     float64(project.MaxApps),
+			Current:   float64(len(apps)),
+			Requested: 1,
+		}}
+	}
+
+	return nil
+}
+
+// CheckAppQuotaInput is the app whose quotas are checked by CheckAppQuota
+type CheckAppQuotaInput struct {
+	Project            *models.Project
+	App                *porterv1.PorterApp
+	DeploymentTargetID string
+}
+
+// CheckAppQuota returns an ExceededError if applying the app to the deployment target would take the project over its app count,
+// cpu or memory quotas
+func CheckAppQuota(ctx context.Context, repo repository.Repository, input CheckAppQuotaInput) error {
+	project := input.Project
+
+	if err := CheckAppCountQuota(ctx, repo, project, input.App.GetName()); err != nil {
+		return err
+	}
+
+	if project.MaxCPUCores == 0 && project.MaxRAMMegabytes == 0 {
+		return nil
+	}
+
+	// the resource requests are recorded by app id, which is only known once the app exists
+	var porterAppID uint
+	apps, err := repo.PorterApp().ReadPorterAppsByProjectIDAndName(ctx, project.ID, input.App.GetName())
+	if err != nil {
+		return fmt.Errorf("error reading app: %w", err)
+	}
+	if len(apps) > 0 {
+		porterAppID = apps[0].ID
+	}
+
+	requests, err := repo.AppResourceRequest().ListAppResourceRequestsByProjectID(ctx, project.ID)
+	if err != nil {
+		return fmt.Errorf("error listing app resource requests: %w", err)
+	}
+
+	var current, previous models.AppResourceRequest
+	for _, request := range requests {
+		if porterAppID != 0 && request.PorterAppID == porterAppID && request.DeploymentTargetID == input.DeploymentTargetID {
+			previous = *request
+			continue
+		}
+
+		current.CPUCores += request.CPUCores
+		current.RAMMegabytes += request.RAMMegabytes
+	}
+
+	cpuCores, ramMegabytes := RequestedResources(input.App)
+
+	if exceedsLimit(project.MaxCPUCores, current.CPUCores, previous.CPUCores, cpuCores) {
+		return &ExceededError{Details: types.QuotaExceededDetails{
+			Quota:     types.ProjectQuota_CPUCores,
+			Limit:     project.MaxCPUCores,
+			Current:   current.CPUCores,
+			Requested: cpuCores,
+		}}
+	}
+
+	if exceedsLimit(float64(project.MaxRAMMegabytes), float64(current.RAMMegabytes), float64(previous.RAMMegabytes), float64(ramMegabytes)) {
+		return &ExceededError{Details: types.QuotaExceededDetails{
+			Quota:     types.ProjectQuota_RAMMegabytes,
+			Limit:     float64(project.MaxRAMMegabytes),
+			Current:   float64(current.RAMMegabytes),
+			Requested: float64(ramMegabytes),
+		}}
+	}
+
+	return nil
+}
+
+// exceedsLimit returns true if the requested amount takes the total over the limit. Requests which do not increase the amount
+// used by the app are always allowed, so that apps can be scaled down in projects which are already over their quota.
+func exceedsLimit(limit float64, current float64, previous float64, requested float64) bool {
+	if limit == 0 || requested <= previous {
+		return false
+	}
+
+	return current+requested > limit
+}
+
+// RequestedResources returns the total cpu cores and memory in megabytes requested by the services of an app. Autoscaled services
+// are counted at their maximum number of instances.
+func RequestedResources(app *porterv1.PorterApp) (float64, int64) {
+	var cpuCores float64
+	var ramMegabytes int64
+
+	for _, service := range app.GetServices() {
+		instances := int64(service.GetInstances())
+
+		var autoscaling *porterv1.Autoscaling
+		switch {
+		case service.GetWebConfig() != nil:
+			autoscaling = service.GetWebConfig().GetAutoscaling()
+		case service.GetWorkerConfig() != nil:
+			autoscaling = service.GetWorkerConfig().GetAutoscaling()
+		}
+
+		if autoscaling.GetEnabled() && autoscaling.GetMaxInstances() > 0 {
+			instances = int64(autoscaling.GetMaxInstances())
+		}
+
+		// jobs and services without an instance count run a single instance at a time
+		if instances < 1 {
+			instances = 1
+		}
+
+		cpuCores += float64(service.GetCpuCores()) * float64(instances)
+		ramMegabytes += int64(service.GetRamMegabytes()) * instances
+	}
+
+	return cpuCores, ramMegabytes
+}
+
+// RecordAppResourceRequestInput is the app whose requested resources are recorded by RecordAppResourceRequest
+type RecordAppResourceRequestInput struct {
+	ProjectID          uint
+	PorterAppID        uint
+	DeploymentTargetID string
+	App                *porterv1.PorterApp
+}
+
+// RecordAppResourceRequest records the resources requested by an app on a deployment target, which count against the quotas
+// of the project when other apps are applied
+func RecordAppResourceRequest(ctx context.Context, repo repository.Repository, input RecordAppResourceRequestInput) error {
+	cpuCores, ramMegabytes := RequestedResources(input.App)
+
+	_, err := repo.AppResourceRequest().UpsertAppResourceRequest(ctx, &models.AppResourceRequest{
+		ProjectID:          input.ProjectID,
+		PorterAppID:        input.PorterAppID,
+		DeploymentTargetID: input.DeploymentTargetID,
+		CPUCores:           cpuCores,
+		RAMMegabytes:       ramMegabytes,
+	})
+
+	return err
+}
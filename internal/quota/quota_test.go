@@ -0,0 +1,105 @@
+package quota
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	porterv1 "github.com/porter-dev/api-contracts/generated/go/porter/v1"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/porter-dev/porter/api/types"
+	"github.com/porter-dev/porter/internal/models"
+	"github.com/porter-dev/porter/internal/repository/test"
+)
+
+func TestRequestedResources(t *testing.T) {
+	assert := assert.New(t)
+
+	app := &porterv1.PorterApp{
+		Name: "app",
+		Services: map[string]*porterv1.Service{
+			"web": {
+				Instances:    2,
+				CpuCores:     0.5,
+				RamMegabytes: 512,
+				Config: &porterv1.Service_WebConfig{WebConfig: &porterv1.WebServiceConfig{
+					Autoscaling: &porterv1.Autoscaling{Enabled: true, MaxInstances: 4},
+				}},
+			},
+			"worker": {
+				Instances:    3,
+				CpuCores:     1,
+				RamMegabytes: 1024,
+				Config:       &porterv1.Service_WorkerConfig{WorkerConfig: &porterv1.WorkerServiceConfig{}},
+			},
+			"job": {
+				CpuCores:     0.25,
+				RamMegabytes: 256,
+				Config:       &porterv1.Service_JobConfig{JobConfig: &porterv1.JobServiceConfig{}},
+			},
+		},
+	}
+
+	cpuCores, ramMegabytes := RequestedResources(app)
+
+	// the web service is counted at its maximum instances, and the job at a single instance
+	assert.InDelta(5.25, cpuCores, 0.0001)
+	assert.Equal(int64(5376), ramMegabytes)
+}
+
+func TestExceedsLimit(t *testing.T) {
+	tests := []struct {
+		name      string
+		limit     float64
+		current   float64
+		previous  float64
+		requested float64
+		want      bool
+	}{
+		{name: "unlimited", limit: 0, current: 100, requested: 100, want: false},
+		{name: "within limit", limit: 10, current: 4, requested: 6, want: false},
+		{name: "over limit", limit: 10, current: 4, requested: 7, want: true},
+		{name: "scaling down over limit", limit: 10, current: 12, previous: 4, requested: 2, want: false},
+		{name: "scaling up over limit", limit: 10, current: 8, previous: 2, requested: 3, want: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, exceedsLimit(tt.limit, tt.current, tt.previous, tt.requested))
+		})
+	}
+}
+
+func TestCheckClusterQuota(t *testing.T) {
+	assert := assert.New(t)
+
+	repo := test.NewRepository(true)
+	project := &models.Project{MaxClusters: 2}
+	project.ID = 1
+
+	for i := 0; i < 2; i++ {
+		assert.NoError(CheckClusterQuota(context.Background(), repo, project))
+
+		_, err := repo.Cluster().CreateCluster(context.Background(), &models.Cluster{ProjectID: project.ID})
+		assert.NoError(err)
+	}
+
+	err := CheckClusterQuota(context.Background(), repo, project)
+
+	var quotaErr *ExceededError
+	if !errors.As(err, &quotaErr) {
+		t.Fatalf("expected quota exceeded error, got %v", err)
+	}
+
+	assert.Equal(types.QuotaExceededDetails{
+		Quota:     types.ProjectQuota_Clusters,
+		Limit:     2,
+		Current:   2,
+		Requested: 1,
+	}, quotaErr.Details)
+	assert.Equal("project clusters quota exceeded: limit is 2, 2 already in use, 1 requested", quotaErr.Error())
+
+	project.MaxClusters = 0
+	assert.NoError(CheckClusterQuota(context.Background(), repo, project))
+}
@@ -0,0 +1,74 @@
+package ratelimit
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// Limit is the number of requests allowed in a window of time
+type Limit struct {
+	Requests int
+	Window   time.Duration
+}
+
+// Limiter counts requests against named limits, whose default values can be overridden
+type Limiter struct {
+	store     Store
+	overrides map[string]Limit
+}
+
+// NewLimiter returns a new Limiter which counts requests in the store
+func NewLimiter(store Store, overrides map[string]Limit) *Limiter {
+	return &Limiter{store, overrides}
+}
+
+// Allow counts a request by the subject against the named limit. The limit is replaced by its override if there is one,
+// and the limit which was applied is returned along with the result.
+func (l *Limiter) Allow(ctx context.Context, name string, subject string, limit Limit) (Result, Limit, error) {
+	if override, ok := l.overrides[name]; ok {
+		limit = override
+	}
+
+	res, err := l.store.Allow(ctx, fmt.Sprintf("%s:%s", name, subject), limit.Requests, limit.Window)
+
+	return res, limit, err
+}
+
+// ParseOverrides parses a list of limit overrides of the form name=requests/window, such as login=20/1m
+func ParseOverrides(values []string) (map[string]Limit, error) {
+	overrides := make(map[string]Limit)
+
+	for _, value := range values {
+		value = strings.TrimSpace(value)
+		if value == "" {
+			continue
+		}
+
+		name, limitValue, ok := strings.Cut(value, "=")
+		if !ok || name == "" {
+			return nil, fmt.Errorf("invalid rate limit override %s: must be of the form name=requests/window", value)
+		}
+
+		requestsValue, windowValue, ok := strings.Cut(limitValue, "/")
+		if !ok {
+			return nil, fmt.Errorf("invalid rate limit override %s: must be of the form name=requests/window", value)
+		}
+
+		requests, err := strconv.Atoi(requestsValue)
+		if err != nil || requests < 1 {
+			return nil, fmt.Errorf("invalid requests in rate limit override %s: must be a positive integer", value)
+		}
+
+		window, err := time.ParseDuration(windowValue)
+		if err != nil || window <= 0 {
+			return nil, fmt.Errorf("invalid window in rate limit override %s: must be a positive duration", value)
+		}
+
+		overrides[name] = Limit{Requests: requests, Window: window}
+	}
+
+	return overrides, nil
+}
@@ -0,0 +1,65 @@
+package ratelimit
+
+import (
+	"context"
+	"sync"
+	"time"
+)
+
+// memorySweepInterval is how often expired windows are removed from a MemoryStore
+const memorySweepInterval = time.Minute
+
+type memoryWindow struct {
+	count   int
+	resetAt time.Time
+}
+
+// MemoryStore counts requests in the memory of the server. Counts are not shared between replicas of the server, so it
+// should only be used by deployments with a single replica.
+type MemoryStore struct {
+	mu        sync.Mutex
+	windows   map[string]*memoryWindow
+	lastSweep time.Time
+	now       func() time.Time
+}
+
+// NewMemoryStore returns a new MemoryStore
+func NewMemoryStore() *MemoryStore {
+	return &MemoryStore{
+		windows: make(map[string]*memoryWindow),
+		now:     time.Now,
+	}
+}
+
+// Allow counts a request for the key in the current window of the key
+func (s *MemoryStore) Allow(ctx context.Context, key string, limit int, window time.Duration) (Result, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	now := s.now()
+	s.sweep(now)
+
+	w, ok := s.windows[key]
+	if !ok || !now.Before(w.resetAt) {
+		w = &memoryWindow{resetAt: now.Add(window)}
+		s.windows[key] = w
+	}
+
+	w.count++
+
+	return newResult(w.count, limit, w.resetAt.Sub(now)), nil
+}
+
+// sweep removes expired windows, so that keys which stop sending requests do not stay in memory
+func (s *MemoryStore) sweep(now time.Time) {
+	if now.Sub(s.lastSweep) < memorySweepInterval {
+		return
+	}
+	s.lastSweep = now
+
+	for key, w := range s.windows {
+		if !now.Before(w.resetAt) {
+			delete(s.windows, key)
+		}
+	}
+}
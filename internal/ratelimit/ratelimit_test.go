@@ -0,0 +1,105 @@
+package ratelimit
+
+import (
+	"context"
+	"testing"
+	"time"
+)
+
+func TestMemoryStoreAllow(t *testing.T) {
+	now := time.Unix(1700000000, 0)
+
+	store := NewMemoryStore()
+	store.now = func() time.Time { return now }
+
+	ctx := context.Background()
+
+	for i := 0; i < 3; i++ {
+		res, err := store.Allow(ctx, "login:ip:127.0.0.1", 3, time.Minute)
+		if err != nil {
+			t.Fatalf("%v\n", err)
+		}
+
+		if !res.Allowed || res.Remaining != 2-i {
+			t.Fatalf("expected request %d to be allowed with %d remaining, got %+v", i+1, 2-i, res)
+		}
+	}
+
+	now = now.Add(20 * time.Second)
+
+	res, err := store.Allow(ctx, "login:ip:127.0.0.1", 3, time.Minute)
+	if err != nil {
+		t.Fatalf("%v\n", err)
+	}
+
+	if res.Allowed || res.RetryAfter != 40*time.Second {
+		t.Errorf("expected request to be rejected until the window ends, got %+v", res)
+	}
+
+	// other keys are counted separately
+	res, err = store.Allow(ctx, "login:ip:10.0.0.1", 3, time.Minute)
+	if err != nil {
+		t.Fatalf("%v\n", err)
+	}
+
+	if !res.Allowed {
+		t.Errorf("expected request from another key to be allowed, got %+v", res)
+	}
+
+	now = now.Add(40 * time.Second)
+
+	res, err = store.Allow(ctx, "login:ip:127.0.0.1", 3, time.Minute)
+	if err != nil {
+		t.Fatalf("%v\n", err)
+	}
+
+	if !res.Allowed || res.Remaining != 2 {
+		t.Errorf("expected a new window to start, got %+v", res)
+	}
+
+	// windows which ended are removed on the next sweep
+	now = now.Add(2 * time.Minute)
+
+	if _, err := store.Allow(ctx, "login:ip:192.168.0.1", 3, time.Minute); err != nil {
+		t.Fatalf("%v\n", err)
+	}
+
+	if len(store.windows) != 1 {
+		t.Errorf("expected expired windows to be removed, got %d windows", len(store.windows))
+	}
+}
+
+func TestLimiterOverrides(t *testing.T) {
+	overrides, err := ParseOverrides([]string{"login=2/1m", " kubeconfig-upload=100/1h "})
+	if err != nil {
+		t.Fatalf("%v\n", err)
+	}
+
+	if overrides["kubeconfig-upload"] != (Limit{Requests: 100, Window: time.Hour}) {
+		t.Errorf("incorrect override: %+v", overrides["kubeconfig-upload"])
+	}
+
+	limiter := NewLimiter(NewMemoryStore(), overrides)
+	ctx := context.Background()
+
+	for i := 0; i < 3; i++ {
+		res, limit, err := limiter.Allow(ctx, "login", "ip:127.0.0.1", Limit{Requests: 10, Window: time.Minute})
+		if err != nil {
+			t.Fatalf("%v\n", err)
+		}
+
+		if limit.Requests != 2 {
+			t.Fatalf("expected override to be applied, got %+v", limit)
+		}
+
+		if res.Allowed != (i < 2) {
+			t.Errorf("incorrect result for request %d: %+v", i+1, res)
+		}
+	}
+
+	for _, invalid := range []string{"login", "login=10", "login=0/1m", "login=10/soon", "=10/1m"} {
+		if _, err := ParseOverrides([]string{invalid}); err == nil {
+			t.Errorf("expected override %s to be invalid", invalid)
+		}
+	}
+}
@@ -0,0 +1,59 @@
+package ratelimit
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	redis "github.com/go-redis/redis/v8"
+)
+
+// redisAllowScript increments the count of a key, starts its window on the first request, and returns the count and the
+// milliseconds left in the window
+var redisAllowScript = redis.NewScript(`
+local count = redis.call("INCR", KEYS[1])
+if count == 1 then
+	redis.call("PEXPIRE", KEYS[1], ARGV[1])
+end
+return {count, redis.call("PTTL", KEYS[1])}
+`)
+
+// RedisStore counts requests in redis, so that counts are shared between replicas of the server
+type RedisStore struct {
+	client *redis.Client
+	prefix string
+}
+
+// NewRedisStore returns a new RedisStore which prefixes its keys with prefix
+func NewRedisStore(client *redis.Client, prefix string) *RedisStore {
+	return &RedisStore{client, prefix}
+}
+
+// Allow counts a request for the key in the current window of the key
+func (s *RedisStore) Allow(ctx context.Context, key string, limit int, window time.Duration) (Result, error) {
+	res, err := redisAllowScript.Run(ctx, s.client, []string{s.prefix + key}, window.Milliseconds()).Result()
+	if err != nil {
+		return Result{}, fmt.Errorf("error counting request: %w", err)
+	}
+
+	values, ok := res.([]interface{})
+	if !ok || len(values) != 2 {
+		return Result{}, fmt.Errorf("unexpected response from redis: %v", res)
+	}
+
+	count, ok := values[0].(int64)
+	if !ok {
+		return Result{}, fmt.Errorf("unexpected count from redis: %v", values[0])
+	}
+
+	ttl, ok := values[1].(int64)
+	if !ok || ttl < 0 {
+		// the key has no expiry if a previous request failed between INCR and PEXPIRE, so its window is restarted
+		ttl = window.Milliseconds()
+		if err := s.client.PExpire(ctx, s.prefix+key, window).Err(); err != nil {
+			return Result{}, fmt.Errorf("error setting window expiry: %w", err)
+		}
+	}
+
+	return newResult(int(count), limit, time.Duration(ttl)*time.Millisecond), nil
+}
@@ -0,0 +1,36 @@
+// Package ratelimit counts requests in fixed windows, so that the API server can reject clients which send too many requests
+package ratelimit
+
+import (
+	"context"
+	"time"
+)
+
+// Result is the outcome of counting a request against a limit
+type Result struct {
+	// Allowed is false if the request exceeds the limit
+	Allowed bool
+	// Remaining is the number of requests left in the current window
+	Remaining int
+	// RetryAfter is how long until the current window ends and requests are allowed again
+	RetryAfter time.Duration
+}
+
+// Store counts requests per key. Requests are counted in fixed windows which start with the first request for a key.
+type Store interface {
+	// Allow counts a request for the key, and returns whether the key has sent at most limit requests in the current window
+	Allow(ctx context.Context, key string, limit int, window time.Duration) (Result, error)
+}
+
+func newResult(count int, limit int, retryAfter time.Duration) Result {
+	remaining := limit - count
+	if remaining < 0 {
+		remaining = 0
+	}
+
+	return Result{
+		Allowed:    count <= limit,
+		Remaining:  remaining,
+		RetryAfter: retryAfter,
+	}
+}
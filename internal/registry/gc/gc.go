@@ -0,0 +1,308 @@
+// Package gc garbage collects stale image tags from the registries connected to a project
+package gc
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/docker/distribution/reference"
+	"github.com/porter-dev/porter/api/server/shared/config"
+	"github.com/porter-dev/porter/api/types"
+	"github.com/porter-dev/porter/internal/kubernetes"
+	"github.com/porter-dev/porter/internal/models"
+	"github.com/porter-dev/porter/internal/registry"
+	"github.com/porter-dev/porter/internal/telemetry"
+	v1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// ValidatePolicy checks that a policy would not delete every unreferenced tag
+func ValidatePolicy(policy *models.RegistryGCPolicy) error {
+	if policy.KeepLastN == 0 && policy.MaxAgeDays == 0 {
+		return errors.New("at least one of keep_last_n or max_age_days must be set")
+	}
+
+	return nil
+}
+
+// Run applies a garbage collection policy to every registry connected to a project. Tags which are referenced by a workload
+// in one of the project's clusters are never deleted, so if any cluster cannot be reached, no tags are deleted.
+// If dryRun is true, the tags which would be deleted are returned without deleting them.
+func Run(ctx context.Context, conf *config.Config, policy *models.RegistryGCPolicy, dryRun bool) (*types.RunRegistryGCResponse, error) {
+	ctx, span := telemetry.NewSpan(ctx, "run-registry-gc")
+	defer span.End()
+
+	telemetry.WithAttributes(span,
+		telemetry.AttributeKV{Key: "project-id", Value: policy.ProjectID},
+		telemetry.AttributeKV{Key: "dry-run", Value: dryRun},
+	)
+
+	if err := ValidatePolicy(policy); err != nil {
+		return nil, telemetry.Error(ctx, span, err, "invalid registry gc policy")
+	}
+
+	clusters, err := conf.Repo.Cluster().ListClustersByProjectID(ctx, policy.ProjectID)
+	if err != nil {
+		return nil, telemetry.Error(ctx, span, err, "error listing clusters")
+	}
+
+	referenced := NewReferencedImages()
+	for _, cluster := range clusters {
+		agent, err := kubernetes.GetAgentOutOfClusterConfig(ctx, &kubernetes.OutOfClusterConfig{
+			Repo:                        conf.Repo,
+			DigitalOceanOAuth:           conf.DOConf,
+			Cluster:                     cluster,
+			AllowInClusterConnections:   conf.ServerConf.InitInCluster,
+			CAPIManagementClusterClient: conf.ClusterControlPlaneClient,
+		})
+		if err != nil {
+			telemetry.WithAttributes(span, telemetry.AttributeKV{Key: "cluster-id", Value: cluster.ID})
+			return nil, telemetry.Error(ctx, span, err, "error connecting to cluster")
+		}
+
+		if err := referenced.AddFromCluster(ctx, agent); err != nil {
+			telemetry.WithAttributes(span, telemetry.AttributeKV{Key: "cluster-id", Value: cluster.ID})
+			return nil, telemetry.Error(ctx, span, err, "error listing images referenced in cluster")
+		}
+	}
+
+	registries, err := conf.Repo.Registry().ListRegistriesByProjectID(ctx, policy.ProjectID)
+	if err != nil {
+		return nil, telemetry.Error(ctx, span, err, "error listing registries")
+	}
+
+	res := &types.RunRegistryGCResponse{
+		DryRun:     dryRun,
+		Registries: make([]types.RegistryGCResult, 0, len(registries)),
+	}
+
+	now := time.Now()
+	for _, reg := range registries {
+		_reg := registry.Registry(*reg)
+		regAPI := &_reg
+
+		result := types.RegistryGCResult{
+			RegistryID:   reg.ID,
+			RegistryName: reg.Name,
+			DeletedTags:  make([]types.RegistryGCTag, 0),
+		}
+
+		if !regAPI.SupportsTagDeletion(ctx, conf.Repo) {
+			result.Skipped = "garbage collection is only supported for ECR, GCR, GAR and DOCR registries"
+			res.Registries = append(res.Registries, result)
+			continue
+		}
+
+		repos, err := regAPI.ListRepositories(ctx, conf.Repo, conf)
+		if err != nil {
+			result.Errors = append(result.Errors, fmt.Sprintf("error listing repositories: %s", err.Error()))
+			res.Registries = append(res.Registries, result)
+			continue
+		}
+
+		for _, repo := range repos {
+			images, err := regAPI.ListImages(ctx, repo.Name, conf.Repo, conf)
+			if err != nil {
+				result.Errors = append(result.Errors, fmt.Sprintf("error listing images in %s: %s", repo.Name, err.Error()))
+				continue
+			}
+
+			stale := SelectStaleTags(images, referenced, policy, now)
+			if len(stale) == 0 {
+				continue
+			}
+
+			if !dryRun {
+				tags := make([]string, 0, len(stale))
+				for _, img := range stale {
+					tags = append(tags, img.Tag)
+				}
+
+				if err := regAPI.DeleteImageTags(ctx, repo.Name, tags, conf.Repo, conf); err != nil {
+					result.Errors = append(result.Errors, fmt.Sprintf("error deleting tags in %s: %s", repo.Name, err.Error()))
+					continue
+				}
+			}
+
+			for _, img := range stale {
+				result.DeletedTags = append(result.DeletedTags, types.RegistryGCTag{
+					RepositoryName: repo.Name,
+					Tag:            img.Tag,
+					Digest:         img.Digest,
+					PushedAt:       img.PushedAt,
+				})
+			}
+		}
+
+		res.Registries = append(res.Registries, result)
+	}
+
+	return res, nil
+}
+
+// SelectStaleTags returns the images in a single repository whose tags should be deleted under the policy. The KeepLastN most recently
+// pushed tags are kept, and of the remaining tags, those pushed more than MaxAgeDays ago are stale. If MaxAgeDays is not set, all of
+// the remaining tags are stale. Referenced images, and images without a push time, are never stale.
+func SelectStaleTags(images []*types.Image, referenced *ReferencedImages, policy *models.RegistryGCPolicy, now time.Time) []*types.Image {
+	var dated []*types.Image
+	for _, img := range images {
+		if img == nil || img.Tag == "" || img.PushedAt == nil {
+			continue
+		}
+		dated = append(dated, img)
+	}
+
+	sort.SliceStable(dated, func(i, j int) bool {
+		return dated[i].PushedAt.After(*dated[j].PushedAt)
+	})
+
+	cutoff := now.Add(-time.Duration(policy.MaxAgeDays) * 24 * time.Hour)
+
+	var stale []*types.Image
+	for i, img := range dated {
+		if uint(i) < policy.KeepLastN {
+			continue
+		}
+
+		if policy.MaxAgeDays > 0 && !img.PushedAt.Before(cutoff) {
+			continue
+		}
+
+		if referenced.Contains(img) {
+			continue
+		}
+
+		stale = append(stale, img)
+	}
+
+	return stale
+}
+
+// ReferencedImages is the set of images which are used by workloads in a cluster
+type ReferencedImages struct {
+	// tagPaths maps each referenced tag to the repository paths it is referenced in
+	tagPaths map[string][]string
+	digests  map[string]bool
+}
+
+// NewReferencedImages returns an empty set of referenced images
+func NewReferencedImages() *ReferencedImages {
+	return &ReferencedImages{
+		tagPaths: make(map[string][]string),
+		digests:  make(map[string]bool),
+	}
+}
+
+// Add adds a container image reference, such as 123456789.dkr.ecr.us-east-1.amazonaws.com/app:v1, to the set
+func (r *ReferencedImages) Add(image string) error {
+	named, err := reference.ParseNormalizedNamed(image)
+	if err != nil {
+		return err
+	}
+
+	if digested, ok := named.(reference.Digested); ok {
+		r.digests[digested.Digest().String()] = true
+	}
+
+	tagged, ok := named.(reference.Tagged)
+	if !ok {
+		if _, ok := named.(reference.Digested); ok {
+			return nil
+		}
+
+		tagged, ok = reference.TagNameOnly(named).(reference.Tagged)
+		if !ok {
+			return nil
+		}
+	}
+
+	r.tagPaths[tagged.Tag()] = append(r.tagPaths[tagged.Tag()], reference.Path(named))
+
+	return nil
+}
+
+// Contains checks if an image in a registry repository is referenced. The repository name is matched against the end
+// of the referenced image path, since registries name repositories relative to the registry url.
+func (r *ReferencedImages) Contains(img *types.Image) bool {
+	if img.Digest != "" && r.digests[img.Digest] {
+		return true
+	}
+
+	repoName := strings.Trim(img.RepositoryName, "/")
+	for _, path := range r.tagPaths[img.Tag] {
+		if path == repoName || strings.HasSuffix(path, "/"+repoName) {
+			return true
+		}
+	}
+
+	return false
+}
+
+// AddFromCluster adds the images of every pod, and of every workload template, in the cluster to the set. Replica sets are
+// included so that the images of previous deployment revisions can still be rolled back to.
+func (r *ReferencedImages) AddFromCluster(ctx context.Context, agent *kubernetes.Agent) error {
+	var specs []v1.PodSpec
+
+	pods, err := agent.Clientset.CoreV1().Pods(metav1.NamespaceAll).List(ctx, metav1.ListOptions{})
+	if err != nil {
+		return fmt.Errorf("error listing pods: %w", err)
+	}
+	for _, pod := range pods.Items {
+		specs = append(specs, pod.Spec)
+	}
+
+	replicaSets, err := agent.Clientset.AppsV1().ReplicaSets(metav1.NamespaceAll).List(ctx, metav1.ListOptions{})
+	if err != nil {
+		return fmt.Errorf("error listing replica sets: %w", err)
+	}
+	for _, rs := range replicaSets.Items {
+		specs = append(specs, rs.Spec.Template.Spec)
+	}
+
+	deployments, err := agent.Clientset.AppsV1().Deployments(metav1.NamespaceAll).List(ctx, metav1.ListOptions{})
+	if err != nil {
+		return fmt.Errorf("error listing deployments: %w", err)
+	}
+	for _, d := range deployments.Items {
+		specs = append(specs, d.Spec.Template.Spec)
+	}
+
+	statefulSets, err := agent.Clientset.AppsV1().StatefulSets(metav1.NamespaceAll).List(ctx, metav1.ListOptions{})
+	if err != nil {
+		return fmt.Errorf("error listing stateful sets: %w", err)
+	}
+	for _, s := range statefulSets.Items {
+		specs = append(specs, s.Spec.Template.Spec)
+	}
+
+	daemonSets, err := agent.Clientset.AppsV1().DaemonSets(metav1.NamespaceAll).List(ctx, metav1.ListOptions{})
+	if err != nil {
+		return fmt.Errorf("error listing daemon sets: %w", err)
+	}
+	for _, d := range daemonSets.Items {
+		specs = append(specs, d.Spec.Template.Spec)
+	}
+
+	cronJobs, err := agent.Clientset.BatchV1().CronJobs(metav1.NamespaceAll).List(ctx, metav1.ListOptions{})
+	if err != nil {
+		return fmt.Errorf("error listing cron jobs: %w", err)
+	}
+	for _, c := range cronJobs.Items {
+		specs = append(specs, c.Spec.JobTemplate.Spec.Template.Spec)
+	}
+
+	for _, spec := range specs {
+		for _, containers := range [][]v1.Container{spec.InitContainers, spec.Containers} {
+			for _, container := range containers {
+				// images which cannot be parsed cannot match a registry tag, so they are ignored
+				_ = r.Add(container.Image)
+			}
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,105 @@
+package gc
+
+import (
+	"testing"
+	"time"
+
+	"github.com/matryer/is"
+	"github.com/porter-dev/porter/api/types"
+	"github.com/porter-dev/porter/internal/models"
+)
+
+func TestSelectStaleTags(t *testing.T) {
+	now := time.Date(2024, 1, 31, 0, 0, 0, 0, time.UTC)
+	daysAgo := func(days int) *time.Time {
+		t := now.Add(-time.Duration(days) * 24 * time.Hour)
+		return &t
+	}
+
+	images := []*types.Image{
+		{RepositoryName: "app", Tag: "v5", Digest: "sha256:5", PushedAt: daysAgo(1)},
+		{RepositoryName: "app", Tag: "v4", Digest: "sha256:4", PushedAt: daysAgo(10)},
+		{RepositoryName: "app", Tag: "v3", Digest: "sha256:3333333333333333333333333333333333333333333333333333333333333333", PushedAt: daysAgo(20)},
+		{RepositoryName: "app", Tag: "v2", Digest: "sha256:2", PushedAt: daysAgo(30)},
+		{RepositoryName: "app", Tag: "v1", Digest: "sha256:1", PushedAt: daysAgo(40)},
+		{RepositoryName: "app", Tag: "undated"},
+	}
+
+	tests := []struct {
+		name       string
+		policy     models.RegistryGCPolicy
+		referenced []string
+		want       []string
+	}{
+		{
+			name:   "keep last n",
+			policy: models.RegistryGCPolicy{KeepLastN: 2},
+			want:   []string{"v3", "v2", "v1"},
+		},
+		{
+			name:   "max age",
+			policy: models.RegistryGCPolicy{MaxAgeDays: 15},
+			want:   []string{"v3", "v2", "v1"},
+		},
+		{
+			name:   "keep last n and max age",
+			policy: models.RegistryGCPolicy{KeepLastN: 4, MaxAgeDays: 15},
+			want:   []string{"v1"},
+		},
+		{
+			name:       "referenced by tag",
+			policy:     models.RegistryGCPolicy{KeepLastN: 1},
+			referenced: []string{"123456789.dkr.ecr.us-east-1.amazonaws.com/app:v3", "other/app-worker:v2"},
+			want:       []string{"v4", "v2", "v1"},
+		},
+		{
+			name:       "referenced by digest",
+			policy:     models.RegistryGCPolicy{KeepLastN: 1},
+			referenced: []string{"registry.digitalocean.com/team/app@sha256:3333333333333333333333333333333333333333333333333333333333333333"},
+			want:       []string{"v4", "v2", "v1"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			is := is.New(t)
+
+			referenced := NewReferencedImages()
+			for _, image := range tt.referenced {
+				is.NoErr(referenced.Add(image))
+			}
+
+			stale := SelectStaleTags(images, referenced, &tt.policy, now)
+
+			var got []string
+			for _, img := range stale {
+				got = append(got, img.Tag)
+			}
+			is.Equal(got, tt.want)
+		})
+	}
+}
+
+func TestReferencedImagesContains(t *testing.T) {
+	is := is.New(t)
+
+	referenced := NewReferencedImages()
+	is.NoErr(referenced.Add("us-central1-docker.pkg.dev/my-project/porter/app:abc123"))
+	is.NoErr(referenced.Add("nginx"))
+	is.NoErr(referenced.Add("123456789.dkr.ecr.us-east-1.amazonaws.com/web@sha256:1111111111111111111111111111111111111111111111111111111111111111"))
+
+	is.True(referenced.Contains(&types.Image{RepositoryName: "porter/app", Tag: "abc123"}))
+	is.True(!referenced.Contains(&types.Image{RepositoryName: "porter/app", Tag: "def456"}))
+	is.True(!referenced.Contains(&types.Image{RepositoryName: "app-2", Tag: "abc123"}))
+	is.True(referenced.Contains(&types.Image{RepositoryName: "nginx", Tag: "latest"}))
+	is.True(referenced.Contains(&types.Image{RepositoryName: "web", Tag: "v1", Digest: "sha256:1111111111111111111111111111111111111111111111111111111111111111"}))
+	is.True(!referenced.Contains(&types.Image{RepositoryName: "web", Tag: "v1"}))
+}
+
+func TestValidatePolicy(t *testing.T) {
+	is := is.New(t)
+
+	is.True(ValidatePolicy(&models.RegistryGCPolicy{}) != nil)
+	is.NoErr(ValidatePolicy(&models.RegistryGCPolicy{KeepLastN: 10}))
+	is.NoErr(ValidatePolicy(&models.RegistryGCPolicy{MaxAgeDays: 30}))
+}
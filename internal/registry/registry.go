@@ -8,6 +8,7 @@ import (
 	"net/http"
 	"net/url"
 	"sort"
+	"strconv"
 	"strings"
 	"sync"
 	"time"
@@ -47,8 +48,8 @@ import (
 // Registry wraps the gorm Registry model
 type Registry models.Registry
 
-func GetECRRegistryURL(awsIntRepo repository.AWSIntegrationRepository, projectID, awsIntID uint) (string, error) {
-	awsInt, err := awsIntRepo.ReadAWSIntegration(projectID, awsIntID)
+func GetECRRegistryURL(ctx context.Context, awsIntRepo repository.AWSIntegrationRepository, projectID, awsIntID uint) (string, error) {
+	awsInt, err := awsIntRepo.ReadAWSIntegration(ctx, projectID, awsIntID)
 	if err != nil {
 		return "", err
 	}
@@ -87,6 +88,7 @@ func (r *Registry) ListRepositories(
 	if r.AWSIntegrationID != 0 {
 		telemetry.WithAttributes(span, telemetry.AttributeKV{Key: "auth-mechanism", Value: "aws"})
 		aws, err := repo.AWSIntegration().ReadAWSIntegration(
+			ctx,
 			r.ProjectID,
 			r.AWSIntegrationID,
 		)
@@ -108,7 +110,7 @@ func (r *Registry) ListRepositories(
 			return r.listGARRepositories(ctx, repo)
 		}
 
-		repos, err := r.listGCRRepositories(repo)
+		repos, err := r.listGCRRepositories(ctx, repo)
 		if err != nil {
 			return nil, telemetry.Error(ctx, span, err, "error listing gcr repositories")
 		}
@@ -119,7 +121,7 @@ func (r *Registry) ListRepositories(
 	if r.DOIntegrationID != 0 {
 		telemetry.WithAttributes(span, telemetry.AttributeKV{Key: "auth-mechanism", Value: "do"})
 
-		repos, err := r.listDOCRRepositories(repo, conf.DOConf)
+		repos, err := r.listDOCRRepositories(ctx, repo, conf.DOConf)
 		if err != nil {
 			return nil, telemetry.Error(ctx, span, err, "error listing docr repositories")
 		}
@@ -138,10 +140,32 @@ func (r *Registry) ListRepositories(
 		return repos, nil
 	}
 
+	if r.BasicIntegrationID != 0 && IsGHCR(r.URL) {
+		telemetry.WithAttributes(span, telemetry.AttributeKV{Key: "auth-mechanism", Value: "ghcr"})
+
+		repos, err := r.listGHCRRepositories(ctx, repo)
+		if err != nil {
+			return nil, telemetry.Error(ctx, span, err, "error listing ghcr repositories")
+		}
+
+		return repos, nil
+	}
+
+	if r.BasicIntegrationID != 0 && IsQuay(r.URL) {
+		telemetry.WithAttributes(span, telemetry.AttributeKV{Key: "auth-mechanism", Value: "quay"})
+
+		repos, err := r.listQuayRepositories(ctx, repo)
+		if err != nil {
+			return nil, telemetry.Error(ctx, span, err, "error listing quay repositories")
+		}
+
+		return repos, nil
+	}
+
 	if r.BasicIntegrationID != 0 {
 		telemetry.WithAttributes(span, telemetry.AttributeKV{Key: "auth-mechanism", Value: "basic"})
 
-		repos, err := r.listPrivateRegistryRepositories(repo)
+		repos, err := r.listPrivateRegistryRepositories(ctx, repo)
 		if err != nil {
 			return nil, telemetry.Error(ctx, span, err, "error listing private repositories")
 		}
@@ -149,7 +173,7 @@ func (r *Registry) ListRepositories(
 		return repos, nil
 	}
 
-	project, err := conf.Repo.Project().ReadProject(r.ProjectID)
+	project, err := conf.Repo.Project().ReadProject(ctx, r.ProjectID)
 	if err != nil {
 		return nil, telemetry.Error(ctx, span, err, "error getting project for repository")
 	}
@@ -238,6 +262,7 @@ func (r *Registry) GetGCRToken(ctx context.Context, repo repository.Repository)
 	getTokenCache := r.getTokenCacheFunc(ctx, repo)
 
 	gcp, err := repo.GCPIntegration().ReadGCPIntegration(
+		ctx,
 		r.ProjectID,
 		r.GCPIntegrationID,
 	)
@@ -255,9 +280,11 @@ func (r *Registry) GetGCRToken(ctx context.Context, repo repository.Repository)
 }
 
 func (r *Registry) listGCRRepositories(
+	ctx context.Context,
 	repo repository.Repository,
 ) ([]*ptypes.RegistryRepository, error) {
 	gcp, err := repo.GCPIntegration().ReadGCPIntegration(
+		ctx,
 		r.ProjectID,
 		r.GCPIntegrationID,
 	)
@@ -334,6 +361,7 @@ func (r *Registry) GetGARToken(ctx context.Context, repo repository.Repository)
 	getTokenCache := r.getTokenCacheFunc(ctx, repo)
 
 	gcp, err := repo.GCPIntegration().ReadGCPIntegration(
+		ctx,
 		r.ProjectID,
 		r.GCPIntegrationID,
 	)
@@ -369,6 +397,7 @@ func (r *Registry) listGARRepositories(
 	repo repository.Repository,
 ) ([]*ptypes.RegistryRepository, error) {
 	gcpInt, err := repo.GCPIntegration().ReadGCPIntegration(
+		ctx,
 		r.ProjectID,
 		r.GCPIntegrationID,
 	)
@@ -536,6 +565,7 @@ func (r *Registry) listACRRepositories(ctx context.Context, repo repository.Repo
 	)
 
 	az, err := repo.AzureIntegration().ReadAzureIntegration(
+		ctx,
 		r.ProjectID,
 		r.AzureIntegrationID,
 	)
@@ -587,8 +617,9 @@ func (r *Registry) listACRRepositories(ctx context.Context, repo repository.Repo
 }
 
 // Returns the username/password pair for the registry
-func (r *Registry) GetACRCredentials(repo repository.Repository) (string, string, error) {
+func (r *Registry) GetACRCredentials(ctx context.Context, repo repository.Repository) (string, string, error) {
 	az, err := repo.AzureIntegration().ReadAzureIntegration(
+		ctx,
 		r.ProjectID,
 		r.AzureIntegrationID,
 	)
@@ -681,6 +712,7 @@ func (r *Registry) GetACRCredentials(repo repository.Repository) (string, string
 
 		// update the az integration
 		az, err = repo.AzureIntegration().OverwriteAzureIntegration(
+			ctx,
 			az,
 		)
 
@@ -693,10 +725,12 @@ func (r *Registry) GetACRCredentials(repo repository.Repository) (string, string
 }
 
 func (r *Registry) listDOCRRepositories(
+	ctx context.Context,
 	repo repository.Repository,
 	doAuth *oauth2.Config,
 ) ([]*ptypes.RegistryRepository, error) {
 	oauthInt, err := repo.OAuthIntegration().ReadOAuthIntegration(
+		ctx,
 		r.ProjectID,
 		r.DOIntegrationID,
 	)
@@ -704,7 +738,7 @@ func (r *Registry) listDOCRRepositories(
 		return nil, err
 	}
 
-	tok, _, err := oauth.GetAccessToken(oauthInt.SharedOAuthModel, doAuth, oauth.MakeUpdateOAuthIntegrationTokenFunction(oauthInt, repo))
+	tok, _, err := oauth.GetAccessToken(oauthInt.SharedOAuthModel, doAuth, oauth.MakeUpdateOAuthIntegrationTokenFunction(ctx, oauthInt, repo))
 	if err != nil {
 		return nil, err
 	}
@@ -737,6 +771,7 @@ func (r *Registry) listDOCRRepositories(
 }
 
 func (r *Registry) listPrivateRegistryRepositories(
+	ctx context.Context,
 	repo repository.Repository,
 ) ([]*ptypes.RegistryRepository, error) {
 	// handle dockerhub different, as it doesn't implement the docker registry http api
@@ -753,6 +788,7 @@ func (r *Registry) listPrivateRegistryRepositories(
 	}
 
 	basic, err := repo.BasicIntegration().ReadBasicIntegration(
+		ctx,
 		r.ProjectID,
 		r.BasicIntegrationID,
 	)
@@ -830,7 +866,7 @@ func (r *Registry) getTokenCacheFunc(
 	repo repository.Repository,
 ) ints.GetTokenCacheFunc {
 	return func(ctx context.Context) (tok *ints.TokenCache, err error) {
-		reg, err := repo.Registry().ReadRegistry(r.ProjectID, r.ID)
+		reg, err := repo.Registry().ReadRegistry(ctx, r.ProjectID, r.ID)
 		if err != nil {
 			return nil, err
 		}
@@ -845,6 +881,7 @@ func (r *Registry) setTokenCacheFunc(
 ) ints.SetTokenCacheFunc {
 	return func(ctx context.Context, token string, expiry time.Time) error {
 		_, err := repo.Registry().UpdateRegistryTokenCache(
+			ctx,
 			&ints.RegTokenCache{
 				TokenCache: ints.TokenCache{
 					Token:  []byte(token),
@@ -874,6 +911,7 @@ func (r *Registry) CreateRepository(
 	if r.AWSIntegrationID != 0 {
 		telemetry.WithAttributes(span, telemetry.AttributeKV{Key: "aws-integration-id", Value: r.AWSIntegrationID})
 		aws, err := conf.Repo.AWSIntegration().ReadAWSIntegration(
+			ctx,
 			r.ProjectID,
 			r.AWSIntegrationID,
 		)
@@ -894,7 +932,7 @@ func (r *Registry) CreateRepository(
 		return nil
 	}
 
-	project, err := conf.Repo.Project().ReadProject(r.ProjectID)
+	project, err := conf.Repo.Project().ReadProject(ctx, r.ProjectID)
 	if err != nil {
 		return telemetry.Error(ctx, span, err, "error getting project for repository")
 	}
@@ -971,6 +1009,7 @@ func (r *Registry) createGARRepository(
 	name string,
 ) error {
 	gcpInt, err := repo.GCPIntegration().ReadGCPIntegration(
+		ctx,
 		r.ProjectID,
 		r.GCPIntegrationID,
 	)
@@ -1028,10 +1067,7 @@ func (r *Registry) ListImages(
 ) ([]*ptypes.Image, error) {
 	// switch on the auth mechanism to get a token
 	if r.AWSIntegrationID != 0 {
-		aws, err := repo.AWSIntegration().ReadAWSIntegration(
-			r.ProjectID,
-			r.AWSIntegrationID,
-		)
+		aws, err := r.getECRIntegration(ctx, repo, conf)
 		if err != nil {
 			return nil, err
 		}
@@ -1039,7 +1075,7 @@ func (r *Registry) ListImages(
 	}
 
 	if r.AzureIntegrationID != 0 {
-		return r.listACRImages(repoName, repo)
+		return r.listACRImages(ctx, repoName, repo)
 	}
 
 	if r.GCPIntegrationID != 0 {
@@ -1047,40 +1083,30 @@ func (r *Registry) ListImages(
 			return r.listGARImages(ctx, repoName, repo)
 		}
 
-		return r.listGCRImages(repoName, repo)
+		return r.listGCRImages(ctx, repoName, repo)
 	}
 
 	if r.DOIntegrationID != 0 {
-		return r.listDOCRImages(repoName, repo, conf.DOConf)
+		return r.listDOCRImages(ctx, repoName, repo, conf.DOConf)
+	}
+
+	if r.BasicIntegrationID != 0 && (IsGHCR(r.URL) || IsQuay(r.URL)) {
+		return r.listRegistryV2Tags(ctx, repoName, repo)
 	}
 
 	if r.BasicIntegrationID != 0 {
-		return r.listPrivateRegistryImages(repoName, repo)
+		return r.listPrivateRegistryImages(ctx, repoName, repo)
 	}
 
-	project, err := conf.Repo.Project().ReadProject(r.ProjectID)
+	project, err := conf.Repo.Project().ReadProject(ctx, r.ProjectID)
 	if err != nil {
 		return nil, fmt.Errorf("error getting project for repository: %w", err)
 	}
 
 	if project.CapiProvisionerEnabled {
-		uri := strings.TrimPrefix(r.URL, "https://")
-		splits := strings.Split(uri, ".")
-		accountID := splits[0]
-		region := splits[3]
-		req := connect.NewRequest(&porterv1.AssumeRoleCredentialsRequest{
-			ProjectId:    int64(r.ProjectID),
-			AwsAccountId: accountID,
-		})
-		creds, err := conf.ClusterControlPlaneClient.AssumeRoleCredentials(ctx, req)
+		aws, err := r.getECRIntegration(ctx, repo, conf)
 		if err != nil {
-			return nil, fmt.Errorf("error getting capi credentials for repository: %w", err)
-		}
-		aws := &ints.AWSIntegration{
-			AWSAccessKeyID:     []byte(creds.Msg.AwsAccessId),
-			AWSSecretAccessKey: []byte(creds.Msg.AwsSecretKey),
-			AWSSessionToken:    []byte(creds.Msg.AwsSessionToken),
-			AWSRegion:          region,
+			return nil, err
 		}
 		return r.listECRImages(aws, repoName, repo)
 	}
@@ -1088,13 +1114,281 @@ func (r *Registry) ListImages(
 	return nil, fmt.Errorf("error listing images")
 }
 
+// getECRIntegration returns the AWS credentials for an ECR registry, either from the AWS integration of the registry
+// or by assuming a role in the registry's account for projects using the CAPI provisioner
+func (r *Registry) getECRIntegration(ctx context.Context, repo repository.Repository, conf *config.Config) (*ints.AWSIntegration, error) {
+	if r.AWSIntegrationID != 0 {
+		return repo.AWSIntegration().ReadAWSIntegration(
+			ctx,
+			r.ProjectID,
+			r.AWSIntegrationID,
+		)
+	}
+
+	uri := strings.TrimPrefix(r.URL, "https://")
+	splits := strings.Split(uri, ".")
+	if len(splits) < 4 {
+		return nil, fmt.Errorf("invalid ecr registry url")
+	}
+	accountID := splits[0]
+	region := splits[3]
+	req := connect.NewRequest(&porterv1.AssumeRoleCredentialsRequest{
+		ProjectId:    int64(r.ProjectID),
+		AwsAccountId: accountID,
+	})
+	creds, err := conf.ClusterControlPlaneClient.AssumeRoleCredentials(ctx, req)
+	if err != nil {
+		return nil, fmt.Errorf("error getting capi credentials for repository: %w", err)
+	}
+
+	return &ints.AWSIntegration{
+		AWSAccessKeyID:     []byte(creds.Msg.AwsAccessId),
+		AWSSecretAccessKey: []byte(creds.Msg.AwsSecretKey),
+		AWSSessionToken:    []byte(creds.Msg.AwsSessionToken),
+		AWSRegion:          region,
+	}, nil
+}
+
+// SupportsTagDeletion returns true if tags can be deleted from the registry with DeleteImageTags
+func (r *Registry) SupportsTagDeletion(ctx context.Context, repo repository.Repository) bool {
+	if r.AWSIntegrationID != 0 || r.GCPIntegrationID != 0 || r.DOIntegrationID != 0 {
+		return true
+	}
+
+	if r.AzureIntegrationID != 0 || r.BasicIntegrationID != 0 {
+		return false
+	}
+
+	project, err := repo.Project().ReadProject(ctx, r.ProjectID)
+	if err != nil {
+		return false
+	}
+
+	return project.CapiProvisionerEnabled
+}
+
+// DeleteImageTags deletes tags from an image repository. Images which are left without a tag are not deleted,
+// and are cleaned up by the lifecycle rules of the registry, if any.
+func (r *Registry) DeleteImageTags(
+	ctx context.Context,
+	repoName string,
+	tags []string,
+	repo repository.Repository,
+	conf *config.Config,
+) error {
+	if len(tags) == 0 {
+		return nil
+	}
+
+	if r.GCPIntegrationID != 0 {
+		if strings.Contains(r.URL, "pkg.dev") {
+			return r.deleteGARImageTags(ctx, repoName, tags, repo)
+		}
+
+		return r.deleteGCRImageTags(ctx, repoName, tags, repo)
+	}
+
+	if r.DOIntegrationID != 0 {
+		return r.deleteDOCRImageTags(ctx, repoName, tags, repo, conf.DOConf)
+	}
+
+	if r.SupportsTagDeletion(ctx, repo) {
+		aws, err := r.getECRIntegration(ctx, repo, conf)
+		if err != nil {
+			return err
+		}
+
+		return r.deleteECRImageTags(aws, repoName, tags)
+	}
+
+	return fmt.Errorf("deleting image tags is not supported for this registry")
+}
+
+func (r *Registry) deleteECRImageTags(aws *ints.AWSIntegration, repoName string, tags []string) error {
+	sess, err := aws.GetSession()
+	if err != nil {
+		return err
+	}
+
+	svc := ecr.New(sess)
+
+	// AWS API expects the length of imageIDs to be at max 100 at a time
+	for start := 0; start < len(tags); start += 100 {
+		end := start + 100
+		if end > len(tags) {
+			end = len(tags)
+		}
+
+		imageIDs := make([]*ecr.ImageIdentifier, 0, end-start)
+		for _, tag := range tags[start:end] {
+			imageTag := tag
+			imageIDs = append(imageIDs, &ecr.ImageIdentifier{
+				ImageTag: &imageTag,
+			})
+		}
+
+		resp, err := svc.BatchDeleteImage(&ecr.BatchDeleteImageInput{
+			RepositoryName: &repoName,
+			ImageIds:       imageIDs,
+		})
+		if err != nil {
+			return err
+		}
+
+		for _, failure := range resp.Failures {
+			if failure.FailureCode != nil && *failure.FailureCode == ecr.ImageFailureCodeImageNotFound {
+				continue
+			}
+
+			return fmt.Errorf("error deleting image tag: %s", failure.String())
+		}
+	}
+
+	return nil
+}
+
+func (r *Registry) deleteGCRImageTags(ctx context.Context, repoName string, tags []string, repo repository.Repository) error {
+	gcp, err := repo.GCPIntegration().ReadGCPIntegration(
+		ctx,
+		r.ProjectID,
+		r.GCPIntegrationID,
+	)
+	if err != nil {
+		return err
+	}
+
+	client := &http.Client{}
+
+	parsedURL, err := url.Parse("https://" + r.URL)
+	if err != nil {
+		return err
+	}
+
+	trimmedPath := strings.Trim(parsedURL.Path, "/")
+
+	for _, tag := range tags {
+		req, err := http.NewRequestWithContext(
+			ctx,
+			"DELETE",
+			fmt.Sprintf("https://%s/v2/%s/%s/manifests/%s", parsedURL.Host, trimmedPath, repoName, tag),
+			nil,
+		)
+		if err != nil {
+			return err
+		}
+
+		req.SetBasicAuth("_json_key", string(gcp.GCPKeyData))
+
+		resp, err := client.Do(req)
+		if err != nil {
+			return err
+		}
+		resp.Body.Close()
+
+		if resp.StatusCode >= 300 && resp.StatusCode != http.StatusNotFound {
+			return fmt.Errorf("error deleting image tag %s: registry returned status %d", tag, resp.StatusCode)
+		}
+	}
+
+	return nil
+}
+
+func (r *Registry) deleteGARImageTags(ctx context.Context, repoName string, tags []string, repo repository.Repository) error {
+	repoImageSlice := strings.Split(repoName, "/")
+
+	if len(repoImageSlice) != 2 {
+		return fmt.Errorf("invalid GAR repo name: %s. Expected to be in the form of REPOSITORY/IMAGE", repoName)
+	}
+
+	gcpInt, err := repo.GCPIntegration().ReadGCPIntegration(
+		ctx,
+		r.ProjectID,
+		r.GCPIntegrationID,
+	)
+	if err != nil {
+		return err
+	}
+
+	svc, err := v1artifactregistry.NewService(ctx, option.WithTokenSource(&garTokenSource{
+		reg:  r,
+		repo: repo,
+		ctx:  ctx,
+	}))
+	if err != nil {
+		return err
+	}
+
+	parsedURL, err := url.Parse("https://" + r.URL)
+	if err != nil {
+		return err
+	}
+
+	location := strings.TrimSuffix(parsedURL.Host, "-docker.pkg.dev")
+	tagsSvc := v1artifactregistry.NewProjectsLocationsRepositoriesPackagesTagsService(svc)
+
+	for _, tag := range tags {
+		_, err := tagsSvc.Delete(fmt.Sprintf("projects/%s/locations/%s/repositories/%s/packages/%s/tags/%s",
+			gcpInt.GCPProjectID, location, repoImageSlice[0], url.PathEscape(repoImageSlice[1]), tag)).Context(ctx).Do()
+		if err != nil {
+			return fmt.Errorf("error deleting image tag %s: %w", tag, err)
+		}
+	}
+
+	return nil
+}
+
+func (r *Registry) deleteDOCRImageTags(
+	ctx context.Context,
+	repoName string,
+	tags []string,
+	repo repository.Repository,
+	doAuth *oauth2.Config,
+) error {
+	oauthInt, err := repo.OAuthIntegration().ReadOAuthIntegration(
+		ctx,
+		r.ProjectID,
+		r.DOIntegrationID,
+	)
+	if err != nil {
+		return err
+	}
+
+	tok, _, err := oauth.GetAccessToken(oauthInt.SharedOAuthModel, doAuth, oauth.MakeUpdateOAuthIntegrationTokenFunction(ctx, oauthInt, repo))
+	if err != nil {
+		return err
+	}
+
+	client := godo.NewFromToken(tok)
+
+	urlArr := strings.Split(r.URL, "/")
+
+	if len(urlArr) != 2 {
+		return fmt.Errorf("invalid digital ocean registry url")
+	}
+
+	for _, tag := range tags {
+		resp, err := client.Registry.DeleteTag(ctx, urlArr[1], repoName, tag)
+		if err != nil {
+			if resp != nil && resp.StatusCode == http.StatusNotFound {
+				continue
+			}
+
+			return fmt.Errorf("error deleting image tag %s: %w", tag, err)
+		}
+	}
+
+	return nil
+}
+
 func (r *Registry) GetECRPaginatedImages(
+	ctx context.Context,
 	repoName string,
 	repo repository.Repository,
 	maxResults int64,
 	nextToken *string,
 ) ([]*ptypes.Image, *string, error) {
 	aws, err := repo.AWSIntegration().ReadAWSIntegration(
+		ctx,
 		r.ProjectID,
 		r.AWSIntegrationID,
 	)
@@ -1307,8 +1601,9 @@ func (r *Registry) listECRImages(aws *ints.AWSIntegration, repoName string, repo
 	return res, nil
 }
 
-func (r *Registry) listACRImages(repoName string, repo repository.Repository) ([]*ptypes.Image, error) {
+func (r *Registry) listACRImages(ctx context.Context, repoName string, repo repository.Repository) ([]*ptypes.Image, error) {
 	az, err := repo.AzureIntegration().ReadAzureIntegration(
+		ctx,
 		r.ProjectID,
 		r.AzureIntegrationID,
 	)
@@ -1355,10 +1650,19 @@ func (r *Registry) listACRImages(repoName string, repo repository.Repository) ([
 
 type gcrImageResp struct {
 	Tags []string `json:"tags"`
+
+	// Manifest is only returned by GCR, and maps each manifest digest to its tags and upload time
+	Manifest map[string]gcrManifest `json:"manifest"`
+}
+
+type gcrManifest struct {
+	Tag            []string `json:"tag"`
+	TimeUploadedMs string   `json:"timeUploadedMs"`
 }
 
-func (r *Registry) listGCRImages(repoName string, repo repository.Repository) ([]*ptypes.Image, error) {
+func (r *Registry) listGCRImages(ctx context.Context, repoName string, repo repository.Repository) ([]*ptypes.Image, error) {
 	gcp, err := repo.GCPIntegration().ReadGCPIntegration(
+		ctx,
 		r.ProjectID,
 		r.GCPIntegrationID,
 	)
@@ -1401,10 +1705,27 @@ func (r *Registry) listGCRImages(repoName string, repo repository.Repository) ([
 	res := make([]*ptypes.Image, 0)
 
 	for _, tag := range gcrResp.Tags {
-		res = append(res, &ptypes.Image{
+		img := &ptypes.Image{
 			RepositoryName: repoName,
 			Tag:            tag,
-		})
+		}
+
+		for digest, manifest := range gcrResp.Manifest {
+			for _, manifestTag := range manifest.Tag {
+				if manifestTag != tag {
+					continue
+				}
+
+				img.Digest = digest
+
+				if ms, err := strconv.ParseInt(manifest.TimeUploadedMs, 10, 64); err == nil {
+					pushedAt := time.UnixMilli(ms)
+					img.PushedAt = &pushedAt
+				}
+			}
+		}
+
+		res = append(res, img)
 	}
 
 	return res, nil
@@ -1418,6 +1739,7 @@ func (r *Registry) listGARImages(ctx context.Context, repoName string, repo repo
 	}
 
 	gcpInt, err := repo.GCPIntegration().ReadGCPIntegration(
+		ctx,
 		r.ProjectID,
 		r.GCPIntegrationID,
 	)
@@ -1487,11 +1809,13 @@ func (r *Registry) listGARImages(ctx context.Context, repoName string, repo repo
 }
 
 func (r *Registry) listDOCRImages(
+	ctx context.Context,
 	repoName string,
 	repo repository.Repository,
 	doAuth *oauth2.Config,
 ) ([]*ptypes.Image, error) {
 	oauthInt, err := repo.OAuthIntegration().ReadOAuthIntegration(
+		ctx,
 		r.ProjectID,
 		r.DOIntegrationID,
 	)
@@ -1499,7 +1823,7 @@ func (r *Registry) listDOCRImages(
 		return nil, err
 	}
 
-	tok, _, err := oauth.GetAccessToken(oauthInt.SharedOAuthModel, doAuth, oauth.MakeUpdateOAuthIntegrationTokenFunction(oauthInt, repo))
+	tok, _, err := oauth.GetAccessToken(oauthInt.SharedOAuthModel, doAuth, oauth.MakeUpdateOAuthIntegrationTokenFunction(ctx, oauthInt, repo))
 	if err != nil {
 		return nil, err
 	}
@@ -1542,22 +1866,27 @@ func (r *Registry) listDOCRImages(
 	res := make([]*ptypes.Image, 0)
 
 	for _, tag := range tags {
+		updatedAt := tag.UpdatedAt
+
 		res = append(res, &ptypes.Image{
 			RepositoryName: repoName,
 			Tag:            tag.Tag,
+			Digest:         tag.ManifestDigest,
+			PushedAt:       &updatedAt,
 		})
 	}
 
 	return res, nil
 }
 
-func (r *Registry) listPrivateRegistryImages(repoName string, repo repository.Repository) ([]*ptypes.Image, error) {
+func (r *Registry) listPrivateRegistryImages(ctx context.Context, repoName string, repo repository.Repository) ([]*ptypes.Image, error) {
 	// handle dockerhub different, as it doesn't implement the docker registry http api
 	if strings.Contains(r.URL, "docker.io") {
-		return r.listDockerHubImages(repoName, repo)
+		return r.listDockerHubImages(ctx, repoName, repo)
 	}
 
 	basic, err := repo.BasicIntegration().ReadBasicIntegration(
+		ctx,
 		r.ProjectID,
 		r.BasicIntegrationID,
 	)
@@ -1623,8 +1952,9 @@ type dockerHubLoginResp struct {
 	Token string `json:"token"`
 }
 
-func (r *Registry) listDockerHubImages(repoName string, repo repository.Repository) ([]*ptypes.Image, error) {
+func (r *Registry) listDockerHubImages(ctx context.Context, repoName string, repo repository.Repository) ([]*ptypes.Image, error) {
 	basic, err := repo.BasicIntegration().ReadBasicIntegration(
+		ctx,
 		r.ProjectID,
 		r.BasicIntegrationID,
 	)
@@ -1705,6 +2035,7 @@ func (r *Registry) listDockerHubImages(repoName string, repo repository.Reposito
 // GetDockerConfigJSON returns a dockerconfigjson file contents with "auths"
 // populated.
 func (r *Registry) GetDockerConfigJSON(
+	ctx context.Context,
 	repo repository.Repository,
 	doAuth *oauth2.Config, // only required if using DOCR
 ) ([]byte, error) {
@@ -1713,23 +2044,23 @@ func (r *Registry) GetDockerConfigJSON(
 
 	// switch on the auth mechanism to get a token
 	if r.AWSIntegrationID != 0 {
-		conf, err = r.getECRDockerConfigFile(repo)
+		conf, err = r.getECRDockerConfigFile(ctx, repo)
 	}
 
 	if r.GCPIntegrationID != 0 {
-		conf, err = r.getGCRDockerConfigFile(repo)
+		conf, err = r.getGCRDockerConfigFile(ctx, repo)
 	}
 
 	if r.DOIntegrationID != 0 {
-		conf, err = r.getDOCRDockerConfigFile(repo, doAuth)
+		conf, err = r.getDOCRDockerConfigFile(ctx, repo, doAuth)
 	}
 
 	if r.BasicIntegrationID != 0 {
-		conf, err = r.getPrivateRegistryDockerConfigFile(repo)
+		conf, err = r.getPrivateRegistryDockerConfigFile(ctx, repo)
 	}
 
 	if r.AzureIntegrationID != 0 {
-		conf, err = r.getACRDockerConfigFile(repo)
+		conf, err = r.getACRDockerConfigFile(ctx, repo)
 	}
 
 	if err != nil {
@@ -1740,9 +2071,11 @@ func (r *Registry) GetDockerConfigJSON(
 }
 
 func (r *Registry) getECRDockerConfigFile(
+	ctx context.Context,
 	repo repository.Repository,
 ) (*configfile.ConfigFile, error) {
 	aws, err := repo.AWSIntegration().ReadAWSIntegration(
+		ctx,
 		r.ProjectID,
 		r.AWSIntegrationID,
 	)
@@ -1793,9 +2126,11 @@ func (r *Registry) getECRDockerConfigFile(
 }
 
 func (r *Registry) getGCRDockerConfigFile(
+	ctx context.Context,
 	repo repository.Repository,
 ) (*configfile.ConfigFile, error) {
 	gcp, err := repo.GCPIntegration().ReadGCPIntegration(
+		ctx,
 		r.ProjectID,
 		r.GCPIntegrationID,
 	)
@@ -1823,10 +2158,12 @@ func (r *Registry) getGCRDockerConfigFile(
 }
 
 func (r *Registry) getDOCRDockerConfigFile(
+	ctx context.Context,
 	repo repository.Repository,
 	doAuth *oauth2.Config,
 ) (*configfile.ConfigFile, error) {
 	oauthInt, err := repo.OAuthIntegration().ReadOAuthIntegration(
+		ctx,
 		r.ProjectID,
 		r.DOIntegrationID,
 	)
@@ -1834,7 +2171,7 @@ func (r *Registry) getDOCRDockerConfigFile(
 		return nil, err
 	}
 
-	tok, _, err := oauth.GetAccessToken(oauthInt.SharedOAuthModel, doAuth, oauth.MakeUpdateOAuthIntegrationTokenFunction(oauthInt, repo))
+	tok, _, err := oauth.GetAccessToken(oauthInt.SharedOAuthModel, doAuth, oauth.MakeUpdateOAuthIntegrationTokenFunction(ctx, oauthInt, repo))
 	if err != nil {
 		return nil, err
 	}
@@ -1859,9 +2196,11 @@ func (r *Registry) getDOCRDockerConfigFile(
 }
 
 func (r *Registry) getPrivateRegistryDockerConfigFile(
+	ctx context.Context,
 	repo repository.Repository,
 ) (*configfile.ConfigFile, error) {
 	basic, err := repo.BasicIntegration().ReadBasicIntegration(
+		ctx,
 		r.ProjectID,
 		r.BasicIntegrationID,
 	)
@@ -1895,9 +2234,10 @@ func (r *Registry) getPrivateRegistryDockerConfigFile(
 }
 
 func (r *Registry) getACRDockerConfigFile(
+	ctx context.Context,
 	repo repository.Repository,
 ) (*configfile.ConfigFile, error) {
-	username, pw, err := r.GetACRCredentials(repo)
+	username, pw, err := r.GetACRCredentials(ctx, repo)
 	if err != nil {
 		return nil, err
 	}
@@ -0,0 +1,359 @@
+package registry
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strings"
+
+	ptypes "github.com/porter-dev/porter/api/types"
+	"github.com/porter-dev/porter/internal/repository"
+)
+
+// GHCRHost is the host of the GitHub Container Registry
+const GHCRHost = "ghcr.io"
+
+// QuayHost is the host of Quay.io
+const QuayHost = "quay.io"
+
+// defaultGitHubAPIURL is the GitHub REST API used to list the container packages of a GHCR owner
+const defaultGitHubAPIURL = "https://api.github.com"
+
+// IsGHCR checks if a registry url points to the GitHub Container Registry
+func IsGHCR(registryURL string) bool {
+	host, _ := splitRegistryURL(registryURL)
+	return host == GHCRHost
+}
+
+// IsQuay checks if a registry url points to Quay.io
+func IsQuay(registryURL string) bool {
+	host, _ := splitRegistryURL(registryURL)
+	return host == QuayHost
+}
+
+// splitRegistryURL returns the host of a registry url, and the namespace which follows the host, if any.
+// For example, ghcr.io/porter-dev is split into ghcr.io and porter-dev.
+func splitRegistryURL(registryURL string) (host string, namespace string) {
+	if splStr := strings.Split(registryURL, "://"); len(splStr) > 1 {
+		registryURL = splStr[1]
+	}
+
+	host, namespace, _ = strings.Cut(strings.Trim(registryURL, "/"), "/")
+
+	return host, namespace
+}
+
+// registryV2Client makes requests to registries implementing the docker registry http api which issue bearer tokens
+// in exchange for basic credentials, such as ghcr.io and quay.io. The token endpoint is discovered from the
+// WWW-Authenticate challenge of the registry.
+type registryV2Client struct {
+	client   *http.Client
+	baseURL  string
+	username string
+	password string
+}
+
+func newRegistryV2Client(host, username, password string) *registryV2Client {
+	return &registryV2Client{
+		client:   &http.Client{},
+		baseURL:  "https://" + host,
+		username: username,
+		password: password,
+	}
+}
+
+// get makes a request to the registry and decodes the JSON response into out
+func (c *registryV2Client) get(ctx context.Context, path string, out interface{}) error {
+	req, err := http.NewRequestWithContext(ctx, "GET", c.baseURL+path, nil)
+	if err != nil {
+		return err
+	}
+
+	req.SetBasicAuth(c.username, c.password)
+
+	resp, err := c.client.Do(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode == http.StatusUnauthorized {
+		token, err := c.token(ctx, resp.Header.Get("WWW-Authenticate"))
+		if err != nil {
+			return err
+		}
+
+		req, err := http.NewRequestWithContext(ctx, "GET", c.baseURL+path, nil)
+		if err != nil {
+			return err
+		}
+
+		req.Header.Set("Authorization", "Bearer "+token)
+
+		resp, err = c.client.Do(req)
+		if err != nil {
+			return err
+		}
+		defer resp.Body.Close()
+	}
+
+	if resp.StatusCode != http.StatusOK {
+		return fmt.Errorf("registry returned status %d for %s", resp.StatusCode, path)
+	}
+
+	return json.NewDecoder(resp.Body).Decode(out)
+}
+
+type registryV2TokenResp struct {
+	Token       string `json:"token"`
+	AccessToken string `json:"access_token"`
+}
+
+// token exchanges the basic credentials for a bearer token, using the realm, service and scope of a
+// challenge such as: Bearer realm="https://ghcr.io/token",service="ghcr.io",scope="repository:org/app:pull"
+func (c *registryV2Client) token(ctx context.Context, challenge string) (string, error) {
+	scheme, params, _ := strings.Cut(challenge, " ")
+	if !strings.EqualFold(scheme, "bearer") {
+		return "", fmt.Errorf("unsupported registry auth challenge: %s", challenge)
+	}
+
+	challengeParams := parseAuthChallengeParams(params)
+
+	realm := challengeParams["realm"]
+	if realm == "" {
+		return "", fmt.Errorf("registry auth challenge is missing a realm")
+	}
+
+	tokenURL, err := url.Parse(realm)
+	if err != nil {
+		return "", err
+	}
+
+	query := tokenURL.Query()
+	for _, key := range []string{"service", "scope"} {
+		if val := challengeParams[key]; val != "" {
+			query.Set(key, val)
+		}
+	}
+	tokenURL.RawQuery = query.Encode()
+
+	req, err := http.NewRequestWithContext(ctx, "GET", tokenURL.String(), nil)
+	if err != nil {
+		return "", err
+	}
+
+	req.SetBasicAuth(c.username, c.password)
+
+	resp, err := c.client.Do(req)
+	if err != nil {
+		return "", err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		return "", fmt.Errorf("registry token endpoint returned status %d", resp.StatusCode)
+	}
+
+	tokenResp := &registryV2TokenResp{}
+	if err := json.NewDecoder(resp.Body).Decode(tokenResp); err != nil {
+		return "", fmt.Errorf("could not read registry token: %w", err)
+	}
+
+	if tokenResp.Token != "" {
+		return tokenResp.Token, nil
+	}
+
+	if tokenResp.AccessToken != "" {
+		return tokenResp.AccessToken, nil
+	}
+
+	return "", fmt.Errorf("registry token endpoint did not return a token")
+}
+
+// parseAuthChallengeParams parses the comma-separated key="value" parameters of a WWW-Authenticate challenge
+func parseAuthChallengeParams(params string) map[string]string {
+	res := make(map[string]string)
+
+	for len(params) > 0 {
+		key, rest, ok := strings.Cut(strings.TrimLeft(params, ", "), "=")
+		if !ok {
+			break
+		}
+
+		var val string
+		if strings.HasPrefix(rest, `"`) {
+			val, rest, _ = strings.Cut(rest[1:], `"`)
+		} else {
+			val, rest, _ = strings.Cut(rest, ",")
+		}
+
+		res[strings.ToLower(strings.TrimSpace(key))] = val
+		params = rest
+	}
+
+	return res
+}
+
+// listRegistryV2Tags lists the tags of a repository in a token-authenticated registry
+func (r *Registry) listRegistryV2Tags(ctx context.Context, repoName string, repo repository.Repository) ([]*ptypes.Image, error) {
+	basic, err := repo.BasicIntegration().ReadBasicIntegration(
+		ctx,
+		r.ProjectID,
+		r.BasicIntegrationID,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	host, _ := splitRegistryURL(r.URL)
+	client := newRegistryV2Client(host, string(basic.Username), string(basic.Password))
+
+	tagsResp := gcrImageResp{}
+	if err := client.get(ctx, fmt.Sprintf("/v2/%s/tags/list", repoName), &tagsResp); err != nil {
+		return nil, err
+	}
+
+	res := make([]*ptypes.Image, 0)
+
+	for _, tag := range tagsResp.Tags {
+		res = append(res, &ptypes.Image{
+			RepositoryName: repoName,
+			Tag:            tag,
+		})
+	}
+
+	return res, nil
+}
+
+// listQuayRepositories lists the repositories in the namespace of a Quay.io registry which the credentials can access
+func (r *Registry) listQuayRepositories(ctx context.Context, repo repository.Repository) ([]*ptypes.RegistryRepository, error) {
+	basic, err := repo.BasicIntegration().ReadBasicIntegration(
+		ctx,
+		r.ProjectID,
+		r.BasicIntegrationID,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	host, namespace := splitRegistryURL(r.URL)
+	client := newRegistryV2Client(host, string(basic.Username), string(basic.Password))
+
+	catalogResp := gcrRepositoryResp{}
+	if err := client.get(ctx, "/v2/_catalog", &catalogResp); err != nil {
+		return nil, err
+	}
+
+	res := make([]*ptypes.RegistryRepository, 0)
+
+	for _, name := range catalogResp.Repositories {
+		if namespace != "" && !strings.HasPrefix(name, namespace+"/") {
+			continue
+		}
+
+		res = append(res, &ptypes.RegistryRepository{
+			Name: name,
+			URI:  host + "/" + name,
+		})
+	}
+
+	return res, nil
+}
+
+type githubPackage struct {
+	Name string `json:"name"`
+}
+
+// listGHCRRepositories lists the container packages of the owner of a GHCR registry. GHCR does not implement the
+// catalog endpoint of the docker registry http api, so the packages are listed with the GitHub REST API, which
+// requires the personal access token of the registry to have the read:packages scope.
+func (r *Registry) listGHCRRepositories(ctx context.Context, repo repository.Repository) ([]*ptypes.RegistryRepository, error) {
+	basic, err := repo.BasicIntegration().ReadBasicIntegration(
+		ctx,
+		r.ProjectID,
+		r.BasicIntegrationID,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	host, owner := splitRegistryURL(r.URL)
+	owner, _, _ = strings.Cut(owner, "/")
+	if owner == "" {
+		owner = string(basic.Username)
+	}
+
+	packages, err := listGitHubContainerPackages(ctx, defaultGitHubAPIURL, owner, string(basic.Password))
+	if err != nil {
+		return nil, err
+	}
+
+	res := make([]*ptypes.RegistryRepository, 0)
+
+	for _, pkg := range packages {
+		name := owner + "/" + pkg.Name
+
+		res = append(res, &ptypes.RegistryRepository{
+			Name: name,
+			URI:  host + "/" + name,
+		})
+	}
+
+	return res, nil
+}
+
+// listGitHubContainerPackages lists the container packages of a GitHub organization, or of a user if no organization exists
+func listGitHubContainerPackages(ctx context.Context, apiURL, owner, token string) ([]githubPackage, error) {
+	client := &http.Client{}
+
+	for _, ownerType := range []string{"orgs", "users"} {
+		var packages []githubPackage
+
+		for page := 1; ; page++ {
+			req, err := http.NewRequestWithContext(
+				ctx,
+				"GET",
+				fmt.Sprintf("%s/%s/%s/packages?package_type=container&per_page=100&page=%d", apiURL, ownerType, url.PathEscape(owner), page),
+				nil,
+			)
+			if err != nil {
+				return nil, err
+			}
+
+			req.Header.Set("Accept", "application/vnd.github+json")
+			req.Header.Set("Authorization", "Bearer "+token)
+
+			resp, err := client.Do(req)
+			if err != nil {
+				return nil, err
+			}
+
+			if resp.StatusCode == http.StatusNotFound && ownerType == "orgs" {
+				resp.Body.Close()
+				break
+			}
+
+			if resp.StatusCode != http.StatusOK {
+				resp.Body.Close()
+				return nil, fmt.Errorf("github returned status %d when listing container packages for %s", resp.StatusCode, owner)
+			}
+
+			var pagePackages []githubPackage
+			err = json.NewDecoder(resp.Body).Decode(&pagePackages)
+			resp.Body.Close()
+			if err != nil {
+				return nil, fmt.Errorf("could not read github container packages: %w", err)
+			}
+
+			packages = append(packages, pagePackages...)
+
+			if len(pagePackages) < 100 {
+				return packages, nil
+			}
+		}
+	}
+
+	return nil, fmt.Errorf("no github organization or user named %s", owner)
+}
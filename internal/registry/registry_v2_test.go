@@ -0,0 +1,109 @@
+package registry
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/matryer/is"
+)
+
+func TestParseAuthChallengeParams(t *testing.T) {
+	is := is.New(t)
+
+	params := parseAuthChallengeParams(`realm="https://ghcr.io/token",service="ghcr.io",scope="repository:porter-dev/app:pull"`)
+
+	is.Equal(params["realm"], "https://ghcr.io/token")
+	is.Equal(params["service"], "ghcr.io")
+	is.Equal(params["scope"], "repository:porter-dev/app:pull")
+}
+
+func TestSplitRegistryURL(t *testing.T) {
+	is := is.New(t)
+
+	host, namespace := splitRegistryURL("https://ghcr.io/porter-dev/")
+	is.Equal(host, "ghcr.io")
+	is.Equal(namespace, "porter-dev")
+
+	host, namespace = splitRegistryURL("quay.io")
+	is.Equal(host, "quay.io")
+	is.Equal(namespace, "")
+
+	is.True(IsGHCR("ghcr.io/porter-dev"))
+	is.True(IsQuay("https://quay.io/porter-dev"))
+	is.True(!IsGHCR("registry.example.com/ghcr.io"))
+}
+
+func TestRegistryV2ClientTokenExchange(t *testing.T) {
+	is := is.New(t)
+
+	var server *httptest.Server
+	server = httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/token":
+			user, pass, ok := r.BasicAuth()
+			if !ok || user != "porter" || pass != "secret" {
+				w.WriteHeader(http.StatusUnauthorized)
+				return
+			}
+			if r.URL.Query().Get("scope") != "repository:porter-dev/app:pull" || r.URL.Query().Get("service") != "registry" {
+				w.WriteHeader(http.StatusBadRequest)
+				return
+			}
+			_ = json.NewEncoder(w).Encode(map[string]string{"token": "bearer-token"})
+		case "/v2/porter-dev/app/tags/list":
+			if r.Header.Get("Authorization") != "Bearer bearer-token" {
+				w.Header().Set("WWW-Authenticate", fmt.Sprintf(`Bearer realm="%s/token",service="registry",scope="repository:porter-dev/app:pull"`, server.URL))
+				w.WriteHeader(http.StatusUnauthorized)
+				return
+			}
+			_ = json.NewEncoder(w).Encode(map[string]interface{}{"tags": []string{"v1", "v2"}})
+		default:
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+	defer server.Close()
+
+	client := &registryV2Client{
+		client:   server.Client(),
+		baseURL:  server.URL,
+		username: "porter",
+		password: "secret",
+	}
+
+	resp := gcrImageResp{}
+	is.NoErr(client.get(context.Background(), "/v2/porter-dev/app/tags/list", &resp))
+	is.Equal(resp.Tags, []string{"v1", "v2"})
+
+	client.password = "wrong"
+	is.True(client.get(context.Background(), "/v2/porter-dev/app/tags/list", &resp) != nil)
+}
+
+func TestListGitHubContainerPackages(t *testing.T) {
+	is := is.New(t)
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Header.Get("Authorization") != "Bearer ghp_token" || r.URL.Query().Get("package_type") != "container" {
+			w.WriteHeader(http.StatusUnauthorized)
+			return
+		}
+
+		switch r.URL.Path {
+		case "/users/porter-user/packages":
+			_ = json.NewEncoder(w).Encode([]map[string]string{{"name": "app"}, {"name": "worker"}})
+		default:
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+	defer server.Close()
+
+	packages, err := listGitHubContainerPackages(context.Background(), server.URL, "porter-user", "ghp_token")
+	is.NoErr(err)
+	is.Equal(packages, []githubPackage{{Name: "app"}, {Name: "worker"}})
+
+	_, err = listGitHubContainerPackages(context.Background(), server.URL, "missing", "ghp_token")
+	is.True(err != nil)
+}
@@ -0,0 +1,16 @@
+package repository
+
+import (
+	"context"
+
+	"github.com/porter-dev/porter/internal/models"
+)
+
+// AddonRepository represents the set of queries on the Addon model
+type AddonRepository interface {
+	CreateAddon(ctx context.Context, addon *models.Addon) (*models.Addon, error)
+	ReadAddon(ctx context.Context, projectID, clusterID uint, name string) (*models.Addon, error)
+	ListAddonsByClusterID(ctx context.Context, projectID, clusterID uint) ([]*models.Addon, error)
+	UpdateAddon(ctx context.Context, addon *models.Addon) (*models.Addon, error)
+	DeleteAddon(ctx context.Context, addon *models.Addon) error
+}
@@ -0,0 +1,15 @@
+package repository
+
+import (
+	"context"
+
+	"github.com/porter-dev/porter/internal/models"
+)
+
+// AlertRuleRepository represents the set of queries on the AlertRule model
+type AlertRuleRepository interface {
+	CreateAlertRule(ctx context.Context, rule *models.AlertRule) (*models.AlertRule, error)
+	ReadAlertRule(ctx context.Context, projectID, ruleID uint) (*models.AlertRule, error)
+	ListAlertRulesByProjectID(ctx context.Context, projectID uint) ([]*models.AlertRule, error)
+	DeleteAlertRule(ctx context.Context, rule *models.AlertRule) error
+}
@@ -1,7 +1,9 @@
 package repository
 
+import "context"
+
 // AllowlistRepository represents the set of queries on the
 // Allowlist model
 type AllowlistRepository interface {
-	UserEmailExists(email string) (bool, error)
+	UserEmailExists(ctx context.Context, email string) (bool, error)
 }
@@ -0,0 +1,17 @@
+package repository
+
+import (
+	"context"
+
+	"github.com/porter-dev/porter/internal/models"
+)
+
+// AppResourceRequestRepository represents the set of queries on the AppResourceRequest model
+type AppResourceRequestRepository interface {
+	// UpsertAppResourceRequest creates or replaces the resource request of an app on a deployment target
+	UpsertAppResourceRequest(ctx context.Context, request *models.AppResourceRequest) (*models.AppResourceRequest, error)
+	// ListAppResourceRequestsByProjectID lists the resource requests of all apps in a project
+	ListAppResourceRequestsByProjectID(ctx context.Context, projectID uint) ([]*models.AppResourceRequest, error)
+	// DeleteAppResourceRequestsByPorterAppID deletes the resource requests of an app on all deployment targets
+	DeleteAppResourceRequestsByPorterAppID(ctx context.Context, porterAppID uint) error
+}
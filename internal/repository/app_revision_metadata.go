@@ -0,0 +1,17 @@
+package repository
+
+import (
+	"context"
+
+	"github.com/porter-dev/porter/internal/models"
+)
+
+// AppRevisionMetadataRepository represents the set of queries on the AppRevisionMetadata model
+type AppRevisionMetadataRepository interface {
+	// ReadAppRevisionMetadata finds the metadata of a revision by its id in the cluster control plane
+	ReadAppRevisionMetadata(ctx context.Context, appRevisionID string) (*models.AppRevisionMetadata, error)
+	CreateAppRevisionMetadata(ctx context.Context, metadata *models.AppRevisionMetadata) (*models.AppRevisionMetadata, error)
+	UpdateAppRevisionMetadata(ctx context.Context, metadata *models.AppRevisionMetadata) (*models.AppRevisionMetadata, error)
+	// ListAppRevisionMetadata lists the metadata of the deployed revisions of an app in a deployment target
+	ListAppRevisionMetadata(ctx context.Context, porterAppID uint, deploymentTargetID string) ([]*models.AppRevisionMetadata, error)
+}
@@ -0,0 +1,18 @@
+package repository
+
+import (
+	"context"
+
+	"github.com/porter-dev/porter/internal/models"
+)
+
+// AppTemplateRepository represents the set of queries on the AppTemplate model
+type AppTemplateRepository interface {
+	CreateAppTemplate(ctx context.Context, template *models.AppTemplate) (*models.AppTemplate, error)
+	UpdateAppTemplate(ctx context.Context, template *models.AppTemplate) (*models.AppTemplate, error)
+	// ReadAppTemplateByName finds the app template of a project with the given name
+	ReadAppTemplateByName(ctx context.Context, projectID uint, name string) (*models.AppTemplate, error)
+	// ListAppTemplatesByProjectID lists the app templates of a project, ordered by name
+	ListAppTemplatesByProjectID(ctx context.Context, projectID uint) ([]*models.AppTemplate, error)
+	DeleteAppTemplate(ctx context.Context, template *models.AppTemplate) error
+}
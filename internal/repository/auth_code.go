@@ -1,11 +1,13 @@
 package repository
 
 import (
+	"context"
+
 	"github.com/porter-dev/porter/internal/models"
 )
 
 // AuthCodeRepository represents the set of queries on the AuthCode model
 type AuthCodeRepository interface {
-	CreateAuthCode(a *models.AuthCode) (*models.AuthCode, error)
-	ReadAuthCode(code string) (*models.AuthCode, error)
+	CreateAuthCode(ctx context.Context, a *models.AuthCode) (*models.AuthCode, error)
+	ReadAuthCode(ctx context.Context, code string) (*models.AuthCode, error)
 }
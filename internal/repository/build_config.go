@@ -1,10 +1,14 @@
 package repository
 
-import "github.com/porter-dev/porter/internal/models"
+import (
+	"context"
+
+	"github.com/porter-dev/porter/internal/models"
+)
 
 // BuildConfigRepository represents the set of queries on the BuildConfig model
 type BuildConfigRepository interface {
-	CreateBuildConfig(*models.BuildConfig) (*models.BuildConfig, error)
-	UpdateBuildConfig(*models.BuildConfig) (*models.BuildConfig, error)
-	GetBuildConfig(uint) (*models.BuildConfig, error)
+	CreateBuildConfig(context.Context, *models.BuildConfig) (*models.BuildConfig, error)
+	UpdateBuildConfig(context.Context, *models.BuildConfig) (*models.BuildConfig, error)
+	GetBuildConfig(context.Context, uint) (*models.BuildConfig, error)
 }
@@ -1,6 +1,9 @@
 package repository
 
 import (
+	"context"
+	"time"
+
 	"github.com/porter-dev/porter/internal/models"
 	ints "github.com/porter-dev/porter/internal/models/integrations"
 )
@@ -8,16 +11,18 @@ import (
 // ClusterRepository represents the set of queries on the
 // Cluster model
 type ClusterRepository interface {
-	CreateClusterCandidate(cc *models.ClusterCandidate) (*models.ClusterCandidate, error)
-	ReadClusterCandidate(projectID, ccID uint) (*models.ClusterCandidate, error)
-	ListClusterCandidatesByProjectID(projectID uint) ([]*models.ClusterCandidate, error)
-	UpdateClusterCandidateCreatedClusterID(id uint, createdClusterID uint) (*models.ClusterCandidate, error)
+	CreateClusterCandidate(ctx context.Context, cc *models.ClusterCandidate) (*models.ClusterCandidate, error)
+	ReadClusterCandidate(ctx context.Context, projectID, ccID uint) (*models.ClusterCandidate, error)
+	ListClusterCandidatesByProjectID(ctx context.Context, projectID uint) ([]*models.ClusterCandidate, error)
+	UpdateClusterCandidateCreatedClusterID(ctx context.Context, id uint, createdClusterID uint) (*models.ClusterCandidate, error)
+	DeleteClusterCandidate(ctx context.Context, cc *models.ClusterCandidate) error
+	DeleteExpiredClusterCandidates(ctx context.Context, now time.Time) (int64, error)
 
-	CreateCluster(cluster *models.Cluster) (*models.Cluster, error)
-	ReadCluster(projectID, clusterID uint) (*models.Cluster, error)
-	ReadClusterByInfraID(projectID, infraID uint) (*models.Cluster, error)
-	ListClustersByProjectID(projectID uint) ([]*models.Cluster, error)
-	UpdateCluster(cluster *models.Cluster) (*models.Cluster, error)
-	UpdateClusterTokenCache(tokenCache *ints.ClusterTokenCache) (*models.Cluster, error)
-	DeleteCluster(cluster *models.Cluster) error
+	CreateCluster(ctx context.Context, cluster *models.Cluster) (*models.Cluster, error)
+	ReadCluster(ctx context.Context, projectID, clusterID uint) (*models.Cluster, error)
+	ReadClusterByInfraID(ctx context.Context, projectID, infraID uint) (*models.Cluster, error)
+	ListClustersByProjectID(ctx context.Context, projectID uint) ([]*models.Cluster, error)
+	UpdateCluster(ctx context.Context, cluster *models.Cluster) (*models.Cluster, error)
+	UpdateClusterTokenCache(ctx context.Context, tokenCache *ints.ClusterTokenCache) (*models.Cluster, error)
+	DeleteCluster(ctx context.Context, cluster *models.Cluster) error
 }
@@ -1,8 +1,12 @@
 package repository
 
-import "github.com/porter-dev/porter/internal/models"
+import (
+	"context"
+
+	"github.com/porter-dev/porter/internal/models"
+)
 
 type CredentialsExchangeTokenRepository interface {
-	CreateCredentialsExchangeToken(ceToken *models.CredentialsExchangeToken) (*models.CredentialsExchangeToken, error)
-	ReadCredentialsExchangeToken(id uint) (*models.CredentialsExchangeToken, error)
+	CreateCredentialsExchangeToken(ctx context.Context, ceToken *models.CredentialsExchangeToken) (*models.CredentialsExchangeToken, error)
+	ReadCredentialsExchangeToken(ctx context.Context, id uint) (*models.CredentialsExchangeToken, error)
 }
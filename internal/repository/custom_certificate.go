@@ -0,0 +1,16 @@
+package repository
+
+import (
+	"context"
+
+	"github.com/porter-dev/porter/internal/models"
+)
+
+// CustomCertificateRepository represents the set of queries on the CustomCertificate model
+type CustomCertificateRepository interface {
+	CreateCustomCertificate(ctx context.Context, cert *models.CustomCertificate) (*models.CustomCertificate, error)
+	ReadCustomCertificate(ctx context.Context, clusterID uint, appName, domain string) (*models.CustomCertificate, error)
+	ListCustomCertificatesByApp(ctx context.Context, clusterID uint, appName string) ([]*models.CustomCertificate, error)
+	UpdateCustomCertificate(ctx context.Context, cert *models.CustomCertificate) (*models.CustomCertificate, error)
+	DeleteCustomCertificate(ctx context.Context, cert *models.CustomCertificate) error
+}
@@ -0,0 +1,19 @@
+package repository
+
+import (
+	"context"
+	"time"
+
+	"github.com/porter-dev/porter/internal/models"
+)
+
+// CustomDomainRepository represents the set of queries on the CustomDomain model
+type CustomDomainRepository interface {
+	CreateCustomDomain(ctx context.Context, domain *models.CustomDomain) (*models.CustomDomain, error)
+	ReadCustomDomain(ctx context.Context, clusterID uint, appName, name string) (*models.CustomDomain, error)
+	ListCustomDomainsByApp(ctx context.Context, clusterID uint, appName string) ([]*models.CustomDomain, error)
+	// ListCustomDomainsToCheck lists the domains which are not verified, and the verified domains last checked before checkedBefore
+	ListCustomDomainsToCheck(ctx context.Context, checkedBefore time.Time) ([]*models.CustomDomain, error)
+	UpdateCustomDomain(ctx context.Context, domain *models.CustomDomain) (*models.CustomDomain, error)
+	DeleteCustomDomain(ctx context.Context, domain *models.CustomDomain) error
+}
@@ -1,14 +1,16 @@
 package repository
 
 import (
+	"context"
+
 	"github.com/porter-dev/porter/internal/models"
 )
 
 type DatabaseRepository interface {
-	CreateDatabase(database *models.Database) (*models.Database, error)
-	ReadDatabase(projectID, clusterID, databaseID uint) (*models.Database, error)
-	ReadDatabaseByInfraID(projectID, infraID uint) (*models.Database, error)
-	ListDatabases(projectID, clusterID uint) ([]*models.Database, error)
-	UpdateDatabase(database *models.Database) (*models.Database, error)
-	DeleteDatabase(projectID, clusterID, databaseID uint) error
+	CreateDatabase(ctx context.Context, database *models.Database) (*models.Database, error)
+	ReadDatabase(ctx context.Context, projectID, clusterID, databaseID uint) (*models.Database, error)
+	ReadDatabaseByInfraID(ctx context.Context, projectID, infraID uint) (*models.Database, error)
+	ListDatabases(ctx context.Context, projectID, clusterID uint) ([]*models.Database, error)
+	UpdateDatabase(ctx context.Context, database *models.Database) (*models.Database, error)
+	DeleteDatabase(ctx context.Context, projectID, clusterID, databaseID uint) error
 }
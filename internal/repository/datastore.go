@@ -0,0 +1,20 @@
+package repository
+
+import (
+	"context"
+
+	"github.com/porter-dev/porter/internal/models"
+)
+
+// DatastoreRepository represents the set of queries on the Datastore and DatastoreLink models
+type DatastoreRepository interface {
+	CreateDatastore(ctx context.Context, datastore *models.Datastore) (*models.Datastore, error)
+	ReadDatastore(ctx context.Context, projectID, datastoreID uint) (*models.Datastore, error)
+	ListDatastoresByProjectID(ctx context.Context, projectID uint) ([]*models.Datastore, error)
+	UpdateDatastore(ctx context.Context, datastore *models.Datastore) (*models.Datastore, error)
+	DeleteDatastore(ctx context.Context, datastore *models.Datastore) error
+	CreateDatastoreLink(ctx context.Context, link *models.DatastoreLink) (*models.DatastoreLink, error)
+	UpdateDatastoreLink(ctx context.Context, link *models.DatastoreLink) (*models.DatastoreLink, error)
+	ListDatastoreLinksByApp(ctx context.Context, projectID, clusterID uint, appName string) ([]*models.DatastoreLink, error)
+	DeleteDatastoreLink(ctx context.Context, link *models.DatastoreLink) error
+}
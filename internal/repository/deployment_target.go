@@ -1,11 +1,19 @@
 package repository
 
 import (
+	"context"
+
 	"github.com/porter-dev/porter/internal/models"
 )
 
 // DeploymentTargetRepository represents the set of queries on the DeploymentTarget model
 type DeploymentTargetRepository interface {
 	// DeploymentTargetBySelectorAndSelectorType finds a deployment target for a projectID and clusterID by its selector and selector type
-	DeploymentTargetBySelectorAndSelectorType(projectID uint, clusterID uint, selector, selectorType string) (*models.DeploymentTarget, error)
+	DeploymentTargetBySelectorAndSelectorType(ctx context.Context, projectID uint, clusterID uint, selector, selectorType string) (*models.DeploymentTarget, error)
+	// DeploymentTargetByID finds a deployment target in a project by its id
+	DeploymentTargetByID(ctx context.Context, projectID uint, deploymentTargetID string) (*models.DeploymentTarget, error)
+	// CreateDeploymentTarget creates a new deployment target
+	CreateDeploymentTarget(ctx context.Context, deploymentTarget *models.DeploymentTarget) (*models.DeploymentTarget, error)
+	// DeleteDeploymentTarget deletes a deployment target
+	DeleteDeploymentTarget(ctx context.Context, deploymentTarget *models.DeploymentTarget) error
 }
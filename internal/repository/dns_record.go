@@ -1,11 +1,13 @@
 package repository
 
 import (
+	"context"
+
 	"github.com/porter-dev/porter/internal/models"
 )
 
 // DNSRecordRepository represents the set of queries on the
 // DNSRecord model
 type DNSRecordRepository interface {
-	CreateDNSRecord(record *models.DNSRecord) (*models.DNSRecord, error)
+	CreateDNSRecord(ctx context.Context, record *models.DNSRecord) (*models.DNSRecord, error)
 }
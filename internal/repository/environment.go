@@ -1,24 +1,28 @@
 package repository
 
-import "github.com/porter-dev/porter/internal/models"
+import (
+	"context"
+
+	"github.com/porter-dev/porter/internal/models"
+)
 
 type EnvironmentRepository interface {
-	CreateEnvironment(env *models.Environment) (*models.Environment, error)
-	ReadEnvironment(projectID, clusterID, gitInstallationID uint, gitRepoOwner, gitRepoName string) (*models.Environment, error)
-	ReadEnvironmentByID(projectID, clusterID, envID uint) (*models.Environment, error)
-	ReadEnvironmentByOwnerRepoName(projectID, clusterID uint, owner, repo string) (*models.Environment, error)
-	ReadEnvironmentByWebhookIDOwnerRepoName(webhookID, owner, repo string) (*models.Environment, error)
-	ListEnvironments(projectID, clusterID uint) ([]*models.Environment, error)
-	UpdateEnvironment(environment *models.Environment) (*models.Environment, error)
-	DeleteEnvironment(env *models.Environment) (*models.Environment, error)
+	CreateEnvironment(ctx context.Context, env *models.Environment) (*models.Environment, error)
+	ReadEnvironment(ctx context.Context, projectID, clusterID, gitInstallationID uint, gitRepoOwner, gitRepoName string) (*models.Environment, error)
+	ReadEnvironmentByID(ctx context.Context, projectID, clusterID, envID uint) (*models.Environment, error)
+	ReadEnvironmentByOwnerRepoName(ctx context.Context, projectID, clusterID uint, owner, repo string) (*models.Environment, error)
+	ReadEnvironmentByWebhookIDOwnerRepoName(ctx context.Context, webhookID, owner, repo string) (*models.Environment, error)
+	ListEnvironments(ctx context.Context, projectID, clusterID uint) ([]*models.Environment, error)
+	UpdateEnvironment(ctx context.Context, environment *models.Environment) (*models.Environment, error)
+	DeleteEnvironment(ctx context.Context, env *models.Environment) (*models.Environment, error)
 
-	CreateDeployment(deployment *models.Deployment) (*models.Deployment, error)
-	ReadDeployment(environmentID uint, namespace string) (*models.Deployment, error)
-	ReadDeploymentByID(projectID, clusterID, id uint) (*models.Deployment, error)
-	ReadDeploymentByGitDetails(environmentID uint, owner, repo string, prNumber uint) (*models.Deployment, error)
-	ReadDeploymentForBranch(environmentID uint, owner, name, branch string) (*models.Deployment, error)
-	ListDeploymentsByCluster(projectID, clusterID uint, states ...string) ([]*models.Deployment, error)
-	ListDeployments(environmentID uint, states ...string) ([]*models.Deployment, error)
-	UpdateDeployment(deployment *models.Deployment) (*models.Deployment, error)
-	DeleteDeployment(deployment *models.Deployment) (*models.Deployment, error)
+	CreateDeployment(ctx context.Context, deployment *models.Deployment) (*models.Deployment, error)
+	ReadDeployment(ctx context.Context, environmentID uint, namespace string) (*models.Deployment, error)
+	ReadDeploymentByID(ctx context.Context, projectID, clusterID, id uint) (*models.Deployment, error)
+	ReadDeploymentByGitDetails(ctx context.Context, environmentID uint, owner, repo string, prNumber uint) (*models.Deployment, error)
+	ReadDeploymentForBranch(ctx context.Context, environmentID uint, owner, name, branch string) (*models.Deployment, error)
+	ListDeploymentsByCluster(ctx context.Context, projectID, clusterID uint, states ...string) ([]*models.Deployment, error)
+	ListDeployments(ctx context.Context, environmentID uint, states ...string) ([]*models.Deployment, error)
+	UpdateDeployment(ctx context.Context, deployment *models.Deployment) (*models.Deployment, error)
+	DeleteDeployment(ctx context.Context, deployment *models.Deployment) (*models.Deployment, error)
 }
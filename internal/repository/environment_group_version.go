@@ -0,0 +1,19 @@
+package repository
+
+import (
+	"context"
+
+	"github.com/porter-dev/porter/internal/models"
+)
+
+// EnvironmentGroupVersionRepository represents the set of queries on the EnvironmentGroupVersion model
+type EnvironmentGroupVersionRepository interface {
+	CreateEnvironmentGroupVersion(ctx context.Context, version *models.EnvironmentGroupVersion) (*models.EnvironmentGroupVersion, error)
+	UpdateEnvironmentGroupVersion(ctx context.Context, version *models.EnvironmentGroupVersion) (*models.EnvironmentGroupVersion, error)
+	// ReadEnvironmentGroupVersion finds a single version of an environment group in a cluster
+	ReadEnvironmentGroupVersion(ctx context.Context, clusterID uint, name string, version int) (*models.EnvironmentGroupVersion, error)
+	// ListEnvironmentGroupVersions lists the versions of an environment group in a cluster, most recent first
+	ListEnvironmentGroupVersions(ctx context.Context, clusterID uint, name string) ([]*models.EnvironmentGroupVersion, error)
+	// DeleteEnvironmentGroupVersions deletes all versions of an environment group in a cluster
+	DeleteEnvironmentGroupVersions(ctx context.Context, clusterID uint, name string) error
+}
@@ -1,28 +1,34 @@
 package repository
 
 import (
+	"context"
+
 	"github.com/porter-dev/porter/api/types"
 	"github.com/porter-dev/porter/internal/models"
 )
 
 type BuildEventRepository interface {
-	CreateEventContainer(am *models.EventContainer) (*models.EventContainer, error)
-	CreateSubEvent(am *models.SubEvent) (*models.SubEvent, error)
-	ReadEventsByContainerID(id uint) ([]*models.SubEvent, error)
-	ReadEventContainer(id uint) (*models.EventContainer, error)
-	ReadSubEvent(id uint) (*models.SubEvent, error)
-	AppendEvent(container *models.EventContainer, event *models.SubEvent) error
+	CreateEventContainer(ctx context.Context, am *models.EventContainer) (*models.EventContainer, error)
+	CreateSubEvent(ctx context.Context, am *models.SubEvent) (*models.SubEvent, error)
+	ReadEventsByContainerID(ctx context.Context, id uint) ([]*models.SubEvent, error)
+	ReadEventContainer(ctx context.Context, id uint) (*models.EventContainer, error)
+	ReadSubEvent(ctx context.Context, id uint) (*models.SubEvent, error)
+	AppendEvent(ctx context.Context, container *models.EventContainer, event *models.SubEvent) error
+	ListEventContainersByPorterAppID(ctx context.Context, porterAppID uint, kind models.EventContainerKind, limit int) ([]*models.EventContainer, error)
+	ListSubEventsAfterIndex(ctx context.Context, containerID uint, afterIndex int64) ([]*models.SubEvent, error)
 }
 
 type KubeEventRepository interface {
-	CreateEvent(event *models.KubeEvent) (*models.KubeEvent, error)
-	AppendSubEvent(event *models.KubeEvent, subEvent *models.KubeSubEvent) error
-	ReadEvent(id uint, projID uint, clusterID uint) (*models.KubeEvent, error)
-	ReadEventByGroup(projID uint, clusterID uint, opts *types.GroupOptions) (*models.KubeEvent, error)
+	CreateEvent(ctx context.Context, event *models.KubeEvent) (*models.KubeEvent, error)
+	AppendSubEvent(ctx context.Context, event *models.KubeEvent, subEvent *models.KubeSubEvent) error
+	ReadEvent(ctx context.Context, id uint, projID uint, clusterID uint) (*models.KubeEvent, error)
+	ReadEventByGroup(ctx context.Context, projID uint, clusterID uint, opts *types.GroupOptions) (*models.KubeEvent, error)
 	ListEventsByProjectID(
+		ctx context.Context,
 		projectID uint,
 		clusterID uint,
 		opts *types.ListKubeEventRequest,
-	) ([]*models.KubeEvent, int64, error)
-	DeleteEvent(id uint) error
+	) ([]*models.KubeEvent, int64, string, error)
+	SearchEvents(ctx context.Context, projectID uint, clusterID uint, opts *types.SearchKubeEventsRequest) ([]*models.KubeEvent, error)
+	DeleteEvent(ctx context.Context, id uint) error
 }
@@ -0,0 +1,15 @@
+package repository
+
+import (
+	"context"
+
+	"github.com/porter-dev/porter/internal/models"
+)
+
+// EventSinkRepository represents the set of queries on the EventSink model
+type EventSinkRepository interface {
+	CreateEventSink(ctx context.Context, sink *models.EventSink) (*models.EventSink, error)
+	ReadEventSink(ctx context.Context, projectID, sinkID uint) (*models.EventSink, error)
+	ListEventSinksByProjectID(ctx context.Context, projectID uint) ([]*models.EventSink, error)
+	DeleteEventSink(ctx context.Context, sink *models.EventSink) error
+}
@@ -1,11 +1,15 @@
 package repository
 
-import "github.com/porter-dev/porter/internal/models"
+import (
+	"context"
+
+	"github.com/porter-dev/porter/internal/models"
+)
 
 // GitActionConfigRepository represents the set of queries on the
 // GitActionConfig model
 type GitActionConfigRepository interface {
-	CreateGitActionConfig(gr *models.GitActionConfig) (*models.GitActionConfig, error)
-	ReadGitActionConfig(id uint) (*models.GitActionConfig, error)
-	UpdateGitActionConfig(gr *models.GitActionConfig) error
+	CreateGitActionConfig(ctx context.Context, gr *models.GitActionConfig) (*models.GitActionConfig, error)
+	ReadGitActionConfig(ctx context.Context, id uint) (*models.GitActionConfig, error)
+	UpdateGitActionConfig(ctx context.Context, gr *models.GitActionConfig) error
 }
@@ -0,0 +1,156 @@
+package gorm
+
+import (
+	"context"
+
+	"github.com/porter-dev/porter/internal/encryption"
+	"github.com/porter-dev/porter/internal/models"
+	"github.com/porter-dev/porter/internal/repository"
+	"gorm.io/gorm"
+)
+
+// AddonRepository uses gorm.DB for querying the database
+type AddonRepository struct {
+	db  *gorm.DB
+	key *[32]byte
+}
+
+// NewAddonRepository returns an AddonRepository which uses gorm.DB for querying
+// the database. It accepts an encryption key to encrypt addon inputs and values
+func NewAddonRepository(db *gorm.DB, key *[32]byte) repository.AddonRepository {
+	return &AddonRepository{db, key}
+}
+
+// CreateAddon creates a new addon
+func (repo *AddonRepository) CreateAddon(ctx context.Context, addon *models.Addon) (*models.Addon, error) {
+	err := repo.EncryptAddonData(addon, repo.key)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := repo.db.WithContext(ctx).Create(addon).Error; err != nil {
+		return nil, err
+	}
+
+	err = repo.DecryptAddonData(addon, repo.key)
+	if err != nil {
+		return nil, err
+	}
+
+	return addon, nil
+}
+
+// ReadAddon finds an addon by project id, cluster id and name
+func (repo *AddonRepository) ReadAddon(ctx context.Context, projectID, clusterID uint, name string) (*models.Addon, error) {
+	addon := &models.Addon{}
+
+	if err := repo.db.WithContext(ctx).Where("project_id = ? AND cluster_id = ? AND name = ?", projectID, clusterID, name).First(addon).Error; err != nil {
+		return nil, err
+	}
+
+	err := repo.DecryptAddonData(addon, repo.key)
+	if err != nil {
+		return nil, err
+	}
+
+	return addon, nil
+}
+
+// ListAddonsByClusterID finds all addons for a given cluster
+func (repo *AddonRepository) ListAddonsByClusterID(ctx context.Context, projectID, clusterID uint) ([]*models.Addon, error) {
+	addons := []*models.Addon{}
+
+	if err := repo.db.WithContext(ctx).Where("project_id = ? AND cluster_id = ?", projectID, clusterID).Order("name asc").Find(&addons).Error; err != nil {
+		return nil, err
+	}
+
+	for _, addon := range addons {
+		err := repo.DecryptAddonData(addon, repo.key)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return addons, nil
+}
+
+// UpdateAddon modifies an existing addon in the database
+func (repo *AddonRepository) UpdateAddon(ctx context.Context, addon *models.Addon) (*models.Addon, error) {
+	err := repo.EncryptAddonData(addon, repo.key)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := repo.db.WithContext(ctx).Save(addon).Error; err != nil {
+		return nil, err
+	}
+
+	err = repo.DecryptAddonData(addon, repo.key)
+	if err != nil {
+		return nil, err
+	}
+
+	return addon, nil
+}
+
+// DeleteAddon deletes an addon
+func (repo *AddonRepository) DeleteAddon(ctx context.Context, addon *models.Addon) error {
+	if err := repo.db.WithContext(ctx).Delete(addon).Error; err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// EncryptAddonData will encrypt the addon inputs and values before writing them to the DB
+func (repo *AddonRepository) EncryptAddonData(
+	addon *models.Addon,
+	key *[32]byte,
+) error {
+	if len(addon.Inputs) > 0 {
+		cipherData, err := encryption.Encrypt(addon.Inputs, key)
+		if err != nil {
+			return err
+		}
+
+		addon.Inputs = cipherData
+	}
+
+	if len(addon.Values) > 0 {
+		cipherData, err := encryption.Encrypt(addon.Values, key)
+		if err != nil {
+			return err
+		}
+
+		addon.Values = cipherData
+	}
+
+	return nil
+}
+
+// DecryptAddonData will decrypt the addon inputs and values before
+// returning them from the DB
+func (repo *AddonRepository) DecryptAddonData(
+	addon *models.Addon,
+	key *[32]byte,
+) error {
+	if len(addon.Inputs) > 0 {
+		plaintext, err := encryption.Decrypt(addon.Inputs, key)
+		if err != nil {
+			return err
+		}
+
+		addon.Inputs = plaintext
+	}
+
+	if len(addon.Values) > 0 {
+		plaintext, err := encryption.Decrypt(addon.Values, key)
+		if err != nil {
+			return err
+		}
+
+		addon.Values = plaintext
+	}
+
+	return nil
+}
@@ -0,0 +1,60 @@
+package gorm
+
+import (
+	"context"
+
+	"github.com/porter-dev/porter/internal/models"
+	"github.com/porter-dev/porter/internal/repository"
+	"gorm.io/gorm"
+)
+
+// AlertRuleRepository uses gorm.DB for querying the database
+type AlertRuleRepository struct {
+	db *gorm.DB
+}
+
+// NewAlertRuleRepository returns an AlertRuleRepository which uses
+// gorm.DB for querying the database
+func NewAlertRuleRepository(db *gorm.DB) repository.AlertRuleRepository {
+	return &AlertRuleRepository{db}
+}
+
+// CreateAlertRule creates a new alert rule
+func (repo *AlertRuleRepository) CreateAlertRule(ctx context.Context, rule *models.AlertRule) (*models.AlertRule, error) {
+	if err := repo.db.WithContext(ctx).Create(rule).Error; err != nil {
+		return nil, err
+	}
+
+	return rule, nil
+}
+
+// ReadAlertRule finds an alert rule by project id and rule id
+func (repo *AlertRuleRepository) ReadAlertRule(ctx context.Context, projectID, ruleID uint) (*models.AlertRule, error) {
+	rule := &models.AlertRule{}
+
+	if err := repo.db.WithContext(ctx).Where("project_id = ? AND id = ?", projectID, ruleID).First(rule).Error; err != nil {
+		return nil, err
+	}
+
+	return rule, nil
+}
+
+// ListAlertRulesByProjectID finds all alert rules for a given project id
+func (repo *AlertRuleRepository) ListAlertRulesByProjectID(ctx context.Context, projectID uint) ([]*models.AlertRule, error) {
+	rules := []*models.AlertRule{}
+
+	if err := repo.db.WithContext(ctx).Where("project_id = ?", projectID).Order("id asc").Find(&rules).Error; err != nil {
+		return nil, err
+	}
+
+	return rules, nil
+}
+
+// DeleteAlertRule deletes an alert rule
+func (repo *AlertRuleRepository) DeleteAlertRule(ctx context.Context, rule *models.AlertRule) error {
+	if err := repo.db.WithContext(ctx).Delete(rule).Error; err != nil {
+		return err
+	}
+
+	return nil
+}
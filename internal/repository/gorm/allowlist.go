@@ -1,6 +1,8 @@
 package gorm
 
 import (
+	"context"
+
 	"github.com/porter-dev/porter/internal/models"
 	"github.com/porter-dev/porter/internal/repository"
 	"gorm.io/gorm"
@@ -17,9 +19,9 @@ func NewAllowlistRepository(db *gorm.DB) repository.AllowlistRepository {
 	return &AllowlistRepository{db}
 }
 
-func (repo *AllowlistRepository) UserEmailExists(email string) (bool, error) {
+func (repo *AllowlistRepository) UserEmailExists(ctx context.Context, email string) (bool, error) {
 	al := &models.Allowlist{}
-	result := repo.db.Where("user_email = ?", email).Find(&al)
+	result := repo.db.WithContext(ctx).Where("user_email = ?", email).Find(&al)
 
 	if err := result.Error; err != nil {
 		return false, err
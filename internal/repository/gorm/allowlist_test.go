@@ -1,6 +1,7 @@
 package gorm_test
 
 import (
+	"context"
 	"testing"
 )
 
@@ -15,7 +16,7 @@ func TestUserEmailExistsOnAllowlist(t *testing.T) {
 
 	expected := true
 
-	found, err := tester.repo.Allowlist().UserEmailExists("some@email.com")
+	found, err := tester.repo.Allowlist().UserEmailExists(context.Background(), "some@email.com")
 	if err != nil {
 		t.Fatalf("%v\n", err)
 	}
@@ -36,7 +37,7 @@ func TestUserDontExistsOnAllowList(t *testing.T) {
 
 	expected := false
 
-	found, err := tester.repo.Allowlist().UserEmailExists("nonexisting@email.com")
+	found, err := tester.repo.Allowlist().UserEmailExists(context.Background(), "nonexisting@email.com")
 	if err != nil {
 		t.Fatalf("%v\n", err)
 	}
@@ -26,7 +26,7 @@ func (cr APIContractRepository) Insert(ctx context.Context, conf models.APIContr
 	if conf.ID == uuid.Nil {
 		conf.ID = uuid.New()
 	}
-	tx := cr.db.Create(&conf)
+	tx := cr.db.WithContext(ctx).Create(&conf)
 	if tx.Error != nil {
 		return conf, tx.Error
 	}
@@ -39,13 +39,13 @@ func (cr APIContractRepository) List(ctx context.Context, projectID uint, cluste
 	var confs []*models.APIContractRevision
 
 	if clusterID == 0 {
-		tx := cr.db.Where("project_id = ?", projectID).Find(&confs).Order("created_at desc")
+		tx := cr.db.WithContext(ctx).Where("project_id = ?", projectID).Find(&confs).Order("created_at desc")
 		if tx.Error != nil {
 			return nil, tx.Error
 		}
 		return confs, nil
 	}
-	tx := cr.db.Where("project_id = ? and cluster_id = ?", projectID, clusterID).Find(&confs).Order("created_at desc")
+	tx := cr.db.WithContext(ctx).Where("project_id = ? and cluster_id = ?", projectID, clusterID).Find(&confs).Order("created_at desc")
 	if tx.Error != nil {
 		return nil, tx.Error
 	}
@@ -64,7 +64,7 @@ func (cr APIContractRepository) Delete(ctx context.Context, projectID uint, clus
 		conf.ClusterID = int(clusterID)
 	}
 
-	tx := cr.db.Delete(&conf)
+	tx := cr.db.WithContext(ctx).Delete(&conf)
 	if tx.Error != nil {
 		return tx.Error
 	}
@@ -78,7 +78,7 @@ func (cr APIContractRepository) Get(ctx context.Context, revisionID uuid.UUID) (
 	}
 
 	var acr models.APIContractRevision
-	tx := cr.db.Find(&acr, "id = ?", revisionID.String())
+	tx := cr.db.WithContext(ctx).Find(&acr, "id = ?", revisionID.String())
 	if tx.Error != nil {
 		return models.APIContractRevision{}, fmt.Errorf("no contract revision found for id %s: %w", revisionID, tx.Error)
 	}
@@ -1,6 +1,8 @@
 package gorm
 
 import (
+	"context"
+
 	"github.com/porter-dev/porter/internal/models"
 	"github.com/porter-dev/porter/internal/repository"
 	"gorm.io/gorm"
@@ -17,27 +19,27 @@ func NewAPITokenRepository(db *gorm.DB) repository.APITokenRepository {
 	return &APITokenRepository{db}
 }
 
-func (repo *APITokenRepository) CreateAPIToken(a *models.APIToken) (*models.APIToken, error) {
-	if err := repo.db.Create(a).Error; err != nil {
+func (repo *APITokenRepository) CreateAPIToken(ctx context.Context, a *models.APIToken) (*models.APIToken, error) {
+	if err := repo.db.WithContext(ctx).Create(a).Error; err != nil {
 		return nil, err
 	}
 	return a, nil
 }
 
-func (repo *APITokenRepository) ListAPITokensByProjectID(projectID uint) ([]*models.APIToken, error) {
+func (repo *APITokenRepository) ListAPITokensByProjectID(ctx context.Context, projectID uint) ([]*models.APIToken, error) {
 	tokens := []*models.APIToken{}
 
-	if err := repo.db.Where("project_id = ? AND NOT revoked", projectID).Find(&tokens).Error; err != nil {
+	if err := repo.db.WithContext(ctx).Where("project_id = ? AND NOT revoked", projectID).Find(&tokens).Error; err != nil {
 		return nil, err
 	}
 
 	return tokens, nil
 }
 
-func (repo *APITokenRepository) ReadAPIToken(projectID uint, uid string) (*models.APIToken, error) {
+func (repo *APITokenRepository) ReadAPIToken(ctx context.Context, projectID uint, uid string) (*models.APIToken, error) {
 	token := &models.APIToken{}
 
-	if err := repo.db.Where("project_id = ? AND unique_id = ?", projectID, uid).First(&token).Error; err != nil {
+	if err := repo.db.WithContext(ctx).Where("project_id = ? AND unique_id = ?", projectID, uid).First(&token).Error; err != nil {
 		return nil, err
 	}
 
@@ -45,9 +47,10 @@ func (repo *APITokenRepository) ReadAPIToken(projectID uint, uid string) (*model
 }
 
 func (repo *APITokenRepository) UpdateAPIToken(
+	ctx context.Context,
 	token *models.APIToken,
 ) (*models.APIToken, error) {
-	if err := repo.db.Save(token).Error; err != nil {
+	if err := repo.db.WithContext(ctx).Save(token).Error; err != nil {
 		return nil, err
 	}
 
@@ -1,6 +1,7 @@
 package gorm_test
 
 import (
+	"context"
 	"testing"
 )
 
@@ -16,7 +17,7 @@ func TestListAPITokensByProjectID(t *testing.T) {
 	defer cleanup(tester, t)
 
 	projectID := tester.initProjects[0].Model.ID
-	found, err := tester.repo.APIToken().ListAPITokensByProjectID(projectID)
+	found, err := tester.repo.APIToken().ListAPITokensByProjectID(context.Background(), projectID)
 	if err != nil {
 		t.Fatalf("%v\n", err)
 	}
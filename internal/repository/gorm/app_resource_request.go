@@ -0,0 +1,58 @@
+package gorm
+
+import (
+	"context"
+	"errors"
+
+	"github.com/porter-dev/porter/internal/models"
+	"github.com/porter-dev/porter/internal/repository"
+	"gorm.io/gorm"
+)
+
+// AppResourceRequestRepository uses gorm.DB for querying the database
+type AppResourceRequestRepository struct {
+	db *gorm.DB
+}
+
+// NewAppResourceRequestRepository returns an AppResourceRequestRepository which uses
+// gorm.DB for querying the database
+func NewAppResourceRequestRepository(db *gorm.DB) repository.AppResourceRequestRepository {
+	return &AppResourceRequestRepository{db}
+}
+
+// UpsertAppResourceRequest creates or replaces the resource request of an app on a deployment target
+func (repo *AppResourceRequestRepository) UpsertAppResourceRequest(ctx context.Context, request *models.AppResourceRequest) (*models.AppResourceRequest, error) {
+	existing := &models.AppResourceRequest{}
+
+	err := repo.db.WithContext(ctx).Where("porter_app_id = ? AND deployment_target_id = ?", request.PorterAppID, request.DeploymentTargetID).First(existing).Error
+	if err != nil && !errors.Is(err, gorm.ErrRecordNotFound) {
+		return nil, err
+	}
+
+	if err == nil {
+		request.Model = existing.Model
+	}
+
+	if err := repo.db.WithContext(ctx).Save(request).Error; err != nil {
+		return nil, err
+	}
+
+	return request, nil
+}
+
+// ListAppResourceRequestsByProjectID lists the resource requests of all apps in a project
+func (repo *AppResourceRequestRepository) ListAppResourceRequestsByProjectID(ctx context.Context, projectID uint) ([]*models.AppResourceRequest, error) {
+	requests := []*models.AppResourceRequest{}
+
+	if err := repo.db.WithContext(ctx).Where("project_id = ?", projectID).Find(&requests).Error; err != nil {
+		return nil, err
+	}
+
+	return requests, nil
+}
+
+// DeleteAppResourceRequestsByPorterAppID deletes the resource requests of an app on all deployment targets
+func (repo *AppResourceRequestRepository) DeleteAppResourceRequestsByPorterAppID(ctx context.Context, porterAppID uint) error {
+	// requests are deleted permanently, since the app and deployment target are unique among deleted rows as well
+	return repo.db.WithContext(ctx).Unscoped().Where("porter_app_id = ?", porterAppID).Delete(&models.AppResourceRequest{}).Error
+}
@@ -0,0 +1,61 @@
+package gorm
+
+import (
+	"context"
+
+	"github.com/porter-dev/porter/internal/models"
+	"github.com/porter-dev/porter/internal/repository"
+	"gorm.io/gorm"
+)
+
+// AppRevisionMetadataRepository uses gorm.DB for querying the database
+type AppRevisionMetadataRepository struct {
+	db *gorm.DB
+}
+
+// NewAppRevisionMetadataRepository returns an AppRevisionMetadataRepository which uses
+// gorm.DB for querying the database
+func NewAppRevisionMetadataRepository(db *gorm.DB) repository.AppRevisionMetadataRepository {
+	return &AppRevisionMetadataRepository{db}
+}
+
+// ReadAppRevisionMetadata finds the metadata of a revision by its id in the cluster control plane
+func (repo *AppRevisionMetadataRepository) ReadAppRevisionMetadata(ctx context.Context, appRevisionID string) (*models.AppRevisionMetadata, error) {
+	metadata := &models.AppRevisionMetadata{}
+
+	if err := repo.db.WithContext(ctx).Where("app_revision_id = ?", appRevisionID).First(metadata).Error; err != nil {
+		return nil, err
+	}
+
+	return metadata, nil
+}
+
+// CreateAppRevisionMetadata creates the metadata of a revision
+func (repo *AppRevisionMetadataRepository) CreateAppRevisionMetadata(ctx context.Context, metadata *models.AppRevisionMetadata) (*models.AppRevisionMetadata, error) {
+	if err := repo.db.WithContext(ctx).Create(metadata).Error; err != nil {
+		return nil, err
+	}
+
+	return metadata, nil
+}
+
+// UpdateAppRevisionMetadata updates the metadata of a revision
+func (repo *AppRevisionMetadataRepository) UpdateAppRevisionMetadata(ctx context.Context, metadata *models.AppRevisionMetadata) (*models.AppRevisionMetadata, error) {
+	if err := repo.db.WithContext(ctx).Save(metadata).Error; err != nil {
+		return nil, err
+	}
+
+	return metadata, nil
+}
+
+// ListAppRevisionMetadata lists the metadata of the deployed revisions of an app in a deployment target
+func (repo *AppRevisionMetadataRepository) ListAppRevisionMetadata(ctx context.Context, porterAppID uint, deploymentTargetID string) ([]*models.AppRevisionMetadata, error) {
+	metadata := []*models.AppRevisionMetadata{}
+
+	if err := repo.db.WithContext(ctx).Where("porter_app_id = ? AND deployment_target_id = ? AND revision_number > 0", porterAppID, deploymentTargetID).
+		Order("revision_number desc, id desc").Find(&metadata).Error; err != nil {
+		return nil, err
+	}
+
+	return metadata, nil
+}
@@ -0,0 +1,57 @@
+package gorm_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/porter-dev/porter/internal/encryption"
+	"github.com/porter-dev/porter/internal/models"
+)
+
+func TestListAppRevisionMetadata(t *testing.T) {
+	suffix, _ := encryption.GenerateRandomBytes(4)
+
+	tester := &tester{
+		dbFileName: fmt.Sprintf("./porter_app_revision_metadata_%s.db", suffix),
+	}
+
+	setupTestEnv(tester, t)
+	defer cleanup(tester, t)
+
+	for i, metadata := range []*models.AppRevisionMetadata{
+		{PorterAppID: 1, DeploymentTargetID: "target", AppRevisionID: "a", DeployedBy: "a@porter.run", RevisionNumber: 1},
+		{PorterAppID: 1, DeploymentTargetID: "target", AppRevisionID: "b", DeployedBy: "b@porter.run", RevisionNumber: 2},
+		{PorterAppID: 1, DeploymentTargetID: "target", AppRevisionID: "c", DeployedBy: "c@porter.run"},
+		{PorterAppID: 1, DeploymentTargetID: "other", AppRevisionID: "d", DeployedBy: "d@porter.run", RevisionNumber: 1},
+	} {
+		if _, err := tester.repo.AppRevisionMetadata().CreateAppRevisionMetadata(context.Background(), metadata); err != nil {
+			t.Fatalf("error creating metadata %d: %v", i, err)
+		}
+	}
+
+	metadata, err := tester.repo.AppRevisionMetadata().ReadAppRevisionMetadata(context.Background(), "c")
+	if err != nil {
+		t.Fatalf("%v\n", err)
+	}
+
+	metadata.RevisionNumber = 3
+	if _, err := tester.repo.AppRevisionMetadata().UpdateAppRevisionMetadata(context.Background(), metadata); err != nil {
+		t.Fatalf("%v\n", err)
+	}
+
+	listed, err := tester.repo.AppRevisionMetadata().ListAppRevisionMetadata(context.Background(), 1, "target")
+	if err != nil {
+		t.Fatalf("%v\n", err)
+	}
+
+	if len(listed) != 3 {
+		t.Fatalf("expected 3 metadata records, got %d", len(listed))
+	}
+
+	for i, expected := range []string{"c", "b", "a"} {
+		if listed[i].AppRevisionID != expected {
+			t.Errorf("expected metadata %d to be for revision %s, got %s", i, expected, listed[i].AppRevisionID)
+		}
+	}
+}
@@ -0,0 +1,65 @@
+package gorm
+
+import (
+	"context"
+
+	"github.com/porter-dev/porter/internal/models"
+	"github.com/porter-dev/porter/internal/repository"
+	"gorm.io/gorm"
+)
+
+// AppTemplateRepository uses gorm.DB for querying the database
+type AppTemplateRepository struct {
+	db *gorm.DB
+}
+
+// NewAppTemplateRepository returns an AppTemplateRepository which uses
+// gorm.DB for querying the database
+func NewAppTemplateRepository(db *gorm.DB) repository.AppTemplateRepository {
+	return &AppTemplateRepository{db}
+}
+
+// CreateAppTemplate creates a new app template
+func (repo *AppTemplateRepository) CreateAppTemplate(ctx context.Context, template *models.AppTemplate) (*models.AppTemplate, error) {
+	if err := repo.db.WithContext(ctx).Create(template).Error; err != nil {
+		return nil, err
+	}
+
+	return template, nil
+}
+
+// UpdateAppTemplate updates an existing app template
+func (repo *AppTemplateRepository) UpdateAppTemplate(ctx context.Context, template *models.AppTemplate) (*models.AppTemplate, error) {
+	if err := repo.db.WithContext(ctx).Save(template).Error; err != nil {
+		return nil, err
+	}
+
+	return template, nil
+}
+
+// ReadAppTemplateByName finds the app template of a project with the given name
+func (repo *AppTemplateRepository) ReadAppTemplateByName(ctx context.Context, projectID uint, name string) (*models.AppTemplate, error) {
+	template := &models.AppTemplate{}
+
+	if err := repo.db.WithContext(ctx).Where("project_id = ? AND name = ?", projectID, name).First(template).Error; err != nil {
+		return nil, err
+	}
+
+	return template, nil
+}
+
+// ListAppTemplatesByProjectID lists the app templates of a project, ordered by name
+func (repo *AppTemplateRepository) ListAppTemplatesByProjectID(ctx context.Context, projectID uint) ([]*models.AppTemplate, error) {
+	templates := []*models.AppTemplate{}
+
+	if err := repo.db.WithContext(ctx).Where("project_id = ?", projectID).Order("name asc").Find(&templates).Error; err != nil {
+		return nil, err
+	}
+
+	return templates, nil
+}
+
+// DeleteAppTemplate deletes an app template
+func (repo *AppTemplateRepository) DeleteAppTemplate(ctx context.Context, template *models.AppTemplate) error {
+	return repo.db.WithContext(ctx).Delete(template).Error
+}
@@ -1,6 +1,8 @@
 package gorm
 
 import (
+	"context"
+
 	"github.com/porter-dev/porter/internal/encryption"
 	"github.com/porter-dev/porter/internal/models"
 	"github.com/porter-dev/porter/internal/repository"
@@ -28,6 +30,7 @@ func NewKubeIntegrationRepository(
 
 // CreateKubeIntegration creates a new kube auth mechanism
 func (repo *KubeIntegrationRepository) CreateKubeIntegration(
+	ctx context.Context,
 	am *ints.KubeIntegration,
 ) (*ints.KubeIntegration, error) {
 	err := repo.EncryptKubeIntegrationData(am, repo.key)
@@ -37,11 +40,11 @@ func (repo *KubeIntegrationRepository) CreateKubeIntegration(
 
 	project := &models.Project{}
 
-	if err := repo.db.Where("id = ?", am.ProjectID).First(&project).Error; err != nil {
+	if err := repo.db.WithContext(ctx).Where("id = ?", am.ProjectID).First(&project).Error; err != nil {
 		return nil, err
 	}
 
-	assoc := repo.db.Model(&project).Association("KubeIntegrations")
+	assoc := repo.db.WithContext(ctx).Model(&project).Association("KubeIntegrations")
 
 	if assoc.Error != nil {
 		return nil, assoc.Error
@@ -56,11 +59,12 @@ func (repo *KubeIntegrationRepository) CreateKubeIntegration(
 
 // ReadKubeIntegration finds a kube auth mechanism by id
 func (repo *KubeIntegrationRepository) ReadKubeIntegration(
+	ctx context.Context,
 	projectID, id uint,
 ) (*ints.KubeIntegration, error) {
 	ki := &ints.KubeIntegration{}
 
-	if err := repo.db.Where("project_id = ? AND id = ?", projectID, id).First(&ki).Error; err != nil {
+	if err := repo.db.WithContext(ctx).Where("project_id = ? AND id = ?", projectID, id).First(&ki).Error; err != nil {
 		return nil, err
 	}
 
@@ -75,11 +79,12 @@ func (repo *KubeIntegrationRepository) ReadKubeIntegration(
 // ListKubeIntegrationsByProjectID finds all kube auth mechanisms
 // for a given project id
 func (repo *KubeIntegrationRepository) ListKubeIntegrationsByProjectID(
+	ctx context.Context,
 	projectID uint,
 ) ([]*ints.KubeIntegration, error) {
 	kis := []*ints.KubeIntegration{}
 
-	if err := repo.db.Where("project_id = ?", projectID).Find(&kis).Error; err != nil {
+	if err := repo.db.WithContext(ctx).Where("project_id = ?", projectID).Find(&kis).Error; err != nil {
 		return nil, err
 	}
 
@@ -230,6 +235,7 @@ func NewBasicIntegrationRepository(
 
 // CreateBasicIntegration creates a new basic auth mechanism
 func (repo *BasicIntegrationRepository) CreateBasicIntegration(
+	ctx context.Context,
 	am *ints.BasicIntegration,
 ) (*ints.BasicIntegration, error) {
 	err := repo.EncryptBasicIntegrationData(am, repo.key)
@@ -239,11 +245,11 @@ func (repo *BasicIntegrationRepository) CreateBasicIntegration(
 
 	project := &models.Project{}
 
-	if err := repo.db.Where("id = ?", am.ProjectID).First(&project).Error; err != nil {
+	if err := repo.db.WithContext(ctx).Where("id = ?", am.ProjectID).First(&project).Error; err != nil {
 		return nil, err
 	}
 
-	assoc := repo.db.Model(&project).Association("BasicIntegrations")
+	assoc := repo.db.WithContext(ctx).Model(&project).Association("BasicIntegrations")
 
 	if assoc.Error != nil {
 		return nil, assoc.Error
@@ -258,11 +264,12 @@ func (repo *BasicIntegrationRepository) CreateBasicIntegration(
 
 // ReadBasicIntegration finds a basic auth mechanism by id
 func (repo *BasicIntegrationRepository) ReadBasicIntegration(
+	ctx context.Context,
 	projectID, id uint,
 ) (*ints.BasicIntegration, error) {
 	basic := &ints.BasicIntegration{}
 
-	if err := repo.db.Where("project_id = ? AND id = ?", projectID, id).First(&basic).Error; err != nil {
+	if err := repo.db.WithContext(ctx).Where("project_id = ? AND id = ?", projectID, id).First(&basic).Error; err != nil {
 		return nil, err
 	}
 
@@ -277,11 +284,12 @@ func (repo *BasicIntegrationRepository) ReadBasicIntegration(
 // ListBasicIntegrationsByProjectID finds all basic auth mechanisms
 // for a given project id
 func (repo *BasicIntegrationRepository) ListBasicIntegrationsByProjectID(
+	ctx context.Context,
 	projectID uint,
 ) ([]*ints.BasicIntegration, error) {
 	basics := []*ints.BasicIntegration{}
 
-	if err := repo.db.Where("project_id = ?", projectID).Find(&basics).Error; err != nil {
+	if err := repo.db.WithContext(ctx).Where("project_id = ?", projectID).Find(&basics).Error; err != nil {
 		return nil, err
 	}
 
@@ -290,15 +298,16 @@ func (repo *BasicIntegrationRepository) ListBasicIntegrationsByProjectID(
 
 // DeleteBasicIntegration deletes an existing basic auth mechanism
 func (repo *BasicIntegrationRepository) DeleteBasicIntegration(
+	ctx context.Context,
 	am *ints.BasicIntegration,
 ) (*ints.BasicIntegration, error) {
 	project := &models.Project{}
 
-	if err := repo.db.Where("id = ?", am.ProjectID).First(&project).Error; err != nil {
+	if err := repo.db.WithContext(ctx).Where("id = ?", am.ProjectID).First(&project).Error; err != nil {
 		return nil, err
 	}
 
-	assoc := repo.db.Model(&project).Association("BasicIntegrations")
+	assoc := repo.db.WithContext(ctx).Model(&project).Association("BasicIntegrations")
 
 	if assoc.Error != nil {
 		return nil, assoc.Error
@@ -308,7 +317,7 @@ func (repo *BasicIntegrationRepository) DeleteBasicIntegration(
 		return nil, err
 	}
 
-	if err := repo.db.Delete(am).Error; err != nil {
+	if err := repo.db.WithContext(ctx).Delete(am).Error; err != nil {
 		return nil, err
 	}
 
@@ -387,6 +396,7 @@ func NewOIDCIntegrationRepository(
 
 // CreateOIDCIntegration creates a new oidc auth mechanism
 func (repo *OIDCIntegrationRepository) CreateOIDCIntegration(
+	ctx context.Context,
 	am *ints.OIDCIntegration,
 ) (*ints.OIDCIntegration, error) {
 	err := repo.EncryptOIDCIntegrationData(am, repo.key)
@@ -396,11 +406,11 @@ func (repo *OIDCIntegrationRepository) CreateOIDCIntegration(
 
 	project := &models.Project{}
 
-	if err := repo.db.Where("id = ?", am.ProjectID).First(&project).Error; err != nil {
+	if err := repo.db.WithContext(ctx).Where("id = ?", am.ProjectID).First(&project).Error; err != nil {
 		return nil, err
 	}
 
-	assoc := repo.db.Model(&project).Association("OIDCIntegrations")
+	assoc := repo.db.WithContext(ctx).Model(&project).Association("OIDCIntegrations")
 
 	if assoc.Error != nil {
 		return nil, assoc.Error
@@ -415,11 +425,12 @@ func (repo *OIDCIntegrationRepository) CreateOIDCIntegration(
 
 // ReadOIDCIntegration finds a oidc auth mechanism by id
 func (repo *OIDCIntegrationRepository) ReadOIDCIntegration(
+	ctx context.Context,
 	projectID, id uint,
 ) (*ints.OIDCIntegration, error) {
 	oidc := &ints.OIDCIntegration{}
 
-	if err := repo.db.Where("project_id = ? AND id = ?", projectID, id).First(&oidc).Error; err != nil {
+	if err := repo.db.WithContext(ctx).Where("project_id = ? AND id = ?", projectID, id).First(&oidc).Error; err != nil {
 		return nil, err
 	}
 
@@ -434,11 +445,12 @@ func (repo *OIDCIntegrationRepository) ReadOIDCIntegration(
 // ListOIDCIntegrationsByProjectID finds all oidc auth mechanisms
 // for a given project id
 func (repo *OIDCIntegrationRepository) ListOIDCIntegrationsByProjectID(
+	ctx context.Context,
 	projectID uint,
 ) ([]*ints.OIDCIntegration, error) {
 	oidcs := []*ints.OIDCIntegration{}
 
-	if err := repo.db.Where("project_id = ?", projectID).Find(&oidcs).Error; err != nil {
+	if err := repo.db.WithContext(ctx).Where("project_id = ?", projectID).Find(&oidcs).Error; err != nil {
 		return nil, err
 	}
 
@@ -591,6 +603,7 @@ func NewOAuthIntegrationRepository(
 
 // CreateOAuthIntegration creates a new oauth auth mechanism
 func (repo *OAuthIntegrationRepository) CreateOAuthIntegration(
+	ctx context.Context,
 	am *ints.OAuthIntegration,
 ) (*ints.OAuthIntegration, error) {
 	err := repo.EncryptOAuthIntegrationData(am, repo.key)
@@ -613,11 +626,11 @@ func (repo *OAuthIntegrationRepository) CreateOAuthIntegration(
 
 	project := &models.Project{}
 
-	if err := repo.db.Where("id = ?", am.ProjectID).First(&project).Error; err != nil {
+	if err := repo.db.WithContext(ctx).Where("id = ?", am.ProjectID).First(&project).Error; err != nil {
 		return nil, err
 	}
 
-	assoc := repo.db.Model(&project).Association("OAuthIntegrations")
+	assoc := repo.db.WithContext(ctx).Model(&project).Association("OAuthIntegrations")
 
 	if assoc.Error != nil {
 		return nil, assoc.Error
@@ -640,11 +653,12 @@ func (repo *OAuthIntegrationRepository) CreateOAuthIntegration(
 
 // ReadOAuthIntegration finds a oauth auth mechanism by id
 func (repo *OAuthIntegrationRepository) ReadOAuthIntegration(
+	ctx context.Context,
 	projectID, id uint,
 ) (*ints.OAuthIntegration, error) {
 	oauth := &ints.OAuthIntegration{}
 
-	if err := repo.db.Where("project_id = ? AND id = ?", projectID, id).First(&oauth).Error; err != nil {
+	if err := repo.db.WithContext(ctx).Where("project_id = ? AND id = ?", projectID, id).First(&oauth).Error; err != nil {
 		return nil, err
 	}
 
@@ -670,11 +684,12 @@ func (repo *OAuthIntegrationRepository) ReadOAuthIntegration(
 // ListOAuthIntegrationsByProjectID finds all oauth auth mechanisms
 // for a given project id
 func (repo *OAuthIntegrationRepository) ListOAuthIntegrationsByProjectID(
+	ctx context.Context,
 	projectID uint,
 ) ([]*ints.OAuthIntegration, error) {
 	oauths := []*ints.OAuthIntegration{}
 
-	if err := repo.db.Where("project_id = ?", projectID).Find(&oauths).Error; err != nil {
+	if err := repo.db.WithContext(ctx).Where("project_id = ?", projectID).Find(&oauths).Error; err != nil {
 		return nil, err
 	}
 
@@ -683,6 +698,7 @@ func (repo *OAuthIntegrationRepository) ListOAuthIntegrationsByProjectID(
 
 // UpdateOAuthIntegration modifies an existing oauth integration in the database
 func (repo *OAuthIntegrationRepository) UpdateOAuthIntegration(
+	ctx context.Context,
 	am *ints.OAuthIntegration,
 ) (*ints.OAuthIntegration, error) {
 	err := repo.EncryptOAuthIntegrationData(am, repo.key)
@@ -703,7 +719,7 @@ func (repo *OAuthIntegrationRepository) UpdateOAuthIntegration(
 		am.ClientID = []byte{}
 	}
 
-	if err := repo.db.Save(am).Error; err != nil {
+	if err := repo.db.WithContext(ctx).Save(am).Error; err != nil {
 		return nil, err
 	}
 
@@ -816,6 +832,7 @@ func NewGCPIntegrationRepository(
 
 // CreateGCPIntegration creates a new gcp auth mechanism
 func (repo *GCPIntegrationRepository) CreateGCPIntegration(
+	ctx context.Context,
 	am *ints.GCPIntegration,
 ) (*ints.GCPIntegration, error) {
 	err := repo.EncryptGCPIntegrationData(am, repo.key)
@@ -834,11 +851,11 @@ func (repo *GCPIntegrationRepository) CreateGCPIntegration(
 
 	project := &models.Project{}
 
-	if err := repo.db.Where("id = ?", am.ProjectID).First(&project).Error; err != nil {
+	if err := repo.db.WithContext(ctx).Where("id = ?", am.ProjectID).First(&project).Error; err != nil {
 		return nil, err
 	}
 
-	assoc := repo.db.Model(&project).Association("GCPIntegrations")
+	assoc := repo.db.WithContext(ctx).Model(&project).Association("GCPIntegrations")
 
 	if assoc.Error != nil {
 		return nil, assoc.Error
@@ -861,11 +878,12 @@ func (repo *GCPIntegrationRepository) CreateGCPIntegration(
 
 // ReadGCPIntegration finds a gcp auth mechanism by id
 func (repo *GCPIntegrationRepository) ReadGCPIntegration(
+	ctx context.Context,
 	projectID, id uint,
 ) (*ints.GCPIntegration, error) {
 	gcp := &ints.GCPIntegration{}
 
-	if err := repo.db.Where("project_id = ? AND id = ?", projectID, id).First(&gcp).Error; err != nil {
+	if err := repo.db.WithContext(ctx).Where("project_id = ? AND id = ?", projectID, id).First(&gcp).Error; err != nil {
 		return nil, err
 	}
 
@@ -889,11 +907,12 @@ func (repo *GCPIntegrationRepository) ReadGCPIntegration(
 // ListGCPIntegrationsByProjectID finds all gcp auth mechanisms
 // for a given project id
 func (repo *GCPIntegrationRepository) ListGCPIntegrationsByProjectID(
+	ctx context.Context,
 	projectID uint,
 ) ([]*ints.GCPIntegration, error) {
 	gcps := []*ints.GCPIntegration{}
 
-	if err := repo.db.Where("project_id = ?", projectID).Find(&gcps).Error; err != nil {
+	if err := repo.db.WithContext(ctx).Where("project_id = ?", projectID).Find(&gcps).Error; err != nil {
 		return nil, err
 	}
 
@@ -956,6 +975,7 @@ func NewAWSIntegrationRepository(
 
 // CreateAWSIntegration creates a new aws auth mechanism
 func (repo *AWSIntegrationRepository) CreateAWSIntegration(
+	ctx context.Context,
 	am *ints.AWSIntegration,
 ) (*ints.AWSIntegration, error) {
 	err := repo.EncryptAWSIntegrationData(am, repo.key)
@@ -980,11 +1000,11 @@ func (repo *AWSIntegrationRepository) CreateAWSIntegration(
 
 	project := &models.Project{}
 
-	if err := repo.db.Where("id = ?", am.ProjectID).First(&project).Error; err != nil {
+	if err := repo.db.WithContext(ctx).Where("id = ?", am.ProjectID).First(&project).Error; err != nil {
 		return nil, err
 	}
 
-	assoc := repo.db.Model(&project).Association("AWSIntegrations")
+	assoc := repo.db.WithContext(ctx).Model(&project).Association("AWSIntegrations")
 
 	if assoc.Error != nil {
 		return nil, assoc.Error
@@ -1007,6 +1027,7 @@ func (repo *AWSIntegrationRepository) CreateAWSIntegration(
 
 // UpdateCluster modifies an existing Cluster in the database
 func (repo *AWSIntegrationRepository) OverwriteAWSIntegration(
+	ctx context.Context,
 	am *ints.AWSIntegration,
 ) (*ints.AWSIntegration, error) {
 	err := repo.EncryptAWSIntegrationData(am, repo.key)
@@ -1029,7 +1050,7 @@ func (repo *AWSIntegrationRepository) OverwriteAWSIntegration(
 		am.AWSSessionToken = []byte{}
 	}
 
-	if err := repo.db.Save(am).Error; err != nil {
+	if err := repo.db.WithContext(ctx).Save(am).Error; err != nil {
 		return nil, err
 	}
 
@@ -1046,11 +1067,12 @@ func (repo *AWSIntegrationRepository) OverwriteAWSIntegration(
 
 // ReadAWSIntegration finds a aws auth mechanism by id
 func (repo *AWSIntegrationRepository) ReadAWSIntegration(
+	ctx context.Context,
 	projectID, id uint,
 ) (*ints.AWSIntegration, error) {
 	aws := &ints.AWSIntegration{}
 
-	if err := repo.db.Where("project_id = ? AND id = ?", projectID, id).First(&aws).Error; err != nil {
+	if err := repo.db.WithContext(ctx).Where("project_id = ? AND id = ?", projectID, id).First(&aws).Error; err != nil {
 		return nil, err
 	}
 
@@ -1077,11 +1099,12 @@ func (repo *AWSIntegrationRepository) ReadAWSIntegration(
 // ListAWSIntegrationsByProjectID finds all aws auth mechanisms
 // for a given project id
 func (repo *AWSIntegrationRepository) ListAWSIntegrationsByProjectID(
+	ctx context.Context,
 	projectID uint,
 ) ([]*ints.AWSIntegration, error) {
 	awss := []*ints.AWSIntegration{}
 
-	if err := repo.db.Where("project_id = ?", projectID).Find(&awss).Error; err != nil {
+	if err := repo.db.WithContext(ctx).Where("project_id = ?", projectID).Find(&awss).Error; err != nil {
 		return nil, err
 	}
 
@@ -1189,18 +1212,18 @@ func NewGithubAppInstallationRepository(db *gorm.DB) repository.GithubAppInstall
 }
 
 // CreateGithubAppInstallation creates a new GithubAppInstallation instance
-func (repo *GithubAppInstallationRepository) CreateGithubAppInstallation(am *ints.GithubAppInstallation) (*ints.GithubAppInstallation, error) {
-	if err := repo.db.Create(am).Error; err != nil {
+func (repo *GithubAppInstallationRepository) CreateGithubAppInstallation(ctx context.Context, am *ints.GithubAppInstallation) (*ints.GithubAppInstallation, error) {
+	if err := repo.db.WithContext(ctx).Create(am).Error; err != nil {
 		return nil, err
 	}
 	return am, nil
 }
 
 // ReadGithubAppInstallationByInstallationID finds a GithubAppInstallation by id
-func (repo *GithubAppInstallationRepository) ReadGithubAppInstallationByInstallationID(gaID uint) (*ints.GithubAppInstallation, error) {
+func (repo *GithubAppInstallationRepository) ReadGithubAppInstallationByInstallationID(ctx context.Context, gaID uint) (*ints.GithubAppInstallation, error) {
 	ret := &ints.GithubAppInstallation{}
 
-	if err := repo.db.Where("installation_id = ?", gaID).First(&ret).Error; err != nil {
+	if err := repo.db.WithContext(ctx).Where("installation_id = ?", gaID).First(&ret).Error; err != nil {
 		return nil, err
 	}
 
@@ -1208,10 +1231,10 @@ func (repo *GithubAppInstallationRepository) ReadGithubAppInstallationByInstalla
 }
 
 // ReadGithubAppInstallationByAccountID finds a GithubAppInstallation by an account ID
-func (repo *GithubAppInstallationRepository) ReadGithubAppInstallationByAccountID(accountID int64) (*ints.GithubAppInstallation, error) {
+func (repo *GithubAppInstallationRepository) ReadGithubAppInstallationByAccountID(ctx context.Context, accountID int64) (*ints.GithubAppInstallation, error) {
 	ret := &ints.GithubAppInstallation{}
 
-	if err := repo.db.Where("account_id = ?", accountID).First(&ret).Error; err != nil {
+	if err := repo.db.WithContext(ctx).Where("account_id = ?", accountID).First(&ret).Error; err != nil {
 		return nil, err
 	}
 
@@ -1220,10 +1243,10 @@ func (repo *GithubAppInstallationRepository) ReadGithubAppInstallationByAccountI
 
 // ReadGithubAppInstallationByAccountIDs finds all instances of GithubInstallations given a list of account IDs
 // note that if there is not Installation for a given ID, no error will be generated
-func (repo *GithubAppInstallationRepository) ReadGithubAppInstallationByAccountIDs(accountIDs []int64) ([]*ints.GithubAppInstallation, error) {
+func (repo *GithubAppInstallationRepository) ReadGithubAppInstallationByAccountIDs(ctx context.Context, accountIDs []int64) ([]*ints.GithubAppInstallation, error) {
 	ret := make([]*ints.GithubAppInstallation, 0)
 
-	if err := repo.db.Where("account_id IN ?", accountIDs).Find(&ret).Error; err != nil {
+	if err := repo.db.WithContext(ctx).Where("account_id IN ?", accountIDs).Find(&ret).Error; err != nil {
 		return nil, err
 	}
 
@@ -1232,8 +1255,8 @@ func (repo *GithubAppInstallationRepository) ReadGithubAppInstallationByAccountI
 
 // DeleteGithubAppInstallationByAccountID deletes a GithubAppInstallation given an account ID
 // note that this deletion is done with db.Unscoped(), so the record is actually deleted
-func (repo *GithubAppInstallationRepository) DeleteGithubAppInstallationByAccountID(accountID int64) error {
-	if err := repo.db.Unscoped().Where("account_id = ?", accountID).Delete(&ints.GithubAppInstallation{}).Error; err != nil {
+func (repo *GithubAppInstallationRepository) DeleteGithubAppInstallationByAccountID(ctx context.Context, accountID int64) error {
+	if err := repo.db.WithContext(ctx).Unscoped().Where("account_id = ?", accountID).Delete(&ints.GithubAppInstallation{}).Error; err != nil {
 		return err
 	}
 
@@ -1251,18 +1274,18 @@ func NewGithubAppOAuthIntegrationRepository(db *gorm.DB) repository.GithubAppOAu
 }
 
 // CreateGithubAppOAuthIntegration creates a new GithubAppOAuthIntegration
-func (repo *GithubAppOAuthIntegrationRepository) CreateGithubAppOAuthIntegration(am *ints.GithubAppOAuthIntegration) (*ints.GithubAppOAuthIntegration, error) {
-	if err := repo.db.Create(am).Error; err != nil {
+func (repo *GithubAppOAuthIntegrationRepository) CreateGithubAppOAuthIntegration(ctx context.Context, am *ints.GithubAppOAuthIntegration) (*ints.GithubAppOAuthIntegration, error) {
+	if err := repo.db.WithContext(ctx).Create(am).Error; err != nil {
 		return nil, err
 	}
 	return am, nil
 }
 
 // ReadGithubAppOauthIntegration finds a GithubAppOauthIntegration by id
-func (repo *GithubAppOAuthIntegrationRepository) ReadGithubAppOauthIntegration(id uint) (*ints.GithubAppOAuthIntegration, error) {
+func (repo *GithubAppOAuthIntegrationRepository) ReadGithubAppOauthIntegration(ctx context.Context, id uint) (*ints.GithubAppOAuthIntegration, error) {
 	ret := &ints.GithubAppOAuthIntegration{}
 
-	if err := repo.db.Where("id = ?", id).First(&ret).Error; err != nil {
+	if err := repo.db.WithContext(ctx).Where("id = ?", id).First(&ret).Error; err != nil {
 		return nil, err
 	}
 
@@ -1270,8 +1293,8 @@ func (repo *GithubAppOAuthIntegrationRepository) ReadGithubAppOauthIntegration(i
 }
 
 // UpdateGithubAppOauthIntegration updates a GithubAppOauthIntegration
-func (repo *GithubAppOAuthIntegrationRepository) UpdateGithubAppOauthIntegration(am *ints.GithubAppOAuthIntegration) (*ints.GithubAppOAuthIntegration, error) {
-	err := repo.db.Save(am).Error
+func (repo *GithubAppOAuthIntegrationRepository) UpdateGithubAppOauthIntegration(ctx context.Context, am *ints.GithubAppOAuthIntegration) (*ints.GithubAppOAuthIntegration, error) {
+	err := repo.db.WithContext(ctx).Save(am).Error
 	if err != nil {
 		return nil, err
 	}
@@ -1299,6 +1322,7 @@ func NewAzureIntegrationRepository(
 
 // CreateAzureIntegration creates a new Azure auth mechanism
 func (repo *AzureIntegrationRepository) CreateAzureIntegration(
+	ctx context.Context,
 	az *ints.AzureIntegration,
 ) (*ints.AzureIntegration, error) {
 	err := repo.EncryptAzureIntegrationData(az, repo.key)
@@ -1323,11 +1347,11 @@ func (repo *AzureIntegrationRepository) CreateAzureIntegration(
 
 	project := &models.Project{}
 
-	if err := repo.db.Where("id = ?", az.ProjectID).First(&project).Error; err != nil {
+	if err := repo.db.WithContext(ctx).Where("id = ?", az.ProjectID).First(&project).Error; err != nil {
 		return nil, err
 	}
 
-	assoc := repo.db.Model(&project).Association("AzureIntegrations")
+	assoc := repo.db.WithContext(ctx).Model(&project).Association("AzureIntegrations")
 
 	if assoc.Error != nil {
 		return nil, assoc.Error
@@ -1350,6 +1374,7 @@ func (repo *AzureIntegrationRepository) CreateAzureIntegration(
 
 // OverwriteAzureIntegration overwrites the Azure credential in the DB
 func (repo *AzureIntegrationRepository) OverwriteAzureIntegration(
+	ctx context.Context,
 	az *ints.AzureIntegration,
 ) (*ints.AzureIntegration, error) {
 	err := repo.EncryptAzureIntegrationData(az, repo.key)
@@ -1372,7 +1397,7 @@ func (repo *AzureIntegrationRepository) OverwriteAzureIntegration(
 		az.AKSPassword = []byte{}
 	}
 
-	if err := repo.db.Save(az).Error; err != nil {
+	if err := repo.db.WithContext(ctx).Save(az).Error; err != nil {
 		return nil, err
 	}
 
@@ -1385,16 +1410,17 @@ func (repo *AzureIntegrationRepository) OverwriteAzureIntegration(
 	}
 
 	// perform another read
-	return repo.ReadAzureIntegration(az.ProjectID, az.ID)
+	return repo.ReadAzureIntegration(ctx, az.ProjectID, az.ID)
 }
 
 // ReadAzureIntegration finds a Azure auth mechanism by id
 func (repo *AzureIntegrationRepository) ReadAzureIntegration(
+	ctx context.Context,
 	projectID, id uint,
 ) (*ints.AzureIntegration, error) {
 	az := &ints.AzureIntegration{}
 
-	if err := repo.db.Where("project_id = ? AND id = ?", projectID, id).First(&az).Error; err != nil {
+	if err := repo.db.WithContext(ctx).Where("project_id = ? AND id = ?", projectID, id).First(&az).Error; err != nil {
 		return nil, err
 	}
 
@@ -1421,11 +1447,12 @@ func (repo *AzureIntegrationRepository) ReadAzureIntegration(
 // ListAzureIntegrationsByProjectID finds all Azure auth mechanisms
 // for a given project id
 func (repo *AzureIntegrationRepository) ListAzureIntegrationsByProjectID(
+	ctx context.Context,
 	projectID uint,
 ) ([]*ints.AzureIntegration, error) {
 	azs := []*ints.AzureIntegration{}
 
-	if err := repo.db.Where("project_id = ?", projectID).Find(&azs).Error; err != nil {
+	if err := repo.db.WithContext(ctx).Where("project_id = ?", projectID).Find(&azs).Error; err != nil {
 		return nil, err
 	}
 
@@ -1540,7 +1567,7 @@ func NewGitlabIntegrationRepository(
 }
 
 // CreateIntegration adds a new GitlabIntegration row to the gitlab_integration table in the database
-func (repo *GitlabIntegrationRepository) CreateGitlabIntegration(gi *ints.GitlabIntegration) (*ints.GitlabIntegration, error) {
+func (repo *GitlabIntegrationRepository) CreateGitlabIntegration(ctx context.Context, gi *ints.GitlabIntegration) (*ints.GitlabIntegration, error) {
 	err := repo.EncryptGitlabIntegrationData(gi, repo.key)
 	if err != nil {
 		return nil, err
@@ -1560,11 +1587,11 @@ func (repo *GitlabIntegrationRepository) CreateGitlabIntegration(gi *ints.Gitlab
 
 	project := &models.Project{}
 
-	if err := repo.db.Where("id = ?", gi.ProjectID).First(&project).Error; err != nil {
+	if err := repo.db.WithContext(ctx).Where("id = ?", gi.ProjectID).First(&project).Error; err != nil {
 		return nil, err
 	}
 
-	assoc := repo.db.Model(&project).Association("GitlabIntegrations")
+	assoc := repo.db.WithContext(ctx).Model(&project).Association("GitlabIntegrations")
 
 	if assoc.Error != nil {
 		return nil, assoc.Error
@@ -1585,10 +1612,10 @@ func (repo *GitlabIntegrationRepository) CreateGitlabIntegration(gi *ints.Gitlab
 	return gi, nil
 }
 
-func (repo *GitlabIntegrationRepository) ReadGitlabIntegration(projectID, id uint) (*ints.GitlabIntegration, error) {
+func (repo *GitlabIntegrationRepository) ReadGitlabIntegration(ctx context.Context, projectID, id uint) (*ints.GitlabIntegration, error) {
 	gi := &ints.GitlabIntegration{}
 
-	if err := repo.db.Where("project_id = ? AND id = ?", projectID, id).First(&gi).Error; err != nil {
+	if err := repo.db.WithContext(ctx).Where("project_id = ? AND id = ?", projectID, id).First(&gi).Error; err != nil {
 		return nil, err
 	}
 
@@ -1611,18 +1638,18 @@ func (repo *GitlabIntegrationRepository) ReadGitlabIntegration(projectID, id uin
 	return gi, nil
 }
 
-func (repo *GitlabIntegrationRepository) ListGitlabIntegrationsByProjectID(projectID uint) ([]*ints.GitlabIntegration, error) {
+func (repo *GitlabIntegrationRepository) ListGitlabIntegrationsByProjectID(ctx context.Context, projectID uint) ([]*ints.GitlabIntegration, error) {
 	gi := []*ints.GitlabIntegration{}
 
-	if err := repo.db.Where("project_id = ? AND deleted_at IS NULL", projectID).Find(&gi).Error; err != nil {
+	if err := repo.db.WithContext(ctx).Where("project_id = ? AND deleted_at IS NULL", projectID).Find(&gi).Error; err != nil {
 		return nil, err
 	}
 
 	return gi, nil
 }
 
-func (repo *GitlabIntegrationRepository) DeleteGitlabIntegrationByID(projectID, id uint) error {
-	if err := repo.db.Where("project_id = ? AND id = ?", projectID, id).Delete(&ints.GitlabIntegration{}).Error; err != nil {
+func (repo *GitlabIntegrationRepository) DeleteGitlabIntegrationByID(ctx context.Context, projectID, id uint) error {
+	if err := repo.db.WithContext(ctx).Where("project_id = ? AND id = ?", projectID, id).Delete(&ints.GitlabIntegration{}).Error; err != nil {
 		return err
 	}
 
@@ -1701,9 +1728,10 @@ func NewGitlabAppOAuthIntegrationRepository(
 }
 
 func (repo *GitlabAppOAuthIntegrationRepository) CreateGitlabAppOAuthIntegration(
+	ctx context.Context,
 	gi *ints.GitlabAppOAuthIntegration,
 ) (*ints.GitlabAppOAuthIntegration, error) {
-	if err := repo.db.Create(gi).Error; err != nil {
+	if err := repo.db.WithContext(ctx).Create(gi).Error; err != nil {
 		return nil, err
 	}
 
@@ -1711,11 +1739,12 @@ func (repo *GitlabAppOAuthIntegrationRepository) CreateGitlabAppOAuthIntegration
 }
 
 func (repo *GitlabAppOAuthIntegrationRepository) ReadGitlabAppOAuthIntegration(
+	ctx context.Context,
 	userID, projectID, integrationID uint,
 ) (*ints.GitlabAppOAuthIntegration, error) {
 	gi := &ints.GitlabAppOAuthIntegration{}
 
-	if err := repo.db.
+	if err := repo.db.WithContext(ctx).
 		Order("gitlab_app_o_auth_integrations.id desc").
 		Joins("INNER JOIN gitlab_integrations ON gitlab_integrations.id = gitlab_app_o_auth_integrations.gitlab_integration_id").
 		Joins("INNER JOIN o_auth_integrations ON o_auth_integrations.id = gitlab_app_o_auth_integrations.o_auth_integration_id").
@@ -1,6 +1,8 @@
 package gorm
 
 import (
+	"context"
+
 	"github.com/porter-dev/porter/internal/models"
 	"github.com/porter-dev/porter/internal/repository"
 	"gorm.io/gorm"
@@ -18,18 +20,18 @@ func NewAuthCodeRepository(db *gorm.DB) repository.AuthCodeRepository {
 }
 
 // CreateAuthCode creates a new auth code
-func (repo *AuthCodeRepository) CreateAuthCode(a *models.AuthCode) (*models.AuthCode, error) {
-	if err := repo.db.Create(a).Error; err != nil {
+func (repo *AuthCodeRepository) CreateAuthCode(ctx context.Context, a *models.AuthCode) (*models.AuthCode, error) {
+	if err := repo.db.WithContext(ctx).Create(a).Error; err != nil {
 		return nil, err
 	}
 	return a, nil
 }
 
 // ReadAuthCode gets an invite specified by a unique token
-func (repo *AuthCodeRepository) ReadAuthCode(code string) (*models.AuthCode, error) {
+func (repo *AuthCodeRepository) ReadAuthCode(ctx context.Context, code string) (*models.AuthCode, error) {
 	a := &models.AuthCode{}
 
-	if err := repo.db.Where("authorization_code = ?", code).First(&a).Error; err != nil {
+	if err := repo.db.WithContext(ctx).Where("authorization_code = ?", code).First(&a).Error; err != nil {
 		return nil, err
 	}
 
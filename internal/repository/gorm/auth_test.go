@@ -1,6 +1,7 @@
 package gorm_test
 
 import (
+	"context"
 	"testing"
 
 	"github.com/go-test/deep"
@@ -28,12 +29,12 @@ func TestCreateKubeIntegration(t *testing.T) {
 
 	expKI := *ki
 
-	ki, err := tester.repo.KubeIntegration().CreateKubeIntegration(ki)
+	ki, err := tester.repo.KubeIntegration().CreateKubeIntegration(context.Background(), ki)
 	if err != nil {
 		t.Fatalf("%v\n", err)
 	}
 
-	ki, err = tester.repo.KubeIntegration().ReadKubeIntegration(tester.initProjects[0].ID, ki.Model.ID)
+	ki, err = tester.repo.KubeIntegration().ReadKubeIntegration(context.Background(), tester.initProjects[0].ID, ki.Model.ID)
 
 	if err != nil {
 		t.Fatalf("%v\n", err)
@@ -64,6 +65,7 @@ func TestListKubeIntegrationsByProjectID(t *testing.T) {
 	defer cleanup(tester, t)
 
 	kis, err := tester.repo.KubeIntegration().ListKubeIntegrationsByProjectID(
+		context.Background(),
 		tester.initProjects[0].Model.ID,
 	)
 	if err != nil {
@@ -115,12 +117,12 @@ func TestCreateBasicIntegration(t *testing.T) {
 
 	expBasic := *basic
 
-	basic, err := tester.repo.BasicIntegration().CreateBasicIntegration(basic)
+	basic, err := tester.repo.BasicIntegration().CreateBasicIntegration(context.Background(), basic)
 	if err != nil {
 		t.Fatalf("%v\n", err)
 	}
 
-	basic, err = tester.repo.BasicIntegration().ReadBasicIntegration(tester.initProjects[0].ID, basic.Model.ID)
+	basic, err = tester.repo.BasicIntegration().ReadBasicIntegration(context.Background(), tester.initProjects[0].ID, basic.Model.ID)
 
 	if err != nil {
 		t.Fatalf("%v\n", err)
@@ -151,6 +153,7 @@ func TestListBasicIntegrationsByProjectID(t *testing.T) {
 	defer cleanup(tester, t)
 
 	basics, err := tester.repo.BasicIntegration().ListBasicIntegrationsByProjectID(
+		context.Background(),
 		tester.initProjects[0].Model.ID,
 	)
 	if err != nil {
@@ -207,12 +210,12 @@ func TestCreateOIDCIntegration(t *testing.T) {
 
 	expOIDC := *oidc
 
-	oidc, err := tester.repo.OIDCIntegration().CreateOIDCIntegration(oidc)
+	oidc, err := tester.repo.OIDCIntegration().CreateOIDCIntegration(context.Background(), oidc)
 	if err != nil {
 		t.Fatalf("%v\n", err)
 	}
 
-	oidc, err = tester.repo.OIDCIntegration().ReadOIDCIntegration(tester.initProjects[0].ID, oidc.Model.ID)
+	oidc, err = tester.repo.OIDCIntegration().ReadOIDCIntegration(context.Background(), tester.initProjects[0].ID, oidc.Model.ID)
 
 	if err != nil {
 		t.Fatalf("%v\n", err)
@@ -243,6 +246,7 @@ func TestListOIDCIntegrationsByProjectID(t *testing.T) {
 	defer cleanup(tester, t)
 
 	oidcs, err := tester.repo.OIDCIntegration().ListOIDCIntegrationsByProjectID(
+		context.Background(),
 		tester.initProjects[0].Model.ID,
 	)
 	if err != nil {
@@ -306,12 +310,12 @@ func TestCreateOAuthIntegration(t *testing.T) {
 
 	expOAuth := *oauth
 
-	oauth, err := tester.repo.OAuthIntegration().CreateOAuthIntegration(oauth)
+	oauth, err := tester.repo.OAuthIntegration().CreateOAuthIntegration(context.Background(), oauth)
 	if err != nil {
 		t.Fatalf("%v\n", err)
 	}
 
-	oauth, err = tester.repo.OAuthIntegration().ReadOAuthIntegration(tester.initProjects[0].ID, oauth.Model.ID)
+	oauth, err = tester.repo.OAuthIntegration().ReadOAuthIntegration(context.Background(), tester.initProjects[0].ID, oauth.Model.ID)
 
 	if err != nil {
 		t.Fatalf("%v\n", err)
@@ -342,6 +346,7 @@ func TestListOAuthIntegrationsByProjectID(t *testing.T) {
 	defer cleanup(tester, t)
 
 	oauths, err := tester.repo.OAuthIntegration().ListOAuthIntegrationsByProjectID(
+		context.Background(),
 		tester.initProjects[0].Model.ID,
 	)
 	if err != nil {
@@ -400,12 +405,12 @@ func TestCreateGCPIntegration(t *testing.T) {
 
 	expGCP := *gcp
 
-	gcp, err := tester.repo.GCPIntegration().CreateGCPIntegration(gcp)
+	gcp, err := tester.repo.GCPIntegration().CreateGCPIntegration(context.Background(), gcp)
 	if err != nil {
 		t.Fatalf("%v\n", err)
 	}
 
-	gcp, err = tester.repo.GCPIntegration().ReadGCPIntegration(tester.initProjects[0].ID, gcp.Model.ID)
+	gcp, err = tester.repo.GCPIntegration().ReadGCPIntegration(context.Background(), tester.initProjects[0].ID, gcp.Model.ID)
 
 	if err != nil {
 		t.Fatalf("%v\n", err)
@@ -436,6 +441,7 @@ func TestListGCPIntegrationsByProjectID(t *testing.T) {
 	defer cleanup(tester, t)
 
 	gcps, err := tester.repo.GCPIntegration().ListGCPIntegrationsByProjectID(
+		context.Background(),
 		tester.initProjects[0].Model.ID,
 	)
 	if err != nil {
@@ -490,12 +496,12 @@ func TestCreateAWSIntegration(t *testing.T) {
 
 	expAWS := *aws
 
-	aws, err := tester.repo.AWSIntegration().CreateAWSIntegration(aws)
+	aws, err := tester.repo.AWSIntegration().CreateAWSIntegration(context.Background(), aws)
 	if err != nil {
 		t.Fatalf("%v\n", err)
 	}
 
-	aws, err = tester.repo.AWSIntegration().ReadAWSIntegration(tester.initProjects[0].ID, aws.Model.ID)
+	aws, err = tester.repo.AWSIntegration().ReadAWSIntegration(context.Background(), tester.initProjects[0].ID, aws.Model.ID)
 
 	if err != nil {
 		t.Fatalf("%v\n", err)
@@ -526,7 +532,7 @@ func TestOverwriteAWSIntegration(t *testing.T) {
 	initAWSIntegration(tester, t)
 	defer cleanup(tester, t)
 
-	aws, err := tester.repo.AWSIntegration().ReadAWSIntegration(tester.initProjects[0].ID, 1)
+	aws, err := tester.repo.AWSIntegration().ReadAWSIntegration(context.Background(), tester.initProjects[0].ID, 1)
 	if err != nil {
 		t.Fatalf("%v\n", err)
 	}
@@ -534,13 +540,13 @@ func TestOverwriteAWSIntegration(t *testing.T) {
 	aws.AWSAccessKeyID = []byte("accesskey2")
 	aws.AWSSecretAccessKey = []byte("secret2")
 
-	aws, err = tester.repo.AWSIntegration().OverwriteAWSIntegration(aws)
+	aws, err = tester.repo.AWSIntegration().OverwriteAWSIntegration(context.Background(), aws)
 
 	if err != nil {
 		t.Fatalf("%v\n", err)
 	}
 
-	gotAWS, err := tester.repo.AWSIntegration().ReadAWSIntegration(tester.initProjects[0].ID, 1)
+	gotAWS, err := tester.repo.AWSIntegration().ReadAWSIntegration(context.Background(), tester.initProjects[0].ID, 1)
 
 	expAWS := &ints.AWSIntegration{
 		ProjectID:          tester.initProjects[0].ID,
@@ -576,6 +582,7 @@ func TestListAWSIntegrationsByProjectID(t *testing.T) {
 	defer cleanup(tester, t)
 
 	awss, err := tester.repo.AWSIntegration().ListAWSIntegrationsByProjectID(
+		context.Background(),
 		tester.initProjects[0].Model.ID,
 	)
 	if err != nil {
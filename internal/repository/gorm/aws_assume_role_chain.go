@@ -40,7 +40,7 @@ func (cr AWSAssumeRoleChain) List(ctx context.Context, projectID uint) ([]*model
 		query += fmt.Sprintf(" and target_arn not like '%%arn:aws:iam::%s%%'", account)
 	}
 
-	tx := cr.db.Where(query, projectID).Find(&confs)
+	tx := cr.db.WithContext(ctx).Where(query, projectID).Find(&confs)
 	if tx.Error != nil {
 		return nil, tx.Error
 	}
@@ -1,6 +1,8 @@
 package gorm
 
 import (
+	"context"
+
 	"github.com/porter-dev/porter/internal/models"
 	"github.com/porter-dev/porter/internal/repository"
 	"gorm.io/gorm"
@@ -19,8 +21,8 @@ func NewBuildConfigRepository(db *gorm.DB) repository.BuildConfigRepository {
 }
 
 // CreateBuildConfig creates a new build config for a release
-func (repo *BuildConfigRepository) CreateBuildConfig(bc *models.BuildConfig) (*models.BuildConfig, error) {
-	if err := repo.db.Create(bc).Error; err != nil {
+func (repo *BuildConfigRepository) CreateBuildConfig(ctx context.Context, bc *models.BuildConfig) (*models.BuildConfig, error) {
+	if err := repo.db.WithContext(ctx).Create(bc).Error; err != nil {
 		return nil, err
 	}
 
@@ -28,8 +30,8 @@ func (repo *BuildConfigRepository) CreateBuildConfig(bc *models.BuildConfig) (*m
 }
 
 // UpdateBuildConfig updates a build config
-func (repo *BuildConfigRepository) UpdateBuildConfig(bc *models.BuildConfig) (*models.BuildConfig, error) {
-	if err := repo.db.Save(bc).Error; err != nil {
+func (repo *BuildConfigRepository) UpdateBuildConfig(ctx context.Context, bc *models.BuildConfig) (*models.BuildConfig, error) {
+	if err := repo.db.WithContext(ctx).Save(bc).Error; err != nil {
 		return nil, err
 	}
 
@@ -37,10 +39,10 @@ func (repo *BuildConfigRepository) UpdateBuildConfig(bc *models.BuildConfig) (*m
 }
 
 // GetBuildConfig returns a BuildConfig with the specified id
-func (repo *BuildConfigRepository) GetBuildConfig(id uint) (*models.BuildConfig, error) {
+func (repo *BuildConfigRepository) GetBuildConfig(ctx context.Context, id uint) (*models.BuildConfig, error) {
 	bc := &models.BuildConfig{}
 
-	if err := repo.db.First(bc, id).Error; err != nil {
+	if err := repo.db.WithContext(ctx).First(bc, id).Error; err != nil {
 		return nil, err
 	}
 
@@ -1,7 +1,9 @@
 package gorm
 
 import (
+	"context"
 	"fmt"
+	"time"
 
 	"github.com/porter-dev/porter/internal/encryption"
 	"github.com/porter-dev/porter/internal/models"
@@ -15,17 +17,25 @@ import (
 type ClusterRepository struct {
 	db  *gorm.DB
 	key *[32]byte
+
+	// readDB is the database which heavy list queries read from, which may be a read replica
+	readDB *gorm.DB
 }
 
 // NewClusterRepository returns a ClusterRepository which uses
 // gorm.DB for querying the database. It accepts an encryption key to encrypt
 // sensitive data
-func NewClusterRepository(db *gorm.DB, key *[32]byte) repository.ClusterRepository {
-	return &ClusterRepository{db, key}
+func NewClusterRepository(db *gorm.DB, key *[32]byte, opts ...RepositoryOption) repository.ClusterRepository {
+	return &ClusterRepository{
+		db:     db,
+		key:    key,
+		readDB: newRepositoryOptions(opts).readDB(db, ReadReplicaCluster),
+	}
 }
 
 // CreateClusterCandidate creates a new cluster candidate
 func (repo *ClusterRepository) CreateClusterCandidate(
+	ctx context.Context,
 	cc *models.ClusterCandidate,
 ) (*models.ClusterCandidate, error) {
 	err := repo.EncryptClusterCandidateData(cc, repo.key)
@@ -35,11 +45,11 @@ func (repo *ClusterRepository) CreateClusterCandidate(
 
 	project := &models.Project{}
 
-	if err := repo.db.Where("id = ?", cc.ProjectID).First(&project).Error; err != nil {
+	if err := repo.db.WithContext(ctx).Where("id = ?", cc.ProjectID).First(&project).Error; err != nil {
 		return nil, err
 	}
 
-	assoc := repo.db.Model(&project).Association("ClusterCandidates")
+	assoc := repo.db.WithContext(ctx).Model(&project).Association("ClusterCandidates")
 
 	if assoc.Error != nil {
 		return nil, assoc.Error
@@ -61,11 +71,12 @@ func (repo *ClusterRepository) CreateClusterCandidate(
 
 // ReadClusterCandidate finds a cluster candidate by id
 func (repo *ClusterRepository) ReadClusterCandidate(
+	ctx context.Context,
 	projectID, ccID uint,
 ) (*models.ClusterCandidate, error) {
 	cc := &models.ClusterCandidate{}
 
-	if err := repo.db.Preload("Resolvers").Where("project_id = ? AND id = ?", projectID, ccID).First(&cc).Error; err != nil {
+	if err := repo.db.WithContext(ctx).Preload("Resolvers").Where("project_id = ? AND id = ?", projectID, ccID).First(&cc).Error; err != nil {
 		return nil, err
 	}
 
@@ -77,11 +88,12 @@ func (repo *ClusterRepository) ReadClusterCandidate(
 // ListClusterCandidatesByProjectID finds all cluster candidates
 // for a given project id
 func (repo *ClusterRepository) ListClusterCandidatesByProjectID(
+	ctx context.Context,
 	projectID uint,
 ) ([]*models.ClusterCandidate, error) {
 	ccs := []*models.ClusterCandidate{}
 
-	if err := repo.db.Preload("Resolvers").Where("project_id = ?", projectID).Find(&ccs).Error; err != nil {
+	if err := repo.db.WithContext(ctx).Preload("Resolvers").Where("project_id = ?", projectID).Find(&ccs).Error; err != nil {
 		return nil, err
 	}
 
@@ -95,18 +107,19 @@ func (repo *ClusterRepository) ListClusterCandidatesByProjectID(
 // UpdateClusterCandidateCreatedClusterID updates the CreatedClusterID for
 // a candidate, after the candidate has been resolved.
 func (repo *ClusterRepository) UpdateClusterCandidateCreatedClusterID(
+	ctx context.Context,
 	id uint,
 	createdClusterID uint,
 ) (*models.ClusterCandidate, error) {
 	cc := &models.ClusterCandidate{}
 
-	if err := repo.db.Where("id = ?", id).First(&cc).Error; err != nil {
+	if err := repo.db.WithContext(ctx).Where("id = ?", id).First(&cc).Error; err != nil {
 		return nil, err
 	}
 
 	cc.CreatedClusterID = createdClusterID
 
-	if err := repo.db.Save(cc).Error; err != nil {
+	if err := repo.db.WithContext(ctx).Save(cc).Error; err != nil {
 		return nil, err
 	}
 
@@ -115,8 +128,44 @@ func (repo *ClusterRepository) UpdateClusterCandidateCreatedClusterID(
 	return cc, nil
 }
 
+// DeleteClusterCandidate deletes a cluster candidate and its resolvers
+func (repo *ClusterRepository) DeleteClusterCandidate(
+	ctx context.Context,
+	cc *models.ClusterCandidate,
+) error {
+	if err := repo.db.WithContext(ctx).Where("cluster_candidate_id = ?", cc.ID).Delete(&models.ClusterResolver{}).Error; err != nil {
+		return err
+	}
+
+	if err := repo.db.WithContext(ctx).Delete(cc).Error; err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// DeleteExpiredClusterCandidates deletes all unresolved cluster candidates which expired before now,
+// and returns the number of candidates deleted
+func (repo *ClusterRepository) DeleteExpiredClusterCandidates(ctx context.Context, now time.Time) (int64, error) {
+	expired := repo.db.WithContext(ctx).Model(&models.ClusterCandidate{}).
+		Where("expires_at < ? AND created_cluster_id = 0", now).
+		Select("id")
+
+	if err := repo.db.WithContext(ctx).Where("cluster_candidate_id IN (?)", expired).Delete(&models.ClusterResolver{}).Error; err != nil {
+		return 0, err
+	}
+
+	res := repo.db.WithContext(ctx).Where("expires_at < ? AND created_cluster_id = 0", now).Delete(&models.ClusterCandidate{})
+	if res.Error != nil {
+		return 0, res.Error
+	}
+
+	return res.RowsAffected, nil
+}
+
 // CreateCluster creates a new cluster
 func (repo *ClusterRepository) CreateCluster(
+	ctx context.Context,
 	cluster *models.Cluster,
 ) (*models.Cluster, error) {
 	err := repo.EncryptClusterData(cluster, repo.key)
@@ -126,7 +175,7 @@ func (repo *ClusterRepository) CreateCluster(
 
 	project := &models.Project{}
 
-	if err := repo.db.Where("id = ?", cluster.ProjectID).First(&project).Error; err != nil {
+	if err := repo.db.WithContext(ctx).Where("id = ?", cluster.ProjectID).First(&project).Error; err != nil {
 		return nil, err
 	}
 
@@ -135,7 +184,7 @@ func (repo *ClusterRepository) CreateCluster(
 		cluster.PreviewEnvsEnabled = false
 	}
 
-	assoc := repo.db.Model(&project).Association("Clusters")
+	assoc := repo.db.WithContext(ctx).Model(&project).Association("Clusters")
 
 	if assoc.Error != nil {
 		return nil, assoc.Error
@@ -148,13 +197,13 @@ func (repo *ClusterRepository) CreateCluster(
 	// create a token cache by default
 	cluster.TokenCache.ClusterID = cluster.ID
 
-	if err := repo.db.Create(&cluster.TokenCache).Error; err != nil {
+	if err := repo.db.WithContext(ctx).Create(&cluster.TokenCache).Error; err != nil {
 		return nil, err
 	}
 
 	cluster.TokenCacheID = cluster.TokenCache.ID
 
-	if err := repo.db.Save(cluster).Error; err != nil {
+	if err := repo.db.WithContext(ctx).Save(cluster).Error; err != nil {
 		return nil, err
 	}
 
@@ -169,19 +218,20 @@ func (repo *ClusterRepository) CreateCluster(
 
 // ReadCluster finds a cluster by id
 func (repo *ClusterRepository) ReadCluster(
+	ctx context.Context,
 	projectID, clusterID uint,
 ) (*models.Cluster, error) {
 	cluster := &models.Cluster{}
 
 	// preload Clusters association
-	if err := repo.db.Where("project_id = ? AND id = ?", projectID, clusterID).First(&cluster).Error; err != nil {
+	if err := repo.db.WithContext(ctx).Where("project_id = ? AND id = ?", projectID, clusterID).First(&cluster).Error; err != nil {
 		return nil, err
 	}
 
 	cache := ints.ClusterTokenCache{}
 
 	if cluster.TokenCacheID != 0 {
-		if err := repo.db.Where("id = ?", cluster.TokenCacheID).First(&cache).Error; err != nil {
+		if err := repo.db.WithContext(ctx).Where("id = ?", cluster.TokenCacheID).First(&cache).Error; err != nil {
 			return nil, err
 		}
 	}
@@ -198,19 +248,20 @@ func (repo *ClusterRepository) ReadCluster(
 
 // ReadCluster finds a cluster by id
 func (repo *ClusterRepository) ReadClusterByInfraID(
+	ctx context.Context,
 	projectID, infraID uint,
 ) (*models.Cluster, error) {
 	cluster := &models.Cluster{}
 
 	// preload Clusters association
-	if err := repo.db.Where("project_id = ? AND infra_id = ?", projectID, infraID).First(&cluster).Error; err != nil {
+	if err := repo.db.WithContext(ctx).Where("project_id = ? AND infra_id = ?", projectID, infraID).First(&cluster).Error; err != nil {
 		return nil, err
 	}
 
 	cache := ints.ClusterTokenCache{}
 
 	if cluster.TokenCacheID != 0 {
-		if err := repo.db.Where("id = ?", cluster.TokenCacheID).First(&cache).Error; err != nil {
+		if err := repo.db.WithContext(ctx).Where("id = ?", cluster.TokenCacheID).First(&cache).Error; err != nil {
 			return nil, err
 		}
 	}
@@ -228,11 +279,12 @@ func (repo *ClusterRepository) ReadClusterByInfraID(
 // ListClustersByProjectID finds all clusters
 // for a given project id
 func (repo *ClusterRepository) ListClustersByProjectID(
+	ctx context.Context,
 	projectID uint,
 ) ([]*models.Cluster, error) {
 	clusters := []*models.Cluster{}
 
-	if err := repo.db.Where("project_id = ?", projectID).Find(&clusters).Error; err != nil {
+	if err := repo.readDB.WithContext(ctx).Where("project_id = ?", projectID).Find(&clusters).Error; err != nil {
 		return nil, err
 	}
 
@@ -245,6 +297,7 @@ func (repo *ClusterRepository) ListClustersByProjectID(
 
 // UpdateCluster modifies an existing Cluster in the database
 func (repo *ClusterRepository) UpdateCluster(
+	ctx context.Context,
 	cluster *models.Cluster,
 ) (*models.Cluster, error) {
 	err := repo.EncryptClusterData(cluster, repo.key)
@@ -256,7 +309,7 @@ func (repo *ClusterRepository) UpdateCluster(
 		// this should only work if the corresponding project has preview environments enabled
 		project := &models.Project{}
 
-		if err := repo.db.Where("id = ?", cluster.ProjectID).First(project).Error; err != nil {
+		if err := repo.db.WithContext(ctx).Where("id = ?", cluster.ProjectID).First(project).Error; err != nil {
 			return nil, fmt.Errorf("error fetching details about cluster's project: %w", err)
 		}
 
@@ -265,7 +318,7 @@ func (repo *ClusterRepository) UpdateCluster(
 		}
 	}
 
-	if err := repo.db.Save(cluster).Error; err != nil {
+	if err := repo.db.WithContext(ctx).Save(cluster).Error; err != nil {
 		return nil, err
 	}
 
@@ -280,6 +333,7 @@ func (repo *ClusterRepository) UpdateCluster(
 
 // UpdateClusterTokenCache updates the token cache for a cluster
 func (repo *ClusterRepository) UpdateClusterTokenCache(
+	ctx context.Context,
 	tokenCache *ints.ClusterTokenCache,
 ) (*models.Cluster, error) {
 	if tok := tokenCache.Token; len(tok) > 0 {
@@ -293,23 +347,23 @@ func (repo *ClusterRepository) UpdateClusterTokenCache(
 
 	cluster := &models.Cluster{}
 
-	if err := repo.db.Where("id = ?", tokenCache.ClusterID).First(&cluster).Error; err != nil {
+	if err := repo.db.WithContext(ctx).Where("id = ?", tokenCache.ClusterID).First(&cluster).Error; err != nil {
 		return nil, err
 	}
 
 	if cluster.TokenCacheID == 0 {
 		tokenCache.ClusterID = cluster.ID
-		if err := repo.db.Create(tokenCache).Error; err != nil {
+		if err := repo.db.WithContext(ctx).Create(tokenCache).Error; err != nil {
 			return nil, err
 		}
 		cluster.TokenCacheID = tokenCache.ID
-		if err := repo.db.Save(cluster).Error; err != nil {
+		if err := repo.db.WithContext(ctx).Save(cluster).Error; err != nil {
 			return nil, err
 		}
 	} else {
 		prev := &ints.ClusterTokenCache{}
 
-		if err := repo.db.Where("id = ?", cluster.TokenCacheID).First(prev).Error; err != nil {
+		if err := repo.db.WithContext(ctx).Where("id = ?", cluster.TokenCacheID).First(prev).Error; err != nil {
 			return nil, err
 		}
 
@@ -317,7 +371,7 @@ func (repo *ClusterRepository) UpdateClusterTokenCache(
 		prev.Expiry = tokenCache.Expiry
 		prev.ClusterID = cluster.ID
 
-		if err := repo.db.Save(prev).Error; err != nil {
+		if err := repo.db.WithContext(ctx).Save(prev).Error; err != nil {
 			return nil, err
 		}
 	}
@@ -327,14 +381,15 @@ func (repo *ClusterRepository) UpdateClusterTokenCache(
 
 // DeleteCluster removes a cluster from the db
 func (repo *ClusterRepository) DeleteCluster(
+	ctx context.Context,
 	cluster *models.Cluster,
 ) error {
 	// clear TokenCache association
-	if err := repo.db.Where("id = ?", cluster.TokenCacheID).Delete(&ints.ClusterTokenCache{}).Error; err != nil {
+	if err := repo.db.WithContext(ctx).Where("id = ?", cluster.TokenCacheID).Delete(&ints.ClusterTokenCache{}).Error; err != nil {
 		return err
 	}
 
-	if err := repo.db.Where("id = ?", cluster.ID).Delete(&models.Cluster{}).Error; err != nil {
+	if err := repo.db.WithContext(ctx).Where("id = ?", cluster.ID).Delete(&models.Cluster{}).Error; err != nil {
 		return err
 	}
 
@@ -1,6 +1,7 @@
 package gorm_test
 
 import (
+	"context"
 	"testing"
 	"time"
 
@@ -34,12 +35,12 @@ func TestCreateClusterCandidate(t *testing.T) {
 
 	expCC := *cc
 
-	cc, err := tester.repo.Cluster().CreateClusterCandidate(cc)
+	cc, err := tester.repo.Cluster().CreateClusterCandidate(context.Background(), cc)
 	if err != nil {
 		t.Fatalf("%v\n", err)
 	}
 
-	cc, err = tester.repo.Cluster().ReadClusterCandidate(tester.initProjects[0].ID, cc.Model.ID)
+	cc, err = tester.repo.Cluster().ReadClusterCandidate(context.Background(), tester.initProjects[0].ID, cc.Model.ID)
 
 	if err != nil {
 		t.Fatalf("%v\n", err)
@@ -87,12 +88,12 @@ func TestCreateClusterCandidateWithResolvers(t *testing.T) {
 
 	expCC := *cc
 
-	cc, err := tester.repo.Cluster().CreateClusterCandidate(cc)
+	cc, err := tester.repo.Cluster().CreateClusterCandidate(context.Background(), cc)
 	if err != nil {
 		t.Fatalf("%v\n", err)
 	}
 
-	cc, err = tester.repo.Cluster().ReadClusterCandidate(tester.initProjects[0].ID, cc.Model.ID)
+	cc, err = tester.repo.Cluster().ReadClusterCandidate(context.Background(), tester.initProjects[0].ID, cc.Model.ID)
 
 	if err != nil {
 		t.Fatalf("%v\n", err)
@@ -136,6 +137,7 @@ func TestListClusterCandidatesByProjectID(t *testing.T) {
 	defer cleanup(tester, t)
 
 	ccs, err := tester.repo.Cluster().ListClusterCandidatesByProjectID(
+		context.Background(),
 		tester.initProjects[0].Model.ID,
 	)
 	if err != nil {
@@ -181,6 +183,7 @@ func TestUpdateClusterCandidateCreatedClusterID(t *testing.T) {
 	defer cleanup(tester, t)
 
 	cc, err := tester.repo.Cluster().UpdateClusterCandidateCreatedClusterID(
+		context.Background(),
 		tester.initCCs[0].ID,
 		tester.initClusters[0].ID,
 	)
@@ -228,12 +231,12 @@ func TestCreateCluster(t *testing.T) {
 
 	expCluster := *cluster
 
-	cluster, err := tester.repo.Cluster().CreateCluster(cluster)
+	cluster, err := tester.repo.Cluster().CreateCluster(context.Background(), cluster)
 	if err != nil {
 		t.Fatalf("%v\n", err)
 	}
 
-	cluster, err = tester.repo.Cluster().ReadCluster(tester.initProjects[0].ID, cluster.Model.ID)
+	cluster, err = tester.repo.Cluster().ReadCluster(context.Background(), tester.initProjects[0].ID, cluster.Model.ID)
 
 	if err != nil {
 		t.Fatalf("%v\n", err)
@@ -267,6 +270,7 @@ func TestListClustersByProjectID(t *testing.T) {
 	defer cleanup(tester, t)
 
 	clusters, err := tester.repo.Cluster().ListClustersByProjectID(
+		context.Background(),
 		tester.initProjects[0].Model.ID,
 	)
 	if err != nil {
@@ -314,13 +318,14 @@ func TestUpdateCluster(t *testing.T) {
 	cluster.Name = "cluster-new-name"
 
 	cluster, err := tester.repo.Cluster().UpdateCluster(
+		context.Background(),
 		cluster,
 	)
 	if err != nil {
 		t.Fatalf("%v\n", err)
 	}
 
-	cluster, err = tester.repo.Cluster().ReadCluster(tester.initProjects[0].ID, tester.initClusters[0].ID)
+	cluster, err = tester.repo.Cluster().ReadCluster(context.Background(), tester.initProjects[0].ID, tester.initClusters[0].ID)
 
 	// make sure data is correct
 	expCluster := models.Cluster{
@@ -369,12 +374,12 @@ func TestUpdateClusterToken(t *testing.T) {
 		},
 	}
 
-	cluster, err := tester.repo.Cluster().CreateCluster(cluster)
+	cluster, err := tester.repo.Cluster().CreateCluster(context.Background(), cluster)
 	if err != nil {
 		t.Fatalf("%v\n", err)
 	}
 
-	cluster, err = tester.repo.Cluster().ReadCluster(tester.initProjects[0].ID, cluster.Model.ID)
+	cluster, err = tester.repo.Cluster().ReadCluster(context.Background(), tester.initProjects[0].ID, cluster.Model.ID)
 
 	if err != nil {
 		t.Fatalf("%v\n", err)
@@ -398,11 +403,11 @@ func TestUpdateClusterToken(t *testing.T) {
 	cluster.TokenCache.Token = []byte("token-2")
 	cluster.TokenCache.Expiry = time.Now().Add(24 * time.Hour)
 
-	cluster, err = tester.repo.Cluster().UpdateClusterTokenCache(&cluster.TokenCache)
+	cluster, err = tester.repo.Cluster().UpdateClusterTokenCache(context.Background(), &cluster.TokenCache)
 	if err != nil {
 		t.Fatalf("%v\n", err)
 	}
-	cluster, err = tester.repo.Cluster().ReadCluster(tester.initProjects[0].ID, cluster.Model.ID)
+	cluster, err = tester.repo.Cluster().ReadCluster(context.Background(), tester.initProjects[0].ID, cluster.Model.ID)
 	if err != nil {
 		t.Fatalf("%v\n", err)
 	}
@@ -436,24 +441,24 @@ func TestDeleteCluster(t *testing.T) {
 	initCluster(tester, t)
 	defer cleanup(tester, t)
 
-	cluster, err := tester.repo.Cluster().ReadCluster(tester.initProjects[0].ID, tester.initClusters[0].Model.ID)
+	cluster, err := tester.repo.Cluster().ReadCluster(context.Background(), tester.initProjects[0].ID, tester.initClusters[0].Model.ID)
 	if err != nil {
 		t.Fatalf("%v\n", err)
 	}
 
-	err = tester.repo.Cluster().DeleteCluster(cluster)
+	err = tester.repo.Cluster().DeleteCluster(context.Background(), cluster)
 
 	if err != nil {
 		t.Fatalf("%v\n", err)
 	}
 
-	_, err = tester.repo.Cluster().ReadCluster(tester.initProjects[0].ID, tester.initClusters[0].Model.ID)
+	_, err = tester.repo.Cluster().ReadCluster(context.Background(), tester.initProjects[0].ID, tester.initClusters[0].Model.ID)
 
 	if err != orm.ErrRecordNotFound {
 		t.Fatalf("incorrect error: expected %v, got %v\n", orm.ErrRecordNotFound, err)
 	}
 
-	clusters, err := tester.repo.Cluster().ListClustersByProjectID(tester.initProjects[0].Model.ID)
+	clusters, err := tester.repo.Cluster().ListClustersByProjectID(context.Background(), tester.initProjects[0].Model.ID)
 	if err != nil {
 		t.Fatalf("%v\n", err)
 	}
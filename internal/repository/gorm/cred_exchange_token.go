@@ -1,6 +1,8 @@
 package gorm
 
 import (
+	"context"
+
 	"github.com/porter-dev/porter/internal/models"
 	"github.com/porter-dev/porter/internal/repository"
 	"gorm.io/gorm"
@@ -15,18 +17,18 @@ func NewCredentialsExchangeTokenRepository(db *gorm.DB) repository.CredentialsEx
 	return &CredentialsExchangeTokenRepository{db}
 }
 
-func (repo *CredentialsExchangeTokenRepository) CreateCredentialsExchangeToken(ceToken *models.CredentialsExchangeToken) (*models.CredentialsExchangeToken, error) {
-	if err := repo.db.Create(ceToken).Error; err != nil {
+func (repo *CredentialsExchangeTokenRepository) CreateCredentialsExchangeToken(ctx context.Context, ceToken *models.CredentialsExchangeToken) (*models.CredentialsExchangeToken, error) {
+	if err := repo.db.WithContext(ctx).Create(ceToken).Error; err != nil {
 		return nil, err
 	}
 
 	return ceToken, nil
 }
 
-func (repo *CredentialsExchangeTokenRepository) ReadCredentialsExchangeToken(id uint) (*models.CredentialsExchangeToken, error) {
+func (repo *CredentialsExchangeTokenRepository) ReadCredentialsExchangeToken(ctx context.Context, id uint) (*models.CredentialsExchangeToken, error) {
 	ceToken := &models.CredentialsExchangeToken{}
 
-	if err := repo.db.Where("id = ?", id).First(&ceToken).Error; err != nil {
+	if err := repo.db.WithContext(ctx).Where("id = ?", id).First(&ceToken).Error; err != nil {
 		return nil, err
 	}
 
@@ -0,0 +1,156 @@
+package gorm
+
+import (
+	"context"
+
+	"github.com/porter-dev/porter/internal/encryption"
+	"github.com/porter-dev/porter/internal/models"
+	"github.com/porter-dev/porter/internal/repository"
+	"gorm.io/gorm"
+)
+
+// CustomCertificateRepository uses gorm.DB for querying the database
+type CustomCertificateRepository struct {
+	db  *gorm.DB
+	key *[32]byte
+}
+
+// NewCustomCertificateRepository returns a CustomCertificateRepository which uses gorm.DB for querying
+// the database. It accepts an encryption key to encrypt certificates and private keys
+func NewCustomCertificateRepository(db *gorm.DB, key *[32]byte) repository.CustomCertificateRepository {
+	return &CustomCertificateRepository{db, key}
+}
+
+// CreateCustomCertificate creates a new custom certificate
+func (repo *CustomCertificateRepository) CreateCustomCertificate(ctx context.Context, cert *models.CustomCertificate) (*models.CustomCertificate, error) {
+	err := repo.EncryptCustomCertificateData(cert, repo.key)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := repo.db.WithContext(ctx).Create(cert).Error; err != nil {
+		return nil, err
+	}
+
+	err = repo.DecryptCustomCertificateData(cert, repo.key)
+	if err != nil {
+		return nil, err
+	}
+
+	return cert, nil
+}
+
+// ReadCustomCertificate finds the custom certificate of a domain of an app
+func (repo *CustomCertificateRepository) ReadCustomCertificate(ctx context.Context, clusterID uint, appName, domain string) (*models.CustomCertificate, error) {
+	cert := &models.CustomCertificate{}
+
+	if err := repo.db.WithContext(ctx).Where("cluster_id = ? AND app_name = ? AND domain = ?", clusterID, appName, domain).First(cert).Error; err != nil {
+		return nil, err
+	}
+
+	err := repo.DecryptCustomCertificateData(cert, repo.key)
+	if err != nil {
+		return nil, err
+	}
+
+	return cert, nil
+}
+
+// ListCustomCertificatesByApp finds the custom certificates of the domains of an app
+func (repo *CustomCertificateRepository) ListCustomCertificatesByApp(ctx context.Context, clusterID uint, appName string) ([]*models.CustomCertificate, error) {
+	certs := []*models.CustomCertificate{}
+
+	if err := repo.db.WithContext(ctx).Where("cluster_id = ? AND app_name = ?", clusterID, appName).Order("domain asc").Find(&certs).Error; err != nil {
+		return nil, err
+	}
+
+	for _, cert := range certs {
+		err := repo.DecryptCustomCertificateData(cert, repo.key)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return certs, nil
+}
+
+// UpdateCustomCertificate modifies an existing custom certificate in the database
+func (repo *CustomCertificateRepository) UpdateCustomCertificate(ctx context.Context, cert *models.CustomCertificate) (*models.CustomCertificate, error) {
+	err := repo.EncryptCustomCertificateData(cert, repo.key)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := repo.db.WithContext(ctx).Save(cert).Error; err != nil {
+		return nil, err
+	}
+
+	err = repo.DecryptCustomCertificateData(cert, repo.key)
+	if err != nil {
+		return nil, err
+	}
+
+	return cert, nil
+}
+
+// DeleteCustomCertificate deletes a custom certificate
+func (repo *CustomCertificateRepository) DeleteCustomCertificate(ctx context.Context, cert *models.CustomCertificate) error {
+	if err := repo.db.WithContext(ctx).Delete(cert).Error; err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// EncryptCustomCertificateData will encrypt the certificate and private key before writing them to the DB
+func (repo *CustomCertificateRepository) EncryptCustomCertificateData(
+	cert *models.CustomCertificate,
+	key *[32]byte,
+) error {
+	if len(cert.Certificate) > 0 {
+		cipherData, err := encryption.Encrypt(cert.Certificate, key)
+		if err != nil {
+			return err
+		}
+
+		cert.Certificate = cipherData
+	}
+
+	if len(cert.PrivateKey) > 0 {
+		cipherData, err := encryption.Encrypt(cert.PrivateKey, key)
+		if err != nil {
+			return err
+		}
+
+		cert.PrivateKey = cipherData
+	}
+
+	return nil
+}
+
+// DecryptCustomCertificateData will decrypt the certificate and private key before
+// returning them from the DB
+func (repo *CustomCertificateRepository) DecryptCustomCertificateData(
+	cert *models.CustomCertificate,
+	key *[32]byte,
+) error {
+	if len(cert.Certificate) > 0 {
+		plaintext, err := encryption.Decrypt(cert.Certificate, key)
+		if err != nil {
+			return err
+		}
+
+		cert.Certificate = plaintext
+	}
+
+	if len(cert.PrivateKey) > 0 {
+		plaintext, err := encryption.Decrypt(cert.PrivateKey, key)
+		if err != nil {
+			return err
+		}
+
+		cert.PrivateKey = plaintext
+	}
+
+	return nil
+}
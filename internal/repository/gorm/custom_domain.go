@@ -0,0 +1,83 @@
+package gorm
+
+import (
+	"context"
+	"time"
+
+	"github.com/porter-dev/porter/api/types"
+	"github.com/porter-dev/porter/internal/models"
+	"github.com/porter-dev/porter/internal/repository"
+	"gorm.io/gorm"
+)
+
+// CustomDomainRepository uses gorm.DB for querying the database
+type CustomDomainRepository struct {
+	db *gorm.DB
+}
+
+// NewCustomDomainRepository returns a CustomDomainRepository which uses gorm.DB for querying the database
+func NewCustomDomainRepository(db *gorm.DB) repository.CustomDomainRepository {
+	return &CustomDomainRepository{db}
+}
+
+// CreateCustomDomain creates a new custom domain
+func (repo *CustomDomainRepository) CreateCustomDomain(ctx context.Context, domain *models.CustomDomain) (*models.CustomDomain, error) {
+	if err := repo.db.WithContext(ctx).Create(domain).Error; err != nil {
+		return nil, err
+	}
+
+	return domain, nil
+}
+
+// ReadCustomDomain finds a custom domain of an app by name
+func (repo *CustomDomainRepository) ReadCustomDomain(ctx context.Context, clusterID uint, appName, name string) (*models.CustomDomain, error) {
+	domain := &models.CustomDomain{}
+
+	if err := repo.db.WithContext(ctx).Where("cluster_id = ? AND app_name = ? AND name = ?", clusterID, appName, name).First(domain).Error; err != nil {
+		return nil, err
+	}
+
+	return domain, nil
+}
+
+// ListCustomDomainsByApp finds the custom domains of an app
+func (repo *CustomDomainRepository) ListCustomDomainsByApp(ctx context.Context, clusterID uint, appName string) ([]*models.CustomDomain, error) {
+	domains := []*models.CustomDomain{}
+
+	if err := repo.db.WithContext(ctx).Where("cluster_id = ? AND app_name = ?", clusterID, appName).Order("name asc").Find(&domains).Error; err != nil {
+		return nil, err
+	}
+
+	return domains, nil
+}
+
+// ListCustomDomainsToCheck lists the domains which are not verified, and the verified domains last checked before checkedBefore
+func (repo *CustomDomainRepository) ListCustomDomainsToCheck(ctx context.Context, checkedBefore time.Time) ([]*models.CustomDomain, error) {
+	domains := []*models.CustomDomain{}
+
+	if err := repo.db.WithContext(ctx).
+		Where("status <> ? OR last_checked_at IS NULL OR last_checked_at < ?", types.CustomDomainStatus_Verified, checkedBefore).
+		Find(&domains).Error; err != nil {
+		return nil, err
+	}
+
+	return domains, nil
+}
+
+// UpdateCustomDomain modifies an existing custom domain in the database
+func (repo *CustomDomainRepository) UpdateCustomDomain(ctx context.Context, domain *models.CustomDomain) (*models.CustomDomain, error) {
+	if err := repo.db.WithContext(ctx).Save(domain).Error; err != nil {
+		return nil, err
+	}
+
+	return domain, nil
+}
+
+// DeleteCustomDomain deletes a custom domain
+func (repo *CustomDomainRepository) DeleteCustomDomain(ctx context.Context, domain *models.CustomDomain) error {
+	if err := repo.db.WithContext(ctx).Delete(domain).Error; err != nil {
+		return err
+	}
+
+	return nil
+}
@@ -0,0 +1,254 @@
+package gorm
+
+import (
+	"context"
+
+	"github.com/porter-dev/porter/internal/encryption"
+	"github.com/porter-dev/porter/internal/models"
+	"github.com/porter-dev/porter/internal/repository"
+	"gorm.io/gorm"
+)
+
+// DatastoreRepository uses gorm.DB for querying the database
+type DatastoreRepository struct {
+	db  *gorm.DB
+	key *[32]byte
+}
+
+// NewDatastoreRepository returns a DatastoreRepository which uses gorm.DB for querying
+// the database. It accepts an encryption key to encrypt datastore credentials
+func NewDatastoreRepository(db *gorm.DB, key *[32]byte) repository.DatastoreRepository {
+	return &DatastoreRepository{db, key}
+}
+
+// CreateDatastore creates a new datastore
+func (repo *DatastoreRepository) CreateDatastore(ctx context.Context, datastore *models.Datastore) (*models.Datastore, error) {
+	err := repo.EncryptDatastoreData(datastore, repo.key)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := repo.db.WithContext(ctx).Create(datastore).Error; err != nil {
+		return nil, err
+	}
+
+	err = repo.DecryptDatastoreData(datastore, repo.key)
+	if err != nil {
+		return nil, err
+	}
+
+	return datastore, nil
+}
+
+// ReadDatastore finds a datastore by project id and datastore id, with its links
+func (repo *DatastoreRepository) ReadDatastore(ctx context.Context, projectID, datastoreID uint) (*models.Datastore, error) {
+	datastore := &models.Datastore{}
+
+	if err := repo.db.WithContext(ctx).Preload("Links").Where("project_id = ? AND id = ?", projectID, datastoreID).First(datastore).Error; err != nil {
+		return nil, err
+	}
+
+	err := repo.DecryptDatastoreData(datastore, repo.key)
+	if err != nil {
+		return nil, err
+	}
+
+	for i := range datastore.Links {
+		err := repo.DecryptDatastoreLinkData(&datastore.Links[i], repo.key)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return datastore, nil
+}
+
+// ListDatastoresByProjectID finds all datastores for a given project id, with their links
+func (repo *DatastoreRepository) ListDatastoresByProjectID(ctx context.Context, projectID uint) ([]*models.Datastore, error) {
+	datastores := []*models.Datastore{}
+
+	if err := repo.db.WithContext(ctx).Preload("Links").Where("project_id = ?", projectID).Order("id asc").Find(&datastores).Error; err != nil {
+		return nil, err
+	}
+
+	for _, datastore := range datastores {
+		err := repo.DecryptDatastoreData(datastore, repo.key)
+		if err != nil {
+			return nil, err
+		}
+
+		for i := range datastore.Links {
+			err := repo.DecryptDatastoreLinkData(&datastore.Links[i], repo.key)
+			if err != nil {
+				return nil, err
+			}
+		}
+	}
+
+	return datastores, nil
+}
+
+// UpdateDatastore modifies an existing datastore in the database. Its links are not updated.
+func (repo *DatastoreRepository) UpdateDatastore(ctx context.Context, datastore *models.Datastore) (*models.Datastore, error) {
+	err := repo.EncryptDatastoreData(datastore, repo.key)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := repo.db.WithContext(ctx).Omit("Links").Save(datastore).Error; err != nil {
+		return nil, err
+	}
+
+	err = repo.DecryptDatastoreData(datastore, repo.key)
+	if err != nil {
+		return nil, err
+	}
+
+	return datastore, nil
+}
+
+// DeleteDatastore deletes a datastore and its links
+func (repo *DatastoreRepository) DeleteDatastore(ctx context.Context, datastore *models.Datastore) error {
+	return repo.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		if err := tx.Where("datastore_id = ?", datastore.ID).Delete(&models.DatastoreLink{}).Error; err != nil {
+			return err
+		}
+
+		return tx.Delete(datastore).Error
+	})
+}
+
+// CreateDatastoreLink links a datastore to an app
+func (repo *DatastoreRepository) CreateDatastoreLink(ctx context.Context, link *models.DatastoreLink) (*models.DatastoreLink, error) {
+	err := repo.EncryptDatastoreLinkData(link, repo.key)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := repo.db.WithContext(ctx).Create(link).Error; err != nil {
+		return nil, err
+	}
+
+	err = repo.DecryptDatastoreLinkData(link, repo.key)
+	if err != nil {
+		return nil, err
+	}
+
+	return link, nil
+}
+
+// UpdateDatastoreLink modifies an existing link in the database
+func (repo *DatastoreRepository) UpdateDatastoreLink(ctx context.Context, link *models.DatastoreLink) (*models.DatastoreLink, error) {
+	err := repo.EncryptDatastoreLinkData(link, repo.key)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := repo.db.WithContext(ctx).Save(link).Error; err != nil {
+		return nil, err
+	}
+
+	err = repo.DecryptDatastoreLinkData(link, repo.key)
+	if err != nil {
+		return nil, err
+	}
+
+	return link, nil
+}
+
+// ListDatastoreLinksByApp finds the links of the datastores of a project to an app
+func (repo *DatastoreRepository) ListDatastoreLinksByApp(ctx context.Context, projectID, clusterID uint, appName string) ([]*models.DatastoreLink, error) {
+	links := []*models.DatastoreLink{}
+
+	if err := repo.db.WithContext(ctx).Where("project_id = ? AND cluster_id = ? AND app_name = ?", projectID, clusterID, appName).Order("id asc").Find(&links).Error; err != nil {
+		return nil, err
+	}
+
+	for _, link := range links {
+		err := repo.DecryptDatastoreLinkData(link, repo.key)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return links, nil
+}
+
+// DeleteDatastoreLink unlinks a datastore from an app
+func (repo *DatastoreRepository) DeleteDatastoreLink(ctx context.Context, link *models.DatastoreLink) error {
+	if err := repo.db.WithContext(ctx).Delete(link).Error; err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// EncryptDatastoreData will encrypt the datastore credentials before writing them to the DB
+func (repo *DatastoreRepository) EncryptDatastoreData(
+	datastore *models.Datastore,
+	key *[32]byte,
+) error {
+	if len(datastore.MasterPassword) > 0 {
+		cipherData, err := encryption.Encrypt(datastore.MasterPassword, key)
+		if err != nil {
+			return err
+		}
+
+		datastore.MasterPassword = cipherData
+	}
+
+	return nil
+}
+
+// DecryptDatastoreData will decrypt the datastore credentials before
+// returning them from the DB
+func (repo *DatastoreRepository) DecryptDatastoreData(
+	datastore *models.Datastore,
+	key *[32]byte,
+) error {
+	if len(datastore.MasterPassword) > 0 {
+		plaintext, err := encryption.Decrypt(datastore.MasterPassword, key)
+		if err != nil {
+			return err
+		}
+
+		datastore.MasterPassword = plaintext
+	}
+
+	return nil
+}
+
+// EncryptDatastoreLinkData will encrypt the credentials of the user of a link before writing them to the DB
+func (repo *DatastoreRepository) EncryptDatastoreLinkData(
+	link *models.DatastoreLink,
+	key *[32]byte,
+) error {
+	if len(link.Password) > 0 {
+		cipherData, err := encryption.Encrypt(link.Password, key)
+		if err != nil {
+			return err
+		}
+
+		link.Password = cipherData
+	}
+
+	return nil
+}
+
+// DecryptDatastoreLinkData will decrypt the credentials of the user of a link before
+// returning them from the DB
+func (repo *DatastoreRepository) DecryptDatastoreLinkData(
+	link *models.DatastoreLink,
+	key *[32]byte,
+) error {
+	if len(link.Password) > 0 {
+		plaintext, err := encryption.Decrypt(link.Password, key)
+		if err != nil {
+			return err
+		}
+
+		link.Password = plaintext
+	}
+
+	return nil
+}
@@ -1,6 +1,7 @@
 package gorm
 
 import (
+	"context"
 	"errors"
 
 	"github.com/google/uuid"
@@ -21,10 +22,10 @@ func NewDeploymentTargetRepository(db *gorm.DB) repository.DeploymentTargetRepos
 }
 
 // DeploymentTargetBySelectorAndSelectorType finds a deployment target for a projectID and clusterID by its selector and selector type
-func (repo *DeploymentTargetRepository) DeploymentTargetBySelectorAndSelectorType(projectID uint, clusterID uint, selector, selectorType string) (*models.DeploymentTarget, error) {
+func (repo *DeploymentTargetRepository) DeploymentTargetBySelectorAndSelectorType(ctx context.Context, projectID uint, clusterID uint, selector, selectorType string) (*models.DeploymentTarget, error) {
 	deploymentTarget := &models.DeploymentTarget{}
 
-	if err := repo.db.Where("project_id = ? AND cluster_id = ? AND selector = ? AND selector_type = ?", projectID, clusterID, selector, selectorType).Limit(1).Find(&deploymentTarget).Error; err != nil {
+	if err := repo.db.WithContext(ctx).Where("project_id = ? AND cluster_id = ? AND selector = ? AND selector_type = ?", projectID, clusterID, selector, selectorType).Limit(1).Find(&deploymentTarget).Error; err != nil {
 		return nil, err
 	}
 
@@ -34,3 +35,49 @@ func (repo *DeploymentTargetRepository) DeploymentTargetBySelectorAndSelectorTyp
 
 	return deploymentTarget, nil
 }
+
+// DeploymentTargetByID finds a deployment target in a project by its id
+func (repo *DeploymentTargetRepository) DeploymentTargetByID(ctx context.Context, projectID uint, deploymentTargetID string) (*models.DeploymentTarget, error) {
+	id, err := uuid.Parse(deploymentTargetID)
+	if err != nil {
+		return nil, err
+	}
+
+	deploymentTarget := &models.DeploymentTarget{}
+
+	if err := repo.db.WithContext(ctx).Where("project_id = ? AND id = ?", projectID, id).Limit(1).Find(&deploymentTarget).Error; err != nil {
+		return nil, err
+	}
+
+	if deploymentTarget.ID == uuid.Nil {
+		return nil, errors.New("deployment target not found")
+	}
+
+	return deploymentTarget, nil
+}
+
+// CreateDeploymentTarget creates a new deployment target
+func (repo *DeploymentTargetRepository) CreateDeploymentTarget(ctx context.Context, deploymentTarget *models.DeploymentTarget) (*models.DeploymentTarget, error) {
+	if deploymentTarget == nil {
+		return nil, errors.New("deployment target is nil")
+	}
+
+	if deploymentTarget.ID == uuid.Nil {
+		deploymentTarget.ID = uuid.New()
+	}
+
+	if err := repo.db.WithContext(ctx).Create(deploymentTarget).Error; err != nil {
+		return nil, err
+	}
+
+	return deploymentTarget, nil
+}
+
+// DeleteDeploymentTarget deletes a deployment target
+func (repo *DeploymentTargetRepository) DeleteDeploymentTarget(ctx context.Context, deploymentTarget *models.DeploymentTarget) error {
+	if deploymentTarget == nil {
+		return errors.New("deployment target is nil")
+	}
+
+	return repo.db.WithContext(ctx).Delete(deploymentTarget).Error
+}
@@ -1,6 +1,8 @@
 package gorm
 
 import (
+	"context"
+
 	"github.com/porter-dev/porter/internal/models"
 	"github.com/porter-dev/porter/internal/repository"
 	"gorm.io/gorm"
@@ -18,8 +20,8 @@ func NewDNSRecordRepository(db *gorm.DB) repository.DNSRecordRepository {
 }
 
 // CreateDNSRecord creates a new helm repo
-func (repo *DNSRecordRepository) CreateDNSRecord(record *models.DNSRecord) (*models.DNSRecord, error) {
-	if err := repo.db.Create(record).Error; err != nil {
+func (repo *DNSRecordRepository) CreateDNSRecord(ctx context.Context, record *models.DNSRecord) (*models.DNSRecord, error) {
+	if err := repo.db.WithContext(ctx).Create(record).Error; err != nil {
 		return nil, err
 	}
 
@@ -1,6 +1,7 @@
 package gorm
 
 import (
+	"context"
 	"strings"
 
 	"github.com/porter-dev/porter/internal/models"
@@ -19,26 +20,26 @@ func NewEnvironmentRepository(db *gorm.DB) repository.EnvironmentRepository {
 	return &EnvironmentRepository{db}
 }
 
-func (repo *EnvironmentRepository) CreateEnvironment(env *models.Environment) (*models.Environment, error) {
-	if err := repo.db.Create(env).Error; err != nil {
+func (repo *EnvironmentRepository) CreateEnvironment(ctx context.Context, env *models.Environment) (*models.Environment, error) {
+	if err := repo.db.WithContext(ctx).Create(env).Error; err != nil {
 		return nil, err
 	}
 	return env, nil
 }
 
-func (repo *EnvironmentRepository) ReadEnvironment(projectID, clusterID, gitInstallationID uint, gitRepoOwner, gitRepoName string) (*models.Environment, error) {
+func (repo *EnvironmentRepository) ReadEnvironment(ctx context.Context, projectID, clusterID, gitInstallationID uint, gitRepoOwner, gitRepoName string) (*models.Environment, error) {
 	env := &models.Environment{}
 
-	switch repo.db.Dialector.Name() {
+	switch repo.db.WithContext(ctx).Dialector.Name() {
 	case "sqlite":
-		if err := repo.db.Order("id desc").Where(
+		if err := repo.db.WithContext(ctx).Order("id desc").Where(
 			"project_id = ? AND cluster_id = ? AND git_installation_id = ? AND git_repo_owner LIKE ? AND git_repo_name LIKE ?",
 			projectID, clusterID, gitInstallationID, gitRepoOwner, gitRepoName,
 		).First(&env).Error; err != nil {
 			return nil, err
 		}
 	case "postgres":
-		if err := repo.db.Order("id desc").Where(
+		if err := repo.db.WithContext(ctx).Order("id desc").Where(
 			"project_id = ? AND cluster_id = ? AND git_installation_id = ? AND git_repo_owner iLIKE ? AND git_repo_name iLIKE ?",
 			projectID, clusterID, gitInstallationID, gitRepoOwner, gitRepoName,
 		).First(&env).Error; err != nil {
@@ -49,10 +50,10 @@ func (repo *EnvironmentRepository) ReadEnvironment(projectID, clusterID, gitInst
 	return env, nil
 }
 
-func (repo *EnvironmentRepository) ReadEnvironmentByID(projectID, clusterID, envID uint) (*models.Environment, error) {
+func (repo *EnvironmentRepository) ReadEnvironmentByID(ctx context.Context, projectID, clusterID, envID uint) (*models.Environment, error) {
 	env := &models.Environment{}
 
-	if err := repo.db.Order("id desc").Where(
+	if err := repo.db.WithContext(ctx).Order("id desc").Where(
 		"project_id = ? AND cluster_id = ? AND id = ?",
 		projectID, clusterID, envID,
 	).First(&env).Error; err != nil {
@@ -63,20 +64,21 @@ func (repo *EnvironmentRepository) ReadEnvironmentByID(projectID, clusterID, env
 }
 
 func (repo *EnvironmentRepository) ReadEnvironmentByOwnerRepoName(
+	ctx context.Context,
 	projectID, clusterID uint,
 	gitRepoOwner, gitRepoName string,
 ) (*models.Environment, error) {
 	env := &models.Environment{}
 
-	switch repo.db.Dialector.Name() {
+	switch repo.db.WithContext(ctx).Dialector.Name() {
 	case "sqlite":
-		if err := repo.db.Order("id desc").Where("project_id = ? AND cluster_id = ? AND git_repo_owner LIKE ? AND git_repo_name LIKE ?",
+		if err := repo.db.WithContext(ctx).Order("id desc").Where("project_id = ? AND cluster_id = ? AND git_repo_owner LIKE ? AND git_repo_name LIKE ?",
 			projectID, clusterID, gitRepoOwner, gitRepoName,
 		).First(&env).Error; err != nil {
 			return nil, err
 		}
 	case "postgres":
-		if err := repo.db.Order("id desc").Where("project_id = ? AND cluster_id = ? AND git_repo_owner iLIKE ? AND git_repo_name iLIKE ?",
+		if err := repo.db.WithContext(ctx).Order("id desc").Where("project_id = ? AND cluster_id = ? AND git_repo_owner iLIKE ? AND git_repo_name iLIKE ?",
 			projectID, clusterID, gitRepoOwner, gitRepoName,
 		).First(&env).Error; err != nil {
 			return nil, err
@@ -87,19 +89,20 @@ func (repo *EnvironmentRepository) ReadEnvironmentByOwnerRepoName(
 }
 
 func (repo *EnvironmentRepository) ReadEnvironmentByWebhookIDOwnerRepoName(
+	ctx context.Context,
 	webhookID, gitRepoOwner, gitRepoName string,
 ) (*models.Environment, error) {
 	env := &models.Environment{}
 
-	switch repo.db.Dialector.Name() {
+	switch repo.db.WithContext(ctx).Dialector.Name() {
 	case "sqlite":
-		if err := repo.db.Order("id desc").Where("webhook_id = ? AND git_repo_owner LIKE ? AND git_repo_name LIKE ?",
+		if err := repo.db.WithContext(ctx).Order("id desc").Where("webhook_id = ? AND git_repo_owner LIKE ? AND git_repo_name LIKE ?",
 			webhookID, gitRepoOwner, gitRepoName,
 		).First(&env).Error; err != nil {
 			return nil, err
 		}
 	case "postgres":
-		if err := repo.db.Order("id desc").Where("webhook_id = ? AND git_repo_owner iLIKE ? AND git_repo_name iLIKE ?",
+		if err := repo.db.WithContext(ctx).Order("id desc").Where("webhook_id = ? AND git_repo_owner iLIKE ? AND git_repo_name iLIKE ?",
 			webhookID, gitRepoOwner, gitRepoName,
 		).First(&env).Error; err != nil {
 			return nil, err
@@ -109,58 +112,58 @@ func (repo *EnvironmentRepository) ReadEnvironmentByWebhookIDOwnerRepoName(
 	return env, nil
 }
 
-func (repo *EnvironmentRepository) ListEnvironments(projectID, clusterID uint) ([]*models.Environment, error) {
+func (repo *EnvironmentRepository) ListEnvironments(ctx context.Context, projectID, clusterID uint) ([]*models.Environment, error) {
 	envs := make([]*models.Environment, 0)
 
-	if err := repo.db.Order("id asc").Where("project_id = ? AND cluster_id = ?", projectID, clusterID).Find(&envs).Error; err != nil {
+	if err := repo.db.WithContext(ctx).Order("id asc").Where("project_id = ? AND cluster_id = ?", projectID, clusterID).Find(&envs).Error; err != nil {
 		return nil, err
 	}
 
 	return envs, nil
 }
 
-func (repo *EnvironmentRepository) UpdateEnvironment(environment *models.Environment) (*models.Environment, error) {
-	if err := repo.db.Save(environment).Error; err != nil {
+func (repo *EnvironmentRepository) UpdateEnvironment(ctx context.Context, environment *models.Environment) (*models.Environment, error) {
+	if err := repo.db.WithContext(ctx).Save(environment).Error; err != nil {
 		return nil, err
 	}
 
 	return environment, nil
 }
 
-func (repo *EnvironmentRepository) DeleteEnvironment(env *models.Environment) (*models.Environment, error) {
-	if err := repo.db.Delete(&env).Error; err != nil {
+func (repo *EnvironmentRepository) DeleteEnvironment(ctx context.Context, env *models.Environment) (*models.Environment, error) {
+	if err := repo.db.WithContext(ctx).Delete(&env).Error; err != nil {
 		return nil, err
 	}
 	return env, nil
 }
 
-func (repo *EnvironmentRepository) CreateDeployment(deployment *models.Deployment) (*models.Deployment, error) {
-	if err := repo.db.Create(deployment).Error; err != nil {
+func (repo *EnvironmentRepository) CreateDeployment(ctx context.Context, deployment *models.Deployment) (*models.Deployment, error) {
+	if err := repo.db.WithContext(ctx).Create(deployment).Error; err != nil {
 		return nil, err
 	}
 	return deployment, nil
 }
 
-func (repo *EnvironmentRepository) UpdateDeployment(deployment *models.Deployment) (*models.Deployment, error) {
-	if err := repo.db.Save(deployment).Error; err != nil {
+func (repo *EnvironmentRepository) UpdateDeployment(ctx context.Context, deployment *models.Deployment) (*models.Deployment, error) {
+	if err := repo.db.WithContext(ctx).Save(deployment).Error; err != nil {
 		return nil, err
 	}
 
 	return deployment, nil
 }
 
-func (repo *EnvironmentRepository) ReadDeployment(environmentID uint, namespace string) (*models.Deployment, error) {
+func (repo *EnvironmentRepository) ReadDeployment(ctx context.Context, environmentID uint, namespace string) (*models.Deployment, error) {
 	depl := &models.Deployment{}
-	if err := repo.db.Order("id desc").Where("environment_id = ? AND namespace = ?", environmentID, namespace).First(&depl).Error; err != nil {
+	if err := repo.db.WithContext(ctx).Order("id desc").Where("environment_id = ? AND namespace = ?", environmentID, namespace).First(&depl).Error; err != nil {
 		return nil, err
 	}
 	return depl, nil
 }
 
-func (repo *EnvironmentRepository) ReadDeploymentByID(projectID, clusterID, id uint) (*models.Deployment, error) {
+func (repo *EnvironmentRepository) ReadDeploymentByID(ctx context.Context, projectID, clusterID, id uint) (*models.Deployment, error) {
 	depl := &models.Deployment{}
 
-	if err := repo.db.
+	if err := repo.db.WithContext(ctx).
 		Order("deployments.updated_at desc").
 		Joins("INNER JOIN environments ON environments.id = deployments.environment_id").
 		Where("environments.project_id = ? AND environments.cluster_id = ? AND deployments.id = ?", projectID, clusterID, id).First(&depl).Error; err != nil {
@@ -171,20 +174,21 @@ func (repo *EnvironmentRepository) ReadDeploymentByID(projectID, clusterID, id u
 }
 
 func (repo *EnvironmentRepository) ReadDeploymentByGitDetails(
+	ctx context.Context,
 	environmentID uint, gitRepoOwner, gitRepoName string, prNumber uint,
 ) (*models.Deployment, error) {
 	depl := &models.Deployment{}
 
-	switch repo.db.Dialector.Name() {
+	switch repo.db.WithContext(ctx).Dialector.Name() {
 	case "sqlite":
-		if err := repo.db.Order("id asc").
+		if err := repo.db.WithContext(ctx).Order("id asc").
 			Where("environment_id = ? AND repo_owner LIKE ? AND repo_name LIKE ? AND pull_request_id = ?",
 				environmentID, gitRepoOwner, gitRepoName, prNumber).
 			First(&depl).Error; err != nil {
 			return nil, err
 		}
 	case "postgres":
-		if err := repo.db.Order("id asc").
+		if err := repo.db.WithContext(ctx).Order("id asc").
 			Where("environment_id = ? AND repo_owner iLIKE ? AND repo_name iLIKE ? AND pull_request_id = ?",
 				environmentID, gitRepoOwner, gitRepoName, prNumber).
 			First(&depl).Error; err != nil {
@@ -195,19 +199,19 @@ func (repo *EnvironmentRepository) ReadDeploymentByGitDetails(
 	return depl, nil
 }
 
-func (repo *EnvironmentRepository) ReadDeploymentForBranch(environmentID uint, owner, name, branch string) (*models.Deployment, error) {
+func (repo *EnvironmentRepository) ReadDeploymentForBranch(ctx context.Context, environmentID uint, owner, name, branch string) (*models.Deployment, error) {
 	depl := &models.Deployment{}
 
-	switch repo.db.Dialector.Name() {
+	switch repo.db.WithContext(ctx).Dialector.Name() {
 	case "sqlite":
-		if err := repo.db.Order("id desc").
+		if err := repo.db.WithContext(ctx).Order("id desc").
 			Where("environment_id = ? AND repo_owner LIKE ? AND repo_name LIKE ? AND pr_branch_from = ? AND pr_branch_into = ?",
 				environmentID, owner, name, branch, branch).
 			First(&depl).Error; err != nil {
 			return nil, err
 		}
 	case "postgres":
-		if err := repo.db.Order("id desc").
+		if err := repo.db.WithContext(ctx).Order("id desc").
 			Where("environment_id = ? AND repo_owner iLIKE ? AND repo_name iLIKE ? AND pr_branch_from = ? AND pr_branch_into = ?",
 				environmentID, owner, name, branch, branch).
 			First(&depl).Error; err != nil {
@@ -218,8 +222,8 @@ func (repo *EnvironmentRepository) ReadDeploymentForBranch(environmentID uint, o
 	return depl, nil
 }
 
-func (repo *EnvironmentRepository) ListDeploymentsByCluster(projectID, clusterID uint, states ...string) ([]*models.Deployment, error) {
-	query := repo.db.
+func (repo *EnvironmentRepository) ListDeploymentsByCluster(ctx context.Context, projectID, clusterID uint, states ...string) ([]*models.Deployment, error) {
+	query := repo.db.WithContext(ctx).
 		Order("deployments.updated_at desc").
 		Joins("INNER JOIN environments ON environments.id = deployments.environment_id").
 		Where("environments.project_id = ? AND environments.cluster_id = ? AND environments.deleted_at IS NULL", projectID, clusterID)
@@ -245,8 +249,8 @@ func (repo *EnvironmentRepository) ListDeploymentsByCluster(projectID, clusterID
 	return depls, nil
 }
 
-func (repo *EnvironmentRepository) ListDeployments(environmentID uint, states ...string) ([]*models.Deployment, error) {
-	query := repo.db.Debug().Order("deployments.updated_at desc").Where("environment_id = ?", environmentID)
+func (repo *EnvironmentRepository) ListDeployments(ctx context.Context, environmentID uint, states ...string) ([]*models.Deployment, error) {
+	query := repo.db.WithContext(ctx).Debug().Order("deployments.updated_at desc").Where("environment_id = ?", environmentID)
 
 	if len(states) > 0 {
 		queryArr := make([]string, len(states))
@@ -269,8 +273,8 @@ func (repo *EnvironmentRepository) ListDeployments(environmentID uint, states ..
 	return depls, nil
 }
 
-func (repo *EnvironmentRepository) DeleteDeployment(deployment *models.Deployment) (*models.Deployment, error) {
-	if err := repo.db.Delete(deployment).Error; err != nil {
+func (repo *EnvironmentRepository) DeleteDeployment(ctx context.Context, deployment *models.Deployment) (*models.Deployment, error) {
+	if err := repo.db.WithContext(ctx).Delete(deployment).Error; err != nil {
 		return nil, err
 	}
 	return deployment, nil
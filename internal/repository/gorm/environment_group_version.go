@@ -0,0 +1,65 @@
+package gorm
+
+import (
+	"context"
+
+	"github.com/porter-dev/porter/internal/models"
+	"github.com/porter-dev/porter/internal/repository"
+	"gorm.io/gorm"
+)
+
+// EnvironmentGroupVersionRepository uses gorm.DB for querying the database
+type EnvironmentGroupVersionRepository struct {
+	db *gorm.DB
+}
+
+// NewEnvironmentGroupVersionRepository returns an EnvironmentGroupVersionRepository which uses
+// gorm.DB for querying the database
+func NewEnvironmentGroupVersionRepository(db *gorm.DB) repository.EnvironmentGroupVersionRepository {
+	return &EnvironmentGroupVersionRepository{db}
+}
+
+// CreateEnvironmentGroupVersion creates a new environment group version
+func (repo *EnvironmentGroupVersionRepository) CreateEnvironmentGroupVersion(ctx context.Context, version *models.EnvironmentGroupVersion) (*models.EnvironmentGroupVersion, error) {
+	if err := repo.db.WithContext(ctx).Create(version).Error; err != nil {
+		return nil, err
+	}
+
+	return version, nil
+}
+
+// UpdateEnvironmentGroupVersion updates an existing environment group version
+func (repo *EnvironmentGroupVersionRepository) UpdateEnvironmentGroupVersion(ctx context.Context, version *models.EnvironmentGroupVersion) (*models.EnvironmentGroupVersion, error) {
+	if err := repo.db.WithContext(ctx).Save(version).Error; err != nil {
+		return nil, err
+	}
+
+	return version, nil
+}
+
+// ReadEnvironmentGroupVersion finds a single version of an environment group in a cluster
+func (repo *EnvironmentGroupVersionRepository) ReadEnvironmentGroupVersion(ctx context.Context, clusterID uint, name string, version int) (*models.EnvironmentGroupVersion, error) {
+	envGroupVersion := &models.EnvironmentGroupVersion{}
+
+	if err := repo.db.WithContext(ctx).Where("cluster_id = ? AND name = ? AND version = ?", clusterID, name, version).First(envGroupVersion).Error; err != nil {
+		return nil, err
+	}
+
+	return envGroupVersion, nil
+}
+
+// ListEnvironmentGroupVersions lists the versions of an environment group in a cluster, most recent first
+func (repo *EnvironmentGroupVersionRepository) ListEnvironmentGroupVersions(ctx context.Context, clusterID uint, name string) ([]*models.EnvironmentGroupVersion, error) {
+	versions := []*models.EnvironmentGroupVersion{}
+
+	if err := repo.db.WithContext(ctx).Where("cluster_id = ? AND name = ?", clusterID, name).Order("version desc").Find(&versions).Error; err != nil {
+		return nil, err
+	}
+
+	return versions, nil
+}
+
+// DeleteEnvironmentGroupVersions deletes all versions of an environment group in a cluster
+func (repo *EnvironmentGroupVersionRepository) DeleteEnvironmentGroupVersions(ctx context.Context, clusterID uint, name string) error {
+	return repo.db.WithContext(ctx).Where("cluster_id = ? AND name = ?", clusterID, name).Delete(&models.EnvironmentGroupVersion{}).Error
+}
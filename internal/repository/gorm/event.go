@@ -1,90 +1,212 @@
 package gorm
 
 import (
+	"context"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/hex"
+	"errors"
 	"strings"
 	"time"
 
 	"github.com/porter-dev/porter/api/types"
+	"github.com/porter-dev/porter/internal/encryption"
 	"github.com/porter-dev/porter/internal/models"
 	"github.com/porter-dev/porter/internal/repository"
 	"gorm.io/gorm"
 )
 
+// encryptedSubEventInfoPrefix marks sub event info which has been encrypted. Info is stored as a
+// base64-encoded ciphertext after the prefix, so that rows written before encryption was introduced
+// can still be read as plaintext.
+const encryptedSubEventInfoPrefix = "enc:v1:"
+
 // BuildEventRepository holds both EventContainer and SubEvent models
 type BuildEventRepository struct {
-	db *gorm.DB
+	db  *gorm.DB
+	key *[32]byte
 }
 
 // NewBuildEventRepository returns a BuildEventRepository which uses
-// gorm.DB for querying the database
-func NewBuildEventRepository(db *gorm.DB) repository.BuildEventRepository {
-	return &BuildEventRepository{db}
+// gorm.DB for querying the database. It accepts an encryption key to encrypt
+// sub event info
+func NewBuildEventRepository(db *gorm.DB, key *[32]byte) repository.BuildEventRepository {
+	return &BuildEventRepository{db, key}
 }
 
-func (repo BuildEventRepository) CreateEventContainer(am *models.EventContainer) (*models.EventContainer, error) {
-	if err := repo.db.Create(am).Error; err != nil {
+func (repo BuildEventRepository) CreateEventContainer(ctx context.Context, am *models.EventContainer) (*models.EventContainer, error) {
+	if err := repo.db.WithContext(ctx).Create(am).Error; err != nil {
 		return nil, err
 	}
 	return am, nil
 }
 
-func (repo BuildEventRepository) CreateSubEvent(am *models.SubEvent) (*models.SubEvent, error) {
-	if err := repo.db.Create(am).Error; err != nil {
+func (repo BuildEventRepository) CreateSubEvent(ctx context.Context, am *models.SubEvent) (*models.SubEvent, error) {
+	if err := repo.EncryptSubEventData(am, repo.key); err != nil {
+		return nil, err
+	}
+
+	if err := repo.db.WithContext(ctx).Create(am).Error; err != nil {
+		return nil, err
+	}
+
+	if err := repo.DecryptSubEventData(am, repo.key); err != nil {
 		return nil, err
 	}
+
 	return am, nil
 }
 
-func (repo BuildEventRepository) ReadEventsByContainerID(id uint) ([]*models.SubEvent, error) {
+func (repo BuildEventRepository) ReadEventsByContainerID(ctx context.Context, id uint) ([]*models.SubEvent, error) {
 	var events []*models.SubEvent
-	if err := repo.db.Where("event_container_id = ?", id).Find(&events).Error; err != nil {
+	if err := repo.db.WithContext(ctx).Where("event_container_id = ?", id).Find(&events).Error; err != nil {
 		return nil, err
 	}
+
+	for _, event := range events {
+		if err := repo.DecryptSubEventData(event, repo.key); err != nil {
+			return nil, err
+		}
+	}
+
 	return events, nil
 }
 
-func (repo BuildEventRepository) ReadEventContainer(id uint) (*models.EventContainer, error) {
+func (repo BuildEventRepository) ReadEventContainer(ctx context.Context, id uint) (*models.EventContainer, error) {
 	container := &models.EventContainer{}
-	if err := repo.db.Where("id = ?", id).First(&container).Error; err != nil {
+	if err := repo.db.WithContext(ctx).Where("id = ?", id).First(&container).Error; err != nil {
 		return nil, err
 	}
 	return container, nil
 }
 
-func (repo BuildEventRepository) ReadSubEvent(id uint) (*models.SubEvent, error) {
+func (repo BuildEventRepository) ReadSubEvent(ctx context.Context, id uint) (*models.SubEvent, error) {
 	event := &models.SubEvent{}
-	if err := repo.db.Where("id = ?", id).First(&event).Error; err != nil {
+	if err := repo.db.WithContext(ctx).Where("id = ?", id).First(&event).Error; err != nil {
 		return nil, err
 	}
+
+	if err := repo.DecryptSubEventData(event, repo.key); err != nil {
+		return nil, err
+	}
+
 	return event, nil
 }
 
 // AppendEvent will check if subevent with same (id, index) already exists
 // if yes, overrite it, otherwise make a new subevent
-func (repo BuildEventRepository) AppendEvent(container *models.EventContainer, event *models.SubEvent) error {
+func (repo BuildEventRepository) AppendEvent(ctx context.Context, container *models.EventContainer, event *models.SubEvent) error {
 	event.EventContainerID = container.ID
-	return repo.db.Create(event).Error
+
+	if err := repo.EncryptSubEventData(event, repo.key); err != nil {
+		return err
+	}
+
+	if err := repo.db.WithContext(ctx).Create(event).Error; err != nil {
+		return err
+	}
+
+	return repo.DecryptSubEventData(event, repo.key)
+}
+
+// ListEventContainersByPorterAppID returns the most recent event containers of a kind of a porter app, newest first
+func (repo BuildEventRepository) ListEventContainersByPorterAppID(ctx context.Context, porterAppID uint, kind models.EventContainerKind, limit int) ([]*models.EventContainer, error) {
+	containers := []*models.EventContainer{}
+	if err := repo.db.WithContext(ctx).Where("porter_app_id = ? AND kind = ?", porterAppID, kind).Order("id desc").Limit(limit).Find(&containers).Error; err != nil {
+		return nil, err
+	}
+
+	return containers, nil
+}
+
+// ListSubEventsAfterIndex returns the sub events of a container with an index greater than afterIndex, ordered by index
+func (repo BuildEventRepository) ListSubEventsAfterIndex(ctx context.Context, containerID uint, afterIndex int64) ([]*models.SubEvent, error) {
+	var events []*models.SubEvent
+	if err := repo.db.WithContext(ctx).Where(`event_container_id = ? AND "index" > ?`, containerID, afterIndex).Order(`"index" asc`).Find(&events).Error; err != nil {
+		return nil, err
+	}
+
+	for _, event := range events {
+		if err := repo.DecryptSubEventData(event, repo.key); err != nil {
+			return nil, err
+		}
+	}
+
+	return events, nil
+}
+
+// EncryptSubEventData will encrypt the sub event info before writing to the DB. Info which is
+// already encrypted is left unchanged.
+func (repo BuildEventRepository) EncryptSubEventData(
+	event *models.SubEvent,
+	key *[32]byte,
+) error {
+	if event.Info == "" || strings.HasPrefix(event.Info, encryptedSubEventInfoPrefix) {
+		return nil
+	}
+
+	cipherData, err := encryption.Encrypt([]byte(event.Info), key)
+	if err != nil {
+		return err
+	}
+
+	event.Info = encryptedSubEventInfoPrefix + base64.StdEncoding.EncodeToString(cipherData)
+
+	return nil
+}
+
+// DecryptSubEventData will decrypt the sub event info before returning it from the DB. Info which
+// was stored before encryption was introduced is returned as-is.
+func (repo BuildEventRepository) DecryptSubEventData(
+	event *models.SubEvent,
+	key *[32]byte,
+) error {
+	if !strings.HasPrefix(event.Info, encryptedSubEventInfoPrefix) {
+		return nil
+	}
+
+	cipherData, err := base64.StdEncoding.DecodeString(strings.TrimPrefix(event.Info, encryptedSubEventInfoPrefix))
+	if err != nil {
+		return err
+	}
+
+	plaintext, err := encryption.Decrypt(cipherData, key)
+	if err != nil {
+		return err
+	}
+
+	event.Info = string(plaintext)
+
+	return nil
 }
 
 // KubeEventRepository uses gorm.DB for querying the database
 type KubeEventRepository struct {
 	db  *gorm.DB
 	key *[32]byte
+
+	// readDB is the database which heavy list queries read from, which may be a read replica
+	readDB *gorm.DB
 }
 
 // NewKubeEventRepository returns an KubeEventRepository which uses
 // gorm.DB for querying the database. It accepts an encryption key to encrypt
 // sensitive data
-func NewKubeEventRepository(db *gorm.DB, key *[32]byte) repository.KubeEventRepository {
-	return &KubeEventRepository{db, key}
+func NewKubeEventRepository(db *gorm.DB, key *[32]byte, opts ...RepositoryOption) repository.KubeEventRepository {
+	return &KubeEventRepository{
+		db:     db,
+		key:    key,
+		readDB: newRepositoryOptions(opts).readDB(db, ReadReplicaKubeEvent),
+	}
 }
 
 // CreateEvent creates a new kube auth mechanism
 func (repo *KubeEventRepository) CreateEvent(
+	ctx context.Context,
 	event *models.KubeEvent,
 ) (*models.KubeEvent, error) {
 	// read the count of the events in the DB
-	query := repo.db.Where("project_id = ? AND cluster_id = ?", event.ProjectID, event.ClusterID)
+	query := repo.db.WithContext(ctx).Where("project_id = ? AND cluster_id = ?", event.ProjectID, event.ClusterID)
 
 	var count int64
 
@@ -96,7 +218,7 @@ func (repo *KubeEventRepository) CreateEvent(
 	// basic fixed-length buffer
 	if count >= 500 {
 		// first, delete the matching sub events
-		err := repo.db.Exec(`
+		err := repo.db.WithContext(ctx).Exec(`
 		  DELETE FROM kube_sub_events 
 		  WHERE kube_event_id IN (
 			SELECT id FROM kube_events k2 WHERE (k2.project_id = ? AND k2.cluster_id = ?) AND k2.id NOT IN (
@@ -109,7 +231,7 @@ func (repo *KubeEventRepository) CreateEvent(
 		}
 
 		// then, delete the matching events
-		err = repo.db.Exec(`
+		err = repo.db.WithContext(ctx).Exec(`
 		  DELETE FROM kube_events 
 		  WHERE (project_id = ? AND cluster_id = ?) AND id NOT IN (
 			SELECT id FROM kube_events k2 WHERE (k2.project_id = ? AND k2.cluster_id = ?) ORDER BY k2.updated_at desc, k2.id desc LIMIT 499
@@ -121,7 +243,7 @@ func (repo *KubeEventRepository) CreateEvent(
 		}
 	}
 
-	if err := repo.db.Create(event).Error; err != nil {
+	if err := repo.db.WithContext(ctx).Create(event).Error; err != nil {
 		return nil, err
 	}
 
@@ -130,11 +252,12 @@ func (repo *KubeEventRepository) CreateEvent(
 
 // ReadEvent finds an event by id
 func (repo *KubeEventRepository) ReadEvent(
+	ctx context.Context,
 	id, projID, clusterID uint,
 ) (*models.KubeEvent, error) {
 	event := &models.KubeEvent{}
 
-	if err := repo.db.Preload("SubEvents").Where(
+	if err := repo.db.WithContext(ctx).Preload("SubEvents").Where(
 		"id = ? AND project_id = ? AND cluster_id = ?",
 		id,
 		projID,
@@ -148,13 +271,14 @@ func (repo *KubeEventRepository) ReadEvent(
 
 // ReadEventByGroup finds an event by a set of options which group events together
 func (repo *KubeEventRepository) ReadEventByGroup(
+	ctx context.Context,
 	projID uint,
 	clusterID uint,
 	opts *types.GroupOptions,
 ) (*models.KubeEvent, error) {
 	event := &models.KubeEvent{}
 
-	query := repo.db.Preload("SubEvents").
+	query := repo.db.WithContext(ctx).Preload("SubEvents").
 		Where("project_id = ? AND cluster_id = ? AND name = ? AND LOWER(resource_type) = LOWER(?)", projID, clusterID, opts.Name, opts.ResourceType)
 
 	// construct query for timestamp
@@ -177,12 +301,15 @@ func (repo *KubeEventRepository) ReadEventByGroup(
 }
 
 // ListEventsByProjectID finds all events for a given project id
-// with the given options
+// with the given options. Events are ordered by the time they were last
+// updated, and the returned cursor lists the next page of events, which
+// is empty on the last page.
 func (repo *KubeEventRepository) ListEventsByProjectID(
+	ctx context.Context,
 	projectID uint,
 	clusterID uint,
 	opts *types.ListKubeEventRequest,
-) ([]*models.KubeEvent, int64, error) {
+) ([]*models.KubeEvent, int64, string, error) {
 	listOpts := opts
 
 	if listOpts.Limit == 0 {
@@ -192,7 +319,7 @@ func (repo *KubeEventRepository) ListEventsByProjectID(
 	events := []*models.KubeEvent{}
 
 	// preload the subevents
-	query := repo.db.Preload("SubEvents").Where("project_id = ? AND cluster_id = ?", projectID, clusterID)
+	query := repo.readDB.WithContext(ctx).Preload("SubEvents").Where("project_id = ? AND cluster_id = ?", projectID, clusterID)
 
 	if listOpts.OwnerName != "" && listOpts.OwnerType != "" {
 		query = query.Where(
@@ -220,25 +347,124 @@ func (repo *KubeEventRepository) ListEventsByProjectID(
 	var count int64
 
 	if err := query.Model([]*models.KubeEvent{}).Count(&count).Error; err != nil {
-		return nil, 0, err
+		return nil, 0, "", err
 	}
 
-	query = query.Order("updated_at desc").Order("id desc").Limit(listOpts.Limit).Offset(listOpts.Skip)
+	if listOpts.Cursor != "" {
+		updatedAt, id, err := types.DecodeKubeEventCursor(listOpts.Cursor)
+		if err != nil {
+			return nil, 0, "", err
+		}
+
+		// seek past the cursor rather than skipping rows, so that later pages are as fast as the first
+		query = query.Where("updated_at < ? OR (updated_at = ? AND id < ?)", updatedAt, updatedAt, id)
+	} else {
+		query = query.Offset(listOpts.Skip)
+	}
+
+	// an extra event is queried to know whether there is a next page
+	query = query.Order("updated_at desc").Order("id desc").Limit(listOpts.Limit + 1)
 
 	if err := query.Find(&events).Error; err != nil {
-		return nil, 0, err
+		return nil, 0, "", err
+	}
+
+	var nextCursor string
+
+	if len(events) > listOpts.Limit {
+		events = events[:listOpts.Limit]
+		last := events[len(events)-1]
+		nextCursor = types.EncodeKubeEventCursor(last.UpdatedAt, last.ID)
+	}
+
+	return events, count, nextCursor, nil
+}
+
+// SearchEvents finds the events of a cluster whose name, or the message or reason of one of
+// their sub events, matches a query. Postgres matches words with a full-text search, while
+// sqlite falls back to a case-insensitive substring match.
+func (repo *KubeEventRepository) SearchEvents(
+	ctx context.Context,
+	projectID uint,
+	clusterID uint,
+	opts *types.SearchKubeEventsRequest,
+) ([]*models.KubeEvent, error) {
+	limit := opts.Limit
+
+	if limit == 0 {
+		limit = 50
+	}
+
+	events := []*models.KubeEvent{}
+
+	query := repo.readDB.WithContext(ctx).Preload("SubEvents").Where("project_id = ? AND cluster_id = ?", projectID, clusterID)
+
+	switch repo.readDB.Dialector.Name() {
+	case "postgres":
+		query = query.Where(`
+		  to_tsvector('simple', name) @@ plainto_tsquery('simple', ?) OR id IN (
+			SELECT kube_event_id FROM kube_sub_events
+			WHERE deleted_at IS NULL AND to_tsvector('simple', message || ' ' || reason) @@ plainto_tsquery('simple', ?)
+		  )
+		`, opts.Query, opts.Query)
+	default:
+		pattern := "%" + strings.ToLower(opts.Query) + "%"
+
+		query = query.Where(`
+		  LOWER(name) LIKE ? OR id IN (
+			SELECT kube_event_id FROM kube_sub_events
+			WHERE deleted_at IS NULL AND (LOWER(message) LIKE ? OR LOWER(reason) LIKE ?)
+		  )
+		`, pattern, pattern, pattern)
+	}
+
+	if !opts.Since.IsZero() {
+		query = query.Where("updated_at >= ?", opts.Since)
+	}
+
+	if err := query.Order("updated_at desc").Order("id desc").Limit(limit).Find(&events).Error; err != nil {
+		return nil, err
 	}
 
-	return events, count, nil
+	return events, nil
 }
 
-// AppendSubEvent will add a subevent to an existing event
-func (repo *KubeEventRepository) AppendSubEvent(event *models.KubeEvent, subEvent *models.KubeSubEvent) error {
+// subEventDedupWindow is how long after the latest occurrence of a sub event an identical sub event,
+// with the same reason and message, is counted as another occurrence rather than stored as a new sub event
+const subEventDedupWindow = 10 * time.Minute
+
+// AppendSubEvent will add a subevent to an existing event. Repeated sub events, such as a pod
+// backing off hundreds of times, increment the occurrence count of the latest identical sub event
+// instead of being stored again.
+func (repo *KubeEventRepository) AppendSubEvent(ctx context.Context, event *models.KubeEvent, subEvent *models.KubeSubEvent) error {
 	subEvent.KubeEventID = event.ID
+	subEvent.MessageHash = hashSubEventMessage(subEvent.Message)
+	subEvent.Severity = types.ClassifyKubeEventSeverity(subEvent.EventType, subEvent.Reason)
+
+	if subEvent.Count == 0 {
+		subEvent.Count = 1
+	}
+
+	repeated := &models.KubeSubEvent{}
+
+	err := repo.db.WithContext(ctx).Where(
+		"kube_event_id = ? AND reason = ? AND message_hash = ? AND updated_at >= ?",
+		event.ID,
+		subEvent.Reason,
+		subEvent.MessageHash,
+		time.Now().Add(-subEventDedupWindow),
+	).Order("updated_at desc").First(repeated).Error
+
+	switch {
+	case err == nil:
+		return repo.aggregateSubEvent(ctx, event, repeated, subEvent)
+	case !errors.Is(err, gorm.ErrRecordNotFound):
+		return err
+	}
 
 	var count int64
 
-	query := repo.db.Where("kube_event_id = ?", event.ID)
+	query := repo.db.WithContext(ctx).Where("kube_event_id = ?", event.ID)
 
 	if err := query.Model([]*models.KubeSubEvent{}).Count(&count).Error; err != nil {
 		return err
@@ -247,7 +473,7 @@ func (repo *KubeEventRepository) AppendSubEvent(event *models.KubeEvent, subEven
 	// if the count is greater than 20, remove the lowest-order events to implement a
 	// basic fixed-length buffer
 	if count >= 20 {
-		err := repo.db.Exec(`
+		err := repo.db.WithContext(ctx).Exec(`
 			  DELETE FROM kube_sub_events 
 			  WHERE kube_event_id = ? AND 
 			  id NOT IN (
@@ -267,12 +493,12 @@ func (repo *KubeEventRepository) AppendSubEvent(event *models.KubeEvent, subEven
 		},
 	}
 
-	if err := repo.db.Model(shallowCopy).Association("SubEvents").Append(subEvent); err != nil {
+	if err := repo.db.WithContext(ctx).Model(shallowCopy).Association("SubEvents").Append(subEvent); err != nil {
 		return err
 	}
 
 	// only update the updated_at field for the event
-	if err := repo.db.Model(shallowCopy).Update("updated_at", time.Now()).Error; err != nil {
+	if err := repo.db.WithContext(ctx).Model(shallowCopy).Update("updated_at", time.Now()).Error; err != nil {
 		return err
 	}
 
@@ -282,11 +508,67 @@ func (repo *KubeEventRepository) AppendSubEvent(event *models.KubeEvent, subEven
 	return nil
 }
 
+// aggregateSubEvent counts a sub event as another occurrence of an identical sub event, and
+// populates the sub event with the aggregated sub event
+func (repo *KubeEventRepository) aggregateSubEvent(
+	ctx context.Context,
+	event *models.KubeEvent,
+	repeated *models.KubeSubEvent,
+	subEvent *models.KubeSubEvent,
+) error {
+	updates := map[string]interface{}{
+		"count":      gorm.Expr("count + ?", subEvent.Count),
+		"updated_at": time.Now(),
+	}
+
+	if !subEvent.Timestamp.IsZero() {
+		updates["timestamp"] = subEvent.Timestamp
+	}
+
+	if err := repo.db.WithContext(ctx).Model(repeated).Updates(updates).Error; err != nil {
+		return err
+	}
+
+	if err := repo.db.WithContext(ctx).First(repeated, repeated.ID).Error; err != nil {
+		return err
+	}
+
+	shallowCopy := &models.KubeEvent{
+		Model: gorm.Model{
+			ID: event.ID,
+		},
+	}
+
+	// only update the updated_at field for the event
+	if err := repo.db.WithContext(ctx).Model(shallowCopy).Update("updated_at", time.Now()).Error; err != nil {
+		return err
+	}
+
+	*subEvent = *repeated
+
+	for i := range event.SubEvents {
+		if event.SubEvents[i].ID == repeated.ID {
+			event.SubEvents[i] = *repeated
+		}
+	}
+
+	event.UpdatedAt = shallowCopy.UpdatedAt
+
+	return nil
+}
+
+// hashSubEventMessage returns the hash which identifies repeated sub event messages
+func hashSubEventMessage(message string) string {
+	sum := sha256.Sum256([]byte(message))
+	return hex.EncodeToString(sum[:])
+}
+
 // DeleteEvent deletes an event by ID
 func (repo *KubeEventRepository) DeleteEvent(
+	ctx context.Context,
 	id uint,
 ) error {
-	return deleteEventPermanently(id, repo.db)
+	return deleteEventPermanently(id, repo.db.WithContext(ctx))
 }
 
 func deleteEventPermanently(id uint, db *gorm.DB) error {
@@ -0,0 +1,120 @@
+package gorm
+
+import (
+	"context"
+
+	"github.com/porter-dev/porter/internal/encryption"
+	"github.com/porter-dev/porter/internal/models"
+	"github.com/porter-dev/porter/internal/repository"
+	"gorm.io/gorm"
+)
+
+// EventSinkRepository uses gorm.DB for querying the database
+type EventSinkRepository struct {
+	db  *gorm.DB
+	key *[32]byte
+}
+
+// NewEventSinkRepository returns an EventSinkRepository which uses
+// gorm.DB for querying the database. It accepts an encryption key to encrypt
+// sink configs
+func NewEventSinkRepository(db *gorm.DB, key *[32]byte) repository.EventSinkRepository {
+	return &EventSinkRepository{db, key}
+}
+
+// CreateEventSink creates a new event sink
+func (repo *EventSinkRepository) CreateEventSink(ctx context.Context, sink *models.EventSink) (*models.EventSink, error) {
+	err := repo.EncryptEventSinkData(sink, repo.key)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := repo.db.WithContext(ctx).Create(sink).Error; err != nil {
+		return nil, err
+	}
+
+	err = repo.DecryptEventSinkData(sink, repo.key)
+	if err != nil {
+		return nil, err
+	}
+
+	return sink, nil
+}
+
+// ReadEventSink finds an event sink by project id and sink id
+func (repo *EventSinkRepository) ReadEventSink(ctx context.Context, projectID, sinkID uint) (*models.EventSink, error) {
+	sink := &models.EventSink{}
+
+	if err := repo.db.WithContext(ctx).Where("project_id = ? AND id = ?", projectID, sinkID).First(sink).Error; err != nil {
+		return nil, err
+	}
+
+	err := repo.DecryptEventSinkData(sink, repo.key)
+	if err != nil {
+		return nil, err
+	}
+
+	return sink, nil
+}
+
+// ListEventSinksByProjectID finds all event sinks for a given project id
+func (repo *EventSinkRepository) ListEventSinksByProjectID(ctx context.Context, projectID uint) ([]*models.EventSink, error) {
+	sinks := []*models.EventSink{}
+
+	if err := repo.db.WithContext(ctx).Where("project_id = ?", projectID).Order("id asc").Find(&sinks).Error; err != nil {
+		return nil, err
+	}
+
+	for _, sink := range sinks {
+		err := repo.DecryptEventSinkData(sink, repo.key)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return sinks, nil
+}
+
+// DeleteEventSink deletes an event sink
+func (repo *EventSinkRepository) DeleteEventSink(ctx context.Context, sink *models.EventSink) error {
+	if err := repo.db.WithContext(ctx).Delete(sink).Error; err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// EncryptEventSinkData will encrypt the sink config before writing it to the DB
+func (repo *EventSinkRepository) EncryptEventSinkData(
+	sink *models.EventSink,
+	key *[32]byte,
+) error {
+	if len(sink.Config) > 0 {
+		cipherData, err := encryption.Encrypt(sink.Config, key)
+		if err != nil {
+			return err
+		}
+
+		sink.Config = cipherData
+	}
+
+	return nil
+}
+
+// DecryptEventSinkData will decrypt the sink config before
+// returning it from the DB
+func (repo *EventSinkRepository) DecryptEventSinkData(
+	sink *models.EventSink,
+	key *[32]byte,
+) error {
+	if len(sink.Config) > 0 {
+		plaintext, err := encryption.Decrypt(sink.Config, key)
+		if err != nil {
+			return err
+		}
+
+		sink.Config = plaintext
+	}
+
+	return nil
+}
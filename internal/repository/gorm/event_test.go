@@ -1,6 +1,7 @@
 package gorm_test
 
 import (
+	"context"
 	"fmt"
 	"testing"
 	"time"
@@ -31,7 +32,7 @@ func TestCreateKubeEvent(t *testing.T) {
 
 	copyKubeEvent := *event
 
-	event, err := tester.repo.KubeEvent().CreateEvent(event)
+	event, err := tester.repo.KubeEvent().CreateEvent(context.Background(), event)
 	if err != nil {
 		t.Fatalf("%v\n", err)
 	}
@@ -47,15 +48,18 @@ func TestCreateKubeEvent(t *testing.T) {
 	copySubEvent := *subEvent
 	copySubEvent.KubeEventID = 1
 
-	err = tester.repo.KubeEvent().AppendSubEvent(event, subEvent)
+	err = tester.repo.KubeEvent().AppendSubEvent(context.Background(), event, subEvent)
 
 	if err != nil {
 		t.Fatalf("%v\n", err)
 	}
 
+	copySubEvent.MessageHash = subEvent.MessageHash
+	copySubEvent.Count = 1
+	copySubEvent.Severity = types.KubeEventSeverity_Critical
 	copyKubeEvent.SubEvents = []models.KubeSubEvent{copySubEvent}
 
-	event, err = tester.repo.KubeEvent().ReadEvent(event.Model.ID, 1, 1)
+	event, err = tester.repo.KubeEvent().ReadEvent(context.Background(), event.Model.ID, 1, 1)
 
 	if err != nil {
 		t.Fatalf("%v\n", err)
@@ -75,6 +79,73 @@ func TestCreateKubeEvent(t *testing.T) {
 	}
 }
 
+func TestAppendRepeatedSubEvent(t *testing.T) {
+	suffix, _ := encryption.GenerateRandomBytes(4)
+
+	tester := &tester{
+		dbFileName: fmt.Sprintf("./porter_repeated_sub_event_%s.db", suffix),
+	}
+
+	setupTestEnv(tester, t)
+	initProject(tester, t)
+	initCluster(tester, t)
+	defer cleanup(tester, t)
+
+	event, err := tester.repo.KubeEvent().CreateEvent(context.Background(), &models.KubeEvent{
+		ProjectID: tester.initProjects[0].Model.ID,
+		ClusterID: tester.initClusters[0].Model.ID,
+		Name:      "pod-example-1",
+		Namespace: "default",
+	})
+	if err != nil {
+		t.Fatalf("%v\n", err)
+	}
+
+	lastSeen := time.Now()
+
+	for i := 0; i < 500; i++ {
+		lastSeen = lastSeen.Add(time.Second)
+
+		err := tester.repo.KubeEvent().AppendSubEvent(context.Background(), event, &models.KubeSubEvent{
+			EventType: "normal",
+			Message:   "Back-off restarting failed container",
+			Reason:    "BackOff",
+			Timestamp: lastSeen,
+		})
+		if err != nil {
+			t.Fatalf("%v\n", err)
+		}
+	}
+
+	// a different message is stored as a new sub event
+	err = tester.repo.KubeEvent().AppendSubEvent(context.Background(), event, &models.KubeSubEvent{
+		EventType: "normal",
+		Message:   "Back-off pulling image",
+		Reason:    "BackOff",
+		Timestamp: lastSeen,
+	})
+	if err != nil {
+		t.Fatalf("%v\n", err)
+	}
+
+	event, err = tester.repo.KubeEvent().ReadEvent(context.Background(), event.Model.ID, 1, 1)
+	if err != nil {
+		t.Fatalf("%v\n", err)
+	}
+
+	if len(event.SubEvents) != 2 {
+		t.Fatalf("expected repeated sub events to be aggregated into 2 sub events, got %d", len(event.SubEvents))
+	}
+
+	if event.SubEvents[0].Count != 500 || !event.SubEvents[0].Timestamp.Equal(lastSeen) {
+		t.Errorf("expected 500 occurrences last seen at %s, got %d occurrences last seen at %s", lastSeen, event.SubEvents[0].Count, event.SubEvents[0].Timestamp)
+	}
+
+	if event.SubEvents[1].Count != 1 {
+		t.Errorf("expected 1 occurrence of the new sub event, got %d", event.SubEvents[1].Count)
+	}
+}
+
 func TestReadKubeEventsByGroup(t *testing.T) {
 	suffix, _ := encryption.GenerateRandomBytes(4)
 
@@ -89,6 +160,7 @@ func TestReadKubeEventsByGroup(t *testing.T) {
 	defer cleanup(tester, t)
 
 	event, err := tester.repo.KubeEvent().ReadEventByGroup(
+		context.Background(),
 		tester.initProjects[0].Model.ID,
 		tester.initClusters[0].Model.ID,
 		&types.GroupOptions{
@@ -148,6 +220,133 @@ func TestListKubeEventsByProjectIDWithSkip(t *testing.T) {
 	}, tester.initKubeEvents[10:35])
 }
 
+func TestListKubeEventsByProjectIDWithCursor(t *testing.T) {
+	suffix, _ := encryption.GenerateRandomBytes(4)
+
+	tester := &tester{
+		dbFileName: fmt.Sprintf("./porter_list_events_%s.db", suffix),
+	}
+
+	setupTestEnv(tester, t)
+	initProject(tester, t)
+	initCluster(tester, t)
+	initKubeEvents(tester, t)
+	defer cleanup(tester, t)
+
+	opts := &types.ListKubeEventRequest{
+		Limit:        25,
+		ResourceType: "pod",
+	}
+
+	events, count, cursor, err := tester.repo.KubeEvent().ListEventsByProjectID(context.Background(), tester.initProjects[0].Model.ID, 1, opts)
+	if err != nil {
+		t.Fatalf("%v\n", err)
+	}
+
+	if count != 50 || cursor == "" {
+		t.Fatalf("expected a count of 50 and a next cursor, got %d and %q", count, cursor)
+	}
+
+	if diff := deep.Equal(tester.initKubeEvents[0:25], events); diff != nil {
+		t.Errorf("incorrect events")
+		t.Error(diff)
+	}
+
+	// skip is ignored when a cursor is set
+	opts.Cursor = cursor
+	opts.Skip = 10
+
+	events, _, cursor, err = tester.repo.KubeEvent().ListEventsByProjectID(context.Background(), tester.initProjects[0].Model.ID, 1, opts)
+	if err != nil {
+		t.Fatalf("%v\n", err)
+	}
+
+	if cursor != "" {
+		t.Errorf("expected no next cursor on the last page, got %q", cursor)
+	}
+
+	if diff := deep.Equal(tester.initKubeEvents[25:50], events); diff != nil {
+		t.Errorf("incorrect events")
+		t.Error(diff)
+	}
+
+	opts.Cursor = "invalid"
+
+	if _, _, _, err := tester.repo.KubeEvent().ListEventsByProjectID(context.Background(), tester.initProjects[0].Model.ID, 1, opts); err == nil {
+		t.Errorf("expected an invalid cursor to return an error")
+	}
+}
+
+func TestSearchKubeEvents(t *testing.T) {
+	suffix, _ := encryption.GenerateRandomBytes(4)
+
+	tester := &tester{
+		dbFileName: fmt.Sprintf("./porter_search_events_%s.db", suffix),
+	}
+
+	setupTestEnv(tester, t)
+	initProject(tester, t)
+	initCluster(tester, t)
+	initKubeEvents(tester, t)
+	defer cleanup(tester, t)
+
+	event, err := tester.repo.KubeEvent().CreateEvent(context.Background(), &models.KubeEvent{
+		ProjectID:    tester.initProjects[0].Model.ID,
+		ClusterID:    tester.initClusters[0].Model.ID,
+		Name:         "web-6d4cf56db6-t5pqx",
+		Namespace:    "default",
+		ResourceType: "pod",
+	})
+	if err != nil {
+		t.Fatalf("%v\n", err)
+	}
+
+	err = tester.repo.KubeEvent().AppendSubEvent(context.Background(), event, &models.KubeSubEvent{
+		EventType: "critical",
+		Message:   "Container web was killed",
+		Reason:    "OOMKilled",
+	})
+	if err != nil {
+		t.Fatalf("%v\n", err)
+	}
+
+	events, err := tester.repo.KubeEvent().SearchEvents(context.Background(), tester.initProjects[0].Model.ID, 1, &types.SearchKubeEventsRequest{
+		Query: "oomkilled",
+	})
+	if err != nil {
+		t.Fatalf("%v\n", err)
+	}
+
+	if len(events) != 1 || events[0].ID != event.ID {
+		t.Fatalf("expected the OOMKilled event to be found, got %d events", len(events))
+	}
+
+	events, err = tester.repo.KubeEvent().SearchEvents(context.Background(), tester.initProjects[0].Model.ID, 1, &types.SearchKubeEventsRequest{
+		Query: "node-example",
+		Limit: 10,
+	})
+	if err != nil {
+		t.Fatalf("%v\n", err)
+	}
+
+	if diff := deep.Equal(tester.initKubeEvents[50:60], events); diff != nil {
+		t.Errorf("incorrect events")
+		t.Error(diff)
+	}
+
+	events, err = tester.repo.KubeEvent().SearchEvents(context.Background(), tester.initProjects[0].Model.ID, 1, &types.SearchKubeEventsRequest{
+		Query: "oomkilled",
+		Since: time.Now().Add(time.Hour),
+	})
+	if err != nil {
+		t.Fatalf("%v\n", err)
+	}
+
+	if len(events) != 0 {
+		t.Errorf("expected no events to be updated after the given time, got %d events", len(events))
+	}
+}
+
 func TestDeleteKubeEvents(t *testing.T) {
 	suffix, _ := encryption.GenerateRandomBytes(4)
 
@@ -162,7 +361,7 @@ func TestDeleteKubeEvents(t *testing.T) {
 	defer cleanup(tester, t)
 
 	// delete a specific event and then test list again
-	err := tester.repo.KubeEvent().DeleteEvent(11)
+	err := tester.repo.KubeEvent().DeleteEvent(context.Background(), 11)
 	if err != nil {
 		t.Fatalf("%v\n", err)
 	}
@@ -176,7 +375,8 @@ func TestDeleteKubeEvents(t *testing.T) {
 func testListKubeEventsByProjectID(tester *tester, t *testing.T, clusterID uint, decrypt bool, opts *types.ListKubeEventRequest, expKubeEvents []*models.KubeEvent) {
 	t.Helper()
 
-	events, _, err := tester.repo.KubeEvent().ListEventsByProjectID(
+	events, _, _, err := tester.repo.KubeEvent().ListEventsByProjectID(
+		context.Background(),
 		tester.initProjects[0].Model.ID,
 		clusterID,
 		opts,
@@ -195,3 +395,68 @@ func testListKubeEventsByProjectID(tester *tester, t *testing.T, clusterID uint,
 		t.Error(diff)
 	}
 }
+
+func TestListBuildLogSubEventsAfterIndex(t *testing.T) {
+	suffix, _ := encryption.GenerateRandomBytes(4)
+
+	tester := &tester{
+		dbFileName: fmt.Sprintf("./porter_build_log_events_%s.db", suffix),
+	}
+
+	setupTestEnv(tester, t)
+	defer cleanup(tester, t)
+
+	container, err := tester.repo.BuildEvent().CreateEventContainer(context.Background(), &models.EventContainer{
+		PorterAppID: 1,
+		Name:        "web",
+		ImageTag:    "abc123",
+	})
+	if err != nil {
+		t.Fatalf("%v\n", err)
+	}
+
+	// append out of order, as chunks from the CLI may arrive
+	for _, index := range []int64{2, 1, 3} {
+		err := tester.repo.BuildEvent().AppendEvent(context.Background(), container, &models.SubEvent{
+			EventID: "build-log",
+			Name:    "web",
+			Index:   index,
+			Status:  types.EventStatusInProgress,
+			Info:    fmt.Sprintf("line %d\n", index),
+		})
+		if err != nil {
+			t.Fatalf("%v\n", err)
+		}
+	}
+
+	events, err := tester.repo.BuildEvent().ListSubEventsAfterIndex(context.Background(), container.ID, 1)
+	if err != nil {
+		t.Fatalf("%v\n", err)
+	}
+
+	if len(events) != 2 {
+		t.Fatalf("expected 2 events, got %d", len(events))
+	}
+
+	if events[0].Index != 2 || events[0].Info != "line 2\n" || events[1].Index != 3 {
+		t.Errorf("incorrect events: %+v, %+v", events[0], events[1])
+	}
+
+	_, err = tester.repo.BuildEvent().CreateEventContainer(context.Background(), &models.EventContainer{
+		PorterAppID: 1,
+		Name:        "revision 2",
+		Kind:        models.EventContainerKind_AutoRollback,
+	})
+	if err != nil {
+		t.Fatalf("%v\n", err)
+	}
+
+	containers, err := tester.repo.BuildEvent().ListEventContainersByPorterAppID(context.Background(), 1, models.EventContainerKind_BuildLog, 10)
+	if err != nil {
+		t.Fatalf("%v\n", err)
+	}
+
+	if len(containers) != 1 || containers[0].ID != container.ID || containers[0].ImageTag != "abc123" {
+		t.Errorf("incorrect event containers: %+v", containers)
+	}
+}
@@ -0,0 +1,206 @@
+package gorm
+
+import (
+	"context"
+
+	"github.com/porter-dev/porter/internal/encryption"
+	"github.com/porter-dev/porter/internal/repository"
+	"gorm.io/gorm"
+
+	ints "github.com/porter-dev/porter/internal/models/integrations"
+)
+
+// ExternalSecretsIntegrationRepository uses gorm.DB for querying the database
+type ExternalSecretsIntegrationRepository struct {
+	db  *gorm.DB
+	key *[32]byte
+}
+
+// NewExternalSecretsIntegrationRepository returns an ExternalSecretsIntegrationRepository which uses
+// gorm.DB for querying the database. It accepts an encryption key to encrypt
+// sensitive data
+func NewExternalSecretsIntegrationRepository(
+	db *gorm.DB,
+	key *[32]byte,
+) repository.ExternalSecretsIntegrationRepository {
+	return &ExternalSecretsIntegrationRepository{db, key}
+}
+
+// CreateExternalSecretsIntegration creates a new external secrets integration
+func (repo *ExternalSecretsIntegrationRepository) CreateExternalSecretsIntegration(
+	ctx context.Context,
+	es *ints.ExternalSecretsIntegration,
+) (*ints.ExternalSecretsIntegration, error) {
+	err := repo.EncryptExternalSecretsIntegrationData(es, repo.key)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := repo.db.WithContext(ctx).Create(es).Error; err != nil {
+		return nil, err
+	}
+
+	err = repo.DecryptExternalSecretsIntegrationData(es, repo.key)
+	if err != nil {
+		return nil, err
+	}
+
+	return es, nil
+}
+
+// ReadExternalSecretsIntegration finds an external secrets integration of a cluster by id
+func (repo *ExternalSecretsIntegrationRepository) ReadExternalSecretsIntegration(
+	ctx context.Context,
+	projectID, clusterID, id uint,
+) (*ints.ExternalSecretsIntegration, error) {
+	es := &ints.ExternalSecretsIntegration{}
+
+	if err := repo.db.WithContext(ctx).Where("project_id = ? AND cluster_id = ? AND id = ?", projectID, clusterID, id).First(es).Error; err != nil {
+		return nil, err
+	}
+
+	err := repo.DecryptExternalSecretsIntegrationData(es, repo.key)
+	if err != nil {
+		return nil, err
+	}
+
+	return es, nil
+}
+
+// ReadExternalSecretsIntegrationByWebhookID finds an external secrets integration by the id in its webhook url
+func (repo *ExternalSecretsIntegrationRepository) ReadExternalSecretsIntegrationByWebhookID(
+	ctx context.Context,
+	webhookID string,
+) (*ints.ExternalSecretsIntegration, error) {
+	es := &ints.ExternalSecretsIntegration{}
+
+	if err := repo.db.WithContext(ctx).Where("webhook_id = ?", webhookID).First(es).Error; err != nil {
+		return nil, err
+	}
+
+	err := repo.DecryptExternalSecretsIntegrationData(es, repo.key)
+	if err != nil {
+		return nil, err
+	}
+
+	return es, nil
+}
+
+// ListExternalSecretsIntegrationsByClusterID lists the external secrets integrations of a cluster
+func (repo *ExternalSecretsIntegrationRepository) ListExternalSecretsIntegrationsByClusterID(
+	ctx context.Context,
+	projectID, clusterID uint,
+) ([]*ints.ExternalSecretsIntegration, error) {
+	integrations := []*ints.ExternalSecretsIntegration{}
+
+	if err := repo.db.WithContext(ctx).Where("project_id = ? AND cluster_id = ?", projectID, clusterID).Find(&integrations).Error; err != nil {
+		return nil, err
+	}
+
+	return repo.decryptAll(integrations)
+}
+
+// ListScheduledExternalSecretsIntegrations lists the integrations of all clusters which sync on a schedule
+func (repo *ExternalSecretsIntegrationRepository) ListScheduledExternalSecretsIntegrations(ctx context.Context) ([]*ints.ExternalSecretsIntegration, error) {
+	integrations := []*ints.ExternalSecretsIntegration{}
+
+	if err := repo.db.WithContext(ctx).Where("sync_interval_minutes > 0").Find(&integrations).Error; err != nil {
+		return nil, err
+	}
+
+	return repo.decryptAll(integrations)
+}
+
+// UpdateExternalSecretsIntegration updates an external secrets integration
+func (repo *ExternalSecretsIntegrationRepository) UpdateExternalSecretsIntegration(
+	ctx context.Context,
+	es *ints.ExternalSecretsIntegration,
+) (*ints.ExternalSecretsIntegration, error) {
+	err := repo.EncryptExternalSecretsIntegrationData(es, repo.key)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := repo.db.WithContext(ctx).Save(es).Error; err != nil {
+		return nil, err
+	}
+
+	err = repo.DecryptExternalSecretsIntegrationData(es, repo.key)
+	if err != nil {
+		return nil, err
+	}
+
+	return es, nil
+}
+
+// DeleteExternalSecretsIntegration deletes an external secrets integration of a cluster
+func (repo *ExternalSecretsIntegrationRepository) DeleteExternalSecretsIntegration(ctx context.Context, projectID, clusterID, id uint) error {
+	return repo.db.WithContext(ctx).Where("project_id = ? AND cluster_id = ? AND id = ?", projectID, clusterID, id).Delete(&ints.ExternalSecretsIntegration{}).Error
+}
+
+func (repo *ExternalSecretsIntegrationRepository) decryptAll(
+	integrations []*ints.ExternalSecretsIntegration,
+) ([]*ints.ExternalSecretsIntegration, error) {
+	for _, es := range integrations {
+		err := repo.DecryptExternalSecretsIntegrationData(es, repo.key)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return integrations, nil
+}
+
+// EncryptExternalSecretsIntegrationData will encrypt the external secrets integration data before
+// writing to the DB
+func (repo *ExternalSecretsIntegrationRepository) EncryptExternalSecretsIntegrationData(
+	es *ints.ExternalSecretsIntegration,
+	key *[32]byte,
+) error {
+	if len(es.Token) > 0 {
+		cipherData, err := encryption.Encrypt(es.Token, key)
+		if err != nil {
+			return err
+		}
+
+		es.Token = cipherData
+	}
+
+	if len(es.WebhookSecret) > 0 {
+		cipherData, err := encryption.Encrypt(es.WebhookSecret, key)
+		if err != nil {
+			return err
+		}
+
+		es.WebhookSecret = cipherData
+	}
+
+	return nil
+}
+
+// DecryptExternalSecretsIntegrationData will decrypt the external secrets integration data before
+// returning it from the DB
+func (repo *ExternalSecretsIntegrationRepository) DecryptExternalSecretsIntegrationData(
+	es *ints.ExternalSecretsIntegration,
+	key *[32]byte,
+) error {
+	if len(es.Token) > 0 {
+		plaintext, err := encryption.Decrypt(es.Token, key)
+		if err != nil {
+			return err
+		}
+
+		es.Token = plaintext
+	}
+
+	if len(es.WebhookSecret) > 0 {
+		plaintext, err := encryption.Decrypt(es.WebhookSecret, key)
+		if err != nil {
+			return err
+		}
+
+		es.WebhookSecret = plaintext
+	}
+
+	return nil
+}
@@ -1,6 +1,8 @@
 package gorm
 
 import (
+	"context"
+
 	"github.com/porter-dev/porter/internal/models"
 	"github.com/porter-dev/porter/internal/repository"
 	"gorm.io/gorm"
@@ -19,14 +21,14 @@ func NewGitActionConfigRepository(db *gorm.DB) repository.GitActionConfigReposit
 }
 
 // CreateGitActionConfig creates a new git repo
-func (repo *GitActionConfigRepository) CreateGitActionConfig(ga *models.GitActionConfig) (*models.GitActionConfig, error) {
+func (repo *GitActionConfigRepository) CreateGitActionConfig(ctx context.Context, ga *models.GitActionConfig) (*models.GitActionConfig, error) {
 	release := &models.Release{}
 
-	if err := repo.db.Where("id = ?", ga.ReleaseID).First(&release).Error; err != nil {
+	if err := repo.db.WithContext(ctx).Where("id = ?", ga.ReleaseID).First(&release).Error; err != nil {
 		return nil, err
 	}
 
-	assoc := repo.db.Model(&release).Association("GitActionConfig")
+	assoc := repo.db.WithContext(ctx).Model(&release).Association("GitActionConfig")
 
 	if assoc.Error != nil {
 		return nil, assoc.Error
@@ -40,16 +42,16 @@ func (repo *GitActionConfigRepository) CreateGitActionConfig(ga *models.GitActio
 }
 
 // ReadGitActionConfig gets a git repo specified by a unique id
-func (repo *GitActionConfigRepository) ReadGitActionConfig(id uint) (*models.GitActionConfig, error) {
+func (repo *GitActionConfigRepository) ReadGitActionConfig(ctx context.Context, id uint) (*models.GitActionConfig, error) {
 	ga := &models.GitActionConfig{}
 
-	if err := repo.db.Where("id = ?", id).First(&ga).Error; err != nil {
+	if err := repo.db.WithContext(ctx).Where("id = ?", id).First(&ga).Error; err != nil {
 		return nil, err
 	}
 
 	return ga, nil
 }
 
-func (repo *GitActionConfigRepository) UpdateGitActionConfig(ga *models.GitActionConfig) error {
-	return repo.db.Save(ga).Error
+func (repo *GitActionConfigRepository) UpdateGitActionConfig(ctx context.Context, ga *models.GitActionConfig) error {
+	return repo.db.WithContext(ctx).Save(ga).Error
 }
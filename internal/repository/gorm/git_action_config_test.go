@@ -1,6 +1,7 @@
 package gorm_test
 
 import (
+	"context"
 	"testing"
 
 	"github.com/go-test/deep"
@@ -29,12 +30,12 @@ func TestCreateGitActionConfig(t *testing.T) {
 
 	expGA := *ga
 
-	ga, err := tester.repo.GitActionConfig().CreateGitActionConfig(ga)
+	ga, err := tester.repo.GitActionConfig().CreateGitActionConfig(context.Background(), ga)
 	if err != nil {
 		t.Fatalf("%v\n", err)
 	}
 
-	ga, err = tester.repo.GitActionConfig().ReadGitActionConfig(ga.Model.ID)
+	ga, err = tester.repo.GitActionConfig().ReadGitActionConfig(context.Background(), ga.Model.ID)
 
 	if err != nil {
 		t.Fatalf("%v\n", err)
@@ -54,7 +55,7 @@ func TestCreateGitActionConfig(t *testing.T) {
 	}
 
 	// read the release and make sure GitActionConfig is expected
-	release, err := tester.repo.Release().ReadRelease(1, "denver-meister-dakota", "default")
+	release, err := tester.repo.Release().ReadRelease(context.Background(), 1, "denver-meister-dakota", "default")
 	if err != nil {
 		t.Fatalf("%v\n", err)
 	}
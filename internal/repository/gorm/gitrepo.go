@@ -1,6 +1,8 @@
 package gorm
 
 import (
+	"context"
+
 	"github.com/porter-dev/porter/internal/models"
 	"github.com/porter-dev/porter/internal/repository"
 	"gorm.io/gorm"
@@ -20,14 +22,14 @@ func NewGitRepoRepository(db *gorm.DB, key *[32]byte) repository.GitRepoReposito
 }
 
 // CreateGitRepo creates a new git repo
-func (repo *GitRepoRepository) CreateGitRepo(gr *models.GitRepo) (*models.GitRepo, error) {
+func (repo *GitRepoRepository) CreateGitRepo(ctx context.Context, gr *models.GitRepo) (*models.GitRepo, error) {
 	project := &models.Project{}
 
-	if err := repo.db.Where("id = ?", gr.ProjectID).First(&project).Error; err != nil {
+	if err := repo.db.WithContext(ctx).Where("id = ?", gr.ProjectID).First(&project).Error; err != nil {
 		return nil, err
 	}
 
-	assoc := repo.db.Model(&project).Association("GitRepos")
+	assoc := repo.db.WithContext(ctx).Model(&project).Association("GitRepos")
 
 	if assoc.Error != nil {
 		return nil, assoc.Error
@@ -41,10 +43,10 @@ func (repo *GitRepoRepository) CreateGitRepo(gr *models.GitRepo) (*models.GitRep
 }
 
 // ReadGitRepo gets a git repo specified by a unique id
-func (repo *GitRepoRepository) ReadGitRepo(id uint) (*models.GitRepo, error) {
+func (repo *GitRepoRepository) ReadGitRepo(ctx context.Context, id uint) (*models.GitRepo, error) {
 	gr := &models.GitRepo{}
 
-	if err := repo.db.Where("id = ?", id).First(&gr).Error; err != nil {
+	if err := repo.db.WithContext(ctx).Where("id = ?", id).First(&gr).Error; err != nil {
 		return nil, err
 	}
 
@@ -54,11 +56,12 @@ func (repo *GitRepoRepository) ReadGitRepo(id uint) (*models.GitRepo, error) {
 // ListGitReposByProjectID finds all git repos
 // for a given project id
 func (repo *GitRepoRepository) ListGitReposByProjectID(
+	ctx context.Context,
 	projectID uint,
 ) ([]*models.GitRepo, error) {
 	grs := []*models.GitRepo{}
 
-	if err := repo.db.Where("project_id = ?", projectID).Find(&grs).Error; err != nil {
+	if err := repo.db.WithContext(ctx).Where("project_id = ?", projectID).Find(&grs).Error; err != nil {
 		return nil, err
 	}
 
@@ -67,9 +70,10 @@ func (repo *GitRepoRepository) ListGitReposByProjectID(
 
 // UpdateGitRepo modifies an existing GitRepo in the database
 func (repo *GitRepoRepository) UpdateGitRepo(
+	ctx context.Context,
 	gr *models.GitRepo,
 ) (*models.GitRepo, error) {
-	if err := repo.db.Save(gr).Error; err != nil {
+	if err := repo.db.WithContext(ctx).Save(gr).Error; err != nil {
 		return nil, err
 	}
 
@@ -78,9 +82,10 @@ func (repo *GitRepoRepository) UpdateGitRepo(
 
 // DeleteGitRepo removes a git repo from the db
 func (repo *GitRepoRepository) DeleteGitRepo(
+	ctx context.Context,
 	gr *models.GitRepo,
 ) error {
-	if err := repo.db.Where("id = ?", gr.ID).Delete(&models.GitRepo{}).Error; err != nil {
+	if err := repo.db.WithContext(ctx).Where("id = ?", gr.ID).Delete(&models.GitRepo{}).Error; err != nil {
 		return err
 	}
 
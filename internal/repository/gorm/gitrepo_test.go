@@ -1,6 +1,7 @@
 package gorm_test
 
 import (
+	"context"
 	"testing"
 
 	"github.com/go-test/deep"
@@ -27,12 +28,12 @@ func TestCreateGitRepo(t *testing.T) {
 
 	expGR := *gr
 
-	gr, err := tester.repo.GitRepo().CreateGitRepo(gr)
+	gr, err := tester.repo.GitRepo().CreateGitRepo(context.Background(), gr)
 	if err != nil {
 		t.Fatalf("%v\n", err)
 	}
 
-	gr, err = tester.repo.GitRepo().ReadGitRepo(gr.Model.ID)
+	gr, err = tester.repo.GitRepo().ReadGitRepo(context.Background(), gr.Model.ID)
 
 	if err != nil {
 		t.Fatalf("%v\n", err)
@@ -62,6 +63,7 @@ func TestListGitReposByProjectID(t *testing.T) {
 	defer cleanup(tester, t)
 
 	grs, err := tester.repo.GitRepo().ListGitReposByProjectID(
+		context.Background(),
 		tester.initProjects[0].Model.ID,
 	)
 	if err != nil {
@@ -105,13 +107,14 @@ func TestUpdateGitRepo(t *testing.T) {
 	gr.RepoEntity = "porter-dev-new-name"
 
 	gr, err := tester.repo.GitRepo().UpdateGitRepo(
+		context.Background(),
 		gr,
 	)
 	if err != nil {
 		t.Fatalf("%v\n", err)
 	}
 
-	gr, err = tester.repo.GitRepo().ReadGitRepo(tester.initGRs[0].ID)
+	gr, err = tester.repo.GitRepo().ReadGitRepo(context.Background(), tester.initGRs[0].ID)
 
 	// make sure data is correct
 	expGR := models.GitRepo{
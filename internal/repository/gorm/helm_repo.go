@@ -1,6 +1,8 @@
 package gorm
 
 import (
+	"context"
+
 	"github.com/porter-dev/porter/internal/encryption"
 	"github.com/porter-dev/porter/internal/models"
 	ints "github.com/porter-dev/porter/internal/models/integrations"
@@ -21,7 +23,7 @@ func NewHelmRepoRepository(db *gorm.DB, key *[32]byte) repository.HelmRepoReposi
 }
 
 // CreateHelmRepo creates a new helm repo
-func (repo *HelmRepoRepository) CreateHelmRepo(hr *models.HelmRepo) (*models.HelmRepo, error) {
+func (repo *HelmRepoRepository) CreateHelmRepo(ctx context.Context, hr *models.HelmRepo) (*models.HelmRepo, error) {
 	err := repo.EncryptHelmRepoData(hr, repo.key)
 	if err != nil {
 		return nil, err
@@ -29,11 +31,11 @@ func (repo *HelmRepoRepository) CreateHelmRepo(hr *models.HelmRepo) (*models.Hel
 
 	project := &models.Project{}
 
-	if err := repo.db.Where("id = ?", hr.ProjectID).First(&project).Error; err != nil {
+	if err := repo.db.WithContext(ctx).Where("id = ?", hr.ProjectID).First(&project).Error; err != nil {
 		return nil, err
 	}
 
-	assoc := repo.db.Model(&project).Association("HelmRepos")
+	assoc := repo.db.WithContext(ctx).Model(&project).Association("HelmRepos")
 
 	if assoc.Error != nil {
 		return nil, assoc.Error
@@ -44,7 +46,7 @@ func (repo *HelmRepoRepository) CreateHelmRepo(hr *models.HelmRepo) (*models.Hel
 	}
 
 	// create a token cache by default
-	assoc = repo.db.Model(hr).Association("TokenCache")
+	assoc = repo.db.WithContext(ctx).Model(hr).Association("TokenCache")
 
 	if assoc.Error != nil {
 		return nil, assoc.Error
@@ -64,10 +66,10 @@ func (repo *HelmRepoRepository) CreateHelmRepo(hr *models.HelmRepo) (*models.Hel
 }
 
 // ReadHelmRepo gets a helm repo specified by a unique id
-func (repo *HelmRepoRepository) ReadHelmRepo(projectID, hrID uint) (*models.HelmRepo, error) {
+func (repo *HelmRepoRepository) ReadHelmRepo(ctx context.Context, projectID, hrID uint) (*models.HelmRepo, error) {
 	hr := &models.HelmRepo{}
 
-	if err := repo.db.Preload("TokenCache").Where("project_id = ? AND id = ?", projectID, hrID).First(&hr).Error; err != nil {
+	if err := repo.db.WithContext(ctx).Preload("TokenCache").Where("project_id = ? AND id = ?", projectID, hrID).First(&hr).Error; err != nil {
 		return nil, err
 	}
 
@@ -79,11 +81,12 @@ func (repo *HelmRepoRepository) ReadHelmRepo(projectID, hrID uint) (*models.Helm
 // ListHelmReposByProjectID finds all helm repos
 // for a given project id
 func (repo *HelmRepoRepository) ListHelmReposByProjectID(
+	ctx context.Context,
 	projectID uint,
 ) ([]*models.HelmRepo, error) {
 	hrs := []*models.HelmRepo{}
 
-	if err := repo.db.Preload("TokenCache").Where("project_id = ?", projectID).Find(&hrs).Error; err != nil {
+	if err := repo.db.WithContext(ctx).Preload("TokenCache").Where("project_id = ?", projectID).Find(&hrs).Error; err != nil {
 		return nil, err
 	}
 
@@ -96,9 +99,10 @@ func (repo *HelmRepoRepository) ListHelmReposByProjectID(
 
 // UpdateHelmRepo modifies an existing HelmRepo in the database
 func (repo *HelmRepoRepository) UpdateHelmRepo(
+	ctx context.Context,
 	hr *models.HelmRepo,
 ) (*models.HelmRepo, error) {
-	if err := repo.db.Save(hr).Error; err != nil {
+	if err := repo.db.WithContext(ctx).Save(hr).Error; err != nil {
 		return nil, err
 	}
 
@@ -107,6 +111,7 @@ func (repo *HelmRepoRepository) UpdateHelmRepo(
 
 // UpdateHelmRepoTokenCache updates the helm repo for a registry
 func (repo *HelmRepoRepository) UpdateHelmRepoTokenCache(
+	ctx context.Context,
 	tokenCache *ints.HelmRepoTokenCache,
 ) (*models.HelmRepo, error) {
 	if tok := tokenCache.Token; len(tok) > 0 {
@@ -120,14 +125,14 @@ func (repo *HelmRepoRepository) UpdateHelmRepoTokenCache(
 
 	hr := &models.HelmRepo{}
 
-	if err := repo.db.Where("id = ?", tokenCache.HelmRepoID).First(&hr).Error; err != nil {
+	if err := repo.db.WithContext(ctx).Where("id = ?", tokenCache.HelmRepoID).First(&hr).Error; err != nil {
 		return nil, err
 	}
 
 	hr.TokenCache.Token = tokenCache.Token
 	hr.TokenCache.Expiry = tokenCache.Expiry
 
-	if err := repo.db.Save(hr).Error; err != nil {
+	if err := repo.db.WithContext(ctx).Save(hr).Error; err != nil {
 		return nil, err
 	}
 
@@ -136,10 +141,11 @@ func (repo *HelmRepoRepository) UpdateHelmRepoTokenCache(
 
 // DeleteHelmRepo removes a registry from the db
 func (repo *HelmRepoRepository) DeleteHelmRepo(
+	ctx context.Context,
 	hr *models.HelmRepo,
 ) error {
 	// clear TokenCache association
-	assoc := repo.db.Model(hr).Association("TokenCache")
+	assoc := repo.db.WithContext(ctx).Model(hr).Association("TokenCache")
 
 	if assoc.Error != nil {
 		return assoc.Error
@@ -149,7 +155,7 @@ func (repo *HelmRepoRepository) DeleteHelmRepo(
 		return err
 	}
 
-	if err := repo.db.Where("id = ?", hr.ID).Delete(&models.HelmRepo{}).Error; err != nil {
+	if err := repo.db.WithContext(ctx).Where("id = ?", hr.ID).Delete(&models.HelmRepo{}).Error; err != nil {
 		return err
 	}
 
@@ -1,6 +1,7 @@
 package gorm_test
 
 import (
+	"context"
 	"testing"
 	"time"
 
@@ -26,12 +27,12 @@ func TestCreateHelmRepo(t *testing.T) {
 		ProjectID: tester.initProjects[0].Model.ID,
 	}
 
-	hr, err := tester.repo.HelmRepo().CreateHelmRepo(hr)
+	hr, err := tester.repo.HelmRepo().CreateHelmRepo(context.Background(), hr)
 	if err != nil {
 		t.Fatalf("%v\n", err)
 	}
 
-	hr, err = tester.repo.HelmRepo().ReadHelmRepo(tester.initProjects[0].Model.ID, hr.Model.ID)
+	hr, err = tester.repo.HelmRepo().ReadHelmRepo(context.Background(), tester.initProjects[0].Model.ID, hr.Model.ID)
 
 	if err != nil {
 		t.Fatalf("%v\n", err)
@@ -62,6 +63,7 @@ func TestListHelmReposByProjectID(t *testing.T) {
 	defer cleanup(tester, t)
 
 	hrs, err := tester.repo.HelmRepo().ListHelmReposByProjectID(
+		context.Background(),
 		tester.initProjects[0].Model.ID,
 	)
 	if err != nil {
@@ -105,13 +107,14 @@ func TestUpdateHelmRepo(t *testing.T) {
 	hr.Name = "helm-repo-new-name"
 
 	hr, err := tester.repo.HelmRepo().UpdateHelmRepo(
+		context.Background(),
 		hr,
 	)
 	if err != nil {
 		t.Fatalf("%v\n", err)
 	}
 
-	hr, err = tester.repo.HelmRepo().ReadHelmRepo(tester.initProjects[0].Model.ID, tester.initHRs[0].ID)
+	hr, err = tester.repo.HelmRepo().ReadHelmRepo(context.Background(), tester.initProjects[0].Model.ID, tester.initHRs[0].ID)
 
 	// make sure data is correct
 	expHelmRepo := models.HelmRepo{
@@ -150,12 +153,12 @@ func TestUpdateHelmRepoToken(t *testing.T) {
 		},
 	}
 
-	hr, err := tester.repo.HelmRepo().CreateHelmRepo(hr)
+	hr, err := tester.repo.HelmRepo().CreateHelmRepo(context.Background(), hr)
 	if err != nil {
 		t.Fatalf("%v\n", err)
 	}
 
-	hr, err = tester.repo.HelmRepo().ReadHelmRepo(tester.initProjects[0].Model.ID, hr.Model.ID)
+	hr, err = tester.repo.HelmRepo().ReadHelmRepo(context.Background(), tester.initProjects[0].Model.ID, hr.Model.ID)
 
 	if err != nil {
 		t.Fatalf("%v\n", err)
@@ -178,11 +181,11 @@ func TestUpdateHelmRepoToken(t *testing.T) {
 	hr.TokenCache.Token = []byte("token-2")
 	hr.TokenCache.Expiry = time.Now().Add(24 * time.Hour)
 
-	hr, err = tester.repo.HelmRepo().UpdateHelmRepoTokenCache(&hr.TokenCache)
+	hr, err = tester.repo.HelmRepo().UpdateHelmRepoTokenCache(context.Background(), &hr.TokenCache)
 	if err != nil {
 		t.Fatalf("%v\n", err)
 	}
-	hr, err = tester.repo.HelmRepo().ReadHelmRepo(tester.initProjects[0].Model.ID, hr.Model.ID)
+	hr, err = tester.repo.HelmRepo().ReadHelmRepo(context.Background(), tester.initProjects[0].Model.ID, hr.Model.ID)
 	if err != nil {
 		t.Fatalf("%v\n", err)
 	}
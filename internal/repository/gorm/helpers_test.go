@@ -1,6 +1,7 @@
 package gorm_test
 
 import (
+	"context"
 	"fmt"
 	"os"
 	"testing"
@@ -74,6 +75,25 @@ func setupTestEnv(tester *tester, t *testing.T) {
 		&models.Operation{},
 		&models.GitActionConfig{},
 		&models.Invite{},
+		&models.EventContainer{},
+		&models.AppRevisionMetadata{},
+		&models.SCIMUser{},
+		&models.SCIMGroup{},
+		&models.SCIMGroupRoleMapping{},
+		&models.UserMFA{},
+		&models.AppResourceRequest{},
+		&models.ServiceAccount{},
+		&models.AlertRule{},
+		&models.EventSink{},
+		&models.Addon{},
+		&models.Datastore{},
+		&models.DatastoreLink{},
+		&models.CustomCertificate{},
+		&models.CustomDomain{},
+		&models.WildcardDomain{},
+		&models.SubdomainAllocation{},
+		&models.PorterApp{},
+		&models.SubEvent{},
 		&models.KubeEvent{},
 		&models.KubeSubEvent{},
 		&models.Onboarding{},
@@ -122,7 +142,7 @@ func initUser(tester *tester, t *testing.T) {
 		Password: "hello1234",
 	}
 
-	user, err := tester.repo.User().CreateUser(user)
+	user, err := tester.repo.User().CreateUser(context.Background(), user)
 	if err != nil {
 		t.Fatalf("%v\n", err)
 	}
@@ -150,7 +170,7 @@ func initMultiUser(tester *tester, t *testing.T) {
 		Password: "hello1234",
 	}
 
-	user, err := tester.repo.User().CreateUser(user)
+	user, err := tester.repo.User().CreateUser(context.Background(), user)
 	if err != nil {
 		t.Fatalf("%v\n", err)
 	}
@@ -162,7 +182,7 @@ func initMultiUser(tester *tester, t *testing.T) {
 		Password: "hello1234",
 	}
 
-	user, err = tester.repo.User().CreateUser(user)
+	user, err = tester.repo.User().CreateUser(context.Background(), user)
 
 	if err != nil {
 		t.Fatalf("%v\n", err)
@@ -178,7 +198,7 @@ func initProject(tester *tester, t *testing.T) {
 		Name: "project-test",
 	}
 
-	proj, err := tester.repo.Project().CreateProject(proj)
+	proj, err := tester.repo.Project().CreateProject(context.Background(), proj)
 	if err != nil {
 		t.Fatalf("%v\n", err)
 	}
@@ -197,7 +217,7 @@ func initProjectRole(tester *tester, t *testing.T) {
 		},
 	}
 
-	role, err := tester.repo.Project().CreateProjectRole(tester.initProjects[0], role)
+	role, err := tester.repo.Project().CreateProjectRole(context.Background(), tester.initProjects[0], role)
 	if err != nil {
 		t.Fatalf("%v\n", err)
 	}
@@ -221,7 +241,7 @@ func initKubeIntegration(tester *tester, t *testing.T) {
 		Kubeconfig: []byte("current-context: testing\n"),
 	}
 
-	ki, err := tester.repo.KubeIntegration().CreateKubeIntegration(ki)
+	ki, err := tester.repo.KubeIntegration().CreateKubeIntegration(context.Background(), ki)
 	if err != nil {
 		t.Fatalf("%v\n", err)
 	}
@@ -247,7 +267,7 @@ func initBasicIntegration(tester *tester, t *testing.T) {
 		Password:  []byte("password"),
 	}
 
-	basic, err := tester.repo.BasicIntegration().CreateBasicIntegration(basic)
+	basic, err := tester.repo.BasicIntegration().CreateBasicIntegration(context.Background(), basic)
 	if err != nil {
 		t.Fatalf("%v\n", err)
 	}
@@ -277,7 +297,7 @@ func initOIDCIntegration(tester *tester, t *testing.T) {
 		RefreshToken: []byte("refreshtoken"),
 	}
 
-	oidc, err := tester.repo.OIDCIntegration().CreateOIDCIntegration(oidc)
+	oidc, err := tester.repo.OIDCIntegration().CreateOIDCIntegration(context.Background(), oidc)
 	if err != nil {
 		t.Fatalf("%v\n", err)
 	}
@@ -307,7 +327,7 @@ func initOAuthIntegration(tester *tester, t *testing.T) {
 		UserID:    tester.initUsers[0].ID,
 	}
 
-	oauth, err := tester.repo.OAuthIntegration().CreateOAuthIntegration(oauth)
+	oauth, err := tester.repo.OAuthIntegration().CreateOAuthIntegration(context.Background(), oauth)
 	if err != nil {
 		t.Fatalf("%v\n", err)
 	}
@@ -334,7 +354,7 @@ func initGCPIntegration(tester *tester, t *testing.T) {
 		GCPKeyData:   []byte("{\"test\":\"key\"}"),
 	}
 
-	gcp, err := tester.repo.GCPIntegration().CreateGCPIntegration(gcp)
+	gcp, err := tester.repo.GCPIntegration().CreateGCPIntegration(context.Background(), gcp)
 	if err != nil {
 		t.Fatalf("%v\n", err)
 	}
@@ -362,7 +382,7 @@ func initAWSIntegration(tester *tester, t *testing.T) {
 		AWSSessionToken:    []byte("optional"),
 	}
 
-	aws, err := tester.repo.AWSIntegration().CreateAWSIntegration(aws)
+	aws, err := tester.repo.AWSIntegration().CreateAWSIntegration(context.Background(), aws)
 	if err != nil {
 		t.Fatalf("%v\n", err)
 	}
@@ -389,7 +409,7 @@ func initClusterCandidate(tester *tester, t *testing.T) {
 		Kubeconfig:        []byte("current-context: testing\n"),
 	}
 
-	cc, err := tester.repo.Cluster().CreateClusterCandidate(cc)
+	cc, err := tester.repo.Cluster().CreateClusterCandidate(context.Background(), cc)
 	if err != nil {
 		t.Fatalf("%v\n", err)
 	}
@@ -416,7 +436,7 @@ func initCluster(tester *tester, t *testing.T) {
 		CertificateAuthorityData: []byte("-----BEGIN"),
 	}
 
-	cluster, err := tester.repo.Cluster().CreateCluster(cluster)
+	cluster, err := tester.repo.Cluster().CreateCluster(context.Background(), cluster)
 	if err != nil {
 		t.Fatalf("%v\n", err)
 	}
@@ -441,7 +461,7 @@ func initGitRepo(tester *tester, t *testing.T) {
 		OAuthIntegrationID: tester.initOAuths[0].ID,
 	}
 
-	gr, err := tester.repo.GitRepo().CreateGitRepo(gr)
+	gr, err := tester.repo.GitRepo().CreateGitRepo(context.Background(), gr)
 	if err != nil {
 		t.Fatalf("%v\n", err)
 	}
@@ -461,7 +481,7 @@ func initRegistry(tester *tester, t *testing.T) {
 		Name:      "registry-test",
 	}
 
-	reg, err := tester.repo.Registry().CreateRegistry(reg)
+	reg, err := tester.repo.Registry().CreateRegistry(context.Background(), reg)
 	if err != nil {
 		t.Fatalf("%v\n", err)
 	}
@@ -482,7 +502,7 @@ func initHelmRepo(tester *tester, t *testing.T) {
 		ProjectID: tester.initProjects[0].Model.ID,
 	}
 
-	hr, err := tester.repo.HelmRepo().CreateHelmRepo(hr)
+	hr, err := tester.repo.HelmRepo().CreateHelmRepo(context.Background(), hr)
 	if err != nil {
 		t.Fatalf("%v\n", err)
 	}
@@ -503,7 +523,7 @@ func initInfra(tester *tester, t *testing.T) {
 		Status:    types.StatusCreated,
 	}
 
-	infra, err := tester.repo.Infra().CreateInfra(infra)
+	infra, err := tester.repo.Infra().CreateInfra(context.Background(), infra)
 	if err != nil {
 		t.Fatalf("%v\n", err)
 	}
@@ -527,7 +547,7 @@ func initInvite(tester *tester, t *testing.T) {
 		ProjectID: 1,
 	}
 
-	invite, err := tester.repo.Invite().CreateInvite(invite)
+	invite, err := tester.repo.Invite().CreateInvite(context.Background(), invite)
 	if err != nil {
 		t.Fatalf("%v\n", err)
 	}
@@ -550,7 +570,7 @@ func initRelease(tester *tester, t *testing.T) {
 		WebhookToken: "abcdefgh",
 	}
 
-	release, err := tester.repo.Release().CreateRelease(release)
+	release, err := tester.repo.Release().CreateRelease(context.Background(), release)
 	if err != nil {
 		t.Fatalf("%v\n", err)
 	}
@@ -583,7 +603,7 @@ func initKubeEvents(tester *tester, t *testing.T) {
 			ResourceType: refType,
 		}
 
-		event, err := tester.repo.KubeEvent().CreateEvent(event)
+		event, err := tester.repo.KubeEvent().CreateEvent(context.Background(), event)
 		if err != nil {
 			t.Fatalf("%v\n", err)
 		}
@@ -595,7 +615,7 @@ func initKubeEvents(tester *tester, t *testing.T) {
 			Reason:    "OOM: memory limit exceeded",
 		}
 
-		err = tester.repo.KubeEvent().AppendSubEvent(event, subEvent)
+		err = tester.repo.KubeEvent().AppendSubEvent(context.Background(), event, subEvent)
 
 		if err != nil {
 			t.Fatalf("%v\n", err)
@@ -611,7 +631,7 @@ func initKubeEvents(tester *tester, t *testing.T) {
 			Reason:    "OOM: memory limit exceeded",
 		}
 
-		err := tester.repo.KubeEvent().AppendSubEvent(initEvents[i], subEvent)
+		err := tester.repo.KubeEvent().AppendSubEvent(context.Background(), initEvents[i], subEvent)
 		if err != nil {
 			t.Fatalf("%v\n", err)
 		}
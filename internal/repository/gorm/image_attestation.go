@@ -0,0 +1,91 @@
+package gorm
+
+import (
+	"context"
+
+	"github.com/porter-dev/porter/internal/models"
+	"github.com/porter-dev/porter/internal/repository"
+	"gorm.io/gorm"
+)
+
+// ImageAttestationRepository uses gorm.DB for querying the database
+type ImageAttestationRepository struct {
+	db *gorm.DB
+}
+
+// NewImageAttestationRepository returns an ImageAttestationRepository which uses
+// gorm.DB for querying the database
+func NewImageAttestationRepository(db *gorm.DB) repository.ImageAttestationRepository {
+	return &ImageAttestationRepository{db}
+}
+
+// CreateImageAttestation creates a new image attestation
+func (repo *ImageAttestationRepository) CreateImageAttestation(ctx context.Context, attestation *models.ImageAttestation) (*models.ImageAttestation, error) {
+	if err := repo.db.WithContext(ctx).Create(attestation).Error; err != nil {
+		return nil, err
+	}
+
+	return attestation, nil
+}
+
+// ListImageAttestationsByTag lists the attestations of the images of an app with the given tag, newest first
+func (repo *ImageAttestationRepository) ListImageAttestationsByTag(ctx context.Context, projectID uint, appName string, tag string) ([]*models.ImageAttestation, error) {
+	attestations := []*models.ImageAttestation{}
+
+	if err := repo.db.WithContext(ctx).Where("project_id = ? AND app_name = ? AND tag = ?", projectID, appName, tag).Order("id desc").Find(&attestations).Error; err != nil {
+		return nil, err
+	}
+
+	return attestations, nil
+}
+
+// ListImageAttestationsByImage lists the attestations of the image with the given repository and tag, newest first
+func (repo *ImageAttestationRepository) ListImageAttestationsByImage(ctx context.Context, projectID uint, repository string, tag string) ([]*models.ImageAttestation, error) {
+	attestations := []*models.ImageAttestation{}
+
+	if err := repo.db.WithContext(ctx).Where("project_id = ? AND repository = ? AND tag = ?", projectID, repository, tag).Order("id desc").Find(&attestations).Error; err != nil {
+		return nil, err
+	}
+
+	return attestations, nil
+}
+
+// ImageSigningPolicyRepository uses gorm.DB for querying the database
+type ImageSigningPolicyRepository struct {
+	db *gorm.DB
+}
+
+// NewImageSigningPolicyRepository returns an ImageSigningPolicyRepository which uses
+// gorm.DB for querying the database
+func NewImageSigningPolicyRepository(db *gorm.DB) repository.ImageSigningPolicyRepository {
+	return &ImageSigningPolicyRepository{db}
+}
+
+// CreateImageSigningPolicy creates the image signing policy of a project
+func (repo *ImageSigningPolicyRepository) CreateImageSigningPolicy(ctx context.Context, policy *models.ImageSigningPolicy) (*models.ImageSigningPolicy, error) {
+	if err := repo.db.WithContext(ctx).Create(policy).Error; err != nil {
+		return nil, err
+	}
+
+	return policy, nil
+}
+
+// UpdateImageSigningPolicy updates the image signing policy of a project
+func (repo *ImageSigningPolicyRepository) UpdateImageSigningPolicy(ctx context.Context, policy *models.ImageSigningPolicy) (*models.ImageSigningPolicy, error) {
+	if err := repo.db.WithContext(ctx).Save(policy).Error; err != nil {
+		return nil, err
+	}
+
+	return policy, nil
+}
+
+// ReadImageSigningPolicy finds the image signing policy of a project
+func (repo *ImageSigningPolicyRepository) ReadImageSigningPolicy(ctx context.Context, projectID uint) (*models.ImageSigningPolicy, error) {
+	policy := &models.ImageSigningPolicy{}
+
+	if err := repo.db.WithContext(ctx).Where("project_id = ?", projectID).First(policy).Error; err != nil {
+		return nil, err
+	}
+
+	return policy, nil
+}
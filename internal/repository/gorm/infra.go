@@ -1,6 +1,7 @@
 package gorm
 
 import (
+	"context"
 	"encoding/hex"
 	"fmt"
 
@@ -23,7 +24,7 @@ func NewInfraRepository(db *gorm.DB, key *[32]byte) repository.InfraRepository {
 }
 
 // CreateInfra creates a new aws infra
-func (repo *InfraRepository) CreateInfra(infra *models.Infra) (*models.Infra, error) {
+func (repo *InfraRepository) CreateInfra(ctx context.Context, infra *models.Infra) (*models.Infra, error) {
 	err := repo.EncryptInfraData(infra, repo.key)
 	if err != nil {
 		return nil, err
@@ -31,11 +32,11 @@ func (repo *InfraRepository) CreateInfra(infra *models.Infra) (*models.Infra, er
 
 	project := &models.Project{}
 
-	if err := repo.db.Where("id = ?", infra.ProjectID).First(&project).Error; err != nil {
+	if err := repo.db.WithContext(ctx).Where("id = ?", infra.ProjectID).First(&project).Error; err != nil {
 		return nil, err
 	}
 
-	assoc := repo.db.Model(&project).Association("Infras")
+	assoc := repo.db.WithContext(ctx).Model(&project).Association("Infras")
 
 	if assoc.Error != nil {
 		return nil, assoc.Error
@@ -55,10 +56,10 @@ func (repo *InfraRepository) CreateInfra(infra *models.Infra) (*models.Infra, er
 }
 
 // ReadInfra gets a aws infra specified by a unique id
-func (repo *InfraRepository) ReadInfra(projectID, infraID uint) (*models.Infra, error) {
+func (repo *InfraRepository) ReadInfra(ctx context.Context, projectID, infraID uint) (*models.Infra, error) {
 	infra := &models.Infra{}
 
-	if err := repo.db.Where("project_id = ? AND id = ?", projectID, infraID).First(&infra).Error; err != nil {
+	if err := repo.db.WithContext(ctx).Where("project_id = ? AND id = ?", projectID, infraID).First(&infra).Error; err != nil {
 		return nil, err
 	}
 
@@ -73,12 +74,13 @@ func (repo *InfraRepository) ReadInfra(projectID, infraID uint) (*models.Infra,
 // ListInfrasByProjectID finds all aws infras
 // for a given project id
 func (repo *InfraRepository) ListInfrasByProjectID(
+	ctx context.Context,
 	projectID uint,
 	apiVersion string,
 ) ([]*models.Infra, error) {
 	infras := []*models.Infra{}
 
-	query := repo.db.Where("project_id = ?", projectID).Order("updated_at desc")
+	query := repo.db.WithContext(ctx).Where("project_id = ?", projectID).Order("updated_at desc")
 
 	if apiVersion != "" {
 		query = query.Where("api_version = ?", apiVersion)
@@ -98,7 +100,7 @@ func (repo *InfraRepository) ListInfrasByProjectID(
 	// get the latest operation for each infra and use it to set LastApplied
 	operations := make([]*models.Operation, 0)
 
-	if err := repo.db.Where("operations.infra_id IN (?)", infraIDs).Where(`
+	if err := repo.db.WithContext(ctx).Where("operations.infra_id IN (?)", infraIDs).Where(`
 	operations.id IN (
 	  SELECT o2.id FROM (SELECT MAX(operations.id) id FROM operations WHERE operations.infra_id IN (?) GROUP BY operations.infra_id) o2
 	)
@@ -129,6 +131,7 @@ func (repo *InfraRepository) ListInfrasByProjectID(
 
 // UpdateInfra modifies an existing Infra in the database
 func (repo *InfraRepository) UpdateInfra(
+	ctx context.Context,
 	ai *models.Infra,
 ) (*models.Infra, error) {
 	err := repo.EncryptInfraData(ai, repo.key)
@@ -136,7 +139,7 @@ func (repo *InfraRepository) UpdateInfra(
 		return nil, err
 	}
 
-	if err := repo.db.Save(ai).Error; err != nil {
+	if err := repo.db.WithContext(ctx).Save(ai).Error; err != nil {
 		return nil, err
 	}
 
@@ -149,7 +152,7 @@ func (repo *InfraRepository) UpdateInfra(
 	return ai, nil
 }
 
-func (repo *InfraRepository) AddOperation(infra *models.Infra, operation *models.Operation) (*models.Operation, error) {
+func (repo *InfraRepository) AddOperation(ctx context.Context, infra *models.Infra, operation *models.Operation) (*models.Operation, error) {
 	// don't accept operations within a 10-length unique ID
 	if len(operation.UID) != hex.EncodedLen(10) {
 		return nil, fmt.Errorf("operation must have unique ID with hex-decoded length 10, length is %d", len(operation.UID))
@@ -161,7 +164,7 @@ func (repo *InfraRepository) AddOperation(infra *models.Infra, operation *models
 		return nil, err
 	}
 
-	assoc := repo.db.Model(&infra).Association("Operations")
+	assoc := repo.db.WithContext(ctx).Model(&infra).Association("Operations")
 
 	if assoc.Error != nil {
 		return nil, assoc.Error
@@ -171,7 +174,7 @@ func (repo *InfraRepository) AddOperation(infra *models.Infra, operation *models
 		return nil, err
 	}
 
-	if err := repo.db.Save(operation).Error; err != nil {
+	if err := repo.db.WithContext(ctx).Save(operation).Error; err != nil {
 		return nil, err
 	}
 
@@ -183,20 +186,20 @@ func (repo *InfraRepository) AddOperation(infra *models.Infra, operation *models
 	return operation, nil
 }
 
-func (repo *InfraRepository) ListOperations(infraID uint) ([]*models.Operation, error) {
+func (repo *InfraRepository) ListOperations(ctx context.Context, infraID uint) ([]*models.Operation, error) {
 	operations := make([]*models.Operation, 0)
 
-	if err := repo.db.Where("infra_id = ?", infraID).Order("id desc").Find(&operations).Error; err != nil {
+	if err := repo.db.WithContext(ctx).Where("infra_id = ?", infraID).Order("id desc").Find(&operations).Error; err != nil {
 		return nil, err
 	}
 
 	return operations, nil
 }
 
-func (repo *InfraRepository) ReadOperation(infraID uint, operationUID string) (*models.Operation, error) {
+func (repo *InfraRepository) ReadOperation(ctx context.Context, infraID uint, operationUID string) (*models.Operation, error) {
 	operation := &models.Operation{}
 
-	if err := repo.db.Order("id desc").Where("infra_id = ? AND uid = ?", infraID, operationUID).First(&operation).Error; err != nil {
+	if err := repo.db.WithContext(ctx).Order("id desc").Where("infra_id = ? AND uid = ?", infraID, operationUID).First(&operation).Error; err != nil {
 		return nil, err
 	}
 
@@ -208,10 +211,10 @@ func (repo *InfraRepository) ReadOperation(infraID uint, operationUID string) (*
 	return operation, nil
 }
 
-func (repo *InfraRepository) GetLatestOperation(infra *models.Infra) (*models.Operation, error) {
+func (repo *InfraRepository) GetLatestOperation(ctx context.Context, infra *models.Infra) (*models.Operation, error) {
 	operation := &models.Operation{}
 
-	if err := repo.db.Order("id desc").Where("infra_id = ?", infra.ID).First(&operation).Error; err != nil {
+	if err := repo.db.WithContext(ctx).Order("id desc").Where("infra_id = ?", infra.ID).First(&operation).Error; err != nil {
 		return nil, err
 	}
 
@@ -225,6 +228,7 @@ func (repo *InfraRepository) GetLatestOperation(infra *models.Infra) (*models.Op
 
 // UpdateInfra modifies an existing Infra in the database
 func (repo *InfraRepository) UpdateOperation(
+	ctx context.Context,
 	operation *models.Operation,
 ) (*models.Operation, error) {
 	err := repo.EncryptOperationData(operation, repo.key)
@@ -232,7 +236,7 @@ func (repo *InfraRepository) UpdateOperation(
 		return nil, err
 	}
 
-	if err := repo.db.Save(operation).Error; err != nil {
+	if err := repo.db.WithContext(ctx).Save(operation).Error; err != nil {
 		return nil, err
 	}
 
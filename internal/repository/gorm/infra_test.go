@@ -1,6 +1,7 @@
 package gorm_test
 
 import (
+	"context"
 	"testing"
 
 	"gorm.io/gorm"
@@ -25,12 +26,12 @@ func TestCreateInfra(t *testing.T) {
 		Status:    types.StatusCreated,
 	}
 
-	infra, err := tester.repo.Infra().CreateInfra(infra)
+	infra, err := tester.repo.Infra().CreateInfra(context.Background(), infra)
 	if err != nil {
 		t.Fatalf("%v\n", err)
 	}
 
-	infra, err = tester.repo.Infra().ReadInfra(tester.initProjects[0].Model.ID, infra.Model.ID)
+	infra, err = tester.repo.Infra().ReadInfra(context.Background(), tester.initProjects[0].Model.ID, infra.Model.ID)
 
 	if err != nil {
 		t.Fatalf("%v\n", err)
@@ -61,6 +62,7 @@ func TestListInfrasByProjectID(t *testing.T) {
 	defer cleanup(tester, t)
 
 	infras, err := tester.repo.Infra().ListInfrasByProjectID(
+		context.Background(),
 		tester.initProjects[0].Model.ID,
 		"",
 	)
@@ -1,6 +1,8 @@
 package gorm
 
 import (
+	"context"
+
 	"github.com/porter-dev/porter/internal/models"
 	"github.com/porter-dev/porter/internal/repository"
 	"gorm.io/gorm"
@@ -18,14 +20,14 @@ func NewInviteRepository(db *gorm.DB) repository.InviteRepository {
 }
 
 // CreateInvite creates a new invite
-func (repo *InviteRepository) CreateInvite(invite *models.Invite) (*models.Invite, error) {
+func (repo *InviteRepository) CreateInvite(ctx context.Context, invite *models.Invite) (*models.Invite, error) {
 	project := &models.Project{}
 
-	if err := repo.db.Where("id = ?", invite.ProjectID).First(&project).Error; err != nil {
+	if err := repo.db.WithContext(ctx).Where("id = ?", invite.ProjectID).First(&project).Error; err != nil {
 		return nil, err
 	}
 
-	assoc := repo.db.Model(&project).Association("Invites")
+	assoc := repo.db.WithContext(ctx).Model(&project).Association("Invites")
 
 	if assoc.Error != nil {
 		return nil, assoc.Error
@@ -39,10 +41,10 @@ func (repo *InviteRepository) CreateInvite(invite *models.Invite) (*models.Invit
 }
 
 // ReadInvite gets an invite specified by a unique id
-func (repo *InviteRepository) ReadInvite(projectID, inviteID uint) (*models.Invite, error) {
+func (repo *InviteRepository) ReadInvite(ctx context.Context, projectID, inviteID uint) (*models.Invite, error) {
 	invite := &models.Invite{}
 
-	if err := repo.db.Where("project_id = ? AND id = ?", projectID, inviteID).First(&invite).Error; err != nil {
+	if err := repo.db.WithContext(ctx).Where("project_id = ? AND id = ?", projectID, inviteID).First(&invite).Error; err != nil {
 		return nil, err
 	}
 
@@ -50,10 +52,10 @@ func (repo *InviteRepository) ReadInvite(projectID, inviteID uint) (*models.Invi
 }
 
 // ReadInviteByToken gets an invite specified by a unique token
-func (repo *InviteRepository) ReadInviteByToken(token string) (*models.Invite, error) {
+func (repo *InviteRepository) ReadInviteByToken(ctx context.Context, token string) (*models.Invite, error) {
 	invite := &models.Invite{}
 
-	if err := repo.db.Where("token = ?", token).First(&invite).Error; err != nil {
+	if err := repo.db.WithContext(ctx).Where("token = ?", token).First(&invite).Error; err != nil {
 		return nil, err
 	}
 
@@ -63,11 +65,12 @@ func (repo *InviteRepository) ReadInviteByToken(token string) (*models.Invite, e
 // ListInvitesByProjectID finds all invites
 // for a given project id
 func (repo *InviteRepository) ListInvitesByProjectID(
+	ctx context.Context,
 	projectID uint,
 ) ([]*models.Invite, error) {
 	invites := []*models.Invite{}
 
-	if err := repo.db.Where("project_id = ?", projectID).Find(&invites).Error; err != nil {
+	if err := repo.db.WithContext(ctx).Where("project_id = ?", projectID).Find(&invites).Error; err != nil {
 		return nil, err
 	}
 
@@ -76,9 +79,10 @@ func (repo *InviteRepository) ListInvitesByProjectID(
 
 // UpdateInvite updates an invitation in the DB
 func (repo *InviteRepository) UpdateInvite(
+	ctx context.Context,
 	invite *models.Invite,
 ) (*models.Invite, error) {
-	if err := repo.db.Save(invite).Error; err != nil {
+	if err := repo.db.WithContext(ctx).Save(invite).Error; err != nil {
 		return nil, err
 	}
 
@@ -87,10 +91,11 @@ func (repo *InviteRepository) UpdateInvite(
 
 // DeleteInvite removes a registry from the db
 func (repo *InviteRepository) DeleteInvite(
+	ctx context.Context,
 	invite *models.Invite,
 ) error {
 	// clear TokenCache association
-	if err := repo.db.Where("id = ?", invite.ID).Delete(&models.Invite{}).Error; err != nil {
+	if err := repo.db.WithContext(ctx).Where("id = ?", invite.ID).Delete(&models.Invite{}).Error; err != nil {
 		return err
 	}
 
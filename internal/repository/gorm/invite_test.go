@@ -1,6 +1,7 @@
 package gorm_test
 
 import (
+	"context"
 	"testing"
 	"time"
 
@@ -27,12 +28,12 @@ func TestCreateInvite(t *testing.T) {
 		ProjectID: 1,
 	}
 
-	invite, err := tester.repo.Invite().CreateInvite(invite)
+	invite, err := tester.repo.Invite().CreateInvite(context.Background(), invite)
 	if err != nil {
 		t.Fatalf("%v\n", err)
 	}
 
-	invite, err = tester.repo.Invite().ReadInvite(1, invite.Model.ID)
+	invite, err = tester.repo.Invite().ReadInvite(context.Background(), 1, invite.Model.ID)
 
 	if err != nil {
 		t.Fatalf("%v\n", err)
@@ -67,6 +68,7 @@ func TestListInvitesByProjectID(t *testing.T) {
 	defer cleanup(tester, t)
 
 	invites, err := tester.repo.Invite().ListInvitesByProjectID(
+		context.Background(),
 		tester.initProjects[0].Model.ID,
 	)
 	if err != nil {
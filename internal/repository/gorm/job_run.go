@@ -0,0 +1,68 @@
+package gorm
+
+import (
+	"context"
+	"time"
+
+	"github.com/porter-dev/porter/internal/models"
+	"github.com/porter-dev/porter/internal/repository"
+	"gorm.io/gorm"
+)
+
+// JobRunRepository uses gorm.DB for querying the database
+type JobRunRepository struct {
+	db *gorm.DB
+}
+
+// NewJobRunRepository returns a JobRunRepository which uses
+// gorm.DB for querying the database
+func NewJobRunRepository(db *gorm.DB) repository.JobRunRepository {
+	return &JobRunRepository{db}
+}
+
+// ReadJobRunByJobName finds the run of a kubernetes job in a cluster
+func (repo *JobRunRepository) ReadJobRunByJobName(ctx context.Context, clusterID uint, namespace, jobName string) (*models.JobRun, error) {
+	run := &models.JobRun{}
+
+	if err := repo.db.WithContext(ctx).Where("cluster_id = ? AND namespace = ? AND job_name = ?", clusterID, namespace, jobName).First(run).Error; err != nil {
+		return nil, err
+	}
+
+	return run, nil
+}
+
+// CreateJobRun creates a new job run
+func (repo *JobRunRepository) CreateJobRun(ctx context.Context, run *models.JobRun) (*models.JobRun, error) {
+	if err := repo.db.WithContext(ctx).Create(run).Error; err != nil {
+		return nil, err
+	}
+
+	return run, nil
+}
+
+// UpdateJobRun updates an existing job run
+func (repo *JobRunRepository) UpdateJobRun(ctx context.Context, run *models.JobRun) (*models.JobRun, error) {
+	if err := repo.db.WithContext(ctx).Save(run).Error; err != nil {
+		return nil, err
+	}
+
+	return run, nil
+}
+
+// ListJobRuns lists the runs of a job service of an app that started after since, most recent first
+func (repo *JobRunRepository) ListJobRuns(ctx context.Context, porterAppID uint, serviceName string, since time.Time, limit int) ([]*models.JobRun, error) {
+	runs := []*models.JobRun{}
+
+	query := repo.db.WithContext(ctx).Where("porter_app_id = ? AND service_name = ? AND started_at >= ?", porterAppID, serviceName, since).
+		Order("started_at desc")
+
+	if limit > 0 {
+		query = query.Limit(limit)
+	}
+
+	if err := query.Find(&runs).Error; err != nil {
+		return nil, err
+	}
+
+	return runs, nil
+}
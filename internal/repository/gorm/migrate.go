@@ -64,6 +64,30 @@ func AutoMigrate(db *gorm.DB, debug bool) error {
 		&models.PorterAppEvent{},
 		&models.AppRevision{},
 		&models.DeploymentTarget{},
+		&models.ProjectWebhook{},
+		&models.ProjectWebhookDelivery{},
+		&models.JobRun{},
+		&models.AppRevisionMetadata{},
+		&models.SCIMUser{},
+		&models.SCIMGroup{},
+		&models.SCIMGroupRoleMapping{},
+		&models.UserMFA{},
+		&models.AppResourceRequest{},
+		&models.ServiceAccount{},
+		&models.AlertRule{},
+		&models.EventSink{},
+		&models.Addon{},
+		&models.Datastore{},
+		&models.DatastoreLink{},
+		&models.CustomCertificate{},
+		&models.CustomDomain{},
+		&models.WildcardDomain{},
+		&models.SubdomainAllocation{},
+		&models.AppTemplate{},
+		&models.ImageAttestation{},
+		&models.ImageSigningPolicy{},
+		&models.EnvironmentGroupVersion{},
+		&models.RegistryGCPolicy{},
 		&ints.KubeIntegration{},
 		&ints.BasicIntegration{},
 		&ints.OIDCIntegration{},
@@ -73,6 +97,7 @@ func AutoMigrate(db *gorm.DB, debug bool) error {
 		&ints.AzureIntegration{},
 		&ints.GitlabIntegration{},
 		&ints.GitlabAppOAuthIntegration{},
+		&ints.ExternalSecretsIntegration{},
 		&ints.TokenCache{},
 		&ints.ClusterTokenCache{},
 		&ints.RegTokenCache{},
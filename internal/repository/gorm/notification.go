@@ -1,6 +1,8 @@
 package gorm
 
 import (
+	"context"
+
 	"github.com/porter-dev/porter/internal/models"
 	"github.com/porter-dev/porter/internal/repository"
 	"gorm.io/gorm"
@@ -16,18 +18,18 @@ func NewNotificationConfigRepository(db *gorm.DB) repository.NotificationConfigR
 }
 
 // CreateNotificationConfig creates a new NotificationConfig
-func (repo NotificationConfigRepository) CreateNotificationConfig(am *models.NotificationConfig) (*models.NotificationConfig, error) {
-	if err := repo.db.Create(am).Error; err != nil {
+func (repo NotificationConfigRepository) CreateNotificationConfig(ctx context.Context, am *models.NotificationConfig) (*models.NotificationConfig, error) {
+	if err := repo.db.WithContext(ctx).Create(am).Error; err != nil {
 		return nil, err
 	}
 	return am, nil
 }
 
 // ReadNotificationConfig reads a NotificationConfig by ID
-func (repo NotificationConfigRepository) ReadNotificationConfig(id uint) (*models.NotificationConfig, error) {
+func (repo NotificationConfigRepository) ReadNotificationConfig(ctx context.Context, id uint) (*models.NotificationConfig, error) {
 	ret := &models.NotificationConfig{}
 
-	if err := repo.db.Where("id = ?", id).First(&ret).Error; err != nil {
+	if err := repo.db.WithContext(ctx).Where("id = ?", id).First(&ret).Error; err != nil {
 		return nil, err
 	}
 
@@ -35,8 +37,8 @@ func (repo NotificationConfigRepository) ReadNotificationConfig(id uint) (*model
 }
 
 // UpdateNotificationConfig updates a given NotificationConfig
-func (repo NotificationConfigRepository) UpdateNotificationConfig(am *models.NotificationConfig) (*models.NotificationConfig, error) {
-	if err := repo.db.Save(am).Error; err != nil {
+func (repo NotificationConfigRepository) UpdateNotificationConfig(ctx context.Context, am *models.NotificationConfig) (*models.NotificationConfig, error) {
+	if err := repo.db.WithContext(ctx).Save(am).Error; err != nil {
 		return nil, err
 	}
 
@@ -53,10 +55,10 @@ func NewJobNotificationConfigRepository(db *gorm.DB) repository.JobNotificationC
 }
 
 // CreateNotificationConfig creates a new JobNotificationConfig
-func (repo JobNotificationConfigRepository) CreateNotificationConfig(am *models.JobNotificationConfig) (*models.JobNotificationConfig, error) {
+func (repo JobNotificationConfigRepository) CreateNotificationConfig(ctx context.Context, am *models.JobNotificationConfig) (*models.JobNotificationConfig, error) {
 	var count int64
 
-	query := repo.db.Where("project_id = ? AND cluster_id = ?", am.ProjectID, am.ClusterID)
+	query := repo.db.WithContext(ctx).Where("project_id = ? AND cluster_id = ?", am.ProjectID, am.ClusterID)
 
 	if err := query.Model([]*models.JobNotificationConfig{}).Count(&count).Error; err != nil {
 		return nil, err
@@ -65,7 +67,7 @@ func (repo JobNotificationConfigRepository) CreateNotificationConfig(am *models.
 	// if the count is greater than 1000, remove the lowest-order events to implement a
 	// basic fixed-length buffer
 	if count >= 1000 {
-		err := repo.db.Exec(`
+		err := repo.db.WithContext(ctx).Exec(`
 			  DELETE FROM job_notification_configs 
 			  WHERE project_id = ? AND cluster_id = ? AND 
 			  id NOT IN (
@@ -77,7 +79,7 @@ func (repo JobNotificationConfigRepository) CreateNotificationConfig(am *models.
 		}
 	}
 
-	if err := repo.db.Create(am).Error; err != nil {
+	if err := repo.db.WithContext(ctx).Create(am).Error; err != nil {
 		return nil, err
 	}
 
@@ -85,10 +87,10 @@ func (repo JobNotificationConfigRepository) CreateNotificationConfig(am *models.
 }
 
 // ReadNotificationConfig reads a JobNotificationConfig by ID
-func (repo JobNotificationConfigRepository) ReadNotificationConfig(projID, clusterID uint, name, namespace string) (*models.JobNotificationConfig, error) {
+func (repo JobNotificationConfigRepository) ReadNotificationConfig(ctx context.Context, projID, clusterID uint, name, namespace string) (*models.JobNotificationConfig, error) {
 	ret := &models.JobNotificationConfig{}
 
-	if err := repo.db.Where("project_id = ? AND cluster_id = ? AND name = ? AND namespace = ?", projID, clusterID, name, namespace).First(&ret).Error; err != nil {
+	if err := repo.db.WithContext(ctx).Where("project_id = ? AND cluster_id = ? AND name = ? AND namespace = ?", projID, clusterID, name, namespace).First(&ret).Error; err != nil {
 		return nil, err
 	}
 
@@ -96,8 +98,8 @@ func (repo JobNotificationConfigRepository) ReadNotificationConfig(projID, clust
 }
 
 // UpdateNotificationConfig updates a given JobNotificationConfig
-func (repo JobNotificationConfigRepository) UpdateNotificationConfig(am *models.JobNotificationConfig) (*models.JobNotificationConfig, error) {
-	if err := repo.db.Save(am).Error; err != nil {
+func (repo JobNotificationConfigRepository) UpdateNotificationConfig(ctx context.Context, am *models.JobNotificationConfig) (*models.JobNotificationConfig, error) {
+	if err := repo.db.WithContext(ctx).Save(am).Error; err != nil {
 		return nil, err
 	}
 
@@ -1,6 +1,8 @@
 package gorm
 
 import (
+	"context"
+
 	"github.com/porter-dev/porter/internal/models"
 	"github.com/porter-dev/porter/internal/repository"
 	"gorm.io/gorm"
@@ -18,9 +20,10 @@ func NewProjectOnboardingRepository(db *gorm.DB) repository.ProjectOnboardingRep
 
 // CreateProjectOnboarding creates a new project onboarding limit
 func (repo *ProjectOnboardingRepository) CreateProjectOnboarding(
+	ctx context.Context,
 	onboarding *models.Onboarding,
 ) (*models.Onboarding, error) {
-	if err := repo.db.Create(onboarding).Error; err != nil {
+	if err := repo.db.WithContext(ctx).Create(onboarding).Error; err != nil {
 		return nil, err
 	}
 
@@ -29,11 +32,12 @@ func (repo *ProjectOnboardingRepository) CreateProjectOnboarding(
 
 // ReadProjectOnboarding finds the project onboarding matching a project ID
 func (repo *ProjectOnboardingRepository) ReadProjectOnboarding(
+	ctx context.Context,
 	projID uint,
 ) (*models.Onboarding, error) {
 	res := &models.Onboarding{}
 
-	if err := repo.db.Where("project_id = ?", projID).First(res).Error; err != nil {
+	if err := repo.db.WithContext(ctx).Where("project_id = ?", projID).First(res).Error; err != nil {
 		return nil, err
 	}
 
@@ -42,9 +46,10 @@ func (repo *ProjectOnboardingRepository) ReadProjectOnboarding(
 
 // UpdateProjectOnboarding modifies an existing ProjectOnboarding in the database
 func (repo *ProjectOnboardingRepository) UpdateProjectOnboarding(
+	ctx context.Context,
 	onboarding *models.Onboarding,
 ) (*models.Onboarding, error) {
-	if err := repo.db.Save(onboarding).Error; err != nil {
+	if err := repo.db.WithContext(ctx).Save(onboarding).Error; err != nil {
 		return nil, err
 	}
 
@@ -1,6 +1,8 @@
 package gorm
 
 import (
+	"context"
+
 	"github.com/porter-dev/porter/internal/models"
 	"github.com/porter-dev/porter/internal/repository"
 	"gorm.io/gorm"
@@ -17,27 +19,27 @@ func NewPolicyRepository(db *gorm.DB) repository.PolicyRepository {
 	return &PolicyRepository{db}
 }
 
-func (repo *PolicyRepository) CreatePolicy(a *models.Policy) (*models.Policy, error) {
-	if err := repo.db.Create(a).Error; err != nil {
+func (repo *PolicyRepository) CreatePolicy(ctx context.Context, a *models.Policy) (*models.Policy, error) {
+	if err := repo.db.WithContext(ctx).Create(a).Error; err != nil {
 		return nil, err
 	}
 	return a, nil
 }
 
-func (repo *PolicyRepository) ListPoliciesByProjectID(projectID uint) ([]*models.Policy, error) {
+func (repo *PolicyRepository) ListPoliciesByProjectID(ctx context.Context, projectID uint) ([]*models.Policy, error) {
 	policys := []*models.Policy{}
 
-	if err := repo.db.Where("project_id = ?", projectID).Find(&policys).Error; err != nil {
+	if err := repo.db.WithContext(ctx).Where("project_id = ?", projectID).Find(&policys).Error; err != nil {
 		return nil, err
 	}
 
 	return policys, nil
 }
 
-func (repo *PolicyRepository) ReadPolicy(projectID uint, uid string) (*models.Policy, error) {
+func (repo *PolicyRepository) ReadPolicy(ctx context.Context, projectID uint, uid string) (*models.Policy, error) {
 	policy := &models.Policy{}
 
-	if err := repo.db.Where("project_id = ? AND unique_id = ?", projectID, uid).First(&policy).Error; err != nil {
+	if err := repo.db.WithContext(ctx).Where("project_id = ? AND unique_id = ?", projectID, uid).First(&policy).Error; err != nil {
 		return nil, err
 	}
 
@@ -45,9 +47,10 @@ func (repo *PolicyRepository) ReadPolicy(projectID uint, uid string) (*models.Po
 }
 
 func (repo *PolicyRepository) UpdatePolicy(
+	ctx context.Context,
 	policy *models.Policy,
 ) (*models.Policy, error) {
-	if err := repo.db.Save(policy).Error; err != nil {
+	if err := repo.db.WithContext(ctx).Save(policy).Error; err != nil {
 		return nil, err
 	}
 
@@ -55,9 +58,10 @@ func (repo *PolicyRepository) UpdatePolicy(
 }
 
 func (repo *PolicyRepository) DeletePolicy(
+	ctx context.Context,
 	policy *models.Policy,
 ) (*models.Policy, error) {
-	if err := repo.db.Delete(&policy).Error; err != nil {
+	if err := repo.db.WithContext(ctx).Delete(&policy).Error; err != nil {
 		return nil, err
 	}
 	return policy, nil
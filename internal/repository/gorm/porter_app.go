@@ -1,6 +1,8 @@
 package gorm
 
 import (
+	"context"
+
 	"github.com/porter-dev/porter/internal/models"
 	"github.com/porter-dev/porter/internal/repository"
 	"gorm.io/gorm"
@@ -17,27 +19,38 @@ func NewPorterAppRepository(db *gorm.DB) repository.PorterAppRepository {
 	return &PorterAppRepository{db}
 }
 
-func (repo *PorterAppRepository) CreatePorterApp(a *models.PorterApp) (*models.PorterApp, error) {
-	if err := repo.db.Create(a).Error; err != nil {
+func (repo *PorterAppRepository) CreatePorterApp(ctx context.Context, a *models.PorterApp) (*models.PorterApp, error) {
+	if err := repo.db.WithContext(ctx).Create(a).Error; err != nil {
 		return nil, err
 	}
 	return a, nil
 }
 
-func (repo *PorterAppRepository) ListPorterAppByClusterID(clusterID uint) ([]*models.PorterApp, error) {
+func (repo *PorterAppRepository) ListPorterAppByClusterID(ctx context.Context, clusterID uint) ([]*models.PorterApp, error) {
+	apps := []*models.PorterApp{}
+
+	if err := repo.db.WithContext(ctx).Where("cluster_id = ?", clusterID).Find(&apps).Error; err != nil {
+		return nil, err
+	}
+
+	return apps, nil
+}
+
+// ListPorterAppsByProjectID lists the apps in all clusters of a project
+func (repo *PorterAppRepository) ListPorterAppsByProjectID(ctx context.Context, projectID uint) ([]*models.PorterApp, error) {
 	apps := []*models.PorterApp{}
 
-	if err := repo.db.Where("cluster_id = ?", clusterID).Find(&apps).Error; err != nil {
+	if err := repo.db.WithContext(ctx).Where("project_id = ?", projectID).Find(&apps).Error; err != nil {
 		return nil, err
 	}
 
 	return apps, nil
 }
 
-func (repo *PorterAppRepository) ReadPorterAppByName(clusterID uint, name string) (*models.PorterApp, error) {
+func (repo *PorterAppRepository) ReadPorterAppByName(ctx context.Context, clusterID uint, name string) (*models.PorterApp, error) {
 	app := &models.PorterApp{}
 
-	if err := repo.db.Where("cluster_id = ? AND name = ?", clusterID, name).Limit(1).Find(&app).Error; err != nil {
+	if err := repo.db.WithContext(ctx).Where("cluster_id = ? AND name = ?", clusterID, name).Limit(1).Find(&app).Error; err != nil {
 		return nil, err
 	}
 
@@ -46,26 +59,58 @@ func (repo *PorterAppRepository) ReadPorterAppByName(clusterID uint, name string
 
 // ReadPorterAppsByProjectIDAndName returns a list of PorterApps by project ID and name. Multiple apps can have the same name and project id
 // if they are in different clusters.
-func (repo *PorterAppRepository) ReadPorterAppsByProjectIDAndName(projectID uint, name string) ([]*models.PorterApp, error) {
+func (repo *PorterAppRepository) ReadPorterAppsByProjectIDAndName(ctx context.Context, projectID uint, name string) ([]*models.PorterApp, error) {
+	apps := []*models.PorterApp{}
+
+	if err := repo.db.WithContext(ctx).Where("project_id = ? AND name = ?", projectID, name).Find(&apps).Error; err != nil {
+		return nil, err
+	}
+
+	return apps, nil
+}
+
+// ReadPorterAppsByGitRepo returns all PorterApps deployed from a git repo, given the installation ID and full name (owner/repo) of the repo
+func (repo *PorterAppRepository) ReadPorterAppsByGitRepo(ctx context.Context, gitRepoID uint, repoName string) ([]*models.PorterApp, error) {
 	apps := []*models.PorterApp{}
 
-	if err := repo.db.Where("project_id = ? AND name = ?", projectID, name).Find(&apps).Error; err != nil {
+	if err := repo.db.WithContext(ctx).Where("git_repo_id = ? AND repo_name = ?", gitRepoID, repoName).Find(&apps).Error; err != nil {
 		return nil, err
 	}
 
 	return apps, nil
 }
 
-func (repo *PorterAppRepository) UpdatePorterApp(app *models.PorterApp) (*models.PorterApp, error) {
-	if err := repo.db.Save(app).Error; err != nil {
+func (repo *PorterAppRepository) UpdatePorterApp(ctx context.Context, app *models.PorterApp) (*models.PorterApp, error) {
+	// the config revision is only written by IncrementPorterAppConfigRevision, so that saving a stale copy of the app does
+	// not revert it
+	if err := repo.db.WithContext(ctx).Omit("config_revision").Save(app).Error; err != nil {
 		return nil, err
 	}
 
 	return app, nil
 }
 
-func (repo *PorterAppRepository) DeletePorterApp(app *models.PorterApp) (*models.PorterApp, error) {
-	if err := repo.db.Delete(&app).Error; err != nil {
+// IncrementPorterAppConfigRevision increments the config revision of an app if it has not changed since the app was read,
+// and returns false if it has
+func (repo *PorterAppRepository) IncrementPorterAppConfigRevision(ctx context.Context, app *models.PorterApp) (bool, error) {
+	res := repo.db.WithContext(ctx).Model(&models.PorterApp{}).
+		Where("id = ? AND config_revision = ?", app.ID, app.ConfigRevision).
+		UpdateColumn("config_revision", gorm.Expr("config_revision + 1"))
+	if res.Error != nil {
+		return false, res.Error
+	}
+
+	if res.RowsAffected == 0 {
+		return false, nil
+	}
+
+	app.ConfigRevision++
+
+	return true, nil
+}
+
+func (repo *PorterAppRepository) DeletePorterApp(ctx context.Context, app *models.PorterApp) (*models.PorterApp, error) {
+	if err := repo.db.WithContext(ctx).Delete(&app).Error; err != nil {
 		return nil, err
 	}
 
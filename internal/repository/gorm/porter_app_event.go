@@ -34,7 +34,7 @@ func (repo *PorterAppEventRepository) ListEventsByPorterAppID(ctx context.Contex
 		return nil, paginatedResult, errors.New("invalid porter app id supplied")
 	}
 
-	db := repo.db.Model(&models.PorterAppEvent{})
+	db := repo.db.WithContext(ctx).Model(&models.PorterAppEvent{})
 	resultDB := db.Where("porter_app_id = ?", id).Order("created_at DESC")
 	resultDB = resultDB.Scopes(helpers.Paginate(db, &paginatedResult, opts...))
 
@@ -61,7 +61,7 @@ func (repo *PorterAppEventRepository) CreateEvent(ctx context.Context, appEvent
 		return errors.New("invalid porter app id supplied to create event")
 	}
 
-	if err := repo.db.Create(appEvent).Error; err != nil {
+	if err := repo.db.WithContext(ctx).Create(appEvent).Error; err != nil {
 		return err
 	}
 	return nil
@@ -81,7 +81,7 @@ func (repo *PorterAppEventRepository) UpdateEvent(ctx context.Context, appEvent
 		appEvent.UpdatedAt = time.Now().UTC()
 	}
 
-	if err := repo.db.Model(appEvent).Updates(appEvent).Error; err != nil {
+	if err := repo.db.WithContext(ctx).Model(appEvent).Updates(appEvent).Error; err != nil {
 		return err
 	}
 	return nil
@@ -96,7 +96,7 @@ func (repo *PorterAppEventRepository) ReadEvent(ctx context.Context, id uuid.UUI
 
 	strID := id.String()
 
-	if err := repo.db.Where("id = ?", strID).First(&appEvent).Error; err != nil {
+	if err := repo.db.WithContext(ctx).Where("id = ?", strID).First(&appEvent).Error; err != nil {
 		return appEvent, err
 	}
 
@@ -120,7 +120,7 @@ func (repo *PorterAppEventRepository) ReadDeployEventByRevision(ctx context.Cont
 	}
 	strRevision := string(revJSON)
 
-	if err := repo.db.Where("porter_app_id = ? AND type = 'DEPLOY' AND metadata->>'revision' = ?", strAppID, strRevision).First(&appEvent).Error; err != nil {
+	if err := repo.db.WithContext(ctx).Where("porter_app_id = ? AND type = 'DEPLOY' AND metadata->>'revision' = ?", strAppID, strRevision).First(&appEvent).Error; err != nil {
 		return appEvent, err
 	}
 
@@ -0,0 +1,90 @@
+package gorm_test
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"testing"
+
+	"github.com/porter-dev/porter/internal/encryption"
+	"github.com/porter-dev/porter/internal/models"
+)
+
+func TestIncrementPorterAppConfigRevision(t *testing.T) {
+	suffix, _ := encryption.GenerateRandomBytes(4)
+
+	tester := &tester{
+		dbFileName: fmt.Sprintf("./porter_app_config_revision_%s.db", suffix),
+	}
+
+	setupTestEnv(tester, t)
+	defer cleanup(tester, t)
+
+	app, err := tester.repo.PorterApp().CreatePorterApp(context.Background(), &models.PorterApp{ProjectID: 1, ClusterID: 1, Name: "app"})
+	if err != nil {
+		t.Fatalf("%v\n", err)
+	}
+
+	first, err := tester.repo.PorterApp().ReadPorterAppByName(context.Background(), 1, "app")
+	if err != nil {
+		t.Fatalf("%v\n", err)
+	}
+
+	second, err := tester.repo.PorterApp().ReadPorterAppByName(context.Background(), 1, "app")
+	if err != nil {
+		t.Fatalf("%v\n", err)
+	}
+
+	ok, err := tester.repo.PorterApp().IncrementPorterAppConfigRevision(context.Background(), first)
+	if err != nil {
+		t.Fatalf("%v\n", err)
+	}
+
+	if !ok || first.ConfigRevision != 1 {
+		t.Fatalf("expected first write to increment the config revision to 1, got %t and %d", ok, first.ConfigRevision)
+	}
+
+	ok, err = tester.repo.PorterApp().IncrementPorterAppConfigRevision(context.Background(), second)
+	if err != nil {
+		t.Fatalf("%v\n", err)
+	}
+
+	if ok || second.ConfigRevision != 0 {
+		t.Fatalf("expected stale write to be rejected, got %t and %d", ok, second.ConfigRevision)
+	}
+
+	// saving a stale copy of the app does not revert its config revision
+	second.GitBranch = "main"
+	if _, err := tester.repo.PorterApp().UpdatePorterApp(context.Background(), second); err != nil {
+		t.Fatalf("%v\n", err)
+	}
+
+	app, err = tester.repo.PorterApp().ReadPorterAppByName(context.Background(), 1, "app")
+	if err != nil {
+		t.Fatalf("%v\n", err)
+	}
+
+	if app.ConfigRevision != 1 || app.GitBranch != "main" {
+		t.Errorf("expected config revision 1 and branch main, got %d and %s", app.ConfigRevision, app.GitBranch)
+	}
+}
+
+func TestReadPorterAppCanceledContext(t *testing.T) {
+	suffix, _ := encryption.GenerateRandomBytes(4)
+
+	tester := &tester{
+		dbFileName: fmt.Sprintf("./porter_app_canceled_context_%s.db", suffix),
+	}
+
+	setupTestEnv(tester, t)
+	defer cleanup(tester, t)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	// queries are run with the context of the caller, so they are not run once it is canceled
+	_, err := tester.repo.PorterApp().ReadPorterAppByName(ctx, 1, "app")
+	if !errors.Is(err, context.Canceled) {
+		t.Fatalf("expected context canceled error, got %v", err)
+	}
+}
@@ -1,6 +1,8 @@
 package gorm
 
 import (
+	"context"
+
 	"github.com/porter-dev/porter/internal/models"
 	"github.com/porter-dev/porter/internal/repository"
 	"gorm.io/gorm"
@@ -18,8 +20,8 @@ func NewProjectRepository(db *gorm.DB) repository.ProjectRepository {
 }
 
 // CreateProject creates a new project
-func (repo *ProjectRepository) CreateProject(project *models.Project) (*models.Project, error) {
-	if err := repo.db.Create(project).Error; err != nil {
+func (repo *ProjectRepository) CreateProject(ctx context.Context, project *models.Project) (*models.Project, error) {
+	if err := repo.db.WithContext(ctx).Create(project).Error; err != nil {
 		return nil, err
 	}
 
@@ -27,8 +29,8 @@ func (repo *ProjectRepository) CreateProject(project *models.Project) (*models.P
 }
 
 // CreateProjectRole appends a role to the existing array of roles
-func (repo *ProjectRepository) CreateProjectRole(project *models.Project, role *models.Role) (*models.Role, error) {
-	assoc := repo.db.Model(&project).Association("Roles")
+func (repo *ProjectRepository) CreateProjectRole(ctx context.Context, project *models.Project, role *models.Role) (*models.Role, error) {
+	assoc := repo.db.WithContext(ctx).Model(&project).Association("Roles")
 
 	if assoc.Error != nil {
 		return nil, assoc.Error
@@ -42,24 +44,24 @@ func (repo *ProjectRepository) CreateProjectRole(project *models.Project, role *
 }
 
 // UpdateProject updates an existing project
-func (repo *ProjectRepository) UpdateProject(project *models.Project) (*models.Project, error) {
-	if err := repo.db.Save(project).Error; err != nil {
+func (repo *ProjectRepository) UpdateProject(ctx context.Context, project *models.Project) (*models.Project, error) {
+	if err := repo.db.WithContext(ctx).Save(project).Error; err != nil {
 		return nil, err
 	}
 
 	return project, nil
 }
 
-func (repo *ProjectRepository) UpdateProjectRole(projID uint, role *models.Role) (*models.Role, error) {
+func (repo *ProjectRepository) UpdateProjectRole(ctx context.Context, projID uint, role *models.Role) (*models.Role, error) {
 	foundRole := &models.Role{}
 
-	if err := repo.db.Where("project_id = ? AND user_id = ?", projID, role.UserID).First(&foundRole).Error; err != nil {
+	if err := repo.db.WithContext(ctx).Where("project_id = ? AND user_id = ?", projID, role.UserID).First(&foundRole).Error; err != nil {
 		return nil, err
 	}
 
 	role.ID = foundRole.ID
 
-	if err := repo.db.Save(&role).Error; err != nil {
+	if err := repo.db.WithContext(ctx).Save(&role).Error; err != nil {
 		return nil, err
 	}
 
@@ -67,10 +69,10 @@ func (repo *ProjectRepository) UpdateProjectRole(projID uint, role *models.Role)
 }
 
 // ReadProject gets a projects specified by a unique id
-func (repo *ProjectRepository) ReadProject(id uint) (*models.Project, error) {
+func (repo *ProjectRepository) ReadProject(ctx context.Context, id uint) (*models.Project, error) {
 	project := &models.Project{}
 
-	if err := repo.db.Preload("Roles").Where("id = ?", id).First(&project).Error; err != nil {
+	if err := repo.db.WithContext(ctx).Preload("Roles").Where("id = ?", id).First(&project).Error; err != nil {
 		return nil, err
 	}
 
@@ -78,11 +80,11 @@ func (repo *ProjectRepository) ReadProject(id uint) (*models.Project, error) {
 }
 
 // ReadProject gets a projects specified by a unique id
-func (repo *ProjectRepository) ReadProjectRole(projID, userID uint) (*models.Role, error) {
+func (repo *ProjectRepository) ReadProjectRole(ctx context.Context, projID, userID uint) (*models.Role, error) {
 	// find the role
 	role := &models.Role{}
 
-	if err := repo.db.Where("project_id = ? AND user_id = ?", projID, userID).First(&role).Error; err != nil {
+	if err := repo.db.WithContext(ctx).Where("project_id = ? AND user_id = ?", projID, userID).First(&role).Error; err != nil {
 		return nil, err
 	}
 
@@ -90,12 +92,12 @@ func (repo *ProjectRepository) ReadProjectRole(projID, userID uint) (*models.Rol
 }
 
 // ListProjectsByUserID lists projects where a user has an associated role
-func (repo *ProjectRepository) ListProjectsByUserID(userID uint) ([]*models.Project, error) {
+func (repo *ProjectRepository) ListProjectsByUserID(ctx context.Context, userID uint) ([]*models.Project, error) {
 	projects := make([]*models.Project, 0)
 
-	subQuery := repo.db.Model(&models.Role{}).Where("user_id = ?", userID).Select("project_id")
+	subQuery := repo.db.WithContext(ctx).Model(&models.Role{}).Where("user_id = ?", userID).Select("project_id")
 
-	if err := repo.db.Preload("Roles").Model(&models.Project{}).Where("id IN (?)", subQuery).Find(&projects).Error; err != nil {
+	if err := repo.db.WithContext(ctx).Preload("Roles").Model(&models.Project{}).Where("id IN (?)", subQuery).Find(&projects).Error; err != nil {
 		return nil, err
 	}
 
@@ -103,10 +105,10 @@ func (repo *ProjectRepository) ListProjectsByUserID(userID uint) ([]*models.Proj
 }
 
 // ReadProject gets a projects specified by a unique id
-func (repo *ProjectRepository) ListProjectRoles(projID uint) ([]models.Role, error) {
+func (repo *ProjectRepository) ListProjectRoles(ctx context.Context, projID uint) ([]models.Role, error) {
 	project := &models.Project{}
 
-	if err := repo.db.Preload("Roles").Where("id = ?", projID).First(&project).Error; err != nil {
+	if err := repo.db.WithContext(ctx).Preload("Roles").Where("id = ?", projID).First(&project).Error; err != nil {
 		return nil, err
 	}
 
@@ -114,22 +116,22 @@ func (repo *ProjectRepository) ListProjectRoles(projID uint) ([]models.Role, err
 }
 
 // DeleteProject deletes a project (marking deleted in the db)
-func (repo *ProjectRepository) DeleteProject(project *models.Project) (*models.Project, error) {
-	if err := repo.db.Delete(&project).Error; err != nil {
+func (repo *ProjectRepository) DeleteProject(ctx context.Context, project *models.Project) (*models.Project, error) {
+	if err := repo.db.WithContext(ctx).Delete(&project).Error; err != nil {
 		return nil, err
 	}
 	return project, nil
 }
 
-func (repo *ProjectRepository) DeleteProjectRole(projID, userID uint) (*models.Role, error) {
+func (repo *ProjectRepository) DeleteProjectRole(ctx context.Context, projID, userID uint) (*models.Role, error) {
 	// find the role
 	role := &models.Role{}
 
-	if err := repo.db.Where("project_id = ? AND user_id = ?", projID, userID).First(&role).Error; err != nil {
+	if err := repo.db.WithContext(ctx).Where("project_id = ? AND user_id = ?", projID, userID).First(&role).Error; err != nil {
 		return nil, err
 	}
 
-	if err := repo.db.Delete(&role).Error; err != nil {
+	if err := repo.db.WithContext(ctx).Delete(&role).Error; err != nil {
 		return nil, err
 	}
 
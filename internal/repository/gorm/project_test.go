@@ -1,6 +1,7 @@
 package gorm_test
 
 import (
+	"context"
 	"testing"
 
 	"github.com/go-test/deep"
@@ -23,12 +24,12 @@ func TestCreateProject(t *testing.T) {
 		Name: "project-test",
 	}
 
-	proj, err := tester.repo.Project().CreateProject(proj)
+	proj, err := tester.repo.Project().CreateProject(context.Background(), proj)
 	if err != nil {
 		t.Fatalf("%v\n", err)
 	}
 
-	proj, err = tester.repo.Project().ReadProject(proj.Model.ID)
+	proj, err = tester.repo.Project().ReadProject(context.Background(), proj.Model.ID)
 
 	if err != nil {
 		t.Fatalf("%v\n", err)
@@ -61,12 +62,12 @@ func TestCreateProjectRole(t *testing.T) {
 		},
 	}
 
-	role, err := tester.repo.Project().CreateProjectRole(tester.initProjects[0], role)
+	role, err := tester.repo.Project().CreateProjectRole(context.Background(), tester.initProjects[0], role)
 	if err != nil {
 		t.Fatalf("%v\n", err)
 	}
 
-	proj, err := tester.repo.Project().ReadProject(tester.initProjects[0].Model.ID)
+	proj, err := tester.repo.Project().ReadProject(context.Background(), tester.initProjects[0].Model.ID)
 	if err != nil {
 		t.Fatalf("%v\n", err)
 	}
@@ -129,12 +130,12 @@ func TestUpdateProjectRole(t *testing.T) {
 		},
 	}
 
-	role, err := tester.repo.Project().UpdateProjectRole(tester.initProjects[0].Model.ID, role)
+	role, err := tester.repo.Project().UpdateProjectRole(context.Background(), tester.initProjects[0].Model.ID, role)
 	if err != nil {
 		t.Fatalf("%v\n", err)
 	}
 
-	proj, err := tester.repo.Project().ReadProject(tester.initProjects[0].Model.ID)
+	proj, err := tester.repo.Project().ReadProject(context.Background(), tester.initProjects[0].Model.ID)
 	if err != nil {
 		t.Fatalf("%v\n", err)
 	}
@@ -197,14 +198,14 @@ func TestListProjectsByUserID(t *testing.T) {
 		},
 	}
 
-	role, err := tester.repo.Project().CreateProjectRole(tester.initProjects[1], role)
+	role, err := tester.repo.Project().CreateProjectRole(context.Background(), tester.initProjects[1], role)
 	if err != nil {
 		t.Fatalf("%v\n", err)
 	}
 
 	defer cleanup(tester, t)
 
-	projects, err := tester.repo.Project().ListProjectsByUserID(tester.initUsers[0].Model.ID)
+	projects, err := tester.repo.Project().ListProjectsByUserID(context.Background(), tester.initUsers[0].Model.ID)
 	if err != nil {
 		t.Fatalf("%v\n", err)
 	}
@@ -255,7 +256,7 @@ func TestReadProjectRole(t *testing.T) {
 
 	defer cleanup(tester, t)
 
-	role, err := tester.repo.Project().ReadProjectRole(1, 1)
+	role, err := tester.repo.Project().ReadProjectRole(context.Background(), 1, 1)
 	if err != nil {
 		t.Fatalf("%v\n", err)
 	}
@@ -285,13 +286,13 @@ func TestDeleteProject(t *testing.T) {
 	initProject(tester, t)
 	defer cleanup(tester, t)
 
-	proj, err := tester.repo.Project().DeleteProject(tester.initProjects[0])
+	proj, err := tester.repo.Project().DeleteProject(context.Background(), tester.initProjects[0])
 	if err != nil {
 		t.Fatalf("%v\n", err)
 	}
 
 	// attempt to read the project and ensure that the error is gorm.ErrRecordNotFound
-	_, err = tester.repo.Project().ReadProject(proj.Model.ID)
+	_, err = tester.repo.Project().ReadProject(context.Background(), proj.Model.ID)
 
 	if err != gorm.ErrRecordNotFound {
 		t.Fatalf("read should have returned record not found: returned %v\n", err)
@@ -309,13 +310,13 @@ func TestDeleteProjectRole(t *testing.T) {
 	initProjectRole(tester, t)
 	defer cleanup(tester, t)
 
-	_, err := tester.repo.Project().DeleteProjectRole(tester.initProjects[0].Model.ID, tester.initUsers[0].Model.ID)
+	_, err := tester.repo.Project().DeleteProjectRole(context.Background(), tester.initProjects[0].Model.ID, tester.initUsers[0].Model.ID)
 	if err != nil {
 		t.Fatalf("%v\n", err)
 	}
 
 	// attempt to read the project and ensure that the error is gorm.ErrRecordNotFound
-	proj, err := tester.repo.Project().ReadProject(tester.initProjects[0].Model.ID)
+	proj, err := tester.repo.Project().ReadProject(context.Background(), tester.initProjects[0].Model.ID)
 	if err != nil {
 		t.Fatalf("%v\n", err)
 	}
@@ -0,0 +1,156 @@
+package gorm
+
+import (
+	"context"
+
+	"github.com/porter-dev/porter/internal/encryption"
+	"github.com/porter-dev/porter/internal/models"
+	"github.com/porter-dev/porter/internal/repository"
+	"gorm.io/gorm"
+)
+
+// ProjectWebhookRepository uses gorm.DB for querying the database
+type ProjectWebhookRepository struct {
+	db  *gorm.DB
+	key *[32]byte
+}
+
+// NewProjectWebhookRepository returns a ProjectWebhookRepository which uses
+// gorm.DB for querying the database. It accepts an encryption key to encrypt
+// webhook secrets
+func NewProjectWebhookRepository(db *gorm.DB, key *[32]byte) repository.ProjectWebhookRepository {
+	return &ProjectWebhookRepository{db, key}
+}
+
+// CreateProjectWebhook creates a new project webhook
+func (repo *ProjectWebhookRepository) CreateProjectWebhook(ctx context.Context, webhook *models.ProjectWebhook) (*models.ProjectWebhook, error) {
+	err := repo.EncryptProjectWebhookData(webhook, repo.key)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := repo.db.WithContext(ctx).Create(webhook).Error; err != nil {
+		return nil, err
+	}
+
+	err = repo.DecryptProjectWebhookData(webhook, repo.key)
+	if err != nil {
+		return nil, err
+	}
+
+	return webhook, nil
+}
+
+// ReadProjectWebhook finds a project webhook by project id and webhook id
+func (repo *ProjectWebhookRepository) ReadProjectWebhook(ctx context.Context, projectID, webhookID uint) (*models.ProjectWebhook, error) {
+	webhook := &models.ProjectWebhook{}
+
+	if err := repo.db.WithContext(ctx).Where("project_id = ? AND id = ?", projectID, webhookID).First(webhook).Error; err != nil {
+		return nil, err
+	}
+
+	err := repo.DecryptProjectWebhookData(webhook, repo.key)
+	if err != nil {
+		return nil, err
+	}
+
+	return webhook, nil
+}
+
+// ListProjectWebhooksByProjectID finds all webhooks for a given project id
+func (repo *ProjectWebhookRepository) ListProjectWebhooksByProjectID(ctx context.Context, projectID uint) ([]*models.ProjectWebhook, error) {
+	webhooks := []*models.ProjectWebhook{}
+
+	if err := repo.db.WithContext(ctx).Where("project_id = ?", projectID).Find(&webhooks).Error; err != nil {
+		return nil, err
+	}
+
+	for _, webhook := range webhooks {
+		err := repo.DecryptProjectWebhookData(webhook, repo.key)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return webhooks, nil
+}
+
+// DeleteProjectWebhook deletes a project webhook
+func (repo *ProjectWebhookRepository) DeleteProjectWebhook(ctx context.Context, webhook *models.ProjectWebhook) error {
+	if err := repo.db.WithContext(ctx).Delete(webhook).Error; err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// CreateProjectWebhookDelivery creates a new delivery record for a webhook
+func (repo *ProjectWebhookRepository) CreateProjectWebhookDelivery(ctx context.Context, delivery *models.ProjectWebhookDelivery) (*models.ProjectWebhookDelivery, error) {
+	if err := repo.db.WithContext(ctx).Create(delivery).Error; err != nil {
+		return nil, err
+	}
+
+	return delivery, nil
+}
+
+// UpdateProjectWebhookDelivery updates a delivery record for a webhook
+func (repo *ProjectWebhookRepository) UpdateProjectWebhookDelivery(ctx context.Context, delivery *models.ProjectWebhookDelivery) (*models.ProjectWebhookDelivery, error) {
+	if err := repo.db.WithContext(ctx).Save(delivery).Error; err != nil {
+		return nil, err
+	}
+
+	return delivery, nil
+}
+
+// ListProjectWebhookDeliveries lists the most recent deliveries for a webhook, newest first
+func (repo *ProjectWebhookRepository) ListProjectWebhookDeliveries(ctx context.Context, webhookID uint, limit int) ([]*models.ProjectWebhookDelivery, error) {
+	deliveries := []*models.ProjectWebhookDelivery{}
+
+	query := repo.db.WithContext(ctx).Where("webhook_id = ?", webhookID).Order("created_at desc")
+
+	if limit > 0 {
+		query = query.Limit(limit)
+	}
+
+	if err := query.Find(&deliveries).Error; err != nil {
+		return nil, err
+	}
+
+	return deliveries, nil
+}
+
+// EncryptProjectWebhookData will encrypt the webhook secret before
+// writing to the DB
+func (repo *ProjectWebhookRepository) EncryptProjectWebhookData(
+	webhook *models.ProjectWebhook,
+	key *[32]byte,
+) error {
+	if len(webhook.Secret) > 0 {
+		cipherData, err := encryption.Encrypt(webhook.Secret, key)
+		if err != nil {
+			return err
+		}
+
+		webhook.Secret = cipherData
+	}
+
+	return nil
+}
+
+// DecryptProjectWebhookData will decrypt the webhook secret before
+// returning it from the DB
+func (repo *ProjectWebhookRepository) DecryptProjectWebhookData(
+	webhook *models.ProjectWebhook,
+	key *[32]byte,
+) error {
+	if len(webhook.Secret) > 0 {
+		plaintext, err := encryption.Decrypt(webhook.Secret, key)
+		if err != nil {
+			return err
+		}
+
+		webhook.Secret = plaintext
+	}
+
+	return nil
+}
@@ -1,6 +1,8 @@
 package gorm
 
 import (
+	"context"
+
 	"github.com/porter-dev/porter/internal/models"
 	"github.com/porter-dev/porter/internal/repository"
 	"gorm.io/gorm"
@@ -18,18 +20,18 @@ func NewPWResetTokenRepository(db *gorm.DB) repository.PWResetTokenRepository {
 }
 
 // CreatePWResetToken creates a new auth code
-func (repo *PWResetTokenRepository) CreatePWResetToken(a *models.PWResetToken) (*models.PWResetToken, error) {
-	if err := repo.db.Create(a).Error; err != nil {
+func (repo *PWResetTokenRepository) CreatePWResetToken(ctx context.Context, a *models.PWResetToken) (*models.PWResetToken, error) {
+	if err := repo.db.WithContext(ctx).Create(a).Error; err != nil {
 		return nil, err
 	}
 	return a, nil
 }
 
 // ReadPWResetToken gets an invite specified by a unique token
-func (repo *PWResetTokenRepository) ReadPWResetToken(id uint) (*models.PWResetToken, error) {
+func (repo *PWResetTokenRepository) ReadPWResetToken(ctx context.Context, id uint) (*models.PWResetToken, error) {
 	pwReset := &models.PWResetToken{}
 
-	if err := repo.db.Where("id = ?", id).First(&pwReset).Error; err != nil {
+	if err := repo.db.WithContext(ctx).Where("id = ?", id).First(&pwReset).Error; err != nil {
 		return nil, err
 	}
 
@@ -38,9 +40,10 @@ func (repo *PWResetTokenRepository) ReadPWResetToken(id uint) (*models.PWResetTo
 
 // UpdatePWResetToken modifies an existing PWResetToken in the database
 func (repo *PWResetTokenRepository) UpdatePWResetToken(
+	ctx context.Context,
 	pwToken *models.PWResetToken,
 ) (*models.PWResetToken, error) {
-	if err := repo.db.Save(pwToken).Error; err != nil {
+	if err := repo.db.WithContext(ctx).Save(pwToken).Error; err != nil {
 		return nil, err
 	}
 
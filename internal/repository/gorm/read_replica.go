@@ -0,0 +1,59 @@
+package gorm
+
+import "gorm.io/gorm"
+
+// Names of the repositories which read heavy list queries from the read replica. These are used to keep
+// a repository reading from the primary with WithPrimaryReads.
+const (
+	ReadReplicaCluster   = "cluster"
+	ReadReplicaKubeEvent = "kube_event"
+)
+
+// RepositoryOption configures the repositories returned by NewRepository
+type RepositoryOption func(*repositoryOptions)
+
+type repositoryOptions struct {
+	readReplica  *gorm.DB
+	primaryReads map[string]bool
+}
+
+// WithReadReplica sends heavy list queries, such as listing the clusters or kube events of a project, to a
+// read-only replica. Replicas lag behind the primary, so writes and all other reads stay on the primary.
+func WithReadReplica(replica *gorm.DB) RepositoryOption {
+	return func(opts *repositoryOptions) {
+		opts.readReplica = replica
+	}
+}
+
+// WithPrimaryReads keeps the named repositories reading from the primary when a read replica is set,
+// for read-after-write sensitive paths which cannot tolerate replication lag
+func WithPrimaryReads(repositories ...string) RepositoryOption {
+	return func(opts *repositoryOptions) {
+		if opts.primaryReads == nil {
+			opts.primaryReads = make(map[string]bool)
+		}
+
+		for _, name := range repositories {
+			opts.primaryReads[name] = true
+		}
+	}
+}
+
+func newRepositoryOptions(opts []RepositoryOption) *repositoryOptions {
+	res := &repositoryOptions{}
+
+	for _, opt := range opts {
+		opt(res)
+	}
+
+	return res
+}
+
+// readDB returns the database which the list queries of a repository read from
+func (opts *repositoryOptions) readDB(primary *gorm.DB, repository string) *gorm.DB {
+	if opts.readReplica == nil || opts.primaryReads[repository] {
+		return primary
+	}
+
+	return opts.readReplica
+}
@@ -0,0 +1,72 @@
+package gorm_test
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"testing"
+
+	"github.com/porter-dev/porter/api/server/shared/config/env"
+	"github.com/porter-dev/porter/internal/adapter"
+	"github.com/porter-dev/porter/internal/encryption"
+	"github.com/porter-dev/porter/internal/models"
+	"github.com/porter-dev/porter/internal/repository/gorm"
+)
+
+func TestReadReplicaListClusters(t *testing.T) {
+	suffix, _ := encryption.GenerateRandomBytes(4)
+
+	tester := &tester{
+		dbFileName: fmt.Sprintf("./read_replica_primary_%s.db", suffix),
+	}
+
+	setupTestEnv(tester, t)
+	initProject(tester, t)
+	initCluster(tester, t)
+	defer cleanup(tester, t)
+
+	// the replica has not caught up with the primary, so it holds no clusters
+	replicaFileName := fmt.Sprintf("./read_replica_replica_%s.db", suffix)
+	defer os.Remove(replicaFileName)
+
+	replica, err := adapter.New(&env.DBConf{
+		SQLLite:     true,
+		SQLLitePath: replicaFileName,
+	})
+	if err != nil {
+		t.Fatalf("%v\n", err)
+	}
+
+	if err := replica.AutoMigrate(&models.Cluster{}); err != nil {
+		t.Fatalf("%v\n", err)
+	}
+
+	projectID := tester.initProjects[0].ID
+
+	repo := gorm.NewRepository(tester.db, tester.key, nil, gorm.WithReadReplica(replica))
+
+	clusters, err := repo.Cluster().ListClustersByProjectID(context.Background(), projectID)
+	if err != nil {
+		t.Fatalf("%v\n", err)
+	}
+
+	if len(clusters) != 0 {
+		t.Fatalf("expected clusters to be listed from the replica, got %d clusters", len(clusters))
+	}
+
+	// reads other than list queries stay on the primary
+	if _, err := repo.Cluster().ReadCluster(context.Background(), projectID, tester.initClusters[0].ID); err != nil {
+		t.Fatalf("expected cluster to be read from the primary, got %v\n", err)
+	}
+
+	repo = gorm.NewRepository(tester.db, tester.key, nil, gorm.WithReadReplica(replica), gorm.WithPrimaryReads(gorm.ReadReplicaCluster))
+
+	clusters, err = repo.Cluster().ListClustersByProjectID(context.Background(), projectID)
+	if err != nil {
+		t.Fatalf("%v\n", err)
+	}
+
+	if len(clusters) != 1 {
+		t.Fatalf("expected clusters to be listed from the primary, got %d clusters", len(clusters))
+	}
+}
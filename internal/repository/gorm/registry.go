@@ -1,6 +1,8 @@
 package gorm
 
 import (
+	"context"
+
 	"github.com/porter-dev/porter/internal/encryption"
 	"github.com/porter-dev/porter/internal/models"
 	ints "github.com/porter-dev/porter/internal/models/integrations"
@@ -21,7 +23,7 @@ func NewRegistryRepository(db *gorm.DB, key *[32]byte) repository.RegistryReposi
 }
 
 // CreateRegistry creates a new registry
-func (repo *RegistryRepository) CreateRegistry(reg *models.Registry) (*models.Registry, error) {
+func (repo *RegistryRepository) CreateRegistry(ctx context.Context, reg *models.Registry) (*models.Registry, error) {
 	err := repo.EncryptRegistryData(reg, repo.key)
 	if err != nil {
 		return nil, err
@@ -29,11 +31,11 @@ func (repo *RegistryRepository) CreateRegistry(reg *models.Registry) (*models.Re
 
 	project := &models.Project{}
 
-	if err := repo.db.Where("id = ?", reg.ProjectID).First(&project).Error; err != nil {
+	if err := repo.db.WithContext(ctx).Where("id = ?", reg.ProjectID).First(&project).Error; err != nil {
 		return nil, err
 	}
 
-	assoc := repo.db.Model(&project).Association("Registries")
+	assoc := repo.db.WithContext(ctx).Model(&project).Association("Registries")
 
 	if assoc.Error != nil {
 		return nil, assoc.Error
@@ -44,7 +46,7 @@ func (repo *RegistryRepository) CreateRegistry(reg *models.Registry) (*models.Re
 	}
 
 	// create a token cache by default
-	assoc = repo.db.Model(reg).Association("TokenCache")
+	assoc = repo.db.WithContext(ctx).Model(reg).Association("TokenCache")
 
 	if assoc.Error != nil {
 		return nil, assoc.Error
@@ -64,10 +66,10 @@ func (repo *RegistryRepository) CreateRegistry(reg *models.Registry) (*models.Re
 }
 
 // ReadRegistry gets a registry specified by a unique id
-func (repo *RegistryRepository) ReadRegistry(projectID, regID uint) (*models.Registry, error) {
+func (repo *RegistryRepository) ReadRegistry(ctx context.Context, projectID, regID uint) (*models.Registry, error) {
 	reg := &models.Registry{}
 
-	if err := repo.db.Preload("TokenCache").Where("project_id = ? AND id = ?", projectID, regID).First(&reg).Error; err != nil {
+	if err := repo.db.WithContext(ctx).Preload("TokenCache").Where("project_id = ? AND id = ?", projectID, regID).First(&reg).Error; err != nil {
 		return nil, err
 	}
 
@@ -76,10 +78,10 @@ func (repo *RegistryRepository) ReadRegistry(projectID, regID uint) (*models.Reg
 	return reg, nil
 }
 
-func (repo *RegistryRepository) ReadRegistryByInfraID(projectID, infraID uint) (*models.Registry, error) {
+func (repo *RegistryRepository) ReadRegistryByInfraID(ctx context.Context, projectID, infraID uint) (*models.Registry, error) {
 	reg := &models.Registry{}
 
-	if err := repo.db.Preload("TokenCache").Where("project_id = ? AND infra_id = ?", projectID, infraID).First(&reg).Error; err != nil {
+	if err := repo.db.WithContext(ctx).Preload("TokenCache").Where("project_id = ? AND infra_id = ?", projectID, infraID).First(&reg).Error; err != nil {
 		return nil, err
 	}
 
@@ -91,11 +93,12 @@ func (repo *RegistryRepository) ReadRegistryByInfraID(projectID, infraID uint) (
 // ListRegistriesByProjectID finds all registries
 // for a given project id
 func (repo *RegistryRepository) ListRegistriesByProjectID(
+	ctx context.Context,
 	projectID uint,
 ) ([]*models.Registry, error) {
 	regs := []*models.Registry{}
 
-	if err := repo.db.Preload("TokenCache").Where("project_id = ?", projectID).Find(&regs).Error; err != nil {
+	if err := repo.db.WithContext(ctx).Preload("TokenCache").Where("project_id = ?", projectID).Find(&regs).Error; err != nil {
 		return nil, err
 	}
 
@@ -108,9 +111,10 @@ func (repo *RegistryRepository) ListRegistriesByProjectID(
 
 // UpdateRegistry modifies an existing Registry in the database
 func (repo *RegistryRepository) UpdateRegistry(
+	ctx context.Context,
 	reg *models.Registry,
 ) (*models.Registry, error) {
-	if err := repo.db.Save(reg).Error; err != nil {
+	if err := repo.db.WithContext(ctx).Save(reg).Error; err != nil {
 		return nil, err
 	}
 
@@ -119,6 +123,7 @@ func (repo *RegistryRepository) UpdateRegistry(
 
 // UpdateRegistryTokenCache updates the token cache for a registry
 func (repo *RegistryRepository) UpdateRegistryTokenCache(
+	ctx context.Context,
 	tokenCache *ints.RegTokenCache,
 ) (*models.Registry, error) {
 	if tok := tokenCache.Token; len(tok) > 0 {
@@ -132,14 +137,14 @@ func (repo *RegistryRepository) UpdateRegistryTokenCache(
 
 	registry := &models.Registry{}
 
-	if err := repo.db.Where("id = ?", tokenCache.RegistryID).First(&registry).Error; err != nil {
+	if err := repo.db.WithContext(ctx).Where("id = ?", tokenCache.RegistryID).First(&registry).Error; err != nil {
 		return nil, err
 	}
 
 	registry.TokenCache.Token = tokenCache.Token
 	registry.TokenCache.Expiry = tokenCache.Expiry
 
-	if err := repo.db.Save(registry).Error; err != nil {
+	if err := repo.db.WithContext(ctx).Save(registry).Error; err != nil {
 		return nil, err
 	}
 
@@ -148,10 +153,11 @@ func (repo *RegistryRepository) UpdateRegistryTokenCache(
 
 // DeleteRegistry removes a registry from the db
 func (repo *RegistryRepository) DeleteRegistry(
+	ctx context.Context,
 	reg *models.Registry,
 ) error {
 	// clear TokenCache association
-	assoc := repo.db.Model(reg).Association("TokenCache")
+	assoc := repo.db.WithContext(ctx).Model(reg).Association("TokenCache")
 
 	if assoc.Error != nil {
 		return assoc.Error
@@ -161,7 +167,7 @@ func (repo *RegistryRepository) DeleteRegistry(
 		return err
 	}
 
-	if err := repo.db.Where("id = ?", reg.ID).Delete(&models.Registry{}).Error; err != nil {
+	if err := repo.db.WithContext(ctx).Where("id = ?", reg.ID).Delete(&models.Registry{}).Error; err != nil {
 		return err
 	}
 
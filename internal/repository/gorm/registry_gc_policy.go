@@ -0,0 +1,60 @@
+package gorm
+
+import (
+	"context"
+
+	"github.com/porter-dev/porter/internal/models"
+	"github.com/porter-dev/porter/internal/repository"
+	"gorm.io/gorm"
+)
+
+// RegistryGCPolicyRepository uses gorm.DB for querying the database
+type RegistryGCPolicyRepository struct {
+	db *gorm.DB
+}
+
+// NewRegistryGCPolicyRepository returns a RegistryGCPolicyRepository which uses
+// gorm.DB for querying the database
+func NewRegistryGCPolicyRepository(db *gorm.DB) repository.RegistryGCPolicyRepository {
+	return &RegistryGCPolicyRepository{db}
+}
+
+// CreateRegistryGCPolicy creates a new registry garbage collection policy
+func (repo *RegistryGCPolicyRepository) CreateRegistryGCPolicy(ctx context.Context, policy *models.RegistryGCPolicy) (*models.RegistryGCPolicy, error) {
+	if err := repo.db.WithContext(ctx).Create(policy).Error; err != nil {
+		return nil, err
+	}
+
+	return policy, nil
+}
+
+// UpdateRegistryGCPolicy updates an existing registry garbage collection policy
+func (repo *RegistryGCPolicyRepository) UpdateRegistryGCPolicy(ctx context.Context, policy *models.RegistryGCPolicy) (*models.RegistryGCPolicy, error) {
+	if err := repo.db.WithContext(ctx).Save(policy).Error; err != nil {
+		return nil, err
+	}
+
+	return policy, nil
+}
+
+// ReadRegistryGCPolicy finds the registry garbage collection policy of a project
+func (repo *RegistryGCPolicyRepository) ReadRegistryGCPolicy(ctx context.Context, projectID uint) (*models.RegistryGCPolicy, error) {
+	policy := &models.RegistryGCPolicy{}
+
+	if err := repo.db.WithContext(ctx).Where("project_id = ?", projectID).First(policy).Error; err != nil {
+		return nil, err
+	}
+
+	return policy, nil
+}
+
+// ListEnabledRegistryGCPolicies lists the policies which are applied by the background job
+func (repo *RegistryGCPolicyRepository) ListEnabledRegistryGCPolicies(ctx context.Context) ([]*models.RegistryGCPolicy, error) {
+	policies := []*models.RegistryGCPolicy{}
+
+	if err := repo.db.WithContext(ctx).Where("enabled = ?", true).Find(&policies).Error; err != nil {
+		return nil, err
+	}
+
+	return policies, nil
+}
@@ -1,6 +1,7 @@
 package gorm_test
 
 import (
+	"context"
 	"testing"
 	"time"
 
@@ -25,12 +26,12 @@ func TestCreateRegistry(t *testing.T) {
 		ProjectID: tester.initProjects[0].Model.ID,
 	}
 
-	reg, err := tester.repo.Registry().CreateRegistry(reg)
+	reg, err := tester.repo.Registry().CreateRegistry(context.Background(), reg)
 	if err != nil {
 		t.Fatalf("%v\n", err)
 	}
 
-	reg, err = tester.repo.Registry().ReadRegistry(tester.initProjects[0].Model.ID, reg.Model.ID)
+	reg, err = tester.repo.Registry().ReadRegistry(context.Background(), tester.initProjects[0].Model.ID, reg.Model.ID)
 
 	if err != nil {
 		t.Fatalf("%v\n", err)
@@ -57,6 +58,7 @@ func TestListRegistriesByProjectID(t *testing.T) {
 	defer cleanup(tester, t)
 
 	regs, err := tester.repo.Registry().ListRegistriesByProjectID(
+		context.Background(),
 		tester.initProjects[0].Model.ID,
 	)
 	if err != nil {
@@ -99,13 +101,14 @@ func TestUpdateRegistry(t *testing.T) {
 	reg.Name = "registry-new-name"
 
 	reg, err := tester.repo.Registry().UpdateRegistry(
+		context.Background(),
 		reg,
 	)
 	if err != nil {
 		t.Fatalf("%v\n", err)
 	}
 
-	reg, err = tester.repo.Registry().ReadRegistry(tester.initProjects[0].Model.ID, tester.initRegs[0].ID)
+	reg, err = tester.repo.Registry().ReadRegistry(context.Background(), tester.initProjects[0].Model.ID, tester.initRegs[0].ID)
 
 	// make sure data is correct
 	expRegistry := models.Registry{
@@ -142,12 +145,12 @@ func TestUpdateRegistryToken(t *testing.T) {
 		},
 	}
 
-	reg, err := tester.repo.Registry().CreateRegistry(reg)
+	reg, err := tester.repo.Registry().CreateRegistry(context.Background(), reg)
 	if err != nil {
 		t.Fatalf("%v\n", err)
 	}
 
-	reg, err = tester.repo.Registry().ReadRegistry(tester.initProjects[0].Model.ID, reg.Model.ID)
+	reg, err = tester.repo.Registry().ReadRegistry(context.Background(), tester.initProjects[0].Model.ID, reg.Model.ID)
 
 	if err != nil {
 		t.Fatalf("%v\n", err)
@@ -170,11 +173,11 @@ func TestUpdateRegistryToken(t *testing.T) {
 	reg.TokenCache.Token = []byte("token-2")
 	reg.TokenCache.Expiry = time.Now().Add(24 * time.Hour)
 
-	reg, err = tester.repo.Registry().UpdateRegistryTokenCache(&reg.TokenCache)
+	reg, err = tester.repo.Registry().UpdateRegistryTokenCache(context.Background(), &reg.TokenCache)
 	if err != nil {
 		t.Fatalf("%v\n", err)
 	}
-	reg, err = tester.repo.Registry().ReadRegistry(tester.initProjects[0].Model.ID, reg.Model.ID)
+	reg, err = tester.repo.Registry().ReadRegistry(context.Background(), tester.initProjects[0].Model.ID, reg.Model.ID)
 	if err != nil {
 		t.Fatalf("%v\n", err)
 	}
@@ -208,24 +211,24 @@ func TestDeleteRegistry(t *testing.T) {
 	initRegistry(tester, t)
 	defer cleanup(tester, t)
 
-	reg, err := tester.repo.Registry().ReadRegistry(tester.initProjects[0].Model.ID, tester.initRegs[0].Model.ID)
+	reg, err := tester.repo.Registry().ReadRegistry(context.Background(), tester.initProjects[0].Model.ID, tester.initRegs[0].Model.ID)
 	if err != nil {
 		t.Fatalf("%v\n", err)
 	}
 
-	err = tester.repo.Registry().DeleteRegistry(reg)
+	err = tester.repo.Registry().DeleteRegistry(context.Background(), reg)
 
 	if err != nil {
 		t.Fatalf("%v\n", err)
 	}
 
-	_, err = tester.repo.Registry().ReadRegistry(tester.initProjects[0].Model.ID, tester.initRegs[0].Model.ID)
+	_, err = tester.repo.Registry().ReadRegistry(context.Background(), tester.initProjects[0].Model.ID, tester.initRegs[0].Model.ID)
 
 	if err != orm.ErrRecordNotFound {
 		t.Fatalf("incorrect error: expected %v, got %v\n", orm.ErrRecordNotFound, err)
 	}
 
-	regs, err := tester.repo.Registry().ListRegistriesByProjectID(tester.initProjects[0].Model.ID)
+	regs, err := tester.repo.Registry().ListRegistriesByProjectID(context.Background(), tester.initProjects[0].Model.ID)
 	if err != nil {
 		t.Fatalf("%v\n", err)
 	}
@@ -1,6 +1,8 @@
 package gorm
 
 import (
+	"context"
+
 	"github.com/porter-dev/porter/internal/models"
 	"github.com/porter-dev/porter/internal/repository"
 	"gorm.io/gorm"
@@ -18,31 +20,31 @@ func NewReleaseRepository(db *gorm.DB) repository.ReleaseRepository {
 }
 
 // CreateRelease adds a new Release row to the Releases table in the database
-func (repo *ReleaseRepository) CreateRelease(release *models.Release) (*models.Release, error) {
-	if err := repo.db.Create(release).Error; err != nil {
+func (repo *ReleaseRepository) CreateRelease(ctx context.Context, release *models.Release) (*models.Release, error) {
+	if err := repo.db.WithContext(ctx).Create(release).Error; err != nil {
 		return nil, err
 	}
 	return release, nil
 }
 
 // ReadRelease finds a single release based on their unique name and namespace pair.
-func (repo *ReleaseRepository) ReadRelease(clusterID uint, name, namespace string) (*models.Release, error) {
+func (repo *ReleaseRepository) ReadRelease(ctx context.Context, clusterID uint, name, namespace string) (*models.Release, error) {
 	release := &models.Release{}
-	if err := repo.db.Preload("GitActionConfig").Preload("Tags").Order("id desc").Where("cluster_id = ? AND name = ? AND namespace = ?", clusterID, name, namespace).First(&release).Error; err != nil {
+	if err := repo.db.WithContext(ctx).Preload("GitActionConfig").Preload("Tags").Order("id desc").Where("cluster_id = ? AND name = ? AND namespace = ?", clusterID, name, namespace).First(&release).Error; err != nil {
 		return nil, err
 	}
 	return release, nil
 }
 
 // ReadRelease finds a single release based on their unique name and namespace pair.
-func (repo *ReleaseRepository) ListReleasesByImageRepoURI(clusterID uint, imageRepoURI string) ([]*models.Release, error) {
+func (repo *ReleaseRepository) ListReleasesByImageRepoURI(ctx context.Context, clusterID uint, imageRepoURI string) ([]*models.Release, error) {
 	releases := make([]*models.Release, 0)
 
 	if imageRepoURI == "" {
 		return releases, nil
 	}
 
-	if err := repo.db.Preload("GitActionConfig").Preload("Tags").Where("cluster_id = ?", clusterID).Where("image_repo_uri = ?", imageRepoURI).Find(&releases).Error; err != nil {
+	if err := repo.db.WithContext(ctx).Preload("GitActionConfig").Preload("Tags").Where("cluster_id = ?", clusterID).Where("image_repo_uri = ?", imageRepoURI).Find(&releases).Error; err != nil {
 		return nil, err
 	}
 
@@ -50,17 +52,17 @@ func (repo *ReleaseRepository) ListReleasesByImageRepoURI(clusterID uint, imageR
 }
 
 // ReadReleaseByWebhookToken finds a single release based on their unique webhook token.
-func (repo *ReleaseRepository) ReadReleaseByWebhookToken(token string) (*models.Release, error) {
+func (repo *ReleaseRepository) ReadReleaseByWebhookToken(ctx context.Context, token string) (*models.Release, error) {
 	release := &models.Release{}
-	if err := repo.db.Preload("GitActionConfig").Preload("Tags").Where("webhook_token = ?", token).First(&release).Error; err != nil {
+	if err := repo.db.WithContext(ctx).Preload("GitActionConfig").Preload("Tags").Where("webhook_token = ?", token).First(&release).Error; err != nil {
 		return nil, err
 	}
 	return release, nil
 }
 
 // UpdateRelease modifies an existing Release in the database
-func (repo *ReleaseRepository) UpdateRelease(release *models.Release) (*models.Release, error) {
-	if err := repo.db.Save(release).Error; err != nil {
+func (repo *ReleaseRepository) UpdateRelease(ctx context.Context, release *models.Release) (*models.Release, error) {
+	if err := repo.db.WithContext(ctx).Save(release).Error; err != nil {
 		return nil, err
 	}
 
@@ -68,8 +70,8 @@ func (repo *ReleaseRepository) UpdateRelease(release *models.Release) (*models.R
 }
 
 // DeleteRelease deletes a single user using their unique name and namespace pair
-func (repo *ReleaseRepository) DeleteRelease(release *models.Release) (*models.Release, error) {
-	if err := repo.db.Delete(&release).Error; err != nil {
+func (repo *ReleaseRepository) DeleteRelease(ctx context.Context, release *models.Release) (*models.Release, error) {
+	if err := repo.db.WithContext(ctx).Delete(&release).Error; err != nil {
 		return nil, err
 	}
 	return release, nil
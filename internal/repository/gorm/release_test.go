@@ -1,6 +1,7 @@
 package gorm_test
 
 import (
+	"context"
 	"fmt"
 	"testing"
 
@@ -25,12 +26,12 @@ func TestCreateRelease(t *testing.T) {
 		WebhookToken: "abcdefgh",
 	}
 
-	release, err := tester.repo.Release().CreateRelease(release)
+	release, err := tester.repo.Release().CreateRelease(context.Background(), release)
 	if err != nil {
 		t.Fatalf("%v\n", err)
 	}
 
-	release, err = tester.repo.Release().ReadRelease(1, release.Name, release.Namespace)
+	release, err = tester.repo.Release().ReadRelease(context.Background(), 1, release.Name, release.Namespace)
 
 	if err != nil {
 		t.Fatalf("%v\n", err)
@@ -49,7 +50,7 @@ func TestCreateRelease(t *testing.T) {
 		t.Errorf("incorrect webhook token: expected %s, got %s\n", "abcdefgh", release.WebhookToken)
 	}
 
-	release, err = tester.repo.Release().ReadReleaseByWebhookToken(release.WebhookToken)
+	release, err = tester.repo.Release().ReadReleaseByWebhookToken(context.Background(), release.WebhookToken)
 
 	if release.Name != "denver-meister-dakota" {
 		t.Errorf("incorrect project name: expected %s, got %s\n", "denver-meister-dakota", release.Name)
@@ -85,7 +86,7 @@ func TestListReleasesByImageRepoURI(t *testing.T) {
 			Tags:         make([]*models.Tag, 0),
 		}
 
-		release, err := tester.repo.Release().CreateRelease(release)
+		release, err := tester.repo.Release().CreateRelease(context.Background(), release)
 		if err != nil {
 			t.Fatalf("%v\n", err)
 		}
@@ -95,7 +96,7 @@ func TestListReleasesByImageRepoURI(t *testing.T) {
 		}
 	}
 
-	resReleases, err := tester.repo.Release().ListReleasesByImageRepoURI(1, "uri1")
+	resReleases, err := tester.repo.Release().ListReleasesByImageRepoURI(context.Background(), 1, "uri1")
 	if err != nil {
 		t.Fatalf("%v\n", err)
 	}
@@ -127,24 +128,24 @@ func TestDeleteRelease(t *testing.T) {
 		WebhookToken: "abcdefgh",
 	}
 
-	release, err := tester.repo.Release().CreateRelease(release)
+	release, err := tester.repo.Release().CreateRelease(context.Background(), release)
 	if err != nil {
 		t.Fatalf("%v\n", err)
 	}
 
-	release, err = tester.repo.Release().ReadRelease(1, release.Name, release.Namespace)
+	release, err = tester.repo.Release().ReadRelease(context.Background(), 1, release.Name, release.Namespace)
 
 	if err != nil {
 		t.Fatalf("%v\n", err)
 	}
 
-	_, err = tester.repo.Release().DeleteRelease(release)
+	_, err = tester.repo.Release().DeleteRelease(context.Background(), release)
 
 	if err != nil {
 		t.Fatalf("%v\n", err)
 	}
 
-	_, err = tester.repo.Release().ReadRelease(1, release.Name, release.Namespace)
+	_, err = tester.repo.Release().ReadRelease(context.Background(), 1, release.Name, release.Namespace)
 
 	if err != orm.ErrRecordNotFound {
 		t.Fatalf("incorrect error: expected %v, got %v\n", orm.ErrRecordNotFound, err)
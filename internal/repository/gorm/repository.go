@@ -7,53 +7,73 @@ import (
 )
 
 type GormRepository struct {
-	user                      repository.UserRepository
-	session                   repository.SessionRepository
-	project                   repository.ProjectRepository
-	cluster                   repository.ClusterRepository
-	database                  repository.DatabaseRepository
-	helmRepo                  repository.HelmRepoRepository
-	registry                  repository.RegistryRepository
-	gitRepo                   repository.GitRepoRepository
-	gitActionConfig           repository.GitActionConfigRepository
-	invite                    repository.InviteRepository
-	release                   repository.ReleaseRepository
-	environment               repository.EnvironmentRepository
-	authCode                  repository.AuthCodeRepository
-	dnsRecord                 repository.DNSRecordRepository
-	pwResetToken              repository.PWResetTokenRepository
-	infra                     repository.InfraRepository
-	kubeIntegration           repository.KubeIntegrationRepository
-	basicIntegration          repository.BasicIntegrationRepository
-	oidcIntegration           repository.OIDCIntegrationRepository
-	oauthIntegration          repository.OAuthIntegrationRepository
-	gcpIntegration            repository.GCPIntegrationRepository
-	awsIntegration            repository.AWSIntegrationRepository
-	azIntegration             repository.AzureIntegrationRepository
-	githubAppInstallation     repository.GithubAppInstallationRepository
-	githubAppOAuthIntegration repository.GithubAppOAuthIntegrationRepository
-	slackIntegration          repository.SlackIntegrationRepository
-	gitlabIntegration         repository.GitlabIntegrationRepository
-	gitlabAppOAuthIntegration repository.GitlabAppOAuthIntegrationRepository
-	notificationConfig        repository.NotificationConfigRepository
-	jobNotificationConfig     repository.JobNotificationConfigRepository
-	buildEvent                repository.BuildEventRepository
-	kubeEvent                 repository.KubeEventRepository
-	projectUsage              repository.ProjectUsageRepository
-	onboarding                repository.ProjectOnboardingRepository
-	ceToken                   repository.CredentialsExchangeTokenRepository
-	buildConfig               repository.BuildConfigRepository
-	allowlist                 repository.AllowlistRepository
-	apiToken                  repository.APITokenRepository
-	policy                    repository.PolicyRepository
-	tag                       repository.TagRepository
-	stack                     repository.StackRepository
-	monitor                   repository.MonitorTestResultRepository
-	apiContractRevisions      repository.APIContractRevisioner
-	awsAssumeRoleChainer      repository.AWSAssumeRoleChainer
-	porterApp                 repository.PorterAppRepository
-	porterAppEvent            repository.PorterAppEventRepository
-	deploymentTarget          repository.DeploymentTargetRepository
+	user                       repository.UserRepository
+	session                    repository.SessionRepository
+	project                    repository.ProjectRepository
+	cluster                    repository.ClusterRepository
+	database                   repository.DatabaseRepository
+	helmRepo                   repository.HelmRepoRepository
+	registry                   repository.RegistryRepository
+	gitRepo                    repository.GitRepoRepository
+	gitActionConfig            repository.GitActionConfigRepository
+	invite                     repository.InviteRepository
+	release                    repository.ReleaseRepository
+	environment                repository.EnvironmentRepository
+	authCode                   repository.AuthCodeRepository
+	dnsRecord                  repository.DNSRecordRepository
+	pwResetToken               repository.PWResetTokenRepository
+	infra                      repository.InfraRepository
+	kubeIntegration            repository.KubeIntegrationRepository
+	basicIntegration           repository.BasicIntegrationRepository
+	oidcIntegration            repository.OIDCIntegrationRepository
+	oauthIntegration           repository.OAuthIntegrationRepository
+	gcpIntegration             repository.GCPIntegrationRepository
+	awsIntegration             repository.AWSIntegrationRepository
+	azIntegration              repository.AzureIntegrationRepository
+	githubAppInstallation      repository.GithubAppInstallationRepository
+	githubAppOAuthIntegration  repository.GithubAppOAuthIntegrationRepository
+	slackIntegration           repository.SlackIntegrationRepository
+	gitlabIntegration          repository.GitlabIntegrationRepository
+	gitlabAppOAuthIntegration  repository.GitlabAppOAuthIntegrationRepository
+	notificationConfig         repository.NotificationConfigRepository
+	jobNotificationConfig      repository.JobNotificationConfigRepository
+	buildEvent                 repository.BuildEventRepository
+	kubeEvent                  repository.KubeEventRepository
+	projectUsage               repository.ProjectUsageRepository
+	onboarding                 repository.ProjectOnboardingRepository
+	ceToken                    repository.CredentialsExchangeTokenRepository
+	buildConfig                repository.BuildConfigRepository
+	allowlist                  repository.AllowlistRepository
+	apiToken                   repository.APITokenRepository
+	policy                     repository.PolicyRepository
+	tag                        repository.TagRepository
+	stack                      repository.StackRepository
+	monitor                    repository.MonitorTestResultRepository
+	apiContractRevisions       repository.APIContractRevisioner
+	awsAssumeRoleChainer       repository.AWSAssumeRoleChainer
+	porterApp                  repository.PorterAppRepository
+	porterAppEvent             repository.PorterAppEventRepository
+	deploymentTarget           repository.DeploymentTargetRepository
+	projectWebhook             repository.ProjectWebhookRepository
+	jobRun                     repository.JobRunRepository
+	environmentGroupVersion    repository.EnvironmentGroupVersionRepository
+	externalSecretsIntegration repository.ExternalSecretsIntegrationRepository
+	registryGCPolicy           repository.RegistryGCPolicyRepository
+	appRevisionMetadata        repository.AppRevisionMetadataRepository
+	scim                       repository.SCIMRepository
+	userMFA                    repository.UserMFARepository
+	appResourceRequest         repository.AppResourceRequestRepository
+	serviceAccount             repository.ServiceAccountRepository
+	alertRule                  repository.AlertRuleRepository
+	eventSink                  repository.EventSinkRepository
+	addon                      repository.AddonRepository
+	datastore                  repository.DatastoreRepository
+	customCertificate          repository.CustomCertificateRepository
+	customDomain               repository.CustomDomainRepository
+	subdomain                  repository.SubdomainRepository
+	appTemplate                repository.AppTemplateRepository
+	imageAttestation           repository.ImageAttestationRepository
+	imageSigningPolicy         repository.ImageSigningPolicyRepository
 }
 
 func (t *GormRepository) User() repository.UserRepository {
@@ -245,56 +265,176 @@ func (t *GormRepository) DeploymentTarget() repository.DeploymentTargetRepositor
 	return t.deploymentTarget
 }
 
+// ProjectWebhook returns the ProjectWebhookRepository interface implemented by gorm
+func (t *GormRepository) ProjectWebhook() repository.ProjectWebhookRepository {
+	return t.projectWebhook
+}
+
+// JobRun returns the JobRunRepository interface implemented by gorm
+func (t *GormRepository) JobRun() repository.JobRunRepository {
+	return t.jobRun
+}
+
+// EnvironmentGroupVersion returns the EnvironmentGroupVersionRepository interface implemented by gorm
+func (t *GormRepository) EnvironmentGroupVersion() repository.EnvironmentGroupVersionRepository {
+	return t.environmentGroupVersion
+}
+
+// ExternalSecretsIntegration returns the ExternalSecretsIntegrationRepository interface implemented by gorm
+func (t *GormRepository) ExternalSecretsIntegration() repository.ExternalSecretsIntegrationRepository {
+	return t.externalSecretsIntegration
+}
+
+// RegistryGCPolicy returns the RegistryGCPolicyRepository interface implemented by gorm
+func (t *GormRepository) RegistryGCPolicy() repository.RegistryGCPolicyRepository {
+	return t.registryGCPolicy
+}
+
+// AppRevisionMetadata returns the AppRevisionMetadataRepository interface implemented by gorm
+func (t *GormRepository) AppRevisionMetadata() repository.AppRevisionMetadataRepository {
+	return t.appRevisionMetadata
+}
+
+// SCIM returns the SCIMRepository interface implemented by gorm
+func (t *GormRepository) SCIM() repository.SCIMRepository {
+	return t.scim
+}
+
+// UserMFA returns the UserMFARepository interface implemented by gorm
+func (t *GormRepository) UserMFA() repository.UserMFARepository {
+	return t.userMFA
+}
+
+// AppResourceRequest returns the AppResourceRequestRepository interface implemented by gorm
+func (t *GormRepository) AppResourceRequest() repository.AppResourceRequestRepository {
+	return t.appResourceRequest
+}
+
+// ServiceAccount returns the ServiceAccountRepository interface implemented by gorm
+func (t *GormRepository) ServiceAccount() repository.ServiceAccountRepository {
+	return t.serviceAccount
+}
+
+// AlertRule returns the AlertRuleRepository interface implemented by gorm
+func (t *GormRepository) AlertRule() repository.AlertRuleRepository {
+	return t.alertRule
+}
+
+// EventSink returns the EventSinkRepository interface implemented by gorm
+func (t *GormRepository) EventSink() repository.EventSinkRepository {
+	return t.eventSink
+}
+
+// Addon returns the AddonRepository interface implemented by gorm
+func (t *GormRepository) Addon() repository.AddonRepository {
+	return t.addon
+}
+
+// Datastore returns the DatastoreRepository interface implemented by gorm
+func (t *GormRepository) Datastore() repository.DatastoreRepository {
+	return t.datastore
+}
+
+// CustomCertificate returns the CustomCertificateRepository interface implemented by gorm
+func (t *GormRepository) CustomCertificate() repository.CustomCertificateRepository {
+	return t.customCertificate
+}
+
+// CustomDomain returns the CustomDomainRepository interface implemented by gorm
+func (t *GormRepository) CustomDomain() repository.CustomDomainRepository {
+	return t.customDomain
+}
+
+// Subdomain returns the SubdomainRepository interface implemented by gorm
+func (t *GormRepository) Subdomain() repository.SubdomainRepository {
+	return t.subdomain
+}
+
+// AppTemplate returns the AppTemplateRepository interface implemented by gorm
+func (t *GormRepository) AppTemplate() repository.AppTemplateRepository {
+	return t.appTemplate
+}
+
+// ImageAttestation returns the ImageAttestationRepository interface implemented by gorm
+func (t *GormRepository) ImageAttestation() repository.ImageAttestationRepository {
+	return t.imageAttestation
+}
+
+// ImageSigningPolicy returns the ImageSigningPolicyRepository interface implemented by gorm
+func (t *GormRepository) ImageSigningPolicy() repository.ImageSigningPolicyRepository {
+	return t.imageSigningPolicy
+}
+
 // NewRepository returns a Repository which persists users in memory
 // and accepts a parameter that can trigger read/write errors
-func NewRepository(db *gorm.DB, key *[32]byte, storageBackend credentials.CredentialStorage) repository.Repository {
+func NewRepository(db *gorm.DB, key *[32]byte, storageBackend credentials.CredentialStorage, opts ...RepositoryOption) repository.Repository {
 	return &GormRepository{
-		user:                      NewUserRepository(db),
-		session:                   NewSessionRepository(db),
-		project:                   NewProjectRepository(db),
-		cluster:                   NewClusterRepository(db, key),
-		database:                  NewDatabaseRepository(db, key),
-		helmRepo:                  NewHelmRepoRepository(db, key),
-		registry:                  NewRegistryRepository(db, key),
-		gitRepo:                   NewGitRepoRepository(db, key),
-		gitActionConfig:           NewGitActionConfigRepository(db),
-		invite:                    NewInviteRepository(db),
-		release:                   NewReleaseRepository(db),
-		environment:               NewEnvironmentRepository(db),
-		authCode:                  NewAuthCodeRepository(db),
-		dnsRecord:                 NewDNSRecordRepository(db),
-		pwResetToken:              NewPWResetTokenRepository(db),
-		infra:                     NewInfraRepository(db, key),
-		kubeIntegration:           NewKubeIntegrationRepository(db, key),
-		basicIntegration:          NewBasicIntegrationRepository(db, key),
-		oidcIntegration:           NewOIDCIntegrationRepository(db, key),
-		oauthIntegration:          NewOAuthIntegrationRepository(db, key, storageBackend),
-		gcpIntegration:            NewGCPIntegrationRepository(db, key, storageBackend),
-		awsIntegration:            NewAWSIntegrationRepository(db, key, storageBackend),
-		azIntegration:             NewAzureIntegrationRepository(db, key, storageBackend),
-		githubAppInstallation:     NewGithubAppInstallationRepository(db),
-		githubAppOAuthIntegration: NewGithubAppOAuthIntegrationRepository(db),
-		slackIntegration:          NewSlackIntegrationRepository(db, key),
-		gitlabIntegration:         NewGitlabIntegrationRepository(db, key, storageBackend),
-		gitlabAppOAuthIntegration: NewGitlabAppOAuthIntegrationRepository(db, key, storageBackend),
-		notificationConfig:        NewNotificationConfigRepository(db),
-		jobNotificationConfig:     NewJobNotificationConfigRepository(db),
-		buildEvent:                NewBuildEventRepository(db),
-		kubeEvent:                 NewKubeEventRepository(db, key),
-		projectUsage:              NewProjectUsageRepository(db),
-		onboarding:                NewProjectOnboardingRepository(db),
-		ceToken:                   NewCredentialsExchangeTokenRepository(db),
-		buildConfig:               NewBuildConfigRepository(db),
-		allowlist:                 NewAllowlistRepository(db),
-		apiToken:                  NewAPITokenRepository(db),
-		policy:                    NewPolicyRepository(db),
-		tag:                       NewTagRepository(db),
-		stack:                     NewStackRepository(db),
-		monitor:                   NewMonitorTestResultRepository(db),
-		apiContractRevisions:      NewAPIContractRevisioner(db),
-		awsAssumeRoleChainer:      NewAWSAssumeRoleChainer(db),
-		porterApp:                 NewPorterAppRepository(db),
-		porterAppEvent:            NewPorterAppEventRepository(db),
-		deploymentTarget:          NewDeploymentTargetRepository(db),
+		user:                       NewUserRepository(db),
+		session:                    NewSessionRepository(db),
+		project:                    NewProjectRepository(db),
+		cluster:                    NewClusterRepository(db, key, opts...),
+		database:                   NewDatabaseRepository(db, key),
+		helmRepo:                   NewHelmRepoRepository(db, key),
+		registry:                   NewRegistryRepository(db, key),
+		gitRepo:                    NewGitRepoRepository(db, key),
+		gitActionConfig:            NewGitActionConfigRepository(db),
+		invite:                     NewInviteRepository(db),
+		release:                    NewReleaseRepository(db),
+		environment:                NewEnvironmentRepository(db),
+		authCode:                   NewAuthCodeRepository(db),
+		dnsRecord:                  NewDNSRecordRepository(db),
+		pwResetToken:               NewPWResetTokenRepository(db),
+		infra:                      NewInfraRepository(db, key),
+		kubeIntegration:            NewKubeIntegrationRepository(db, key),
+		basicIntegration:           NewBasicIntegrationRepository(db, key),
+		oidcIntegration:            NewOIDCIntegrationRepository(db, key),
+		oauthIntegration:           NewOAuthIntegrationRepository(db, key, storageBackend),
+		gcpIntegration:             NewGCPIntegrationRepository(db, key, storageBackend),
+		awsIntegration:             NewAWSIntegrationRepository(db, key, storageBackend),
+		azIntegration:              NewAzureIntegrationRepository(db, key, storageBackend),
+		githubAppInstallation:      NewGithubAppInstallationRepository(db),
+		githubAppOAuthIntegration:  NewGithubAppOAuthIntegrationRepository(db),
+		slackIntegration:           NewSlackIntegrationRepository(db, key),
+		gitlabIntegration:          NewGitlabIntegrationRepository(db, key, storageBackend),
+		gitlabAppOAuthIntegration:  NewGitlabAppOAuthIntegrationRepository(db, key, storageBackend),
+		notificationConfig:         NewNotificationConfigRepository(db),
+		jobNotificationConfig:      NewJobNotificationConfigRepository(db),
+		buildEvent:                 NewBuildEventRepository(db, key),
+		kubeEvent:                  NewKubeEventRepository(db, key, opts...),
+		projectUsage:               NewProjectUsageRepository(db),
+		onboarding:                 NewProjectOnboardingRepository(db),
+		ceToken:                    NewCredentialsExchangeTokenRepository(db),
+		buildConfig:                NewBuildConfigRepository(db),
+		allowlist:                  NewAllowlistRepository(db),
+		apiToken:                   NewAPITokenRepository(db),
+		policy:                     NewPolicyRepository(db),
+		tag:                        NewTagRepository(db),
+		stack:                      NewStackRepository(db),
+		monitor:                    NewMonitorTestResultRepository(db),
+		apiContractRevisions:       NewAPIContractRevisioner(db),
+		awsAssumeRoleChainer:       NewAWSAssumeRoleChainer(db),
+		porterApp:                  NewPorterAppRepository(db),
+		porterAppEvent:             NewPorterAppEventRepository(db),
+		deploymentTarget:           NewDeploymentTargetRepository(db),
+		projectWebhook:             NewProjectWebhookRepository(db, key),
+		jobRun:                     NewJobRunRepository(db),
+		environmentGroupVersion:    NewEnvironmentGroupVersionRepository(db),
+		externalSecretsIntegration: NewExternalSecretsIntegrationRepository(db, key),
+		registryGCPolicy:           NewRegistryGCPolicyRepository(db),
+		appRevisionMetadata:        NewAppRevisionMetadataRepository(db),
+		scim:                       NewSCIMRepository(db),
+		userMFA:                    NewUserMFARepository(db, key),
+		appResourceRequest:         NewAppResourceRequestRepository(db),
+		serviceAccount:             NewServiceAccountRepository(db),
+		alertRule:                  NewAlertRuleRepository(db),
+		eventSink:                  NewEventSinkRepository(db, key),
+		addon:                      NewAddonRepository(db, key),
+		datastore:                  NewDatastoreRepository(db, key),
+		customCertificate:          NewCustomCertificateRepository(db, key),
+		customDomain:               NewCustomDomainRepository(db),
+		subdomain:                  NewSubdomainRepository(db),
+		appTemplate:                NewAppTemplateRepository(db),
+		imageAttestation:           NewImageAttestationRepository(db),
+		imageSigningPolicy:         NewImageSigningPolicyRepository(db),
 	}
 }
@@ -0,0 +1,164 @@
+package gorm
+
+import (
+	"context"
+
+	"github.com/porter-dev/porter/internal/models"
+	"github.com/porter-dev/porter/internal/repository"
+	"gorm.io/gorm"
+)
+
+// SCIMRepository uses gorm.DB for querying the database
+type SCIMRepository struct {
+	db *gorm.DB
+}
+
+// NewSCIMRepository returns a SCIMRepository which uses gorm.DB for querying the database
+func NewSCIMRepository(db *gorm.DB) repository.SCIMRepository {
+	return &SCIMRepository{db}
+}
+
+// CreateSCIMUser creates a new provisioned user
+func (repo *SCIMRepository) CreateSCIMUser(ctx context.Context, user *models.SCIMUser) (*models.SCIMUser, error) {
+	if err := repo.db.WithContext(ctx).Create(user).Error; err != nil {
+		return nil, err
+	}
+
+	return user, nil
+}
+
+// ReadSCIMUser finds a provisioned user by project id and id
+func (repo *SCIMRepository) ReadSCIMUser(ctx context.Context, projectID, id uint) (*models.SCIMUser, error) {
+	user := &models.SCIMUser{}
+
+	if err := repo.db.WithContext(ctx).Where("project_id = ? AND id = ?", projectID, id).First(user).Error; err != nil {
+		return nil, err
+	}
+
+	return user, nil
+}
+
+// ListSCIMUsers lists the provisioned users of a project, ordered by id
+func (repo *SCIMRepository) ListSCIMUsers(ctx context.Context, projectID uint) ([]*models.SCIMUser, error) {
+	users := []*models.SCIMUser{}
+
+	if err := repo.db.WithContext(ctx).Where("project_id = ?", projectID).Order("id asc").Find(&users).Error; err != nil {
+		return nil, err
+	}
+
+	return users, nil
+}
+
+// UpdateSCIMUser modifies an existing provisioned user
+func (repo *SCIMRepository) UpdateSCIMUser(ctx context.Context, user *models.SCIMUser) (*models.SCIMUser, error) {
+	if err := repo.db.WithContext(ctx).Save(user).Error; err != nil {
+		return nil, err
+	}
+
+	return user, nil
+}
+
+// DeleteSCIMUser deletes a provisioned user and removes it from its groups
+func (repo *SCIMRepository) DeleteSCIMUser(ctx context.Context, user *models.SCIMUser) error {
+	return repo.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		if err := tx.Exec("DELETE FROM scim_group_members WHERE scim_user_id = ?", user.ID).Error; err != nil {
+			return err
+		}
+
+		return tx.Delete(user).Error
+	})
+}
+
+// CreateSCIMGroup creates a new group along with its members
+func (repo *SCIMRepository) CreateSCIMGroup(ctx context.Context, group *models.SCIMGroup) (*models.SCIMGroup, error) {
+	if err := repo.db.WithContext(ctx).Omit("Members.*").Create(group).Error; err != nil {
+		return nil, err
+	}
+
+	return group, nil
+}
+
+// ReadSCIMGroup finds a group by project id and id, along with its members
+func (repo *SCIMRepository) ReadSCIMGroup(ctx context.Context, projectID, id uint) (*models.SCIMGroup, error) {
+	group := &models.SCIMGroup{}
+
+	if err := repo.db.WithContext(ctx).Preload("Members").Where("project_id = ? AND id = ?", projectID, id).First(group).Error; err != nil {
+		return nil, err
+	}
+
+	return group, nil
+}
+
+// ListSCIMGroups lists the groups of a project along with their members, ordered by id
+func (repo *SCIMRepository) ListSCIMGroups(ctx context.Context, projectID uint) ([]*models.SCIMGroup, error) {
+	groups := []*models.SCIMGroup{}
+
+	if err := repo.db.WithContext(ctx).Preload("Members").Where("project_id = ?", projectID).Order("id asc").Find(&groups).Error; err != nil {
+		return nil, err
+	}
+
+	return groups, nil
+}
+
+// UpdateSCIMGroup modifies an existing group and replaces its members
+func (repo *SCIMRepository) UpdateSCIMGroup(ctx context.Context, group *models.SCIMGroup) (*models.SCIMGroup, error) {
+	err := repo.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		if err := tx.Omit("Members").Save(group).Error; err != nil {
+			return err
+		}
+
+		return tx.Model(group).Association("Members").Replace(group.Members)
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return group, nil
+}
+
+// DeleteSCIMGroup deletes a group and its memberships
+func (repo *SCIMRepository) DeleteSCIMGroup(ctx context.Context, group *models.SCIMGroup) error {
+	return repo.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		if err := tx.Model(group).Association("Members").Clear(); err != nil {
+			return err
+		}
+
+		return tx.Delete(group).Error
+	})
+}
+
+// CreateSCIMGroupRoleMapping creates a new group role mapping
+func (repo *SCIMRepository) CreateSCIMGroupRoleMapping(ctx context.Context, mapping *models.SCIMGroupRoleMapping) (*models.SCIMGroupRoleMapping, error) {
+	if err := repo.db.WithContext(ctx).Create(mapping).Error; err != nil {
+		return nil, err
+	}
+
+	return mapping, nil
+}
+
+// ReadSCIMGroupRoleMapping finds a group role mapping by project id and id
+func (repo *SCIMRepository) ReadSCIMGroupRoleMapping(ctx context.Context, projectID, id uint) (*models.SCIMGroupRoleMapping, error) {
+	mapping := &models.SCIMGroupRoleMapping{}
+
+	if err := repo.db.WithContext(ctx).Where("project_id = ? AND id = ?", projectID, id).First(mapping).Error; err != nil {
+		return nil, err
+	}
+
+	return mapping, nil
+}
+
+// ListSCIMGroupRoleMappings lists the group role mappings of a project
+func (repo *SCIMRepository) ListSCIMGroupRoleMappings(ctx context.Context, projectID uint) ([]*models.SCIMGroupRoleMapping, error) {
+	mappings := []*models.SCIMGroupRoleMapping{}
+
+	if err := repo.db.WithContext(ctx).Where("project_id = ?", projectID).Order("id asc").Find(&mappings).Error; err != nil {
+		return nil, err
+	}
+
+	return mappings, nil
+}
+
+// DeleteSCIMGroupRoleMapping deletes a group role mapping
+func (repo *SCIMRepository) DeleteSCIMGroupRoleMapping(ctx context.Context, mapping *models.SCIMGroupRoleMapping) error {
+	return repo.db.WithContext(ctx).Delete(mapping).Error
+}
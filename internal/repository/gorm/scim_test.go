@@ -0,0 +1,66 @@
+package gorm_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/porter-dev/porter/internal/encryption"
+	"github.com/porter-dev/porter/internal/models"
+)
+
+func TestSCIMGroupMembers(t *testing.T) {
+	suffix, _ := encryption.GenerateRandomBytes(4)
+
+	tester := &tester{
+		dbFileName: fmt.Sprintf("./porter_scim_%s.db", suffix),
+	}
+
+	setupTestEnv(tester, t)
+	defer cleanup(tester, t)
+
+	users := make([]models.SCIMUser, 0)
+	for i, userName := range []string{"a@porter.run", "b@porter.run"} {
+		user, err := tester.repo.SCIM().CreateSCIMUser(context.Background(), &models.SCIMUser{ProjectID: 1, UserID: uint(i + 1), UserName: userName, Active: true})
+		if err != nil {
+			t.Fatalf("%v\n", err)
+		}
+		users = append(users, *user)
+	}
+
+	group, err := tester.repo.SCIM().CreateSCIMGroup(context.Background(), &models.SCIMGroup{ProjectID: 1, DisplayName: "engineering", Members: users})
+	if err != nil {
+		t.Fatalf("%v\n", err)
+	}
+
+	group.Members = users[1:]
+	if _, err := tester.repo.SCIM().UpdateSCIMGroup(context.Background(), group); err != nil {
+		t.Fatalf("%v\n", err)
+	}
+
+	group, err = tester.repo.SCIM().ReadSCIMGroup(context.Background(), 1, group.ID)
+	if err != nil {
+		t.Fatalf("%v\n", err)
+	}
+
+	if len(group.Members) != 1 || group.Members[0].UserName != "b@porter.run" {
+		t.Fatalf("incorrect group members after update: %+v", group.Members)
+	}
+
+	if err := tester.repo.SCIM().DeleteSCIMUser(context.Background(), &users[1]); err != nil {
+		t.Fatalf("%v\n", err)
+	}
+
+	groups, err := tester.repo.SCIM().ListSCIMGroups(context.Background(), 1)
+	if err != nil {
+		t.Fatalf("%v\n", err)
+	}
+
+	if len(groups) != 1 || len(groups[0].Members) != 0 {
+		t.Errorf("expected deleted user to be removed from group, got %+v", groups)
+	}
+
+	if _, err := tester.repo.SCIM().ReadSCIMGroup(context.Background(), 2, group.ID); err == nil {
+		t.Errorf("expected group not to be readable from another project")
+	}
+}
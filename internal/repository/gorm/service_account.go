@@ -0,0 +1,80 @@
+package gorm
+
+import (
+	"context"
+
+	"github.com/porter-dev/porter/internal/models"
+	"github.com/porter-dev/porter/internal/repository"
+	"gorm.io/gorm"
+)
+
+// ServiceAccountRepository uses gorm.DB for querying the database
+type ServiceAccountRepository struct {
+	db *gorm.DB
+}
+
+// NewServiceAccountRepository returns a ServiceAccountRepository which uses
+// gorm.DB for querying the database
+func NewServiceAccountRepository(db *gorm.DB) repository.ServiceAccountRepository {
+	return &ServiceAccountRepository{db}
+}
+
+// CreateServiceAccount creates a new service account
+func (repo *ServiceAccountRepository) CreateServiceAccount(ctx context.Context, sa *models.ServiceAccount) (*models.ServiceAccount, error) {
+	if err := repo.db.WithContext(ctx).Create(sa).Error; err != nil {
+		return nil, err
+	}
+
+	return sa, nil
+}
+
+// ReadServiceAccount finds a service account of a project by id
+func (repo *ServiceAccountRepository) ReadServiceAccount(ctx context.Context, projectID, id uint) (*models.ServiceAccount, error) {
+	sa := &models.ServiceAccount{}
+
+	if err := repo.db.WithContext(ctx).Where("project_id = ? AND id = ?", projectID, id).First(sa).Error; err != nil {
+		return nil, err
+	}
+
+	return sa, nil
+}
+
+// ReadServiceAccountByUserID finds the service account of a service account user
+func (repo *ServiceAccountRepository) ReadServiceAccountByUserID(ctx context.Context, userID uint) (*models.ServiceAccount, error) {
+	sa := &models.ServiceAccount{}
+
+	if err := repo.db.WithContext(ctx).Where("user_id = ?", userID).First(sa).Error; err != nil {
+		return nil, err
+	}
+
+	return sa, nil
+}
+
+// ListServiceAccountsByProjectID lists the service accounts of a project
+func (repo *ServiceAccountRepository) ListServiceAccountsByProjectID(ctx context.Context, projectID uint) ([]*models.ServiceAccount, error) {
+	sas := []*models.ServiceAccount{}
+
+	if err := repo.db.WithContext(ctx).Where("project_id = ?", projectID).Find(&sas).Error; err != nil {
+		return nil, err
+	}
+
+	return sas, nil
+}
+
+// UpdateServiceAccount updates an existing service account
+func (repo *ServiceAccountRepository) UpdateServiceAccount(ctx context.Context, sa *models.ServiceAccount) (*models.ServiceAccount, error) {
+	if err := repo.db.WithContext(ctx).Save(sa).Error; err != nil {
+		return nil, err
+	}
+
+	return sa, nil
+}
+
+// DeleteServiceAccount deletes a service account
+func (repo *ServiceAccountRepository) DeleteServiceAccount(ctx context.Context, sa *models.ServiceAccount) (*models.ServiceAccount, error) {
+	if err := repo.db.WithContext(ctx).Delete(sa).Error; err != nil {
+		return nil, err
+	}
+
+	return sa, nil
+}
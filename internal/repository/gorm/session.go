@@ -1,6 +1,8 @@
 package gorm
 
 import (
+	"context"
+
 	"github.com/porter-dev/porter/internal/models"
 	"github.com/porter-dev/porter/internal/repository"
 	"gorm.io/gorm"
@@ -17,24 +19,24 @@ func NewSessionRepository(db *gorm.DB) repository.SessionRepository {
 }
 
 // CreateSession must take in Key, Data, and ExpiresAt as arguments.
-func (s *SessionRepository) CreateSession(session *models.Session) (*models.Session, error) {
-	if err := s.db.Create(session).Error; err != nil {
+func (s *SessionRepository) CreateSession(ctx context.Context, session *models.Session) (*models.Session, error) {
+	if err := s.db.WithContext(ctx).Create(session).Error; err != nil {
 		return nil, err
 	}
 	return session, nil
 }
 
 // UpdateSession updates only the Data field using Key as selector.
-func (s *SessionRepository) UpdateSession(session *models.Session) (*models.Session, error) {
-	if err := s.db.Model(session).Where("Key = ?", session.Key).Updates(session).Error; err != nil {
+func (s *SessionRepository) UpdateSession(ctx context.Context, session *models.Session) (*models.Session, error) {
+	if err := s.db.WithContext(ctx).Model(session).Where("Key = ?", session.Key).Updates(session).Error; err != nil {
 		return nil, err
 	}
 	return session, nil
 }
 
 // DeleteSession deletes a session by Key
-func (s *SessionRepository) DeleteSession(session *models.Session) (*models.Session, error) {
-	if err := s.db.Where("Key = ?", session.Key).Unscoped().Delete(session).Error; err != nil {
+func (s *SessionRepository) DeleteSession(ctx context.Context, session *models.Session) (*models.Session, error) {
+	if err := s.db.WithContext(ctx).Where("Key = ?", session.Key).Unscoped().Delete(session).Error; err != nil {
 		return nil, err
 	}
 
@@ -42,8 +44,8 @@ func (s *SessionRepository) DeleteSession(session *models.Session) (*models.Sess
 }
 
 // SelectSession returns a session with matching key
-func (s *SessionRepository) SelectSession(session *models.Session) (*models.Session, error) {
-	if err := s.db.Where("Key = ? AND deleted_at is null", session.Key).First(session).Error; err != nil {
+func (s *SessionRepository) SelectSession(ctx context.Context, session *models.Session) (*models.Session, error) {
+	if err := s.db.WithContext(ctx).Where("Key = ? AND deleted_at is null", session.Key).First(session).Error; err != nil {
 		return nil, err
 	}
 
@@ -1,6 +1,8 @@
 package gorm
 
 import (
+	"context"
+
 	"github.com/porter-dev/porter/internal/encryption"
 	"github.com/porter-dev/porter/internal/repository"
 	"gorm.io/gorm"
@@ -26,6 +28,7 @@ func NewSlackIntegrationRepository(
 
 // CreateSlackIntegration creates a new kube auth mechanism
 func (repo *SlackIntegrationRepository) CreateSlackIntegration(
+	ctx context.Context,
 	slackInt *ints.SlackIntegration,
 ) (*ints.SlackIntegration, error) {
 	err := repo.EncryptSlackIntegrationData(slackInt, repo.key)
@@ -33,7 +36,7 @@ func (repo *SlackIntegrationRepository) CreateSlackIntegration(
 		return nil, err
 	}
 
-	if err := repo.db.Create(slackInt).Error; err != nil {
+	if err := repo.db.WithContext(ctx).Create(slackInt).Error; err != nil {
 		return nil, err
 	}
 
@@ -43,11 +46,12 @@ func (repo *SlackIntegrationRepository) CreateSlackIntegration(
 // ListSlackIntegrationsByProjectID finds all kube auth mechanisms
 // for a given project id
 func (repo *SlackIntegrationRepository) ListSlackIntegrationsByProjectID(
+	ctx context.Context,
 	projectID uint,
 ) ([]*ints.SlackIntegration, error) {
 	slackInts := []*ints.SlackIntegration{}
 
-	if err := repo.db.Where("project_id = ?", projectID).Find(&slackInts).Error; err != nil {
+	if err := repo.db.WithContext(ctx).Where("project_id = ?", projectID).Find(&slackInts).Error; err != nil {
 		return nil, err
 	}
 
@@ -60,9 +64,10 @@ func (repo *SlackIntegrationRepository) ListSlackIntegrationsByProjectID(
 
 // DeleteSlackIntegration deletes a slack integration by ID
 func (repo *SlackIntegrationRepository) DeleteSlackIntegration(
+	ctx context.Context,
 	integrationID uint,
 ) error {
-	if err := repo.db.Where("id = ?", integrationID).Delete(&ints.SlackIntegration{}).Error; err != nil {
+	if err := repo.db.WithContext(ctx).Where("id = ?", integrationID).Delete(&ints.SlackIntegration{}).Error; err != nil {
 		return err
 	}
 
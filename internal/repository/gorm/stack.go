@@ -1,6 +1,8 @@
 package gorm
 
 import (
+	"context"
+
 	"github.com/porter-dev/porter/internal/models"
 	"github.com/porter-dev/porter/internal/repository"
 	"gorm.io/gorm"
@@ -18,8 +20,8 @@ func NewStackRepository(db *gorm.DB) repository.StackRepository {
 }
 
 // CreateStack creates a new stack
-func (repo *StackRepository) CreateStack(stack *models.Stack) (*models.Stack, error) {
-	if err := repo.db.Create(stack).Error; err != nil {
+func (repo *StackRepository) CreateStack(ctx context.Context, stack *models.Stack) (*models.Stack, error) {
+	if err := repo.db.WithContext(ctx).Create(stack).Error; err != nil {
 		return nil, err
 	}
 
@@ -27,9 +29,9 @@ func (repo *StackRepository) CreateStack(stack *models.Stack) (*models.Stack, er
 }
 
 // ReadStack gets a stack specified by its string id
-func (repo *StackRepository) ListStacks(projectID, clusterID uint, namespace string) ([]*models.Stack, error) {
+func (repo *StackRepository) ListStacks(ctx context.Context, projectID, clusterID uint, namespace string) ([]*models.Stack, error) {
 	stacks := make([]*models.Stack, 0)
-	query := repo.db.Where("stacks.project_id = ? AND stacks.cluster_id = ?", projectID, clusterID)
+	query := repo.db.WithContext(ctx).Where("stacks.project_id = ? AND stacks.cluster_id = ?", projectID, clusterID)
 
 	if namespace != "" {
 		query = query.Where("stacks.namespace = ?", namespace)
@@ -49,7 +51,7 @@ func (repo *StackRepository) ListStacks(projectID, clusterID uint, namespace str
 	// query for each stack's revision
 	revisions := make([]*models.StackRevision, 0)
 
-	if err := repo.db.Preload("SourceConfigs").Preload("Resources").Preload("EnvGroups").Where("stack_revisions.stack_id IN (?)", stackIDs).Where(`
+	if err := repo.db.WithContext(ctx).Preload("SourceConfigs").Preload("Resources").Preload("EnvGroups").Where("stack_revisions.stack_id IN (?)", stackIDs).Where(`
 	stack_revisions.id IN (
 	  SELECT s2.id FROM (SELECT MAX(stack_revisions.id) id FROM stack_revisions WHERE stack_revisions.stack_id IN (?) GROUP BY stack_revisions.stack_id) s2
 	)
@@ -75,10 +77,10 @@ func (repo *StackRepository) ListStacks(projectID, clusterID uint, namespace str
 	return stacks, nil
 }
 
-func (repo *StackRepository) ReadStackByID(projectID, stackID uint) (*models.Stack, error) {
+func (repo *StackRepository) ReadStackByID(ctx context.Context, projectID, stackID uint) (*models.Stack, error) {
 	stack := &models.Stack{}
 
-	if err := repo.db.
+	if err := repo.db.WithContext(ctx).
 		Preload("Revisions", func(db *gorm.DB) *gorm.DB {
 			return db.Order("stack_revisions.revision_number DESC").Limit(100)
 		}).
@@ -93,10 +95,10 @@ func (repo *StackRepository) ReadStackByID(projectID, stackID uint) (*models.Sta
 }
 
 // ReadStack gets a stack specified by its string id
-func (repo *StackRepository) ReadStackByStringID(projectID uint, stackID string) (*models.Stack, error) {
+func (repo *StackRepository) ReadStackByStringID(ctx context.Context, projectID uint, stackID string) (*models.Stack, error) {
 	stack := &models.Stack{}
 
-	if err := repo.db.
+	if err := repo.db.WithContext(ctx).
 		Preload("Revisions", func(db *gorm.DB) *gorm.DB {
 			return db.Order("stack_revisions.revision_number DESC").Limit(100)
 		}).
@@ -111,58 +113,58 @@ func (repo *StackRepository) ReadStackByStringID(projectID uint, stackID string)
 }
 
 // DeleteStack creates a new stack
-func (repo *StackRepository) DeleteStack(stack *models.Stack) (*models.Stack, error) {
-	if err := repo.db.Delete(stack).Error; err != nil {
+func (repo *StackRepository) DeleteStack(ctx context.Context, stack *models.Stack) (*models.Stack, error) {
+	if err := repo.db.WithContext(ctx).Delete(stack).Error; err != nil {
 		return nil, err
 	}
 
 	return stack, nil
 }
 
-func (repo *StackRepository) UpdateStack(stack *models.Stack) (*models.Stack, error) {
-	if err := repo.db.Save(stack).Error; err != nil {
+func (repo *StackRepository) UpdateStack(ctx context.Context, stack *models.Stack) (*models.Stack, error) {
+	if err := repo.db.WithContext(ctx).Save(stack).Error; err != nil {
 		return nil, err
 	}
 
 	return stack, nil
 }
 
-func (repo *StackRepository) UpdateStackRevision(revision *models.StackRevision) (*models.StackRevision, error) {
-	if err := repo.db.Save(revision).Error; err != nil {
+func (repo *StackRepository) UpdateStackRevision(ctx context.Context, revision *models.StackRevision) (*models.StackRevision, error) {
+	if err := repo.db.WithContext(ctx).Save(revision).Error; err != nil {
 		return nil, err
 	}
 
 	return revision, nil
 }
 
-func (repo *StackRepository) ReadStackRevision(stackRevisionID uint) (*models.StackRevision, error) {
+func (repo *StackRepository) ReadStackRevision(ctx context.Context, stackRevisionID uint) (*models.StackRevision, error) {
 	revision := &models.StackRevision{}
 
-	if err := repo.db.Preload("Resources").Preload("SourceConfigs").Preload("EnvGroups").Where("id = ?", stackRevisionID).First(&revision).Error; err != nil {
+	if err := repo.db.WithContext(ctx).Preload("Resources").Preload("SourceConfigs").Preload("EnvGroups").Where("id = ?", stackRevisionID).First(&revision).Error; err != nil {
 		return nil, err
 	}
 
 	return revision, nil
 }
 
-func (repo *StackRepository) ReadStackRevisionByNumber(stackID uint, revisionNumber uint) (*models.StackRevision, error) {
+func (repo *StackRepository) ReadStackRevisionByNumber(ctx context.Context, stackID uint, revisionNumber uint) (*models.StackRevision, error) {
 	revision := &models.StackRevision{}
 
-	if err := repo.db.Preload("Resources").Preload("SourceConfigs").Preload("EnvGroups").Where("stack_id = ? AND revision_number = ?", stackID, revisionNumber).First(&revision).Error; err != nil {
+	if err := repo.db.WithContext(ctx).Preload("Resources").Preload("SourceConfigs").Preload("EnvGroups").Where("stack_id = ? AND revision_number = ?", stackID, revisionNumber).First(&revision).Error; err != nil {
 		return nil, err
 	}
 
 	return revision, nil
 }
 
-func (repo *StackRepository) AppendNewRevision(revision *models.StackRevision) (*models.StackRevision, error) {
+func (repo *StackRepository) AppendNewRevision(ctx context.Context, revision *models.StackRevision) (*models.StackRevision, error) {
 	stack := &models.Stack{}
 
-	if err := repo.db.Where("id = ?", revision.StackID).First(&stack).Error; err != nil {
+	if err := repo.db.WithContext(ctx).Where("id = ?", revision.StackID).First(&stack).Error; err != nil {
 		return nil, err
 	}
 
-	assoc := repo.db.Model(&stack).Association("Revisions")
+	assoc := repo.db.WithContext(ctx).Model(&stack).Association("Revisions")
 
 	if assoc.Error != nil {
 		return nil, assoc.Error
@@ -175,28 +177,28 @@ func (repo *StackRepository) AppendNewRevision(revision *models.StackRevision) (
 	return revision, nil
 }
 
-func (repo *StackRepository) ReadStackResource(resourceID uint) (*models.StackResource, error) {
+func (repo *StackRepository) ReadStackResource(ctx context.Context, resourceID uint) (*models.StackResource, error) {
 	resource := &models.StackResource{}
 
-	if err := repo.db.Where("id = ?", resourceID).First(&resource).Error; err != nil {
+	if err := repo.db.WithContext(ctx).Where("id = ?", resourceID).First(&resource).Error; err != nil {
 		return nil, err
 	}
 
 	return resource, nil
 }
 
-func (repo *StackRepository) UpdateStackResource(resource *models.StackResource) (*models.StackResource, error) {
-	if err := repo.db.Save(resource).Error; err != nil {
+func (repo *StackRepository) UpdateStackResource(ctx context.Context, resource *models.StackResource) (*models.StackResource, error) {
+	if err := repo.db.WithContext(ctx).Save(resource).Error; err != nil {
 		return nil, err
 	}
 
 	return resource, nil
 }
 
-func (repo *StackRepository) ReadStackEnvGroupFirstMatch(projectID, clusterID uint, namespace, name string) (*models.StackEnvGroup, error) {
+func (repo *StackRepository) ReadStackEnvGroupFirstMatch(ctx context.Context, projectID, clusterID uint, namespace, name string) (*models.StackEnvGroup, error) {
 	envGroup := &models.StackEnvGroup{}
 
-	if err := repo.db.Where("project_id = ? AND cluster_id = ? AND namespace = ? AND name = ?", projectID, clusterID, namespace, name).Order("id desc").First(&envGroup).Error; err != nil {
+	if err := repo.db.WithContext(ctx).Where("project_id = ? AND cluster_id = ? AND namespace = ? AND name = ?", projectID, clusterID, namespace, name).Order("id desc").First(&envGroup).Error; err != nil {
 		return nil, err
 	}
 
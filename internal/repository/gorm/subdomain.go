@@ -0,0 +1,121 @@
+package gorm
+
+import (
+	"context"
+	"errors"
+
+	"github.com/porter-dev/porter/internal/models"
+	"github.com/porter-dev/porter/internal/repository"
+	"gorm.io/gorm"
+)
+
+// SubdomainRepository uses gorm.DB for querying the database
+type SubdomainRepository struct {
+	db *gorm.DB
+}
+
+// NewSubdomainRepository returns a SubdomainRepository which uses gorm.DB for querying the database
+func NewSubdomainRepository(db *gorm.DB) repository.SubdomainRepository {
+	return &SubdomainRepository{db}
+}
+
+// ReadWildcardDomain finds the wildcard domain of a project
+func (repo *SubdomainRepository) ReadWildcardDomain(ctx context.Context, projectID uint) (*models.WildcardDomain, error) {
+	domain := &models.WildcardDomain{}
+
+	if err := repo.db.WithContext(ctx).Where("project_id = ?", projectID).First(domain).Error; err != nil {
+		return nil, err
+	}
+
+	return domain, nil
+}
+
+// SaveWildcardDomain creates the wildcard domain of a project, or updates it if it exists
+func (repo *SubdomainRepository) SaveWildcardDomain(ctx context.Context, domain *models.WildcardDomain) (*models.WildcardDomain, error) {
+	existing, err := repo.ReadWildcardDomain(ctx, domain.ProjectID)
+	if err != nil && !errors.Is(err, gorm.ErrRecordNotFound) {
+		return nil, err
+	}
+
+	if existing != nil {
+		domain.ID = existing.ID
+		domain.CreatedAt = existing.CreatedAt
+	}
+
+	if err := repo.db.WithContext(ctx).Save(domain).Error; err != nil {
+		return nil, err
+	}
+
+	return domain, nil
+}
+
+// DeleteWildcardDomain deletes the wildcard domain of a project and releases its subdomains. Both are deleted permanently, so
+// that the domain can be set again and the names allocated again.
+func (repo *SubdomainRepository) DeleteWildcardDomain(ctx context.Context, domain *models.WildcardDomain) error {
+	return repo.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		if err := tx.Unscoped().Where("project_id = ?", domain.ProjectID).Delete(&models.SubdomainAllocation{}).Error; err != nil {
+			return err
+		}
+
+		return tx.Unscoped().Delete(domain).Error
+	})
+}
+
+// CreateSubdomainAllocation allocates a subdomain, and fails if the name is already allocated in the project
+func (repo *SubdomainRepository) CreateSubdomainAllocation(ctx context.Context, allocation *models.SubdomainAllocation) (*models.SubdomainAllocation, error) {
+	if err := repo.db.WithContext(ctx).Create(allocation).Error; err != nil {
+		return nil, err
+	}
+
+	return allocation, nil
+}
+
+// ReadSubdomainAllocation finds a subdomain allocated in a project by name
+func (repo *SubdomainRepository) ReadSubdomainAllocation(ctx context.Context, projectID uint, name string) (*models.SubdomainAllocation, error) {
+	allocation := &models.SubdomainAllocation{}
+
+	if err := repo.db.WithContext(ctx).Where("project_id = ? AND name = ?", projectID, name).First(allocation).Error; err != nil {
+		return nil, err
+	}
+
+	return allocation, nil
+}
+
+// ReadSubdomainAllocationByService finds the subdomain allocated to a service of an app on a deployment target
+func (repo *SubdomainRepository) ReadSubdomainAllocationByService(ctx context.Context, clusterID uint, appName, serviceName, deploymentTargetID string) (*models.SubdomainAllocation, error) {
+	allocation := &models.SubdomainAllocation{}
+
+	if err := repo.db.WithContext(ctx).
+		Where("cluster_id = ? AND app_name = ? AND service_name = ? AND deployment_target_id = ?", clusterID, appName, serviceName, deploymentTargetID).
+		First(allocation).Error; err != nil {
+		return nil, err
+	}
+
+	return allocation, nil
+}
+
+// ListSubdomainAllocations lists the subdomains allocated in a project
+func (repo *SubdomainRepository) ListSubdomainAllocations(ctx context.Context, projectID uint) ([]*models.SubdomainAllocation, error) {
+	allocations := []*models.SubdomainAllocation{}
+
+	if err := repo.db.WithContext(ctx).Where("project_id = ?", projectID).Order("name asc").Find(&allocations).Error; err != nil {
+		return nil, err
+	}
+
+	return allocations, nil
+}
+
+// DeleteSubdomainAllocation releases a subdomain, so that its name can be allocated again
+func (repo *SubdomainRepository) DeleteSubdomainAllocation(ctx context.Context, allocation *models.SubdomainAllocation) error {
+	return repo.db.WithContext(ctx).Unscoped().Delete(allocation).Error
+}
+
+// DeleteSubdomainAllocationsByApp releases the subdomains allocated to an app
+func (repo *SubdomainRepository) DeleteSubdomainAllocationsByApp(ctx context.Context, clusterID uint, appName string) error {
+	return repo.db.WithContext(ctx).Unscoped().Where("cluster_id = ? AND app_name = ?", clusterID, appName).Delete(&models.SubdomainAllocation{}).Error
+}
+
+// DeleteSubdomainAllocationsByDeploymentTarget releases the subdomains allocated to a preview environment
+func (repo *SubdomainRepository) DeleteSubdomainAllocationsByDeploymentTarget(ctx context.Context, deploymentTargetID string) error {
+	return repo.db.WithContext(ctx).Unscoped().Where("deployment_target_id = ?", deploymentTargetID).Delete(&models.SubdomainAllocation{}).Error
+}
@@ -1,6 +1,7 @@
 package gorm
 
 import (
+	"context"
 	"fmt"
 
 	"github.com/porter-dev/porter/internal/models"
@@ -19,29 +20,29 @@ func NewTagRepository(db *gorm.DB) repository.TagRepository {
 	return &TagRepository{db}
 }
 
-func (repo *TagRepository) CreateTag(tag *models.Tag) (*models.Tag, error) {
-	existingTag, _ := repo.ReadTagByNameAndProjectId(tag.Name, tag.ProjectID)
+func (repo *TagRepository) CreateTag(ctx context.Context, tag *models.Tag) (*models.Tag, error) {
+	existingTag, _ := repo.ReadTagByNameAndProjectId(ctx, tag.Name, tag.ProjectID)
 
 	if existingTag != nil {
 		return nil, fmt.Errorf("tag already exists")
 	}
 
-	if err := repo.db.Create(tag).Error; err != nil {
+	if err := repo.db.WithContext(ctx).Create(tag).Error; err != nil {
 		return nil, err
 	}
 	return tag, nil
 }
 
-func (repo *TagRepository) LinkTagsToRelease(tags []string, release *models.Release) ([]*models.Tag, error) {
+func (repo *TagRepository) LinkTagsToRelease(ctx context.Context, tags []string, release *models.Release) ([]*models.Tag, error) {
 	populatedTags := make([]*models.Tag, 0)
-	err := repo.db.Where("name IN ?", tags).Where("project_id = ?", release.ProjectID).Find(&populatedTags).Error
+	err := repo.db.WithContext(ctx).Where("name IN ?", tags).Where("project_id = ?", release.ProjectID).Find(&populatedTags).Error
 	if err != nil {
 		return nil, err
 	}
 
 	release.Tags = populatedTags
 
-	err = repo.db.Save(release).Error
+	err = repo.db.WithContext(ctx).Save(release).Error
 
 	if err != nil {
 		return nil, err
@@ -50,14 +51,14 @@ func (repo *TagRepository) LinkTagsToRelease(tags []string, release *models.Rele
 	return populatedTags, nil
 }
 
-func (repo *TagRepository) UnlinkTagsFromRelease(tags []string, release *models.Release) error {
+func (repo *TagRepository) UnlinkTagsFromRelease(ctx context.Context, tags []string, release *models.Release) error {
 	populatedTags := make([]*models.Tag, 0)
-	err := repo.db.Where("name IN ?", tags).Where("project_id = ?", release.ProjectID).Find(&populatedTags).Error
+	err := repo.db.WithContext(ctx).Where("name IN ?", tags).Where("project_id = ?", release.ProjectID).Find(&populatedTags).Error
 	if err != nil {
 		return err
 	}
 
-	err = repo.db.Model(&release).Association("Tags").Delete(populatedTags)
+	err = repo.db.WithContext(ctx).Model(&release).Association("Tags").Delete(populatedTags)
 
 	if err != nil {
 		return err
@@ -66,10 +67,10 @@ func (repo *TagRepository) UnlinkTagsFromRelease(tags []string, release *models.
 	return nil
 }
 
-func (repo *TagRepository) ReadTagByNameAndProjectId(tagName string, projectId uint) (*models.Tag, error) {
+func (repo *TagRepository) ReadTagByNameAndProjectId(ctx context.Context, tagName string, projectId uint) (*models.Tag, error) {
 	tag := &models.Tag{}
 
-	err := repo.db.Where("name = ? AND project_id = ?", tagName, projectId).First(tag).Error
+	err := repo.db.WithContext(ctx).Where("name = ? AND project_id = ?", tagName, projectId).First(tag).Error
 	if err != nil {
 		return nil, err
 	}
@@ -77,10 +78,10 @@ func (repo *TagRepository) ReadTagByNameAndProjectId(tagName string, projectId u
 	return tag, nil
 }
 
-func (repo *TagRepository) ListTagsByProjectId(projectId uint) ([]*models.Tag, error) {
+func (repo *TagRepository) ListTagsByProjectId(ctx context.Context, projectId uint) ([]*models.Tag, error) {
 	tags := make([]*models.Tag, 0)
 
-	err := repo.db.Preload("Releases").Where("project_id = ?", projectId).Find(&tags).Error
+	err := repo.db.WithContext(ctx).Preload("Releases").Where("project_id = ?", projectId).Find(&tags).Error
 	if err != nil {
 		return nil, err
 	}
@@ -88,22 +89,22 @@ func (repo *TagRepository) ListTagsByProjectId(projectId uint) ([]*models.Tag, e
 	return tags, nil
 }
 
-func (repo *TagRepository) UpdateTag(tag *models.Tag) (*models.Tag, error) {
-	existingTag, _ := repo.ReadTagByNameAndProjectId(tag.Name, tag.ProjectID)
+func (repo *TagRepository) UpdateTag(ctx context.Context, tag *models.Tag) (*models.Tag, error) {
+	existingTag, _ := repo.ReadTagByNameAndProjectId(ctx, tag.Name, tag.ProjectID)
 
 	if existingTag != nil {
 		return nil, fmt.Errorf("tag already exists")
 	}
 
-	if err := repo.db.Save(tag).Error; err != nil {
+	if err := repo.db.WithContext(ctx).Save(tag).Error; err != nil {
 		return nil, err
 	}
 
 	return tag, nil
 }
 
-func (repo *TagRepository) DeleteTag(id uint) error {
-	if err := repo.db.Delete(&models.Tag{}, id).Error; err != nil {
+func (repo *TagRepository) DeleteTag(ctx context.Context, id uint) error {
+	if err := repo.db.WithContext(ctx).Delete(&models.Tag{}, id).Error; err != nil {
 		return err
 	}
 
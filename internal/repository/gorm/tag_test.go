@@ -1,6 +1,7 @@
 package gorm_test
 
 import (
+	"context"
 	"testing"
 
 	"github.com/porter-dev/porter/internal/models"
@@ -22,7 +23,7 @@ func TestCreateNewTag(t *testing.T) {
 		Color:     "#ffffff",
 	}
 
-	_, err := tester.repo.Tag().CreateTag(tag)
+	_, err := tester.repo.Tag().CreateTag(context.Background(), tag)
 	if err != nil {
 		t.Fatalf("%v\n", err)
 	}
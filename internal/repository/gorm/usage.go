@@ -1,6 +1,8 @@
 package gorm
 
 import (
+	"context"
+
 	"github.com/porter-dev/porter/internal/models"
 	"github.com/porter-dev/porter/internal/repository"
 	"gorm.io/gorm"
@@ -18,9 +20,10 @@ func NewProjectUsageRepository(db *gorm.DB) repository.ProjectUsageRepository {
 
 // CreateProjectUsage creates a new project usage limit
 func (repo *ProjectUsageRepository) CreateProjectUsage(
+	ctx context.Context,
 	usage *models.ProjectUsage,
 ) (*models.ProjectUsage, error) {
-	if err := repo.db.Create(usage).Error; err != nil {
+	if err := repo.db.WithContext(ctx).Create(usage).Error; err != nil {
 		return nil, err
 	}
 
@@ -29,11 +32,12 @@ func (repo *ProjectUsageRepository) CreateProjectUsage(
 
 // ReadProjectUsage finds the project usage matching a project ID
 func (repo *ProjectUsageRepository) ReadProjectUsage(
+	ctx context.Context,
 	projID uint,
 ) (*models.ProjectUsage, error) {
 	res := &models.ProjectUsage{}
 
-	if err := repo.db.Where("project_id = ?", projID).First(res).Error; err != nil {
+	if err := repo.db.WithContext(ctx).Where("project_id = ?", projID).First(res).Error; err != nil {
 		return nil, err
 	}
 
@@ -42,9 +46,10 @@ func (repo *ProjectUsageRepository) ReadProjectUsage(
 
 // UpdateProjectUsage modifies an existing ProjectUsage in the database
 func (repo *ProjectUsageRepository) UpdateProjectUsage(
+	ctx context.Context,
 	usage *models.ProjectUsage,
 ) (*models.ProjectUsage, error) {
-	if err := repo.db.Save(usage).Error; err != nil {
+	if err := repo.db.WithContext(ctx).Save(usage).Error; err != nil {
 		return nil, err
 	}
 
@@ -53,9 +58,10 @@ func (repo *ProjectUsageRepository) UpdateProjectUsage(
 
 // CreateProjectUsageCache creates a new project usage cache
 func (repo *ProjectUsageRepository) CreateProjectUsageCache(
+	ctx context.Context,
 	cache *models.ProjectUsageCache,
 ) (*models.ProjectUsageCache, error) {
-	if err := repo.db.Create(cache).Error; err != nil {
+	if err := repo.db.WithContext(ctx).Create(cache).Error; err != nil {
 		return nil, err
 	}
 
@@ -64,11 +70,12 @@ func (repo *ProjectUsageRepository) CreateProjectUsageCache(
 
 // ReadProjectUsageCache finds the project usage cache matching a project ID
 func (repo *ProjectUsageRepository) ReadProjectUsageCache(
+	ctx context.Context,
 	projID uint,
 ) (*models.ProjectUsageCache, error) {
 	res := &models.ProjectUsageCache{}
 
-	if err := repo.db.Where("project_id = ?", projID).First(res).Error; err != nil {
+	if err := repo.db.WithContext(ctx).Where("project_id = ?", projID).First(res).Error; err != nil {
 		return nil, err
 	}
 
@@ -77,9 +84,10 @@ func (repo *ProjectUsageRepository) ReadProjectUsageCache(
 
 // UpdateProjectUsageCache modifies an existing ProjectUsageCache in the database
 func (repo *ProjectUsageRepository) UpdateProjectUsageCache(
+	ctx context.Context,
 	cache *models.ProjectUsageCache,
 ) (*models.ProjectUsageCache, error) {
-	if err := repo.db.Save(cache).Error; err != nil {
+	if err := repo.db.WithContext(ctx).Save(cache).Error; err != nil {
 		return nil, err
 	}
 
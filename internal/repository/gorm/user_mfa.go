@@ -0,0 +1,118 @@
+package gorm
+
+import (
+	"context"
+
+	"github.com/porter-dev/porter/internal/encryption"
+	"github.com/porter-dev/porter/internal/models"
+	"github.com/porter-dev/porter/internal/repository"
+	"gorm.io/gorm"
+)
+
+// UserMFARepository uses gorm.DB for querying the database
+type UserMFARepository struct {
+	db  *gorm.DB
+	key *[32]byte
+}
+
+// NewUserMFARepository returns a UserMFARepository which uses gorm.DB for
+// querying the database. It accepts an encryption key to encrypt TOTP secrets
+func NewUserMFARepository(db *gorm.DB, key *[32]byte) repository.UserMFARepository {
+	return &UserMFARepository{db, key}
+}
+
+// CreateUserMFA creates a new MFA enrollment for a user
+func (repo *UserMFARepository) CreateUserMFA(ctx context.Context, mfa *models.UserMFA) (*models.UserMFA, error) {
+	err := repo.EncryptUserMFAData(mfa, repo.key)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := repo.db.WithContext(ctx).Create(mfa).Error; err != nil {
+		return nil, err
+	}
+
+	err = repo.DecryptUserMFAData(mfa, repo.key)
+	if err != nil {
+		return nil, err
+	}
+
+	return mfa, nil
+}
+
+// ReadUserMFA finds the MFA enrollment of a user
+func (repo *UserMFARepository) ReadUserMFA(ctx context.Context, userID uint) (*models.UserMFA, error) {
+	mfa := &models.UserMFA{}
+
+	if err := repo.db.WithContext(ctx).Where("user_id = ?", userID).First(mfa).Error; err != nil {
+		return nil, err
+	}
+
+	err := repo.DecryptUserMFAData(mfa, repo.key)
+	if err != nil {
+		return nil, err
+	}
+
+	return mfa, nil
+}
+
+// UpdateUserMFA updates the MFA enrollment of a user
+func (repo *UserMFARepository) UpdateUserMFA(ctx context.Context, mfa *models.UserMFA) (*models.UserMFA, error) {
+	err := repo.EncryptUserMFAData(mfa, repo.key)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := repo.db.WithContext(ctx).Save(mfa).Error; err != nil {
+		return nil, err
+	}
+
+	err = repo.DecryptUserMFAData(mfa, repo.key)
+	if err != nil {
+		return nil, err
+	}
+
+	return mfa, nil
+}
+
+// DeleteUserMFA deletes the MFA enrollment of a user. The enrollment is deleted permanently, so that the
+// user can enroll again.
+func (repo *UserMFARepository) DeleteUserMFA(ctx context.Context, mfa *models.UserMFA) error {
+	return repo.db.WithContext(ctx).Unscoped().Delete(mfa).Error
+}
+
+// EncryptUserMFAData will encrypt the TOTP secret before
+// writing to the DB
+func (repo *UserMFARepository) EncryptUserMFAData(
+	mfa *models.UserMFA,
+	key *[32]byte,
+) error {
+	if len(mfa.TOTPSecret) > 0 {
+		cipherData, err := encryption.Encrypt(mfa.TOTPSecret, key)
+		if err != nil {
+			return err
+		}
+
+		mfa.TOTPSecret = cipherData
+	}
+
+	return nil
+}
+
+// DecryptUserMFAData will decrypt the TOTP secret before
+// returning it from the DB
+func (repo *UserMFARepository) DecryptUserMFAData(
+	mfa *models.UserMFA,
+	key *[32]byte,
+) error {
+	if len(mfa.TOTPSecret) > 0 {
+		plaintext, err := encryption.Decrypt(mfa.TOTPSecret, key)
+		if err != nil {
+			return err
+		}
+
+		mfa.TOTPSecret = plaintext
+	}
+
+	return nil
+}
@@ -1,6 +1,7 @@
 package gorm_test
 
 import (
+	"context"
 	"testing"
 
 	"github.com/go-test/deep"
@@ -16,7 +17,7 @@ func TestListUsersByIDs(t *testing.T) {
 	initMultiUser(tester, t)
 	defer cleanup(tester, t)
 
-	users, err := tester.repo.User().ListUsersByIDs([]uint{1, 2})
+	users, err := tester.repo.User().ListUsersByIDs(context.Background(), []uint{1, 2})
 	if err != nil {
 		t.Fatalf("%v\n", err)
 	}
@@ -26,7 +27,7 @@ func TestListUsersByIDs(t *testing.T) {
 		t.Error(diff)
 	}
 
-	users, err = tester.repo.User().ListUsersByIDs([]uint{1})
+	users, err = tester.repo.User().ListUsersByIDs(context.Background(), []uint{1})
 
 	if err != nil {
 		t.Fatalf("%v\n", err)
@@ -54,12 +55,12 @@ func TestReadUserByGithubUserID(t *testing.T) {
 		GithubUserID: 5,
 	}
 
-	user, err := tester.repo.User().CreateUser(user)
+	user, err := tester.repo.User().CreateUser(context.Background(), user)
 	if err != nil {
 		t.Fatalf("%v\n", err)
 	}
 
-	readUser, err := tester.repo.User().ReadUserByGithubUserID(5)
+	readUser, err := tester.repo.User().ReadUserByGithubUserID(context.Background(), 5)
 	if err != nil {
 		t.Fatalf("%v\n", err)
 	}
@@ -84,12 +85,12 @@ func TestReadUserByGoogleUserID(t *testing.T) {
 		GoogleUserID: "alsdkfjsldaf",
 	}
 
-	user, err := tester.repo.User().CreateUser(user)
+	user, err := tester.repo.User().CreateUser(context.Background(), user)
 	if err != nil {
 		t.Fatalf("%v\n", err)
 	}
 
-	readUser, err := tester.repo.User().ReadUserByGoogleUserID("alsdkfjsldaf")
+	readUser, err := tester.repo.User().ReadUserByGoogleUserID(context.Background(), "alsdkfjsldaf")
 	if err != nil {
 		t.Fatalf("%v\n", err)
 	}
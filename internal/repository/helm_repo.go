@@ -1,16 +1,18 @@
 package repository
 
 import (
+	"context"
+
 	"github.com/porter-dev/porter/internal/models"
 	ints "github.com/porter-dev/porter/internal/models/integrations"
 )
 
 // HelmRepoRepository represents the set of queries on the HelmRepo model
 type HelmRepoRepository interface {
-	CreateHelmRepo(repo *models.HelmRepo) (*models.HelmRepo, error)
-	ReadHelmRepo(projectID, hrID uint) (*models.HelmRepo, error)
-	ListHelmReposByProjectID(projectID uint) ([]*models.HelmRepo, error)
-	UpdateHelmRepo(repo *models.HelmRepo) (*models.HelmRepo, error)
-	UpdateHelmRepoTokenCache(tokenCache *ints.HelmRepoTokenCache) (*models.HelmRepo, error)
-	DeleteHelmRepo(repo *models.HelmRepo) error
+	CreateHelmRepo(ctx context.Context, repo *models.HelmRepo) (*models.HelmRepo, error)
+	ReadHelmRepo(ctx context.Context, projectID, hrID uint) (*models.HelmRepo, error)
+	ListHelmReposByProjectID(ctx context.Context, projectID uint) ([]*models.HelmRepo, error)
+	UpdateHelmRepo(ctx context.Context, repo *models.HelmRepo) (*models.HelmRepo, error)
+	UpdateHelmRepoTokenCache(ctx context.Context, tokenCache *ints.HelmRepoTokenCache) (*models.HelmRepo, error)
+	DeleteHelmRepo(ctx context.Context, repo *models.HelmRepo) error
 }
@@ -0,0 +1,24 @@
+package repository
+
+import (
+	"context"
+
+	"github.com/porter-dev/porter/internal/models"
+)
+
+// ImageAttestationRepository represents the set of queries on the ImageAttestation model
+type ImageAttestationRepository interface {
+	CreateImageAttestation(ctx context.Context, attestation *models.ImageAttestation) (*models.ImageAttestation, error)
+	// ListImageAttestationsByTag lists the attestations of the images of an app with the given tag, newest first
+	ListImageAttestationsByTag(ctx context.Context, projectID uint, appName string, tag string) ([]*models.ImageAttestation, error)
+	// ListImageAttestationsByImage lists the attestations of the image with the given repository and tag, newest first
+	ListImageAttestationsByImage(ctx context.Context, projectID uint, repository string, tag string) ([]*models.ImageAttestation, error)
+}
+
+// ImageSigningPolicyRepository represents the set of queries on the ImageSigningPolicy model
+type ImageSigningPolicyRepository interface {
+	CreateImageSigningPolicy(ctx context.Context, policy *models.ImageSigningPolicy) (*models.ImageSigningPolicy, error)
+	UpdateImageSigningPolicy(ctx context.Context, policy *models.ImageSigningPolicy) (*models.ImageSigningPolicy, error)
+	// ReadImageSigningPolicy finds the image signing policy of a project
+	ReadImageSigningPolicy(ctx context.Context, projectID uint) (*models.ImageSigningPolicy, error)
+}
@@ -1,103 +1,117 @@
 package repository
 
 import (
+	"context"
+
 	ints "github.com/porter-dev/porter/internal/models/integrations"
 )
 
 // KubeIntegrationRepository represents the set of queries on the OIDC auth
 // mechanism
 type KubeIntegrationRepository interface {
-	CreateKubeIntegration(am *ints.KubeIntegration) (*ints.KubeIntegration, error)
-	ReadKubeIntegration(projectID, id uint) (*ints.KubeIntegration, error)
-	ListKubeIntegrationsByProjectID(projectID uint) ([]*ints.KubeIntegration, error)
+	CreateKubeIntegration(ctx context.Context, am *ints.KubeIntegration) (*ints.KubeIntegration, error)
+	ReadKubeIntegration(ctx context.Context, projectID, id uint) (*ints.KubeIntegration, error)
+	ListKubeIntegrationsByProjectID(ctx context.Context, projectID uint) ([]*ints.KubeIntegration, error)
 }
 
 // BasicIntegrationRepository represents the set of queries on the "basic" auth
 // mechanism
 type BasicIntegrationRepository interface {
-	CreateBasicIntegration(am *ints.BasicIntegration) (*ints.BasicIntegration, error)
-	ReadBasicIntegration(projectID, id uint) (*ints.BasicIntegration, error)
-	ListBasicIntegrationsByProjectID(projectID uint) ([]*ints.BasicIntegration, error)
-	DeleteBasicIntegration(am *ints.BasicIntegration) (*ints.BasicIntegration, error)
+	CreateBasicIntegration(ctx context.Context, am *ints.BasicIntegration) (*ints.BasicIntegration, error)
+	ReadBasicIntegration(ctx context.Context, projectID, id uint) (*ints.BasicIntegration, error)
+	ListBasicIntegrationsByProjectID(ctx context.Context, projectID uint) ([]*ints.BasicIntegration, error)
+	DeleteBasicIntegration(ctx context.Context, am *ints.BasicIntegration) (*ints.BasicIntegration, error)
 }
 
 // OIDCIntegrationRepository represents the set of queries on the OIDC auth
 // mechanism
 type OIDCIntegrationRepository interface {
-	CreateOIDCIntegration(am *ints.OIDCIntegration) (*ints.OIDCIntegration, error)
-	ReadOIDCIntegration(projectID, id uint) (*ints.OIDCIntegration, error)
-	ListOIDCIntegrationsByProjectID(projectID uint) ([]*ints.OIDCIntegration, error)
+	CreateOIDCIntegration(ctx context.Context, am *ints.OIDCIntegration) (*ints.OIDCIntegration, error)
+	ReadOIDCIntegration(ctx context.Context, projectID, id uint) (*ints.OIDCIntegration, error)
+	ListOIDCIntegrationsByProjectID(ctx context.Context, projectID uint) ([]*ints.OIDCIntegration, error)
 }
 
 // OAuthIntegrationRepository represents the set of queries on the oauth
 // mechanism
 type OAuthIntegrationRepository interface {
-	CreateOAuthIntegration(am *ints.OAuthIntegration) (*ints.OAuthIntegration, error)
-	ReadOAuthIntegration(projectID, id uint) (*ints.OAuthIntegration, error)
-	ListOAuthIntegrationsByProjectID(projectID uint) ([]*ints.OAuthIntegration, error)
-	UpdateOAuthIntegration(am *ints.OAuthIntegration) (*ints.OAuthIntegration, error)
+	CreateOAuthIntegration(ctx context.Context, am *ints.OAuthIntegration) (*ints.OAuthIntegration, error)
+	ReadOAuthIntegration(ctx context.Context, projectID, id uint) (*ints.OAuthIntegration, error)
+	ListOAuthIntegrationsByProjectID(ctx context.Context, projectID uint) ([]*ints.OAuthIntegration, error)
+	UpdateOAuthIntegration(ctx context.Context, am *ints.OAuthIntegration) (*ints.OAuthIntegration, error)
 }
 
 // GithubAppOAuthIntegrationRepository represents the set of queries on the oauth
 // mechanism
 type GithubAppOAuthIntegrationRepository interface {
-	CreateGithubAppOAuthIntegration(am *ints.GithubAppOAuthIntegration) (*ints.GithubAppOAuthIntegration, error)
-	ReadGithubAppOauthIntegration(id uint) (*ints.GithubAppOAuthIntegration, error)
-	UpdateGithubAppOauthIntegration(am *ints.GithubAppOAuthIntegration) (*ints.GithubAppOAuthIntegration, error)
+	CreateGithubAppOAuthIntegration(ctx context.Context, am *ints.GithubAppOAuthIntegration) (*ints.GithubAppOAuthIntegration, error)
+	ReadGithubAppOauthIntegration(ctx context.Context, id uint) (*ints.GithubAppOAuthIntegration, error)
+	UpdateGithubAppOauthIntegration(ctx context.Context, am *ints.GithubAppOAuthIntegration) (*ints.GithubAppOAuthIntegration, error)
 }
 
 // SlackIntegrationRepository represents the set of queries on a Slack integration
 type SlackIntegrationRepository interface {
-	CreateSlackIntegration(slackInt *ints.SlackIntegration) (*ints.SlackIntegration, error)
-	ListSlackIntegrationsByProjectID(projectID uint) ([]*ints.SlackIntegration, error)
-	DeleteSlackIntegration(integrationID uint) error
+	CreateSlackIntegration(ctx context.Context, slackInt *ints.SlackIntegration) (*ints.SlackIntegration, error)
+	ListSlackIntegrationsByProjectID(ctx context.Context, projectID uint) ([]*ints.SlackIntegration, error)
+	DeleteSlackIntegration(ctx context.Context, integrationID uint) error
 }
 
 // AWSIntegrationRepository represents the set of queries on the AWS auth
 // mechanism
 type AWSIntegrationRepository interface {
-	CreateAWSIntegration(am *ints.AWSIntegration) (*ints.AWSIntegration, error)
-	OverwriteAWSIntegration(am *ints.AWSIntegration) (*ints.AWSIntegration, error)
-	ReadAWSIntegration(projectID, id uint) (*ints.AWSIntegration, error)
-	ListAWSIntegrationsByProjectID(projectID uint) ([]*ints.AWSIntegration, error)
+	CreateAWSIntegration(ctx context.Context, am *ints.AWSIntegration) (*ints.AWSIntegration, error)
+	OverwriteAWSIntegration(ctx context.Context, am *ints.AWSIntegration) (*ints.AWSIntegration, error)
+	ReadAWSIntegration(ctx context.Context, projectID, id uint) (*ints.AWSIntegration, error)
+	ListAWSIntegrationsByProjectID(ctx context.Context, projectID uint) ([]*ints.AWSIntegration, error)
 }
 
 // AzureIntegrationRepository represents the set of queries on the AWS auth
 // mechanism
 type AzureIntegrationRepository interface {
-	CreateAzureIntegration(az *ints.AzureIntegration) (*ints.AzureIntegration, error)
-	OverwriteAzureIntegration(az *ints.AzureIntegration) (*ints.AzureIntegration, error)
-	ReadAzureIntegration(projectID, id uint) (*ints.AzureIntegration, error)
-	ListAzureIntegrationsByProjectID(projectID uint) ([]*ints.AzureIntegration, error)
+	CreateAzureIntegration(ctx context.Context, az *ints.AzureIntegration) (*ints.AzureIntegration, error)
+	OverwriteAzureIntegration(ctx context.Context, az *ints.AzureIntegration) (*ints.AzureIntegration, error)
+	ReadAzureIntegration(ctx context.Context, projectID, id uint) (*ints.AzureIntegration, error)
+	ListAzureIntegrationsByProjectID(ctx context.Context, projectID uint) ([]*ints.AzureIntegration, error)
 }
 
 // GCPIntegrationRepository represents the set of queries on the GCP auth
 // mechanism
 type GCPIntegrationRepository interface {
-	CreateGCPIntegration(am *ints.GCPIntegration) (*ints.GCPIntegration, error)
-	ReadGCPIntegration(projectID, id uint) (*ints.GCPIntegration, error)
-	ListGCPIntegrationsByProjectID(projectID uint) ([]*ints.GCPIntegration, error)
+	CreateGCPIntegration(ctx context.Context, am *ints.GCPIntegration) (*ints.GCPIntegration, error)
+	ReadGCPIntegration(ctx context.Context, projectID, id uint) (*ints.GCPIntegration, error)
+	ListGCPIntegrationsByProjectID(ctx context.Context, projectID uint) ([]*ints.GCPIntegration, error)
 }
 
 // GithubAppInstallationRepository represents the set of queries for github app installations
 type GithubAppInstallationRepository interface {
-	CreateGithubAppInstallation(am *ints.GithubAppInstallation) (*ints.GithubAppInstallation, error)
-	ReadGithubAppInstallationByInstallationID(gaID uint) (*ints.GithubAppInstallation, error)
-	ReadGithubAppInstallationByAccountID(accountID int64) (*ints.GithubAppInstallation, error)
-	ReadGithubAppInstallationByAccountIDs(accountIDs []int64) ([]*ints.GithubAppInstallation, error)
-	DeleteGithubAppInstallationByAccountID(accountID int64) error
+	CreateGithubAppInstallation(ctx context.Context, am *ints.GithubAppInstallation) (*ints.GithubAppInstallation, error)
+	ReadGithubAppInstallationByInstallationID(ctx context.Context, gaID uint) (*ints.GithubAppInstallation, error)
+	ReadGithubAppInstallationByAccountID(ctx context.Context, accountID int64) (*ints.GithubAppInstallation, error)
+	ReadGithubAppInstallationByAccountIDs(ctx context.Context, accountIDs []int64) ([]*ints.GithubAppInstallation, error)
+	DeleteGithubAppInstallationByAccountID(ctx context.Context, accountID int64) error
 }
 
 // GitlabIntegrationRepository represents the set of queries on the GitlabIntegration model
 type GitlabIntegrationRepository interface {
-	CreateGitlabIntegration(gi *ints.GitlabIntegration) (*ints.GitlabIntegration, error)
-	ReadGitlabIntegration(projectID, id uint) (*ints.GitlabIntegration, error)
-	ListGitlabIntegrationsByProjectID(projectID uint) ([]*ints.GitlabIntegration, error)
-	DeleteGitlabIntegrationByID(projectID, id uint) error
+	CreateGitlabIntegration(ctx context.Context, gi *ints.GitlabIntegration) (*ints.GitlabIntegration, error)
+	ReadGitlabIntegration(ctx context.Context, projectID, id uint) (*ints.GitlabIntegration, error)
+	ListGitlabIntegrationsByProjectID(ctx context.Context, projectID uint) ([]*ints.GitlabIntegration, error)
+	DeleteGitlabIntegrationByID(ctx context.Context, projectID, id uint) error
 }
 
 // GitlabAppOAuthIntegrationRepository represents the set of queries on the GitlabOAuthIntegration model
 type GitlabAppOAuthIntegrationRepository interface {
-	CreateGitlabAppOAuthIntegration(gi *ints.GitlabAppOAuthIntegration) (*ints.GitlabAppOAuthIntegration, error)
-	ReadGitlabAppOAuthIntegration(userID, projectID, integrationID uint) (*ints.GitlabAppOAuthIntegration, error)
+	CreateGitlabAppOAuthIntegration(ctx context.Context, gi *ints.GitlabAppOAuthIntegration) (*ints.GitlabAppOAuthIntegration, error)
+	ReadGitlabAppOAuthIntegration(ctx context.Context, userID, projectID, integrationID uint) (*ints.GitlabAppOAuthIntegration, error)
+}
+
+// ExternalSecretsIntegrationRepository represents the set of queries on the ExternalSecretsIntegration model
+type ExternalSecretsIntegrationRepository interface {
+	CreateExternalSecretsIntegration(ctx context.Context, es *ints.ExternalSecretsIntegration) (*ints.ExternalSecretsIntegration, error)
+	ReadExternalSecretsIntegration(ctx context.Context, projectID, clusterID, id uint) (*ints.ExternalSecretsIntegration, error)
+	ReadExternalSecretsIntegrationByWebhookID(ctx context.Context, webhookID string) (*ints.ExternalSecretsIntegration, error)
+	ListExternalSecretsIntegrationsByClusterID(ctx context.Context, projectID, clusterID uint) ([]*ints.ExternalSecretsIntegration, error)
+	// ListScheduledExternalSecretsIntegrations lists the integrations of all clusters which sync on a schedule
+	ListScheduledExternalSecretsIntegrations(ctx context.Context) ([]*ints.ExternalSecretsIntegration, error)
+	UpdateExternalSecretsIntegration(ctx context.Context, es *ints.ExternalSecretsIntegration) (*ints.ExternalSecretsIntegration, error)
+	DeleteExternalSecretsIntegration(ctx context.Context, projectID, clusterID, id uint) error
 }
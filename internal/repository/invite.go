@@ -1,15 +1,17 @@
 package repository
 
 import (
+	"context"
+
 	"github.com/porter-dev/porter/internal/models"
 )
 
 // InviteRepository represents the set of queries on the Invite model
 type InviteRepository interface {
-	CreateInvite(invite *models.Invite) (*models.Invite, error)
-	ReadInvite(projectID, inviteID uint) (*models.Invite, error)
-	ReadInviteByToken(token string) (*models.Invite, error)
-	ListInvitesByProjectID(projectID uint) ([]*models.Invite, error)
-	UpdateInvite(invite *models.Invite) (*models.Invite, error)
-	DeleteInvite(invite *models.Invite) error
+	CreateInvite(ctx context.Context, invite *models.Invite) (*models.Invite, error)
+	ReadInvite(ctx context.Context, projectID, inviteID uint) (*models.Invite, error)
+	ReadInviteByToken(ctx context.Context, token string) (*models.Invite, error)
+	ListInvitesByProjectID(ctx context.Context, projectID uint) ([]*models.Invite, error)
+	UpdateInvite(ctx context.Context, invite *models.Invite) (*models.Invite, error)
+	DeleteInvite(ctx context.Context, invite *models.Invite) error
 }
@@ -0,0 +1,18 @@
+package repository
+
+import (
+	"context"
+	"time"
+
+	"github.com/porter-dev/porter/internal/models"
+)
+
+// JobRunRepository represents the set of queries on the JobRun model
+type JobRunRepository interface {
+	// ReadJobRunByJobName finds the run of a kubernetes job in a cluster
+	ReadJobRunByJobName(ctx context.Context, clusterID uint, namespace, jobName string) (*models.JobRun, error)
+	CreateJobRun(ctx context.Context, run *models.JobRun) (*models.JobRun, error)
+	UpdateJobRun(ctx context.Context, run *models.JobRun) (*models.JobRun, error)
+	// ListJobRuns lists the runs of a job service of an app that started after since, most recent first
+	ListJobRuns(ctx context.Context, porterAppID uint, serviceName string, since time.Time, limit int) ([]*models.JobRun, error)
+}
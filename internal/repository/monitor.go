@@ -1,12 +1,16 @@
 package repository
 
-import "github.com/porter-dev/porter/internal/models"
+import (
+	"context"
+
+	"github.com/porter-dev/porter/internal/models"
+)
 
 type MonitorTestResultRepository interface {
-	CreateMonitorTestResult(monitor *models.MonitorTestResult) (*models.MonitorTestResult, error)
-	ReadMonitorTestResult(projectID, clusterID uint, operationID string) (*models.MonitorTestResult, error)
-	UpdateMonitorTestResult(monitor *models.MonitorTestResult) (*models.MonitorTestResult, error)
+	CreateMonitorTestResult(ctx context.Context, monitor *models.MonitorTestResult) (*models.MonitorTestResult, error)
+	ReadMonitorTestResult(ctx context.Context, projectID, clusterID uint, operationID string) (*models.MonitorTestResult, error)
+	UpdateMonitorTestResult(ctx context.Context, monitor *models.MonitorTestResult) (*models.MonitorTestResult, error)
 
-	ArchiveMonitorTestResults(projectID, clusterID uint, recommenderID string) error
-	DeleteOldMonitorTestResults(projectID, clusterID uint, recommenderID string) error
+	ArchiveMonitorTestResults(ctx context.Context, projectID, clusterID uint, recommenderID string) error
+	DeleteOldMonitorTestResults(ctx context.Context, projectID, clusterID uint, recommenderID string) error
 }
@@ -1,17 +1,19 @@
 package repository
 
 import (
+	"context"
+
 	"github.com/porter-dev/porter/internal/models"
 )
 
 type NotificationConfigRepository interface {
-	CreateNotificationConfig(am *models.NotificationConfig) (*models.NotificationConfig, error)
-	ReadNotificationConfig(id uint) (*models.NotificationConfig, error)
-	UpdateNotificationConfig(am *models.NotificationConfig) (*models.NotificationConfig, error)
+	CreateNotificationConfig(ctx context.Context, am *models.NotificationConfig) (*models.NotificationConfig, error)
+	ReadNotificationConfig(ctx context.Context, id uint) (*models.NotificationConfig, error)
+	UpdateNotificationConfig(ctx context.Context, am *models.NotificationConfig) (*models.NotificationConfig, error)
 }
 
 type JobNotificationConfigRepository interface {
-	CreateNotificationConfig(am *models.JobNotificationConfig) (*models.JobNotificationConfig, error)
-	ReadNotificationConfig(projID, clusterID uint, name, namespace string) (*models.JobNotificationConfig, error)
-	UpdateNotificationConfig(am *models.JobNotificationConfig) (*models.JobNotificationConfig, error)
+	CreateNotificationConfig(ctx context.Context, am *models.JobNotificationConfig) (*models.JobNotificationConfig, error)
+	ReadNotificationConfig(ctx context.Context, projID, clusterID uint, name, namespace string) (*models.JobNotificationConfig, error)
+	UpdateNotificationConfig(ctx context.Context, am *models.JobNotificationConfig) (*models.JobNotificationConfig, error)
 }
@@ -1,14 +1,16 @@
 package repository
 
 import (
+	"context"
+
 	"github.com/porter-dev/porter/internal/models"
 )
 
 // PolicyRepository represents the set of queries on the Policy model
 type PolicyRepository interface {
-	CreatePolicy(policy *models.Policy) (*models.Policy, error)
-	ListPoliciesByProjectID(projectID uint) ([]*models.Policy, error)
-	ReadPolicy(projectID uint, uid string) (*models.Policy, error)
-	UpdatePolicy(token *models.Policy) (*models.Policy, error)
-	DeletePolicy(policy *models.Policy) (*models.Policy, error)
+	CreatePolicy(ctx context.Context, policy *models.Policy) (*models.Policy, error)
+	ListPoliciesByProjectID(ctx context.Context, projectID uint) ([]*models.Policy, error)
+	ReadPolicy(ctx context.Context, projectID uint, uid string) (*models.Policy, error)
+	UpdatePolicy(ctx context.Context, token *models.Policy) (*models.Policy, error)
+	DeletePolicy(ctx context.Context, policy *models.Policy) (*models.Policy, error)
 }
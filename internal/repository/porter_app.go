@@ -1,15 +1,23 @@
 package repository
 
 import (
+	"context"
+
 	"github.com/porter-dev/porter/internal/models"
 )
 
 // PorterAppRepository represents the set of queries on the PorterApp model
 type PorterAppRepository interface {
-	ReadPorterAppByName(clusterID uint, name string) (*models.PorterApp, error)
-	ReadPorterAppsByProjectIDAndName(projectID uint, name string) ([]*models.PorterApp, error)
-	CreatePorterApp(app *models.PorterApp) (*models.PorterApp, error)
-	ListPorterAppByClusterID(clusterID uint) ([]*models.PorterApp, error)
-	UpdatePorterApp(app *models.PorterApp) (*models.PorterApp, error)
-	DeletePorterApp(app *models.PorterApp) (*models.PorterApp, error)
+	ReadPorterAppByName(ctx context.Context, clusterID uint, name string) (*models.PorterApp, error)
+	ReadPorterAppsByProjectIDAndName(ctx context.Context, projectID uint, name string) ([]*models.PorterApp, error)
+	ReadPorterAppsByGitRepo(ctx context.Context, gitRepoID uint, repoName string) ([]*models.PorterApp, error)
+	CreatePorterApp(ctx context.Context, app *models.PorterApp) (*models.PorterApp, error)
+	ListPorterAppByClusterID(ctx context.Context, clusterID uint) ([]*models.PorterApp, error)
+	// ListPorterAppsByProjectID lists the apps in all clusters of a project
+	ListPorterAppsByProjectID(ctx context.Context, projectID uint) ([]*models.PorterApp, error)
+	UpdatePorterApp(ctx context.Context, app *models.PorterApp) (*models.PorterApp, error)
+	// IncrementPorterAppConfigRevision increments the config revision of an app if it has not changed since the app was read,
+	// and returns false if it has
+	IncrementPorterAppConfigRevision(ctx context.Context, app *models.PorterApp) (bool, error)
+	DeletePorterApp(ctx context.Context, app *models.PorterApp) (*models.PorterApp, error)
 }
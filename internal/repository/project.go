@@ -1,6 +1,8 @@
 package repository
 
 import (
+	"context"
+
 	"github.com/porter-dev/porter/internal/models"
 )
 
@@ -9,14 +11,14 @@ type WriteProject func(project *models.Project) (*models.Project, error)
 
 // ProjectRepository represents the set of queries on the Project model
 type ProjectRepository interface {
-	CreateProject(project *models.Project) (*models.Project, error)
-	CreateProjectRole(project *models.Project, role *models.Role) (*models.Role, error)
-	UpdateProject(project *models.Project) (*models.Project, error)
-	UpdateProjectRole(projID uint, role *models.Role) (*models.Role, error)
-	ReadProject(id uint) (*models.Project, error)
-	ReadProjectRole(projID, userID uint) (*models.Role, error)
-	ListProjectRoles(projID uint) ([]models.Role, error)
-	ListProjectsByUserID(userID uint) ([]*models.Project, error)
-	DeleteProject(project *models.Project) (*models.Project, error)
-	DeleteProjectRole(projID, userID uint) (*models.Role, error)
+	CreateProject(ctx context.Context, project *models.Project) (*models.Project, error)
+	CreateProjectRole(ctx context.Context, project *models.Project, role *models.Role) (*models.Role, error)
+	UpdateProject(ctx context.Context, project *models.Project) (*models.Project, error)
+	UpdateProjectRole(ctx context.Context, projID uint, role *models.Role) (*models.Role, error)
+	ReadProject(ctx context.Context, id uint) (*models.Project, error)
+	ReadProjectRole(ctx context.Context, projID, userID uint) (*models.Role, error)
+	ListProjectRoles(ctx context.Context, projID uint) ([]models.Role, error)
+	ListProjectsByUserID(ctx context.Context, userID uint) ([]*models.Project, error)
+	DeleteProject(ctx context.Context, project *models.Project) (*models.Project, error)
+	DeleteProjectRole(ctx context.Context, projID, userID uint) (*models.Role, error)
 }
@@ -0,0 +1,19 @@
+package repository
+
+import (
+	"context"
+
+	"github.com/porter-dev/porter/internal/models"
+)
+
+// ProjectWebhookRepository represents the set of queries on the ProjectWebhook
+// and ProjectWebhookDelivery models
+type ProjectWebhookRepository interface {
+	CreateProjectWebhook(ctx context.Context, webhook *models.ProjectWebhook) (*models.ProjectWebhook, error)
+	ReadProjectWebhook(ctx context.Context, projectID, webhookID uint) (*models.ProjectWebhook, error)
+	ListProjectWebhooksByProjectID(ctx context.Context, projectID uint) ([]*models.ProjectWebhook, error)
+	DeleteProjectWebhook(ctx context.Context, webhook *models.ProjectWebhook) error
+	CreateProjectWebhookDelivery(ctx context.Context, delivery *models.ProjectWebhookDelivery) (*models.ProjectWebhookDelivery, error)
+	UpdateProjectWebhookDelivery(ctx context.Context, delivery *models.ProjectWebhookDelivery) (*models.ProjectWebhookDelivery, error)
+	ListProjectWebhookDeliveries(ctx context.Context, webhookID uint, limit int) ([]*models.ProjectWebhookDelivery, error)
+}
@@ -1,12 +1,14 @@
 package repository
 
 import (
+	"context"
+
 	"github.com/porter-dev/porter/internal/models"
 )
 
 // PWResetTokenRepository represents the set of queries on the PWResetToken model
 type PWResetTokenRepository interface {
-	CreatePWResetToken(pwToken *models.PWResetToken) (*models.PWResetToken, error)
-	ReadPWResetToken(id uint) (*models.PWResetToken, error)
-	UpdatePWResetToken(pwToken *models.PWResetToken) (*models.PWResetToken, error)
+	CreatePWResetToken(ctx context.Context, pwToken *models.PWResetToken) (*models.PWResetToken, error)
+	ReadPWResetToken(ctx context.Context, id uint) (*models.PWResetToken, error)
+	UpdatePWResetToken(ctx context.Context, pwToken *models.PWResetToken) (*models.PWResetToken, error)
 }
@@ -0,0 +1,17 @@
+package repository
+
+import (
+	"context"
+
+	"github.com/porter-dev/porter/internal/models"
+)
+
+// RegistryGCPolicyRepository represents the set of queries on the RegistryGCPolicy model
+type RegistryGCPolicyRepository interface {
+	CreateRegistryGCPolicy(ctx context.Context, policy *models.RegistryGCPolicy) (*models.RegistryGCPolicy, error)
+	UpdateRegistryGCPolicy(ctx context.Context, policy *models.RegistryGCPolicy) (*models.RegistryGCPolicy, error)
+	// ReadRegistryGCPolicy finds the registry garbage collection policy of a project
+	ReadRegistryGCPolicy(ctx context.Context, projectID uint) (*models.RegistryGCPolicy, error)
+	// ListEnabledRegistryGCPolicies lists the policies which are applied by the background job
+	ListEnabledRegistryGCPolicies(ctx context.Context) ([]*models.RegistryGCPolicy, error)
+}
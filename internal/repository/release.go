@@ -1,6 +1,8 @@
 package repository
 
 import (
+	"context"
+
 	"github.com/porter-dev/porter/internal/models"
 )
 
@@ -9,10 +11,10 @@ type WriteRelease func(release *models.Release) (*models.Release, error)
 
 // ReleaseRepository represents the set of queries on the Release model
 type ReleaseRepository interface {
-	CreateRelease(release *models.Release) (*models.Release, error)
-	ReadRelease(clusterID uint, name, namespace string) (*models.Release, error)
-	ReadReleaseByWebhookToken(token string) (*models.Release, error)
-	ListReleasesByImageRepoURI(clusterID uint, imageRepoURI string) ([]*models.Release, error)
-	UpdateRelease(release *models.Release) (*models.Release, error)
-	DeleteRelease(release *models.Release) (*models.Release, error)
+	CreateRelease(ctx context.Context, release *models.Release) (*models.Release, error)
+	ReadRelease(ctx context.Context, clusterID uint, name, namespace string) (*models.Release, error)
+	ReadReleaseByWebhookToken(ctx context.Context, token string) (*models.Release, error)
+	ListReleasesByImageRepoURI(ctx context.Context, clusterID uint, imageRepoURI string) ([]*models.Release, error)
+	UpdateRelease(ctx context.Context, release *models.Release) (*models.Release, error)
+	DeleteRelease(ctx context.Context, release *models.Release) (*models.Release, error)
 }
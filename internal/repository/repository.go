@@ -48,4 +48,24 @@ type Repository interface {
 	PorterApp() PorterAppRepository
 	PorterAppEvent() PorterAppEventRepository
 	DeploymentTarget() DeploymentTargetRepository
+	ProjectWebhook() ProjectWebhookRepository
+	JobRun() JobRunRepository
+	EnvironmentGroupVersion() EnvironmentGroupVersionRepository
+	ExternalSecretsIntegration() ExternalSecretsIntegrationRepository
+	RegistryGCPolicy() RegistryGCPolicyRepository
+	AppRevisionMetadata() AppRevisionMetadataRepository
+	SCIM() SCIMRepository
+	UserMFA() UserMFARepository
+	AppResourceRequest() AppResourceRequestRepository
+	ServiceAccount() ServiceAccountRepository
+	AlertRule() AlertRuleRepository
+	EventSink() EventSinkRepository
+	Addon() AddonRepository
+	Datastore() DatastoreRepository
+	CustomCertificate() CustomCertificateRepository
+	CustomDomain() CustomDomainRepository
+	Subdomain() SubdomainRepository
+	AppTemplate() AppTemplateRepository
+	ImageAttestation() ImageAttestationRepository
+	ImageSigningPolicy() ImageSigningPolicyRepository
 }
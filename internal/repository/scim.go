@@ -0,0 +1,27 @@
+package repository
+
+import (
+	"context"
+
+	"github.com/porter-dev/porter/internal/models"
+)
+
+// SCIMRepository represents the set of queries on the SCIMUser, SCIMGroup and SCIMGroupRoleMapping models
+type SCIMRepository interface {
+	CreateSCIMUser(ctx context.Context, user *models.SCIMUser) (*models.SCIMUser, error)
+	ReadSCIMUser(ctx context.Context, projectID, id uint) (*models.SCIMUser, error)
+	ListSCIMUsers(ctx context.Context, projectID uint) ([]*models.SCIMUser, error)
+	UpdateSCIMUser(ctx context.Context, user *models.SCIMUser) (*models.SCIMUser, error)
+	DeleteSCIMUser(ctx context.Context, user *models.SCIMUser) error
+
+	CreateSCIMGroup(ctx context.Context, group *models.SCIMGroup) (*models.SCIMGroup, error)
+	ReadSCIMGroup(ctx context.Context, projectID, id uint) (*models.SCIMGroup, error)
+	ListSCIMGroups(ctx context.Context, projectID uint) ([]*models.SCIMGroup, error)
+	UpdateSCIMGroup(ctx context.Context, group *models.SCIMGroup) (*models.SCIMGroup, error)
+	DeleteSCIMGroup(ctx context.Context, group *models.SCIMGroup) error
+
+	CreateSCIMGroupRoleMapping(ctx context.Context, mapping *models.SCIMGroupRoleMapping) (*models.SCIMGroupRoleMapping, error)
+	ReadSCIMGroupRoleMapping(ctx context.Context, projectID, id uint) (*models.SCIMGroupRoleMapping, error)
+	ListSCIMGroupRoleMappings(ctx context.Context, projectID uint) ([]*models.SCIMGroupRoleMapping, error)
+	DeleteSCIMGroupRoleMapping(ctx context.Context, mapping *models.SCIMGroupRoleMapping) error
+}
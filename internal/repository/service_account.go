@@ -0,0 +1,18 @@
+package repository
+
+import (
+	"context"
+
+	"github.com/porter-dev/porter/internal/models"
+)
+
+// ServiceAccountRepository represents the set of queries on the ServiceAccount model
+type ServiceAccountRepository interface {
+	CreateServiceAccount(ctx context.Context, sa *models.ServiceAccount) (*models.ServiceAccount, error)
+	ReadServiceAccount(ctx context.Context, projectID, id uint) (*models.ServiceAccount, error)
+	// ReadServiceAccountByUserID finds the service account of a service account user
+	ReadServiceAccountByUserID(ctx context.Context, userID uint) (*models.ServiceAccount, error)
+	ListServiceAccountsByProjectID(ctx context.Context, projectID uint) ([]*models.ServiceAccount, error)
+	UpdateServiceAccount(ctx context.Context, sa *models.ServiceAccount) (*models.ServiceAccount, error)
+	DeleteServiceAccount(ctx context.Context, sa *models.ServiceAccount) (*models.ServiceAccount, error)
+}
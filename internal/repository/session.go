@@ -1,13 +1,15 @@
 package repository
 
 import (
+	"context"
+
 	"github.com/porter-dev/porter/internal/models"
 )
 
 // SessionRepository represents the set of queries on the Session model
 type SessionRepository interface {
-	CreateSession(session *models.Session) (*models.Session, error)
-	UpdateSession(session *models.Session) (*models.Session, error)
-	DeleteSession(session *models.Session) (*models.Session, error)
-	SelectSession(session *models.Session) (*models.Session, error)
+	CreateSession(ctx context.Context, session *models.Session) (*models.Session, error)
+	UpdateSession(ctx context.Context, session *models.Session) (*models.Session, error)
+	DeleteSession(ctx context.Context, session *models.Session) (*models.Session, error)
+	SelectSession(ctx context.Context, session *models.Session) (*models.Session, error)
 }
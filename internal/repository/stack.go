@@ -1,23 +1,27 @@
 package repository
 
-import "github.com/porter-dev/porter/internal/models"
+import (
+	"context"
+
+	"github.com/porter-dev/porter/internal/models"
+)
 
 // StackRepository represents the set of queries on the Stack model
 type StackRepository interface {
-	CreateStack(stack *models.Stack) (*models.Stack, error)
-	ReadStackByID(projectID, stackID uint) (*models.Stack, error)
-	ReadStackByStringID(projectID uint, stackID string) (*models.Stack, error)
-	ListStacks(projectID uint, clusterID uint, namespace string) ([]*models.Stack, error)
-	DeleteStack(stack *models.Stack) (*models.Stack, error)
-	UpdateStack(stack *models.Stack) (*models.Stack, error)
+	CreateStack(ctx context.Context, stack *models.Stack) (*models.Stack, error)
+	ReadStackByID(ctx context.Context, projectID, stackID uint) (*models.Stack, error)
+	ReadStackByStringID(ctx context.Context, projectID uint, stackID string) (*models.Stack, error)
+	ListStacks(ctx context.Context, projectID uint, clusterID uint, namespace string) ([]*models.Stack, error)
+	DeleteStack(ctx context.Context, stack *models.Stack) (*models.Stack, error)
+	UpdateStack(ctx context.Context, stack *models.Stack) (*models.Stack, error)
 
-	UpdateStackRevision(revision *models.StackRevision) (*models.StackRevision, error)
-	ReadStackRevision(stackRevisionID uint) (*models.StackRevision, error)
-	ReadStackRevisionByNumber(stackID uint, revisionNumber uint) (*models.StackRevision, error)
-	AppendNewRevision(revision *models.StackRevision) (*models.StackRevision, error)
+	UpdateStackRevision(ctx context.Context, revision *models.StackRevision) (*models.StackRevision, error)
+	ReadStackRevision(ctx context.Context, stackRevisionID uint) (*models.StackRevision, error)
+	ReadStackRevisionByNumber(ctx context.Context, stackID uint, revisionNumber uint) (*models.StackRevision, error)
+	AppendNewRevision(ctx context.Context, revision *models.StackRevision) (*models.StackRevision, error)
 
-	ReadStackResource(resourceID uint) (*models.StackResource, error)
-	UpdateStackResource(resource *models.StackResource) (*models.StackResource, error)
+	ReadStackResource(ctx context.Context, resourceID uint) (*models.StackResource, error)
+	UpdateStackResource(ctx context.Context, resource *models.StackResource) (*models.StackResource, error)
 
-	ReadStackEnvGroupFirstMatch(projectID, clusterID uint, namespace, name string) (*models.StackEnvGroup, error)
+	ReadStackEnvGroupFirstMatch(ctx context.Context, projectID, clusterID uint, namespace, name string) (*models.StackEnvGroup, error)
 }
@@ -0,0 +1,26 @@
+package repository
+
+import (
+	"context"
+
+	"github.com/porter-dev/porter/internal/models"
+)
+
+// SubdomainRepository represents the set of queries on the WildcardDomain and SubdomainAllocation models
+type SubdomainRepository interface {
+	ReadWildcardDomain(ctx context.Context, projectID uint) (*models.WildcardDomain, error)
+	// SaveWildcardDomain creates the wildcard domain of a project, or updates it if it exists
+	SaveWildcardDomain(ctx context.Context, domain *models.WildcardDomain) (*models.WildcardDomain, error)
+	// DeleteWildcardDomain deletes the wildcard domain of a project and releases its subdomains
+	DeleteWildcardDomain(ctx context.Context, domain *models.WildcardDomain) error
+
+	// CreateSubdomainAllocation allocates a subdomain, and fails if the name is already allocated in the project
+	CreateSubdomainAllocation(ctx context.Context, allocation *models.SubdomainAllocation) (*models.SubdomainAllocation, error)
+	ReadSubdomainAllocation(ctx context.Context, projectID uint, name string) (*models.SubdomainAllocation, error)
+	// ReadSubdomainAllocationByService finds the subdomain allocated to a service of an app on a deployment target
+	ReadSubdomainAllocationByService(ctx context.Context, clusterID uint, appName, serviceName, deploymentTargetID string) (*models.SubdomainAllocation, error)
+	ListSubdomainAllocations(ctx context.Context, projectID uint) ([]*models.SubdomainAllocation, error)
+	DeleteSubdomainAllocation(ctx context.Context, allocation *models.SubdomainAllocation) error
+	DeleteSubdomainAllocationsByApp(ctx context.Context, clusterID uint, appName string) error
+	DeleteSubdomainAllocationsByDeploymentTarget(ctx context.Context, deploymentTargetID string) error
+}
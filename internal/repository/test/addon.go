@@ -0,0 +1,98 @@
+package test
+
+import (
+	"context"
+	"errors"
+
+	"github.com/porter-dev/porter/internal/models"
+	"github.com/porter-dev/porter/internal/repository"
+	"gorm.io/gorm"
+)
+
+// AddonRepository implements repository.AddonRepository
+type AddonRepository struct {
+	canQuery bool
+	addons   []*models.Addon
+}
+
+// NewAddonRepository will return errors if canQuery is false
+func NewAddonRepository(canQuery bool) repository.AddonRepository {
+	return &AddonRepository{
+		canQuery,
+		[]*models.Addon{},
+	}
+}
+
+// CreateAddon creates a new addon
+func (repo *AddonRepository) CreateAddon(ctx context.Context, addon *models.Addon) (*models.Addon, error) {
+	if !repo.canQuery {
+		return nil, errors.New("Cannot write database")
+	}
+
+	repo.addons = append(repo.addons, addon)
+	addon.ID = uint(len(repo.addons))
+
+	return addon, nil
+}
+
+// ReadAddon finds an addon by project id, cluster id and name
+func (repo *AddonRepository) ReadAddon(ctx context.Context, projectID, clusterID uint, name string) (*models.Addon, error) {
+	if !repo.canQuery {
+		return nil, errors.New("Cannot read from database")
+	}
+
+	for _, addon := range repo.addons {
+		if addon != nil && addon.ProjectID == projectID && addon.ClusterID == clusterID && addon.Name == name {
+			return addon, nil
+		}
+	}
+
+	return nil, gorm.ErrRecordNotFound
+}
+
+// ListAddonsByClusterID finds all addons for a given cluster
+func (repo *AddonRepository) ListAddonsByClusterID(ctx context.Context, projectID, clusterID uint) ([]*models.Addon, error) {
+	if !repo.canQuery {
+		return nil, errors.New("Cannot read from database")
+	}
+
+	res := make([]*models.Addon, 0)
+
+	for _, addon := range repo.addons {
+		if addon != nil && addon.ProjectID == projectID && addon.ClusterID == clusterID {
+			res = append(res, addon)
+		}
+	}
+
+	return res, nil
+}
+
+// UpdateAddon modifies an existing addon
+func (repo *AddonRepository) UpdateAddon(ctx context.Context, addon *models.Addon) (*models.Addon, error) {
+	if !repo.canQuery {
+		return nil, errors.New("Cannot write database")
+	}
+
+	if int(addon.ID-1) >= len(repo.addons) || repo.addons[addon.ID-1] == nil {
+		return nil, gorm.ErrRecordNotFound
+	}
+
+	repo.addons[addon.ID-1] = addon
+
+	return addon, nil
+}
+
+// DeleteAddon deletes an addon
+func (repo *AddonRepository) DeleteAddon(ctx context.Context, addon *models.Addon) error {
+	if !repo.canQuery {
+		return errors.New("Cannot write database")
+	}
+
+	if int(addon.ID-1) >= len(repo.addons) || repo.addons[addon.ID-1] == nil {
+		return gorm.ErrRecordNotFound
+	}
+
+	repo.addons[addon.ID-1] = nil
+
+	return nil
+}
@@ -0,0 +1,87 @@
+package test
+
+import (
+	"context"
+	"errors"
+
+	"github.com/porter-dev/porter/internal/models"
+	"github.com/porter-dev/porter/internal/repository"
+	"gorm.io/gorm"
+)
+
+// AlertRuleRepository implements repository.AlertRuleRepository
+type AlertRuleRepository struct {
+	canQuery bool
+	rules    []*models.AlertRule
+}
+
+// NewAlertRuleRepository will return errors if canQuery is false
+func NewAlertRuleRepository(canQuery bool) repository.AlertRuleRepository {
+	return &AlertRuleRepository{
+		canQuery,
+		[]*models.AlertRule{},
+	}
+}
+
+// CreateAlertRule creates a new alert rule
+func (repo *AlertRuleRepository) CreateAlertRule(ctx context.Context, rule *models.AlertRule) (*models.AlertRule, error) {
+	if !repo.canQuery {
+		return nil, errors.New("Cannot write database")
+	}
+
+	repo.rules = append(repo.rules, rule)
+	rule.ID = uint(len(repo.rules))
+
+	return rule, nil
+}
+
+// ReadAlertRule finds an alert rule by project id and rule id
+func (repo *AlertRuleRepository) ReadAlertRule(ctx context.Context, projectID, ruleID uint) (*models.AlertRule, error) {
+	if !repo.canQuery {
+		return nil, errors.New("Cannot read from database")
+	}
+
+	if int(ruleID-1) >= len(repo.rules) || repo.rules[ruleID-1] == nil {
+		return nil, gorm.ErrRecordNotFound
+	}
+
+	rule := repo.rules[ruleID-1]
+
+	if rule.ProjectID != projectID {
+		return nil, gorm.ErrRecordNotFound
+	}
+
+	return rule, nil
+}
+
+// ListAlertRulesByProjectID finds all alert rules for a given project id
+func (repo *AlertRuleRepository) ListAlertRulesByProjectID(ctx context.Context, projectID uint) ([]*models.AlertRule, error) {
+	if !repo.canQuery {
+		return nil, errors.New("Cannot read from database")
+	}
+
+	res := make([]*models.AlertRule, 0)
+
+	for _, rule := range repo.rules {
+		if rule != nil && rule.ProjectID == projectID {
+			res = append(res, rule)
+		}
+	}
+
+	return res, nil
+}
+
+// DeleteAlertRule deletes an alert rule
+func (repo *AlertRuleRepository) DeleteAlertRule(ctx context.Context, rule *models.AlertRule) error {
+	if !repo.canQuery {
+		return errors.New("Cannot write database")
+	}
+
+	if int(rule.ID-1) >= len(repo.rules) || repo.rules[rule.ID-1] == nil {
+		return gorm.ErrRecordNotFound
+	}
+
+	repo.rules[rule.ID-1] = nil
+
+	return nil
+}
@@ -1,6 +1,7 @@
 package test
 
 import (
+	"context"
 	"errors"
 
 	"github.com/porter-dev/porter/internal/models"
@@ -19,7 +20,7 @@ func NewAllowlistRepository(canQuery bool) repository.AllowlistRepository {
 	return &AllowlistRepository{canQuery, []*models.Allowlist{}}
 }
 
-func (repo *AllowlistRepository) UserEmailExists(email string) (bool, error) {
+func (repo *AllowlistRepository) UserEmailExists(ctx context.Context, email string) (bool, error) {
 	if !repo.canQuery {
 		return false, errors.New("cannot read database")
 	}
@@ -1,6 +1,8 @@
 package test
 
 import (
+	"context"
+
 	"github.com/porter-dev/porter/internal/models"
 	"github.com/porter-dev/porter/internal/repository"
 )
@@ -13,19 +15,20 @@ func NewAPITokenRepository(canQuery bool) repository.APITokenRepository {
 	return &APITokenRepository{canQuery}
 }
 
-func (repo *APITokenRepository) CreateAPIToken(a *models.APIToken) (*models.APIToken, error) {
+func (repo *APITokenRepository) CreateAPIToken(ctx context.Context, a *models.APIToken) (*models.APIToken, error) {
 	panic("unimplemented")
 }
 
-func (repo *APITokenRepository) ListAPITokensByProjectID(projectID uint) ([]*models.APIToken, error) {
+func (repo *APITokenRepository) ListAPITokensByProjectID(ctx context.Context, projectID uint) ([]*models.APIToken, error) {
 	panic("unimplemented")
 }
 
-func (repo *APITokenRepository) ReadAPIToken(projectID uint, uid string) (*models.APIToken, error) {
+func (repo *APITokenRepository) ReadAPIToken(ctx context.Context, projectID uint, uid string) (*models.APIToken, error) {
 	panic("unimplemented")
 }
 
 func (repo *APITokenRepository) UpdateAPIToken(
+	ctx context.Context,
 	token *models.APIToken,
 ) (*models.APIToken, error) {
 	panic("unimplemented")
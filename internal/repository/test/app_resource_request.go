@@ -0,0 +1,75 @@
+package test
+
+import (
+	"context"
+	"errors"
+
+	"github.com/porter-dev/porter/internal/models"
+	"github.com/porter-dev/porter/internal/repository"
+)
+
+// AppResourceRequestRepository implements repository.AppResourceRequestRepository
+type AppResourceRequestRepository struct {
+	canQuery bool
+	requests []*models.AppResourceRequest
+}
+
+// NewAppResourceRequestRepository will return errors if canQuery is false
+func NewAppResourceRequestRepository(canQuery bool) repository.AppResourceRequestRepository {
+	return &AppResourceRequestRepository{
+		canQuery,
+		[]*models.AppResourceRequest{},
+	}
+}
+
+// UpsertAppResourceRequest creates or replaces the resource request of an app on a deployment target
+func (repo *AppResourceRequestRepository) UpsertAppResourceRequest(ctx context.Context, request *models.AppResourceRequest) (*models.AppResourceRequest, error) {
+	if !repo.canQuery {
+		return nil, errors.New("Cannot write database")
+	}
+
+	for i, existing := range repo.requests {
+		if existing != nil && existing.PorterAppID == request.PorterAppID && existing.DeploymentTargetID == request.DeploymentTargetID {
+			request.ID = existing.ID
+			repo.requests[i] = request
+
+			return request, nil
+		}
+	}
+
+	repo.requests = append(repo.requests, request)
+	request.ID = uint(len(repo.requests))
+
+	return request, nil
+}
+
+// ListAppResourceRequestsByProjectID lists the resource requests of all apps in a project
+func (repo *AppResourceRequestRepository) ListAppResourceRequestsByProjectID(ctx context.Context, projectID uint) ([]*models.AppResourceRequest, error) {
+	if !repo.canQuery {
+		return nil, errors.New("Cannot read from database")
+	}
+
+	res := make([]*models.AppResourceRequest, 0)
+	for _, request := range repo.requests {
+		if request != nil && request.ProjectID == projectID {
+			res = append(res, request)
+		}
+	}
+
+	return res, nil
+}
+
+// DeleteAppResourceRequestsByPorterAppID deletes the resource requests of an app on all deployment targets
+func (repo *AppResourceRequestRepository) DeleteAppResourceRequestsByPorterAppID(ctx context.Context, porterAppID uint) error {
+	if !repo.canQuery {
+		return errors.New("Cannot write database")
+	}
+
+	for i, request := range repo.requests {
+		if request != nil && request.PorterAppID == porterAppID {
+			repo.requests[i] = nil
+		}
+	}
+
+	return nil
+}
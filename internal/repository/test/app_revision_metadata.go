@@ -0,0 +1,82 @@
+package test
+
+import (
+	"context"
+	"errors"
+
+	"github.com/porter-dev/porter/internal/models"
+	"github.com/porter-dev/porter/internal/repository"
+	"gorm.io/gorm"
+)
+
+// AppRevisionMetadataRepository implements repository.AppRevisionMetadataRepository
+type AppRevisionMetadataRepository struct {
+	canQuery bool
+	metadata []*models.AppRevisionMetadata
+}
+
+// NewAppRevisionMetadataRepository will return errors if canQuery is false
+func NewAppRevisionMetadataRepository(canQuery bool) repository.AppRevisionMetadataRepository {
+	return &AppRevisionMetadataRepository{
+		canQuery,
+		[]*models.AppRevisionMetadata{},
+	}
+}
+
+// ReadAppRevisionMetadata finds the metadata of a revision by its id in the cluster control plane
+func (repo *AppRevisionMetadataRepository) ReadAppRevisionMetadata(ctx context.Context, appRevisionID string) (*models.AppRevisionMetadata, error) {
+	if !repo.canQuery {
+		return nil, errors.New("Cannot read from database")
+	}
+
+	for _, metadata := range repo.metadata {
+		if metadata.AppRevisionID == appRevisionID {
+			return metadata, nil
+		}
+	}
+
+	return nil, gorm.ErrRecordNotFound
+}
+
+// CreateAppRevisionMetadata creates the metadata of a revision
+func (repo *AppRevisionMetadataRepository) CreateAppRevisionMetadata(ctx context.Context, metadata *models.AppRevisionMetadata) (*models.AppRevisionMetadata, error) {
+	if !repo.canQuery {
+		return nil, errors.New("Cannot write database")
+	}
+
+	metadata.ID = uint(len(repo.metadata) + 1)
+	repo.metadata = append(repo.metadata, metadata)
+
+	return metadata, nil
+}
+
+// UpdateAppRevisionMetadata updates the metadata of a revision
+func (repo *AppRevisionMetadataRepository) UpdateAppRevisionMetadata(ctx context.Context, metadata *models.AppRevisionMetadata) (*models.AppRevisionMetadata, error) {
+	if !repo.canQuery {
+		return nil, errors.New("Cannot write database")
+	}
+
+	if int(metadata.ID-1) >= len(repo.metadata) || repo.metadata[metadata.ID-1] == nil {
+		return nil, gorm.ErrRecordNotFound
+	}
+
+	repo.metadata[metadata.ID-1] = metadata
+
+	return metadata, nil
+}
+
+// ListAppRevisionMetadata lists the metadata of the deployed revisions of an app in a deployment target
+func (repo *AppRevisionMetadataRepository) ListAppRevisionMetadata(ctx context.Context, porterAppID uint, deploymentTargetID string) ([]*models.AppRevisionMetadata, error) {
+	if !repo.canQuery {
+		return nil, errors.New("Cannot read from database")
+	}
+
+	res := make([]*models.AppRevisionMetadata, 0)
+	for _, metadata := range repo.metadata {
+		if metadata.PorterAppID == porterAppID && metadata.DeploymentTargetID == deploymentTargetID && metadata.RevisionNumber > 0 {
+			res = append(res, metadata)
+		}
+	}
+
+	return res, nil
+}
@@ -0,0 +1,102 @@
+package test
+
+import (
+	"context"
+	"errors"
+	"sort"
+
+	"github.com/porter-dev/porter/internal/models"
+	"github.com/porter-dev/porter/internal/repository"
+	"gorm.io/gorm"
+)
+
+// AppTemplateRepository implements repository.AppTemplateRepository
+type AppTemplateRepository struct {
+	canQuery  bool
+	templates []*models.AppTemplate
+}
+
+// NewAppTemplateRepository will return errors if canQuery is false
+func NewAppTemplateRepository(canQuery bool) repository.AppTemplateRepository {
+	return &AppTemplateRepository{
+		canQuery,
+		[]*models.AppTemplate{},
+	}
+}
+
+// CreateAppTemplate creates a new app template
+func (repo *AppTemplateRepository) CreateAppTemplate(ctx context.Context, template *models.AppTemplate) (*models.AppTemplate, error) {
+	if !repo.canQuery {
+		return nil, errors.New("Cannot write database")
+	}
+
+	repo.templates = append(repo.templates, template)
+	template.ID = uint(len(repo.templates))
+
+	return template, nil
+}
+
+// UpdateAppTemplate updates an existing app template
+func (repo *AppTemplateRepository) UpdateAppTemplate(ctx context.Context, template *models.AppTemplate) (*models.AppTemplate, error) {
+	if !repo.canQuery {
+		return nil, errors.New("Cannot write database")
+	}
+
+	if int(template.ID-1) >= len(repo.templates) || repo.templates[template.ID-1] == nil {
+		return nil, gorm.ErrRecordNotFound
+	}
+
+	repo.templates[template.ID-1] = template
+
+	return template, nil
+}
+
+// ReadAppTemplateByName finds the app template of a project with the given name
+func (repo *AppTemplateRepository) ReadAppTemplateByName(ctx context.Context, projectID uint, name string) (*models.AppTemplate, error) {
+	if !repo.canQuery {
+		return nil, errors.New("Cannot read from database")
+	}
+
+	for _, template := range repo.templates {
+		if template != nil && template.ProjectID == projectID && template.Name == name {
+			return template, nil
+		}
+	}
+
+	return nil, gorm.ErrRecordNotFound
+}
+
+// ListAppTemplatesByProjectID lists the app templates of a project, ordered by name
+func (repo *AppTemplateRepository) ListAppTemplatesByProjectID(ctx context.Context, projectID uint) ([]*models.AppTemplate, error) {
+	if !repo.canQuery {
+		return nil, errors.New("Cannot read from database")
+	}
+
+	res := make([]*models.AppTemplate, 0)
+	for _, template := range repo.templates {
+		if template != nil && template.ProjectID == projectID {
+			res = append(res, template)
+		}
+	}
+
+	sort.Slice(res, func(i, j int) bool {
+		return res[i].Name < res[j].Name
+	})
+
+	return res, nil
+}
+
+// DeleteAppTemplate deletes an app template
+func (repo *AppTemplateRepository) DeleteAppTemplate(ctx context.Context, template *models.AppTemplate) error {
+	if !repo.canQuery {
+		return errors.New("Cannot write database")
+	}
+
+	if int(template.ID-1) >= len(repo.templates) || repo.templates[template.ID-1] == nil {
+		return gorm.ErrRecordNotFound
+	}
+
+	repo.templates[template.ID-1] = nil
+
+	return nil
+}
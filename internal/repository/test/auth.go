@@ -1,6 +1,7 @@
 package test
 
 import (
+	"context"
 	"errors"
 
 	"github.com/porter-dev/porter/internal/repository"
@@ -25,6 +26,7 @@ func NewKubeIntegrationRepository(canQuery bool) repository.KubeIntegrationRepos
 
 // CreateKubeIntegration creates a new kube auth mechanism
 func (repo *KubeIntegrationRepository) CreateKubeIntegration(
+	ctx context.Context,
 	am *ints.KubeIntegration,
 ) (*ints.KubeIntegration, error) {
 	if !repo.canQuery {
@@ -39,6 +41,7 @@ func (repo *KubeIntegrationRepository) CreateKubeIntegration(
 
 // ReadKubeIntegration finds a kube auth mechanism by id
 func (repo *KubeIntegrationRepository) ReadKubeIntegration(
+	ctx context.Context,
 	projectID, id uint,
 ) (*ints.KubeIntegration, error) {
 	if !repo.canQuery {
@@ -56,6 +59,7 @@ func (repo *KubeIntegrationRepository) ReadKubeIntegration(
 // ListKubeIntegrationsByProjectID finds all kube auth mechanisms
 // for a given project id
 func (repo *KubeIntegrationRepository) ListKubeIntegrationsByProjectID(
+	ctx context.Context,
 	projectID uint,
 ) ([]*ints.KubeIntegration, error) {
 	if !repo.canQuery {
@@ -89,6 +93,7 @@ func NewBasicIntegrationRepository(canQuery bool) repository.BasicIntegrationRep
 
 // CreateBasicIntegration creates a new basic auth mechanism
 func (repo *BasicIntegrationRepository) CreateBasicIntegration(
+	ctx context.Context,
 	am *ints.BasicIntegration,
 ) (*ints.BasicIntegration, error) {
 	if !repo.canQuery {
@@ -103,6 +108,7 @@ func (repo *BasicIntegrationRepository) CreateBasicIntegration(
 
 // ReadBasicIntegration finds a basic auth mechanism by id
 func (repo *BasicIntegrationRepository) ReadBasicIntegration(
+	ctx context.Context,
 	projectID, id uint,
 ) (*ints.BasicIntegration, error) {
 	if !repo.canQuery {
@@ -120,6 +126,7 @@ func (repo *BasicIntegrationRepository) ReadBasicIntegration(
 // ListBasicIntegrationsByProjectID finds all basic auth mechanisms
 // for a given project id
 func (repo *BasicIntegrationRepository) ListBasicIntegrationsByProjectID(
+	ctx context.Context,
 	projectID uint,
 ) ([]*ints.BasicIntegration, error) {
 	if !repo.canQuery {
@@ -139,6 +146,7 @@ func (repo *BasicIntegrationRepository) ListBasicIntegrationsByProjectID(
 
 // DeleteBasicIntegration deletes a basic integration
 func (repo *BasicIntegrationRepository) DeleteBasicIntegration(
+	ctx context.Context,
 	am *ints.BasicIntegration,
 ) (*ints.BasicIntegration, error) {
 	if !repo.canQuery {
@@ -174,6 +182,7 @@ func NewOIDCIntegrationRepository(canQuery bool) repository.OIDCIntegrationRepos
 
 // CreateOIDCIntegration creates a new oidc auth mechanism
 func (repo *OIDCIntegrationRepository) CreateOIDCIntegration(
+	ctx context.Context,
 	am *ints.OIDCIntegration,
 ) (*ints.OIDCIntegration, error) {
 	if !repo.canQuery {
@@ -188,6 +197,7 @@ func (repo *OIDCIntegrationRepository) CreateOIDCIntegration(
 
 // ReadOIDCIntegration finds a oidc auth mechanism by id
 func (repo *OIDCIntegrationRepository) ReadOIDCIntegration(
+	ctx context.Context,
 	projectID, id uint,
 ) (*ints.OIDCIntegration, error) {
 	if !repo.canQuery {
@@ -205,6 +215,7 @@ func (repo *OIDCIntegrationRepository) ReadOIDCIntegration(
 // ListOIDCIntegrationsByProjectID finds all oidc auth mechanisms
 // for a given project id
 func (repo *OIDCIntegrationRepository) ListOIDCIntegrationsByProjectID(
+	ctx context.Context,
 	projectID uint,
 ) ([]*ints.OIDCIntegration, error) {
 	if !repo.canQuery {
@@ -238,6 +249,7 @@ func NewOAuthIntegrationRepository(canQuery bool) repository.OAuthIntegrationRep
 
 // CreateOAuthIntegration creates a new o auth mechanism
 func (repo *OAuthIntegrationRepository) CreateOAuthIntegration(
+	ctx context.Context,
 	am *ints.OAuthIntegration,
 ) (*ints.OAuthIntegration, error) {
 	if !repo.canQuery {
@@ -252,6 +264,7 @@ func (repo *OAuthIntegrationRepository) CreateOAuthIntegration(
 
 // ReadOAuthIntegration finds a o auth mechanism by id
 func (repo *OAuthIntegrationRepository) ReadOAuthIntegration(
+	ctx context.Context,
 	projectID, id uint,
 ) (*ints.OAuthIntegration, error) {
 	if !repo.canQuery {
@@ -269,6 +282,7 @@ func (repo *OAuthIntegrationRepository) ReadOAuthIntegration(
 // ListOAuthIntegrationsByProjectID finds all o auth mechanisms
 // for a given project id
 func (repo *OAuthIntegrationRepository) ListOAuthIntegrationsByProjectID(
+	ctx context.Context,
 	projectID uint,
 ) ([]*ints.OAuthIntegration, error) {
 	if !repo.canQuery {
@@ -288,6 +302,7 @@ func (repo *OAuthIntegrationRepository) ListOAuthIntegrationsByProjectID(
 
 // UpdateOAuthIntegration updates an oauth integration in the DB
 func (repo *OAuthIntegrationRepository) UpdateOAuthIntegration(
+	ctx context.Context,
 	am *ints.OAuthIntegration,
 ) (*ints.OAuthIntegration, error) {
 	if !repo.canQuery {
@@ -320,6 +335,7 @@ func NewAWSIntegrationRepository(canQuery bool) repository.AWSIntegrationReposit
 
 // CreateAWSIntegration creates a new aws auth mechanism
 func (repo *AWSIntegrationRepository) CreateAWSIntegration(
+	ctx context.Context,
 	am *ints.AWSIntegration,
 ) (*ints.AWSIntegration, error) {
 	if !repo.canQuery {
@@ -333,6 +349,7 @@ func (repo *AWSIntegrationRepository) CreateAWSIntegration(
 }
 
 func (repo *AWSIntegrationRepository) OverwriteAWSIntegration(
+	ctx context.Context,
 	am *ints.AWSIntegration,
 ) (*ints.AWSIntegration, error) {
 	if !repo.canQuery {
@@ -351,6 +368,7 @@ func (repo *AWSIntegrationRepository) OverwriteAWSIntegration(
 
 // ReadAWSIntegration finds a aws auth mechanism by id
 func (repo *AWSIntegrationRepository) ReadAWSIntegration(
+	ctx context.Context,
 	projectID, id uint,
 ) (*ints.AWSIntegration, error) {
 	if !repo.canQuery {
@@ -368,6 +386,7 @@ func (repo *AWSIntegrationRepository) ReadAWSIntegration(
 // ListAWSIntegrationsByProjectID finds all aws auth mechanisms
 // for a given project id
 func (repo *AWSIntegrationRepository) ListAWSIntegrationsByProjectID(
+	ctx context.Context,
 	projectID uint,
 ) ([]*ints.AWSIntegration, error) {
 	if !repo.canQuery {
@@ -401,6 +420,7 @@ func NewGCPIntegrationRepository(canQuery bool) repository.GCPIntegrationReposit
 
 // CreateGCPIntegration creates a new gcp auth mechanism
 func (repo *GCPIntegrationRepository) CreateGCPIntegration(
+	ctx context.Context,
 	am *ints.GCPIntegration,
 ) (*ints.GCPIntegration, error) {
 	if !repo.canQuery {
@@ -415,6 +435,7 @@ func (repo *GCPIntegrationRepository) CreateGCPIntegration(
 
 // ReadGCPIntegration finds a gcp auth mechanism by id
 func (repo *GCPIntegrationRepository) ReadGCPIntegration(
+	ctx context.Context,
 	projectID, id uint,
 ) (*ints.GCPIntegration, error) {
 	if !repo.canQuery {
@@ -432,6 +453,7 @@ func (repo *GCPIntegrationRepository) ReadGCPIntegration(
 // ListGCPIntegrationsByProjectID finds all gcp auth mechanisms
 // for a given project id
 func (repo *GCPIntegrationRepository) ListGCPIntegrationsByProjectID(
+	ctx context.Context,
 	projectID uint,
 ) ([]*ints.GCPIntegration, error) {
 	if !repo.canQuery {
@@ -462,7 +484,7 @@ func NewGithubAppInstallationRepository(canQuery bool) repository.GithubAppInsta
 	}
 }
 
-func (repo *GithubAppInstallationRepository) CreateGithubAppInstallation(am *ints.GithubAppInstallation) (*ints.GithubAppInstallation, error) {
+func (repo *GithubAppInstallationRepository) CreateGithubAppInstallation(ctx context.Context, am *ints.GithubAppInstallation) (*ints.GithubAppInstallation, error) {
 	if !repo.canQuery {
 		return nil, errors.New("cannot write database")
 	}
@@ -485,11 +507,11 @@ func (repo *GithubAppInstallationRepository) ReadGithubAppInstallation(projectID
 	return repo.githubAppInstallations[int(id-1)], nil
 }
 
-func (repo *GithubAppInstallationRepository) ReadGithubAppInstallationByInstallationID(gaID uint) (*ints.GithubAppInstallation, error) {
+func (repo *GithubAppInstallationRepository) ReadGithubAppInstallationByInstallationID(ctx context.Context, gaID uint) (*ints.GithubAppInstallation, error) {
 	panic("unimplemented")
 }
 
-func (repo *GithubAppInstallationRepository) ReadGithubAppInstallationByAccountID(accountID int64) (*ints.GithubAppInstallation, error) {
+func (repo *GithubAppInstallationRepository) ReadGithubAppInstallationByAccountID(ctx context.Context, accountID int64) (*ints.GithubAppInstallation, error) {
 	if !repo.canQuery {
 		return nil, errors.New("cannot write database")
 	}
@@ -503,7 +525,7 @@ func (repo *GithubAppInstallationRepository) ReadGithubAppInstallationByAccountI
 	return nil, gorm.ErrRecordNotFound
 }
 
-func (repo *GithubAppInstallationRepository) ReadGithubAppInstallationByAccountIDs(accountIDs []int64) ([]*ints.GithubAppInstallation, error) {
+func (repo *GithubAppInstallationRepository) ReadGithubAppInstallationByAccountIDs(ctx context.Context, accountIDs []int64) ([]*ints.GithubAppInstallation, error) {
 	if !repo.canQuery {
 		return nil, errors.New("cannot write database")
 	}
@@ -522,7 +544,7 @@ func (repo *GithubAppInstallationRepository) ReadGithubAppInstallationByAccountI
 	return ret, nil
 }
 
-func (repo *GithubAppInstallationRepository) DeleteGithubAppInstallationByAccountID(accountID int64) error {
+func (repo *GithubAppInstallationRepository) DeleteGithubAppInstallationByAccountID(ctx context.Context, accountID int64) error {
 	if !repo.canQuery {
 		return errors.New("cannot write database")
 	}
@@ -548,7 +570,7 @@ func NewGithubAppOAuthIntegrationRepository(canQuery bool) repository.GithubAppO
 	}
 }
 
-func (repo *GithubAppOAuthIntegrationRepository) CreateGithubAppOAuthIntegration(am *ints.GithubAppOAuthIntegration) (*ints.GithubAppOAuthIntegration, error) {
+func (repo *GithubAppOAuthIntegrationRepository) CreateGithubAppOAuthIntegration(ctx context.Context, am *ints.GithubAppOAuthIntegration) (*ints.GithubAppOAuthIntegration, error) {
 	if !repo.canQuery {
 		return nil, errors.New("cannot write database")
 	}
@@ -559,7 +581,7 @@ func (repo *GithubAppOAuthIntegrationRepository) CreateGithubAppOAuthIntegration
 	return am, nil
 }
 
-func (repo *GithubAppOAuthIntegrationRepository) ReadGithubAppOauthIntegration(id uint) (*ints.GithubAppOAuthIntegration, error) {
+func (repo *GithubAppOAuthIntegrationRepository) ReadGithubAppOauthIntegration(ctx context.Context, id uint) (*ints.GithubAppOAuthIntegration, error) {
 	if !repo.canQuery {
 		return nil, errors.New("cannot write database")
 	}
@@ -571,7 +593,7 @@ func (repo *GithubAppOAuthIntegrationRepository) ReadGithubAppOauthIntegration(i
 	return repo.githubAppOauthIntegrations[int(id-1)], nil
 }
 
-func (repo *GithubAppOAuthIntegrationRepository) UpdateGithubAppOauthIntegration(am *ints.GithubAppOAuthIntegration) (*ints.GithubAppOAuthIntegration, error) {
+func (repo *GithubAppOAuthIntegrationRepository) UpdateGithubAppOauthIntegration(ctx context.Context, am *ints.GithubAppOAuthIntegration) (*ints.GithubAppOAuthIntegration, error) {
 	if !repo.canQuery {
 		return nil, errors.New("Cannot write database")
 	}
@@ -598,6 +620,7 @@ func NewAzureIntegrationRepository() repository.AzureIntegrationRepository {
 
 // CreateAzureIntegration creates a new Azure auth mechanism
 func (repo *AzureIntegrationRepository) CreateAzureIntegration(
+	ctx context.Context,
 	az *ints.AzureIntegration,
 ) (*ints.AzureIntegration, error) {
 	panic("unimplemented")
@@ -605,6 +628,7 @@ func (repo *AzureIntegrationRepository) CreateAzureIntegration(
 
 // OverwriteAzureIntegration overwrites the Azure credential in the DB
 func (repo *AzureIntegrationRepository) OverwriteAzureIntegration(
+	ctx context.Context,
 	az *ints.AzureIntegration,
 ) (*ints.AzureIntegration, error) {
 	panic("unimplemented")
@@ -612,6 +636,7 @@ func (repo *AzureIntegrationRepository) OverwriteAzureIntegration(
 
 // ReadAzureIntegration finds a Azure auth mechanism by id
 func (repo *AzureIntegrationRepository) ReadAzureIntegration(
+	ctx context.Context,
 	projectID, id uint,
 ) (*ints.AzureIntegration, error) {
 	panic("unimplemented")
@@ -620,6 +645,7 @@ func (repo *AzureIntegrationRepository) ReadAzureIntegration(
 // ListAzureIntegrationsByProjectID finds all Azure auth mechanisms
 // for a given project id
 func (repo *AzureIntegrationRepository) ListAzureIntegrationsByProjectID(
+	ctx context.Context,
 	projectID uint,
 ) ([]*ints.AzureIntegration, error) {
 	panic("unimplemented")
@@ -637,7 +663,7 @@ func NewGitlabIntegrationRepository(canQuery bool) repository.GitlabIntegrationR
 	}
 }
 
-func (repo *GitlabIntegrationRepository) CreateGitlabIntegration(gi *ints.GitlabIntegration) (*ints.GitlabIntegration, error) {
+func (repo *GitlabIntegrationRepository) CreateGitlabIntegration(ctx context.Context, gi *ints.GitlabIntegration) (*ints.GitlabIntegration, error) {
 	if !repo.canQuery {
 		return nil, errors.New("cannot write database")
 	}
@@ -648,7 +674,7 @@ func (repo *GitlabIntegrationRepository) CreateGitlabIntegration(gi *ints.Gitlab
 	return gi, nil
 }
 
-func (repo *GitlabIntegrationRepository) ReadGitlabIntegration(projectID, id uint) (*ints.GitlabIntegration, error) {
+func (repo *GitlabIntegrationRepository) ReadGitlabIntegration(ctx context.Context, projectID, id uint) (*ints.GitlabIntegration, error) {
 	if !repo.canQuery {
 		return nil, errors.New("Cannot read from database")
 	}
@@ -661,7 +687,7 @@ func (repo *GitlabIntegrationRepository) ReadGitlabIntegration(projectID, id uin
 	return repo.gitlabIntegrations[index], nil
 }
 
-func (repo *GitlabIntegrationRepository) ListGitlabIntegrationsByProjectID(projectID uint) ([]*ints.GitlabIntegration, error) {
+func (repo *GitlabIntegrationRepository) ListGitlabIntegrationsByProjectID(ctx context.Context, projectID uint) ([]*ints.GitlabIntegration, error) {
 	if !repo.canQuery {
 		return nil, errors.New("Cannot read from database")
 	}
@@ -677,7 +703,7 @@ func (repo *GitlabIntegrationRepository) ListGitlabIntegrationsByProjectID(proje
 	return res, nil
 }
 
-func (repo *GitlabIntegrationRepository) DeleteGitlabIntegrationByID(projectID, id uint) error {
+func (repo *GitlabIntegrationRepository) DeleteGitlabIntegrationByID(ctx context.Context, projectID, id uint) error {
 	if !repo.canQuery {
 		return errors.New("Cannot write database")
 	}
@@ -705,6 +731,7 @@ func NewGitlabAppOAuthIntegrationRepository(canQuery bool) repository.GitlabAppO
 }
 
 func (repo *GitlabAppOAuthIntegrationRepository) CreateGitlabAppOAuthIntegration(
+	ctx context.Context,
 	gi *ints.GitlabAppOAuthIntegration,
 ) (*ints.GitlabAppOAuthIntegration, error) {
 	if !repo.canQuery {
@@ -718,6 +745,7 @@ func (repo *GitlabAppOAuthIntegrationRepository) CreateGitlabAppOAuthIntegration
 }
 
 func (repo *GitlabAppOAuthIntegrationRepository) ReadGitlabAppOAuthIntegration(
+	ctx context.Context,
 	userID, projectID, integrationID uint,
 ) (*ints.GitlabAppOAuthIntegration, error) {
 	panic("not implemented")
@@ -1,6 +1,7 @@
 package test
 
 import (
+	"context"
 	"errors"
 
 	"github.com/porter-dev/porter/internal/models"
@@ -21,7 +22,7 @@ func NewAuthCodeRepository(canQuery bool) repository.AuthCodeRepository {
 }
 
 // CreateAuthCode creates a new invite
-func (repo *AuthCodeRepository) CreateAuthCode(a *models.AuthCode) (*models.AuthCode, error) {
+func (repo *AuthCodeRepository) CreateAuthCode(ctx context.Context, a *models.AuthCode) (*models.AuthCode, error) {
 	if !repo.canQuery {
 		return nil, errors.New("Cannot write database")
 	}
@@ -33,7 +34,7 @@ func (repo *AuthCodeRepository) CreateAuthCode(a *models.AuthCode) (*models.Auth
 }
 
 // ReadAuthCode gets an auth code object specified by the unique code
-func (repo *AuthCodeRepository) ReadAuthCode(code string) (*models.AuthCode, error) {
+func (repo *AuthCodeRepository) ReadAuthCode(ctx context.Context, code string) (*models.AuthCode, error) {
 	if !repo.canQuery {
 		return nil, errors.New("Cannot read from database")
 	}
@@ -1,6 +1,7 @@
 package test
 
 import (
+	"context"
 	"errors"
 
 	"github.com/porter-dev/porter/internal/models"
@@ -17,6 +18,7 @@ func NewBuildConfigRepository(canQuery bool) repository.BuildConfigRepository {
 }
 
 func (repo *BuildConfigRepository) CreateBuildConfig(
+	ctx context.Context,
 	bc *models.BuildConfig,
 ) (*models.BuildConfig, error) {
 	if !repo.canQuery {
@@ -29,7 +31,7 @@ func (repo *BuildConfigRepository) CreateBuildConfig(
 	return bc, nil
 }
 
-func (repo *BuildConfigRepository) UpdateBuildConfig(bc *models.BuildConfig) (*models.BuildConfig, error) {
+func (repo *BuildConfigRepository) UpdateBuildConfig(ctx context.Context, bc *models.BuildConfig) (*models.BuildConfig, error) {
 	if !repo.canQuery {
 		return nil, errors.New("cannot write database")
 	}
@@ -38,7 +40,7 @@ func (repo *BuildConfigRepository) UpdateBuildConfig(bc *models.BuildConfig) (*m
 	return bc, nil
 }
 
-func (repo *BuildConfigRepository) GetBuildConfig(id uint) (*models.BuildConfig, error) {
+func (repo *BuildConfigRepository) GetBuildConfig(ctx context.Context, id uint) (*models.BuildConfig, error) {
 	if !repo.canQuery {
 		return nil, errors.New("cannot write database")
 	}
@@ -1,7 +1,9 @@
 package test
 
 import (
+	"context"
 	"errors"
+	"time"
 
 	"github.com/porter-dev/porter/internal/models"
 	"github.com/porter-dev/porter/internal/repository"
@@ -28,6 +30,7 @@ func NewClusterRepository(canQuery bool) repository.ClusterRepository {
 
 // CreateClusterCandidate creates a new cluster candidate
 func (repo *ClusterRepository) CreateClusterCandidate(
+	ctx context.Context,
 	cc *models.ClusterCandidate,
 ) (*models.ClusterCandidate, error) {
 	if !repo.canQuery {
@@ -41,7 +44,7 @@ func (repo *ClusterRepository) CreateClusterCandidate(
 }
 
 // ReadClusterCandidate finds a service account candidate by id
-func (repo *ClusterRepository) ReadClusterCandidate(projectID, id uint) (*models.ClusterCandidate, error) {
+func (repo *ClusterRepository) ReadClusterCandidate(ctx context.Context, projectID, id uint) (*models.ClusterCandidate, error) {
 	if !repo.canQuery {
 		return nil, errors.New("Cannot read from database")
 	}
@@ -57,6 +60,7 @@ func (repo *ClusterRepository) ReadClusterCandidate(projectID, id uint) (*models
 // ListClusterCandidatesByProjectID finds all service account candidates
 // for a given project id
 func (repo *ClusterRepository) ListClusterCandidatesByProjectID(
+	ctx context.Context,
 	projectID uint,
 ) ([]*models.ClusterCandidate, error) {
 	if !repo.canQuery {
@@ -66,7 +70,7 @@ func (repo *ClusterRepository) ListClusterCandidatesByProjectID(
 	res := make([]*models.ClusterCandidate, 0)
 
 	for _, saCandidate := range repo.clusterCandidates {
-		if saCandidate.ProjectID == projectID {
+		if saCandidate != nil && saCandidate.ProjectID == projectID {
 			res = append(res, saCandidate)
 		}
 	}
@@ -77,6 +81,7 @@ func (repo *ClusterRepository) ListClusterCandidatesByProjectID(
 // UpdateClusterCandidateCreatedClusterID updates the CreatedClusterID for
 // a candidate, after the candidate has been resolved.
 func (repo *ClusterRepository) UpdateClusterCandidateCreatedClusterID(
+	ctx context.Context,
 	id uint,
 	createdClusterID uint,
 ) (*models.ClusterCandidate, error) {
@@ -90,8 +95,45 @@ func (repo *ClusterRepository) UpdateClusterCandidateCreatedClusterID(
 	return repo.clusterCandidates[index], nil
 }
 
+// DeleteClusterCandidate deletes a cluster candidate
+func (repo *ClusterRepository) DeleteClusterCandidate(
+	ctx context.Context,
+	cc *models.ClusterCandidate,
+) error {
+	if !repo.canQuery {
+		return errors.New("Cannot write database")
+	}
+
+	if int(cc.ID-1) >= len(repo.clusterCandidates) || repo.clusterCandidates[cc.ID-1] == nil {
+		return gorm.ErrRecordNotFound
+	}
+
+	repo.clusterCandidates[cc.ID-1] = nil
+
+	return nil
+}
+
+// DeleteExpiredClusterCandidates deletes all unresolved cluster candidates which expired before now
+func (repo *ClusterRepository) DeleteExpiredClusterCandidates(ctx context.Context, now time.Time) (int64, error) {
+	if !repo.canQuery {
+		return 0, errors.New("Cannot write database")
+	}
+
+	var deleted int64
+
+	for i, cc := range repo.clusterCandidates {
+		if cc != nil && cc.CreatedClusterID == 0 && cc.ExpiresAt != nil && cc.ExpiresAt.Before(now) {
+			repo.clusterCandidates[i] = nil
+			deleted++
+		}
+	}
+
+	return deleted, nil
+}
+
 // CreateCluster creates a new servicea account
 func (repo *ClusterRepository) CreateCluster(
+	ctx context.Context,
 	cluster *models.Cluster,
 ) (*models.Cluster, error) {
 	if !repo.canQuery {
@@ -110,6 +152,7 @@ func (repo *ClusterRepository) CreateCluster(
 
 // ReadCluster finds a service account by id
 func (repo *ClusterRepository) ReadCluster(
+	ctx context.Context,
 	projectID, id uint,
 ) (*models.Cluster, error) {
 	if !repo.canQuery {
@@ -125,6 +168,7 @@ func (repo *ClusterRepository) ReadCluster(
 }
 
 func (repo *ClusterRepository) ReadClusterByInfraID(
+	ctx context.Context,
 	projectID, infraID uint,
 ) (*models.Cluster, error) {
 	panic("unimplemented")
@@ -133,6 +177,7 @@ func (repo *ClusterRepository) ReadClusterByInfraID(
 // ListClustersByProjectID finds all service accounts
 // for a given project id
 func (repo *ClusterRepository) ListClustersByProjectID(
+	ctx context.Context,
 	projectID uint,
 ) ([]*models.Cluster, error) {
 	if !repo.canQuery {
@@ -152,6 +197,7 @@ func (repo *ClusterRepository) ListClustersByProjectID(
 
 // UpdateCluster modifies an existing Cluster in the database
 func (repo *ClusterRepository) UpdateCluster(
+	ctx context.Context,
 	cluster *models.Cluster,
 ) (*models.Cluster, error) {
 	if !repo.canQuery {
@@ -170,6 +216,7 @@ func (repo *ClusterRepository) UpdateCluster(
 
 // UpdateClusterTokenCache updates the token cache for a cluster
 func (repo *ClusterRepository) UpdateClusterTokenCache(
+	ctx context.Context,
 	tokenCache *ints.ClusterTokenCache,
 ) (*models.Cluster, error) {
 	if !repo.canQuery {
@@ -185,6 +232,7 @@ func (repo *ClusterRepository) UpdateClusterTokenCache(
 
 // DeleteCluster removes a cluster from the array by setting it to nil
 func (repo *ClusterRepository) DeleteCluster(
+	ctx context.Context,
 	cluster *models.Cluster,
 ) error {
 	if !repo.canQuery {
@@ -1,6 +1,7 @@
 package test
 
 import (
+	"context"
 	"errors"
 
 	"github.com/porter-dev/porter/internal/models"
@@ -18,6 +19,7 @@ func NewCredentialsExchangeTokenRepository(canQuery bool) repository.Credentials
 }
 
 func (repo *CredentialsExchangeTokenRepository) CreateCredentialsExchangeToken(
+	ctx context.Context,
 	a *models.CredentialsExchangeToken,
 ) (*models.CredentialsExchangeToken, error) {
 	if !repo.canQuery {
@@ -31,7 +33,7 @@ func (repo *CredentialsExchangeTokenRepository) CreateCredentialsExchangeToken(
 }
 
 // ReadPWResetToken gets an auth code object specified by the unique code
-func (repo *CredentialsExchangeTokenRepository) ReadCredentialsExchangeToken(id uint) (*models.CredentialsExchangeToken, error) {
+func (repo *CredentialsExchangeTokenRepository) ReadCredentialsExchangeToken(ctx context.Context, id uint) (*models.CredentialsExchangeToken, error) {
 	if !repo.canQuery {
 		return nil, errors.New("Cannot read from database")
 	}
@@ -0,0 +1,98 @@
+package test
+
+import (
+	"context"
+	"errors"
+
+	"github.com/porter-dev/porter/internal/models"
+	"github.com/porter-dev/porter/internal/repository"
+	"gorm.io/gorm"
+)
+
+// CustomCertificateRepository implements repository.CustomCertificateRepository
+type CustomCertificateRepository struct {
+	canQuery bool
+	certs    []*models.CustomCertificate
+}
+
+// NewCustomCertificateRepository will return errors if canQuery is false
+func NewCustomCertificateRepository(canQuery bool) repository.CustomCertificateRepository {
+	return &CustomCertificateRepository{
+		canQuery,
+		[]*models.CustomCertificate{},
+	}
+}
+
+// CreateCustomCertificate creates a new custom certificate
+func (repo *CustomCertificateRepository) CreateCustomCertificate(ctx context.Context, cert *models.CustomCertificate) (*models.CustomCertificate, error) {
+	if !repo.canQuery {
+		return nil, errors.New("Cannot write database")
+	}
+
+	repo.certs = append(repo.certs, cert)
+	cert.ID = uint(len(repo.certs))
+
+	return cert, nil
+}
+
+// ReadCustomCertificate finds the custom certificate of a domain of an app
+func (repo *CustomCertificateRepository) ReadCustomCertificate(ctx context.Context, clusterID uint, appName, domain string) (*models.CustomCertificate, error) {
+	if !repo.canQuery {
+		return nil, errors.New("Cannot read from database")
+	}
+
+	for _, cert := range repo.certs {
+		if cert != nil && cert.ClusterID == clusterID && cert.AppName == appName && cert.Domain == domain {
+			return cert, nil
+		}
+	}
+
+	return nil, gorm.ErrRecordNotFound
+}
+
+// ListCustomCertificatesByApp finds the custom certificates of the domains of an app
+func (repo *CustomCertificateRepository) ListCustomCertificatesByApp(ctx context.Context, clusterID uint, appName string) ([]*models.CustomCertificate, error) {
+	if !repo.canQuery {
+		return nil, errors.New("Cannot read from database")
+	}
+
+	res := make([]*models.CustomCertificate, 0)
+
+	for _, cert := range repo.certs {
+		if cert != nil && cert.ClusterID == clusterID && cert.AppName == appName {
+			res = append(res, cert)
+		}
+	}
+
+	return res, nil
+}
+
+// UpdateCustomCertificate modifies an existing custom certificate
+func (repo *CustomCertificateRepository) UpdateCustomCertificate(ctx context.Context, cert *models.CustomCertificate) (*models.CustomCertificate, error) {
+	if !repo.canQuery {
+		return nil, errors.New("Cannot write database")
+	}
+
+	if int(cert.ID-1) >= len(repo.certs) || repo.certs[cert.ID-1] == nil {
+		return nil, gorm.ErrRecordNotFound
+	}
+
+	repo.certs[cert.ID-1] = cert
+
+	return cert, nil
+}
+
+// DeleteCustomCertificate deletes a custom certificate
+func (repo *CustomCertificateRepository) DeleteCustomCertificate(ctx context.Context, cert *models.CustomCertificate) error {
+	if !repo.canQuery {
+		return errors.New("Cannot write database")
+	}
+
+	if int(cert.ID-1) >= len(repo.certs) || repo.certs[cert.ID-1] == nil {
+		return gorm.ErrRecordNotFound
+	}
+
+	repo.certs[cert.ID-1] = nil
+
+	return nil
+}
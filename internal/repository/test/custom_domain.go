@@ -0,0 +1,121 @@
+package test
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/porter-dev/porter/api/types"
+	"github.com/porter-dev/porter/internal/models"
+	"github.com/porter-dev/porter/internal/repository"
+	"gorm.io/gorm"
+)
+
+// CustomDomainRepository implements repository.CustomDomainRepository
+type CustomDomainRepository struct {
+	canQuery bool
+	domains  []*models.CustomDomain
+}
+
+// NewCustomDomainRepository will return errors if canQuery is false
+func NewCustomDomainRepository(canQuery bool) repository.CustomDomainRepository {
+	return &CustomDomainRepository{
+		canQuery,
+		[]*models.CustomDomain{},
+	}
+}
+
+// CreateCustomDomain creates a new custom domain
+func (repo *CustomDomainRepository) CreateCustomDomain(ctx context.Context, domain *models.CustomDomain) (*models.CustomDomain, error) {
+	if !repo.canQuery {
+		return nil, errors.New("Cannot write database")
+	}
+
+	repo.domains = append(repo.domains, domain)
+	domain.ID = uint(len(repo.domains))
+
+	return domain, nil
+}
+
+// ReadCustomDomain finds a custom domain of an app by name
+func (repo *CustomDomainRepository) ReadCustomDomain(ctx context.Context, clusterID uint, appName, name string) (*models.CustomDomain, error) {
+	if !repo.canQuery {
+		return nil, errors.New("Cannot read from database")
+	}
+
+	for _, domain := range repo.domains {
+		if domain != nil && domain.ClusterID == clusterID && domain.AppName == appName && domain.Name == name {
+			return domain, nil
+		}
+	}
+
+	return nil, gorm.ErrRecordNotFound
+}
+
+// ListCustomDomainsByApp finds the custom domains of an app
+func (repo *CustomDomainRepository) ListCustomDomainsByApp(ctx context.Context, clusterID uint, appName string) ([]*models.CustomDomain, error) {
+	if !repo.canQuery {
+		return nil, errors.New("Cannot read from database")
+	}
+
+	res := make([]*models.CustomDomain, 0)
+
+	for _, domain := range repo.domains {
+		if domain != nil && domain.ClusterID == clusterID && domain.AppName == appName {
+			res = append(res, domain)
+		}
+	}
+
+	return res, nil
+}
+
+// ListCustomDomainsToCheck lists the domains which are not verified, and the verified domains last checked before checkedBefore
+func (repo *CustomDomainRepository) ListCustomDomainsToCheck(ctx context.Context, checkedBefore time.Time) ([]*models.CustomDomain, error) {
+	if !repo.canQuery {
+		return nil, errors.New("Cannot read from database")
+	}
+
+	res := make([]*models.CustomDomain, 0)
+
+	for _, domain := range repo.domains {
+		if domain == nil {
+			continue
+		}
+
+		if domain.Status != types.CustomDomainStatus_Verified || domain.LastCheckedAt == nil || domain.LastCheckedAt.Before(checkedBefore) {
+			res = append(res, domain)
+		}
+	}
+
+	return res, nil
+}
+
+// UpdateCustomDomain modifies an existing custom domain
+func (repo *CustomDomainRepository) UpdateCustomDomain(ctx context.Context, domain *models.CustomDomain) (*models.CustomDomain, error) {
+	if !repo.canQuery {
+		return nil, errors.New("Cannot write database")
+	}
+
+	if int(domain.ID-1) >= len(repo.domains) || repo.domains[domain.ID-1] == nil {
+		return nil, gorm.ErrRecordNotFound
+	}
+
+	repo.domains[domain.ID-1] = domain
+
+	return domain, nil
+}
+
+// DeleteCustomDomain deletes a custom domain
+func (repo *CustomDomainRepository) DeleteCustomDomain(ctx context.Context, domain *models.CustomDomain) error {
+	if !repo.canQuery {
+		return errors.New("Cannot write database")
+	}
+
+	if int(domain.ID-1) >= len(repo.domains) || repo.domains[domain.ID-1] == nil {
+		return gorm.ErrRecordNotFound
+	}
+
+	repo.domains[domain.ID-1] = nil
+
+	return nil
+}
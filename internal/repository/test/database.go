@@ -1,6 +1,8 @@
 package test
 
 import (
+	"context"
+
 	"github.com/porter-dev/porter/internal/models"
 	"github.com/porter-dev/porter/internal/repository"
 )
@@ -11,26 +13,26 @@ func NewDatabaseRepository() repository.DatabaseRepository {
 	return &DatabaseRepository{}
 }
 
-func (repo *DatabaseRepository) CreateDatabase(database *models.Database) (*models.Database, error) {
+func (repo *DatabaseRepository) CreateDatabase(ctx context.Context, database *models.Database) (*models.Database, error) {
 	panic("unimplemented")
 }
 
-func (repo *DatabaseRepository) ReadDatabase(projectID, clusterID, databaseID uint) (*models.Database, error) {
+func (repo *DatabaseRepository) ReadDatabase(ctx context.Context, projectID, clusterID, databaseID uint) (*models.Database, error) {
 	panic("unimplemented")
 }
 
-func (repo *DatabaseRepository) ReadDatabaseByInfraID(projectID, infraID uint) (*models.Database, error) {
+func (repo *DatabaseRepository) ReadDatabaseByInfraID(ctx context.Context, projectID, infraID uint) (*models.Database, error) {
 	panic("unimplemented")
 }
 
-func (repo *DatabaseRepository) UpdateDatabase(database *models.Database) (*models.Database, error) {
+func (repo *DatabaseRepository) UpdateDatabase(ctx context.Context, database *models.Database) (*models.Database, error) {
 	panic("unimplemented")
 }
 
-func (repo *DatabaseRepository) DeleteDatabase(projectID, clusterID, databaseID uint) error {
+func (repo *DatabaseRepository) DeleteDatabase(ctx context.Context, projectID, clusterID, databaseID uint) error {
 	panic("unimplemented")
 }
 
-func (repo *DatabaseRepository) ListDatabases(projectID, clusterID uint) ([]*models.Database, error) {
+func (repo *DatabaseRepository) ListDatabases(ctx context.Context, projectID, clusterID uint) ([]*models.Database, error) {
 	panic("unimplemented")
 }
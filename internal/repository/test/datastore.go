@@ -0,0 +1,182 @@
+package test
+
+import (
+	"context"
+	"errors"
+
+	"github.com/porter-dev/porter/internal/models"
+	"github.com/porter-dev/porter/internal/repository"
+	"gorm.io/gorm"
+)
+
+// DatastoreRepository implements repository.DatastoreRepository
+type DatastoreRepository struct {
+	canQuery   bool
+	datastores []*models.Datastore
+	links      []*models.DatastoreLink
+}
+
+// NewDatastoreRepository will return errors if canQuery is false
+func NewDatastoreRepository(canQuery bool) repository.DatastoreRepository {
+	return &DatastoreRepository{
+		canQuery,
+		[]*models.Datastore{},
+		[]*models.DatastoreLink{},
+	}
+}
+
+// CreateDatastore creates a new datastore
+func (repo *DatastoreRepository) CreateDatastore(ctx context.Context, datastore *models.Datastore) (*models.Datastore, error) {
+	if !repo.canQuery {
+		return nil, errors.New("Cannot write database")
+	}
+
+	repo.datastores = append(repo.datastores, datastore)
+	datastore.ID = uint(len(repo.datastores))
+
+	return datastore, nil
+}
+
+// ReadDatastore finds a datastore by project id and datastore id, with its links
+func (repo *DatastoreRepository) ReadDatastore(ctx context.Context, projectID, datastoreID uint) (*models.Datastore, error) {
+	if !repo.canQuery {
+		return nil, errors.New("Cannot read from database")
+	}
+
+	if int(datastoreID-1) >= len(repo.datastores) || repo.datastores[datastoreID-1] == nil {
+		return nil, gorm.ErrRecordNotFound
+	}
+
+	datastore := repo.datastores[datastoreID-1]
+
+	if datastore.ProjectID != projectID {
+		return nil, gorm.ErrRecordNotFound
+	}
+
+	repo.loadLinks(datastore)
+
+	return datastore, nil
+}
+
+// ListDatastoresByProjectID finds all datastores for a given project id, with their links
+func (repo *DatastoreRepository) ListDatastoresByProjectID(ctx context.Context, projectID uint) ([]*models.Datastore, error) {
+	if !repo.canQuery {
+		return nil, errors.New("Cannot read from database")
+	}
+
+	res := make([]*models.Datastore, 0)
+
+	for _, datastore := range repo.datastores {
+		if datastore != nil && datastore.ProjectID == projectID {
+			repo.loadLinks(datastore)
+			res = append(res, datastore)
+		}
+	}
+
+	return res, nil
+}
+
+// UpdateDatastore modifies an existing datastore
+func (repo *DatastoreRepository) UpdateDatastore(ctx context.Context, datastore *models.Datastore) (*models.Datastore, error) {
+	if !repo.canQuery {
+		return nil, errors.New("Cannot write database")
+	}
+
+	if int(datastore.ID-1) >= len(repo.datastores) || repo.datastores[datastore.ID-1] == nil {
+		return nil, gorm.ErrRecordNotFound
+	}
+
+	repo.datastores[datastore.ID-1] = datastore
+
+	return datastore, nil
+}
+
+// DeleteDatastore deletes a datastore and its links
+func (repo *DatastoreRepository) DeleteDatastore(ctx context.Context, datastore *models.Datastore) error {
+	if !repo.canQuery {
+		return errors.New("Cannot write database")
+	}
+
+	if int(datastore.ID-1) >= len(repo.datastores) || repo.datastores[datastore.ID-1] == nil {
+		return gorm.ErrRecordNotFound
+	}
+
+	repo.datastores[datastore.ID-1] = nil
+
+	for i, link := range repo.links {
+		if link != nil && link.DatastoreID == datastore.ID {
+			repo.links[i] = nil
+		}
+	}
+
+	return nil
+}
+
+// CreateDatastoreLink links a datastore to an app
+func (repo *DatastoreRepository) CreateDatastoreLink(ctx context.Context, link *models.DatastoreLink) (*models.DatastoreLink, error) {
+	if !repo.canQuery {
+		return nil, errors.New("Cannot write database")
+	}
+
+	repo.links = append(repo.links, link)
+	link.ID = uint(len(repo.links))
+
+	return link, nil
+}
+
+// UpdateDatastoreLink modifies an existing link
+func (repo *DatastoreRepository) UpdateDatastoreLink(ctx context.Context, link *models.DatastoreLink) (*models.DatastoreLink, error) {
+	if !repo.canQuery {
+		return nil, errors.New("Cannot write database")
+	}
+
+	if int(link.ID-1) >= len(repo.links) || repo.links[link.ID-1] == nil {
+		return nil, gorm.ErrRecordNotFound
+	}
+
+	repo.links[link.ID-1] = link
+
+	return link, nil
+}
+
+// ListDatastoreLinksByApp finds the links of the datastores of a project to an app
+func (repo *DatastoreRepository) ListDatastoreLinksByApp(ctx context.Context, projectID, clusterID uint, appName string) ([]*models.DatastoreLink, error) {
+	if !repo.canQuery {
+		return nil, errors.New("Cannot read from database")
+	}
+
+	res := make([]*models.DatastoreLink, 0)
+
+	for _, link := range repo.links {
+		if link != nil && link.ProjectID == projectID && link.ClusterID == clusterID && link.AppName == appName {
+			res = append(res, link)
+		}
+	}
+
+	return res, nil
+}
+
+// DeleteDatastoreLink unlinks a datastore from an app
+func (repo *DatastoreRepository) DeleteDatastoreLink(ctx context.Context, link *models.DatastoreLink) error {
+	if !repo.canQuery {
+		return errors.New("Cannot write database")
+	}
+
+	if int(link.ID-1) >= len(repo.links) || repo.links[link.ID-1] == nil {
+		return gorm.ErrRecordNotFound
+	}
+
+	repo.links[link.ID-1] = nil
+
+	return nil
+}
+
+func (repo *DatastoreRepository) loadLinks(datastore *models.Datastore) {
+	datastore.Links = nil
+
+	for _, link := range repo.links {
+		if link != nil && link.DatastoreID == datastore.ID {
+			datastore.Links = append(datastore.Links, *link)
+		}
+	}
+}
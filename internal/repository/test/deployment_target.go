@@ -1,6 +1,7 @@
 package test
 
 import (
+	"context"
 	"errors"
 
 	"github.com/porter-dev/porter/internal/models"
@@ -18,6 +19,21 @@ func NewDeploymentTargetRepository() repository.DeploymentTargetRepository {
 }
 
 // DeploymentTargetBySelectorAndSelectorType finds a deployment target for a projectID and clusterID by its selector and selector type
-func (repo *DeploymentTargetRepository) DeploymentTargetBySelectorAndSelectorType(projectID uint, clusterID uint, selector, selectorType string) (*models.DeploymentTarget, error) {
+func (repo *DeploymentTargetRepository) DeploymentTargetBySelectorAndSelectorType(ctx context.Context, projectID uint, clusterID uint, selector, selectorType string) (*models.DeploymentTarget, error) {
 	return nil, errors.New("cannot read database")
 }
+
+// DeploymentTargetByID finds a deployment target in a project by its id
+func (repo *DeploymentTargetRepository) DeploymentTargetByID(ctx context.Context, projectID uint, deploymentTargetID string) (*models.DeploymentTarget, error) {
+	return nil, errors.New("cannot read database")
+}
+
+// CreateDeploymentTarget creates a new deployment target
+func (repo *DeploymentTargetRepository) CreateDeploymentTarget(ctx context.Context, deploymentTarget *models.DeploymentTarget) (*models.DeploymentTarget, error) {
+	return nil, errors.New("cannot write database")
+}
+
+// DeleteDeploymentTarget deletes a deployment target
+func (repo *DeploymentTargetRepository) DeleteDeploymentTarget(ctx context.Context, deploymentTarget *models.DeploymentTarget) error {
+	return errors.New("cannot write database")
+}
@@ -1,6 +1,7 @@
 package test
 
 import (
+	"context"
 	"errors"
 
 	"github.com/porter-dev/porter/internal/models"
@@ -23,6 +24,7 @@ func NewDNSRecordRepository(canQuery bool) repository.DNSRecordRepository {
 
 // CreateDNSRecord creates a new repoistry
 func (repo *DNSRecordRepository) CreateDNSRecord(
+	ctx context.Context,
 	record *models.DNSRecord,
 ) (*models.DNSRecord, error) {
 	if !repo.canQuery {
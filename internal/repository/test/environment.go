@@ -1,6 +1,8 @@
 package test
 
 import (
+	"context"
+
 	"github.com/porter-dev/porter/internal/models"
 	"github.com/porter-dev/porter/internal/repository"
 )
@@ -14,73 +16,74 @@ func NewEnvironmentRepository() repository.EnvironmentRepository {
 	return &EnvironmentRepository{}
 }
 
-func (repo *EnvironmentRepository) CreateEnvironment(env *models.Environment) (*models.Environment, error) {
+func (repo *EnvironmentRepository) CreateEnvironment(ctx context.Context, env *models.Environment) (*models.Environment, error) {
 	panic("unimplemented")
 }
 
-func (repo *EnvironmentRepository) ReadEnvironment(projectID, clusterID, gitInstallationID uint, gitRepoOwner, gitRepoName string) (*models.Environment, error) {
+func (repo *EnvironmentRepository) ReadEnvironment(ctx context.Context, projectID, clusterID, gitInstallationID uint, gitRepoOwner, gitRepoName string) (*models.Environment, error) {
 	panic("unimplemented")
 }
 
-func (repo *EnvironmentRepository) ReadEnvironmentByID(projectID, clusterID, envID uint) (*models.Environment, error) {
+func (repo *EnvironmentRepository) ReadEnvironmentByID(ctx context.Context, projectID, clusterID, envID uint) (*models.Environment, error) {
 	panic("unimplemented")
 }
 
 func (repo *EnvironmentRepository) ReadEnvironmentByOwnerRepoName(
+	ctx context.Context,
 	projectID, clusterID uint,
 	gitRepoOwner, gitRepoName string,
 ) (*models.Environment, error) {
 	panic("unimplemented")
 }
 
-func (repo *EnvironmentRepository) ReadEnvironmentByWebhookIDOwnerRepoName(webhookID, owner, repoName string) (*models.Environment, error) {
+func (repo *EnvironmentRepository) ReadEnvironmentByWebhookIDOwnerRepoName(ctx context.Context, webhookID, owner, repoName string) (*models.Environment, error) {
 	panic("unimplemented")
 }
 
-func (repo *EnvironmentRepository) ListEnvironments(projectID, clusterID uint) ([]*models.Environment, error) {
+func (repo *EnvironmentRepository) ListEnvironments(ctx context.Context, projectID, clusterID uint) ([]*models.Environment, error) {
 	panic("unimplemented")
 }
 
-func (repo *EnvironmentRepository) UpdateEnvironment(environment *models.Environment) (*models.Environment, error) {
+func (repo *EnvironmentRepository) UpdateEnvironment(ctx context.Context, environment *models.Environment) (*models.Environment, error) {
 	panic("unimplemented")
 }
 
-func (repo *EnvironmentRepository) DeleteEnvironment(env *models.Environment) (*models.Environment, error) {
+func (repo *EnvironmentRepository) DeleteEnvironment(ctx context.Context, env *models.Environment) (*models.Environment, error) {
 	panic("unimplemented")
 }
 
-func (repo *EnvironmentRepository) CreateDeployment(deployment *models.Deployment) (*models.Deployment, error) {
+func (repo *EnvironmentRepository) CreateDeployment(ctx context.Context, deployment *models.Deployment) (*models.Deployment, error) {
 	panic("unimplemented")
 }
 
-func (repo *EnvironmentRepository) UpdateDeployment(deployment *models.Deployment) (*models.Deployment, error) {
+func (repo *EnvironmentRepository) UpdateDeployment(ctx context.Context, deployment *models.Deployment) (*models.Deployment, error) {
 	panic("unimplemented")
 }
 
-func (repo *EnvironmentRepository) ReadDeployment(environmentID uint, namespace string) (*models.Deployment, error) {
+func (repo *EnvironmentRepository) ReadDeployment(ctx context.Context, environmentID uint, namespace string) (*models.Deployment, error) {
 	panic("unimplemented")
 }
 
-func (repo *EnvironmentRepository) ReadDeploymentByID(projectID, clusterID, id uint) (*models.Deployment, error) {
+func (repo *EnvironmentRepository) ReadDeploymentByID(ctx context.Context, projectID, clusterID, id uint) (*models.Deployment, error) {
 	panic("unimplemented")
 }
 
-func (repo *EnvironmentRepository) ReadDeploymentByGitDetails(environmentID uint, owner, repoName string, prNumber uint) (*models.Deployment, error) {
+func (repo *EnvironmentRepository) ReadDeploymentByGitDetails(ctx context.Context, environmentID uint, owner, repoName string, prNumber uint) (*models.Deployment, error) {
 	panic("unimplemented")
 }
 
-func (repo *EnvironmentRepository) ReadDeploymentForBranch(environmentID uint, owner, name, branch string) (*models.Deployment, error) {
+func (repo *EnvironmentRepository) ReadDeploymentForBranch(ctx context.Context, environmentID uint, owner, name, branch string) (*models.Deployment, error) {
 	panic("unimplemented")
 }
 
-func (repo *EnvironmentRepository) ListDeploymentsByCluster(projectID, clusterID uint, states ...string) ([]*models.Deployment, error) {
+func (repo *EnvironmentRepository) ListDeploymentsByCluster(ctx context.Context, projectID, clusterID uint, states ...string) ([]*models.Deployment, error) {
 	panic("unimplemented")
 }
 
-func (repo *EnvironmentRepository) ListDeployments(environmentID uint, states ...string) ([]*models.Deployment, error) {
+func (repo *EnvironmentRepository) ListDeployments(ctx context.Context, environmentID uint, states ...string) ([]*models.Deployment, error) {
 	panic("unimplemented")
 }
 
-func (repo *EnvironmentRepository) DeleteDeployment(deployment *models.Deployment) (*models.Deployment, error) {
+func (repo *EnvironmentRepository) DeleteDeployment(ctx context.Context, deployment *models.Deployment) (*models.Deployment, error) {
 	panic("unimplemented")
 }
@@ -0,0 +1,103 @@
+package test
+
+import (
+	"context"
+	"errors"
+	"sort"
+
+	"github.com/porter-dev/porter/internal/models"
+	"github.com/porter-dev/porter/internal/repository"
+	"gorm.io/gorm"
+)
+
+// EnvironmentGroupVersionRepository implements repository.EnvironmentGroupVersionRepository
+type EnvironmentGroupVersionRepository struct {
+	canQuery bool
+	versions []*models.EnvironmentGroupVersion
+}
+
+// NewEnvironmentGroupVersionRepository will return errors if canQuery is false
+func NewEnvironmentGroupVersionRepository(canQuery bool) repository.EnvironmentGroupVersionRepository {
+	return &EnvironmentGroupVersionRepository{
+		canQuery,
+		[]*models.EnvironmentGroupVersion{},
+	}
+}
+
+// CreateEnvironmentGroupVersion creates a new environment group version
+func (repo *EnvironmentGroupVersionRepository) CreateEnvironmentGroupVersion(ctx context.Context, version *models.EnvironmentGroupVersion) (*models.EnvironmentGroupVersion, error) {
+	if !repo.canQuery {
+		return nil, errors.New("Cannot write database")
+	}
+
+	repo.versions = append(repo.versions, version)
+	version.ID = uint(len(repo.versions))
+
+	return version, nil
+}
+
+// UpdateEnvironmentGroupVersion updates an existing environment group version
+func (repo *EnvironmentGroupVersionRepository) UpdateEnvironmentGroupVersion(ctx context.Context, version *models.EnvironmentGroupVersion) (*models.EnvironmentGroupVersion, error) {
+	if !repo.canQuery {
+		return nil, errors.New("Cannot write database")
+	}
+
+	if int(version.ID-1) >= len(repo.versions) || repo.versions[version.ID-1] == nil {
+		return nil, gorm.ErrRecordNotFound
+	}
+
+	repo.versions[version.ID-1] = version
+
+	return version, nil
+}
+
+// ReadEnvironmentGroupVersion finds a single version of an environment group in a cluster
+func (repo *EnvironmentGroupVersionRepository) ReadEnvironmentGroupVersion(ctx context.Context, clusterID uint, name string, version int) (*models.EnvironmentGroupVersion, error) {
+	if !repo.canQuery {
+		return nil, errors.New("Cannot read from database")
+	}
+
+	for _, v := range repo.versions {
+		if v != nil && v.ClusterID == clusterID && v.Name == name && v.Version == version {
+			return v, nil
+		}
+	}
+
+	return nil, gorm.ErrRecordNotFound
+}
+
+// ListEnvironmentGroupVersions lists the versions of an environment group in a cluster, most recent first
+func (repo *EnvironmentGroupVersionRepository) ListEnvironmentGroupVersions(ctx context.Context, clusterID uint, name string) ([]*models.EnvironmentGroupVersion, error) {
+	if !repo.canQuery {
+		return nil, errors.New("Cannot read from database")
+	}
+
+	versions := []*models.EnvironmentGroupVersion{}
+
+	for _, v := range repo.versions {
+		if v != nil && v.ClusterID == clusterID && v.Name == name {
+			versions = append(versions, v)
+		}
+	}
+
+	sort.Slice(versions, func(i, j int) bool {
+		return versions[i].Version > versions[j].Version
+	})
+
+	return versions, nil
+}
+
+// DeleteEnvironmentGroupVersions deletes all versions of an environment group in a cluster
+func (repo *EnvironmentGroupVersionRepository) DeleteEnvironmentGroupVersions(ctx context.Context, clusterID uint, name string) error {
+	if !repo.canQuery {
+		return errors.New("Cannot write database")
+	}
+
+	for i, v := range repo.versions {
+		if v != nil && v.ClusterID == clusterID && v.Name == name {
+			repo.versions[i] = nil
+		}
+	}
+
+	return nil
+}
@@ -1,6 +1,8 @@
 package test
 
 import (
+	"context"
+
 	"github.com/porter-dev/porter/api/types"
 	"github.com/porter-dev/porter/internal/models"
 	"github.com/porter-dev/porter/internal/repository"
@@ -12,27 +14,35 @@ func NewBuildEventRepository(canQuery bool) repository.BuildEventRepository {
 	return &BuildEventRepository{}
 }
 
-func (n *BuildEventRepository) CreateEventContainer(am *models.EventContainer) (*models.EventContainer, error) {
+func (n *BuildEventRepository) CreateEventContainer(ctx context.Context, am *models.EventContainer) (*models.EventContainer, error) {
+	panic("not implemented") // TODO: Implement
+}
+
+func (n *BuildEventRepository) CreateSubEvent(ctx context.Context, am *models.SubEvent) (*models.SubEvent, error) {
+	panic("not implemented") // TODO: Implement
+}
+
+func (n *BuildEventRepository) ReadEventsByContainerID(ctx context.Context, id uint) ([]*models.SubEvent, error) {
 	panic("not implemented") // TODO: Implement
 }
 
-func (n *BuildEventRepository) CreateSubEvent(am *models.SubEvent) (*models.SubEvent, error) {
+func (n *BuildEventRepository) ReadEventContainer(ctx context.Context, id uint) (*models.EventContainer, error) {
 	panic("not implemented") // TODO: Implement
 }
 
-func (n *BuildEventRepository) ReadEventsByContainerID(id uint) ([]*models.SubEvent, error) {
+func (n *BuildEventRepository) ReadSubEvent(ctx context.Context, id uint) (*models.SubEvent, error) {
 	panic("not implemented") // TODO: Implement
 }
 
-func (n *BuildEventRepository) ReadEventContainer(id uint) (*models.EventContainer, error) {
+func (n *BuildEventRepository) AppendEvent(ctx context.Context, container *models.EventContainer, event *models.SubEvent) error {
 	panic("not implemented") // TODO: Implement
 }
 
-func (n *BuildEventRepository) ReadSubEvent(id uint) (*models.SubEvent, error) {
+func (n *BuildEventRepository) ListEventContainersByPorterAppID(ctx context.Context, porterAppID uint, kind models.EventContainerKind, limit int) ([]*models.EventContainer, error) {
 	panic("not implemented") // TODO: Implement
 }
 
-func (n *BuildEventRepository) AppendEvent(container *models.EventContainer, event *models.SubEvent) error {
+func (n *BuildEventRepository) ListSubEventsAfterIndex(ctx context.Context, containerID uint, afterIndex int64) ([]*models.SubEvent, error) {
 	panic("not implemented") // TODO: Implement
 }
 
@@ -42,15 +52,16 @@ func NewKubeEventRepository(canQuery bool) repository.KubeEventRepository {
 	return &KubeEventRepository{}
 }
 
-func (n *KubeEventRepository) CreateEvent(event *models.KubeEvent) (*models.KubeEvent, error) {
+func (n *KubeEventRepository) CreateEvent(ctx context.Context, event *models.KubeEvent) (*models.KubeEvent, error) {
 	panic("not implemented") // TODO: Implement
 }
 
-func (n *KubeEventRepository) ReadEvent(id uint, projID uint, clusterID uint) (*models.KubeEvent, error) {
+func (n *KubeEventRepository) ReadEvent(ctx context.Context, id uint, projID uint, clusterID uint) (*models.KubeEvent, error) {
 	panic("not implemented") // TODO: Implement
 }
 
 func (n *KubeEventRepository) ReadEventByGroup(
+	ctx context.Context,
 	projID uint,
 	clusterID uint,
 	opts *types.GroupOptions,
@@ -59,17 +70,27 @@ func (n *KubeEventRepository) ReadEventByGroup(
 }
 
 func (n *KubeEventRepository) ListEventsByProjectID(
+	ctx context.Context,
 	projectID uint,
 	clusterID uint,
 	opts *types.ListKubeEventRequest,
-) ([]*models.KubeEvent, int64, error) {
+) ([]*models.KubeEvent, int64, string, error) {
+	panic("not implemented") // TODO: Implement
+}
+
+func (n *KubeEventRepository) SearchEvents(
+	ctx context.Context,
+	projectID uint,
+	clusterID uint,
+	opts *types.SearchKubeEventsRequest,
+) ([]*models.KubeEvent, error) {
 	panic("not implemented") // TODO: Implement
 }
 
-func (n *KubeEventRepository) AppendSubEvent(event *models.KubeEvent, subEvent *models.KubeSubEvent) error {
+func (n *KubeEventRepository) AppendSubEvent(ctx context.Context, event *models.KubeEvent, subEvent *models.KubeSubEvent) error {
 	panic("not implemented") // TODO: Implement
 }
 
-func (n *KubeEventRepository) DeleteEvent(id uint) error {
+func (n *KubeEventRepository) DeleteEvent(ctx context.Context, id uint) error {
 	panic("not implemented") // TODO: Implement
 }
@@ -0,0 +1,87 @@
+package test
+
+import (
+	"context"
+	"errors"
+
+	"github.com/porter-dev/porter/internal/models"
+	"github.com/porter-dev/porter/internal/repository"
+	"gorm.io/gorm"
+)
+
+// EventSinkRepository implements repository.EventSinkRepository
+type EventSinkRepository struct {
+	canQuery bool
+	sinks    []*models.EventSink
+}
+
+// NewEventSinkRepository will return errors if canQuery is false
+func NewEventSinkRepository(canQuery bool) repository.EventSinkRepository {
+	return &EventSinkRepository{
+		canQuery,
+		[]*models.EventSink{},
+	}
+}
+
+// CreateEventSink creates a new event sink
+func (repo *EventSinkRepository) CreateEventSink(ctx context.Context, sink *models.EventSink) (*models.EventSink, error) {
+	if !repo.canQuery {
+		return nil, errors.New("Cannot write database")
+	}
+
+	repo.sinks = append(repo.sinks, sink)
+	sink.ID = uint(len(repo.sinks))
+
+	return sink, nil
+}
+
+// ReadEventSink finds an event sink by project id and sink id
+func (repo *EventSinkRepository) ReadEventSink(ctx context.Context, projectID, sinkID uint) (*models.EventSink, error) {
+	if !repo.canQuery {
+		return nil, errors.New("Cannot read from database")
+	}
+
+	if int(sinkID-1) >= len(repo.sinks) || repo.sinks[sinkID-1] == nil {
+		return nil, gorm.ErrRecordNotFound
+	}
+
+	sink := repo.sinks[sinkID-1]
+
+	if sink.ProjectID != projectID {
+		return nil, gorm.ErrRecordNotFound
+	}
+
+	return sink, nil
+}
+
+// ListEventSinksByProjectID finds all event sinks for a given project id
+func (repo *EventSinkRepository) ListEventSinksByProjectID(ctx context.Context, projectID uint) ([]*models.EventSink, error) {
+	if !repo.canQuery {
+		return nil, errors.New("Cannot read from database")
+	}
+
+	res := make([]*models.EventSink, 0)
+
+	for _, sink := range repo.sinks {
+		if sink != nil && sink.ProjectID == projectID {
+			res = append(res, sink)
+		}
+	}
+
+	return res, nil
+}
+
+// DeleteEventSink deletes an event sink
+func (repo *EventSinkRepository) DeleteEventSink(ctx context.Context, sink *models.EventSink) error {
+	if !repo.canQuery {
+		return errors.New("Cannot write database")
+	}
+
+	if int(sink.ID-1) >= len(repo.sinks) || repo.sinks[sink.ID-1] == nil {
+		return gorm.ErrRecordNotFound
+	}
+
+	repo.sinks[sink.ID-1] = nil
+
+	return nil
+}
@@ -0,0 +1,149 @@
+package test
+
+import (
+	"context"
+	"errors"
+
+	"github.com/porter-dev/porter/internal/repository"
+	"gorm.io/gorm"
+
+	ints "github.com/porter-dev/porter/internal/models/integrations"
+)
+
+// ExternalSecretsIntegrationRepository implements repository.ExternalSecretsIntegrationRepository
+type ExternalSecretsIntegrationRepository struct {
+	canQuery     bool
+	integrations []*ints.ExternalSecretsIntegration
+}
+
+// NewExternalSecretsIntegrationRepository will return errors if canQuery is false
+func NewExternalSecretsIntegrationRepository(canQuery bool) repository.ExternalSecretsIntegrationRepository {
+	return &ExternalSecretsIntegrationRepository{
+		canQuery,
+		[]*ints.ExternalSecretsIntegration{},
+	}
+}
+
+// CreateExternalSecretsIntegration creates a new external secrets integration
+func (repo *ExternalSecretsIntegrationRepository) CreateExternalSecretsIntegration(
+	ctx context.Context,
+	es *ints.ExternalSecretsIntegration,
+) (*ints.ExternalSecretsIntegration, error) {
+	if !repo.canQuery {
+		return nil, errors.New("Cannot write database")
+	}
+
+	repo.integrations = append(repo.integrations, es)
+	es.ID = uint(len(repo.integrations))
+
+	return es, nil
+}
+
+// ReadExternalSecretsIntegration finds an external secrets integration of a cluster by id
+func (repo *ExternalSecretsIntegrationRepository) ReadExternalSecretsIntegration(
+	ctx context.Context,
+	projectID, clusterID, id uint,
+) (*ints.ExternalSecretsIntegration, error) {
+	if !repo.canQuery {
+		return nil, errors.New("Cannot read from database")
+	}
+
+	if int(id-1) >= len(repo.integrations) || repo.integrations[id-1] == nil {
+		return nil, gorm.ErrRecordNotFound
+	}
+
+	es := repo.integrations[id-1]
+	if es.ProjectID != projectID || es.ClusterID != clusterID {
+		return nil, gorm.ErrRecordNotFound
+	}
+
+	return es, nil
+}
+
+// ReadExternalSecretsIntegrationByWebhookID finds an external secrets integration by the id in its webhook url
+func (repo *ExternalSecretsIntegrationRepository) ReadExternalSecretsIntegrationByWebhookID(
+	ctx context.Context,
+	webhookID string,
+) (*ints.ExternalSecretsIntegration, error) {
+	if !repo.canQuery {
+		return nil, errors.New("Cannot read from database")
+	}
+
+	for _, es := range repo.integrations {
+		if es != nil && webhookID != "" && es.WebhookID == webhookID {
+			return es, nil
+		}
+	}
+
+	return nil, gorm.ErrRecordNotFound
+}
+
+// ListExternalSecretsIntegrationsByClusterID lists the external secrets integrations of a cluster
+func (repo *ExternalSecretsIntegrationRepository) ListExternalSecretsIntegrationsByClusterID(
+	ctx context.Context,
+	projectID, clusterID uint,
+) ([]*ints.ExternalSecretsIntegration, error) {
+	if !repo.canQuery {
+		return nil, errors.New("Cannot read from database")
+	}
+
+	res := make([]*ints.ExternalSecretsIntegration, 0)
+
+	for _, es := range repo.integrations {
+		if es != nil && es.ProjectID == projectID && es.ClusterID == clusterID {
+			res = append(res, es)
+		}
+	}
+
+	return res, nil
+}
+
+// ListScheduledExternalSecretsIntegrations lists the integrations of all clusters which sync on a schedule
+func (repo *ExternalSecretsIntegrationRepository) ListScheduledExternalSecretsIntegrations(ctx context.Context) ([]*ints.ExternalSecretsIntegration, error) {
+	if !repo.canQuery {
+		return nil, errors.New("Cannot read from database")
+	}
+
+	res := make([]*ints.ExternalSecretsIntegration, 0)
+
+	for _, es := range repo.integrations {
+		if es != nil && es.SyncIntervalMinutes > 0 {
+			res = append(res, es)
+		}
+	}
+
+	return res, nil
+}
+
+// UpdateExternalSecretsIntegration updates an external secrets integration
+func (repo *ExternalSecretsIntegrationRepository) UpdateExternalSecretsIntegration(
+	ctx context.Context,
+	es *ints.ExternalSecretsIntegration,
+) (*ints.ExternalSecretsIntegration, error) {
+	if !repo.canQuery {
+		return nil, errors.New("Cannot write database")
+	}
+
+	if int(es.ID-1) >= len(repo.integrations) || repo.integrations[es.ID-1] == nil {
+		return nil, gorm.ErrRecordNotFound
+	}
+
+	repo.integrations[es.ID-1] = es
+
+	return es, nil
+}
+
+// DeleteExternalSecretsIntegration deletes an external secrets integration of a cluster
+func (repo *ExternalSecretsIntegrationRepository) DeleteExternalSecretsIntegration(ctx context.Context, projectID, clusterID, id uint) error {
+	if !repo.canQuery {
+		return errors.New("Cannot write database")
+	}
+
+	if _, err := repo.ReadExternalSecretsIntegration(ctx, projectID, clusterID, id); err != nil {
+		return err
+	}
+
+	repo.integrations[id-1] = nil
+
+	return nil
+}
@@ -1,6 +1,7 @@
 package test
 
 import (
+	"context"
 	"errors"
 
 	"github.com/porter-dev/porter/internal/models"
@@ -22,7 +23,7 @@ func NewGitActionConfigRepository(canQuery bool) repository.GitActionConfigRepos
 }
 
 // CreateGitActionConfig creates a new git repo
-func (repo *GitActionConfigRepository) CreateGitActionConfig(gac *models.GitActionConfig) (*models.GitActionConfig, error) {
+func (repo *GitActionConfigRepository) CreateGitActionConfig(ctx context.Context, gac *models.GitActionConfig) (*models.GitActionConfig, error) {
 	if !repo.canQuery {
 		return nil, errors.New("Cannot write database")
 	}
@@ -34,7 +35,7 @@ func (repo *GitActionConfigRepository) CreateGitActionConfig(gac *models.GitActi
 }
 
 // ReadGitActionConfig gets a git repo specified by a unique id
-func (repo *GitActionConfigRepository) ReadGitActionConfig(id uint) (*models.GitActionConfig, error) {
+func (repo *GitActionConfigRepository) ReadGitActionConfig(ctx context.Context, id uint) (*models.GitActionConfig, error) {
 	if !repo.canQuery {
 		return nil, errors.New("Cannot read from database")
 	}
@@ -47,7 +48,7 @@ func (repo *GitActionConfigRepository) ReadGitActionConfig(id uint) (*models.Git
 	return repo.gitActionConfigs[index], nil
 }
 
-func (repo *GitActionConfigRepository) UpdateGitActionConfig(gac *models.GitActionConfig) error {
+func (repo *GitActionConfigRepository) UpdateGitActionConfig(ctx context.Context, gac *models.GitActionConfig) error {
 	if !repo.canQuery {
 		return errors.New("Cannot write database")
 	}
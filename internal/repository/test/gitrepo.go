@@ -1,6 +1,7 @@
 package test
 
 import (
+	"context"
 	"errors"
 
 	"github.com/porter-dev/porter/internal/models"
@@ -24,7 +25,7 @@ func NewGitRepoRepository(canQuery bool) repository.GitRepoRepository {
 }
 
 // CreateGitRepo creates a new repo client and appends it to the in-memory list
-func (repo *GitRepoRepository) CreateGitRepo(gr *models.GitRepo) (*models.GitRepo, error) {
+func (repo *GitRepoRepository) CreateGitRepo(ctx context.Context, gr *models.GitRepo) (*models.GitRepo, error) {
 	if !repo.canQuery {
 		return nil, errors.New("Cannot write database")
 	}
@@ -36,7 +37,7 @@ func (repo *GitRepoRepository) CreateGitRepo(gr *models.GitRepo) (*models.GitRep
 }
 
 // ReadGitRepo returns a repo client by id
-func (repo *GitRepoRepository) ReadGitRepo(id uint) (*models.GitRepo, error) {
+func (repo *GitRepoRepository) ReadGitRepo(ctx context.Context, id uint) (*models.GitRepo, error) {
 	if !repo.canQuery {
 		return nil, errors.New("Cannot read from database")
 	}
@@ -50,7 +51,7 @@ func (repo *GitRepoRepository) ReadGitRepo(id uint) (*models.GitRepo, error) {
 }
 
 // ListGitReposByProjectID returns a list of repo clients that match a project id
-func (repo *GitRepoRepository) ListGitReposByProjectID(projectID uint) ([]*models.GitRepo, error) {
+func (repo *GitRepoRepository) ListGitReposByProjectID(ctx context.Context, projectID uint) ([]*models.GitRepo, error) {
 	if !repo.canQuery {
 		return nil, errors.New("Cannot read from database")
 	}
@@ -68,6 +69,7 @@ func (repo *GitRepoRepository) ListGitReposByProjectID(projectID uint) ([]*model
 
 // UpdateGitRepo modifies an existing GitRepo in the database
 func (repo *GitRepoRepository) UpdateGitRepo(
+	ctx context.Context,
 	gr *models.GitRepo,
 ) (*models.GitRepo, error) {
 	if !repo.canQuery {
@@ -86,6 +88,7 @@ func (repo *GitRepoRepository) UpdateGitRepo(
 
 // DeleteGitRepo removes a repoistry from the array by setting it to nil
 func (repo *GitRepoRepository) DeleteGitRepo(
+	ctx context.Context,
 	gr *models.GitRepo,
 ) error {
 	if !repo.canQuery {
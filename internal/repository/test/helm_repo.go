@@ -1,6 +1,7 @@
 package test
 
 import (
+	"context"
 	"errors"
 
 	"github.com/porter-dev/porter/internal/models"
@@ -25,6 +26,7 @@ func NewHelmRepoRepository(canQuery bool) repository.HelmRepoRepository {
 
 // CreateHelmRepo creates a new repoistry
 func (repo *HelmRepoRepository) CreateHelmRepo(
+	ctx context.Context,
 	hr *models.HelmRepo,
 ) (*models.HelmRepo, error) {
 	if !repo.canQuery {
@@ -39,6 +41,7 @@ func (repo *HelmRepoRepository) CreateHelmRepo(
 
 // ReadHelmRepo finds a repoistry by id
 func (repo *HelmRepoRepository) ReadHelmRepo(
+	ctx context.Context,
 	projectID uint,
 	id uint,
 ) (*models.HelmRepo, error) {
@@ -57,6 +60,7 @@ func (repo *HelmRepoRepository) ReadHelmRepo(
 // ListHelmReposByProjectID finds all repoistries
 // for a given project id
 func (repo *HelmRepoRepository) ListHelmReposByProjectID(
+	ctx context.Context,
 	projectID uint,
 ) ([]*models.HelmRepo, error) {
 	if !repo.canQuery {
@@ -76,6 +80,7 @@ func (repo *HelmRepoRepository) ListHelmReposByProjectID(
 
 // UpdateHelmRepo modifies an existing HelmRepo in the database
 func (repo *HelmRepoRepository) UpdateHelmRepo(
+	ctx context.Context,
 	hr *models.HelmRepo,
 ) (*models.HelmRepo, error) {
 	if !repo.canQuery {
@@ -94,6 +99,7 @@ func (repo *HelmRepoRepository) UpdateHelmRepo(
 
 // UpdateHelmRepoTokenCache updates the token cache for a repoistry
 func (repo *HelmRepoRepository) UpdateHelmRepoTokenCache(
+	ctx context.Context,
 	tokenCache *ints.HelmRepoTokenCache,
 ) (*models.HelmRepo, error) {
 	if !repo.canQuery {
@@ -109,6 +115,7 @@ func (repo *HelmRepoRepository) UpdateHelmRepoTokenCache(
 
 // DeleteHelmRepo removes a repoistry from the array by setting it to nil
 func (repo *HelmRepoRepository) DeleteHelmRepo(
+	ctx context.Context,
 	hr *models.HelmRepo,
 ) error {
 	if !repo.canQuery {
@@ -0,0 +1,126 @@
+package test
+
+import (
+	"context"
+	"errors"
+
+	"github.com/porter-dev/porter/internal/models"
+	"github.com/porter-dev/porter/internal/repository"
+	"gorm.io/gorm"
+)
+
+// ImageAttestationRepository implements repository.ImageAttestationRepository
+type ImageAttestationRepository struct {
+	canQuery     bool
+	attestations []*models.ImageAttestation
+}
+
+// NewImageAttestationRepository will return errors if canQuery is false
+func NewImageAttestationRepository(canQuery bool) repository.ImageAttestationRepository {
+	return &ImageAttestationRepository{
+		canQuery,
+		[]*models.ImageAttestation{},
+	}
+}
+
+// CreateImageAttestation creates a new image attestation
+func (repo *ImageAttestationRepository) CreateImageAttestation(ctx context.Context, attestation *models.ImageAttestation) (*models.ImageAttestation, error) {
+	if !repo.canQuery {
+		return nil, errors.New("Cannot write database")
+	}
+
+	repo.attestations = append(repo.attestations, attestation)
+	attestation.ID = uint(len(repo.attestations))
+
+	return attestation, nil
+}
+
+// ListImageAttestationsByTag lists the attestations of the images of an app with the given tag, newest first
+func (repo *ImageAttestationRepository) ListImageAttestationsByTag(ctx context.Context, projectID uint, appName string, tag string) ([]*models.ImageAttestation, error) {
+	if !repo.canQuery {
+		return nil, errors.New("Cannot read from database")
+	}
+
+	res := make([]*models.ImageAttestation, 0)
+	for i := len(repo.attestations) - 1; i >= 0; i-- {
+		attestation := repo.attestations[i]
+		if attestation.ProjectID == projectID && attestation.AppName == appName && attestation.Tag == tag {
+			res = append(res, attestation)
+		}
+	}
+
+	return res, nil
+}
+
+// ListImageAttestationsByImage lists the attestations of the image with the given repository and tag, newest first
+func (repo *ImageAttestationRepository) ListImageAttestationsByImage(ctx context.Context, projectID uint, repository string, tag string) ([]*models.ImageAttestation, error) {
+	if !repo.canQuery {
+		return nil, errors.New("Cannot read from database")
+	}
+
+	res := make([]*models.ImageAttestation, 0)
+	for i := len(repo.attestations) - 1; i >= 0; i-- {
+		attestation := repo.attestations[i]
+		if attestation.ProjectID == projectID && attestation.Repository == repository && attestation.Tag == tag {
+			res = append(res, attestation)
+		}
+	}
+
+	return res, nil
+}
+
+// ImageSigningPolicyRepository implements repository.ImageSigningPolicyRepository
+type ImageSigningPolicyRepository struct {
+	canQuery bool
+	policies []*models.ImageSigningPolicy
+}
+
+// NewImageSigningPolicyRepository will return errors if canQuery is false
+func NewImageSigningPolicyRepository(canQuery bool) repository.ImageSigningPolicyRepository {
+	return &ImageSigningPolicyRepository{
+		canQuery,
+		[]*models.ImageSigningPolicy{},
+	}
+}
+
+// CreateImageSigningPolicy creates the image signing policy of a project
+func (repo *ImageSigningPolicyRepository) CreateImageSigningPolicy(ctx context.Context, policy *models.ImageSigningPolicy) (*models.ImageSigningPolicy, error) {
+	if !repo.canQuery {
+		return nil, errors.New("Cannot write database")
+	}
+
+	repo.policies = append(repo.policies, policy)
+	policy.ID = uint(len(repo.policies))
+
+	return policy, nil
+}
+
+// UpdateImageSigningPolicy updates the image signing policy of a project
+func (repo *ImageSigningPolicyRepository) UpdateImageSigningPolicy(ctx context.Context, policy *models.ImageSigningPolicy) (*models.ImageSigningPolicy, error) {
+	if !repo.canQuery {
+		return nil, errors.New("Cannot write database")
+	}
+
+	if int(policy.ID-1) >= len(repo.policies) {
+		return nil, gorm.ErrRecordNotFound
+	}
+
+	repo.policies[policy.ID-1] = policy
+
+	return policy, nil
+}
+
+// ReadImageSigningPolicy finds the image signing policy of a project
+func (repo *ImageSigningPolicyRepository) ReadImageSigningPolicy(ctx context.Context, projectID uint) (*models.ImageSigningPolicy, error) {
+	if !repo.canQuery {
+		return nil, errors.New("Cannot read from database")
+	}
+
+	for _, policy := range repo.policies {
+		if policy.ProjectID == projectID {
+			return policy, nil
+		}
+	}
+
+	return nil, gorm.ErrRecordNotFound
+}
@@ -1,6 +1,7 @@
 package test
 
 import (
+	"context"
 	"errors"
 
 	"github.com/porter-dev/porter/internal/models"
@@ -24,6 +25,7 @@ func NewInfraRepository(canQuery bool) repository.InfraRepository {
 
 // CreateInfra creates a new aws infra
 func (repo *InfraRepository) CreateInfra(
+	ctx context.Context,
 	infra *models.Infra,
 ) (*models.Infra, error) {
 	if !repo.canQuery {
@@ -38,6 +40,7 @@ func (repo *InfraRepository) CreateInfra(
 
 // ReadInfra finds a aws infra by id
 func (repo *InfraRepository) ReadInfra(
+	ctx context.Context,
 	projectID,
 	id uint,
 ) (*models.Infra, error) {
@@ -56,6 +59,7 @@ func (repo *InfraRepository) ReadInfra(
 // ListInfrasByProjectID finds all aws infras
 // for a given project id
 func (repo *InfraRepository) ListInfrasByProjectID(
+	ctx context.Context,
 	projectID uint,
 	apiVersion string,
 ) ([]*models.Infra, error) {
@@ -76,6 +80,7 @@ func (repo *InfraRepository) ListInfrasByProjectID(
 
 // UpdateInfra modifies an existing Infra in the database
 func (repo *InfraRepository) UpdateInfra(
+	ctx context.Context,
 	ai *models.Infra,
 ) (*models.Infra, error) {
 	if !repo.canQuery {
@@ -92,23 +97,24 @@ func (repo *InfraRepository) UpdateInfra(
 	return ai, nil
 }
 
-func (repo *InfraRepository) AddOperation(infra *models.Infra, operation *models.Operation) (*models.Operation, error) {
+func (repo *InfraRepository) AddOperation(ctx context.Context, infra *models.Infra, operation *models.Operation) (*models.Operation, error) {
 	panic("unimplemented")
 }
 
-func (repo *InfraRepository) GetLatestOperation(infra *models.Infra) (*models.Operation, error) {
+func (repo *InfraRepository) GetLatestOperation(ctx context.Context, infra *models.Infra) (*models.Operation, error) {
 	panic("unimplemented")
 }
 
-func (repo *InfraRepository) ListOperations(infraID uint) ([]*models.Operation, error) {
+func (repo *InfraRepository) ListOperations(ctx context.Context, infraID uint) ([]*models.Operation, error) {
 	panic("unimplemented")
 }
 
-func (repo *InfraRepository) ReadOperation(infraID uint, operationUID string) (*models.Operation, error) {
+func (repo *InfraRepository) ReadOperation(ctx context.Context, infraID uint, operationUID string) (*models.Operation, error) {
 	panic("unimplemented")
 }
 
 func (repo *InfraRepository) UpdateOperation(
+	ctx context.Context,
 	operation *models.Operation,
 ) (*models.Operation, error) {
 	panic("unimplemented")
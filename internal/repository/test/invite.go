@@ -1,6 +1,7 @@
 package test
 
 import (
+	"context"
 	"errors"
 
 	"github.com/porter-dev/porter/internal/models"
@@ -21,7 +22,7 @@ func NewInviteRepository(canQuery bool) repository.InviteRepository {
 }
 
 // CreateInvite creates a new invite
-func (repo *InviteRepository) CreateInvite(invite *models.Invite) (*models.Invite, error) {
+func (repo *InviteRepository) CreateInvite(ctx context.Context, invite *models.Invite) (*models.Invite, error) {
 	if !repo.canQuery {
 		return nil, errors.New("Cannot write database")
 	}
@@ -33,7 +34,7 @@ func (repo *InviteRepository) CreateInvite(invite *models.Invite) (*models.Invit
 }
 
 // ReadInvite gets an invite specified by a unique id
-func (repo *InviteRepository) ReadInvite(projectID, id uint) (*models.Invite, error) {
+func (repo *InviteRepository) ReadInvite(ctx context.Context, projectID, id uint) (*models.Invite, error) {
 	if !repo.canQuery {
 		return nil, errors.New("Cannot read from database")
 	}
@@ -47,7 +48,7 @@ func (repo *InviteRepository) ReadInvite(projectID, id uint) (*models.Invite, er
 }
 
 // ReadInviteByToken gets an invite specified by a unique token
-func (repo *InviteRepository) ReadInviteByToken(token string) (*models.Invite, error) {
+func (repo *InviteRepository) ReadInviteByToken(ctx context.Context, token string) (*models.Invite, error) {
 	if !repo.canQuery {
 		return nil, errors.New("Cannot read from database")
 	}
@@ -70,6 +71,7 @@ func (repo *InviteRepository) ReadInviteByToken(token string) (*models.Invite, e
 // ListInvitesByProjectID finds all invites
 // for a given project id
 func (repo *InviteRepository) ListInvitesByProjectID(
+	ctx context.Context,
 	projectID uint,
 ) ([]*models.Invite, error) {
 	if !repo.canQuery {
@@ -89,6 +91,7 @@ func (repo *InviteRepository) ListInvitesByProjectID(
 
 // UpdateInvite updates an invitation in the DB
 func (repo *InviteRepository) UpdateInvite(
+	ctx context.Context,
 	invite *models.Invite,
 ) (*models.Invite, error) {
 	if !repo.canQuery {
@@ -107,6 +110,7 @@ func (repo *InviteRepository) UpdateInvite(
 
 // DeleteInvite removes a registry from the db
 func (repo *InviteRepository) DeleteInvite(
+	ctx context.Context,
 	invite *models.Invite,
 ) error {
 	if !repo.canQuery {
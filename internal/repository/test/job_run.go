@@ -0,0 +1,93 @@
+package test
+
+import (
+	"context"
+	"errors"
+	"sort"
+	"time"
+
+	"github.com/porter-dev/porter/internal/models"
+	"github.com/porter-dev/porter/internal/repository"
+	"gorm.io/gorm"
+)
+
+// JobRunRepository implements repository.JobRunRepository
+type JobRunRepository struct {
+	canQuery bool
+	runs     []*models.JobRun
+}
+
+// NewJobRunRepository will return errors if canQuery is false
+func NewJobRunRepository(canQuery bool) repository.JobRunRepository {
+	return &JobRunRepository{
+		canQuery,
+		[]*models.JobRun{},
+	}
+}
+
+// ReadJobRunByJobName finds the run of a kubernetes job in a cluster
+func (repo *JobRunRepository) ReadJobRunByJobName(ctx context.Context, clusterID uint, namespace, jobName string) (*models.JobRun, error) {
+	if !repo.canQuery {
+		return nil, errors.New("Cannot read from database")
+	}
+
+	for _, run := range repo.runs {
+		if run.ClusterID == clusterID && run.Namespace == namespace && run.JobName == jobName {
+			return run, nil
+		}
+	}
+
+	return nil, gorm.ErrRecordNotFound
+}
+
+// CreateJobRun creates a new job run
+func (repo *JobRunRepository) CreateJobRun(ctx context.Context, run *models.JobRun) (*models.JobRun, error) {
+	if !repo.canQuery {
+		return nil, errors.New("Cannot write database")
+	}
+
+	repo.runs = append(repo.runs, run)
+	run.ID = uint(len(repo.runs))
+
+	return run, nil
+}
+
+// UpdateJobRun updates an existing job run
+func (repo *JobRunRepository) UpdateJobRun(ctx context.Context, run *models.JobRun) (*models.JobRun, error) {
+	if !repo.canQuery {
+		return nil, errors.New("Cannot write database")
+	}
+
+	if int(run.ID-1) >= len(repo.runs) || repo.runs[run.ID-1] == nil {
+		return nil, gorm.ErrRecordNotFound
+	}
+
+	repo.runs[run.ID-1] = run
+
+	return run, nil
+}
+
+// ListJobRuns lists the runs of a job service of an app that started after since, most recent first
+func (repo *JobRunRepository) ListJobRuns(ctx context.Context, porterAppID uint, serviceName string, since time.Time, limit int) ([]*models.JobRun, error) {
+	if !repo.canQuery {
+		return nil, errors.New("Cannot read from database")
+	}
+
+	runs := []*models.JobRun{}
+
+	for _, run := range repo.runs {
+		if run.PorterAppID == porterAppID && run.ServiceName == serviceName && !run.StartedAt.Before(since) {
+			runs = append(runs, run)
+		}
+	}
+
+	sort.Slice(runs, func(i, j int) bool {
+		return runs[i].StartedAt.After(runs[j].StartedAt)
+	})
+
+	if limit > 0 && len(runs) > limit {
+		runs = runs[:limit]
+	}
+
+	return runs, nil
+}
@@ -1,6 +1,8 @@
 package test
 
 import (
+	"context"
+
 	"github.com/porter-dev/porter/internal/models"
 	"github.com/porter-dev/porter/internal/repository"
 )
@@ -11,22 +13,22 @@ func NewMonitorTestResultRepository(canQuery bool) repository.MonitorTestResultR
 	return &MonitorTestResultRepository{}
 }
 
-func (n *MonitorTestResultRepository) CreateMonitorTestResult(monitor *models.MonitorTestResult) (*models.MonitorTestResult, error) {
+func (n *MonitorTestResultRepository) CreateMonitorTestResult(ctx context.Context, monitor *models.MonitorTestResult) (*models.MonitorTestResult, error) {
 	panic("not implemented") // TODO: Implement
 }
 
-func (n *MonitorTestResultRepository) ReadMonitorTestResult(projectID, clusterID uint, operationID string) (*models.MonitorTestResult, error) {
+func (n *MonitorTestResultRepository) ReadMonitorTestResult(ctx context.Context, projectID, clusterID uint, operationID string) (*models.MonitorTestResult, error) {
 	panic("not implemented") // TODO: Implement
 }
 
-func (n *MonitorTestResultRepository) UpdateMonitorTestResult(monitor *models.MonitorTestResult) (*models.MonitorTestResult, error) {
+func (n *MonitorTestResultRepository) UpdateMonitorTestResult(ctx context.Context, monitor *models.MonitorTestResult) (*models.MonitorTestResult, error) {
 	panic("not implemented") // TODO: Implement
 }
 
-func (n *MonitorTestResultRepository) ArchiveMonitorTestResults(projectID, clusterID uint, recommenderID string) error {
+func (n *MonitorTestResultRepository) ArchiveMonitorTestResults(ctx context.Context, projectID, clusterID uint, recommenderID string) error {
 	panic("not implemented") // TODO: Implement
 }
 
-func (n *MonitorTestResultRepository) DeleteOldMonitorTestResults(projectID, clusterID uint, recommenderID string) error {
+func (n *MonitorTestResultRepository) DeleteOldMonitorTestResults(ctx context.Context, projectID, clusterID uint, recommenderID string) error {
 	panic("not implemented") // TODO: Implement
 }
@@ -1,6 +1,8 @@
 package test
 
 import (
+	"context"
+
 	"github.com/porter-dev/porter/internal/models"
 	"github.com/porter-dev/porter/internal/repository"
 )
@@ -11,15 +13,15 @@ func NewNotificationConfigRepository(canQuery bool) repository.NotificationConfi
 	return &NotificationConfigRepository{}
 }
 
-func (n *NotificationConfigRepository) CreateNotificationConfig(am *models.NotificationConfig) (*models.NotificationConfig, error) {
+func (n *NotificationConfigRepository) CreateNotificationConfig(ctx context.Context, am *models.NotificationConfig) (*models.NotificationConfig, error) {
 	panic("not implemented") // TODO: Implement
 }
 
-func (n *NotificationConfigRepository) ReadNotificationConfig(id uint) (*models.NotificationConfig, error) {
+func (n *NotificationConfigRepository) ReadNotificationConfig(ctx context.Context, id uint) (*models.NotificationConfig, error) {
 	panic("not implemented") // TODO: Implement
 }
 
-func (n *NotificationConfigRepository) UpdateNotificationConfig(am *models.NotificationConfig) (*models.NotificationConfig, error) {
+func (n *NotificationConfigRepository) UpdateNotificationConfig(ctx context.Context, am *models.NotificationConfig) (*models.NotificationConfig, error) {
 	panic("not implemented") // TODO: Implement
 }
 
@@ -29,14 +31,14 @@ func NewJobNotificationConfigRepository(canQuery bool) repository.JobNotificatio
 	return &JobNotificationConfigRepository{}
 }
 
-func (n *JobNotificationConfigRepository) CreateNotificationConfig(am *models.JobNotificationConfig) (*models.JobNotificationConfig, error) {
+func (n *JobNotificationConfigRepository) CreateNotificationConfig(ctx context.Context, am *models.JobNotificationConfig) (*models.JobNotificationConfig, error) {
 	panic("not implemented") // TODO: Implement
 }
 
-func (n *JobNotificationConfigRepository) ReadNotificationConfig(projID, clusterID uint, name, namespace string) (*models.JobNotificationConfig, error) {
+func (n *JobNotificationConfigRepository) ReadNotificationConfig(ctx context.Context, projID, clusterID uint, name, namespace string) (*models.JobNotificationConfig, error) {
 	panic("not implemented") // TODO: Implement
 }
 
-func (n *JobNotificationConfigRepository) UpdateNotificationConfig(am *models.JobNotificationConfig) (*models.JobNotificationConfig, error) {
+func (n *JobNotificationConfigRepository) UpdateNotificationConfig(ctx context.Context, am *models.JobNotificationConfig) (*models.JobNotificationConfig, error) {
 	panic("not implemented") // TODO: Implement
 }
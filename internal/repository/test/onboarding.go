@@ -1,6 +1,7 @@
 package test
 
 import (
+	"context"
 	"errors"
 
 	"github.com/porter-dev/porter/internal/models"
@@ -24,6 +25,7 @@ func NewProjectOnboardingRepository(canQuery bool) repository.ProjectOnboardingR
 
 // CreateProjectOnboarding creates a new project onboarding limit
 func (repo *ProjectOnboardingRepository) CreateProjectOnboarding(
+	ctx context.Context,
 	onboarding *models.Onboarding,
 ) (*models.Onboarding, error) {
 	if !repo.canQuery {
@@ -41,6 +43,7 @@ func (repo *ProjectOnboardingRepository) CreateProjectOnboarding(
 
 // CreateProjectOnboarding reads a project onboarding by project id
 func (repo *ProjectOnboardingRepository) ReadProjectOnboarding(
+	ctx context.Context,
 	projID uint,
 ) (*models.Onboarding, error) {
 	if !repo.canQuery {
@@ -58,6 +61,7 @@ func (repo *ProjectOnboardingRepository) ReadProjectOnboarding(
 
 // UpdateProjectOnboarding modifies an existing ProjectOnboarding in the database
 func (repo *ProjectOnboardingRepository) UpdateProjectOnboarding(
+	ctx context.Context,
 	onboarding *models.Onboarding,
 ) (*models.Onboarding, error) {
 	if !repo.canQuery {
@@ -1,6 +1,7 @@
 package test
 
 import (
+	"context"
 	"errors"
 	"strings"
 
@@ -17,27 +18,42 @@ func NewPorterAppRepository(canQuery bool, failingMethods ...string) repository.
 	return &PorterAppRepository{canQuery, strings.Join(failingMethods, ",")}
 }
 
-func (repo *PorterAppRepository) ReadPorterAppByName(clusterID uint, name string) (*models.PorterApp, error) {
+func (repo *PorterAppRepository) ReadPorterAppByName(ctx context.Context, clusterID uint, name string) (*models.PorterApp, error) {
 	return nil, errors.New("cannot write database")
 }
 
 // ReadPorterAppsByProjectIDAndName is a test method that is not implemented
-func (repo *PorterAppRepository) ReadPorterAppsByProjectIDAndName(projectID uint, name string) ([]*models.PorterApp, error) {
+func (repo *PorterAppRepository) ReadPorterAppsByProjectIDAndName(ctx context.Context, projectID uint, name string) ([]*models.PorterApp, error) {
 	return nil, errors.New("cannot write database")
 }
 
-func (repo *PorterAppRepository) CreatePorterApp(app *models.PorterApp) (*models.PorterApp, error) {
+// ReadPorterAppsByGitRepo is a test method that is not implemented
+func (repo *PorterAppRepository) ReadPorterAppsByGitRepo(ctx context.Context, gitRepoID uint, repoName string) ([]*models.PorterApp, error) {
+	return nil, errors.New("cannot read database")
+}
+
+func (repo *PorterAppRepository) CreatePorterApp(ctx context.Context, app *models.PorterApp) (*models.PorterApp, error) {
 	return nil, errors.New("cannot write database")
 }
 
-func (repo *PorterAppRepository) UpdatePorterApp(app *models.PorterApp) (*models.PorterApp, error) {
+func (repo *PorterAppRepository) UpdatePorterApp(ctx context.Context, app *models.PorterApp) (*models.PorterApp, error) {
 	return nil, errors.New("cannot write database")
 }
 
-func (repo *PorterAppRepository) ListPorterAppByClusterID(clusterID uint) ([]*models.PorterApp, error) {
+// IncrementPorterAppConfigRevision is a test method that is not implemented
+func (repo *PorterAppRepository) IncrementPorterAppConfigRevision(ctx context.Context, app *models.PorterApp) (bool, error) {
+	return false, errors.New("cannot write database")
+}
+
+func (repo *PorterAppRepository) ListPorterAppByClusterID(ctx context.Context, clusterID uint) ([]*models.PorterApp, error) {
 	return nil, errors.New("cannot write database")
 }
 
-func (repo *PorterAppRepository) DeletePorterApp(app *models.PorterApp) (*models.PorterApp, error) {
+// ListPorterAppsByProjectID is a test method that is not implemented
+func (repo *PorterAppRepository) ListPorterAppsByProjectID(ctx context.Context, projectID uint) ([]*models.PorterApp, error) {
+	return nil, errors.New("cannot read database")
+}
+
+func (repo *PorterAppRepository) DeletePorterApp(ctx context.Context, app *models.PorterApp) (*models.PorterApp, error) {
 	return nil, errors.New("cannot write database")
 }
@@ -1,6 +1,7 @@
 package test
 
 import (
+	"context"
 	"errors"
 	"strings"
 
@@ -31,7 +32,7 @@ func NewProjectRepository(canQuery bool, failingMethods ...string) repository.Pr
 }
 
 // CreateProject appends a new project to the in-memory projects array
-func (repo *ProjectRepository) CreateProject(project *models.Project) (*models.Project, error) {
+func (repo *ProjectRepository) CreateProject(ctx context.Context, project *models.Project) (*models.Project, error) {
 	if !repo.canQuery || strings.Contains(repo.failingMethods, CreateProjectMethod) {
 		return nil, errors.New("Cannot write database")
 	}
@@ -43,7 +44,7 @@ func (repo *ProjectRepository) CreateProject(project *models.Project) (*models.P
 }
 
 // CreateProjectRole appends a role to the existing array of roles
-func (repo *ProjectRepository) CreateProjectRole(project *models.Project, role *models.Role) (*models.Role, error) {
+func (repo *ProjectRepository) CreateProjectRole(ctx context.Context, project *models.Project, role *models.Role) (*models.Role, error) {
 	if !repo.canQuery || strings.Contains(repo.failingMethods, CreateProjectRoleMethod) {
 		return nil, errors.New("Cannot write database")
 	}
@@ -60,12 +61,12 @@ func (repo *ProjectRepository) CreateProjectRole(project *models.Project, role *
 	return role, nil
 }
 
-func (repo *ProjectRepository) UpdateProject(project *models.Project) (*models.Project, error) {
+func (repo *ProjectRepository) UpdateProject(ctx context.Context, project *models.Project) (*models.Project, error) {
 	panic("unimplemented")
 }
 
 // CreateProjectRole appends a role to the existing array of roles
-func (repo *ProjectRepository) UpdateProjectRole(projID uint, role *models.Role) (*models.Role, error) {
+func (repo *ProjectRepository) UpdateProjectRole(ctx context.Context, projID uint, role *models.Role) (*models.Role, error) {
 	if !repo.canQuery {
 		return nil, errors.New("Cannot read from database")
 	}
@@ -100,7 +101,7 @@ func (repo *ProjectRepository) UpdateProjectRole(projID uint, role *models.Role)
 }
 
 // ReadProject gets a projects specified by a unique id
-func (repo *ProjectRepository) ReadProjectRole(userID, projID uint) (*models.Role, error) {
+func (repo *ProjectRepository) ReadProjectRole(ctx context.Context, userID, projID uint) (*models.Role, error) {
 	if !repo.canQuery {
 		return nil, errors.New("Cannot read from database")
 	}
@@ -122,7 +123,7 @@ func (repo *ProjectRepository) ReadProjectRole(userID, projID uint) (*models.Rol
 }
 
 // ReadProject gets a projects specified by a unique id
-func (repo *ProjectRepository) ReadProject(id uint) (*models.Project, error) {
+func (repo *ProjectRepository) ReadProject(ctx context.Context, id uint) (*models.Project, error) {
 	if !repo.canQuery || strings.Contains(repo.failingMethods, ReadProjectMethod) {
 		return nil, errors.New("Cannot read from database")
 	}
@@ -136,7 +137,7 @@ func (repo *ProjectRepository) ReadProject(id uint) (*models.Project, error) {
 }
 
 // ListProjectsByUserID lists projects where a user has an associated role
-func (repo *ProjectRepository) ListProjectsByUserID(userID uint) ([]*models.Project, error) {
+func (repo *ProjectRepository) ListProjectsByUserID(ctx context.Context, userID uint) ([]*models.Project, error) {
 	if !repo.canQuery || strings.Contains(repo.failingMethods, ListProjectsByUserIDMethod) {
 		return nil, errors.New("Cannot read from database")
 	}
@@ -156,7 +157,7 @@ func (repo *ProjectRepository) ListProjectsByUserID(userID uint) ([]*models.Proj
 }
 
 // ListProjectRoles returns a list of roles for the project
-func (repo *ProjectRepository) ListProjectRoles(projID uint) ([]models.Role, error) {
+func (repo *ProjectRepository) ListProjectRoles(ctx context.Context, projID uint) ([]models.Role, error) {
 	if !repo.canQuery {
 		return nil, errors.New("Cannot read from database")
 	}
@@ -172,7 +173,7 @@ func (repo *ProjectRepository) ListProjectRoles(projID uint) ([]models.Role, err
 }
 
 // DeleteProject removes a project
-func (repo *ProjectRepository) DeleteProject(project *models.Project) (*models.Project, error) {
+func (repo *ProjectRepository) DeleteProject(ctx context.Context, project *models.Project) (*models.Project, error) {
 	if !repo.canQuery {
 		return nil, errors.New("Cannot write database")
 	}
@@ -187,7 +188,7 @@ func (repo *ProjectRepository) DeleteProject(project *models.Project) (*models.P
 	return project, nil
 }
 
-func (repo *ProjectRepository) DeleteProjectRole(projID, userID uint) (*models.Role, error) {
+func (repo *ProjectRepository) DeleteProjectRole(ctx context.Context, projID, userID uint) (*models.Role, error) {
 	if !repo.canQuery {
 		return nil, errors.New("Cannot write database")
 	}
@@ -0,0 +1,137 @@
+package test
+
+import (
+	"context"
+	"errors"
+
+	"github.com/porter-dev/porter/internal/models"
+	"github.com/porter-dev/porter/internal/repository"
+	"gorm.io/gorm"
+)
+
+// ProjectWebhookRepository implements repository.ProjectWebhookRepository
+type ProjectWebhookRepository struct {
+	canQuery   bool
+	webhooks   []*models.ProjectWebhook
+	deliveries []*models.ProjectWebhookDelivery
+}
+
+// NewProjectWebhookRepository will return errors if canQuery is false
+func NewProjectWebhookRepository(canQuery bool) repository.ProjectWebhookRepository {
+	return &ProjectWebhookRepository{
+		canQuery,
+		[]*models.ProjectWebhook{},
+		[]*models.ProjectWebhookDelivery{},
+	}
+}
+
+// CreateProjectWebhook creates a new project webhook
+func (repo *ProjectWebhookRepository) CreateProjectWebhook(ctx context.Context, webhook *models.ProjectWebhook) (*models.ProjectWebhook, error) {
+	if !repo.canQuery {
+		return nil, errors.New("Cannot write database")
+	}
+
+	repo.webhooks = append(repo.webhooks, webhook)
+	webhook.ID = uint(len(repo.webhooks))
+
+	return webhook, nil
+}
+
+// ReadProjectWebhook finds a project webhook by project id and webhook id
+func (repo *ProjectWebhookRepository) ReadProjectWebhook(ctx context.Context, projectID, webhookID uint) (*models.ProjectWebhook, error) {
+	if !repo.canQuery {
+		return nil, errors.New("Cannot read from database")
+	}
+
+	if int(webhookID-1) >= len(repo.webhooks) || repo.webhooks[webhookID-1] == nil {
+		return nil, gorm.ErrRecordNotFound
+	}
+
+	webhook := repo.webhooks[webhookID-1]
+
+	if webhook.ProjectID != projectID {
+		return nil, gorm.ErrRecordNotFound
+	}
+
+	return webhook, nil
+}
+
+// ListProjectWebhooksByProjectID finds all webhooks for a given project id
+func (repo *ProjectWebhookRepository) ListProjectWebhooksByProjectID(ctx context.Context, projectID uint) ([]*models.ProjectWebhook, error) {
+	if !repo.canQuery {
+		return nil, errors.New("Cannot read from database")
+	}
+
+	res := make([]*models.ProjectWebhook, 0)
+
+	for _, webhook := range repo.webhooks {
+		if webhook != nil && webhook.ProjectID == projectID {
+			res = append(res, webhook)
+		}
+	}
+
+	return res, nil
+}
+
+// DeleteProjectWebhook deletes a project webhook
+func (repo *ProjectWebhookRepository) DeleteProjectWebhook(ctx context.Context, webhook *models.ProjectWebhook) error {
+	if !repo.canQuery {
+		return errors.New("Cannot write database")
+	}
+
+	if int(webhook.ID-1) >= len(repo.webhooks) || repo.webhooks[webhook.ID-1] == nil {
+		return gorm.ErrRecordNotFound
+	}
+
+	repo.webhooks[webhook.ID-1] = nil
+
+	return nil
+}
+
+// CreateProjectWebhookDelivery creates a new delivery record for a webhook
+func (repo *ProjectWebhookRepository) CreateProjectWebhookDelivery(ctx context.Context, delivery *models.ProjectWebhookDelivery) (*models.ProjectWebhookDelivery, error) {
+	if !repo.canQuery {
+		return nil, errors.New("Cannot write database")
+	}
+
+	repo.deliveries = append(repo.deliveries, delivery)
+	delivery.ID = uint(len(repo.deliveries))
+
+	return delivery, nil
+}
+
+// UpdateProjectWebhookDelivery updates a delivery record for a webhook
+func (repo *ProjectWebhookRepository) UpdateProjectWebhookDelivery(ctx context.Context, delivery *models.ProjectWebhookDelivery) (*models.ProjectWebhookDelivery, error) {
+	if !repo.canQuery {
+		return nil, errors.New("Cannot write database")
+	}
+
+	if int(delivery.ID-1) >= len(repo.deliveries) {
+		return nil, gorm.ErrRecordNotFound
+	}
+
+	repo.deliveries[delivery.ID-1] = delivery
+
+	return delivery, nil
+}
+
+// ListProjectWebhookDeliveries lists the most recent deliveries for a webhook, newest first
+func (repo *ProjectWebhookRepository) ListProjectWebhookDeliveries(ctx context.Context, webhookID uint, limit int) ([]*models.ProjectWebhookDelivery, error) {
+	if !repo.canQuery {
+		return nil, errors.New("Cannot read from database")
+	}
+
+	res := make([]*models.ProjectWebhookDelivery, 0)
+
+	for i := len(repo.deliveries) - 1; i >= 0; i-- {
+		if limit > 0 && len(res) >= limit {
+			break
+		}
+
+		if repo.deliveries[i].WebhookID == webhookID {
+			res = append(res, repo.deliveries[i])
+		}
+	}
+
+	return res, nil
+}
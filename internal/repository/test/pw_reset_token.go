@@ -1,6 +1,7 @@
 package test
 
 import (
+	"context"
 	"errors"
 
 	"github.com/porter-dev/porter/internal/models"
@@ -21,7 +22,7 @@ func NewPWResetTokenRepository(canQuery bool) repository.PWResetTokenRepository
 }
 
 // CreatePWResetToken creates a new invite
-func (repo *PWResetTokenRepository) CreatePWResetToken(a *models.PWResetToken) (*models.PWResetToken, error) {
+func (repo *PWResetTokenRepository) CreatePWResetToken(ctx context.Context, a *models.PWResetToken) (*models.PWResetToken, error) {
 	if !repo.canQuery {
 		return nil, errors.New("Cannot write database")
 	}
@@ -33,7 +34,7 @@ func (repo *PWResetTokenRepository) CreatePWResetToken(a *models.PWResetToken) (
 }
 
 // ReadPWResetToken gets an auth code object specified by the unique code
-func (repo *PWResetTokenRepository) ReadPWResetToken(id uint) (*models.PWResetToken, error) {
+func (repo *PWResetTokenRepository) ReadPWResetToken(ctx context.Context, id uint) (*models.PWResetToken, error) {
 	if !repo.canQuery {
 		return nil, errors.New("Cannot read from database")
 	}
@@ -48,6 +49,7 @@ func (repo *PWResetTokenRepository) ReadPWResetToken(id uint) (*models.PWResetTo
 
 // UpdatePWResetToken modifies an existing PWResetToken in the database
 func (repo *PWResetTokenRepository) UpdatePWResetToken(
+	ctx context.Context,
 	pwToken *models.PWResetToken,
 ) (*models.PWResetToken, error) {
 	if !repo.canQuery {
@@ -1,6 +1,7 @@
 package test
 
 import (
+	"context"
 	"errors"
 
 	"github.com/porter-dev/porter/internal/models"
@@ -25,6 +26,7 @@ func NewRegistryRepository(canQuery bool) repository.RegistryRepository {
 
 // CreateRegistry creates a new registry
 func (repo *RegistryRepository) CreateRegistry(
+	ctx context.Context,
 	reg *models.Registry,
 ) (*models.Registry, error) {
 	if !repo.canQuery {
@@ -39,6 +41,7 @@ func (repo *RegistryRepository) CreateRegistry(
 
 // ReadRegistry finds a registry by id
 func (repo *RegistryRepository) ReadRegistry(
+	ctx context.Context,
 	projectID, regID uint,
 ) (*models.Registry, error) {
 	if !repo.canQuery {
@@ -54,6 +57,7 @@ func (repo *RegistryRepository) ReadRegistry(
 }
 
 func (repo *RegistryRepository) ReadRegistryByInfraID(
+	ctx context.Context,
 	projectID, infraID uint,
 ) (*models.Registry, error) {
 	panic("unimplemented")
@@ -62,6 +66,7 @@ func (repo *RegistryRepository) ReadRegistryByInfraID(
 // ListRegistriesByProjectID finds all registries
 // for a given project id
 func (repo *RegistryRepository) ListRegistriesByProjectID(
+	ctx context.Context,
 	projectID uint,
 ) ([]*models.Registry, error) {
 	if !repo.canQuery {
@@ -81,6 +86,7 @@ func (repo *RegistryRepository) ListRegistriesByProjectID(
 
 // UpdateRegistry modifies an existing Registry in the database
 func (repo *RegistryRepository) UpdateRegistry(
+	ctx context.Context,
 	reg *models.Registry,
 ) (*models.Registry, error) {
 	if !repo.canQuery {
@@ -99,6 +105,7 @@ func (repo *RegistryRepository) UpdateRegistry(
 
 // UpdateRegistryTokenCache updates the token cache for a registry
 func (repo *RegistryRepository) UpdateRegistryTokenCache(
+	ctx context.Context,
 	tokenCache *ints.RegTokenCache,
 ) (*models.Registry, error) {
 	if !repo.canQuery {
@@ -114,6 +121,7 @@ func (repo *RegistryRepository) UpdateRegistryTokenCache(
 
 // DeleteRegistry removes a registry from the array by setting it to nil
 func (repo *RegistryRepository) DeleteRegistry(
+	ctx context.Context,
 	reg *models.Registry,
 ) error {
 	if !repo.canQuery {
@@ -0,0 +1,82 @@
+package test
+
+import (
+	"context"
+	"errors"
+
+	"github.com/porter-dev/porter/internal/models"
+	"github.com/porter-dev/porter/internal/repository"
+	"gorm.io/gorm"
+)
+
+// RegistryGCPolicyRepository implements repository.RegistryGCPolicyRepository
+type RegistryGCPolicyRepository struct {
+	canQuery bool
+	policies []*models.RegistryGCPolicy
+}
+
+// NewRegistryGCPolicyRepository will return errors if canQuery is false
+func NewRegistryGCPolicyRepository(canQuery bool) repository.RegistryGCPolicyRepository {
+	return &RegistryGCPolicyRepository{
+		canQuery,
+		[]*models.RegistryGCPolicy{},
+	}
+}
+
+// CreateRegistryGCPolicy creates a new registry garbage collection policy
+func (repo *RegistryGCPolicyRepository) CreateRegistryGCPolicy(ctx context.Context, policy *models.RegistryGCPolicy) (*models.RegistryGCPolicy, error) {
+	if !repo.canQuery {
+		return nil, errors.New("Cannot write database")
+	}
+
+	repo.policies = append(repo.policies, policy)
+	policy.ID = uint(len(repo.policies))
+
+	return policy, nil
+}
+
+// UpdateRegistryGCPolicy updates an existing registry garbage collection policy
+func (repo *RegistryGCPolicyRepository) UpdateRegistryGCPolicy(ctx context.Context, policy *models.RegistryGCPolicy) (*models.RegistryGCPolicy, error) {
+	if !repo.canQuery {
+		return nil, errors.New("Cannot write database")
+	}
+
+	if int(policy.ID-1) >= len(repo.policies) || repo.policies[policy.ID-1] == nil {
+		return nil, gorm.ErrRecordNotFound
+	}
+
+	repo.policies[policy.ID-1] = policy
+
+	return policy, nil
+}
+
+// ReadRegistryGCPolicy finds the registry garbage collection policy of a project
+func (repo *RegistryGCPolicyRepository) ReadRegistryGCPolicy(ctx context.Context, projectID uint) (*models.RegistryGCPolicy, error) {
+	if !repo.canQuery {
+		return nil, errors.New("Cannot read from database")
+	}
+
+	for _, policy := range repo.policies {
+		if policy != nil && policy.ProjectID == projectID {
+			return policy, nil
+		}
+	}
+
+	return nil, gorm.ErrRecordNotFound
+}
+
+// ListEnabledRegistryGCPolicies lists the policies which are applied by the background job
+func (repo *RegistryGCPolicyRepository) ListEnabledRegistryGCPolicies(ctx context.Context) ([]*models.RegistryGCPolicy, error) {
+	if !repo.canQuery {
+		return nil, errors.New("Cannot read from database")
+	}
+
+	res := make([]*models.RegistryGCPolicy, 0)
+	for _, policy := range repo.policies {
+		if policy != nil && policy.Enabled {
+			res = append(res, policy)
+		}
+	}
+
+	return res, nil
+}
@@ -1,6 +1,7 @@
 package test
 
 import (
+	"context"
 	"errors"
 
 	"github.com/porter-dev/porter/internal/models"
@@ -24,6 +25,7 @@ func NewReleaseRepository(canQuery bool) repository.ReleaseRepository {
 
 // CreateRelease creates a new release
 func (repo *ReleaseRepository) CreateRelease(
+	ctx context.Context,
 	release *models.Release,
 ) (*models.Release, error) {
 	if !repo.canQuery {
@@ -42,6 +44,7 @@ func (repo *ReleaseRepository) CreateRelease(
 
 // ReadRelease finds a release by id
 func (repo *ReleaseRepository) ReadReleaseByWebhookToken(
+	ctx context.Context,
 	token string,
 ) (*models.Release, error) {
 	if !repo.canQuery {
@@ -59,6 +62,7 @@ func (repo *ReleaseRepository) ReadReleaseByWebhookToken(
 
 // ReadRelease finds a release by id
 func (repo *ReleaseRepository) ReadRelease(
+	ctx context.Context,
 	clusterID uint, name, namespace string,
 ) (*models.Release, error) {
 	if !repo.canQuery {
@@ -76,6 +80,7 @@ func (repo *ReleaseRepository) ReadRelease(
 
 // ListReleasesByProjectID finds all releases for a given project id
 func (repo *ReleaseRepository) ListReleasesByImageRepoURI(
+	ctx context.Context,
 	clusterID uint, imageRepoURI string,
 ) ([]*models.Release, error) {
 	if !repo.canQuery {
@@ -95,6 +100,7 @@ func (repo *ReleaseRepository) ListReleasesByImageRepoURI(
 
 // UpdateRelease modifies an existing Release in the database
 func (repo *ReleaseRepository) UpdateRelease(
+	ctx context.Context,
 	release *models.Release,
 ) (*models.Release, error) {
 	if !repo.canQuery {
@@ -113,6 +119,7 @@ func (repo *ReleaseRepository) UpdateRelease(
 
 // DeleteRelease removes a release from the array by setting it to nil
 func (repo *ReleaseRepository) DeleteRelease(
+	ctx context.Context,
 	release *models.Release,
 ) (*models.Release, error) {
 	if !repo.canQuery {
@@ -5,53 +5,73 @@ import (
 )
 
 type TestRepository struct {
-	user                      repository.UserRepository
-	session                   repository.SessionRepository
-	project                   repository.ProjectRepository
-	cluster                   repository.ClusterRepository
-	helmRepo                  repository.HelmRepoRepository
-	registry                  repository.RegistryRepository
-	gitRepo                   repository.GitRepoRepository
-	gitActionConfig           repository.GitActionConfigRepository
-	invite                    repository.InviteRepository
-	release                   repository.ReleaseRepository
-	environment               repository.EnvironmentRepository
-	authCode                  repository.AuthCodeRepository
-	dnsRecord                 repository.DNSRecordRepository
-	pwResetToken              repository.PWResetTokenRepository
-	infra                     repository.InfraRepository
-	kubeIntegration           repository.KubeIntegrationRepository
-	basicIntegration          repository.BasicIntegrationRepository
-	oidcIntegration           repository.OIDCIntegrationRepository
-	oauthIntegration          repository.OAuthIntegrationRepository
-	gcpIntegration            repository.GCPIntegrationRepository
-	awsIntegration            repository.AWSIntegrationRepository
-	azIntegration             repository.AzureIntegrationRepository
-	githubAppInstallation     repository.GithubAppInstallationRepository
-	githubAppOAuthIntegration repository.GithubAppOAuthIntegrationRepository
-	gitlabIntegration         repository.GitlabIntegrationRepository
-	gitlabAppOAuthIntegration repository.GitlabAppOAuthIntegrationRepository
-	slackIntegration          repository.SlackIntegrationRepository
-	notificationConfig        repository.NotificationConfigRepository
-	jobNotificationConfig     repository.JobNotificationConfigRepository
-	buildEvent                repository.BuildEventRepository
-	kubeEvent                 repository.KubeEventRepository
-	projectUsage              repository.ProjectUsageRepository
-	onboarding                repository.ProjectOnboardingRepository
-	ceToken                   repository.CredentialsExchangeTokenRepository
-	buildConfig               repository.BuildConfigRepository
-	database                  repository.DatabaseRepository
-	allowlist                 repository.AllowlistRepository
-	apiToken                  repository.APITokenRepository
-	policy                    repository.PolicyRepository
-	tag                       repository.TagRepository
-	stack                     repository.StackRepository
-	monitor                   repository.MonitorTestResultRepository
-	apiContractRevision       repository.APIContractRevisioner
-	awsAssumeRoleChainer      repository.AWSAssumeRoleChainer
-	porterApp                 repository.PorterAppRepository
-	porterAppEvent            repository.PorterAppEventRepository
-	deploymentTarget          repository.DeploymentTargetRepository
+	user                       repository.UserRepository
+	session                    repository.SessionRepository
+	project                    repository.ProjectRepository
+	cluster                    repository.ClusterRepository
+	helmRepo                   repository.HelmRepoRepository
+	registry                   repository.RegistryRepository
+	gitRepo                    repository.GitRepoRepository
+	gitActionConfig            repository.GitActionConfigRepository
+	invite                     repository.InviteRepository
+	release                    repository.ReleaseRepository
+	environment                repository.EnvironmentRepository
+	authCode                   repository.AuthCodeRepository
+	dnsRecord                  repository.DNSRecordRepository
+	pwResetToken               repository.PWResetTokenRepository
+	infra                      repository.InfraRepository
+	kubeIntegration            repository.KubeIntegrationRepository
+	basicIntegration           repository.BasicIntegrationRepository
+	oidcIntegration            repository.OIDCIntegrationRepository
+	oauthIntegration           repository.OAuthIntegrationRepository
+	gcpIntegration             repository.GCPIntegrationRepository
+	awsIntegration             repository.AWSIntegrationRepository
+	azIntegration              repository.AzureIntegrationRepository
+	githubAppInstallation      repository.GithubAppInstallationRepository
+	githubAppOAuthIntegration  repository.GithubAppOAuthIntegrationRepository
+	gitlabIntegration          repository.GitlabIntegrationRepository
+	gitlabAppOAuthIntegration  repository.GitlabAppOAuthIntegrationRepository
+	slackIntegration           repository.SlackIntegrationRepository
+	notificationConfig         repository.NotificationConfigRepository
+	jobNotificationConfig      repository.JobNotificationConfigRepository
+	buildEvent                 repository.BuildEventRepository
+	kubeEvent                  repository.KubeEventRepository
+	projectUsage               repository.ProjectUsageRepository
+	onboarding                 repository.ProjectOnboardingRepository
+	ceToken                    repository.CredentialsExchangeTokenRepository
+	buildConfig                repository.BuildConfigRepository
+	database                   repository.DatabaseRepository
+	allowlist                  repository.AllowlistRepository
+	apiToken                   repository.APITokenRepository
+	policy                     repository.PolicyRepository
+	tag                        repository.TagRepository
+	stack                      repository.StackRepository
+	monitor                    repository.MonitorTestResultRepository
+	apiContractRevision        repository.APIContractRevisioner
+	awsAssumeRoleChainer       repository.AWSAssumeRoleChainer
+	porterApp                  repository.PorterAppRepository
+	porterAppEvent             repository.PorterAppEventRepository
+	deploymentTarget           repository.DeploymentTargetRepository
+	projectWebhook             repository.ProjectWebhookRepository
+	jobRun                     repository.JobRunRepository
+	environmentGroupVersion    repository.EnvironmentGroupVersionRepository
+	externalSecretsIntegration repository.ExternalSecretsIntegrationRepository
+	registryGCPolicy           repository.RegistryGCPolicyRepository
+	appRevisionMetadata        repository.AppRevisionMetadataRepository
+	scim                       repository.SCIMRepository
+	userMFA                    repository.UserMFARepository
+	appResourceRequest         repository.AppResourceRequestRepository
+	serviceAccount             repository.ServiceAccountRepository
+	alertRule                  repository.AlertRuleRepository
+	eventSink                  repository.EventSinkRepository
+	addon                      repository.AddonRepository
+	datastore                  repository.DatastoreRepository
+	customCertificate          repository.CustomCertificateRepository
+	customDomain               repository.CustomDomainRepository
+	subdomain                  repository.SubdomainRepository
+	appTemplate                repository.AppTemplateRepository
+	imageAttestation           repository.ImageAttestationRepository
+	imageSigningPolicy         repository.ImageSigningPolicyRepository
 }
 
 func (t *TestRepository) User() repository.UserRepository {
@@ -243,56 +263,176 @@ func (t *TestRepository) DeploymentTarget() repository.DeploymentTargetRepositor
 	return t.deploymentTarget
 }
 
+// ProjectWebhook returns a test ProjectWebhookRepository
+func (t *TestRepository) ProjectWebhook() repository.ProjectWebhookRepository {
+	return t.projectWebhook
+}
+
+// JobRun returns a test JobRunRepository
+func (t *TestRepository) JobRun() repository.JobRunRepository {
+	return t.jobRun
+}
+
+// EnvironmentGroupVersion returns a test EnvironmentGroupVersionRepository
+func (t *TestRepository) EnvironmentGroupVersion() repository.EnvironmentGroupVersionRepository {
+	return t.environmentGroupVersion
+}
+
+// ExternalSecretsIntegration returns a test ExternalSecretsIntegrationRepository
+func (t *TestRepository) ExternalSecretsIntegration() repository.ExternalSecretsIntegrationRepository {
+	return t.externalSecretsIntegration
+}
+
+// RegistryGCPolicy returns a test RegistryGCPolicyRepository
+func (t *TestRepository) RegistryGCPolicy() repository.RegistryGCPolicyRepository {
+	return t.registryGCPolicy
+}
+
+// AppRevisionMetadata returns a test AppRevisionMetadataRepository
+func (t *TestRepository) AppRevisionMetadata() repository.AppRevisionMetadataRepository {
+	return t.appRevisionMetadata
+}
+
+// SCIM returns a test SCIMRepository
+func (t *TestRepository) SCIM() repository.SCIMRepository {
+	return t.scim
+}
+
+// UserMFA returns a test UserMFARepository
+func (t *TestRepository) UserMFA() repository.UserMFARepository {
+	return t.userMFA
+}
+
+// AppResourceRequest returns a test AppResourceRequestRepository
+func (t *TestRepository) AppResourceRequest() repository.AppResourceRequestRepository {
+	return t.appResourceRequest
+}
+
+// ServiceAccount returns a test ServiceAccountRepository
+func (t *TestRepository) ServiceAccount() repository.ServiceAccountRepository {
+	return t.serviceAccount
+}
+
+// AlertRule returns a test AlertRuleRepository
+func (t *TestRepository) AlertRule() repository.AlertRuleRepository {
+	return t.alertRule
+}
+
+// EventSink returns a test EventSinkRepository
+func (t *TestRepository) EventSink() repository.EventSinkRepository {
+	return t.eventSink
+}
+
+// Addon returns a test AddonRepository
+func (t *TestRepository) Addon() repository.AddonRepository {
+	return t.addon
+}
+
+// Datastore returns a test DatastoreRepository
+func (t *TestRepository) Datastore() repository.DatastoreRepository {
+	return t.datastore
+}
+
+// CustomCertificate returns a test CustomCertificateRepository
+func (t *TestRepository) CustomCertificate() repository.CustomCertificateRepository {
+	return t.customCertificate
+}
+
+// CustomDomain returns a test CustomDomainRepository
+func (t *TestRepository) CustomDomain() repository.CustomDomainRepository {
+	return t.customDomain
+}
+
+// Subdomain returns a test SubdomainRepository
+func (t *TestRepository) Subdomain() repository.SubdomainRepository {
+	return t.subdomain
+}
+
+// AppTemplate returns a test AppTemplateRepository
+func (t *TestRepository) AppTemplate() repository.AppTemplateRepository {
+	return t.appTemplate
+}
+
+// ImageAttestation returns a test ImageAttestationRepository
+func (t *TestRepository) ImageAttestation() repository.ImageAttestationRepository {
+	return t.imageAttestation
+}
+
+// ImageSigningPolicy returns a test ImageSigningPolicyRepository
+func (t *TestRepository) ImageSigningPolicy() repository.ImageSigningPolicyRepository {
+	return t.imageSigningPolicy
+}
+
 // NewRepository returns a Repository which persists users in memory
 // and accepts a parameter that can trigger read/write errors
 func NewRepository(canQuery bool, failingMethods ...string) repository.Repository {
 	return &TestRepository{
-		user:                      NewUserRepository(canQuery, failingMethods...),
-		session:                   NewSessionRepository(canQuery, failingMethods...),
-		project:                   NewProjectRepository(canQuery, failingMethods...),
-		cluster:                   NewClusterRepository(canQuery),
-		helmRepo:                  NewHelmRepoRepository(canQuery),
-		registry:                  NewRegistryRepository(canQuery),
-		gitRepo:                   NewGitRepoRepository(canQuery),
-		gitActionConfig:           NewGitActionConfigRepository(canQuery),
-		invite:                    NewInviteRepository(canQuery),
-		release:                   NewReleaseRepository(canQuery),
-		environment:               NewEnvironmentRepository(),
-		authCode:                  NewAuthCodeRepository(canQuery),
-		dnsRecord:                 NewDNSRecordRepository(canQuery),
-		pwResetToken:              NewPWResetTokenRepository(canQuery),
-		infra:                     NewInfraRepository(canQuery),
-		kubeIntegration:           NewKubeIntegrationRepository(canQuery),
-		basicIntegration:          NewBasicIntegrationRepository(canQuery),
-		oidcIntegration:           NewOIDCIntegrationRepository(canQuery),
-		oauthIntegration:          NewOAuthIntegrationRepository(canQuery),
-		gcpIntegration:            NewGCPIntegrationRepository(canQuery),
-		awsIntegration:            NewAWSIntegrationRepository(canQuery),
-		azIntegration:             NewAzureIntegrationRepository(),
-		githubAppInstallation:     NewGithubAppInstallationRepository(canQuery),
-		githubAppOAuthIntegration: NewGithubAppOAuthIntegrationRepository(canQuery),
-		gitlabIntegration:         NewGitlabIntegrationRepository(canQuery),
-		gitlabAppOAuthIntegration: NewGitlabAppOAuthIntegrationRepository(canQuery),
-		slackIntegration:          NewSlackIntegrationRepository(canQuery),
-		notificationConfig:        NewNotificationConfigRepository(canQuery),
-		jobNotificationConfig:     NewJobNotificationConfigRepository(canQuery),
-		buildEvent:                NewBuildEventRepository(canQuery),
-		kubeEvent:                 NewKubeEventRepository(canQuery),
-		projectUsage:              NewProjectUsageRepository(canQuery),
-		onboarding:                NewProjectOnboardingRepository(canQuery),
-		ceToken:                   NewCredentialsExchangeTokenRepository(canQuery),
-		buildConfig:               NewBuildConfigRepository(canQuery),
-		database:                  NewDatabaseRepository(),
-		allowlist:                 NewAllowlistRepository(canQuery),
-		apiToken:                  NewAPITokenRepository(canQuery),
-		policy:                    NewPolicyRepository(canQuery),
-		tag:                       NewTagRepository(),
-		stack:                     NewStackRepository(),
-		monitor:                   NewMonitorTestResultRepository(canQuery),
-		apiContractRevision:       NewAPIContractRevisioner(),
-		awsAssumeRoleChainer:      NewAWSAssumeRoleChainer(),
-		porterApp:                 NewPorterAppRepository(canQuery, failingMethods...),
-		porterAppEvent:            NewPorterAppEventRepository(canQuery),
-		deploymentTarget:          NewDeploymentTargetRepository(),
+		user:                       NewUserRepository(canQuery, failingMethods...),
+		session:                    NewSessionRepository(canQuery, failingMethods...),
+		project:                    NewProjectRepository(canQuery, failingMethods...),
+		cluster:                    NewClusterRepository(canQuery),
+		helmRepo:                   NewHelmRepoRepository(canQuery),
+		registry:                   NewRegistryRepository(canQuery),
+		gitRepo:                    NewGitRepoRepository(canQuery),
+		gitActionConfig:            NewGitActionConfigRepository(canQuery),
+		invite:                     NewInviteRepository(canQuery),
+		release:                    NewReleaseRepository(canQuery),
+		environment:                NewEnvironmentRepository(),
+		authCode:                   NewAuthCodeRepository(canQuery),
+		dnsRecord:                  NewDNSRecordRepository(canQuery),
+		pwResetToken:               NewPWResetTokenRepository(canQuery),
+		infra:                      NewInfraRepository(canQuery),
+		kubeIntegration:            NewKubeIntegrationRepository(canQuery),
+		basicIntegration:           NewBasicIntegrationRepository(canQuery),
+		oidcIntegration:            NewOIDCIntegrationRepository(canQuery),
+		oauthIntegration:           NewOAuthIntegrationRepository(canQuery),
+		gcpIntegration:             NewGCPIntegrationRepository(canQuery),
+		awsIntegration:             NewAWSIntegrationRepository(canQuery),
+		azIntegration:              NewAzureIntegrationRepository(),
+		githubAppInstallation:      NewGithubAppInstallationRepository(canQuery),
+		githubAppOAuthIntegration:  NewGithubAppOAuthIntegrationRepository(canQuery),
+		gitlabIntegration:          NewGitlabIntegrationRepository(canQuery),
+		gitlabAppOAuthIntegration:  NewGitlabAppOAuthIntegrationRepository(canQuery),
+		slackIntegration:           NewSlackIntegrationRepository(canQuery),
+		notificationConfig:         NewNotificationConfigRepository(canQuery),
+		jobNotificationConfig:      NewJobNotificationConfigRepository(canQuery),
+		buildEvent:                 NewBuildEventRepository(canQuery),
+		kubeEvent:                  NewKubeEventRepository(canQuery),
+		projectUsage:               NewProjectUsageRepository(canQuery),
+		onboarding:                 NewProjectOnboardingRepository(canQuery),
+		ceToken:                    NewCredentialsExchangeTokenRepository(canQuery),
+		buildConfig:                NewBuildConfigRepository(canQuery),
+		database:                   NewDatabaseRepository(),
+		allowlist:                  NewAllowlistRepository(canQuery),
+		apiToken:                   NewAPITokenRepository(canQuery),
+		policy:                     NewPolicyRepository(canQuery),
+		tag:                        NewTagRepository(),
+		stack:                      NewStackRepository(),
+		monitor:                    NewMonitorTestResultRepository(canQuery),
+		apiContractRevision:        NewAPIContractRevisioner(),
+		awsAssumeRoleChainer:       NewAWSAssumeRoleChainer(),
+		porterApp:                  NewPorterAppRepository(canQuery, failingMethods...),
+		porterAppEvent:             NewPorterAppEventRepository(canQuery),
+		deploymentTarget:           NewDeploymentTargetRepository(),
+		projectWebhook:             NewProjectWebhookRepository(canQuery),
+		jobRun:                     NewJobRunRepository(canQuery),
+		environmentGroupVersion:    NewEnvironmentGroupVersionRepository(canQuery),
+		externalSecretsIntegration: NewExternalSecretsIntegrationRepository(canQuery),
+		registryGCPolicy:           NewRegistryGCPolicyRepository(canQuery),
+		appRevisionMetadata:        NewAppRevisionMetadataRepository(canQuery),
+		scim:                       NewSCIMRepository(canQuery),
+		userMFA:                    NewUserMFARepository(canQuery),
+		appResourceRequest:         NewAppResourceRequestRepository(canQuery),
+		serviceAccount:             NewServiceAccountRepository(canQuery),
+		alertRule:                  NewAlertRuleRepository(canQuery),
+		eventSink:                  NewEventSinkRepository(canQuery),
+		addon:                      NewAddonRepository(canQuery),
+		datastore:                  NewDatastoreRepository(canQuery),
+		customCertificate:          NewCustomCertificateRepository(canQuery),
+		customDomain:               NewCustomDomainRepository(canQuery),
+		subdomain:                  NewSubdomainRepository(canQuery),
+		appTemplate:                NewAppTemplateRepository(canQuery),
+		imageAttestation:           NewImageAttestationRepository(canQuery),
+		imageSigningPolicy:         NewImageSigningPolicyRepository(canQuery),
 	}
 }
@@ -0,0 +1,240 @@
+package test
+
+import (
+	"context"
+	"errors"
+
+	"github.com/porter-dev/porter/internal/models"
+	"github.com/porter-dev/porter/internal/repository"
+	"gorm.io/gorm"
+)
+
+// SCIMRepository implements repository.SCIMRepository
+type SCIMRepository struct {
+	canQuery bool
+	users    []*models.SCIMUser
+	groups   []*models.SCIMGroup
+	mappings []*models.SCIMGroupRoleMapping
+}
+
+// NewSCIMRepository will return errors if canQuery is false
+func NewSCIMRepository(canQuery bool) repository.SCIMRepository {
+	return &SCIMRepository{
+		canQuery,
+		[]*models.SCIMUser{},
+		[]*models.SCIMGroup{},
+		[]*models.SCIMGroupRoleMapping{},
+	}
+}
+
+// CreateSCIMUser creates a new provisioned user
+func (repo *SCIMRepository) CreateSCIMUser(ctx context.Context, user *models.SCIMUser) (*models.SCIMUser, error) {
+	if !repo.canQuery {
+		return nil, errors.New("Cannot write database")
+	}
+
+	repo.users = append(repo.users, user)
+	user.ID = uint(len(repo.users))
+
+	return user, nil
+}
+
+// ReadSCIMUser finds a provisioned user by project id and id
+func (repo *SCIMRepository) ReadSCIMUser(ctx context.Context, projectID, id uint) (*models.SCIMUser, error) {
+	if !repo.canQuery {
+		return nil, errors.New("Cannot read from database")
+	}
+
+	if id == 0 || int(id-1) >= len(repo.users) || repo.users[id-1] == nil || repo.users[id-1].ProjectID != projectID {
+		return nil, gorm.ErrRecordNotFound
+	}
+
+	return repo.users[id-1], nil
+}
+
+// ListSCIMUsers lists the provisioned users of a project, ordered by id
+func (repo *SCIMRepository) ListSCIMUsers(ctx context.Context, projectID uint) ([]*models.SCIMUser, error) {
+	if !repo.canQuery {
+		return nil, errors.New("Cannot read from database")
+	}
+
+	res := make([]*models.SCIMUser, 0)
+	for _, user := range repo.users {
+		if user != nil && user.ProjectID == projectID {
+			res = append(res, user)
+		}
+	}
+
+	return res, nil
+}
+
+// UpdateSCIMUser modifies an existing provisioned user
+func (repo *SCIMRepository) UpdateSCIMUser(ctx context.Context, user *models.SCIMUser) (*models.SCIMUser, error) {
+	if !repo.canQuery {
+		return nil, errors.New("Cannot write database")
+	}
+
+	if user.ID == 0 || int(user.ID-1) >= len(repo.users) || repo.users[user.ID-1] == nil {
+		return nil, gorm.ErrRecordNotFound
+	}
+
+	repo.users[user.ID-1] = user
+
+	return user, nil
+}
+
+// DeleteSCIMUser deletes a provisioned user and removes it from its groups
+func (repo *SCIMRepository) DeleteSCIMUser(ctx context.Context, user *models.SCIMUser) error {
+	if !repo.canQuery {
+		return errors.New("Cannot write database")
+	}
+
+	if user.ID == 0 || int(user.ID-1) >= len(repo.users) || repo.users[user.ID-1] == nil {
+		return gorm.ErrRecordNotFound
+	}
+
+	repo.users[user.ID-1] = nil
+
+	for _, group := range repo.groups {
+		if group == nil {
+			continue
+		}
+
+		members := make([]models.SCIMUser, 0)
+		for _, member := range group.Members {
+			if member.ID != user.ID {
+				members = append(members, member)
+			}
+		}
+		group.Members = members
+	}
+
+	return nil
+}
+
+// CreateSCIMGroup creates a new group along with its members
+func (repo *SCIMRepository) CreateSCIMGroup(ctx context.Context, group *models.SCIMGroup) (*models.SCIMGroup, error) {
+	if !repo.canQuery {
+		return nil, errors.New("Cannot write database")
+	}
+
+	repo.groups = append(repo.groups, group)
+	group.ID = uint(len(repo.groups))
+
+	return group, nil
+}
+
+// ReadSCIMGroup finds a group by project id and id, along with its members
+func (repo *SCIMRepository) ReadSCIMGroup(ctx context.Context, projectID, id uint) (*models.SCIMGroup, error) {
+	if !repo.canQuery {
+		return nil, errors.New("Cannot read from database")
+	}
+
+	if id == 0 || int(id-1) >= len(repo.groups) || repo.groups[id-1] == nil || repo.groups[id-1].ProjectID != projectID {
+		return nil, gorm.ErrRecordNotFound
+	}
+
+	return repo.groups[id-1], nil
+}
+
+// ListSCIMGroups lists the groups of a project along with their members, ordered by id
+func (repo *SCIMRepository) ListSCIMGroups(ctx context.Context, projectID uint) ([]*models.SCIMGroup, error) {
+	if !repo.canQuery {
+		return nil, errors.New("Cannot read from database")
+	}
+
+	res := make([]*models.SCIMGroup, 0)
+	for _, group := range repo.groups {
+		if group != nil && group.ProjectID == projectID {
+			res = append(res, group)
+		}
+	}
+
+	return res, nil
+}
+
+// UpdateSCIMGroup modifies an existing group and replaces its members
+func (repo *SCIMRepository) UpdateSCIMGroup(ctx context.Context, group *models.SCIMGroup) (*models.SCIMGroup, error) {
+	if !repo.canQuery {
+		return nil, errors.New("Cannot write database")
+	}
+
+	if group.ID == 0 || int(group.ID-1) >= len(repo.groups) || repo.groups[group.ID-1] == nil {
+		return nil, gorm.ErrRecordNotFound
+	}
+
+	repo.groups[group.ID-1] = group
+
+	return group, nil
+}
+
+// DeleteSCIMGroup deletes a group and its memberships
+func (repo *SCIMRepository) DeleteSCIMGroup(ctx context.Context, group *models.SCIMGroup) error {
+	if !repo.canQuery {
+		return errors.New("Cannot write database")
+	}
+
+	if group.ID == 0 || int(group.ID-1) >= len(repo.groups) || repo.groups[group.ID-1] == nil {
+		return gorm.ErrRecordNotFound
+	}
+
+	repo.groups[group.ID-1] = nil
+
+	return nil
+}
+
+// CreateSCIMGroupRoleMapping creates a new group role mapping
+func (repo *SCIMRepository) CreateSCIMGroupRoleMapping(ctx context.Context, mapping *models.SCIMGroupRoleMapping) (*models.SCIMGroupRoleMapping, error) {
+	if !repo.canQuery {
+		return nil, errors.New("Cannot write database")
+	}
+
+	repo.mappings = append(repo.mappings, mapping)
+	mapping.ID = uint(len(repo.mappings))
+
+	return mapping, nil
+}
+
+// ReadSCIMGroupRoleMapping finds a group role mapping by project id and id
+func (repo *SCIMRepository) ReadSCIMGroupRoleMapping(ctx context.Context, projectID, id uint) (*models.SCIMGroupRoleMapping, error) {
+	if !repo.canQuery {
+		return nil, errors.New("Cannot read from database")
+	}
+
+	if id == 0 || int(id-1) >= len(repo.mappings) || repo.mappings[id-1] == nil || repo.mappings[id-1].ProjectID != projectID {
+		return nil, gorm.ErrRecordNotFound
+	}
+
+	return repo.mappings[id-1], nil
+}
+
+// ListSCIMGroupRoleMappings lists the group role mappings of a project
+func (repo *SCIMRepository) ListSCIMGroupRoleMappings(ctx context.Context, projectID uint) ([]*models.SCIMGroupRoleMapping, error) {
+	if !repo.canQuery {
+		return nil, errors.New("Cannot read from database")
+	}
+
+	res := make([]*models.SCIMGroupRoleMapping, 0)
+	for _, mapping := range repo.mappings {
+		if mapping != nil && mapping.ProjectID == projectID {
+			res = append(res, mapping)
+		}
+	}
+
+	return res, nil
+}
+
+// DeleteSCIMGroupRoleMapping deletes a group role mapping
+func (repo *SCIMRepository) DeleteSCIMGroupRoleMapping(ctx context.Context, mapping *models.SCIMGroupRoleMapping) error {
+	if !repo.canQuery {
+		return errors.New("Cannot write database")
+	}
+
+	if mapping.ID == 0 || int(mapping.ID-1) >= len(repo.mappings) || repo.mappings[mapping.ID-1] == nil {
+		return gorm.ErrRecordNotFound
+	}
+
+	repo.mappings[mapping.ID-1] = nil
+
+	return nil
+}
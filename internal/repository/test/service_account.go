@@ -0,0 +1,110 @@
+package test
+
+import (
+	"context"
+	"errors"
+
+	"github.com/porter-dev/porter/internal/models"
+	"github.com/porter-dev/porter/internal/repository"
+	"gorm.io/gorm"
+)
+
+// ServiceAccountRepository implements repository.ServiceAccountRepository
+type ServiceAccountRepository struct {
+	canQuery        bool
+	serviceAccounts []*models.ServiceAccount
+}
+
+// NewServiceAccountRepository will return errors if canQuery is false
+func NewServiceAccountRepository(canQuery bool) repository.ServiceAccountRepository {
+	return &ServiceAccountRepository{
+		canQuery,
+		[]*models.ServiceAccount{},
+	}
+}
+
+// CreateServiceAccount creates a new service account
+func (repo *ServiceAccountRepository) CreateServiceAccount(ctx context.Context, sa *models.ServiceAccount) (*models.ServiceAccount, error) {
+	if !repo.canQuery {
+		return nil, errors.New("Cannot write database")
+	}
+
+	repo.serviceAccounts = append(repo.serviceAccounts, sa)
+	sa.ID = uint(len(repo.serviceAccounts))
+
+	return sa, nil
+}
+
+// ReadServiceAccount finds a service account of a project by id
+func (repo *ServiceAccountRepository) ReadServiceAccount(ctx context.Context, projectID, id uint) (*models.ServiceAccount, error) {
+	if !repo.canQuery {
+		return nil, errors.New("Cannot read from database")
+	}
+
+	if int(id-1) >= len(repo.serviceAccounts) || repo.serviceAccounts[id-1] == nil || repo.serviceAccounts[id-1].ProjectID != projectID {
+		return nil, gorm.ErrRecordNotFound
+	}
+
+	return repo.serviceAccounts[id-1], nil
+}
+
+// ReadServiceAccountByUserID finds the service account of a service account user
+func (repo *ServiceAccountRepository) ReadServiceAccountByUserID(ctx context.Context, userID uint) (*models.ServiceAccount, error) {
+	if !repo.canQuery {
+		return nil, errors.New("Cannot read from database")
+	}
+
+	for _, sa := range repo.serviceAccounts {
+		if sa != nil && sa.UserID == userID {
+			return sa, nil
+		}
+	}
+
+	return nil, gorm.ErrRecordNotFound
+}
+
+// ListServiceAccountsByProjectID lists the service accounts of a project
+func (repo *ServiceAccountRepository) ListServiceAccountsByProjectID(ctx context.Context, projectID uint) ([]*models.ServiceAccount, error) {
+	if !repo.canQuery {
+		return nil, errors.New("Cannot read from database")
+	}
+
+	res := make([]*models.ServiceAccount, 0)
+	for _, sa := range repo.serviceAccounts {
+		if sa != nil && sa.ProjectID == projectID {
+			res = append(res, sa)
+		}
+	}
+
+	return res, nil
+}
+
+// UpdateServiceAccount updates an existing service account
+func (repo *ServiceAccountRepository) UpdateServiceAccount(ctx context.Context, sa *models.ServiceAccount) (*models.ServiceAccount, error) {
+	if !repo.canQuery {
+		return nil, errors.New("Cannot write database")
+	}
+
+	if int(sa.ID-1) >= len(repo.serviceAccounts) || repo.serviceAccounts[sa.ID-1] == nil {
+		return nil, gorm.ErrRecordNotFound
+	}
+
+	repo.serviceAccounts[sa.ID-1] = sa
+
+	return sa, nil
+}
+
+// DeleteServiceAccount deletes a service account
+func (repo *ServiceAccountRepository) DeleteServiceAccount(ctx context.Context, sa *models.ServiceAccount) (*models.ServiceAccount, error) {
+	if !repo.canQuery {
+		return nil, errors.New("Cannot write database")
+	}
+
+	if int(sa.ID-1) >= len(repo.serviceAccounts) || repo.serviceAccounts[sa.ID-1] == nil {
+		return nil, gorm.ErrRecordNotFound
+	}
+
+	repo.serviceAccounts[sa.ID-1] = nil
+
+	return sa, nil
+}
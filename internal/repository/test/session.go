@@ -1,6 +1,7 @@
 package test
 
 import (
+	"context"
 	"errors"
 	"strings"
 
@@ -27,7 +28,7 @@ func NewSessionRepository(canQuery bool, failingMethods ...string) repository.Se
 }
 
 // CreateSession must take in Key, Data, and ExpiresAt as arguments.
-func (repo *SessionRepository) CreateSession(session *models.Session) (*models.Session, error) {
+func (repo *SessionRepository) CreateSession(ctx context.Context, session *models.Session) (*models.Session, error) {
 	if !repo.canQuery || strings.Contains(repo.failingMethods, CreateSessionMethod) {
 		return nil, errors.New("Cannot write database")
 	}
@@ -48,7 +49,7 @@ func (repo *SessionRepository) CreateSession(session *models.Session) (*models.S
 }
 
 // UpdateSession updates only the Data field using Key as selector.
-func (repo *SessionRepository) UpdateSession(session *models.Session) (*models.Session, error) {
+func (repo *SessionRepository) UpdateSession(ctx context.Context, session *models.Session) (*models.Session, error) {
 	if !repo.canQuery {
 		return nil, errors.New("Cannot write database")
 	}
@@ -71,7 +72,7 @@ func (repo *SessionRepository) UpdateSession(session *models.Session) (*models.S
 }
 
 // DeleteSession deletes a session by Key
-func (repo *SessionRepository) DeleteSession(session *models.Session) (*models.Session, error) {
+func (repo *SessionRepository) DeleteSession(ctx context.Context, session *models.Session) (*models.Session, error) {
 	if !repo.canQuery {
 		return nil, errors.New("Cannot write database")
 	}
@@ -87,7 +88,7 @@ func (repo *SessionRepository) DeleteSession(session *models.Session) (*models.S
 }
 
 // SelectSession returns a session with matching key
-func (repo *SessionRepository) SelectSession(session *models.Session) (*models.Session, error) {
+func (repo *SessionRepository) SelectSession(ctx context.Context, session *models.Session) (*models.Session, error) {
 	if !repo.canQuery || strings.Contains(repo.failingMethods, SelectSessionMethod) {
 		return nil, errors.New("Cannot write database")
 	}
@@ -1,24 +1,49 @@
 package test
 
 import (
+	"context"
+	"errors"
+
 	ints "github.com/porter-dev/porter/internal/models/integrations"
 	"github.com/porter-dev/porter/internal/repository"
 )
 
-type SlackIntegrationRepository struct{}
+type SlackIntegrationRepository struct {
+	canQuery  bool
+	slackInts []*ints.SlackIntegration
+}
 
 func NewSlackIntegrationRepository(canQuery bool) repository.SlackIntegrationRepository {
-	return &SlackIntegrationRepository{}
+	return &SlackIntegrationRepository{canQuery: canQuery}
 }
 
-func (s *SlackIntegrationRepository) CreateSlackIntegration(slackInt *ints.SlackIntegration) (*ints.SlackIntegration, error) {
-	panic("not implemented") // TODO: Implement
+func (s *SlackIntegrationRepository) CreateSlackIntegration(ctx context.Context, slackInt *ints.SlackIntegration) (*ints.SlackIntegration, error) {
+	if !s.canQuery {
+		return nil, errors.New("Cannot write database")
+	}
+
+	s.slackInts = append(s.slackInts, slackInt)
+	slackInt.ID = uint(len(s.slackInts))
+
+	return slackInt, nil
 }
 
-func (s *SlackIntegrationRepository) ListSlackIntegrationsByProjectID(projectID uint) ([]*ints.SlackIntegration, error) {
-	panic("not implemented") // TODO: Implement
+func (s *SlackIntegrationRepository) ListSlackIntegrationsByProjectID(ctx context.Context, projectID uint) ([]*ints.SlackIntegration, error) {
+	if !s.canQuery {
+		return nil, errors.New("Cannot read from database")
+	}
+
+	res := make([]*ints.SlackIntegration, 0)
+
+	for _, slackInt := range s.slackInts {
+		if slackInt.ProjectID == projectID {
+			res = append(res, slackInt)
+		}
+	}
+
+	return res, nil
 }
 
-func (s *SlackIntegrationRepository) DeleteSlackIntegration(integrationID uint) error {
+func (s *SlackIntegrationRepository) DeleteSlackIntegration(ctx context.Context, integrationID uint) error {
 	panic("not implemented") // TODO: Implement
 }
@@ -1,6 +1,8 @@
 package test
 
 import (
+	"context"
+
 	"github.com/porter-dev/porter/internal/models"
 	"github.com/porter-dev/porter/internal/repository"
 )
@@ -12,57 +14,57 @@ func NewStackRepository() repository.StackRepository {
 }
 
 // CreateStack creates a new stack
-func (repo *StackRepository) CreateStack(stack *models.Stack) (*models.Stack, error) {
+func (repo *StackRepository) CreateStack(ctx context.Context, stack *models.Stack) (*models.Stack, error) {
 	panic("unimplemented")
 }
 
 // ReadStack gets a stack specified by its string id
-func (repo *StackRepository) ListStacks(projectID, clusterID uint, namespace string) ([]*models.Stack, error) {
+func (repo *StackRepository) ListStacks(ctx context.Context, projectID, clusterID uint, namespace string) ([]*models.Stack, error) {
 	panic("unimplemented")
 }
 
-func (repo *StackRepository) ReadStackByID(projectID, stackID uint) (*models.Stack, error) {
+func (repo *StackRepository) ReadStackByID(ctx context.Context, projectID, stackID uint) (*models.Stack, error) {
 	panic("unimplemented")
 }
 
 // ReadStack gets a stack specified by its string id
-func (repo *StackRepository) ReadStackByStringID(projectID uint, stackID string) (*models.Stack, error) {
+func (repo *StackRepository) ReadStackByStringID(ctx context.Context, projectID uint, stackID string) (*models.Stack, error) {
 	panic("unimplemented")
 }
 
 // DeleteStack creates a new stack
-func (repo *StackRepository) DeleteStack(stack *models.Stack) (*models.Stack, error) {
+func (repo *StackRepository) DeleteStack(ctx context.Context, stack *models.Stack) (*models.Stack, error) {
 	panic("unimplemented")
 }
 
-func (repo *StackRepository) UpdateStack(stack *models.Stack) (*models.Stack, error) {
+func (repo *StackRepository) UpdateStack(ctx context.Context, stack *models.Stack) (*models.Stack, error) {
 	panic("unimplemented")
 }
 
-func (repo *StackRepository) UpdateStackRevision(revision *models.StackRevision) (*models.StackRevision, error) {
+func (repo *StackRepository) UpdateStackRevision(ctx context.Context, revision *models.StackRevision) (*models.StackRevision, error) {
 	panic("unimplemented")
 }
 
-func (repo *StackRepository) ReadStackRevision(stackRevisionID uint) (*models.StackRevision, error) {
+func (repo *StackRepository) ReadStackRevision(ctx context.Context, stackRevisionID uint) (*models.StackRevision, error) {
 	panic("unimplemented")
 }
 
-func (repo *StackRepository) ReadStackRevisionByNumber(stackID uint, revisionNumber uint) (*models.StackRevision, error) {
+func (repo *StackRepository) ReadStackRevisionByNumber(ctx context.Context, stackID uint, revisionNumber uint) (*models.StackRevision, error) {
 	panic("unimplemented")
 }
 
-func (repo *StackRepository) AppendNewRevision(revision *models.StackRevision) (*models.StackRevision, error) {
+func (repo *StackRepository) AppendNewRevision(ctx context.Context, revision *models.StackRevision) (*models.StackRevision, error) {
 	panic("unimplemented")
 }
 
-func (repo *StackRepository) ReadStackResource(resourceID uint) (*models.StackResource, error) {
+func (repo *StackRepository) ReadStackResource(ctx context.Context, resourceID uint) (*models.StackResource, error) {
 	panic("unimplemented")
 }
 
-func (repo *StackRepository) UpdateStackResource(resource *models.StackResource) (*models.StackResource, error) {
+func (repo *StackRepository) UpdateStackResource(ctx context.Context, resource *models.StackResource) (*models.StackResource, error) {
 	panic("unimplemented")
 }
 
-func (repo *StackRepository) ReadStackEnvGroupFirstMatch(projectID, clusterID uint, namespace, name string) (*models.StackEnvGroup, error) {
+func (repo *StackRepository) ReadStackEnvGroupFirstMatch(ctx context.Context, projectID, clusterID uint, namespace, name string) (*models.StackEnvGroup, error) {
 	panic("unimplemented")
 }
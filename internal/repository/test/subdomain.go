@@ -0,0 +1,194 @@
+package test
+
+import (
+	"context"
+	"errors"
+
+	"github.com/porter-dev/porter/internal/models"
+	"github.com/porter-dev/porter/internal/repository"
+	"gorm.io/gorm"
+)
+
+// SubdomainRepository implements repository.SubdomainRepository
+type SubdomainRepository struct {
+	canQuery        bool
+	wildcardDomains []*models.WildcardDomain
+	allocations     []*models.SubdomainAllocation
+}
+
+// NewSubdomainRepository will return errors if canQuery is false
+func NewSubdomainRepository(canQuery bool) repository.SubdomainRepository {
+	return &SubdomainRepository{
+		canQuery,
+		[]*models.WildcardDomain{},
+		[]*models.SubdomainAllocation{},
+	}
+}
+
+// ReadWildcardDomain finds the wildcard domain of a project
+func (repo *SubdomainRepository) ReadWildcardDomain(ctx context.Context, projectID uint) (*models.WildcardDomain, error) {
+	if !repo.canQuery {
+		return nil, errors.New("Cannot read from database")
+	}
+
+	for _, domain := range repo.wildcardDomains {
+		if domain != nil && domain.ProjectID == projectID {
+			return domain, nil
+		}
+	}
+
+	return nil, gorm.ErrRecordNotFound
+}
+
+// SaveWildcardDomain creates the wildcard domain of a project, or updates it if it exists
+func (repo *SubdomainRepository) SaveWildcardDomain(ctx context.Context, domain *models.WildcardDomain) (*models.WildcardDomain, error) {
+	if !repo.canQuery {
+		return nil, errors.New("Cannot write database")
+	}
+
+	for i, existing := range repo.wildcardDomains {
+		if existing != nil && existing.ProjectID == domain.ProjectID {
+			domain.ID = existing.ID
+			repo.wildcardDomains[i] = domain
+
+			return domain, nil
+		}
+	}
+
+	repo.wildcardDomains = append(repo.wildcardDomains, domain)
+	domain.ID = uint(len(repo.wildcardDomains))
+
+	return domain, nil
+}
+
+// DeleteWildcardDomain deletes the wildcard domain of a project and releases its subdomains
+func (repo *SubdomainRepository) DeleteWildcardDomain(ctx context.Context, domain *models.WildcardDomain) error {
+	if !repo.canQuery {
+		return errors.New("Cannot write database")
+	}
+
+	if int(domain.ID-1) >= len(repo.wildcardDomains) || repo.wildcardDomains[domain.ID-1] == nil {
+		return gorm.ErrRecordNotFound
+	}
+
+	repo.wildcardDomains[domain.ID-1] = nil
+
+	for i, allocation := range repo.allocations {
+		if allocation != nil && allocation.ProjectID == domain.ProjectID {
+			repo.allocations[i] = nil
+		}
+	}
+
+	return nil
+}
+
+// CreateSubdomainAllocation allocates a subdomain, and fails if the name is already allocated in the project
+func (repo *SubdomainRepository) CreateSubdomainAllocation(ctx context.Context, allocation *models.SubdomainAllocation) (*models.SubdomainAllocation, error) {
+	if !repo.canQuery {
+		return nil, errors.New("Cannot write database")
+	}
+
+	for _, existing := range repo.allocations {
+		if existing != nil && existing.ProjectID == allocation.ProjectID && existing.Name == allocation.Name {
+			return nil, errors.New("duplicate key value violates unique constraint")
+		}
+	}
+
+	repo.allocations = append(repo.allocations, allocation)
+	allocation.ID = uint(len(repo.allocations))
+
+	return allocation, nil
+}
+
+// ReadSubdomainAllocation finds a subdomain allocated in a project by name
+func (repo *SubdomainRepository) ReadSubdomainAllocation(ctx context.Context, projectID uint, name string) (*models.SubdomainAllocation, error) {
+	if !repo.canQuery {
+		return nil, errors.New("Cannot read from database")
+	}
+
+	for _, allocation := range repo.allocations {
+		if allocation != nil && allocation.ProjectID == projectID && allocation.Name == name {
+			return allocation, nil
+		}
+	}
+
+	return nil, gorm.ErrRecordNotFound
+}
+
+// ReadSubdomainAllocationByService finds the subdomain allocated to a service of an app on a deployment target
+func (repo *SubdomainRepository) ReadSubdomainAllocationByService(ctx context.Context, clusterID uint, appName, serviceName, deploymentTargetID string) (*models.SubdomainAllocation, error) {
+	if !repo.canQuery {
+		return nil, errors.New("Cannot read from database")
+	}
+
+	for _, allocation := range repo.allocations {
+		if allocation != nil && allocation.ClusterID == clusterID && allocation.AppName == appName &&
+			allocation.ServiceName == serviceName && allocation.DeploymentTargetID == deploymentTargetID {
+			return allocation, nil
+		}
+	}
+
+	return nil, gorm.ErrRecordNotFound
+}
+
+// ListSubdomainAllocations lists the subdomains allocated in a project
+func (repo *SubdomainRepository) ListSubdomainAllocations(ctx context.Context, projectID uint) ([]*models.SubdomainAllocation, error) {
+	if !repo.canQuery {
+		return nil, errors.New("Cannot read from database")
+	}
+
+	res := make([]*models.SubdomainAllocation, 0)
+
+	for _, allocation := range repo.allocations {
+		if allocation != nil && allocation.ProjectID == projectID {
+			res = append(res, allocation)
+		}
+	}
+
+	return res, nil
+}
+
+// DeleteSubdomainAllocation releases a subdomain
+func (repo *SubdomainRepository) DeleteSubdomainAllocation(ctx context.Context, allocation *models.SubdomainAllocation) error {
+	if !repo.canQuery {
+		return errors.New("Cannot write database")
+	}
+
+	if int(allocation.ID-1) >= len(repo.allocations) || repo.allocations[allocation.ID-1] == nil {
+		return gorm.ErrRecordNotFound
+	}
+
+	repo.allocations[allocation.ID-1] = nil
+
+	return nil
+}
+
+// DeleteSubdomainAllocationsByApp releases the subdomains allocated to an app
+func (repo *SubdomainRepository) DeleteSubdomainAllocationsByApp(ctx context.Context, clusterID uint, appName string) error {
+	if !repo.canQuery {
+		return errors.New("Cannot write database")
+	}
+
+	for i, allocation := range repo.allocations {
+		if allocation != nil && allocation.ClusterID == clusterID && allocation.AppName == appName {
+			repo.allocations[i] = nil
+		}
+	}
+
+	return nil
+}
+
+// DeleteSubdomainAllocationsByDeploymentTarget releases the subdomains allocated to a preview environment
+func (repo *SubdomainRepository) DeleteSubdomainAllocationsByDeploymentTarget(ctx context.Context, deploymentTargetID string) error {
+	if !repo.canQuery {
+		return errors.New("Cannot write database")
+	}
+
+	for i, allocation := range repo.allocations {
+		if allocation != nil && allocation.DeploymentTargetID == deploymentTargetID {
+			repo.allocations[i] = nil
+		}
+	}
+
+	return nil
+}
@@ -1,6 +1,8 @@
 package test
 
 import (
+	"context"
+
 	"github.com/porter-dev/porter/internal/models"
 	"github.com/porter-dev/porter/internal/repository"
 )
@@ -11,30 +13,30 @@ func NewTagRepository() repository.TagRepository {
 	return &TagRepository{}
 }
 
-func (repo *TagRepository) CreateTag(tag *models.Tag) (*models.Tag, error) {
+func (repo *TagRepository) CreateTag(ctx context.Context, tag *models.Tag) (*models.Tag, error) {
 	panic("not implemented")
 }
 
-func (repo *TagRepository) ReadTagByNameAndProjectId(tagName string, projectID uint) (*models.Tag, error) {
+func (repo *TagRepository) ReadTagByNameAndProjectId(ctx context.Context, tagName string, projectID uint) (*models.Tag, error) {
 	panic("not implemented")
 }
 
-func (repo *TagRepository) ListTagsByProjectId(projectId uint) ([]*models.Tag, error) {
+func (repo *TagRepository) ListTagsByProjectId(ctx context.Context, projectId uint) ([]*models.Tag, error) {
 	panic("not implemented")
 }
 
-func (repo *TagRepository) UpdateTag(tag *models.Tag) (*models.Tag, error) {
+func (repo *TagRepository) UpdateTag(ctx context.Context, tag *models.Tag) (*models.Tag, error) {
 	panic("not implemented")
 }
 
-func (repo *TagRepository) DeleteTag(id uint) error {
+func (repo *TagRepository) DeleteTag(ctx context.Context, id uint) error {
 	panic("not implemented")
 }
 
-func (repo *TagRepository) UnlinkTagsFromRelease(tags []string, release *models.Release) error {
+func (repo *TagRepository) UnlinkTagsFromRelease(ctx context.Context, tags []string, release *models.Release) error {
 	panic("not implemented")
 }
 
-func (repo *TagRepository) LinkTagsToRelease(tags []string, release *models.Release) ([]*models.Tag, error) {
+func (repo *TagRepository) LinkTagsToRelease(ctx context.Context, tags []string, release *models.Release) ([]*models.Tag, error) {
 	panic("not implemented")
 }
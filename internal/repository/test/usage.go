@@ -1,6 +1,7 @@
 package test
 
 import (
+	"context"
 	"errors"
 
 	"github.com/porter-dev/porter/internal/models"
@@ -26,6 +27,7 @@ func NewProjectUsageRepository(canQuery bool) repository.ProjectUsageRepository
 
 // CreateProjectUsage creates a new project usage limit
 func (repo *ProjectUsageRepository) CreateProjectUsage(
+	ctx context.Context,
 	usage *models.ProjectUsage,
 ) (*models.ProjectUsage, error) {
 	if !repo.canQuery {
@@ -43,6 +45,7 @@ func (repo *ProjectUsageRepository) CreateProjectUsage(
 
 // CreateProjectUsage reads a project usage by project id
 func (repo *ProjectUsageRepository) ReadProjectUsage(
+	ctx context.Context,
 	projID uint,
 ) (*models.ProjectUsage, error) {
 	if !repo.canQuery {
@@ -60,6 +63,7 @@ func (repo *ProjectUsageRepository) ReadProjectUsage(
 
 // UpdateProjectUsage modifies an existing ProjectUsage in the database
 func (repo *ProjectUsageRepository) UpdateProjectUsage(
+	ctx context.Context,
 	usage *models.ProjectUsage,
 ) (*models.ProjectUsage, error) {
 	if !repo.canQuery {
@@ -78,6 +82,7 @@ func (repo *ProjectUsageRepository) UpdateProjectUsage(
 
 // CreateProjectUsageCache creates a new project usage cache
 func (repo *ProjectUsageRepository) CreateProjectUsageCache(
+	ctx context.Context,
 	cache *models.ProjectUsageCache,
 ) (*models.ProjectUsageCache, error) {
 	if !repo.canQuery {
@@ -95,6 +100,7 @@ func (repo *ProjectUsageRepository) CreateProjectUsageCache(
 
 // CreateProjectUsageCache reads a project usage by project id
 func (repo *ProjectUsageRepository) ReadProjectUsageCache(
+	ctx context.Context,
 	projID uint,
 ) (*models.ProjectUsageCache, error) {
 	if !repo.canQuery {
@@ -112,6 +118,7 @@ func (repo *ProjectUsageRepository) ReadProjectUsageCache(
 
 // UpdateProjectUsageCache modifies an existing ProjectUsageCache in the database
 func (repo *ProjectUsageRepository) UpdateProjectUsageCache(
+	ctx context.Context,
 	cache *models.ProjectUsageCache,
 ) (*models.ProjectUsageCache, error) {
 	if !repo.canQuery {
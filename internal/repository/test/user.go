@@ -1,6 +1,7 @@
 package test
 
 import (
+	"context"
 	"errors"
 	"strings"
 
@@ -32,7 +33,7 @@ func NewUserRepository(canQuery bool, failingMethods ...string) repository.UserR
 }
 
 // CreateUser adds a new User row to the Users table in array memory
-func (repo *UserRepository) CreateUser(user *models.User) (*models.User, error) {
+func (repo *UserRepository) CreateUser(ctx context.Context, user *models.User) (*models.User, error) {
 	if !repo.canQuery || strings.Contains(repo.failingMethods, CreateUserMethod) {
 		return nil, errors.New("Cannot write database")
 	}
@@ -52,7 +53,7 @@ func (repo *UserRepository) CreateUser(user *models.User) (*models.User, error)
 }
 
 // ReadUser finds a single user based on their unique id
-func (repo *UserRepository) ReadUser(id uint) (*models.User, error) {
+func (repo *UserRepository) ReadUser(ctx context.Context, id uint) (*models.User, error) {
 	if !repo.canQuery || strings.Contains(repo.failingMethods, ReadUserMethod) {
 		return nil, errors.New("Cannot read from database")
 	}
@@ -65,7 +66,7 @@ func (repo *UserRepository) ReadUser(id uint) (*models.User, error) {
 	return repo.users[index], nil
 }
 
-func (repo *UserRepository) ListUsersByIDs(ids []uint) ([]*models.User, error) {
+func (repo *UserRepository) ListUsersByIDs(ctx context.Context, ids []uint) ([]*models.User, error) {
 	if !repo.canQuery {
 		return nil, errors.New("Cannot read from database")
 	}
@@ -85,7 +86,7 @@ func (repo *UserRepository) ListUsersByIDs(ids []uint) ([]*models.User, error) {
 }
 
 // ReadUserByEmail finds a single user based on their unique email
-func (repo *UserRepository) ReadUserByEmail(email string) (*models.User, error) {
+func (repo *UserRepository) ReadUserByEmail(ctx context.Context, email string) (*models.User, error) {
 	if !repo.canQuery || strings.Contains(repo.failingMethods, ReadUserByEmailMethod) {
 		return nil, errors.New("Cannot read from database")
 	}
@@ -100,7 +101,7 @@ func (repo *UserRepository) ReadUserByEmail(email string) (*models.User, error)
 }
 
 // ReadUserByGithubUserID finds a single user based on their github id field
-func (repo *UserRepository) ReadUserByGithubUserID(id int64) (*models.User, error) {
+func (repo *UserRepository) ReadUserByGithubUserID(ctx context.Context, id int64) (*models.User, error) {
 	if !repo.canQuery {
 		return nil, errors.New("Cannot read from database")
 	}
@@ -115,7 +116,7 @@ func (repo *UserRepository) ReadUserByGithubUserID(id int64) (*models.User, erro
 }
 
 // ReadUserByGoogleUserID finds a single user based on their github id field
-func (repo *UserRepository) ReadUserByGoogleUserID(id string) (*models.User, error) {
+func (repo *UserRepository) ReadUserByGoogleUserID(ctx context.Context, id string) (*models.User, error) {
 	if !repo.canQuery {
 		return nil, errors.New("Cannot read from database")
 	}
@@ -130,7 +131,7 @@ func (repo *UserRepository) ReadUserByGoogleUserID(id string) (*models.User, err
 }
 
 // UpdateUser modifies an existing User in the database
-func (repo *UserRepository) UpdateUser(user *models.User) (*models.User, error) {
+func (repo *UserRepository) UpdateUser(ctx context.Context, user *models.User) (*models.User, error) {
 	if !repo.canQuery {
 		return nil, errors.New("Cannot write database")
 	}
@@ -149,7 +150,7 @@ func (repo *UserRepository) UpdateUser(user *models.User) (*models.User, error)
 }
 
 // DeleteUser deletes a single user using their unique id
-func (repo *UserRepository) DeleteUser(user *models.User) (*models.User, error) {
+func (repo *UserRepository) DeleteUser(ctx context.Context, user *models.User) (*models.User, error) {
 	if !repo.canQuery || strings.Contains(repo.failingMethods, DeleteUserMethod) {
 		return nil, errors.New("Cannot write database")
 	}
@@ -165,7 +166,7 @@ func (repo *UserRepository) DeleteUser(user *models.User) (*models.User, error)
 }
 
 // CheckPassword checks the input password is correct for the provided user id.
-func (repo *UserRepository) CheckPassword(id int, pwd string) (bool, error) {
+func (repo *UserRepository) CheckPassword(ctx context.Context, id int, pwd string) (bool, error) {
 	if !repo.canQuery {
 		return false, errors.New("Cannot write database")
 	}
@@ -0,0 +1,87 @@
+package test
+
+import (
+	"context"
+	"errors"
+
+	"github.com/porter-dev/porter/internal/models"
+	"github.com/porter-dev/porter/internal/repository"
+	"gorm.io/gorm"
+)
+
+// UserMFARepository implements repository.UserMFARepository
+type UserMFARepository struct {
+	canQuery bool
+	mfas     []*models.UserMFA
+}
+
+// NewUserMFARepository will return errors if canQuery is false
+func NewUserMFARepository(canQuery bool) repository.UserMFARepository {
+	return &UserMFARepository{
+		canQuery,
+		[]*models.UserMFA{},
+	}
+}
+
+// CreateUserMFA creates a new MFA enrollment for a user
+func (repo *UserMFARepository) CreateUserMFA(ctx context.Context, mfa *models.UserMFA) (*models.UserMFA, error) {
+	if !repo.canQuery {
+		return nil, errors.New("Cannot write database")
+	}
+
+	for _, existing := range repo.mfas {
+		if existing != nil && existing.UserID == mfa.UserID {
+			return nil, errors.New("user already has an mfa enrollment")
+		}
+	}
+
+	repo.mfas = append(repo.mfas, mfa)
+	mfa.ID = uint(len(repo.mfas))
+
+	return mfa, nil
+}
+
+// ReadUserMFA finds the MFA enrollment of a user
+func (repo *UserMFARepository) ReadUserMFA(ctx context.Context, userID uint) (*models.UserMFA, error) {
+	if !repo.canQuery {
+		return nil, errors.New("Cannot read from database")
+	}
+
+	for _, mfa := range repo.mfas {
+		if mfa != nil && mfa.UserID == userID {
+			return mfa, nil
+		}
+	}
+
+	return nil, gorm.ErrRecordNotFound
+}
+
+// UpdateUserMFA updates the MFA enrollment of a user
+func (repo *UserMFARepository) UpdateUserMFA(ctx context.Context, mfa *models.UserMFA) (*models.UserMFA, error) {
+	if !repo.canQuery {
+		return nil, errors.New("Cannot write database")
+	}
+
+	if int(mfa.ID-1) >= len(repo.mfas) || repo.mfas[mfa.ID-1] == nil {
+		return nil, gorm.ErrRecordNotFound
+	}
+
+	repo.mfas[mfa.ID-1] = mfa
+
+	return mfa, nil
+}
+
+// DeleteUserMFA deletes the MFA enrollment of a user
+func (repo *UserMFARepository) DeleteUserMFA(ctx context.Context, mfa *models.UserMFA) error {
+	if !repo.canQuery {
+		return errors.New("Cannot write database")
+	}
+
+	if int(mfa.ID-1) >= len(repo.mfas) || repo.mfas[mfa.ID-1] == nil {
+		return gorm.ErrRecordNotFound
+	}
+
+	repo.mfas[mfa.ID-1] = nil
+
+	return nil
+}
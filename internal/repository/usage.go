@@ -1,13 +1,17 @@
 package repository
 
-import "github.com/porter-dev/porter/internal/models"
+import (
+	"context"
+
+	"github.com/porter-dev/porter/internal/models"
+)
 
 // ProjectUsageRepository represents the set of queries on the ProjectUsage model
 type ProjectUsageRepository interface {
-	CreateProjectUsage(usage *models.ProjectUsage) (*models.ProjectUsage, error)
-	ReadProjectUsage(projID uint) (*models.ProjectUsage, error)
-	UpdateProjectUsage(cache *models.ProjectUsage) (*models.ProjectUsage, error)
-	CreateProjectUsageCache(cache *models.ProjectUsageCache) (*models.ProjectUsageCache, error)
-	ReadProjectUsageCache(projID uint) (*models.ProjectUsageCache, error)
-	UpdateProjectUsageCache(cache *models.ProjectUsageCache) (*models.ProjectUsageCache, error)
+	CreateProjectUsage(ctx context.Context, usage *models.ProjectUsage) (*models.ProjectUsage, error)
+	ReadProjectUsage(ctx context.Context, projID uint) (*models.ProjectUsage, error)
+	UpdateProjectUsage(ctx context.Context, cache *models.ProjectUsage) (*models.ProjectUsage, error)
+	CreateProjectUsageCache(ctx context.Context, cache *models.ProjectUsageCache) (*models.ProjectUsageCache, error)
+	ReadProjectUsageCache(ctx context.Context, projID uint) (*models.ProjectUsageCache, error)
+	UpdateProjectUsageCache(ctx context.Context, cache *models.ProjectUsageCache) (*models.ProjectUsageCache, error)
 }
@@ -1,6 +1,8 @@
 package repository
 
 import (
+	"context"
+
 	"github.com/porter-dev/porter/internal/models"
 )
 
@@ -9,13 +11,13 @@ type WriteUser func(user *models.User) (*models.User, error)
 
 // UserRepository represents the set of queries on the User model
 type UserRepository interface {
-	CreateUser(user *models.User) (*models.User, error)
-	CheckPassword(id int, pwd string) (bool, error)
-	ReadUser(id uint) (*models.User, error)
-	ReadUserByEmail(email string) (*models.User, error)
-	ReadUserByGithubUserID(id int64) (*models.User, error)
-	ReadUserByGoogleUserID(id string) (*models.User, error)
-	ListUsersByIDs(ids []uint) ([]*models.User, error)
-	UpdateUser(user *models.User) (*models.User, error)
-	DeleteUser(user *models.User) (*models.User, error)
+	CreateUser(ctx context.Context, user *models.User) (*models.User, error)
+	CheckPassword(ctx context.Context, id int, pwd string) (bool, error)
+	ReadUser(ctx context.Context, id uint) (*models.User, error)
+	ReadUserByEmail(ctx context.Context, email string) (*models.User, error)
+	ReadUserByGithubUserID(ctx context.Context, id int64) (*models.User, error)
+	ReadUserByGoogleUserID(ctx context.Context, id string) (*models.User, error)
+	ListUsersByIDs(ctx context.Context, ids []uint) ([]*models.User, error)
+	UpdateUser(ctx context.Context, user *models.User) (*models.User, error)
+	DeleteUser(ctx context.Context, user *models.User) (*models.User, error)
 }
@@ -0,0 +1,15 @@
+package repository
+
+import (
+	"context"
+
+	"github.com/porter-dev/porter/internal/models"
+)
+
+// UserMFARepository represents the set of queries on the UserMFA model
+type UserMFARepository interface {
+	CreateUserMFA(ctx context.Context, mfa *models.UserMFA) (*models.UserMFA, error)
+	ReadUserMFA(ctx context.Context, userID uint) (*models.UserMFA, error)
+	UpdateUserMFA(ctx context.Context, mfa *models.UserMFA) (*models.UserMFA, error)
+	DeleteUserMFA(ctx context.Context, mfa *models.UserMFA) error
+}
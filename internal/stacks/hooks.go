@@ -1,6 +1,7 @@
 package stacks
 
 import (
+	"context"
 	"errors"
 	"fmt"
 
@@ -10,9 +11,9 @@ import (
 	"gorm.io/gorm"
 )
 
-func UpdateHelmRevision(config *config.Config, projID, clusterID uint, rel *release.Release) error {
+func UpdateHelmRevision(ctx context.Context, config *config.Config, projID, clusterID uint, rel *release.Release) error {
 	// read release by stack ID
-	relModel, err := config.Repo.Release().ReadRelease(clusterID, rel.Name, rel.Namespace)
+	relModel, err := config.Repo.Release().ReadRelease(ctx, clusterID, rel.Name, rel.Namespace)
 	if err != nil {
 		return err
 	}
@@ -21,19 +22,19 @@ func UpdateHelmRevision(config *config.Config, projID, clusterID uint, rel *rele
 		return nil
 	}
 
-	stackResource, err := config.Repo.Stack().ReadStackResource(relModel.StackResourceID)
+	stackResource, err := config.Repo.Stack().ReadStackResource(ctx, relModel.StackResourceID)
 	if err != nil {
 		return err
 	}
 
 	// read the revision number corresponding and create a new revision of the stack
-	oldStackRevision, err := config.Repo.Stack().ReadStackRevision(stackResource.StackRevisionID)
+	oldStackRevision, err := config.Repo.Stack().ReadStackRevision(ctx, stackResource.StackRevisionID)
 	if err != nil {
 		return err
 	}
 
 	// get the latest revision for that stack
-	stack, err := config.Repo.Stack().ReadStackByID(projID, oldStackRevision.StackID)
+	stack, err := config.Repo.Stack().ReadStackByID(ctx, projID, oldStackRevision.StackID)
 	if err != nil {
 		return err
 	}
@@ -75,14 +76,14 @@ func UpdateHelmRevision(config *config.Config, projID, clusterID uint, rel *rele
 	stackRevision.Reason = "ApplicationUpgrade"
 	stackRevision.Message = fmt.Sprintf("The application %s was updated from version %d to %d", rel.Name, rel.Version-1, rel.Version)
 
-	_, err = config.Repo.Stack().AppendNewRevision(stackRevision)
+	_, err = config.Repo.Stack().AppendNewRevision(ctx, stackRevision)
 
 	return err
 }
 
-func UpdateEnvGroupVersion(config *config.Config, projID, clusterID uint, envGroup *types.EnvGroup) error {
+func UpdateEnvGroupVersion(ctx context.Context, config *config.Config, projID, clusterID uint, envGroup *types.EnvGroup) error {
 	// read stack env group by params
-	stackEnvGroup, err := config.Repo.Stack().ReadStackEnvGroupFirstMatch(projID, clusterID, envGroup.Namespace, envGroup.Name)
+	stackEnvGroup, err := config.Repo.Stack().ReadStackEnvGroupFirstMatch(ctx, projID, clusterID, envGroup.Namespace, envGroup.Name)
 	if err != nil {
 		if errors.Is(err, gorm.ErrRecordNotFound) {
 			return nil
@@ -92,13 +93,13 @@ func UpdateEnvGroupVersion(config *config.Config, projID, clusterID uint, envGro
 	}
 
 	// read the revision number corresponding and create a new revision of the stack
-	oldStackRevision, err := config.Repo.Stack().ReadStackRevision(stackEnvGroup.StackRevisionID)
+	oldStackRevision, err := config.Repo.Stack().ReadStackRevision(ctx, stackEnvGroup.StackRevisionID)
 	if err != nil {
 		return err
 	}
 
 	// get the latest revision for that stack
-	stack, err := config.Repo.Stack().ReadStackByID(projID, oldStackRevision.StackID)
+	stack, err := config.Repo.Stack().ReadStackByID(ctx, projID, oldStackRevision.StackID)
 	if err != nil {
 		return err
 	}
@@ -149,14 +150,14 @@ func UpdateEnvGroupVersion(config *config.Config, projID, clusterID uint, envGro
 	stackRevision.Message = fmt.Sprintf("The environment group %s was updated from version %d to %d", envGroup.Name, envGroup.Version-1, envGroup.Version)
 	stackRevision.Status = "deployed"
 
-	_, err = config.Repo.Stack().AppendNewRevision(stackRevision)
+	_, err = config.Repo.Stack().AppendNewRevision(ctx, stackRevision)
 
 	return err
 }
 
-func GetStackForEnvGroup(config *config.Config, projID, clusterID uint, envGroup *types.EnvGroup) (string, error) {
+func GetStackForEnvGroup(ctx context.Context, config *config.Config, projID, clusterID uint, envGroup *types.EnvGroup) (string, error) {
 	// read stack env group by params
-	stackEnvGroup, err := config.Repo.Stack().ReadStackEnvGroupFirstMatch(projID, clusterID, envGroup.Namespace, envGroup.Name)
+	stackEnvGroup, err := config.Repo.Stack().ReadStackEnvGroupFirstMatch(ctx, projID, clusterID, envGroup.Namespace, envGroup.Name)
 	if err != nil {
 		if errors.Is(err, gorm.ErrRecordNotFound) {
 			return "", nil
@@ -166,13 +167,13 @@ func GetStackForEnvGroup(config *config.Config, projID, clusterID uint, envGroup
 	}
 
 	// read the revision number corresponding and create a new revision of the stack
-	oldStackRevision, err := config.Repo.Stack().ReadStackRevision(stackEnvGroup.StackRevisionID)
+	oldStackRevision, err := config.Repo.Stack().ReadStackRevision(ctx, stackEnvGroup.StackRevisionID)
 	if err != nil {
 		return "", err
 	}
 
 	// get the latest revision for that stack
-	stack, err := config.Repo.Stack().ReadStackByID(projID, oldStackRevision.StackID)
+	stack, err := config.Repo.Stack().ReadStackByID(ctx, projID, oldStackRevision.StackID)
 	if err != nil {
 		return "", err
 	}
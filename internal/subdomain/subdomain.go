@@ -0,0 +1,182 @@
+// Package subdomain allocates subdomains of the wildcard domain of a project to the web services of apps, so that new apps and
+// preview environments get a hostname without a DNS record being created for each of them.
+package subdomain
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"regexp"
+	"strings"
+
+	"github.com/porter-dev/porter/internal/models"
+	"github.com/porter-dev/porter/internal/repository"
+	"gorm.io/gorm"
+	"k8s.io/apimachinery/pkg/util/validation"
+)
+
+const (
+	// maxLabelLength is the maximum length of a DNS label
+	maxLabelLength = 63
+	// maxAttempts is the number of suffixed names tried before allocation gives up
+	maxAttempts = 100
+)
+
+// ErrNameTaken is returned when reserving a name which is already allocated in the project
+var ErrNameTaken = errors.New("subdomain is already allocated")
+
+var invalidLabelChars = regexp.MustCompile(`[^a-z0-9]+`)
+
+// NormalizeWildcardDomain returns the parent domain of a wildcard domain, without the leading *. or trailing dot
+func NormalizeWildcardDomain(domain string) (string, error) {
+	domain = strings.TrimSuffix(strings.TrimPrefix(strings.ToLower(strings.TrimSpace(domain)), "*."), ".")
+
+	if errs := validation.IsDNS1123Subdomain(domain); len(errs) > 0 {
+		return "", fmt.Errorf("invalid wildcard domain: %s", strings.Join(errs, ", "))
+	}
+
+	if !strings.Contains(domain, ".") {
+		return "", errors.New("wildcard domain must have at least two labels, such as apps.example.com")
+	}
+
+	// the longest subdomain must still fit in a hostname
+	if len(domain)+maxLabelLength+1 > validation.DNS1123SubdomainMaxLength {
+		return "", fmt.Errorf("wildcard domain must be at most %d characters", validation.DNS1123SubdomainMaxLength-maxLabelLength-1)
+	}
+
+	return domain, nil
+}
+
+// Label returns the DNS label for the given parts, such as the names of a service and an app, joined with dashes. Characters
+// which are not allowed in a label are replaced, and the label is truncated to the maximum length of a label.
+func Label(parts ...string) string {
+	slugs := make([]string, 0, len(parts))
+
+	for _, part := range parts {
+		slug := strings.Trim(invalidLabelChars.ReplaceAllString(strings.ToLower(part), "-"), "-")
+		if slug != "" {
+			slugs = append(slugs, slug)
+		}
+	}
+
+	return truncate(strings.Join(slugs, "-"), maxLabelLength)
+}
+
+// candidate returns the name tried on the given attempt of an allocation. Names after the first are suffixed with the attempt.
+func candidate(label string, attempt int) string {
+	if attempt == 1 {
+		return label
+	}
+
+	suffix := fmt.Sprintf("-%d", attempt)
+
+	return truncate(label, maxLabelLength-len(suffix)) + suffix
+}
+
+func truncate(label string, length int) string {
+	if len(label) > length {
+		label = label[:length]
+	}
+
+	return strings.TrimRight(label, "-")
+}
+
+// AllocateInput identifies the service which is allocated a subdomain
+type AllocateInput struct {
+	ProjectID   uint
+	ClusterID   uint
+	AppName     string
+	ServiceName string
+
+	// DeploymentTargetID and PreviewName are set when allocating for a preview environment, whose subdomains include the name of
+	// the preview so that each branch gets its own hostname
+	DeploymentTargetID string
+	PreviewName        string
+}
+
+// Allocate returns the subdomain allocated to a service, or a subdomain reserved for it, and allocates one if it has neither. The
+// subdomain is named after the service and app, and suffixed with a number if the name is allocated to something else.
+func Allocate(ctx context.Context, repo repository.SubdomainRepository, input AllocateInput) (*models.SubdomainAllocation, error) {
+	existing, err := repo.ReadSubdomainAllocationByService(ctx, input.ClusterID, input.AppName, input.ServiceName, input.DeploymentTargetID)
+	if err == nil {
+		return existing, nil
+	}
+
+	if !errors.Is(err, gorm.ErrRecordNotFound) {
+		return nil, fmt.Errorf("error reading subdomain of service: %w", err)
+	}
+
+	label := Label(input.ServiceName, input.AppName, input.PreviewName)
+	if label == "" {
+		return nil, errors.New("service and app names do not contain any valid characters")
+	}
+
+	for attempt := 1; attempt <= maxAttempts; attempt++ {
+		allocation := &models.SubdomainAllocation{
+			ProjectID:          input.ProjectID,
+			Name:               candidate(label, attempt),
+			ClusterID:          input.ClusterID,
+			AppName:            input.AppName,
+			ServiceName:        input.ServiceName,
+			DeploymentTargetID: input.DeploymentTargetID,
+		}
+
+		allocation, err := create(ctx, repo, allocation)
+		if err == nil {
+			return allocation, nil
+		}
+
+		if !errors.Is(err, ErrNameTaken) {
+			return nil, err
+		}
+	}
+
+	return nil, fmt.Errorf("no free subdomain found for %s after %d attempts", label, maxAttempts)
+}
+
+// Reserve allocates a subdomain by name, such as to keep it from being allocated automatically or to give it to a service. It
+// returns ErrNameTaken if the name is already allocated.
+func Reserve(ctx context.Context, repo repository.SubdomainRepository, allocation *models.SubdomainAllocation) (*models.SubdomainAllocation, error) {
+	if errs := validation.IsDNS1123Label(allocation.Name); len(errs) > 0 {
+		return nil, fmt.Errorf("invalid subdomain: %s", strings.Join(errs, ", "))
+	}
+
+	return create(ctx, repo, allocation)
+}
+
+// create allocates a subdomain, returning ErrNameTaken if the name is allocated, including by a concurrent allocation which the
+// unique index on the name rejects
+func create(ctx context.Context, repo repository.SubdomainRepository, allocation *models.SubdomainAllocation) (*models.SubdomainAllocation, error) {
+	taken, err := isTaken(ctx, repo, allocation.ProjectID, allocation.Name)
+	if err != nil {
+		return nil, err
+	}
+
+	if taken {
+		return nil, ErrNameTaken
+	}
+
+	created, err := repo.CreateSubdomainAllocation(ctx, allocation)
+	if err != nil {
+		if taken, readErr := isTaken(ctx, repo, allocation.ProjectID, allocation.Name); readErr == nil && taken {
+			return nil, ErrNameTaken
+		}
+
+		return nil, fmt.Errorf("error allocating subdomain: %w", err)
+	}
+
+	return created, nil
+}
+
+func isTaken(ctx context.Context, repo repository.SubdomainRepository, projectID uint, name string) (bool, error) {
+	_, err := repo.ReadSubdomainAllocation(ctx, projectID, name)
+	if err == nil {
+		return true, nil
+	}
+
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		return false, nil
+	}
+
+	return false, fmt.Errorf("error reading subdomain: %w", err)
+}
@@ -0,0 +1,97 @@
+package subdomain
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"testing"
+
+	"github.com/matryer/is"
+	"github.com/porter-dev/porter/internal/models"
+	"github.com/porter-dev/porter/internal/repository/test"
+)
+
+func TestNormalizeWildcardDomain(t *testing.T) {
+	is := is.New(t)
+
+	domain, err := NormalizeWildcardDomain("*.Apps.Example.com.")
+	is.NoErr(err)
+	is.Equal(domain, "apps.example.com")
+
+	_, err = NormalizeWildcardDomain("localhost")
+	is.True(err != nil)
+
+	_, err = NormalizeWildcardDomain("apps_example.com")
+	is.True(err != nil)
+}
+
+func TestLabel(t *testing.T) {
+	is := is.New(t)
+
+	is.Equal(Label("web", "my_app", ""), "web-my-app")
+	is.Equal(Label("web", "api", "feature/Login-Page"), "web-api-feature-login-page")
+
+	long := Label("web", strings.Repeat("a", 70))
+	is.Equal(len(long), maxLabelLength)
+	is.Equal(len(candidate(long, 12)), maxLabelLength)
+	is.True(strings.HasSuffix(candidate(long, 12), "-12"))
+}
+
+func TestAllocate(t *testing.T) {
+	is := is.New(t)
+	ctx := context.Background()
+
+	repo := test.NewSubdomainRepository(true)
+
+	web, err := Allocate(ctx, repo, AllocateInput{ProjectID: 1, ClusterID: 1, AppName: "api", ServiceName: "web"})
+	is.NoErr(err)
+	is.Equal(web.Name, "web-api")
+
+	// allocation is idempotent for the same service
+	again, err := Allocate(ctx, repo, AllocateInput{ProjectID: 1, ClusterID: 1, AppName: "api", ServiceName: "web"})
+	is.NoErr(err)
+	is.Equal(again.ID, web.ID)
+
+	// the same app on another cluster of the project does not collide
+	other, err := Allocate(ctx, repo, AllocateInput{ProjectID: 1, ClusterID: 2, AppName: "api", ServiceName: "web"})
+	is.NoErr(err)
+	is.Equal(other.Name, "web-api-2")
+
+	preview, err := Allocate(ctx, repo, AllocateInput{ProjectID: 1, ClusterID: 1, AppName: "api", ServiceName: "web", DeploymentTargetID: "dt-1", PreviewName: "preview-pr-12"})
+	is.NoErr(err)
+	is.Equal(preview.Name, "web-api-preview-pr-12")
+
+	// another project has its own names
+	otherProject, err := Allocate(ctx, repo, AllocateInput{ProjectID: 2, ClusterID: 3, AppName: "api", ServiceName: "web"})
+	is.NoErr(err)
+	is.Equal(otherProject.Name, "web-api")
+}
+
+func TestReserve(t *testing.T) {
+	is := is.New(t)
+	ctx := context.Background()
+
+	repo := test.NewSubdomainRepository(true)
+
+	_, err := Reserve(ctx, repo, &models.SubdomainAllocation{ProjectID: 1, Name: "web-api"})
+	is.NoErr(err)
+
+	_, err = Reserve(ctx, repo, &models.SubdomainAllocation{ProjectID: 1, Name: "web-api"})
+	is.True(errors.Is(err, ErrNameTaken))
+
+	_, err = Reserve(ctx, repo, &models.SubdomainAllocation{ProjectID: 1, Name: "Not_A_Label"})
+	is.True(err != nil)
+
+	// a reserved name is skipped by automatic allocation
+	web, err := Allocate(ctx, repo, AllocateInput{ProjectID: 1, ClusterID: 1, AppName: "api", ServiceName: "web"})
+	is.NoErr(err)
+	is.Equal(web.Name, "web-api-2")
+
+	// a name reserved for a service is allocated to it
+	_, err = Reserve(ctx, repo, &models.SubdomainAllocation{ProjectID: 1, Name: "dashboard", ClusterID: 1, AppName: "admin", ServiceName: "web"})
+	is.NoErr(err)
+
+	admin, err := Allocate(ctx, repo, AllocateInput{ProjectID: 1, ClusterID: 1, AppName: "admin", ServiceName: "web"})
+	is.NoErr(err)
+	is.Equal(admin.Name, "dashboard")
+}
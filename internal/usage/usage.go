@@ -25,7 +25,7 @@ type GetUsageOpts struct {
 }
 
 // GetUsage gets a project's current usage and usage limit
-func GetUsage(opts *GetUsageOpts) (
+func GetUsage(ctx context.Context, opts *GetUsageOpts) (
 	current, limit *types.ProjectUsage,
 	resourceUse *models.ProjectUsageCache,
 	err error,
@@ -36,7 +36,7 @@ func GetUsage(opts *GetUsageOpts) (
 		return nil, nil, nil, err
 	}
 
-	usageCache, err := opts.Repo.ProjectUsage().ReadProjectUsageCache(opts.Project.ID)
+	usageCache, err := opts.Repo.ProjectUsage().ReadProjectUsageCache(ctx, opts.Project.ID)
 	isCacheFound := true
 
 	if isCacheFound = !errors.Is(err, gorm.ErrRecordNotFound); err != nil && isCacheFound {
@@ -44,13 +44,13 @@ func GetUsage(opts *GetUsageOpts) (
 	}
 
 	// query for the linked cluster counts
-	clusters, err := opts.Repo.Cluster().ListClustersByProjectID(opts.Project.ID)
+	clusters, err := opts.Repo.Cluster().ListClustersByProjectID(ctx, opts.Project.ID)
 	if err != nil {
 		return nil, nil, nil, err
 	}
 
 	// query for the linked user counts
-	roles, err := opts.Repo.Project().ListProjectRoles(opts.Project.ID)
+	roles, err := opts.Repo.Project().ListProjectRoles(ctx, opts.Project.ID)
 	if err != nil {
 		return nil, nil, nil, err
 	}
@@ -97,9 +97,9 @@ func GetUsage(opts *GetUsageOpts) (
 	usageCache.Exceeded = isExceeded
 
 	if !isCacheFound {
-		usageCache, err = opts.Repo.ProjectUsage().CreateProjectUsageCache(usageCache)
+		usageCache, err = opts.Repo.ProjectUsage().CreateProjectUsageCache(ctx, usageCache)
 	} else if isUsageChanged(&oldUsageCache, usageCache) {
-		usageCache, err = opts.Repo.ProjectUsage().UpdateProjectUsageCache(usageCache)
+		usageCache, err = opts.Repo.ProjectUsage().UpdateProjectUsageCache(ctx, usageCache)
 	}
 
 	if err != nil {
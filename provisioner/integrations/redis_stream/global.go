@@ -98,6 +98,7 @@ func PushToGlobalStream(
 }
 
 func GlobalStreamListener(
+	ctx context.Context,
 	client *redis.Client,
 	config *config.Config,
 	repo repository.Repository,
@@ -145,13 +146,13 @@ func GlobalStreamListener(
 
 			config.Logger.Debug().Msg(fmt.Sprintf("reading infra %d and operation %s for project %d", name.InfraID, name.OperationUID, name.ProjectID))
 
-			infra, err := repo.Infra().ReadInfra(name.ProjectID, name.InfraID)
+			infra, err := repo.Infra().ReadInfra(ctx, name.ProjectID, name.InfraID)
 			if err != nil {
 				config.Logger.Debug().Msg(fmt.Sprintf("could not read infra %d in project %d: %s", name.InfraID, name.ProjectID, err.Error()))
 				continue
 			}
 
-			operation, err := repo.Infra().ReadOperation(name.InfraID, name.OperationUID)
+			operation, err := repo.Infra().ReadOperation(ctx, name.InfraID, name.OperationUID)
 			if err != nil {
 				config.Logger.Debug().Msg(fmt.Sprintf("could not read operation %s, infra %d in project %d: %s", name.OperationUID, name.InfraID, name.ProjectID, err.Error()))
 				continue
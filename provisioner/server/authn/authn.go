@@ -161,12 +161,12 @@ func (authn *AuthNPorterToken) getPorterTokenFromRequest(r *http.Request) (*mode
 		return nil, errInvalidToken
 	}
 
-	return ValidatePorterToken(authn.config, uint(porterTokenID), porterToken)
+	return ValidatePorterToken(r.Context(), authn.config, uint(porterTokenID), porterToken)
 }
 
-func ValidatePorterToken(config *config.Config, tokenID uint, token string) (*models.CredentialsExchangeToken, error) {
+func ValidatePorterToken(ctx context.Context, config *config.Config, tokenID uint, token string) (*models.CredentialsExchangeToken, error) {
 	// read the access token in the header, check against DB
-	ceToken, err := config.Repo.CredentialsExchangeToken().ReadCredentialsExchangeToken(tokenID)
+	ceToken, err := config.Repo.CredentialsExchangeToken().ReadCredentialsExchangeToken(ctx, tokenID)
 	if err != nil {
 		return nil, err
 	}
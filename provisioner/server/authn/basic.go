@@ -52,7 +52,7 @@ func (authn *AuthNBasic) ServeHTTP(w http.ResponseWriter, r *http.Request) {
 			return
 		}
 
-		ceToken, err := ValidatePorterToken(authn.config, uint(porterTokenID), porterToken)
+		ceToken, err := ValidatePorterToken(r.Context(), authn.config, uint(porterTokenID), porterToken)
 		if err != nil {
 			authn.sendForbiddenError(err, w, r)
 			return
@@ -48,7 +48,7 @@ func (p *ProjectScopedMiddleware) ServeHTTP(w http.ResponseWriter, r *http.Reque
 		return
 	}
 
-	project, err := p.config.Repo.Project().ReadProject(projID)
+	project, err := p.config.Repo.Project().ReadProject(r.Context(), projID)
 	if err != nil {
 		if err == gorm.ErrRecordNotFound {
 			apierrors.HandleAPIError(p.config.Logger, p.config.Alerter, w, r, apierrors.NewErrForbidden(
@@ -80,7 +80,7 @@ func (p *WorkspaceScopedMiddleware) ServeHTTP(w http.ResponseWriter, r *http.Req
 	}
 
 	// look for infra with that ID and project ID
-	infra, err := p.config.Repo.Infra().ReadInfra(name.ProjectID, name.InfraID)
+	infra, err := p.config.Repo.Infra().ReadInfra(r.Context(), name.ProjectID, name.InfraID)
 	if err != nil {
 		if err == gorm.ErrRecordNotFound {
 			apierrors.HandleAPIError(
@@ -99,7 +99,7 @@ func (p *WorkspaceScopedMiddleware) ServeHTTP(w http.ResponseWriter, r *http.Req
 	}
 
 	// look for matching operation for the infra
-	operation, err := p.config.Repo.Infra().ReadOperation(infra.ID, name.OperationUID)
+	operation, err := p.config.Repo.Infra().ReadOperation(r.Context(), infra.ID, name.OperationUID)
 	if err != nil {
 		if errors.Is(err, gorm.ErrRecordNotFound) {
 			apierrors.HandleAPIError(p.config.Logger, p.config.Alerter, w, r, apierrors.NewErrForbidden(err), true)
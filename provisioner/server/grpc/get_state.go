@@ -10,18 +10,20 @@ import (
 )
 
 func (s *ProvisionerServer) GetStateUpdate(infra *pb.Infra, server pb.Provisioner_GetStateUpdateServer) error {
-	name, ok := verifyStaticTokenContext(s.config, server.Context())
+	ctx := server.Context()
+
+	name, ok := verifyStaticTokenContext(s.config, ctx)
 
 	if !ok {
 		return fmt.Errorf("unauthorized")
 	}
 
-	modelInfra, err := s.config.Repo.Infra().ReadInfra(name.ProjectID, name.InfraID)
+	modelInfra, err := s.config.Repo.Infra().ReadInfra(ctx, name.ProjectID, name.InfraID)
 	if err != nil {
 		return err
 	}
 
-	operation, err := s.config.Repo.Infra().ReadOperation(name.InfraID, name.OperationUID)
+	operation, err := s.config.Repo.Infra().ReadOperation(ctx, name.InfraID, name.OperationUID)
 	if err != nil {
 		return err
 	}
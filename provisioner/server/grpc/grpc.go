@@ -81,7 +81,7 @@ func verifyPorterTokenContext(config *config.Config, ctx context.Context) (*mode
 		return nil, false
 	}
 
-	_, err = authn.ValidatePorterToken(config, uint(tokenID), tokenArr[0])
+	_, err = authn.ValidatePorterToken(ctx, config, uint(tokenID), tokenArr[0])
 
 	if err != nil {
 		return nil, false
@@ -11,18 +11,20 @@ import (
 )
 
 func (s *ProvisionerServer) StoreLog(stream pb.Provisioner_StoreLogServer) error {
-	name, ok := verifyPorterTokenContext(s.config, stream.Context())
+	ctx := stream.Context()
+
+	name, ok := verifyPorterTokenContext(s.config, ctx)
 
 	if !ok {
 		return fmt.Errorf("unauthorized")
 	}
 
-	infra, err := s.config.Repo.Infra().ReadInfra(name.ProjectID, name.InfraID)
+	infra, err := s.config.Repo.Infra().ReadInfra(ctx, name.ProjectID, name.InfraID)
 	if err != nil {
 		return err
 	}
 
-	operation, err := s.config.Repo.Infra().ReadOperation(name.InfraID, name.OperationUID)
+	operation, err := s.config.Repo.Infra().ReadOperation(ctx, name.InfraID, name.OperationUID)
 	if err != nil {
 		return err
 	}
@@ -1,6 +1,7 @@
 package provision
 
 import (
+	"context"
 	"encoding/json"
 	"fmt"
 	"net/http"
@@ -70,14 +71,14 @@ func (c *ProvisionApplyHandler) ServeHTTP(w http.ResponseWriter, r *http.Request
 		TemplateVersion: "v0.1.0",
 	}
 
-	operation, err = c.Config.Repo.Infra().AddOperation(infra, operation)
+	operation, err = c.Config.Repo.Infra().AddOperation(r.Context(), infra, operation)
 
 	if err != nil {
 		apierrors.HandleAPIError(c.Config.Logger, c.Config.Alerter, w, r, apierrors.NewErrInternal(err), true)
 		return
 	}
 
-	ceToken, rawToken, err := createCredentialsExchangeToken(c.Config, infra)
+	ceToken, rawToken, err := createCredentialsExchangeToken(r.Context(), c.Config, infra)
 	if err != nil {
 		apierrors.HandleAPIError(c.Config.Logger, c.Config.Alerter, w, r, apierrors.NewErrInternal(err), true)
 		return
@@ -123,7 +124,7 @@ func (c *ProvisionApplyHandler) ServeHTTP(w http.ResponseWriter, r *http.Request
 		infra.Status = types.InfraStatus("updating")
 	}
 
-	infra, err = c.Config.Repo.Infra().UpdateInfra(infra)
+	infra, err = c.Config.Repo.Infra().UpdateInfra(r.Context(), infra)
 
 	if err != nil {
 		apierrors.HandleAPIError(c.Config.Logger, c.Config.Alerter, w, r, apierrors.NewErrInternal(err), true)
@@ -160,7 +161,7 @@ func (c *ProvisionApplyHandler) ServeHTTP(w http.ResponseWriter, r *http.Request
 	}
 }
 
-func createCredentialsExchangeToken(conf *config.Config, infra *models.Infra) (*models.CredentialsExchangeToken, string, error) {
+func createCredentialsExchangeToken(ctx context.Context, conf *config.Config, infra *models.Infra) (*models.CredentialsExchangeToken, string, error) {
 	// convert the form to a project model
 	expiry := time.Now().Add(6 * time.Hour)
 
@@ -185,7 +186,7 @@ func createCredentialsExchangeToken(conf *config.Config, infra *models.Infra) (*
 	}
 
 	// handle write to the database
-	ceToken, err = conf.Repo.CredentialsExchangeToken().CreateCredentialsExchangeToken(ceToken)
+	ceToken, err = conf.Repo.CredentialsExchangeToken().CreateCredentialsExchangeToken(ctx, ceToken)
 
 	if err != nil {
 		return nil, "", err
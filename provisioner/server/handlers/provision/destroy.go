@@ -43,7 +43,7 @@ func (c *ProvisionDestroyHandler) ServeHTTP(w http.ResponseWriter, r *http.Reque
 	}
 
 	// get the values from the previous operation to re-use
-	lastOp, err := c.Config.Repo.Infra().GetLatestOperation(infra)
+	lastOp, err := c.Config.Repo.Infra().GetLatestOperation(r.Context(), infra)
 	if err != nil {
 		apierrors.HandleAPIError(c.Config.Logger, c.Config.Alerter, w, r, apierrors.NewErrInternal(err), true)
 		return
@@ -65,14 +65,14 @@ func (c *ProvisionDestroyHandler) ServeHTTP(w http.ResponseWriter, r *http.Reque
 		TemplateVersion: "v0.1.0",
 	}
 
-	operation, err = c.Config.Repo.Infra().AddOperation(infra, operation)
+	operation, err = c.Config.Repo.Infra().AddOperation(r.Context(), infra, operation)
 
 	if err != nil {
 		apierrors.HandleAPIError(c.Config.Logger, c.Config.Alerter, w, r, apierrors.NewErrInternal(err), true)
 		return
 	}
 
-	ceToken, rawToken, err := createCredentialsExchangeToken(c.Config, infra)
+	ceToken, rawToken, err := createCredentialsExchangeToken(r.Context(), c.Config, infra)
 	if err != nil {
 		apierrors.HandleAPIError(c.Config.Logger, c.Config.Alerter, w, r, apierrors.NewErrInternal(err), true)
 		return
@@ -119,7 +119,7 @@ func (c *ProvisionDestroyHandler) ServeHTTP(w http.ResponseWriter, r *http.Reque
 
 	infra.Status = types.InfraStatus("deleting")
 
-	infra, err = c.Config.Repo.Infra().UpdateInfra(infra)
+	infra, err = c.Config.Repo.Infra().UpdateInfra(r.Context(), infra)
 
 	if err != nil {
 		apierrors.HandleAPIError(c.Config.Logger, c.Config.Alerter, w, r, apierrors.NewErrInternal(err), true)
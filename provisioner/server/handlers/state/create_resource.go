@@ -51,7 +51,7 @@ func (c *CreateResourceHandler) ServeHTTP(w http.ResponseWriter, r *http.Request
 	}
 	// update the operation to indicate completion
 	operation.Status = "completed"
-	operation, err := c.Config.Repo.Infra().UpdateOperation(operation)
+	operation, err := c.Config.Repo.Infra().UpdateOperation(ctx, operation)
 	if err != nil {
 		apierrors.HandleAPIError(c.Config.Logger, c.Config.Alerter, w, r, apierrors.NewErrInternal(err), true)
 		return
@@ -70,7 +70,7 @@ func (c *CreateResourceHandler) ServeHTTP(w http.ResponseWriter, r *http.Request
 	}
 	// update the infra to indicate completion
 	infra.Status = "created"
-	infra, err = c.Config.Repo.Infra().UpdateInfra(infra)
+	infra, err = c.Config.Repo.Infra().UpdateInfra(ctx, infra)
 	if err != nil {
 		apierrors.HandleAPIError(c.Config.Logger, c.Config.Alerter, w, r, apierrors.NewErrInternal(err), true)
 		return
@@ -79,7 +79,7 @@ func (c *CreateResourceHandler) ServeHTTP(w http.ResponseWriter, r *http.Request
 	switch req.Kind {
 	case string(types.InfraEKS), string(types.InfraDOKS), string(types.InfraGKE), string(types.InfraAKS):
 		var cluster *models.Cluster
-		cluster, err = createCluster(c.Config, infra, operation, req.Output)
+		cluster, err = createCluster(ctx, c.Config, infra, operation, req.Output)
 		if cluster != nil {
 			c.Config.AnalyticsClient.Track(analytics.ClusterProvisioningSuccessTrack(
 				&analytics.ClusterProvisioningSuccessTrackOpts{
@@ -90,19 +90,19 @@ func (c *CreateResourceHandler) ServeHTTP(w http.ResponseWriter, r *http.Request
 			))
 		}
 	case string(types.InfraECR):
-		_, err = createECRRegistry(c.Config, infra, operation, req.Output)
+		_, err = createECRRegistry(ctx, c.Config, infra, operation, req.Output)
 	case string(types.InfraRDS):
 		_, err = createRDSDatabase(ctx, c.Config, infra, operation, req.Output)
 	case string(types.InfraS3):
 		err = createS3Bucket(ctx, c.Config, infra, operation, req.Output)
 	case string(types.InfraDOCR):
-		_, err = createDOCRRegistry(c.Config, infra, operation, req.Output)
+		_, err = createDOCRRegistry(ctx, c.Config, infra, operation, req.Output)
 	case string(types.InfraGCR):
-		_, err = createGCRRegistry(c.Config, infra, operation, req.Output)
+		_, err = createGCRRegistry(ctx, c.Config, infra, operation, req.Output)
 	case string(types.InfraGAR):
-		_, err = createGARRegistry(c.Config, infra, operation, req.Output)
+		_, err = createGARRegistry(ctx, c.Config, infra, operation, req.Output)
 	case string(types.InfraACR):
-		_, err = createACRRegistry(c.Config, infra, operation, req.Output)
+		_, err = createACRRegistry(ctx, c.Config, infra, operation, req.Output)
 	}
 	if err != nil {
 		apierrors.HandleAPIError(c.Config.Logger, c.Config.Alerter, w, r, apierrors.NewErrInternal(err), true)
@@ -110,7 +110,7 @@ func (c *CreateResourceHandler) ServeHTTP(w http.ResponseWriter, r *http.Request
 	}
 }
 
-func createECRRegistry(config *config.Config, infra *models.Infra, operation *models.Operation, output map[string]interface{}) (*models.Registry, error) {
+func createECRRegistry(ctx context.Context, config *config.Config, infra *models.Infra, operation *models.Operation, output map[string]interface{}) (*models.Registry, error) {
 	reg := &models.Registry{
 		ProjectID:        infra.ProjectID,
 		AWSIntegrationID: infra.AWSIntegrationID,
@@ -118,7 +118,7 @@ func createECRRegistry(config *config.Config, infra *models.Infra, operation *mo
 		Name:             output["name"].(string),
 	}
 	// parse raw data into ECR type
-	awsInt, err := config.Repo.AWSIntegration().ReadAWSIntegration(reg.ProjectID, reg.AWSIntegrationID)
+	awsInt, err := config.Repo.AWSIntegration().ReadAWSIntegration(ctx, reg.ProjectID, reg.AWSIntegrationID)
 	if err != nil {
 		return nil, err
 	}
@@ -135,7 +135,7 @@ func createECRRegistry(config *config.Config, infra *models.Infra, operation *mo
 		return nil, err
 	}
 	reg.URL = *authOutput.AuthorizationData[0].ProxyEndpoint
-	reg, err = config.Repo.Registry().CreateRegistry(reg)
+	reg, err = config.Repo.Registry().CreateRegistry(ctx, reg)
 	if err != nil {
 		return nil, err
 	}
@@ -151,7 +151,7 @@ func createRDSDatabase(ctx context.Context, config *config.Config, infra *models
 	var database *models.Database
 	var err error
 	var isNotFound bool
-	database, err = config.Repo.Database().ReadDatabaseByInfraID(infra.ProjectID, infra.ID)
+	database, err = config.Repo.Database().ReadDatabaseByInfraID(ctx, infra.ProjectID, infra.ID)
 	isNotFound = err != nil && errors.Is(err, gorm.ErrRecordNotFound)
 	if isNotFound {
 		database = &models.Database{
@@ -167,12 +167,12 @@ func createRDSDatabase(ctx context.Context, config *config.Config, infra *models
 	database.InstanceEndpoint = output["rds_connection_endpoint"].(string)
 	database.InstanceName = output["rds_instance_name"].(string)
 	if isNotFound {
-		database, err = config.Repo.Database().CreateDatabase(database)
+		database, err = config.Repo.Database().CreateDatabase(ctx, database)
 	} else {
-		database, err = config.Repo.Database().UpdateDatabase(database)
+		database, err = config.Repo.Database().UpdateDatabase(ctx, database)
 	}
 	infra.DatabaseID = database.ID
-	infra, err = config.Repo.Infra().UpdateInfra(infra)
+	infra, err = config.Repo.Infra().UpdateInfra(ctx, infra)
 	if err != nil {
 		return nil, err
 	}
@@ -197,7 +197,7 @@ func createS3Bucket(ctx context.Context, config *config.Config, infra *models.In
 	return createS3EnvGroup(ctx, config, infra, lastApplied, output)
 }
 
-func createCluster(config *config.Config, infra *models.Infra, operation *models.Operation, output map[string]interface{}) (*models.Cluster, error) {
+func createCluster(ctx context.Context, config *config.Config, infra *models.Infra, operation *models.Operation, output map[string]interface{}) (*models.Cluster, error) {
 	// check for infra id being 0 as a safeguard so that all non-provisioned
 	// clusters are not matched by read
 	if infra.ID == 0 {
@@ -208,7 +208,7 @@ func createCluster(config *config.Config, infra *models.Infra, operation *models
 	var isNotFound bool
 	// look for cluster matching infra in database; if the cluster already exists, update the cluster but
 	// don't add it again
-	cluster, err = config.Repo.Cluster().ReadClusterByInfraID(infra.ProjectID, infra.ID)
+	cluster, err = config.Repo.Cluster().ReadClusterByInfraID(ctx, infra.ProjectID, infra.ID)
 	isNotFound = err != nil && errors.Is(err, gorm.ErrRecordNotFound)
 	if isNotFound {
 		cluster = getNewCluster(infra)
@@ -221,12 +221,12 @@ func createCluster(config *config.Config, infra *models.Infra, operation *models
 	}
 	// if cluster_token is output and infra is azure, update the azure integration
 	if _, exists := output["cluster_token"]; exists && infra.AzureIntegrationID != 0 {
-		azInt, err := config.Repo.AzureIntegration().ReadAzureIntegration(infra.ProjectID, infra.AzureIntegrationID)
+		azInt, err := config.Repo.AzureIntegration().ReadAzureIntegration(ctx, infra.ProjectID, infra.AzureIntegrationID)
 		if err != nil {
 			return nil, err
 		}
 		azInt.AKSPassword = []byte(output["cluster_token"].(string))
-		azInt, err = config.Repo.AzureIntegration().OverwriteAzureIntegration(azInt)
+		azInt, err = config.Repo.AzureIntegration().OverwriteAzureIntegration(ctx, azInt)
 		if err != nil {
 			return nil, err
 		}
@@ -239,9 +239,9 @@ func createCluster(config *config.Config, infra *models.Infra, operation *models
 	cluster.Server = output["cluster_endpoint"].(string)
 	cluster.CertificateAuthorityData = caData
 	if isNotFound {
-		cluster, err = config.Repo.Cluster().CreateCluster(cluster)
+		cluster, err = config.Repo.Cluster().CreateCluster(ctx, cluster)
 	} else {
-		cluster, err = config.Repo.Cluster().UpdateCluster(cluster)
+		cluster, err = config.Repo.Cluster().UpdateCluster(ctx, cluster)
 	}
 	if err != nil {
 		return nil, err
@@ -287,7 +287,7 @@ func transformClusterCAData(ca []byte) ([]byte, error) {
 	return ca, nil
 }
 
-func createDOCRRegistry(config *config.Config, infra *models.Infra, operation *models.Operation, output map[string]interface{}) (*models.Registry, error) {
+func createDOCRRegistry(ctx context.Context, config *config.Config, infra *models.Infra, operation *models.Operation, output map[string]interface{}) (*models.Registry, error) {
 	reg := &models.Registry{
 		ProjectID:       infra.ProjectID,
 		DOIntegrationID: infra.DOIntegrationID,
@@ -295,10 +295,10 @@ func createDOCRRegistry(config *config.Config, infra *models.Infra, operation *m
 		URL:             output["url"].(string),
 		Name:            output["name"].(string),
 	}
-	return config.Repo.Registry().CreateRegistry(reg)
+	return config.Repo.Registry().CreateRegistry(ctx, reg)
 }
 
-func createGCRRegistry(config *config.Config, infra *models.Infra, operation *models.Operation, output map[string]interface{}) (*models.Registry, error) {
+func createGCRRegistry(ctx context.Context, config *config.Config, infra *models.Infra, operation *models.Operation, output map[string]interface{}) (*models.Registry, error) {
 	reg := &models.Registry{
 		ProjectID:        infra.ProjectID,
 		GCPIntegrationID: infra.GCPIntegrationID,
@@ -306,10 +306,10 @@ func createGCRRegistry(config *config.Config, infra *models.Infra, operation *mo
 		URL:              output["url"].(string),
 		Name:             "gcr-registry",
 	}
-	return config.Repo.Registry().CreateRegistry(reg)
+	return config.Repo.Registry().CreateRegistry(ctx, reg)
 }
 
-func createGARRegistry(config *config.Config, infra *models.Infra, operation *models.Operation, output map[string]interface{}) (*models.Registry, error) {
+func createGARRegistry(ctx context.Context, config *config.Config, infra *models.Infra, operation *models.Operation, output map[string]interface{}) (*models.Registry, error) {
 	reg := &models.Registry{
 		ProjectID:        infra.ProjectID,
 		GCPIntegrationID: infra.GCPIntegrationID,
@@ -317,10 +317,10 @@ func createGARRegistry(config *config.Config, infra *models.Infra, operation *mo
 		URL:              output["url"].(string),
 		Name:             "gar-registry",
 	}
-	return config.Repo.Registry().CreateRegistry(reg)
+	return config.Repo.Registry().CreateRegistry(ctx, reg)
 }
 
-func createACRRegistry(config *config.Config, infra *models.Infra, operation *models.Operation, output map[string]interface{}) (*models.Registry, error) {
+func createACRRegistry(ctx context.Context, config *config.Config, infra *models.Infra, operation *models.Operation, output map[string]interface{}) (*models.Registry, error) {
 	reg := &models.Registry{
 		ProjectID:          infra.ProjectID,
 		AzureIntegrationID: infra.AzureIntegrationID,
@@ -328,11 +328,11 @@ func createACRRegistry(config *config.Config, infra *models.Infra, operation *mo
 		URL:                output["url"].(string),
 		Name:               output["name"].(string),
 	}
-	return config.Repo.Registry().CreateRegistry(reg)
+	return config.Repo.Registry().CreateRegistry(ctx, reg)
 }
 
 func createRDSEnvGroup(ctx context.Context, config *config.Config, infra *models.Infra, database *models.Database, lastApplied map[string]interface{}) error {
-	cluster, err := config.Repo.Cluster().ReadCluster(infra.ProjectID, infra.ParentClusterID)
+	cluster, err := config.Repo.Cluster().ReadCluster(ctx, infra.ProjectID, infra.ParentClusterID)
 	if err != nil {
 		return err
 	}
@@ -370,7 +370,7 @@ func createRDSEnvGroup(ctx context.Context, config *config.Config, infra *models
 }
 
 func deleteRDSEnvGroup(ctx context.Context, config *config.Config, infra *models.Infra, lastApplied map[string]interface{}) error {
-	cluster, err := config.Repo.Cluster().ReadCluster(infra.ProjectID, infra.ParentClusterID)
+	cluster, err := config.Repo.Cluster().ReadCluster(ctx, infra.ProjectID, infra.ParentClusterID)
 	if err != nil {
 		return err
 	}
@@ -391,7 +391,7 @@ func deleteRDSEnvGroup(ctx context.Context, config *config.Config, infra *models
 }
 
 func createS3EnvGroup(ctx context.Context, config *config.Config, infra *models.Infra, lastApplied map[string]interface{}, output map[string]interface{}) error {
-	cluster, err := config.Repo.Cluster().ReadCluster(infra.ProjectID, infra.ParentClusterID)
+	cluster, err := config.Repo.Cluster().ReadCluster(ctx, infra.ProjectID, infra.ParentClusterID)
 	if err != nil {
 		return err
 	}
@@ -422,7 +422,7 @@ func createS3EnvGroup(ctx context.Context, config *config.Config, infra *models.
 }
 
 func deleteS3EnvGroup(ctx context.Context, config *config.Config, infra *models.Infra, lastApplied map[string]interface{}) error {
-	cluster, err := config.Repo.Cluster().ReadCluster(infra.ProjectID, infra.ParentClusterID)
+	cluster, err := config.Repo.Cluster().ReadCluster(ctx, infra.ProjectID, infra.ParentClusterID)
 	if err != nil {
 		return err
 	}
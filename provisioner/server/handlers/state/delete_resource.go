@@ -39,7 +39,7 @@ func (c *DeleteResourceHandler) ServeHTTP(w http.ResponseWriter, r *http.Request
 	// update the operation to indicate completion
 	operation.Status = "completed"
 
-	operation, err := c.Config.Repo.Infra().UpdateOperation(operation)
+	operation, err := c.Config.Repo.Infra().UpdateOperation(ctx, operation)
 	if err != nil {
 		apierrors.HandleAPIError(c.Config.Logger, c.Config.Alerter, w, r, apierrors.NewErrInternal(err), true)
 		return
@@ -64,7 +64,7 @@ func (c *DeleteResourceHandler) ServeHTTP(w http.ResponseWriter, r *http.Request
 	// update the infra to indicate deletion
 	infra.Status = "deleted"
 
-	infra, err = c.Config.Repo.Infra().UpdateInfra(infra)
+	infra, err = c.Config.Repo.Infra().UpdateInfra(ctx, infra)
 
 	if err != nil {
 		apierrors.HandleAPIError(c.Config.Logger, c.Config.Alerter, w, r, apierrors.NewErrInternal(err), true)
@@ -74,11 +74,11 @@ func (c *DeleteResourceHandler) ServeHTTP(w http.ResponseWriter, r *http.Request
 	// switch on the kind of resource and write the corresponding objects to the database
 	switch infra.Kind {
 	case types.InfraECR, types.InfraGCR, types.InfraGAR, types.InfraDOCR, types.InfraACR:
-		_, err = deleteRegistry(c.Config, infra, operation)
+		_, err = deleteRegistry(ctx, c.Config, infra, operation)
 	case types.InfraEKS, types.InfraDOKS, types.InfraGKE, types.InfraAKS:
-		_, err = deleteCluster(c.Config, infra, operation)
+		_, err = deleteCluster(ctx, c.Config, infra, operation)
 	case types.InfraRDS:
-		_, err = deleteDatabase(c.Config, infra, operation)
+		_, err = deleteDatabase(ctx, c.Config, infra, operation)
 	case types.InfraS3:
 		err = deleteS3Bucket(ctx, c.Config, infra, operation)
 	}
@@ -89,13 +89,13 @@ func (c *DeleteResourceHandler) ServeHTTP(w http.ResponseWriter, r *http.Request
 	}
 }
 
-func deleteRegistry(config *config.Config, infra *models.Infra, operation *models.Operation) (*models.Registry, error) {
-	reg, err := config.Repo.Registry().ReadRegistryByInfraID(infra.ProjectID, infra.ID)
+func deleteRegistry(ctx context.Context, config *config.Config, infra *models.Infra, operation *models.Operation) (*models.Registry, error) {
+	reg, err := config.Repo.Registry().ReadRegistryByInfraID(ctx, infra.ProjectID, infra.ID)
 	if err != nil {
 		return nil, err
 	}
 
-	err = config.Repo.Registry().DeleteRegistry(reg)
+	err = config.Repo.Registry().DeleteRegistry(ctx, reg)
 
 	if err != nil {
 		return nil, err
@@ -104,13 +104,13 @@ func deleteRegistry(config *config.Config, infra *models.Infra, operation *model
 	return reg, nil
 }
 
-func deleteCluster(config *config.Config, infra *models.Infra, operation *models.Operation) (*models.Cluster, error) {
-	cluster, err := config.Repo.Cluster().ReadClusterByInfraID(infra.ProjectID, infra.ID)
+func deleteCluster(ctx context.Context, config *config.Config, infra *models.Infra, operation *models.Operation) (*models.Cluster, error) {
+	cluster, err := config.Repo.Cluster().ReadClusterByInfraID(ctx, infra.ProjectID, infra.ID)
 	if err != nil {
 		return nil, err
 	}
 
-	err = config.Repo.Cluster().DeleteCluster(cluster)
+	err = config.Repo.Cluster().DeleteCluster(ctx, cluster)
 
 	if err != nil {
 		return nil, err
@@ -119,13 +119,13 @@ func deleteCluster(config *config.Config, infra *models.Infra, operation *models
 	return cluster, nil
 }
 
-func deleteDatabase(config *config.Config, infra *models.Infra, operation *models.Operation) (*models.Database, error) {
-	database, err := config.Repo.Database().ReadDatabaseByInfraID(infra.ProjectID, infra.ID)
+func deleteDatabase(ctx context.Context, config *config.Config, infra *models.Infra, operation *models.Operation) (*models.Database, error) {
+	database, err := config.Repo.Database().ReadDatabaseByInfraID(ctx, infra.ProjectID, infra.ID)
 	if err != nil {
 		return nil, err
 	}
 
-	err = config.Repo.Database().DeleteDatabase(database.ProjectID, database.ClusterID, database.ID)
+	err = config.Repo.Database().DeleteDatabase(ctx, database.ProjectID, database.ClusterID, database.ID)
 
 	if err != nil {
 		return nil, err
@@ -42,7 +42,7 @@ func (c *ReportErrorHandler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
 	// update the infra to indicate error
 	infra.Status = "errored"
 
-	infra, err := c.Config.Repo.Infra().UpdateInfra(infra)
+	infra, err := c.Config.Repo.Infra().UpdateInfra(r.Context(), infra)
 	if err != nil {
 		apierrors.HandleAPIError(c.Config.Logger, c.Config.Alerter, w, r, apierrors.NewErrInternal(err), true)
 		return
@@ -53,7 +53,7 @@ func (c *ReportErrorHandler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
 	operation.Errored = true
 	operation.Error = req.Error
 
-	operation, err = c.Config.Repo.Infra().UpdateOperation(operation)
+	operation, err = c.Config.Repo.Infra().UpdateOperation(r.Context(), operation)
 
 	if err != nil {
 		apierrors.HandleAPIError(c.Config.Logger, c.Config.Alerter, w, r, apierrors.NewErrInternal(err), true)